@@ -5,12 +5,16 @@ import "github.com/spf13/viper"
 var Cfg Config //全局变量，存储配置文件内容
 
 type Config struct {
-	DBType           string       // 数据库类型
-	ConnectionString string       // 数据库连接字符串
-	DBPool           DBPoolConfig // 数据库连接池配置
-	MinIO            MinIOConfig  // MinIO配置
-	Server           ServerConfig // 服务器配置
-	Redka            RedkaConfig  // Redka缓存配置
+	DBType           string              // 数据库类型
+	ConnectionString string              // 数据库连接字符串
+	DBPool           DBPoolConfig        // 数据库连接池配置
+	MinIO            MinIOConfig         // MinIO配置
+	Server           ServerConfig        // 服务器配置
+	Redka            RedkaConfig         // Redka缓存配置
+	Upload           UploadConfig        // 文件上传配置
+	Retention        RetentionConfig     // 消息保留期限配置
+	Admin            AdminConfig         // 管理员配置
+	FriendRequest    FriendRequestConfig // 好友请求配置
 }
 
 type DBPoolConfig struct {
@@ -29,7 +33,8 @@ type MinIOConfig struct {
 }
 
 type ServerConfig struct {
-	Port string // 服务器端口
+	Port   string // 服务器端口
+	NodeId int    // 节点ID（0-1023），用于生成消息ID，多节点部署时每个节点必须不同
 }
 
 type RedkaConfig struct {
@@ -40,6 +45,27 @@ type RedkaConfig struct {
 	MaxMemory   string // 最大内存使用量
 }
 
+type UploadConfig struct {
+	FileExtensions []string // 允许上传的普通文件扩展名（如 ".pdf"），为空时使用默认列表
+	MaxFileSize    int      // 普通文件大小上限（MB），为0时使用默认值
+}
+
+type RetentionConfig struct {
+	Days          int  // 全局消息保留天数，0表示永久保留；群组可以单独设置
+	IntervalHours int  // 清理任务执行间隔（小时），为0时默认24
+	BatchSize     int  // 每批处理的消息数，为0时默认500
+	Archive       bool // 清理前是否将消息内容压缩归档到对象存储
+}
+
+type FriendRequestConfig struct {
+	ExpireDays          int // 待处理的好友请求有效天数，为0时默认7
+	RejectCooldownHours int // 请求被拒绝后再次向同一用户发送请求的间隔（小时），为0时默认24
+}
+
+type AdminConfig struct {
+	UserIds []int // 管理员用户ID，可以调用数据导入等管理接口
+}
+
 func init() {
 	viper.SetConfigName("Config")
 	viper.AddConfigPath(".")
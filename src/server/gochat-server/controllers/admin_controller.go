@@ -0,0 +1,53 @@
+package controllers
+
+import (
+	"gochat_server/dto"
+	"gochat_server/services"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ImportData 导入用户、好友关系、群组和历史消息
+// 请求体为导入文件内容，也可以通过 multipart 表单的 file 字段上传
+func ImportData(c *gin.Context) {
+	var reader io.Reader = c.Request.Body
+	if strings.HasPrefix(c.ContentType(), "multipart/") {
+		file, err := c.FormFile("file")
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Code:    400,
+				Message: "获取文件失败: " + err.Error(),
+			})
+			return
+		}
+		src, err := file.Open()
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Code:    400,
+				Message: "打开文件失败: " + err.Error(),
+			})
+			return
+		}
+		defer src.Close()
+		reader = src
+	}
+
+	report, err := services.ImportData(reader)
+	if err != nil {
+		c.JSON(http.StatusOK, dto.Response{
+			Code:    400,
+			Message: err.Error(),
+			Data:    report,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Response{
+		Code:    0,
+		Message: "导入完成",
+		Data:    report,
+	})
+}
@@ -0,0 +1,111 @@
+package controllers
+
+import (
+	"gochat_server/dto"
+	"gochat_server/middlewares"
+	"gochat_server/services"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// blockParameter 拉黑用户的参数
+type blockParameter struct {
+	UserId int `json:"userId" binding:"required"`
+}
+
+// BlockUser 拉黑用户
+func BlockUser(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Code:    401,
+			Message: "未授权",
+		})
+		return
+	}
+
+	var parameter blockParameter
+	if err := c.ShouldBindJSON(&parameter); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Code:    400,
+			Message: "参数错误: " + err.Error(),
+		})
+		return
+	}
+
+	if err := services.BlockUser(userID, parameter.UserId); err != nil {
+		c.JSON(http.StatusOK, dto.ErrorResponse{
+			Code:    400,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Response{
+		Code:    0,
+		Message: "拉黑成功",
+	})
+}
+
+// UnblockUser 取消拉黑
+func UnblockUser(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Code:    401,
+			Message: "未授权",
+		})
+		return
+	}
+
+	targetId, err := strconv.Atoi(c.Param("userId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Code:    400,
+			Message: "无效的用户ID",
+		})
+		return
+	}
+
+	if err := services.UnblockUser(userID, targetId); err != nil {
+		c.JSON(http.StatusOK, dto.ErrorResponse{
+			Code:    400,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Response{
+		Code:    0,
+		Message: "取消拉黑成功",
+	})
+}
+
+// GetBlockedUsers 获取黑名单
+func GetBlockedUsers(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Code:    401,
+			Message: "未授权",
+		})
+		return
+	}
+
+	users, err := services.GetBlockedUsers(userID)
+	if err != nil {
+		c.JSON(http.StatusOK, dto.ErrorResponse{
+			Code:    400,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Response{
+		Code:    0,
+		Message: "获取成功",
+		Data:    users,
+	})
+}
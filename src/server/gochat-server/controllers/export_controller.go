@@ -0,0 +1,117 @@
+package controllers
+
+import (
+	"gochat_server/dto"
+	"gochat_server/middlewares"
+	"gochat_server/services"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// exportParameter 创建导出任务的参数，friendId 和 groupId 二选一
+type exportParameter struct {
+	FriendId int    `json:"friendId"`
+	GroupId  int    `json:"groupId"`
+	Format   string `json:"format" binding:"required"` // json 或 html
+}
+
+// CreateExportJob 创建聊天记录导出任务
+func CreateExportJob(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Code:    401,
+			Message: "未授权",
+		})
+		return
+	}
+
+	var parameter exportParameter
+	if err := c.ShouldBindJSON(&parameter); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Code:    400,
+			Message: "参数错误: " + err.Error(),
+		})
+		return
+	}
+
+	job, err := services.CreateExportJob(userID, parameter.FriendId, parameter.GroupId, parameter.Format)
+	if err != nil {
+		c.JSON(http.StatusOK, dto.ErrorResponse{
+			Code:    400,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Response{
+		Code:    0,
+		Message: "创建成功",
+		Data:    job,
+	})
+}
+
+// GetExportJobs 获取最近的导出任务
+func GetExportJobs(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Code:    401,
+			Message: "未授权",
+		})
+		return
+	}
+
+	jobs, err := services.GetExportJobs(userID)
+	if err != nil {
+		c.JSON(http.StatusOK, dto.ErrorResponse{
+			Code:    400,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Response{
+		Code:    0,
+		Message: "获取成功",
+		Data:    jobs,
+	})
+}
+
+// GetExportJob 查询导出进度，完成后返回下载链接
+func GetExportJob(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Code:    401,
+			Message: "未授权",
+		})
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Code:    400,
+			Message: "无效的导出任务ID",
+		})
+		return
+	}
+
+	job, err := services.GetExportJob(userID, id)
+	if err != nil {
+		c.JSON(http.StatusOK, dto.ErrorResponse{
+			Code:    400,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Response{
+		Code:    0,
+		Message: "获取成功",
+		Data:    job,
+	})
+}
@@ -22,8 +22,10 @@ func SendFriendRequest(c *gin.Context) {
 	}
 
 	var parameter struct {
-		FriendId int    `json:"friendId" binding:"required"`
-		Remark   string `json:"remark"`
+		FriendId    int    `json:"friendId" binding:"required"`
+		Remark      string `json:"remark"`
+		Source      string `json:"source"`      // 请求来源: search / shared_card
+		SourceMsgId string `json:"sourceMsgId"` // 来源为名片分享时的名片消息ID
 	}
 
 	if err := c.ShouldBindJSON(&parameter); err != nil {
@@ -34,7 +36,7 @@ func SendFriendRequest(c *gin.Context) {
 		return
 	}
 
-	err := services.SendFriendRequest(userID, parameter.FriendId, parameter.Remark)
+	merged, err := services.SendFriendRequest(userID, parameter.FriendId, parameter.Remark, parameter.Source, parameter.SourceMsgId)
 	if err != nil {
 		c.JSON(http.StatusOK, dto.ErrorResponse{
 			Code:    400,
@@ -43,6 +45,16 @@ func SendFriendRequest(c *gin.Context) {
 		return
 	}
 
+	// 对方也发送了好友请求时直接成为好友
+	if merged {
+		c.JSON(http.StatusOK, dto.Response{
+			Code:    0,
+			Message: "对方也向你发送了好友请求，已成为好友",
+			Data:    map[string]interface{}{"accepted": true},
+		})
+		return
+	}
+
 	c.JSON(http.StatusOK, dto.Response{
 		Code:    0,
 		Message: "好友请求已发送",
@@ -73,8 +85,8 @@ func AcceptFriendRequest(c *gin.Context) {
 		return
 	}
 
-	// 验证请求是否属于当前用户
-	requests, err := services.GetFriendRequests(userID)
+	// 只有请求的接收者可以处理请求
+	err := services.AcceptFriendRequest(userID, parameter.RequestId)
 	if err != nil {
 		c.JSON(http.StatusOK, dto.ErrorResponse{
 			Code:    400,
@@ -83,23 +95,38 @@ func AcceptFriendRequest(c *gin.Context) {
 		return
 	}
 
-	found := false
-	for _, req := range requests {
-		if req.ID == parameter.RequestId {
-			found = true
-			break
-		}
+	c.JSON(http.StatusOK, dto.Response{
+		Code:    0,
+		Message: "已接受好友请求",
+		Data:    nil,
+	})
+}
+
+// RejectFriendRequest 拒绝好友请求
+func RejectFriendRequest(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Code:    401,
+			Message: "未授权",
+		})
+		return
 	}
 
-	if !found {
-		c.JSON(http.StatusOK, dto.ErrorResponse{
-			Code:    403,
-			Message: "无权操作此请求",
+	var parameter struct {
+		RequestId int `json:"requestId" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&parameter); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Code:    400,
+			Message: "参数错误: " + err.Error(),
 		})
 		return
 	}
 
-	err = services.AcceptFriendRequest(parameter.RequestId)
+	// 只有请求的接收者可以处理请求
+	err := services.RejectFriendRequest(userID, parameter.RequestId)
 	if err != nil {
 		c.JSON(http.StatusOK, dto.ErrorResponse{
 			Code:    400,
@@ -110,13 +137,13 @@ func AcceptFriendRequest(c *gin.Context) {
 
 	c.JSON(http.StatusOK, dto.Response{
 		Code:    0,
-		Message: "已接受好友请求",
+		Message: "已拒绝好友请求",
 		Data:    nil,
 	})
 }
 
-// RejectFriendRequest 拒绝好友请求
-func RejectFriendRequest(c *gin.Context) {
+// WithdrawFriendRequest 撤回发送的好友请求
+func WithdrawFriendRequest(c *gin.Context) {
 	userID, ok := middlewares.GetUserID(c)
 	if !ok {
 		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
@@ -138,8 +165,7 @@ func RejectFriendRequest(c *gin.Context) {
 		return
 	}
 
-	// 验证请求是否属于当前用户
-	requests, err := services.GetFriendRequests(userID)
+	err := services.WithdrawFriendRequest(userID, parameter.RequestId)
 	if err != nil {
 		c.JSON(http.StatusOK, dto.ErrorResponse{
 			Code:    400,
@@ -148,23 +174,38 @@ func RejectFriendRequest(c *gin.Context) {
 		return
 	}
 
-	found := false
-	for _, req := range requests {
-		if req.ID == parameter.RequestId {
-			found = true
-			break
-		}
-	}
+	c.JSON(http.StatusOK, dto.Response{
+		Code:    0,
+		Message: "已撤回好友请求",
+		Data:    nil,
+	})
+}
 
-	if !found {
-		c.JSON(http.StatusOK, dto.ErrorResponse{
-			Code:    403,
-			Message: "无权操作此请求",
+// GetFriendSuggestions 获取可能认识的人，按共同好友、共同群聊和地区推荐
+func GetFriendSuggestions(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Code:    401,
+			Message: "未授权",
 		})
 		return
 	}
 
-	err = services.RejectFriendRequest(parameter.RequestId)
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	pageSize, err := strconv.Atoi(c.DefaultQuery("pageSize", "20"))
+	if err != nil || pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	// 是否把同地区的用户也作为推荐
+	sameRegion := c.Query("sameRegion") == "true"
+
+	suggestions, total, err := services.GetFriendSuggestions(userID, sameRegion, page, pageSize)
 	if err != nil {
 		c.JSON(http.StatusOK, dto.ErrorResponse{
 			Code:    400,
@@ -175,8 +216,13 @@ func RejectFriendRequest(c *gin.Context) {
 
 	c.JSON(http.StatusOK, dto.Response{
 		Code:    0,
-		Message: "已拒绝好友请求",
-		Data:    nil,
+		Message: "获取成功",
+		Data: map[string]interface{}{
+			"suggestions": suggestions,
+			"total":       total,
+			"page":        page,
+			"pageSize":    pageSize,
+		},
 	})
 }
 
@@ -203,7 +249,7 @@ func GetFriendList(c *gin.Context) {
 	c.JSON(http.StatusOK, dto.Response{
 		Code:    0,
 		Message: "获取成功",
-		Data:    friends,
+		Data:    services.HideBlockedPresence(friends, userID),
 	})
 }
 
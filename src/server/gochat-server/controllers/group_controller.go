@@ -199,6 +199,140 @@ func AddGroupMembers(c *gin.Context) {
 	})
 }
 
+// SetGroupReadReceipts 设置群消息的已读回执开关
+func SetGroupReadReceipts(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Code:    401,
+			Message: "未授权",
+		})
+		return
+	}
+
+	groupIdStr := c.Param("groupId")
+	groupId, err := strconv.Atoi(groupIdStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Code:    400,
+			Message: "无效的群组ID",
+		})
+		return
+	}
+
+	// 检查是否是群主
+	isOwner, err := services.IsGroupOwner(groupId, userID)
+	if err != nil {
+		c.JSON(http.StatusOK, dto.ErrorResponse{
+			Code:    400,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	if !isOwner {
+		c.JSON(http.StatusOK, dto.ErrorResponse{
+			Code:    403,
+			Message: "只有群主可以修改已读回执设置",
+		})
+		return
+	}
+
+	var parameter struct {
+		Enabled *bool `json:"enabled" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&parameter); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Code:    400,
+			Message: "参数错误: " + err.Error(),
+		})
+		return
+	}
+
+	err = services.SetGroupReadReceipts(groupId, *parameter.Enabled)
+	if err != nil {
+		c.JSON(http.StatusOK, dto.ErrorResponse{
+			Code:    400,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Response{
+		Code:    0,
+		Message: "设置成功",
+		Data:    nil,
+	})
+}
+
+// SetGroupRetention 设置群消息保留天数，0 表示使用全局设置（仅群主）
+func SetGroupRetention(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Code:    401,
+			Message: "未授权",
+		})
+		return
+	}
+
+	groupIdStr := c.Param("groupId")
+	groupId, err := strconv.Atoi(groupIdStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Code:    400,
+			Message: "无效的群组ID",
+		})
+		return
+	}
+
+	// 检查是否是群主
+	isOwner, err := services.IsGroupOwner(groupId, userID)
+	if err != nil {
+		c.JSON(http.StatusOK, dto.ErrorResponse{
+			Code:    400,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	if !isOwner {
+		c.JSON(http.StatusOK, dto.ErrorResponse{
+			Code:    403,
+			Message: "只有群主可以修改消息保留设置",
+		})
+		return
+	}
+
+	var parameter struct {
+		Days *int `json:"days" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&parameter); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Code:    400,
+			Message: "参数错误: " + err.Error(),
+		})
+		return
+	}
+
+	err = services.SetGroupRetention(groupId, *parameter.Days)
+	if err != nil {
+		c.JSON(http.StatusOK, dto.ErrorResponse{
+			Code:    400,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Response{
+		Code:    0,
+		Message: "设置成功",
+		Data:    nil,
+	})
+}
+
 // RemoveGroupMember 移除群成员
 func RemoveGroupMember(c *gin.Context) {
 	userID, ok := middlewares.GetUserID(c)
@@ -315,6 +449,6 @@ func GetGroupMembers(c *gin.Context) {
 	c.JSON(http.StatusOK, dto.Response{
 		Code:    0,
 		Message: "获取成功",
-		Data:    members,
+		Data:    services.HideBlockedPresence(members, userID),
 	})
 }
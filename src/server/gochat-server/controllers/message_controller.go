@@ -5,9 +5,9 @@ import (
 	"gochat_server/middlewares"
 	"gochat_server/services"
 	wsmanager "gochat_server/ws_manager"
-	"log"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
@@ -24,10 +24,12 @@ func SendMessage(c *gin.Context) {
 	}
 
 	var parameter struct {
-		ToUserId int    `json:"toUserId" binding:"required"`
-		MsgType  int    `json:"msgType" binding:"required"`
-		Content  string `json:"content" binding:"required"`
-		GroupId  *int   `json:"groupId"`
+		ToUserId     int                  `json:"toUserId" binding:"required"`
+		MsgType      int                  `json:"msgType" binding:"required"`
+		Content      string               `json:"content" binding:"required"`
+		GroupId      *int                 `json:"groupId"`
+		Mentions     []dto.MessageMention `json:"mentions"`
+		ReplyToMsgId string               `json:"replyToMsgId"` // 被回复的消息ID
 	}
 
 	if err := c.ShouldBindJSON(&parameter); err != nil {
@@ -39,7 +41,7 @@ func SendMessage(c *gin.Context) {
 	}
 
 	// 发送消息
-	msgId, err := services.SendMessage(userID, parameter.ToUserId, parameter.MsgType, parameter.Content, parameter.GroupId)
+	msgId, err := services.SendMessage(c.Request.Context(), userID, parameter.ToUserId, parameter.MsgType, parameter.Content, parameter.GroupId, parameter.Mentions, parameter.ReplyToMsgId)
 	if err != nil {
 		c.JSON(http.StatusOK, dto.ErrorResponse{
 			Code:    400,
@@ -48,63 +50,7 @@ func SendMessage(c *gin.Context) {
 		return
 	}
 
-	// 如果接收者在线，通过WebSocket推送消息
-	isGroup := parameter.GroupId != nil && *parameter.GroupId > 0
-	if !isGroup {
-		// 私聊消息
-		if wsmanager.IsUserOnline(strconv.Itoa(parameter.ToUserId)) {
-			// 检查接收者是否设置了免打扰
-			isDoNotDisturb, err := services.IsDoNotDisturbActive(parameter.ToUserId, &userID, nil)
-			if err != nil {
-				// 免打扰检查失败，仍然发送消息但记录错误
-				log.Printf("Failed to check do not disturb status: %v", err)
-				isDoNotDisturb = false
-			}
-			
-			// 获取消息详情
-			messageDetail, err := services.GetMessageDetail(msgId)
-			if err == nil {
-				// 构建正确的WebSocket消息格式
-				wsMessage := map[string]interface{}{
-					"type": "message",
-					"data": messageDetail,
-					"doNotDisturb": isDoNotDisturb, // 添加免打扰标识
-				}
-				// 推送消息
-				wsmanager.SendMessageToUser(strconv.Itoa(parameter.ToUserId), wsMessage)
-			}
-		}
-	} else {
-		// 群聊消息 - 推送给所有在线群成员
-		members, err := services.GetGroupMembers(*parameter.GroupId)
-		if err == nil {
-			// 构建群聊消息详情
-			groupMessageDetail := services.BuildGroupMessageDetail(msgId, userID, *parameter.GroupId, parameter.MsgType, parameter.Content)
-			
-			// 广播给所有在线群成员（除了发送者）
-			for _, member := range members {
-				if member.ID != userID && wsmanager.IsUserOnline(strconv.Itoa(member.ID)) {
-					// 检查该成员是否设置了免打扰
-					isDoNotDisturb, err := services.IsDoNotDisturbActive(member.ID, nil, parameter.GroupId)
-					if err != nil {
-						// 免打扰检查失败，仍然发送消息但记录错误
-						log.Printf("Failed to check do not disturb status for user %d: %v", member.ID, err)
-						isDoNotDisturb = false
-					}
-					
-					// 构建正确的WebSocket消息格式
-					wsMessage := map[string]interface{}{
-						"type": "message",
-						"data": groupMessageDetail,
-						"doNotDisturb": isDoNotDisturb, // 添加免打扰标识
-					}
-					
-					wsmanager.SendMessageToUser(strconv.Itoa(member.ID), wsMessage)
-				}
-			}
-		}
-	}
-
+	// 消息由发件箱分发器推送给在线的接收者
 	c.JSON(http.StatusOK, dto.Response{
 		Code:    0,
 		Message: "发送成功",
@@ -115,6 +61,8 @@ func SendMessage(c *gin.Context) {
 }
 
 // GetChatHistory 获取聊天历史
+// 指定 before、after、around、aroundMsgId 任一参数时按游标分页（before 为空表示从最新消息开始），
+// 否则按 page、pageSize 分页
 func GetChatHistory(c *gin.Context) {
 	userID, ok := middlewares.GetUserID(c)
 	if !ok {
@@ -128,6 +76,12 @@ func GetChatHistory(c *gin.Context) {
 	// 获取查询参数
 	friendIdStr := c.Query("friendId")
 	groupIdStr := c.Query("groupId")
+
+	if isCursorHistoryRequest(c) {
+		getChatHistoryByCursor(c, userID, friendIdStr, groupIdStr)
+		return
+	}
+
 	pageStr := c.DefaultQuery("page", "1")
 	pageSizeStr := c.DefaultQuery("pageSize", "20")
 
@@ -174,7 +128,7 @@ func GetChatHistory(c *gin.Context) {
 			return
 		}
 
-		messages, total, err = services.GetGroupChatHistory(groupId, page, pageSize)
+		messages, total, err = services.GetGroupChatHistory(userID, groupId, page, pageSize)
 		if err != nil {
 			c.JSON(http.StatusOK, dto.ErrorResponse{
 				Code:    400,
@@ -202,6 +156,75 @@ func GetChatHistory(c *gin.Context) {
 	})
 }
 
+// isCursorHistoryRequest 是否为游标分页请求
+func isCursorHistoryRequest(c *gin.Context) bool {
+	for _, key := range []string{"before", "after", "around", "aroundMsgId"} {
+		if _, ok := c.GetQuery(key); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// getChatHistoryByCursor 按游标分页获取聊天历史
+func getChatHistoryByCursor(c *gin.Context, userID int, friendIdStr, groupIdStr string) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if err != nil || limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	query := services.HistoryQuery{
+		Before:      c.Query("before"),
+		After:       c.Query("after"),
+		Around:      c.Query("around"),
+		AroundMsgId: c.Query("aroundMsgId"),
+		Limit:       limit,
+	}
+
+	var page *services.HistoryPage
+	if friendIdStr != "" {
+		friendId, convErr := strconv.Atoi(friendIdStr)
+		if convErr != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Code:    400,
+				Message: "无效的好友ID",
+			})
+			return
+		}
+		page, err = services.GetChatHistoryByCursor(userID, friendId, query)
+	} else if groupIdStr != "" {
+		groupId, convErr := strconv.Atoi(groupIdStr)
+		if convErr != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Code:    400,
+				Message: "无效的群组ID",
+			})
+			return
+		}
+		page, err = services.GetGroupChatHistoryByCursor(userID, groupId, query)
+	} else {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Code:    400,
+			Message: "请提供friendId或groupId参数",
+		})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusOK, dto.ErrorResponse{
+			Code:    400,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Response{
+		Code:    0,
+		Message: "获取成功",
+		Data:    page,
+	})
+}
+
 // GetConversationList 获取会话列表
 func GetConversationList(c *gin.Context) {
 	userID, ok := middlewares.GetUserID(c)
@@ -269,10 +292,10 @@ func UploadFile(c *gin.Context) {
 
 	// 获取文件类型参数
 	fileType := c.PostForm("type")
-	if fileType != "image" && fileType != "video" {
+	if fileType != "image" && fileType != "video" && fileType != "audio" && fileType != "file" {
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
 			Code:    400,
-			Message: "文件类型必须是 image 或 video",
+			Message: "文件类型必须是 image、video、audio 或 file",
 		})
 		return
 	}
@@ -289,7 +312,7 @@ func UploadFile(c *gin.Context) {
 	defer file.Close()
 
 	// 上传文件到 MinIO
-	url, err := services.UploadFile(file, fileHeader, fileType)
+	uploaded, err := services.UploadFileWithInfo(file, fileHeader, fileType)
 	if err != nil {
 		c.JSON(http.StatusOK, dto.ErrorResponse{
 			Code:    400,
@@ -298,12 +321,28 @@ func UploadFile(c *gin.Context) {
 		return
 	}
 
+	// 普通文件返回完整的文件信息，可直接作为文件消息的内容发送
+	data := map[string]interface{}{
+		"url": uploaded.Url,
+	}
+	if fileType == "file" {
+		data["fileName"] = uploaded.FileName
+		data["fileSize"] = uploaded.FileSize
+		data["mimeType"] = uploaded.MimeType
+		data["checksum"] = uploaded.Checksum
+		data["storageKey"] = uploaded.StorageKey
+	}
+	// 音频返回存储键和服务端解析的时长、波形，发送语音消息时只需提供存储键
+	if fileType == "audio" {
+		data["storageKey"] = uploaded.StorageKey
+		data["duration"] = uploaded.Audio.Duration
+		data["waveform"] = uploaded.Audio.Waveform
+	}
+
 	c.JSON(http.StatusOK, dto.Response{
 		Code:    0,
 		Message: "上传成功",
-		Data: map[string]interface{}{
-			"url": url,
-		},
+		Data:    data,
 	})
 }
 
@@ -499,6 +538,42 @@ func GetMessageStatus(c *gin.Context) {
 	})
 }
 
+// GetMessageReceipts 获取消息的已读回执
+func GetMessageReceipts(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Code:    401,
+			Message: "未授权",
+		})
+		return
+	}
+
+	msgId := c.Query("msgId")
+	if msgId == "" {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Code:    400,
+			Message: "msgId参数不能为空",
+		})
+		return
+	}
+
+	receipts, err := services.GetMessageReceipts(msgId, userID)
+	if err != nil {
+		c.JSON(http.StatusOK, dto.ErrorResponse{
+			Code:    400,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Response{
+		Code:    0,
+		Message: "获取成功",
+		Data:    receipts,
+	})
+}
+
 // GetUnreadMessageCount 获取未读消息数
 func GetUnreadMessageCount(c *gin.Context) {
 	userID, ok := middlewares.GetUserID(c)
@@ -535,11 +610,25 @@ func GetUnreadMessageCount(c *gin.Context) {
 		return
 	}
 
+	// @提及单独计数，私聊会话不存在提及
+	mentionCount := 0
+	if friendId == 0 {
+		mentionCount, err = services.GetUnreadMentionCount(userID, groupId)
+		if err != nil {
+			c.JSON(http.StatusOK, dto.ErrorResponse{
+				Code:    400,
+				Message: err.Error(),
+			})
+			return
+		}
+	}
+
 	c.JSON(http.StatusOK, dto.Response{
 		Code:    0,
 		Message: "获取成功",
 		Data: map[string]interface{}{
-			"unreadCount": count,
+			"unreadCount":        count,
+			"unreadMentionCount": mentionCount,
 		},
 	})
 }
@@ -586,3 +675,359 @@ func MarkAllMessagesAsRead(c *gin.Context) {
 		Data:    nil,
 	})
 }
+
+// GetMentionMessages 获取提及我的群消息
+func GetMentionMessages(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Code:    401,
+			Message: "未授权",
+		})
+		return
+	}
+
+	groupIdStr := c.DefaultQuery("groupId", "")
+	var groupId *int
+	if groupIdStr != "" {
+		id, err := strconv.Atoi(groupIdStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Code:    400,
+				Message: "无效的群组ID",
+			})
+			return
+		}
+		groupId = &id
+	}
+
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	pageSize, err := strconv.Atoi(c.DefaultQuery("pageSize", "20"))
+	if err != nil || pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	messages, total, err := services.GetMentionsOfUser(userID, groupId, page, pageSize)
+	if err != nil {
+		c.JSON(http.StatusOK, dto.ErrorResponse{
+			Code:    400,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Response{
+		Code:    0,
+		Message: "获取成功",
+		Data: map[string]interface{}{
+			"messages": messages,
+			"total":    total,
+			"page":     page,
+			"pageSize": pageSize,
+		},
+	})
+}
+
+// ForwardMessages 转发消息
+func ForwardMessages(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Code:    401,
+			Message: "未授权",
+		})
+		return
+	}
+
+	var parameter struct {
+		MsgIds  []string            `json:"msgIds" binding:"required"`
+		Targets []dto.ForwardTarget `json:"targets" binding:"required"`
+		Merged  bool                `json:"merged"`
+		Title   string              `json:"title"`
+	}
+
+	if err := c.ShouldBindJSON(&parameter); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Code:    400,
+			Message: "参数错误: " + err.Error(),
+		})
+		return
+	}
+
+	results, err := services.ForwardMessages(c.Request.Context(), userID, parameter.MsgIds, parameter.Targets, parameter.Merged, parameter.Title)
+	if err != nil {
+		c.JSON(http.StatusOK, dto.ErrorResponse{
+			Code:    400,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Response{
+		Code:    0,
+		Message: "转发成功",
+		Data:    results,
+	})
+}
+
+// SearchMessages 搜索聊天记录
+func SearchMessages(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Code:    401,
+			Message: "未授权",
+		})
+		return
+	}
+
+	query := &services.SearchQuery{
+		UserId:  userID,
+		Keyword: c.Query("keyword"),
+	}
+
+	// 可选的整数过滤条件
+	intParams := map[string]*int{
+		"friendId":   &query.FriendId,
+		"groupId":    &query.GroupId,
+		"fromUserId": &query.FromUserId,
+	}
+	for name, target := range intParams {
+		value := c.Query(name)
+		if value == "" {
+			continue
+		}
+		id, err := strconv.Atoi(value)
+		if err != nil || id <= 0 {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Code:    400,
+				Message: "参数错误: " + name,
+			})
+			return
+		}
+		*target = id
+	}
+
+	// 时间范围，支持 RFC3339 或 Unix 时间戳（秒）
+	timeParams := map[string]**time.Time{
+		"startTime": &query.StartTime,
+		"endTime":   &query.EndTime,
+	}
+	for name, target := range timeParams {
+		value := c.Query(name)
+		if value == "" {
+			continue
+		}
+		t, err := parseQueryTime(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Code:    400,
+				Message: "参数错误: " + name,
+			})
+			return
+		}
+		*target = &t
+	}
+
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	pageSize, err := strconv.Atoi(c.DefaultQuery("pageSize", "20"))
+	if err != nil || pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+	query.Page = page
+	query.PageSize = pageSize
+
+	messages, total, err := services.SearchMessages(query)
+	if err != nil {
+		c.JSON(http.StatusOK, dto.ErrorResponse{
+			Code:    400,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Response{
+		Code:    0,
+		Message: "搜索成功",
+		Data: map[string]interface{}{
+			"messages": messages,
+			"total":    total,
+			"page":     page,
+			"pageSize": pageSize,
+		},
+	})
+}
+
+// parseQueryTime 解析查询参数中的时间，支持 RFC3339 和 Unix 时间戳（秒）
+func parseQueryTime(value string) (time.Time, error) {
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(seconds, 0), nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+// DeleteMessages 删除消息，只对自己隐藏
+func DeleteMessages(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Code:    401,
+			Message: "未授权",
+		})
+		return
+	}
+
+	var parameter struct {
+		MsgIds []string `json:"msgIds" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&parameter); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Code:    400,
+			Message: "参数错误: " + err.Error(),
+		})
+		return
+	}
+
+	if err := services.DeleteMessagesForUser(userID, parameter.MsgIds); err != nil {
+		c.JSON(http.StatusOK, dto.ErrorResponse{
+			Code:    400,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Response{
+		Code:    0,
+		Message: "删除成功",
+		Data:    nil,
+	})
+}
+
+// ClearChatHistory 清空与好友或群的聊天记录，只对自己生效
+func ClearChatHistory(c *gin.Context) {
+	clearConversation(c, false, "清空成功")
+}
+
+// DeleteConversation 删除会话并清空聊天记录，收到新消息后会话重新出现
+func DeleteConversation(c *gin.Context) {
+	clearConversation(c, true, "删除成功")
+}
+
+// clearConversation 清空会话，hide 为 true 时同时从会话列表中删除
+func clearConversation(c *gin.Context, hide bool, successMessage string) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Code:    401,
+			Message: "未授权",
+		})
+		return
+	}
+
+	var parameter struct {
+		FriendId int `json:"friendId"`
+		GroupId  int `json:"groupId"`
+	}
+
+	if err := c.ShouldBindJSON(&parameter); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Code:    400,
+			Message: "参数错误: " + err.Error(),
+		})
+		return
+	}
+
+	if err := services.ClearConversation(userID, parameter.FriendId, parameter.GroupId, hide); err != nil {
+		c.JSON(http.StatusOK, dto.ErrorResponse{
+			Code:    400,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Response{
+		Code:    0,
+		Message: successMessage,
+		Data:    nil,
+	})
+}
+
+// GetMessageTimer 获取与好友或群的阅后即焚设置
+func GetMessageTimer(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Code:    401,
+			Message: "未授权",
+		})
+		return
+	}
+
+	friendId, _ := strconv.Atoi(c.Query("friendId"))
+	groupId, _ := strconv.Atoi(c.Query("groupId"))
+
+	timer, err := services.GetMessageTimer(userID, friendId, groupId)
+	if err != nil {
+		c.JSON(http.StatusOK, dto.ErrorResponse{
+			Code:    400,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Response{
+		Code:    0,
+		Message: "获取成功",
+		Data:    timer,
+	})
+}
+
+// SetMessageTimer 设置与好友或群的阅后即焚时长，ttlSeconds 为 0 时关闭
+func SetMessageTimer(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Code:    401,
+			Message: "未授权",
+		})
+		return
+	}
+
+	var parameter struct {
+		FriendId   int  `json:"friendId"`
+		GroupId    int  `json:"groupId"`
+		TtlSeconds *int `json:"ttlSeconds" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&parameter); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Code:    400,
+			Message: "参数错误: " + err.Error(),
+		})
+		return
+	}
+
+	timer, err := services.SetMessageTimer(userID, parameter.FriendId, parameter.GroupId, *parameter.TtlSeconds)
+	if err != nil {
+		c.JSON(http.StatusOK, dto.ErrorResponse{
+			Code:    400,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Response{
+		Code:    0,
+		Message: "设置成功",
+		Data:    timer,
+	})
+}
@@ -45,4 +45,45 @@ func WarmupCache(c *gin.Context) {
 func GetCacheStats(c *gin.Context) {
 	stats := services.GetCacheStats()
 	utils.RespondSuccess(c, stats)
-}
\ No newline at end of file
+}
+
+// GetOutboxStats 获取消息发件箱积压和延迟
+func GetOutboxStats(c *gin.Context) {
+	stats := services.GetOutboxStats()
+	utils.RespondSuccess(c, stats)
+}
+
+// RetryDeadOutboxEntries 重新推送多次失败的消息
+func RetryDeadOutboxEntries(c *gin.Context) {
+	count, err := services.RetryDeadOutboxEntries()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	utils.RespondSuccess(c, map[string]int{
+		"count": count,
+	})
+}
+
+// GetRetentionStatus 获取消息保留期限配置和清理任务进度
+func GetRetentionStatus(c *gin.Context) {
+	status, err := services.GetRetentionStatus()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	utils.RespondSuccess(c, status)
+}
+
+// RunRetentionJob 立即执行消息保留期限清理任务，有未完成的任务时继续该任务
+func RunRetentionJob(c *gin.Context) {
+	job, err := services.RunRetentionJob()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	utils.RespondSuccess(c, job)
+}
@@ -0,0 +1,132 @@
+package controllers
+
+import (
+	"gochat_server/dto"
+	"gochat_server/middlewares"
+	"gochat_server/services"
+	wsmanager "gochat_server/ws_manager"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AddMessageReaction 添加消息表态
+func AddMessageReaction(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Code:    401,
+			Message: "未授权",
+		})
+		return
+	}
+
+	var parameter struct {
+		MsgId string `json:"msgId" binding:"required"`
+		Emoji string `json:"emoji" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&parameter); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Code:    400,
+			Message: "参数错误: " + err.Error(),
+		})
+		return
+	}
+
+	messageDetail, err := services.AddMessageReaction(parameter.MsgId, userID, parameter.Emoji)
+	if err != nil {
+		c.JSON(http.StatusOK, dto.ErrorResponse{
+			Code:    400,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	go notifyReactionUpdated(messageDetail, userID, parameter.Emoji, "add")
+
+	c.JSON(http.StatusOK, dto.Response{
+		Code:    0,
+		Message: "表态成功",
+		Data:    nil,
+	})
+}
+
+// RemoveMessageReaction 取消消息表态
+func RemoveMessageReaction(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Code:    401,
+			Message: "未授权",
+		})
+		return
+	}
+
+	var parameter struct {
+		MsgId string `json:"msgId" binding:"required"`
+		Emoji string `json:"emoji" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&parameter); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Code:    400,
+			Message: "参数错误: " + err.Error(),
+		})
+		return
+	}
+
+	messageDetail, err := services.RemoveMessageReaction(parameter.MsgId, userID, parameter.Emoji)
+	if err != nil {
+		c.JSON(http.StatusOK, dto.ErrorResponse{
+			Code:    400,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	go notifyReactionUpdated(messageDetail, userID, parameter.Emoji, "remove")
+
+	c.JSON(http.StatusOK, dto.Response{
+		Code:    0,
+		Message: "取消表态成功",
+		Data:    nil,
+	})
+}
+
+// notifyReactionUpdated 通过WebSocket通知会话参与者消息表态已更新
+func notifyReactionUpdated(messageDetail *services.MessageDetail, operatorId int, emoji string, action string) {
+	reactions, err := services.GetMessageReactions([]string{messageDetail.MsgId})
+	if err != nil {
+		log.Printf("Failed to load reactions for message %s: %v", messageDetail.MsgId, err)
+		return
+	}
+
+	for _, participantId := range services.GetMessageParticipantIds(messageDetail) {
+		if !wsmanager.IsUserOnline(strconv.Itoa(participantId)) {
+			continue
+		}
+
+		data := map[string]interface{}{
+			"msgId":      messageDetail.MsgId,
+			"operatorId": operatorId,
+			"emoji":      emoji,
+			"action":     action,
+			"isGroup":    messageDetail.IsGroup,
+			"reactions":  services.BuildReactionSummaries(reactions[messageDetail.MsgId], participantId),
+		}
+		if messageDetail.IsGroup && messageDetail.GroupId != nil {
+			data["groupId"] = *messageDetail.GroupId
+		} else {
+			data["fromUserId"] = messageDetail.FromUserId
+			data["toUserId"] = messageDetail.ToUserId
+		}
+
+		wsmanager.SendMessageToUser(strconv.Itoa(participantId), map[string]interface{}{
+			"type": "reaction_updated",
+			"data": data,
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package controllers
+
+import (
+	"gochat_server/dto"
+	"gochat_server/middlewares"
+	"gochat_server/services"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// scheduledMessageParameter 创建或修改定时消息的参数，除 sendTime 外与发送消息接口相同
+type scheduledMessageParameter struct {
+	ToUserId     int                  `json:"toUserId"`
+	GroupId      int                  `json:"groupId"`
+	MsgType      int                  `json:"msgType" binding:"required"`
+	Content      string               `json:"content" binding:"required"`
+	Mentions     []dto.MessageMention `json:"mentions"`
+	ReplyToMsgId string               `json:"replyToMsgId"`
+	SendTime     time.Time            `json:"sendTime" binding:"required"`
+}
+
+// toRequest 转换为服务层的请求
+func (p *scheduledMessageParameter) toRequest() *services.ScheduledMessageRequest {
+	return &services.ScheduledMessageRequest{
+		ToUserId:     p.ToUserId,
+		GroupId:      p.GroupId,
+		MsgType:      p.MsgType,
+		Content:      p.Content,
+		Mentions:     p.Mentions,
+		ReplyToMsgId: p.ReplyToMsgId,
+		SendTime:     p.SendTime,
+	}
+}
+
+// CreateScheduledMessage 创建定时消息
+func CreateScheduledMessage(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Code:    401,
+			Message: "未授权",
+		})
+		return
+	}
+
+	var parameter scheduledMessageParameter
+	if err := c.ShouldBindJSON(&parameter); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Code:    400,
+			Message: "参数错误: " + err.Error(),
+		})
+		return
+	}
+
+	scheduled, err := services.CreateScheduledMessage(userID, parameter.toRequest())
+	if err != nil {
+		c.JSON(http.StatusOK, dto.ErrorResponse{
+			Code:    400,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Response{
+		Code:    0,
+		Message: "创建成功",
+		Data:    scheduled,
+	})
+}
+
+// GetScheduledMessages 获取定时消息列表，可按状态筛选
+func GetScheduledMessages(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Code:    401,
+			Message: "未授权",
+		})
+		return
+	}
+
+	scheduled, err := services.GetScheduledMessages(userID, c.Query("status"))
+	if err != nil {
+		c.JSON(http.StatusOK, dto.ErrorResponse{
+			Code:    400,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Response{
+		Code:    0,
+		Message: "获取成功",
+		Data:    scheduled,
+	})
+}
+
+// UpdateScheduledMessage 修改定时消息的内容或发送时间
+func UpdateScheduledMessage(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Code:    401,
+			Message: "未授权",
+		})
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Code:    400,
+			Message: "无效的定时消息ID",
+		})
+		return
+	}
+
+	var parameter scheduledMessageParameter
+	if err := c.ShouldBindJSON(&parameter); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Code:    400,
+			Message: "参数错误: " + err.Error(),
+		})
+		return
+	}
+
+	scheduled, err := services.UpdateScheduledMessage(userID, id, parameter.toRequest())
+	if err != nil {
+		c.JSON(http.StatusOK, dto.ErrorResponse{
+			Code:    400,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Response{
+		Code:    0,
+		Message: "修改成功",
+		Data:    scheduled,
+	})
+}
+
+// CancelScheduledMessage 取消定时消息
+func CancelScheduledMessage(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Code:    401,
+			Message: "未授权",
+		})
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Code:    400,
+			Message: "无效的定时消息ID",
+		})
+		return
+	}
+
+	if err := services.CancelScheduledMessage(userID, id); err != nil {
+		c.JSON(http.StatusOK, dto.ErrorResponse{
+			Code:    400,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Response{
+		Code:    0,
+		Message: "取消成功",
+		Data:    nil,
+	})
+}
@@ -1,9 +1,15 @@
 package dto
 
 const (
-	TEXT_MESSAGE  = iota + 1 // 文本消息
-	IMAGE_MESSAGE            // 图片消息
-	VIDEO_MESSAGE            // 视频消息
+	TEXT_MESSAGE           = iota + 1 // 文本消息
+	IMAGE_MESSAGE                     // 图片消息
+	VIDEO_MESSAGE                     // 视频消息
+	MERGED_FORWARD_MESSAGE            // 合并转发消息（聊天记录卡片）
+	FILE_MESSAGE                      // 文件消息
+	VOICE_MESSAGE                     // 语音消息
+	LOCATION_MESSAGE                  // 位置消息
+	CONTACT_CARD_MESSAGE              // 名片消息
+	SYSTEM_MESSAGE                    // 系统消息（会话设置变更等提示）
 )
 
 // 消息体，记录是什么消息
@@ -26,3 +32,104 @@ type VideoMessage struct {
 	MsgId	string `json:"msgId"`
 	VideoUrl string `json:"videoUrl"`
 }
+
+// 提及类型
+const (
+	MENTION_USER = "user" // @指定成员
+	MENTION_ALL  = "all"  // @所有人
+)
+
+// MessageMention 消息中的@提及实体，Offset/Length 以字符（rune）为单位
+type MessageMention struct {
+	Type   string `json:"type"`
+	UserId int    `json:"userId,omitempty"`
+	Offset int    `json:"offset"`
+	Length int    `json:"length"`
+}
+
+// 转发目标类型
+const (
+	FORWARD_TARGET_FRIEND = "friend" // 转发给好友
+	FORWARD_TARGET_GROUP  = "group"  // 转发到群聊
+)
+
+// ForwardTarget 转发目标
+type ForwardTarget struct {
+	Type string `json:"type"`
+	Id   int    `json:"id"`
+}
+
+// MergedForwardContent 合并转发消息内容，以聊天记录卡片形式展示
+type MergedForwardContent struct {
+	Title string              `json:"title"`
+	Items []MergedForwardItem `json:"items"`
+}
+
+// MergedForwardItem 合并转发中的单条消息
+type MergedForwardItem struct {
+	MsgId        string `json:"msgId"`
+	FromUserId   int    `json:"fromUserId"`
+	FromNickname string `json:"fromNickname"`
+	MsgType      int    `json:"msgType"`
+	Content      string `json:"content"`
+	CreateTime   int64  `json:"createTime"`
+}
+
+// FileContent 文件消息内容，由上传接口返回，发送文件消息时原样作为消息内容
+type FileContent struct {
+	FileName   string `json:"fileName"`
+	FileSize   int64  `json:"fileSize"`
+	MimeType   string `json:"mimeType"`
+	Checksum   string `json:"checksum"`
+	StorageKey string `json:"storageKey"`
+	Url        string `json:"url,omitempty"`
+}
+
+// VoiceContent 语音消息内容
+// 发送时只需提供 StorageKey，时长和波形由服务端在上传时解析，不信任客户端提交的值
+type VoiceContent struct {
+	StorageKey string `json:"storageKey"`
+	MimeType   string `json:"mimeType,omitempty"`
+	FileSize   int64  `json:"fileSize,omitempty"`
+	Duration   int    `json:"duration"` // 毫秒
+	Waveform   []int  `json:"waveform"`
+	Url        string `json:"url,omitempty"`
+}
+
+// LocationContent 位置消息内容
+type LocationContent struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Name      string  `json:"name"`
+	Address   string  `json:"address"`
+}
+
+// ContactCardContent 名片消息内容
+// 发送时只需提供 UserId，昵称、头像等资料在读取时实时查询
+type ContactCardContent struct {
+	UserId    int    `json:"userId"`
+	Username  string `json:"username,omitempty"`
+	Nickname  string `json:"nickname,omitempty"`
+	Avatar    string `json:"avatar,omitempty"`
+	Signature string `json:"signature,omitempty"`
+	Region    string `json:"region,omitempty"`
+}
+
+// 系统消息事件
+const (
+	SYSTEM_EVENT_MESSAGE_TIMER = "message_timer" // 设置阅后即焚计时器
+)
+
+// SystemContent 系统消息内容，由服务端生成，在聊天中以提示形式展示
+type SystemContent struct {
+	Event      string `json:"event"`
+	Text       string `json:"text"`
+	OperatorId int    `json:"operatorId"`
+	TtlSeconds int    `json:"ttlSeconds,omitempty"` // 仅 message_timer 事件
+}
+
+// 好友请求来源
+const (
+	FRIEND_SOURCE_SEARCH      = "search"      // 搜索添加
+	FRIEND_SOURCE_SHARED_CARD = "shared_card" // 名片分享
+)
@@ -0,0 +1,59 @@
+package dto
+
+import "encoding/json"
+
+// MessageBody 消息体，以 JSON 形式保存在 Message 表中
+// Version 为 Data 的结构版本，读取时由对应消息类型的编解码器升级到最新版本
+type MessageBody struct {
+	Type     int              `json:"type"`
+	Version  int              `json:"v"`
+	Data     json.RawMessage  `json:"data"`
+	Mentions []MessageMention `json:"mentions,omitempty"`
+	ReplyTo  *MessageReply    `json:"replyTo,omitempty"`
+}
+
+// RECALLED_MESSAGE_CONTENT 已撤回消息在历史记录、离线消息和会话列表中显示的内容
+const RECALLED_MESSAGE_CONTENT = "[消息已撤回]"
+
+// PURGED_MESSAGE_CONTENT 超过保留期限被清理的消息显示的内容
+const PURGED_MESSAGE_CONTENT = "[消息已过期]"
+
+// MessageReply 被回复消息的快照，原消息被撤回或删除后仍可展示
+type MessageReply struct {
+	MsgId      string `json:"msgId"`
+	FromUserId int    `json:"fromUserId"`
+	MsgType    int    `json:"msgType"`
+	Preview    string `json:"preview"`
+}
+
+// 文本实体类型
+const (
+	TEXT_ENTITY_URL     = "url"     // 链接
+	TEXT_ENTITY_EMAIL   = "email"   // 邮箱
+	TEXT_ENTITY_MENTION = "mention" // @提及
+)
+
+// TextEntity 文本中的实体，Offset/Length 以字符（rune）为单位
+type TextEntity struct {
+	Type   string `json:"type"`
+	Offset int    `json:"offset"`
+	Length int    `json:"length"`
+	UserId int    `json:"userId,omitempty"` // 仅 mention 类型
+}
+
+// TextBody 文本消息体
+type TextBody struct {
+	Text     string       `json:"text"`
+	Entities []TextEntity `json:"entities,omitempty"`
+}
+
+// MediaBody 图片、视频消息体
+type MediaBody struct {
+	Url        string `json:"url"`
+	StorageKey string `json:"storageKey,omitempty"`
+	MimeType   string `json:"mimeType,omitempty"`
+	FileSize   int64  `json:"fileSize,omitempty"`
+	Width      int    `json:"width,omitempty"`
+	Height     int    `json:"height,omitempty"`
+	Duration   int    `json:"duration,omitempty"` // 毫秒，仅视频
+}
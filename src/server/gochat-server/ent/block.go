@@ -0,0 +1,126 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"gochat_server/ent/block"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Block is the model entity for the Block schema.
+type Block struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 拉黑者ID
+	UserId int `json:"userId,omitempty"`
+	// 被拉黑的用户ID
+	BlockedUserId int `json:"blockedUserId,omitempty"`
+	// 拉黑时间
+	CreateTime   time.Time `json:"createTime,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Block) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case block.FieldID, block.FieldUserId, block.FieldBlockedUserId:
+			values[i] = new(sql.NullInt64)
+		case block.FieldCreateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Block fields.
+func (b *Block) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case block.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			b.ID = int(value.Int64)
+		case block.FieldUserId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field userId", values[i])
+			} else if value.Valid {
+				b.UserId = int(value.Int64)
+			}
+		case block.FieldBlockedUserId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field blockedUserId", values[i])
+			} else if value.Valid {
+				b.BlockedUserId = int(value.Int64)
+			}
+		case block.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field createTime", values[i])
+			} else if value.Valid {
+				b.CreateTime = value.Time
+			}
+		default:
+			b.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Block.
+// This includes values selected through modifiers, order, etc.
+func (b *Block) Value(name string) (ent.Value, error) {
+	return b.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Block.
+// Note that you need to call Block.Unwrap() before calling this method if this Block
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (b *Block) Update() *BlockUpdateOne {
+	return NewBlockClient(b.config).UpdateOne(b)
+}
+
+// Unwrap unwraps the Block entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (b *Block) Unwrap() *Block {
+	_tx, ok := b.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Block is not a transactional entity")
+	}
+	b.config.driver = _tx.drv
+	return b
+}
+
+// String implements the fmt.Stringer.
+func (b *Block) String() string {
+	var builder strings.Builder
+	builder.WriteString("Block(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", b.ID))
+	builder.WriteString("userId=")
+	builder.WriteString(fmt.Sprintf("%v", b.UserId))
+	builder.WriteString(", ")
+	builder.WriteString("blockedUserId=")
+	builder.WriteString(fmt.Sprintf("%v", b.BlockedUserId))
+	builder.WriteString(", ")
+	builder.WriteString("createTime=")
+	builder.WriteString(b.CreateTime.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Blocks is a parsable slice of Block.
+type Blocks []*Block
@@ -0,0 +1,70 @@
+// Code generated by ent, DO NOT EDIT.
+
+package block
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the block type in the database.
+	Label = "block"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserId holds the string denoting the userid field in the database.
+	FieldUserId = "user_id"
+	// FieldBlockedUserId holds the string denoting the blockeduserid field in the database.
+	FieldBlockedUserId = "blocked_user_id"
+	// FieldCreateTime holds the string denoting the createtime field in the database.
+	FieldCreateTime = "create_time"
+	// Table holds the table name of the block in the database.
+	Table = "blocks"
+)
+
+// Columns holds all SQL columns for block fields.
+var Columns = []string{
+	FieldID,
+	FieldUserId,
+	FieldBlockedUserId,
+	FieldCreateTime,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreateTime holds the default value on creation for the "createTime" field.
+	DefaultCreateTime func() time.Time
+)
+
+// OrderOption defines the ordering options for the Block queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserId orders the results by the userId field.
+func ByUserId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserId, opts...).ToFunc()
+}
+
+// ByBlockedUserId orders the results by the blockedUserId field.
+func ByBlockedUserId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlockedUserId, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the createTime field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
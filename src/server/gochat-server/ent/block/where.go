@@ -0,0 +1,205 @@
+// Code generated by ent, DO NOT EDIT.
+
+package block
+
+import (
+	"gochat_server/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Block {
+	return predicate.Block(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Block {
+	return predicate.Block(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Block {
+	return predicate.Block(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Block {
+	return predicate.Block(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Block {
+	return predicate.Block(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Block {
+	return predicate.Block(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Block {
+	return predicate.Block(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Block {
+	return predicate.Block(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Block {
+	return predicate.Block(sql.FieldLTE(FieldID, id))
+}
+
+// UserId applies equality check predicate on the "userId" field. It's identical to UserIdEQ.
+func UserId(v int) predicate.Block {
+	return predicate.Block(sql.FieldEQ(FieldUserId, v))
+}
+
+// BlockedUserId applies equality check predicate on the "blockedUserId" field. It's identical to BlockedUserIdEQ.
+func BlockedUserId(v int) predicate.Block {
+	return predicate.Block(sql.FieldEQ(FieldBlockedUserId, v))
+}
+
+// CreateTime applies equality check predicate on the "createTime" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.Block {
+	return predicate.Block(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UserIdEQ applies the EQ predicate on the "userId" field.
+func UserIdEQ(v int) predicate.Block {
+	return predicate.Block(sql.FieldEQ(FieldUserId, v))
+}
+
+// UserIdNEQ applies the NEQ predicate on the "userId" field.
+func UserIdNEQ(v int) predicate.Block {
+	return predicate.Block(sql.FieldNEQ(FieldUserId, v))
+}
+
+// UserIdIn applies the In predicate on the "userId" field.
+func UserIdIn(vs ...int) predicate.Block {
+	return predicate.Block(sql.FieldIn(FieldUserId, vs...))
+}
+
+// UserIdNotIn applies the NotIn predicate on the "userId" field.
+func UserIdNotIn(vs ...int) predicate.Block {
+	return predicate.Block(sql.FieldNotIn(FieldUserId, vs...))
+}
+
+// UserIdGT applies the GT predicate on the "userId" field.
+func UserIdGT(v int) predicate.Block {
+	return predicate.Block(sql.FieldGT(FieldUserId, v))
+}
+
+// UserIdGTE applies the GTE predicate on the "userId" field.
+func UserIdGTE(v int) predicate.Block {
+	return predicate.Block(sql.FieldGTE(FieldUserId, v))
+}
+
+// UserIdLT applies the LT predicate on the "userId" field.
+func UserIdLT(v int) predicate.Block {
+	return predicate.Block(sql.FieldLT(FieldUserId, v))
+}
+
+// UserIdLTE applies the LTE predicate on the "userId" field.
+func UserIdLTE(v int) predicate.Block {
+	return predicate.Block(sql.FieldLTE(FieldUserId, v))
+}
+
+// BlockedUserIdEQ applies the EQ predicate on the "blockedUserId" field.
+func BlockedUserIdEQ(v int) predicate.Block {
+	return predicate.Block(sql.FieldEQ(FieldBlockedUserId, v))
+}
+
+// BlockedUserIdNEQ applies the NEQ predicate on the "blockedUserId" field.
+func BlockedUserIdNEQ(v int) predicate.Block {
+	return predicate.Block(sql.FieldNEQ(FieldBlockedUserId, v))
+}
+
+// BlockedUserIdIn applies the In predicate on the "blockedUserId" field.
+func BlockedUserIdIn(vs ...int) predicate.Block {
+	return predicate.Block(sql.FieldIn(FieldBlockedUserId, vs...))
+}
+
+// BlockedUserIdNotIn applies the NotIn predicate on the "blockedUserId" field.
+func BlockedUserIdNotIn(vs ...int) predicate.Block {
+	return predicate.Block(sql.FieldNotIn(FieldBlockedUserId, vs...))
+}
+
+// BlockedUserIdGT applies the GT predicate on the "blockedUserId" field.
+func BlockedUserIdGT(v int) predicate.Block {
+	return predicate.Block(sql.FieldGT(FieldBlockedUserId, v))
+}
+
+// BlockedUserIdGTE applies the GTE predicate on the "blockedUserId" field.
+func BlockedUserIdGTE(v int) predicate.Block {
+	return predicate.Block(sql.FieldGTE(FieldBlockedUserId, v))
+}
+
+// BlockedUserIdLT applies the LT predicate on the "blockedUserId" field.
+func BlockedUserIdLT(v int) predicate.Block {
+	return predicate.Block(sql.FieldLT(FieldBlockedUserId, v))
+}
+
+// BlockedUserIdLTE applies the LTE predicate on the "blockedUserId" field.
+func BlockedUserIdLTE(v int) predicate.Block {
+	return predicate.Block(sql.FieldLTE(FieldBlockedUserId, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "createTime" field.
+func CreateTimeEQ(v time.Time) predicate.Block {
+	return predicate.Block(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "createTime" field.
+func CreateTimeNEQ(v time.Time) predicate.Block {
+	return predicate.Block(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "createTime" field.
+func CreateTimeIn(vs ...time.Time) predicate.Block {
+	return predicate.Block(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "createTime" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.Block {
+	return predicate.Block(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "createTime" field.
+func CreateTimeGT(v time.Time) predicate.Block {
+	return predicate.Block(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "createTime" field.
+func CreateTimeGTE(v time.Time) predicate.Block {
+	return predicate.Block(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "createTime" field.
+func CreateTimeLT(v time.Time) predicate.Block {
+	return predicate.Block(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "createTime" field.
+func CreateTimeLTE(v time.Time) predicate.Block {
+	return predicate.Block(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Block) predicate.Block {
+	return predicate.Block(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Block) predicate.Block {
+	return predicate.Block(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Block) predicate.Block {
+	return predicate.Block(sql.NotPredicates(p))
+}
@@ -0,0 +1,228 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gochat_server/ent/block"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BlockCreate is the builder for creating a Block entity.
+type BlockCreate struct {
+	config
+	mutation *BlockMutation
+	hooks    []Hook
+}
+
+// SetUserId sets the "userId" field.
+func (bc *BlockCreate) SetUserId(i int) *BlockCreate {
+	bc.mutation.SetUserId(i)
+	return bc
+}
+
+// SetBlockedUserId sets the "blockedUserId" field.
+func (bc *BlockCreate) SetBlockedUserId(i int) *BlockCreate {
+	bc.mutation.SetBlockedUserId(i)
+	return bc
+}
+
+// SetCreateTime sets the "createTime" field.
+func (bc *BlockCreate) SetCreateTime(t time.Time) *BlockCreate {
+	bc.mutation.SetCreateTime(t)
+	return bc
+}
+
+// SetNillableCreateTime sets the "createTime" field if the given value is not nil.
+func (bc *BlockCreate) SetNillableCreateTime(t *time.Time) *BlockCreate {
+	if t != nil {
+		bc.SetCreateTime(*t)
+	}
+	return bc
+}
+
+// Mutation returns the BlockMutation object of the builder.
+func (bc *BlockCreate) Mutation() *BlockMutation {
+	return bc.mutation
+}
+
+// Save creates the Block in the database.
+func (bc *BlockCreate) Save(ctx context.Context) (*Block, error) {
+	bc.defaults()
+	return withHooks(ctx, bc.sqlSave, bc.mutation, bc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (bc *BlockCreate) SaveX(ctx context.Context) *Block {
+	v, err := bc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bc *BlockCreate) Exec(ctx context.Context) error {
+	_, err := bc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bc *BlockCreate) ExecX(ctx context.Context) {
+	if err := bc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bc *BlockCreate) defaults() {
+	if _, ok := bc.mutation.CreateTime(); !ok {
+		v := block.DefaultCreateTime()
+		bc.mutation.SetCreateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bc *BlockCreate) check() error {
+	if _, ok := bc.mutation.UserId(); !ok {
+		return &ValidationError{Name: "userId", err: errors.New(`ent: missing required field "Block.userId"`)}
+	}
+	if _, ok := bc.mutation.BlockedUserId(); !ok {
+		return &ValidationError{Name: "blockedUserId", err: errors.New(`ent: missing required field "Block.blockedUserId"`)}
+	}
+	if _, ok := bc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "createTime", err: errors.New(`ent: missing required field "Block.createTime"`)}
+	}
+	return nil
+}
+
+func (bc *BlockCreate) sqlSave(ctx context.Context) (*Block, error) {
+	if err := bc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := bc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, bc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	bc.mutation.id = &_node.ID
+	bc.mutation.done = true
+	return _node, nil
+}
+
+func (bc *BlockCreate) createSpec() (*Block, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Block{config: bc.config}
+		_spec = sqlgraph.NewCreateSpec(block.Table, sqlgraph.NewFieldSpec(block.FieldID, field.TypeInt))
+	)
+	if value, ok := bc.mutation.UserId(); ok {
+		_spec.SetField(block.FieldUserId, field.TypeInt, value)
+		_node.UserId = value
+	}
+	if value, ok := bc.mutation.BlockedUserId(); ok {
+		_spec.SetField(block.FieldBlockedUserId, field.TypeInt, value)
+		_node.BlockedUserId = value
+	}
+	if value, ok := bc.mutation.CreateTime(); ok {
+		_spec.SetField(block.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	return _node, _spec
+}
+
+// BlockCreateBulk is the builder for creating many Block entities in bulk.
+type BlockCreateBulk struct {
+	config
+	err      error
+	builders []*BlockCreate
+}
+
+// Save creates the Block entities in the database.
+func (bcb *BlockCreateBulk) Save(ctx context.Context) ([]*Block, error) {
+	if bcb.err != nil {
+		return nil, bcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(bcb.builders))
+	nodes := make([]*Block, len(bcb.builders))
+	mutators := make([]Mutator, len(bcb.builders))
+	for i := range bcb.builders {
+		func(i int, root context.Context) {
+			builder := bcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BlockMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, bcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, bcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, bcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bcb *BlockCreateBulk) SaveX(ctx context.Context) []*Block {
+	v, err := bcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bcb *BlockCreateBulk) Exec(ctx context.Context) error {
+	_, err := bcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bcb *BlockCreateBulk) ExecX(ctx context.Context) {
+	if err := bcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,318 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gochat_server/ent/block"
+	"gochat_server/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BlockUpdate is the builder for updating Block entities.
+type BlockUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BlockMutation
+}
+
+// Where appends a list predicates to the BlockUpdate builder.
+func (bu *BlockUpdate) Where(ps ...predicate.Block) *BlockUpdate {
+	bu.mutation.Where(ps...)
+	return bu
+}
+
+// SetUserId sets the "userId" field.
+func (bu *BlockUpdate) SetUserId(i int) *BlockUpdate {
+	bu.mutation.ResetUserId()
+	bu.mutation.SetUserId(i)
+	return bu
+}
+
+// SetNillableUserId sets the "userId" field if the given value is not nil.
+func (bu *BlockUpdate) SetNillableUserId(i *int) *BlockUpdate {
+	if i != nil {
+		bu.SetUserId(*i)
+	}
+	return bu
+}
+
+// AddUserId adds i to the "userId" field.
+func (bu *BlockUpdate) AddUserId(i int) *BlockUpdate {
+	bu.mutation.AddUserId(i)
+	return bu
+}
+
+// SetBlockedUserId sets the "blockedUserId" field.
+func (bu *BlockUpdate) SetBlockedUserId(i int) *BlockUpdate {
+	bu.mutation.ResetBlockedUserId()
+	bu.mutation.SetBlockedUserId(i)
+	return bu
+}
+
+// SetNillableBlockedUserId sets the "blockedUserId" field if the given value is not nil.
+func (bu *BlockUpdate) SetNillableBlockedUserId(i *int) *BlockUpdate {
+	if i != nil {
+		bu.SetBlockedUserId(*i)
+	}
+	return bu
+}
+
+// AddBlockedUserId adds i to the "blockedUserId" field.
+func (bu *BlockUpdate) AddBlockedUserId(i int) *BlockUpdate {
+	bu.mutation.AddBlockedUserId(i)
+	return bu
+}
+
+// SetCreateTime sets the "createTime" field.
+func (bu *BlockUpdate) SetCreateTime(t time.Time) *BlockUpdate {
+	bu.mutation.SetCreateTime(t)
+	return bu
+}
+
+// SetNillableCreateTime sets the "createTime" field if the given value is not nil.
+func (bu *BlockUpdate) SetNillableCreateTime(t *time.Time) *BlockUpdate {
+	if t != nil {
+		bu.SetCreateTime(*t)
+	}
+	return bu
+}
+
+// Mutation returns the BlockMutation object of the builder.
+func (bu *BlockUpdate) Mutation() *BlockMutation {
+	return bu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bu *BlockUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, bu.sqlSave, bu.mutation, bu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bu *BlockUpdate) SaveX(ctx context.Context) int {
+	affected, err := bu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bu *BlockUpdate) Exec(ctx context.Context) error {
+	_, err := bu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bu *BlockUpdate) ExecX(ctx context.Context) {
+	if err := bu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (bu *BlockUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(block.Table, block.Columns, sqlgraph.NewFieldSpec(block.FieldID, field.TypeInt))
+	if ps := bu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bu.mutation.UserId(); ok {
+		_spec.SetField(block.FieldUserId, field.TypeInt, value)
+	}
+	if value, ok := bu.mutation.AddedUserId(); ok {
+		_spec.AddField(block.FieldUserId, field.TypeInt, value)
+	}
+	if value, ok := bu.mutation.BlockedUserId(); ok {
+		_spec.SetField(block.FieldBlockedUserId, field.TypeInt, value)
+	}
+	if value, ok := bu.mutation.AddedBlockedUserId(); ok {
+		_spec.AddField(block.FieldBlockedUserId, field.TypeInt, value)
+	}
+	if value, ok := bu.mutation.CreateTime(); ok {
+		_spec.SetField(block.FieldCreateTime, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, bu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{block.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	bu.mutation.done = true
+	return n, nil
+}
+
+// BlockUpdateOne is the builder for updating a single Block entity.
+type BlockUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BlockMutation
+}
+
+// SetUserId sets the "userId" field.
+func (buo *BlockUpdateOne) SetUserId(i int) *BlockUpdateOne {
+	buo.mutation.ResetUserId()
+	buo.mutation.SetUserId(i)
+	return buo
+}
+
+// SetNillableUserId sets the "userId" field if the given value is not nil.
+func (buo *BlockUpdateOne) SetNillableUserId(i *int) *BlockUpdateOne {
+	if i != nil {
+		buo.SetUserId(*i)
+	}
+	return buo
+}
+
+// AddUserId adds i to the "userId" field.
+func (buo *BlockUpdateOne) AddUserId(i int) *BlockUpdateOne {
+	buo.mutation.AddUserId(i)
+	return buo
+}
+
+// SetBlockedUserId sets the "blockedUserId" field.
+func (buo *BlockUpdateOne) SetBlockedUserId(i int) *BlockUpdateOne {
+	buo.mutation.ResetBlockedUserId()
+	buo.mutation.SetBlockedUserId(i)
+	return buo
+}
+
+// SetNillableBlockedUserId sets the "blockedUserId" field if the given value is not nil.
+func (buo *BlockUpdateOne) SetNillableBlockedUserId(i *int) *BlockUpdateOne {
+	if i != nil {
+		buo.SetBlockedUserId(*i)
+	}
+	return buo
+}
+
+// AddBlockedUserId adds i to the "blockedUserId" field.
+func (buo *BlockUpdateOne) AddBlockedUserId(i int) *BlockUpdateOne {
+	buo.mutation.AddBlockedUserId(i)
+	return buo
+}
+
+// SetCreateTime sets the "createTime" field.
+func (buo *BlockUpdateOne) SetCreateTime(t time.Time) *BlockUpdateOne {
+	buo.mutation.SetCreateTime(t)
+	return buo
+}
+
+// SetNillableCreateTime sets the "createTime" field if the given value is not nil.
+func (buo *BlockUpdateOne) SetNillableCreateTime(t *time.Time) *BlockUpdateOne {
+	if t != nil {
+		buo.SetCreateTime(*t)
+	}
+	return buo
+}
+
+// Mutation returns the BlockMutation object of the builder.
+func (buo *BlockUpdateOne) Mutation() *BlockMutation {
+	return buo.mutation
+}
+
+// Where appends a list predicates to the BlockUpdate builder.
+func (buo *BlockUpdateOne) Where(ps ...predicate.Block) *BlockUpdateOne {
+	buo.mutation.Where(ps...)
+	return buo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (buo *BlockUpdateOne) Select(field string, fields ...string) *BlockUpdateOne {
+	buo.fields = append([]string{field}, fields...)
+	return buo
+}
+
+// Save executes the query and returns the updated Block entity.
+func (buo *BlockUpdateOne) Save(ctx context.Context) (*Block, error) {
+	return withHooks(ctx, buo.sqlSave, buo.mutation, buo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (buo *BlockUpdateOne) SaveX(ctx context.Context) *Block {
+	node, err := buo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (buo *BlockUpdateOne) Exec(ctx context.Context) error {
+	_, err := buo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (buo *BlockUpdateOne) ExecX(ctx context.Context) {
+	if err := buo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (buo *BlockUpdateOne) sqlSave(ctx context.Context) (_node *Block, err error) {
+	_spec := sqlgraph.NewUpdateSpec(block.Table, block.Columns, sqlgraph.NewFieldSpec(block.FieldID, field.TypeInt))
+	id, ok := buo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Block.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := buo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, block.FieldID)
+		for _, f := range fields {
+			if !block.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != block.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := buo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := buo.mutation.UserId(); ok {
+		_spec.SetField(block.FieldUserId, field.TypeInt, value)
+	}
+	if value, ok := buo.mutation.AddedUserId(); ok {
+		_spec.AddField(block.FieldUserId, field.TypeInt, value)
+	}
+	if value, ok := buo.mutation.BlockedUserId(); ok {
+		_spec.SetField(block.FieldBlockedUserId, field.TypeInt, value)
+	}
+	if value, ok := buo.mutation.AddedBlockedUserId(); ok {
+		_spec.AddField(block.FieldBlockedUserId, field.TypeInt, value)
+	}
+	if value, ok := buo.mutation.CreateTime(); ok {
+		_spec.SetField(block.FieldCreateTime, field.TypeTime, value)
+	}
+	_node = &Block{config: buo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, buo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{block.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	buo.mutation.done = true
+	return _node, nil
+}
@@ -29,6 +29,8 @@ type ChatRecord struct {
 	IsGroup bool `json:"isGroup,omitempty"`
 	// 群聊ID，仅群聊时有值
 	GroupId int `json:"groupId,omitempty"`
+	// 私聊会话ID，由双方ID按从小到大拼接，用于按会话分页
+	ConversationId string `json:"conversationId,omitempty"`
 	// 创建时间
 	CreateTime   time.Time `json:"createTime,omitempty"`
 	selectValues sql.SelectValues
@@ -43,7 +45,7 @@ func (*ChatRecord) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case chatrecord.FieldID, chatrecord.FieldFromUserId, chatrecord.FieldToUserId, chatrecord.FieldMsgType, chatrecord.FieldGroupId:
 			values[i] = new(sql.NullInt64)
-		case chatrecord.FieldMsgId:
+		case chatrecord.FieldMsgId, chatrecord.FieldConversationId:
 			values[i] = new(sql.NullString)
 		case chatrecord.FieldCreateTime:
 			values[i] = new(sql.NullTime)
@@ -104,6 +106,12 @@ func (cr *ChatRecord) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				cr.GroupId = int(value.Int64)
 			}
+		case chatrecord.FieldConversationId:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field conversationId", values[i])
+			} else if value.Valid {
+				cr.ConversationId = value.String
+			}
 		case chatrecord.FieldCreateTime:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field createTime", values[i])
@@ -164,6 +172,9 @@ func (cr *ChatRecord) String() string {
 	builder.WriteString("groupId=")
 	builder.WriteString(fmt.Sprintf("%v", cr.GroupId))
 	builder.WriteString(", ")
+	builder.WriteString("conversationId=")
+	builder.WriteString(cr.ConversationId)
+	builder.WriteString(", ")
 	builder.WriteString("createTime=")
 	builder.WriteString(cr.CreateTime.Format(time.ANSIC))
 	builder.WriteByte(')')
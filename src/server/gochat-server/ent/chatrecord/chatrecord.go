@@ -25,6 +25,8 @@ const (
 	FieldIsGroup = "is_group"
 	// FieldGroupId holds the string denoting the groupid field in the database.
 	FieldGroupId = "group_id"
+	// FieldConversationId holds the string denoting the conversationid field in the database.
+	FieldConversationId = "conversation_id"
 	// FieldCreateTime holds the string denoting the createtime field in the database.
 	FieldCreateTime = "create_time"
 	// Table holds the table name of the chatrecord in the database.
@@ -40,6 +42,7 @@ var Columns = []string{
 	FieldMsgType,
 	FieldIsGroup,
 	FieldGroupId,
+	FieldConversationId,
 	FieldCreateTime,
 }
 
@@ -100,6 +103,11 @@ func ByGroupId(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldGroupId, opts...).ToFunc()
 }
 
+// ByConversationId orders the results by the conversationId field.
+func ByConversationId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConversationId, opts...).ToFunc()
+}
+
 // ByCreateTime orders the results by the createTime field.
 func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
@@ -84,6 +84,11 @@ func GroupId(v int) predicate.ChatRecord {
 	return predicate.ChatRecord(sql.FieldEQ(FieldGroupId, v))
 }
 
+// ConversationId applies equality check predicate on the "conversationId" field. It's identical to ConversationIdEQ.
+func ConversationId(v string) predicate.ChatRecord {
+	return predicate.ChatRecord(sql.FieldEQ(FieldConversationId, v))
+}
+
 // CreateTime applies equality check predicate on the "createTime" field. It's identical to CreateTimeEQ.
 func CreateTime(v time.Time) predicate.ChatRecord {
 	return predicate.ChatRecord(sql.FieldEQ(FieldCreateTime, v))
@@ -334,6 +339,81 @@ func GroupIdNotNil() predicate.ChatRecord {
 	return predicate.ChatRecord(sql.FieldNotNull(FieldGroupId))
 }
 
+// ConversationIdEQ applies the EQ predicate on the "conversationId" field.
+func ConversationIdEQ(v string) predicate.ChatRecord {
+	return predicate.ChatRecord(sql.FieldEQ(FieldConversationId, v))
+}
+
+// ConversationIdNEQ applies the NEQ predicate on the "conversationId" field.
+func ConversationIdNEQ(v string) predicate.ChatRecord {
+	return predicate.ChatRecord(sql.FieldNEQ(FieldConversationId, v))
+}
+
+// ConversationIdIn applies the In predicate on the "conversationId" field.
+func ConversationIdIn(vs ...string) predicate.ChatRecord {
+	return predicate.ChatRecord(sql.FieldIn(FieldConversationId, vs...))
+}
+
+// ConversationIdNotIn applies the NotIn predicate on the "conversationId" field.
+func ConversationIdNotIn(vs ...string) predicate.ChatRecord {
+	return predicate.ChatRecord(sql.FieldNotIn(FieldConversationId, vs...))
+}
+
+// ConversationIdGT applies the GT predicate on the "conversationId" field.
+func ConversationIdGT(v string) predicate.ChatRecord {
+	return predicate.ChatRecord(sql.FieldGT(FieldConversationId, v))
+}
+
+// ConversationIdGTE applies the GTE predicate on the "conversationId" field.
+func ConversationIdGTE(v string) predicate.ChatRecord {
+	return predicate.ChatRecord(sql.FieldGTE(FieldConversationId, v))
+}
+
+// ConversationIdLT applies the LT predicate on the "conversationId" field.
+func ConversationIdLT(v string) predicate.ChatRecord {
+	return predicate.ChatRecord(sql.FieldLT(FieldConversationId, v))
+}
+
+// ConversationIdLTE applies the LTE predicate on the "conversationId" field.
+func ConversationIdLTE(v string) predicate.ChatRecord {
+	return predicate.ChatRecord(sql.FieldLTE(FieldConversationId, v))
+}
+
+// ConversationIdContains applies the Contains predicate on the "conversationId" field.
+func ConversationIdContains(v string) predicate.ChatRecord {
+	return predicate.ChatRecord(sql.FieldContains(FieldConversationId, v))
+}
+
+// ConversationIdHasPrefix applies the HasPrefix predicate on the "conversationId" field.
+func ConversationIdHasPrefix(v string) predicate.ChatRecord {
+	return predicate.ChatRecord(sql.FieldHasPrefix(FieldConversationId, v))
+}
+
+// ConversationIdHasSuffix applies the HasSuffix predicate on the "conversationId" field.
+func ConversationIdHasSuffix(v string) predicate.ChatRecord {
+	return predicate.ChatRecord(sql.FieldHasSuffix(FieldConversationId, v))
+}
+
+// ConversationIdIsNil applies the IsNil predicate on the "conversationId" field.
+func ConversationIdIsNil() predicate.ChatRecord {
+	return predicate.ChatRecord(sql.FieldIsNull(FieldConversationId))
+}
+
+// ConversationIdNotNil applies the NotNil predicate on the "conversationId" field.
+func ConversationIdNotNil() predicate.ChatRecord {
+	return predicate.ChatRecord(sql.FieldNotNull(FieldConversationId))
+}
+
+// ConversationIdEqualFold applies the EqualFold predicate on the "conversationId" field.
+func ConversationIdEqualFold(v string) predicate.ChatRecord {
+	return predicate.ChatRecord(sql.FieldEqualFold(FieldConversationId, v))
+}
+
+// ConversationIdContainsFold applies the ContainsFold predicate on the "conversationId" field.
+func ConversationIdContainsFold(v string) predicate.ChatRecord {
+	return predicate.ChatRecord(sql.FieldContainsFold(FieldConversationId, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "createTime" field.
 func CreateTimeEQ(v time.Time) predicate.ChatRecord {
 	return predicate.ChatRecord(sql.FieldEQ(FieldCreateTime, v))
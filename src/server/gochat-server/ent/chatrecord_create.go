@@ -72,6 +72,20 @@ func (crc *ChatRecordCreate) SetNillableGroupId(i *int) *ChatRecordCreate {
 	return crc
 }
 
+// SetConversationId sets the "conversationId" field.
+func (crc *ChatRecordCreate) SetConversationId(s string) *ChatRecordCreate {
+	crc.mutation.SetConversationId(s)
+	return crc
+}
+
+// SetNillableConversationId sets the "conversationId" field if the given value is not nil.
+func (crc *ChatRecordCreate) SetNillableConversationId(s *string) *ChatRecordCreate {
+	if s != nil {
+		crc.SetConversationId(*s)
+	}
+	return crc
+}
+
 // SetCreateTime sets the "createTime" field.
 func (crc *ChatRecordCreate) SetCreateTime(t time.Time) *ChatRecordCreate {
 	crc.mutation.SetCreateTime(t)
@@ -206,6 +220,10 @@ func (crc *ChatRecordCreate) createSpec() (*ChatRecord, *sqlgraph.CreateSpec) {
 		_spec.SetField(chatrecord.FieldGroupId, field.TypeInt, value)
 		_node.GroupId = value
 	}
+	if value, ok := crc.mutation.ConversationId(); ok {
+		_spec.SetField(chatrecord.FieldConversationId, field.TypeString, value)
+		_node.ConversationId = value
+	}
 	if value, ok := crc.mutation.CreateTime(); ok {
 		_spec.SetField(chatrecord.FieldCreateTime, field.TypeTime, value)
 		_node.CreateTime = value
@@ -146,6 +146,26 @@ func (cru *ChatRecordUpdate) ClearGroupId() *ChatRecordUpdate {
 	return cru
 }
 
+// SetConversationId sets the "conversationId" field.
+func (cru *ChatRecordUpdate) SetConversationId(s string) *ChatRecordUpdate {
+	cru.mutation.SetConversationId(s)
+	return cru
+}
+
+// SetNillableConversationId sets the "conversationId" field if the given value is not nil.
+func (cru *ChatRecordUpdate) SetNillableConversationId(s *string) *ChatRecordUpdate {
+	if s != nil {
+		cru.SetConversationId(*s)
+	}
+	return cru
+}
+
+// ClearConversationId clears the value of the "conversationId" field.
+func (cru *ChatRecordUpdate) ClearConversationId() *ChatRecordUpdate {
+	cru.mutation.ClearConversationId()
+	return cru
+}
+
 // SetCreateTime sets the "createTime" field.
 func (cru *ChatRecordUpdate) SetCreateTime(t time.Time) *ChatRecordUpdate {
 	cru.mutation.SetCreateTime(t)
@@ -247,6 +267,12 @@ func (cru *ChatRecordUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if cru.mutation.GroupIdCleared() {
 		_spec.ClearField(chatrecord.FieldGroupId, field.TypeInt)
 	}
+	if value, ok := cru.mutation.ConversationId(); ok {
+		_spec.SetField(chatrecord.FieldConversationId, field.TypeString, value)
+	}
+	if cru.mutation.ConversationIdCleared() {
+		_spec.ClearField(chatrecord.FieldConversationId, field.TypeString)
+	}
 	if value, ok := cru.mutation.CreateTime(); ok {
 		_spec.SetField(chatrecord.FieldCreateTime, field.TypeTime, value)
 	}
@@ -388,6 +414,26 @@ func (cruo *ChatRecordUpdateOne) ClearGroupId() *ChatRecordUpdateOne {
 	return cruo
 }
 
+// SetConversationId sets the "conversationId" field.
+func (cruo *ChatRecordUpdateOne) SetConversationId(s string) *ChatRecordUpdateOne {
+	cruo.mutation.SetConversationId(s)
+	return cruo
+}
+
+// SetNillableConversationId sets the "conversationId" field if the given value is not nil.
+func (cruo *ChatRecordUpdateOne) SetNillableConversationId(s *string) *ChatRecordUpdateOne {
+	if s != nil {
+		cruo.SetConversationId(*s)
+	}
+	return cruo
+}
+
+// ClearConversationId clears the value of the "conversationId" field.
+func (cruo *ChatRecordUpdateOne) ClearConversationId() *ChatRecordUpdateOne {
+	cruo.mutation.ClearConversationId()
+	return cruo
+}
+
 // SetCreateTime sets the "createTime" field.
 func (cruo *ChatRecordUpdateOne) SetCreateTime(t time.Time) *ChatRecordUpdateOne {
 	cruo.mutation.SetCreateTime(t)
@@ -519,6 +565,12 @@ func (cruo *ChatRecordUpdateOne) sqlSave(ctx context.Context) (_node *ChatRecord
 	if cruo.mutation.GroupIdCleared() {
 		_spec.ClearField(chatrecord.FieldGroupId, field.TypeInt)
 	}
+	if value, ok := cruo.mutation.ConversationId(); ok {
+		_spec.SetField(chatrecord.FieldConversationId, field.TypeString, value)
+	}
+	if cruo.mutation.ConversationIdCleared() {
+		_spec.ClearField(chatrecord.FieldConversationId, field.TypeString)
+	}
 	if value, ok := cruo.mutation.CreateTime(); ok {
 		_spec.SetField(chatrecord.FieldCreateTime, field.TypeTime, value)
 	}
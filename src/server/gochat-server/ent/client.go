@@ -11,18 +11,36 @@ import (
 
 	"gochat_server/ent/migrate"
 
+	"gochat_server/ent/block"
 	"gochat_server/ent/chatrecord"
+	"gochat_server/ent/contactcardmessage"
+	"gochat_server/ent/conversationclear"
+	"gochat_server/ent/conversationtimer"
+	"gochat_server/ent/datamigration"
 	"gochat_server/ent/donotdisturb"
+	"gochat_server/ent/exportjob"
+	"gochat_server/ent/filemessage"
 	"gochat_server/ent/friendrelationship"
 	"gochat_server/ent/friendrequest"
 	"gochat_server/ent/group"
 	"gochat_server/ent/groupchatrecord"
+	"gochat_server/ent/groupwatermark"
 	"gochat_server/ent/imagemessage"
+	"gochat_server/ent/locationmessage"
+	"gochat_server/ent/mergedforwardmessage"
 	"gochat_server/ent/message"
+	"gochat_server/ent/messagedeletion"
+	"gochat_server/ent/messageforward"
+	"gochat_server/ent/messagemention"
+	"gochat_server/ent/messageoutbox"
+	"gochat_server/ent/messagereaction"
 	"gochat_server/ent/messagestatus"
+	"gochat_server/ent/retentionjob"
+	"gochat_server/ent/scheduledmessage"
 	"gochat_server/ent/textmessage"
 	"gochat_server/ent/user"
 	"gochat_server/ent/videomessage"
+	"gochat_server/ent/voicemessage"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -34,10 +52,24 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// Block is the client for interacting with the Block builders.
+	Block *BlockClient
 	// ChatRecord is the client for interacting with the ChatRecord builders.
 	ChatRecord *ChatRecordClient
+	// ContactCardMessage is the client for interacting with the ContactCardMessage builders.
+	ContactCardMessage *ContactCardMessageClient
+	// ConversationClear is the client for interacting with the ConversationClear builders.
+	ConversationClear *ConversationClearClient
+	// ConversationTimer is the client for interacting with the ConversationTimer builders.
+	ConversationTimer *ConversationTimerClient
+	// DataMigration is the client for interacting with the DataMigration builders.
+	DataMigration *DataMigrationClient
 	// DoNotDisturb is the client for interacting with the DoNotDisturb builders.
 	DoNotDisturb *DoNotDisturbClient
+	// ExportJob is the client for interacting with the ExportJob builders.
+	ExportJob *ExportJobClient
+	// FileMessage is the client for interacting with the FileMessage builders.
+	FileMessage *FileMessageClient
 	// FriendRelationship is the client for interacting with the FriendRelationship builders.
 	FriendRelationship *FriendRelationshipClient
 	// FriendRequest is the client for interacting with the FriendRequest builders.
@@ -46,18 +78,40 @@ type Client struct {
 	Group *GroupClient
 	// GroupChatRecord is the client for interacting with the GroupChatRecord builders.
 	GroupChatRecord *GroupChatRecordClient
+	// GroupWatermark is the client for interacting with the GroupWatermark builders.
+	GroupWatermark *GroupWatermarkClient
 	// ImageMessage is the client for interacting with the ImageMessage builders.
 	ImageMessage *ImageMessageClient
+	// LocationMessage is the client for interacting with the LocationMessage builders.
+	LocationMessage *LocationMessageClient
+	// MergedForwardMessage is the client for interacting with the MergedForwardMessage builders.
+	MergedForwardMessage *MergedForwardMessageClient
 	// Message is the client for interacting with the Message builders.
 	Message *MessageClient
+	// MessageDeletion is the client for interacting with the MessageDeletion builders.
+	MessageDeletion *MessageDeletionClient
+	// MessageForward is the client for interacting with the MessageForward builders.
+	MessageForward *MessageForwardClient
+	// MessageMention is the client for interacting with the MessageMention builders.
+	MessageMention *MessageMentionClient
+	// MessageOutbox is the client for interacting with the MessageOutbox builders.
+	MessageOutbox *MessageOutboxClient
+	// MessageReaction is the client for interacting with the MessageReaction builders.
+	MessageReaction *MessageReactionClient
 	// MessageStatus is the client for interacting with the MessageStatus builders.
 	MessageStatus *MessageStatusClient
+	// RetentionJob is the client for interacting with the RetentionJob builders.
+	RetentionJob *RetentionJobClient
+	// ScheduledMessage is the client for interacting with the ScheduledMessage builders.
+	ScheduledMessage *ScheduledMessageClient
 	// TextMessage is the client for interacting with the TextMessage builders.
 	TextMessage *TextMessageClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// VideoMessage is the client for interacting with the VideoMessage builders.
 	VideoMessage *VideoMessageClient
+	// VoiceMessage is the client for interacting with the VoiceMessage builders.
+	VoiceMessage *VoiceMessageClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -69,18 +123,36 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.Block = NewBlockClient(c.config)
 	c.ChatRecord = NewChatRecordClient(c.config)
+	c.ContactCardMessage = NewContactCardMessageClient(c.config)
+	c.ConversationClear = NewConversationClearClient(c.config)
+	c.ConversationTimer = NewConversationTimerClient(c.config)
+	c.DataMigration = NewDataMigrationClient(c.config)
 	c.DoNotDisturb = NewDoNotDisturbClient(c.config)
+	c.ExportJob = NewExportJobClient(c.config)
+	c.FileMessage = NewFileMessageClient(c.config)
 	c.FriendRelationship = NewFriendRelationshipClient(c.config)
 	c.FriendRequest = NewFriendRequestClient(c.config)
 	c.Group = NewGroupClient(c.config)
 	c.GroupChatRecord = NewGroupChatRecordClient(c.config)
+	c.GroupWatermark = NewGroupWatermarkClient(c.config)
 	c.ImageMessage = NewImageMessageClient(c.config)
+	c.LocationMessage = NewLocationMessageClient(c.config)
+	c.MergedForwardMessage = NewMergedForwardMessageClient(c.config)
 	c.Message = NewMessageClient(c.config)
+	c.MessageDeletion = NewMessageDeletionClient(c.config)
+	c.MessageForward = NewMessageForwardClient(c.config)
+	c.MessageMention = NewMessageMentionClient(c.config)
+	c.MessageOutbox = NewMessageOutboxClient(c.config)
+	c.MessageReaction = NewMessageReactionClient(c.config)
 	c.MessageStatus = NewMessageStatusClient(c.config)
+	c.RetentionJob = NewRetentionJobClient(c.config)
+	c.ScheduledMessage = NewScheduledMessageClient(c.config)
 	c.TextMessage = NewTextMessageClient(c.config)
 	c.User = NewUserClient(c.config)
 	c.VideoMessage = NewVideoMessageClient(c.config)
+	c.VoiceMessage = NewVoiceMessageClient(c.config)
 }
 
 type (
@@ -171,20 +243,38 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:                ctx,
-		config:             cfg,
-		ChatRecord:         NewChatRecordClient(cfg),
-		DoNotDisturb:       NewDoNotDisturbClient(cfg),
-		FriendRelationship: NewFriendRelationshipClient(cfg),
-		FriendRequest:      NewFriendRequestClient(cfg),
-		Group:              NewGroupClient(cfg),
-		GroupChatRecord:    NewGroupChatRecordClient(cfg),
-		ImageMessage:       NewImageMessageClient(cfg),
-		Message:            NewMessageClient(cfg),
-		MessageStatus:      NewMessageStatusClient(cfg),
-		TextMessage:        NewTextMessageClient(cfg),
-		User:               NewUserClient(cfg),
-		VideoMessage:       NewVideoMessageClient(cfg),
+		ctx:                  ctx,
+		config:               cfg,
+		Block:                NewBlockClient(cfg),
+		ChatRecord:           NewChatRecordClient(cfg),
+		ContactCardMessage:   NewContactCardMessageClient(cfg),
+		ConversationClear:    NewConversationClearClient(cfg),
+		ConversationTimer:    NewConversationTimerClient(cfg),
+		DataMigration:        NewDataMigrationClient(cfg),
+		DoNotDisturb:         NewDoNotDisturbClient(cfg),
+		ExportJob:            NewExportJobClient(cfg),
+		FileMessage:          NewFileMessageClient(cfg),
+		FriendRelationship:   NewFriendRelationshipClient(cfg),
+		FriendRequest:        NewFriendRequestClient(cfg),
+		Group:                NewGroupClient(cfg),
+		GroupChatRecord:      NewGroupChatRecordClient(cfg),
+		GroupWatermark:       NewGroupWatermarkClient(cfg),
+		ImageMessage:         NewImageMessageClient(cfg),
+		LocationMessage:      NewLocationMessageClient(cfg),
+		MergedForwardMessage: NewMergedForwardMessageClient(cfg),
+		Message:              NewMessageClient(cfg),
+		MessageDeletion:      NewMessageDeletionClient(cfg),
+		MessageForward:       NewMessageForwardClient(cfg),
+		MessageMention:       NewMessageMentionClient(cfg),
+		MessageOutbox:        NewMessageOutboxClient(cfg),
+		MessageReaction:      NewMessageReactionClient(cfg),
+		MessageStatus:        NewMessageStatusClient(cfg),
+		RetentionJob:         NewRetentionJobClient(cfg),
+		ScheduledMessage:     NewScheduledMessageClient(cfg),
+		TextMessage:          NewTextMessageClient(cfg),
+		User:                 NewUserClient(cfg),
+		VideoMessage:         NewVideoMessageClient(cfg),
+		VoiceMessage:         NewVoiceMessageClient(cfg),
 	}, nil
 }
 
@@ -202,27 +292,45 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:                ctx,
-		config:             cfg,
-		ChatRecord:         NewChatRecordClient(cfg),
-		DoNotDisturb:       NewDoNotDisturbClient(cfg),
-		FriendRelationship: NewFriendRelationshipClient(cfg),
-		FriendRequest:      NewFriendRequestClient(cfg),
-		Group:              NewGroupClient(cfg),
-		GroupChatRecord:    NewGroupChatRecordClient(cfg),
-		ImageMessage:       NewImageMessageClient(cfg),
-		Message:            NewMessageClient(cfg),
-		MessageStatus:      NewMessageStatusClient(cfg),
-		TextMessage:        NewTextMessageClient(cfg),
-		User:               NewUserClient(cfg),
-		VideoMessage:       NewVideoMessageClient(cfg),
+		ctx:                  ctx,
+		config:               cfg,
+		Block:                NewBlockClient(cfg),
+		ChatRecord:           NewChatRecordClient(cfg),
+		ContactCardMessage:   NewContactCardMessageClient(cfg),
+		ConversationClear:    NewConversationClearClient(cfg),
+		ConversationTimer:    NewConversationTimerClient(cfg),
+		DataMigration:        NewDataMigrationClient(cfg),
+		DoNotDisturb:         NewDoNotDisturbClient(cfg),
+		ExportJob:            NewExportJobClient(cfg),
+		FileMessage:          NewFileMessageClient(cfg),
+		FriendRelationship:   NewFriendRelationshipClient(cfg),
+		FriendRequest:        NewFriendRequestClient(cfg),
+		Group:                NewGroupClient(cfg),
+		GroupChatRecord:      NewGroupChatRecordClient(cfg),
+		GroupWatermark:       NewGroupWatermarkClient(cfg),
+		ImageMessage:         NewImageMessageClient(cfg),
+		LocationMessage:      NewLocationMessageClient(cfg),
+		MergedForwardMessage: NewMergedForwardMessageClient(cfg),
+		Message:              NewMessageClient(cfg),
+		MessageDeletion:      NewMessageDeletionClient(cfg),
+		MessageForward:       NewMessageForwardClient(cfg),
+		MessageMention:       NewMessageMentionClient(cfg),
+		MessageOutbox:        NewMessageOutboxClient(cfg),
+		MessageReaction:      NewMessageReactionClient(cfg),
+		MessageStatus:        NewMessageStatusClient(cfg),
+		RetentionJob:         NewRetentionJobClient(cfg),
+		ScheduledMessage:     NewScheduledMessageClient(cfg),
+		TextMessage:          NewTextMessageClient(cfg),
+		User:                 NewUserClient(cfg),
+		VideoMessage:         NewVideoMessageClient(cfg),
+		VoiceMessage:         NewVoiceMessageClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		ChatRecord.
+//		Block.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -245,9 +353,14 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.ChatRecord, c.DoNotDisturb, c.FriendRelationship, c.FriendRequest, c.Group,
-		c.GroupChatRecord, c.ImageMessage, c.Message, c.MessageStatus, c.TextMessage,
-		c.User, c.VideoMessage,
+		c.Block, c.ChatRecord, c.ContactCardMessage, c.ConversationClear,
+		c.ConversationTimer, c.DataMigration, c.DoNotDisturb, c.ExportJob,
+		c.FileMessage, c.FriendRelationship, c.FriendRequest, c.Group,
+		c.GroupChatRecord, c.GroupWatermark, c.ImageMessage, c.LocationMessage,
+		c.MergedForwardMessage, c.Message, c.MessageDeletion, c.MessageForward,
+		c.MessageMention, c.MessageOutbox, c.MessageReaction, c.MessageStatus,
+		c.RetentionJob, c.ScheduledMessage, c.TextMessage, c.User, c.VideoMessage,
+		c.VoiceMessage,
 	} {
 		n.Use(hooks...)
 	}
@@ -257,9 +370,14 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.ChatRecord, c.DoNotDisturb, c.FriendRelationship, c.FriendRequest, c.Group,
-		c.GroupChatRecord, c.ImageMessage, c.Message, c.MessageStatus, c.TextMessage,
-		c.User, c.VideoMessage,
+		c.Block, c.ChatRecord, c.ContactCardMessage, c.ConversationClear,
+		c.ConversationTimer, c.DataMigration, c.DoNotDisturb, c.ExportJob,
+		c.FileMessage, c.FriendRelationship, c.FriendRequest, c.Group,
+		c.GroupChatRecord, c.GroupWatermark, c.ImageMessage, c.LocationMessage,
+		c.MergedForwardMessage, c.Message, c.MessageDeletion, c.MessageForward,
+		c.MessageMention, c.MessageOutbox, c.MessageReaction, c.MessageStatus,
+		c.RetentionJob, c.ScheduledMessage, c.TextMessage, c.User, c.VideoMessage,
+		c.VoiceMessage,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -268,10 +386,24 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *BlockMutation:
+		return c.Block.mutate(ctx, m)
 	case *ChatRecordMutation:
 		return c.ChatRecord.mutate(ctx, m)
+	case *ContactCardMessageMutation:
+		return c.ContactCardMessage.mutate(ctx, m)
+	case *ConversationClearMutation:
+		return c.ConversationClear.mutate(ctx, m)
+	case *ConversationTimerMutation:
+		return c.ConversationTimer.mutate(ctx, m)
+	case *DataMigrationMutation:
+		return c.DataMigration.mutate(ctx, m)
 	case *DoNotDisturbMutation:
 		return c.DoNotDisturb.mutate(ctx, m)
+	case *ExportJobMutation:
+		return c.ExportJob.mutate(ctx, m)
+	case *FileMessageMutation:
+		return c.FileMessage.mutate(ctx, m)
 	case *FriendRelationshipMutation:
 		return c.FriendRelationship.mutate(ctx, m)
 	case *FriendRequestMutation:
@@ -280,23 +412,178 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Group.mutate(ctx, m)
 	case *GroupChatRecordMutation:
 		return c.GroupChatRecord.mutate(ctx, m)
+	case *GroupWatermarkMutation:
+		return c.GroupWatermark.mutate(ctx, m)
 	case *ImageMessageMutation:
 		return c.ImageMessage.mutate(ctx, m)
+	case *LocationMessageMutation:
+		return c.LocationMessage.mutate(ctx, m)
+	case *MergedForwardMessageMutation:
+		return c.MergedForwardMessage.mutate(ctx, m)
 	case *MessageMutation:
 		return c.Message.mutate(ctx, m)
+	case *MessageDeletionMutation:
+		return c.MessageDeletion.mutate(ctx, m)
+	case *MessageForwardMutation:
+		return c.MessageForward.mutate(ctx, m)
+	case *MessageMentionMutation:
+		return c.MessageMention.mutate(ctx, m)
+	case *MessageOutboxMutation:
+		return c.MessageOutbox.mutate(ctx, m)
+	case *MessageReactionMutation:
+		return c.MessageReaction.mutate(ctx, m)
 	case *MessageStatusMutation:
 		return c.MessageStatus.mutate(ctx, m)
+	case *RetentionJobMutation:
+		return c.RetentionJob.mutate(ctx, m)
+	case *ScheduledMessageMutation:
+		return c.ScheduledMessage.mutate(ctx, m)
 	case *TextMessageMutation:
 		return c.TextMessage.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	case *VideoMessageMutation:
 		return c.VideoMessage.mutate(ctx, m)
+	case *VoiceMessageMutation:
+		return c.VoiceMessage.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// BlockClient is a client for the Block schema.
+type BlockClient struct {
+	config
+}
+
+// NewBlockClient returns a client for the Block from the given config.
+func NewBlockClient(c config) *BlockClient {
+	return &BlockClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `block.Hooks(f(g(h())))`.
+func (c *BlockClient) Use(hooks ...Hook) {
+	c.hooks.Block = append(c.hooks.Block, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `block.Intercept(f(g(h())))`.
+func (c *BlockClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Block = append(c.inters.Block, interceptors...)
+}
+
+// Create returns a builder for creating a Block entity.
+func (c *BlockClient) Create() *BlockCreate {
+	mutation := newBlockMutation(c.config, OpCreate)
+	return &BlockCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Block entities.
+func (c *BlockClient) CreateBulk(builders ...*BlockCreate) *BlockCreateBulk {
+	return &BlockCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BlockClient) MapCreateBulk(slice any, setFunc func(*BlockCreate, int)) *BlockCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BlockCreateBulk{err: fmt.Errorf("calling to BlockClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BlockCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BlockCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Block.
+func (c *BlockClient) Update() *BlockUpdate {
+	mutation := newBlockMutation(c.config, OpUpdate)
+	return &BlockUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BlockClient) UpdateOne(b *Block) *BlockUpdateOne {
+	mutation := newBlockMutation(c.config, OpUpdateOne, withBlock(b))
+	return &BlockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BlockClient) UpdateOneID(id int) *BlockUpdateOne {
+	mutation := newBlockMutation(c.config, OpUpdateOne, withBlockID(id))
+	return &BlockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Block.
+func (c *BlockClient) Delete() *BlockDelete {
+	mutation := newBlockMutation(c.config, OpDelete)
+	return &BlockDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BlockClient) DeleteOne(b *Block) *BlockDeleteOne {
+	return c.DeleteOneID(b.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BlockClient) DeleteOneID(id int) *BlockDeleteOne {
+	builder := c.Delete().Where(block.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BlockDeleteOne{builder}
+}
+
+// Query returns a query builder for Block.
+func (c *BlockClient) Query() *BlockQuery {
+	return &BlockQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBlock},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Block entity by its id.
+func (c *BlockClient) Get(ctx context.Context, id int) (*Block, error) {
+	return c.Query().Where(block.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BlockClient) GetX(ctx context.Context, id int) *Block {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BlockClient) Hooks() []Hook {
+	return c.hooks.Block
+}
+
+// Interceptors returns the client interceptors.
+func (c *BlockClient) Interceptors() []Interceptor {
+	return c.inters.Block
+}
+
+func (c *BlockClient) mutate(ctx context.Context, m *BlockMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BlockCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BlockUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BlockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BlockDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Block mutation op: %q", m.Op())
+	}
+}
+
 // ChatRecordClient is a client for the ChatRecord schema.
 type ChatRecordClient struct {
 	config
@@ -430,107 +717,107 @@ func (c *ChatRecordClient) mutate(ctx context.Context, m *ChatRecordMutation) (V
 	}
 }
 
-// DoNotDisturbClient is a client for the DoNotDisturb schema.
-type DoNotDisturbClient struct {
+// ContactCardMessageClient is a client for the ContactCardMessage schema.
+type ContactCardMessageClient struct {
 	config
 }
 
-// NewDoNotDisturbClient returns a client for the DoNotDisturb from the given config.
-func NewDoNotDisturbClient(c config) *DoNotDisturbClient {
-	return &DoNotDisturbClient{config: c}
+// NewContactCardMessageClient returns a client for the ContactCardMessage from the given config.
+func NewContactCardMessageClient(c config) *ContactCardMessageClient {
+	return &ContactCardMessageClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `donotdisturb.Hooks(f(g(h())))`.
-func (c *DoNotDisturbClient) Use(hooks ...Hook) {
-	c.hooks.DoNotDisturb = append(c.hooks.DoNotDisturb, hooks...)
+// A call to `Use(f, g, h)` equals to `contactcardmessage.Hooks(f(g(h())))`.
+func (c *ContactCardMessageClient) Use(hooks ...Hook) {
+	c.hooks.ContactCardMessage = append(c.hooks.ContactCardMessage, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `donotdisturb.Intercept(f(g(h())))`.
-func (c *DoNotDisturbClient) Intercept(interceptors ...Interceptor) {
-	c.inters.DoNotDisturb = append(c.inters.DoNotDisturb, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `contactcardmessage.Intercept(f(g(h())))`.
+func (c *ContactCardMessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ContactCardMessage = append(c.inters.ContactCardMessage, interceptors...)
 }
 
-// Create returns a builder for creating a DoNotDisturb entity.
-func (c *DoNotDisturbClient) Create() *DoNotDisturbCreate {
-	mutation := newDoNotDisturbMutation(c.config, OpCreate)
-	return &DoNotDisturbCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ContactCardMessage entity.
+func (c *ContactCardMessageClient) Create() *ContactCardMessageCreate {
+	mutation := newContactCardMessageMutation(c.config, OpCreate)
+	return &ContactCardMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of DoNotDisturb entities.
-func (c *DoNotDisturbClient) CreateBulk(builders ...*DoNotDisturbCreate) *DoNotDisturbCreateBulk {
-	return &DoNotDisturbCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ContactCardMessage entities.
+func (c *ContactCardMessageClient) CreateBulk(builders ...*ContactCardMessageCreate) *ContactCardMessageCreateBulk {
+	return &ContactCardMessageCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *DoNotDisturbClient) MapCreateBulk(slice any, setFunc func(*DoNotDisturbCreate, int)) *DoNotDisturbCreateBulk {
+func (c *ContactCardMessageClient) MapCreateBulk(slice any, setFunc func(*ContactCardMessageCreate, int)) *ContactCardMessageCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &DoNotDisturbCreateBulk{err: fmt.Errorf("calling to DoNotDisturbClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ContactCardMessageCreateBulk{err: fmt.Errorf("calling to ContactCardMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*DoNotDisturbCreate, rv.Len())
+	builders := make([]*ContactCardMessageCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &DoNotDisturbCreateBulk{config: c.config, builders: builders}
+	return &ContactCardMessageCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for DoNotDisturb.
-func (c *DoNotDisturbClient) Update() *DoNotDisturbUpdate {
-	mutation := newDoNotDisturbMutation(c.config, OpUpdate)
-	return &DoNotDisturbUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ContactCardMessage.
+func (c *ContactCardMessageClient) Update() *ContactCardMessageUpdate {
+	mutation := newContactCardMessageMutation(c.config, OpUpdate)
+	return &ContactCardMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *DoNotDisturbClient) UpdateOne(dnd *DoNotDisturb) *DoNotDisturbUpdateOne {
-	mutation := newDoNotDisturbMutation(c.config, OpUpdateOne, withDoNotDisturb(dnd))
-	return &DoNotDisturbUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ContactCardMessageClient) UpdateOne(ccm *ContactCardMessage) *ContactCardMessageUpdateOne {
+	mutation := newContactCardMessageMutation(c.config, OpUpdateOne, withContactCardMessage(ccm))
+	return &ContactCardMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *DoNotDisturbClient) UpdateOneID(id int) *DoNotDisturbUpdateOne {
-	mutation := newDoNotDisturbMutation(c.config, OpUpdateOne, withDoNotDisturbID(id))
-	return &DoNotDisturbUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ContactCardMessageClient) UpdateOneID(id int) *ContactCardMessageUpdateOne {
+	mutation := newContactCardMessageMutation(c.config, OpUpdateOne, withContactCardMessageID(id))
+	return &ContactCardMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for DoNotDisturb.
-func (c *DoNotDisturbClient) Delete() *DoNotDisturbDelete {
-	mutation := newDoNotDisturbMutation(c.config, OpDelete)
-	return &DoNotDisturbDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ContactCardMessage.
+func (c *ContactCardMessageClient) Delete() *ContactCardMessageDelete {
+	mutation := newContactCardMessageMutation(c.config, OpDelete)
+	return &ContactCardMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *DoNotDisturbClient) DeleteOne(dnd *DoNotDisturb) *DoNotDisturbDeleteOne {
-	return c.DeleteOneID(dnd.ID)
+func (c *ContactCardMessageClient) DeleteOne(ccm *ContactCardMessage) *ContactCardMessageDeleteOne {
+	return c.DeleteOneID(ccm.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *DoNotDisturbClient) DeleteOneID(id int) *DoNotDisturbDeleteOne {
-	builder := c.Delete().Where(donotdisturb.ID(id))
+func (c *ContactCardMessageClient) DeleteOneID(id int) *ContactCardMessageDeleteOne {
+	builder := c.Delete().Where(contactcardmessage.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &DoNotDisturbDeleteOne{builder}
+	return &ContactCardMessageDeleteOne{builder}
 }
 
-// Query returns a query builder for DoNotDisturb.
-func (c *DoNotDisturbClient) Query() *DoNotDisturbQuery {
-	return &DoNotDisturbQuery{
+// Query returns a query builder for ContactCardMessage.
+func (c *ContactCardMessageClient) Query() *ContactCardMessageQuery {
+	return &ContactCardMessageQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeDoNotDisturb},
+		ctx:    &QueryContext{Type: TypeContactCardMessage},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a DoNotDisturb entity by its id.
-func (c *DoNotDisturbClient) Get(ctx context.Context, id int) (*DoNotDisturb, error) {
-	return c.Query().Where(donotdisturb.ID(id)).Only(ctx)
+// Get returns a ContactCardMessage entity by its id.
+func (c *ContactCardMessageClient) Get(ctx context.Context, id int) (*ContactCardMessage, error) {
+	return c.Query().Where(contactcardmessage.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *DoNotDisturbClient) GetX(ctx context.Context, id int) *DoNotDisturb {
+func (c *ContactCardMessageClient) GetX(ctx context.Context, id int) *ContactCardMessage {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -539,131 +826,131 @@ func (c *DoNotDisturbClient) GetX(ctx context.Context, id int) *DoNotDisturb {
 }
 
 // Hooks returns the client hooks.
-func (c *DoNotDisturbClient) Hooks() []Hook {
-	return c.hooks.DoNotDisturb
+func (c *ContactCardMessageClient) Hooks() []Hook {
+	return c.hooks.ContactCardMessage
 }
 
 // Interceptors returns the client interceptors.
-func (c *DoNotDisturbClient) Interceptors() []Interceptor {
-	return c.inters.DoNotDisturb
+func (c *ContactCardMessageClient) Interceptors() []Interceptor {
+	return c.inters.ContactCardMessage
 }
 
-func (c *DoNotDisturbClient) mutate(ctx context.Context, m *DoNotDisturbMutation) (Value, error) {
+func (c *ContactCardMessageClient) mutate(ctx context.Context, m *ContactCardMessageMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&DoNotDisturbCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ContactCardMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&DoNotDisturbUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ContactCardMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&DoNotDisturbUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ContactCardMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&DoNotDisturbDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ContactCardMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown DoNotDisturb mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ContactCardMessage mutation op: %q", m.Op())
 	}
 }
 
-// FriendRelationshipClient is a client for the FriendRelationship schema.
-type FriendRelationshipClient struct {
+// ConversationClearClient is a client for the ConversationClear schema.
+type ConversationClearClient struct {
 	config
 }
 
-// NewFriendRelationshipClient returns a client for the FriendRelationship from the given config.
-func NewFriendRelationshipClient(c config) *FriendRelationshipClient {
-	return &FriendRelationshipClient{config: c}
+// NewConversationClearClient returns a client for the ConversationClear from the given config.
+func NewConversationClearClient(c config) *ConversationClearClient {
+	return &ConversationClearClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `friendrelationship.Hooks(f(g(h())))`.
-func (c *FriendRelationshipClient) Use(hooks ...Hook) {
-	c.hooks.FriendRelationship = append(c.hooks.FriendRelationship, hooks...)
+// A call to `Use(f, g, h)` equals to `conversationclear.Hooks(f(g(h())))`.
+func (c *ConversationClearClient) Use(hooks ...Hook) {
+	c.hooks.ConversationClear = append(c.hooks.ConversationClear, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `friendrelationship.Intercept(f(g(h())))`.
-func (c *FriendRelationshipClient) Intercept(interceptors ...Interceptor) {
-	c.inters.FriendRelationship = append(c.inters.FriendRelationship, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `conversationclear.Intercept(f(g(h())))`.
+func (c *ConversationClearClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ConversationClear = append(c.inters.ConversationClear, interceptors...)
 }
 
-// Create returns a builder for creating a FriendRelationship entity.
-func (c *FriendRelationshipClient) Create() *FriendRelationshipCreate {
-	mutation := newFriendRelationshipMutation(c.config, OpCreate)
-	return &FriendRelationshipCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ConversationClear entity.
+func (c *ConversationClearClient) Create() *ConversationClearCreate {
+	mutation := newConversationClearMutation(c.config, OpCreate)
+	return &ConversationClearCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of FriendRelationship entities.
-func (c *FriendRelationshipClient) CreateBulk(builders ...*FriendRelationshipCreate) *FriendRelationshipCreateBulk {
-	return &FriendRelationshipCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ConversationClear entities.
+func (c *ConversationClearClient) CreateBulk(builders ...*ConversationClearCreate) *ConversationClearCreateBulk {
+	return &ConversationClearCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *FriendRelationshipClient) MapCreateBulk(slice any, setFunc func(*FriendRelationshipCreate, int)) *FriendRelationshipCreateBulk {
+func (c *ConversationClearClient) MapCreateBulk(slice any, setFunc func(*ConversationClearCreate, int)) *ConversationClearCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &FriendRelationshipCreateBulk{err: fmt.Errorf("calling to FriendRelationshipClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ConversationClearCreateBulk{err: fmt.Errorf("calling to ConversationClearClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*FriendRelationshipCreate, rv.Len())
+	builders := make([]*ConversationClearCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &FriendRelationshipCreateBulk{config: c.config, builders: builders}
+	return &ConversationClearCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for FriendRelationship.
-func (c *FriendRelationshipClient) Update() *FriendRelationshipUpdate {
-	mutation := newFriendRelationshipMutation(c.config, OpUpdate)
-	return &FriendRelationshipUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ConversationClear.
+func (c *ConversationClearClient) Update() *ConversationClearUpdate {
+	mutation := newConversationClearMutation(c.config, OpUpdate)
+	return &ConversationClearUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *FriendRelationshipClient) UpdateOne(fr *FriendRelationship) *FriendRelationshipUpdateOne {
-	mutation := newFriendRelationshipMutation(c.config, OpUpdateOne, withFriendRelationship(fr))
-	return &FriendRelationshipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ConversationClearClient) UpdateOne(cc *ConversationClear) *ConversationClearUpdateOne {
+	mutation := newConversationClearMutation(c.config, OpUpdateOne, withConversationClear(cc))
+	return &ConversationClearUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *FriendRelationshipClient) UpdateOneID(id int) *FriendRelationshipUpdateOne {
-	mutation := newFriendRelationshipMutation(c.config, OpUpdateOne, withFriendRelationshipID(id))
-	return &FriendRelationshipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ConversationClearClient) UpdateOneID(id int) *ConversationClearUpdateOne {
+	mutation := newConversationClearMutation(c.config, OpUpdateOne, withConversationClearID(id))
+	return &ConversationClearUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for FriendRelationship.
-func (c *FriendRelationshipClient) Delete() *FriendRelationshipDelete {
-	mutation := newFriendRelationshipMutation(c.config, OpDelete)
-	return &FriendRelationshipDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ConversationClear.
+func (c *ConversationClearClient) Delete() *ConversationClearDelete {
+	mutation := newConversationClearMutation(c.config, OpDelete)
+	return &ConversationClearDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *FriendRelationshipClient) DeleteOne(fr *FriendRelationship) *FriendRelationshipDeleteOne {
-	return c.DeleteOneID(fr.ID)
+func (c *ConversationClearClient) DeleteOne(cc *ConversationClear) *ConversationClearDeleteOne {
+	return c.DeleteOneID(cc.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *FriendRelationshipClient) DeleteOneID(id int) *FriendRelationshipDeleteOne {
-	builder := c.Delete().Where(friendrelationship.ID(id))
+func (c *ConversationClearClient) DeleteOneID(id int) *ConversationClearDeleteOne {
+	builder := c.Delete().Where(conversationclear.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &FriendRelationshipDeleteOne{builder}
+	return &ConversationClearDeleteOne{builder}
 }
 
-// Query returns a query builder for FriendRelationship.
-func (c *FriendRelationshipClient) Query() *FriendRelationshipQuery {
-	return &FriendRelationshipQuery{
+// Query returns a query builder for ConversationClear.
+func (c *ConversationClearClient) Query() *ConversationClearQuery {
+	return &ConversationClearQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeFriendRelationship},
+		ctx:    &QueryContext{Type: TypeConversationClear},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a FriendRelationship entity by its id.
-func (c *FriendRelationshipClient) Get(ctx context.Context, id int) (*FriendRelationship, error) {
-	return c.Query().Where(friendrelationship.ID(id)).Only(ctx)
+// Get returns a ConversationClear entity by its id.
+func (c *ConversationClearClient) Get(ctx context.Context, id int) (*ConversationClear, error) {
+	return c.Query().Where(conversationclear.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *FriendRelationshipClient) GetX(ctx context.Context, id int) *FriendRelationship {
+func (c *ConversationClearClient) GetX(ctx context.Context, id int) *ConversationClear {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -672,131 +959,131 @@ func (c *FriendRelationshipClient) GetX(ctx context.Context, id int) *FriendRela
 }
 
 // Hooks returns the client hooks.
-func (c *FriendRelationshipClient) Hooks() []Hook {
-	return c.hooks.FriendRelationship
+func (c *ConversationClearClient) Hooks() []Hook {
+	return c.hooks.ConversationClear
 }
 
 // Interceptors returns the client interceptors.
-func (c *FriendRelationshipClient) Interceptors() []Interceptor {
-	return c.inters.FriendRelationship
+func (c *ConversationClearClient) Interceptors() []Interceptor {
+	return c.inters.ConversationClear
 }
 
-func (c *FriendRelationshipClient) mutate(ctx context.Context, m *FriendRelationshipMutation) (Value, error) {
+func (c *ConversationClearClient) mutate(ctx context.Context, m *ConversationClearMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&FriendRelationshipCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ConversationClearCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&FriendRelationshipUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ConversationClearUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&FriendRelationshipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ConversationClearUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&FriendRelationshipDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ConversationClearDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown FriendRelationship mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ConversationClear mutation op: %q", m.Op())
 	}
 }
 
-// FriendRequestClient is a client for the FriendRequest schema.
-type FriendRequestClient struct {
+// ConversationTimerClient is a client for the ConversationTimer schema.
+type ConversationTimerClient struct {
 	config
 }
 
-// NewFriendRequestClient returns a client for the FriendRequest from the given config.
-func NewFriendRequestClient(c config) *FriendRequestClient {
-	return &FriendRequestClient{config: c}
+// NewConversationTimerClient returns a client for the ConversationTimer from the given config.
+func NewConversationTimerClient(c config) *ConversationTimerClient {
+	return &ConversationTimerClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `friendrequest.Hooks(f(g(h())))`.
-func (c *FriendRequestClient) Use(hooks ...Hook) {
-	c.hooks.FriendRequest = append(c.hooks.FriendRequest, hooks...)
+// A call to `Use(f, g, h)` equals to `conversationtimer.Hooks(f(g(h())))`.
+func (c *ConversationTimerClient) Use(hooks ...Hook) {
+	c.hooks.ConversationTimer = append(c.hooks.ConversationTimer, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `friendrequest.Intercept(f(g(h())))`.
-func (c *FriendRequestClient) Intercept(interceptors ...Interceptor) {
-	c.inters.FriendRequest = append(c.inters.FriendRequest, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `conversationtimer.Intercept(f(g(h())))`.
+func (c *ConversationTimerClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ConversationTimer = append(c.inters.ConversationTimer, interceptors...)
 }
 
-// Create returns a builder for creating a FriendRequest entity.
-func (c *FriendRequestClient) Create() *FriendRequestCreate {
-	mutation := newFriendRequestMutation(c.config, OpCreate)
-	return &FriendRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ConversationTimer entity.
+func (c *ConversationTimerClient) Create() *ConversationTimerCreate {
+	mutation := newConversationTimerMutation(c.config, OpCreate)
+	return &ConversationTimerCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of FriendRequest entities.
-func (c *FriendRequestClient) CreateBulk(builders ...*FriendRequestCreate) *FriendRequestCreateBulk {
-	return &FriendRequestCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ConversationTimer entities.
+func (c *ConversationTimerClient) CreateBulk(builders ...*ConversationTimerCreate) *ConversationTimerCreateBulk {
+	return &ConversationTimerCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *FriendRequestClient) MapCreateBulk(slice any, setFunc func(*FriendRequestCreate, int)) *FriendRequestCreateBulk {
+func (c *ConversationTimerClient) MapCreateBulk(slice any, setFunc func(*ConversationTimerCreate, int)) *ConversationTimerCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &FriendRequestCreateBulk{err: fmt.Errorf("calling to FriendRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ConversationTimerCreateBulk{err: fmt.Errorf("calling to ConversationTimerClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*FriendRequestCreate, rv.Len())
+	builders := make([]*ConversationTimerCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &FriendRequestCreateBulk{config: c.config, builders: builders}
+	return &ConversationTimerCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for FriendRequest.
-func (c *FriendRequestClient) Update() *FriendRequestUpdate {
-	mutation := newFriendRequestMutation(c.config, OpUpdate)
-	return &FriendRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ConversationTimer.
+func (c *ConversationTimerClient) Update() *ConversationTimerUpdate {
+	mutation := newConversationTimerMutation(c.config, OpUpdate)
+	return &ConversationTimerUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *FriendRequestClient) UpdateOne(fr *FriendRequest) *FriendRequestUpdateOne {
-	mutation := newFriendRequestMutation(c.config, OpUpdateOne, withFriendRequest(fr))
-	return &FriendRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ConversationTimerClient) UpdateOne(ct *ConversationTimer) *ConversationTimerUpdateOne {
+	mutation := newConversationTimerMutation(c.config, OpUpdateOne, withConversationTimer(ct))
+	return &ConversationTimerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *FriendRequestClient) UpdateOneID(id int) *FriendRequestUpdateOne {
-	mutation := newFriendRequestMutation(c.config, OpUpdateOne, withFriendRequestID(id))
-	return &FriendRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ConversationTimerClient) UpdateOneID(id int) *ConversationTimerUpdateOne {
+	mutation := newConversationTimerMutation(c.config, OpUpdateOne, withConversationTimerID(id))
+	return &ConversationTimerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for FriendRequest.
-func (c *FriendRequestClient) Delete() *FriendRequestDelete {
-	mutation := newFriendRequestMutation(c.config, OpDelete)
-	return &FriendRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ConversationTimer.
+func (c *ConversationTimerClient) Delete() *ConversationTimerDelete {
+	mutation := newConversationTimerMutation(c.config, OpDelete)
+	return &ConversationTimerDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *FriendRequestClient) DeleteOne(fr *FriendRequest) *FriendRequestDeleteOne {
-	return c.DeleteOneID(fr.ID)
+func (c *ConversationTimerClient) DeleteOne(ct *ConversationTimer) *ConversationTimerDeleteOne {
+	return c.DeleteOneID(ct.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *FriendRequestClient) DeleteOneID(id int) *FriendRequestDeleteOne {
-	builder := c.Delete().Where(friendrequest.ID(id))
+func (c *ConversationTimerClient) DeleteOneID(id int) *ConversationTimerDeleteOne {
+	builder := c.Delete().Where(conversationtimer.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &FriendRequestDeleteOne{builder}
+	return &ConversationTimerDeleteOne{builder}
 }
 
-// Query returns a query builder for FriendRequest.
-func (c *FriendRequestClient) Query() *FriendRequestQuery {
-	return &FriendRequestQuery{
+// Query returns a query builder for ConversationTimer.
+func (c *ConversationTimerClient) Query() *ConversationTimerQuery {
+	return &ConversationTimerQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeFriendRequest},
+		ctx:    &QueryContext{Type: TypeConversationTimer},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a FriendRequest entity by its id.
-func (c *FriendRequestClient) Get(ctx context.Context, id int) (*FriendRequest, error) {
-	return c.Query().Where(friendrequest.ID(id)).Only(ctx)
+// Get returns a ConversationTimer entity by its id.
+func (c *ConversationTimerClient) Get(ctx context.Context, id int) (*ConversationTimer, error) {
+	return c.Query().Where(conversationtimer.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *FriendRequestClient) GetX(ctx context.Context, id int) *FriendRequest {
+func (c *ConversationTimerClient) GetX(ctx context.Context, id int) *ConversationTimer {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -805,38 +1092,836 @@ func (c *FriendRequestClient) GetX(ctx context.Context, id int) *FriendRequest {
 }
 
 // Hooks returns the client hooks.
-func (c *FriendRequestClient) Hooks() []Hook {
-	return c.hooks.FriendRequest
+func (c *ConversationTimerClient) Hooks() []Hook {
+	return c.hooks.ConversationTimer
 }
 
 // Interceptors returns the client interceptors.
-func (c *FriendRequestClient) Interceptors() []Interceptor {
-	return c.inters.FriendRequest
+func (c *ConversationTimerClient) Interceptors() []Interceptor {
+	return c.inters.ConversationTimer
 }
 
-func (c *FriendRequestClient) mutate(ctx context.Context, m *FriendRequestMutation) (Value, error) {
+func (c *ConversationTimerClient) mutate(ctx context.Context, m *ConversationTimerMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&FriendRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ConversationTimerCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&FriendRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ConversationTimerUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&FriendRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ConversationTimerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&FriendRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ConversationTimerDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown FriendRequest mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ConversationTimer mutation op: %q", m.Op())
 	}
 }
 
-// GroupClient is a client for the Group schema.
-type GroupClient struct {
+// DataMigrationClient is a client for the DataMigration schema.
+type DataMigrationClient struct {
 	config
 }
 
-// NewGroupClient returns a client for the Group from the given config.
-func NewGroupClient(c config) *GroupClient {
-	return &GroupClient{config: c}
+// NewDataMigrationClient returns a client for the DataMigration from the given config.
+func NewDataMigrationClient(c config) *DataMigrationClient {
+	return &DataMigrationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `datamigration.Hooks(f(g(h())))`.
+func (c *DataMigrationClient) Use(hooks ...Hook) {
+	c.hooks.DataMigration = append(c.hooks.DataMigration, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `datamigration.Intercept(f(g(h())))`.
+func (c *DataMigrationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DataMigration = append(c.inters.DataMigration, interceptors...)
+}
+
+// Create returns a builder for creating a DataMigration entity.
+func (c *DataMigrationClient) Create() *DataMigrationCreate {
+	mutation := newDataMigrationMutation(c.config, OpCreate)
+	return &DataMigrationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DataMigration entities.
+func (c *DataMigrationClient) CreateBulk(builders ...*DataMigrationCreate) *DataMigrationCreateBulk {
+	return &DataMigrationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DataMigrationClient) MapCreateBulk(slice any, setFunc func(*DataMigrationCreate, int)) *DataMigrationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DataMigrationCreateBulk{err: fmt.Errorf("calling to DataMigrationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DataMigrationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DataMigrationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DataMigration.
+func (c *DataMigrationClient) Update() *DataMigrationUpdate {
+	mutation := newDataMigrationMutation(c.config, OpUpdate)
+	return &DataMigrationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DataMigrationClient) UpdateOne(dm *DataMigration) *DataMigrationUpdateOne {
+	mutation := newDataMigrationMutation(c.config, OpUpdateOne, withDataMigration(dm))
+	return &DataMigrationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DataMigrationClient) UpdateOneID(id int) *DataMigrationUpdateOne {
+	mutation := newDataMigrationMutation(c.config, OpUpdateOne, withDataMigrationID(id))
+	return &DataMigrationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DataMigration.
+func (c *DataMigrationClient) Delete() *DataMigrationDelete {
+	mutation := newDataMigrationMutation(c.config, OpDelete)
+	return &DataMigrationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DataMigrationClient) DeleteOne(dm *DataMigration) *DataMigrationDeleteOne {
+	return c.DeleteOneID(dm.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DataMigrationClient) DeleteOneID(id int) *DataMigrationDeleteOne {
+	builder := c.Delete().Where(datamigration.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DataMigrationDeleteOne{builder}
+}
+
+// Query returns a query builder for DataMigration.
+func (c *DataMigrationClient) Query() *DataMigrationQuery {
+	return &DataMigrationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDataMigration},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DataMigration entity by its id.
+func (c *DataMigrationClient) Get(ctx context.Context, id int) (*DataMigration, error) {
+	return c.Query().Where(datamigration.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DataMigrationClient) GetX(ctx context.Context, id int) *DataMigration {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DataMigrationClient) Hooks() []Hook {
+	return c.hooks.DataMigration
+}
+
+// Interceptors returns the client interceptors.
+func (c *DataMigrationClient) Interceptors() []Interceptor {
+	return c.inters.DataMigration
+}
+
+func (c *DataMigrationClient) mutate(ctx context.Context, m *DataMigrationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DataMigrationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DataMigrationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DataMigrationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DataMigrationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DataMigration mutation op: %q", m.Op())
+	}
+}
+
+// DoNotDisturbClient is a client for the DoNotDisturb schema.
+type DoNotDisturbClient struct {
+	config
+}
+
+// NewDoNotDisturbClient returns a client for the DoNotDisturb from the given config.
+func NewDoNotDisturbClient(c config) *DoNotDisturbClient {
+	return &DoNotDisturbClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `donotdisturb.Hooks(f(g(h())))`.
+func (c *DoNotDisturbClient) Use(hooks ...Hook) {
+	c.hooks.DoNotDisturb = append(c.hooks.DoNotDisturb, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `donotdisturb.Intercept(f(g(h())))`.
+func (c *DoNotDisturbClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DoNotDisturb = append(c.inters.DoNotDisturb, interceptors...)
+}
+
+// Create returns a builder for creating a DoNotDisturb entity.
+func (c *DoNotDisturbClient) Create() *DoNotDisturbCreate {
+	mutation := newDoNotDisturbMutation(c.config, OpCreate)
+	return &DoNotDisturbCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DoNotDisturb entities.
+func (c *DoNotDisturbClient) CreateBulk(builders ...*DoNotDisturbCreate) *DoNotDisturbCreateBulk {
+	return &DoNotDisturbCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DoNotDisturbClient) MapCreateBulk(slice any, setFunc func(*DoNotDisturbCreate, int)) *DoNotDisturbCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DoNotDisturbCreateBulk{err: fmt.Errorf("calling to DoNotDisturbClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DoNotDisturbCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DoNotDisturbCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DoNotDisturb.
+func (c *DoNotDisturbClient) Update() *DoNotDisturbUpdate {
+	mutation := newDoNotDisturbMutation(c.config, OpUpdate)
+	return &DoNotDisturbUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DoNotDisturbClient) UpdateOne(dnd *DoNotDisturb) *DoNotDisturbUpdateOne {
+	mutation := newDoNotDisturbMutation(c.config, OpUpdateOne, withDoNotDisturb(dnd))
+	return &DoNotDisturbUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DoNotDisturbClient) UpdateOneID(id int) *DoNotDisturbUpdateOne {
+	mutation := newDoNotDisturbMutation(c.config, OpUpdateOne, withDoNotDisturbID(id))
+	return &DoNotDisturbUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DoNotDisturb.
+func (c *DoNotDisturbClient) Delete() *DoNotDisturbDelete {
+	mutation := newDoNotDisturbMutation(c.config, OpDelete)
+	return &DoNotDisturbDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DoNotDisturbClient) DeleteOne(dnd *DoNotDisturb) *DoNotDisturbDeleteOne {
+	return c.DeleteOneID(dnd.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DoNotDisturbClient) DeleteOneID(id int) *DoNotDisturbDeleteOne {
+	builder := c.Delete().Where(donotdisturb.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DoNotDisturbDeleteOne{builder}
+}
+
+// Query returns a query builder for DoNotDisturb.
+func (c *DoNotDisturbClient) Query() *DoNotDisturbQuery {
+	return &DoNotDisturbQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDoNotDisturb},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DoNotDisturb entity by its id.
+func (c *DoNotDisturbClient) Get(ctx context.Context, id int) (*DoNotDisturb, error) {
+	return c.Query().Where(donotdisturb.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DoNotDisturbClient) GetX(ctx context.Context, id int) *DoNotDisturb {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DoNotDisturbClient) Hooks() []Hook {
+	return c.hooks.DoNotDisturb
+}
+
+// Interceptors returns the client interceptors.
+func (c *DoNotDisturbClient) Interceptors() []Interceptor {
+	return c.inters.DoNotDisturb
+}
+
+func (c *DoNotDisturbClient) mutate(ctx context.Context, m *DoNotDisturbMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DoNotDisturbCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DoNotDisturbUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DoNotDisturbUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DoNotDisturbDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DoNotDisturb mutation op: %q", m.Op())
+	}
+}
+
+// ExportJobClient is a client for the ExportJob schema.
+type ExportJobClient struct {
+	config
+}
+
+// NewExportJobClient returns a client for the ExportJob from the given config.
+func NewExportJobClient(c config) *ExportJobClient {
+	return &ExportJobClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `exportjob.Hooks(f(g(h())))`.
+func (c *ExportJobClient) Use(hooks ...Hook) {
+	c.hooks.ExportJob = append(c.hooks.ExportJob, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `exportjob.Intercept(f(g(h())))`.
+func (c *ExportJobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ExportJob = append(c.inters.ExportJob, interceptors...)
+}
+
+// Create returns a builder for creating a ExportJob entity.
+func (c *ExportJobClient) Create() *ExportJobCreate {
+	mutation := newExportJobMutation(c.config, OpCreate)
+	return &ExportJobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ExportJob entities.
+func (c *ExportJobClient) CreateBulk(builders ...*ExportJobCreate) *ExportJobCreateBulk {
+	return &ExportJobCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ExportJobClient) MapCreateBulk(slice any, setFunc func(*ExportJobCreate, int)) *ExportJobCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ExportJobCreateBulk{err: fmt.Errorf("calling to ExportJobClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ExportJobCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ExportJobCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ExportJob.
+func (c *ExportJobClient) Update() *ExportJobUpdate {
+	mutation := newExportJobMutation(c.config, OpUpdate)
+	return &ExportJobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ExportJobClient) UpdateOne(ej *ExportJob) *ExportJobUpdateOne {
+	mutation := newExportJobMutation(c.config, OpUpdateOne, withExportJob(ej))
+	return &ExportJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ExportJobClient) UpdateOneID(id int) *ExportJobUpdateOne {
+	mutation := newExportJobMutation(c.config, OpUpdateOne, withExportJobID(id))
+	return &ExportJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ExportJob.
+func (c *ExportJobClient) Delete() *ExportJobDelete {
+	mutation := newExportJobMutation(c.config, OpDelete)
+	return &ExportJobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ExportJobClient) DeleteOne(ej *ExportJob) *ExportJobDeleteOne {
+	return c.DeleteOneID(ej.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ExportJobClient) DeleteOneID(id int) *ExportJobDeleteOne {
+	builder := c.Delete().Where(exportjob.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ExportJobDeleteOne{builder}
+}
+
+// Query returns a query builder for ExportJob.
+func (c *ExportJobClient) Query() *ExportJobQuery {
+	return &ExportJobQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeExportJob},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ExportJob entity by its id.
+func (c *ExportJobClient) Get(ctx context.Context, id int) (*ExportJob, error) {
+	return c.Query().Where(exportjob.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ExportJobClient) GetX(ctx context.Context, id int) *ExportJob {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ExportJobClient) Hooks() []Hook {
+	return c.hooks.ExportJob
+}
+
+// Interceptors returns the client interceptors.
+func (c *ExportJobClient) Interceptors() []Interceptor {
+	return c.inters.ExportJob
+}
+
+func (c *ExportJobClient) mutate(ctx context.Context, m *ExportJobMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ExportJobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ExportJobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ExportJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ExportJobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ExportJob mutation op: %q", m.Op())
+	}
+}
+
+// FileMessageClient is a client for the FileMessage schema.
+type FileMessageClient struct {
+	config
+}
+
+// NewFileMessageClient returns a client for the FileMessage from the given config.
+func NewFileMessageClient(c config) *FileMessageClient {
+	return &FileMessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `filemessage.Hooks(f(g(h())))`.
+func (c *FileMessageClient) Use(hooks ...Hook) {
+	c.hooks.FileMessage = append(c.hooks.FileMessage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `filemessage.Intercept(f(g(h())))`.
+func (c *FileMessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FileMessage = append(c.inters.FileMessage, interceptors...)
+}
+
+// Create returns a builder for creating a FileMessage entity.
+func (c *FileMessageClient) Create() *FileMessageCreate {
+	mutation := newFileMessageMutation(c.config, OpCreate)
+	return &FileMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FileMessage entities.
+func (c *FileMessageClient) CreateBulk(builders ...*FileMessageCreate) *FileMessageCreateBulk {
+	return &FileMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FileMessageClient) MapCreateBulk(slice any, setFunc func(*FileMessageCreate, int)) *FileMessageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FileMessageCreateBulk{err: fmt.Errorf("calling to FileMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FileMessageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FileMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FileMessage.
+func (c *FileMessageClient) Update() *FileMessageUpdate {
+	mutation := newFileMessageMutation(c.config, OpUpdate)
+	return &FileMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FileMessageClient) UpdateOne(fm *FileMessage) *FileMessageUpdateOne {
+	mutation := newFileMessageMutation(c.config, OpUpdateOne, withFileMessage(fm))
+	return &FileMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FileMessageClient) UpdateOneID(id int) *FileMessageUpdateOne {
+	mutation := newFileMessageMutation(c.config, OpUpdateOne, withFileMessageID(id))
+	return &FileMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FileMessage.
+func (c *FileMessageClient) Delete() *FileMessageDelete {
+	mutation := newFileMessageMutation(c.config, OpDelete)
+	return &FileMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FileMessageClient) DeleteOne(fm *FileMessage) *FileMessageDeleteOne {
+	return c.DeleteOneID(fm.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FileMessageClient) DeleteOneID(id int) *FileMessageDeleteOne {
+	builder := c.Delete().Where(filemessage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FileMessageDeleteOne{builder}
+}
+
+// Query returns a query builder for FileMessage.
+func (c *FileMessageClient) Query() *FileMessageQuery {
+	return &FileMessageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFileMessage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FileMessage entity by its id.
+func (c *FileMessageClient) Get(ctx context.Context, id int) (*FileMessage, error) {
+	return c.Query().Where(filemessage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FileMessageClient) GetX(ctx context.Context, id int) *FileMessage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *FileMessageClient) Hooks() []Hook {
+	return c.hooks.FileMessage
+}
+
+// Interceptors returns the client interceptors.
+func (c *FileMessageClient) Interceptors() []Interceptor {
+	return c.inters.FileMessage
+}
+
+func (c *FileMessageClient) mutate(ctx context.Context, m *FileMessageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FileMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FileMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FileMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FileMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FileMessage mutation op: %q", m.Op())
+	}
+}
+
+// FriendRelationshipClient is a client for the FriendRelationship schema.
+type FriendRelationshipClient struct {
+	config
+}
+
+// NewFriendRelationshipClient returns a client for the FriendRelationship from the given config.
+func NewFriendRelationshipClient(c config) *FriendRelationshipClient {
+	return &FriendRelationshipClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `friendrelationship.Hooks(f(g(h())))`.
+func (c *FriendRelationshipClient) Use(hooks ...Hook) {
+	c.hooks.FriendRelationship = append(c.hooks.FriendRelationship, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `friendrelationship.Intercept(f(g(h())))`.
+func (c *FriendRelationshipClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FriendRelationship = append(c.inters.FriendRelationship, interceptors...)
+}
+
+// Create returns a builder for creating a FriendRelationship entity.
+func (c *FriendRelationshipClient) Create() *FriendRelationshipCreate {
+	mutation := newFriendRelationshipMutation(c.config, OpCreate)
+	return &FriendRelationshipCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FriendRelationship entities.
+func (c *FriendRelationshipClient) CreateBulk(builders ...*FriendRelationshipCreate) *FriendRelationshipCreateBulk {
+	return &FriendRelationshipCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FriendRelationshipClient) MapCreateBulk(slice any, setFunc func(*FriendRelationshipCreate, int)) *FriendRelationshipCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FriendRelationshipCreateBulk{err: fmt.Errorf("calling to FriendRelationshipClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FriendRelationshipCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FriendRelationshipCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FriendRelationship.
+func (c *FriendRelationshipClient) Update() *FriendRelationshipUpdate {
+	mutation := newFriendRelationshipMutation(c.config, OpUpdate)
+	return &FriendRelationshipUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FriendRelationshipClient) UpdateOne(fr *FriendRelationship) *FriendRelationshipUpdateOne {
+	mutation := newFriendRelationshipMutation(c.config, OpUpdateOne, withFriendRelationship(fr))
+	return &FriendRelationshipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FriendRelationshipClient) UpdateOneID(id int) *FriendRelationshipUpdateOne {
+	mutation := newFriendRelationshipMutation(c.config, OpUpdateOne, withFriendRelationshipID(id))
+	return &FriendRelationshipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FriendRelationship.
+func (c *FriendRelationshipClient) Delete() *FriendRelationshipDelete {
+	mutation := newFriendRelationshipMutation(c.config, OpDelete)
+	return &FriendRelationshipDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FriendRelationshipClient) DeleteOne(fr *FriendRelationship) *FriendRelationshipDeleteOne {
+	return c.DeleteOneID(fr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FriendRelationshipClient) DeleteOneID(id int) *FriendRelationshipDeleteOne {
+	builder := c.Delete().Where(friendrelationship.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FriendRelationshipDeleteOne{builder}
+}
+
+// Query returns a query builder for FriendRelationship.
+func (c *FriendRelationshipClient) Query() *FriendRelationshipQuery {
+	return &FriendRelationshipQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFriendRelationship},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FriendRelationship entity by its id.
+func (c *FriendRelationshipClient) Get(ctx context.Context, id int) (*FriendRelationship, error) {
+	return c.Query().Where(friendrelationship.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FriendRelationshipClient) GetX(ctx context.Context, id int) *FriendRelationship {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *FriendRelationshipClient) Hooks() []Hook {
+	return c.hooks.FriendRelationship
+}
+
+// Interceptors returns the client interceptors.
+func (c *FriendRelationshipClient) Interceptors() []Interceptor {
+	return c.inters.FriendRelationship
+}
+
+func (c *FriendRelationshipClient) mutate(ctx context.Context, m *FriendRelationshipMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FriendRelationshipCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FriendRelationshipUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FriendRelationshipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FriendRelationshipDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FriendRelationship mutation op: %q", m.Op())
+	}
+}
+
+// FriendRequestClient is a client for the FriendRequest schema.
+type FriendRequestClient struct {
+	config
+}
+
+// NewFriendRequestClient returns a client for the FriendRequest from the given config.
+func NewFriendRequestClient(c config) *FriendRequestClient {
+	return &FriendRequestClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `friendrequest.Hooks(f(g(h())))`.
+func (c *FriendRequestClient) Use(hooks ...Hook) {
+	c.hooks.FriendRequest = append(c.hooks.FriendRequest, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `friendrequest.Intercept(f(g(h())))`.
+func (c *FriendRequestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FriendRequest = append(c.inters.FriendRequest, interceptors...)
+}
+
+// Create returns a builder for creating a FriendRequest entity.
+func (c *FriendRequestClient) Create() *FriendRequestCreate {
+	mutation := newFriendRequestMutation(c.config, OpCreate)
+	return &FriendRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FriendRequest entities.
+func (c *FriendRequestClient) CreateBulk(builders ...*FriendRequestCreate) *FriendRequestCreateBulk {
+	return &FriendRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FriendRequestClient) MapCreateBulk(slice any, setFunc func(*FriendRequestCreate, int)) *FriendRequestCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FriendRequestCreateBulk{err: fmt.Errorf("calling to FriendRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FriendRequestCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FriendRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FriendRequest.
+func (c *FriendRequestClient) Update() *FriendRequestUpdate {
+	mutation := newFriendRequestMutation(c.config, OpUpdate)
+	return &FriendRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FriendRequestClient) UpdateOne(fr *FriendRequest) *FriendRequestUpdateOne {
+	mutation := newFriendRequestMutation(c.config, OpUpdateOne, withFriendRequest(fr))
+	return &FriendRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FriendRequestClient) UpdateOneID(id int) *FriendRequestUpdateOne {
+	mutation := newFriendRequestMutation(c.config, OpUpdateOne, withFriendRequestID(id))
+	return &FriendRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FriendRequest.
+func (c *FriendRequestClient) Delete() *FriendRequestDelete {
+	mutation := newFriendRequestMutation(c.config, OpDelete)
+	return &FriendRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FriendRequestClient) DeleteOne(fr *FriendRequest) *FriendRequestDeleteOne {
+	return c.DeleteOneID(fr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FriendRequestClient) DeleteOneID(id int) *FriendRequestDeleteOne {
+	builder := c.Delete().Where(friendrequest.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FriendRequestDeleteOne{builder}
+}
+
+// Query returns a query builder for FriendRequest.
+func (c *FriendRequestClient) Query() *FriendRequestQuery {
+	return &FriendRequestQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFriendRequest},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FriendRequest entity by its id.
+func (c *FriendRequestClient) Get(ctx context.Context, id int) (*FriendRequest, error) {
+	return c.Query().Where(friendrequest.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FriendRequestClient) GetX(ctx context.Context, id int) *FriendRequest {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *FriendRequestClient) Hooks() []Hook {
+	return c.hooks.FriendRequest
+}
+
+// Interceptors returns the client interceptors.
+func (c *FriendRequestClient) Interceptors() []Interceptor {
+	return c.inters.FriendRequest
+}
+
+func (c *FriendRequestClient) mutate(ctx context.Context, m *FriendRequestMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FriendRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FriendRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FriendRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FriendRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FriendRequest mutation op: %q", m.Op())
+	}
+}
+
+// GroupClient is a client for the Group schema.
+type GroupClient struct {
+	config
+}
+
+// NewGroupClient returns a client for the Group from the given config.
+func NewGroupClient(c config) *GroupClient {
+	return &GroupClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
@@ -846,90 +1931,1287 @@ func (c *GroupClient) Use(hooks ...Hook) {
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `group.Intercept(f(g(h())))`.
-func (c *GroupClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Group = append(c.inters.Group, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `group.Intercept(f(g(h())))`.
+func (c *GroupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Group = append(c.inters.Group, interceptors...)
+}
+
+// Create returns a builder for creating a Group entity.
+func (c *GroupClient) Create() *GroupCreate {
+	mutation := newGroupMutation(c.config, OpCreate)
+	return &GroupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Group entities.
+func (c *GroupClient) CreateBulk(builders ...*GroupCreate) *GroupCreateBulk {
+	return &GroupCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GroupClient) MapCreateBulk(slice any, setFunc func(*GroupCreate, int)) *GroupCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GroupCreateBulk{err: fmt.Errorf("calling to GroupClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GroupCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GroupCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Group.
+func (c *GroupClient) Update() *GroupUpdate {
+	mutation := newGroupMutation(c.config, OpUpdate)
+	return &GroupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GroupClient) UpdateOne(gr *Group) *GroupUpdateOne {
+	mutation := newGroupMutation(c.config, OpUpdateOne, withGroup(gr))
+	return &GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GroupClient) UpdateOneID(id int) *GroupUpdateOne {
+	mutation := newGroupMutation(c.config, OpUpdateOne, withGroupID(id))
+	return &GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Group.
+func (c *GroupClient) Delete() *GroupDelete {
+	mutation := newGroupMutation(c.config, OpDelete)
+	return &GroupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GroupClient) DeleteOne(gr *Group) *GroupDeleteOne {
+	return c.DeleteOneID(gr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GroupClient) DeleteOneID(id int) *GroupDeleteOne {
+	builder := c.Delete().Where(group.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GroupDeleteOne{builder}
+}
+
+// Query returns a query builder for Group.
+func (c *GroupClient) Query() *GroupQuery {
+	return &GroupQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGroup},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Group entity by its id.
+func (c *GroupClient) Get(ctx context.Context, id int) (*Group, error) {
+	return c.Query().Where(group.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GroupClient) GetX(ctx context.Context, id int) *Group {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *GroupClient) Hooks() []Hook {
+	return c.hooks.Group
+}
+
+// Interceptors returns the client interceptors.
+func (c *GroupClient) Interceptors() []Interceptor {
+	return c.inters.Group
+}
+
+func (c *GroupClient) mutate(ctx context.Context, m *GroupMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GroupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GroupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GroupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Group mutation op: %q", m.Op())
+	}
+}
+
+// GroupChatRecordClient is a client for the GroupChatRecord schema.
+type GroupChatRecordClient struct {
+	config
+}
+
+// NewGroupChatRecordClient returns a client for the GroupChatRecord from the given config.
+func NewGroupChatRecordClient(c config) *GroupChatRecordClient {
+	return &GroupChatRecordClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `groupchatrecord.Hooks(f(g(h())))`.
+func (c *GroupChatRecordClient) Use(hooks ...Hook) {
+	c.hooks.GroupChatRecord = append(c.hooks.GroupChatRecord, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `groupchatrecord.Intercept(f(g(h())))`.
+func (c *GroupChatRecordClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GroupChatRecord = append(c.inters.GroupChatRecord, interceptors...)
+}
+
+// Create returns a builder for creating a GroupChatRecord entity.
+func (c *GroupChatRecordClient) Create() *GroupChatRecordCreate {
+	mutation := newGroupChatRecordMutation(c.config, OpCreate)
+	return &GroupChatRecordCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GroupChatRecord entities.
+func (c *GroupChatRecordClient) CreateBulk(builders ...*GroupChatRecordCreate) *GroupChatRecordCreateBulk {
+	return &GroupChatRecordCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GroupChatRecordClient) MapCreateBulk(slice any, setFunc func(*GroupChatRecordCreate, int)) *GroupChatRecordCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GroupChatRecordCreateBulk{err: fmt.Errorf("calling to GroupChatRecordClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GroupChatRecordCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GroupChatRecordCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GroupChatRecord.
+func (c *GroupChatRecordClient) Update() *GroupChatRecordUpdate {
+	mutation := newGroupChatRecordMutation(c.config, OpUpdate)
+	return &GroupChatRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GroupChatRecordClient) UpdateOne(gcr *GroupChatRecord) *GroupChatRecordUpdateOne {
+	mutation := newGroupChatRecordMutation(c.config, OpUpdateOne, withGroupChatRecord(gcr))
+	return &GroupChatRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GroupChatRecordClient) UpdateOneID(id int) *GroupChatRecordUpdateOne {
+	mutation := newGroupChatRecordMutation(c.config, OpUpdateOne, withGroupChatRecordID(id))
+	return &GroupChatRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GroupChatRecord.
+func (c *GroupChatRecordClient) Delete() *GroupChatRecordDelete {
+	mutation := newGroupChatRecordMutation(c.config, OpDelete)
+	return &GroupChatRecordDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GroupChatRecordClient) DeleteOne(gcr *GroupChatRecord) *GroupChatRecordDeleteOne {
+	return c.DeleteOneID(gcr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GroupChatRecordClient) DeleteOneID(id int) *GroupChatRecordDeleteOne {
+	builder := c.Delete().Where(groupchatrecord.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GroupChatRecordDeleteOne{builder}
+}
+
+// Query returns a query builder for GroupChatRecord.
+func (c *GroupChatRecordClient) Query() *GroupChatRecordQuery {
+	return &GroupChatRecordQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGroupChatRecord},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GroupChatRecord entity by its id.
+func (c *GroupChatRecordClient) Get(ctx context.Context, id int) (*GroupChatRecord, error) {
+	return c.Query().Where(groupchatrecord.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GroupChatRecordClient) GetX(ctx context.Context, id int) *GroupChatRecord {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *GroupChatRecordClient) Hooks() []Hook {
+	return c.hooks.GroupChatRecord
+}
+
+// Interceptors returns the client interceptors.
+func (c *GroupChatRecordClient) Interceptors() []Interceptor {
+	return c.inters.GroupChatRecord
+}
+
+func (c *GroupChatRecordClient) mutate(ctx context.Context, m *GroupChatRecordMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GroupChatRecordCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GroupChatRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GroupChatRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GroupChatRecordDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown GroupChatRecord mutation op: %q", m.Op())
+	}
+}
+
+// GroupWatermarkClient is a client for the GroupWatermark schema.
+type GroupWatermarkClient struct {
+	config
+}
+
+// NewGroupWatermarkClient returns a client for the GroupWatermark from the given config.
+func NewGroupWatermarkClient(c config) *GroupWatermarkClient {
+	return &GroupWatermarkClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `groupwatermark.Hooks(f(g(h())))`.
+func (c *GroupWatermarkClient) Use(hooks ...Hook) {
+	c.hooks.GroupWatermark = append(c.hooks.GroupWatermark, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `groupwatermark.Intercept(f(g(h())))`.
+func (c *GroupWatermarkClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GroupWatermark = append(c.inters.GroupWatermark, interceptors...)
+}
+
+// Create returns a builder for creating a GroupWatermark entity.
+func (c *GroupWatermarkClient) Create() *GroupWatermarkCreate {
+	mutation := newGroupWatermarkMutation(c.config, OpCreate)
+	return &GroupWatermarkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GroupWatermark entities.
+func (c *GroupWatermarkClient) CreateBulk(builders ...*GroupWatermarkCreate) *GroupWatermarkCreateBulk {
+	return &GroupWatermarkCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GroupWatermarkClient) MapCreateBulk(slice any, setFunc func(*GroupWatermarkCreate, int)) *GroupWatermarkCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GroupWatermarkCreateBulk{err: fmt.Errorf("calling to GroupWatermarkClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GroupWatermarkCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GroupWatermarkCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GroupWatermark.
+func (c *GroupWatermarkClient) Update() *GroupWatermarkUpdate {
+	mutation := newGroupWatermarkMutation(c.config, OpUpdate)
+	return &GroupWatermarkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GroupWatermarkClient) UpdateOne(gw *GroupWatermark) *GroupWatermarkUpdateOne {
+	mutation := newGroupWatermarkMutation(c.config, OpUpdateOne, withGroupWatermark(gw))
+	return &GroupWatermarkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GroupWatermarkClient) UpdateOneID(id int) *GroupWatermarkUpdateOne {
+	mutation := newGroupWatermarkMutation(c.config, OpUpdateOne, withGroupWatermarkID(id))
+	return &GroupWatermarkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GroupWatermark.
+func (c *GroupWatermarkClient) Delete() *GroupWatermarkDelete {
+	mutation := newGroupWatermarkMutation(c.config, OpDelete)
+	return &GroupWatermarkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GroupWatermarkClient) DeleteOne(gw *GroupWatermark) *GroupWatermarkDeleteOne {
+	return c.DeleteOneID(gw.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GroupWatermarkClient) DeleteOneID(id int) *GroupWatermarkDeleteOne {
+	builder := c.Delete().Where(groupwatermark.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GroupWatermarkDeleteOne{builder}
+}
+
+// Query returns a query builder for GroupWatermark.
+func (c *GroupWatermarkClient) Query() *GroupWatermarkQuery {
+	return &GroupWatermarkQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGroupWatermark},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GroupWatermark entity by its id.
+func (c *GroupWatermarkClient) Get(ctx context.Context, id int) (*GroupWatermark, error) {
+	return c.Query().Where(groupwatermark.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GroupWatermarkClient) GetX(ctx context.Context, id int) *GroupWatermark {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *GroupWatermarkClient) Hooks() []Hook {
+	return c.hooks.GroupWatermark
+}
+
+// Interceptors returns the client interceptors.
+func (c *GroupWatermarkClient) Interceptors() []Interceptor {
+	return c.inters.GroupWatermark
+}
+
+func (c *GroupWatermarkClient) mutate(ctx context.Context, m *GroupWatermarkMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GroupWatermarkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GroupWatermarkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GroupWatermarkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GroupWatermarkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown GroupWatermark mutation op: %q", m.Op())
+	}
+}
+
+// ImageMessageClient is a client for the ImageMessage schema.
+type ImageMessageClient struct {
+	config
+}
+
+// NewImageMessageClient returns a client for the ImageMessage from the given config.
+func NewImageMessageClient(c config) *ImageMessageClient {
+	return &ImageMessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `imagemessage.Hooks(f(g(h())))`.
+func (c *ImageMessageClient) Use(hooks ...Hook) {
+	c.hooks.ImageMessage = append(c.hooks.ImageMessage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `imagemessage.Intercept(f(g(h())))`.
+func (c *ImageMessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ImageMessage = append(c.inters.ImageMessage, interceptors...)
+}
+
+// Create returns a builder for creating a ImageMessage entity.
+func (c *ImageMessageClient) Create() *ImageMessageCreate {
+	mutation := newImageMessageMutation(c.config, OpCreate)
+	return &ImageMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ImageMessage entities.
+func (c *ImageMessageClient) CreateBulk(builders ...*ImageMessageCreate) *ImageMessageCreateBulk {
+	return &ImageMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ImageMessageClient) MapCreateBulk(slice any, setFunc func(*ImageMessageCreate, int)) *ImageMessageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ImageMessageCreateBulk{err: fmt.Errorf("calling to ImageMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ImageMessageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ImageMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ImageMessage.
+func (c *ImageMessageClient) Update() *ImageMessageUpdate {
+	mutation := newImageMessageMutation(c.config, OpUpdate)
+	return &ImageMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ImageMessageClient) UpdateOne(im *ImageMessage) *ImageMessageUpdateOne {
+	mutation := newImageMessageMutation(c.config, OpUpdateOne, withImageMessage(im))
+	return &ImageMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ImageMessageClient) UpdateOneID(id int) *ImageMessageUpdateOne {
+	mutation := newImageMessageMutation(c.config, OpUpdateOne, withImageMessageID(id))
+	return &ImageMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ImageMessage.
+func (c *ImageMessageClient) Delete() *ImageMessageDelete {
+	mutation := newImageMessageMutation(c.config, OpDelete)
+	return &ImageMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ImageMessageClient) DeleteOne(im *ImageMessage) *ImageMessageDeleteOne {
+	return c.DeleteOneID(im.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ImageMessageClient) DeleteOneID(id int) *ImageMessageDeleteOne {
+	builder := c.Delete().Where(imagemessage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ImageMessageDeleteOne{builder}
+}
+
+// Query returns a query builder for ImageMessage.
+func (c *ImageMessageClient) Query() *ImageMessageQuery {
+	return &ImageMessageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeImageMessage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ImageMessage entity by its id.
+func (c *ImageMessageClient) Get(ctx context.Context, id int) (*ImageMessage, error) {
+	return c.Query().Where(imagemessage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ImageMessageClient) GetX(ctx context.Context, id int) *ImageMessage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ImageMessageClient) Hooks() []Hook {
+	return c.hooks.ImageMessage
+}
+
+// Interceptors returns the client interceptors.
+func (c *ImageMessageClient) Interceptors() []Interceptor {
+	return c.inters.ImageMessage
+}
+
+func (c *ImageMessageClient) mutate(ctx context.Context, m *ImageMessageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ImageMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ImageMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ImageMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ImageMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ImageMessage mutation op: %q", m.Op())
+	}
+}
+
+// LocationMessageClient is a client for the LocationMessage schema.
+type LocationMessageClient struct {
+	config
+}
+
+// NewLocationMessageClient returns a client for the LocationMessage from the given config.
+func NewLocationMessageClient(c config) *LocationMessageClient {
+	return &LocationMessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `locationmessage.Hooks(f(g(h())))`.
+func (c *LocationMessageClient) Use(hooks ...Hook) {
+	c.hooks.LocationMessage = append(c.hooks.LocationMessage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `locationmessage.Intercept(f(g(h())))`.
+func (c *LocationMessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LocationMessage = append(c.inters.LocationMessage, interceptors...)
+}
+
+// Create returns a builder for creating a LocationMessage entity.
+func (c *LocationMessageClient) Create() *LocationMessageCreate {
+	mutation := newLocationMessageMutation(c.config, OpCreate)
+	return &LocationMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LocationMessage entities.
+func (c *LocationMessageClient) CreateBulk(builders ...*LocationMessageCreate) *LocationMessageCreateBulk {
+	return &LocationMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LocationMessageClient) MapCreateBulk(slice any, setFunc func(*LocationMessageCreate, int)) *LocationMessageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LocationMessageCreateBulk{err: fmt.Errorf("calling to LocationMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LocationMessageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LocationMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LocationMessage.
+func (c *LocationMessageClient) Update() *LocationMessageUpdate {
+	mutation := newLocationMessageMutation(c.config, OpUpdate)
+	return &LocationMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LocationMessageClient) UpdateOne(lm *LocationMessage) *LocationMessageUpdateOne {
+	mutation := newLocationMessageMutation(c.config, OpUpdateOne, withLocationMessage(lm))
+	return &LocationMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LocationMessageClient) UpdateOneID(id int) *LocationMessageUpdateOne {
+	mutation := newLocationMessageMutation(c.config, OpUpdateOne, withLocationMessageID(id))
+	return &LocationMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LocationMessage.
+func (c *LocationMessageClient) Delete() *LocationMessageDelete {
+	mutation := newLocationMessageMutation(c.config, OpDelete)
+	return &LocationMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LocationMessageClient) DeleteOne(lm *LocationMessage) *LocationMessageDeleteOne {
+	return c.DeleteOneID(lm.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LocationMessageClient) DeleteOneID(id int) *LocationMessageDeleteOne {
+	builder := c.Delete().Where(locationmessage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LocationMessageDeleteOne{builder}
+}
+
+// Query returns a query builder for LocationMessage.
+func (c *LocationMessageClient) Query() *LocationMessageQuery {
+	return &LocationMessageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLocationMessage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LocationMessage entity by its id.
+func (c *LocationMessageClient) Get(ctx context.Context, id int) (*LocationMessage, error) {
+	return c.Query().Where(locationmessage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LocationMessageClient) GetX(ctx context.Context, id int) *LocationMessage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LocationMessageClient) Hooks() []Hook {
+	return c.hooks.LocationMessage
+}
+
+// Interceptors returns the client interceptors.
+func (c *LocationMessageClient) Interceptors() []Interceptor {
+	return c.inters.LocationMessage
+}
+
+func (c *LocationMessageClient) mutate(ctx context.Context, m *LocationMessageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LocationMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LocationMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LocationMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LocationMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LocationMessage mutation op: %q", m.Op())
+	}
+}
+
+// MergedForwardMessageClient is a client for the MergedForwardMessage schema.
+type MergedForwardMessageClient struct {
+	config
+}
+
+// NewMergedForwardMessageClient returns a client for the MergedForwardMessage from the given config.
+func NewMergedForwardMessageClient(c config) *MergedForwardMessageClient {
+	return &MergedForwardMessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `mergedforwardmessage.Hooks(f(g(h())))`.
+func (c *MergedForwardMessageClient) Use(hooks ...Hook) {
+	c.hooks.MergedForwardMessage = append(c.hooks.MergedForwardMessage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `mergedforwardmessage.Intercept(f(g(h())))`.
+func (c *MergedForwardMessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MergedForwardMessage = append(c.inters.MergedForwardMessage, interceptors...)
+}
+
+// Create returns a builder for creating a MergedForwardMessage entity.
+func (c *MergedForwardMessageClient) Create() *MergedForwardMessageCreate {
+	mutation := newMergedForwardMessageMutation(c.config, OpCreate)
+	return &MergedForwardMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MergedForwardMessage entities.
+func (c *MergedForwardMessageClient) CreateBulk(builders ...*MergedForwardMessageCreate) *MergedForwardMessageCreateBulk {
+	return &MergedForwardMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MergedForwardMessageClient) MapCreateBulk(slice any, setFunc func(*MergedForwardMessageCreate, int)) *MergedForwardMessageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MergedForwardMessageCreateBulk{err: fmt.Errorf("calling to MergedForwardMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MergedForwardMessageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MergedForwardMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MergedForwardMessage.
+func (c *MergedForwardMessageClient) Update() *MergedForwardMessageUpdate {
+	mutation := newMergedForwardMessageMutation(c.config, OpUpdate)
+	return &MergedForwardMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MergedForwardMessageClient) UpdateOne(mfm *MergedForwardMessage) *MergedForwardMessageUpdateOne {
+	mutation := newMergedForwardMessageMutation(c.config, OpUpdateOne, withMergedForwardMessage(mfm))
+	return &MergedForwardMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MergedForwardMessageClient) UpdateOneID(id int) *MergedForwardMessageUpdateOne {
+	mutation := newMergedForwardMessageMutation(c.config, OpUpdateOne, withMergedForwardMessageID(id))
+	return &MergedForwardMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MergedForwardMessage.
+func (c *MergedForwardMessageClient) Delete() *MergedForwardMessageDelete {
+	mutation := newMergedForwardMessageMutation(c.config, OpDelete)
+	return &MergedForwardMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MergedForwardMessageClient) DeleteOne(mfm *MergedForwardMessage) *MergedForwardMessageDeleteOne {
+	return c.DeleteOneID(mfm.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MergedForwardMessageClient) DeleteOneID(id int) *MergedForwardMessageDeleteOne {
+	builder := c.Delete().Where(mergedforwardmessage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MergedForwardMessageDeleteOne{builder}
+}
+
+// Query returns a query builder for MergedForwardMessage.
+func (c *MergedForwardMessageClient) Query() *MergedForwardMessageQuery {
+	return &MergedForwardMessageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMergedForwardMessage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MergedForwardMessage entity by its id.
+func (c *MergedForwardMessageClient) Get(ctx context.Context, id int) (*MergedForwardMessage, error) {
+	return c.Query().Where(mergedforwardmessage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MergedForwardMessageClient) GetX(ctx context.Context, id int) *MergedForwardMessage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MergedForwardMessageClient) Hooks() []Hook {
+	return c.hooks.MergedForwardMessage
+}
+
+// Interceptors returns the client interceptors.
+func (c *MergedForwardMessageClient) Interceptors() []Interceptor {
+	return c.inters.MergedForwardMessage
+}
+
+func (c *MergedForwardMessageClient) mutate(ctx context.Context, m *MergedForwardMessageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MergedForwardMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MergedForwardMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MergedForwardMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MergedForwardMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MergedForwardMessage mutation op: %q", m.Op())
+	}
+}
+
+// MessageClient is a client for the Message schema.
+type MessageClient struct {
+	config
+}
+
+// NewMessageClient returns a client for the Message from the given config.
+func NewMessageClient(c config) *MessageClient {
+	return &MessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `message.Hooks(f(g(h())))`.
+func (c *MessageClient) Use(hooks ...Hook) {
+	c.hooks.Message = append(c.hooks.Message, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `message.Intercept(f(g(h())))`.
+func (c *MessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Message = append(c.inters.Message, interceptors...)
+}
+
+// Create returns a builder for creating a Message entity.
+func (c *MessageClient) Create() *MessageCreate {
+	mutation := newMessageMutation(c.config, OpCreate)
+	return &MessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Message entities.
+func (c *MessageClient) CreateBulk(builders ...*MessageCreate) *MessageCreateBulk {
+	return &MessageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MessageClient) MapCreateBulk(slice any, setFunc func(*MessageCreate, int)) *MessageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MessageCreateBulk{err: fmt.Errorf("calling to MessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MessageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Message.
+func (c *MessageClient) Update() *MessageUpdate {
+	mutation := newMessageMutation(c.config, OpUpdate)
+	return &MessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MessageClient) UpdateOne(m *Message) *MessageUpdateOne {
+	mutation := newMessageMutation(c.config, OpUpdateOne, withMessage(m))
+	return &MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MessageClient) UpdateOneID(id int) *MessageUpdateOne {
+	mutation := newMessageMutation(c.config, OpUpdateOne, withMessageID(id))
+	return &MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Message.
+func (c *MessageClient) Delete() *MessageDelete {
+	mutation := newMessageMutation(c.config, OpDelete)
+	return &MessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MessageClient) DeleteOne(m *Message) *MessageDeleteOne {
+	return c.DeleteOneID(m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MessageClient) DeleteOneID(id int) *MessageDeleteOne {
+	builder := c.Delete().Where(message.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MessageDeleteOne{builder}
+}
+
+// Query returns a query builder for Message.
+func (c *MessageClient) Query() *MessageQuery {
+	return &MessageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMessage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Message entity by its id.
+func (c *MessageClient) Get(ctx context.Context, id int) (*Message, error) {
+	return c.Query().Where(message.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MessageClient) GetX(ctx context.Context, id int) *Message {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MessageClient) Hooks() []Hook {
+	return c.hooks.Message
+}
+
+// Interceptors returns the client interceptors.
+func (c *MessageClient) Interceptors() []Interceptor {
+	return c.inters.Message
+}
+
+func (c *MessageClient) mutate(ctx context.Context, m *MessageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Message mutation op: %q", m.Op())
+	}
+}
+
+// MessageDeletionClient is a client for the MessageDeletion schema.
+type MessageDeletionClient struct {
+	config
+}
+
+// NewMessageDeletionClient returns a client for the MessageDeletion from the given config.
+func NewMessageDeletionClient(c config) *MessageDeletionClient {
+	return &MessageDeletionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `messagedeletion.Hooks(f(g(h())))`.
+func (c *MessageDeletionClient) Use(hooks ...Hook) {
+	c.hooks.MessageDeletion = append(c.hooks.MessageDeletion, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `messagedeletion.Intercept(f(g(h())))`.
+func (c *MessageDeletionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MessageDeletion = append(c.inters.MessageDeletion, interceptors...)
+}
+
+// Create returns a builder for creating a MessageDeletion entity.
+func (c *MessageDeletionClient) Create() *MessageDeletionCreate {
+	mutation := newMessageDeletionMutation(c.config, OpCreate)
+	return &MessageDeletionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MessageDeletion entities.
+func (c *MessageDeletionClient) CreateBulk(builders ...*MessageDeletionCreate) *MessageDeletionCreateBulk {
+	return &MessageDeletionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MessageDeletionClient) MapCreateBulk(slice any, setFunc func(*MessageDeletionCreate, int)) *MessageDeletionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MessageDeletionCreateBulk{err: fmt.Errorf("calling to MessageDeletionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MessageDeletionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MessageDeletionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MessageDeletion.
+func (c *MessageDeletionClient) Update() *MessageDeletionUpdate {
+	mutation := newMessageDeletionMutation(c.config, OpUpdate)
+	return &MessageDeletionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MessageDeletionClient) UpdateOne(md *MessageDeletion) *MessageDeletionUpdateOne {
+	mutation := newMessageDeletionMutation(c.config, OpUpdateOne, withMessageDeletion(md))
+	return &MessageDeletionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MessageDeletionClient) UpdateOneID(id int) *MessageDeletionUpdateOne {
+	mutation := newMessageDeletionMutation(c.config, OpUpdateOne, withMessageDeletionID(id))
+	return &MessageDeletionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MessageDeletion.
+func (c *MessageDeletionClient) Delete() *MessageDeletionDelete {
+	mutation := newMessageDeletionMutation(c.config, OpDelete)
+	return &MessageDeletionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MessageDeletionClient) DeleteOne(md *MessageDeletion) *MessageDeletionDeleteOne {
+	return c.DeleteOneID(md.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MessageDeletionClient) DeleteOneID(id int) *MessageDeletionDeleteOne {
+	builder := c.Delete().Where(messagedeletion.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MessageDeletionDeleteOne{builder}
+}
+
+// Query returns a query builder for MessageDeletion.
+func (c *MessageDeletionClient) Query() *MessageDeletionQuery {
+	return &MessageDeletionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMessageDeletion},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MessageDeletion entity by its id.
+func (c *MessageDeletionClient) Get(ctx context.Context, id int) (*MessageDeletion, error) {
+	return c.Query().Where(messagedeletion.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MessageDeletionClient) GetX(ctx context.Context, id int) *MessageDeletion {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MessageDeletionClient) Hooks() []Hook {
+	return c.hooks.MessageDeletion
+}
+
+// Interceptors returns the client interceptors.
+func (c *MessageDeletionClient) Interceptors() []Interceptor {
+	return c.inters.MessageDeletion
+}
+
+func (c *MessageDeletionClient) mutate(ctx context.Context, m *MessageDeletionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MessageDeletionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MessageDeletionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MessageDeletionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MessageDeletionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MessageDeletion mutation op: %q", m.Op())
+	}
+}
+
+// MessageForwardClient is a client for the MessageForward schema.
+type MessageForwardClient struct {
+	config
+}
+
+// NewMessageForwardClient returns a client for the MessageForward from the given config.
+func NewMessageForwardClient(c config) *MessageForwardClient {
+	return &MessageForwardClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `messageforward.Hooks(f(g(h())))`.
+func (c *MessageForwardClient) Use(hooks ...Hook) {
+	c.hooks.MessageForward = append(c.hooks.MessageForward, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `messageforward.Intercept(f(g(h())))`.
+func (c *MessageForwardClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MessageForward = append(c.inters.MessageForward, interceptors...)
+}
+
+// Create returns a builder for creating a MessageForward entity.
+func (c *MessageForwardClient) Create() *MessageForwardCreate {
+	mutation := newMessageForwardMutation(c.config, OpCreate)
+	return &MessageForwardCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MessageForward entities.
+func (c *MessageForwardClient) CreateBulk(builders ...*MessageForwardCreate) *MessageForwardCreateBulk {
+	return &MessageForwardCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MessageForwardClient) MapCreateBulk(slice any, setFunc func(*MessageForwardCreate, int)) *MessageForwardCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MessageForwardCreateBulk{err: fmt.Errorf("calling to MessageForwardClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MessageForwardCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MessageForwardCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MessageForward.
+func (c *MessageForwardClient) Update() *MessageForwardUpdate {
+	mutation := newMessageForwardMutation(c.config, OpUpdate)
+	return &MessageForwardUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MessageForwardClient) UpdateOne(mf *MessageForward) *MessageForwardUpdateOne {
+	mutation := newMessageForwardMutation(c.config, OpUpdateOne, withMessageForward(mf))
+	return &MessageForwardUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MessageForwardClient) UpdateOneID(id int) *MessageForwardUpdateOne {
+	mutation := newMessageForwardMutation(c.config, OpUpdateOne, withMessageForwardID(id))
+	return &MessageForwardUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MessageForward.
+func (c *MessageForwardClient) Delete() *MessageForwardDelete {
+	mutation := newMessageForwardMutation(c.config, OpDelete)
+	return &MessageForwardDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MessageForwardClient) DeleteOne(mf *MessageForward) *MessageForwardDeleteOne {
+	return c.DeleteOneID(mf.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MessageForwardClient) DeleteOneID(id int) *MessageForwardDeleteOne {
+	builder := c.Delete().Where(messageforward.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MessageForwardDeleteOne{builder}
+}
+
+// Query returns a query builder for MessageForward.
+func (c *MessageForwardClient) Query() *MessageForwardQuery {
+	return &MessageForwardQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMessageForward},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MessageForward entity by its id.
+func (c *MessageForwardClient) Get(ctx context.Context, id int) (*MessageForward, error) {
+	return c.Query().Where(messageforward.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MessageForwardClient) GetX(ctx context.Context, id int) *MessageForward {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MessageForwardClient) Hooks() []Hook {
+	return c.hooks.MessageForward
+}
+
+// Interceptors returns the client interceptors.
+func (c *MessageForwardClient) Interceptors() []Interceptor {
+	return c.inters.MessageForward
+}
+
+func (c *MessageForwardClient) mutate(ctx context.Context, m *MessageForwardMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MessageForwardCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MessageForwardUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MessageForwardUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MessageForwardDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MessageForward mutation op: %q", m.Op())
+	}
+}
+
+// MessageMentionClient is a client for the MessageMention schema.
+type MessageMentionClient struct {
+	config
+}
+
+// NewMessageMentionClient returns a client for the MessageMention from the given config.
+func NewMessageMentionClient(c config) *MessageMentionClient {
+	return &MessageMentionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `messagemention.Hooks(f(g(h())))`.
+func (c *MessageMentionClient) Use(hooks ...Hook) {
+	c.hooks.MessageMention = append(c.hooks.MessageMention, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `messagemention.Intercept(f(g(h())))`.
+func (c *MessageMentionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MessageMention = append(c.inters.MessageMention, interceptors...)
 }
 
-// Create returns a builder for creating a Group entity.
-func (c *GroupClient) Create() *GroupCreate {
-	mutation := newGroupMutation(c.config, OpCreate)
-	return &GroupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a MessageMention entity.
+func (c *MessageMentionClient) Create() *MessageMentionCreate {
+	mutation := newMessageMentionMutation(c.config, OpCreate)
+	return &MessageMentionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Group entities.
-func (c *GroupClient) CreateBulk(builders ...*GroupCreate) *GroupCreateBulk {
-	return &GroupCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of MessageMention entities.
+func (c *MessageMentionClient) CreateBulk(builders ...*MessageMentionCreate) *MessageMentionCreateBulk {
+	return &MessageMentionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *GroupClient) MapCreateBulk(slice any, setFunc func(*GroupCreate, int)) *GroupCreateBulk {
+func (c *MessageMentionClient) MapCreateBulk(slice any, setFunc func(*MessageMentionCreate, int)) *MessageMentionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &GroupCreateBulk{err: fmt.Errorf("calling to GroupClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &MessageMentionCreateBulk{err: fmt.Errorf("calling to MessageMentionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*GroupCreate, rv.Len())
+	builders := make([]*MessageMentionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &GroupCreateBulk{config: c.config, builders: builders}
+	return &MessageMentionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Group.
-func (c *GroupClient) Update() *GroupUpdate {
-	mutation := newGroupMutation(c.config, OpUpdate)
-	return &GroupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for MessageMention.
+func (c *MessageMentionClient) Update() *MessageMentionUpdate {
+	mutation := newMessageMentionMutation(c.config, OpUpdate)
+	return &MessageMentionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *GroupClient) UpdateOne(gr *Group) *GroupUpdateOne {
-	mutation := newGroupMutation(c.config, OpUpdateOne, withGroup(gr))
-	return &GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *MessageMentionClient) UpdateOne(mm *MessageMention) *MessageMentionUpdateOne {
+	mutation := newMessageMentionMutation(c.config, OpUpdateOne, withMessageMention(mm))
+	return &MessageMentionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *GroupClient) UpdateOneID(id int) *GroupUpdateOne {
-	mutation := newGroupMutation(c.config, OpUpdateOne, withGroupID(id))
-	return &GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *MessageMentionClient) UpdateOneID(id int) *MessageMentionUpdateOne {
+	mutation := newMessageMentionMutation(c.config, OpUpdateOne, withMessageMentionID(id))
+	return &MessageMentionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Group.
-func (c *GroupClient) Delete() *GroupDelete {
-	mutation := newGroupMutation(c.config, OpDelete)
-	return &GroupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for MessageMention.
+func (c *MessageMentionClient) Delete() *MessageMentionDelete {
+	mutation := newMessageMentionMutation(c.config, OpDelete)
+	return &MessageMentionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *GroupClient) DeleteOne(gr *Group) *GroupDeleteOne {
-	return c.DeleteOneID(gr.ID)
+func (c *MessageMentionClient) DeleteOne(mm *MessageMention) *MessageMentionDeleteOne {
+	return c.DeleteOneID(mm.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *GroupClient) DeleteOneID(id int) *GroupDeleteOne {
-	builder := c.Delete().Where(group.ID(id))
+func (c *MessageMentionClient) DeleteOneID(id int) *MessageMentionDeleteOne {
+	builder := c.Delete().Where(messagemention.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &GroupDeleteOne{builder}
+	return &MessageMentionDeleteOne{builder}
 }
 
-// Query returns a query builder for Group.
-func (c *GroupClient) Query() *GroupQuery {
-	return &GroupQuery{
+// Query returns a query builder for MessageMention.
+func (c *MessageMentionClient) Query() *MessageMentionQuery {
+	return &MessageMentionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeGroup},
+		ctx:    &QueryContext{Type: TypeMessageMention},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Group entity by its id.
-func (c *GroupClient) Get(ctx context.Context, id int) (*Group, error) {
-	return c.Query().Where(group.ID(id)).Only(ctx)
+// Get returns a MessageMention entity by its id.
+func (c *MessageMentionClient) Get(ctx context.Context, id int) (*MessageMention, error) {
+	return c.Query().Where(messagemention.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *GroupClient) GetX(ctx context.Context, id int) *Group {
+func (c *MessageMentionClient) GetX(ctx context.Context, id int) *MessageMention {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -938,131 +3220,131 @@ func (c *GroupClient) GetX(ctx context.Context, id int) *Group {
 }
 
 // Hooks returns the client hooks.
-func (c *GroupClient) Hooks() []Hook {
-	return c.hooks.Group
+func (c *MessageMentionClient) Hooks() []Hook {
+	return c.hooks.MessageMention
 }
 
 // Interceptors returns the client interceptors.
-func (c *GroupClient) Interceptors() []Interceptor {
-	return c.inters.Group
+func (c *MessageMentionClient) Interceptors() []Interceptor {
+	return c.inters.MessageMention
 }
 
-func (c *GroupClient) mutate(ctx context.Context, m *GroupMutation) (Value, error) {
+func (c *MessageMentionClient) mutate(ctx context.Context, m *MessageMentionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&GroupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MessageMentionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&GroupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MessageMentionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MessageMentionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&GroupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&MessageMentionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Group mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown MessageMention mutation op: %q", m.Op())
 	}
 }
 
-// GroupChatRecordClient is a client for the GroupChatRecord schema.
-type GroupChatRecordClient struct {
+// MessageOutboxClient is a client for the MessageOutbox schema.
+type MessageOutboxClient struct {
 	config
 }
 
-// NewGroupChatRecordClient returns a client for the GroupChatRecord from the given config.
-func NewGroupChatRecordClient(c config) *GroupChatRecordClient {
-	return &GroupChatRecordClient{config: c}
+// NewMessageOutboxClient returns a client for the MessageOutbox from the given config.
+func NewMessageOutboxClient(c config) *MessageOutboxClient {
+	return &MessageOutboxClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `groupchatrecord.Hooks(f(g(h())))`.
-func (c *GroupChatRecordClient) Use(hooks ...Hook) {
-	c.hooks.GroupChatRecord = append(c.hooks.GroupChatRecord, hooks...)
+// A call to `Use(f, g, h)` equals to `messageoutbox.Hooks(f(g(h())))`.
+func (c *MessageOutboxClient) Use(hooks ...Hook) {
+	c.hooks.MessageOutbox = append(c.hooks.MessageOutbox, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `groupchatrecord.Intercept(f(g(h())))`.
-func (c *GroupChatRecordClient) Intercept(interceptors ...Interceptor) {
-	c.inters.GroupChatRecord = append(c.inters.GroupChatRecord, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `messageoutbox.Intercept(f(g(h())))`.
+func (c *MessageOutboxClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MessageOutbox = append(c.inters.MessageOutbox, interceptors...)
 }
 
-// Create returns a builder for creating a GroupChatRecord entity.
-func (c *GroupChatRecordClient) Create() *GroupChatRecordCreate {
-	mutation := newGroupChatRecordMutation(c.config, OpCreate)
-	return &GroupChatRecordCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a MessageOutbox entity.
+func (c *MessageOutboxClient) Create() *MessageOutboxCreate {
+	mutation := newMessageOutboxMutation(c.config, OpCreate)
+	return &MessageOutboxCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of GroupChatRecord entities.
-func (c *GroupChatRecordClient) CreateBulk(builders ...*GroupChatRecordCreate) *GroupChatRecordCreateBulk {
-	return &GroupChatRecordCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of MessageOutbox entities.
+func (c *MessageOutboxClient) CreateBulk(builders ...*MessageOutboxCreate) *MessageOutboxCreateBulk {
+	return &MessageOutboxCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *GroupChatRecordClient) MapCreateBulk(slice any, setFunc func(*GroupChatRecordCreate, int)) *GroupChatRecordCreateBulk {
+func (c *MessageOutboxClient) MapCreateBulk(slice any, setFunc func(*MessageOutboxCreate, int)) *MessageOutboxCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &GroupChatRecordCreateBulk{err: fmt.Errorf("calling to GroupChatRecordClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &MessageOutboxCreateBulk{err: fmt.Errorf("calling to MessageOutboxClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*GroupChatRecordCreate, rv.Len())
+	builders := make([]*MessageOutboxCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &GroupChatRecordCreateBulk{config: c.config, builders: builders}
+	return &MessageOutboxCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for GroupChatRecord.
-func (c *GroupChatRecordClient) Update() *GroupChatRecordUpdate {
-	mutation := newGroupChatRecordMutation(c.config, OpUpdate)
-	return &GroupChatRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for MessageOutbox.
+func (c *MessageOutboxClient) Update() *MessageOutboxUpdate {
+	mutation := newMessageOutboxMutation(c.config, OpUpdate)
+	return &MessageOutboxUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *GroupChatRecordClient) UpdateOne(gcr *GroupChatRecord) *GroupChatRecordUpdateOne {
-	mutation := newGroupChatRecordMutation(c.config, OpUpdateOne, withGroupChatRecord(gcr))
-	return &GroupChatRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *MessageOutboxClient) UpdateOne(mo *MessageOutbox) *MessageOutboxUpdateOne {
+	mutation := newMessageOutboxMutation(c.config, OpUpdateOne, withMessageOutbox(mo))
+	return &MessageOutboxUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *GroupChatRecordClient) UpdateOneID(id int) *GroupChatRecordUpdateOne {
-	mutation := newGroupChatRecordMutation(c.config, OpUpdateOne, withGroupChatRecordID(id))
-	return &GroupChatRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *MessageOutboxClient) UpdateOneID(id int) *MessageOutboxUpdateOne {
+	mutation := newMessageOutboxMutation(c.config, OpUpdateOne, withMessageOutboxID(id))
+	return &MessageOutboxUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for GroupChatRecord.
-func (c *GroupChatRecordClient) Delete() *GroupChatRecordDelete {
-	mutation := newGroupChatRecordMutation(c.config, OpDelete)
-	return &GroupChatRecordDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for MessageOutbox.
+func (c *MessageOutboxClient) Delete() *MessageOutboxDelete {
+	mutation := newMessageOutboxMutation(c.config, OpDelete)
+	return &MessageOutboxDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *GroupChatRecordClient) DeleteOne(gcr *GroupChatRecord) *GroupChatRecordDeleteOne {
-	return c.DeleteOneID(gcr.ID)
+func (c *MessageOutboxClient) DeleteOne(mo *MessageOutbox) *MessageOutboxDeleteOne {
+	return c.DeleteOneID(mo.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *GroupChatRecordClient) DeleteOneID(id int) *GroupChatRecordDeleteOne {
-	builder := c.Delete().Where(groupchatrecord.ID(id))
+func (c *MessageOutboxClient) DeleteOneID(id int) *MessageOutboxDeleteOne {
+	builder := c.Delete().Where(messageoutbox.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &GroupChatRecordDeleteOne{builder}
+	return &MessageOutboxDeleteOne{builder}
 }
 
-// Query returns a query builder for GroupChatRecord.
-func (c *GroupChatRecordClient) Query() *GroupChatRecordQuery {
-	return &GroupChatRecordQuery{
+// Query returns a query builder for MessageOutbox.
+func (c *MessageOutboxClient) Query() *MessageOutboxQuery {
+	return &MessageOutboxQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeGroupChatRecord},
+		ctx:    &QueryContext{Type: TypeMessageOutbox},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a GroupChatRecord entity by its id.
-func (c *GroupChatRecordClient) Get(ctx context.Context, id int) (*GroupChatRecord, error) {
-	return c.Query().Where(groupchatrecord.ID(id)).Only(ctx)
+// Get returns a MessageOutbox entity by its id.
+func (c *MessageOutboxClient) Get(ctx context.Context, id int) (*MessageOutbox, error) {
+	return c.Query().Where(messageoutbox.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *GroupChatRecordClient) GetX(ctx context.Context, id int) *GroupChatRecord {
+func (c *MessageOutboxClient) GetX(ctx context.Context, id int) *MessageOutbox {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1071,131 +3353,264 @@ func (c *GroupChatRecordClient) GetX(ctx context.Context, id int) *GroupChatReco
 }
 
 // Hooks returns the client hooks.
-func (c *GroupChatRecordClient) Hooks() []Hook {
-	return c.hooks.GroupChatRecord
+func (c *MessageOutboxClient) Hooks() []Hook {
+	return c.hooks.MessageOutbox
 }
 
 // Interceptors returns the client interceptors.
-func (c *GroupChatRecordClient) Interceptors() []Interceptor {
-	return c.inters.GroupChatRecord
+func (c *MessageOutboxClient) Interceptors() []Interceptor {
+	return c.inters.MessageOutbox
 }
 
-func (c *GroupChatRecordClient) mutate(ctx context.Context, m *GroupChatRecordMutation) (Value, error) {
+func (c *MessageOutboxClient) mutate(ctx context.Context, m *MessageOutboxMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&GroupChatRecordCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MessageOutboxCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&GroupChatRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MessageOutboxUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&GroupChatRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MessageOutboxUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&GroupChatRecordDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&MessageOutboxDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown GroupChatRecord mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown MessageOutbox mutation op: %q", m.Op())
 	}
 }
 
-// ImageMessageClient is a client for the ImageMessage schema.
-type ImageMessageClient struct {
+// MessageReactionClient is a client for the MessageReaction schema.
+type MessageReactionClient struct {
 	config
 }
 
-// NewImageMessageClient returns a client for the ImageMessage from the given config.
-func NewImageMessageClient(c config) *ImageMessageClient {
-	return &ImageMessageClient{config: c}
+// NewMessageReactionClient returns a client for the MessageReaction from the given config.
+func NewMessageReactionClient(c config) *MessageReactionClient {
+	return &MessageReactionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `imagemessage.Hooks(f(g(h())))`.
-func (c *ImageMessageClient) Use(hooks ...Hook) {
-	c.hooks.ImageMessage = append(c.hooks.ImageMessage, hooks...)
+// A call to `Use(f, g, h)` equals to `messagereaction.Hooks(f(g(h())))`.
+func (c *MessageReactionClient) Use(hooks ...Hook) {
+	c.hooks.MessageReaction = append(c.hooks.MessageReaction, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `imagemessage.Intercept(f(g(h())))`.
-func (c *ImageMessageClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ImageMessage = append(c.inters.ImageMessage, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `messagereaction.Intercept(f(g(h())))`.
+func (c *MessageReactionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MessageReaction = append(c.inters.MessageReaction, interceptors...)
 }
 
-// Create returns a builder for creating a ImageMessage entity.
-func (c *ImageMessageClient) Create() *ImageMessageCreate {
-	mutation := newImageMessageMutation(c.config, OpCreate)
-	return &ImageMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a MessageReaction entity.
+func (c *MessageReactionClient) Create() *MessageReactionCreate {
+	mutation := newMessageReactionMutation(c.config, OpCreate)
+	return &MessageReactionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ImageMessage entities.
-func (c *ImageMessageClient) CreateBulk(builders ...*ImageMessageCreate) *ImageMessageCreateBulk {
-	return &ImageMessageCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of MessageReaction entities.
+func (c *MessageReactionClient) CreateBulk(builders ...*MessageReactionCreate) *MessageReactionCreateBulk {
+	return &MessageReactionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ImageMessageClient) MapCreateBulk(slice any, setFunc func(*ImageMessageCreate, int)) *ImageMessageCreateBulk {
+func (c *MessageReactionClient) MapCreateBulk(slice any, setFunc func(*MessageReactionCreate, int)) *MessageReactionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ImageMessageCreateBulk{err: fmt.Errorf("calling to ImageMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &MessageReactionCreateBulk{err: fmt.Errorf("calling to MessageReactionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ImageMessageCreate, rv.Len())
+	builders := make([]*MessageReactionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ImageMessageCreateBulk{config: c.config, builders: builders}
+	return &MessageReactionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ImageMessage.
-func (c *ImageMessageClient) Update() *ImageMessageUpdate {
-	mutation := newImageMessageMutation(c.config, OpUpdate)
-	return &ImageMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for MessageReaction.
+func (c *MessageReactionClient) Update() *MessageReactionUpdate {
+	mutation := newMessageReactionMutation(c.config, OpUpdate)
+	return &MessageReactionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ImageMessageClient) UpdateOne(im *ImageMessage) *ImageMessageUpdateOne {
-	mutation := newImageMessageMutation(c.config, OpUpdateOne, withImageMessage(im))
-	return &ImageMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *MessageReactionClient) UpdateOne(mr *MessageReaction) *MessageReactionUpdateOne {
+	mutation := newMessageReactionMutation(c.config, OpUpdateOne, withMessageReaction(mr))
+	return &MessageReactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MessageReactionClient) UpdateOneID(id int) *MessageReactionUpdateOne {
+	mutation := newMessageReactionMutation(c.config, OpUpdateOne, withMessageReactionID(id))
+	return &MessageReactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MessageReaction.
+func (c *MessageReactionClient) Delete() *MessageReactionDelete {
+	mutation := newMessageReactionMutation(c.config, OpDelete)
+	return &MessageReactionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MessageReactionClient) DeleteOne(mr *MessageReaction) *MessageReactionDeleteOne {
+	return c.DeleteOneID(mr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MessageReactionClient) DeleteOneID(id int) *MessageReactionDeleteOne {
+	builder := c.Delete().Where(messagereaction.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MessageReactionDeleteOne{builder}
+}
+
+// Query returns a query builder for MessageReaction.
+func (c *MessageReactionClient) Query() *MessageReactionQuery {
+	return &MessageReactionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMessageReaction},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MessageReaction entity by its id.
+func (c *MessageReactionClient) Get(ctx context.Context, id int) (*MessageReaction, error) {
+	return c.Query().Where(messagereaction.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MessageReactionClient) GetX(ctx context.Context, id int) *MessageReaction {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MessageReactionClient) Hooks() []Hook {
+	return c.hooks.MessageReaction
+}
+
+// Interceptors returns the client interceptors.
+func (c *MessageReactionClient) Interceptors() []Interceptor {
+	return c.inters.MessageReaction
+}
+
+func (c *MessageReactionClient) mutate(ctx context.Context, m *MessageReactionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MessageReactionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MessageReactionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MessageReactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MessageReactionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MessageReaction mutation op: %q", m.Op())
+	}
+}
+
+// MessageStatusClient is a client for the MessageStatus schema.
+type MessageStatusClient struct {
+	config
+}
+
+// NewMessageStatusClient returns a client for the MessageStatus from the given config.
+func NewMessageStatusClient(c config) *MessageStatusClient {
+	return &MessageStatusClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `messagestatus.Hooks(f(g(h())))`.
+func (c *MessageStatusClient) Use(hooks ...Hook) {
+	c.hooks.MessageStatus = append(c.hooks.MessageStatus, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `messagestatus.Intercept(f(g(h())))`.
+func (c *MessageStatusClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MessageStatus = append(c.inters.MessageStatus, interceptors...)
+}
+
+// Create returns a builder for creating a MessageStatus entity.
+func (c *MessageStatusClient) Create() *MessageStatusCreate {
+	mutation := newMessageStatusMutation(c.config, OpCreate)
+	return &MessageStatusCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MessageStatus entities.
+func (c *MessageStatusClient) CreateBulk(builders ...*MessageStatusCreate) *MessageStatusCreateBulk {
+	return &MessageStatusCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MessageStatusClient) MapCreateBulk(slice any, setFunc func(*MessageStatusCreate, int)) *MessageStatusCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MessageStatusCreateBulk{err: fmt.Errorf("calling to MessageStatusClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MessageStatusCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MessageStatusCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MessageStatus.
+func (c *MessageStatusClient) Update() *MessageStatusUpdate {
+	mutation := newMessageStatusMutation(c.config, OpUpdate)
+	return &MessageStatusUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MessageStatusClient) UpdateOne(ms *MessageStatus) *MessageStatusUpdateOne {
+	mutation := newMessageStatusMutation(c.config, OpUpdateOne, withMessageStatus(ms))
+	return &MessageStatusUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ImageMessageClient) UpdateOneID(id int) *ImageMessageUpdateOne {
-	mutation := newImageMessageMutation(c.config, OpUpdateOne, withImageMessageID(id))
-	return &ImageMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *MessageStatusClient) UpdateOneID(id int) *MessageStatusUpdateOne {
+	mutation := newMessageStatusMutation(c.config, OpUpdateOne, withMessageStatusID(id))
+	return &MessageStatusUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ImageMessage.
-func (c *ImageMessageClient) Delete() *ImageMessageDelete {
-	mutation := newImageMessageMutation(c.config, OpDelete)
-	return &ImageMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for MessageStatus.
+func (c *MessageStatusClient) Delete() *MessageStatusDelete {
+	mutation := newMessageStatusMutation(c.config, OpDelete)
+	return &MessageStatusDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ImageMessageClient) DeleteOne(im *ImageMessage) *ImageMessageDeleteOne {
-	return c.DeleteOneID(im.ID)
+func (c *MessageStatusClient) DeleteOne(ms *MessageStatus) *MessageStatusDeleteOne {
+	return c.DeleteOneID(ms.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ImageMessageClient) DeleteOneID(id int) *ImageMessageDeleteOne {
-	builder := c.Delete().Where(imagemessage.ID(id))
+func (c *MessageStatusClient) DeleteOneID(id int) *MessageStatusDeleteOne {
+	builder := c.Delete().Where(messagestatus.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ImageMessageDeleteOne{builder}
+	return &MessageStatusDeleteOne{builder}
 }
 
-// Query returns a query builder for ImageMessage.
-func (c *ImageMessageClient) Query() *ImageMessageQuery {
-	return &ImageMessageQuery{
+// Query returns a query builder for MessageStatus.
+func (c *MessageStatusClient) Query() *MessageStatusQuery {
+	return &MessageStatusQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeImageMessage},
+		ctx:    &QueryContext{Type: TypeMessageStatus},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ImageMessage entity by its id.
-func (c *ImageMessageClient) Get(ctx context.Context, id int) (*ImageMessage, error) {
-	return c.Query().Where(imagemessage.ID(id)).Only(ctx)
+// Get returns a MessageStatus entity by its id.
+func (c *MessageStatusClient) Get(ctx context.Context, id int) (*MessageStatus, error) {
+	return c.Query().Where(messagestatus.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ImageMessageClient) GetX(ctx context.Context, id int) *ImageMessage {
+func (c *MessageStatusClient) GetX(ctx context.Context, id int) *MessageStatus {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1204,131 +3619,131 @@ func (c *ImageMessageClient) GetX(ctx context.Context, id int) *ImageMessage {
 }
 
 // Hooks returns the client hooks.
-func (c *ImageMessageClient) Hooks() []Hook {
-	return c.hooks.ImageMessage
+func (c *MessageStatusClient) Hooks() []Hook {
+	return c.hooks.MessageStatus
 }
 
 // Interceptors returns the client interceptors.
-func (c *ImageMessageClient) Interceptors() []Interceptor {
-	return c.inters.ImageMessage
+func (c *MessageStatusClient) Interceptors() []Interceptor {
+	return c.inters.MessageStatus
 }
 
-func (c *ImageMessageClient) mutate(ctx context.Context, m *ImageMessageMutation) (Value, error) {
+func (c *MessageStatusClient) mutate(ctx context.Context, m *MessageStatusMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ImageMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MessageStatusCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ImageMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MessageStatusUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ImageMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MessageStatusUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ImageMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&MessageStatusDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ImageMessage mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown MessageStatus mutation op: %q", m.Op())
 	}
 }
 
-// MessageClient is a client for the Message schema.
-type MessageClient struct {
+// RetentionJobClient is a client for the RetentionJob schema.
+type RetentionJobClient struct {
 	config
 }
 
-// NewMessageClient returns a client for the Message from the given config.
-func NewMessageClient(c config) *MessageClient {
-	return &MessageClient{config: c}
+// NewRetentionJobClient returns a client for the RetentionJob from the given config.
+func NewRetentionJobClient(c config) *RetentionJobClient {
+	return &RetentionJobClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `message.Hooks(f(g(h())))`.
-func (c *MessageClient) Use(hooks ...Hook) {
-	c.hooks.Message = append(c.hooks.Message, hooks...)
+// A call to `Use(f, g, h)` equals to `retentionjob.Hooks(f(g(h())))`.
+func (c *RetentionJobClient) Use(hooks ...Hook) {
+	c.hooks.RetentionJob = append(c.hooks.RetentionJob, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `message.Intercept(f(g(h())))`.
-func (c *MessageClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Message = append(c.inters.Message, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `retentionjob.Intercept(f(g(h())))`.
+func (c *RetentionJobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RetentionJob = append(c.inters.RetentionJob, interceptors...)
 }
 
-// Create returns a builder for creating a Message entity.
-func (c *MessageClient) Create() *MessageCreate {
-	mutation := newMessageMutation(c.config, OpCreate)
-	return &MessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a RetentionJob entity.
+func (c *RetentionJobClient) Create() *RetentionJobCreate {
+	mutation := newRetentionJobMutation(c.config, OpCreate)
+	return &RetentionJobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Message entities.
-func (c *MessageClient) CreateBulk(builders ...*MessageCreate) *MessageCreateBulk {
-	return &MessageCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of RetentionJob entities.
+func (c *RetentionJobClient) CreateBulk(builders ...*RetentionJobCreate) *RetentionJobCreateBulk {
+	return &RetentionJobCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *MessageClient) MapCreateBulk(slice any, setFunc func(*MessageCreate, int)) *MessageCreateBulk {
+func (c *RetentionJobClient) MapCreateBulk(slice any, setFunc func(*RetentionJobCreate, int)) *RetentionJobCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &MessageCreateBulk{err: fmt.Errorf("calling to MessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &RetentionJobCreateBulk{err: fmt.Errorf("calling to RetentionJobClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*MessageCreate, rv.Len())
+	builders := make([]*RetentionJobCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &MessageCreateBulk{config: c.config, builders: builders}
+	return &RetentionJobCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Message.
-func (c *MessageClient) Update() *MessageUpdate {
-	mutation := newMessageMutation(c.config, OpUpdate)
-	return &MessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for RetentionJob.
+func (c *RetentionJobClient) Update() *RetentionJobUpdate {
+	mutation := newRetentionJobMutation(c.config, OpUpdate)
+	return &RetentionJobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *MessageClient) UpdateOne(m *Message) *MessageUpdateOne {
-	mutation := newMessageMutation(c.config, OpUpdateOne, withMessage(m))
-	return &MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *RetentionJobClient) UpdateOne(rj *RetentionJob) *RetentionJobUpdateOne {
+	mutation := newRetentionJobMutation(c.config, OpUpdateOne, withRetentionJob(rj))
+	return &RetentionJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *MessageClient) UpdateOneID(id int) *MessageUpdateOne {
-	mutation := newMessageMutation(c.config, OpUpdateOne, withMessageID(id))
-	return &MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *RetentionJobClient) UpdateOneID(id int) *RetentionJobUpdateOne {
+	mutation := newRetentionJobMutation(c.config, OpUpdateOne, withRetentionJobID(id))
+	return &RetentionJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Message.
-func (c *MessageClient) Delete() *MessageDelete {
-	mutation := newMessageMutation(c.config, OpDelete)
-	return &MessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for RetentionJob.
+func (c *RetentionJobClient) Delete() *RetentionJobDelete {
+	mutation := newRetentionJobMutation(c.config, OpDelete)
+	return &RetentionJobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *MessageClient) DeleteOne(m *Message) *MessageDeleteOne {
-	return c.DeleteOneID(m.ID)
+func (c *RetentionJobClient) DeleteOne(rj *RetentionJob) *RetentionJobDeleteOne {
+	return c.DeleteOneID(rj.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *MessageClient) DeleteOneID(id int) *MessageDeleteOne {
-	builder := c.Delete().Where(message.ID(id))
+func (c *RetentionJobClient) DeleteOneID(id int) *RetentionJobDeleteOne {
+	builder := c.Delete().Where(retentionjob.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &MessageDeleteOne{builder}
+	return &RetentionJobDeleteOne{builder}
 }
 
-// Query returns a query builder for Message.
-func (c *MessageClient) Query() *MessageQuery {
-	return &MessageQuery{
+// Query returns a query builder for RetentionJob.
+func (c *RetentionJobClient) Query() *RetentionJobQuery {
+	return &RetentionJobQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeMessage},
+		ctx:    &QueryContext{Type: TypeRetentionJob},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Message entity by its id.
-func (c *MessageClient) Get(ctx context.Context, id int) (*Message, error) {
-	return c.Query().Where(message.ID(id)).Only(ctx)
+// Get returns a RetentionJob entity by its id.
+func (c *RetentionJobClient) Get(ctx context.Context, id int) (*RetentionJob, error) {
+	return c.Query().Where(retentionjob.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *MessageClient) GetX(ctx context.Context, id int) *Message {
+func (c *RetentionJobClient) GetX(ctx context.Context, id int) *RetentionJob {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1337,131 +3752,131 @@ func (c *MessageClient) GetX(ctx context.Context, id int) *Message {
 }
 
 // Hooks returns the client hooks.
-func (c *MessageClient) Hooks() []Hook {
-	return c.hooks.Message
+func (c *RetentionJobClient) Hooks() []Hook {
+	return c.hooks.RetentionJob
 }
 
 // Interceptors returns the client interceptors.
-func (c *MessageClient) Interceptors() []Interceptor {
-	return c.inters.Message
+func (c *RetentionJobClient) Interceptors() []Interceptor {
+	return c.inters.RetentionJob
 }
 
-func (c *MessageClient) mutate(ctx context.Context, m *MessageMutation) (Value, error) {
+func (c *RetentionJobClient) mutate(ctx context.Context, m *RetentionJobMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&MessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RetentionJobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&MessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RetentionJobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RetentionJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&MessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&RetentionJobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Message mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown RetentionJob mutation op: %q", m.Op())
 	}
 }
 
-// MessageStatusClient is a client for the MessageStatus schema.
-type MessageStatusClient struct {
+// ScheduledMessageClient is a client for the ScheduledMessage schema.
+type ScheduledMessageClient struct {
 	config
 }
 
-// NewMessageStatusClient returns a client for the MessageStatus from the given config.
-func NewMessageStatusClient(c config) *MessageStatusClient {
-	return &MessageStatusClient{config: c}
+// NewScheduledMessageClient returns a client for the ScheduledMessage from the given config.
+func NewScheduledMessageClient(c config) *ScheduledMessageClient {
+	return &ScheduledMessageClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `messagestatus.Hooks(f(g(h())))`.
-func (c *MessageStatusClient) Use(hooks ...Hook) {
-	c.hooks.MessageStatus = append(c.hooks.MessageStatus, hooks...)
+// A call to `Use(f, g, h)` equals to `scheduledmessage.Hooks(f(g(h())))`.
+func (c *ScheduledMessageClient) Use(hooks ...Hook) {
+	c.hooks.ScheduledMessage = append(c.hooks.ScheduledMessage, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `messagestatus.Intercept(f(g(h())))`.
-func (c *MessageStatusClient) Intercept(interceptors ...Interceptor) {
-	c.inters.MessageStatus = append(c.inters.MessageStatus, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `scheduledmessage.Intercept(f(g(h())))`.
+func (c *ScheduledMessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ScheduledMessage = append(c.inters.ScheduledMessage, interceptors...)
 }
 
-// Create returns a builder for creating a MessageStatus entity.
-func (c *MessageStatusClient) Create() *MessageStatusCreate {
-	mutation := newMessageStatusMutation(c.config, OpCreate)
-	return &MessageStatusCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ScheduledMessage entity.
+func (c *ScheduledMessageClient) Create() *ScheduledMessageCreate {
+	mutation := newScheduledMessageMutation(c.config, OpCreate)
+	return &ScheduledMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of MessageStatus entities.
-func (c *MessageStatusClient) CreateBulk(builders ...*MessageStatusCreate) *MessageStatusCreateBulk {
-	return &MessageStatusCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ScheduledMessage entities.
+func (c *ScheduledMessageClient) CreateBulk(builders ...*ScheduledMessageCreate) *ScheduledMessageCreateBulk {
+	return &ScheduledMessageCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *MessageStatusClient) MapCreateBulk(slice any, setFunc func(*MessageStatusCreate, int)) *MessageStatusCreateBulk {
+func (c *ScheduledMessageClient) MapCreateBulk(slice any, setFunc func(*ScheduledMessageCreate, int)) *ScheduledMessageCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &MessageStatusCreateBulk{err: fmt.Errorf("calling to MessageStatusClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ScheduledMessageCreateBulk{err: fmt.Errorf("calling to ScheduledMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*MessageStatusCreate, rv.Len())
+	builders := make([]*ScheduledMessageCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &MessageStatusCreateBulk{config: c.config, builders: builders}
+	return &ScheduledMessageCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for MessageStatus.
-func (c *MessageStatusClient) Update() *MessageStatusUpdate {
-	mutation := newMessageStatusMutation(c.config, OpUpdate)
-	return &MessageStatusUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ScheduledMessage.
+func (c *ScheduledMessageClient) Update() *ScheduledMessageUpdate {
+	mutation := newScheduledMessageMutation(c.config, OpUpdate)
+	return &ScheduledMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *MessageStatusClient) UpdateOne(ms *MessageStatus) *MessageStatusUpdateOne {
-	mutation := newMessageStatusMutation(c.config, OpUpdateOne, withMessageStatus(ms))
-	return &MessageStatusUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ScheduledMessageClient) UpdateOne(sm *ScheduledMessage) *ScheduledMessageUpdateOne {
+	mutation := newScheduledMessageMutation(c.config, OpUpdateOne, withScheduledMessage(sm))
+	return &ScheduledMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *MessageStatusClient) UpdateOneID(id int) *MessageStatusUpdateOne {
-	mutation := newMessageStatusMutation(c.config, OpUpdateOne, withMessageStatusID(id))
-	return &MessageStatusUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ScheduledMessageClient) UpdateOneID(id int) *ScheduledMessageUpdateOne {
+	mutation := newScheduledMessageMutation(c.config, OpUpdateOne, withScheduledMessageID(id))
+	return &ScheduledMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for MessageStatus.
-func (c *MessageStatusClient) Delete() *MessageStatusDelete {
-	mutation := newMessageStatusMutation(c.config, OpDelete)
-	return &MessageStatusDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ScheduledMessage.
+func (c *ScheduledMessageClient) Delete() *ScheduledMessageDelete {
+	mutation := newScheduledMessageMutation(c.config, OpDelete)
+	return &ScheduledMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *MessageStatusClient) DeleteOne(ms *MessageStatus) *MessageStatusDeleteOne {
-	return c.DeleteOneID(ms.ID)
+func (c *ScheduledMessageClient) DeleteOne(sm *ScheduledMessage) *ScheduledMessageDeleteOne {
+	return c.DeleteOneID(sm.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *MessageStatusClient) DeleteOneID(id int) *MessageStatusDeleteOne {
-	builder := c.Delete().Where(messagestatus.ID(id))
+func (c *ScheduledMessageClient) DeleteOneID(id int) *ScheduledMessageDeleteOne {
+	builder := c.Delete().Where(scheduledmessage.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &MessageStatusDeleteOne{builder}
+	return &ScheduledMessageDeleteOne{builder}
 }
 
-// Query returns a query builder for MessageStatus.
-func (c *MessageStatusClient) Query() *MessageStatusQuery {
-	return &MessageStatusQuery{
+// Query returns a query builder for ScheduledMessage.
+func (c *ScheduledMessageClient) Query() *ScheduledMessageQuery {
+	return &ScheduledMessageQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeMessageStatus},
+		ctx:    &QueryContext{Type: TypeScheduledMessage},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a MessageStatus entity by its id.
-func (c *MessageStatusClient) Get(ctx context.Context, id int) (*MessageStatus, error) {
-	return c.Query().Where(messagestatus.ID(id)).Only(ctx)
+// Get returns a ScheduledMessage entity by its id.
+func (c *ScheduledMessageClient) Get(ctx context.Context, id int) (*ScheduledMessage, error) {
+	return c.Query().Where(scheduledmessage.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *MessageStatusClient) GetX(ctx context.Context, id int) *MessageStatus {
+func (c *ScheduledMessageClient) GetX(ctx context.Context, id int) *ScheduledMessage {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1470,27 +3885,27 @@ func (c *MessageStatusClient) GetX(ctx context.Context, id int) *MessageStatus {
 }
 
 // Hooks returns the client hooks.
-func (c *MessageStatusClient) Hooks() []Hook {
-	return c.hooks.MessageStatus
+func (c *ScheduledMessageClient) Hooks() []Hook {
+	return c.hooks.ScheduledMessage
 }
 
 // Interceptors returns the client interceptors.
-func (c *MessageStatusClient) Interceptors() []Interceptor {
-	return c.inters.MessageStatus
+func (c *ScheduledMessageClient) Interceptors() []Interceptor {
+	return c.inters.ScheduledMessage
 }
 
-func (c *MessageStatusClient) mutate(ctx context.Context, m *MessageStatusMutation) (Value, error) {
+func (c *ScheduledMessageClient) mutate(ctx context.Context, m *ScheduledMessageMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&MessageStatusCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ScheduledMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&MessageStatusUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ScheduledMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&MessageStatusUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ScheduledMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&MessageStatusDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ScheduledMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown MessageStatus mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ScheduledMessage mutation op: %q", m.Op())
 	}
 }
 
@@ -1893,16 +4308,157 @@ func (c *VideoMessageClient) mutate(ctx context.Context, m *VideoMessageMutation
 	}
 }
 
+// VoiceMessageClient is a client for the VoiceMessage schema.
+type VoiceMessageClient struct {
+	config
+}
+
+// NewVoiceMessageClient returns a client for the VoiceMessage from the given config.
+func NewVoiceMessageClient(c config) *VoiceMessageClient {
+	return &VoiceMessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `voicemessage.Hooks(f(g(h())))`.
+func (c *VoiceMessageClient) Use(hooks ...Hook) {
+	c.hooks.VoiceMessage = append(c.hooks.VoiceMessage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `voicemessage.Intercept(f(g(h())))`.
+func (c *VoiceMessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.VoiceMessage = append(c.inters.VoiceMessage, interceptors...)
+}
+
+// Create returns a builder for creating a VoiceMessage entity.
+func (c *VoiceMessageClient) Create() *VoiceMessageCreate {
+	mutation := newVoiceMessageMutation(c.config, OpCreate)
+	return &VoiceMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of VoiceMessage entities.
+func (c *VoiceMessageClient) CreateBulk(builders ...*VoiceMessageCreate) *VoiceMessageCreateBulk {
+	return &VoiceMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *VoiceMessageClient) MapCreateBulk(slice any, setFunc func(*VoiceMessageCreate, int)) *VoiceMessageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &VoiceMessageCreateBulk{err: fmt.Errorf("calling to VoiceMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*VoiceMessageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &VoiceMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for VoiceMessage.
+func (c *VoiceMessageClient) Update() *VoiceMessageUpdate {
+	mutation := newVoiceMessageMutation(c.config, OpUpdate)
+	return &VoiceMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *VoiceMessageClient) UpdateOne(vm *VoiceMessage) *VoiceMessageUpdateOne {
+	mutation := newVoiceMessageMutation(c.config, OpUpdateOne, withVoiceMessage(vm))
+	return &VoiceMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *VoiceMessageClient) UpdateOneID(id int) *VoiceMessageUpdateOne {
+	mutation := newVoiceMessageMutation(c.config, OpUpdateOne, withVoiceMessageID(id))
+	return &VoiceMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for VoiceMessage.
+func (c *VoiceMessageClient) Delete() *VoiceMessageDelete {
+	mutation := newVoiceMessageMutation(c.config, OpDelete)
+	return &VoiceMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *VoiceMessageClient) DeleteOne(vm *VoiceMessage) *VoiceMessageDeleteOne {
+	return c.DeleteOneID(vm.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *VoiceMessageClient) DeleteOneID(id int) *VoiceMessageDeleteOne {
+	builder := c.Delete().Where(voicemessage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &VoiceMessageDeleteOne{builder}
+}
+
+// Query returns a query builder for VoiceMessage.
+func (c *VoiceMessageClient) Query() *VoiceMessageQuery {
+	return &VoiceMessageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeVoiceMessage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a VoiceMessage entity by its id.
+func (c *VoiceMessageClient) Get(ctx context.Context, id int) (*VoiceMessage, error) {
+	return c.Query().Where(voicemessage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *VoiceMessageClient) GetX(ctx context.Context, id int) *VoiceMessage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *VoiceMessageClient) Hooks() []Hook {
+	return c.hooks.VoiceMessage
+}
+
+// Interceptors returns the client interceptors.
+func (c *VoiceMessageClient) Interceptors() []Interceptor {
+	return c.inters.VoiceMessage
+}
+
+func (c *VoiceMessageClient) mutate(ctx context.Context, m *VoiceMessageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&VoiceMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&VoiceMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&VoiceMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&VoiceMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown VoiceMessage mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		ChatRecord, DoNotDisturb, FriendRelationship, FriendRequest, Group,
-		GroupChatRecord, ImageMessage, Message, MessageStatus, TextMessage, User,
-		VideoMessage []ent.Hook
+		Block, ChatRecord, ContactCardMessage, ConversationClear, ConversationTimer,
+		DataMigration, DoNotDisturb, ExportJob, FileMessage, FriendRelationship,
+		FriendRequest, Group, GroupChatRecord, GroupWatermark, ImageMessage,
+		LocationMessage, MergedForwardMessage, Message, MessageDeletion,
+		MessageForward, MessageMention, MessageOutbox, MessageReaction, MessageStatus,
+		RetentionJob, ScheduledMessage, TextMessage, User, VideoMessage,
+		VoiceMessage []ent.Hook
 	}
 	inters struct {
-		ChatRecord, DoNotDisturb, FriendRelationship, FriendRequest, Group,
-		GroupChatRecord, ImageMessage, Message, MessageStatus, TextMessage, User,
-		VideoMessage []ent.Interceptor
+		Block, ChatRecord, ContactCardMessage, ConversationClear, ConversationTimer,
+		DataMigration, DoNotDisturb, ExportJob, FileMessage, FriendRelationship,
+		FriendRequest, Group, GroupChatRecord, GroupWatermark, ImageMessage,
+		LocationMessage, MergedForwardMessage, Message, MessageDeletion,
+		MessageForward, MessageMention, MessageOutbox, MessageReaction, MessageStatus,
+		RetentionJob, ScheduledMessage, TextMessage, User, VideoMessage,
+		VoiceMessage []ent.Interceptor
 	}
 )
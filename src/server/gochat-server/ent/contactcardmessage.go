@@ -0,0 +1,114 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"gochat_server/ent/contactcardmessage"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ContactCardMessage is the model entity for the ContactCardMessage schema.
+type ContactCardMessage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 消息ID,由发送者产生
+	MsgId string `json:"msgId,omitempty"`
+	// 名片中的用户ID,资料在读取时实时查询
+	UserId       int `json:"userId,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ContactCardMessage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case contactcardmessage.FieldID, contactcardmessage.FieldUserId:
+			values[i] = new(sql.NullInt64)
+		case contactcardmessage.FieldMsgId:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ContactCardMessage fields.
+func (ccm *ContactCardMessage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case contactcardmessage.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ccm.ID = int(value.Int64)
+		case contactcardmessage.FieldMsgId:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field msgId", values[i])
+			} else if value.Valid {
+				ccm.MsgId = value.String
+			}
+		case contactcardmessage.FieldUserId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field userId", values[i])
+			} else if value.Valid {
+				ccm.UserId = int(value.Int64)
+			}
+		default:
+			ccm.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ContactCardMessage.
+// This includes values selected through modifiers, order, etc.
+func (ccm *ContactCardMessage) Value(name string) (ent.Value, error) {
+	return ccm.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ContactCardMessage.
+// Note that you need to call ContactCardMessage.Unwrap() before calling this method if this ContactCardMessage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ccm *ContactCardMessage) Update() *ContactCardMessageUpdateOne {
+	return NewContactCardMessageClient(ccm.config).UpdateOne(ccm)
+}
+
+// Unwrap unwraps the ContactCardMessage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ccm *ContactCardMessage) Unwrap() *ContactCardMessage {
+	_tx, ok := ccm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ContactCardMessage is not a transactional entity")
+	}
+	ccm.config.driver = _tx.drv
+	return ccm
+}
+
+// String implements the fmt.Stringer.
+func (ccm *ContactCardMessage) String() string {
+	var builder strings.Builder
+	builder.WriteString("ContactCardMessage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ccm.ID))
+	builder.WriteString("msgId=")
+	builder.WriteString(ccm.MsgId)
+	builder.WriteString(", ")
+	builder.WriteString("userId=")
+	builder.WriteString(fmt.Sprintf("%v", ccm.UserId))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ContactCardMessages is a parsable slice of ContactCardMessage.
+type ContactCardMessages []*ContactCardMessage
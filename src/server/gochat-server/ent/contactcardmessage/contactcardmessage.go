@@ -0,0 +1,60 @@
+// Code generated by ent, DO NOT EDIT.
+
+package contactcardmessage
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the contactcardmessage type in the database.
+	Label = "contact_card_message"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldMsgId holds the string denoting the msgid field in the database.
+	FieldMsgId = "msg_id"
+	// FieldUserId holds the string denoting the userid field in the database.
+	FieldUserId = "user_id"
+	// Table holds the table name of the contactcardmessage in the database.
+	Table = "contact_card_messages"
+)
+
+// Columns holds all SQL columns for contactcardmessage fields.
+var Columns = []string{
+	FieldID,
+	FieldMsgId,
+	FieldUserId,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// MsgIdValidator is a validator for the "msgId" field. It is called by the builders before save.
+	MsgIdValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the ContactCardMessage queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByMsgId orders the results by the msgId field.
+func ByMsgId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMsgId, opts...).ToFunc()
+}
+
+// ByUserId orders the results by the userId field.
+func ByUserId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserId, opts...).ToFunc()
+}
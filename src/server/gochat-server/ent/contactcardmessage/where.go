@@ -0,0 +1,184 @@
+// Code generated by ent, DO NOT EDIT.
+
+package contactcardmessage
+
+import (
+	"gochat_server/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ContactCardMessage {
+	return predicate.ContactCardMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ContactCardMessage {
+	return predicate.ContactCardMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ContactCardMessage {
+	return predicate.ContactCardMessage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ContactCardMessage {
+	return predicate.ContactCardMessage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ContactCardMessage {
+	return predicate.ContactCardMessage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ContactCardMessage {
+	return predicate.ContactCardMessage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ContactCardMessage {
+	return predicate.ContactCardMessage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ContactCardMessage {
+	return predicate.ContactCardMessage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ContactCardMessage {
+	return predicate.ContactCardMessage(sql.FieldLTE(FieldID, id))
+}
+
+// MsgId applies equality check predicate on the "msgId" field. It's identical to MsgIdEQ.
+func MsgId(v string) predicate.ContactCardMessage {
+	return predicate.ContactCardMessage(sql.FieldEQ(FieldMsgId, v))
+}
+
+// UserId applies equality check predicate on the "userId" field. It's identical to UserIdEQ.
+func UserId(v int) predicate.ContactCardMessage {
+	return predicate.ContactCardMessage(sql.FieldEQ(FieldUserId, v))
+}
+
+// MsgIdEQ applies the EQ predicate on the "msgId" field.
+func MsgIdEQ(v string) predicate.ContactCardMessage {
+	return predicate.ContactCardMessage(sql.FieldEQ(FieldMsgId, v))
+}
+
+// MsgIdNEQ applies the NEQ predicate on the "msgId" field.
+func MsgIdNEQ(v string) predicate.ContactCardMessage {
+	return predicate.ContactCardMessage(sql.FieldNEQ(FieldMsgId, v))
+}
+
+// MsgIdIn applies the In predicate on the "msgId" field.
+func MsgIdIn(vs ...string) predicate.ContactCardMessage {
+	return predicate.ContactCardMessage(sql.FieldIn(FieldMsgId, vs...))
+}
+
+// MsgIdNotIn applies the NotIn predicate on the "msgId" field.
+func MsgIdNotIn(vs ...string) predicate.ContactCardMessage {
+	return predicate.ContactCardMessage(sql.FieldNotIn(FieldMsgId, vs...))
+}
+
+// MsgIdGT applies the GT predicate on the "msgId" field.
+func MsgIdGT(v string) predicate.ContactCardMessage {
+	return predicate.ContactCardMessage(sql.FieldGT(FieldMsgId, v))
+}
+
+// MsgIdGTE applies the GTE predicate on the "msgId" field.
+func MsgIdGTE(v string) predicate.ContactCardMessage {
+	return predicate.ContactCardMessage(sql.FieldGTE(FieldMsgId, v))
+}
+
+// MsgIdLT applies the LT predicate on the "msgId" field.
+func MsgIdLT(v string) predicate.ContactCardMessage {
+	return predicate.ContactCardMessage(sql.FieldLT(FieldMsgId, v))
+}
+
+// MsgIdLTE applies the LTE predicate on the "msgId" field.
+func MsgIdLTE(v string) predicate.ContactCardMessage {
+	return predicate.ContactCardMessage(sql.FieldLTE(FieldMsgId, v))
+}
+
+// MsgIdContains applies the Contains predicate on the "msgId" field.
+func MsgIdContains(v string) predicate.ContactCardMessage {
+	return predicate.ContactCardMessage(sql.FieldContains(FieldMsgId, v))
+}
+
+// MsgIdHasPrefix applies the HasPrefix predicate on the "msgId" field.
+func MsgIdHasPrefix(v string) predicate.ContactCardMessage {
+	return predicate.ContactCardMessage(sql.FieldHasPrefix(FieldMsgId, v))
+}
+
+// MsgIdHasSuffix applies the HasSuffix predicate on the "msgId" field.
+func MsgIdHasSuffix(v string) predicate.ContactCardMessage {
+	return predicate.ContactCardMessage(sql.FieldHasSuffix(FieldMsgId, v))
+}
+
+// MsgIdEqualFold applies the EqualFold predicate on the "msgId" field.
+func MsgIdEqualFold(v string) predicate.ContactCardMessage {
+	return predicate.ContactCardMessage(sql.FieldEqualFold(FieldMsgId, v))
+}
+
+// MsgIdContainsFold applies the ContainsFold predicate on the "msgId" field.
+func MsgIdContainsFold(v string) predicate.ContactCardMessage {
+	return predicate.ContactCardMessage(sql.FieldContainsFold(FieldMsgId, v))
+}
+
+// UserIdEQ applies the EQ predicate on the "userId" field.
+func UserIdEQ(v int) predicate.ContactCardMessage {
+	return predicate.ContactCardMessage(sql.FieldEQ(FieldUserId, v))
+}
+
+// UserIdNEQ applies the NEQ predicate on the "userId" field.
+func UserIdNEQ(v int) predicate.ContactCardMessage {
+	return predicate.ContactCardMessage(sql.FieldNEQ(FieldUserId, v))
+}
+
+// UserIdIn applies the In predicate on the "userId" field.
+func UserIdIn(vs ...int) predicate.ContactCardMessage {
+	return predicate.ContactCardMessage(sql.FieldIn(FieldUserId, vs...))
+}
+
+// UserIdNotIn applies the NotIn predicate on the "userId" field.
+func UserIdNotIn(vs ...int) predicate.ContactCardMessage {
+	return predicate.ContactCardMessage(sql.FieldNotIn(FieldUserId, vs...))
+}
+
+// UserIdGT applies the GT predicate on the "userId" field.
+func UserIdGT(v int) predicate.ContactCardMessage {
+	return predicate.ContactCardMessage(sql.FieldGT(FieldUserId, v))
+}
+
+// UserIdGTE applies the GTE predicate on the "userId" field.
+func UserIdGTE(v int) predicate.ContactCardMessage {
+	return predicate.ContactCardMessage(sql.FieldGTE(FieldUserId, v))
+}
+
+// UserIdLT applies the LT predicate on the "userId" field.
+func UserIdLT(v int) predicate.ContactCardMessage {
+	return predicate.ContactCardMessage(sql.FieldLT(FieldUserId, v))
+}
+
+// UserIdLTE applies the LTE predicate on the "userId" field.
+func UserIdLTE(v int) predicate.ContactCardMessage {
+	return predicate.ContactCardMessage(sql.FieldLTE(FieldUserId, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ContactCardMessage) predicate.ContactCardMessage {
+	return predicate.ContactCardMessage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ContactCardMessage) predicate.ContactCardMessage {
+	return predicate.ContactCardMessage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ContactCardMessage) predicate.ContactCardMessage {
+	return predicate.ContactCardMessage(sql.NotPredicates(p))
+}
@@ -0,0 +1,201 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gochat_server/ent/contactcardmessage"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ContactCardMessageCreate is the builder for creating a ContactCardMessage entity.
+type ContactCardMessageCreate struct {
+	config
+	mutation *ContactCardMessageMutation
+	hooks    []Hook
+}
+
+// SetMsgId sets the "msgId" field.
+func (ccmc *ContactCardMessageCreate) SetMsgId(s string) *ContactCardMessageCreate {
+	ccmc.mutation.SetMsgId(s)
+	return ccmc
+}
+
+// SetUserId sets the "userId" field.
+func (ccmc *ContactCardMessageCreate) SetUserId(i int) *ContactCardMessageCreate {
+	ccmc.mutation.SetUserId(i)
+	return ccmc
+}
+
+// Mutation returns the ContactCardMessageMutation object of the builder.
+func (ccmc *ContactCardMessageCreate) Mutation() *ContactCardMessageMutation {
+	return ccmc.mutation
+}
+
+// Save creates the ContactCardMessage in the database.
+func (ccmc *ContactCardMessageCreate) Save(ctx context.Context) (*ContactCardMessage, error) {
+	return withHooks(ctx, ccmc.sqlSave, ccmc.mutation, ccmc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ccmc *ContactCardMessageCreate) SaveX(ctx context.Context) *ContactCardMessage {
+	v, err := ccmc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ccmc *ContactCardMessageCreate) Exec(ctx context.Context) error {
+	_, err := ccmc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccmc *ContactCardMessageCreate) ExecX(ctx context.Context) {
+	if err := ccmc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ccmc *ContactCardMessageCreate) check() error {
+	if _, ok := ccmc.mutation.MsgId(); !ok {
+		return &ValidationError{Name: "msgId", err: errors.New(`ent: missing required field "ContactCardMessage.msgId"`)}
+	}
+	if v, ok := ccmc.mutation.MsgId(); ok {
+		if err := contactcardmessage.MsgIdValidator(v); err != nil {
+			return &ValidationError{Name: "msgId", err: fmt.Errorf(`ent: validator failed for field "ContactCardMessage.msgId": %w`, err)}
+		}
+	}
+	if _, ok := ccmc.mutation.UserId(); !ok {
+		return &ValidationError{Name: "userId", err: errors.New(`ent: missing required field "ContactCardMessage.userId"`)}
+	}
+	return nil
+}
+
+func (ccmc *ContactCardMessageCreate) sqlSave(ctx context.Context) (*ContactCardMessage, error) {
+	if err := ccmc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ccmc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ccmc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ccmc.mutation.id = &_node.ID
+	ccmc.mutation.done = true
+	return _node, nil
+}
+
+func (ccmc *ContactCardMessageCreate) createSpec() (*ContactCardMessage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ContactCardMessage{config: ccmc.config}
+		_spec = sqlgraph.NewCreateSpec(contactcardmessage.Table, sqlgraph.NewFieldSpec(contactcardmessage.FieldID, field.TypeInt))
+	)
+	if value, ok := ccmc.mutation.MsgId(); ok {
+		_spec.SetField(contactcardmessage.FieldMsgId, field.TypeString, value)
+		_node.MsgId = value
+	}
+	if value, ok := ccmc.mutation.UserId(); ok {
+		_spec.SetField(contactcardmessage.FieldUserId, field.TypeInt, value)
+		_node.UserId = value
+	}
+	return _node, _spec
+}
+
+// ContactCardMessageCreateBulk is the builder for creating many ContactCardMessage entities in bulk.
+type ContactCardMessageCreateBulk struct {
+	config
+	err      error
+	builders []*ContactCardMessageCreate
+}
+
+// Save creates the ContactCardMessage entities in the database.
+func (ccmcb *ContactCardMessageCreateBulk) Save(ctx context.Context) ([]*ContactCardMessage, error) {
+	if ccmcb.err != nil {
+		return nil, ccmcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ccmcb.builders))
+	nodes := make([]*ContactCardMessage, len(ccmcb.builders))
+	mutators := make([]Mutator, len(ccmcb.builders))
+	for i := range ccmcb.builders {
+		func(i int, root context.Context) {
+			builder := ccmcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ContactCardMessageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ccmcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ccmcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ccmcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccmcb *ContactCardMessageCreateBulk) SaveX(ctx context.Context) []*ContactCardMessage {
+	v, err := ccmcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ccmcb *ContactCardMessageCreateBulk) Exec(ctx context.Context) error {
+	_, err := ccmcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccmcb *ContactCardMessageCreateBulk) ExecX(ctx context.Context) {
+	if err := ccmcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
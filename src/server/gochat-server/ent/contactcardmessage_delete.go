@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"gochat_server/ent/contactcardmessage"
+	"gochat_server/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ContactCardMessageDelete is the builder for deleting a ContactCardMessage entity.
+type ContactCardMessageDelete struct {
+	config
+	hooks    []Hook
+	mutation *ContactCardMessageMutation
+}
+
+// Where appends a list predicates to the ContactCardMessageDelete builder.
+func (ccmd *ContactCardMessageDelete) Where(ps ...predicate.ContactCardMessage) *ContactCardMessageDelete {
+	ccmd.mutation.Where(ps...)
+	return ccmd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ccmd *ContactCardMessageDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ccmd.sqlExec, ccmd.mutation, ccmd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccmd *ContactCardMessageDelete) ExecX(ctx context.Context) int {
+	n, err := ccmd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ccmd *ContactCardMessageDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(contactcardmessage.Table, sqlgraph.NewFieldSpec(contactcardmessage.FieldID, field.TypeInt))
+	if ps := ccmd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ccmd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ccmd.mutation.done = true
+	return affected, err
+}
+
+// ContactCardMessageDeleteOne is the builder for deleting a single ContactCardMessage entity.
+type ContactCardMessageDeleteOne struct {
+	ccmd *ContactCardMessageDelete
+}
+
+// Where appends a list predicates to the ContactCardMessageDelete builder.
+func (ccmdo *ContactCardMessageDeleteOne) Where(ps ...predicate.ContactCardMessage) *ContactCardMessageDeleteOne {
+	ccmdo.ccmd.mutation.Where(ps...)
+	return ccmdo
+}
+
+// Exec executes the deletion query.
+func (ccmdo *ContactCardMessageDeleteOne) Exec(ctx context.Context) error {
+	n, err := ccmdo.ccmd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{contactcardmessage.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccmdo *ContactCardMessageDeleteOne) ExecX(ctx context.Context) {
+	if err := ccmdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"gochat_server/ent/contactcardmessage"
+	"gochat_server/ent/predicate"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ContactCardMessageQuery is the builder for querying ContactCardMessage entities.
+type ContactCardMessageQuery struct {
+	config
+	ctx        *QueryContext
+	order      []contactcardmessage.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ContactCardMessage
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ContactCardMessageQuery builder.
+func (ccmq *ContactCardMessageQuery) Where(ps ...predicate.ContactCardMessage) *ContactCardMessageQuery {
+	ccmq.predicates = append(ccmq.predicates, ps...)
+	return ccmq
+}
+
+// Limit the number of records to be returned by this query.
+func (ccmq *ContactCardMessageQuery) Limit(limit int) *ContactCardMessageQuery {
+	ccmq.ctx.Limit = &limit
+	return ccmq
+}
+
+// Offset to start from.
+func (ccmq *ContactCardMessageQuery) Offset(offset int) *ContactCardMessageQuery {
+	ccmq.ctx.Offset = &offset
+	return ccmq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ccmq *ContactCardMessageQuery) Unique(unique bool) *ContactCardMessageQuery {
+	ccmq.ctx.Unique = &unique
+	return ccmq
+}
+
+// Order specifies how the records should be ordered.
+func (ccmq *ContactCardMessageQuery) Order(o ...contactcardmessage.OrderOption) *ContactCardMessageQuery {
+	ccmq.order = append(ccmq.order, o...)
+	return ccmq
+}
+
+// First returns the first ContactCardMessage entity from the query.
+// Returns a *NotFoundError when no ContactCardMessage was found.
+func (ccmq *ContactCardMessageQuery) First(ctx context.Context) (*ContactCardMessage, error) {
+	nodes, err := ccmq.Limit(1).All(setContextOp(ctx, ccmq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{contactcardmessage.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ccmq *ContactCardMessageQuery) FirstX(ctx context.Context) *ContactCardMessage {
+	node, err := ccmq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ContactCardMessage ID from the query.
+// Returns a *NotFoundError when no ContactCardMessage ID was found.
+func (ccmq *ContactCardMessageQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ccmq.Limit(1).IDs(setContextOp(ctx, ccmq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{contactcardmessage.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ccmq *ContactCardMessageQuery) FirstIDX(ctx context.Context) int {
+	id, err := ccmq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ContactCardMessage entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ContactCardMessage entity is found.
+// Returns a *NotFoundError when no ContactCardMessage entities are found.
+func (ccmq *ContactCardMessageQuery) Only(ctx context.Context) (*ContactCardMessage, error) {
+	nodes, err := ccmq.Limit(2).All(setContextOp(ctx, ccmq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{contactcardmessage.Label}
+	default:
+		return nil, &NotSingularError{contactcardmessage.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ccmq *ContactCardMessageQuery) OnlyX(ctx context.Context) *ContactCardMessage {
+	node, err := ccmq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ContactCardMessage ID in the query.
+// Returns a *NotSingularError when more than one ContactCardMessage ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ccmq *ContactCardMessageQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ccmq.Limit(2).IDs(setContextOp(ctx, ccmq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{contactcardmessage.Label}
+	default:
+		err = &NotSingularError{contactcardmessage.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ccmq *ContactCardMessageQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ccmq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ContactCardMessages.
+func (ccmq *ContactCardMessageQuery) All(ctx context.Context) ([]*ContactCardMessage, error) {
+	ctx = setContextOp(ctx, ccmq.ctx, ent.OpQueryAll)
+	if err := ccmq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ContactCardMessage, *ContactCardMessageQuery]()
+	return withInterceptors[[]*ContactCardMessage](ctx, ccmq, qr, ccmq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ccmq *ContactCardMessageQuery) AllX(ctx context.Context) []*ContactCardMessage {
+	nodes, err := ccmq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ContactCardMessage IDs.
+func (ccmq *ContactCardMessageQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if ccmq.ctx.Unique == nil && ccmq.path != nil {
+		ccmq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ccmq.ctx, ent.OpQueryIDs)
+	if err = ccmq.Select(contactcardmessage.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ccmq *ContactCardMessageQuery) IDsX(ctx context.Context) []int {
+	ids, err := ccmq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ccmq *ContactCardMessageQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ccmq.ctx, ent.OpQueryCount)
+	if err := ccmq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ccmq, querierCount[*ContactCardMessageQuery](), ccmq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ccmq *ContactCardMessageQuery) CountX(ctx context.Context) int {
+	count, err := ccmq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ccmq *ContactCardMessageQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ccmq.ctx, ent.OpQueryExist)
+	switch _, err := ccmq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ccmq *ContactCardMessageQuery) ExistX(ctx context.Context) bool {
+	exist, err := ccmq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ContactCardMessageQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ccmq *ContactCardMessageQuery) Clone() *ContactCardMessageQuery {
+	if ccmq == nil {
+		return nil
+	}
+	return &ContactCardMessageQuery{
+		config:     ccmq.config,
+		ctx:        ccmq.ctx.Clone(),
+		order:      append([]contactcardmessage.OrderOption{}, ccmq.order...),
+		inters:     append([]Interceptor{}, ccmq.inters...),
+		predicates: append([]predicate.ContactCardMessage{}, ccmq.predicates...),
+		// clone intermediate query.
+		sql:  ccmq.sql.Clone(),
+		path: ccmq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		MsgId string `json:"msgId,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ContactCardMessage.Query().
+//		GroupBy(contactcardmessage.FieldMsgId).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ccmq *ContactCardMessageQuery) GroupBy(field string, fields ...string) *ContactCardMessageGroupBy {
+	ccmq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ContactCardMessageGroupBy{build: ccmq}
+	grbuild.flds = &ccmq.ctx.Fields
+	grbuild.label = contactcardmessage.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		MsgId string `json:"msgId,omitempty"`
+//	}
+//
+//	client.ContactCardMessage.Query().
+//		Select(contactcardmessage.FieldMsgId).
+//		Scan(ctx, &v)
+func (ccmq *ContactCardMessageQuery) Select(fields ...string) *ContactCardMessageSelect {
+	ccmq.ctx.Fields = append(ccmq.ctx.Fields, fields...)
+	sbuild := &ContactCardMessageSelect{ContactCardMessageQuery: ccmq}
+	sbuild.label = contactcardmessage.Label
+	sbuild.flds, sbuild.scan = &ccmq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ContactCardMessageSelect configured with the given aggregations.
+func (ccmq *ContactCardMessageQuery) Aggregate(fns ...AggregateFunc) *ContactCardMessageSelect {
+	return ccmq.Select().Aggregate(fns...)
+}
+
+func (ccmq *ContactCardMessageQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ccmq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ccmq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ccmq.ctx.Fields {
+		if !contactcardmessage.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ccmq.path != nil {
+		prev, err := ccmq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ccmq.sql = prev
+	}
+	return nil
+}
+
+func (ccmq *ContactCardMessageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ContactCardMessage, error) {
+	var (
+		nodes = []*ContactCardMessage{}
+		_spec = ccmq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ContactCardMessage).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ContactCardMessage{config: ccmq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ccmq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ccmq *ContactCardMessageQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ccmq.querySpec()
+	_spec.Node.Columns = ccmq.ctx.Fields
+	if len(ccmq.ctx.Fields) > 0 {
+		_spec.Unique = ccmq.ctx.Unique != nil && *ccmq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ccmq.driver, _spec)
+}
+
+func (ccmq *ContactCardMessageQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(contactcardmessage.Table, contactcardmessage.Columns, sqlgraph.NewFieldSpec(contactcardmessage.FieldID, field.TypeInt))
+	_spec.From = ccmq.sql
+	if unique := ccmq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ccmq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ccmq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, contactcardmessage.FieldID)
+		for i := range fields {
+			if fields[i] != contactcardmessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ccmq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ccmq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ccmq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ccmq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ccmq *ContactCardMessageQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ccmq.driver.Dialect())
+	t1 := builder.Table(contactcardmessage.Table)
+	columns := ccmq.ctx.Fields
+	if len(columns) == 0 {
+		columns = contactcardmessage.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ccmq.sql != nil {
+		selector = ccmq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ccmq.ctx.Unique != nil && *ccmq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ccmq.predicates {
+		p(selector)
+	}
+	for _, p := range ccmq.order {
+		p(selector)
+	}
+	if offset := ccmq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ccmq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ContactCardMessageGroupBy is the group-by builder for ContactCardMessage entities.
+type ContactCardMessageGroupBy struct {
+	selector
+	build *ContactCardMessageQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ccmgb *ContactCardMessageGroupBy) Aggregate(fns ...AggregateFunc) *ContactCardMessageGroupBy {
+	ccmgb.fns = append(ccmgb.fns, fns...)
+	return ccmgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ccmgb *ContactCardMessageGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ccmgb.build.ctx, ent.OpQueryGroupBy)
+	if err := ccmgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ContactCardMessageQuery, *ContactCardMessageGroupBy](ctx, ccmgb.build, ccmgb, ccmgb.build.inters, v)
+}
+
+func (ccmgb *ContactCardMessageGroupBy) sqlScan(ctx context.Context, root *ContactCardMessageQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ccmgb.fns))
+	for _, fn := range ccmgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ccmgb.flds)+len(ccmgb.fns))
+		for _, f := range *ccmgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ccmgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ccmgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ContactCardMessageSelect is the builder for selecting fields of ContactCardMessage entities.
+type ContactCardMessageSelect struct {
+	*ContactCardMessageQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ccms *ContactCardMessageSelect) Aggregate(fns ...AggregateFunc) *ContactCardMessageSelect {
+	ccms.fns = append(ccms.fns, fns...)
+	return ccms
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ccms *ContactCardMessageSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ccms.ctx, ent.OpQuerySelect)
+	if err := ccms.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ContactCardMessageQuery, *ContactCardMessageSelect](ctx, ccms.ContactCardMessageQuery, ccms, ccms.inters, v)
+}
+
+func (ccms *ContactCardMessageSelect) sqlScan(ctx context.Context, root *ContactCardMessageQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ccms.fns))
+	for _, fn := range ccms.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ccms.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ccms.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
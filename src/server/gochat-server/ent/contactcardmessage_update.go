@@ -0,0 +1,289 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gochat_server/ent/contactcardmessage"
+	"gochat_server/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ContactCardMessageUpdate is the builder for updating ContactCardMessage entities.
+type ContactCardMessageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ContactCardMessageMutation
+}
+
+// Where appends a list predicates to the ContactCardMessageUpdate builder.
+func (ccmu *ContactCardMessageUpdate) Where(ps ...predicate.ContactCardMessage) *ContactCardMessageUpdate {
+	ccmu.mutation.Where(ps...)
+	return ccmu
+}
+
+// SetMsgId sets the "msgId" field.
+func (ccmu *ContactCardMessageUpdate) SetMsgId(s string) *ContactCardMessageUpdate {
+	ccmu.mutation.SetMsgId(s)
+	return ccmu
+}
+
+// SetNillableMsgId sets the "msgId" field if the given value is not nil.
+func (ccmu *ContactCardMessageUpdate) SetNillableMsgId(s *string) *ContactCardMessageUpdate {
+	if s != nil {
+		ccmu.SetMsgId(*s)
+	}
+	return ccmu
+}
+
+// SetUserId sets the "userId" field.
+func (ccmu *ContactCardMessageUpdate) SetUserId(i int) *ContactCardMessageUpdate {
+	ccmu.mutation.ResetUserId()
+	ccmu.mutation.SetUserId(i)
+	return ccmu
+}
+
+// SetNillableUserId sets the "userId" field if the given value is not nil.
+func (ccmu *ContactCardMessageUpdate) SetNillableUserId(i *int) *ContactCardMessageUpdate {
+	if i != nil {
+		ccmu.SetUserId(*i)
+	}
+	return ccmu
+}
+
+// AddUserId adds i to the "userId" field.
+func (ccmu *ContactCardMessageUpdate) AddUserId(i int) *ContactCardMessageUpdate {
+	ccmu.mutation.AddUserId(i)
+	return ccmu
+}
+
+// Mutation returns the ContactCardMessageMutation object of the builder.
+func (ccmu *ContactCardMessageUpdate) Mutation() *ContactCardMessageMutation {
+	return ccmu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ccmu *ContactCardMessageUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ccmu.sqlSave, ccmu.mutation, ccmu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccmu *ContactCardMessageUpdate) SaveX(ctx context.Context) int {
+	affected, err := ccmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ccmu *ContactCardMessageUpdate) Exec(ctx context.Context) error {
+	_, err := ccmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccmu *ContactCardMessageUpdate) ExecX(ctx context.Context) {
+	if err := ccmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ccmu *ContactCardMessageUpdate) check() error {
+	if v, ok := ccmu.mutation.MsgId(); ok {
+		if err := contactcardmessage.MsgIdValidator(v); err != nil {
+			return &ValidationError{Name: "msgId", err: fmt.Errorf(`ent: validator failed for field "ContactCardMessage.msgId": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ccmu *ContactCardMessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ccmu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(contactcardmessage.Table, contactcardmessage.Columns, sqlgraph.NewFieldSpec(contactcardmessage.FieldID, field.TypeInt))
+	if ps := ccmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ccmu.mutation.MsgId(); ok {
+		_spec.SetField(contactcardmessage.FieldMsgId, field.TypeString, value)
+	}
+	if value, ok := ccmu.mutation.UserId(); ok {
+		_spec.SetField(contactcardmessage.FieldUserId, field.TypeInt, value)
+	}
+	if value, ok := ccmu.mutation.AddedUserId(); ok {
+		_spec.AddField(contactcardmessage.FieldUserId, field.TypeInt, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ccmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{contactcardmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ccmu.mutation.done = true
+	return n, nil
+}
+
+// ContactCardMessageUpdateOne is the builder for updating a single ContactCardMessage entity.
+type ContactCardMessageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ContactCardMessageMutation
+}
+
+// SetMsgId sets the "msgId" field.
+func (ccmuo *ContactCardMessageUpdateOne) SetMsgId(s string) *ContactCardMessageUpdateOne {
+	ccmuo.mutation.SetMsgId(s)
+	return ccmuo
+}
+
+// SetNillableMsgId sets the "msgId" field if the given value is not nil.
+func (ccmuo *ContactCardMessageUpdateOne) SetNillableMsgId(s *string) *ContactCardMessageUpdateOne {
+	if s != nil {
+		ccmuo.SetMsgId(*s)
+	}
+	return ccmuo
+}
+
+// SetUserId sets the "userId" field.
+func (ccmuo *ContactCardMessageUpdateOne) SetUserId(i int) *ContactCardMessageUpdateOne {
+	ccmuo.mutation.ResetUserId()
+	ccmuo.mutation.SetUserId(i)
+	return ccmuo
+}
+
+// SetNillableUserId sets the "userId" field if the given value is not nil.
+func (ccmuo *ContactCardMessageUpdateOne) SetNillableUserId(i *int) *ContactCardMessageUpdateOne {
+	if i != nil {
+		ccmuo.SetUserId(*i)
+	}
+	return ccmuo
+}
+
+// AddUserId adds i to the "userId" field.
+func (ccmuo *ContactCardMessageUpdateOne) AddUserId(i int) *ContactCardMessageUpdateOne {
+	ccmuo.mutation.AddUserId(i)
+	return ccmuo
+}
+
+// Mutation returns the ContactCardMessageMutation object of the builder.
+func (ccmuo *ContactCardMessageUpdateOne) Mutation() *ContactCardMessageMutation {
+	return ccmuo.mutation
+}
+
+// Where appends a list predicates to the ContactCardMessageUpdate builder.
+func (ccmuo *ContactCardMessageUpdateOne) Where(ps ...predicate.ContactCardMessage) *ContactCardMessageUpdateOne {
+	ccmuo.mutation.Where(ps...)
+	return ccmuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ccmuo *ContactCardMessageUpdateOne) Select(field string, fields ...string) *ContactCardMessageUpdateOne {
+	ccmuo.fields = append([]string{field}, fields...)
+	return ccmuo
+}
+
+// Save executes the query and returns the updated ContactCardMessage entity.
+func (ccmuo *ContactCardMessageUpdateOne) Save(ctx context.Context) (*ContactCardMessage, error) {
+	return withHooks(ctx, ccmuo.sqlSave, ccmuo.mutation, ccmuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccmuo *ContactCardMessageUpdateOne) SaveX(ctx context.Context) *ContactCardMessage {
+	node, err := ccmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ccmuo *ContactCardMessageUpdateOne) Exec(ctx context.Context) error {
+	_, err := ccmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccmuo *ContactCardMessageUpdateOne) ExecX(ctx context.Context) {
+	if err := ccmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ccmuo *ContactCardMessageUpdateOne) check() error {
+	if v, ok := ccmuo.mutation.MsgId(); ok {
+		if err := contactcardmessage.MsgIdValidator(v); err != nil {
+			return &ValidationError{Name: "msgId", err: fmt.Errorf(`ent: validator failed for field "ContactCardMessage.msgId": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ccmuo *ContactCardMessageUpdateOne) sqlSave(ctx context.Context) (_node *ContactCardMessage, err error) {
+	if err := ccmuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(contactcardmessage.Table, contactcardmessage.Columns, sqlgraph.NewFieldSpec(contactcardmessage.FieldID, field.TypeInt))
+	id, ok := ccmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ContactCardMessage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ccmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, contactcardmessage.FieldID)
+		for _, f := range fields {
+			if !contactcardmessage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != contactcardmessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ccmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ccmuo.mutation.MsgId(); ok {
+		_spec.SetField(contactcardmessage.FieldMsgId, field.TypeString, value)
+	}
+	if value, ok := ccmuo.mutation.UserId(); ok {
+		_spec.SetField(contactcardmessage.FieldUserId, field.TypeInt, value)
+	}
+	if value, ok := ccmuo.mutation.AddedUserId(); ok {
+		_spec.AddField(contactcardmessage.FieldUserId, field.TypeInt, value)
+	}
+	_node = &ContactCardMessage{config: ccmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ccmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{contactcardmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ccmuo.mutation.done = true
+	return _node, nil
+}
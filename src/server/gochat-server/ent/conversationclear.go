@@ -0,0 +1,150 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"gochat_server/ent/conversationclear"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ConversationClear is the model entity for the ConversationClear schema.
+type ConversationClear struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 用户ID
+	UserId int `json:"userId,omitempty"`
+	// 是否为群聊会话
+	IsGroup bool `json:"isGroup,omitempty"`
+	// 私聊为对方用户ID，群聊为群组ID
+	TargetId int `json:"targetId,omitempty"`
+	// 清空时间，之前的消息对该用户隐藏
+	ClearTime time.Time `json:"clearTime,omitempty"`
+	// 会话是否已删除
+	Hidden       bool `json:"hidden,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ConversationClear) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case conversationclear.FieldIsGroup, conversationclear.FieldHidden:
+			values[i] = new(sql.NullBool)
+		case conversationclear.FieldID, conversationclear.FieldUserId, conversationclear.FieldTargetId:
+			values[i] = new(sql.NullInt64)
+		case conversationclear.FieldClearTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ConversationClear fields.
+func (cc *ConversationClear) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case conversationclear.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			cc.ID = int(value.Int64)
+		case conversationclear.FieldUserId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field userId", values[i])
+			} else if value.Valid {
+				cc.UserId = int(value.Int64)
+			}
+		case conversationclear.FieldIsGroup:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field isGroup", values[i])
+			} else if value.Valid {
+				cc.IsGroup = value.Bool
+			}
+		case conversationclear.FieldTargetId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field targetId", values[i])
+			} else if value.Valid {
+				cc.TargetId = int(value.Int64)
+			}
+		case conversationclear.FieldClearTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field clearTime", values[i])
+			} else if value.Valid {
+				cc.ClearTime = value.Time
+			}
+		case conversationclear.FieldHidden:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field hidden", values[i])
+			} else if value.Valid {
+				cc.Hidden = value.Bool
+			}
+		default:
+			cc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ConversationClear.
+// This includes values selected through modifiers, order, etc.
+func (cc *ConversationClear) Value(name string) (ent.Value, error) {
+	return cc.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ConversationClear.
+// Note that you need to call ConversationClear.Unwrap() before calling this method if this ConversationClear
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cc *ConversationClear) Update() *ConversationClearUpdateOne {
+	return NewConversationClearClient(cc.config).UpdateOne(cc)
+}
+
+// Unwrap unwraps the ConversationClear entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cc *ConversationClear) Unwrap() *ConversationClear {
+	_tx, ok := cc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ConversationClear is not a transactional entity")
+	}
+	cc.config.driver = _tx.drv
+	return cc
+}
+
+// String implements the fmt.Stringer.
+func (cc *ConversationClear) String() string {
+	var builder strings.Builder
+	builder.WriteString("ConversationClear(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cc.ID))
+	builder.WriteString("userId=")
+	builder.WriteString(fmt.Sprintf("%v", cc.UserId))
+	builder.WriteString(", ")
+	builder.WriteString("isGroup=")
+	builder.WriteString(fmt.Sprintf("%v", cc.IsGroup))
+	builder.WriteString(", ")
+	builder.WriteString("targetId=")
+	builder.WriteString(fmt.Sprintf("%v", cc.TargetId))
+	builder.WriteString(", ")
+	builder.WriteString("clearTime=")
+	builder.WriteString(cc.ClearTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("hidden=")
+	builder.WriteString(fmt.Sprintf("%v", cc.Hidden))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ConversationClears is a parsable slice of ConversationClear.
+type ConversationClears []*ConversationClear
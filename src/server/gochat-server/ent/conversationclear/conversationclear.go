@@ -0,0 +1,86 @@
+// Code generated by ent, DO NOT EDIT.
+
+package conversationclear
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the conversationclear type in the database.
+	Label = "conversation_clear"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserId holds the string denoting the userid field in the database.
+	FieldUserId = "user_id"
+	// FieldIsGroup holds the string denoting the isgroup field in the database.
+	FieldIsGroup = "is_group"
+	// FieldTargetId holds the string denoting the targetid field in the database.
+	FieldTargetId = "target_id"
+	// FieldClearTime holds the string denoting the cleartime field in the database.
+	FieldClearTime = "clear_time"
+	// FieldHidden holds the string denoting the hidden field in the database.
+	FieldHidden = "hidden"
+	// Table holds the table name of the conversationclear in the database.
+	Table = "conversation_clears"
+)
+
+// Columns holds all SQL columns for conversationclear fields.
+var Columns = []string{
+	FieldID,
+	FieldUserId,
+	FieldIsGroup,
+	FieldTargetId,
+	FieldClearTime,
+	FieldHidden,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultIsGroup holds the default value on creation for the "isGroup" field.
+	DefaultIsGroup bool
+	// DefaultHidden holds the default value on creation for the "hidden" field.
+	DefaultHidden bool
+)
+
+// OrderOption defines the ordering options for the ConversationClear queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserId orders the results by the userId field.
+func ByUserId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserId, opts...).ToFunc()
+}
+
+// ByIsGroup orders the results by the isGroup field.
+func ByIsGroup(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsGroup, opts...).ToFunc()
+}
+
+// ByTargetId orders the results by the targetId field.
+func ByTargetId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetId, opts...).ToFunc()
+}
+
+// ByClearTime orders the results by the clearTime field.
+func ByClearTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClearTime, opts...).ToFunc()
+}
+
+// ByHidden orders the results by the hidden field.
+func ByHidden(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHidden, opts...).ToFunc()
+}
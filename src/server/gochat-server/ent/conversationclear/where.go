@@ -0,0 +1,235 @@
+// Code generated by ent, DO NOT EDIT.
+
+package conversationclear
+
+import (
+	"gochat_server/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.FieldLTE(FieldID, id))
+}
+
+// UserId applies equality check predicate on the "userId" field. It's identical to UserIdEQ.
+func UserId(v int) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.FieldEQ(FieldUserId, v))
+}
+
+// IsGroup applies equality check predicate on the "isGroup" field. It's identical to IsGroupEQ.
+func IsGroup(v bool) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.FieldEQ(FieldIsGroup, v))
+}
+
+// TargetId applies equality check predicate on the "targetId" field. It's identical to TargetIdEQ.
+func TargetId(v int) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.FieldEQ(FieldTargetId, v))
+}
+
+// ClearTime applies equality check predicate on the "clearTime" field. It's identical to ClearTimeEQ.
+func ClearTime(v time.Time) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.FieldEQ(FieldClearTime, v))
+}
+
+// Hidden applies equality check predicate on the "hidden" field. It's identical to HiddenEQ.
+func Hidden(v bool) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.FieldEQ(FieldHidden, v))
+}
+
+// UserIdEQ applies the EQ predicate on the "userId" field.
+func UserIdEQ(v int) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.FieldEQ(FieldUserId, v))
+}
+
+// UserIdNEQ applies the NEQ predicate on the "userId" field.
+func UserIdNEQ(v int) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.FieldNEQ(FieldUserId, v))
+}
+
+// UserIdIn applies the In predicate on the "userId" field.
+func UserIdIn(vs ...int) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.FieldIn(FieldUserId, vs...))
+}
+
+// UserIdNotIn applies the NotIn predicate on the "userId" field.
+func UserIdNotIn(vs ...int) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.FieldNotIn(FieldUserId, vs...))
+}
+
+// UserIdGT applies the GT predicate on the "userId" field.
+func UserIdGT(v int) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.FieldGT(FieldUserId, v))
+}
+
+// UserIdGTE applies the GTE predicate on the "userId" field.
+func UserIdGTE(v int) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.FieldGTE(FieldUserId, v))
+}
+
+// UserIdLT applies the LT predicate on the "userId" field.
+func UserIdLT(v int) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.FieldLT(FieldUserId, v))
+}
+
+// UserIdLTE applies the LTE predicate on the "userId" field.
+func UserIdLTE(v int) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.FieldLTE(FieldUserId, v))
+}
+
+// IsGroupEQ applies the EQ predicate on the "isGroup" field.
+func IsGroupEQ(v bool) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.FieldEQ(FieldIsGroup, v))
+}
+
+// IsGroupNEQ applies the NEQ predicate on the "isGroup" field.
+func IsGroupNEQ(v bool) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.FieldNEQ(FieldIsGroup, v))
+}
+
+// TargetIdEQ applies the EQ predicate on the "targetId" field.
+func TargetIdEQ(v int) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.FieldEQ(FieldTargetId, v))
+}
+
+// TargetIdNEQ applies the NEQ predicate on the "targetId" field.
+func TargetIdNEQ(v int) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.FieldNEQ(FieldTargetId, v))
+}
+
+// TargetIdIn applies the In predicate on the "targetId" field.
+func TargetIdIn(vs ...int) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.FieldIn(FieldTargetId, vs...))
+}
+
+// TargetIdNotIn applies the NotIn predicate on the "targetId" field.
+func TargetIdNotIn(vs ...int) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.FieldNotIn(FieldTargetId, vs...))
+}
+
+// TargetIdGT applies the GT predicate on the "targetId" field.
+func TargetIdGT(v int) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.FieldGT(FieldTargetId, v))
+}
+
+// TargetIdGTE applies the GTE predicate on the "targetId" field.
+func TargetIdGTE(v int) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.FieldGTE(FieldTargetId, v))
+}
+
+// TargetIdLT applies the LT predicate on the "targetId" field.
+func TargetIdLT(v int) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.FieldLT(FieldTargetId, v))
+}
+
+// TargetIdLTE applies the LTE predicate on the "targetId" field.
+func TargetIdLTE(v int) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.FieldLTE(FieldTargetId, v))
+}
+
+// ClearTimeEQ applies the EQ predicate on the "clearTime" field.
+func ClearTimeEQ(v time.Time) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.FieldEQ(FieldClearTime, v))
+}
+
+// ClearTimeNEQ applies the NEQ predicate on the "clearTime" field.
+func ClearTimeNEQ(v time.Time) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.FieldNEQ(FieldClearTime, v))
+}
+
+// ClearTimeIn applies the In predicate on the "clearTime" field.
+func ClearTimeIn(vs ...time.Time) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.FieldIn(FieldClearTime, vs...))
+}
+
+// ClearTimeNotIn applies the NotIn predicate on the "clearTime" field.
+func ClearTimeNotIn(vs ...time.Time) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.FieldNotIn(FieldClearTime, vs...))
+}
+
+// ClearTimeGT applies the GT predicate on the "clearTime" field.
+func ClearTimeGT(v time.Time) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.FieldGT(FieldClearTime, v))
+}
+
+// ClearTimeGTE applies the GTE predicate on the "clearTime" field.
+func ClearTimeGTE(v time.Time) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.FieldGTE(FieldClearTime, v))
+}
+
+// ClearTimeLT applies the LT predicate on the "clearTime" field.
+func ClearTimeLT(v time.Time) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.FieldLT(FieldClearTime, v))
+}
+
+// ClearTimeLTE applies the LTE predicate on the "clearTime" field.
+func ClearTimeLTE(v time.Time) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.FieldLTE(FieldClearTime, v))
+}
+
+// HiddenEQ applies the EQ predicate on the "hidden" field.
+func HiddenEQ(v bool) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.FieldEQ(FieldHidden, v))
+}
+
+// HiddenNEQ applies the NEQ predicate on the "hidden" field.
+func HiddenNEQ(v bool) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.FieldNEQ(FieldHidden, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ConversationClear) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ConversationClear) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ConversationClear) predicate.ConversationClear {
+	return predicate.ConversationClear(sql.NotPredicates(p))
+}
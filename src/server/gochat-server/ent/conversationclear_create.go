@@ -0,0 +1,266 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gochat_server/ent/conversationclear"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ConversationClearCreate is the builder for creating a ConversationClear entity.
+type ConversationClearCreate struct {
+	config
+	mutation *ConversationClearMutation
+	hooks    []Hook
+}
+
+// SetUserId sets the "userId" field.
+func (ccc *ConversationClearCreate) SetUserId(i int) *ConversationClearCreate {
+	ccc.mutation.SetUserId(i)
+	return ccc
+}
+
+// SetIsGroup sets the "isGroup" field.
+func (ccc *ConversationClearCreate) SetIsGroup(b bool) *ConversationClearCreate {
+	ccc.mutation.SetIsGroup(b)
+	return ccc
+}
+
+// SetNillableIsGroup sets the "isGroup" field if the given value is not nil.
+func (ccc *ConversationClearCreate) SetNillableIsGroup(b *bool) *ConversationClearCreate {
+	if b != nil {
+		ccc.SetIsGroup(*b)
+	}
+	return ccc
+}
+
+// SetTargetId sets the "targetId" field.
+func (ccc *ConversationClearCreate) SetTargetId(i int) *ConversationClearCreate {
+	ccc.mutation.SetTargetId(i)
+	return ccc
+}
+
+// SetClearTime sets the "clearTime" field.
+func (ccc *ConversationClearCreate) SetClearTime(t time.Time) *ConversationClearCreate {
+	ccc.mutation.SetClearTime(t)
+	return ccc
+}
+
+// SetHidden sets the "hidden" field.
+func (ccc *ConversationClearCreate) SetHidden(b bool) *ConversationClearCreate {
+	ccc.mutation.SetHidden(b)
+	return ccc
+}
+
+// SetNillableHidden sets the "hidden" field if the given value is not nil.
+func (ccc *ConversationClearCreate) SetNillableHidden(b *bool) *ConversationClearCreate {
+	if b != nil {
+		ccc.SetHidden(*b)
+	}
+	return ccc
+}
+
+// Mutation returns the ConversationClearMutation object of the builder.
+func (ccc *ConversationClearCreate) Mutation() *ConversationClearMutation {
+	return ccc.mutation
+}
+
+// Save creates the ConversationClear in the database.
+func (ccc *ConversationClearCreate) Save(ctx context.Context) (*ConversationClear, error) {
+	ccc.defaults()
+	return withHooks(ctx, ccc.sqlSave, ccc.mutation, ccc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ccc *ConversationClearCreate) SaveX(ctx context.Context) *ConversationClear {
+	v, err := ccc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ccc *ConversationClearCreate) Exec(ctx context.Context) error {
+	_, err := ccc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccc *ConversationClearCreate) ExecX(ctx context.Context) {
+	if err := ccc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ccc *ConversationClearCreate) defaults() {
+	if _, ok := ccc.mutation.IsGroup(); !ok {
+		v := conversationclear.DefaultIsGroup
+		ccc.mutation.SetIsGroup(v)
+	}
+	if _, ok := ccc.mutation.Hidden(); !ok {
+		v := conversationclear.DefaultHidden
+		ccc.mutation.SetHidden(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ccc *ConversationClearCreate) check() error {
+	if _, ok := ccc.mutation.UserId(); !ok {
+		return &ValidationError{Name: "userId", err: errors.New(`ent: missing required field "ConversationClear.userId"`)}
+	}
+	if _, ok := ccc.mutation.IsGroup(); !ok {
+		return &ValidationError{Name: "isGroup", err: errors.New(`ent: missing required field "ConversationClear.isGroup"`)}
+	}
+	if _, ok := ccc.mutation.TargetId(); !ok {
+		return &ValidationError{Name: "targetId", err: errors.New(`ent: missing required field "ConversationClear.targetId"`)}
+	}
+	if _, ok := ccc.mutation.ClearTime(); !ok {
+		return &ValidationError{Name: "clearTime", err: errors.New(`ent: missing required field "ConversationClear.clearTime"`)}
+	}
+	if _, ok := ccc.mutation.Hidden(); !ok {
+		return &ValidationError{Name: "hidden", err: errors.New(`ent: missing required field "ConversationClear.hidden"`)}
+	}
+	return nil
+}
+
+func (ccc *ConversationClearCreate) sqlSave(ctx context.Context) (*ConversationClear, error) {
+	if err := ccc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ccc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ccc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ccc.mutation.id = &_node.ID
+	ccc.mutation.done = true
+	return _node, nil
+}
+
+func (ccc *ConversationClearCreate) createSpec() (*ConversationClear, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ConversationClear{config: ccc.config}
+		_spec = sqlgraph.NewCreateSpec(conversationclear.Table, sqlgraph.NewFieldSpec(conversationclear.FieldID, field.TypeInt))
+	)
+	if value, ok := ccc.mutation.UserId(); ok {
+		_spec.SetField(conversationclear.FieldUserId, field.TypeInt, value)
+		_node.UserId = value
+	}
+	if value, ok := ccc.mutation.IsGroup(); ok {
+		_spec.SetField(conversationclear.FieldIsGroup, field.TypeBool, value)
+		_node.IsGroup = value
+	}
+	if value, ok := ccc.mutation.TargetId(); ok {
+		_spec.SetField(conversationclear.FieldTargetId, field.TypeInt, value)
+		_node.TargetId = value
+	}
+	if value, ok := ccc.mutation.ClearTime(); ok {
+		_spec.SetField(conversationclear.FieldClearTime, field.TypeTime, value)
+		_node.ClearTime = value
+	}
+	if value, ok := ccc.mutation.Hidden(); ok {
+		_spec.SetField(conversationclear.FieldHidden, field.TypeBool, value)
+		_node.Hidden = value
+	}
+	return _node, _spec
+}
+
+// ConversationClearCreateBulk is the builder for creating many ConversationClear entities in bulk.
+type ConversationClearCreateBulk struct {
+	config
+	err      error
+	builders []*ConversationClearCreate
+}
+
+// Save creates the ConversationClear entities in the database.
+func (cccb *ConversationClearCreateBulk) Save(ctx context.Context) ([]*ConversationClear, error) {
+	if cccb.err != nil {
+		return nil, cccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cccb.builders))
+	nodes := make([]*ConversationClear, len(cccb.builders))
+	mutators := make([]Mutator, len(cccb.builders))
+	for i := range cccb.builders {
+		func(i int, root context.Context) {
+			builder := cccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ConversationClearMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cccb *ConversationClearCreateBulk) SaveX(ctx context.Context) []*ConversationClear {
+	v, err := cccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cccb *ConversationClearCreateBulk) Exec(ctx context.Context) error {
+	_, err := cccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cccb *ConversationClearCreateBulk) ExecX(ctx context.Context) {
+	if err := cccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"gochat_server/ent/conversationclear"
+	"gochat_server/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ConversationClearDelete is the builder for deleting a ConversationClear entity.
+type ConversationClearDelete struct {
+	config
+	hooks    []Hook
+	mutation *ConversationClearMutation
+}
+
+// Where appends a list predicates to the ConversationClearDelete builder.
+func (ccd *ConversationClearDelete) Where(ps ...predicate.ConversationClear) *ConversationClearDelete {
+	ccd.mutation.Where(ps...)
+	return ccd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ccd *ConversationClearDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ccd.sqlExec, ccd.mutation, ccd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccd *ConversationClearDelete) ExecX(ctx context.Context) int {
+	n, err := ccd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ccd *ConversationClearDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(conversationclear.Table, sqlgraph.NewFieldSpec(conversationclear.FieldID, field.TypeInt))
+	if ps := ccd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ccd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ccd.mutation.done = true
+	return affected, err
+}
+
+// ConversationClearDeleteOne is the builder for deleting a single ConversationClear entity.
+type ConversationClearDeleteOne struct {
+	ccd *ConversationClearDelete
+}
+
+// Where appends a list predicates to the ConversationClearDelete builder.
+func (ccdo *ConversationClearDeleteOne) Where(ps ...predicate.ConversationClear) *ConversationClearDeleteOne {
+	ccdo.ccd.mutation.Where(ps...)
+	return ccdo
+}
+
+// Exec executes the deletion query.
+func (ccdo *ConversationClearDeleteOne) Exec(ctx context.Context) error {
+	n, err := ccdo.ccd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{conversationclear.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccdo *ConversationClearDeleteOne) ExecX(ctx context.Context) {
+	if err := ccdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"gochat_server/ent/conversationclear"
+	"gochat_server/ent/predicate"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ConversationClearQuery is the builder for querying ConversationClear entities.
+type ConversationClearQuery struct {
+	config
+	ctx        *QueryContext
+	order      []conversationclear.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ConversationClear
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ConversationClearQuery builder.
+func (ccq *ConversationClearQuery) Where(ps ...predicate.ConversationClear) *ConversationClearQuery {
+	ccq.predicates = append(ccq.predicates, ps...)
+	return ccq
+}
+
+// Limit the number of records to be returned by this query.
+func (ccq *ConversationClearQuery) Limit(limit int) *ConversationClearQuery {
+	ccq.ctx.Limit = &limit
+	return ccq
+}
+
+// Offset to start from.
+func (ccq *ConversationClearQuery) Offset(offset int) *ConversationClearQuery {
+	ccq.ctx.Offset = &offset
+	return ccq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ccq *ConversationClearQuery) Unique(unique bool) *ConversationClearQuery {
+	ccq.ctx.Unique = &unique
+	return ccq
+}
+
+// Order specifies how the records should be ordered.
+func (ccq *ConversationClearQuery) Order(o ...conversationclear.OrderOption) *ConversationClearQuery {
+	ccq.order = append(ccq.order, o...)
+	return ccq
+}
+
+// First returns the first ConversationClear entity from the query.
+// Returns a *NotFoundError when no ConversationClear was found.
+func (ccq *ConversationClearQuery) First(ctx context.Context) (*ConversationClear, error) {
+	nodes, err := ccq.Limit(1).All(setContextOp(ctx, ccq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{conversationclear.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ccq *ConversationClearQuery) FirstX(ctx context.Context) *ConversationClear {
+	node, err := ccq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ConversationClear ID from the query.
+// Returns a *NotFoundError when no ConversationClear ID was found.
+func (ccq *ConversationClearQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ccq.Limit(1).IDs(setContextOp(ctx, ccq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{conversationclear.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ccq *ConversationClearQuery) FirstIDX(ctx context.Context) int {
+	id, err := ccq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ConversationClear entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ConversationClear entity is found.
+// Returns a *NotFoundError when no ConversationClear entities are found.
+func (ccq *ConversationClearQuery) Only(ctx context.Context) (*ConversationClear, error) {
+	nodes, err := ccq.Limit(2).All(setContextOp(ctx, ccq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{conversationclear.Label}
+	default:
+		return nil, &NotSingularError{conversationclear.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ccq *ConversationClearQuery) OnlyX(ctx context.Context) *ConversationClear {
+	node, err := ccq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ConversationClear ID in the query.
+// Returns a *NotSingularError when more than one ConversationClear ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ccq *ConversationClearQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ccq.Limit(2).IDs(setContextOp(ctx, ccq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{conversationclear.Label}
+	default:
+		err = &NotSingularError{conversationclear.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ccq *ConversationClearQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ccq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ConversationClears.
+func (ccq *ConversationClearQuery) All(ctx context.Context) ([]*ConversationClear, error) {
+	ctx = setContextOp(ctx, ccq.ctx, ent.OpQueryAll)
+	if err := ccq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ConversationClear, *ConversationClearQuery]()
+	return withInterceptors[[]*ConversationClear](ctx, ccq, qr, ccq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ccq *ConversationClearQuery) AllX(ctx context.Context) []*ConversationClear {
+	nodes, err := ccq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ConversationClear IDs.
+func (ccq *ConversationClearQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if ccq.ctx.Unique == nil && ccq.path != nil {
+		ccq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ccq.ctx, ent.OpQueryIDs)
+	if err = ccq.Select(conversationclear.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ccq *ConversationClearQuery) IDsX(ctx context.Context) []int {
+	ids, err := ccq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ccq *ConversationClearQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ccq.ctx, ent.OpQueryCount)
+	if err := ccq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ccq, querierCount[*ConversationClearQuery](), ccq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ccq *ConversationClearQuery) CountX(ctx context.Context) int {
+	count, err := ccq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ccq *ConversationClearQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ccq.ctx, ent.OpQueryExist)
+	switch _, err := ccq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ccq *ConversationClearQuery) ExistX(ctx context.Context) bool {
+	exist, err := ccq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ConversationClearQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ccq *ConversationClearQuery) Clone() *ConversationClearQuery {
+	if ccq == nil {
+		return nil
+	}
+	return &ConversationClearQuery{
+		config:     ccq.config,
+		ctx:        ccq.ctx.Clone(),
+		order:      append([]conversationclear.OrderOption{}, ccq.order...),
+		inters:     append([]Interceptor{}, ccq.inters...),
+		predicates: append([]predicate.ConversationClear{}, ccq.predicates...),
+		// clone intermediate query.
+		sql:  ccq.sql.Clone(),
+		path: ccq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserId int `json:"userId,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ConversationClear.Query().
+//		GroupBy(conversationclear.FieldUserId).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ccq *ConversationClearQuery) GroupBy(field string, fields ...string) *ConversationClearGroupBy {
+	ccq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ConversationClearGroupBy{build: ccq}
+	grbuild.flds = &ccq.ctx.Fields
+	grbuild.label = conversationclear.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserId int `json:"userId,omitempty"`
+//	}
+//
+//	client.ConversationClear.Query().
+//		Select(conversationclear.FieldUserId).
+//		Scan(ctx, &v)
+func (ccq *ConversationClearQuery) Select(fields ...string) *ConversationClearSelect {
+	ccq.ctx.Fields = append(ccq.ctx.Fields, fields...)
+	sbuild := &ConversationClearSelect{ConversationClearQuery: ccq}
+	sbuild.label = conversationclear.Label
+	sbuild.flds, sbuild.scan = &ccq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ConversationClearSelect configured with the given aggregations.
+func (ccq *ConversationClearQuery) Aggregate(fns ...AggregateFunc) *ConversationClearSelect {
+	return ccq.Select().Aggregate(fns...)
+}
+
+func (ccq *ConversationClearQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ccq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ccq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ccq.ctx.Fields {
+		if !conversationclear.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ccq.path != nil {
+		prev, err := ccq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ccq.sql = prev
+	}
+	return nil
+}
+
+func (ccq *ConversationClearQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ConversationClear, error) {
+	var (
+		nodes = []*ConversationClear{}
+		_spec = ccq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ConversationClear).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ConversationClear{config: ccq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ccq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ccq *ConversationClearQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ccq.querySpec()
+	_spec.Node.Columns = ccq.ctx.Fields
+	if len(ccq.ctx.Fields) > 0 {
+		_spec.Unique = ccq.ctx.Unique != nil && *ccq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ccq.driver, _spec)
+}
+
+func (ccq *ConversationClearQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(conversationclear.Table, conversationclear.Columns, sqlgraph.NewFieldSpec(conversationclear.FieldID, field.TypeInt))
+	_spec.From = ccq.sql
+	if unique := ccq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ccq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ccq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, conversationclear.FieldID)
+		for i := range fields {
+			if fields[i] != conversationclear.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ccq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ccq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ccq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ccq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ccq *ConversationClearQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ccq.driver.Dialect())
+	t1 := builder.Table(conversationclear.Table)
+	columns := ccq.ctx.Fields
+	if len(columns) == 0 {
+		columns = conversationclear.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ccq.sql != nil {
+		selector = ccq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ccq.ctx.Unique != nil && *ccq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ccq.predicates {
+		p(selector)
+	}
+	for _, p := range ccq.order {
+		p(selector)
+	}
+	if offset := ccq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ccq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ConversationClearGroupBy is the group-by builder for ConversationClear entities.
+type ConversationClearGroupBy struct {
+	selector
+	build *ConversationClearQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ccgb *ConversationClearGroupBy) Aggregate(fns ...AggregateFunc) *ConversationClearGroupBy {
+	ccgb.fns = append(ccgb.fns, fns...)
+	return ccgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ccgb *ConversationClearGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ccgb.build.ctx, ent.OpQueryGroupBy)
+	if err := ccgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ConversationClearQuery, *ConversationClearGroupBy](ctx, ccgb.build, ccgb, ccgb.build.inters, v)
+}
+
+func (ccgb *ConversationClearGroupBy) sqlScan(ctx context.Context, root *ConversationClearQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ccgb.fns))
+	for _, fn := range ccgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ccgb.flds)+len(ccgb.fns))
+		for _, f := range *ccgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ccgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ccgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ConversationClearSelect is the builder for selecting fields of ConversationClear entities.
+type ConversationClearSelect struct {
+	*ConversationClearQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ccs *ConversationClearSelect) Aggregate(fns ...AggregateFunc) *ConversationClearSelect {
+	ccs.fns = append(ccs.fns, fns...)
+	return ccs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ccs *ConversationClearSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ccs.ctx, ent.OpQuerySelect)
+	if err := ccs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ConversationClearQuery, *ConversationClearSelect](ctx, ccs.ConversationClearQuery, ccs, ccs.inters, v)
+}
+
+func (ccs *ConversationClearSelect) sqlScan(ctx context.Context, root *ConversationClearQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ccs.fns))
+	for _, fn := range ccs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ccs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ccs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
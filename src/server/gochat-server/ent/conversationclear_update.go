@@ -0,0 +1,386 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gochat_server/ent/conversationclear"
+	"gochat_server/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ConversationClearUpdate is the builder for updating ConversationClear entities.
+type ConversationClearUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ConversationClearMutation
+}
+
+// Where appends a list predicates to the ConversationClearUpdate builder.
+func (ccu *ConversationClearUpdate) Where(ps ...predicate.ConversationClear) *ConversationClearUpdate {
+	ccu.mutation.Where(ps...)
+	return ccu
+}
+
+// SetUserId sets the "userId" field.
+func (ccu *ConversationClearUpdate) SetUserId(i int) *ConversationClearUpdate {
+	ccu.mutation.ResetUserId()
+	ccu.mutation.SetUserId(i)
+	return ccu
+}
+
+// SetNillableUserId sets the "userId" field if the given value is not nil.
+func (ccu *ConversationClearUpdate) SetNillableUserId(i *int) *ConversationClearUpdate {
+	if i != nil {
+		ccu.SetUserId(*i)
+	}
+	return ccu
+}
+
+// AddUserId adds i to the "userId" field.
+func (ccu *ConversationClearUpdate) AddUserId(i int) *ConversationClearUpdate {
+	ccu.mutation.AddUserId(i)
+	return ccu
+}
+
+// SetIsGroup sets the "isGroup" field.
+func (ccu *ConversationClearUpdate) SetIsGroup(b bool) *ConversationClearUpdate {
+	ccu.mutation.SetIsGroup(b)
+	return ccu
+}
+
+// SetNillableIsGroup sets the "isGroup" field if the given value is not nil.
+func (ccu *ConversationClearUpdate) SetNillableIsGroup(b *bool) *ConversationClearUpdate {
+	if b != nil {
+		ccu.SetIsGroup(*b)
+	}
+	return ccu
+}
+
+// SetTargetId sets the "targetId" field.
+func (ccu *ConversationClearUpdate) SetTargetId(i int) *ConversationClearUpdate {
+	ccu.mutation.ResetTargetId()
+	ccu.mutation.SetTargetId(i)
+	return ccu
+}
+
+// SetNillableTargetId sets the "targetId" field if the given value is not nil.
+func (ccu *ConversationClearUpdate) SetNillableTargetId(i *int) *ConversationClearUpdate {
+	if i != nil {
+		ccu.SetTargetId(*i)
+	}
+	return ccu
+}
+
+// AddTargetId adds i to the "targetId" field.
+func (ccu *ConversationClearUpdate) AddTargetId(i int) *ConversationClearUpdate {
+	ccu.mutation.AddTargetId(i)
+	return ccu
+}
+
+// SetClearTime sets the "clearTime" field.
+func (ccu *ConversationClearUpdate) SetClearTime(t time.Time) *ConversationClearUpdate {
+	ccu.mutation.SetClearTime(t)
+	return ccu
+}
+
+// SetNillableClearTime sets the "clearTime" field if the given value is not nil.
+func (ccu *ConversationClearUpdate) SetNillableClearTime(t *time.Time) *ConversationClearUpdate {
+	if t != nil {
+		ccu.SetClearTime(*t)
+	}
+	return ccu
+}
+
+// SetHidden sets the "hidden" field.
+func (ccu *ConversationClearUpdate) SetHidden(b bool) *ConversationClearUpdate {
+	ccu.mutation.SetHidden(b)
+	return ccu
+}
+
+// SetNillableHidden sets the "hidden" field if the given value is not nil.
+func (ccu *ConversationClearUpdate) SetNillableHidden(b *bool) *ConversationClearUpdate {
+	if b != nil {
+		ccu.SetHidden(*b)
+	}
+	return ccu
+}
+
+// Mutation returns the ConversationClearMutation object of the builder.
+func (ccu *ConversationClearUpdate) Mutation() *ConversationClearMutation {
+	return ccu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ccu *ConversationClearUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ccu.sqlSave, ccu.mutation, ccu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccu *ConversationClearUpdate) SaveX(ctx context.Context) int {
+	affected, err := ccu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ccu *ConversationClearUpdate) Exec(ctx context.Context) error {
+	_, err := ccu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccu *ConversationClearUpdate) ExecX(ctx context.Context) {
+	if err := ccu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ccu *ConversationClearUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(conversationclear.Table, conversationclear.Columns, sqlgraph.NewFieldSpec(conversationclear.FieldID, field.TypeInt))
+	if ps := ccu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ccu.mutation.UserId(); ok {
+		_spec.SetField(conversationclear.FieldUserId, field.TypeInt, value)
+	}
+	if value, ok := ccu.mutation.AddedUserId(); ok {
+		_spec.AddField(conversationclear.FieldUserId, field.TypeInt, value)
+	}
+	if value, ok := ccu.mutation.IsGroup(); ok {
+		_spec.SetField(conversationclear.FieldIsGroup, field.TypeBool, value)
+	}
+	if value, ok := ccu.mutation.TargetId(); ok {
+		_spec.SetField(conversationclear.FieldTargetId, field.TypeInt, value)
+	}
+	if value, ok := ccu.mutation.AddedTargetId(); ok {
+		_spec.AddField(conversationclear.FieldTargetId, field.TypeInt, value)
+	}
+	if value, ok := ccu.mutation.ClearTime(); ok {
+		_spec.SetField(conversationclear.FieldClearTime, field.TypeTime, value)
+	}
+	if value, ok := ccu.mutation.Hidden(); ok {
+		_spec.SetField(conversationclear.FieldHidden, field.TypeBool, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ccu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{conversationclear.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ccu.mutation.done = true
+	return n, nil
+}
+
+// ConversationClearUpdateOne is the builder for updating a single ConversationClear entity.
+type ConversationClearUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ConversationClearMutation
+}
+
+// SetUserId sets the "userId" field.
+func (ccuo *ConversationClearUpdateOne) SetUserId(i int) *ConversationClearUpdateOne {
+	ccuo.mutation.ResetUserId()
+	ccuo.mutation.SetUserId(i)
+	return ccuo
+}
+
+// SetNillableUserId sets the "userId" field if the given value is not nil.
+func (ccuo *ConversationClearUpdateOne) SetNillableUserId(i *int) *ConversationClearUpdateOne {
+	if i != nil {
+		ccuo.SetUserId(*i)
+	}
+	return ccuo
+}
+
+// AddUserId adds i to the "userId" field.
+func (ccuo *ConversationClearUpdateOne) AddUserId(i int) *ConversationClearUpdateOne {
+	ccuo.mutation.AddUserId(i)
+	return ccuo
+}
+
+// SetIsGroup sets the "isGroup" field.
+func (ccuo *ConversationClearUpdateOne) SetIsGroup(b bool) *ConversationClearUpdateOne {
+	ccuo.mutation.SetIsGroup(b)
+	return ccuo
+}
+
+// SetNillableIsGroup sets the "isGroup" field if the given value is not nil.
+func (ccuo *ConversationClearUpdateOne) SetNillableIsGroup(b *bool) *ConversationClearUpdateOne {
+	if b != nil {
+		ccuo.SetIsGroup(*b)
+	}
+	return ccuo
+}
+
+// SetTargetId sets the "targetId" field.
+func (ccuo *ConversationClearUpdateOne) SetTargetId(i int) *ConversationClearUpdateOne {
+	ccuo.mutation.ResetTargetId()
+	ccuo.mutation.SetTargetId(i)
+	return ccuo
+}
+
+// SetNillableTargetId sets the "targetId" field if the given value is not nil.
+func (ccuo *ConversationClearUpdateOne) SetNillableTargetId(i *int) *ConversationClearUpdateOne {
+	if i != nil {
+		ccuo.SetTargetId(*i)
+	}
+	return ccuo
+}
+
+// AddTargetId adds i to the "targetId" field.
+func (ccuo *ConversationClearUpdateOne) AddTargetId(i int) *ConversationClearUpdateOne {
+	ccuo.mutation.AddTargetId(i)
+	return ccuo
+}
+
+// SetClearTime sets the "clearTime" field.
+func (ccuo *ConversationClearUpdateOne) SetClearTime(t time.Time) *ConversationClearUpdateOne {
+	ccuo.mutation.SetClearTime(t)
+	return ccuo
+}
+
+// SetNillableClearTime sets the "clearTime" field if the given value is not nil.
+func (ccuo *ConversationClearUpdateOne) SetNillableClearTime(t *time.Time) *ConversationClearUpdateOne {
+	if t != nil {
+		ccuo.SetClearTime(*t)
+	}
+	return ccuo
+}
+
+// SetHidden sets the "hidden" field.
+func (ccuo *ConversationClearUpdateOne) SetHidden(b bool) *ConversationClearUpdateOne {
+	ccuo.mutation.SetHidden(b)
+	return ccuo
+}
+
+// SetNillableHidden sets the "hidden" field if the given value is not nil.
+func (ccuo *ConversationClearUpdateOne) SetNillableHidden(b *bool) *ConversationClearUpdateOne {
+	if b != nil {
+		ccuo.SetHidden(*b)
+	}
+	return ccuo
+}
+
+// Mutation returns the ConversationClearMutation object of the builder.
+func (ccuo *ConversationClearUpdateOne) Mutation() *ConversationClearMutation {
+	return ccuo.mutation
+}
+
+// Where appends a list predicates to the ConversationClearUpdate builder.
+func (ccuo *ConversationClearUpdateOne) Where(ps ...predicate.ConversationClear) *ConversationClearUpdateOne {
+	ccuo.mutation.Where(ps...)
+	return ccuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ccuo *ConversationClearUpdateOne) Select(field string, fields ...string) *ConversationClearUpdateOne {
+	ccuo.fields = append([]string{field}, fields...)
+	return ccuo
+}
+
+// Save executes the query and returns the updated ConversationClear entity.
+func (ccuo *ConversationClearUpdateOne) Save(ctx context.Context) (*ConversationClear, error) {
+	return withHooks(ctx, ccuo.sqlSave, ccuo.mutation, ccuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccuo *ConversationClearUpdateOne) SaveX(ctx context.Context) *ConversationClear {
+	node, err := ccuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ccuo *ConversationClearUpdateOne) Exec(ctx context.Context) error {
+	_, err := ccuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccuo *ConversationClearUpdateOne) ExecX(ctx context.Context) {
+	if err := ccuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ccuo *ConversationClearUpdateOne) sqlSave(ctx context.Context) (_node *ConversationClear, err error) {
+	_spec := sqlgraph.NewUpdateSpec(conversationclear.Table, conversationclear.Columns, sqlgraph.NewFieldSpec(conversationclear.FieldID, field.TypeInt))
+	id, ok := ccuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ConversationClear.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ccuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, conversationclear.FieldID)
+		for _, f := range fields {
+			if !conversationclear.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != conversationclear.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ccuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ccuo.mutation.UserId(); ok {
+		_spec.SetField(conversationclear.FieldUserId, field.TypeInt, value)
+	}
+	if value, ok := ccuo.mutation.AddedUserId(); ok {
+		_spec.AddField(conversationclear.FieldUserId, field.TypeInt, value)
+	}
+	if value, ok := ccuo.mutation.IsGroup(); ok {
+		_spec.SetField(conversationclear.FieldIsGroup, field.TypeBool, value)
+	}
+	if value, ok := ccuo.mutation.TargetId(); ok {
+		_spec.SetField(conversationclear.FieldTargetId, field.TypeInt, value)
+	}
+	if value, ok := ccuo.mutation.AddedTargetId(); ok {
+		_spec.AddField(conversationclear.FieldTargetId, field.TypeInt, value)
+	}
+	if value, ok := ccuo.mutation.ClearTime(); ok {
+		_spec.SetField(conversationclear.FieldClearTime, field.TypeTime, value)
+	}
+	if value, ok := ccuo.mutation.Hidden(); ok {
+		_spec.SetField(conversationclear.FieldHidden, field.TypeBool, value)
+	}
+	_node = &ConversationClear{config: ccuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ccuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{conversationclear.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ccuo.mutation.done = true
+	return _node, nil
+}
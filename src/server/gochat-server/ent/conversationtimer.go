@@ -0,0 +1,152 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"gochat_server/ent/conversationtimer"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ConversationTimer is the model entity for the ConversationTimer schema.
+type ConversationTimer struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 会话ID
+	ConversationId string `json:"conversationId,omitempty"`
+	// 是否为群聊会话
+	IsGroup bool `json:"isGroup,omitempty"`
+	// 消息保留时长(秒)，0表示关闭
+	TtlSeconds int `json:"ttlSeconds,omitempty"`
+	// 最后设置的用户ID
+	UpdateUserId int `json:"updateUserId,omitempty"`
+	// 最后设置时间
+	UpdateTime   time.Time `json:"updateTime,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ConversationTimer) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case conversationtimer.FieldIsGroup:
+			values[i] = new(sql.NullBool)
+		case conversationtimer.FieldID, conversationtimer.FieldTtlSeconds, conversationtimer.FieldUpdateUserId:
+			values[i] = new(sql.NullInt64)
+		case conversationtimer.FieldConversationId:
+			values[i] = new(sql.NullString)
+		case conversationtimer.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ConversationTimer fields.
+func (ct *ConversationTimer) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case conversationtimer.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ct.ID = int(value.Int64)
+		case conversationtimer.FieldConversationId:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field conversationId", values[i])
+			} else if value.Valid {
+				ct.ConversationId = value.String
+			}
+		case conversationtimer.FieldIsGroup:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field isGroup", values[i])
+			} else if value.Valid {
+				ct.IsGroup = value.Bool
+			}
+		case conversationtimer.FieldTtlSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field ttlSeconds", values[i])
+			} else if value.Valid {
+				ct.TtlSeconds = int(value.Int64)
+			}
+		case conversationtimer.FieldUpdateUserId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updateUserId", values[i])
+			} else if value.Valid {
+				ct.UpdateUserId = int(value.Int64)
+			}
+		case conversationtimer.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updateTime", values[i])
+			} else if value.Valid {
+				ct.UpdateTime = value.Time
+			}
+		default:
+			ct.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ConversationTimer.
+// This includes values selected through modifiers, order, etc.
+func (ct *ConversationTimer) Value(name string) (ent.Value, error) {
+	return ct.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ConversationTimer.
+// Note that you need to call ConversationTimer.Unwrap() before calling this method if this ConversationTimer
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ct *ConversationTimer) Update() *ConversationTimerUpdateOne {
+	return NewConversationTimerClient(ct.config).UpdateOne(ct)
+}
+
+// Unwrap unwraps the ConversationTimer entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ct *ConversationTimer) Unwrap() *ConversationTimer {
+	_tx, ok := ct.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ConversationTimer is not a transactional entity")
+	}
+	ct.config.driver = _tx.drv
+	return ct
+}
+
+// String implements the fmt.Stringer.
+func (ct *ConversationTimer) String() string {
+	var builder strings.Builder
+	builder.WriteString("ConversationTimer(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ct.ID))
+	builder.WriteString("conversationId=")
+	builder.WriteString(ct.ConversationId)
+	builder.WriteString(", ")
+	builder.WriteString("isGroup=")
+	builder.WriteString(fmt.Sprintf("%v", ct.IsGroup))
+	builder.WriteString(", ")
+	builder.WriteString("ttlSeconds=")
+	builder.WriteString(fmt.Sprintf("%v", ct.TtlSeconds))
+	builder.WriteString(", ")
+	builder.WriteString("updateUserId=")
+	builder.WriteString(fmt.Sprintf("%v", ct.UpdateUserId))
+	builder.WriteString(", ")
+	builder.WriteString("updateTime=")
+	builder.WriteString(ct.UpdateTime.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ConversationTimers is a parsable slice of ConversationTimer.
+type ConversationTimers []*ConversationTimer
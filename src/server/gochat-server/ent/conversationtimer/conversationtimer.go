@@ -0,0 +1,92 @@
+// Code generated by ent, DO NOT EDIT.
+
+package conversationtimer
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the conversationtimer type in the database.
+	Label = "conversation_timer"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldConversationId holds the string denoting the conversationid field in the database.
+	FieldConversationId = "conversation_id"
+	// FieldIsGroup holds the string denoting the isgroup field in the database.
+	FieldIsGroup = "is_group"
+	// FieldTtlSeconds holds the string denoting the ttlseconds field in the database.
+	FieldTtlSeconds = "ttl_seconds"
+	// FieldUpdateUserId holds the string denoting the updateuserid field in the database.
+	FieldUpdateUserId = "update_user_id"
+	// FieldUpdateTime holds the string denoting the updatetime field in the database.
+	FieldUpdateTime = "update_time"
+	// Table holds the table name of the conversationtimer in the database.
+	Table = "conversation_timers"
+)
+
+// Columns holds all SQL columns for conversationtimer fields.
+var Columns = []string{
+	FieldID,
+	FieldConversationId,
+	FieldIsGroup,
+	FieldTtlSeconds,
+	FieldUpdateUserId,
+	FieldUpdateTime,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ConversationIdValidator is a validator for the "conversationId" field. It is called by the builders before save.
+	ConversationIdValidator func(string) error
+	// DefaultIsGroup holds the default value on creation for the "isGroup" field.
+	DefaultIsGroup bool
+	// DefaultTtlSeconds holds the default value on creation for the "ttlSeconds" field.
+	DefaultTtlSeconds int
+	// DefaultUpdateTime holds the default value on creation for the "updateTime" field.
+	DefaultUpdateTime func() time.Time
+)
+
+// OrderOption defines the ordering options for the ConversationTimer queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByConversationId orders the results by the conversationId field.
+func ByConversationId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConversationId, opts...).ToFunc()
+}
+
+// ByIsGroup orders the results by the isGroup field.
+func ByIsGroup(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsGroup, opts...).ToFunc()
+}
+
+// ByTtlSeconds orders the results by the ttlSeconds field.
+func ByTtlSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTtlSeconds, opts...).ToFunc()
+}
+
+// ByUpdateUserId orders the results by the updateUserId field.
+func ByUpdateUserId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateUserId, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the updateTime field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
@@ -0,0 +1,290 @@
+// Code generated by ent, DO NOT EDIT.
+
+package conversationtimer
+
+import (
+	"gochat_server/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldLTE(FieldID, id))
+}
+
+// ConversationId applies equality check predicate on the "conversationId" field. It's identical to ConversationIdEQ.
+func ConversationId(v string) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldEQ(FieldConversationId, v))
+}
+
+// IsGroup applies equality check predicate on the "isGroup" field. It's identical to IsGroupEQ.
+func IsGroup(v bool) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldEQ(FieldIsGroup, v))
+}
+
+// TtlSeconds applies equality check predicate on the "ttlSeconds" field. It's identical to TtlSecondsEQ.
+func TtlSeconds(v int) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldEQ(FieldTtlSeconds, v))
+}
+
+// UpdateUserId applies equality check predicate on the "updateUserId" field. It's identical to UpdateUserIdEQ.
+func UpdateUserId(v int) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldEQ(FieldUpdateUserId, v))
+}
+
+// UpdateTime applies equality check predicate on the "updateTime" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// ConversationIdEQ applies the EQ predicate on the "conversationId" field.
+func ConversationIdEQ(v string) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldEQ(FieldConversationId, v))
+}
+
+// ConversationIdNEQ applies the NEQ predicate on the "conversationId" field.
+func ConversationIdNEQ(v string) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldNEQ(FieldConversationId, v))
+}
+
+// ConversationIdIn applies the In predicate on the "conversationId" field.
+func ConversationIdIn(vs ...string) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldIn(FieldConversationId, vs...))
+}
+
+// ConversationIdNotIn applies the NotIn predicate on the "conversationId" field.
+func ConversationIdNotIn(vs ...string) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldNotIn(FieldConversationId, vs...))
+}
+
+// ConversationIdGT applies the GT predicate on the "conversationId" field.
+func ConversationIdGT(v string) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldGT(FieldConversationId, v))
+}
+
+// ConversationIdGTE applies the GTE predicate on the "conversationId" field.
+func ConversationIdGTE(v string) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldGTE(FieldConversationId, v))
+}
+
+// ConversationIdLT applies the LT predicate on the "conversationId" field.
+func ConversationIdLT(v string) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldLT(FieldConversationId, v))
+}
+
+// ConversationIdLTE applies the LTE predicate on the "conversationId" field.
+func ConversationIdLTE(v string) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldLTE(FieldConversationId, v))
+}
+
+// ConversationIdContains applies the Contains predicate on the "conversationId" field.
+func ConversationIdContains(v string) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldContains(FieldConversationId, v))
+}
+
+// ConversationIdHasPrefix applies the HasPrefix predicate on the "conversationId" field.
+func ConversationIdHasPrefix(v string) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldHasPrefix(FieldConversationId, v))
+}
+
+// ConversationIdHasSuffix applies the HasSuffix predicate on the "conversationId" field.
+func ConversationIdHasSuffix(v string) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldHasSuffix(FieldConversationId, v))
+}
+
+// ConversationIdEqualFold applies the EqualFold predicate on the "conversationId" field.
+func ConversationIdEqualFold(v string) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldEqualFold(FieldConversationId, v))
+}
+
+// ConversationIdContainsFold applies the ContainsFold predicate on the "conversationId" field.
+func ConversationIdContainsFold(v string) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldContainsFold(FieldConversationId, v))
+}
+
+// IsGroupEQ applies the EQ predicate on the "isGroup" field.
+func IsGroupEQ(v bool) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldEQ(FieldIsGroup, v))
+}
+
+// IsGroupNEQ applies the NEQ predicate on the "isGroup" field.
+func IsGroupNEQ(v bool) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldNEQ(FieldIsGroup, v))
+}
+
+// TtlSecondsEQ applies the EQ predicate on the "ttlSeconds" field.
+func TtlSecondsEQ(v int) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldEQ(FieldTtlSeconds, v))
+}
+
+// TtlSecondsNEQ applies the NEQ predicate on the "ttlSeconds" field.
+func TtlSecondsNEQ(v int) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldNEQ(FieldTtlSeconds, v))
+}
+
+// TtlSecondsIn applies the In predicate on the "ttlSeconds" field.
+func TtlSecondsIn(vs ...int) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldIn(FieldTtlSeconds, vs...))
+}
+
+// TtlSecondsNotIn applies the NotIn predicate on the "ttlSeconds" field.
+func TtlSecondsNotIn(vs ...int) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldNotIn(FieldTtlSeconds, vs...))
+}
+
+// TtlSecondsGT applies the GT predicate on the "ttlSeconds" field.
+func TtlSecondsGT(v int) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldGT(FieldTtlSeconds, v))
+}
+
+// TtlSecondsGTE applies the GTE predicate on the "ttlSeconds" field.
+func TtlSecondsGTE(v int) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldGTE(FieldTtlSeconds, v))
+}
+
+// TtlSecondsLT applies the LT predicate on the "ttlSeconds" field.
+func TtlSecondsLT(v int) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldLT(FieldTtlSeconds, v))
+}
+
+// TtlSecondsLTE applies the LTE predicate on the "ttlSeconds" field.
+func TtlSecondsLTE(v int) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldLTE(FieldTtlSeconds, v))
+}
+
+// UpdateUserIdEQ applies the EQ predicate on the "updateUserId" field.
+func UpdateUserIdEQ(v int) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldEQ(FieldUpdateUserId, v))
+}
+
+// UpdateUserIdNEQ applies the NEQ predicate on the "updateUserId" field.
+func UpdateUserIdNEQ(v int) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldNEQ(FieldUpdateUserId, v))
+}
+
+// UpdateUserIdIn applies the In predicate on the "updateUserId" field.
+func UpdateUserIdIn(vs ...int) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldIn(FieldUpdateUserId, vs...))
+}
+
+// UpdateUserIdNotIn applies the NotIn predicate on the "updateUserId" field.
+func UpdateUserIdNotIn(vs ...int) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldNotIn(FieldUpdateUserId, vs...))
+}
+
+// UpdateUserIdGT applies the GT predicate on the "updateUserId" field.
+func UpdateUserIdGT(v int) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldGT(FieldUpdateUserId, v))
+}
+
+// UpdateUserIdGTE applies the GTE predicate on the "updateUserId" field.
+func UpdateUserIdGTE(v int) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldGTE(FieldUpdateUserId, v))
+}
+
+// UpdateUserIdLT applies the LT predicate on the "updateUserId" field.
+func UpdateUserIdLT(v int) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldLT(FieldUpdateUserId, v))
+}
+
+// UpdateUserIdLTE applies the LTE predicate on the "updateUserId" field.
+func UpdateUserIdLTE(v int) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldLTE(FieldUpdateUserId, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "updateTime" field.
+func UpdateTimeEQ(v time.Time) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "updateTime" field.
+func UpdateTimeNEQ(v time.Time) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "updateTime" field.
+func UpdateTimeIn(vs ...time.Time) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "updateTime" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "updateTime" field.
+func UpdateTimeGT(v time.Time) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "updateTime" field.
+func UpdateTimeGTE(v time.Time) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "updateTime" field.
+func UpdateTimeLT(v time.Time) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "updateTime" field.
+func UpdateTimeLTE(v time.Time) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ConversationTimer) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ConversationTimer) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ConversationTimer) predicate.ConversationTimer {
+	return predicate.ConversationTimer(sql.NotPredicates(p))
+}
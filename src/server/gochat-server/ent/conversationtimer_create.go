@@ -0,0 +1,283 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gochat_server/ent/conversationtimer"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ConversationTimerCreate is the builder for creating a ConversationTimer entity.
+type ConversationTimerCreate struct {
+	config
+	mutation *ConversationTimerMutation
+	hooks    []Hook
+}
+
+// SetConversationId sets the "conversationId" field.
+func (ctc *ConversationTimerCreate) SetConversationId(s string) *ConversationTimerCreate {
+	ctc.mutation.SetConversationId(s)
+	return ctc
+}
+
+// SetIsGroup sets the "isGroup" field.
+func (ctc *ConversationTimerCreate) SetIsGroup(b bool) *ConversationTimerCreate {
+	ctc.mutation.SetIsGroup(b)
+	return ctc
+}
+
+// SetNillableIsGroup sets the "isGroup" field if the given value is not nil.
+func (ctc *ConversationTimerCreate) SetNillableIsGroup(b *bool) *ConversationTimerCreate {
+	if b != nil {
+		ctc.SetIsGroup(*b)
+	}
+	return ctc
+}
+
+// SetTtlSeconds sets the "ttlSeconds" field.
+func (ctc *ConversationTimerCreate) SetTtlSeconds(i int) *ConversationTimerCreate {
+	ctc.mutation.SetTtlSeconds(i)
+	return ctc
+}
+
+// SetNillableTtlSeconds sets the "ttlSeconds" field if the given value is not nil.
+func (ctc *ConversationTimerCreate) SetNillableTtlSeconds(i *int) *ConversationTimerCreate {
+	if i != nil {
+		ctc.SetTtlSeconds(*i)
+	}
+	return ctc
+}
+
+// SetUpdateUserId sets the "updateUserId" field.
+func (ctc *ConversationTimerCreate) SetUpdateUserId(i int) *ConversationTimerCreate {
+	ctc.mutation.SetUpdateUserId(i)
+	return ctc
+}
+
+// SetUpdateTime sets the "updateTime" field.
+func (ctc *ConversationTimerCreate) SetUpdateTime(t time.Time) *ConversationTimerCreate {
+	ctc.mutation.SetUpdateTime(t)
+	return ctc
+}
+
+// SetNillableUpdateTime sets the "updateTime" field if the given value is not nil.
+func (ctc *ConversationTimerCreate) SetNillableUpdateTime(t *time.Time) *ConversationTimerCreate {
+	if t != nil {
+		ctc.SetUpdateTime(*t)
+	}
+	return ctc
+}
+
+// Mutation returns the ConversationTimerMutation object of the builder.
+func (ctc *ConversationTimerCreate) Mutation() *ConversationTimerMutation {
+	return ctc.mutation
+}
+
+// Save creates the ConversationTimer in the database.
+func (ctc *ConversationTimerCreate) Save(ctx context.Context) (*ConversationTimer, error) {
+	ctc.defaults()
+	return withHooks(ctx, ctc.sqlSave, ctc.mutation, ctc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ctc *ConversationTimerCreate) SaveX(ctx context.Context) *ConversationTimer {
+	v, err := ctc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ctc *ConversationTimerCreate) Exec(ctx context.Context) error {
+	_, err := ctc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ctc *ConversationTimerCreate) ExecX(ctx context.Context) {
+	if err := ctc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ctc *ConversationTimerCreate) defaults() {
+	if _, ok := ctc.mutation.IsGroup(); !ok {
+		v := conversationtimer.DefaultIsGroup
+		ctc.mutation.SetIsGroup(v)
+	}
+	if _, ok := ctc.mutation.TtlSeconds(); !ok {
+		v := conversationtimer.DefaultTtlSeconds
+		ctc.mutation.SetTtlSeconds(v)
+	}
+	if _, ok := ctc.mutation.UpdateTime(); !ok {
+		v := conversationtimer.DefaultUpdateTime()
+		ctc.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ctc *ConversationTimerCreate) check() error {
+	if _, ok := ctc.mutation.ConversationId(); !ok {
+		return &ValidationError{Name: "conversationId", err: errors.New(`ent: missing required field "ConversationTimer.conversationId"`)}
+	}
+	if v, ok := ctc.mutation.ConversationId(); ok {
+		if err := conversationtimer.ConversationIdValidator(v); err != nil {
+			return &ValidationError{Name: "conversationId", err: fmt.Errorf(`ent: validator failed for field "ConversationTimer.conversationId": %w`, err)}
+		}
+	}
+	if _, ok := ctc.mutation.IsGroup(); !ok {
+		return &ValidationError{Name: "isGroup", err: errors.New(`ent: missing required field "ConversationTimer.isGroup"`)}
+	}
+	if _, ok := ctc.mutation.TtlSeconds(); !ok {
+		return &ValidationError{Name: "ttlSeconds", err: errors.New(`ent: missing required field "ConversationTimer.ttlSeconds"`)}
+	}
+	if _, ok := ctc.mutation.UpdateUserId(); !ok {
+		return &ValidationError{Name: "updateUserId", err: errors.New(`ent: missing required field "ConversationTimer.updateUserId"`)}
+	}
+	if _, ok := ctc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "updateTime", err: errors.New(`ent: missing required field "ConversationTimer.updateTime"`)}
+	}
+	return nil
+}
+
+func (ctc *ConversationTimerCreate) sqlSave(ctx context.Context) (*ConversationTimer, error) {
+	if err := ctc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ctc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ctc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ctc.mutation.id = &_node.ID
+	ctc.mutation.done = true
+	return _node, nil
+}
+
+func (ctc *ConversationTimerCreate) createSpec() (*ConversationTimer, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ConversationTimer{config: ctc.config}
+		_spec = sqlgraph.NewCreateSpec(conversationtimer.Table, sqlgraph.NewFieldSpec(conversationtimer.FieldID, field.TypeInt))
+	)
+	if value, ok := ctc.mutation.ConversationId(); ok {
+		_spec.SetField(conversationtimer.FieldConversationId, field.TypeString, value)
+		_node.ConversationId = value
+	}
+	if value, ok := ctc.mutation.IsGroup(); ok {
+		_spec.SetField(conversationtimer.FieldIsGroup, field.TypeBool, value)
+		_node.IsGroup = value
+	}
+	if value, ok := ctc.mutation.TtlSeconds(); ok {
+		_spec.SetField(conversationtimer.FieldTtlSeconds, field.TypeInt, value)
+		_node.TtlSeconds = value
+	}
+	if value, ok := ctc.mutation.UpdateUserId(); ok {
+		_spec.SetField(conversationtimer.FieldUpdateUserId, field.TypeInt, value)
+		_node.UpdateUserId = value
+	}
+	if value, ok := ctc.mutation.UpdateTime(); ok {
+		_spec.SetField(conversationtimer.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	return _node, _spec
+}
+
+// ConversationTimerCreateBulk is the builder for creating many ConversationTimer entities in bulk.
+type ConversationTimerCreateBulk struct {
+	config
+	err      error
+	builders []*ConversationTimerCreate
+}
+
+// Save creates the ConversationTimer entities in the database.
+func (ctcb *ConversationTimerCreateBulk) Save(ctx context.Context) ([]*ConversationTimer, error) {
+	if ctcb.err != nil {
+		return nil, ctcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ctcb.builders))
+	nodes := make([]*ConversationTimer, len(ctcb.builders))
+	mutators := make([]Mutator, len(ctcb.builders))
+	for i := range ctcb.builders {
+		func(i int, root context.Context) {
+			builder := ctcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ConversationTimerMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ctcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ctcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ctcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ctcb *ConversationTimerCreateBulk) SaveX(ctx context.Context) []*ConversationTimer {
+	v, err := ctcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ctcb *ConversationTimerCreateBulk) Exec(ctx context.Context) error {
+	_, err := ctcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ctcb *ConversationTimerCreateBulk) ExecX(ctx context.Context) {
+	if err := ctcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"gochat_server/ent/conversationtimer"
+	"gochat_server/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ConversationTimerDelete is the builder for deleting a ConversationTimer entity.
+type ConversationTimerDelete struct {
+	config
+	hooks    []Hook
+	mutation *ConversationTimerMutation
+}
+
+// Where appends a list predicates to the ConversationTimerDelete builder.
+func (ctd *ConversationTimerDelete) Where(ps ...predicate.ConversationTimer) *ConversationTimerDelete {
+	ctd.mutation.Where(ps...)
+	return ctd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ctd *ConversationTimerDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ctd.sqlExec, ctd.mutation, ctd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ctd *ConversationTimerDelete) ExecX(ctx context.Context) int {
+	n, err := ctd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ctd *ConversationTimerDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(conversationtimer.Table, sqlgraph.NewFieldSpec(conversationtimer.FieldID, field.TypeInt))
+	if ps := ctd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ctd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ctd.mutation.done = true
+	return affected, err
+}
+
+// ConversationTimerDeleteOne is the builder for deleting a single ConversationTimer entity.
+type ConversationTimerDeleteOne struct {
+	ctd *ConversationTimerDelete
+}
+
+// Where appends a list predicates to the ConversationTimerDelete builder.
+func (ctdo *ConversationTimerDeleteOne) Where(ps ...predicate.ConversationTimer) *ConversationTimerDeleteOne {
+	ctdo.ctd.mutation.Where(ps...)
+	return ctdo
+}
+
+// Exec executes the deletion query.
+func (ctdo *ConversationTimerDeleteOne) Exec(ctx context.Context) error {
+	n, err := ctdo.ctd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{conversationtimer.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ctdo *ConversationTimerDeleteOne) ExecX(ctx context.Context) {
+	if err := ctdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"gochat_server/ent/conversationtimer"
+	"gochat_server/ent/predicate"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ConversationTimerQuery is the builder for querying ConversationTimer entities.
+type ConversationTimerQuery struct {
+	config
+	ctx        *QueryContext
+	order      []conversationtimer.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ConversationTimer
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ConversationTimerQuery builder.
+func (ctq *ConversationTimerQuery) Where(ps ...predicate.ConversationTimer) *ConversationTimerQuery {
+	ctq.predicates = append(ctq.predicates, ps...)
+	return ctq
+}
+
+// Limit the number of records to be returned by this query.
+func (ctq *ConversationTimerQuery) Limit(limit int) *ConversationTimerQuery {
+	ctq.ctx.Limit = &limit
+	return ctq
+}
+
+// Offset to start from.
+func (ctq *ConversationTimerQuery) Offset(offset int) *ConversationTimerQuery {
+	ctq.ctx.Offset = &offset
+	return ctq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ctq *ConversationTimerQuery) Unique(unique bool) *ConversationTimerQuery {
+	ctq.ctx.Unique = &unique
+	return ctq
+}
+
+// Order specifies how the records should be ordered.
+func (ctq *ConversationTimerQuery) Order(o ...conversationtimer.OrderOption) *ConversationTimerQuery {
+	ctq.order = append(ctq.order, o...)
+	return ctq
+}
+
+// First returns the first ConversationTimer entity from the query.
+// Returns a *NotFoundError when no ConversationTimer was found.
+func (ctq *ConversationTimerQuery) First(ctx context.Context) (*ConversationTimer, error) {
+	nodes, err := ctq.Limit(1).All(setContextOp(ctx, ctq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{conversationtimer.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ctq *ConversationTimerQuery) FirstX(ctx context.Context) *ConversationTimer {
+	node, err := ctq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ConversationTimer ID from the query.
+// Returns a *NotFoundError when no ConversationTimer ID was found.
+func (ctq *ConversationTimerQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ctq.Limit(1).IDs(setContextOp(ctx, ctq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{conversationtimer.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ctq *ConversationTimerQuery) FirstIDX(ctx context.Context) int {
+	id, err := ctq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ConversationTimer entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ConversationTimer entity is found.
+// Returns a *NotFoundError when no ConversationTimer entities are found.
+func (ctq *ConversationTimerQuery) Only(ctx context.Context) (*ConversationTimer, error) {
+	nodes, err := ctq.Limit(2).All(setContextOp(ctx, ctq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{conversationtimer.Label}
+	default:
+		return nil, &NotSingularError{conversationtimer.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ctq *ConversationTimerQuery) OnlyX(ctx context.Context) *ConversationTimer {
+	node, err := ctq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ConversationTimer ID in the query.
+// Returns a *NotSingularError when more than one ConversationTimer ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ctq *ConversationTimerQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ctq.Limit(2).IDs(setContextOp(ctx, ctq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{conversationtimer.Label}
+	default:
+		err = &NotSingularError{conversationtimer.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ctq *ConversationTimerQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ctq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ConversationTimers.
+func (ctq *ConversationTimerQuery) All(ctx context.Context) ([]*ConversationTimer, error) {
+	ctx = setContextOp(ctx, ctq.ctx, ent.OpQueryAll)
+	if err := ctq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ConversationTimer, *ConversationTimerQuery]()
+	return withInterceptors[[]*ConversationTimer](ctx, ctq, qr, ctq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ctq *ConversationTimerQuery) AllX(ctx context.Context) []*ConversationTimer {
+	nodes, err := ctq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ConversationTimer IDs.
+func (ctq *ConversationTimerQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if ctq.ctx.Unique == nil && ctq.path != nil {
+		ctq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ctq.ctx, ent.OpQueryIDs)
+	if err = ctq.Select(conversationtimer.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ctq *ConversationTimerQuery) IDsX(ctx context.Context) []int {
+	ids, err := ctq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ctq *ConversationTimerQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ctq.ctx, ent.OpQueryCount)
+	if err := ctq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ctq, querierCount[*ConversationTimerQuery](), ctq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ctq *ConversationTimerQuery) CountX(ctx context.Context) int {
+	count, err := ctq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ctq *ConversationTimerQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ctq.ctx, ent.OpQueryExist)
+	switch _, err := ctq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ctq *ConversationTimerQuery) ExistX(ctx context.Context) bool {
+	exist, err := ctq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ConversationTimerQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ctq *ConversationTimerQuery) Clone() *ConversationTimerQuery {
+	if ctq == nil {
+		return nil
+	}
+	return &ConversationTimerQuery{
+		config:     ctq.config,
+		ctx:        ctq.ctx.Clone(),
+		order:      append([]conversationtimer.OrderOption{}, ctq.order...),
+		inters:     append([]Interceptor{}, ctq.inters...),
+		predicates: append([]predicate.ConversationTimer{}, ctq.predicates...),
+		// clone intermediate query.
+		sql:  ctq.sql.Clone(),
+		path: ctq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ConversationId string `json:"conversationId,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ConversationTimer.Query().
+//		GroupBy(conversationtimer.FieldConversationId).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ctq *ConversationTimerQuery) GroupBy(field string, fields ...string) *ConversationTimerGroupBy {
+	ctq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ConversationTimerGroupBy{build: ctq}
+	grbuild.flds = &ctq.ctx.Fields
+	grbuild.label = conversationtimer.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ConversationId string `json:"conversationId,omitempty"`
+//	}
+//
+//	client.ConversationTimer.Query().
+//		Select(conversationtimer.FieldConversationId).
+//		Scan(ctx, &v)
+func (ctq *ConversationTimerQuery) Select(fields ...string) *ConversationTimerSelect {
+	ctq.ctx.Fields = append(ctq.ctx.Fields, fields...)
+	sbuild := &ConversationTimerSelect{ConversationTimerQuery: ctq}
+	sbuild.label = conversationtimer.Label
+	sbuild.flds, sbuild.scan = &ctq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ConversationTimerSelect configured with the given aggregations.
+func (ctq *ConversationTimerQuery) Aggregate(fns ...AggregateFunc) *ConversationTimerSelect {
+	return ctq.Select().Aggregate(fns...)
+}
+
+func (ctq *ConversationTimerQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ctq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ctq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ctq.ctx.Fields {
+		if !conversationtimer.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ctq.path != nil {
+		prev, err := ctq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ctq.sql = prev
+	}
+	return nil
+}
+
+func (ctq *ConversationTimerQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ConversationTimer, error) {
+	var (
+		nodes = []*ConversationTimer{}
+		_spec = ctq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ConversationTimer).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ConversationTimer{config: ctq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ctq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ctq *ConversationTimerQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ctq.querySpec()
+	_spec.Node.Columns = ctq.ctx.Fields
+	if len(ctq.ctx.Fields) > 0 {
+		_spec.Unique = ctq.ctx.Unique != nil && *ctq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ctq.driver, _spec)
+}
+
+func (ctq *ConversationTimerQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(conversationtimer.Table, conversationtimer.Columns, sqlgraph.NewFieldSpec(conversationtimer.FieldID, field.TypeInt))
+	_spec.From = ctq.sql
+	if unique := ctq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ctq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ctq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, conversationtimer.FieldID)
+		for i := range fields {
+			if fields[i] != conversationtimer.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ctq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ctq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ctq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ctq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ctq *ConversationTimerQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ctq.driver.Dialect())
+	t1 := builder.Table(conversationtimer.Table)
+	columns := ctq.ctx.Fields
+	if len(columns) == 0 {
+		columns = conversationtimer.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ctq.sql != nil {
+		selector = ctq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ctq.ctx.Unique != nil && *ctq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ctq.predicates {
+		p(selector)
+	}
+	for _, p := range ctq.order {
+		p(selector)
+	}
+	if offset := ctq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ctq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ConversationTimerGroupBy is the group-by builder for ConversationTimer entities.
+type ConversationTimerGroupBy struct {
+	selector
+	build *ConversationTimerQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ctgb *ConversationTimerGroupBy) Aggregate(fns ...AggregateFunc) *ConversationTimerGroupBy {
+	ctgb.fns = append(ctgb.fns, fns...)
+	return ctgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ctgb *ConversationTimerGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ctgb.build.ctx, ent.OpQueryGroupBy)
+	if err := ctgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ConversationTimerQuery, *ConversationTimerGroupBy](ctx, ctgb.build, ctgb, ctgb.build.inters, v)
+}
+
+func (ctgb *ConversationTimerGroupBy) sqlScan(ctx context.Context, root *ConversationTimerQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ctgb.fns))
+	for _, fn := range ctgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ctgb.flds)+len(ctgb.fns))
+		for _, f := range *ctgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ctgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ctgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ConversationTimerSelect is the builder for selecting fields of ConversationTimer entities.
+type ConversationTimerSelect struct {
+	*ConversationTimerQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (cts *ConversationTimerSelect) Aggregate(fns ...AggregateFunc) *ConversationTimerSelect {
+	cts.fns = append(cts.fns, fns...)
+	return cts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cts *ConversationTimerSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cts.ctx, ent.OpQuerySelect)
+	if err := cts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ConversationTimerQuery, *ConversationTimerSelect](ctx, cts.ConversationTimerQuery, cts, cts.inters, v)
+}
+
+func (cts *ConversationTimerSelect) sqlScan(ctx context.Context, root *ConversationTimerQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(cts.fns))
+	for _, fn := range cts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*cts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,412 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gochat_server/ent/conversationtimer"
+	"gochat_server/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ConversationTimerUpdate is the builder for updating ConversationTimer entities.
+type ConversationTimerUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ConversationTimerMutation
+}
+
+// Where appends a list predicates to the ConversationTimerUpdate builder.
+func (ctu *ConversationTimerUpdate) Where(ps ...predicate.ConversationTimer) *ConversationTimerUpdate {
+	ctu.mutation.Where(ps...)
+	return ctu
+}
+
+// SetConversationId sets the "conversationId" field.
+func (ctu *ConversationTimerUpdate) SetConversationId(s string) *ConversationTimerUpdate {
+	ctu.mutation.SetConversationId(s)
+	return ctu
+}
+
+// SetNillableConversationId sets the "conversationId" field if the given value is not nil.
+func (ctu *ConversationTimerUpdate) SetNillableConversationId(s *string) *ConversationTimerUpdate {
+	if s != nil {
+		ctu.SetConversationId(*s)
+	}
+	return ctu
+}
+
+// SetIsGroup sets the "isGroup" field.
+func (ctu *ConversationTimerUpdate) SetIsGroup(b bool) *ConversationTimerUpdate {
+	ctu.mutation.SetIsGroup(b)
+	return ctu
+}
+
+// SetNillableIsGroup sets the "isGroup" field if the given value is not nil.
+func (ctu *ConversationTimerUpdate) SetNillableIsGroup(b *bool) *ConversationTimerUpdate {
+	if b != nil {
+		ctu.SetIsGroup(*b)
+	}
+	return ctu
+}
+
+// SetTtlSeconds sets the "ttlSeconds" field.
+func (ctu *ConversationTimerUpdate) SetTtlSeconds(i int) *ConversationTimerUpdate {
+	ctu.mutation.ResetTtlSeconds()
+	ctu.mutation.SetTtlSeconds(i)
+	return ctu
+}
+
+// SetNillableTtlSeconds sets the "ttlSeconds" field if the given value is not nil.
+func (ctu *ConversationTimerUpdate) SetNillableTtlSeconds(i *int) *ConversationTimerUpdate {
+	if i != nil {
+		ctu.SetTtlSeconds(*i)
+	}
+	return ctu
+}
+
+// AddTtlSeconds adds i to the "ttlSeconds" field.
+func (ctu *ConversationTimerUpdate) AddTtlSeconds(i int) *ConversationTimerUpdate {
+	ctu.mutation.AddTtlSeconds(i)
+	return ctu
+}
+
+// SetUpdateUserId sets the "updateUserId" field.
+func (ctu *ConversationTimerUpdate) SetUpdateUserId(i int) *ConversationTimerUpdate {
+	ctu.mutation.ResetUpdateUserId()
+	ctu.mutation.SetUpdateUserId(i)
+	return ctu
+}
+
+// SetNillableUpdateUserId sets the "updateUserId" field if the given value is not nil.
+func (ctu *ConversationTimerUpdate) SetNillableUpdateUserId(i *int) *ConversationTimerUpdate {
+	if i != nil {
+		ctu.SetUpdateUserId(*i)
+	}
+	return ctu
+}
+
+// AddUpdateUserId adds i to the "updateUserId" field.
+func (ctu *ConversationTimerUpdate) AddUpdateUserId(i int) *ConversationTimerUpdate {
+	ctu.mutation.AddUpdateUserId(i)
+	return ctu
+}
+
+// SetUpdateTime sets the "updateTime" field.
+func (ctu *ConversationTimerUpdate) SetUpdateTime(t time.Time) *ConversationTimerUpdate {
+	ctu.mutation.SetUpdateTime(t)
+	return ctu
+}
+
+// SetNillableUpdateTime sets the "updateTime" field if the given value is not nil.
+func (ctu *ConversationTimerUpdate) SetNillableUpdateTime(t *time.Time) *ConversationTimerUpdate {
+	if t != nil {
+		ctu.SetUpdateTime(*t)
+	}
+	return ctu
+}
+
+// Mutation returns the ConversationTimerMutation object of the builder.
+func (ctu *ConversationTimerUpdate) Mutation() *ConversationTimerMutation {
+	return ctu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ctu *ConversationTimerUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ctu.sqlSave, ctu.mutation, ctu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ctu *ConversationTimerUpdate) SaveX(ctx context.Context) int {
+	affected, err := ctu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ctu *ConversationTimerUpdate) Exec(ctx context.Context) error {
+	_, err := ctu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ctu *ConversationTimerUpdate) ExecX(ctx context.Context) {
+	if err := ctu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ctu *ConversationTimerUpdate) check() error {
+	if v, ok := ctu.mutation.ConversationId(); ok {
+		if err := conversationtimer.ConversationIdValidator(v); err != nil {
+			return &ValidationError{Name: "conversationId", err: fmt.Errorf(`ent: validator failed for field "ConversationTimer.conversationId": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ctu *ConversationTimerUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ctu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(conversationtimer.Table, conversationtimer.Columns, sqlgraph.NewFieldSpec(conversationtimer.FieldID, field.TypeInt))
+	if ps := ctu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ctu.mutation.ConversationId(); ok {
+		_spec.SetField(conversationtimer.FieldConversationId, field.TypeString, value)
+	}
+	if value, ok := ctu.mutation.IsGroup(); ok {
+		_spec.SetField(conversationtimer.FieldIsGroup, field.TypeBool, value)
+	}
+	if value, ok := ctu.mutation.TtlSeconds(); ok {
+		_spec.SetField(conversationtimer.FieldTtlSeconds, field.TypeInt, value)
+	}
+	if value, ok := ctu.mutation.AddedTtlSeconds(); ok {
+		_spec.AddField(conversationtimer.FieldTtlSeconds, field.TypeInt, value)
+	}
+	if value, ok := ctu.mutation.UpdateUserId(); ok {
+		_spec.SetField(conversationtimer.FieldUpdateUserId, field.TypeInt, value)
+	}
+	if value, ok := ctu.mutation.AddedUpdateUserId(); ok {
+		_spec.AddField(conversationtimer.FieldUpdateUserId, field.TypeInt, value)
+	}
+	if value, ok := ctu.mutation.UpdateTime(); ok {
+		_spec.SetField(conversationtimer.FieldUpdateTime, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ctu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{conversationtimer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ctu.mutation.done = true
+	return n, nil
+}
+
+// ConversationTimerUpdateOne is the builder for updating a single ConversationTimer entity.
+type ConversationTimerUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ConversationTimerMutation
+}
+
+// SetConversationId sets the "conversationId" field.
+func (ctuo *ConversationTimerUpdateOne) SetConversationId(s string) *ConversationTimerUpdateOne {
+	ctuo.mutation.SetConversationId(s)
+	return ctuo
+}
+
+// SetNillableConversationId sets the "conversationId" field if the given value is not nil.
+func (ctuo *ConversationTimerUpdateOne) SetNillableConversationId(s *string) *ConversationTimerUpdateOne {
+	if s != nil {
+		ctuo.SetConversationId(*s)
+	}
+	return ctuo
+}
+
+// SetIsGroup sets the "isGroup" field.
+func (ctuo *ConversationTimerUpdateOne) SetIsGroup(b bool) *ConversationTimerUpdateOne {
+	ctuo.mutation.SetIsGroup(b)
+	return ctuo
+}
+
+// SetNillableIsGroup sets the "isGroup" field if the given value is not nil.
+func (ctuo *ConversationTimerUpdateOne) SetNillableIsGroup(b *bool) *ConversationTimerUpdateOne {
+	if b != nil {
+		ctuo.SetIsGroup(*b)
+	}
+	return ctuo
+}
+
+// SetTtlSeconds sets the "ttlSeconds" field.
+func (ctuo *ConversationTimerUpdateOne) SetTtlSeconds(i int) *ConversationTimerUpdateOne {
+	ctuo.mutation.ResetTtlSeconds()
+	ctuo.mutation.SetTtlSeconds(i)
+	return ctuo
+}
+
+// SetNillableTtlSeconds sets the "ttlSeconds" field if the given value is not nil.
+func (ctuo *ConversationTimerUpdateOne) SetNillableTtlSeconds(i *int) *ConversationTimerUpdateOne {
+	if i != nil {
+		ctuo.SetTtlSeconds(*i)
+	}
+	return ctuo
+}
+
+// AddTtlSeconds adds i to the "ttlSeconds" field.
+func (ctuo *ConversationTimerUpdateOne) AddTtlSeconds(i int) *ConversationTimerUpdateOne {
+	ctuo.mutation.AddTtlSeconds(i)
+	return ctuo
+}
+
+// SetUpdateUserId sets the "updateUserId" field.
+func (ctuo *ConversationTimerUpdateOne) SetUpdateUserId(i int) *ConversationTimerUpdateOne {
+	ctuo.mutation.ResetUpdateUserId()
+	ctuo.mutation.SetUpdateUserId(i)
+	return ctuo
+}
+
+// SetNillableUpdateUserId sets the "updateUserId" field if the given value is not nil.
+func (ctuo *ConversationTimerUpdateOne) SetNillableUpdateUserId(i *int) *ConversationTimerUpdateOne {
+	if i != nil {
+		ctuo.SetUpdateUserId(*i)
+	}
+	return ctuo
+}
+
+// AddUpdateUserId adds i to the "updateUserId" field.
+func (ctuo *ConversationTimerUpdateOne) AddUpdateUserId(i int) *ConversationTimerUpdateOne {
+	ctuo.mutation.AddUpdateUserId(i)
+	return ctuo
+}
+
+// SetUpdateTime sets the "updateTime" field.
+func (ctuo *ConversationTimerUpdateOne) SetUpdateTime(t time.Time) *ConversationTimerUpdateOne {
+	ctuo.mutation.SetUpdateTime(t)
+	return ctuo
+}
+
+// SetNillableUpdateTime sets the "updateTime" field if the given value is not nil.
+func (ctuo *ConversationTimerUpdateOne) SetNillableUpdateTime(t *time.Time) *ConversationTimerUpdateOne {
+	if t != nil {
+		ctuo.SetUpdateTime(*t)
+	}
+	return ctuo
+}
+
+// Mutation returns the ConversationTimerMutation object of the builder.
+func (ctuo *ConversationTimerUpdateOne) Mutation() *ConversationTimerMutation {
+	return ctuo.mutation
+}
+
+// Where appends a list predicates to the ConversationTimerUpdate builder.
+func (ctuo *ConversationTimerUpdateOne) Where(ps ...predicate.ConversationTimer) *ConversationTimerUpdateOne {
+	ctuo.mutation.Where(ps...)
+	return ctuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ctuo *ConversationTimerUpdateOne) Select(field string, fields ...string) *ConversationTimerUpdateOne {
+	ctuo.fields = append([]string{field}, fields...)
+	return ctuo
+}
+
+// Save executes the query and returns the updated ConversationTimer entity.
+func (ctuo *ConversationTimerUpdateOne) Save(ctx context.Context) (*ConversationTimer, error) {
+	return withHooks(ctx, ctuo.sqlSave, ctuo.mutation, ctuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ctuo *ConversationTimerUpdateOne) SaveX(ctx context.Context) *ConversationTimer {
+	node, err := ctuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ctuo *ConversationTimerUpdateOne) Exec(ctx context.Context) error {
+	_, err := ctuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ctuo *ConversationTimerUpdateOne) ExecX(ctx context.Context) {
+	if err := ctuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ctuo *ConversationTimerUpdateOne) check() error {
+	if v, ok := ctuo.mutation.ConversationId(); ok {
+		if err := conversationtimer.ConversationIdValidator(v); err != nil {
+			return &ValidationError{Name: "conversationId", err: fmt.Errorf(`ent: validator failed for field "ConversationTimer.conversationId": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ctuo *ConversationTimerUpdateOne) sqlSave(ctx context.Context) (_node *ConversationTimer, err error) {
+	if err := ctuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(conversationtimer.Table, conversationtimer.Columns, sqlgraph.NewFieldSpec(conversationtimer.FieldID, field.TypeInt))
+	id, ok := ctuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ConversationTimer.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ctuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, conversationtimer.FieldID)
+		for _, f := range fields {
+			if !conversationtimer.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != conversationtimer.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ctuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ctuo.mutation.ConversationId(); ok {
+		_spec.SetField(conversationtimer.FieldConversationId, field.TypeString, value)
+	}
+	if value, ok := ctuo.mutation.IsGroup(); ok {
+		_spec.SetField(conversationtimer.FieldIsGroup, field.TypeBool, value)
+	}
+	if value, ok := ctuo.mutation.TtlSeconds(); ok {
+		_spec.SetField(conversationtimer.FieldTtlSeconds, field.TypeInt, value)
+	}
+	if value, ok := ctuo.mutation.AddedTtlSeconds(); ok {
+		_spec.AddField(conversationtimer.FieldTtlSeconds, field.TypeInt, value)
+	}
+	if value, ok := ctuo.mutation.UpdateUserId(); ok {
+		_spec.SetField(conversationtimer.FieldUpdateUserId, field.TypeInt, value)
+	}
+	if value, ok := ctuo.mutation.AddedUpdateUserId(); ok {
+		_spec.AddField(conversationtimer.FieldUpdateUserId, field.TypeInt, value)
+	}
+	if value, ok := ctuo.mutation.UpdateTime(); ok {
+		_spec.SetField(conversationtimer.FieldUpdateTime, field.TypeTime, value)
+	}
+	_node = &ConversationTimer{config: ctuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ctuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{conversationtimer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ctuo.mutation.done = true
+	return _node, nil
+}
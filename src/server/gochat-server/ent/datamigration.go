@@ -0,0 +1,177 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"gochat_server/ent/datamigration"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// DataMigration is the model entity for the DataMigration schema.
+type DataMigration struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 迁移名称
+	Name string `json:"name,omitempty"`
+	// 已处理到的记录ID
+	Cursor int `json:"cursor,omitempty"`
+	// 已迁移的记录数
+	Migrated int `json:"migrated,omitempty"`
+	// 是否已完成
+	IsCompleted bool `json:"isCompleted,omitempty"`
+	// 开始时间
+	StartTime time.Time `json:"startTime,omitempty"`
+	// 最近一次更新进度的时间
+	UpdateTime time.Time `json:"updateTime,omitempty"`
+	// 完成时间
+	FinishTime   *time.Time `json:"finishTime,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DataMigration) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case datamigration.FieldIsCompleted:
+			values[i] = new(sql.NullBool)
+		case datamigration.FieldID, datamigration.FieldCursor, datamigration.FieldMigrated:
+			values[i] = new(sql.NullInt64)
+		case datamigration.FieldName:
+			values[i] = new(sql.NullString)
+		case datamigration.FieldStartTime, datamigration.FieldUpdateTime, datamigration.FieldFinishTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DataMigration fields.
+func (dm *DataMigration) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case datamigration.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			dm.ID = int(value.Int64)
+		case datamigration.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				dm.Name = value.String
+			}
+		case datamigration.FieldCursor:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field cursor", values[i])
+			} else if value.Valid {
+				dm.Cursor = int(value.Int64)
+			}
+		case datamigration.FieldMigrated:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field migrated", values[i])
+			} else if value.Valid {
+				dm.Migrated = int(value.Int64)
+			}
+		case datamigration.FieldIsCompleted:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field isCompleted", values[i])
+			} else if value.Valid {
+				dm.IsCompleted = value.Bool
+			}
+		case datamigration.FieldStartTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field startTime", values[i])
+			} else if value.Valid {
+				dm.StartTime = value.Time
+			}
+		case datamigration.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updateTime", values[i])
+			} else if value.Valid {
+				dm.UpdateTime = value.Time
+			}
+		case datamigration.FieldFinishTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finishTime", values[i])
+			} else if value.Valid {
+				dm.FinishTime = new(time.Time)
+				*dm.FinishTime = value.Time
+			}
+		default:
+			dm.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DataMigration.
+// This includes values selected through modifiers, order, etc.
+func (dm *DataMigration) Value(name string) (ent.Value, error) {
+	return dm.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DataMigration.
+// Note that you need to call DataMigration.Unwrap() before calling this method if this DataMigration
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (dm *DataMigration) Update() *DataMigrationUpdateOne {
+	return NewDataMigrationClient(dm.config).UpdateOne(dm)
+}
+
+// Unwrap unwraps the DataMigration entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (dm *DataMigration) Unwrap() *DataMigration {
+	_tx, ok := dm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DataMigration is not a transactional entity")
+	}
+	dm.config.driver = _tx.drv
+	return dm
+}
+
+// String implements the fmt.Stringer.
+func (dm *DataMigration) String() string {
+	var builder strings.Builder
+	builder.WriteString("DataMigration(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", dm.ID))
+	builder.WriteString("name=")
+	builder.WriteString(dm.Name)
+	builder.WriteString(", ")
+	builder.WriteString("cursor=")
+	builder.WriteString(fmt.Sprintf("%v", dm.Cursor))
+	builder.WriteString(", ")
+	builder.WriteString("migrated=")
+	builder.WriteString(fmt.Sprintf("%v", dm.Migrated))
+	builder.WriteString(", ")
+	builder.WriteString("isCompleted=")
+	builder.WriteString(fmt.Sprintf("%v", dm.IsCompleted))
+	builder.WriteString(", ")
+	builder.WriteString("startTime=")
+	builder.WriteString(dm.StartTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updateTime=")
+	builder.WriteString(dm.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := dm.FinishTime; v != nil {
+		builder.WriteString("finishTime=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DataMigrations is a parsable slice of DataMigration.
+type DataMigrations []*DataMigration
@@ -0,0 +1,114 @@
+// Code generated by ent, DO NOT EDIT.
+
+package datamigration
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the datamigration type in the database.
+	Label = "data_migration"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldCursor holds the string denoting the cursor field in the database.
+	FieldCursor = "cursor"
+	// FieldMigrated holds the string denoting the migrated field in the database.
+	FieldMigrated = "migrated"
+	// FieldIsCompleted holds the string denoting the iscompleted field in the database.
+	FieldIsCompleted = "is_completed"
+	// FieldStartTime holds the string denoting the starttime field in the database.
+	FieldStartTime = "start_time"
+	// FieldUpdateTime holds the string denoting the updatetime field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldFinishTime holds the string denoting the finishtime field in the database.
+	FieldFinishTime = "finish_time"
+	// Table holds the table name of the datamigration in the database.
+	Table = "data_migrations"
+)
+
+// Columns holds all SQL columns for datamigration fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldCursor,
+	FieldMigrated,
+	FieldIsCompleted,
+	FieldStartTime,
+	FieldUpdateTime,
+	FieldFinishTime,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultCursor holds the default value on creation for the "cursor" field.
+	DefaultCursor int
+	// DefaultMigrated holds the default value on creation for the "migrated" field.
+	DefaultMigrated int
+	// DefaultIsCompleted holds the default value on creation for the "isCompleted" field.
+	DefaultIsCompleted bool
+	// DefaultStartTime holds the default value on creation for the "startTime" field.
+	DefaultStartTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "updateTime" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "updateTime" field.
+	UpdateDefaultUpdateTime func() time.Time
+)
+
+// OrderOption defines the ordering options for the DataMigration queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByCursor orders the results by the cursor field.
+func ByCursor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCursor, opts...).ToFunc()
+}
+
+// ByMigrated orders the results by the migrated field.
+func ByMigrated(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMigrated, opts...).ToFunc()
+}
+
+// ByIsCompleted orders the results by the isCompleted field.
+func ByIsCompleted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsCompleted, opts...).ToFunc()
+}
+
+// ByStartTime orders the results by the startTime field.
+func ByStartTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the updateTime field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByFinishTime orders the results by the finishTime field.
+func ByFinishTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinishTime, opts...).ToFunc()
+}
@@ -0,0 +1,390 @@
+// Code generated by ent, DO NOT EDIT.
+
+package datamigration
+
+import (
+	"gochat_server/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldEQ(FieldName, v))
+}
+
+// Cursor applies equality check predicate on the "cursor" field. It's identical to CursorEQ.
+func Cursor(v int) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldEQ(FieldCursor, v))
+}
+
+// Migrated applies equality check predicate on the "migrated" field. It's identical to MigratedEQ.
+func Migrated(v int) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldEQ(FieldMigrated, v))
+}
+
+// IsCompleted applies equality check predicate on the "isCompleted" field. It's identical to IsCompletedEQ.
+func IsCompleted(v bool) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldEQ(FieldIsCompleted, v))
+}
+
+// StartTime applies equality check predicate on the "startTime" field. It's identical to StartTimeEQ.
+func StartTime(v time.Time) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldEQ(FieldStartTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "updateTime" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// FinishTime applies equality check predicate on the "finishTime" field. It's identical to FinishTimeEQ.
+func FinishTime(v time.Time) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldEQ(FieldFinishTime, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldContainsFold(FieldName, v))
+}
+
+// CursorEQ applies the EQ predicate on the "cursor" field.
+func CursorEQ(v int) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldEQ(FieldCursor, v))
+}
+
+// CursorNEQ applies the NEQ predicate on the "cursor" field.
+func CursorNEQ(v int) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldNEQ(FieldCursor, v))
+}
+
+// CursorIn applies the In predicate on the "cursor" field.
+func CursorIn(vs ...int) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldIn(FieldCursor, vs...))
+}
+
+// CursorNotIn applies the NotIn predicate on the "cursor" field.
+func CursorNotIn(vs ...int) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldNotIn(FieldCursor, vs...))
+}
+
+// CursorGT applies the GT predicate on the "cursor" field.
+func CursorGT(v int) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldGT(FieldCursor, v))
+}
+
+// CursorGTE applies the GTE predicate on the "cursor" field.
+func CursorGTE(v int) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldGTE(FieldCursor, v))
+}
+
+// CursorLT applies the LT predicate on the "cursor" field.
+func CursorLT(v int) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldLT(FieldCursor, v))
+}
+
+// CursorLTE applies the LTE predicate on the "cursor" field.
+func CursorLTE(v int) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldLTE(FieldCursor, v))
+}
+
+// MigratedEQ applies the EQ predicate on the "migrated" field.
+func MigratedEQ(v int) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldEQ(FieldMigrated, v))
+}
+
+// MigratedNEQ applies the NEQ predicate on the "migrated" field.
+func MigratedNEQ(v int) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldNEQ(FieldMigrated, v))
+}
+
+// MigratedIn applies the In predicate on the "migrated" field.
+func MigratedIn(vs ...int) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldIn(FieldMigrated, vs...))
+}
+
+// MigratedNotIn applies the NotIn predicate on the "migrated" field.
+func MigratedNotIn(vs ...int) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldNotIn(FieldMigrated, vs...))
+}
+
+// MigratedGT applies the GT predicate on the "migrated" field.
+func MigratedGT(v int) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldGT(FieldMigrated, v))
+}
+
+// MigratedGTE applies the GTE predicate on the "migrated" field.
+func MigratedGTE(v int) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldGTE(FieldMigrated, v))
+}
+
+// MigratedLT applies the LT predicate on the "migrated" field.
+func MigratedLT(v int) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldLT(FieldMigrated, v))
+}
+
+// MigratedLTE applies the LTE predicate on the "migrated" field.
+func MigratedLTE(v int) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldLTE(FieldMigrated, v))
+}
+
+// IsCompletedEQ applies the EQ predicate on the "isCompleted" field.
+func IsCompletedEQ(v bool) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldEQ(FieldIsCompleted, v))
+}
+
+// IsCompletedNEQ applies the NEQ predicate on the "isCompleted" field.
+func IsCompletedNEQ(v bool) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldNEQ(FieldIsCompleted, v))
+}
+
+// StartTimeEQ applies the EQ predicate on the "startTime" field.
+func StartTimeEQ(v time.Time) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldEQ(FieldStartTime, v))
+}
+
+// StartTimeNEQ applies the NEQ predicate on the "startTime" field.
+func StartTimeNEQ(v time.Time) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldNEQ(FieldStartTime, v))
+}
+
+// StartTimeIn applies the In predicate on the "startTime" field.
+func StartTimeIn(vs ...time.Time) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldIn(FieldStartTime, vs...))
+}
+
+// StartTimeNotIn applies the NotIn predicate on the "startTime" field.
+func StartTimeNotIn(vs ...time.Time) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldNotIn(FieldStartTime, vs...))
+}
+
+// StartTimeGT applies the GT predicate on the "startTime" field.
+func StartTimeGT(v time.Time) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldGT(FieldStartTime, v))
+}
+
+// StartTimeGTE applies the GTE predicate on the "startTime" field.
+func StartTimeGTE(v time.Time) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldGTE(FieldStartTime, v))
+}
+
+// StartTimeLT applies the LT predicate on the "startTime" field.
+func StartTimeLT(v time.Time) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldLT(FieldStartTime, v))
+}
+
+// StartTimeLTE applies the LTE predicate on the "startTime" field.
+func StartTimeLTE(v time.Time) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldLTE(FieldStartTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "updateTime" field.
+func UpdateTimeEQ(v time.Time) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "updateTime" field.
+func UpdateTimeNEQ(v time.Time) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "updateTime" field.
+func UpdateTimeIn(vs ...time.Time) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "updateTime" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "updateTime" field.
+func UpdateTimeGT(v time.Time) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "updateTime" field.
+func UpdateTimeGTE(v time.Time) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "updateTime" field.
+func UpdateTimeLT(v time.Time) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "updateTime" field.
+func UpdateTimeLTE(v time.Time) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// FinishTimeEQ applies the EQ predicate on the "finishTime" field.
+func FinishTimeEQ(v time.Time) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldEQ(FieldFinishTime, v))
+}
+
+// FinishTimeNEQ applies the NEQ predicate on the "finishTime" field.
+func FinishTimeNEQ(v time.Time) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldNEQ(FieldFinishTime, v))
+}
+
+// FinishTimeIn applies the In predicate on the "finishTime" field.
+func FinishTimeIn(vs ...time.Time) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldIn(FieldFinishTime, vs...))
+}
+
+// FinishTimeNotIn applies the NotIn predicate on the "finishTime" field.
+func FinishTimeNotIn(vs ...time.Time) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldNotIn(FieldFinishTime, vs...))
+}
+
+// FinishTimeGT applies the GT predicate on the "finishTime" field.
+func FinishTimeGT(v time.Time) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldGT(FieldFinishTime, v))
+}
+
+// FinishTimeGTE applies the GTE predicate on the "finishTime" field.
+func FinishTimeGTE(v time.Time) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldGTE(FieldFinishTime, v))
+}
+
+// FinishTimeLT applies the LT predicate on the "finishTime" field.
+func FinishTimeLT(v time.Time) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldLT(FieldFinishTime, v))
+}
+
+// FinishTimeLTE applies the LTE predicate on the "finishTime" field.
+func FinishTimeLTE(v time.Time) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldLTE(FieldFinishTime, v))
+}
+
+// FinishTimeIsNil applies the IsNil predicate on the "finishTime" field.
+func FinishTimeIsNil() predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldIsNull(FieldFinishTime))
+}
+
+// FinishTimeNotNil applies the NotNil predicate on the "finishTime" field.
+func FinishTimeNotNil() predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldNotNull(FieldFinishTime))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DataMigration) predicate.DataMigration {
+	return predicate.DataMigration(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DataMigration) predicate.DataMigration {
+	return predicate.DataMigration(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DataMigration) predicate.DataMigration {
+	return predicate.DataMigration(sql.NotPredicates(p))
+}
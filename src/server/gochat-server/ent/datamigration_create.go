@@ -0,0 +1,338 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gochat_server/ent/datamigration"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DataMigrationCreate is the builder for creating a DataMigration entity.
+type DataMigrationCreate struct {
+	config
+	mutation *DataMigrationMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (dmc *DataMigrationCreate) SetName(s string) *DataMigrationCreate {
+	dmc.mutation.SetName(s)
+	return dmc
+}
+
+// SetCursor sets the "cursor" field.
+func (dmc *DataMigrationCreate) SetCursor(i int) *DataMigrationCreate {
+	dmc.mutation.SetCursor(i)
+	return dmc
+}
+
+// SetNillableCursor sets the "cursor" field if the given value is not nil.
+func (dmc *DataMigrationCreate) SetNillableCursor(i *int) *DataMigrationCreate {
+	if i != nil {
+		dmc.SetCursor(*i)
+	}
+	return dmc
+}
+
+// SetMigrated sets the "migrated" field.
+func (dmc *DataMigrationCreate) SetMigrated(i int) *DataMigrationCreate {
+	dmc.mutation.SetMigrated(i)
+	return dmc
+}
+
+// SetNillableMigrated sets the "migrated" field if the given value is not nil.
+func (dmc *DataMigrationCreate) SetNillableMigrated(i *int) *DataMigrationCreate {
+	if i != nil {
+		dmc.SetMigrated(*i)
+	}
+	return dmc
+}
+
+// SetIsCompleted sets the "isCompleted" field.
+func (dmc *DataMigrationCreate) SetIsCompleted(b bool) *DataMigrationCreate {
+	dmc.mutation.SetIsCompleted(b)
+	return dmc
+}
+
+// SetNillableIsCompleted sets the "isCompleted" field if the given value is not nil.
+func (dmc *DataMigrationCreate) SetNillableIsCompleted(b *bool) *DataMigrationCreate {
+	if b != nil {
+		dmc.SetIsCompleted(*b)
+	}
+	return dmc
+}
+
+// SetStartTime sets the "startTime" field.
+func (dmc *DataMigrationCreate) SetStartTime(t time.Time) *DataMigrationCreate {
+	dmc.mutation.SetStartTime(t)
+	return dmc
+}
+
+// SetNillableStartTime sets the "startTime" field if the given value is not nil.
+func (dmc *DataMigrationCreate) SetNillableStartTime(t *time.Time) *DataMigrationCreate {
+	if t != nil {
+		dmc.SetStartTime(*t)
+	}
+	return dmc
+}
+
+// SetUpdateTime sets the "updateTime" field.
+func (dmc *DataMigrationCreate) SetUpdateTime(t time.Time) *DataMigrationCreate {
+	dmc.mutation.SetUpdateTime(t)
+	return dmc
+}
+
+// SetNillableUpdateTime sets the "updateTime" field if the given value is not nil.
+func (dmc *DataMigrationCreate) SetNillableUpdateTime(t *time.Time) *DataMigrationCreate {
+	if t != nil {
+		dmc.SetUpdateTime(*t)
+	}
+	return dmc
+}
+
+// SetFinishTime sets the "finishTime" field.
+func (dmc *DataMigrationCreate) SetFinishTime(t time.Time) *DataMigrationCreate {
+	dmc.mutation.SetFinishTime(t)
+	return dmc
+}
+
+// SetNillableFinishTime sets the "finishTime" field if the given value is not nil.
+func (dmc *DataMigrationCreate) SetNillableFinishTime(t *time.Time) *DataMigrationCreate {
+	if t != nil {
+		dmc.SetFinishTime(*t)
+	}
+	return dmc
+}
+
+// Mutation returns the DataMigrationMutation object of the builder.
+func (dmc *DataMigrationCreate) Mutation() *DataMigrationMutation {
+	return dmc.mutation
+}
+
+// Save creates the DataMigration in the database.
+func (dmc *DataMigrationCreate) Save(ctx context.Context) (*DataMigration, error) {
+	dmc.defaults()
+	return withHooks(ctx, dmc.sqlSave, dmc.mutation, dmc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dmc *DataMigrationCreate) SaveX(ctx context.Context) *DataMigration {
+	v, err := dmc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dmc *DataMigrationCreate) Exec(ctx context.Context) error {
+	_, err := dmc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dmc *DataMigrationCreate) ExecX(ctx context.Context) {
+	if err := dmc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dmc *DataMigrationCreate) defaults() {
+	if _, ok := dmc.mutation.Cursor(); !ok {
+		v := datamigration.DefaultCursor
+		dmc.mutation.SetCursor(v)
+	}
+	if _, ok := dmc.mutation.Migrated(); !ok {
+		v := datamigration.DefaultMigrated
+		dmc.mutation.SetMigrated(v)
+	}
+	if _, ok := dmc.mutation.IsCompleted(); !ok {
+		v := datamigration.DefaultIsCompleted
+		dmc.mutation.SetIsCompleted(v)
+	}
+	if _, ok := dmc.mutation.StartTime(); !ok {
+		v := datamigration.DefaultStartTime()
+		dmc.mutation.SetStartTime(v)
+	}
+	if _, ok := dmc.mutation.UpdateTime(); !ok {
+		v := datamigration.DefaultUpdateTime()
+		dmc.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dmc *DataMigrationCreate) check() error {
+	if _, ok := dmc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "DataMigration.name"`)}
+	}
+	if v, ok := dmc.mutation.Name(); ok {
+		if err := datamigration.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "DataMigration.name": %w`, err)}
+		}
+	}
+	if _, ok := dmc.mutation.Cursor(); !ok {
+		return &ValidationError{Name: "cursor", err: errors.New(`ent: missing required field "DataMigration.cursor"`)}
+	}
+	if _, ok := dmc.mutation.Migrated(); !ok {
+		return &ValidationError{Name: "migrated", err: errors.New(`ent: missing required field "DataMigration.migrated"`)}
+	}
+	if _, ok := dmc.mutation.IsCompleted(); !ok {
+		return &ValidationError{Name: "isCompleted", err: errors.New(`ent: missing required field "DataMigration.isCompleted"`)}
+	}
+	if _, ok := dmc.mutation.StartTime(); !ok {
+		return &ValidationError{Name: "startTime", err: errors.New(`ent: missing required field "DataMigration.startTime"`)}
+	}
+	if _, ok := dmc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "updateTime", err: errors.New(`ent: missing required field "DataMigration.updateTime"`)}
+	}
+	return nil
+}
+
+func (dmc *DataMigrationCreate) sqlSave(ctx context.Context) (*DataMigration, error) {
+	if err := dmc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dmc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dmc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	dmc.mutation.id = &_node.ID
+	dmc.mutation.done = true
+	return _node, nil
+}
+
+func (dmc *DataMigrationCreate) createSpec() (*DataMigration, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DataMigration{config: dmc.config}
+		_spec = sqlgraph.NewCreateSpec(datamigration.Table, sqlgraph.NewFieldSpec(datamigration.FieldID, field.TypeInt))
+	)
+	if value, ok := dmc.mutation.Name(); ok {
+		_spec.SetField(datamigration.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := dmc.mutation.Cursor(); ok {
+		_spec.SetField(datamigration.FieldCursor, field.TypeInt, value)
+		_node.Cursor = value
+	}
+	if value, ok := dmc.mutation.Migrated(); ok {
+		_spec.SetField(datamigration.FieldMigrated, field.TypeInt, value)
+		_node.Migrated = value
+	}
+	if value, ok := dmc.mutation.IsCompleted(); ok {
+		_spec.SetField(datamigration.FieldIsCompleted, field.TypeBool, value)
+		_node.IsCompleted = value
+	}
+	if value, ok := dmc.mutation.StartTime(); ok {
+		_spec.SetField(datamigration.FieldStartTime, field.TypeTime, value)
+		_node.StartTime = value
+	}
+	if value, ok := dmc.mutation.UpdateTime(); ok {
+		_spec.SetField(datamigration.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := dmc.mutation.FinishTime(); ok {
+		_spec.SetField(datamigration.FieldFinishTime, field.TypeTime, value)
+		_node.FinishTime = &value
+	}
+	return _node, _spec
+}
+
+// DataMigrationCreateBulk is the builder for creating many DataMigration entities in bulk.
+type DataMigrationCreateBulk struct {
+	config
+	err      error
+	builders []*DataMigrationCreate
+}
+
+// Save creates the DataMigration entities in the database.
+func (dmcb *DataMigrationCreateBulk) Save(ctx context.Context) ([]*DataMigration, error) {
+	if dmcb.err != nil {
+		return nil, dmcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(dmcb.builders))
+	nodes := make([]*DataMigration, len(dmcb.builders))
+	mutators := make([]Mutator, len(dmcb.builders))
+	for i := range dmcb.builders {
+		func(i int, root context.Context) {
+			builder := dmcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DataMigrationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dmcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dmcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dmcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dmcb *DataMigrationCreateBulk) SaveX(ctx context.Context) []*DataMigration {
+	v, err := dmcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dmcb *DataMigrationCreateBulk) Exec(ctx context.Context) error {
+	_, err := dmcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dmcb *DataMigrationCreateBulk) ExecX(ctx context.Context) {
+	if err := dmcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"gochat_server/ent/datamigration"
+	"gochat_server/ent/predicate"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DataMigrationQuery is the builder for querying DataMigration entities.
+type DataMigrationQuery struct {
+	config
+	ctx        *QueryContext
+	order      []datamigration.OrderOption
+	inters     []Interceptor
+	predicates []predicate.DataMigration
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the DataMigrationQuery builder.
+func (dmq *DataMigrationQuery) Where(ps ...predicate.DataMigration) *DataMigrationQuery {
+	dmq.predicates = append(dmq.predicates, ps...)
+	return dmq
+}
+
+// Limit the number of records to be returned by this query.
+func (dmq *DataMigrationQuery) Limit(limit int) *DataMigrationQuery {
+	dmq.ctx.Limit = &limit
+	return dmq
+}
+
+// Offset to start from.
+func (dmq *DataMigrationQuery) Offset(offset int) *DataMigrationQuery {
+	dmq.ctx.Offset = &offset
+	return dmq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (dmq *DataMigrationQuery) Unique(unique bool) *DataMigrationQuery {
+	dmq.ctx.Unique = &unique
+	return dmq
+}
+
+// Order specifies how the records should be ordered.
+func (dmq *DataMigrationQuery) Order(o ...datamigration.OrderOption) *DataMigrationQuery {
+	dmq.order = append(dmq.order, o...)
+	return dmq
+}
+
+// First returns the first DataMigration entity from the query.
+// Returns a *NotFoundError when no DataMigration was found.
+func (dmq *DataMigrationQuery) First(ctx context.Context) (*DataMigration, error) {
+	nodes, err := dmq.Limit(1).All(setContextOp(ctx, dmq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{datamigration.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (dmq *DataMigrationQuery) FirstX(ctx context.Context) *DataMigration {
+	node, err := dmq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first DataMigration ID from the query.
+// Returns a *NotFoundError when no DataMigration ID was found.
+func (dmq *DataMigrationQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = dmq.Limit(1).IDs(setContextOp(ctx, dmq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{datamigration.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (dmq *DataMigrationQuery) FirstIDX(ctx context.Context) int {
+	id, err := dmq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single DataMigration entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one DataMigration entity is found.
+// Returns a *NotFoundError when no DataMigration entities are found.
+func (dmq *DataMigrationQuery) Only(ctx context.Context) (*DataMigration, error) {
+	nodes, err := dmq.Limit(2).All(setContextOp(ctx, dmq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{datamigration.Label}
+	default:
+		return nil, &NotSingularError{datamigration.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (dmq *DataMigrationQuery) OnlyX(ctx context.Context) *DataMigration {
+	node, err := dmq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only DataMigration ID in the query.
+// Returns a *NotSingularError when more than one DataMigration ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (dmq *DataMigrationQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = dmq.Limit(2).IDs(setContextOp(ctx, dmq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{datamigration.Label}
+	default:
+		err = &NotSingularError{datamigration.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (dmq *DataMigrationQuery) OnlyIDX(ctx context.Context) int {
+	id, err := dmq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of DataMigrations.
+func (dmq *DataMigrationQuery) All(ctx context.Context) ([]*DataMigration, error) {
+	ctx = setContextOp(ctx, dmq.ctx, ent.OpQueryAll)
+	if err := dmq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*DataMigration, *DataMigrationQuery]()
+	return withInterceptors[[]*DataMigration](ctx, dmq, qr, dmq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (dmq *DataMigrationQuery) AllX(ctx context.Context) []*DataMigration {
+	nodes, err := dmq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of DataMigration IDs.
+func (dmq *DataMigrationQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if dmq.ctx.Unique == nil && dmq.path != nil {
+		dmq.Unique(true)
+	}
+	ctx = setContextOp(ctx, dmq.ctx, ent.OpQueryIDs)
+	if err = dmq.Select(datamigration.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (dmq *DataMigrationQuery) IDsX(ctx context.Context) []int {
+	ids, err := dmq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (dmq *DataMigrationQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, dmq.ctx, ent.OpQueryCount)
+	if err := dmq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, dmq, querierCount[*DataMigrationQuery](), dmq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (dmq *DataMigrationQuery) CountX(ctx context.Context) int {
+	count, err := dmq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (dmq *DataMigrationQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, dmq.ctx, ent.OpQueryExist)
+	switch _, err := dmq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (dmq *DataMigrationQuery) ExistX(ctx context.Context) bool {
+	exist, err := dmq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the DataMigrationQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (dmq *DataMigrationQuery) Clone() *DataMigrationQuery {
+	if dmq == nil {
+		return nil
+	}
+	return &DataMigrationQuery{
+		config:     dmq.config,
+		ctx:        dmq.ctx.Clone(),
+		order:      append([]datamigration.OrderOption{}, dmq.order...),
+		inters:     append([]Interceptor{}, dmq.inters...),
+		predicates: append([]predicate.DataMigration{}, dmq.predicates...),
+		// clone intermediate query.
+		sql:  dmq.sql.Clone(),
+		path: dmq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.DataMigration.Query().
+//		GroupBy(datamigration.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (dmq *DataMigrationQuery) GroupBy(field string, fields ...string) *DataMigrationGroupBy {
+	dmq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &DataMigrationGroupBy{build: dmq}
+	grbuild.flds = &dmq.ctx.Fields
+	grbuild.label = datamigration.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.DataMigration.Query().
+//		Select(datamigration.FieldName).
+//		Scan(ctx, &v)
+func (dmq *DataMigrationQuery) Select(fields ...string) *DataMigrationSelect {
+	dmq.ctx.Fields = append(dmq.ctx.Fields, fields...)
+	sbuild := &DataMigrationSelect{DataMigrationQuery: dmq}
+	sbuild.label = datamigration.Label
+	sbuild.flds, sbuild.scan = &dmq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a DataMigrationSelect configured with the given aggregations.
+func (dmq *DataMigrationQuery) Aggregate(fns ...AggregateFunc) *DataMigrationSelect {
+	return dmq.Select().Aggregate(fns...)
+}
+
+func (dmq *DataMigrationQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range dmq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, dmq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range dmq.ctx.Fields {
+		if !datamigration.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if dmq.path != nil {
+		prev, err := dmq.path(ctx)
+		if err != nil {
+			return err
+		}
+		dmq.sql = prev
+	}
+	return nil
+}
+
+func (dmq *DataMigrationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*DataMigration, error) {
+	var (
+		nodes = []*DataMigration{}
+		_spec = dmq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*DataMigration).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &DataMigration{config: dmq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, dmq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (dmq *DataMigrationQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := dmq.querySpec()
+	_spec.Node.Columns = dmq.ctx.Fields
+	if len(dmq.ctx.Fields) > 0 {
+		_spec.Unique = dmq.ctx.Unique != nil && *dmq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, dmq.driver, _spec)
+}
+
+func (dmq *DataMigrationQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(datamigration.Table, datamigration.Columns, sqlgraph.NewFieldSpec(datamigration.FieldID, field.TypeInt))
+	_spec.From = dmq.sql
+	if unique := dmq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if dmq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := dmq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, datamigration.FieldID)
+		for i := range fields {
+			if fields[i] != datamigration.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := dmq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := dmq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := dmq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := dmq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (dmq *DataMigrationQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(dmq.driver.Dialect())
+	t1 := builder.Table(datamigration.Table)
+	columns := dmq.ctx.Fields
+	if len(columns) == 0 {
+		columns = datamigration.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if dmq.sql != nil {
+		selector = dmq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if dmq.ctx.Unique != nil && *dmq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range dmq.predicates {
+		p(selector)
+	}
+	for _, p := range dmq.order {
+		p(selector)
+	}
+	if offset := dmq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := dmq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// DataMigrationGroupBy is the group-by builder for DataMigration entities.
+type DataMigrationGroupBy struct {
+	selector
+	build *DataMigrationQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (dmgb *DataMigrationGroupBy) Aggregate(fns ...AggregateFunc) *DataMigrationGroupBy {
+	dmgb.fns = append(dmgb.fns, fns...)
+	return dmgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dmgb *DataMigrationGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, dmgb.build.ctx, ent.OpQueryGroupBy)
+	if err := dmgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DataMigrationQuery, *DataMigrationGroupBy](ctx, dmgb.build, dmgb, dmgb.build.inters, v)
+}
+
+func (dmgb *DataMigrationGroupBy) sqlScan(ctx context.Context, root *DataMigrationQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(dmgb.fns))
+	for _, fn := range dmgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*dmgb.flds)+len(dmgb.fns))
+		for _, f := range *dmgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*dmgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dmgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// DataMigrationSelect is the builder for selecting fields of DataMigration entities.
+type DataMigrationSelect struct {
+	*DataMigrationQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (dms *DataMigrationSelect) Aggregate(fns ...AggregateFunc) *DataMigrationSelect {
+	dms.fns = append(dms.fns, fns...)
+	return dms
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dms *DataMigrationSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, dms.ctx, ent.OpQuerySelect)
+	if err := dms.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DataMigrationQuery, *DataMigrationSelect](ctx, dms.DataMigrationQuery, dms, dms.inters, v)
+}
+
+func (dms *DataMigrationSelect) sqlScan(ctx context.Context, root *DataMigrationQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(dms.fns))
+	for _, fn := range dms.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*dms.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dms.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
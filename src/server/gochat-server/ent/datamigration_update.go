@@ -0,0 +1,500 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gochat_server/ent/datamigration"
+	"gochat_server/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DataMigrationUpdate is the builder for updating DataMigration entities.
+type DataMigrationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DataMigrationMutation
+}
+
+// Where appends a list predicates to the DataMigrationUpdate builder.
+func (dmu *DataMigrationUpdate) Where(ps ...predicate.DataMigration) *DataMigrationUpdate {
+	dmu.mutation.Where(ps...)
+	return dmu
+}
+
+// SetName sets the "name" field.
+func (dmu *DataMigrationUpdate) SetName(s string) *DataMigrationUpdate {
+	dmu.mutation.SetName(s)
+	return dmu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (dmu *DataMigrationUpdate) SetNillableName(s *string) *DataMigrationUpdate {
+	if s != nil {
+		dmu.SetName(*s)
+	}
+	return dmu
+}
+
+// SetCursor sets the "cursor" field.
+func (dmu *DataMigrationUpdate) SetCursor(i int) *DataMigrationUpdate {
+	dmu.mutation.ResetCursor()
+	dmu.mutation.SetCursor(i)
+	return dmu
+}
+
+// SetNillableCursor sets the "cursor" field if the given value is not nil.
+func (dmu *DataMigrationUpdate) SetNillableCursor(i *int) *DataMigrationUpdate {
+	if i != nil {
+		dmu.SetCursor(*i)
+	}
+	return dmu
+}
+
+// AddCursor adds i to the "cursor" field.
+func (dmu *DataMigrationUpdate) AddCursor(i int) *DataMigrationUpdate {
+	dmu.mutation.AddCursor(i)
+	return dmu
+}
+
+// SetMigrated sets the "migrated" field.
+func (dmu *DataMigrationUpdate) SetMigrated(i int) *DataMigrationUpdate {
+	dmu.mutation.ResetMigrated()
+	dmu.mutation.SetMigrated(i)
+	return dmu
+}
+
+// SetNillableMigrated sets the "migrated" field if the given value is not nil.
+func (dmu *DataMigrationUpdate) SetNillableMigrated(i *int) *DataMigrationUpdate {
+	if i != nil {
+		dmu.SetMigrated(*i)
+	}
+	return dmu
+}
+
+// AddMigrated adds i to the "migrated" field.
+func (dmu *DataMigrationUpdate) AddMigrated(i int) *DataMigrationUpdate {
+	dmu.mutation.AddMigrated(i)
+	return dmu
+}
+
+// SetIsCompleted sets the "isCompleted" field.
+func (dmu *DataMigrationUpdate) SetIsCompleted(b bool) *DataMigrationUpdate {
+	dmu.mutation.SetIsCompleted(b)
+	return dmu
+}
+
+// SetNillableIsCompleted sets the "isCompleted" field if the given value is not nil.
+func (dmu *DataMigrationUpdate) SetNillableIsCompleted(b *bool) *DataMigrationUpdate {
+	if b != nil {
+		dmu.SetIsCompleted(*b)
+	}
+	return dmu
+}
+
+// SetStartTime sets the "startTime" field.
+func (dmu *DataMigrationUpdate) SetStartTime(t time.Time) *DataMigrationUpdate {
+	dmu.mutation.SetStartTime(t)
+	return dmu
+}
+
+// SetNillableStartTime sets the "startTime" field if the given value is not nil.
+func (dmu *DataMigrationUpdate) SetNillableStartTime(t *time.Time) *DataMigrationUpdate {
+	if t != nil {
+		dmu.SetStartTime(*t)
+	}
+	return dmu
+}
+
+// SetUpdateTime sets the "updateTime" field.
+func (dmu *DataMigrationUpdate) SetUpdateTime(t time.Time) *DataMigrationUpdate {
+	dmu.mutation.SetUpdateTime(t)
+	return dmu
+}
+
+// SetFinishTime sets the "finishTime" field.
+func (dmu *DataMigrationUpdate) SetFinishTime(t time.Time) *DataMigrationUpdate {
+	dmu.mutation.SetFinishTime(t)
+	return dmu
+}
+
+// SetNillableFinishTime sets the "finishTime" field if the given value is not nil.
+func (dmu *DataMigrationUpdate) SetNillableFinishTime(t *time.Time) *DataMigrationUpdate {
+	if t != nil {
+		dmu.SetFinishTime(*t)
+	}
+	return dmu
+}
+
+// ClearFinishTime clears the value of the "finishTime" field.
+func (dmu *DataMigrationUpdate) ClearFinishTime() *DataMigrationUpdate {
+	dmu.mutation.ClearFinishTime()
+	return dmu
+}
+
+// Mutation returns the DataMigrationMutation object of the builder.
+func (dmu *DataMigrationUpdate) Mutation() *DataMigrationMutation {
+	return dmu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (dmu *DataMigrationUpdate) Save(ctx context.Context) (int, error) {
+	dmu.defaults()
+	return withHooks(ctx, dmu.sqlSave, dmu.mutation, dmu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dmu *DataMigrationUpdate) SaveX(ctx context.Context) int {
+	affected, err := dmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (dmu *DataMigrationUpdate) Exec(ctx context.Context) error {
+	_, err := dmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dmu *DataMigrationUpdate) ExecX(ctx context.Context) {
+	if err := dmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dmu *DataMigrationUpdate) defaults() {
+	if _, ok := dmu.mutation.UpdateTime(); !ok {
+		v := datamigration.UpdateDefaultUpdateTime()
+		dmu.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dmu *DataMigrationUpdate) check() error {
+	if v, ok := dmu.mutation.Name(); ok {
+		if err := datamigration.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "DataMigration.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (dmu *DataMigrationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := dmu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(datamigration.Table, datamigration.Columns, sqlgraph.NewFieldSpec(datamigration.FieldID, field.TypeInt))
+	if ps := dmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dmu.mutation.Name(); ok {
+		_spec.SetField(datamigration.FieldName, field.TypeString, value)
+	}
+	if value, ok := dmu.mutation.Cursor(); ok {
+		_spec.SetField(datamigration.FieldCursor, field.TypeInt, value)
+	}
+	if value, ok := dmu.mutation.AddedCursor(); ok {
+		_spec.AddField(datamigration.FieldCursor, field.TypeInt, value)
+	}
+	if value, ok := dmu.mutation.Migrated(); ok {
+		_spec.SetField(datamigration.FieldMigrated, field.TypeInt, value)
+	}
+	if value, ok := dmu.mutation.AddedMigrated(); ok {
+		_spec.AddField(datamigration.FieldMigrated, field.TypeInt, value)
+	}
+	if value, ok := dmu.mutation.IsCompleted(); ok {
+		_spec.SetField(datamigration.FieldIsCompleted, field.TypeBool, value)
+	}
+	if value, ok := dmu.mutation.StartTime(); ok {
+		_spec.SetField(datamigration.FieldStartTime, field.TypeTime, value)
+	}
+	if value, ok := dmu.mutation.UpdateTime(); ok {
+		_spec.SetField(datamigration.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := dmu.mutation.FinishTime(); ok {
+		_spec.SetField(datamigration.FieldFinishTime, field.TypeTime, value)
+	}
+	if dmu.mutation.FinishTimeCleared() {
+		_spec.ClearField(datamigration.FieldFinishTime, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, dmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{datamigration.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	dmu.mutation.done = true
+	return n, nil
+}
+
+// DataMigrationUpdateOne is the builder for updating a single DataMigration entity.
+type DataMigrationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DataMigrationMutation
+}
+
+// SetName sets the "name" field.
+func (dmuo *DataMigrationUpdateOne) SetName(s string) *DataMigrationUpdateOne {
+	dmuo.mutation.SetName(s)
+	return dmuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (dmuo *DataMigrationUpdateOne) SetNillableName(s *string) *DataMigrationUpdateOne {
+	if s != nil {
+		dmuo.SetName(*s)
+	}
+	return dmuo
+}
+
+// SetCursor sets the "cursor" field.
+func (dmuo *DataMigrationUpdateOne) SetCursor(i int) *DataMigrationUpdateOne {
+	dmuo.mutation.ResetCursor()
+	dmuo.mutation.SetCursor(i)
+	return dmuo
+}
+
+// SetNillableCursor sets the "cursor" field if the given value is not nil.
+func (dmuo *DataMigrationUpdateOne) SetNillableCursor(i *int) *DataMigrationUpdateOne {
+	if i != nil {
+		dmuo.SetCursor(*i)
+	}
+	return dmuo
+}
+
+// AddCursor adds i to the "cursor" field.
+func (dmuo *DataMigrationUpdateOne) AddCursor(i int) *DataMigrationUpdateOne {
+	dmuo.mutation.AddCursor(i)
+	return dmuo
+}
+
+// SetMigrated sets the "migrated" field.
+func (dmuo *DataMigrationUpdateOne) SetMigrated(i int) *DataMigrationUpdateOne {
+	dmuo.mutation.ResetMigrated()
+	dmuo.mutation.SetMigrated(i)
+	return dmuo
+}
+
+// SetNillableMigrated sets the "migrated" field if the given value is not nil.
+func (dmuo *DataMigrationUpdateOne) SetNillableMigrated(i *int) *DataMigrationUpdateOne {
+	if i != nil {
+		dmuo.SetMigrated(*i)
+	}
+	return dmuo
+}
+
+// AddMigrated adds i to the "migrated" field.
+func (dmuo *DataMigrationUpdateOne) AddMigrated(i int) *DataMigrationUpdateOne {
+	dmuo.mutation.AddMigrated(i)
+	return dmuo
+}
+
+// SetIsCompleted sets the "isCompleted" field.
+func (dmuo *DataMigrationUpdateOne) SetIsCompleted(b bool) *DataMigrationUpdateOne {
+	dmuo.mutation.SetIsCompleted(b)
+	return dmuo
+}
+
+// SetNillableIsCompleted sets the "isCompleted" field if the given value is not nil.
+func (dmuo *DataMigrationUpdateOne) SetNillableIsCompleted(b *bool) *DataMigrationUpdateOne {
+	if b != nil {
+		dmuo.SetIsCompleted(*b)
+	}
+	return dmuo
+}
+
+// SetStartTime sets the "startTime" field.
+func (dmuo *DataMigrationUpdateOne) SetStartTime(t time.Time) *DataMigrationUpdateOne {
+	dmuo.mutation.SetStartTime(t)
+	return dmuo
+}
+
+// SetNillableStartTime sets the "startTime" field if the given value is not nil.
+func (dmuo *DataMigrationUpdateOne) SetNillableStartTime(t *time.Time) *DataMigrationUpdateOne {
+	if t != nil {
+		dmuo.SetStartTime(*t)
+	}
+	return dmuo
+}
+
+// SetUpdateTime sets the "updateTime" field.
+func (dmuo *DataMigrationUpdateOne) SetUpdateTime(t time.Time) *DataMigrationUpdateOne {
+	dmuo.mutation.SetUpdateTime(t)
+	return dmuo
+}
+
+// SetFinishTime sets the "finishTime" field.
+func (dmuo *DataMigrationUpdateOne) SetFinishTime(t time.Time) *DataMigrationUpdateOne {
+	dmuo.mutation.SetFinishTime(t)
+	return dmuo
+}
+
+// SetNillableFinishTime sets the "finishTime" field if the given value is not nil.
+func (dmuo *DataMigrationUpdateOne) SetNillableFinishTime(t *time.Time) *DataMigrationUpdateOne {
+	if t != nil {
+		dmuo.SetFinishTime(*t)
+	}
+	return dmuo
+}
+
+// ClearFinishTime clears the value of the "finishTime" field.
+func (dmuo *DataMigrationUpdateOne) ClearFinishTime() *DataMigrationUpdateOne {
+	dmuo.mutation.ClearFinishTime()
+	return dmuo
+}
+
+// Mutation returns the DataMigrationMutation object of the builder.
+func (dmuo *DataMigrationUpdateOne) Mutation() *DataMigrationMutation {
+	return dmuo.mutation
+}
+
+// Where appends a list predicates to the DataMigrationUpdate builder.
+func (dmuo *DataMigrationUpdateOne) Where(ps ...predicate.DataMigration) *DataMigrationUpdateOne {
+	dmuo.mutation.Where(ps...)
+	return dmuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (dmuo *DataMigrationUpdateOne) Select(field string, fields ...string) *DataMigrationUpdateOne {
+	dmuo.fields = append([]string{field}, fields...)
+	return dmuo
+}
+
+// Save executes the query and returns the updated DataMigration entity.
+func (dmuo *DataMigrationUpdateOne) Save(ctx context.Context) (*DataMigration, error) {
+	dmuo.defaults()
+	return withHooks(ctx, dmuo.sqlSave, dmuo.mutation, dmuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dmuo *DataMigrationUpdateOne) SaveX(ctx context.Context) *DataMigration {
+	node, err := dmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (dmuo *DataMigrationUpdateOne) Exec(ctx context.Context) error {
+	_, err := dmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dmuo *DataMigrationUpdateOne) ExecX(ctx context.Context) {
+	if err := dmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dmuo *DataMigrationUpdateOne) defaults() {
+	if _, ok := dmuo.mutation.UpdateTime(); !ok {
+		v := datamigration.UpdateDefaultUpdateTime()
+		dmuo.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dmuo *DataMigrationUpdateOne) check() error {
+	if v, ok := dmuo.mutation.Name(); ok {
+		if err := datamigration.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "DataMigration.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (dmuo *DataMigrationUpdateOne) sqlSave(ctx context.Context) (_node *DataMigration, err error) {
+	if err := dmuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(datamigration.Table, datamigration.Columns, sqlgraph.NewFieldSpec(datamigration.FieldID, field.TypeInt))
+	id, ok := dmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DataMigration.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := dmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, datamigration.FieldID)
+		for _, f := range fields {
+			if !datamigration.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != datamigration.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := dmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dmuo.mutation.Name(); ok {
+		_spec.SetField(datamigration.FieldName, field.TypeString, value)
+	}
+	if value, ok := dmuo.mutation.Cursor(); ok {
+		_spec.SetField(datamigration.FieldCursor, field.TypeInt, value)
+	}
+	if value, ok := dmuo.mutation.AddedCursor(); ok {
+		_spec.AddField(datamigration.FieldCursor, field.TypeInt, value)
+	}
+	if value, ok := dmuo.mutation.Migrated(); ok {
+		_spec.SetField(datamigration.FieldMigrated, field.TypeInt, value)
+	}
+	if value, ok := dmuo.mutation.AddedMigrated(); ok {
+		_spec.AddField(datamigration.FieldMigrated, field.TypeInt, value)
+	}
+	if value, ok := dmuo.mutation.IsCompleted(); ok {
+		_spec.SetField(datamigration.FieldIsCompleted, field.TypeBool, value)
+	}
+	if value, ok := dmuo.mutation.StartTime(); ok {
+		_spec.SetField(datamigration.FieldStartTime, field.TypeTime, value)
+	}
+	if value, ok := dmuo.mutation.UpdateTime(); ok {
+		_spec.SetField(datamigration.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := dmuo.mutation.FinishTime(); ok {
+		_spec.SetField(datamigration.FieldFinishTime, field.TypeTime, value)
+	}
+	if dmuo.mutation.FinishTimeCleared() {
+		_spec.ClearField(datamigration.FieldFinishTime, field.TypeTime)
+	}
+	_node = &DataMigration{config: dmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, dmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{datamigration.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	dmuo.mutation.done = true
+	return _node, nil
+}
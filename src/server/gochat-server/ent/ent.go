@@ -6,18 +6,36 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"gochat_server/ent/block"
 	"gochat_server/ent/chatrecord"
+	"gochat_server/ent/contactcardmessage"
+	"gochat_server/ent/conversationclear"
+	"gochat_server/ent/conversationtimer"
+	"gochat_server/ent/datamigration"
 	"gochat_server/ent/donotdisturb"
+	"gochat_server/ent/exportjob"
+	"gochat_server/ent/filemessage"
 	"gochat_server/ent/friendrelationship"
 	"gochat_server/ent/friendrequest"
 	"gochat_server/ent/group"
 	"gochat_server/ent/groupchatrecord"
+	"gochat_server/ent/groupwatermark"
 	"gochat_server/ent/imagemessage"
+	"gochat_server/ent/locationmessage"
+	"gochat_server/ent/mergedforwardmessage"
 	"gochat_server/ent/message"
+	"gochat_server/ent/messagedeletion"
+	"gochat_server/ent/messageforward"
+	"gochat_server/ent/messagemention"
+	"gochat_server/ent/messageoutbox"
+	"gochat_server/ent/messagereaction"
 	"gochat_server/ent/messagestatus"
+	"gochat_server/ent/retentionjob"
+	"gochat_server/ent/scheduledmessage"
 	"gochat_server/ent/textmessage"
 	"gochat_server/ent/user"
 	"gochat_server/ent/videomessage"
+	"gochat_server/ent/voicemessage"
 	"reflect"
 	"sync"
 
@@ -84,18 +102,36 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			chatrecord.Table:         chatrecord.ValidColumn,
-			donotdisturb.Table:       donotdisturb.ValidColumn,
-			friendrelationship.Table: friendrelationship.ValidColumn,
-			friendrequest.Table:      friendrequest.ValidColumn,
-			group.Table:              group.ValidColumn,
-			groupchatrecord.Table:    groupchatrecord.ValidColumn,
-			imagemessage.Table:       imagemessage.ValidColumn,
-			message.Table:            message.ValidColumn,
-			messagestatus.Table:      messagestatus.ValidColumn,
-			textmessage.Table:        textmessage.ValidColumn,
-			user.Table:               user.ValidColumn,
-			videomessage.Table:       videomessage.ValidColumn,
+			block.Table:                block.ValidColumn,
+			chatrecord.Table:           chatrecord.ValidColumn,
+			contactcardmessage.Table:   contactcardmessage.ValidColumn,
+			conversationclear.Table:    conversationclear.ValidColumn,
+			conversationtimer.Table:    conversationtimer.ValidColumn,
+			datamigration.Table:        datamigration.ValidColumn,
+			donotdisturb.Table:         donotdisturb.ValidColumn,
+			exportjob.Table:            exportjob.ValidColumn,
+			filemessage.Table:          filemessage.ValidColumn,
+			friendrelationship.Table:   friendrelationship.ValidColumn,
+			friendrequest.Table:        friendrequest.ValidColumn,
+			group.Table:                group.ValidColumn,
+			groupchatrecord.Table:      groupchatrecord.ValidColumn,
+			groupwatermark.Table:       groupwatermark.ValidColumn,
+			imagemessage.Table:         imagemessage.ValidColumn,
+			locationmessage.Table:      locationmessage.ValidColumn,
+			mergedforwardmessage.Table: mergedforwardmessage.ValidColumn,
+			message.Table:              message.ValidColumn,
+			messagedeletion.Table:      messagedeletion.ValidColumn,
+			messageforward.Table:       messageforward.ValidColumn,
+			messagemention.Table:       messagemention.ValidColumn,
+			messageoutbox.Table:        messageoutbox.ValidColumn,
+			messagereaction.Table:      messagereaction.ValidColumn,
+			messagestatus.Table:        messagestatus.ValidColumn,
+			retentionjob.Table:         retentionjob.ValidColumn,
+			scheduledmessage.Table:     scheduledmessage.ValidColumn,
+			textmessage.Table:          textmessage.ValidColumn,
+			user.Table:                 user.ValidColumn,
+			videomessage.Table:         videomessage.ValidColumn,
+			voicemessage.Table:         voicemessage.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -0,0 +1,257 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"gochat_server/ent/exportjob"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ExportJob is the model entity for the ExportJob schema.
+type ExportJob struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 导出用户ID
+	UserId int `json:"userId,omitempty"`
+	// 是否为群聊会话
+	IsGroup bool `json:"isGroup,omitempty"`
+	// 私聊为好友ID，群聊为群组ID
+	TargetId int `json:"targetId,omitempty"`
+	// 导出格式: json, html(包含媒体文件的ZIP压缩包)
+	Format string `json:"format,omitempty"`
+	// 状态: pending 等待中, running 导出中, completed 已完成, failed 失败, expired 文件已过期删除
+	Status string `json:"status,omitempty"`
+	// 导出中任务的租约到期时间，到期未完成时由其他实例重新导出
+	LeaseTime *time.Time `json:"leaseTime,omitempty"`
+	// 领取次数，用于条件更新保证同一时间只有一个实例导出
+	Attempts int `json:"attempts,omitempty"`
+	// 需要导出的消息数
+	Total int `json:"total,omitempty"`
+	// 已导出的消息数
+	Processed int `json:"processed,omitempty"`
+	// 导出文件在对象存储中的键
+	ObjectName string `json:"objectName,omitempty"`
+	// 导出文件大小(字节)
+	FileSize int64 `json:"fileSize,omitempty"`
+	// 失败原因
+	LastError string `json:"lastError,omitempty"`
+	// 创建时间
+	CreateTime time.Time `json:"createTime,omitempty"`
+	// 完成时间
+	FinishTime   *time.Time `json:"finishTime,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ExportJob) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case exportjob.FieldIsGroup:
+			values[i] = new(sql.NullBool)
+		case exportjob.FieldID, exportjob.FieldUserId, exportjob.FieldTargetId, exportjob.FieldAttempts, exportjob.FieldTotal, exportjob.FieldProcessed, exportjob.FieldFileSize:
+			values[i] = new(sql.NullInt64)
+		case exportjob.FieldFormat, exportjob.FieldStatus, exportjob.FieldObjectName, exportjob.FieldLastError:
+			values[i] = new(sql.NullString)
+		case exportjob.FieldLeaseTime, exportjob.FieldCreateTime, exportjob.FieldFinishTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ExportJob fields.
+func (ej *ExportJob) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case exportjob.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ej.ID = int(value.Int64)
+		case exportjob.FieldUserId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field userId", values[i])
+			} else if value.Valid {
+				ej.UserId = int(value.Int64)
+			}
+		case exportjob.FieldIsGroup:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field isGroup", values[i])
+			} else if value.Valid {
+				ej.IsGroup = value.Bool
+			}
+		case exportjob.FieldTargetId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field targetId", values[i])
+			} else if value.Valid {
+				ej.TargetId = int(value.Int64)
+			}
+		case exportjob.FieldFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field format", values[i])
+			} else if value.Valid {
+				ej.Format = value.String
+			}
+		case exportjob.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ej.Status = value.String
+			}
+		case exportjob.FieldLeaseTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field leaseTime", values[i])
+			} else if value.Valid {
+				ej.LeaseTime = new(time.Time)
+				*ej.LeaseTime = value.Time
+			}
+		case exportjob.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				ej.Attempts = int(value.Int64)
+			}
+		case exportjob.FieldTotal:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total", values[i])
+			} else if value.Valid {
+				ej.Total = int(value.Int64)
+			}
+		case exportjob.FieldProcessed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field processed", values[i])
+			} else if value.Valid {
+				ej.Processed = int(value.Int64)
+			}
+		case exportjob.FieldObjectName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field objectName", values[i])
+			} else if value.Valid {
+				ej.ObjectName = value.String
+			}
+		case exportjob.FieldFileSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field fileSize", values[i])
+			} else if value.Valid {
+				ej.FileSize = value.Int64
+			}
+		case exportjob.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field lastError", values[i])
+			} else if value.Valid {
+				ej.LastError = value.String
+			}
+		case exportjob.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field createTime", values[i])
+			} else if value.Valid {
+				ej.CreateTime = value.Time
+			}
+		case exportjob.FieldFinishTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finishTime", values[i])
+			} else if value.Valid {
+				ej.FinishTime = new(time.Time)
+				*ej.FinishTime = value.Time
+			}
+		default:
+			ej.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ExportJob.
+// This includes values selected through modifiers, order, etc.
+func (ej *ExportJob) Value(name string) (ent.Value, error) {
+	return ej.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ExportJob.
+// Note that you need to call ExportJob.Unwrap() before calling this method if this ExportJob
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ej *ExportJob) Update() *ExportJobUpdateOne {
+	return NewExportJobClient(ej.config).UpdateOne(ej)
+}
+
+// Unwrap unwraps the ExportJob entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ej *ExportJob) Unwrap() *ExportJob {
+	_tx, ok := ej.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ExportJob is not a transactional entity")
+	}
+	ej.config.driver = _tx.drv
+	return ej
+}
+
+// String implements the fmt.Stringer.
+func (ej *ExportJob) String() string {
+	var builder strings.Builder
+	builder.WriteString("ExportJob(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ej.ID))
+	builder.WriteString("userId=")
+	builder.WriteString(fmt.Sprintf("%v", ej.UserId))
+	builder.WriteString(", ")
+	builder.WriteString("isGroup=")
+	builder.WriteString(fmt.Sprintf("%v", ej.IsGroup))
+	builder.WriteString(", ")
+	builder.WriteString("targetId=")
+	builder.WriteString(fmt.Sprintf("%v", ej.TargetId))
+	builder.WriteString(", ")
+	builder.WriteString("format=")
+	builder.WriteString(ej.Format)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(ej.Status)
+	builder.WriteString(", ")
+	if v := ej.LeaseTime; v != nil {
+		builder.WriteString("leaseTime=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", ej.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("total=")
+	builder.WriteString(fmt.Sprintf("%v", ej.Total))
+	builder.WriteString(", ")
+	builder.WriteString("processed=")
+	builder.WriteString(fmt.Sprintf("%v", ej.Processed))
+	builder.WriteString(", ")
+	builder.WriteString("objectName=")
+	builder.WriteString(ej.ObjectName)
+	builder.WriteString(", ")
+	builder.WriteString("fileSize=")
+	builder.WriteString(fmt.Sprintf("%v", ej.FileSize))
+	builder.WriteString(", ")
+	builder.WriteString("lastError=")
+	builder.WriteString(ej.LastError)
+	builder.WriteString(", ")
+	builder.WriteString("createTime=")
+	builder.WriteString(ej.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := ej.FinishTime; v != nil {
+		builder.WriteString("finishTime=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ExportJobs is a parsable slice of ExportJob.
+type ExportJobs []*ExportJob
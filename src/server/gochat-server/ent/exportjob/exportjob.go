@@ -0,0 +1,174 @@
+// Code generated by ent, DO NOT EDIT.
+
+package exportjob
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the exportjob type in the database.
+	Label = "export_job"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserId holds the string denoting the userid field in the database.
+	FieldUserId = "user_id"
+	// FieldIsGroup holds the string denoting the isgroup field in the database.
+	FieldIsGroup = "is_group"
+	// FieldTargetId holds the string denoting the targetid field in the database.
+	FieldTargetId = "target_id"
+	// FieldFormat holds the string denoting the format field in the database.
+	FieldFormat = "format"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldLeaseTime holds the string denoting the leasetime field in the database.
+	FieldLeaseTime = "lease_time"
+	// FieldAttempts holds the string denoting the attempts field in the database.
+	FieldAttempts = "attempts"
+	// FieldTotal holds the string denoting the total field in the database.
+	FieldTotal = "total"
+	// FieldProcessed holds the string denoting the processed field in the database.
+	FieldProcessed = "processed"
+	// FieldObjectName holds the string denoting the objectname field in the database.
+	FieldObjectName = "object_name"
+	// FieldFileSize holds the string denoting the filesize field in the database.
+	FieldFileSize = "file_size"
+	// FieldLastError holds the string denoting the lasterror field in the database.
+	FieldLastError = "last_error"
+	// FieldCreateTime holds the string denoting the createtime field in the database.
+	FieldCreateTime = "create_time"
+	// FieldFinishTime holds the string denoting the finishtime field in the database.
+	FieldFinishTime = "finish_time"
+	// Table holds the table name of the exportjob in the database.
+	Table = "export_jobs"
+)
+
+// Columns holds all SQL columns for exportjob fields.
+var Columns = []string{
+	FieldID,
+	FieldUserId,
+	FieldIsGroup,
+	FieldTargetId,
+	FieldFormat,
+	FieldStatus,
+	FieldLeaseTime,
+	FieldAttempts,
+	FieldTotal,
+	FieldProcessed,
+	FieldObjectName,
+	FieldFileSize,
+	FieldLastError,
+	FieldCreateTime,
+	FieldFinishTime,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultIsGroup holds the default value on creation for the "isGroup" field.
+	DefaultIsGroup bool
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultAttempts holds the default value on creation for the "attempts" field.
+	DefaultAttempts int
+	// DefaultTotal holds the default value on creation for the "total" field.
+	DefaultTotal int
+	// DefaultProcessed holds the default value on creation for the "processed" field.
+	DefaultProcessed int
+	// DefaultObjectName holds the default value on creation for the "objectName" field.
+	DefaultObjectName string
+	// DefaultFileSize holds the default value on creation for the "fileSize" field.
+	DefaultFileSize int64
+	// DefaultLastError holds the default value on creation for the "lastError" field.
+	DefaultLastError string
+	// DefaultCreateTime holds the default value on creation for the "createTime" field.
+	DefaultCreateTime func() time.Time
+)
+
+// OrderOption defines the ordering options for the ExportJob queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserId orders the results by the userId field.
+func ByUserId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserId, opts...).ToFunc()
+}
+
+// ByIsGroup orders the results by the isGroup field.
+func ByIsGroup(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsGroup, opts...).ToFunc()
+}
+
+// ByTargetId orders the results by the targetId field.
+func ByTargetId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetId, opts...).ToFunc()
+}
+
+// ByFormat orders the results by the format field.
+func ByFormat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFormat, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByLeaseTime orders the results by the leaseTime field.
+func ByLeaseTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLeaseTime, opts...).ToFunc()
+}
+
+// ByAttempts orders the results by the attempts field.
+func ByAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttempts, opts...).ToFunc()
+}
+
+// ByTotal orders the results by the total field.
+func ByTotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotal, opts...).ToFunc()
+}
+
+// ByProcessed orders the results by the processed field.
+func ByProcessed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProcessed, opts...).ToFunc()
+}
+
+// ByObjectName orders the results by the objectName field.
+func ByObjectName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldObjectName, opts...).ToFunc()
+}
+
+// ByFileSize orders the results by the fileSize field.
+func ByFileSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFileSize, opts...).ToFunc()
+}
+
+// ByLastError orders the results by the lastError field.
+func ByLastError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastError, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the createTime field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByFinishTime orders the results by the finishTime field.
+func ByFinishTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinishTime, opts...).ToFunc()
+}
@@ -0,0 +1,790 @@
+// Code generated by ent, DO NOT EDIT.
+
+package exportjob
+
+import (
+	"gochat_server/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLTE(FieldID, id))
+}
+
+// UserId applies equality check predicate on the "userId" field. It's identical to UserIdEQ.
+func UserId(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldUserId, v))
+}
+
+// IsGroup applies equality check predicate on the "isGroup" field. It's identical to IsGroupEQ.
+func IsGroup(v bool) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldIsGroup, v))
+}
+
+// TargetId applies equality check predicate on the "targetId" field. It's identical to TargetIdEQ.
+func TargetId(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldTargetId, v))
+}
+
+// Format applies equality check predicate on the "format" field. It's identical to FormatEQ.
+func Format(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldFormat, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldStatus, v))
+}
+
+// LeaseTime applies equality check predicate on the "leaseTime" field. It's identical to LeaseTimeEQ.
+func LeaseTime(v time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldLeaseTime, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldAttempts, v))
+}
+
+// Total applies equality check predicate on the "total" field. It's identical to TotalEQ.
+func Total(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldTotal, v))
+}
+
+// Processed applies equality check predicate on the "processed" field. It's identical to ProcessedEQ.
+func Processed(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldProcessed, v))
+}
+
+// ObjectName applies equality check predicate on the "objectName" field. It's identical to ObjectNameEQ.
+func ObjectName(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldObjectName, v))
+}
+
+// FileSize applies equality check predicate on the "fileSize" field. It's identical to FileSizeEQ.
+func FileSize(v int64) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldFileSize, v))
+}
+
+// LastError applies equality check predicate on the "lastError" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldLastError, v))
+}
+
+// CreateTime applies equality check predicate on the "createTime" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// FinishTime applies equality check predicate on the "finishTime" field. It's identical to FinishTimeEQ.
+func FinishTime(v time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldFinishTime, v))
+}
+
+// UserIdEQ applies the EQ predicate on the "userId" field.
+func UserIdEQ(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldUserId, v))
+}
+
+// UserIdNEQ applies the NEQ predicate on the "userId" field.
+func UserIdNEQ(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNEQ(FieldUserId, v))
+}
+
+// UserIdIn applies the In predicate on the "userId" field.
+func UserIdIn(vs ...int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldIn(FieldUserId, vs...))
+}
+
+// UserIdNotIn applies the NotIn predicate on the "userId" field.
+func UserIdNotIn(vs ...int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNotIn(FieldUserId, vs...))
+}
+
+// UserIdGT applies the GT predicate on the "userId" field.
+func UserIdGT(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGT(FieldUserId, v))
+}
+
+// UserIdGTE applies the GTE predicate on the "userId" field.
+func UserIdGTE(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGTE(FieldUserId, v))
+}
+
+// UserIdLT applies the LT predicate on the "userId" field.
+func UserIdLT(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLT(FieldUserId, v))
+}
+
+// UserIdLTE applies the LTE predicate on the "userId" field.
+func UserIdLTE(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLTE(FieldUserId, v))
+}
+
+// IsGroupEQ applies the EQ predicate on the "isGroup" field.
+func IsGroupEQ(v bool) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldIsGroup, v))
+}
+
+// IsGroupNEQ applies the NEQ predicate on the "isGroup" field.
+func IsGroupNEQ(v bool) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNEQ(FieldIsGroup, v))
+}
+
+// TargetIdEQ applies the EQ predicate on the "targetId" field.
+func TargetIdEQ(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldTargetId, v))
+}
+
+// TargetIdNEQ applies the NEQ predicate on the "targetId" field.
+func TargetIdNEQ(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNEQ(FieldTargetId, v))
+}
+
+// TargetIdIn applies the In predicate on the "targetId" field.
+func TargetIdIn(vs ...int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldIn(FieldTargetId, vs...))
+}
+
+// TargetIdNotIn applies the NotIn predicate on the "targetId" field.
+func TargetIdNotIn(vs ...int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNotIn(FieldTargetId, vs...))
+}
+
+// TargetIdGT applies the GT predicate on the "targetId" field.
+func TargetIdGT(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGT(FieldTargetId, v))
+}
+
+// TargetIdGTE applies the GTE predicate on the "targetId" field.
+func TargetIdGTE(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGTE(FieldTargetId, v))
+}
+
+// TargetIdLT applies the LT predicate on the "targetId" field.
+func TargetIdLT(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLT(FieldTargetId, v))
+}
+
+// TargetIdLTE applies the LTE predicate on the "targetId" field.
+func TargetIdLTE(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLTE(FieldTargetId, v))
+}
+
+// FormatEQ applies the EQ predicate on the "format" field.
+func FormatEQ(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldFormat, v))
+}
+
+// FormatNEQ applies the NEQ predicate on the "format" field.
+func FormatNEQ(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNEQ(FieldFormat, v))
+}
+
+// FormatIn applies the In predicate on the "format" field.
+func FormatIn(vs ...string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldIn(FieldFormat, vs...))
+}
+
+// FormatNotIn applies the NotIn predicate on the "format" field.
+func FormatNotIn(vs ...string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNotIn(FieldFormat, vs...))
+}
+
+// FormatGT applies the GT predicate on the "format" field.
+func FormatGT(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGT(FieldFormat, v))
+}
+
+// FormatGTE applies the GTE predicate on the "format" field.
+func FormatGTE(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGTE(FieldFormat, v))
+}
+
+// FormatLT applies the LT predicate on the "format" field.
+func FormatLT(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLT(FieldFormat, v))
+}
+
+// FormatLTE applies the LTE predicate on the "format" field.
+func FormatLTE(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLTE(FieldFormat, v))
+}
+
+// FormatContains applies the Contains predicate on the "format" field.
+func FormatContains(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldContains(FieldFormat, v))
+}
+
+// FormatHasPrefix applies the HasPrefix predicate on the "format" field.
+func FormatHasPrefix(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldHasPrefix(FieldFormat, v))
+}
+
+// FormatHasSuffix applies the HasSuffix predicate on the "format" field.
+func FormatHasSuffix(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldHasSuffix(FieldFormat, v))
+}
+
+// FormatEqualFold applies the EqualFold predicate on the "format" field.
+func FormatEqualFold(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEqualFold(FieldFormat, v))
+}
+
+// FormatContainsFold applies the ContainsFold predicate on the "format" field.
+func FormatContainsFold(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldContainsFold(FieldFormat, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// LeaseTimeEQ applies the EQ predicate on the "leaseTime" field.
+func LeaseTimeEQ(v time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldLeaseTime, v))
+}
+
+// LeaseTimeNEQ applies the NEQ predicate on the "leaseTime" field.
+func LeaseTimeNEQ(v time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNEQ(FieldLeaseTime, v))
+}
+
+// LeaseTimeIn applies the In predicate on the "leaseTime" field.
+func LeaseTimeIn(vs ...time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldIn(FieldLeaseTime, vs...))
+}
+
+// LeaseTimeNotIn applies the NotIn predicate on the "leaseTime" field.
+func LeaseTimeNotIn(vs ...time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNotIn(FieldLeaseTime, vs...))
+}
+
+// LeaseTimeGT applies the GT predicate on the "leaseTime" field.
+func LeaseTimeGT(v time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGT(FieldLeaseTime, v))
+}
+
+// LeaseTimeGTE applies the GTE predicate on the "leaseTime" field.
+func LeaseTimeGTE(v time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGTE(FieldLeaseTime, v))
+}
+
+// LeaseTimeLT applies the LT predicate on the "leaseTime" field.
+func LeaseTimeLT(v time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLT(FieldLeaseTime, v))
+}
+
+// LeaseTimeLTE applies the LTE predicate on the "leaseTime" field.
+func LeaseTimeLTE(v time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLTE(FieldLeaseTime, v))
+}
+
+// LeaseTimeIsNil applies the IsNil predicate on the "leaseTime" field.
+func LeaseTimeIsNil() predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldIsNull(FieldLeaseTime))
+}
+
+// LeaseTimeNotNil applies the NotNil predicate on the "leaseTime" field.
+func LeaseTimeNotNil() predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNotNull(FieldLeaseTime))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLTE(FieldAttempts, v))
+}
+
+// TotalEQ applies the EQ predicate on the "total" field.
+func TotalEQ(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldTotal, v))
+}
+
+// TotalNEQ applies the NEQ predicate on the "total" field.
+func TotalNEQ(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNEQ(FieldTotal, v))
+}
+
+// TotalIn applies the In predicate on the "total" field.
+func TotalIn(vs ...int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldIn(FieldTotal, vs...))
+}
+
+// TotalNotIn applies the NotIn predicate on the "total" field.
+func TotalNotIn(vs ...int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNotIn(FieldTotal, vs...))
+}
+
+// TotalGT applies the GT predicate on the "total" field.
+func TotalGT(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGT(FieldTotal, v))
+}
+
+// TotalGTE applies the GTE predicate on the "total" field.
+func TotalGTE(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGTE(FieldTotal, v))
+}
+
+// TotalLT applies the LT predicate on the "total" field.
+func TotalLT(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLT(FieldTotal, v))
+}
+
+// TotalLTE applies the LTE predicate on the "total" field.
+func TotalLTE(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLTE(FieldTotal, v))
+}
+
+// ProcessedEQ applies the EQ predicate on the "processed" field.
+func ProcessedEQ(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldProcessed, v))
+}
+
+// ProcessedNEQ applies the NEQ predicate on the "processed" field.
+func ProcessedNEQ(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNEQ(FieldProcessed, v))
+}
+
+// ProcessedIn applies the In predicate on the "processed" field.
+func ProcessedIn(vs ...int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldIn(FieldProcessed, vs...))
+}
+
+// ProcessedNotIn applies the NotIn predicate on the "processed" field.
+func ProcessedNotIn(vs ...int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNotIn(FieldProcessed, vs...))
+}
+
+// ProcessedGT applies the GT predicate on the "processed" field.
+func ProcessedGT(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGT(FieldProcessed, v))
+}
+
+// ProcessedGTE applies the GTE predicate on the "processed" field.
+func ProcessedGTE(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGTE(FieldProcessed, v))
+}
+
+// ProcessedLT applies the LT predicate on the "processed" field.
+func ProcessedLT(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLT(FieldProcessed, v))
+}
+
+// ProcessedLTE applies the LTE predicate on the "processed" field.
+func ProcessedLTE(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLTE(FieldProcessed, v))
+}
+
+// ObjectNameEQ applies the EQ predicate on the "objectName" field.
+func ObjectNameEQ(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldObjectName, v))
+}
+
+// ObjectNameNEQ applies the NEQ predicate on the "objectName" field.
+func ObjectNameNEQ(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNEQ(FieldObjectName, v))
+}
+
+// ObjectNameIn applies the In predicate on the "objectName" field.
+func ObjectNameIn(vs ...string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldIn(FieldObjectName, vs...))
+}
+
+// ObjectNameNotIn applies the NotIn predicate on the "objectName" field.
+func ObjectNameNotIn(vs ...string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNotIn(FieldObjectName, vs...))
+}
+
+// ObjectNameGT applies the GT predicate on the "objectName" field.
+func ObjectNameGT(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGT(FieldObjectName, v))
+}
+
+// ObjectNameGTE applies the GTE predicate on the "objectName" field.
+func ObjectNameGTE(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGTE(FieldObjectName, v))
+}
+
+// ObjectNameLT applies the LT predicate on the "objectName" field.
+func ObjectNameLT(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLT(FieldObjectName, v))
+}
+
+// ObjectNameLTE applies the LTE predicate on the "objectName" field.
+func ObjectNameLTE(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLTE(FieldObjectName, v))
+}
+
+// ObjectNameContains applies the Contains predicate on the "objectName" field.
+func ObjectNameContains(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldContains(FieldObjectName, v))
+}
+
+// ObjectNameHasPrefix applies the HasPrefix predicate on the "objectName" field.
+func ObjectNameHasPrefix(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldHasPrefix(FieldObjectName, v))
+}
+
+// ObjectNameHasSuffix applies the HasSuffix predicate on the "objectName" field.
+func ObjectNameHasSuffix(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldHasSuffix(FieldObjectName, v))
+}
+
+// ObjectNameEqualFold applies the EqualFold predicate on the "objectName" field.
+func ObjectNameEqualFold(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEqualFold(FieldObjectName, v))
+}
+
+// ObjectNameContainsFold applies the ContainsFold predicate on the "objectName" field.
+func ObjectNameContainsFold(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldContainsFold(FieldObjectName, v))
+}
+
+// FileSizeEQ applies the EQ predicate on the "fileSize" field.
+func FileSizeEQ(v int64) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldFileSize, v))
+}
+
+// FileSizeNEQ applies the NEQ predicate on the "fileSize" field.
+func FileSizeNEQ(v int64) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNEQ(FieldFileSize, v))
+}
+
+// FileSizeIn applies the In predicate on the "fileSize" field.
+func FileSizeIn(vs ...int64) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldIn(FieldFileSize, vs...))
+}
+
+// FileSizeNotIn applies the NotIn predicate on the "fileSize" field.
+func FileSizeNotIn(vs ...int64) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNotIn(FieldFileSize, vs...))
+}
+
+// FileSizeGT applies the GT predicate on the "fileSize" field.
+func FileSizeGT(v int64) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGT(FieldFileSize, v))
+}
+
+// FileSizeGTE applies the GTE predicate on the "fileSize" field.
+func FileSizeGTE(v int64) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGTE(FieldFileSize, v))
+}
+
+// FileSizeLT applies the LT predicate on the "fileSize" field.
+func FileSizeLT(v int64) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLT(FieldFileSize, v))
+}
+
+// FileSizeLTE applies the LTE predicate on the "fileSize" field.
+func FileSizeLTE(v int64) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLTE(FieldFileSize, v))
+}
+
+// LastErrorEQ applies the EQ predicate on the "lastError" field.
+func LastErrorEQ(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "lastError" field.
+func LastErrorNEQ(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "lastError" field.
+func LastErrorIn(vs ...string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "lastError" field.
+func LastErrorNotIn(vs ...string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "lastError" field.
+func LastErrorGT(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "lastError" field.
+func LastErrorGTE(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "lastError" field.
+func LastErrorLT(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "lastError" field.
+func LastErrorLTE(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "lastError" field.
+func LastErrorContains(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "lastError" field.
+func LastErrorHasPrefix(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "lastError" field.
+func LastErrorHasSuffix(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "lastError" field.
+func LastErrorEqualFold(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "lastError" field.
+func LastErrorContainsFold(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "createTime" field.
+func CreateTimeEQ(v time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "createTime" field.
+func CreateTimeNEQ(v time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "createTime" field.
+func CreateTimeIn(vs ...time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "createTime" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "createTime" field.
+func CreateTimeGT(v time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "createTime" field.
+func CreateTimeGTE(v time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "createTime" field.
+func CreateTimeLT(v time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "createTime" field.
+func CreateTimeLTE(v time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// FinishTimeEQ applies the EQ predicate on the "finishTime" field.
+func FinishTimeEQ(v time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldFinishTime, v))
+}
+
+// FinishTimeNEQ applies the NEQ predicate on the "finishTime" field.
+func FinishTimeNEQ(v time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNEQ(FieldFinishTime, v))
+}
+
+// FinishTimeIn applies the In predicate on the "finishTime" field.
+func FinishTimeIn(vs ...time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldIn(FieldFinishTime, vs...))
+}
+
+// FinishTimeNotIn applies the NotIn predicate on the "finishTime" field.
+func FinishTimeNotIn(vs ...time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNotIn(FieldFinishTime, vs...))
+}
+
+// FinishTimeGT applies the GT predicate on the "finishTime" field.
+func FinishTimeGT(v time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGT(FieldFinishTime, v))
+}
+
+// FinishTimeGTE applies the GTE predicate on the "finishTime" field.
+func FinishTimeGTE(v time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGTE(FieldFinishTime, v))
+}
+
+// FinishTimeLT applies the LT predicate on the "finishTime" field.
+func FinishTimeLT(v time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLT(FieldFinishTime, v))
+}
+
+// FinishTimeLTE applies the LTE predicate on the "finishTime" field.
+func FinishTimeLTE(v time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLTE(FieldFinishTime, v))
+}
+
+// FinishTimeIsNil applies the IsNil predicate on the "finishTime" field.
+func FinishTimeIsNil() predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldIsNull(FieldFinishTime))
+}
+
+// FinishTimeNotNil applies the NotNil predicate on the "finishTime" field.
+func FinishTimeNotNil() predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNotNull(FieldFinishTime))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ExportJob) predicate.ExportJob {
+	return predicate.ExportJob(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ExportJob) predicate.ExportJob {
+	return predicate.ExportJob(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ExportJob) predicate.ExportJob {
+	return predicate.ExportJob(sql.NotPredicates(p))
+}
@@ -0,0 +1,477 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gochat_server/ent/exportjob"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ExportJobCreate is the builder for creating a ExportJob entity.
+type ExportJobCreate struct {
+	config
+	mutation *ExportJobMutation
+	hooks    []Hook
+}
+
+// SetUserId sets the "userId" field.
+func (ejc *ExportJobCreate) SetUserId(i int) *ExportJobCreate {
+	ejc.mutation.SetUserId(i)
+	return ejc
+}
+
+// SetIsGroup sets the "isGroup" field.
+func (ejc *ExportJobCreate) SetIsGroup(b bool) *ExportJobCreate {
+	ejc.mutation.SetIsGroup(b)
+	return ejc
+}
+
+// SetNillableIsGroup sets the "isGroup" field if the given value is not nil.
+func (ejc *ExportJobCreate) SetNillableIsGroup(b *bool) *ExportJobCreate {
+	if b != nil {
+		ejc.SetIsGroup(*b)
+	}
+	return ejc
+}
+
+// SetTargetId sets the "targetId" field.
+func (ejc *ExportJobCreate) SetTargetId(i int) *ExportJobCreate {
+	ejc.mutation.SetTargetId(i)
+	return ejc
+}
+
+// SetFormat sets the "format" field.
+func (ejc *ExportJobCreate) SetFormat(s string) *ExportJobCreate {
+	ejc.mutation.SetFormat(s)
+	return ejc
+}
+
+// SetStatus sets the "status" field.
+func (ejc *ExportJobCreate) SetStatus(s string) *ExportJobCreate {
+	ejc.mutation.SetStatus(s)
+	return ejc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ejc *ExportJobCreate) SetNillableStatus(s *string) *ExportJobCreate {
+	if s != nil {
+		ejc.SetStatus(*s)
+	}
+	return ejc
+}
+
+// SetLeaseTime sets the "leaseTime" field.
+func (ejc *ExportJobCreate) SetLeaseTime(t time.Time) *ExportJobCreate {
+	ejc.mutation.SetLeaseTime(t)
+	return ejc
+}
+
+// SetNillableLeaseTime sets the "leaseTime" field if the given value is not nil.
+func (ejc *ExportJobCreate) SetNillableLeaseTime(t *time.Time) *ExportJobCreate {
+	if t != nil {
+		ejc.SetLeaseTime(*t)
+	}
+	return ejc
+}
+
+// SetAttempts sets the "attempts" field.
+func (ejc *ExportJobCreate) SetAttempts(i int) *ExportJobCreate {
+	ejc.mutation.SetAttempts(i)
+	return ejc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (ejc *ExportJobCreate) SetNillableAttempts(i *int) *ExportJobCreate {
+	if i != nil {
+		ejc.SetAttempts(*i)
+	}
+	return ejc
+}
+
+// SetTotal sets the "total" field.
+func (ejc *ExportJobCreate) SetTotal(i int) *ExportJobCreate {
+	ejc.mutation.SetTotal(i)
+	return ejc
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (ejc *ExportJobCreate) SetNillableTotal(i *int) *ExportJobCreate {
+	if i != nil {
+		ejc.SetTotal(*i)
+	}
+	return ejc
+}
+
+// SetProcessed sets the "processed" field.
+func (ejc *ExportJobCreate) SetProcessed(i int) *ExportJobCreate {
+	ejc.mutation.SetProcessed(i)
+	return ejc
+}
+
+// SetNillableProcessed sets the "processed" field if the given value is not nil.
+func (ejc *ExportJobCreate) SetNillableProcessed(i *int) *ExportJobCreate {
+	if i != nil {
+		ejc.SetProcessed(*i)
+	}
+	return ejc
+}
+
+// SetObjectName sets the "objectName" field.
+func (ejc *ExportJobCreate) SetObjectName(s string) *ExportJobCreate {
+	ejc.mutation.SetObjectName(s)
+	return ejc
+}
+
+// SetNillableObjectName sets the "objectName" field if the given value is not nil.
+func (ejc *ExportJobCreate) SetNillableObjectName(s *string) *ExportJobCreate {
+	if s != nil {
+		ejc.SetObjectName(*s)
+	}
+	return ejc
+}
+
+// SetFileSize sets the "fileSize" field.
+func (ejc *ExportJobCreate) SetFileSize(i int64) *ExportJobCreate {
+	ejc.mutation.SetFileSize(i)
+	return ejc
+}
+
+// SetNillableFileSize sets the "fileSize" field if the given value is not nil.
+func (ejc *ExportJobCreate) SetNillableFileSize(i *int64) *ExportJobCreate {
+	if i != nil {
+		ejc.SetFileSize(*i)
+	}
+	return ejc
+}
+
+// SetLastError sets the "lastError" field.
+func (ejc *ExportJobCreate) SetLastError(s string) *ExportJobCreate {
+	ejc.mutation.SetLastError(s)
+	return ejc
+}
+
+// SetNillableLastError sets the "lastError" field if the given value is not nil.
+func (ejc *ExportJobCreate) SetNillableLastError(s *string) *ExportJobCreate {
+	if s != nil {
+		ejc.SetLastError(*s)
+	}
+	return ejc
+}
+
+// SetCreateTime sets the "createTime" field.
+func (ejc *ExportJobCreate) SetCreateTime(t time.Time) *ExportJobCreate {
+	ejc.mutation.SetCreateTime(t)
+	return ejc
+}
+
+// SetNillableCreateTime sets the "createTime" field if the given value is not nil.
+func (ejc *ExportJobCreate) SetNillableCreateTime(t *time.Time) *ExportJobCreate {
+	if t != nil {
+		ejc.SetCreateTime(*t)
+	}
+	return ejc
+}
+
+// SetFinishTime sets the "finishTime" field.
+func (ejc *ExportJobCreate) SetFinishTime(t time.Time) *ExportJobCreate {
+	ejc.mutation.SetFinishTime(t)
+	return ejc
+}
+
+// SetNillableFinishTime sets the "finishTime" field if the given value is not nil.
+func (ejc *ExportJobCreate) SetNillableFinishTime(t *time.Time) *ExportJobCreate {
+	if t != nil {
+		ejc.SetFinishTime(*t)
+	}
+	return ejc
+}
+
+// Mutation returns the ExportJobMutation object of the builder.
+func (ejc *ExportJobCreate) Mutation() *ExportJobMutation {
+	return ejc.mutation
+}
+
+// Save creates the ExportJob in the database.
+func (ejc *ExportJobCreate) Save(ctx context.Context) (*ExportJob, error) {
+	ejc.defaults()
+	return withHooks(ctx, ejc.sqlSave, ejc.mutation, ejc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ejc *ExportJobCreate) SaveX(ctx context.Context) *ExportJob {
+	v, err := ejc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ejc *ExportJobCreate) Exec(ctx context.Context) error {
+	_, err := ejc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ejc *ExportJobCreate) ExecX(ctx context.Context) {
+	if err := ejc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ejc *ExportJobCreate) defaults() {
+	if _, ok := ejc.mutation.IsGroup(); !ok {
+		v := exportjob.DefaultIsGroup
+		ejc.mutation.SetIsGroup(v)
+	}
+	if _, ok := ejc.mutation.Status(); !ok {
+		v := exportjob.DefaultStatus
+		ejc.mutation.SetStatus(v)
+	}
+	if _, ok := ejc.mutation.Attempts(); !ok {
+		v := exportjob.DefaultAttempts
+		ejc.mutation.SetAttempts(v)
+	}
+	if _, ok := ejc.mutation.Total(); !ok {
+		v := exportjob.DefaultTotal
+		ejc.mutation.SetTotal(v)
+	}
+	if _, ok := ejc.mutation.Processed(); !ok {
+		v := exportjob.DefaultProcessed
+		ejc.mutation.SetProcessed(v)
+	}
+	if _, ok := ejc.mutation.ObjectName(); !ok {
+		v := exportjob.DefaultObjectName
+		ejc.mutation.SetObjectName(v)
+	}
+	if _, ok := ejc.mutation.FileSize(); !ok {
+		v := exportjob.DefaultFileSize
+		ejc.mutation.SetFileSize(v)
+	}
+	if _, ok := ejc.mutation.LastError(); !ok {
+		v := exportjob.DefaultLastError
+		ejc.mutation.SetLastError(v)
+	}
+	if _, ok := ejc.mutation.CreateTime(); !ok {
+		v := exportjob.DefaultCreateTime()
+		ejc.mutation.SetCreateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ejc *ExportJobCreate) check() error {
+	if _, ok := ejc.mutation.UserId(); !ok {
+		return &ValidationError{Name: "userId", err: errors.New(`ent: missing required field "ExportJob.userId"`)}
+	}
+	if _, ok := ejc.mutation.IsGroup(); !ok {
+		return &ValidationError{Name: "isGroup", err: errors.New(`ent: missing required field "ExportJob.isGroup"`)}
+	}
+	if _, ok := ejc.mutation.TargetId(); !ok {
+		return &ValidationError{Name: "targetId", err: errors.New(`ent: missing required field "ExportJob.targetId"`)}
+	}
+	if _, ok := ejc.mutation.Format(); !ok {
+		return &ValidationError{Name: "format", err: errors.New(`ent: missing required field "ExportJob.format"`)}
+	}
+	if _, ok := ejc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ExportJob.status"`)}
+	}
+	if _, ok := ejc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "ExportJob.attempts"`)}
+	}
+	if _, ok := ejc.mutation.Total(); !ok {
+		return &ValidationError{Name: "total", err: errors.New(`ent: missing required field "ExportJob.total"`)}
+	}
+	if _, ok := ejc.mutation.Processed(); !ok {
+		return &ValidationError{Name: "processed", err: errors.New(`ent: missing required field "ExportJob.processed"`)}
+	}
+	if _, ok := ejc.mutation.ObjectName(); !ok {
+		return &ValidationError{Name: "objectName", err: errors.New(`ent: missing required field "ExportJob.objectName"`)}
+	}
+	if _, ok := ejc.mutation.FileSize(); !ok {
+		return &ValidationError{Name: "fileSize", err: errors.New(`ent: missing required field "ExportJob.fileSize"`)}
+	}
+	if _, ok := ejc.mutation.LastError(); !ok {
+		return &ValidationError{Name: "lastError", err: errors.New(`ent: missing required field "ExportJob.lastError"`)}
+	}
+	if _, ok := ejc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "createTime", err: errors.New(`ent: missing required field "ExportJob.createTime"`)}
+	}
+	return nil
+}
+
+func (ejc *ExportJobCreate) sqlSave(ctx context.Context) (*ExportJob, error) {
+	if err := ejc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ejc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ejc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ejc.mutation.id = &_node.ID
+	ejc.mutation.done = true
+	return _node, nil
+}
+
+func (ejc *ExportJobCreate) createSpec() (*ExportJob, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ExportJob{config: ejc.config}
+		_spec = sqlgraph.NewCreateSpec(exportjob.Table, sqlgraph.NewFieldSpec(exportjob.FieldID, field.TypeInt))
+	)
+	if value, ok := ejc.mutation.UserId(); ok {
+		_spec.SetField(exportjob.FieldUserId, field.TypeInt, value)
+		_node.UserId = value
+	}
+	if value, ok := ejc.mutation.IsGroup(); ok {
+		_spec.SetField(exportjob.FieldIsGroup, field.TypeBool, value)
+		_node.IsGroup = value
+	}
+	if value, ok := ejc.mutation.TargetId(); ok {
+		_spec.SetField(exportjob.FieldTargetId, field.TypeInt, value)
+		_node.TargetId = value
+	}
+	if value, ok := ejc.mutation.Format(); ok {
+		_spec.SetField(exportjob.FieldFormat, field.TypeString, value)
+		_node.Format = value
+	}
+	if value, ok := ejc.mutation.Status(); ok {
+		_spec.SetField(exportjob.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := ejc.mutation.LeaseTime(); ok {
+		_spec.SetField(exportjob.FieldLeaseTime, field.TypeTime, value)
+		_node.LeaseTime = &value
+	}
+	if value, ok := ejc.mutation.Attempts(); ok {
+		_spec.SetField(exportjob.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := ejc.mutation.Total(); ok {
+		_spec.SetField(exportjob.FieldTotal, field.TypeInt, value)
+		_node.Total = value
+	}
+	if value, ok := ejc.mutation.Processed(); ok {
+		_spec.SetField(exportjob.FieldProcessed, field.TypeInt, value)
+		_node.Processed = value
+	}
+	if value, ok := ejc.mutation.ObjectName(); ok {
+		_spec.SetField(exportjob.FieldObjectName, field.TypeString, value)
+		_node.ObjectName = value
+	}
+	if value, ok := ejc.mutation.FileSize(); ok {
+		_spec.SetField(exportjob.FieldFileSize, field.TypeInt64, value)
+		_node.FileSize = value
+	}
+	if value, ok := ejc.mutation.LastError(); ok {
+		_spec.SetField(exportjob.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := ejc.mutation.CreateTime(); ok {
+		_spec.SetField(exportjob.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := ejc.mutation.FinishTime(); ok {
+		_spec.SetField(exportjob.FieldFinishTime, field.TypeTime, value)
+		_node.FinishTime = &value
+	}
+	return _node, _spec
+}
+
+// ExportJobCreateBulk is the builder for creating many ExportJob entities in bulk.
+type ExportJobCreateBulk struct {
+	config
+	err      error
+	builders []*ExportJobCreate
+}
+
+// Save creates the ExportJob entities in the database.
+func (ejcb *ExportJobCreateBulk) Save(ctx context.Context) ([]*ExportJob, error) {
+	if ejcb.err != nil {
+		return nil, ejcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ejcb.builders))
+	nodes := make([]*ExportJob, len(ejcb.builders))
+	mutators := make([]Mutator, len(ejcb.builders))
+	for i := range ejcb.builders {
+		func(i int, root context.Context) {
+			builder := ejcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ExportJobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ejcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ejcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ejcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ejcb *ExportJobCreateBulk) SaveX(ctx context.Context) []*ExportJob {
+	v, err := ejcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ejcb *ExportJobCreateBulk) Exec(ctx context.Context) error {
+	_, err := ejcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ejcb *ExportJobCreateBulk) ExecX(ctx context.Context) {
+	if err := ejcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
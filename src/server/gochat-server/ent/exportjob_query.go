@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"gochat_server/ent/exportjob"
+	"gochat_server/ent/predicate"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ExportJobQuery is the builder for querying ExportJob entities.
+type ExportJobQuery struct {
+	config
+	ctx        *QueryContext
+	order      []exportjob.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ExportJob
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ExportJobQuery builder.
+func (ejq *ExportJobQuery) Where(ps ...predicate.ExportJob) *ExportJobQuery {
+	ejq.predicates = append(ejq.predicates, ps...)
+	return ejq
+}
+
+// Limit the number of records to be returned by this query.
+func (ejq *ExportJobQuery) Limit(limit int) *ExportJobQuery {
+	ejq.ctx.Limit = &limit
+	return ejq
+}
+
+// Offset to start from.
+func (ejq *ExportJobQuery) Offset(offset int) *ExportJobQuery {
+	ejq.ctx.Offset = &offset
+	return ejq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ejq *ExportJobQuery) Unique(unique bool) *ExportJobQuery {
+	ejq.ctx.Unique = &unique
+	return ejq
+}
+
+// Order specifies how the records should be ordered.
+func (ejq *ExportJobQuery) Order(o ...exportjob.OrderOption) *ExportJobQuery {
+	ejq.order = append(ejq.order, o...)
+	return ejq
+}
+
+// First returns the first ExportJob entity from the query.
+// Returns a *NotFoundError when no ExportJob was found.
+func (ejq *ExportJobQuery) First(ctx context.Context) (*ExportJob, error) {
+	nodes, err := ejq.Limit(1).All(setContextOp(ctx, ejq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{exportjob.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ejq *ExportJobQuery) FirstX(ctx context.Context) *ExportJob {
+	node, err := ejq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ExportJob ID from the query.
+// Returns a *NotFoundError when no ExportJob ID was found.
+func (ejq *ExportJobQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ejq.Limit(1).IDs(setContextOp(ctx, ejq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{exportjob.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ejq *ExportJobQuery) FirstIDX(ctx context.Context) int {
+	id, err := ejq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ExportJob entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ExportJob entity is found.
+// Returns a *NotFoundError when no ExportJob entities are found.
+func (ejq *ExportJobQuery) Only(ctx context.Context) (*ExportJob, error) {
+	nodes, err := ejq.Limit(2).All(setContextOp(ctx, ejq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{exportjob.Label}
+	default:
+		return nil, &NotSingularError{exportjob.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ejq *ExportJobQuery) OnlyX(ctx context.Context) *ExportJob {
+	node, err := ejq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ExportJob ID in the query.
+// Returns a *NotSingularError when more than one ExportJob ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ejq *ExportJobQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ejq.Limit(2).IDs(setContextOp(ctx, ejq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{exportjob.Label}
+	default:
+		err = &NotSingularError{exportjob.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ejq *ExportJobQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ejq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ExportJobs.
+func (ejq *ExportJobQuery) All(ctx context.Context) ([]*ExportJob, error) {
+	ctx = setContextOp(ctx, ejq.ctx, ent.OpQueryAll)
+	if err := ejq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ExportJob, *ExportJobQuery]()
+	return withInterceptors[[]*ExportJob](ctx, ejq, qr, ejq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ejq *ExportJobQuery) AllX(ctx context.Context) []*ExportJob {
+	nodes, err := ejq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ExportJob IDs.
+func (ejq *ExportJobQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if ejq.ctx.Unique == nil && ejq.path != nil {
+		ejq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ejq.ctx, ent.OpQueryIDs)
+	if err = ejq.Select(exportjob.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ejq *ExportJobQuery) IDsX(ctx context.Context) []int {
+	ids, err := ejq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ejq *ExportJobQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ejq.ctx, ent.OpQueryCount)
+	if err := ejq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ejq, querierCount[*ExportJobQuery](), ejq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ejq *ExportJobQuery) CountX(ctx context.Context) int {
+	count, err := ejq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ejq *ExportJobQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ejq.ctx, ent.OpQueryExist)
+	switch _, err := ejq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ejq *ExportJobQuery) ExistX(ctx context.Context) bool {
+	exist, err := ejq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ExportJobQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ejq *ExportJobQuery) Clone() *ExportJobQuery {
+	if ejq == nil {
+		return nil
+	}
+	return &ExportJobQuery{
+		config:     ejq.config,
+		ctx:        ejq.ctx.Clone(),
+		order:      append([]exportjob.OrderOption{}, ejq.order...),
+		inters:     append([]Interceptor{}, ejq.inters...),
+		predicates: append([]predicate.ExportJob{}, ejq.predicates...),
+		// clone intermediate query.
+		sql:  ejq.sql.Clone(),
+		path: ejq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserId int `json:"userId,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ExportJob.Query().
+//		GroupBy(exportjob.FieldUserId).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ejq *ExportJobQuery) GroupBy(field string, fields ...string) *ExportJobGroupBy {
+	ejq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ExportJobGroupBy{build: ejq}
+	grbuild.flds = &ejq.ctx.Fields
+	grbuild.label = exportjob.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserId int `json:"userId,omitempty"`
+//	}
+//
+//	client.ExportJob.Query().
+//		Select(exportjob.FieldUserId).
+//		Scan(ctx, &v)
+func (ejq *ExportJobQuery) Select(fields ...string) *ExportJobSelect {
+	ejq.ctx.Fields = append(ejq.ctx.Fields, fields...)
+	sbuild := &ExportJobSelect{ExportJobQuery: ejq}
+	sbuild.label = exportjob.Label
+	sbuild.flds, sbuild.scan = &ejq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ExportJobSelect configured with the given aggregations.
+func (ejq *ExportJobQuery) Aggregate(fns ...AggregateFunc) *ExportJobSelect {
+	return ejq.Select().Aggregate(fns...)
+}
+
+func (ejq *ExportJobQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ejq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ejq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ejq.ctx.Fields {
+		if !exportjob.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ejq.path != nil {
+		prev, err := ejq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ejq.sql = prev
+	}
+	return nil
+}
+
+func (ejq *ExportJobQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ExportJob, error) {
+	var (
+		nodes = []*ExportJob{}
+		_spec = ejq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ExportJob).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ExportJob{config: ejq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ejq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ejq *ExportJobQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ejq.querySpec()
+	_spec.Node.Columns = ejq.ctx.Fields
+	if len(ejq.ctx.Fields) > 0 {
+		_spec.Unique = ejq.ctx.Unique != nil && *ejq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ejq.driver, _spec)
+}
+
+func (ejq *ExportJobQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(exportjob.Table, exportjob.Columns, sqlgraph.NewFieldSpec(exportjob.FieldID, field.TypeInt))
+	_spec.From = ejq.sql
+	if unique := ejq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ejq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ejq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, exportjob.FieldID)
+		for i := range fields {
+			if fields[i] != exportjob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ejq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ejq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ejq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ejq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ejq *ExportJobQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ejq.driver.Dialect())
+	t1 := builder.Table(exportjob.Table)
+	columns := ejq.ctx.Fields
+	if len(columns) == 0 {
+		columns = exportjob.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ejq.sql != nil {
+		selector = ejq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ejq.ctx.Unique != nil && *ejq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ejq.predicates {
+		p(selector)
+	}
+	for _, p := range ejq.order {
+		p(selector)
+	}
+	if offset := ejq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ejq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ExportJobGroupBy is the group-by builder for ExportJob entities.
+type ExportJobGroupBy struct {
+	selector
+	build *ExportJobQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ejgb *ExportJobGroupBy) Aggregate(fns ...AggregateFunc) *ExportJobGroupBy {
+	ejgb.fns = append(ejgb.fns, fns...)
+	return ejgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ejgb *ExportJobGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ejgb.build.ctx, ent.OpQueryGroupBy)
+	if err := ejgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ExportJobQuery, *ExportJobGroupBy](ctx, ejgb.build, ejgb, ejgb.build.inters, v)
+}
+
+func (ejgb *ExportJobGroupBy) sqlScan(ctx context.Context, root *ExportJobQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ejgb.fns))
+	for _, fn := range ejgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ejgb.flds)+len(ejgb.fns))
+		for _, f := range *ejgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ejgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ejgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ExportJobSelect is the builder for selecting fields of ExportJob entities.
+type ExportJobSelect struct {
+	*ExportJobQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ejs *ExportJobSelect) Aggregate(fns ...AggregateFunc) *ExportJobSelect {
+	ejs.fns = append(ejs.fns, fns...)
+	return ejs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ejs *ExportJobSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ejs.ctx, ent.OpQuerySelect)
+	if err := ejs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ExportJobQuery, *ExportJobSelect](ctx, ejs.ExportJobQuery, ejs, ejs.inters, v)
+}
+
+func (ejs *ExportJobSelect) sqlScan(ctx context.Context, root *ExportJobQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ejs.fns))
+	for _, fn := range ejs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ejs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ejs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,808 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gochat_server/ent/exportjob"
+	"gochat_server/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ExportJobUpdate is the builder for updating ExportJob entities.
+type ExportJobUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ExportJobMutation
+}
+
+// Where appends a list predicates to the ExportJobUpdate builder.
+func (eju *ExportJobUpdate) Where(ps ...predicate.ExportJob) *ExportJobUpdate {
+	eju.mutation.Where(ps...)
+	return eju
+}
+
+// SetUserId sets the "userId" field.
+func (eju *ExportJobUpdate) SetUserId(i int) *ExportJobUpdate {
+	eju.mutation.ResetUserId()
+	eju.mutation.SetUserId(i)
+	return eju
+}
+
+// SetNillableUserId sets the "userId" field if the given value is not nil.
+func (eju *ExportJobUpdate) SetNillableUserId(i *int) *ExportJobUpdate {
+	if i != nil {
+		eju.SetUserId(*i)
+	}
+	return eju
+}
+
+// AddUserId adds i to the "userId" field.
+func (eju *ExportJobUpdate) AddUserId(i int) *ExportJobUpdate {
+	eju.mutation.AddUserId(i)
+	return eju
+}
+
+// SetIsGroup sets the "isGroup" field.
+func (eju *ExportJobUpdate) SetIsGroup(b bool) *ExportJobUpdate {
+	eju.mutation.SetIsGroup(b)
+	return eju
+}
+
+// SetNillableIsGroup sets the "isGroup" field if the given value is not nil.
+func (eju *ExportJobUpdate) SetNillableIsGroup(b *bool) *ExportJobUpdate {
+	if b != nil {
+		eju.SetIsGroup(*b)
+	}
+	return eju
+}
+
+// SetTargetId sets the "targetId" field.
+func (eju *ExportJobUpdate) SetTargetId(i int) *ExportJobUpdate {
+	eju.mutation.ResetTargetId()
+	eju.mutation.SetTargetId(i)
+	return eju
+}
+
+// SetNillableTargetId sets the "targetId" field if the given value is not nil.
+func (eju *ExportJobUpdate) SetNillableTargetId(i *int) *ExportJobUpdate {
+	if i != nil {
+		eju.SetTargetId(*i)
+	}
+	return eju
+}
+
+// AddTargetId adds i to the "targetId" field.
+func (eju *ExportJobUpdate) AddTargetId(i int) *ExportJobUpdate {
+	eju.mutation.AddTargetId(i)
+	return eju
+}
+
+// SetFormat sets the "format" field.
+func (eju *ExportJobUpdate) SetFormat(s string) *ExportJobUpdate {
+	eju.mutation.SetFormat(s)
+	return eju
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (eju *ExportJobUpdate) SetNillableFormat(s *string) *ExportJobUpdate {
+	if s != nil {
+		eju.SetFormat(*s)
+	}
+	return eju
+}
+
+// SetStatus sets the "status" field.
+func (eju *ExportJobUpdate) SetStatus(s string) *ExportJobUpdate {
+	eju.mutation.SetStatus(s)
+	return eju
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (eju *ExportJobUpdate) SetNillableStatus(s *string) *ExportJobUpdate {
+	if s != nil {
+		eju.SetStatus(*s)
+	}
+	return eju
+}
+
+// SetLeaseTime sets the "leaseTime" field.
+func (eju *ExportJobUpdate) SetLeaseTime(t time.Time) *ExportJobUpdate {
+	eju.mutation.SetLeaseTime(t)
+	return eju
+}
+
+// SetNillableLeaseTime sets the "leaseTime" field if the given value is not nil.
+func (eju *ExportJobUpdate) SetNillableLeaseTime(t *time.Time) *ExportJobUpdate {
+	if t != nil {
+		eju.SetLeaseTime(*t)
+	}
+	return eju
+}
+
+// ClearLeaseTime clears the value of the "leaseTime" field.
+func (eju *ExportJobUpdate) ClearLeaseTime() *ExportJobUpdate {
+	eju.mutation.ClearLeaseTime()
+	return eju
+}
+
+// SetAttempts sets the "attempts" field.
+func (eju *ExportJobUpdate) SetAttempts(i int) *ExportJobUpdate {
+	eju.mutation.ResetAttempts()
+	eju.mutation.SetAttempts(i)
+	return eju
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (eju *ExportJobUpdate) SetNillableAttempts(i *int) *ExportJobUpdate {
+	if i != nil {
+		eju.SetAttempts(*i)
+	}
+	return eju
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (eju *ExportJobUpdate) AddAttempts(i int) *ExportJobUpdate {
+	eju.mutation.AddAttempts(i)
+	return eju
+}
+
+// SetTotal sets the "total" field.
+func (eju *ExportJobUpdate) SetTotal(i int) *ExportJobUpdate {
+	eju.mutation.ResetTotal()
+	eju.mutation.SetTotal(i)
+	return eju
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (eju *ExportJobUpdate) SetNillableTotal(i *int) *ExportJobUpdate {
+	if i != nil {
+		eju.SetTotal(*i)
+	}
+	return eju
+}
+
+// AddTotal adds i to the "total" field.
+func (eju *ExportJobUpdate) AddTotal(i int) *ExportJobUpdate {
+	eju.mutation.AddTotal(i)
+	return eju
+}
+
+// SetProcessed sets the "processed" field.
+func (eju *ExportJobUpdate) SetProcessed(i int) *ExportJobUpdate {
+	eju.mutation.ResetProcessed()
+	eju.mutation.SetProcessed(i)
+	return eju
+}
+
+// SetNillableProcessed sets the "processed" field if the given value is not nil.
+func (eju *ExportJobUpdate) SetNillableProcessed(i *int) *ExportJobUpdate {
+	if i != nil {
+		eju.SetProcessed(*i)
+	}
+	return eju
+}
+
+// AddProcessed adds i to the "processed" field.
+func (eju *ExportJobUpdate) AddProcessed(i int) *ExportJobUpdate {
+	eju.mutation.AddProcessed(i)
+	return eju
+}
+
+// SetObjectName sets the "objectName" field.
+func (eju *ExportJobUpdate) SetObjectName(s string) *ExportJobUpdate {
+	eju.mutation.SetObjectName(s)
+	return eju
+}
+
+// SetNillableObjectName sets the "objectName" field if the given value is not nil.
+func (eju *ExportJobUpdate) SetNillableObjectName(s *string) *ExportJobUpdate {
+	if s != nil {
+		eju.SetObjectName(*s)
+	}
+	return eju
+}
+
+// SetFileSize sets the "fileSize" field.
+func (eju *ExportJobUpdate) SetFileSize(i int64) *ExportJobUpdate {
+	eju.mutation.ResetFileSize()
+	eju.mutation.SetFileSize(i)
+	return eju
+}
+
+// SetNillableFileSize sets the "fileSize" field if the given value is not nil.
+func (eju *ExportJobUpdate) SetNillableFileSize(i *int64) *ExportJobUpdate {
+	if i != nil {
+		eju.SetFileSize(*i)
+	}
+	return eju
+}
+
+// AddFileSize adds i to the "fileSize" field.
+func (eju *ExportJobUpdate) AddFileSize(i int64) *ExportJobUpdate {
+	eju.mutation.AddFileSize(i)
+	return eju
+}
+
+// SetLastError sets the "lastError" field.
+func (eju *ExportJobUpdate) SetLastError(s string) *ExportJobUpdate {
+	eju.mutation.SetLastError(s)
+	return eju
+}
+
+// SetNillableLastError sets the "lastError" field if the given value is not nil.
+func (eju *ExportJobUpdate) SetNillableLastError(s *string) *ExportJobUpdate {
+	if s != nil {
+		eju.SetLastError(*s)
+	}
+	return eju
+}
+
+// SetCreateTime sets the "createTime" field.
+func (eju *ExportJobUpdate) SetCreateTime(t time.Time) *ExportJobUpdate {
+	eju.mutation.SetCreateTime(t)
+	return eju
+}
+
+// SetNillableCreateTime sets the "createTime" field if the given value is not nil.
+func (eju *ExportJobUpdate) SetNillableCreateTime(t *time.Time) *ExportJobUpdate {
+	if t != nil {
+		eju.SetCreateTime(*t)
+	}
+	return eju
+}
+
+// SetFinishTime sets the "finishTime" field.
+func (eju *ExportJobUpdate) SetFinishTime(t time.Time) *ExportJobUpdate {
+	eju.mutation.SetFinishTime(t)
+	return eju
+}
+
+// SetNillableFinishTime sets the "finishTime" field if the given value is not nil.
+func (eju *ExportJobUpdate) SetNillableFinishTime(t *time.Time) *ExportJobUpdate {
+	if t != nil {
+		eju.SetFinishTime(*t)
+	}
+	return eju
+}
+
+// ClearFinishTime clears the value of the "finishTime" field.
+func (eju *ExportJobUpdate) ClearFinishTime() *ExportJobUpdate {
+	eju.mutation.ClearFinishTime()
+	return eju
+}
+
+// Mutation returns the ExportJobMutation object of the builder.
+func (eju *ExportJobUpdate) Mutation() *ExportJobMutation {
+	return eju.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (eju *ExportJobUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, eju.sqlSave, eju.mutation, eju.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eju *ExportJobUpdate) SaveX(ctx context.Context) int {
+	affected, err := eju.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (eju *ExportJobUpdate) Exec(ctx context.Context) error {
+	_, err := eju.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eju *ExportJobUpdate) ExecX(ctx context.Context) {
+	if err := eju.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (eju *ExportJobUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(exportjob.Table, exportjob.Columns, sqlgraph.NewFieldSpec(exportjob.FieldID, field.TypeInt))
+	if ps := eju.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eju.mutation.UserId(); ok {
+		_spec.SetField(exportjob.FieldUserId, field.TypeInt, value)
+	}
+	if value, ok := eju.mutation.AddedUserId(); ok {
+		_spec.AddField(exportjob.FieldUserId, field.TypeInt, value)
+	}
+	if value, ok := eju.mutation.IsGroup(); ok {
+		_spec.SetField(exportjob.FieldIsGroup, field.TypeBool, value)
+	}
+	if value, ok := eju.mutation.TargetId(); ok {
+		_spec.SetField(exportjob.FieldTargetId, field.TypeInt, value)
+	}
+	if value, ok := eju.mutation.AddedTargetId(); ok {
+		_spec.AddField(exportjob.FieldTargetId, field.TypeInt, value)
+	}
+	if value, ok := eju.mutation.Format(); ok {
+		_spec.SetField(exportjob.FieldFormat, field.TypeString, value)
+	}
+	if value, ok := eju.mutation.Status(); ok {
+		_spec.SetField(exportjob.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := eju.mutation.LeaseTime(); ok {
+		_spec.SetField(exportjob.FieldLeaseTime, field.TypeTime, value)
+	}
+	if eju.mutation.LeaseTimeCleared() {
+		_spec.ClearField(exportjob.FieldLeaseTime, field.TypeTime)
+	}
+	if value, ok := eju.mutation.Attempts(); ok {
+		_spec.SetField(exportjob.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := eju.mutation.AddedAttempts(); ok {
+		_spec.AddField(exportjob.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := eju.mutation.Total(); ok {
+		_spec.SetField(exportjob.FieldTotal, field.TypeInt, value)
+	}
+	if value, ok := eju.mutation.AddedTotal(); ok {
+		_spec.AddField(exportjob.FieldTotal, field.TypeInt, value)
+	}
+	if value, ok := eju.mutation.Processed(); ok {
+		_spec.SetField(exportjob.FieldProcessed, field.TypeInt, value)
+	}
+	if value, ok := eju.mutation.AddedProcessed(); ok {
+		_spec.AddField(exportjob.FieldProcessed, field.TypeInt, value)
+	}
+	if value, ok := eju.mutation.ObjectName(); ok {
+		_spec.SetField(exportjob.FieldObjectName, field.TypeString, value)
+	}
+	if value, ok := eju.mutation.FileSize(); ok {
+		_spec.SetField(exportjob.FieldFileSize, field.TypeInt64, value)
+	}
+	if value, ok := eju.mutation.AddedFileSize(); ok {
+		_spec.AddField(exportjob.FieldFileSize, field.TypeInt64, value)
+	}
+	if value, ok := eju.mutation.LastError(); ok {
+		_spec.SetField(exportjob.FieldLastError, field.TypeString, value)
+	}
+	if value, ok := eju.mutation.CreateTime(); ok {
+		_spec.SetField(exportjob.FieldCreateTime, field.TypeTime, value)
+	}
+	if value, ok := eju.mutation.FinishTime(); ok {
+		_spec.SetField(exportjob.FieldFinishTime, field.TypeTime, value)
+	}
+	if eju.mutation.FinishTimeCleared() {
+		_spec.ClearField(exportjob.FieldFinishTime, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, eju.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{exportjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	eju.mutation.done = true
+	return n, nil
+}
+
+// ExportJobUpdateOne is the builder for updating a single ExportJob entity.
+type ExportJobUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ExportJobMutation
+}
+
+// SetUserId sets the "userId" field.
+func (ejuo *ExportJobUpdateOne) SetUserId(i int) *ExportJobUpdateOne {
+	ejuo.mutation.ResetUserId()
+	ejuo.mutation.SetUserId(i)
+	return ejuo
+}
+
+// SetNillableUserId sets the "userId" field if the given value is not nil.
+func (ejuo *ExportJobUpdateOne) SetNillableUserId(i *int) *ExportJobUpdateOne {
+	if i != nil {
+		ejuo.SetUserId(*i)
+	}
+	return ejuo
+}
+
+// AddUserId adds i to the "userId" field.
+func (ejuo *ExportJobUpdateOne) AddUserId(i int) *ExportJobUpdateOne {
+	ejuo.mutation.AddUserId(i)
+	return ejuo
+}
+
+// SetIsGroup sets the "isGroup" field.
+func (ejuo *ExportJobUpdateOne) SetIsGroup(b bool) *ExportJobUpdateOne {
+	ejuo.mutation.SetIsGroup(b)
+	return ejuo
+}
+
+// SetNillableIsGroup sets the "isGroup" field if the given value is not nil.
+func (ejuo *ExportJobUpdateOne) SetNillableIsGroup(b *bool) *ExportJobUpdateOne {
+	if b != nil {
+		ejuo.SetIsGroup(*b)
+	}
+	return ejuo
+}
+
+// SetTargetId sets the "targetId" field.
+func (ejuo *ExportJobUpdateOne) SetTargetId(i int) *ExportJobUpdateOne {
+	ejuo.mutation.ResetTargetId()
+	ejuo.mutation.SetTargetId(i)
+	return ejuo
+}
+
+// SetNillableTargetId sets the "targetId" field if the given value is not nil.
+func (ejuo *ExportJobUpdateOne) SetNillableTargetId(i *int) *ExportJobUpdateOne {
+	if i != nil {
+		ejuo.SetTargetId(*i)
+	}
+	return ejuo
+}
+
+// AddTargetId adds i to the "targetId" field.
+func (ejuo *ExportJobUpdateOne) AddTargetId(i int) *ExportJobUpdateOne {
+	ejuo.mutation.AddTargetId(i)
+	return ejuo
+}
+
+// SetFormat sets the "format" field.
+func (ejuo *ExportJobUpdateOne) SetFormat(s string) *ExportJobUpdateOne {
+	ejuo.mutation.SetFormat(s)
+	return ejuo
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (ejuo *ExportJobUpdateOne) SetNillableFormat(s *string) *ExportJobUpdateOne {
+	if s != nil {
+		ejuo.SetFormat(*s)
+	}
+	return ejuo
+}
+
+// SetStatus sets the "status" field.
+func (ejuo *ExportJobUpdateOne) SetStatus(s string) *ExportJobUpdateOne {
+	ejuo.mutation.SetStatus(s)
+	return ejuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ejuo *ExportJobUpdateOne) SetNillableStatus(s *string) *ExportJobUpdateOne {
+	if s != nil {
+		ejuo.SetStatus(*s)
+	}
+	return ejuo
+}
+
+// SetLeaseTime sets the "leaseTime" field.
+func (ejuo *ExportJobUpdateOne) SetLeaseTime(t time.Time) *ExportJobUpdateOne {
+	ejuo.mutation.SetLeaseTime(t)
+	return ejuo
+}
+
+// SetNillableLeaseTime sets the "leaseTime" field if the given value is not nil.
+func (ejuo *ExportJobUpdateOne) SetNillableLeaseTime(t *time.Time) *ExportJobUpdateOne {
+	if t != nil {
+		ejuo.SetLeaseTime(*t)
+	}
+	return ejuo
+}
+
+// ClearLeaseTime clears the value of the "leaseTime" field.
+func (ejuo *ExportJobUpdateOne) ClearLeaseTime() *ExportJobUpdateOne {
+	ejuo.mutation.ClearLeaseTime()
+	return ejuo
+}
+
+// SetAttempts sets the "attempts" field.
+func (ejuo *ExportJobUpdateOne) SetAttempts(i int) *ExportJobUpdateOne {
+	ejuo.mutation.ResetAttempts()
+	ejuo.mutation.SetAttempts(i)
+	return ejuo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (ejuo *ExportJobUpdateOne) SetNillableAttempts(i *int) *ExportJobUpdateOne {
+	if i != nil {
+		ejuo.SetAttempts(*i)
+	}
+	return ejuo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (ejuo *ExportJobUpdateOne) AddAttempts(i int) *ExportJobUpdateOne {
+	ejuo.mutation.AddAttempts(i)
+	return ejuo
+}
+
+// SetTotal sets the "total" field.
+func (ejuo *ExportJobUpdateOne) SetTotal(i int) *ExportJobUpdateOne {
+	ejuo.mutation.ResetTotal()
+	ejuo.mutation.SetTotal(i)
+	return ejuo
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (ejuo *ExportJobUpdateOne) SetNillableTotal(i *int) *ExportJobUpdateOne {
+	if i != nil {
+		ejuo.SetTotal(*i)
+	}
+	return ejuo
+}
+
+// AddTotal adds i to the "total" field.
+func (ejuo *ExportJobUpdateOne) AddTotal(i int) *ExportJobUpdateOne {
+	ejuo.mutation.AddTotal(i)
+	return ejuo
+}
+
+// SetProcessed sets the "processed" field.
+func (ejuo *ExportJobUpdateOne) SetProcessed(i int) *ExportJobUpdateOne {
+	ejuo.mutation.ResetProcessed()
+	ejuo.mutation.SetProcessed(i)
+	return ejuo
+}
+
+// SetNillableProcessed sets the "processed" field if the given value is not nil.
+func (ejuo *ExportJobUpdateOne) SetNillableProcessed(i *int) *ExportJobUpdateOne {
+	if i != nil {
+		ejuo.SetProcessed(*i)
+	}
+	return ejuo
+}
+
+// AddProcessed adds i to the "processed" field.
+func (ejuo *ExportJobUpdateOne) AddProcessed(i int) *ExportJobUpdateOne {
+	ejuo.mutation.AddProcessed(i)
+	return ejuo
+}
+
+// SetObjectName sets the "objectName" field.
+func (ejuo *ExportJobUpdateOne) SetObjectName(s string) *ExportJobUpdateOne {
+	ejuo.mutation.SetObjectName(s)
+	return ejuo
+}
+
+// SetNillableObjectName sets the "objectName" field if the given value is not nil.
+func (ejuo *ExportJobUpdateOne) SetNillableObjectName(s *string) *ExportJobUpdateOne {
+	if s != nil {
+		ejuo.SetObjectName(*s)
+	}
+	return ejuo
+}
+
+// SetFileSize sets the "fileSize" field.
+func (ejuo *ExportJobUpdateOne) SetFileSize(i int64) *ExportJobUpdateOne {
+	ejuo.mutation.ResetFileSize()
+	ejuo.mutation.SetFileSize(i)
+	return ejuo
+}
+
+// SetNillableFileSize sets the "fileSize" field if the given value is not nil.
+func (ejuo *ExportJobUpdateOne) SetNillableFileSize(i *int64) *ExportJobUpdateOne {
+	if i != nil {
+		ejuo.SetFileSize(*i)
+	}
+	return ejuo
+}
+
+// AddFileSize adds i to the "fileSize" field.
+func (ejuo *ExportJobUpdateOne) AddFileSize(i int64) *ExportJobUpdateOne {
+	ejuo.mutation.AddFileSize(i)
+	return ejuo
+}
+
+// SetLastError sets the "lastError" field.
+func (ejuo *ExportJobUpdateOne) SetLastError(s string) *ExportJobUpdateOne {
+	ejuo.mutation.SetLastError(s)
+	return ejuo
+}
+
+// SetNillableLastError sets the "lastError" field if the given value is not nil.
+func (ejuo *ExportJobUpdateOne) SetNillableLastError(s *string) *ExportJobUpdateOne {
+	if s != nil {
+		ejuo.SetLastError(*s)
+	}
+	return ejuo
+}
+
+// SetCreateTime sets the "createTime" field.
+func (ejuo *ExportJobUpdateOne) SetCreateTime(t time.Time) *ExportJobUpdateOne {
+	ejuo.mutation.SetCreateTime(t)
+	return ejuo
+}
+
+// SetNillableCreateTime sets the "createTime" field if the given value is not nil.
+func (ejuo *ExportJobUpdateOne) SetNillableCreateTime(t *time.Time) *ExportJobUpdateOne {
+	if t != nil {
+		ejuo.SetCreateTime(*t)
+	}
+	return ejuo
+}
+
+// SetFinishTime sets the "finishTime" field.
+func (ejuo *ExportJobUpdateOne) SetFinishTime(t time.Time) *ExportJobUpdateOne {
+	ejuo.mutation.SetFinishTime(t)
+	return ejuo
+}
+
+// SetNillableFinishTime sets the "finishTime" field if the given value is not nil.
+func (ejuo *ExportJobUpdateOne) SetNillableFinishTime(t *time.Time) *ExportJobUpdateOne {
+	if t != nil {
+		ejuo.SetFinishTime(*t)
+	}
+	return ejuo
+}
+
+// ClearFinishTime clears the value of the "finishTime" field.
+func (ejuo *ExportJobUpdateOne) ClearFinishTime() *ExportJobUpdateOne {
+	ejuo.mutation.ClearFinishTime()
+	return ejuo
+}
+
+// Mutation returns the ExportJobMutation object of the builder.
+func (ejuo *ExportJobUpdateOne) Mutation() *ExportJobMutation {
+	return ejuo.mutation
+}
+
+// Where appends a list predicates to the ExportJobUpdate builder.
+func (ejuo *ExportJobUpdateOne) Where(ps ...predicate.ExportJob) *ExportJobUpdateOne {
+	ejuo.mutation.Where(ps...)
+	return ejuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ejuo *ExportJobUpdateOne) Select(field string, fields ...string) *ExportJobUpdateOne {
+	ejuo.fields = append([]string{field}, fields...)
+	return ejuo
+}
+
+// Save executes the query and returns the updated ExportJob entity.
+func (ejuo *ExportJobUpdateOne) Save(ctx context.Context) (*ExportJob, error) {
+	return withHooks(ctx, ejuo.sqlSave, ejuo.mutation, ejuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ejuo *ExportJobUpdateOne) SaveX(ctx context.Context) *ExportJob {
+	node, err := ejuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ejuo *ExportJobUpdateOne) Exec(ctx context.Context) error {
+	_, err := ejuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ejuo *ExportJobUpdateOne) ExecX(ctx context.Context) {
+	if err := ejuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ejuo *ExportJobUpdateOne) sqlSave(ctx context.Context) (_node *ExportJob, err error) {
+	_spec := sqlgraph.NewUpdateSpec(exportjob.Table, exportjob.Columns, sqlgraph.NewFieldSpec(exportjob.FieldID, field.TypeInt))
+	id, ok := ejuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ExportJob.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ejuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, exportjob.FieldID)
+		for _, f := range fields {
+			if !exportjob.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != exportjob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ejuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ejuo.mutation.UserId(); ok {
+		_spec.SetField(exportjob.FieldUserId, field.TypeInt, value)
+	}
+	if value, ok := ejuo.mutation.AddedUserId(); ok {
+		_spec.AddField(exportjob.FieldUserId, field.TypeInt, value)
+	}
+	if value, ok := ejuo.mutation.IsGroup(); ok {
+		_spec.SetField(exportjob.FieldIsGroup, field.TypeBool, value)
+	}
+	if value, ok := ejuo.mutation.TargetId(); ok {
+		_spec.SetField(exportjob.FieldTargetId, field.TypeInt, value)
+	}
+	if value, ok := ejuo.mutation.AddedTargetId(); ok {
+		_spec.AddField(exportjob.FieldTargetId, field.TypeInt, value)
+	}
+	if value, ok := ejuo.mutation.Format(); ok {
+		_spec.SetField(exportjob.FieldFormat, field.TypeString, value)
+	}
+	if value, ok := ejuo.mutation.Status(); ok {
+		_spec.SetField(exportjob.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := ejuo.mutation.LeaseTime(); ok {
+		_spec.SetField(exportjob.FieldLeaseTime, field.TypeTime, value)
+	}
+	if ejuo.mutation.LeaseTimeCleared() {
+		_spec.ClearField(exportjob.FieldLeaseTime, field.TypeTime)
+	}
+	if value, ok := ejuo.mutation.Attempts(); ok {
+		_spec.SetField(exportjob.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := ejuo.mutation.AddedAttempts(); ok {
+		_spec.AddField(exportjob.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := ejuo.mutation.Total(); ok {
+		_spec.SetField(exportjob.FieldTotal, field.TypeInt, value)
+	}
+	if value, ok := ejuo.mutation.AddedTotal(); ok {
+		_spec.AddField(exportjob.FieldTotal, field.TypeInt, value)
+	}
+	if value, ok := ejuo.mutation.Processed(); ok {
+		_spec.SetField(exportjob.FieldProcessed, field.TypeInt, value)
+	}
+	if value, ok := ejuo.mutation.AddedProcessed(); ok {
+		_spec.AddField(exportjob.FieldProcessed, field.TypeInt, value)
+	}
+	if value, ok := ejuo.mutation.ObjectName(); ok {
+		_spec.SetField(exportjob.FieldObjectName, field.TypeString, value)
+	}
+	if value, ok := ejuo.mutation.FileSize(); ok {
+		_spec.SetField(exportjob.FieldFileSize, field.TypeInt64, value)
+	}
+	if value, ok := ejuo.mutation.AddedFileSize(); ok {
+		_spec.AddField(exportjob.FieldFileSize, field.TypeInt64, value)
+	}
+	if value, ok := ejuo.mutation.LastError(); ok {
+		_spec.SetField(exportjob.FieldLastError, field.TypeString, value)
+	}
+	if value, ok := ejuo.mutation.CreateTime(); ok {
+		_spec.SetField(exportjob.FieldCreateTime, field.TypeTime, value)
+	}
+	if value, ok := ejuo.mutation.FinishTime(); ok {
+		_spec.SetField(exportjob.FieldFinishTime, field.TypeTime, value)
+	}
+	if ejuo.mutation.FinishTimeCleared() {
+		_spec.ClearField(exportjob.FieldFinishTime, field.TypeTime)
+	}
+	_node = &ExportJob{config: ejuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ejuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{exportjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ejuo.mutation.done = true
+	return _node, nil
+}
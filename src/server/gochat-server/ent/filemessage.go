@@ -0,0 +1,158 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"gochat_server/ent/filemessage"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// FileMessage is the model entity for the FileMessage schema.
+type FileMessage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 消息ID,由发送者产生
+	MsgId string `json:"msgId,omitempty"`
+	// 原始文件名
+	FileName string `json:"fileName,omitempty"`
+	// 文件大小(字节)
+	FileSize int64 `json:"fileSize,omitempty"`
+	// 文件MIME类型
+	MimeType string `json:"mimeType,omitempty"`
+	// 文件内容的SHA-256校验和(十六进制)
+	Checksum string `json:"checksum,omitempty"`
+	// 文件在对象存储中的键
+	StorageKey   string `json:"storageKey,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FileMessage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case filemessage.FieldID, filemessage.FieldFileSize:
+			values[i] = new(sql.NullInt64)
+		case filemessage.FieldMsgId, filemessage.FieldFileName, filemessage.FieldMimeType, filemessage.FieldChecksum, filemessage.FieldStorageKey:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FileMessage fields.
+func (fm *FileMessage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case filemessage.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			fm.ID = int(value.Int64)
+		case filemessage.FieldMsgId:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field msgId", values[i])
+			} else if value.Valid {
+				fm.MsgId = value.String
+			}
+		case filemessage.FieldFileName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fileName", values[i])
+			} else if value.Valid {
+				fm.FileName = value.String
+			}
+		case filemessage.FieldFileSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field fileSize", values[i])
+			} else if value.Valid {
+				fm.FileSize = value.Int64
+			}
+		case filemessage.FieldMimeType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mimeType", values[i])
+			} else if value.Valid {
+				fm.MimeType = value.String
+			}
+		case filemessage.FieldChecksum:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field checksum", values[i])
+			} else if value.Valid {
+				fm.Checksum = value.String
+			}
+		case filemessage.FieldStorageKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field storageKey", values[i])
+			} else if value.Valid {
+				fm.StorageKey = value.String
+			}
+		default:
+			fm.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the FileMessage.
+// This includes values selected through modifiers, order, etc.
+func (fm *FileMessage) Value(name string) (ent.Value, error) {
+	return fm.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this FileMessage.
+// Note that you need to call FileMessage.Unwrap() before calling this method if this FileMessage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (fm *FileMessage) Update() *FileMessageUpdateOne {
+	return NewFileMessageClient(fm.config).UpdateOne(fm)
+}
+
+// Unwrap unwraps the FileMessage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (fm *FileMessage) Unwrap() *FileMessage {
+	_tx, ok := fm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FileMessage is not a transactional entity")
+	}
+	fm.config.driver = _tx.drv
+	return fm
+}
+
+// String implements the fmt.Stringer.
+func (fm *FileMessage) String() string {
+	var builder strings.Builder
+	builder.WriteString("FileMessage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", fm.ID))
+	builder.WriteString("msgId=")
+	builder.WriteString(fm.MsgId)
+	builder.WriteString(", ")
+	builder.WriteString("fileName=")
+	builder.WriteString(fm.FileName)
+	builder.WriteString(", ")
+	builder.WriteString("fileSize=")
+	builder.WriteString(fmt.Sprintf("%v", fm.FileSize))
+	builder.WriteString(", ")
+	builder.WriteString("mimeType=")
+	builder.WriteString(fm.MimeType)
+	builder.WriteString(", ")
+	builder.WriteString("checksum=")
+	builder.WriteString(fm.Checksum)
+	builder.WriteString(", ")
+	builder.WriteString("storageKey=")
+	builder.WriteString(fm.StorageKey)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// FileMessages is a parsable slice of FileMessage.
+type FileMessages []*FileMessage
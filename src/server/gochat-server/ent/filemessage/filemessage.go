@@ -0,0 +1,100 @@
+// Code generated by ent, DO NOT EDIT.
+
+package filemessage
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the filemessage type in the database.
+	Label = "file_message"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldMsgId holds the string denoting the msgid field in the database.
+	FieldMsgId = "msg_id"
+	// FieldFileName holds the string denoting the filename field in the database.
+	FieldFileName = "file_name"
+	// FieldFileSize holds the string denoting the filesize field in the database.
+	FieldFileSize = "file_size"
+	// FieldMimeType holds the string denoting the mimetype field in the database.
+	FieldMimeType = "mime_type"
+	// FieldChecksum holds the string denoting the checksum field in the database.
+	FieldChecksum = "checksum"
+	// FieldStorageKey holds the string denoting the storagekey field in the database.
+	FieldStorageKey = "storage_key"
+	// Table holds the table name of the filemessage in the database.
+	Table = "file_messages"
+)
+
+// Columns holds all SQL columns for filemessage fields.
+var Columns = []string{
+	FieldID,
+	FieldMsgId,
+	FieldFileName,
+	FieldFileSize,
+	FieldMimeType,
+	FieldChecksum,
+	FieldStorageKey,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// MsgIdValidator is a validator for the "msgId" field. It is called by the builders before save.
+	MsgIdValidator func(string) error
+	// FileNameValidator is a validator for the "fileName" field. It is called by the builders before save.
+	FileNameValidator func(string) error
+	// FileSizeValidator is a validator for the "fileSize" field. It is called by the builders before save.
+	FileSizeValidator func(int64) error
+	// DefaultMimeType holds the default value on creation for the "mimeType" field.
+	DefaultMimeType string
+	// StorageKeyValidator is a validator for the "storageKey" field. It is called by the builders before save.
+	StorageKeyValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the FileMessage queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByMsgId orders the results by the msgId field.
+func ByMsgId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMsgId, opts...).ToFunc()
+}
+
+// ByFileName orders the results by the fileName field.
+func ByFileName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFileName, opts...).ToFunc()
+}
+
+// ByFileSize orders the results by the fileSize field.
+func ByFileSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFileSize, opts...).ToFunc()
+}
+
+// ByMimeType orders the results by the mimeType field.
+func ByMimeType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMimeType, opts...).ToFunc()
+}
+
+// ByChecksum orders the results by the checksum field.
+func ByChecksum(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChecksum, opts...).ToFunc()
+}
+
+// ByStorageKey orders the results by the storageKey field.
+func ByStorageKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStorageKey, opts...).ToFunc()
+}
@@ -0,0 +1,464 @@
+// Code generated by ent, DO NOT EDIT.
+
+package filemessage
+
+import (
+	"gochat_server/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldLTE(FieldID, id))
+}
+
+// MsgId applies equality check predicate on the "msgId" field. It's identical to MsgIdEQ.
+func MsgId(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldEQ(FieldMsgId, v))
+}
+
+// FileName applies equality check predicate on the "fileName" field. It's identical to FileNameEQ.
+func FileName(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldEQ(FieldFileName, v))
+}
+
+// FileSize applies equality check predicate on the "fileSize" field. It's identical to FileSizeEQ.
+func FileSize(v int64) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldEQ(FieldFileSize, v))
+}
+
+// MimeType applies equality check predicate on the "mimeType" field. It's identical to MimeTypeEQ.
+func MimeType(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldEQ(FieldMimeType, v))
+}
+
+// Checksum applies equality check predicate on the "checksum" field. It's identical to ChecksumEQ.
+func Checksum(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldEQ(FieldChecksum, v))
+}
+
+// StorageKey applies equality check predicate on the "storageKey" field. It's identical to StorageKeyEQ.
+func StorageKey(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldEQ(FieldStorageKey, v))
+}
+
+// MsgIdEQ applies the EQ predicate on the "msgId" field.
+func MsgIdEQ(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldEQ(FieldMsgId, v))
+}
+
+// MsgIdNEQ applies the NEQ predicate on the "msgId" field.
+func MsgIdNEQ(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldNEQ(FieldMsgId, v))
+}
+
+// MsgIdIn applies the In predicate on the "msgId" field.
+func MsgIdIn(vs ...string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldIn(FieldMsgId, vs...))
+}
+
+// MsgIdNotIn applies the NotIn predicate on the "msgId" field.
+func MsgIdNotIn(vs ...string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldNotIn(FieldMsgId, vs...))
+}
+
+// MsgIdGT applies the GT predicate on the "msgId" field.
+func MsgIdGT(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldGT(FieldMsgId, v))
+}
+
+// MsgIdGTE applies the GTE predicate on the "msgId" field.
+func MsgIdGTE(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldGTE(FieldMsgId, v))
+}
+
+// MsgIdLT applies the LT predicate on the "msgId" field.
+func MsgIdLT(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldLT(FieldMsgId, v))
+}
+
+// MsgIdLTE applies the LTE predicate on the "msgId" field.
+func MsgIdLTE(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldLTE(FieldMsgId, v))
+}
+
+// MsgIdContains applies the Contains predicate on the "msgId" field.
+func MsgIdContains(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldContains(FieldMsgId, v))
+}
+
+// MsgIdHasPrefix applies the HasPrefix predicate on the "msgId" field.
+func MsgIdHasPrefix(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldHasPrefix(FieldMsgId, v))
+}
+
+// MsgIdHasSuffix applies the HasSuffix predicate on the "msgId" field.
+func MsgIdHasSuffix(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldHasSuffix(FieldMsgId, v))
+}
+
+// MsgIdEqualFold applies the EqualFold predicate on the "msgId" field.
+func MsgIdEqualFold(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldEqualFold(FieldMsgId, v))
+}
+
+// MsgIdContainsFold applies the ContainsFold predicate on the "msgId" field.
+func MsgIdContainsFold(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldContainsFold(FieldMsgId, v))
+}
+
+// FileNameEQ applies the EQ predicate on the "fileName" field.
+func FileNameEQ(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldEQ(FieldFileName, v))
+}
+
+// FileNameNEQ applies the NEQ predicate on the "fileName" field.
+func FileNameNEQ(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldNEQ(FieldFileName, v))
+}
+
+// FileNameIn applies the In predicate on the "fileName" field.
+func FileNameIn(vs ...string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldIn(FieldFileName, vs...))
+}
+
+// FileNameNotIn applies the NotIn predicate on the "fileName" field.
+func FileNameNotIn(vs ...string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldNotIn(FieldFileName, vs...))
+}
+
+// FileNameGT applies the GT predicate on the "fileName" field.
+func FileNameGT(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldGT(FieldFileName, v))
+}
+
+// FileNameGTE applies the GTE predicate on the "fileName" field.
+func FileNameGTE(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldGTE(FieldFileName, v))
+}
+
+// FileNameLT applies the LT predicate on the "fileName" field.
+func FileNameLT(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldLT(FieldFileName, v))
+}
+
+// FileNameLTE applies the LTE predicate on the "fileName" field.
+func FileNameLTE(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldLTE(FieldFileName, v))
+}
+
+// FileNameContains applies the Contains predicate on the "fileName" field.
+func FileNameContains(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldContains(FieldFileName, v))
+}
+
+// FileNameHasPrefix applies the HasPrefix predicate on the "fileName" field.
+func FileNameHasPrefix(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldHasPrefix(FieldFileName, v))
+}
+
+// FileNameHasSuffix applies the HasSuffix predicate on the "fileName" field.
+func FileNameHasSuffix(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldHasSuffix(FieldFileName, v))
+}
+
+// FileNameEqualFold applies the EqualFold predicate on the "fileName" field.
+func FileNameEqualFold(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldEqualFold(FieldFileName, v))
+}
+
+// FileNameContainsFold applies the ContainsFold predicate on the "fileName" field.
+func FileNameContainsFold(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldContainsFold(FieldFileName, v))
+}
+
+// FileSizeEQ applies the EQ predicate on the "fileSize" field.
+func FileSizeEQ(v int64) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldEQ(FieldFileSize, v))
+}
+
+// FileSizeNEQ applies the NEQ predicate on the "fileSize" field.
+func FileSizeNEQ(v int64) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldNEQ(FieldFileSize, v))
+}
+
+// FileSizeIn applies the In predicate on the "fileSize" field.
+func FileSizeIn(vs ...int64) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldIn(FieldFileSize, vs...))
+}
+
+// FileSizeNotIn applies the NotIn predicate on the "fileSize" field.
+func FileSizeNotIn(vs ...int64) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldNotIn(FieldFileSize, vs...))
+}
+
+// FileSizeGT applies the GT predicate on the "fileSize" field.
+func FileSizeGT(v int64) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldGT(FieldFileSize, v))
+}
+
+// FileSizeGTE applies the GTE predicate on the "fileSize" field.
+func FileSizeGTE(v int64) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldGTE(FieldFileSize, v))
+}
+
+// FileSizeLT applies the LT predicate on the "fileSize" field.
+func FileSizeLT(v int64) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldLT(FieldFileSize, v))
+}
+
+// FileSizeLTE applies the LTE predicate on the "fileSize" field.
+func FileSizeLTE(v int64) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldLTE(FieldFileSize, v))
+}
+
+// MimeTypeEQ applies the EQ predicate on the "mimeType" field.
+func MimeTypeEQ(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldEQ(FieldMimeType, v))
+}
+
+// MimeTypeNEQ applies the NEQ predicate on the "mimeType" field.
+func MimeTypeNEQ(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldNEQ(FieldMimeType, v))
+}
+
+// MimeTypeIn applies the In predicate on the "mimeType" field.
+func MimeTypeIn(vs ...string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldIn(FieldMimeType, vs...))
+}
+
+// MimeTypeNotIn applies the NotIn predicate on the "mimeType" field.
+func MimeTypeNotIn(vs ...string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldNotIn(FieldMimeType, vs...))
+}
+
+// MimeTypeGT applies the GT predicate on the "mimeType" field.
+func MimeTypeGT(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldGT(FieldMimeType, v))
+}
+
+// MimeTypeGTE applies the GTE predicate on the "mimeType" field.
+func MimeTypeGTE(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldGTE(FieldMimeType, v))
+}
+
+// MimeTypeLT applies the LT predicate on the "mimeType" field.
+func MimeTypeLT(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldLT(FieldMimeType, v))
+}
+
+// MimeTypeLTE applies the LTE predicate on the "mimeType" field.
+func MimeTypeLTE(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldLTE(FieldMimeType, v))
+}
+
+// MimeTypeContains applies the Contains predicate on the "mimeType" field.
+func MimeTypeContains(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldContains(FieldMimeType, v))
+}
+
+// MimeTypeHasPrefix applies the HasPrefix predicate on the "mimeType" field.
+func MimeTypeHasPrefix(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldHasPrefix(FieldMimeType, v))
+}
+
+// MimeTypeHasSuffix applies the HasSuffix predicate on the "mimeType" field.
+func MimeTypeHasSuffix(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldHasSuffix(FieldMimeType, v))
+}
+
+// MimeTypeEqualFold applies the EqualFold predicate on the "mimeType" field.
+func MimeTypeEqualFold(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldEqualFold(FieldMimeType, v))
+}
+
+// MimeTypeContainsFold applies the ContainsFold predicate on the "mimeType" field.
+func MimeTypeContainsFold(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldContainsFold(FieldMimeType, v))
+}
+
+// ChecksumEQ applies the EQ predicate on the "checksum" field.
+func ChecksumEQ(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldEQ(FieldChecksum, v))
+}
+
+// ChecksumNEQ applies the NEQ predicate on the "checksum" field.
+func ChecksumNEQ(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldNEQ(FieldChecksum, v))
+}
+
+// ChecksumIn applies the In predicate on the "checksum" field.
+func ChecksumIn(vs ...string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldIn(FieldChecksum, vs...))
+}
+
+// ChecksumNotIn applies the NotIn predicate on the "checksum" field.
+func ChecksumNotIn(vs ...string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldNotIn(FieldChecksum, vs...))
+}
+
+// ChecksumGT applies the GT predicate on the "checksum" field.
+func ChecksumGT(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldGT(FieldChecksum, v))
+}
+
+// ChecksumGTE applies the GTE predicate on the "checksum" field.
+func ChecksumGTE(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldGTE(FieldChecksum, v))
+}
+
+// ChecksumLT applies the LT predicate on the "checksum" field.
+func ChecksumLT(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldLT(FieldChecksum, v))
+}
+
+// ChecksumLTE applies the LTE predicate on the "checksum" field.
+func ChecksumLTE(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldLTE(FieldChecksum, v))
+}
+
+// ChecksumContains applies the Contains predicate on the "checksum" field.
+func ChecksumContains(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldContains(FieldChecksum, v))
+}
+
+// ChecksumHasPrefix applies the HasPrefix predicate on the "checksum" field.
+func ChecksumHasPrefix(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldHasPrefix(FieldChecksum, v))
+}
+
+// ChecksumHasSuffix applies the HasSuffix predicate on the "checksum" field.
+func ChecksumHasSuffix(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldHasSuffix(FieldChecksum, v))
+}
+
+// ChecksumEqualFold applies the EqualFold predicate on the "checksum" field.
+func ChecksumEqualFold(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldEqualFold(FieldChecksum, v))
+}
+
+// ChecksumContainsFold applies the ContainsFold predicate on the "checksum" field.
+func ChecksumContainsFold(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldContainsFold(FieldChecksum, v))
+}
+
+// StorageKeyEQ applies the EQ predicate on the "storageKey" field.
+func StorageKeyEQ(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldEQ(FieldStorageKey, v))
+}
+
+// StorageKeyNEQ applies the NEQ predicate on the "storageKey" field.
+func StorageKeyNEQ(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldNEQ(FieldStorageKey, v))
+}
+
+// StorageKeyIn applies the In predicate on the "storageKey" field.
+func StorageKeyIn(vs ...string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldIn(FieldStorageKey, vs...))
+}
+
+// StorageKeyNotIn applies the NotIn predicate on the "storageKey" field.
+func StorageKeyNotIn(vs ...string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldNotIn(FieldStorageKey, vs...))
+}
+
+// StorageKeyGT applies the GT predicate on the "storageKey" field.
+func StorageKeyGT(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldGT(FieldStorageKey, v))
+}
+
+// StorageKeyGTE applies the GTE predicate on the "storageKey" field.
+func StorageKeyGTE(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldGTE(FieldStorageKey, v))
+}
+
+// StorageKeyLT applies the LT predicate on the "storageKey" field.
+func StorageKeyLT(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldLT(FieldStorageKey, v))
+}
+
+// StorageKeyLTE applies the LTE predicate on the "storageKey" field.
+func StorageKeyLTE(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldLTE(FieldStorageKey, v))
+}
+
+// StorageKeyContains applies the Contains predicate on the "storageKey" field.
+func StorageKeyContains(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldContains(FieldStorageKey, v))
+}
+
+// StorageKeyHasPrefix applies the HasPrefix predicate on the "storageKey" field.
+func StorageKeyHasPrefix(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldHasPrefix(FieldStorageKey, v))
+}
+
+// StorageKeyHasSuffix applies the HasSuffix predicate on the "storageKey" field.
+func StorageKeyHasSuffix(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldHasSuffix(FieldStorageKey, v))
+}
+
+// StorageKeyEqualFold applies the EqualFold predicate on the "storageKey" field.
+func StorageKeyEqualFold(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldEqualFold(FieldStorageKey, v))
+}
+
+// StorageKeyContainsFold applies the ContainsFold predicate on the "storageKey" field.
+func StorageKeyContainsFold(v string) predicate.FileMessage {
+	return predicate.FileMessage(sql.FieldContainsFold(FieldStorageKey, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.FileMessage) predicate.FileMessage {
+	return predicate.FileMessage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.FileMessage) predicate.FileMessage {
+	return predicate.FileMessage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.FileMessage) predicate.FileMessage {
+	return predicate.FileMessage(sql.NotPredicates(p))
+}
@@ -0,0 +1,286 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gochat_server/ent/filemessage"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FileMessageCreate is the builder for creating a FileMessage entity.
+type FileMessageCreate struct {
+	config
+	mutation *FileMessageMutation
+	hooks    []Hook
+}
+
+// SetMsgId sets the "msgId" field.
+func (fmc *FileMessageCreate) SetMsgId(s string) *FileMessageCreate {
+	fmc.mutation.SetMsgId(s)
+	return fmc
+}
+
+// SetFileName sets the "fileName" field.
+func (fmc *FileMessageCreate) SetFileName(s string) *FileMessageCreate {
+	fmc.mutation.SetFileName(s)
+	return fmc
+}
+
+// SetFileSize sets the "fileSize" field.
+func (fmc *FileMessageCreate) SetFileSize(i int64) *FileMessageCreate {
+	fmc.mutation.SetFileSize(i)
+	return fmc
+}
+
+// SetMimeType sets the "mimeType" field.
+func (fmc *FileMessageCreate) SetMimeType(s string) *FileMessageCreate {
+	fmc.mutation.SetMimeType(s)
+	return fmc
+}
+
+// SetNillableMimeType sets the "mimeType" field if the given value is not nil.
+func (fmc *FileMessageCreate) SetNillableMimeType(s *string) *FileMessageCreate {
+	if s != nil {
+		fmc.SetMimeType(*s)
+	}
+	return fmc
+}
+
+// SetChecksum sets the "checksum" field.
+func (fmc *FileMessageCreate) SetChecksum(s string) *FileMessageCreate {
+	fmc.mutation.SetChecksum(s)
+	return fmc
+}
+
+// SetStorageKey sets the "storageKey" field.
+func (fmc *FileMessageCreate) SetStorageKey(s string) *FileMessageCreate {
+	fmc.mutation.SetStorageKey(s)
+	return fmc
+}
+
+// Mutation returns the FileMessageMutation object of the builder.
+func (fmc *FileMessageCreate) Mutation() *FileMessageMutation {
+	return fmc.mutation
+}
+
+// Save creates the FileMessage in the database.
+func (fmc *FileMessageCreate) Save(ctx context.Context) (*FileMessage, error) {
+	fmc.defaults()
+	return withHooks(ctx, fmc.sqlSave, fmc.mutation, fmc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (fmc *FileMessageCreate) SaveX(ctx context.Context) *FileMessage {
+	v, err := fmc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (fmc *FileMessageCreate) Exec(ctx context.Context) error {
+	_, err := fmc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fmc *FileMessageCreate) ExecX(ctx context.Context) {
+	if err := fmc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fmc *FileMessageCreate) defaults() {
+	if _, ok := fmc.mutation.MimeType(); !ok {
+		v := filemessage.DefaultMimeType
+		fmc.mutation.SetMimeType(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fmc *FileMessageCreate) check() error {
+	if _, ok := fmc.mutation.MsgId(); !ok {
+		return &ValidationError{Name: "msgId", err: errors.New(`ent: missing required field "FileMessage.msgId"`)}
+	}
+	if v, ok := fmc.mutation.MsgId(); ok {
+		if err := filemessage.MsgIdValidator(v); err != nil {
+			return &ValidationError{Name: "msgId", err: fmt.Errorf(`ent: validator failed for field "FileMessage.msgId": %w`, err)}
+		}
+	}
+	if _, ok := fmc.mutation.FileName(); !ok {
+		return &ValidationError{Name: "fileName", err: errors.New(`ent: missing required field "FileMessage.fileName"`)}
+	}
+	if v, ok := fmc.mutation.FileName(); ok {
+		if err := filemessage.FileNameValidator(v); err != nil {
+			return &ValidationError{Name: "fileName", err: fmt.Errorf(`ent: validator failed for field "FileMessage.fileName": %w`, err)}
+		}
+	}
+	if _, ok := fmc.mutation.FileSize(); !ok {
+		return &ValidationError{Name: "fileSize", err: errors.New(`ent: missing required field "FileMessage.fileSize"`)}
+	}
+	if v, ok := fmc.mutation.FileSize(); ok {
+		if err := filemessage.FileSizeValidator(v); err != nil {
+			return &ValidationError{Name: "fileSize", err: fmt.Errorf(`ent: validator failed for field "FileMessage.fileSize": %w`, err)}
+		}
+	}
+	if _, ok := fmc.mutation.MimeType(); !ok {
+		return &ValidationError{Name: "mimeType", err: errors.New(`ent: missing required field "FileMessage.mimeType"`)}
+	}
+	if _, ok := fmc.mutation.Checksum(); !ok {
+		return &ValidationError{Name: "checksum", err: errors.New(`ent: missing required field "FileMessage.checksum"`)}
+	}
+	if _, ok := fmc.mutation.StorageKey(); !ok {
+		return &ValidationError{Name: "storageKey", err: errors.New(`ent: missing required field "FileMessage.storageKey"`)}
+	}
+	if v, ok := fmc.mutation.StorageKey(); ok {
+		if err := filemessage.StorageKeyValidator(v); err != nil {
+			return &ValidationError{Name: "storageKey", err: fmt.Errorf(`ent: validator failed for field "FileMessage.storageKey": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (fmc *FileMessageCreate) sqlSave(ctx context.Context) (*FileMessage, error) {
+	if err := fmc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := fmc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, fmc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	fmc.mutation.id = &_node.ID
+	fmc.mutation.done = true
+	return _node, nil
+}
+
+func (fmc *FileMessageCreate) createSpec() (*FileMessage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FileMessage{config: fmc.config}
+		_spec = sqlgraph.NewCreateSpec(filemessage.Table, sqlgraph.NewFieldSpec(filemessage.FieldID, field.TypeInt))
+	)
+	if value, ok := fmc.mutation.MsgId(); ok {
+		_spec.SetField(filemessage.FieldMsgId, field.TypeString, value)
+		_node.MsgId = value
+	}
+	if value, ok := fmc.mutation.FileName(); ok {
+		_spec.SetField(filemessage.FieldFileName, field.TypeString, value)
+		_node.FileName = value
+	}
+	if value, ok := fmc.mutation.FileSize(); ok {
+		_spec.SetField(filemessage.FieldFileSize, field.TypeInt64, value)
+		_node.FileSize = value
+	}
+	if value, ok := fmc.mutation.MimeType(); ok {
+		_spec.SetField(filemessage.FieldMimeType, field.TypeString, value)
+		_node.MimeType = value
+	}
+	if value, ok := fmc.mutation.Checksum(); ok {
+		_spec.SetField(filemessage.FieldChecksum, field.TypeString, value)
+		_node.Checksum = value
+	}
+	if value, ok := fmc.mutation.StorageKey(); ok {
+		_spec.SetField(filemessage.FieldStorageKey, field.TypeString, value)
+		_node.StorageKey = value
+	}
+	return _node, _spec
+}
+
+// FileMessageCreateBulk is the builder for creating many FileMessage entities in bulk.
+type FileMessageCreateBulk struct {
+	config
+	err      error
+	builders []*FileMessageCreate
+}
+
+// Save creates the FileMessage entities in the database.
+func (fmcb *FileMessageCreateBulk) Save(ctx context.Context) ([]*FileMessage, error) {
+	if fmcb.err != nil {
+		return nil, fmcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(fmcb.builders))
+	nodes := make([]*FileMessage, len(fmcb.builders))
+	mutators := make([]Mutator, len(fmcb.builders))
+	for i := range fmcb.builders {
+		func(i int, root context.Context) {
+			builder := fmcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FileMessageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, fmcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, fmcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, fmcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fmcb *FileMessageCreateBulk) SaveX(ctx context.Context) []*FileMessage {
+	v, err := fmcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (fmcb *FileMessageCreateBulk) Exec(ctx context.Context) error {
+	_, err := fmcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fmcb *FileMessageCreateBulk) ExecX(ctx context.Context) {
+	if err := fmcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
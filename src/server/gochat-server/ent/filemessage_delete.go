@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"gochat_server/ent/filemessage"
+	"gochat_server/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FileMessageDelete is the builder for deleting a FileMessage entity.
+type FileMessageDelete struct {
+	config
+	hooks    []Hook
+	mutation *FileMessageMutation
+}
+
+// Where appends a list predicates to the FileMessageDelete builder.
+func (fmd *FileMessageDelete) Where(ps ...predicate.FileMessage) *FileMessageDelete {
+	fmd.mutation.Where(ps...)
+	return fmd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (fmd *FileMessageDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, fmd.sqlExec, fmd.mutation, fmd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fmd *FileMessageDelete) ExecX(ctx context.Context) int {
+	n, err := fmd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (fmd *FileMessageDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(filemessage.Table, sqlgraph.NewFieldSpec(filemessage.FieldID, field.TypeInt))
+	if ps := fmd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, fmd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	fmd.mutation.done = true
+	return affected, err
+}
+
+// FileMessageDeleteOne is the builder for deleting a single FileMessage entity.
+type FileMessageDeleteOne struct {
+	fmd *FileMessageDelete
+}
+
+// Where appends a list predicates to the FileMessageDelete builder.
+func (fmdo *FileMessageDeleteOne) Where(ps ...predicate.FileMessage) *FileMessageDeleteOne {
+	fmdo.fmd.mutation.Where(ps...)
+	return fmdo
+}
+
+// Exec executes the deletion query.
+func (fmdo *FileMessageDeleteOne) Exec(ctx context.Context) error {
+	n, err := fmdo.fmd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{filemessage.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fmdo *FileMessageDeleteOne) ExecX(ctx context.Context) {
+	if err := fmdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
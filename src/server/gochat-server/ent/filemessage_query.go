@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"gochat_server/ent/filemessage"
+	"gochat_server/ent/predicate"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FileMessageQuery is the builder for querying FileMessage entities.
+type FileMessageQuery struct {
+	config
+	ctx        *QueryContext
+	order      []filemessage.OrderOption
+	inters     []Interceptor
+	predicates []predicate.FileMessage
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the FileMessageQuery builder.
+func (fmq *FileMessageQuery) Where(ps ...predicate.FileMessage) *FileMessageQuery {
+	fmq.predicates = append(fmq.predicates, ps...)
+	return fmq
+}
+
+// Limit the number of records to be returned by this query.
+func (fmq *FileMessageQuery) Limit(limit int) *FileMessageQuery {
+	fmq.ctx.Limit = &limit
+	return fmq
+}
+
+// Offset to start from.
+func (fmq *FileMessageQuery) Offset(offset int) *FileMessageQuery {
+	fmq.ctx.Offset = &offset
+	return fmq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (fmq *FileMessageQuery) Unique(unique bool) *FileMessageQuery {
+	fmq.ctx.Unique = &unique
+	return fmq
+}
+
+// Order specifies how the records should be ordered.
+func (fmq *FileMessageQuery) Order(o ...filemessage.OrderOption) *FileMessageQuery {
+	fmq.order = append(fmq.order, o...)
+	return fmq
+}
+
+// First returns the first FileMessage entity from the query.
+// Returns a *NotFoundError when no FileMessage was found.
+func (fmq *FileMessageQuery) First(ctx context.Context) (*FileMessage, error) {
+	nodes, err := fmq.Limit(1).All(setContextOp(ctx, fmq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{filemessage.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (fmq *FileMessageQuery) FirstX(ctx context.Context) *FileMessage {
+	node, err := fmq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first FileMessage ID from the query.
+// Returns a *NotFoundError when no FileMessage ID was found.
+func (fmq *FileMessageQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = fmq.Limit(1).IDs(setContextOp(ctx, fmq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{filemessage.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (fmq *FileMessageQuery) FirstIDX(ctx context.Context) int {
+	id, err := fmq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single FileMessage entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one FileMessage entity is found.
+// Returns a *NotFoundError when no FileMessage entities are found.
+func (fmq *FileMessageQuery) Only(ctx context.Context) (*FileMessage, error) {
+	nodes, err := fmq.Limit(2).All(setContextOp(ctx, fmq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{filemessage.Label}
+	default:
+		return nil, &NotSingularError{filemessage.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (fmq *FileMessageQuery) OnlyX(ctx context.Context) *FileMessage {
+	node, err := fmq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only FileMessage ID in the query.
+// Returns a *NotSingularError when more than one FileMessage ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (fmq *FileMessageQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = fmq.Limit(2).IDs(setContextOp(ctx, fmq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{filemessage.Label}
+	default:
+		err = &NotSingularError{filemessage.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (fmq *FileMessageQuery) OnlyIDX(ctx context.Context) int {
+	id, err := fmq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of FileMessages.
+func (fmq *FileMessageQuery) All(ctx context.Context) ([]*FileMessage, error) {
+	ctx = setContextOp(ctx, fmq.ctx, ent.OpQueryAll)
+	if err := fmq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*FileMessage, *FileMessageQuery]()
+	return withInterceptors[[]*FileMessage](ctx, fmq, qr, fmq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (fmq *FileMessageQuery) AllX(ctx context.Context) []*FileMessage {
+	nodes, err := fmq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of FileMessage IDs.
+func (fmq *FileMessageQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if fmq.ctx.Unique == nil && fmq.path != nil {
+		fmq.Unique(true)
+	}
+	ctx = setContextOp(ctx, fmq.ctx, ent.OpQueryIDs)
+	if err = fmq.Select(filemessage.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (fmq *FileMessageQuery) IDsX(ctx context.Context) []int {
+	ids, err := fmq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (fmq *FileMessageQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, fmq.ctx, ent.OpQueryCount)
+	if err := fmq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, fmq, querierCount[*FileMessageQuery](), fmq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (fmq *FileMessageQuery) CountX(ctx context.Context) int {
+	count, err := fmq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (fmq *FileMessageQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, fmq.ctx, ent.OpQueryExist)
+	switch _, err := fmq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (fmq *FileMessageQuery) ExistX(ctx context.Context) bool {
+	exist, err := fmq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the FileMessageQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (fmq *FileMessageQuery) Clone() *FileMessageQuery {
+	if fmq == nil {
+		return nil
+	}
+	return &FileMessageQuery{
+		config:     fmq.config,
+		ctx:        fmq.ctx.Clone(),
+		order:      append([]filemessage.OrderOption{}, fmq.order...),
+		inters:     append([]Interceptor{}, fmq.inters...),
+		predicates: append([]predicate.FileMessage{}, fmq.predicates...),
+		// clone intermediate query.
+		sql:  fmq.sql.Clone(),
+		path: fmq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		MsgId string `json:"msgId,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.FileMessage.Query().
+//		GroupBy(filemessage.FieldMsgId).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (fmq *FileMessageQuery) GroupBy(field string, fields ...string) *FileMessageGroupBy {
+	fmq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &FileMessageGroupBy{build: fmq}
+	grbuild.flds = &fmq.ctx.Fields
+	grbuild.label = filemessage.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		MsgId string `json:"msgId,omitempty"`
+//	}
+//
+//	client.FileMessage.Query().
+//		Select(filemessage.FieldMsgId).
+//		Scan(ctx, &v)
+func (fmq *FileMessageQuery) Select(fields ...string) *FileMessageSelect {
+	fmq.ctx.Fields = append(fmq.ctx.Fields, fields...)
+	sbuild := &FileMessageSelect{FileMessageQuery: fmq}
+	sbuild.label = filemessage.Label
+	sbuild.flds, sbuild.scan = &fmq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a FileMessageSelect configured with the given aggregations.
+func (fmq *FileMessageQuery) Aggregate(fns ...AggregateFunc) *FileMessageSelect {
+	return fmq.Select().Aggregate(fns...)
+}
+
+func (fmq *FileMessageQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range fmq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, fmq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range fmq.ctx.Fields {
+		if !filemessage.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if fmq.path != nil {
+		prev, err := fmq.path(ctx)
+		if err != nil {
+			return err
+		}
+		fmq.sql = prev
+	}
+	return nil
+}
+
+func (fmq *FileMessageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*FileMessage, error) {
+	var (
+		nodes = []*FileMessage{}
+		_spec = fmq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*FileMessage).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &FileMessage{config: fmq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, fmq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (fmq *FileMessageQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := fmq.querySpec()
+	_spec.Node.Columns = fmq.ctx.Fields
+	if len(fmq.ctx.Fields) > 0 {
+		_spec.Unique = fmq.ctx.Unique != nil && *fmq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, fmq.driver, _spec)
+}
+
+func (fmq *FileMessageQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(filemessage.Table, filemessage.Columns, sqlgraph.NewFieldSpec(filemessage.FieldID, field.TypeInt))
+	_spec.From = fmq.sql
+	if unique := fmq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if fmq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := fmq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, filemessage.FieldID)
+		for i := range fields {
+			if fields[i] != filemessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := fmq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := fmq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := fmq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := fmq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (fmq *FileMessageQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(fmq.driver.Dialect())
+	t1 := builder.Table(filemessage.Table)
+	columns := fmq.ctx.Fields
+	if len(columns) == 0 {
+		columns = filemessage.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if fmq.sql != nil {
+		selector = fmq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if fmq.ctx.Unique != nil && *fmq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range fmq.predicates {
+		p(selector)
+	}
+	for _, p := range fmq.order {
+		p(selector)
+	}
+	if offset := fmq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := fmq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// FileMessageGroupBy is the group-by builder for FileMessage entities.
+type FileMessageGroupBy struct {
+	selector
+	build *FileMessageQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (fmgb *FileMessageGroupBy) Aggregate(fns ...AggregateFunc) *FileMessageGroupBy {
+	fmgb.fns = append(fmgb.fns, fns...)
+	return fmgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (fmgb *FileMessageGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, fmgb.build.ctx, ent.OpQueryGroupBy)
+	if err := fmgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*FileMessageQuery, *FileMessageGroupBy](ctx, fmgb.build, fmgb, fmgb.build.inters, v)
+}
+
+func (fmgb *FileMessageGroupBy) sqlScan(ctx context.Context, root *FileMessageQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(fmgb.fns))
+	for _, fn := range fmgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*fmgb.flds)+len(fmgb.fns))
+		for _, f := range *fmgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*fmgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := fmgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// FileMessageSelect is the builder for selecting fields of FileMessage entities.
+type FileMessageSelect struct {
+	*FileMessageQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (fms *FileMessageSelect) Aggregate(fns ...AggregateFunc) *FileMessageSelect {
+	fms.fns = append(fms.fns, fns...)
+	return fms
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (fms *FileMessageSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, fms.ctx, ent.OpQuerySelect)
+	if err := fms.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*FileMessageQuery, *FileMessageSelect](ctx, fms.FileMessageQuery, fms, fms.inters, v)
+}
+
+func (fms *FileMessageSelect) sqlScan(ctx context.Context, root *FileMessageQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(fms.fns))
+	for _, fn := range fms.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*fms.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := fms.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
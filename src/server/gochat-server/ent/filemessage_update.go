@@ -0,0 +1,455 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gochat_server/ent/filemessage"
+	"gochat_server/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FileMessageUpdate is the builder for updating FileMessage entities.
+type FileMessageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *FileMessageMutation
+}
+
+// Where appends a list predicates to the FileMessageUpdate builder.
+func (fmu *FileMessageUpdate) Where(ps ...predicate.FileMessage) *FileMessageUpdate {
+	fmu.mutation.Where(ps...)
+	return fmu
+}
+
+// SetMsgId sets the "msgId" field.
+func (fmu *FileMessageUpdate) SetMsgId(s string) *FileMessageUpdate {
+	fmu.mutation.SetMsgId(s)
+	return fmu
+}
+
+// SetNillableMsgId sets the "msgId" field if the given value is not nil.
+func (fmu *FileMessageUpdate) SetNillableMsgId(s *string) *FileMessageUpdate {
+	if s != nil {
+		fmu.SetMsgId(*s)
+	}
+	return fmu
+}
+
+// SetFileName sets the "fileName" field.
+func (fmu *FileMessageUpdate) SetFileName(s string) *FileMessageUpdate {
+	fmu.mutation.SetFileName(s)
+	return fmu
+}
+
+// SetNillableFileName sets the "fileName" field if the given value is not nil.
+func (fmu *FileMessageUpdate) SetNillableFileName(s *string) *FileMessageUpdate {
+	if s != nil {
+		fmu.SetFileName(*s)
+	}
+	return fmu
+}
+
+// SetFileSize sets the "fileSize" field.
+func (fmu *FileMessageUpdate) SetFileSize(i int64) *FileMessageUpdate {
+	fmu.mutation.ResetFileSize()
+	fmu.mutation.SetFileSize(i)
+	return fmu
+}
+
+// SetNillableFileSize sets the "fileSize" field if the given value is not nil.
+func (fmu *FileMessageUpdate) SetNillableFileSize(i *int64) *FileMessageUpdate {
+	if i != nil {
+		fmu.SetFileSize(*i)
+	}
+	return fmu
+}
+
+// AddFileSize adds i to the "fileSize" field.
+func (fmu *FileMessageUpdate) AddFileSize(i int64) *FileMessageUpdate {
+	fmu.mutation.AddFileSize(i)
+	return fmu
+}
+
+// SetMimeType sets the "mimeType" field.
+func (fmu *FileMessageUpdate) SetMimeType(s string) *FileMessageUpdate {
+	fmu.mutation.SetMimeType(s)
+	return fmu
+}
+
+// SetNillableMimeType sets the "mimeType" field if the given value is not nil.
+func (fmu *FileMessageUpdate) SetNillableMimeType(s *string) *FileMessageUpdate {
+	if s != nil {
+		fmu.SetMimeType(*s)
+	}
+	return fmu
+}
+
+// SetChecksum sets the "checksum" field.
+func (fmu *FileMessageUpdate) SetChecksum(s string) *FileMessageUpdate {
+	fmu.mutation.SetChecksum(s)
+	return fmu
+}
+
+// SetNillableChecksum sets the "checksum" field if the given value is not nil.
+func (fmu *FileMessageUpdate) SetNillableChecksum(s *string) *FileMessageUpdate {
+	if s != nil {
+		fmu.SetChecksum(*s)
+	}
+	return fmu
+}
+
+// SetStorageKey sets the "storageKey" field.
+func (fmu *FileMessageUpdate) SetStorageKey(s string) *FileMessageUpdate {
+	fmu.mutation.SetStorageKey(s)
+	return fmu
+}
+
+// SetNillableStorageKey sets the "storageKey" field if the given value is not nil.
+func (fmu *FileMessageUpdate) SetNillableStorageKey(s *string) *FileMessageUpdate {
+	if s != nil {
+		fmu.SetStorageKey(*s)
+	}
+	return fmu
+}
+
+// Mutation returns the FileMessageMutation object of the builder.
+func (fmu *FileMessageUpdate) Mutation() *FileMessageMutation {
+	return fmu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (fmu *FileMessageUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, fmu.sqlSave, fmu.mutation, fmu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fmu *FileMessageUpdate) SaveX(ctx context.Context) int {
+	affected, err := fmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (fmu *FileMessageUpdate) Exec(ctx context.Context) error {
+	_, err := fmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fmu *FileMessageUpdate) ExecX(ctx context.Context) {
+	if err := fmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fmu *FileMessageUpdate) check() error {
+	if v, ok := fmu.mutation.MsgId(); ok {
+		if err := filemessage.MsgIdValidator(v); err != nil {
+			return &ValidationError{Name: "msgId", err: fmt.Errorf(`ent: validator failed for field "FileMessage.msgId": %w`, err)}
+		}
+	}
+	if v, ok := fmu.mutation.FileName(); ok {
+		if err := filemessage.FileNameValidator(v); err != nil {
+			return &ValidationError{Name: "fileName", err: fmt.Errorf(`ent: validator failed for field "FileMessage.fileName": %w`, err)}
+		}
+	}
+	if v, ok := fmu.mutation.FileSize(); ok {
+		if err := filemessage.FileSizeValidator(v); err != nil {
+			return &ValidationError{Name: "fileSize", err: fmt.Errorf(`ent: validator failed for field "FileMessage.fileSize": %w`, err)}
+		}
+	}
+	if v, ok := fmu.mutation.StorageKey(); ok {
+		if err := filemessage.StorageKeyValidator(v); err != nil {
+			return &ValidationError{Name: "storageKey", err: fmt.Errorf(`ent: validator failed for field "FileMessage.storageKey": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (fmu *FileMessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := fmu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(filemessage.Table, filemessage.Columns, sqlgraph.NewFieldSpec(filemessage.FieldID, field.TypeInt))
+	if ps := fmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fmu.mutation.MsgId(); ok {
+		_spec.SetField(filemessage.FieldMsgId, field.TypeString, value)
+	}
+	if value, ok := fmu.mutation.FileName(); ok {
+		_spec.SetField(filemessage.FieldFileName, field.TypeString, value)
+	}
+	if value, ok := fmu.mutation.FileSize(); ok {
+		_spec.SetField(filemessage.FieldFileSize, field.TypeInt64, value)
+	}
+	if value, ok := fmu.mutation.AddedFileSize(); ok {
+		_spec.AddField(filemessage.FieldFileSize, field.TypeInt64, value)
+	}
+	if value, ok := fmu.mutation.MimeType(); ok {
+		_spec.SetField(filemessage.FieldMimeType, field.TypeString, value)
+	}
+	if value, ok := fmu.mutation.Checksum(); ok {
+		_spec.SetField(filemessage.FieldChecksum, field.TypeString, value)
+	}
+	if value, ok := fmu.mutation.StorageKey(); ok {
+		_spec.SetField(filemessage.FieldStorageKey, field.TypeString, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, fmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{filemessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	fmu.mutation.done = true
+	return n, nil
+}
+
+// FileMessageUpdateOne is the builder for updating a single FileMessage entity.
+type FileMessageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *FileMessageMutation
+}
+
+// SetMsgId sets the "msgId" field.
+func (fmuo *FileMessageUpdateOne) SetMsgId(s string) *FileMessageUpdateOne {
+	fmuo.mutation.SetMsgId(s)
+	return fmuo
+}
+
+// SetNillableMsgId sets the "msgId" field if the given value is not nil.
+func (fmuo *FileMessageUpdateOne) SetNillableMsgId(s *string) *FileMessageUpdateOne {
+	if s != nil {
+		fmuo.SetMsgId(*s)
+	}
+	return fmuo
+}
+
+// SetFileName sets the "fileName" field.
+func (fmuo *FileMessageUpdateOne) SetFileName(s string) *FileMessageUpdateOne {
+	fmuo.mutation.SetFileName(s)
+	return fmuo
+}
+
+// SetNillableFileName sets the "fileName" field if the given value is not nil.
+func (fmuo *FileMessageUpdateOne) SetNillableFileName(s *string) *FileMessageUpdateOne {
+	if s != nil {
+		fmuo.SetFileName(*s)
+	}
+	return fmuo
+}
+
+// SetFileSize sets the "fileSize" field.
+func (fmuo *FileMessageUpdateOne) SetFileSize(i int64) *FileMessageUpdateOne {
+	fmuo.mutation.ResetFileSize()
+	fmuo.mutation.SetFileSize(i)
+	return fmuo
+}
+
+// SetNillableFileSize sets the "fileSize" field if the given value is not nil.
+func (fmuo *FileMessageUpdateOne) SetNillableFileSize(i *int64) *FileMessageUpdateOne {
+	if i != nil {
+		fmuo.SetFileSize(*i)
+	}
+	return fmuo
+}
+
+// AddFileSize adds i to the "fileSize" field.
+func (fmuo *FileMessageUpdateOne) AddFileSize(i int64) *FileMessageUpdateOne {
+	fmuo.mutation.AddFileSize(i)
+	return fmuo
+}
+
+// SetMimeType sets the "mimeType" field.
+func (fmuo *FileMessageUpdateOne) SetMimeType(s string) *FileMessageUpdateOne {
+	fmuo.mutation.SetMimeType(s)
+	return fmuo
+}
+
+// SetNillableMimeType sets the "mimeType" field if the given value is not nil.
+func (fmuo *FileMessageUpdateOne) SetNillableMimeType(s *string) *FileMessageUpdateOne {
+	if s != nil {
+		fmuo.SetMimeType(*s)
+	}
+	return fmuo
+}
+
+// SetChecksum sets the "checksum" field.
+func (fmuo *FileMessageUpdateOne) SetChecksum(s string) *FileMessageUpdateOne {
+	fmuo.mutation.SetChecksum(s)
+	return fmuo
+}
+
+// SetNillableChecksum sets the "checksum" field if the given value is not nil.
+func (fmuo *FileMessageUpdateOne) SetNillableChecksum(s *string) *FileMessageUpdateOne {
+	if s != nil {
+		fmuo.SetChecksum(*s)
+	}
+	return fmuo
+}
+
+// SetStorageKey sets the "storageKey" field.
+func (fmuo *FileMessageUpdateOne) SetStorageKey(s string) *FileMessageUpdateOne {
+	fmuo.mutation.SetStorageKey(s)
+	return fmuo
+}
+
+// SetNillableStorageKey sets the "storageKey" field if the given value is not nil.
+func (fmuo *FileMessageUpdateOne) SetNillableStorageKey(s *string) *FileMessageUpdateOne {
+	if s != nil {
+		fmuo.SetStorageKey(*s)
+	}
+	return fmuo
+}
+
+// Mutation returns the FileMessageMutation object of the builder.
+func (fmuo *FileMessageUpdateOne) Mutation() *FileMessageMutation {
+	return fmuo.mutation
+}
+
+// Where appends a list predicates to the FileMessageUpdate builder.
+func (fmuo *FileMessageUpdateOne) Where(ps ...predicate.FileMessage) *FileMessageUpdateOne {
+	fmuo.mutation.Where(ps...)
+	return fmuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (fmuo *FileMessageUpdateOne) Select(field string, fields ...string) *FileMessageUpdateOne {
+	fmuo.fields = append([]string{field}, fields...)
+	return fmuo
+}
+
+// Save executes the query and returns the updated FileMessage entity.
+func (fmuo *FileMessageUpdateOne) Save(ctx context.Context) (*FileMessage, error) {
+	return withHooks(ctx, fmuo.sqlSave, fmuo.mutation, fmuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fmuo *FileMessageUpdateOne) SaveX(ctx context.Context) *FileMessage {
+	node, err := fmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (fmuo *FileMessageUpdateOne) Exec(ctx context.Context) error {
+	_, err := fmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fmuo *FileMessageUpdateOne) ExecX(ctx context.Context) {
+	if err := fmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fmuo *FileMessageUpdateOne) check() error {
+	if v, ok := fmuo.mutation.MsgId(); ok {
+		if err := filemessage.MsgIdValidator(v); err != nil {
+			return &ValidationError{Name: "msgId", err: fmt.Errorf(`ent: validator failed for field "FileMessage.msgId": %w`, err)}
+		}
+	}
+	if v, ok := fmuo.mutation.FileName(); ok {
+		if err := filemessage.FileNameValidator(v); err != nil {
+			return &ValidationError{Name: "fileName", err: fmt.Errorf(`ent: validator failed for field "FileMessage.fileName": %w`, err)}
+		}
+	}
+	if v, ok := fmuo.mutation.FileSize(); ok {
+		if err := filemessage.FileSizeValidator(v); err != nil {
+			return &ValidationError{Name: "fileSize", err: fmt.Errorf(`ent: validator failed for field "FileMessage.fileSize": %w`, err)}
+		}
+	}
+	if v, ok := fmuo.mutation.StorageKey(); ok {
+		if err := filemessage.StorageKeyValidator(v); err != nil {
+			return &ValidationError{Name: "storageKey", err: fmt.Errorf(`ent: validator failed for field "FileMessage.storageKey": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (fmuo *FileMessageUpdateOne) sqlSave(ctx context.Context) (_node *FileMessage, err error) {
+	if err := fmuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(filemessage.Table, filemessage.Columns, sqlgraph.NewFieldSpec(filemessage.FieldID, field.TypeInt))
+	id, ok := fmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FileMessage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := fmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, filemessage.FieldID)
+		for _, f := range fields {
+			if !filemessage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != filemessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := fmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fmuo.mutation.MsgId(); ok {
+		_spec.SetField(filemessage.FieldMsgId, field.TypeString, value)
+	}
+	if value, ok := fmuo.mutation.FileName(); ok {
+		_spec.SetField(filemessage.FieldFileName, field.TypeString, value)
+	}
+	if value, ok := fmuo.mutation.FileSize(); ok {
+		_spec.SetField(filemessage.FieldFileSize, field.TypeInt64, value)
+	}
+	if value, ok := fmuo.mutation.AddedFileSize(); ok {
+		_spec.AddField(filemessage.FieldFileSize, field.TypeInt64, value)
+	}
+	if value, ok := fmuo.mutation.MimeType(); ok {
+		_spec.SetField(filemessage.FieldMimeType, field.TypeString, value)
+	}
+	if value, ok := fmuo.mutation.Checksum(); ok {
+		_spec.SetField(filemessage.FieldChecksum, field.TypeString, value)
+	}
+	if value, ok := fmuo.mutation.StorageKey(); ok {
+		_spec.SetField(filemessage.FieldStorageKey, field.TypeString, value)
+	}
+	_node = &FileMessage{config: fmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, fmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{filemessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	fmuo.mutation.done = true
+	return _node, nil
+}
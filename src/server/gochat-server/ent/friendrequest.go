@@ -23,10 +23,16 @@ type FriendRequest struct {
 	ToUserId int `json:"toUserId,omitempty"`
 	// 备注信息
 	Remark string `json:"remark,omitempty"`
-	// 状态: 0-待处理, 1-已接受, 2-已拒绝
+	// 状态: 0-待处理, 1-已接受, 2-已拒绝, 3-已撤回, 4-已过期
 	Status int `json:"status,omitempty"`
+	// 来源: search-搜索, shared_card-名片分享
+	Source string `json:"source,omitempty"`
+	// 来源为名片分享时,对应的名片消息ID
+	SourceMsgId string `json:"sourceMsgId,omitempty"`
 	// 创建时间
-	CreateTime   time.Time `json:"createTime,omitempty"`
+	CreateTime time.Time `json:"createTime,omitempty"`
+	// 处理时间（接受、拒绝、撤回或过期的时间）
+	HandleTime   *time.Time `json:"handleTime,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -37,9 +43,9 @@ func (*FriendRequest) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case friendrequest.FieldID, friendrequest.FieldFromUserId, friendrequest.FieldToUserId, friendrequest.FieldStatus:
 			values[i] = new(sql.NullInt64)
-		case friendrequest.FieldRemark:
+		case friendrequest.FieldRemark, friendrequest.FieldSource, friendrequest.FieldSourceMsgId:
 			values[i] = new(sql.NullString)
-		case friendrequest.FieldCreateTime:
+		case friendrequest.FieldCreateTime, friendrequest.FieldHandleTime:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -86,12 +92,31 @@ func (fr *FriendRequest) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				fr.Status = int(value.Int64)
 			}
+		case friendrequest.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				fr.Source = value.String
+			}
+		case friendrequest.FieldSourceMsgId:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sourceMsgId", values[i])
+			} else if value.Valid {
+				fr.SourceMsgId = value.String
+			}
 		case friendrequest.FieldCreateTime:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field createTime", values[i])
 			} else if value.Valid {
 				fr.CreateTime = value.Time
 			}
+		case friendrequest.FieldHandleTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field handleTime", values[i])
+			} else if value.Valid {
+				fr.HandleTime = new(time.Time)
+				*fr.HandleTime = value.Time
+			}
 		default:
 			fr.selectValues.Set(columns[i], values[i])
 		}
@@ -140,8 +165,19 @@ func (fr *FriendRequest) String() string {
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", fr.Status))
 	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(fr.Source)
+	builder.WriteString(", ")
+	builder.WriteString("sourceMsgId=")
+	builder.WriteString(fr.SourceMsgId)
+	builder.WriteString(", ")
 	builder.WriteString("createTime=")
 	builder.WriteString(fr.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := fr.HandleTime; v != nil {
+		builder.WriteString("handleTime=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
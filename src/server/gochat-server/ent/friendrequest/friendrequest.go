@@ -21,8 +21,14 @@ const (
 	FieldRemark = "remark"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldSourceMsgId holds the string denoting the sourcemsgid field in the database.
+	FieldSourceMsgId = "source_msg_id"
 	// FieldCreateTime holds the string denoting the createtime field in the database.
 	FieldCreateTime = "create_time"
+	// FieldHandleTime holds the string denoting the handletime field in the database.
+	FieldHandleTime = "handle_time"
 	// Table holds the table name of the friendrequest in the database.
 	Table = "friend_requests"
 )
@@ -34,7 +40,10 @@ var Columns = []string{
 	FieldToUserId,
 	FieldRemark,
 	FieldStatus,
+	FieldSource,
+	FieldSourceMsgId,
 	FieldCreateTime,
+	FieldHandleTime,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -82,7 +91,22 @@ func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
 }
 
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// BySourceMsgId orders the results by the sourceMsgId field.
+func BySourceMsgId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceMsgId, opts...).ToFunc()
+}
+
 // ByCreateTime orders the results by the createTime field.
 func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
 }
+
+// ByHandleTime orders the results by the handleTime field.
+func ByHandleTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHandleTime, opts...).ToFunc()
+}
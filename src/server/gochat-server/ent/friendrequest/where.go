@@ -74,11 +74,26 @@ func Status(v int) predicate.FriendRequest {
 	return predicate.FriendRequest(sql.FieldEQ(FieldStatus, v))
 }
 
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceMsgId applies equality check predicate on the "sourceMsgId" field. It's identical to SourceMsgIdEQ.
+func SourceMsgId(v string) predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldEQ(FieldSourceMsgId, v))
+}
+
 // CreateTime applies equality check predicate on the "createTime" field. It's identical to CreateTimeEQ.
 func CreateTime(v time.Time) predicate.FriendRequest {
 	return predicate.FriendRequest(sql.FieldEQ(FieldCreateTime, v))
 }
 
+// HandleTime applies equality check predicate on the "handleTime" field. It's identical to HandleTimeEQ.
+func HandleTime(v time.Time) predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldEQ(FieldHandleTime, v))
+}
+
 // FromUserIdEQ applies the EQ predicate on the "fromUserId" field.
 func FromUserIdEQ(v int) predicate.FriendRequest {
 	return predicate.FriendRequest(sql.FieldEQ(FieldFromUserId, v))
@@ -274,6 +289,156 @@ func StatusLTE(v int) predicate.FriendRequest {
 	return predicate.FriendRequest(sql.FieldLTE(FieldStatus, v))
 }
 
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceIsNil applies the IsNil predicate on the "source" field.
+func SourceIsNil() predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldIsNull(FieldSource))
+}
+
+// SourceNotNil applies the NotNil predicate on the "source" field.
+func SourceNotNil() predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldNotNull(FieldSource))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldContainsFold(FieldSource, v))
+}
+
+// SourceMsgIdEQ applies the EQ predicate on the "sourceMsgId" field.
+func SourceMsgIdEQ(v string) predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldEQ(FieldSourceMsgId, v))
+}
+
+// SourceMsgIdNEQ applies the NEQ predicate on the "sourceMsgId" field.
+func SourceMsgIdNEQ(v string) predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldNEQ(FieldSourceMsgId, v))
+}
+
+// SourceMsgIdIn applies the In predicate on the "sourceMsgId" field.
+func SourceMsgIdIn(vs ...string) predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldIn(FieldSourceMsgId, vs...))
+}
+
+// SourceMsgIdNotIn applies the NotIn predicate on the "sourceMsgId" field.
+func SourceMsgIdNotIn(vs ...string) predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldNotIn(FieldSourceMsgId, vs...))
+}
+
+// SourceMsgIdGT applies the GT predicate on the "sourceMsgId" field.
+func SourceMsgIdGT(v string) predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldGT(FieldSourceMsgId, v))
+}
+
+// SourceMsgIdGTE applies the GTE predicate on the "sourceMsgId" field.
+func SourceMsgIdGTE(v string) predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldGTE(FieldSourceMsgId, v))
+}
+
+// SourceMsgIdLT applies the LT predicate on the "sourceMsgId" field.
+func SourceMsgIdLT(v string) predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldLT(FieldSourceMsgId, v))
+}
+
+// SourceMsgIdLTE applies the LTE predicate on the "sourceMsgId" field.
+func SourceMsgIdLTE(v string) predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldLTE(FieldSourceMsgId, v))
+}
+
+// SourceMsgIdContains applies the Contains predicate on the "sourceMsgId" field.
+func SourceMsgIdContains(v string) predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldContains(FieldSourceMsgId, v))
+}
+
+// SourceMsgIdHasPrefix applies the HasPrefix predicate on the "sourceMsgId" field.
+func SourceMsgIdHasPrefix(v string) predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldHasPrefix(FieldSourceMsgId, v))
+}
+
+// SourceMsgIdHasSuffix applies the HasSuffix predicate on the "sourceMsgId" field.
+func SourceMsgIdHasSuffix(v string) predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldHasSuffix(FieldSourceMsgId, v))
+}
+
+// SourceMsgIdIsNil applies the IsNil predicate on the "sourceMsgId" field.
+func SourceMsgIdIsNil() predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldIsNull(FieldSourceMsgId))
+}
+
+// SourceMsgIdNotNil applies the NotNil predicate on the "sourceMsgId" field.
+func SourceMsgIdNotNil() predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldNotNull(FieldSourceMsgId))
+}
+
+// SourceMsgIdEqualFold applies the EqualFold predicate on the "sourceMsgId" field.
+func SourceMsgIdEqualFold(v string) predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldEqualFold(FieldSourceMsgId, v))
+}
+
+// SourceMsgIdContainsFold applies the ContainsFold predicate on the "sourceMsgId" field.
+func SourceMsgIdContainsFold(v string) predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldContainsFold(FieldSourceMsgId, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "createTime" field.
 func CreateTimeEQ(v time.Time) predicate.FriendRequest {
 	return predicate.FriendRequest(sql.FieldEQ(FieldCreateTime, v))
@@ -314,6 +479,56 @@ func CreateTimeLTE(v time.Time) predicate.FriendRequest {
 	return predicate.FriendRequest(sql.FieldLTE(FieldCreateTime, v))
 }
 
+// HandleTimeEQ applies the EQ predicate on the "handleTime" field.
+func HandleTimeEQ(v time.Time) predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldEQ(FieldHandleTime, v))
+}
+
+// HandleTimeNEQ applies the NEQ predicate on the "handleTime" field.
+func HandleTimeNEQ(v time.Time) predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldNEQ(FieldHandleTime, v))
+}
+
+// HandleTimeIn applies the In predicate on the "handleTime" field.
+func HandleTimeIn(vs ...time.Time) predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldIn(FieldHandleTime, vs...))
+}
+
+// HandleTimeNotIn applies the NotIn predicate on the "handleTime" field.
+func HandleTimeNotIn(vs ...time.Time) predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldNotIn(FieldHandleTime, vs...))
+}
+
+// HandleTimeGT applies the GT predicate on the "handleTime" field.
+func HandleTimeGT(v time.Time) predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldGT(FieldHandleTime, v))
+}
+
+// HandleTimeGTE applies the GTE predicate on the "handleTime" field.
+func HandleTimeGTE(v time.Time) predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldGTE(FieldHandleTime, v))
+}
+
+// HandleTimeLT applies the LT predicate on the "handleTime" field.
+func HandleTimeLT(v time.Time) predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldLT(FieldHandleTime, v))
+}
+
+// HandleTimeLTE applies the LTE predicate on the "handleTime" field.
+func HandleTimeLTE(v time.Time) predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldLTE(FieldHandleTime, v))
+}
+
+// HandleTimeIsNil applies the IsNil predicate on the "handleTime" field.
+func HandleTimeIsNil() predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldIsNull(FieldHandleTime))
+}
+
+// HandleTimeNotNil applies the NotNil predicate on the "handleTime" field.
+func HandleTimeNotNil() predicate.FriendRequest {
+	return predicate.FriendRequest(sql.FieldNotNull(FieldHandleTime))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.FriendRequest) predicate.FriendRequest {
 	return predicate.FriendRequest(sql.AndPredicates(predicates...))
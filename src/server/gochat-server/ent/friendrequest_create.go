@@ -60,6 +60,34 @@ func (frc *FriendRequestCreate) SetNillableStatus(i *int) *FriendRequestCreate {
 	return frc
 }
 
+// SetSource sets the "source" field.
+func (frc *FriendRequestCreate) SetSource(s string) *FriendRequestCreate {
+	frc.mutation.SetSource(s)
+	return frc
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (frc *FriendRequestCreate) SetNillableSource(s *string) *FriendRequestCreate {
+	if s != nil {
+		frc.SetSource(*s)
+	}
+	return frc
+}
+
+// SetSourceMsgId sets the "sourceMsgId" field.
+func (frc *FriendRequestCreate) SetSourceMsgId(s string) *FriendRequestCreate {
+	frc.mutation.SetSourceMsgId(s)
+	return frc
+}
+
+// SetNillableSourceMsgId sets the "sourceMsgId" field if the given value is not nil.
+func (frc *FriendRequestCreate) SetNillableSourceMsgId(s *string) *FriendRequestCreate {
+	if s != nil {
+		frc.SetSourceMsgId(*s)
+	}
+	return frc
+}
+
 // SetCreateTime sets the "createTime" field.
 func (frc *FriendRequestCreate) SetCreateTime(t time.Time) *FriendRequestCreate {
 	frc.mutation.SetCreateTime(t)
@@ -74,6 +102,20 @@ func (frc *FriendRequestCreate) SetNillableCreateTime(t *time.Time) *FriendReque
 	return frc
 }
 
+// SetHandleTime sets the "handleTime" field.
+func (frc *FriendRequestCreate) SetHandleTime(t time.Time) *FriendRequestCreate {
+	frc.mutation.SetHandleTime(t)
+	return frc
+}
+
+// SetNillableHandleTime sets the "handleTime" field if the given value is not nil.
+func (frc *FriendRequestCreate) SetNillableHandleTime(t *time.Time) *FriendRequestCreate {
+	if t != nil {
+		frc.SetHandleTime(*t)
+	}
+	return frc
+}
+
 // Mutation returns the FriendRequestMutation object of the builder.
 func (frc *FriendRequestCreate) Mutation() *FriendRequestMutation {
 	return frc.mutation
@@ -175,10 +217,22 @@ func (frc *FriendRequestCreate) createSpec() (*FriendRequest, *sqlgraph.CreateSp
 		_spec.SetField(friendrequest.FieldStatus, field.TypeInt, value)
 		_node.Status = value
 	}
+	if value, ok := frc.mutation.Source(); ok {
+		_spec.SetField(friendrequest.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := frc.mutation.SourceMsgId(); ok {
+		_spec.SetField(friendrequest.FieldSourceMsgId, field.TypeString, value)
+		_node.SourceMsgId = value
+	}
 	if value, ok := frc.mutation.CreateTime(); ok {
 		_spec.SetField(friendrequest.FieldCreateTime, field.TypeTime, value)
 		_node.CreateTime = value
 	}
+	if value, ok := frc.mutation.HandleTime(); ok {
+		_spec.SetField(friendrequest.FieldHandleTime, field.TypeTime, value)
+		_node.HandleTime = &value
+	}
 	return _node, _spec
 }
 
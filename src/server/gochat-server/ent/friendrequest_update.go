@@ -111,6 +111,46 @@ func (fru *FriendRequestUpdate) AddStatus(i int) *FriendRequestUpdate {
 	return fru
 }
 
+// SetSource sets the "source" field.
+func (fru *FriendRequestUpdate) SetSource(s string) *FriendRequestUpdate {
+	fru.mutation.SetSource(s)
+	return fru
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (fru *FriendRequestUpdate) SetNillableSource(s *string) *FriendRequestUpdate {
+	if s != nil {
+		fru.SetSource(*s)
+	}
+	return fru
+}
+
+// ClearSource clears the value of the "source" field.
+func (fru *FriendRequestUpdate) ClearSource() *FriendRequestUpdate {
+	fru.mutation.ClearSource()
+	return fru
+}
+
+// SetSourceMsgId sets the "sourceMsgId" field.
+func (fru *FriendRequestUpdate) SetSourceMsgId(s string) *FriendRequestUpdate {
+	fru.mutation.SetSourceMsgId(s)
+	return fru
+}
+
+// SetNillableSourceMsgId sets the "sourceMsgId" field if the given value is not nil.
+func (fru *FriendRequestUpdate) SetNillableSourceMsgId(s *string) *FriendRequestUpdate {
+	if s != nil {
+		fru.SetSourceMsgId(*s)
+	}
+	return fru
+}
+
+// ClearSourceMsgId clears the value of the "sourceMsgId" field.
+func (fru *FriendRequestUpdate) ClearSourceMsgId() *FriendRequestUpdate {
+	fru.mutation.ClearSourceMsgId()
+	return fru
+}
+
 // SetCreateTime sets the "createTime" field.
 func (fru *FriendRequestUpdate) SetCreateTime(t time.Time) *FriendRequestUpdate {
 	fru.mutation.SetCreateTime(t)
@@ -125,6 +165,26 @@ func (fru *FriendRequestUpdate) SetNillableCreateTime(t *time.Time) *FriendReque
 	return fru
 }
 
+// SetHandleTime sets the "handleTime" field.
+func (fru *FriendRequestUpdate) SetHandleTime(t time.Time) *FriendRequestUpdate {
+	fru.mutation.SetHandleTime(t)
+	return fru
+}
+
+// SetNillableHandleTime sets the "handleTime" field if the given value is not nil.
+func (fru *FriendRequestUpdate) SetNillableHandleTime(t *time.Time) *FriendRequestUpdate {
+	if t != nil {
+		fru.SetHandleTime(*t)
+	}
+	return fru
+}
+
+// ClearHandleTime clears the value of the "handleTime" field.
+func (fru *FriendRequestUpdate) ClearHandleTime() *FriendRequestUpdate {
+	fru.mutation.ClearHandleTime()
+	return fru
+}
+
 // Mutation returns the FriendRequestMutation object of the builder.
 func (fru *FriendRequestUpdate) Mutation() *FriendRequestMutation {
 	return fru.mutation
@@ -190,9 +250,27 @@ func (fru *FriendRequestUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if value, ok := fru.mutation.AddedStatus(); ok {
 		_spec.AddField(friendrequest.FieldStatus, field.TypeInt, value)
 	}
+	if value, ok := fru.mutation.Source(); ok {
+		_spec.SetField(friendrequest.FieldSource, field.TypeString, value)
+	}
+	if fru.mutation.SourceCleared() {
+		_spec.ClearField(friendrequest.FieldSource, field.TypeString)
+	}
+	if value, ok := fru.mutation.SourceMsgId(); ok {
+		_spec.SetField(friendrequest.FieldSourceMsgId, field.TypeString, value)
+	}
+	if fru.mutation.SourceMsgIdCleared() {
+		_spec.ClearField(friendrequest.FieldSourceMsgId, field.TypeString)
+	}
 	if value, ok := fru.mutation.CreateTime(); ok {
 		_spec.SetField(friendrequest.FieldCreateTime, field.TypeTime, value)
 	}
+	if value, ok := fru.mutation.HandleTime(); ok {
+		_spec.SetField(friendrequest.FieldHandleTime, field.TypeTime, value)
+	}
+	if fru.mutation.HandleTimeCleared() {
+		_spec.ClearField(friendrequest.FieldHandleTime, field.TypeTime)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, fru.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{friendrequest.Label}
@@ -296,6 +374,46 @@ func (fruo *FriendRequestUpdateOne) AddStatus(i int) *FriendRequestUpdateOne {
 	return fruo
 }
 
+// SetSource sets the "source" field.
+func (fruo *FriendRequestUpdateOne) SetSource(s string) *FriendRequestUpdateOne {
+	fruo.mutation.SetSource(s)
+	return fruo
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (fruo *FriendRequestUpdateOne) SetNillableSource(s *string) *FriendRequestUpdateOne {
+	if s != nil {
+		fruo.SetSource(*s)
+	}
+	return fruo
+}
+
+// ClearSource clears the value of the "source" field.
+func (fruo *FriendRequestUpdateOne) ClearSource() *FriendRequestUpdateOne {
+	fruo.mutation.ClearSource()
+	return fruo
+}
+
+// SetSourceMsgId sets the "sourceMsgId" field.
+func (fruo *FriendRequestUpdateOne) SetSourceMsgId(s string) *FriendRequestUpdateOne {
+	fruo.mutation.SetSourceMsgId(s)
+	return fruo
+}
+
+// SetNillableSourceMsgId sets the "sourceMsgId" field if the given value is not nil.
+func (fruo *FriendRequestUpdateOne) SetNillableSourceMsgId(s *string) *FriendRequestUpdateOne {
+	if s != nil {
+		fruo.SetSourceMsgId(*s)
+	}
+	return fruo
+}
+
+// ClearSourceMsgId clears the value of the "sourceMsgId" field.
+func (fruo *FriendRequestUpdateOne) ClearSourceMsgId() *FriendRequestUpdateOne {
+	fruo.mutation.ClearSourceMsgId()
+	return fruo
+}
+
 // SetCreateTime sets the "createTime" field.
 func (fruo *FriendRequestUpdateOne) SetCreateTime(t time.Time) *FriendRequestUpdateOne {
 	fruo.mutation.SetCreateTime(t)
@@ -310,6 +428,26 @@ func (fruo *FriendRequestUpdateOne) SetNillableCreateTime(t *time.Time) *FriendR
 	return fruo
 }
 
+// SetHandleTime sets the "handleTime" field.
+func (fruo *FriendRequestUpdateOne) SetHandleTime(t time.Time) *FriendRequestUpdateOne {
+	fruo.mutation.SetHandleTime(t)
+	return fruo
+}
+
+// SetNillableHandleTime sets the "handleTime" field if the given value is not nil.
+func (fruo *FriendRequestUpdateOne) SetNillableHandleTime(t *time.Time) *FriendRequestUpdateOne {
+	if t != nil {
+		fruo.SetHandleTime(*t)
+	}
+	return fruo
+}
+
+// ClearHandleTime clears the value of the "handleTime" field.
+func (fruo *FriendRequestUpdateOne) ClearHandleTime() *FriendRequestUpdateOne {
+	fruo.mutation.ClearHandleTime()
+	return fruo
+}
+
 // Mutation returns the FriendRequestMutation object of the builder.
 func (fruo *FriendRequestUpdateOne) Mutation() *FriendRequestMutation {
 	return fruo.mutation
@@ -405,9 +543,27 @@ func (fruo *FriendRequestUpdateOne) sqlSave(ctx context.Context) (_node *FriendR
 	if value, ok := fruo.mutation.AddedStatus(); ok {
 		_spec.AddField(friendrequest.FieldStatus, field.TypeInt, value)
 	}
+	if value, ok := fruo.mutation.Source(); ok {
+		_spec.SetField(friendrequest.FieldSource, field.TypeString, value)
+	}
+	if fruo.mutation.SourceCleared() {
+		_spec.ClearField(friendrequest.FieldSource, field.TypeString)
+	}
+	if value, ok := fruo.mutation.SourceMsgId(); ok {
+		_spec.SetField(friendrequest.FieldSourceMsgId, field.TypeString, value)
+	}
+	if fruo.mutation.SourceMsgIdCleared() {
+		_spec.ClearField(friendrequest.FieldSourceMsgId, field.TypeString)
+	}
 	if value, ok := fruo.mutation.CreateTime(); ok {
 		_spec.SetField(friendrequest.FieldCreateTime, field.TypeTime, value)
 	}
+	if value, ok := fruo.mutation.HandleTime(); ok {
+		_spec.SetField(friendrequest.FieldHandleTime, field.TypeTime, value)
+	}
+	if fruo.mutation.HandleTimeCleared() {
+		_spec.ClearField(friendrequest.FieldHandleTime, field.TypeTime)
+	}
 	_node = &FriendRequest{config: fruo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
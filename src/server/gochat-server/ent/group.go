@@ -29,8 +29,12 @@ type Group struct {
 	// 群组创建时间
 	CreateTime time.Time `json:"createTime,omitempty"`
 	// 群组成员ID列表
-	Members      []int `json:"members,omitempty"`
-	selectValues sql.SelectValues
+	Members []int `json:"members,omitempty"`
+	// 是否开启已读回执
+	ReadReceiptsEnabled bool `json:"readReceiptsEnabled"`
+	// 消息保留天数，0表示使用全局设置
+	RetentionDays int `json:"retentionDays"`
+	selectValues  sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -40,7 +44,9 @@ func (*Group) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case group.FieldMembers:
 			values[i] = new([]byte)
-		case group.FieldID, group.FieldOwnerId, group.FieldCreateUserId:
+		case group.FieldReadReceiptsEnabled:
+			values[i] = new(sql.NullBool)
+		case group.FieldID, group.FieldOwnerId, group.FieldCreateUserId, group.FieldRetentionDays:
 			values[i] = new(sql.NullInt64)
 		case group.FieldGroupId, group.FieldGroupName:
 			values[i] = new(sql.NullString)
@@ -105,6 +111,18 @@ func (gr *Group) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field members: %w", err)
 				}
 			}
+		case group.FieldReadReceiptsEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field readReceiptsEnabled", values[i])
+			} else if value.Valid {
+				gr.ReadReceiptsEnabled = value.Bool
+			}
+		case group.FieldRetentionDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field retentionDays", values[i])
+			} else if value.Valid {
+				gr.RetentionDays = int(value.Int64)
+			}
 		default:
 			gr.selectValues.Set(columns[i], values[i])
 		}
@@ -158,6 +176,12 @@ func (gr *Group) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("members=")
 	builder.WriteString(fmt.Sprintf("%v", gr.Members))
+	builder.WriteString(", ")
+	builder.WriteString("readReceiptsEnabled=")
+	builder.WriteString(fmt.Sprintf("%v", gr.ReadReceiptsEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("retentionDays=")
+	builder.WriteString(fmt.Sprintf("%v", gr.RetentionDays))
 	builder.WriteByte(')')
 	return builder.String()
 }
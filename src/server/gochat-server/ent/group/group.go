@@ -25,6 +25,10 @@ const (
 	FieldCreateTime = "create_time"
 	// FieldMembers holds the string denoting the members field in the database.
 	FieldMembers = "members"
+	// FieldReadReceiptsEnabled holds the string denoting the readreceiptsenabled field in the database.
+	FieldReadReceiptsEnabled = "read_receipts_enabled"
+	// FieldRetentionDays holds the string denoting the retentiondays field in the database.
+	FieldRetentionDays = "retention_days"
 	// Table holds the table name of the group in the database.
 	Table = "groups"
 )
@@ -38,6 +42,8 @@ var Columns = []string{
 	FieldCreateUserId,
 	FieldCreateTime,
 	FieldMembers,
+	FieldReadReceiptsEnabled,
+	FieldRetentionDays,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -57,6 +63,10 @@ var (
 	GroupNameValidator func(string) error
 	// DefaultCreateTime holds the default value on creation for the "createTime" field.
 	DefaultCreateTime func() time.Time
+	// DefaultReadReceiptsEnabled holds the default value on creation for the "readReceiptsEnabled" field.
+	DefaultReadReceiptsEnabled bool
+	// DefaultRetentionDays holds the default value on creation for the "retentionDays" field.
+	DefaultRetentionDays int
 )
 
 // OrderOption defines the ordering options for the Group queries.
@@ -91,3 +101,13 @@ func ByCreateUserId(opts ...sql.OrderTermOption) OrderOption {
 func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
 }
+
+// ByReadReceiptsEnabled orders the results by the readReceiptsEnabled field.
+func ByReadReceiptsEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReadReceiptsEnabled, opts...).ToFunc()
+}
+
+// ByRetentionDays orders the results by the retentionDays field.
+func ByRetentionDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRetentionDays, opts...).ToFunc()
+}
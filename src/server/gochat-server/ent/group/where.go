@@ -79,6 +79,16 @@ func CreateTime(v time.Time) predicate.Group {
 	return predicate.Group(sql.FieldEQ(FieldCreateTime, v))
 }
 
+// ReadReceiptsEnabled applies equality check predicate on the "readReceiptsEnabled" field. It's identical to ReadReceiptsEnabledEQ.
+func ReadReceiptsEnabled(v bool) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldReadReceiptsEnabled, v))
+}
+
+// RetentionDays applies equality check predicate on the "retentionDays" field. It's identical to RetentionDaysEQ.
+func RetentionDays(v int) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldRetentionDays, v))
+}
+
 // GroupIdEQ applies the EQ predicate on the "groupId" field.
 func GroupIdEQ(v string) predicate.Group {
 	return predicate.Group(sql.FieldEQ(FieldGroupId, v))
@@ -329,6 +339,56 @@ func CreateTimeLTE(v time.Time) predicate.Group {
 	return predicate.Group(sql.FieldLTE(FieldCreateTime, v))
 }
 
+// ReadReceiptsEnabledEQ applies the EQ predicate on the "readReceiptsEnabled" field.
+func ReadReceiptsEnabledEQ(v bool) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldReadReceiptsEnabled, v))
+}
+
+// ReadReceiptsEnabledNEQ applies the NEQ predicate on the "readReceiptsEnabled" field.
+func ReadReceiptsEnabledNEQ(v bool) predicate.Group {
+	return predicate.Group(sql.FieldNEQ(FieldReadReceiptsEnabled, v))
+}
+
+// RetentionDaysEQ applies the EQ predicate on the "retentionDays" field.
+func RetentionDaysEQ(v int) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldRetentionDays, v))
+}
+
+// RetentionDaysNEQ applies the NEQ predicate on the "retentionDays" field.
+func RetentionDaysNEQ(v int) predicate.Group {
+	return predicate.Group(sql.FieldNEQ(FieldRetentionDays, v))
+}
+
+// RetentionDaysIn applies the In predicate on the "retentionDays" field.
+func RetentionDaysIn(vs ...int) predicate.Group {
+	return predicate.Group(sql.FieldIn(FieldRetentionDays, vs...))
+}
+
+// RetentionDaysNotIn applies the NotIn predicate on the "retentionDays" field.
+func RetentionDaysNotIn(vs ...int) predicate.Group {
+	return predicate.Group(sql.FieldNotIn(FieldRetentionDays, vs...))
+}
+
+// RetentionDaysGT applies the GT predicate on the "retentionDays" field.
+func RetentionDaysGT(v int) predicate.Group {
+	return predicate.Group(sql.FieldGT(FieldRetentionDays, v))
+}
+
+// RetentionDaysGTE applies the GTE predicate on the "retentionDays" field.
+func RetentionDaysGTE(v int) predicate.Group {
+	return predicate.Group(sql.FieldGTE(FieldRetentionDays, v))
+}
+
+// RetentionDaysLT applies the LT predicate on the "retentionDays" field.
+func RetentionDaysLT(v int) predicate.Group {
+	return predicate.Group(sql.FieldLT(FieldRetentionDays, v))
+}
+
+// RetentionDaysLTE applies the LTE predicate on the "retentionDays" field.
+func RetentionDaysLTE(v int) predicate.Group {
+	return predicate.Group(sql.FieldLTE(FieldRetentionDays, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Group) predicate.Group {
 	return predicate.Group(sql.AndPredicates(predicates...))
@@ -64,6 +64,34 @@ func (gc *GroupCreate) SetMembers(i []int) *GroupCreate {
 	return gc
 }
 
+// SetReadReceiptsEnabled sets the "readReceiptsEnabled" field.
+func (gc *GroupCreate) SetReadReceiptsEnabled(b bool) *GroupCreate {
+	gc.mutation.SetReadReceiptsEnabled(b)
+	return gc
+}
+
+// SetNillableReadReceiptsEnabled sets the "readReceiptsEnabled" field if the given value is not nil.
+func (gc *GroupCreate) SetNillableReadReceiptsEnabled(b *bool) *GroupCreate {
+	if b != nil {
+		gc.SetReadReceiptsEnabled(*b)
+	}
+	return gc
+}
+
+// SetRetentionDays sets the "retentionDays" field.
+func (gc *GroupCreate) SetRetentionDays(i int) *GroupCreate {
+	gc.mutation.SetRetentionDays(i)
+	return gc
+}
+
+// SetNillableRetentionDays sets the "retentionDays" field if the given value is not nil.
+func (gc *GroupCreate) SetNillableRetentionDays(i *int) *GroupCreate {
+	if i != nil {
+		gc.SetRetentionDays(*i)
+	}
+	return gc
+}
+
 // Mutation returns the GroupMutation object of the builder.
 func (gc *GroupCreate) Mutation() *GroupMutation {
 	return gc.mutation
@@ -103,6 +131,14 @@ func (gc *GroupCreate) defaults() {
 		v := group.DefaultCreateTime()
 		gc.mutation.SetCreateTime(v)
 	}
+	if _, ok := gc.mutation.ReadReceiptsEnabled(); !ok {
+		v := group.DefaultReadReceiptsEnabled
+		gc.mutation.SetReadReceiptsEnabled(v)
+	}
+	if _, ok := gc.mutation.RetentionDays(); !ok {
+		v := group.DefaultRetentionDays
+		gc.mutation.SetRetentionDays(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -135,6 +171,12 @@ func (gc *GroupCreate) check() error {
 	if _, ok := gc.mutation.Members(); !ok {
 		return &ValidationError{Name: "members", err: errors.New(`ent: missing required field "Group.members"`)}
 	}
+	if _, ok := gc.mutation.ReadReceiptsEnabled(); !ok {
+		return &ValidationError{Name: "readReceiptsEnabled", err: errors.New(`ent: missing required field "Group.readReceiptsEnabled"`)}
+	}
+	if _, ok := gc.mutation.RetentionDays(); !ok {
+		return &ValidationError{Name: "retentionDays", err: errors.New(`ent: missing required field "Group.retentionDays"`)}
+	}
 	return nil
 }
 
@@ -185,6 +227,14 @@ func (gc *GroupCreate) createSpec() (*Group, *sqlgraph.CreateSpec) {
 		_spec.SetField(group.FieldMembers, field.TypeJSON, value)
 		_node.Members = value
 	}
+	if value, ok := gc.mutation.ReadReceiptsEnabled(); ok {
+		_spec.SetField(group.FieldReadReceiptsEnabled, field.TypeBool, value)
+		_node.ReadReceiptsEnabled = value
+	}
+	if value, ok := gc.mutation.RetentionDays(); ok {
+		_spec.SetField(group.FieldRetentionDays, field.TypeInt, value)
+		_node.RetentionDays = value
+	}
 	return _node, _spec
 }
 
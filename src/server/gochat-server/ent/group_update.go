@@ -125,6 +125,41 @@ func (gu *GroupUpdate) AppendMembers(i []int) *GroupUpdate {
 	return gu
 }
 
+// SetReadReceiptsEnabled sets the "readReceiptsEnabled" field.
+func (gu *GroupUpdate) SetReadReceiptsEnabled(b bool) *GroupUpdate {
+	gu.mutation.SetReadReceiptsEnabled(b)
+	return gu
+}
+
+// SetNillableReadReceiptsEnabled sets the "readReceiptsEnabled" field if the given value is not nil.
+func (gu *GroupUpdate) SetNillableReadReceiptsEnabled(b *bool) *GroupUpdate {
+	if b != nil {
+		gu.SetReadReceiptsEnabled(*b)
+	}
+	return gu
+}
+
+// SetRetentionDays sets the "retentionDays" field.
+func (gu *GroupUpdate) SetRetentionDays(i int) *GroupUpdate {
+	gu.mutation.ResetRetentionDays()
+	gu.mutation.SetRetentionDays(i)
+	return gu
+}
+
+// SetNillableRetentionDays sets the "retentionDays" field if the given value is not nil.
+func (gu *GroupUpdate) SetNillableRetentionDays(i *int) *GroupUpdate {
+	if i != nil {
+		gu.SetRetentionDays(*i)
+	}
+	return gu
+}
+
+// AddRetentionDays adds i to the "retentionDays" field.
+func (gu *GroupUpdate) AddRetentionDays(i int) *GroupUpdate {
+	gu.mutation.AddRetentionDays(i)
+	return gu
+}
+
 // Mutation returns the GroupMutation object of the builder.
 func (gu *GroupUpdate) Mutation() *GroupMutation {
 	return gu.mutation
@@ -213,6 +248,15 @@ func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			sqljson.Append(u, group.FieldMembers, value)
 		})
 	}
+	if value, ok := gu.mutation.ReadReceiptsEnabled(); ok {
+		_spec.SetField(group.FieldReadReceiptsEnabled, field.TypeBool, value)
+	}
+	if value, ok := gu.mutation.RetentionDays(); ok {
+		_spec.SetField(group.FieldRetentionDays, field.TypeInt, value)
+	}
+	if value, ok := gu.mutation.AddedRetentionDays(); ok {
+		_spec.AddField(group.FieldRetentionDays, field.TypeInt, value)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, gu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{group.Label}
@@ -329,6 +373,41 @@ func (guo *GroupUpdateOne) AppendMembers(i []int) *GroupUpdateOne {
 	return guo
 }
 
+// SetReadReceiptsEnabled sets the "readReceiptsEnabled" field.
+func (guo *GroupUpdateOne) SetReadReceiptsEnabled(b bool) *GroupUpdateOne {
+	guo.mutation.SetReadReceiptsEnabled(b)
+	return guo
+}
+
+// SetNillableReadReceiptsEnabled sets the "readReceiptsEnabled" field if the given value is not nil.
+func (guo *GroupUpdateOne) SetNillableReadReceiptsEnabled(b *bool) *GroupUpdateOne {
+	if b != nil {
+		guo.SetReadReceiptsEnabled(*b)
+	}
+	return guo
+}
+
+// SetRetentionDays sets the "retentionDays" field.
+func (guo *GroupUpdateOne) SetRetentionDays(i int) *GroupUpdateOne {
+	guo.mutation.ResetRetentionDays()
+	guo.mutation.SetRetentionDays(i)
+	return guo
+}
+
+// SetNillableRetentionDays sets the "retentionDays" field if the given value is not nil.
+func (guo *GroupUpdateOne) SetNillableRetentionDays(i *int) *GroupUpdateOne {
+	if i != nil {
+		guo.SetRetentionDays(*i)
+	}
+	return guo
+}
+
+// AddRetentionDays adds i to the "retentionDays" field.
+func (guo *GroupUpdateOne) AddRetentionDays(i int) *GroupUpdateOne {
+	guo.mutation.AddRetentionDays(i)
+	return guo
+}
+
 // Mutation returns the GroupMutation object of the builder.
 func (guo *GroupUpdateOne) Mutation() *GroupMutation {
 	return guo.mutation
@@ -447,6 +526,15 @@ func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error
 			sqljson.Append(u, group.FieldMembers, value)
 		})
 	}
+	if value, ok := guo.mutation.ReadReceiptsEnabled(); ok {
+		_spec.SetField(group.FieldReadReceiptsEnabled, field.TypeBool, value)
+	}
+	if value, ok := guo.mutation.RetentionDays(); ok {
+		_spec.SetField(group.FieldRetentionDays, field.TypeInt, value)
+	}
+	if value, ok := guo.mutation.AddedRetentionDays(); ok {
+		_spec.AddField(group.FieldRetentionDays, field.TypeInt, value)
+	}
 	_node = &Group{config: guo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,187 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"gochat_server/ent/groupwatermark"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// GroupWatermark is the model entity for the GroupWatermark schema.
+type GroupWatermark struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 群成员ID
+	UserId int `json:"userId,omitempty"`
+	// 群组ID
+	GroupId int `json:"groupId,omitempty"`
+	// 已读水位
+	ReadSeq int `json:"readSeq,omitempty"`
+	// 已送达水位
+	DeliveredSeq int `json:"deliveredSeq,omitempty"`
+	// 加入群聊时的最新消息序号，之前的消息不计入该成员的已读回执
+	JoinSeq int `json:"joinSeq,omitempty"`
+	// 已读水位最近更新时间
+	ReadTime *time.Time `json:"readTime,omitempty"`
+	// 已送达水位最近更新时间
+	DeliveredTime *time.Time `json:"deliveredTime,omitempty"`
+	// 创建时间
+	CreateTime   time.Time `json:"createTime,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GroupWatermark) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case groupwatermark.FieldID, groupwatermark.FieldUserId, groupwatermark.FieldGroupId, groupwatermark.FieldReadSeq, groupwatermark.FieldDeliveredSeq, groupwatermark.FieldJoinSeq:
+			values[i] = new(sql.NullInt64)
+		case groupwatermark.FieldReadTime, groupwatermark.FieldDeliveredTime, groupwatermark.FieldCreateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GroupWatermark fields.
+func (gw *GroupWatermark) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case groupwatermark.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			gw.ID = int(value.Int64)
+		case groupwatermark.FieldUserId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field userId", values[i])
+			} else if value.Valid {
+				gw.UserId = int(value.Int64)
+			}
+		case groupwatermark.FieldGroupId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field groupId", values[i])
+			} else if value.Valid {
+				gw.GroupId = int(value.Int64)
+			}
+		case groupwatermark.FieldReadSeq:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field readSeq", values[i])
+			} else if value.Valid {
+				gw.ReadSeq = int(value.Int64)
+			}
+		case groupwatermark.FieldDeliveredSeq:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deliveredSeq", values[i])
+			} else if value.Valid {
+				gw.DeliveredSeq = int(value.Int64)
+			}
+		case groupwatermark.FieldJoinSeq:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field joinSeq", values[i])
+			} else if value.Valid {
+				gw.JoinSeq = int(value.Int64)
+			}
+		case groupwatermark.FieldReadTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field readTime", values[i])
+			} else if value.Valid {
+				gw.ReadTime = new(time.Time)
+				*gw.ReadTime = value.Time
+			}
+		case groupwatermark.FieldDeliveredTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deliveredTime", values[i])
+			} else if value.Valid {
+				gw.DeliveredTime = new(time.Time)
+				*gw.DeliveredTime = value.Time
+			}
+		case groupwatermark.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field createTime", values[i])
+			} else if value.Valid {
+				gw.CreateTime = value.Time
+			}
+		default:
+			gw.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GroupWatermark.
+// This includes values selected through modifiers, order, etc.
+func (gw *GroupWatermark) Value(name string) (ent.Value, error) {
+	return gw.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this GroupWatermark.
+// Note that you need to call GroupWatermark.Unwrap() before calling this method if this GroupWatermark
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (gw *GroupWatermark) Update() *GroupWatermarkUpdateOne {
+	return NewGroupWatermarkClient(gw.config).UpdateOne(gw)
+}
+
+// Unwrap unwraps the GroupWatermark entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (gw *GroupWatermark) Unwrap() *GroupWatermark {
+	_tx, ok := gw.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GroupWatermark is not a transactional entity")
+	}
+	gw.config.driver = _tx.drv
+	return gw
+}
+
+// String implements the fmt.Stringer.
+func (gw *GroupWatermark) String() string {
+	var builder strings.Builder
+	builder.WriteString("GroupWatermark(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", gw.ID))
+	builder.WriteString("userId=")
+	builder.WriteString(fmt.Sprintf("%v", gw.UserId))
+	builder.WriteString(", ")
+	builder.WriteString("groupId=")
+	builder.WriteString(fmt.Sprintf("%v", gw.GroupId))
+	builder.WriteString(", ")
+	builder.WriteString("readSeq=")
+	builder.WriteString(fmt.Sprintf("%v", gw.ReadSeq))
+	builder.WriteString(", ")
+	builder.WriteString("deliveredSeq=")
+	builder.WriteString(fmt.Sprintf("%v", gw.DeliveredSeq))
+	builder.WriteString(", ")
+	builder.WriteString("joinSeq=")
+	builder.WriteString(fmt.Sprintf("%v", gw.JoinSeq))
+	builder.WriteString(", ")
+	if v := gw.ReadTime; v != nil {
+		builder.WriteString("readTime=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := gw.DeliveredTime; v != nil {
+		builder.WriteString("deliveredTime=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("createTime=")
+	builder.WriteString(gw.CreateTime.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GroupWatermarks is a parsable slice of GroupWatermark.
+type GroupWatermarks []*GroupWatermark
@@ -0,0 +1,116 @@
+// Code generated by ent, DO NOT EDIT.
+
+package groupwatermark
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the groupwatermark type in the database.
+	Label = "group_watermark"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserId holds the string denoting the userid field in the database.
+	FieldUserId = "user_id"
+	// FieldGroupId holds the string denoting the groupid field in the database.
+	FieldGroupId = "group_id"
+	// FieldReadSeq holds the string denoting the readseq field in the database.
+	FieldReadSeq = "read_seq"
+	// FieldDeliveredSeq holds the string denoting the deliveredseq field in the database.
+	FieldDeliveredSeq = "delivered_seq"
+	// FieldJoinSeq holds the string denoting the joinseq field in the database.
+	FieldJoinSeq = "join_seq"
+	// FieldReadTime holds the string denoting the readtime field in the database.
+	FieldReadTime = "read_time"
+	// FieldDeliveredTime holds the string denoting the deliveredtime field in the database.
+	FieldDeliveredTime = "delivered_time"
+	// FieldCreateTime holds the string denoting the createtime field in the database.
+	FieldCreateTime = "create_time"
+	// Table holds the table name of the groupwatermark in the database.
+	Table = "group_watermarks"
+)
+
+// Columns holds all SQL columns for groupwatermark fields.
+var Columns = []string{
+	FieldID,
+	FieldUserId,
+	FieldGroupId,
+	FieldReadSeq,
+	FieldDeliveredSeq,
+	FieldJoinSeq,
+	FieldReadTime,
+	FieldDeliveredTime,
+	FieldCreateTime,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultReadSeq holds the default value on creation for the "readSeq" field.
+	DefaultReadSeq int
+	// DefaultDeliveredSeq holds the default value on creation for the "deliveredSeq" field.
+	DefaultDeliveredSeq int
+	// DefaultJoinSeq holds the default value on creation for the "joinSeq" field.
+	DefaultJoinSeq int
+	// DefaultCreateTime holds the default value on creation for the "createTime" field.
+	DefaultCreateTime func() time.Time
+)
+
+// OrderOption defines the ordering options for the GroupWatermark queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserId orders the results by the userId field.
+func ByUserId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserId, opts...).ToFunc()
+}
+
+// ByGroupId orders the results by the groupId field.
+func ByGroupId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGroupId, opts...).ToFunc()
+}
+
+// ByReadSeq orders the results by the readSeq field.
+func ByReadSeq(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReadSeq, opts...).ToFunc()
+}
+
+// ByDeliveredSeq orders the results by the deliveredSeq field.
+func ByDeliveredSeq(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliveredSeq, opts...).ToFunc()
+}
+
+// ByJoinSeq orders the results by the joinSeq field.
+func ByJoinSeq(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJoinSeq, opts...).ToFunc()
+}
+
+// ByReadTime orders the results by the readTime field.
+func ByReadTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReadTime, opts...).ToFunc()
+}
+
+// ByDeliveredTime orders the results by the deliveredTime field.
+func ByDeliveredTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliveredTime, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the createTime field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
@@ -0,0 +1,450 @@
+// Code generated by ent, DO NOT EDIT.
+
+package groupwatermark
+
+import (
+	"gochat_server/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldLTE(FieldID, id))
+}
+
+// UserId applies equality check predicate on the "userId" field. It's identical to UserIdEQ.
+func UserId(v int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldEQ(FieldUserId, v))
+}
+
+// GroupId applies equality check predicate on the "groupId" field. It's identical to GroupIdEQ.
+func GroupId(v int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldEQ(FieldGroupId, v))
+}
+
+// ReadSeq applies equality check predicate on the "readSeq" field. It's identical to ReadSeqEQ.
+func ReadSeq(v int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldEQ(FieldReadSeq, v))
+}
+
+// DeliveredSeq applies equality check predicate on the "deliveredSeq" field. It's identical to DeliveredSeqEQ.
+func DeliveredSeq(v int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldEQ(FieldDeliveredSeq, v))
+}
+
+// JoinSeq applies equality check predicate on the "joinSeq" field. It's identical to JoinSeqEQ.
+func JoinSeq(v int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldEQ(FieldJoinSeq, v))
+}
+
+// ReadTime applies equality check predicate on the "readTime" field. It's identical to ReadTimeEQ.
+func ReadTime(v time.Time) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldEQ(FieldReadTime, v))
+}
+
+// DeliveredTime applies equality check predicate on the "deliveredTime" field. It's identical to DeliveredTimeEQ.
+func DeliveredTime(v time.Time) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldEQ(FieldDeliveredTime, v))
+}
+
+// CreateTime applies equality check predicate on the "createTime" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UserIdEQ applies the EQ predicate on the "userId" field.
+func UserIdEQ(v int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldEQ(FieldUserId, v))
+}
+
+// UserIdNEQ applies the NEQ predicate on the "userId" field.
+func UserIdNEQ(v int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldNEQ(FieldUserId, v))
+}
+
+// UserIdIn applies the In predicate on the "userId" field.
+func UserIdIn(vs ...int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldIn(FieldUserId, vs...))
+}
+
+// UserIdNotIn applies the NotIn predicate on the "userId" field.
+func UserIdNotIn(vs ...int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldNotIn(FieldUserId, vs...))
+}
+
+// UserIdGT applies the GT predicate on the "userId" field.
+func UserIdGT(v int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldGT(FieldUserId, v))
+}
+
+// UserIdGTE applies the GTE predicate on the "userId" field.
+func UserIdGTE(v int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldGTE(FieldUserId, v))
+}
+
+// UserIdLT applies the LT predicate on the "userId" field.
+func UserIdLT(v int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldLT(FieldUserId, v))
+}
+
+// UserIdLTE applies the LTE predicate on the "userId" field.
+func UserIdLTE(v int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldLTE(FieldUserId, v))
+}
+
+// GroupIdEQ applies the EQ predicate on the "groupId" field.
+func GroupIdEQ(v int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldEQ(FieldGroupId, v))
+}
+
+// GroupIdNEQ applies the NEQ predicate on the "groupId" field.
+func GroupIdNEQ(v int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldNEQ(FieldGroupId, v))
+}
+
+// GroupIdIn applies the In predicate on the "groupId" field.
+func GroupIdIn(vs ...int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldIn(FieldGroupId, vs...))
+}
+
+// GroupIdNotIn applies the NotIn predicate on the "groupId" field.
+func GroupIdNotIn(vs ...int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldNotIn(FieldGroupId, vs...))
+}
+
+// GroupIdGT applies the GT predicate on the "groupId" field.
+func GroupIdGT(v int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldGT(FieldGroupId, v))
+}
+
+// GroupIdGTE applies the GTE predicate on the "groupId" field.
+func GroupIdGTE(v int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldGTE(FieldGroupId, v))
+}
+
+// GroupIdLT applies the LT predicate on the "groupId" field.
+func GroupIdLT(v int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldLT(FieldGroupId, v))
+}
+
+// GroupIdLTE applies the LTE predicate on the "groupId" field.
+func GroupIdLTE(v int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldLTE(FieldGroupId, v))
+}
+
+// ReadSeqEQ applies the EQ predicate on the "readSeq" field.
+func ReadSeqEQ(v int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldEQ(FieldReadSeq, v))
+}
+
+// ReadSeqNEQ applies the NEQ predicate on the "readSeq" field.
+func ReadSeqNEQ(v int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldNEQ(FieldReadSeq, v))
+}
+
+// ReadSeqIn applies the In predicate on the "readSeq" field.
+func ReadSeqIn(vs ...int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldIn(FieldReadSeq, vs...))
+}
+
+// ReadSeqNotIn applies the NotIn predicate on the "readSeq" field.
+func ReadSeqNotIn(vs ...int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldNotIn(FieldReadSeq, vs...))
+}
+
+// ReadSeqGT applies the GT predicate on the "readSeq" field.
+func ReadSeqGT(v int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldGT(FieldReadSeq, v))
+}
+
+// ReadSeqGTE applies the GTE predicate on the "readSeq" field.
+func ReadSeqGTE(v int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldGTE(FieldReadSeq, v))
+}
+
+// ReadSeqLT applies the LT predicate on the "readSeq" field.
+func ReadSeqLT(v int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldLT(FieldReadSeq, v))
+}
+
+// ReadSeqLTE applies the LTE predicate on the "readSeq" field.
+func ReadSeqLTE(v int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldLTE(FieldReadSeq, v))
+}
+
+// DeliveredSeqEQ applies the EQ predicate on the "deliveredSeq" field.
+func DeliveredSeqEQ(v int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldEQ(FieldDeliveredSeq, v))
+}
+
+// DeliveredSeqNEQ applies the NEQ predicate on the "deliveredSeq" field.
+func DeliveredSeqNEQ(v int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldNEQ(FieldDeliveredSeq, v))
+}
+
+// DeliveredSeqIn applies the In predicate on the "deliveredSeq" field.
+func DeliveredSeqIn(vs ...int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldIn(FieldDeliveredSeq, vs...))
+}
+
+// DeliveredSeqNotIn applies the NotIn predicate on the "deliveredSeq" field.
+func DeliveredSeqNotIn(vs ...int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldNotIn(FieldDeliveredSeq, vs...))
+}
+
+// DeliveredSeqGT applies the GT predicate on the "deliveredSeq" field.
+func DeliveredSeqGT(v int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldGT(FieldDeliveredSeq, v))
+}
+
+// DeliveredSeqGTE applies the GTE predicate on the "deliveredSeq" field.
+func DeliveredSeqGTE(v int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldGTE(FieldDeliveredSeq, v))
+}
+
+// DeliveredSeqLT applies the LT predicate on the "deliveredSeq" field.
+func DeliveredSeqLT(v int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldLT(FieldDeliveredSeq, v))
+}
+
+// DeliveredSeqLTE applies the LTE predicate on the "deliveredSeq" field.
+func DeliveredSeqLTE(v int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldLTE(FieldDeliveredSeq, v))
+}
+
+// JoinSeqEQ applies the EQ predicate on the "joinSeq" field.
+func JoinSeqEQ(v int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldEQ(FieldJoinSeq, v))
+}
+
+// JoinSeqNEQ applies the NEQ predicate on the "joinSeq" field.
+func JoinSeqNEQ(v int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldNEQ(FieldJoinSeq, v))
+}
+
+// JoinSeqIn applies the In predicate on the "joinSeq" field.
+func JoinSeqIn(vs ...int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldIn(FieldJoinSeq, vs...))
+}
+
+// JoinSeqNotIn applies the NotIn predicate on the "joinSeq" field.
+func JoinSeqNotIn(vs ...int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldNotIn(FieldJoinSeq, vs...))
+}
+
+// JoinSeqGT applies the GT predicate on the "joinSeq" field.
+func JoinSeqGT(v int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldGT(FieldJoinSeq, v))
+}
+
+// JoinSeqGTE applies the GTE predicate on the "joinSeq" field.
+func JoinSeqGTE(v int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldGTE(FieldJoinSeq, v))
+}
+
+// JoinSeqLT applies the LT predicate on the "joinSeq" field.
+func JoinSeqLT(v int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldLT(FieldJoinSeq, v))
+}
+
+// JoinSeqLTE applies the LTE predicate on the "joinSeq" field.
+func JoinSeqLTE(v int) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldLTE(FieldJoinSeq, v))
+}
+
+// ReadTimeEQ applies the EQ predicate on the "readTime" field.
+func ReadTimeEQ(v time.Time) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldEQ(FieldReadTime, v))
+}
+
+// ReadTimeNEQ applies the NEQ predicate on the "readTime" field.
+func ReadTimeNEQ(v time.Time) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldNEQ(FieldReadTime, v))
+}
+
+// ReadTimeIn applies the In predicate on the "readTime" field.
+func ReadTimeIn(vs ...time.Time) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldIn(FieldReadTime, vs...))
+}
+
+// ReadTimeNotIn applies the NotIn predicate on the "readTime" field.
+func ReadTimeNotIn(vs ...time.Time) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldNotIn(FieldReadTime, vs...))
+}
+
+// ReadTimeGT applies the GT predicate on the "readTime" field.
+func ReadTimeGT(v time.Time) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldGT(FieldReadTime, v))
+}
+
+// ReadTimeGTE applies the GTE predicate on the "readTime" field.
+func ReadTimeGTE(v time.Time) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldGTE(FieldReadTime, v))
+}
+
+// ReadTimeLT applies the LT predicate on the "readTime" field.
+func ReadTimeLT(v time.Time) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldLT(FieldReadTime, v))
+}
+
+// ReadTimeLTE applies the LTE predicate on the "readTime" field.
+func ReadTimeLTE(v time.Time) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldLTE(FieldReadTime, v))
+}
+
+// ReadTimeIsNil applies the IsNil predicate on the "readTime" field.
+func ReadTimeIsNil() predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldIsNull(FieldReadTime))
+}
+
+// ReadTimeNotNil applies the NotNil predicate on the "readTime" field.
+func ReadTimeNotNil() predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldNotNull(FieldReadTime))
+}
+
+// DeliveredTimeEQ applies the EQ predicate on the "deliveredTime" field.
+func DeliveredTimeEQ(v time.Time) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldEQ(FieldDeliveredTime, v))
+}
+
+// DeliveredTimeNEQ applies the NEQ predicate on the "deliveredTime" field.
+func DeliveredTimeNEQ(v time.Time) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldNEQ(FieldDeliveredTime, v))
+}
+
+// DeliveredTimeIn applies the In predicate on the "deliveredTime" field.
+func DeliveredTimeIn(vs ...time.Time) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldIn(FieldDeliveredTime, vs...))
+}
+
+// DeliveredTimeNotIn applies the NotIn predicate on the "deliveredTime" field.
+func DeliveredTimeNotIn(vs ...time.Time) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldNotIn(FieldDeliveredTime, vs...))
+}
+
+// DeliveredTimeGT applies the GT predicate on the "deliveredTime" field.
+func DeliveredTimeGT(v time.Time) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldGT(FieldDeliveredTime, v))
+}
+
+// DeliveredTimeGTE applies the GTE predicate on the "deliveredTime" field.
+func DeliveredTimeGTE(v time.Time) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldGTE(FieldDeliveredTime, v))
+}
+
+// DeliveredTimeLT applies the LT predicate on the "deliveredTime" field.
+func DeliveredTimeLT(v time.Time) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldLT(FieldDeliveredTime, v))
+}
+
+// DeliveredTimeLTE applies the LTE predicate on the "deliveredTime" field.
+func DeliveredTimeLTE(v time.Time) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldLTE(FieldDeliveredTime, v))
+}
+
+// DeliveredTimeIsNil applies the IsNil predicate on the "deliveredTime" field.
+func DeliveredTimeIsNil() predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldIsNull(FieldDeliveredTime))
+}
+
+// DeliveredTimeNotNil applies the NotNil predicate on the "deliveredTime" field.
+func DeliveredTimeNotNil() predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldNotNull(FieldDeliveredTime))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "createTime" field.
+func CreateTimeEQ(v time.Time) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "createTime" field.
+func CreateTimeNEQ(v time.Time) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "createTime" field.
+func CreateTimeIn(vs ...time.Time) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "createTime" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "createTime" field.
+func CreateTimeGT(v time.Time) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "createTime" field.
+func CreateTimeGTE(v time.Time) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "createTime" field.
+func CreateTimeLT(v time.Time) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "createTime" field.
+func CreateTimeLTE(v time.Time) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.GroupWatermark) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.GroupWatermark) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.GroupWatermark) predicate.GroupWatermark {
+	return predicate.GroupWatermark(sql.NotPredicates(p))
+}
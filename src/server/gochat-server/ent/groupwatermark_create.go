@@ -0,0 +1,339 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gochat_server/ent/groupwatermark"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GroupWatermarkCreate is the builder for creating a GroupWatermark entity.
+type GroupWatermarkCreate struct {
+	config
+	mutation *GroupWatermarkMutation
+	hooks    []Hook
+}
+
+// SetUserId sets the "userId" field.
+func (gwc *GroupWatermarkCreate) SetUserId(i int) *GroupWatermarkCreate {
+	gwc.mutation.SetUserId(i)
+	return gwc
+}
+
+// SetGroupId sets the "groupId" field.
+func (gwc *GroupWatermarkCreate) SetGroupId(i int) *GroupWatermarkCreate {
+	gwc.mutation.SetGroupId(i)
+	return gwc
+}
+
+// SetReadSeq sets the "readSeq" field.
+func (gwc *GroupWatermarkCreate) SetReadSeq(i int) *GroupWatermarkCreate {
+	gwc.mutation.SetReadSeq(i)
+	return gwc
+}
+
+// SetNillableReadSeq sets the "readSeq" field if the given value is not nil.
+func (gwc *GroupWatermarkCreate) SetNillableReadSeq(i *int) *GroupWatermarkCreate {
+	if i != nil {
+		gwc.SetReadSeq(*i)
+	}
+	return gwc
+}
+
+// SetDeliveredSeq sets the "deliveredSeq" field.
+func (gwc *GroupWatermarkCreate) SetDeliveredSeq(i int) *GroupWatermarkCreate {
+	gwc.mutation.SetDeliveredSeq(i)
+	return gwc
+}
+
+// SetNillableDeliveredSeq sets the "deliveredSeq" field if the given value is not nil.
+func (gwc *GroupWatermarkCreate) SetNillableDeliveredSeq(i *int) *GroupWatermarkCreate {
+	if i != nil {
+		gwc.SetDeliveredSeq(*i)
+	}
+	return gwc
+}
+
+// SetJoinSeq sets the "joinSeq" field.
+func (gwc *GroupWatermarkCreate) SetJoinSeq(i int) *GroupWatermarkCreate {
+	gwc.mutation.SetJoinSeq(i)
+	return gwc
+}
+
+// SetNillableJoinSeq sets the "joinSeq" field if the given value is not nil.
+func (gwc *GroupWatermarkCreate) SetNillableJoinSeq(i *int) *GroupWatermarkCreate {
+	if i != nil {
+		gwc.SetJoinSeq(*i)
+	}
+	return gwc
+}
+
+// SetReadTime sets the "readTime" field.
+func (gwc *GroupWatermarkCreate) SetReadTime(t time.Time) *GroupWatermarkCreate {
+	gwc.mutation.SetReadTime(t)
+	return gwc
+}
+
+// SetNillableReadTime sets the "readTime" field if the given value is not nil.
+func (gwc *GroupWatermarkCreate) SetNillableReadTime(t *time.Time) *GroupWatermarkCreate {
+	if t != nil {
+		gwc.SetReadTime(*t)
+	}
+	return gwc
+}
+
+// SetDeliveredTime sets the "deliveredTime" field.
+func (gwc *GroupWatermarkCreate) SetDeliveredTime(t time.Time) *GroupWatermarkCreate {
+	gwc.mutation.SetDeliveredTime(t)
+	return gwc
+}
+
+// SetNillableDeliveredTime sets the "deliveredTime" field if the given value is not nil.
+func (gwc *GroupWatermarkCreate) SetNillableDeliveredTime(t *time.Time) *GroupWatermarkCreate {
+	if t != nil {
+		gwc.SetDeliveredTime(*t)
+	}
+	return gwc
+}
+
+// SetCreateTime sets the "createTime" field.
+func (gwc *GroupWatermarkCreate) SetCreateTime(t time.Time) *GroupWatermarkCreate {
+	gwc.mutation.SetCreateTime(t)
+	return gwc
+}
+
+// SetNillableCreateTime sets the "createTime" field if the given value is not nil.
+func (gwc *GroupWatermarkCreate) SetNillableCreateTime(t *time.Time) *GroupWatermarkCreate {
+	if t != nil {
+		gwc.SetCreateTime(*t)
+	}
+	return gwc
+}
+
+// Mutation returns the GroupWatermarkMutation object of the builder.
+func (gwc *GroupWatermarkCreate) Mutation() *GroupWatermarkMutation {
+	return gwc.mutation
+}
+
+// Save creates the GroupWatermark in the database.
+func (gwc *GroupWatermarkCreate) Save(ctx context.Context) (*GroupWatermark, error) {
+	gwc.defaults()
+	return withHooks(ctx, gwc.sqlSave, gwc.mutation, gwc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (gwc *GroupWatermarkCreate) SaveX(ctx context.Context) *GroupWatermark {
+	v, err := gwc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gwc *GroupWatermarkCreate) Exec(ctx context.Context) error {
+	_, err := gwc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gwc *GroupWatermarkCreate) ExecX(ctx context.Context) {
+	if err := gwc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (gwc *GroupWatermarkCreate) defaults() {
+	if _, ok := gwc.mutation.ReadSeq(); !ok {
+		v := groupwatermark.DefaultReadSeq
+		gwc.mutation.SetReadSeq(v)
+	}
+	if _, ok := gwc.mutation.DeliveredSeq(); !ok {
+		v := groupwatermark.DefaultDeliveredSeq
+		gwc.mutation.SetDeliveredSeq(v)
+	}
+	if _, ok := gwc.mutation.JoinSeq(); !ok {
+		v := groupwatermark.DefaultJoinSeq
+		gwc.mutation.SetJoinSeq(v)
+	}
+	if _, ok := gwc.mutation.CreateTime(); !ok {
+		v := groupwatermark.DefaultCreateTime()
+		gwc.mutation.SetCreateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gwc *GroupWatermarkCreate) check() error {
+	if _, ok := gwc.mutation.UserId(); !ok {
+		return &ValidationError{Name: "userId", err: errors.New(`ent: missing required field "GroupWatermark.userId"`)}
+	}
+	if _, ok := gwc.mutation.GroupId(); !ok {
+		return &ValidationError{Name: "groupId", err: errors.New(`ent: missing required field "GroupWatermark.groupId"`)}
+	}
+	if _, ok := gwc.mutation.ReadSeq(); !ok {
+		return &ValidationError{Name: "readSeq", err: errors.New(`ent: missing required field "GroupWatermark.readSeq"`)}
+	}
+	if _, ok := gwc.mutation.DeliveredSeq(); !ok {
+		return &ValidationError{Name: "deliveredSeq", err: errors.New(`ent: missing required field "GroupWatermark.deliveredSeq"`)}
+	}
+	if _, ok := gwc.mutation.JoinSeq(); !ok {
+		return &ValidationError{Name: "joinSeq", err: errors.New(`ent: missing required field "GroupWatermark.joinSeq"`)}
+	}
+	if _, ok := gwc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "createTime", err: errors.New(`ent: missing required field "GroupWatermark.createTime"`)}
+	}
+	return nil
+}
+
+func (gwc *GroupWatermarkCreate) sqlSave(ctx context.Context) (*GroupWatermark, error) {
+	if err := gwc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := gwc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, gwc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	gwc.mutation.id = &_node.ID
+	gwc.mutation.done = true
+	return _node, nil
+}
+
+func (gwc *GroupWatermarkCreate) createSpec() (*GroupWatermark, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GroupWatermark{config: gwc.config}
+		_spec = sqlgraph.NewCreateSpec(groupwatermark.Table, sqlgraph.NewFieldSpec(groupwatermark.FieldID, field.TypeInt))
+	)
+	if value, ok := gwc.mutation.UserId(); ok {
+		_spec.SetField(groupwatermark.FieldUserId, field.TypeInt, value)
+		_node.UserId = value
+	}
+	if value, ok := gwc.mutation.GroupId(); ok {
+		_spec.SetField(groupwatermark.FieldGroupId, field.TypeInt, value)
+		_node.GroupId = value
+	}
+	if value, ok := gwc.mutation.ReadSeq(); ok {
+		_spec.SetField(groupwatermark.FieldReadSeq, field.TypeInt, value)
+		_node.ReadSeq = value
+	}
+	if value, ok := gwc.mutation.DeliveredSeq(); ok {
+		_spec.SetField(groupwatermark.FieldDeliveredSeq, field.TypeInt, value)
+		_node.DeliveredSeq = value
+	}
+	if value, ok := gwc.mutation.JoinSeq(); ok {
+		_spec.SetField(groupwatermark.FieldJoinSeq, field.TypeInt, value)
+		_node.JoinSeq = value
+	}
+	if value, ok := gwc.mutation.ReadTime(); ok {
+		_spec.SetField(groupwatermark.FieldReadTime, field.TypeTime, value)
+		_node.ReadTime = &value
+	}
+	if value, ok := gwc.mutation.DeliveredTime(); ok {
+		_spec.SetField(groupwatermark.FieldDeliveredTime, field.TypeTime, value)
+		_node.DeliveredTime = &value
+	}
+	if value, ok := gwc.mutation.CreateTime(); ok {
+		_spec.SetField(groupwatermark.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	return _node, _spec
+}
+
+// GroupWatermarkCreateBulk is the builder for creating many GroupWatermark entities in bulk.
+type GroupWatermarkCreateBulk struct {
+	config
+	err      error
+	builders []*GroupWatermarkCreate
+}
+
+// Save creates the GroupWatermark entities in the database.
+func (gwcb *GroupWatermarkCreateBulk) Save(ctx context.Context) ([]*GroupWatermark, error) {
+	if gwcb.err != nil {
+		return nil, gwcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(gwcb.builders))
+	nodes := make([]*GroupWatermark, len(gwcb.builders))
+	mutators := make([]Mutator, len(gwcb.builders))
+	for i := range gwcb.builders {
+		func(i int, root context.Context) {
+			builder := gwcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GroupWatermarkMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, gwcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, gwcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, gwcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gwcb *GroupWatermarkCreateBulk) SaveX(ctx context.Context) []*GroupWatermark {
+	v, err := gwcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gwcb *GroupWatermarkCreateBulk) Exec(ctx context.Context) error {
+	_, err := gwcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gwcb *GroupWatermarkCreateBulk) ExecX(ctx context.Context) {
+	if err := gwcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"gochat_server/ent/groupwatermark"
+	"gochat_server/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GroupWatermarkDelete is the builder for deleting a GroupWatermark entity.
+type GroupWatermarkDelete struct {
+	config
+	hooks    []Hook
+	mutation *GroupWatermarkMutation
+}
+
+// Where appends a list predicates to the GroupWatermarkDelete builder.
+func (gwd *GroupWatermarkDelete) Where(ps ...predicate.GroupWatermark) *GroupWatermarkDelete {
+	gwd.mutation.Where(ps...)
+	return gwd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (gwd *GroupWatermarkDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, gwd.sqlExec, gwd.mutation, gwd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gwd *GroupWatermarkDelete) ExecX(ctx context.Context) int {
+	n, err := gwd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (gwd *GroupWatermarkDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(groupwatermark.Table, sqlgraph.NewFieldSpec(groupwatermark.FieldID, field.TypeInt))
+	if ps := gwd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, gwd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	gwd.mutation.done = true
+	return affected, err
+}
+
+// GroupWatermarkDeleteOne is the builder for deleting a single GroupWatermark entity.
+type GroupWatermarkDeleteOne struct {
+	gwd *GroupWatermarkDelete
+}
+
+// Where appends a list predicates to the GroupWatermarkDelete builder.
+func (gwdo *GroupWatermarkDeleteOne) Where(ps ...predicate.GroupWatermark) *GroupWatermarkDeleteOne {
+	gwdo.gwd.mutation.Where(ps...)
+	return gwdo
+}
+
+// Exec executes the deletion query.
+func (gwdo *GroupWatermarkDeleteOne) Exec(ctx context.Context) error {
+	n, err := gwdo.gwd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{groupwatermark.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gwdo *GroupWatermarkDeleteOne) ExecX(ctx context.Context) {
+	if err := gwdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"gochat_server/ent/groupwatermark"
+	"gochat_server/ent/predicate"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GroupWatermarkQuery is the builder for querying GroupWatermark entities.
+type GroupWatermarkQuery struct {
+	config
+	ctx        *QueryContext
+	order      []groupwatermark.OrderOption
+	inters     []Interceptor
+	predicates []predicate.GroupWatermark
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the GroupWatermarkQuery builder.
+func (gwq *GroupWatermarkQuery) Where(ps ...predicate.GroupWatermark) *GroupWatermarkQuery {
+	gwq.predicates = append(gwq.predicates, ps...)
+	return gwq
+}
+
+// Limit the number of records to be returned by this query.
+func (gwq *GroupWatermarkQuery) Limit(limit int) *GroupWatermarkQuery {
+	gwq.ctx.Limit = &limit
+	return gwq
+}
+
+// Offset to start from.
+func (gwq *GroupWatermarkQuery) Offset(offset int) *GroupWatermarkQuery {
+	gwq.ctx.Offset = &offset
+	return gwq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (gwq *GroupWatermarkQuery) Unique(unique bool) *GroupWatermarkQuery {
+	gwq.ctx.Unique = &unique
+	return gwq
+}
+
+// Order specifies how the records should be ordered.
+func (gwq *GroupWatermarkQuery) Order(o ...groupwatermark.OrderOption) *GroupWatermarkQuery {
+	gwq.order = append(gwq.order, o...)
+	return gwq
+}
+
+// First returns the first GroupWatermark entity from the query.
+// Returns a *NotFoundError when no GroupWatermark was found.
+func (gwq *GroupWatermarkQuery) First(ctx context.Context) (*GroupWatermark, error) {
+	nodes, err := gwq.Limit(1).All(setContextOp(ctx, gwq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{groupwatermark.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (gwq *GroupWatermarkQuery) FirstX(ctx context.Context) *GroupWatermark {
+	node, err := gwq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first GroupWatermark ID from the query.
+// Returns a *NotFoundError when no GroupWatermark ID was found.
+func (gwq *GroupWatermarkQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = gwq.Limit(1).IDs(setContextOp(ctx, gwq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{groupwatermark.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (gwq *GroupWatermarkQuery) FirstIDX(ctx context.Context) int {
+	id, err := gwq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single GroupWatermark entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one GroupWatermark entity is found.
+// Returns a *NotFoundError when no GroupWatermark entities are found.
+func (gwq *GroupWatermarkQuery) Only(ctx context.Context) (*GroupWatermark, error) {
+	nodes, err := gwq.Limit(2).All(setContextOp(ctx, gwq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{groupwatermark.Label}
+	default:
+		return nil, &NotSingularError{groupwatermark.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (gwq *GroupWatermarkQuery) OnlyX(ctx context.Context) *GroupWatermark {
+	node, err := gwq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only GroupWatermark ID in the query.
+// Returns a *NotSingularError when more than one GroupWatermark ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (gwq *GroupWatermarkQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = gwq.Limit(2).IDs(setContextOp(ctx, gwq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{groupwatermark.Label}
+	default:
+		err = &NotSingularError{groupwatermark.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (gwq *GroupWatermarkQuery) OnlyIDX(ctx context.Context) int {
+	id, err := gwq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of GroupWatermarks.
+func (gwq *GroupWatermarkQuery) All(ctx context.Context) ([]*GroupWatermark, error) {
+	ctx = setContextOp(ctx, gwq.ctx, ent.OpQueryAll)
+	if err := gwq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*GroupWatermark, *GroupWatermarkQuery]()
+	return withInterceptors[[]*GroupWatermark](ctx, gwq, qr, gwq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (gwq *GroupWatermarkQuery) AllX(ctx context.Context) []*GroupWatermark {
+	nodes, err := gwq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of GroupWatermark IDs.
+func (gwq *GroupWatermarkQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if gwq.ctx.Unique == nil && gwq.path != nil {
+		gwq.Unique(true)
+	}
+	ctx = setContextOp(ctx, gwq.ctx, ent.OpQueryIDs)
+	if err = gwq.Select(groupwatermark.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (gwq *GroupWatermarkQuery) IDsX(ctx context.Context) []int {
+	ids, err := gwq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (gwq *GroupWatermarkQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, gwq.ctx, ent.OpQueryCount)
+	if err := gwq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, gwq, querierCount[*GroupWatermarkQuery](), gwq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (gwq *GroupWatermarkQuery) CountX(ctx context.Context) int {
+	count, err := gwq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (gwq *GroupWatermarkQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, gwq.ctx, ent.OpQueryExist)
+	switch _, err := gwq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (gwq *GroupWatermarkQuery) ExistX(ctx context.Context) bool {
+	exist, err := gwq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the GroupWatermarkQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (gwq *GroupWatermarkQuery) Clone() *GroupWatermarkQuery {
+	if gwq == nil {
+		return nil
+	}
+	return &GroupWatermarkQuery{
+		config:     gwq.config,
+		ctx:        gwq.ctx.Clone(),
+		order:      append([]groupwatermark.OrderOption{}, gwq.order...),
+		inters:     append([]Interceptor{}, gwq.inters...),
+		predicates: append([]predicate.GroupWatermark{}, gwq.predicates...),
+		// clone intermediate query.
+		sql:  gwq.sql.Clone(),
+		path: gwq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserId int `json:"userId,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.GroupWatermark.Query().
+//		GroupBy(groupwatermark.FieldUserId).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (gwq *GroupWatermarkQuery) GroupBy(field string, fields ...string) *GroupWatermarkGroupBy {
+	gwq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &GroupWatermarkGroupBy{build: gwq}
+	grbuild.flds = &gwq.ctx.Fields
+	grbuild.label = groupwatermark.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserId int `json:"userId,omitempty"`
+//	}
+//
+//	client.GroupWatermark.Query().
+//		Select(groupwatermark.FieldUserId).
+//		Scan(ctx, &v)
+func (gwq *GroupWatermarkQuery) Select(fields ...string) *GroupWatermarkSelect {
+	gwq.ctx.Fields = append(gwq.ctx.Fields, fields...)
+	sbuild := &GroupWatermarkSelect{GroupWatermarkQuery: gwq}
+	sbuild.label = groupwatermark.Label
+	sbuild.flds, sbuild.scan = &gwq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a GroupWatermarkSelect configured with the given aggregations.
+func (gwq *GroupWatermarkQuery) Aggregate(fns ...AggregateFunc) *GroupWatermarkSelect {
+	return gwq.Select().Aggregate(fns...)
+}
+
+func (gwq *GroupWatermarkQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range gwq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, gwq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range gwq.ctx.Fields {
+		if !groupwatermark.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if gwq.path != nil {
+		prev, err := gwq.path(ctx)
+		if err != nil {
+			return err
+		}
+		gwq.sql = prev
+	}
+	return nil
+}
+
+func (gwq *GroupWatermarkQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*GroupWatermark, error) {
+	var (
+		nodes = []*GroupWatermark{}
+		_spec = gwq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*GroupWatermark).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &GroupWatermark{config: gwq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, gwq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (gwq *GroupWatermarkQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := gwq.querySpec()
+	_spec.Node.Columns = gwq.ctx.Fields
+	if len(gwq.ctx.Fields) > 0 {
+		_spec.Unique = gwq.ctx.Unique != nil && *gwq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, gwq.driver, _spec)
+}
+
+func (gwq *GroupWatermarkQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(groupwatermark.Table, groupwatermark.Columns, sqlgraph.NewFieldSpec(groupwatermark.FieldID, field.TypeInt))
+	_spec.From = gwq.sql
+	if unique := gwq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if gwq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := gwq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, groupwatermark.FieldID)
+		for i := range fields {
+			if fields[i] != groupwatermark.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := gwq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := gwq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := gwq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := gwq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (gwq *GroupWatermarkQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(gwq.driver.Dialect())
+	t1 := builder.Table(groupwatermark.Table)
+	columns := gwq.ctx.Fields
+	if len(columns) == 0 {
+		columns = groupwatermark.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if gwq.sql != nil {
+		selector = gwq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if gwq.ctx.Unique != nil && *gwq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range gwq.predicates {
+		p(selector)
+	}
+	for _, p := range gwq.order {
+		p(selector)
+	}
+	if offset := gwq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := gwq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// GroupWatermarkGroupBy is the group-by builder for GroupWatermark entities.
+type GroupWatermarkGroupBy struct {
+	selector
+	build *GroupWatermarkQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (gwgb *GroupWatermarkGroupBy) Aggregate(fns ...AggregateFunc) *GroupWatermarkGroupBy {
+	gwgb.fns = append(gwgb.fns, fns...)
+	return gwgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (gwgb *GroupWatermarkGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, gwgb.build.ctx, ent.OpQueryGroupBy)
+	if err := gwgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*GroupWatermarkQuery, *GroupWatermarkGroupBy](ctx, gwgb.build, gwgb, gwgb.build.inters, v)
+}
+
+func (gwgb *GroupWatermarkGroupBy) sqlScan(ctx context.Context, root *GroupWatermarkQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(gwgb.fns))
+	for _, fn := range gwgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*gwgb.flds)+len(gwgb.fns))
+		for _, f := range *gwgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*gwgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := gwgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// GroupWatermarkSelect is the builder for selecting fields of GroupWatermark entities.
+type GroupWatermarkSelect struct {
+	*GroupWatermarkQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (gws *GroupWatermarkSelect) Aggregate(fns ...AggregateFunc) *GroupWatermarkSelect {
+	gws.fns = append(gws.fns, fns...)
+	return gws
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (gws *GroupWatermarkSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, gws.ctx, ent.OpQuerySelect)
+	if err := gws.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*GroupWatermarkQuery, *GroupWatermarkSelect](ctx, gws.GroupWatermarkQuery, gws, gws.inters, v)
+}
+
+func (gws *GroupWatermarkSelect) sqlScan(ctx context.Context, root *GroupWatermarkQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(gws.fns))
+	for _, fn := range gws.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*gws.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := gws.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
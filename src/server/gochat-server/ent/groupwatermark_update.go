@@ -0,0 +1,584 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gochat_server/ent/groupwatermark"
+	"gochat_server/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GroupWatermarkUpdate is the builder for updating GroupWatermark entities.
+type GroupWatermarkUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GroupWatermarkMutation
+}
+
+// Where appends a list predicates to the GroupWatermarkUpdate builder.
+func (gwu *GroupWatermarkUpdate) Where(ps ...predicate.GroupWatermark) *GroupWatermarkUpdate {
+	gwu.mutation.Where(ps...)
+	return gwu
+}
+
+// SetUserId sets the "userId" field.
+func (gwu *GroupWatermarkUpdate) SetUserId(i int) *GroupWatermarkUpdate {
+	gwu.mutation.ResetUserId()
+	gwu.mutation.SetUserId(i)
+	return gwu
+}
+
+// SetNillableUserId sets the "userId" field if the given value is not nil.
+func (gwu *GroupWatermarkUpdate) SetNillableUserId(i *int) *GroupWatermarkUpdate {
+	if i != nil {
+		gwu.SetUserId(*i)
+	}
+	return gwu
+}
+
+// AddUserId adds i to the "userId" field.
+func (gwu *GroupWatermarkUpdate) AddUserId(i int) *GroupWatermarkUpdate {
+	gwu.mutation.AddUserId(i)
+	return gwu
+}
+
+// SetGroupId sets the "groupId" field.
+func (gwu *GroupWatermarkUpdate) SetGroupId(i int) *GroupWatermarkUpdate {
+	gwu.mutation.ResetGroupId()
+	gwu.mutation.SetGroupId(i)
+	return gwu
+}
+
+// SetNillableGroupId sets the "groupId" field if the given value is not nil.
+func (gwu *GroupWatermarkUpdate) SetNillableGroupId(i *int) *GroupWatermarkUpdate {
+	if i != nil {
+		gwu.SetGroupId(*i)
+	}
+	return gwu
+}
+
+// AddGroupId adds i to the "groupId" field.
+func (gwu *GroupWatermarkUpdate) AddGroupId(i int) *GroupWatermarkUpdate {
+	gwu.mutation.AddGroupId(i)
+	return gwu
+}
+
+// SetReadSeq sets the "readSeq" field.
+func (gwu *GroupWatermarkUpdate) SetReadSeq(i int) *GroupWatermarkUpdate {
+	gwu.mutation.ResetReadSeq()
+	gwu.mutation.SetReadSeq(i)
+	return gwu
+}
+
+// SetNillableReadSeq sets the "readSeq" field if the given value is not nil.
+func (gwu *GroupWatermarkUpdate) SetNillableReadSeq(i *int) *GroupWatermarkUpdate {
+	if i != nil {
+		gwu.SetReadSeq(*i)
+	}
+	return gwu
+}
+
+// AddReadSeq adds i to the "readSeq" field.
+func (gwu *GroupWatermarkUpdate) AddReadSeq(i int) *GroupWatermarkUpdate {
+	gwu.mutation.AddReadSeq(i)
+	return gwu
+}
+
+// SetDeliveredSeq sets the "deliveredSeq" field.
+func (gwu *GroupWatermarkUpdate) SetDeliveredSeq(i int) *GroupWatermarkUpdate {
+	gwu.mutation.ResetDeliveredSeq()
+	gwu.mutation.SetDeliveredSeq(i)
+	return gwu
+}
+
+// SetNillableDeliveredSeq sets the "deliveredSeq" field if the given value is not nil.
+func (gwu *GroupWatermarkUpdate) SetNillableDeliveredSeq(i *int) *GroupWatermarkUpdate {
+	if i != nil {
+		gwu.SetDeliveredSeq(*i)
+	}
+	return gwu
+}
+
+// AddDeliveredSeq adds i to the "deliveredSeq" field.
+func (gwu *GroupWatermarkUpdate) AddDeliveredSeq(i int) *GroupWatermarkUpdate {
+	gwu.mutation.AddDeliveredSeq(i)
+	return gwu
+}
+
+// SetJoinSeq sets the "joinSeq" field.
+func (gwu *GroupWatermarkUpdate) SetJoinSeq(i int) *GroupWatermarkUpdate {
+	gwu.mutation.ResetJoinSeq()
+	gwu.mutation.SetJoinSeq(i)
+	return gwu
+}
+
+// SetNillableJoinSeq sets the "joinSeq" field if the given value is not nil.
+func (gwu *GroupWatermarkUpdate) SetNillableJoinSeq(i *int) *GroupWatermarkUpdate {
+	if i != nil {
+		gwu.SetJoinSeq(*i)
+	}
+	return gwu
+}
+
+// AddJoinSeq adds i to the "joinSeq" field.
+func (gwu *GroupWatermarkUpdate) AddJoinSeq(i int) *GroupWatermarkUpdate {
+	gwu.mutation.AddJoinSeq(i)
+	return gwu
+}
+
+// SetReadTime sets the "readTime" field.
+func (gwu *GroupWatermarkUpdate) SetReadTime(t time.Time) *GroupWatermarkUpdate {
+	gwu.mutation.SetReadTime(t)
+	return gwu
+}
+
+// SetNillableReadTime sets the "readTime" field if the given value is not nil.
+func (gwu *GroupWatermarkUpdate) SetNillableReadTime(t *time.Time) *GroupWatermarkUpdate {
+	if t != nil {
+		gwu.SetReadTime(*t)
+	}
+	return gwu
+}
+
+// ClearReadTime clears the value of the "readTime" field.
+func (gwu *GroupWatermarkUpdate) ClearReadTime() *GroupWatermarkUpdate {
+	gwu.mutation.ClearReadTime()
+	return gwu
+}
+
+// SetDeliveredTime sets the "deliveredTime" field.
+func (gwu *GroupWatermarkUpdate) SetDeliveredTime(t time.Time) *GroupWatermarkUpdate {
+	gwu.mutation.SetDeliveredTime(t)
+	return gwu
+}
+
+// SetNillableDeliveredTime sets the "deliveredTime" field if the given value is not nil.
+func (gwu *GroupWatermarkUpdate) SetNillableDeliveredTime(t *time.Time) *GroupWatermarkUpdate {
+	if t != nil {
+		gwu.SetDeliveredTime(*t)
+	}
+	return gwu
+}
+
+// ClearDeliveredTime clears the value of the "deliveredTime" field.
+func (gwu *GroupWatermarkUpdate) ClearDeliveredTime() *GroupWatermarkUpdate {
+	gwu.mutation.ClearDeliveredTime()
+	return gwu
+}
+
+// SetCreateTime sets the "createTime" field.
+func (gwu *GroupWatermarkUpdate) SetCreateTime(t time.Time) *GroupWatermarkUpdate {
+	gwu.mutation.SetCreateTime(t)
+	return gwu
+}
+
+// SetNillableCreateTime sets the "createTime" field if the given value is not nil.
+func (gwu *GroupWatermarkUpdate) SetNillableCreateTime(t *time.Time) *GroupWatermarkUpdate {
+	if t != nil {
+		gwu.SetCreateTime(*t)
+	}
+	return gwu
+}
+
+// Mutation returns the GroupWatermarkMutation object of the builder.
+func (gwu *GroupWatermarkUpdate) Mutation() *GroupWatermarkMutation {
+	return gwu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (gwu *GroupWatermarkUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, gwu.sqlSave, gwu.mutation, gwu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gwu *GroupWatermarkUpdate) SaveX(ctx context.Context) int {
+	affected, err := gwu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (gwu *GroupWatermarkUpdate) Exec(ctx context.Context) error {
+	_, err := gwu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gwu *GroupWatermarkUpdate) ExecX(ctx context.Context) {
+	if err := gwu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (gwu *GroupWatermarkUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(groupwatermark.Table, groupwatermark.Columns, sqlgraph.NewFieldSpec(groupwatermark.FieldID, field.TypeInt))
+	if ps := gwu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gwu.mutation.UserId(); ok {
+		_spec.SetField(groupwatermark.FieldUserId, field.TypeInt, value)
+	}
+	if value, ok := gwu.mutation.AddedUserId(); ok {
+		_spec.AddField(groupwatermark.FieldUserId, field.TypeInt, value)
+	}
+	if value, ok := gwu.mutation.GroupId(); ok {
+		_spec.SetField(groupwatermark.FieldGroupId, field.TypeInt, value)
+	}
+	if value, ok := gwu.mutation.AddedGroupId(); ok {
+		_spec.AddField(groupwatermark.FieldGroupId, field.TypeInt, value)
+	}
+	if value, ok := gwu.mutation.ReadSeq(); ok {
+		_spec.SetField(groupwatermark.FieldReadSeq, field.TypeInt, value)
+	}
+	if value, ok := gwu.mutation.AddedReadSeq(); ok {
+		_spec.AddField(groupwatermark.FieldReadSeq, field.TypeInt, value)
+	}
+	if value, ok := gwu.mutation.DeliveredSeq(); ok {
+		_spec.SetField(groupwatermark.FieldDeliveredSeq, field.TypeInt, value)
+	}
+	if value, ok := gwu.mutation.AddedDeliveredSeq(); ok {
+		_spec.AddField(groupwatermark.FieldDeliveredSeq, field.TypeInt, value)
+	}
+	if value, ok := gwu.mutation.JoinSeq(); ok {
+		_spec.SetField(groupwatermark.FieldJoinSeq, field.TypeInt, value)
+	}
+	if value, ok := gwu.mutation.AddedJoinSeq(); ok {
+		_spec.AddField(groupwatermark.FieldJoinSeq, field.TypeInt, value)
+	}
+	if value, ok := gwu.mutation.ReadTime(); ok {
+		_spec.SetField(groupwatermark.FieldReadTime, field.TypeTime, value)
+	}
+	if gwu.mutation.ReadTimeCleared() {
+		_spec.ClearField(groupwatermark.FieldReadTime, field.TypeTime)
+	}
+	if value, ok := gwu.mutation.DeliveredTime(); ok {
+		_spec.SetField(groupwatermark.FieldDeliveredTime, field.TypeTime, value)
+	}
+	if gwu.mutation.DeliveredTimeCleared() {
+		_spec.ClearField(groupwatermark.FieldDeliveredTime, field.TypeTime)
+	}
+	if value, ok := gwu.mutation.CreateTime(); ok {
+		_spec.SetField(groupwatermark.FieldCreateTime, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, gwu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{groupwatermark.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	gwu.mutation.done = true
+	return n, nil
+}
+
+// GroupWatermarkUpdateOne is the builder for updating a single GroupWatermark entity.
+type GroupWatermarkUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GroupWatermarkMutation
+}
+
+// SetUserId sets the "userId" field.
+func (gwuo *GroupWatermarkUpdateOne) SetUserId(i int) *GroupWatermarkUpdateOne {
+	gwuo.mutation.ResetUserId()
+	gwuo.mutation.SetUserId(i)
+	return gwuo
+}
+
+// SetNillableUserId sets the "userId" field if the given value is not nil.
+func (gwuo *GroupWatermarkUpdateOne) SetNillableUserId(i *int) *GroupWatermarkUpdateOne {
+	if i != nil {
+		gwuo.SetUserId(*i)
+	}
+	return gwuo
+}
+
+// AddUserId adds i to the "userId" field.
+func (gwuo *GroupWatermarkUpdateOne) AddUserId(i int) *GroupWatermarkUpdateOne {
+	gwuo.mutation.AddUserId(i)
+	return gwuo
+}
+
+// SetGroupId sets the "groupId" field.
+func (gwuo *GroupWatermarkUpdateOne) SetGroupId(i int) *GroupWatermarkUpdateOne {
+	gwuo.mutation.ResetGroupId()
+	gwuo.mutation.SetGroupId(i)
+	return gwuo
+}
+
+// SetNillableGroupId sets the "groupId" field if the given value is not nil.
+func (gwuo *GroupWatermarkUpdateOne) SetNillableGroupId(i *int) *GroupWatermarkUpdateOne {
+	if i != nil {
+		gwuo.SetGroupId(*i)
+	}
+	return gwuo
+}
+
+// AddGroupId adds i to the "groupId" field.
+func (gwuo *GroupWatermarkUpdateOne) AddGroupId(i int) *GroupWatermarkUpdateOne {
+	gwuo.mutation.AddGroupId(i)
+	return gwuo
+}
+
+// SetReadSeq sets the "readSeq" field.
+func (gwuo *GroupWatermarkUpdateOne) SetReadSeq(i int) *GroupWatermarkUpdateOne {
+	gwuo.mutation.ResetReadSeq()
+	gwuo.mutation.SetReadSeq(i)
+	return gwuo
+}
+
+// SetNillableReadSeq sets the "readSeq" field if the given value is not nil.
+func (gwuo *GroupWatermarkUpdateOne) SetNillableReadSeq(i *int) *GroupWatermarkUpdateOne {
+	if i != nil {
+		gwuo.SetReadSeq(*i)
+	}
+	return gwuo
+}
+
+// AddReadSeq adds i to the "readSeq" field.
+func (gwuo *GroupWatermarkUpdateOne) AddReadSeq(i int) *GroupWatermarkUpdateOne {
+	gwuo.mutation.AddReadSeq(i)
+	return gwuo
+}
+
+// SetDeliveredSeq sets the "deliveredSeq" field.
+func (gwuo *GroupWatermarkUpdateOne) SetDeliveredSeq(i int) *GroupWatermarkUpdateOne {
+	gwuo.mutation.ResetDeliveredSeq()
+	gwuo.mutation.SetDeliveredSeq(i)
+	return gwuo
+}
+
+// SetNillableDeliveredSeq sets the "deliveredSeq" field if the given value is not nil.
+func (gwuo *GroupWatermarkUpdateOne) SetNillableDeliveredSeq(i *int) *GroupWatermarkUpdateOne {
+	if i != nil {
+		gwuo.SetDeliveredSeq(*i)
+	}
+	return gwuo
+}
+
+// AddDeliveredSeq adds i to the "deliveredSeq" field.
+func (gwuo *GroupWatermarkUpdateOne) AddDeliveredSeq(i int) *GroupWatermarkUpdateOne {
+	gwuo.mutation.AddDeliveredSeq(i)
+	return gwuo
+}
+
+// SetJoinSeq sets the "joinSeq" field.
+func (gwuo *GroupWatermarkUpdateOne) SetJoinSeq(i int) *GroupWatermarkUpdateOne {
+	gwuo.mutation.ResetJoinSeq()
+	gwuo.mutation.SetJoinSeq(i)
+	return gwuo
+}
+
+// SetNillableJoinSeq sets the "joinSeq" field if the given value is not nil.
+func (gwuo *GroupWatermarkUpdateOne) SetNillableJoinSeq(i *int) *GroupWatermarkUpdateOne {
+	if i != nil {
+		gwuo.SetJoinSeq(*i)
+	}
+	return gwuo
+}
+
+// AddJoinSeq adds i to the "joinSeq" field.
+func (gwuo *GroupWatermarkUpdateOne) AddJoinSeq(i int) *GroupWatermarkUpdateOne {
+	gwuo.mutation.AddJoinSeq(i)
+	return gwuo
+}
+
+// SetReadTime sets the "readTime" field.
+func (gwuo *GroupWatermarkUpdateOne) SetReadTime(t time.Time) *GroupWatermarkUpdateOne {
+	gwuo.mutation.SetReadTime(t)
+	return gwuo
+}
+
+// SetNillableReadTime sets the "readTime" field if the given value is not nil.
+func (gwuo *GroupWatermarkUpdateOne) SetNillableReadTime(t *time.Time) *GroupWatermarkUpdateOne {
+	if t != nil {
+		gwuo.SetReadTime(*t)
+	}
+	return gwuo
+}
+
+// ClearReadTime clears the value of the "readTime" field.
+func (gwuo *GroupWatermarkUpdateOne) ClearReadTime() *GroupWatermarkUpdateOne {
+	gwuo.mutation.ClearReadTime()
+	return gwuo
+}
+
+// SetDeliveredTime sets the "deliveredTime" field.
+func (gwuo *GroupWatermarkUpdateOne) SetDeliveredTime(t time.Time) *GroupWatermarkUpdateOne {
+	gwuo.mutation.SetDeliveredTime(t)
+	return gwuo
+}
+
+// SetNillableDeliveredTime sets the "deliveredTime" field if the given value is not nil.
+func (gwuo *GroupWatermarkUpdateOne) SetNillableDeliveredTime(t *time.Time) *GroupWatermarkUpdateOne {
+	if t != nil {
+		gwuo.SetDeliveredTime(*t)
+	}
+	return gwuo
+}
+
+// ClearDeliveredTime clears the value of the "deliveredTime" field.
+func (gwuo *GroupWatermarkUpdateOne) ClearDeliveredTime() *GroupWatermarkUpdateOne {
+	gwuo.mutation.ClearDeliveredTime()
+	return gwuo
+}
+
+// SetCreateTime sets the "createTime" field.
+func (gwuo *GroupWatermarkUpdateOne) SetCreateTime(t time.Time) *GroupWatermarkUpdateOne {
+	gwuo.mutation.SetCreateTime(t)
+	return gwuo
+}
+
+// SetNillableCreateTime sets the "createTime" field if the given value is not nil.
+func (gwuo *GroupWatermarkUpdateOne) SetNillableCreateTime(t *time.Time) *GroupWatermarkUpdateOne {
+	if t != nil {
+		gwuo.SetCreateTime(*t)
+	}
+	return gwuo
+}
+
+// Mutation returns the GroupWatermarkMutation object of the builder.
+func (gwuo *GroupWatermarkUpdateOne) Mutation() *GroupWatermarkMutation {
+	return gwuo.mutation
+}
+
+// Where appends a list predicates to the GroupWatermarkUpdate builder.
+func (gwuo *GroupWatermarkUpdateOne) Where(ps ...predicate.GroupWatermark) *GroupWatermarkUpdateOne {
+	gwuo.mutation.Where(ps...)
+	return gwuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (gwuo *GroupWatermarkUpdateOne) Select(field string, fields ...string) *GroupWatermarkUpdateOne {
+	gwuo.fields = append([]string{field}, fields...)
+	return gwuo
+}
+
+// Save executes the query and returns the updated GroupWatermark entity.
+func (gwuo *GroupWatermarkUpdateOne) Save(ctx context.Context) (*GroupWatermark, error) {
+	return withHooks(ctx, gwuo.sqlSave, gwuo.mutation, gwuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gwuo *GroupWatermarkUpdateOne) SaveX(ctx context.Context) *GroupWatermark {
+	node, err := gwuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (gwuo *GroupWatermarkUpdateOne) Exec(ctx context.Context) error {
+	_, err := gwuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gwuo *GroupWatermarkUpdateOne) ExecX(ctx context.Context) {
+	if err := gwuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (gwuo *GroupWatermarkUpdateOne) sqlSave(ctx context.Context) (_node *GroupWatermark, err error) {
+	_spec := sqlgraph.NewUpdateSpec(groupwatermark.Table, groupwatermark.Columns, sqlgraph.NewFieldSpec(groupwatermark.FieldID, field.TypeInt))
+	id, ok := gwuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GroupWatermark.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := gwuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, groupwatermark.FieldID)
+		for _, f := range fields {
+			if !groupwatermark.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != groupwatermark.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := gwuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gwuo.mutation.UserId(); ok {
+		_spec.SetField(groupwatermark.FieldUserId, field.TypeInt, value)
+	}
+	if value, ok := gwuo.mutation.AddedUserId(); ok {
+		_spec.AddField(groupwatermark.FieldUserId, field.TypeInt, value)
+	}
+	if value, ok := gwuo.mutation.GroupId(); ok {
+		_spec.SetField(groupwatermark.FieldGroupId, field.TypeInt, value)
+	}
+	if value, ok := gwuo.mutation.AddedGroupId(); ok {
+		_spec.AddField(groupwatermark.FieldGroupId, field.TypeInt, value)
+	}
+	if value, ok := gwuo.mutation.ReadSeq(); ok {
+		_spec.SetField(groupwatermark.FieldReadSeq, field.TypeInt, value)
+	}
+	if value, ok := gwuo.mutation.AddedReadSeq(); ok {
+		_spec.AddField(groupwatermark.FieldReadSeq, field.TypeInt, value)
+	}
+	if value, ok := gwuo.mutation.DeliveredSeq(); ok {
+		_spec.SetField(groupwatermark.FieldDeliveredSeq, field.TypeInt, value)
+	}
+	if value, ok := gwuo.mutation.AddedDeliveredSeq(); ok {
+		_spec.AddField(groupwatermark.FieldDeliveredSeq, field.TypeInt, value)
+	}
+	if value, ok := gwuo.mutation.JoinSeq(); ok {
+		_spec.SetField(groupwatermark.FieldJoinSeq, field.TypeInt, value)
+	}
+	if value, ok := gwuo.mutation.AddedJoinSeq(); ok {
+		_spec.AddField(groupwatermark.FieldJoinSeq, field.TypeInt, value)
+	}
+	if value, ok := gwuo.mutation.ReadTime(); ok {
+		_spec.SetField(groupwatermark.FieldReadTime, field.TypeTime, value)
+	}
+	if gwuo.mutation.ReadTimeCleared() {
+		_spec.ClearField(groupwatermark.FieldReadTime, field.TypeTime)
+	}
+	if value, ok := gwuo.mutation.DeliveredTime(); ok {
+		_spec.SetField(groupwatermark.FieldDeliveredTime, field.TypeTime, value)
+	}
+	if gwuo.mutation.DeliveredTimeCleared() {
+		_spec.ClearField(groupwatermark.FieldDeliveredTime, field.TypeTime)
+	}
+	if value, ok := gwuo.mutation.CreateTime(); ok {
+		_spec.SetField(groupwatermark.FieldCreateTime, field.TypeTime, value)
+	}
+	_node = &GroupWatermark{config: gwuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, gwuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{groupwatermark.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	gwuo.mutation.done = true
+	return _node, nil
+}
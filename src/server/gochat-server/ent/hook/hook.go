@@ -8,6 +8,18 @@ import (
 	"gochat_server/ent"
 )
 
+// The BlockFunc type is an adapter to allow the use of ordinary
+// function as Block mutator.
+type BlockFunc func(context.Context, *ent.BlockMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BlockFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BlockMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BlockMutation", m)
+}
+
 // The ChatRecordFunc type is an adapter to allow the use of ordinary
 // function as ChatRecord mutator.
 type ChatRecordFunc func(context.Context, *ent.ChatRecordMutation) (ent.Value, error)
@@ -20,6 +32,54 @@ func (f ChatRecordFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ChatRecordMutation", m)
 }
 
+// The ContactCardMessageFunc type is an adapter to allow the use of ordinary
+// function as ContactCardMessage mutator.
+type ContactCardMessageFunc func(context.Context, *ent.ContactCardMessageMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ContactCardMessageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ContactCardMessageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ContactCardMessageMutation", m)
+}
+
+// The ConversationClearFunc type is an adapter to allow the use of ordinary
+// function as ConversationClear mutator.
+type ConversationClearFunc func(context.Context, *ent.ConversationClearMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ConversationClearFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ConversationClearMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ConversationClearMutation", m)
+}
+
+// The ConversationTimerFunc type is an adapter to allow the use of ordinary
+// function as ConversationTimer mutator.
+type ConversationTimerFunc func(context.Context, *ent.ConversationTimerMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ConversationTimerFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ConversationTimerMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ConversationTimerMutation", m)
+}
+
+// The DataMigrationFunc type is an adapter to allow the use of ordinary
+// function as DataMigration mutator.
+type DataMigrationFunc func(context.Context, *ent.DataMigrationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DataMigrationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DataMigrationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DataMigrationMutation", m)
+}
+
 // The DoNotDisturbFunc type is an adapter to allow the use of ordinary
 // function as DoNotDisturb mutator.
 type DoNotDisturbFunc func(context.Context, *ent.DoNotDisturbMutation) (ent.Value, error)
@@ -32,6 +92,30 @@ func (f DoNotDisturbFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DoNotDisturbMutation", m)
 }
 
+// The ExportJobFunc type is an adapter to allow the use of ordinary
+// function as ExportJob mutator.
+type ExportJobFunc func(context.Context, *ent.ExportJobMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ExportJobFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ExportJobMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ExportJobMutation", m)
+}
+
+// The FileMessageFunc type is an adapter to allow the use of ordinary
+// function as FileMessage mutator.
+type FileMessageFunc func(context.Context, *ent.FileMessageMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FileMessageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.FileMessageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FileMessageMutation", m)
+}
+
 // The FriendRelationshipFunc type is an adapter to allow the use of ordinary
 // function as FriendRelationship mutator.
 type FriendRelationshipFunc func(context.Context, *ent.FriendRelationshipMutation) (ent.Value, error)
@@ -80,6 +164,18 @@ func (f GroupChatRecordFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Va
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GroupChatRecordMutation", m)
 }
 
+// The GroupWatermarkFunc type is an adapter to allow the use of ordinary
+// function as GroupWatermark mutator.
+type GroupWatermarkFunc func(context.Context, *ent.GroupWatermarkMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GroupWatermarkFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.GroupWatermarkMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GroupWatermarkMutation", m)
+}
+
 // The ImageMessageFunc type is an adapter to allow the use of ordinary
 // function as ImageMessage mutator.
 type ImageMessageFunc func(context.Context, *ent.ImageMessageMutation) (ent.Value, error)
@@ -92,6 +188,30 @@ func (f ImageMessageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ImageMessageMutation", m)
 }
 
+// The LocationMessageFunc type is an adapter to allow the use of ordinary
+// function as LocationMessage mutator.
+type LocationMessageFunc func(context.Context, *ent.LocationMessageMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LocationMessageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LocationMessageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LocationMessageMutation", m)
+}
+
+// The MergedForwardMessageFunc type is an adapter to allow the use of ordinary
+// function as MergedForwardMessage mutator.
+type MergedForwardMessageFunc func(context.Context, *ent.MergedForwardMessageMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MergedForwardMessageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MergedForwardMessageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MergedForwardMessageMutation", m)
+}
+
 // The MessageFunc type is an adapter to allow the use of ordinary
 // function as Message mutator.
 type MessageFunc func(context.Context, *ent.MessageMutation) (ent.Value, error)
@@ -104,6 +224,66 @@ func (f MessageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MessageMutation", m)
 }
 
+// The MessageDeletionFunc type is an adapter to allow the use of ordinary
+// function as MessageDeletion mutator.
+type MessageDeletionFunc func(context.Context, *ent.MessageDeletionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MessageDeletionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MessageDeletionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MessageDeletionMutation", m)
+}
+
+// The MessageForwardFunc type is an adapter to allow the use of ordinary
+// function as MessageForward mutator.
+type MessageForwardFunc func(context.Context, *ent.MessageForwardMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MessageForwardFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MessageForwardMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MessageForwardMutation", m)
+}
+
+// The MessageMentionFunc type is an adapter to allow the use of ordinary
+// function as MessageMention mutator.
+type MessageMentionFunc func(context.Context, *ent.MessageMentionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MessageMentionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MessageMentionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MessageMentionMutation", m)
+}
+
+// The MessageOutboxFunc type is an adapter to allow the use of ordinary
+// function as MessageOutbox mutator.
+type MessageOutboxFunc func(context.Context, *ent.MessageOutboxMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MessageOutboxFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MessageOutboxMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MessageOutboxMutation", m)
+}
+
+// The MessageReactionFunc type is an adapter to allow the use of ordinary
+// function as MessageReaction mutator.
+type MessageReactionFunc func(context.Context, *ent.MessageReactionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MessageReactionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MessageReactionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MessageReactionMutation", m)
+}
+
 // The MessageStatusFunc type is an adapter to allow the use of ordinary
 // function as MessageStatus mutator.
 type MessageStatusFunc func(context.Context, *ent.MessageStatusMutation) (ent.Value, error)
@@ -116,6 +296,30 @@ func (f MessageStatusFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Valu
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MessageStatusMutation", m)
 }
 
+// The RetentionJobFunc type is an adapter to allow the use of ordinary
+// function as RetentionJob mutator.
+type RetentionJobFunc func(context.Context, *ent.RetentionJobMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RetentionJobFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RetentionJobMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RetentionJobMutation", m)
+}
+
+// The ScheduledMessageFunc type is an adapter to allow the use of ordinary
+// function as ScheduledMessage mutator.
+type ScheduledMessageFunc func(context.Context, *ent.ScheduledMessageMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ScheduledMessageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ScheduledMessageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ScheduledMessageMutation", m)
+}
+
 // The TextMessageFunc type is an adapter to allow the use of ordinary
 // function as TextMessage mutator.
 type TextMessageFunc func(context.Context, *ent.TextMessageMutation) (ent.Value, error)
@@ -152,6 +356,18 @@ func (f VideoMessageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.VideoMessageMutation", m)
 }
 
+// The VoiceMessageFunc type is an adapter to allow the use of ordinary
+// function as VoiceMessage mutator.
+type VoiceMessageFunc func(context.Context, *ent.VoiceMessageMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f VoiceMessageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.VoiceMessageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.VoiceMessageMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
@@ -0,0 +1,149 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"gochat_server/ent/locationmessage"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// LocationMessage is the model entity for the LocationMessage schema.
+type LocationMessage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 消息ID,由发送者产生
+	MsgId string `json:"msgId,omitempty"`
+	// 纬度
+	Latitude float64 `json:"latitude,omitempty"`
+	// 经度
+	Longitude float64 `json:"longitude,omitempty"`
+	// 地点名称
+	Name string `json:"name,omitempty"`
+	// 详细地址
+	Address      string `json:"address,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LocationMessage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case locationmessage.FieldLatitude, locationmessage.FieldLongitude:
+			values[i] = new(sql.NullFloat64)
+		case locationmessage.FieldID:
+			values[i] = new(sql.NullInt64)
+		case locationmessage.FieldMsgId, locationmessage.FieldName, locationmessage.FieldAddress:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LocationMessage fields.
+func (lm *LocationMessage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case locationmessage.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			lm.ID = int(value.Int64)
+		case locationmessage.FieldMsgId:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field msgId", values[i])
+			} else if value.Valid {
+				lm.MsgId = value.String
+			}
+		case locationmessage.FieldLatitude:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field latitude", values[i])
+			} else if value.Valid {
+				lm.Latitude = value.Float64
+			}
+		case locationmessage.FieldLongitude:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field longitude", values[i])
+			} else if value.Valid {
+				lm.Longitude = value.Float64
+			}
+		case locationmessage.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				lm.Name = value.String
+			}
+		case locationmessage.FieldAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address", values[i])
+			} else if value.Valid {
+				lm.Address = value.String
+			}
+		default:
+			lm.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LocationMessage.
+// This includes values selected through modifiers, order, etc.
+func (lm *LocationMessage) Value(name string) (ent.Value, error) {
+	return lm.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this LocationMessage.
+// Note that you need to call LocationMessage.Unwrap() before calling this method if this LocationMessage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (lm *LocationMessage) Update() *LocationMessageUpdateOne {
+	return NewLocationMessageClient(lm.config).UpdateOne(lm)
+}
+
+// Unwrap unwraps the LocationMessage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (lm *LocationMessage) Unwrap() *LocationMessage {
+	_tx, ok := lm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LocationMessage is not a transactional entity")
+	}
+	lm.config.driver = _tx.drv
+	return lm
+}
+
+// String implements the fmt.Stringer.
+func (lm *LocationMessage) String() string {
+	var builder strings.Builder
+	builder.WriteString("LocationMessage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", lm.ID))
+	builder.WriteString("msgId=")
+	builder.WriteString(lm.MsgId)
+	builder.WriteString(", ")
+	builder.WriteString("latitude=")
+	builder.WriteString(fmt.Sprintf("%v", lm.Latitude))
+	builder.WriteString(", ")
+	builder.WriteString("longitude=")
+	builder.WriteString(fmt.Sprintf("%v", lm.Longitude))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(lm.Name)
+	builder.WriteString(", ")
+	builder.WriteString("address=")
+	builder.WriteString(lm.Address)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LocationMessages is a parsable slice of LocationMessage.
+type LocationMessages []*LocationMessage
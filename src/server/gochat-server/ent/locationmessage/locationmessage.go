@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package locationmessage
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the locationmessage type in the database.
+	Label = "location_message"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldMsgId holds the string denoting the msgid field in the database.
+	FieldMsgId = "msg_id"
+	// FieldLatitude holds the string denoting the latitude field in the database.
+	FieldLatitude = "latitude"
+	// FieldLongitude holds the string denoting the longitude field in the database.
+	FieldLongitude = "longitude"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldAddress holds the string denoting the address field in the database.
+	FieldAddress = "address"
+	// Table holds the table name of the locationmessage in the database.
+	Table = "location_messages"
+)
+
+// Columns holds all SQL columns for locationmessage fields.
+var Columns = []string{
+	FieldID,
+	FieldMsgId,
+	FieldLatitude,
+	FieldLongitude,
+	FieldName,
+	FieldAddress,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// MsgIdValidator is a validator for the "msgId" field. It is called by the builders before save.
+	MsgIdValidator func(string) error
+	// LatitudeValidator is a validator for the "latitude" field. It is called by the builders before save.
+	LatitudeValidator func(float64) error
+	// LongitudeValidator is a validator for the "longitude" field. It is called by the builders before save.
+	LongitudeValidator func(float64) error
+)
+
+// OrderOption defines the ordering options for the LocationMessage queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByMsgId orders the results by the msgId field.
+func ByMsgId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMsgId, opts...).ToFunc()
+}
+
+// ByLatitude orders the results by the latitude field.
+func ByLatitude(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLatitude, opts...).ToFunc()
+}
+
+// ByLongitude orders the results by the longitude field.
+func ByLongitude(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLongitude, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByAddress orders the results by the address field.
+func ByAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAddress, opts...).ToFunc()
+}
@@ -0,0 +1,389 @@
+// Code generated by ent, DO NOT EDIT.
+
+package locationmessage
+
+import (
+	"gochat_server/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldLTE(FieldID, id))
+}
+
+// MsgId applies equality check predicate on the "msgId" field. It's identical to MsgIdEQ.
+func MsgId(v string) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldEQ(FieldMsgId, v))
+}
+
+// Latitude applies equality check predicate on the "latitude" field. It's identical to LatitudeEQ.
+func Latitude(v float64) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldEQ(FieldLatitude, v))
+}
+
+// Longitude applies equality check predicate on the "longitude" field. It's identical to LongitudeEQ.
+func Longitude(v float64) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldEQ(FieldLongitude, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldEQ(FieldName, v))
+}
+
+// Address applies equality check predicate on the "address" field. It's identical to AddressEQ.
+func Address(v string) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldEQ(FieldAddress, v))
+}
+
+// MsgIdEQ applies the EQ predicate on the "msgId" field.
+func MsgIdEQ(v string) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldEQ(FieldMsgId, v))
+}
+
+// MsgIdNEQ applies the NEQ predicate on the "msgId" field.
+func MsgIdNEQ(v string) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldNEQ(FieldMsgId, v))
+}
+
+// MsgIdIn applies the In predicate on the "msgId" field.
+func MsgIdIn(vs ...string) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldIn(FieldMsgId, vs...))
+}
+
+// MsgIdNotIn applies the NotIn predicate on the "msgId" field.
+func MsgIdNotIn(vs ...string) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldNotIn(FieldMsgId, vs...))
+}
+
+// MsgIdGT applies the GT predicate on the "msgId" field.
+func MsgIdGT(v string) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldGT(FieldMsgId, v))
+}
+
+// MsgIdGTE applies the GTE predicate on the "msgId" field.
+func MsgIdGTE(v string) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldGTE(FieldMsgId, v))
+}
+
+// MsgIdLT applies the LT predicate on the "msgId" field.
+func MsgIdLT(v string) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldLT(FieldMsgId, v))
+}
+
+// MsgIdLTE applies the LTE predicate on the "msgId" field.
+func MsgIdLTE(v string) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldLTE(FieldMsgId, v))
+}
+
+// MsgIdContains applies the Contains predicate on the "msgId" field.
+func MsgIdContains(v string) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldContains(FieldMsgId, v))
+}
+
+// MsgIdHasPrefix applies the HasPrefix predicate on the "msgId" field.
+func MsgIdHasPrefix(v string) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldHasPrefix(FieldMsgId, v))
+}
+
+// MsgIdHasSuffix applies the HasSuffix predicate on the "msgId" field.
+func MsgIdHasSuffix(v string) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldHasSuffix(FieldMsgId, v))
+}
+
+// MsgIdEqualFold applies the EqualFold predicate on the "msgId" field.
+func MsgIdEqualFold(v string) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldEqualFold(FieldMsgId, v))
+}
+
+// MsgIdContainsFold applies the ContainsFold predicate on the "msgId" field.
+func MsgIdContainsFold(v string) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldContainsFold(FieldMsgId, v))
+}
+
+// LatitudeEQ applies the EQ predicate on the "latitude" field.
+func LatitudeEQ(v float64) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldEQ(FieldLatitude, v))
+}
+
+// LatitudeNEQ applies the NEQ predicate on the "latitude" field.
+func LatitudeNEQ(v float64) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldNEQ(FieldLatitude, v))
+}
+
+// LatitudeIn applies the In predicate on the "latitude" field.
+func LatitudeIn(vs ...float64) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldIn(FieldLatitude, vs...))
+}
+
+// LatitudeNotIn applies the NotIn predicate on the "latitude" field.
+func LatitudeNotIn(vs ...float64) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldNotIn(FieldLatitude, vs...))
+}
+
+// LatitudeGT applies the GT predicate on the "latitude" field.
+func LatitudeGT(v float64) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldGT(FieldLatitude, v))
+}
+
+// LatitudeGTE applies the GTE predicate on the "latitude" field.
+func LatitudeGTE(v float64) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldGTE(FieldLatitude, v))
+}
+
+// LatitudeLT applies the LT predicate on the "latitude" field.
+func LatitudeLT(v float64) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldLT(FieldLatitude, v))
+}
+
+// LatitudeLTE applies the LTE predicate on the "latitude" field.
+func LatitudeLTE(v float64) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldLTE(FieldLatitude, v))
+}
+
+// LongitudeEQ applies the EQ predicate on the "longitude" field.
+func LongitudeEQ(v float64) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldEQ(FieldLongitude, v))
+}
+
+// LongitudeNEQ applies the NEQ predicate on the "longitude" field.
+func LongitudeNEQ(v float64) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldNEQ(FieldLongitude, v))
+}
+
+// LongitudeIn applies the In predicate on the "longitude" field.
+func LongitudeIn(vs ...float64) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldIn(FieldLongitude, vs...))
+}
+
+// LongitudeNotIn applies the NotIn predicate on the "longitude" field.
+func LongitudeNotIn(vs ...float64) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldNotIn(FieldLongitude, vs...))
+}
+
+// LongitudeGT applies the GT predicate on the "longitude" field.
+func LongitudeGT(v float64) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldGT(FieldLongitude, v))
+}
+
+// LongitudeGTE applies the GTE predicate on the "longitude" field.
+func LongitudeGTE(v float64) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldGTE(FieldLongitude, v))
+}
+
+// LongitudeLT applies the LT predicate on the "longitude" field.
+func LongitudeLT(v float64) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldLT(FieldLongitude, v))
+}
+
+// LongitudeLTE applies the LTE predicate on the "longitude" field.
+func LongitudeLTE(v float64) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldLTE(FieldLongitude, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldIsNull(FieldName))
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldNotNull(FieldName))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldContainsFold(FieldName, v))
+}
+
+// AddressEQ applies the EQ predicate on the "address" field.
+func AddressEQ(v string) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldEQ(FieldAddress, v))
+}
+
+// AddressNEQ applies the NEQ predicate on the "address" field.
+func AddressNEQ(v string) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldNEQ(FieldAddress, v))
+}
+
+// AddressIn applies the In predicate on the "address" field.
+func AddressIn(vs ...string) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldIn(FieldAddress, vs...))
+}
+
+// AddressNotIn applies the NotIn predicate on the "address" field.
+func AddressNotIn(vs ...string) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldNotIn(FieldAddress, vs...))
+}
+
+// AddressGT applies the GT predicate on the "address" field.
+func AddressGT(v string) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldGT(FieldAddress, v))
+}
+
+// AddressGTE applies the GTE predicate on the "address" field.
+func AddressGTE(v string) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldGTE(FieldAddress, v))
+}
+
+// AddressLT applies the LT predicate on the "address" field.
+func AddressLT(v string) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldLT(FieldAddress, v))
+}
+
+// AddressLTE applies the LTE predicate on the "address" field.
+func AddressLTE(v string) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldLTE(FieldAddress, v))
+}
+
+// AddressContains applies the Contains predicate on the "address" field.
+func AddressContains(v string) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldContains(FieldAddress, v))
+}
+
+// AddressHasPrefix applies the HasPrefix predicate on the "address" field.
+func AddressHasPrefix(v string) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldHasPrefix(FieldAddress, v))
+}
+
+// AddressHasSuffix applies the HasSuffix predicate on the "address" field.
+func AddressHasSuffix(v string) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldHasSuffix(FieldAddress, v))
+}
+
+// AddressIsNil applies the IsNil predicate on the "address" field.
+func AddressIsNil() predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldIsNull(FieldAddress))
+}
+
+// AddressNotNil applies the NotNil predicate on the "address" field.
+func AddressNotNil() predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldNotNull(FieldAddress))
+}
+
+// AddressEqualFold applies the EqualFold predicate on the "address" field.
+func AddressEqualFold(v string) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldEqualFold(FieldAddress, v))
+}
+
+// AddressContainsFold applies the ContainsFold predicate on the "address" field.
+func AddressContainsFold(v string) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.FieldContainsFold(FieldAddress, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LocationMessage) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LocationMessage) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LocationMessage) predicate.LocationMessage {
+	return predicate.LocationMessage(sql.NotPredicates(p))
+}
@@ -0,0 +1,260 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gochat_server/ent/locationmessage"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LocationMessageCreate is the builder for creating a LocationMessage entity.
+type LocationMessageCreate struct {
+	config
+	mutation *LocationMessageMutation
+	hooks    []Hook
+}
+
+// SetMsgId sets the "msgId" field.
+func (lmc *LocationMessageCreate) SetMsgId(s string) *LocationMessageCreate {
+	lmc.mutation.SetMsgId(s)
+	return lmc
+}
+
+// SetLatitude sets the "latitude" field.
+func (lmc *LocationMessageCreate) SetLatitude(f float64) *LocationMessageCreate {
+	lmc.mutation.SetLatitude(f)
+	return lmc
+}
+
+// SetLongitude sets the "longitude" field.
+func (lmc *LocationMessageCreate) SetLongitude(f float64) *LocationMessageCreate {
+	lmc.mutation.SetLongitude(f)
+	return lmc
+}
+
+// SetName sets the "name" field.
+func (lmc *LocationMessageCreate) SetName(s string) *LocationMessageCreate {
+	lmc.mutation.SetName(s)
+	return lmc
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (lmc *LocationMessageCreate) SetNillableName(s *string) *LocationMessageCreate {
+	if s != nil {
+		lmc.SetName(*s)
+	}
+	return lmc
+}
+
+// SetAddress sets the "address" field.
+func (lmc *LocationMessageCreate) SetAddress(s string) *LocationMessageCreate {
+	lmc.mutation.SetAddress(s)
+	return lmc
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (lmc *LocationMessageCreate) SetNillableAddress(s *string) *LocationMessageCreate {
+	if s != nil {
+		lmc.SetAddress(*s)
+	}
+	return lmc
+}
+
+// Mutation returns the LocationMessageMutation object of the builder.
+func (lmc *LocationMessageCreate) Mutation() *LocationMessageMutation {
+	return lmc.mutation
+}
+
+// Save creates the LocationMessage in the database.
+func (lmc *LocationMessageCreate) Save(ctx context.Context) (*LocationMessage, error) {
+	return withHooks(ctx, lmc.sqlSave, lmc.mutation, lmc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lmc *LocationMessageCreate) SaveX(ctx context.Context) *LocationMessage {
+	v, err := lmc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lmc *LocationMessageCreate) Exec(ctx context.Context) error {
+	_, err := lmc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lmc *LocationMessageCreate) ExecX(ctx context.Context) {
+	if err := lmc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lmc *LocationMessageCreate) check() error {
+	if _, ok := lmc.mutation.MsgId(); !ok {
+		return &ValidationError{Name: "msgId", err: errors.New(`ent: missing required field "LocationMessage.msgId"`)}
+	}
+	if v, ok := lmc.mutation.MsgId(); ok {
+		if err := locationmessage.MsgIdValidator(v); err != nil {
+			return &ValidationError{Name: "msgId", err: fmt.Errorf(`ent: validator failed for field "LocationMessage.msgId": %w`, err)}
+		}
+	}
+	if _, ok := lmc.mutation.Latitude(); !ok {
+		return &ValidationError{Name: "latitude", err: errors.New(`ent: missing required field "LocationMessage.latitude"`)}
+	}
+	if v, ok := lmc.mutation.Latitude(); ok {
+		if err := locationmessage.LatitudeValidator(v); err != nil {
+			return &ValidationError{Name: "latitude", err: fmt.Errorf(`ent: validator failed for field "LocationMessage.latitude": %w`, err)}
+		}
+	}
+	if _, ok := lmc.mutation.Longitude(); !ok {
+		return &ValidationError{Name: "longitude", err: errors.New(`ent: missing required field "LocationMessage.longitude"`)}
+	}
+	if v, ok := lmc.mutation.Longitude(); ok {
+		if err := locationmessage.LongitudeValidator(v); err != nil {
+			return &ValidationError{Name: "longitude", err: fmt.Errorf(`ent: validator failed for field "LocationMessage.longitude": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (lmc *LocationMessageCreate) sqlSave(ctx context.Context) (*LocationMessage, error) {
+	if err := lmc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := lmc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lmc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	lmc.mutation.id = &_node.ID
+	lmc.mutation.done = true
+	return _node, nil
+}
+
+func (lmc *LocationMessageCreate) createSpec() (*LocationMessage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LocationMessage{config: lmc.config}
+		_spec = sqlgraph.NewCreateSpec(locationmessage.Table, sqlgraph.NewFieldSpec(locationmessage.FieldID, field.TypeInt))
+	)
+	if value, ok := lmc.mutation.MsgId(); ok {
+		_spec.SetField(locationmessage.FieldMsgId, field.TypeString, value)
+		_node.MsgId = value
+	}
+	if value, ok := lmc.mutation.Latitude(); ok {
+		_spec.SetField(locationmessage.FieldLatitude, field.TypeFloat64, value)
+		_node.Latitude = value
+	}
+	if value, ok := lmc.mutation.Longitude(); ok {
+		_spec.SetField(locationmessage.FieldLongitude, field.TypeFloat64, value)
+		_node.Longitude = value
+	}
+	if value, ok := lmc.mutation.Name(); ok {
+		_spec.SetField(locationmessage.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := lmc.mutation.Address(); ok {
+		_spec.SetField(locationmessage.FieldAddress, field.TypeString, value)
+		_node.Address = value
+	}
+	return _node, _spec
+}
+
+// LocationMessageCreateBulk is the builder for creating many LocationMessage entities in bulk.
+type LocationMessageCreateBulk struct {
+	config
+	err      error
+	builders []*LocationMessageCreate
+}
+
+// Save creates the LocationMessage entities in the database.
+func (lmcb *LocationMessageCreateBulk) Save(ctx context.Context) ([]*LocationMessage, error) {
+	if lmcb.err != nil {
+		return nil, lmcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(lmcb.builders))
+	nodes := make([]*LocationMessage, len(lmcb.builders))
+	mutators := make([]Mutator, len(lmcb.builders))
+	for i := range lmcb.builders {
+		func(i int, root context.Context) {
+			builder := lmcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LocationMessageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lmcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lmcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lmcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lmcb *LocationMessageCreateBulk) SaveX(ctx context.Context) []*LocationMessage {
+	v, err := lmcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lmcb *LocationMessageCreateBulk) Exec(ctx context.Context) error {
+	_, err := lmcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lmcb *LocationMessageCreateBulk) ExecX(ctx context.Context) {
+	if err := lmcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
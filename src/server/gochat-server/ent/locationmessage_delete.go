@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"gochat_server/ent/locationmessage"
+	"gochat_server/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LocationMessageDelete is the builder for deleting a LocationMessage entity.
+type LocationMessageDelete struct {
+	config
+	hooks    []Hook
+	mutation *LocationMessageMutation
+}
+
+// Where appends a list predicates to the LocationMessageDelete builder.
+func (lmd *LocationMessageDelete) Where(ps ...predicate.LocationMessage) *LocationMessageDelete {
+	lmd.mutation.Where(ps...)
+	return lmd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (lmd *LocationMessageDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, lmd.sqlExec, lmd.mutation, lmd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lmd *LocationMessageDelete) ExecX(ctx context.Context) int {
+	n, err := lmd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (lmd *LocationMessageDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(locationmessage.Table, sqlgraph.NewFieldSpec(locationmessage.FieldID, field.TypeInt))
+	if ps := lmd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, lmd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	lmd.mutation.done = true
+	return affected, err
+}
+
+// LocationMessageDeleteOne is the builder for deleting a single LocationMessage entity.
+type LocationMessageDeleteOne struct {
+	lmd *LocationMessageDelete
+}
+
+// Where appends a list predicates to the LocationMessageDelete builder.
+func (lmdo *LocationMessageDeleteOne) Where(ps ...predicate.LocationMessage) *LocationMessageDeleteOne {
+	lmdo.lmd.mutation.Where(ps...)
+	return lmdo
+}
+
+// Exec executes the deletion query.
+func (lmdo *LocationMessageDeleteOne) Exec(ctx context.Context) error {
+	n, err := lmdo.lmd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{locationmessage.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lmdo *LocationMessageDeleteOne) ExecX(ctx context.Context) {
+	if err := lmdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"gochat_server/ent/locationmessage"
+	"gochat_server/ent/predicate"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LocationMessageQuery is the builder for querying LocationMessage entities.
+type LocationMessageQuery struct {
+	config
+	ctx        *QueryContext
+	order      []locationmessage.OrderOption
+	inters     []Interceptor
+	predicates []predicate.LocationMessage
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the LocationMessageQuery builder.
+func (lmq *LocationMessageQuery) Where(ps ...predicate.LocationMessage) *LocationMessageQuery {
+	lmq.predicates = append(lmq.predicates, ps...)
+	return lmq
+}
+
+// Limit the number of records to be returned by this query.
+func (lmq *LocationMessageQuery) Limit(limit int) *LocationMessageQuery {
+	lmq.ctx.Limit = &limit
+	return lmq
+}
+
+// Offset to start from.
+func (lmq *LocationMessageQuery) Offset(offset int) *LocationMessageQuery {
+	lmq.ctx.Offset = &offset
+	return lmq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (lmq *LocationMessageQuery) Unique(unique bool) *LocationMessageQuery {
+	lmq.ctx.Unique = &unique
+	return lmq
+}
+
+// Order specifies how the records should be ordered.
+func (lmq *LocationMessageQuery) Order(o ...locationmessage.OrderOption) *LocationMessageQuery {
+	lmq.order = append(lmq.order, o...)
+	return lmq
+}
+
+// First returns the first LocationMessage entity from the query.
+// Returns a *NotFoundError when no LocationMessage was found.
+func (lmq *LocationMessageQuery) First(ctx context.Context) (*LocationMessage, error) {
+	nodes, err := lmq.Limit(1).All(setContextOp(ctx, lmq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{locationmessage.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (lmq *LocationMessageQuery) FirstX(ctx context.Context) *LocationMessage {
+	node, err := lmq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first LocationMessage ID from the query.
+// Returns a *NotFoundError when no LocationMessage ID was found.
+func (lmq *LocationMessageQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = lmq.Limit(1).IDs(setContextOp(ctx, lmq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{locationmessage.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (lmq *LocationMessageQuery) FirstIDX(ctx context.Context) int {
+	id, err := lmq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single LocationMessage entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one LocationMessage entity is found.
+// Returns a *NotFoundError when no LocationMessage entities are found.
+func (lmq *LocationMessageQuery) Only(ctx context.Context) (*LocationMessage, error) {
+	nodes, err := lmq.Limit(2).All(setContextOp(ctx, lmq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{locationmessage.Label}
+	default:
+		return nil, &NotSingularError{locationmessage.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (lmq *LocationMessageQuery) OnlyX(ctx context.Context) *LocationMessage {
+	node, err := lmq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only LocationMessage ID in the query.
+// Returns a *NotSingularError when more than one LocationMessage ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (lmq *LocationMessageQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = lmq.Limit(2).IDs(setContextOp(ctx, lmq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{locationmessage.Label}
+	default:
+		err = &NotSingularError{locationmessage.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (lmq *LocationMessageQuery) OnlyIDX(ctx context.Context) int {
+	id, err := lmq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of LocationMessages.
+func (lmq *LocationMessageQuery) All(ctx context.Context) ([]*LocationMessage, error) {
+	ctx = setContextOp(ctx, lmq.ctx, ent.OpQueryAll)
+	if err := lmq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*LocationMessage, *LocationMessageQuery]()
+	return withInterceptors[[]*LocationMessage](ctx, lmq, qr, lmq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (lmq *LocationMessageQuery) AllX(ctx context.Context) []*LocationMessage {
+	nodes, err := lmq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of LocationMessage IDs.
+func (lmq *LocationMessageQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if lmq.ctx.Unique == nil && lmq.path != nil {
+		lmq.Unique(true)
+	}
+	ctx = setContextOp(ctx, lmq.ctx, ent.OpQueryIDs)
+	if err = lmq.Select(locationmessage.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (lmq *LocationMessageQuery) IDsX(ctx context.Context) []int {
+	ids, err := lmq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (lmq *LocationMessageQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, lmq.ctx, ent.OpQueryCount)
+	if err := lmq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, lmq, querierCount[*LocationMessageQuery](), lmq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (lmq *LocationMessageQuery) CountX(ctx context.Context) int {
+	count, err := lmq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (lmq *LocationMessageQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, lmq.ctx, ent.OpQueryExist)
+	switch _, err := lmq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (lmq *LocationMessageQuery) ExistX(ctx context.Context) bool {
+	exist, err := lmq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the LocationMessageQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (lmq *LocationMessageQuery) Clone() *LocationMessageQuery {
+	if lmq == nil {
+		return nil
+	}
+	return &LocationMessageQuery{
+		config:     lmq.config,
+		ctx:        lmq.ctx.Clone(),
+		order:      append([]locationmessage.OrderOption{}, lmq.order...),
+		inters:     append([]Interceptor{}, lmq.inters...),
+		predicates: append([]predicate.LocationMessage{}, lmq.predicates...),
+		// clone intermediate query.
+		sql:  lmq.sql.Clone(),
+		path: lmq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		MsgId string `json:"msgId,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.LocationMessage.Query().
+//		GroupBy(locationmessage.FieldMsgId).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (lmq *LocationMessageQuery) GroupBy(field string, fields ...string) *LocationMessageGroupBy {
+	lmq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &LocationMessageGroupBy{build: lmq}
+	grbuild.flds = &lmq.ctx.Fields
+	grbuild.label = locationmessage.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		MsgId string `json:"msgId,omitempty"`
+//	}
+//
+//	client.LocationMessage.Query().
+//		Select(locationmessage.FieldMsgId).
+//		Scan(ctx, &v)
+func (lmq *LocationMessageQuery) Select(fields ...string) *LocationMessageSelect {
+	lmq.ctx.Fields = append(lmq.ctx.Fields, fields...)
+	sbuild := &LocationMessageSelect{LocationMessageQuery: lmq}
+	sbuild.label = locationmessage.Label
+	sbuild.flds, sbuild.scan = &lmq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a LocationMessageSelect configured with the given aggregations.
+func (lmq *LocationMessageQuery) Aggregate(fns ...AggregateFunc) *LocationMessageSelect {
+	return lmq.Select().Aggregate(fns...)
+}
+
+func (lmq *LocationMessageQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range lmq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, lmq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range lmq.ctx.Fields {
+		if !locationmessage.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if lmq.path != nil {
+		prev, err := lmq.path(ctx)
+		if err != nil {
+			return err
+		}
+		lmq.sql = prev
+	}
+	return nil
+}
+
+func (lmq *LocationMessageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*LocationMessage, error) {
+	var (
+		nodes = []*LocationMessage{}
+		_spec = lmq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*LocationMessage).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &LocationMessage{config: lmq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, lmq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (lmq *LocationMessageQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := lmq.querySpec()
+	_spec.Node.Columns = lmq.ctx.Fields
+	if len(lmq.ctx.Fields) > 0 {
+		_spec.Unique = lmq.ctx.Unique != nil && *lmq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, lmq.driver, _spec)
+}
+
+func (lmq *LocationMessageQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(locationmessage.Table, locationmessage.Columns, sqlgraph.NewFieldSpec(locationmessage.FieldID, field.TypeInt))
+	_spec.From = lmq.sql
+	if unique := lmq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if lmq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := lmq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, locationmessage.FieldID)
+		for i := range fields {
+			if fields[i] != locationmessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := lmq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := lmq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := lmq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := lmq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (lmq *LocationMessageQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(lmq.driver.Dialect())
+	t1 := builder.Table(locationmessage.Table)
+	columns := lmq.ctx.Fields
+	if len(columns) == 0 {
+		columns = locationmessage.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if lmq.sql != nil {
+		selector = lmq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if lmq.ctx.Unique != nil && *lmq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range lmq.predicates {
+		p(selector)
+	}
+	for _, p := range lmq.order {
+		p(selector)
+	}
+	if offset := lmq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := lmq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// LocationMessageGroupBy is the group-by builder for LocationMessage entities.
+type LocationMessageGroupBy struct {
+	selector
+	build *LocationMessageQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (lmgb *LocationMessageGroupBy) Aggregate(fns ...AggregateFunc) *LocationMessageGroupBy {
+	lmgb.fns = append(lmgb.fns, fns...)
+	return lmgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (lmgb *LocationMessageGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, lmgb.build.ctx, ent.OpQueryGroupBy)
+	if err := lmgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LocationMessageQuery, *LocationMessageGroupBy](ctx, lmgb.build, lmgb, lmgb.build.inters, v)
+}
+
+func (lmgb *LocationMessageGroupBy) sqlScan(ctx context.Context, root *LocationMessageQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(lmgb.fns))
+	for _, fn := range lmgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*lmgb.flds)+len(lmgb.fns))
+		for _, f := range *lmgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*lmgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := lmgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// LocationMessageSelect is the builder for selecting fields of LocationMessage entities.
+type LocationMessageSelect struct {
+	*LocationMessageQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (lms *LocationMessageSelect) Aggregate(fns ...AggregateFunc) *LocationMessageSelect {
+	lms.fns = append(lms.fns, fns...)
+	return lms
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (lms *LocationMessageSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, lms.ctx, ent.OpQuerySelect)
+	if err := lms.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LocationMessageQuery, *LocationMessageSelect](ctx, lms.LocationMessageQuery, lms, lms.inters, v)
+}
+
+func (lms *LocationMessageSelect) sqlScan(ctx context.Context, root *LocationMessageQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(lms.fns))
+	for _, fn := range lms.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*lms.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := lms.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,467 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gochat_server/ent/locationmessage"
+	"gochat_server/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LocationMessageUpdate is the builder for updating LocationMessage entities.
+type LocationMessageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LocationMessageMutation
+}
+
+// Where appends a list predicates to the LocationMessageUpdate builder.
+func (lmu *LocationMessageUpdate) Where(ps ...predicate.LocationMessage) *LocationMessageUpdate {
+	lmu.mutation.Where(ps...)
+	return lmu
+}
+
+// SetMsgId sets the "msgId" field.
+func (lmu *LocationMessageUpdate) SetMsgId(s string) *LocationMessageUpdate {
+	lmu.mutation.SetMsgId(s)
+	return lmu
+}
+
+// SetNillableMsgId sets the "msgId" field if the given value is not nil.
+func (lmu *LocationMessageUpdate) SetNillableMsgId(s *string) *LocationMessageUpdate {
+	if s != nil {
+		lmu.SetMsgId(*s)
+	}
+	return lmu
+}
+
+// SetLatitude sets the "latitude" field.
+func (lmu *LocationMessageUpdate) SetLatitude(f float64) *LocationMessageUpdate {
+	lmu.mutation.ResetLatitude()
+	lmu.mutation.SetLatitude(f)
+	return lmu
+}
+
+// SetNillableLatitude sets the "latitude" field if the given value is not nil.
+func (lmu *LocationMessageUpdate) SetNillableLatitude(f *float64) *LocationMessageUpdate {
+	if f != nil {
+		lmu.SetLatitude(*f)
+	}
+	return lmu
+}
+
+// AddLatitude adds f to the "latitude" field.
+func (lmu *LocationMessageUpdate) AddLatitude(f float64) *LocationMessageUpdate {
+	lmu.mutation.AddLatitude(f)
+	return lmu
+}
+
+// SetLongitude sets the "longitude" field.
+func (lmu *LocationMessageUpdate) SetLongitude(f float64) *LocationMessageUpdate {
+	lmu.mutation.ResetLongitude()
+	lmu.mutation.SetLongitude(f)
+	return lmu
+}
+
+// SetNillableLongitude sets the "longitude" field if the given value is not nil.
+func (lmu *LocationMessageUpdate) SetNillableLongitude(f *float64) *LocationMessageUpdate {
+	if f != nil {
+		lmu.SetLongitude(*f)
+	}
+	return lmu
+}
+
+// AddLongitude adds f to the "longitude" field.
+func (lmu *LocationMessageUpdate) AddLongitude(f float64) *LocationMessageUpdate {
+	lmu.mutation.AddLongitude(f)
+	return lmu
+}
+
+// SetName sets the "name" field.
+func (lmu *LocationMessageUpdate) SetName(s string) *LocationMessageUpdate {
+	lmu.mutation.SetName(s)
+	return lmu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (lmu *LocationMessageUpdate) SetNillableName(s *string) *LocationMessageUpdate {
+	if s != nil {
+		lmu.SetName(*s)
+	}
+	return lmu
+}
+
+// ClearName clears the value of the "name" field.
+func (lmu *LocationMessageUpdate) ClearName() *LocationMessageUpdate {
+	lmu.mutation.ClearName()
+	return lmu
+}
+
+// SetAddress sets the "address" field.
+func (lmu *LocationMessageUpdate) SetAddress(s string) *LocationMessageUpdate {
+	lmu.mutation.SetAddress(s)
+	return lmu
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (lmu *LocationMessageUpdate) SetNillableAddress(s *string) *LocationMessageUpdate {
+	if s != nil {
+		lmu.SetAddress(*s)
+	}
+	return lmu
+}
+
+// ClearAddress clears the value of the "address" field.
+func (lmu *LocationMessageUpdate) ClearAddress() *LocationMessageUpdate {
+	lmu.mutation.ClearAddress()
+	return lmu
+}
+
+// Mutation returns the LocationMessageMutation object of the builder.
+func (lmu *LocationMessageUpdate) Mutation() *LocationMessageMutation {
+	return lmu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (lmu *LocationMessageUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, lmu.sqlSave, lmu.mutation, lmu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lmu *LocationMessageUpdate) SaveX(ctx context.Context) int {
+	affected, err := lmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (lmu *LocationMessageUpdate) Exec(ctx context.Context) error {
+	_, err := lmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lmu *LocationMessageUpdate) ExecX(ctx context.Context) {
+	if err := lmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lmu *LocationMessageUpdate) check() error {
+	if v, ok := lmu.mutation.MsgId(); ok {
+		if err := locationmessage.MsgIdValidator(v); err != nil {
+			return &ValidationError{Name: "msgId", err: fmt.Errorf(`ent: validator failed for field "LocationMessage.msgId": %w`, err)}
+		}
+	}
+	if v, ok := lmu.mutation.Latitude(); ok {
+		if err := locationmessage.LatitudeValidator(v); err != nil {
+			return &ValidationError{Name: "latitude", err: fmt.Errorf(`ent: validator failed for field "LocationMessage.latitude": %w`, err)}
+		}
+	}
+	if v, ok := lmu.mutation.Longitude(); ok {
+		if err := locationmessage.LongitudeValidator(v); err != nil {
+			return &ValidationError{Name: "longitude", err: fmt.Errorf(`ent: validator failed for field "LocationMessage.longitude": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (lmu *LocationMessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := lmu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(locationmessage.Table, locationmessage.Columns, sqlgraph.NewFieldSpec(locationmessage.FieldID, field.TypeInt))
+	if ps := lmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lmu.mutation.MsgId(); ok {
+		_spec.SetField(locationmessage.FieldMsgId, field.TypeString, value)
+	}
+	if value, ok := lmu.mutation.Latitude(); ok {
+		_spec.SetField(locationmessage.FieldLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := lmu.mutation.AddedLatitude(); ok {
+		_spec.AddField(locationmessage.FieldLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := lmu.mutation.Longitude(); ok {
+		_spec.SetField(locationmessage.FieldLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := lmu.mutation.AddedLongitude(); ok {
+		_spec.AddField(locationmessage.FieldLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := lmu.mutation.Name(); ok {
+		_spec.SetField(locationmessage.FieldName, field.TypeString, value)
+	}
+	if lmu.mutation.NameCleared() {
+		_spec.ClearField(locationmessage.FieldName, field.TypeString)
+	}
+	if value, ok := lmu.mutation.Address(); ok {
+		_spec.SetField(locationmessage.FieldAddress, field.TypeString, value)
+	}
+	if lmu.mutation.AddressCleared() {
+		_spec.ClearField(locationmessage.FieldAddress, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, lmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{locationmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	lmu.mutation.done = true
+	return n, nil
+}
+
+// LocationMessageUpdateOne is the builder for updating a single LocationMessage entity.
+type LocationMessageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LocationMessageMutation
+}
+
+// SetMsgId sets the "msgId" field.
+func (lmuo *LocationMessageUpdateOne) SetMsgId(s string) *LocationMessageUpdateOne {
+	lmuo.mutation.SetMsgId(s)
+	return lmuo
+}
+
+// SetNillableMsgId sets the "msgId" field if the given value is not nil.
+func (lmuo *LocationMessageUpdateOne) SetNillableMsgId(s *string) *LocationMessageUpdateOne {
+	if s != nil {
+		lmuo.SetMsgId(*s)
+	}
+	return lmuo
+}
+
+// SetLatitude sets the "latitude" field.
+func (lmuo *LocationMessageUpdateOne) SetLatitude(f float64) *LocationMessageUpdateOne {
+	lmuo.mutation.ResetLatitude()
+	lmuo.mutation.SetLatitude(f)
+	return lmuo
+}
+
+// SetNillableLatitude sets the "latitude" field if the given value is not nil.
+func (lmuo *LocationMessageUpdateOne) SetNillableLatitude(f *float64) *LocationMessageUpdateOne {
+	if f != nil {
+		lmuo.SetLatitude(*f)
+	}
+	return lmuo
+}
+
+// AddLatitude adds f to the "latitude" field.
+func (lmuo *LocationMessageUpdateOne) AddLatitude(f float64) *LocationMessageUpdateOne {
+	lmuo.mutation.AddLatitude(f)
+	return lmuo
+}
+
+// SetLongitude sets the "longitude" field.
+func (lmuo *LocationMessageUpdateOne) SetLongitude(f float64) *LocationMessageUpdateOne {
+	lmuo.mutation.ResetLongitude()
+	lmuo.mutation.SetLongitude(f)
+	return lmuo
+}
+
+// SetNillableLongitude sets the "longitude" field if the given value is not nil.
+func (lmuo *LocationMessageUpdateOne) SetNillableLongitude(f *float64) *LocationMessageUpdateOne {
+	if f != nil {
+		lmuo.SetLongitude(*f)
+	}
+	return lmuo
+}
+
+// AddLongitude adds f to the "longitude" field.
+func (lmuo *LocationMessageUpdateOne) AddLongitude(f float64) *LocationMessageUpdateOne {
+	lmuo.mutation.AddLongitude(f)
+	return lmuo
+}
+
+// SetName sets the "name" field.
+func (lmuo *LocationMessageUpdateOne) SetName(s string) *LocationMessageUpdateOne {
+	lmuo.mutation.SetName(s)
+	return lmuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (lmuo *LocationMessageUpdateOne) SetNillableName(s *string) *LocationMessageUpdateOne {
+	if s != nil {
+		lmuo.SetName(*s)
+	}
+	return lmuo
+}
+
+// ClearName clears the value of the "name" field.
+func (lmuo *LocationMessageUpdateOne) ClearName() *LocationMessageUpdateOne {
+	lmuo.mutation.ClearName()
+	return lmuo
+}
+
+// SetAddress sets the "address" field.
+func (lmuo *LocationMessageUpdateOne) SetAddress(s string) *LocationMessageUpdateOne {
+	lmuo.mutation.SetAddress(s)
+	return lmuo
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (lmuo *LocationMessageUpdateOne) SetNillableAddress(s *string) *LocationMessageUpdateOne {
+	if s != nil {
+		lmuo.SetAddress(*s)
+	}
+	return lmuo
+}
+
+// ClearAddress clears the value of the "address" field.
+func (lmuo *LocationMessageUpdateOne) ClearAddress() *LocationMessageUpdateOne {
+	lmuo.mutation.ClearAddress()
+	return lmuo
+}
+
+// Mutation returns the LocationMessageMutation object of the builder.
+func (lmuo *LocationMessageUpdateOne) Mutation() *LocationMessageMutation {
+	return lmuo.mutation
+}
+
+// Where appends a list predicates to the LocationMessageUpdate builder.
+func (lmuo *LocationMessageUpdateOne) Where(ps ...predicate.LocationMessage) *LocationMessageUpdateOne {
+	lmuo.mutation.Where(ps...)
+	return lmuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (lmuo *LocationMessageUpdateOne) Select(field string, fields ...string) *LocationMessageUpdateOne {
+	lmuo.fields = append([]string{field}, fields...)
+	return lmuo
+}
+
+// Save executes the query and returns the updated LocationMessage entity.
+func (lmuo *LocationMessageUpdateOne) Save(ctx context.Context) (*LocationMessage, error) {
+	return withHooks(ctx, lmuo.sqlSave, lmuo.mutation, lmuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lmuo *LocationMessageUpdateOne) SaveX(ctx context.Context) *LocationMessage {
+	node, err := lmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (lmuo *LocationMessageUpdateOne) Exec(ctx context.Context) error {
+	_, err := lmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lmuo *LocationMessageUpdateOne) ExecX(ctx context.Context) {
+	if err := lmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lmuo *LocationMessageUpdateOne) check() error {
+	if v, ok := lmuo.mutation.MsgId(); ok {
+		if err := locationmessage.MsgIdValidator(v); err != nil {
+			return &ValidationError{Name: "msgId", err: fmt.Errorf(`ent: validator failed for field "LocationMessage.msgId": %w`, err)}
+		}
+	}
+	if v, ok := lmuo.mutation.Latitude(); ok {
+		if err := locationmessage.LatitudeValidator(v); err != nil {
+			return &ValidationError{Name: "latitude", err: fmt.Errorf(`ent: validator failed for field "LocationMessage.latitude": %w`, err)}
+		}
+	}
+	if v, ok := lmuo.mutation.Longitude(); ok {
+		if err := locationmessage.LongitudeValidator(v); err != nil {
+			return &ValidationError{Name: "longitude", err: fmt.Errorf(`ent: validator failed for field "LocationMessage.longitude": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (lmuo *LocationMessageUpdateOne) sqlSave(ctx context.Context) (_node *LocationMessage, err error) {
+	if err := lmuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(locationmessage.Table, locationmessage.Columns, sqlgraph.NewFieldSpec(locationmessage.FieldID, field.TypeInt))
+	id, ok := lmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LocationMessage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := lmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, locationmessage.FieldID)
+		for _, f := range fields {
+			if !locationmessage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != locationmessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := lmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lmuo.mutation.MsgId(); ok {
+		_spec.SetField(locationmessage.FieldMsgId, field.TypeString, value)
+	}
+	if value, ok := lmuo.mutation.Latitude(); ok {
+		_spec.SetField(locationmessage.FieldLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := lmuo.mutation.AddedLatitude(); ok {
+		_spec.AddField(locationmessage.FieldLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := lmuo.mutation.Longitude(); ok {
+		_spec.SetField(locationmessage.FieldLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := lmuo.mutation.AddedLongitude(); ok {
+		_spec.AddField(locationmessage.FieldLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := lmuo.mutation.Name(); ok {
+		_spec.SetField(locationmessage.FieldName, field.TypeString, value)
+	}
+	if lmuo.mutation.NameCleared() {
+		_spec.ClearField(locationmessage.FieldName, field.TypeString)
+	}
+	if value, ok := lmuo.mutation.Address(); ok {
+		_spec.SetField(locationmessage.FieldAddress, field.TypeString, value)
+	}
+	if lmuo.mutation.AddressCleared() {
+		_spec.ClearField(locationmessage.FieldAddress, field.TypeString)
+	}
+	_node = &LocationMessage{config: lmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, lmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{locationmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	lmuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,125 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"gochat_server/ent/mergedforwardmessage"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// MergedForwardMessage is the model entity for the MergedForwardMessage schema.
+type MergedForwardMessage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 消息ID,由发送者产生
+	MsgId string `json:"msgId,omitempty"`
+	// 聊天记录标题
+	Title string `json:"title,omitempty"`
+	// 合并转发的聊天记录(JSON)
+	Payload      string `json:"payload,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MergedForwardMessage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case mergedforwardmessage.FieldID:
+			values[i] = new(sql.NullInt64)
+		case mergedforwardmessage.FieldMsgId, mergedforwardmessage.FieldTitle, mergedforwardmessage.FieldPayload:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MergedForwardMessage fields.
+func (mfm *MergedForwardMessage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case mergedforwardmessage.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			mfm.ID = int(value.Int64)
+		case mergedforwardmessage.FieldMsgId:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field msgId", values[i])
+			} else if value.Valid {
+				mfm.MsgId = value.String
+			}
+		case mergedforwardmessage.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				mfm.Title = value.String
+			}
+		case mergedforwardmessage.FieldPayload:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payload", values[i])
+			} else if value.Valid {
+				mfm.Payload = value.String
+			}
+		default:
+			mfm.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MergedForwardMessage.
+// This includes values selected through modifiers, order, etc.
+func (mfm *MergedForwardMessage) Value(name string) (ent.Value, error) {
+	return mfm.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this MergedForwardMessage.
+// Note that you need to call MergedForwardMessage.Unwrap() before calling this method if this MergedForwardMessage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mfm *MergedForwardMessage) Update() *MergedForwardMessageUpdateOne {
+	return NewMergedForwardMessageClient(mfm.config).UpdateOne(mfm)
+}
+
+// Unwrap unwraps the MergedForwardMessage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mfm *MergedForwardMessage) Unwrap() *MergedForwardMessage {
+	_tx, ok := mfm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MergedForwardMessage is not a transactional entity")
+	}
+	mfm.config.driver = _tx.drv
+	return mfm
+}
+
+// String implements the fmt.Stringer.
+func (mfm *MergedForwardMessage) String() string {
+	var builder strings.Builder
+	builder.WriteString("MergedForwardMessage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mfm.ID))
+	builder.WriteString("msgId=")
+	builder.WriteString(mfm.MsgId)
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(mfm.Title)
+	builder.WriteString(", ")
+	builder.WriteString("payload=")
+	builder.WriteString(mfm.Payload)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MergedForwardMessages is a parsable slice of MergedForwardMessage.
+type MergedForwardMessages []*MergedForwardMessage
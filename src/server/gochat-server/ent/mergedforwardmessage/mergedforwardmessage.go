@@ -0,0 +1,72 @@
+// Code generated by ent, DO NOT EDIT.
+
+package mergedforwardmessage
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the mergedforwardmessage type in the database.
+	Label = "merged_forward_message"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldMsgId holds the string denoting the msgid field in the database.
+	FieldMsgId = "msg_id"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// FieldPayload holds the string denoting the payload field in the database.
+	FieldPayload = "payload"
+	// Table holds the table name of the mergedforwardmessage in the database.
+	Table = "merged_forward_messages"
+)
+
+// Columns holds all SQL columns for mergedforwardmessage fields.
+var Columns = []string{
+	FieldID,
+	FieldMsgId,
+	FieldTitle,
+	FieldPayload,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// MsgIdValidator is a validator for the "msgId" field. It is called by the builders before save.
+	MsgIdValidator func(string) error
+	// TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	TitleValidator func(string) error
+	// PayloadValidator is a validator for the "payload" field. It is called by the builders before save.
+	PayloadValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the MergedForwardMessage queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByMsgId orders the results by the msgId field.
+func ByMsgId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMsgId, opts...).ToFunc()
+}
+
+// ByTitle orders the results by the title field.
+func ByTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitle, opts...).ToFunc()
+}
+
+// ByPayload orders the results by the payload field.
+func ByPayload(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayload, opts...).ToFunc()
+}
@@ -0,0 +1,279 @@
+// Code generated by ent, DO NOT EDIT.
+
+package mergedforwardmessage
+
+import (
+	"gochat_server/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldLTE(FieldID, id))
+}
+
+// MsgId applies equality check predicate on the "msgId" field. It's identical to MsgIdEQ.
+func MsgId(v string) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldEQ(FieldMsgId, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldEQ(FieldTitle, v))
+}
+
+// Payload applies equality check predicate on the "payload" field. It's identical to PayloadEQ.
+func Payload(v string) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldEQ(FieldPayload, v))
+}
+
+// MsgIdEQ applies the EQ predicate on the "msgId" field.
+func MsgIdEQ(v string) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldEQ(FieldMsgId, v))
+}
+
+// MsgIdNEQ applies the NEQ predicate on the "msgId" field.
+func MsgIdNEQ(v string) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldNEQ(FieldMsgId, v))
+}
+
+// MsgIdIn applies the In predicate on the "msgId" field.
+func MsgIdIn(vs ...string) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldIn(FieldMsgId, vs...))
+}
+
+// MsgIdNotIn applies the NotIn predicate on the "msgId" field.
+func MsgIdNotIn(vs ...string) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldNotIn(FieldMsgId, vs...))
+}
+
+// MsgIdGT applies the GT predicate on the "msgId" field.
+func MsgIdGT(v string) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldGT(FieldMsgId, v))
+}
+
+// MsgIdGTE applies the GTE predicate on the "msgId" field.
+func MsgIdGTE(v string) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldGTE(FieldMsgId, v))
+}
+
+// MsgIdLT applies the LT predicate on the "msgId" field.
+func MsgIdLT(v string) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldLT(FieldMsgId, v))
+}
+
+// MsgIdLTE applies the LTE predicate on the "msgId" field.
+func MsgIdLTE(v string) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldLTE(FieldMsgId, v))
+}
+
+// MsgIdContains applies the Contains predicate on the "msgId" field.
+func MsgIdContains(v string) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldContains(FieldMsgId, v))
+}
+
+// MsgIdHasPrefix applies the HasPrefix predicate on the "msgId" field.
+func MsgIdHasPrefix(v string) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldHasPrefix(FieldMsgId, v))
+}
+
+// MsgIdHasSuffix applies the HasSuffix predicate on the "msgId" field.
+func MsgIdHasSuffix(v string) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldHasSuffix(FieldMsgId, v))
+}
+
+// MsgIdEqualFold applies the EqualFold predicate on the "msgId" field.
+func MsgIdEqualFold(v string) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldEqualFold(FieldMsgId, v))
+}
+
+// MsgIdContainsFold applies the ContainsFold predicate on the "msgId" field.
+func MsgIdContainsFold(v string) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldContainsFold(FieldMsgId, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// PayloadEQ applies the EQ predicate on the "payload" field.
+func PayloadEQ(v string) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldEQ(FieldPayload, v))
+}
+
+// PayloadNEQ applies the NEQ predicate on the "payload" field.
+func PayloadNEQ(v string) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldNEQ(FieldPayload, v))
+}
+
+// PayloadIn applies the In predicate on the "payload" field.
+func PayloadIn(vs ...string) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldIn(FieldPayload, vs...))
+}
+
+// PayloadNotIn applies the NotIn predicate on the "payload" field.
+func PayloadNotIn(vs ...string) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldNotIn(FieldPayload, vs...))
+}
+
+// PayloadGT applies the GT predicate on the "payload" field.
+func PayloadGT(v string) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldGT(FieldPayload, v))
+}
+
+// PayloadGTE applies the GTE predicate on the "payload" field.
+func PayloadGTE(v string) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldGTE(FieldPayload, v))
+}
+
+// PayloadLT applies the LT predicate on the "payload" field.
+func PayloadLT(v string) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldLT(FieldPayload, v))
+}
+
+// PayloadLTE applies the LTE predicate on the "payload" field.
+func PayloadLTE(v string) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldLTE(FieldPayload, v))
+}
+
+// PayloadContains applies the Contains predicate on the "payload" field.
+func PayloadContains(v string) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldContains(FieldPayload, v))
+}
+
+// PayloadHasPrefix applies the HasPrefix predicate on the "payload" field.
+func PayloadHasPrefix(v string) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldHasPrefix(FieldPayload, v))
+}
+
+// PayloadHasSuffix applies the HasSuffix predicate on the "payload" field.
+func PayloadHasSuffix(v string) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldHasSuffix(FieldPayload, v))
+}
+
+// PayloadEqualFold applies the EqualFold predicate on the "payload" field.
+func PayloadEqualFold(v string) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldEqualFold(FieldPayload, v))
+}
+
+// PayloadContainsFold applies the ContainsFold predicate on the "payload" field.
+func PayloadContainsFold(v string) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.FieldContainsFold(FieldPayload, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MergedForwardMessage) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MergedForwardMessage) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MergedForwardMessage) predicate.MergedForwardMessage {
+	return predicate.MergedForwardMessage(sql.NotPredicates(p))
+}
@@ -0,0 +1,224 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gochat_server/ent/mergedforwardmessage"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MergedForwardMessageCreate is the builder for creating a MergedForwardMessage entity.
+type MergedForwardMessageCreate struct {
+	config
+	mutation *MergedForwardMessageMutation
+	hooks    []Hook
+}
+
+// SetMsgId sets the "msgId" field.
+func (mfmc *MergedForwardMessageCreate) SetMsgId(s string) *MergedForwardMessageCreate {
+	mfmc.mutation.SetMsgId(s)
+	return mfmc
+}
+
+// SetTitle sets the "title" field.
+func (mfmc *MergedForwardMessageCreate) SetTitle(s string) *MergedForwardMessageCreate {
+	mfmc.mutation.SetTitle(s)
+	return mfmc
+}
+
+// SetPayload sets the "payload" field.
+func (mfmc *MergedForwardMessageCreate) SetPayload(s string) *MergedForwardMessageCreate {
+	mfmc.mutation.SetPayload(s)
+	return mfmc
+}
+
+// Mutation returns the MergedForwardMessageMutation object of the builder.
+func (mfmc *MergedForwardMessageCreate) Mutation() *MergedForwardMessageMutation {
+	return mfmc.mutation
+}
+
+// Save creates the MergedForwardMessage in the database.
+func (mfmc *MergedForwardMessageCreate) Save(ctx context.Context) (*MergedForwardMessage, error) {
+	return withHooks(ctx, mfmc.sqlSave, mfmc.mutation, mfmc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mfmc *MergedForwardMessageCreate) SaveX(ctx context.Context) *MergedForwardMessage {
+	v, err := mfmc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mfmc *MergedForwardMessageCreate) Exec(ctx context.Context) error {
+	_, err := mfmc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mfmc *MergedForwardMessageCreate) ExecX(ctx context.Context) {
+	if err := mfmc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mfmc *MergedForwardMessageCreate) check() error {
+	if _, ok := mfmc.mutation.MsgId(); !ok {
+		return &ValidationError{Name: "msgId", err: errors.New(`ent: missing required field "MergedForwardMessage.msgId"`)}
+	}
+	if v, ok := mfmc.mutation.MsgId(); ok {
+		if err := mergedforwardmessage.MsgIdValidator(v); err != nil {
+			return &ValidationError{Name: "msgId", err: fmt.Errorf(`ent: validator failed for field "MergedForwardMessage.msgId": %w`, err)}
+		}
+	}
+	if _, ok := mfmc.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "MergedForwardMessage.title"`)}
+	}
+	if v, ok := mfmc.mutation.Title(); ok {
+		if err := mergedforwardmessage.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "MergedForwardMessage.title": %w`, err)}
+		}
+	}
+	if _, ok := mfmc.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New(`ent: missing required field "MergedForwardMessage.payload"`)}
+	}
+	if v, ok := mfmc.mutation.Payload(); ok {
+		if err := mergedforwardmessage.PayloadValidator(v); err != nil {
+			return &ValidationError{Name: "payload", err: fmt.Errorf(`ent: validator failed for field "MergedForwardMessage.payload": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (mfmc *MergedForwardMessageCreate) sqlSave(ctx context.Context) (*MergedForwardMessage, error) {
+	if err := mfmc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mfmc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mfmc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	mfmc.mutation.id = &_node.ID
+	mfmc.mutation.done = true
+	return _node, nil
+}
+
+func (mfmc *MergedForwardMessageCreate) createSpec() (*MergedForwardMessage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MergedForwardMessage{config: mfmc.config}
+		_spec = sqlgraph.NewCreateSpec(mergedforwardmessage.Table, sqlgraph.NewFieldSpec(mergedforwardmessage.FieldID, field.TypeInt))
+	)
+	if value, ok := mfmc.mutation.MsgId(); ok {
+		_spec.SetField(mergedforwardmessage.FieldMsgId, field.TypeString, value)
+		_node.MsgId = value
+	}
+	if value, ok := mfmc.mutation.Title(); ok {
+		_spec.SetField(mergedforwardmessage.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := mfmc.mutation.Payload(); ok {
+		_spec.SetField(mergedforwardmessage.FieldPayload, field.TypeString, value)
+		_node.Payload = value
+	}
+	return _node, _spec
+}
+
+// MergedForwardMessageCreateBulk is the builder for creating many MergedForwardMessage entities in bulk.
+type MergedForwardMessageCreateBulk struct {
+	config
+	err      error
+	builders []*MergedForwardMessageCreate
+}
+
+// Save creates the MergedForwardMessage entities in the database.
+func (mfmcb *MergedForwardMessageCreateBulk) Save(ctx context.Context) ([]*MergedForwardMessage, error) {
+	if mfmcb.err != nil {
+		return nil, mfmcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mfmcb.builders))
+	nodes := make([]*MergedForwardMessage, len(mfmcb.builders))
+	mutators := make([]Mutator, len(mfmcb.builders))
+	for i := range mfmcb.builders {
+		func(i int, root context.Context) {
+			builder := mfmcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MergedForwardMessageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mfmcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mfmcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mfmcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mfmcb *MergedForwardMessageCreateBulk) SaveX(ctx context.Context) []*MergedForwardMessage {
+	v, err := mfmcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mfmcb *MergedForwardMessageCreateBulk) Exec(ctx context.Context) error {
+	_, err := mfmcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mfmcb *MergedForwardMessageCreateBulk) ExecX(ctx context.Context) {
+	if err := mfmcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
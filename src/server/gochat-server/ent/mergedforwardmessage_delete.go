@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"gochat_server/ent/mergedforwardmessage"
+	"gochat_server/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MergedForwardMessageDelete is the builder for deleting a MergedForwardMessage entity.
+type MergedForwardMessageDelete struct {
+	config
+	hooks    []Hook
+	mutation *MergedForwardMessageMutation
+}
+
+// Where appends a list predicates to the MergedForwardMessageDelete builder.
+func (mfmd *MergedForwardMessageDelete) Where(ps ...predicate.MergedForwardMessage) *MergedForwardMessageDelete {
+	mfmd.mutation.Where(ps...)
+	return mfmd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mfmd *MergedForwardMessageDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, mfmd.sqlExec, mfmd.mutation, mfmd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mfmd *MergedForwardMessageDelete) ExecX(ctx context.Context) int {
+	n, err := mfmd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mfmd *MergedForwardMessageDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(mergedforwardmessage.Table, sqlgraph.NewFieldSpec(mergedforwardmessage.FieldID, field.TypeInt))
+	if ps := mfmd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mfmd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mfmd.mutation.done = true
+	return affected, err
+}
+
+// MergedForwardMessageDeleteOne is the builder for deleting a single MergedForwardMessage entity.
+type MergedForwardMessageDeleteOne struct {
+	mfmd *MergedForwardMessageDelete
+}
+
+// Where appends a list predicates to the MergedForwardMessageDelete builder.
+func (mfmdo *MergedForwardMessageDeleteOne) Where(ps ...predicate.MergedForwardMessage) *MergedForwardMessageDeleteOne {
+	mfmdo.mfmd.mutation.Where(ps...)
+	return mfmdo
+}
+
+// Exec executes the deletion query.
+func (mfmdo *MergedForwardMessageDeleteOne) Exec(ctx context.Context) error {
+	n, err := mfmdo.mfmd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{mergedforwardmessage.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mfmdo *MergedForwardMessageDeleteOne) ExecX(ctx context.Context) {
+	if err := mfmdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"gochat_server/ent/mergedforwardmessage"
+	"gochat_server/ent/predicate"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MergedForwardMessageQuery is the builder for querying MergedForwardMessage entities.
+type MergedForwardMessageQuery struct {
+	config
+	ctx        *QueryContext
+	order      []mergedforwardmessage.OrderOption
+	inters     []Interceptor
+	predicates []predicate.MergedForwardMessage
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MergedForwardMessageQuery builder.
+func (mfmq *MergedForwardMessageQuery) Where(ps ...predicate.MergedForwardMessage) *MergedForwardMessageQuery {
+	mfmq.predicates = append(mfmq.predicates, ps...)
+	return mfmq
+}
+
+// Limit the number of records to be returned by this query.
+func (mfmq *MergedForwardMessageQuery) Limit(limit int) *MergedForwardMessageQuery {
+	mfmq.ctx.Limit = &limit
+	return mfmq
+}
+
+// Offset to start from.
+func (mfmq *MergedForwardMessageQuery) Offset(offset int) *MergedForwardMessageQuery {
+	mfmq.ctx.Offset = &offset
+	return mfmq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mfmq *MergedForwardMessageQuery) Unique(unique bool) *MergedForwardMessageQuery {
+	mfmq.ctx.Unique = &unique
+	return mfmq
+}
+
+// Order specifies how the records should be ordered.
+func (mfmq *MergedForwardMessageQuery) Order(o ...mergedforwardmessage.OrderOption) *MergedForwardMessageQuery {
+	mfmq.order = append(mfmq.order, o...)
+	return mfmq
+}
+
+// First returns the first MergedForwardMessage entity from the query.
+// Returns a *NotFoundError when no MergedForwardMessage was found.
+func (mfmq *MergedForwardMessageQuery) First(ctx context.Context) (*MergedForwardMessage, error) {
+	nodes, err := mfmq.Limit(1).All(setContextOp(ctx, mfmq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{mergedforwardmessage.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mfmq *MergedForwardMessageQuery) FirstX(ctx context.Context) *MergedForwardMessage {
+	node, err := mfmq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MergedForwardMessage ID from the query.
+// Returns a *NotFoundError when no MergedForwardMessage ID was found.
+func (mfmq *MergedForwardMessageQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mfmq.Limit(1).IDs(setContextOp(ctx, mfmq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{mergedforwardmessage.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mfmq *MergedForwardMessageQuery) FirstIDX(ctx context.Context) int {
+	id, err := mfmq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MergedForwardMessage entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MergedForwardMessage entity is found.
+// Returns a *NotFoundError when no MergedForwardMessage entities are found.
+func (mfmq *MergedForwardMessageQuery) Only(ctx context.Context) (*MergedForwardMessage, error) {
+	nodes, err := mfmq.Limit(2).All(setContextOp(ctx, mfmq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{mergedforwardmessage.Label}
+	default:
+		return nil, &NotSingularError{mergedforwardmessage.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mfmq *MergedForwardMessageQuery) OnlyX(ctx context.Context) *MergedForwardMessage {
+	node, err := mfmq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MergedForwardMessage ID in the query.
+// Returns a *NotSingularError when more than one MergedForwardMessage ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (mfmq *MergedForwardMessageQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mfmq.Limit(2).IDs(setContextOp(ctx, mfmq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{mergedforwardmessage.Label}
+	default:
+		err = &NotSingularError{mergedforwardmessage.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mfmq *MergedForwardMessageQuery) OnlyIDX(ctx context.Context) int {
+	id, err := mfmq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MergedForwardMessages.
+func (mfmq *MergedForwardMessageQuery) All(ctx context.Context) ([]*MergedForwardMessage, error) {
+	ctx = setContextOp(ctx, mfmq.ctx, ent.OpQueryAll)
+	if err := mfmq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*MergedForwardMessage, *MergedForwardMessageQuery]()
+	return withInterceptors[[]*MergedForwardMessage](ctx, mfmq, qr, mfmq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mfmq *MergedForwardMessageQuery) AllX(ctx context.Context) []*MergedForwardMessage {
+	nodes, err := mfmq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MergedForwardMessage IDs.
+func (mfmq *MergedForwardMessageQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if mfmq.ctx.Unique == nil && mfmq.path != nil {
+		mfmq.Unique(true)
+	}
+	ctx = setContextOp(ctx, mfmq.ctx, ent.OpQueryIDs)
+	if err = mfmq.Select(mergedforwardmessage.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mfmq *MergedForwardMessageQuery) IDsX(ctx context.Context) []int {
+	ids, err := mfmq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mfmq *MergedForwardMessageQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, mfmq.ctx, ent.OpQueryCount)
+	if err := mfmq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, mfmq, querierCount[*MergedForwardMessageQuery](), mfmq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mfmq *MergedForwardMessageQuery) CountX(ctx context.Context) int {
+	count, err := mfmq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mfmq *MergedForwardMessageQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, mfmq.ctx, ent.OpQueryExist)
+	switch _, err := mfmq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mfmq *MergedForwardMessageQuery) ExistX(ctx context.Context) bool {
+	exist, err := mfmq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MergedForwardMessageQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mfmq *MergedForwardMessageQuery) Clone() *MergedForwardMessageQuery {
+	if mfmq == nil {
+		return nil
+	}
+	return &MergedForwardMessageQuery{
+		config:     mfmq.config,
+		ctx:        mfmq.ctx.Clone(),
+		order:      append([]mergedforwardmessage.OrderOption{}, mfmq.order...),
+		inters:     append([]Interceptor{}, mfmq.inters...),
+		predicates: append([]predicate.MergedForwardMessage{}, mfmq.predicates...),
+		// clone intermediate query.
+		sql:  mfmq.sql.Clone(),
+		path: mfmq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		MsgId string `json:"msgId,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MergedForwardMessage.Query().
+//		GroupBy(mergedforwardmessage.FieldMsgId).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (mfmq *MergedForwardMessageQuery) GroupBy(field string, fields ...string) *MergedForwardMessageGroupBy {
+	mfmq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &MergedForwardMessageGroupBy{build: mfmq}
+	grbuild.flds = &mfmq.ctx.Fields
+	grbuild.label = mergedforwardmessage.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		MsgId string `json:"msgId,omitempty"`
+//	}
+//
+//	client.MergedForwardMessage.Query().
+//		Select(mergedforwardmessage.FieldMsgId).
+//		Scan(ctx, &v)
+func (mfmq *MergedForwardMessageQuery) Select(fields ...string) *MergedForwardMessageSelect {
+	mfmq.ctx.Fields = append(mfmq.ctx.Fields, fields...)
+	sbuild := &MergedForwardMessageSelect{MergedForwardMessageQuery: mfmq}
+	sbuild.label = mergedforwardmessage.Label
+	sbuild.flds, sbuild.scan = &mfmq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a MergedForwardMessageSelect configured with the given aggregations.
+func (mfmq *MergedForwardMessageQuery) Aggregate(fns ...AggregateFunc) *MergedForwardMessageSelect {
+	return mfmq.Select().Aggregate(fns...)
+}
+
+func (mfmq *MergedForwardMessageQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range mfmq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, mfmq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range mfmq.ctx.Fields {
+		if !mergedforwardmessage.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if mfmq.path != nil {
+		prev, err := mfmq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mfmq.sql = prev
+	}
+	return nil
+}
+
+func (mfmq *MergedForwardMessageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MergedForwardMessage, error) {
+	var (
+		nodes = []*MergedForwardMessage{}
+		_spec = mfmq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MergedForwardMessage).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MergedForwardMessage{config: mfmq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mfmq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (mfmq *MergedForwardMessageQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mfmq.querySpec()
+	_spec.Node.Columns = mfmq.ctx.Fields
+	if len(mfmq.ctx.Fields) > 0 {
+		_spec.Unique = mfmq.ctx.Unique != nil && *mfmq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, mfmq.driver, _spec)
+}
+
+func (mfmq *MergedForwardMessageQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(mergedforwardmessage.Table, mergedforwardmessage.Columns, sqlgraph.NewFieldSpec(mergedforwardmessage.FieldID, field.TypeInt))
+	_spec.From = mfmq.sql
+	if unique := mfmq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if mfmq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := mfmq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mergedforwardmessage.FieldID)
+		for i := range fields {
+			if fields[i] != mergedforwardmessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := mfmq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mfmq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mfmq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mfmq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mfmq *MergedForwardMessageQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mfmq.driver.Dialect())
+	t1 := builder.Table(mergedforwardmessage.Table)
+	columns := mfmq.ctx.Fields
+	if len(columns) == 0 {
+		columns = mergedforwardmessage.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mfmq.sql != nil {
+		selector = mfmq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if mfmq.ctx.Unique != nil && *mfmq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range mfmq.predicates {
+		p(selector)
+	}
+	for _, p := range mfmq.order {
+		p(selector)
+	}
+	if offset := mfmq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mfmq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// MergedForwardMessageGroupBy is the group-by builder for MergedForwardMessage entities.
+type MergedForwardMessageGroupBy struct {
+	selector
+	build *MergedForwardMessageQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mfmgb *MergedForwardMessageGroupBy) Aggregate(fns ...AggregateFunc) *MergedForwardMessageGroupBy {
+	mfmgb.fns = append(mfmgb.fns, fns...)
+	return mfmgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mfmgb *MergedForwardMessageGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mfmgb.build.ctx, ent.OpQueryGroupBy)
+	if err := mfmgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MergedForwardMessageQuery, *MergedForwardMessageGroupBy](ctx, mfmgb.build, mfmgb, mfmgb.build.inters, v)
+}
+
+func (mfmgb *MergedForwardMessageGroupBy) sqlScan(ctx context.Context, root *MergedForwardMessageQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(mfmgb.fns))
+	for _, fn := range mfmgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*mfmgb.flds)+len(mfmgb.fns))
+		for _, f := range *mfmgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*mfmgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mfmgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// MergedForwardMessageSelect is the builder for selecting fields of MergedForwardMessage entities.
+type MergedForwardMessageSelect struct {
+	*MergedForwardMessageQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mfms *MergedForwardMessageSelect) Aggregate(fns ...AggregateFunc) *MergedForwardMessageSelect {
+	mfms.fns = append(mfms.fns, fns...)
+	return mfms
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mfms *MergedForwardMessageSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mfms.ctx, ent.OpQuerySelect)
+	if err := mfms.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MergedForwardMessageQuery, *MergedForwardMessageSelect](ctx, mfms.MergedForwardMessageQuery, mfms, mfms.inters, v)
+}
+
+func (mfms *MergedForwardMessageSelect) sqlScan(ctx context.Context, root *MergedForwardMessageQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mfms.fns))
+	for _, fn := range mfms.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mfms.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mfms.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
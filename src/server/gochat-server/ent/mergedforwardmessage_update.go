@@ -0,0 +1,323 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gochat_server/ent/mergedforwardmessage"
+	"gochat_server/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MergedForwardMessageUpdate is the builder for updating MergedForwardMessage entities.
+type MergedForwardMessageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MergedForwardMessageMutation
+}
+
+// Where appends a list predicates to the MergedForwardMessageUpdate builder.
+func (mfmu *MergedForwardMessageUpdate) Where(ps ...predicate.MergedForwardMessage) *MergedForwardMessageUpdate {
+	mfmu.mutation.Where(ps...)
+	return mfmu
+}
+
+// SetMsgId sets the "msgId" field.
+func (mfmu *MergedForwardMessageUpdate) SetMsgId(s string) *MergedForwardMessageUpdate {
+	mfmu.mutation.SetMsgId(s)
+	return mfmu
+}
+
+// SetNillableMsgId sets the "msgId" field if the given value is not nil.
+func (mfmu *MergedForwardMessageUpdate) SetNillableMsgId(s *string) *MergedForwardMessageUpdate {
+	if s != nil {
+		mfmu.SetMsgId(*s)
+	}
+	return mfmu
+}
+
+// SetTitle sets the "title" field.
+func (mfmu *MergedForwardMessageUpdate) SetTitle(s string) *MergedForwardMessageUpdate {
+	mfmu.mutation.SetTitle(s)
+	return mfmu
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (mfmu *MergedForwardMessageUpdate) SetNillableTitle(s *string) *MergedForwardMessageUpdate {
+	if s != nil {
+		mfmu.SetTitle(*s)
+	}
+	return mfmu
+}
+
+// SetPayload sets the "payload" field.
+func (mfmu *MergedForwardMessageUpdate) SetPayload(s string) *MergedForwardMessageUpdate {
+	mfmu.mutation.SetPayload(s)
+	return mfmu
+}
+
+// SetNillablePayload sets the "payload" field if the given value is not nil.
+func (mfmu *MergedForwardMessageUpdate) SetNillablePayload(s *string) *MergedForwardMessageUpdate {
+	if s != nil {
+		mfmu.SetPayload(*s)
+	}
+	return mfmu
+}
+
+// Mutation returns the MergedForwardMessageMutation object of the builder.
+func (mfmu *MergedForwardMessageUpdate) Mutation() *MergedForwardMessageMutation {
+	return mfmu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mfmu *MergedForwardMessageUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, mfmu.sqlSave, mfmu.mutation, mfmu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mfmu *MergedForwardMessageUpdate) SaveX(ctx context.Context) int {
+	affected, err := mfmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mfmu *MergedForwardMessageUpdate) Exec(ctx context.Context) error {
+	_, err := mfmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mfmu *MergedForwardMessageUpdate) ExecX(ctx context.Context) {
+	if err := mfmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mfmu *MergedForwardMessageUpdate) check() error {
+	if v, ok := mfmu.mutation.MsgId(); ok {
+		if err := mergedforwardmessage.MsgIdValidator(v); err != nil {
+			return &ValidationError{Name: "msgId", err: fmt.Errorf(`ent: validator failed for field "MergedForwardMessage.msgId": %w`, err)}
+		}
+	}
+	if v, ok := mfmu.mutation.Title(); ok {
+		if err := mergedforwardmessage.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "MergedForwardMessage.title": %w`, err)}
+		}
+	}
+	if v, ok := mfmu.mutation.Payload(); ok {
+		if err := mergedforwardmessage.PayloadValidator(v); err != nil {
+			return &ValidationError{Name: "payload", err: fmt.Errorf(`ent: validator failed for field "MergedForwardMessage.payload": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (mfmu *MergedForwardMessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mfmu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mergedforwardmessage.Table, mergedforwardmessage.Columns, sqlgraph.NewFieldSpec(mergedforwardmessage.FieldID, field.TypeInt))
+	if ps := mfmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mfmu.mutation.MsgId(); ok {
+		_spec.SetField(mergedforwardmessage.FieldMsgId, field.TypeString, value)
+	}
+	if value, ok := mfmu.mutation.Title(); ok {
+		_spec.SetField(mergedforwardmessage.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := mfmu.mutation.Payload(); ok {
+		_spec.SetField(mergedforwardmessage.FieldPayload, field.TypeString, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mfmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mergedforwardmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mfmu.mutation.done = true
+	return n, nil
+}
+
+// MergedForwardMessageUpdateOne is the builder for updating a single MergedForwardMessage entity.
+type MergedForwardMessageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MergedForwardMessageMutation
+}
+
+// SetMsgId sets the "msgId" field.
+func (mfmuo *MergedForwardMessageUpdateOne) SetMsgId(s string) *MergedForwardMessageUpdateOne {
+	mfmuo.mutation.SetMsgId(s)
+	return mfmuo
+}
+
+// SetNillableMsgId sets the "msgId" field if the given value is not nil.
+func (mfmuo *MergedForwardMessageUpdateOne) SetNillableMsgId(s *string) *MergedForwardMessageUpdateOne {
+	if s != nil {
+		mfmuo.SetMsgId(*s)
+	}
+	return mfmuo
+}
+
+// SetTitle sets the "title" field.
+func (mfmuo *MergedForwardMessageUpdateOne) SetTitle(s string) *MergedForwardMessageUpdateOne {
+	mfmuo.mutation.SetTitle(s)
+	return mfmuo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (mfmuo *MergedForwardMessageUpdateOne) SetNillableTitle(s *string) *MergedForwardMessageUpdateOne {
+	if s != nil {
+		mfmuo.SetTitle(*s)
+	}
+	return mfmuo
+}
+
+// SetPayload sets the "payload" field.
+func (mfmuo *MergedForwardMessageUpdateOne) SetPayload(s string) *MergedForwardMessageUpdateOne {
+	mfmuo.mutation.SetPayload(s)
+	return mfmuo
+}
+
+// SetNillablePayload sets the "payload" field if the given value is not nil.
+func (mfmuo *MergedForwardMessageUpdateOne) SetNillablePayload(s *string) *MergedForwardMessageUpdateOne {
+	if s != nil {
+		mfmuo.SetPayload(*s)
+	}
+	return mfmuo
+}
+
+// Mutation returns the MergedForwardMessageMutation object of the builder.
+func (mfmuo *MergedForwardMessageUpdateOne) Mutation() *MergedForwardMessageMutation {
+	return mfmuo.mutation
+}
+
+// Where appends a list predicates to the MergedForwardMessageUpdate builder.
+func (mfmuo *MergedForwardMessageUpdateOne) Where(ps ...predicate.MergedForwardMessage) *MergedForwardMessageUpdateOne {
+	mfmuo.mutation.Where(ps...)
+	return mfmuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mfmuo *MergedForwardMessageUpdateOne) Select(field string, fields ...string) *MergedForwardMessageUpdateOne {
+	mfmuo.fields = append([]string{field}, fields...)
+	return mfmuo
+}
+
+// Save executes the query and returns the updated MergedForwardMessage entity.
+func (mfmuo *MergedForwardMessageUpdateOne) Save(ctx context.Context) (*MergedForwardMessage, error) {
+	return withHooks(ctx, mfmuo.sqlSave, mfmuo.mutation, mfmuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mfmuo *MergedForwardMessageUpdateOne) SaveX(ctx context.Context) *MergedForwardMessage {
+	node, err := mfmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mfmuo *MergedForwardMessageUpdateOne) Exec(ctx context.Context) error {
+	_, err := mfmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mfmuo *MergedForwardMessageUpdateOne) ExecX(ctx context.Context) {
+	if err := mfmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mfmuo *MergedForwardMessageUpdateOne) check() error {
+	if v, ok := mfmuo.mutation.MsgId(); ok {
+		if err := mergedforwardmessage.MsgIdValidator(v); err != nil {
+			return &ValidationError{Name: "msgId", err: fmt.Errorf(`ent: validator failed for field "MergedForwardMessage.msgId": %w`, err)}
+		}
+	}
+	if v, ok := mfmuo.mutation.Title(); ok {
+		if err := mergedforwardmessage.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "MergedForwardMessage.title": %w`, err)}
+		}
+	}
+	if v, ok := mfmuo.mutation.Payload(); ok {
+		if err := mergedforwardmessage.PayloadValidator(v); err != nil {
+			return &ValidationError{Name: "payload", err: fmt.Errorf(`ent: validator failed for field "MergedForwardMessage.payload": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (mfmuo *MergedForwardMessageUpdateOne) sqlSave(ctx context.Context) (_node *MergedForwardMessage, err error) {
+	if err := mfmuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mergedforwardmessage.Table, mergedforwardmessage.Columns, sqlgraph.NewFieldSpec(mergedforwardmessage.FieldID, field.TypeInt))
+	id, ok := mfmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MergedForwardMessage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mfmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mergedforwardmessage.FieldID)
+		for _, f := range fields {
+			if !mergedforwardmessage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != mergedforwardmessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mfmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mfmuo.mutation.MsgId(); ok {
+		_spec.SetField(mergedforwardmessage.FieldMsgId, field.TypeString, value)
+	}
+	if value, ok := mfmuo.mutation.Title(); ok {
+		_spec.SetField(mergedforwardmessage.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := mfmuo.mutation.Payload(); ok {
+		_spec.SetField(mergedforwardmessage.FieldPayload, field.TypeString, value)
+	}
+	_node = &MergedForwardMessage{config: mfmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mfmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mergedforwardmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mfmuo.mutation.done = true
+	return _node, nil
+}
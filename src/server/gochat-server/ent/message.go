@@ -23,12 +23,22 @@ type Message struct {
 	MsgType string `json:"msgType,omitempty"`
 	// 消息内容(冗余存储,便于快速列表展示)
 	Content string `json:"content,omitempty"`
+	// 结构化消息体(JSON),包含类型、版本、内容、@提及和回复引用
+	Body string `json:"body,omitempty"`
 	// 是否已撤回
 	IsRevoked bool `json:"isRevoked,omitempty"`
 	// 撤回时间
 	RevokeTime *time.Time `json:"revokeTime,omitempty"`
 	// 创建时间
-	CreateTime   time.Time `json:"createTime,omitempty"`
+	CreateTime time.Time `json:"createTime,omitempty"`
+	// 过期时间，阅后即焚的消息到期后删除
+	ExpireTime *time.Time `json:"expireTime,omitempty"`
+	// 是否已超过保留期限被清理，清理后只保留占位记录
+	IsPurged bool `json:"isPurged,omitempty"`
+	// 清理时间
+	PurgeTime *time.Time `json:"purgeTime,omitempty"`
+	// 消息体引用的由本服务存储的媒体文件，用于判断文件是否仍被引用
+	StorageKey   string `json:"storageKey,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -37,13 +47,13 @@ func (*Message) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case message.FieldIsRevoked:
+		case message.FieldIsRevoked, message.FieldIsPurged:
 			values[i] = new(sql.NullBool)
 		case message.FieldID:
 			values[i] = new(sql.NullInt64)
-		case message.FieldMsgId, message.FieldMsgType, message.FieldContent:
+		case message.FieldMsgId, message.FieldMsgType, message.FieldContent, message.FieldBody, message.FieldStorageKey:
 			values[i] = new(sql.NullString)
-		case message.FieldRevokeTime, message.FieldCreateTime:
+		case message.FieldRevokeTime, message.FieldCreateTime, message.FieldExpireTime, message.FieldPurgeTime:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -84,6 +94,12 @@ func (m *Message) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				m.Content = value.String
 			}
+		case message.FieldBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body", values[i])
+			} else if value.Valid {
+				m.Body = value.String
+			}
 		case message.FieldIsRevoked:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field isRevoked", values[i])
@@ -103,6 +119,32 @@ func (m *Message) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				m.CreateTime = value.Time
 			}
+		case message.FieldExpireTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expireTime", values[i])
+			} else if value.Valid {
+				m.ExpireTime = new(time.Time)
+				*m.ExpireTime = value.Time
+			}
+		case message.FieldIsPurged:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field isPurged", values[i])
+			} else if value.Valid {
+				m.IsPurged = value.Bool
+			}
+		case message.FieldPurgeTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field purgeTime", values[i])
+			} else if value.Valid {
+				m.PurgeTime = new(time.Time)
+				*m.PurgeTime = value.Time
+			}
+		case message.FieldStorageKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field storageKey", values[i])
+			} else if value.Valid {
+				m.StorageKey = value.String
+			}
 		default:
 			m.selectValues.Set(columns[i], values[i])
 		}
@@ -148,6 +190,9 @@ func (m *Message) String() string {
 	builder.WriteString("content=")
 	builder.WriteString(m.Content)
 	builder.WriteString(", ")
+	builder.WriteString("body=")
+	builder.WriteString(m.Body)
+	builder.WriteString(", ")
 	builder.WriteString("isRevoked=")
 	builder.WriteString(fmt.Sprintf("%v", m.IsRevoked))
 	builder.WriteString(", ")
@@ -158,6 +203,22 @@ func (m *Message) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("createTime=")
 	builder.WriteString(m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := m.ExpireTime; v != nil {
+		builder.WriteString("expireTime=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("isPurged=")
+	builder.WriteString(fmt.Sprintf("%v", m.IsPurged))
+	builder.WriteString(", ")
+	if v := m.PurgeTime; v != nil {
+		builder.WriteString("purgeTime=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("storageKey=")
+	builder.WriteString(m.StorageKey)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -19,12 +19,22 @@ const (
 	FieldMsgType = "msg_type"
 	// FieldContent holds the string denoting the content field in the database.
 	FieldContent = "content"
+	// FieldBody holds the string denoting the body field in the database.
+	FieldBody = "body"
 	// FieldIsRevoked holds the string denoting the isrevoked field in the database.
 	FieldIsRevoked = "is_revoked"
 	// FieldRevokeTime holds the string denoting the revoketime field in the database.
 	FieldRevokeTime = "revoke_time"
 	// FieldCreateTime holds the string denoting the createtime field in the database.
 	FieldCreateTime = "create_time"
+	// FieldExpireTime holds the string denoting the expiretime field in the database.
+	FieldExpireTime = "expire_time"
+	// FieldIsPurged holds the string denoting the ispurged field in the database.
+	FieldIsPurged = "is_purged"
+	// FieldPurgeTime holds the string denoting the purgetime field in the database.
+	FieldPurgeTime = "purge_time"
+	// FieldStorageKey holds the string denoting the storagekey field in the database.
+	FieldStorageKey = "storage_key"
 	// Table holds the table name of the message in the database.
 	Table = "messages"
 )
@@ -35,9 +45,14 @@ var Columns = []string{
 	FieldMsgId,
 	FieldMsgType,
 	FieldContent,
+	FieldBody,
 	FieldIsRevoked,
 	FieldRevokeTime,
 	FieldCreateTime,
+	FieldExpireTime,
+	FieldIsPurged,
+	FieldPurgeTime,
+	FieldStorageKey,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -57,10 +72,16 @@ var (
 	MsgTypeValidator func(string) error
 	// ContentValidator is a validator for the "content" field. It is called by the builders before save.
 	ContentValidator func(string) error
+	// DefaultBody holds the default value on creation for the "body" field.
+	DefaultBody string
 	// DefaultIsRevoked holds the default value on creation for the "isRevoked" field.
 	DefaultIsRevoked bool
 	// DefaultCreateTime holds the default value on creation for the "createTime" field.
 	DefaultCreateTime func() time.Time
+	// DefaultIsPurged holds the default value on creation for the "isPurged" field.
+	DefaultIsPurged bool
+	// DefaultStorageKey holds the default value on creation for the "storageKey" field.
+	DefaultStorageKey string
 )
 
 // OrderOption defines the ordering options for the Message queries.
@@ -86,6 +107,11 @@ func ByContent(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldContent, opts...).ToFunc()
 }
 
+// ByBody orders the results by the body field.
+func ByBody(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBody, opts...).ToFunc()
+}
+
 // ByIsRevoked orders the results by the isRevoked field.
 func ByIsRevoked(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsRevoked, opts...).ToFunc()
@@ -100,3 +126,23 @@ func ByRevokeTime(opts ...sql.OrderTermOption) OrderOption {
 func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
 }
+
+// ByExpireTime orders the results by the expireTime field.
+func ByExpireTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpireTime, opts...).ToFunc()
+}
+
+// ByIsPurged orders the results by the isPurged field.
+func ByIsPurged(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsPurged, opts...).ToFunc()
+}
+
+// ByPurgeTime orders the results by the purgeTime field.
+func ByPurgeTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPurgeTime, opts...).ToFunc()
+}
+
+// ByStorageKey orders the results by the storageKey field.
+func ByStorageKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStorageKey, opts...).ToFunc()
+}
@@ -69,6 +69,11 @@ func Content(v string) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldContent, v))
 }
 
+// Body applies equality check predicate on the "body" field. It's identical to BodyEQ.
+func Body(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldBody, v))
+}
+
 // IsRevoked applies equality check predicate on the "isRevoked" field. It's identical to IsRevokedEQ.
 func IsRevoked(v bool) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldIsRevoked, v))
@@ -84,6 +89,26 @@ func CreateTime(v time.Time) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldCreateTime, v))
 }
 
+// ExpireTime applies equality check predicate on the "expireTime" field. It's identical to ExpireTimeEQ.
+func ExpireTime(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldExpireTime, v))
+}
+
+// IsPurged applies equality check predicate on the "isPurged" field. It's identical to IsPurgedEQ.
+func IsPurged(v bool) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldIsPurged, v))
+}
+
+// PurgeTime applies equality check predicate on the "purgeTime" field. It's identical to PurgeTimeEQ.
+func PurgeTime(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldPurgeTime, v))
+}
+
+// StorageKey applies equality check predicate on the "storageKey" field. It's identical to StorageKeyEQ.
+func StorageKey(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldStorageKey, v))
+}
+
 // MsgIdEQ applies the EQ predicate on the "msgId" field.
 func MsgIdEQ(v string) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldMsgId, v))
@@ -279,6 +304,71 @@ func ContentContainsFold(v string) predicate.Message {
 	return predicate.Message(sql.FieldContainsFold(FieldContent, v))
 }
 
+// BodyEQ applies the EQ predicate on the "body" field.
+func BodyEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldBody, v))
+}
+
+// BodyNEQ applies the NEQ predicate on the "body" field.
+func BodyNEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldBody, v))
+}
+
+// BodyIn applies the In predicate on the "body" field.
+func BodyIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldBody, vs...))
+}
+
+// BodyNotIn applies the NotIn predicate on the "body" field.
+func BodyNotIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldBody, vs...))
+}
+
+// BodyGT applies the GT predicate on the "body" field.
+func BodyGT(v string) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldBody, v))
+}
+
+// BodyGTE applies the GTE predicate on the "body" field.
+func BodyGTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldBody, v))
+}
+
+// BodyLT applies the LT predicate on the "body" field.
+func BodyLT(v string) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldBody, v))
+}
+
+// BodyLTE applies the LTE predicate on the "body" field.
+func BodyLTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldBody, v))
+}
+
+// BodyContains applies the Contains predicate on the "body" field.
+func BodyContains(v string) predicate.Message {
+	return predicate.Message(sql.FieldContains(FieldBody, v))
+}
+
+// BodyHasPrefix applies the HasPrefix predicate on the "body" field.
+func BodyHasPrefix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasPrefix(FieldBody, v))
+}
+
+// BodyHasSuffix applies the HasSuffix predicate on the "body" field.
+func BodyHasSuffix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasSuffix(FieldBody, v))
+}
+
+// BodyEqualFold applies the EqualFold predicate on the "body" field.
+func BodyEqualFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldEqualFold(FieldBody, v))
+}
+
+// BodyContainsFold applies the ContainsFold predicate on the "body" field.
+func BodyContainsFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldContainsFold(FieldBody, v))
+}
+
 // IsRevokedEQ applies the EQ predicate on the "isRevoked" field.
 func IsRevokedEQ(v bool) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldIsRevoked, v))
@@ -379,6 +469,181 @@ func CreateTimeLTE(v time.Time) predicate.Message {
 	return predicate.Message(sql.FieldLTE(FieldCreateTime, v))
 }
 
+// ExpireTimeEQ applies the EQ predicate on the "expireTime" field.
+func ExpireTimeEQ(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldExpireTime, v))
+}
+
+// ExpireTimeNEQ applies the NEQ predicate on the "expireTime" field.
+func ExpireTimeNEQ(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldExpireTime, v))
+}
+
+// ExpireTimeIn applies the In predicate on the "expireTime" field.
+func ExpireTimeIn(vs ...time.Time) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldExpireTime, vs...))
+}
+
+// ExpireTimeNotIn applies the NotIn predicate on the "expireTime" field.
+func ExpireTimeNotIn(vs ...time.Time) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldExpireTime, vs...))
+}
+
+// ExpireTimeGT applies the GT predicate on the "expireTime" field.
+func ExpireTimeGT(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldExpireTime, v))
+}
+
+// ExpireTimeGTE applies the GTE predicate on the "expireTime" field.
+func ExpireTimeGTE(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldExpireTime, v))
+}
+
+// ExpireTimeLT applies the LT predicate on the "expireTime" field.
+func ExpireTimeLT(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldExpireTime, v))
+}
+
+// ExpireTimeLTE applies the LTE predicate on the "expireTime" field.
+func ExpireTimeLTE(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldExpireTime, v))
+}
+
+// ExpireTimeIsNil applies the IsNil predicate on the "expireTime" field.
+func ExpireTimeIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldExpireTime))
+}
+
+// ExpireTimeNotNil applies the NotNil predicate on the "expireTime" field.
+func ExpireTimeNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldExpireTime))
+}
+
+// IsPurgedEQ applies the EQ predicate on the "isPurged" field.
+func IsPurgedEQ(v bool) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldIsPurged, v))
+}
+
+// IsPurgedNEQ applies the NEQ predicate on the "isPurged" field.
+func IsPurgedNEQ(v bool) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldIsPurged, v))
+}
+
+// PurgeTimeEQ applies the EQ predicate on the "purgeTime" field.
+func PurgeTimeEQ(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldPurgeTime, v))
+}
+
+// PurgeTimeNEQ applies the NEQ predicate on the "purgeTime" field.
+func PurgeTimeNEQ(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldPurgeTime, v))
+}
+
+// PurgeTimeIn applies the In predicate on the "purgeTime" field.
+func PurgeTimeIn(vs ...time.Time) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldPurgeTime, vs...))
+}
+
+// PurgeTimeNotIn applies the NotIn predicate on the "purgeTime" field.
+func PurgeTimeNotIn(vs ...time.Time) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldPurgeTime, vs...))
+}
+
+// PurgeTimeGT applies the GT predicate on the "purgeTime" field.
+func PurgeTimeGT(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldPurgeTime, v))
+}
+
+// PurgeTimeGTE applies the GTE predicate on the "purgeTime" field.
+func PurgeTimeGTE(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldPurgeTime, v))
+}
+
+// PurgeTimeLT applies the LT predicate on the "purgeTime" field.
+func PurgeTimeLT(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldPurgeTime, v))
+}
+
+// PurgeTimeLTE applies the LTE predicate on the "purgeTime" field.
+func PurgeTimeLTE(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldPurgeTime, v))
+}
+
+// PurgeTimeIsNil applies the IsNil predicate on the "purgeTime" field.
+func PurgeTimeIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldPurgeTime))
+}
+
+// PurgeTimeNotNil applies the NotNil predicate on the "purgeTime" field.
+func PurgeTimeNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldPurgeTime))
+}
+
+// StorageKeyEQ applies the EQ predicate on the "storageKey" field.
+func StorageKeyEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldStorageKey, v))
+}
+
+// StorageKeyNEQ applies the NEQ predicate on the "storageKey" field.
+func StorageKeyNEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldStorageKey, v))
+}
+
+// StorageKeyIn applies the In predicate on the "storageKey" field.
+func StorageKeyIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldStorageKey, vs...))
+}
+
+// StorageKeyNotIn applies the NotIn predicate on the "storageKey" field.
+func StorageKeyNotIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldStorageKey, vs...))
+}
+
+// StorageKeyGT applies the GT predicate on the "storageKey" field.
+func StorageKeyGT(v string) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldStorageKey, v))
+}
+
+// StorageKeyGTE applies the GTE predicate on the "storageKey" field.
+func StorageKeyGTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldStorageKey, v))
+}
+
+// StorageKeyLT applies the LT predicate on the "storageKey" field.
+func StorageKeyLT(v string) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldStorageKey, v))
+}
+
+// StorageKeyLTE applies the LTE predicate on the "storageKey" field.
+func StorageKeyLTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldStorageKey, v))
+}
+
+// StorageKeyContains applies the Contains predicate on the "storageKey" field.
+func StorageKeyContains(v string) predicate.Message {
+	return predicate.Message(sql.FieldContains(FieldStorageKey, v))
+}
+
+// StorageKeyHasPrefix applies the HasPrefix predicate on the "storageKey" field.
+func StorageKeyHasPrefix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasPrefix(FieldStorageKey, v))
+}
+
+// StorageKeyHasSuffix applies the HasSuffix predicate on the "storageKey" field.
+func StorageKeyHasSuffix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasSuffix(FieldStorageKey, v))
+}
+
+// StorageKeyEqualFold applies the EqualFold predicate on the "storageKey" field.
+func StorageKeyEqualFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldEqualFold(FieldStorageKey, v))
+}
+
+// StorageKeyContainsFold applies the ContainsFold predicate on the "storageKey" field.
+func StorageKeyContainsFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldContainsFold(FieldStorageKey, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Message) predicate.Message {
 	return predicate.Message(sql.AndPredicates(predicates...))
@@ -38,6 +38,20 @@ func (mc *MessageCreate) SetContent(s string) *MessageCreate {
 	return mc
 }
 
+// SetBody sets the "body" field.
+func (mc *MessageCreate) SetBody(s string) *MessageCreate {
+	mc.mutation.SetBody(s)
+	return mc
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableBody(s *string) *MessageCreate {
+	if s != nil {
+		mc.SetBody(*s)
+	}
+	return mc
+}
+
 // SetIsRevoked sets the "isRevoked" field.
 func (mc *MessageCreate) SetIsRevoked(b bool) *MessageCreate {
 	mc.mutation.SetIsRevoked(b)
@@ -80,6 +94,62 @@ func (mc *MessageCreate) SetNillableCreateTime(t *time.Time) *MessageCreate {
 	return mc
 }
 
+// SetExpireTime sets the "expireTime" field.
+func (mc *MessageCreate) SetExpireTime(t time.Time) *MessageCreate {
+	mc.mutation.SetExpireTime(t)
+	return mc
+}
+
+// SetNillableExpireTime sets the "expireTime" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableExpireTime(t *time.Time) *MessageCreate {
+	if t != nil {
+		mc.SetExpireTime(*t)
+	}
+	return mc
+}
+
+// SetIsPurged sets the "isPurged" field.
+func (mc *MessageCreate) SetIsPurged(b bool) *MessageCreate {
+	mc.mutation.SetIsPurged(b)
+	return mc
+}
+
+// SetNillableIsPurged sets the "isPurged" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableIsPurged(b *bool) *MessageCreate {
+	if b != nil {
+		mc.SetIsPurged(*b)
+	}
+	return mc
+}
+
+// SetPurgeTime sets the "purgeTime" field.
+func (mc *MessageCreate) SetPurgeTime(t time.Time) *MessageCreate {
+	mc.mutation.SetPurgeTime(t)
+	return mc
+}
+
+// SetNillablePurgeTime sets the "purgeTime" field if the given value is not nil.
+func (mc *MessageCreate) SetNillablePurgeTime(t *time.Time) *MessageCreate {
+	if t != nil {
+		mc.SetPurgeTime(*t)
+	}
+	return mc
+}
+
+// SetStorageKey sets the "storageKey" field.
+func (mc *MessageCreate) SetStorageKey(s string) *MessageCreate {
+	mc.mutation.SetStorageKey(s)
+	return mc
+}
+
+// SetNillableStorageKey sets the "storageKey" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableStorageKey(s *string) *MessageCreate {
+	if s != nil {
+		mc.SetStorageKey(*s)
+	}
+	return mc
+}
+
 // Mutation returns the MessageMutation object of the builder.
 func (mc *MessageCreate) Mutation() *MessageMutation {
 	return mc.mutation
@@ -115,6 +185,10 @@ func (mc *MessageCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (mc *MessageCreate) defaults() {
+	if _, ok := mc.mutation.Body(); !ok {
+		v := message.DefaultBody
+		mc.mutation.SetBody(v)
+	}
 	if _, ok := mc.mutation.IsRevoked(); !ok {
 		v := message.DefaultIsRevoked
 		mc.mutation.SetIsRevoked(v)
@@ -123,6 +197,14 @@ func (mc *MessageCreate) defaults() {
 		v := message.DefaultCreateTime()
 		mc.mutation.SetCreateTime(v)
 	}
+	if _, ok := mc.mutation.IsPurged(); !ok {
+		v := message.DefaultIsPurged
+		mc.mutation.SetIsPurged(v)
+	}
+	if _, ok := mc.mutation.StorageKey(); !ok {
+		v := message.DefaultStorageKey
+		mc.mutation.SetStorageKey(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -151,12 +233,21 @@ func (mc *MessageCreate) check() error {
 			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "Message.content": %w`, err)}
 		}
 	}
+	if _, ok := mc.mutation.Body(); !ok {
+		return &ValidationError{Name: "body", err: errors.New(`ent: missing required field "Message.body"`)}
+	}
 	if _, ok := mc.mutation.IsRevoked(); !ok {
 		return &ValidationError{Name: "isRevoked", err: errors.New(`ent: missing required field "Message.isRevoked"`)}
 	}
 	if _, ok := mc.mutation.CreateTime(); !ok {
 		return &ValidationError{Name: "createTime", err: errors.New(`ent: missing required field "Message.createTime"`)}
 	}
+	if _, ok := mc.mutation.IsPurged(); !ok {
+		return &ValidationError{Name: "isPurged", err: errors.New(`ent: missing required field "Message.isPurged"`)}
+	}
+	if _, ok := mc.mutation.StorageKey(); !ok {
+		return &ValidationError{Name: "storageKey", err: errors.New(`ent: missing required field "Message.storageKey"`)}
+	}
 	return nil
 }
 
@@ -195,6 +286,10 @@ func (mc *MessageCreate) createSpec() (*Message, *sqlgraph.CreateSpec) {
 		_spec.SetField(message.FieldContent, field.TypeString, value)
 		_node.Content = value
 	}
+	if value, ok := mc.mutation.Body(); ok {
+		_spec.SetField(message.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
 	if value, ok := mc.mutation.IsRevoked(); ok {
 		_spec.SetField(message.FieldIsRevoked, field.TypeBool, value)
 		_node.IsRevoked = value
@@ -207,6 +302,22 @@ func (mc *MessageCreate) createSpec() (*Message, *sqlgraph.CreateSpec) {
 		_spec.SetField(message.FieldCreateTime, field.TypeTime, value)
 		_node.CreateTime = value
 	}
+	if value, ok := mc.mutation.ExpireTime(); ok {
+		_spec.SetField(message.FieldExpireTime, field.TypeTime, value)
+		_node.ExpireTime = &value
+	}
+	if value, ok := mc.mutation.IsPurged(); ok {
+		_spec.SetField(message.FieldIsPurged, field.TypeBool, value)
+		_node.IsPurged = value
+	}
+	if value, ok := mc.mutation.PurgeTime(); ok {
+		_spec.SetField(message.FieldPurgeTime, field.TypeTime, value)
+		_node.PurgeTime = &value
+	}
+	if value, ok := mc.mutation.StorageKey(); ok {
+		_spec.SetField(message.FieldStorageKey, field.TypeString, value)
+		_node.StorageKey = value
+	}
 	return _node, _spec
 }
 
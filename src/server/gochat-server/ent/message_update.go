@@ -70,6 +70,20 @@ func (mu *MessageUpdate) SetNillableContent(s *string) *MessageUpdate {
 	return mu
 }
 
+// SetBody sets the "body" field.
+func (mu *MessageUpdate) SetBody(s string) *MessageUpdate {
+	mu.mutation.SetBody(s)
+	return mu
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableBody(s *string) *MessageUpdate {
+	if s != nil {
+		mu.SetBody(*s)
+	}
+	return mu
+}
+
 // SetIsRevoked sets the "isRevoked" field.
 func (mu *MessageUpdate) SetIsRevoked(b bool) *MessageUpdate {
 	mu.mutation.SetIsRevoked(b)
@@ -118,6 +132,74 @@ func (mu *MessageUpdate) SetNillableCreateTime(t *time.Time) *MessageUpdate {
 	return mu
 }
 
+// SetExpireTime sets the "expireTime" field.
+func (mu *MessageUpdate) SetExpireTime(t time.Time) *MessageUpdate {
+	mu.mutation.SetExpireTime(t)
+	return mu
+}
+
+// SetNillableExpireTime sets the "expireTime" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableExpireTime(t *time.Time) *MessageUpdate {
+	if t != nil {
+		mu.SetExpireTime(*t)
+	}
+	return mu
+}
+
+// ClearExpireTime clears the value of the "expireTime" field.
+func (mu *MessageUpdate) ClearExpireTime() *MessageUpdate {
+	mu.mutation.ClearExpireTime()
+	return mu
+}
+
+// SetIsPurged sets the "isPurged" field.
+func (mu *MessageUpdate) SetIsPurged(b bool) *MessageUpdate {
+	mu.mutation.SetIsPurged(b)
+	return mu
+}
+
+// SetNillableIsPurged sets the "isPurged" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableIsPurged(b *bool) *MessageUpdate {
+	if b != nil {
+		mu.SetIsPurged(*b)
+	}
+	return mu
+}
+
+// SetPurgeTime sets the "purgeTime" field.
+func (mu *MessageUpdate) SetPurgeTime(t time.Time) *MessageUpdate {
+	mu.mutation.SetPurgeTime(t)
+	return mu
+}
+
+// SetNillablePurgeTime sets the "purgeTime" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillablePurgeTime(t *time.Time) *MessageUpdate {
+	if t != nil {
+		mu.SetPurgeTime(*t)
+	}
+	return mu
+}
+
+// ClearPurgeTime clears the value of the "purgeTime" field.
+func (mu *MessageUpdate) ClearPurgeTime() *MessageUpdate {
+	mu.mutation.ClearPurgeTime()
+	return mu
+}
+
+// SetStorageKey sets the "storageKey" field.
+func (mu *MessageUpdate) SetStorageKey(s string) *MessageUpdate {
+	mu.mutation.SetStorageKey(s)
+	return mu
+}
+
+// SetNillableStorageKey sets the "storageKey" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableStorageKey(s *string) *MessageUpdate {
+	if s != nil {
+		mu.SetStorageKey(*s)
+	}
+	return mu
+}
+
 // Mutation returns the MessageMutation object of the builder.
 func (mu *MessageUpdate) Mutation() *MessageMutation {
 	return mu.mutation
@@ -191,6 +273,9 @@ func (mu *MessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := mu.mutation.Content(); ok {
 		_spec.SetField(message.FieldContent, field.TypeString, value)
 	}
+	if value, ok := mu.mutation.Body(); ok {
+		_spec.SetField(message.FieldBody, field.TypeString, value)
+	}
 	if value, ok := mu.mutation.IsRevoked(); ok {
 		_spec.SetField(message.FieldIsRevoked, field.TypeBool, value)
 	}
@@ -203,6 +288,24 @@ func (mu *MessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := mu.mutation.CreateTime(); ok {
 		_spec.SetField(message.FieldCreateTime, field.TypeTime, value)
 	}
+	if value, ok := mu.mutation.ExpireTime(); ok {
+		_spec.SetField(message.FieldExpireTime, field.TypeTime, value)
+	}
+	if mu.mutation.ExpireTimeCleared() {
+		_spec.ClearField(message.FieldExpireTime, field.TypeTime)
+	}
+	if value, ok := mu.mutation.IsPurged(); ok {
+		_spec.SetField(message.FieldIsPurged, field.TypeBool, value)
+	}
+	if value, ok := mu.mutation.PurgeTime(); ok {
+		_spec.SetField(message.FieldPurgeTime, field.TypeTime, value)
+	}
+	if mu.mutation.PurgeTimeCleared() {
+		_spec.ClearField(message.FieldPurgeTime, field.TypeTime)
+	}
+	if value, ok := mu.mutation.StorageKey(); ok {
+		_spec.SetField(message.FieldStorageKey, field.TypeString, value)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, mu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{message.Label}
@@ -265,6 +368,20 @@ func (muo *MessageUpdateOne) SetNillableContent(s *string) *MessageUpdateOne {
 	return muo
 }
 
+// SetBody sets the "body" field.
+func (muo *MessageUpdateOne) SetBody(s string) *MessageUpdateOne {
+	muo.mutation.SetBody(s)
+	return muo
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableBody(s *string) *MessageUpdateOne {
+	if s != nil {
+		muo.SetBody(*s)
+	}
+	return muo
+}
+
 // SetIsRevoked sets the "isRevoked" field.
 func (muo *MessageUpdateOne) SetIsRevoked(b bool) *MessageUpdateOne {
 	muo.mutation.SetIsRevoked(b)
@@ -313,6 +430,74 @@ func (muo *MessageUpdateOne) SetNillableCreateTime(t *time.Time) *MessageUpdateO
 	return muo
 }
 
+// SetExpireTime sets the "expireTime" field.
+func (muo *MessageUpdateOne) SetExpireTime(t time.Time) *MessageUpdateOne {
+	muo.mutation.SetExpireTime(t)
+	return muo
+}
+
+// SetNillableExpireTime sets the "expireTime" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableExpireTime(t *time.Time) *MessageUpdateOne {
+	if t != nil {
+		muo.SetExpireTime(*t)
+	}
+	return muo
+}
+
+// ClearExpireTime clears the value of the "expireTime" field.
+func (muo *MessageUpdateOne) ClearExpireTime() *MessageUpdateOne {
+	muo.mutation.ClearExpireTime()
+	return muo
+}
+
+// SetIsPurged sets the "isPurged" field.
+func (muo *MessageUpdateOne) SetIsPurged(b bool) *MessageUpdateOne {
+	muo.mutation.SetIsPurged(b)
+	return muo
+}
+
+// SetNillableIsPurged sets the "isPurged" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableIsPurged(b *bool) *MessageUpdateOne {
+	if b != nil {
+		muo.SetIsPurged(*b)
+	}
+	return muo
+}
+
+// SetPurgeTime sets the "purgeTime" field.
+func (muo *MessageUpdateOne) SetPurgeTime(t time.Time) *MessageUpdateOne {
+	muo.mutation.SetPurgeTime(t)
+	return muo
+}
+
+// SetNillablePurgeTime sets the "purgeTime" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillablePurgeTime(t *time.Time) *MessageUpdateOne {
+	if t != nil {
+		muo.SetPurgeTime(*t)
+	}
+	return muo
+}
+
+// ClearPurgeTime clears the value of the "purgeTime" field.
+func (muo *MessageUpdateOne) ClearPurgeTime() *MessageUpdateOne {
+	muo.mutation.ClearPurgeTime()
+	return muo
+}
+
+// SetStorageKey sets the "storageKey" field.
+func (muo *MessageUpdateOne) SetStorageKey(s string) *MessageUpdateOne {
+	muo.mutation.SetStorageKey(s)
+	return muo
+}
+
+// SetNillableStorageKey sets the "storageKey" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableStorageKey(s *string) *MessageUpdateOne {
+	if s != nil {
+		muo.SetStorageKey(*s)
+	}
+	return muo
+}
+
 // Mutation returns the MessageMutation object of the builder.
 func (muo *MessageUpdateOne) Mutation() *MessageMutation {
 	return muo.mutation
@@ -416,6 +601,9 @@ func (muo *MessageUpdateOne) sqlSave(ctx context.Context) (_node *Message, err e
 	if value, ok := muo.mutation.Content(); ok {
 		_spec.SetField(message.FieldContent, field.TypeString, value)
 	}
+	if value, ok := muo.mutation.Body(); ok {
+		_spec.SetField(message.FieldBody, field.TypeString, value)
+	}
 	if value, ok := muo.mutation.IsRevoked(); ok {
 		_spec.SetField(message.FieldIsRevoked, field.TypeBool, value)
 	}
@@ -428,6 +616,24 @@ func (muo *MessageUpdateOne) sqlSave(ctx context.Context) (_node *Message, err e
 	if value, ok := muo.mutation.CreateTime(); ok {
 		_spec.SetField(message.FieldCreateTime, field.TypeTime, value)
 	}
+	if value, ok := muo.mutation.ExpireTime(); ok {
+		_spec.SetField(message.FieldExpireTime, field.TypeTime, value)
+	}
+	if muo.mutation.ExpireTimeCleared() {
+		_spec.ClearField(message.FieldExpireTime, field.TypeTime)
+	}
+	if value, ok := muo.mutation.IsPurged(); ok {
+		_spec.SetField(message.FieldIsPurged, field.TypeBool, value)
+	}
+	if value, ok := muo.mutation.PurgeTime(); ok {
+		_spec.SetField(message.FieldPurgeTime, field.TypeTime, value)
+	}
+	if muo.mutation.PurgeTimeCleared() {
+		_spec.ClearField(message.FieldPurgeTime, field.TypeTime)
+	}
+	if value, ok := muo.mutation.StorageKey(); ok {
+		_spec.SetField(message.FieldStorageKey, field.TypeString, value)
+	}
 	_node = &Message{config: muo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
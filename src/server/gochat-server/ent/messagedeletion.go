@@ -0,0 +1,152 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"gochat_server/ent/messagedeletion"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// MessageDeletion is the model entity for the MessageDeletion schema.
+type MessageDeletion struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 删除消息的用户ID
+	UserId int `json:"userId,omitempty"`
+	// 消息ID
+	MsgId string `json:"msgId,omitempty"`
+	// 是否为群聊消息
+	IsGroup bool `json:"isGroup,omitempty"`
+	// 所在会话：私聊为对方用户ID，群聊为群组ID
+	TargetId int `json:"targetId,omitempty"`
+	// 删除时间
+	CreateTime   time.Time `json:"createTime,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MessageDeletion) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case messagedeletion.FieldIsGroup:
+			values[i] = new(sql.NullBool)
+		case messagedeletion.FieldID, messagedeletion.FieldUserId, messagedeletion.FieldTargetId:
+			values[i] = new(sql.NullInt64)
+		case messagedeletion.FieldMsgId:
+			values[i] = new(sql.NullString)
+		case messagedeletion.FieldCreateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MessageDeletion fields.
+func (md *MessageDeletion) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case messagedeletion.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			md.ID = int(value.Int64)
+		case messagedeletion.FieldUserId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field userId", values[i])
+			} else if value.Valid {
+				md.UserId = int(value.Int64)
+			}
+		case messagedeletion.FieldMsgId:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field msgId", values[i])
+			} else if value.Valid {
+				md.MsgId = value.String
+			}
+		case messagedeletion.FieldIsGroup:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field isGroup", values[i])
+			} else if value.Valid {
+				md.IsGroup = value.Bool
+			}
+		case messagedeletion.FieldTargetId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field targetId", values[i])
+			} else if value.Valid {
+				md.TargetId = int(value.Int64)
+			}
+		case messagedeletion.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field createTime", values[i])
+			} else if value.Valid {
+				md.CreateTime = value.Time
+			}
+		default:
+			md.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MessageDeletion.
+// This includes values selected through modifiers, order, etc.
+func (md *MessageDeletion) Value(name string) (ent.Value, error) {
+	return md.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this MessageDeletion.
+// Note that you need to call MessageDeletion.Unwrap() before calling this method if this MessageDeletion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (md *MessageDeletion) Update() *MessageDeletionUpdateOne {
+	return NewMessageDeletionClient(md.config).UpdateOne(md)
+}
+
+// Unwrap unwraps the MessageDeletion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (md *MessageDeletion) Unwrap() *MessageDeletion {
+	_tx, ok := md.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MessageDeletion is not a transactional entity")
+	}
+	md.config.driver = _tx.drv
+	return md
+}
+
+// String implements the fmt.Stringer.
+func (md *MessageDeletion) String() string {
+	var builder strings.Builder
+	builder.WriteString("MessageDeletion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", md.ID))
+	builder.WriteString("userId=")
+	builder.WriteString(fmt.Sprintf("%v", md.UserId))
+	builder.WriteString(", ")
+	builder.WriteString("msgId=")
+	builder.WriteString(md.MsgId)
+	builder.WriteString(", ")
+	builder.WriteString("isGroup=")
+	builder.WriteString(fmt.Sprintf("%v", md.IsGroup))
+	builder.WriteString(", ")
+	builder.WriteString("targetId=")
+	builder.WriteString(fmt.Sprintf("%v", md.TargetId))
+	builder.WriteString(", ")
+	builder.WriteString("createTime=")
+	builder.WriteString(md.CreateTime.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MessageDeletions is a parsable slice of MessageDeletion.
+type MessageDeletions []*MessageDeletion
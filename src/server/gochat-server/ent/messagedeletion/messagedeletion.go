@@ -0,0 +1,90 @@
+// Code generated by ent, DO NOT EDIT.
+
+package messagedeletion
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the messagedeletion type in the database.
+	Label = "message_deletion"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserId holds the string denoting the userid field in the database.
+	FieldUserId = "user_id"
+	// FieldMsgId holds the string denoting the msgid field in the database.
+	FieldMsgId = "msg_id"
+	// FieldIsGroup holds the string denoting the isgroup field in the database.
+	FieldIsGroup = "is_group"
+	// FieldTargetId holds the string denoting the targetid field in the database.
+	FieldTargetId = "target_id"
+	// FieldCreateTime holds the string denoting the createtime field in the database.
+	FieldCreateTime = "create_time"
+	// Table holds the table name of the messagedeletion in the database.
+	Table = "message_deletions"
+)
+
+// Columns holds all SQL columns for messagedeletion fields.
+var Columns = []string{
+	FieldID,
+	FieldUserId,
+	FieldMsgId,
+	FieldIsGroup,
+	FieldTargetId,
+	FieldCreateTime,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// MsgIdValidator is a validator for the "msgId" field. It is called by the builders before save.
+	MsgIdValidator func(string) error
+	// DefaultIsGroup holds the default value on creation for the "isGroup" field.
+	DefaultIsGroup bool
+	// DefaultCreateTime holds the default value on creation for the "createTime" field.
+	DefaultCreateTime func() time.Time
+)
+
+// OrderOption defines the ordering options for the MessageDeletion queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserId orders the results by the userId field.
+func ByUserId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserId, opts...).ToFunc()
+}
+
+// ByMsgId orders the results by the msgId field.
+func ByMsgId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMsgId, opts...).ToFunc()
+}
+
+// ByIsGroup orders the results by the isGroup field.
+func ByIsGroup(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsGroup, opts...).ToFunc()
+}
+
+// ByTargetId orders the results by the targetId field.
+func ByTargetId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetId, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the createTime field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
@@ -0,0 +1,290 @@
+// Code generated by ent, DO NOT EDIT.
+
+package messagedeletion
+
+import (
+	"gochat_server/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldLTE(FieldID, id))
+}
+
+// UserId applies equality check predicate on the "userId" field. It's identical to UserIdEQ.
+func UserId(v int) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldEQ(FieldUserId, v))
+}
+
+// MsgId applies equality check predicate on the "msgId" field. It's identical to MsgIdEQ.
+func MsgId(v string) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldEQ(FieldMsgId, v))
+}
+
+// IsGroup applies equality check predicate on the "isGroup" field. It's identical to IsGroupEQ.
+func IsGroup(v bool) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldEQ(FieldIsGroup, v))
+}
+
+// TargetId applies equality check predicate on the "targetId" field. It's identical to TargetIdEQ.
+func TargetId(v int) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldEQ(FieldTargetId, v))
+}
+
+// CreateTime applies equality check predicate on the "createTime" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UserIdEQ applies the EQ predicate on the "userId" field.
+func UserIdEQ(v int) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldEQ(FieldUserId, v))
+}
+
+// UserIdNEQ applies the NEQ predicate on the "userId" field.
+func UserIdNEQ(v int) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldNEQ(FieldUserId, v))
+}
+
+// UserIdIn applies the In predicate on the "userId" field.
+func UserIdIn(vs ...int) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldIn(FieldUserId, vs...))
+}
+
+// UserIdNotIn applies the NotIn predicate on the "userId" field.
+func UserIdNotIn(vs ...int) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldNotIn(FieldUserId, vs...))
+}
+
+// UserIdGT applies the GT predicate on the "userId" field.
+func UserIdGT(v int) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldGT(FieldUserId, v))
+}
+
+// UserIdGTE applies the GTE predicate on the "userId" field.
+func UserIdGTE(v int) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldGTE(FieldUserId, v))
+}
+
+// UserIdLT applies the LT predicate on the "userId" field.
+func UserIdLT(v int) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldLT(FieldUserId, v))
+}
+
+// UserIdLTE applies the LTE predicate on the "userId" field.
+func UserIdLTE(v int) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldLTE(FieldUserId, v))
+}
+
+// MsgIdEQ applies the EQ predicate on the "msgId" field.
+func MsgIdEQ(v string) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldEQ(FieldMsgId, v))
+}
+
+// MsgIdNEQ applies the NEQ predicate on the "msgId" field.
+func MsgIdNEQ(v string) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldNEQ(FieldMsgId, v))
+}
+
+// MsgIdIn applies the In predicate on the "msgId" field.
+func MsgIdIn(vs ...string) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldIn(FieldMsgId, vs...))
+}
+
+// MsgIdNotIn applies the NotIn predicate on the "msgId" field.
+func MsgIdNotIn(vs ...string) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldNotIn(FieldMsgId, vs...))
+}
+
+// MsgIdGT applies the GT predicate on the "msgId" field.
+func MsgIdGT(v string) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldGT(FieldMsgId, v))
+}
+
+// MsgIdGTE applies the GTE predicate on the "msgId" field.
+func MsgIdGTE(v string) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldGTE(FieldMsgId, v))
+}
+
+// MsgIdLT applies the LT predicate on the "msgId" field.
+func MsgIdLT(v string) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldLT(FieldMsgId, v))
+}
+
+// MsgIdLTE applies the LTE predicate on the "msgId" field.
+func MsgIdLTE(v string) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldLTE(FieldMsgId, v))
+}
+
+// MsgIdContains applies the Contains predicate on the "msgId" field.
+func MsgIdContains(v string) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldContains(FieldMsgId, v))
+}
+
+// MsgIdHasPrefix applies the HasPrefix predicate on the "msgId" field.
+func MsgIdHasPrefix(v string) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldHasPrefix(FieldMsgId, v))
+}
+
+// MsgIdHasSuffix applies the HasSuffix predicate on the "msgId" field.
+func MsgIdHasSuffix(v string) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldHasSuffix(FieldMsgId, v))
+}
+
+// MsgIdEqualFold applies the EqualFold predicate on the "msgId" field.
+func MsgIdEqualFold(v string) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldEqualFold(FieldMsgId, v))
+}
+
+// MsgIdContainsFold applies the ContainsFold predicate on the "msgId" field.
+func MsgIdContainsFold(v string) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldContainsFold(FieldMsgId, v))
+}
+
+// IsGroupEQ applies the EQ predicate on the "isGroup" field.
+func IsGroupEQ(v bool) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldEQ(FieldIsGroup, v))
+}
+
+// IsGroupNEQ applies the NEQ predicate on the "isGroup" field.
+func IsGroupNEQ(v bool) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldNEQ(FieldIsGroup, v))
+}
+
+// TargetIdEQ applies the EQ predicate on the "targetId" field.
+func TargetIdEQ(v int) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldEQ(FieldTargetId, v))
+}
+
+// TargetIdNEQ applies the NEQ predicate on the "targetId" field.
+func TargetIdNEQ(v int) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldNEQ(FieldTargetId, v))
+}
+
+// TargetIdIn applies the In predicate on the "targetId" field.
+func TargetIdIn(vs ...int) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldIn(FieldTargetId, vs...))
+}
+
+// TargetIdNotIn applies the NotIn predicate on the "targetId" field.
+func TargetIdNotIn(vs ...int) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldNotIn(FieldTargetId, vs...))
+}
+
+// TargetIdGT applies the GT predicate on the "targetId" field.
+func TargetIdGT(v int) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldGT(FieldTargetId, v))
+}
+
+// TargetIdGTE applies the GTE predicate on the "targetId" field.
+func TargetIdGTE(v int) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldGTE(FieldTargetId, v))
+}
+
+// TargetIdLT applies the LT predicate on the "targetId" field.
+func TargetIdLT(v int) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldLT(FieldTargetId, v))
+}
+
+// TargetIdLTE applies the LTE predicate on the "targetId" field.
+func TargetIdLTE(v int) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldLTE(FieldTargetId, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "createTime" field.
+func CreateTimeEQ(v time.Time) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "createTime" field.
+func CreateTimeNEQ(v time.Time) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "createTime" field.
+func CreateTimeIn(vs ...time.Time) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "createTime" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "createTime" field.
+func CreateTimeGT(v time.Time) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "createTime" field.
+func CreateTimeGTE(v time.Time) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "createTime" field.
+func CreateTimeLT(v time.Time) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "createTime" field.
+func CreateTimeLTE(v time.Time) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MessageDeletion) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MessageDeletion) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MessageDeletion) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.NotPredicates(p))
+}
@@ -0,0 +1,271 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gochat_server/ent/messagedeletion"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MessageDeletionCreate is the builder for creating a MessageDeletion entity.
+type MessageDeletionCreate struct {
+	config
+	mutation *MessageDeletionMutation
+	hooks    []Hook
+}
+
+// SetUserId sets the "userId" field.
+func (mdc *MessageDeletionCreate) SetUserId(i int) *MessageDeletionCreate {
+	mdc.mutation.SetUserId(i)
+	return mdc
+}
+
+// SetMsgId sets the "msgId" field.
+func (mdc *MessageDeletionCreate) SetMsgId(s string) *MessageDeletionCreate {
+	mdc.mutation.SetMsgId(s)
+	return mdc
+}
+
+// SetIsGroup sets the "isGroup" field.
+func (mdc *MessageDeletionCreate) SetIsGroup(b bool) *MessageDeletionCreate {
+	mdc.mutation.SetIsGroup(b)
+	return mdc
+}
+
+// SetNillableIsGroup sets the "isGroup" field if the given value is not nil.
+func (mdc *MessageDeletionCreate) SetNillableIsGroup(b *bool) *MessageDeletionCreate {
+	if b != nil {
+		mdc.SetIsGroup(*b)
+	}
+	return mdc
+}
+
+// SetTargetId sets the "targetId" field.
+func (mdc *MessageDeletionCreate) SetTargetId(i int) *MessageDeletionCreate {
+	mdc.mutation.SetTargetId(i)
+	return mdc
+}
+
+// SetCreateTime sets the "createTime" field.
+func (mdc *MessageDeletionCreate) SetCreateTime(t time.Time) *MessageDeletionCreate {
+	mdc.mutation.SetCreateTime(t)
+	return mdc
+}
+
+// SetNillableCreateTime sets the "createTime" field if the given value is not nil.
+func (mdc *MessageDeletionCreate) SetNillableCreateTime(t *time.Time) *MessageDeletionCreate {
+	if t != nil {
+		mdc.SetCreateTime(*t)
+	}
+	return mdc
+}
+
+// Mutation returns the MessageDeletionMutation object of the builder.
+func (mdc *MessageDeletionCreate) Mutation() *MessageDeletionMutation {
+	return mdc.mutation
+}
+
+// Save creates the MessageDeletion in the database.
+func (mdc *MessageDeletionCreate) Save(ctx context.Context) (*MessageDeletion, error) {
+	mdc.defaults()
+	return withHooks(ctx, mdc.sqlSave, mdc.mutation, mdc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mdc *MessageDeletionCreate) SaveX(ctx context.Context) *MessageDeletion {
+	v, err := mdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mdc *MessageDeletionCreate) Exec(ctx context.Context) error {
+	_, err := mdc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mdc *MessageDeletionCreate) ExecX(ctx context.Context) {
+	if err := mdc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mdc *MessageDeletionCreate) defaults() {
+	if _, ok := mdc.mutation.IsGroup(); !ok {
+		v := messagedeletion.DefaultIsGroup
+		mdc.mutation.SetIsGroup(v)
+	}
+	if _, ok := mdc.mutation.CreateTime(); !ok {
+		v := messagedeletion.DefaultCreateTime()
+		mdc.mutation.SetCreateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mdc *MessageDeletionCreate) check() error {
+	if _, ok := mdc.mutation.UserId(); !ok {
+		return &ValidationError{Name: "userId", err: errors.New(`ent: missing required field "MessageDeletion.userId"`)}
+	}
+	if _, ok := mdc.mutation.MsgId(); !ok {
+		return &ValidationError{Name: "msgId", err: errors.New(`ent: missing required field "MessageDeletion.msgId"`)}
+	}
+	if v, ok := mdc.mutation.MsgId(); ok {
+		if err := messagedeletion.MsgIdValidator(v); err != nil {
+			return &ValidationError{Name: "msgId", err: fmt.Errorf(`ent: validator failed for field "MessageDeletion.msgId": %w`, err)}
+		}
+	}
+	if _, ok := mdc.mutation.IsGroup(); !ok {
+		return &ValidationError{Name: "isGroup", err: errors.New(`ent: missing required field "MessageDeletion.isGroup"`)}
+	}
+	if _, ok := mdc.mutation.TargetId(); !ok {
+		return &ValidationError{Name: "targetId", err: errors.New(`ent: missing required field "MessageDeletion.targetId"`)}
+	}
+	if _, ok := mdc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "createTime", err: errors.New(`ent: missing required field "MessageDeletion.createTime"`)}
+	}
+	return nil
+}
+
+func (mdc *MessageDeletionCreate) sqlSave(ctx context.Context) (*MessageDeletion, error) {
+	if err := mdc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mdc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mdc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	mdc.mutation.id = &_node.ID
+	mdc.mutation.done = true
+	return _node, nil
+}
+
+func (mdc *MessageDeletionCreate) createSpec() (*MessageDeletion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MessageDeletion{config: mdc.config}
+		_spec = sqlgraph.NewCreateSpec(messagedeletion.Table, sqlgraph.NewFieldSpec(messagedeletion.FieldID, field.TypeInt))
+	)
+	if value, ok := mdc.mutation.UserId(); ok {
+		_spec.SetField(messagedeletion.FieldUserId, field.TypeInt, value)
+		_node.UserId = value
+	}
+	if value, ok := mdc.mutation.MsgId(); ok {
+		_spec.SetField(messagedeletion.FieldMsgId, field.TypeString, value)
+		_node.MsgId = value
+	}
+	if value, ok := mdc.mutation.IsGroup(); ok {
+		_spec.SetField(messagedeletion.FieldIsGroup, field.TypeBool, value)
+		_node.IsGroup = value
+	}
+	if value, ok := mdc.mutation.TargetId(); ok {
+		_spec.SetField(messagedeletion.FieldTargetId, field.TypeInt, value)
+		_node.TargetId = value
+	}
+	if value, ok := mdc.mutation.CreateTime(); ok {
+		_spec.SetField(messagedeletion.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	return _node, _spec
+}
+
+// MessageDeletionCreateBulk is the builder for creating many MessageDeletion entities in bulk.
+type MessageDeletionCreateBulk struct {
+	config
+	err      error
+	builders []*MessageDeletionCreate
+}
+
+// Save creates the MessageDeletion entities in the database.
+func (mdcb *MessageDeletionCreateBulk) Save(ctx context.Context) ([]*MessageDeletion, error) {
+	if mdcb.err != nil {
+		return nil, mdcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mdcb.builders))
+	nodes := make([]*MessageDeletion, len(mdcb.builders))
+	mutators := make([]Mutator, len(mdcb.builders))
+	for i := range mdcb.builders {
+		func(i int, root context.Context) {
+			builder := mdcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MessageDeletionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mdcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mdcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mdcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mdcb *MessageDeletionCreateBulk) SaveX(ctx context.Context) []*MessageDeletion {
+	v, err := mdcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mdcb *MessageDeletionCreateBulk) Exec(ctx context.Context) error {
+	_, err := mdcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mdcb *MessageDeletionCreateBulk) ExecX(ctx context.Context) {
+	if err := mdcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"gochat_server/ent/messagedeletion"
+	"gochat_server/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MessageDeletionDelete is the builder for deleting a MessageDeletion entity.
+type MessageDeletionDelete struct {
+	config
+	hooks    []Hook
+	mutation *MessageDeletionMutation
+}
+
+// Where appends a list predicates to the MessageDeletionDelete builder.
+func (mdd *MessageDeletionDelete) Where(ps ...predicate.MessageDeletion) *MessageDeletionDelete {
+	mdd.mutation.Where(ps...)
+	return mdd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mdd *MessageDeletionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, mdd.sqlExec, mdd.mutation, mdd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mdd *MessageDeletionDelete) ExecX(ctx context.Context) int {
+	n, err := mdd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mdd *MessageDeletionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(messagedeletion.Table, sqlgraph.NewFieldSpec(messagedeletion.FieldID, field.TypeInt))
+	if ps := mdd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mdd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mdd.mutation.done = true
+	return affected, err
+}
+
+// MessageDeletionDeleteOne is the builder for deleting a single MessageDeletion entity.
+type MessageDeletionDeleteOne struct {
+	mdd *MessageDeletionDelete
+}
+
+// Where appends a list predicates to the MessageDeletionDelete builder.
+func (mddo *MessageDeletionDeleteOne) Where(ps ...predicate.MessageDeletion) *MessageDeletionDeleteOne {
+	mddo.mdd.mutation.Where(ps...)
+	return mddo
+}
+
+// Exec executes the deletion query.
+func (mddo *MessageDeletionDeleteOne) Exec(ctx context.Context) error {
+	n, err := mddo.mdd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{messagedeletion.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mddo *MessageDeletionDeleteOne) ExecX(ctx context.Context) {
+	if err := mddo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
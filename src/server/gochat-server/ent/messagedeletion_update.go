@@ -0,0 +1,412 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gochat_server/ent/messagedeletion"
+	"gochat_server/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MessageDeletionUpdate is the builder for updating MessageDeletion entities.
+type MessageDeletionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MessageDeletionMutation
+}
+
+// Where appends a list predicates to the MessageDeletionUpdate builder.
+func (mdu *MessageDeletionUpdate) Where(ps ...predicate.MessageDeletion) *MessageDeletionUpdate {
+	mdu.mutation.Where(ps...)
+	return mdu
+}
+
+// SetUserId sets the "userId" field.
+func (mdu *MessageDeletionUpdate) SetUserId(i int) *MessageDeletionUpdate {
+	mdu.mutation.ResetUserId()
+	mdu.mutation.SetUserId(i)
+	return mdu
+}
+
+// SetNillableUserId sets the "userId" field if the given value is not nil.
+func (mdu *MessageDeletionUpdate) SetNillableUserId(i *int) *MessageDeletionUpdate {
+	if i != nil {
+		mdu.SetUserId(*i)
+	}
+	return mdu
+}
+
+// AddUserId adds i to the "userId" field.
+func (mdu *MessageDeletionUpdate) AddUserId(i int) *MessageDeletionUpdate {
+	mdu.mutation.AddUserId(i)
+	return mdu
+}
+
+// SetMsgId sets the "msgId" field.
+func (mdu *MessageDeletionUpdate) SetMsgId(s string) *MessageDeletionUpdate {
+	mdu.mutation.SetMsgId(s)
+	return mdu
+}
+
+// SetNillableMsgId sets the "msgId" field if the given value is not nil.
+func (mdu *MessageDeletionUpdate) SetNillableMsgId(s *string) *MessageDeletionUpdate {
+	if s != nil {
+		mdu.SetMsgId(*s)
+	}
+	return mdu
+}
+
+// SetIsGroup sets the "isGroup" field.
+func (mdu *MessageDeletionUpdate) SetIsGroup(b bool) *MessageDeletionUpdate {
+	mdu.mutation.SetIsGroup(b)
+	return mdu
+}
+
+// SetNillableIsGroup sets the "isGroup" field if the given value is not nil.
+func (mdu *MessageDeletionUpdate) SetNillableIsGroup(b *bool) *MessageDeletionUpdate {
+	if b != nil {
+		mdu.SetIsGroup(*b)
+	}
+	return mdu
+}
+
+// SetTargetId sets the "targetId" field.
+func (mdu *MessageDeletionUpdate) SetTargetId(i int) *MessageDeletionUpdate {
+	mdu.mutation.ResetTargetId()
+	mdu.mutation.SetTargetId(i)
+	return mdu
+}
+
+// SetNillableTargetId sets the "targetId" field if the given value is not nil.
+func (mdu *MessageDeletionUpdate) SetNillableTargetId(i *int) *MessageDeletionUpdate {
+	if i != nil {
+		mdu.SetTargetId(*i)
+	}
+	return mdu
+}
+
+// AddTargetId adds i to the "targetId" field.
+func (mdu *MessageDeletionUpdate) AddTargetId(i int) *MessageDeletionUpdate {
+	mdu.mutation.AddTargetId(i)
+	return mdu
+}
+
+// SetCreateTime sets the "createTime" field.
+func (mdu *MessageDeletionUpdate) SetCreateTime(t time.Time) *MessageDeletionUpdate {
+	mdu.mutation.SetCreateTime(t)
+	return mdu
+}
+
+// SetNillableCreateTime sets the "createTime" field if the given value is not nil.
+func (mdu *MessageDeletionUpdate) SetNillableCreateTime(t *time.Time) *MessageDeletionUpdate {
+	if t != nil {
+		mdu.SetCreateTime(*t)
+	}
+	return mdu
+}
+
+// Mutation returns the MessageDeletionMutation object of the builder.
+func (mdu *MessageDeletionUpdate) Mutation() *MessageDeletionMutation {
+	return mdu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mdu *MessageDeletionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, mdu.sqlSave, mdu.mutation, mdu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mdu *MessageDeletionUpdate) SaveX(ctx context.Context) int {
+	affected, err := mdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mdu *MessageDeletionUpdate) Exec(ctx context.Context) error {
+	_, err := mdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mdu *MessageDeletionUpdate) ExecX(ctx context.Context) {
+	if err := mdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mdu *MessageDeletionUpdate) check() error {
+	if v, ok := mdu.mutation.MsgId(); ok {
+		if err := messagedeletion.MsgIdValidator(v); err != nil {
+			return &ValidationError{Name: "msgId", err: fmt.Errorf(`ent: validator failed for field "MessageDeletion.msgId": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (mdu *MessageDeletionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mdu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(messagedeletion.Table, messagedeletion.Columns, sqlgraph.NewFieldSpec(messagedeletion.FieldID, field.TypeInt))
+	if ps := mdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mdu.mutation.UserId(); ok {
+		_spec.SetField(messagedeletion.FieldUserId, field.TypeInt, value)
+	}
+	if value, ok := mdu.mutation.AddedUserId(); ok {
+		_spec.AddField(messagedeletion.FieldUserId, field.TypeInt, value)
+	}
+	if value, ok := mdu.mutation.MsgId(); ok {
+		_spec.SetField(messagedeletion.FieldMsgId, field.TypeString, value)
+	}
+	if value, ok := mdu.mutation.IsGroup(); ok {
+		_spec.SetField(messagedeletion.FieldIsGroup, field.TypeBool, value)
+	}
+	if value, ok := mdu.mutation.TargetId(); ok {
+		_spec.SetField(messagedeletion.FieldTargetId, field.TypeInt, value)
+	}
+	if value, ok := mdu.mutation.AddedTargetId(); ok {
+		_spec.AddField(messagedeletion.FieldTargetId, field.TypeInt, value)
+	}
+	if value, ok := mdu.mutation.CreateTime(); ok {
+		_spec.SetField(messagedeletion.FieldCreateTime, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messagedeletion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mdu.mutation.done = true
+	return n, nil
+}
+
+// MessageDeletionUpdateOne is the builder for updating a single MessageDeletion entity.
+type MessageDeletionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MessageDeletionMutation
+}
+
+// SetUserId sets the "userId" field.
+func (mduo *MessageDeletionUpdateOne) SetUserId(i int) *MessageDeletionUpdateOne {
+	mduo.mutation.ResetUserId()
+	mduo.mutation.SetUserId(i)
+	return mduo
+}
+
+// SetNillableUserId sets the "userId" field if the given value is not nil.
+func (mduo *MessageDeletionUpdateOne) SetNillableUserId(i *int) *MessageDeletionUpdateOne {
+	if i != nil {
+		mduo.SetUserId(*i)
+	}
+	return mduo
+}
+
+// AddUserId adds i to the "userId" field.
+func (mduo *MessageDeletionUpdateOne) AddUserId(i int) *MessageDeletionUpdateOne {
+	mduo.mutation.AddUserId(i)
+	return mduo
+}
+
+// SetMsgId sets the "msgId" field.
+func (mduo *MessageDeletionUpdateOne) SetMsgId(s string) *MessageDeletionUpdateOne {
+	mduo.mutation.SetMsgId(s)
+	return mduo
+}
+
+// SetNillableMsgId sets the "msgId" field if the given value is not nil.
+func (mduo *MessageDeletionUpdateOne) SetNillableMsgId(s *string) *MessageDeletionUpdateOne {
+	if s != nil {
+		mduo.SetMsgId(*s)
+	}
+	return mduo
+}
+
+// SetIsGroup sets the "isGroup" field.
+func (mduo *MessageDeletionUpdateOne) SetIsGroup(b bool) *MessageDeletionUpdateOne {
+	mduo.mutation.SetIsGroup(b)
+	return mduo
+}
+
+// SetNillableIsGroup sets the "isGroup" field if the given value is not nil.
+func (mduo *MessageDeletionUpdateOne) SetNillableIsGroup(b *bool) *MessageDeletionUpdateOne {
+	if b != nil {
+		mduo.SetIsGroup(*b)
+	}
+	return mduo
+}
+
+// SetTargetId sets the "targetId" field.
+func (mduo *MessageDeletionUpdateOne) SetTargetId(i int) *MessageDeletionUpdateOne {
+	mduo.mutation.ResetTargetId()
+	mduo.mutation.SetTargetId(i)
+	return mduo
+}
+
+// SetNillableTargetId sets the "targetId" field if the given value is not nil.
+func (mduo *MessageDeletionUpdateOne) SetNillableTargetId(i *int) *MessageDeletionUpdateOne {
+	if i != nil {
+		mduo.SetTargetId(*i)
+	}
+	return mduo
+}
+
+// AddTargetId adds i to the "targetId" field.
+func (mduo *MessageDeletionUpdateOne) AddTargetId(i int) *MessageDeletionUpdateOne {
+	mduo.mutation.AddTargetId(i)
+	return mduo
+}
+
+// SetCreateTime sets the "createTime" field.
+func (mduo *MessageDeletionUpdateOne) SetCreateTime(t time.Time) *MessageDeletionUpdateOne {
+	mduo.mutation.SetCreateTime(t)
+	return mduo
+}
+
+// SetNillableCreateTime sets the "createTime" field if the given value is not nil.
+func (mduo *MessageDeletionUpdateOne) SetNillableCreateTime(t *time.Time) *MessageDeletionUpdateOne {
+	if t != nil {
+		mduo.SetCreateTime(*t)
+	}
+	return mduo
+}
+
+// Mutation returns the MessageDeletionMutation object of the builder.
+func (mduo *MessageDeletionUpdateOne) Mutation() *MessageDeletionMutation {
+	return mduo.mutation
+}
+
+// Where appends a list predicates to the MessageDeletionUpdate builder.
+func (mduo *MessageDeletionUpdateOne) Where(ps ...predicate.MessageDeletion) *MessageDeletionUpdateOne {
+	mduo.mutation.Where(ps...)
+	return mduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mduo *MessageDeletionUpdateOne) Select(field string, fields ...string) *MessageDeletionUpdateOne {
+	mduo.fields = append([]string{field}, fields...)
+	return mduo
+}
+
+// Save executes the query and returns the updated MessageDeletion entity.
+func (mduo *MessageDeletionUpdateOne) Save(ctx context.Context) (*MessageDeletion, error) {
+	return withHooks(ctx, mduo.sqlSave, mduo.mutation, mduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mduo *MessageDeletionUpdateOne) SaveX(ctx context.Context) *MessageDeletion {
+	node, err := mduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mduo *MessageDeletionUpdateOne) Exec(ctx context.Context) error {
+	_, err := mduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mduo *MessageDeletionUpdateOne) ExecX(ctx context.Context) {
+	if err := mduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mduo *MessageDeletionUpdateOne) check() error {
+	if v, ok := mduo.mutation.MsgId(); ok {
+		if err := messagedeletion.MsgIdValidator(v); err != nil {
+			return &ValidationError{Name: "msgId", err: fmt.Errorf(`ent: validator failed for field "MessageDeletion.msgId": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (mduo *MessageDeletionUpdateOne) sqlSave(ctx context.Context) (_node *MessageDeletion, err error) {
+	if err := mduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(messagedeletion.Table, messagedeletion.Columns, sqlgraph.NewFieldSpec(messagedeletion.FieldID, field.TypeInt))
+	id, ok := mduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MessageDeletion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, messagedeletion.FieldID)
+		for _, f := range fields {
+			if !messagedeletion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != messagedeletion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mduo.mutation.UserId(); ok {
+		_spec.SetField(messagedeletion.FieldUserId, field.TypeInt, value)
+	}
+	if value, ok := mduo.mutation.AddedUserId(); ok {
+		_spec.AddField(messagedeletion.FieldUserId, field.TypeInt, value)
+	}
+	if value, ok := mduo.mutation.MsgId(); ok {
+		_spec.SetField(messagedeletion.FieldMsgId, field.TypeString, value)
+	}
+	if value, ok := mduo.mutation.IsGroup(); ok {
+		_spec.SetField(messagedeletion.FieldIsGroup, field.TypeBool, value)
+	}
+	if value, ok := mduo.mutation.TargetId(); ok {
+		_spec.SetField(messagedeletion.FieldTargetId, field.TypeInt, value)
+	}
+	if value, ok := mduo.mutation.AddedTargetId(); ok {
+		_spec.AddField(messagedeletion.FieldTargetId, field.TypeInt, value)
+	}
+	if value, ok := mduo.mutation.CreateTime(); ok {
+		_spec.SetField(messagedeletion.FieldCreateTime, field.TypeTime, value)
+	}
+	_node = &MessageDeletion{config: mduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messagedeletion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mduo.mutation.done = true
+	return _node, nil
+}
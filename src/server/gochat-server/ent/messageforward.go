@@ -0,0 +1,185 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"gochat_server/ent/messageforward"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// MessageForward is the model entity for the MessageForward schema.
+type MessageForward struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 转发生成的新消息ID
+	MsgId string `json:"msgId,omitempty"`
+	// 原始消息ID
+	SourceMsgId string `json:"sourceMsgId,omitempty"`
+	// 原始消息发送者ID
+	SourceFromUserId int `json:"sourceFromUserId,omitempty"`
+	// 原始消息是否为群聊消息
+	SourceIsGroup bool `json:"sourceIsGroup,omitempty"`
+	// 原始消息所在群组ID
+	SourceGroupId int `json:"sourceGroupId,omitempty"`
+	// 原始消息发送时间
+	SourceCreateTime time.Time `json:"sourceCreateTime,omitempty"`
+	// 转发者ID
+	ForwardUserId int `json:"forwardUserId,omitempty"`
+	// 转发时间
+	CreateTime   time.Time `json:"createTime,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MessageForward) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case messageforward.FieldSourceIsGroup:
+			values[i] = new(sql.NullBool)
+		case messageforward.FieldID, messageforward.FieldSourceFromUserId, messageforward.FieldSourceGroupId, messageforward.FieldForwardUserId:
+			values[i] = new(sql.NullInt64)
+		case messageforward.FieldMsgId, messageforward.FieldSourceMsgId:
+			values[i] = new(sql.NullString)
+		case messageforward.FieldSourceCreateTime, messageforward.FieldCreateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MessageForward fields.
+func (mf *MessageForward) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case messageforward.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			mf.ID = int(value.Int64)
+		case messageforward.FieldMsgId:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field msgId", values[i])
+			} else if value.Valid {
+				mf.MsgId = value.String
+			}
+		case messageforward.FieldSourceMsgId:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sourceMsgId", values[i])
+			} else if value.Valid {
+				mf.SourceMsgId = value.String
+			}
+		case messageforward.FieldSourceFromUserId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sourceFromUserId", values[i])
+			} else if value.Valid {
+				mf.SourceFromUserId = int(value.Int64)
+			}
+		case messageforward.FieldSourceIsGroup:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field sourceIsGroup", values[i])
+			} else if value.Valid {
+				mf.SourceIsGroup = value.Bool
+			}
+		case messageforward.FieldSourceGroupId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sourceGroupId", values[i])
+			} else if value.Valid {
+				mf.SourceGroupId = int(value.Int64)
+			}
+		case messageforward.FieldSourceCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field sourceCreateTime", values[i])
+			} else if value.Valid {
+				mf.SourceCreateTime = value.Time
+			}
+		case messageforward.FieldForwardUserId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field forwardUserId", values[i])
+			} else if value.Valid {
+				mf.ForwardUserId = int(value.Int64)
+			}
+		case messageforward.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field createTime", values[i])
+			} else if value.Valid {
+				mf.CreateTime = value.Time
+			}
+		default:
+			mf.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MessageForward.
+// This includes values selected through modifiers, order, etc.
+func (mf *MessageForward) Value(name string) (ent.Value, error) {
+	return mf.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this MessageForward.
+// Note that you need to call MessageForward.Unwrap() before calling this method if this MessageForward
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mf *MessageForward) Update() *MessageForwardUpdateOne {
+	return NewMessageForwardClient(mf.config).UpdateOne(mf)
+}
+
+// Unwrap unwraps the MessageForward entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mf *MessageForward) Unwrap() *MessageForward {
+	_tx, ok := mf.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MessageForward is not a transactional entity")
+	}
+	mf.config.driver = _tx.drv
+	return mf
+}
+
+// String implements the fmt.Stringer.
+func (mf *MessageForward) String() string {
+	var builder strings.Builder
+	builder.WriteString("MessageForward(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mf.ID))
+	builder.WriteString("msgId=")
+	builder.WriteString(mf.MsgId)
+	builder.WriteString(", ")
+	builder.WriteString("sourceMsgId=")
+	builder.WriteString(mf.SourceMsgId)
+	builder.WriteString(", ")
+	builder.WriteString("sourceFromUserId=")
+	builder.WriteString(fmt.Sprintf("%v", mf.SourceFromUserId))
+	builder.WriteString(", ")
+	builder.WriteString("sourceIsGroup=")
+	builder.WriteString(fmt.Sprintf("%v", mf.SourceIsGroup))
+	builder.WriteString(", ")
+	builder.WriteString("sourceGroupId=")
+	builder.WriteString(fmt.Sprintf("%v", mf.SourceGroupId))
+	builder.WriteString(", ")
+	builder.WriteString("sourceCreateTime=")
+	builder.WriteString(mf.SourceCreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("forwardUserId=")
+	builder.WriteString(fmt.Sprintf("%v", mf.ForwardUserId))
+	builder.WriteString(", ")
+	builder.WriteString("createTime=")
+	builder.WriteString(mf.CreateTime.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MessageForwards is a parsable slice of MessageForward.
+type MessageForwards []*MessageForward
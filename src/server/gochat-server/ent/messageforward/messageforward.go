@@ -0,0 +1,116 @@
+// Code generated by ent, DO NOT EDIT.
+
+package messageforward
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the messageforward type in the database.
+	Label = "message_forward"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldMsgId holds the string denoting the msgid field in the database.
+	FieldMsgId = "msg_id"
+	// FieldSourceMsgId holds the string denoting the sourcemsgid field in the database.
+	FieldSourceMsgId = "source_msg_id"
+	// FieldSourceFromUserId holds the string denoting the sourcefromuserid field in the database.
+	FieldSourceFromUserId = "source_from_user_id"
+	// FieldSourceIsGroup holds the string denoting the sourceisgroup field in the database.
+	FieldSourceIsGroup = "source_is_group"
+	// FieldSourceGroupId holds the string denoting the sourcegroupid field in the database.
+	FieldSourceGroupId = "source_group_id"
+	// FieldSourceCreateTime holds the string denoting the sourcecreatetime field in the database.
+	FieldSourceCreateTime = "source_create_time"
+	// FieldForwardUserId holds the string denoting the forwarduserid field in the database.
+	FieldForwardUserId = "forward_user_id"
+	// FieldCreateTime holds the string denoting the createtime field in the database.
+	FieldCreateTime = "create_time"
+	// Table holds the table name of the messageforward in the database.
+	Table = "message_forwards"
+)
+
+// Columns holds all SQL columns for messageforward fields.
+var Columns = []string{
+	FieldID,
+	FieldMsgId,
+	FieldSourceMsgId,
+	FieldSourceFromUserId,
+	FieldSourceIsGroup,
+	FieldSourceGroupId,
+	FieldSourceCreateTime,
+	FieldForwardUserId,
+	FieldCreateTime,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// MsgIdValidator is a validator for the "msgId" field. It is called by the builders before save.
+	MsgIdValidator func(string) error
+	// SourceMsgIdValidator is a validator for the "sourceMsgId" field. It is called by the builders before save.
+	SourceMsgIdValidator func(string) error
+	// DefaultSourceIsGroup holds the default value on creation for the "sourceIsGroup" field.
+	DefaultSourceIsGroup bool
+	// DefaultCreateTime holds the default value on creation for the "createTime" field.
+	DefaultCreateTime func() time.Time
+)
+
+// OrderOption defines the ordering options for the MessageForward queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByMsgId orders the results by the msgId field.
+func ByMsgId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMsgId, opts...).ToFunc()
+}
+
+// BySourceMsgId orders the results by the sourceMsgId field.
+func BySourceMsgId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceMsgId, opts...).ToFunc()
+}
+
+// BySourceFromUserId orders the results by the sourceFromUserId field.
+func BySourceFromUserId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceFromUserId, opts...).ToFunc()
+}
+
+// BySourceIsGroup orders the results by the sourceIsGroup field.
+func BySourceIsGroup(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceIsGroup, opts...).ToFunc()
+}
+
+// BySourceGroupId orders the results by the sourceGroupId field.
+func BySourceGroupId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceGroupId, opts...).ToFunc()
+}
+
+// BySourceCreateTime orders the results by the sourceCreateTime field.
+func BySourceCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceCreateTime, opts...).ToFunc()
+}
+
+// ByForwardUserId orders the results by the forwardUserId field.
+func ByForwardUserId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldForwardUserId, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the createTime field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
@@ -0,0 +1,460 @@
+// Code generated by ent, DO NOT EDIT.
+
+package messageforward
+
+import (
+	"gochat_server/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldLTE(FieldID, id))
+}
+
+// MsgId applies equality check predicate on the "msgId" field. It's identical to MsgIdEQ.
+func MsgId(v string) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldEQ(FieldMsgId, v))
+}
+
+// SourceMsgId applies equality check predicate on the "sourceMsgId" field. It's identical to SourceMsgIdEQ.
+func SourceMsgId(v string) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldEQ(FieldSourceMsgId, v))
+}
+
+// SourceFromUserId applies equality check predicate on the "sourceFromUserId" field. It's identical to SourceFromUserIdEQ.
+func SourceFromUserId(v int) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldEQ(FieldSourceFromUserId, v))
+}
+
+// SourceIsGroup applies equality check predicate on the "sourceIsGroup" field. It's identical to SourceIsGroupEQ.
+func SourceIsGroup(v bool) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldEQ(FieldSourceIsGroup, v))
+}
+
+// SourceGroupId applies equality check predicate on the "sourceGroupId" field. It's identical to SourceGroupIdEQ.
+func SourceGroupId(v int) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldEQ(FieldSourceGroupId, v))
+}
+
+// SourceCreateTime applies equality check predicate on the "sourceCreateTime" field. It's identical to SourceCreateTimeEQ.
+func SourceCreateTime(v time.Time) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldEQ(FieldSourceCreateTime, v))
+}
+
+// ForwardUserId applies equality check predicate on the "forwardUserId" field. It's identical to ForwardUserIdEQ.
+func ForwardUserId(v int) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldEQ(FieldForwardUserId, v))
+}
+
+// CreateTime applies equality check predicate on the "createTime" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// MsgIdEQ applies the EQ predicate on the "msgId" field.
+func MsgIdEQ(v string) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldEQ(FieldMsgId, v))
+}
+
+// MsgIdNEQ applies the NEQ predicate on the "msgId" field.
+func MsgIdNEQ(v string) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldNEQ(FieldMsgId, v))
+}
+
+// MsgIdIn applies the In predicate on the "msgId" field.
+func MsgIdIn(vs ...string) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldIn(FieldMsgId, vs...))
+}
+
+// MsgIdNotIn applies the NotIn predicate on the "msgId" field.
+func MsgIdNotIn(vs ...string) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldNotIn(FieldMsgId, vs...))
+}
+
+// MsgIdGT applies the GT predicate on the "msgId" field.
+func MsgIdGT(v string) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldGT(FieldMsgId, v))
+}
+
+// MsgIdGTE applies the GTE predicate on the "msgId" field.
+func MsgIdGTE(v string) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldGTE(FieldMsgId, v))
+}
+
+// MsgIdLT applies the LT predicate on the "msgId" field.
+func MsgIdLT(v string) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldLT(FieldMsgId, v))
+}
+
+// MsgIdLTE applies the LTE predicate on the "msgId" field.
+func MsgIdLTE(v string) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldLTE(FieldMsgId, v))
+}
+
+// MsgIdContains applies the Contains predicate on the "msgId" field.
+func MsgIdContains(v string) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldContains(FieldMsgId, v))
+}
+
+// MsgIdHasPrefix applies the HasPrefix predicate on the "msgId" field.
+func MsgIdHasPrefix(v string) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldHasPrefix(FieldMsgId, v))
+}
+
+// MsgIdHasSuffix applies the HasSuffix predicate on the "msgId" field.
+func MsgIdHasSuffix(v string) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldHasSuffix(FieldMsgId, v))
+}
+
+// MsgIdEqualFold applies the EqualFold predicate on the "msgId" field.
+func MsgIdEqualFold(v string) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldEqualFold(FieldMsgId, v))
+}
+
+// MsgIdContainsFold applies the ContainsFold predicate on the "msgId" field.
+func MsgIdContainsFold(v string) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldContainsFold(FieldMsgId, v))
+}
+
+// SourceMsgIdEQ applies the EQ predicate on the "sourceMsgId" field.
+func SourceMsgIdEQ(v string) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldEQ(FieldSourceMsgId, v))
+}
+
+// SourceMsgIdNEQ applies the NEQ predicate on the "sourceMsgId" field.
+func SourceMsgIdNEQ(v string) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldNEQ(FieldSourceMsgId, v))
+}
+
+// SourceMsgIdIn applies the In predicate on the "sourceMsgId" field.
+func SourceMsgIdIn(vs ...string) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldIn(FieldSourceMsgId, vs...))
+}
+
+// SourceMsgIdNotIn applies the NotIn predicate on the "sourceMsgId" field.
+func SourceMsgIdNotIn(vs ...string) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldNotIn(FieldSourceMsgId, vs...))
+}
+
+// SourceMsgIdGT applies the GT predicate on the "sourceMsgId" field.
+func SourceMsgIdGT(v string) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldGT(FieldSourceMsgId, v))
+}
+
+// SourceMsgIdGTE applies the GTE predicate on the "sourceMsgId" field.
+func SourceMsgIdGTE(v string) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldGTE(FieldSourceMsgId, v))
+}
+
+// SourceMsgIdLT applies the LT predicate on the "sourceMsgId" field.
+func SourceMsgIdLT(v string) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldLT(FieldSourceMsgId, v))
+}
+
+// SourceMsgIdLTE applies the LTE predicate on the "sourceMsgId" field.
+func SourceMsgIdLTE(v string) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldLTE(FieldSourceMsgId, v))
+}
+
+// SourceMsgIdContains applies the Contains predicate on the "sourceMsgId" field.
+func SourceMsgIdContains(v string) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldContains(FieldSourceMsgId, v))
+}
+
+// SourceMsgIdHasPrefix applies the HasPrefix predicate on the "sourceMsgId" field.
+func SourceMsgIdHasPrefix(v string) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldHasPrefix(FieldSourceMsgId, v))
+}
+
+// SourceMsgIdHasSuffix applies the HasSuffix predicate on the "sourceMsgId" field.
+func SourceMsgIdHasSuffix(v string) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldHasSuffix(FieldSourceMsgId, v))
+}
+
+// SourceMsgIdEqualFold applies the EqualFold predicate on the "sourceMsgId" field.
+func SourceMsgIdEqualFold(v string) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldEqualFold(FieldSourceMsgId, v))
+}
+
+// SourceMsgIdContainsFold applies the ContainsFold predicate on the "sourceMsgId" field.
+func SourceMsgIdContainsFold(v string) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldContainsFold(FieldSourceMsgId, v))
+}
+
+// SourceFromUserIdEQ applies the EQ predicate on the "sourceFromUserId" field.
+func SourceFromUserIdEQ(v int) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldEQ(FieldSourceFromUserId, v))
+}
+
+// SourceFromUserIdNEQ applies the NEQ predicate on the "sourceFromUserId" field.
+func SourceFromUserIdNEQ(v int) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldNEQ(FieldSourceFromUserId, v))
+}
+
+// SourceFromUserIdIn applies the In predicate on the "sourceFromUserId" field.
+func SourceFromUserIdIn(vs ...int) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldIn(FieldSourceFromUserId, vs...))
+}
+
+// SourceFromUserIdNotIn applies the NotIn predicate on the "sourceFromUserId" field.
+func SourceFromUserIdNotIn(vs ...int) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldNotIn(FieldSourceFromUserId, vs...))
+}
+
+// SourceFromUserIdGT applies the GT predicate on the "sourceFromUserId" field.
+func SourceFromUserIdGT(v int) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldGT(FieldSourceFromUserId, v))
+}
+
+// SourceFromUserIdGTE applies the GTE predicate on the "sourceFromUserId" field.
+func SourceFromUserIdGTE(v int) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldGTE(FieldSourceFromUserId, v))
+}
+
+// SourceFromUserIdLT applies the LT predicate on the "sourceFromUserId" field.
+func SourceFromUserIdLT(v int) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldLT(FieldSourceFromUserId, v))
+}
+
+// SourceFromUserIdLTE applies the LTE predicate on the "sourceFromUserId" field.
+func SourceFromUserIdLTE(v int) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldLTE(FieldSourceFromUserId, v))
+}
+
+// SourceIsGroupEQ applies the EQ predicate on the "sourceIsGroup" field.
+func SourceIsGroupEQ(v bool) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldEQ(FieldSourceIsGroup, v))
+}
+
+// SourceIsGroupNEQ applies the NEQ predicate on the "sourceIsGroup" field.
+func SourceIsGroupNEQ(v bool) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldNEQ(FieldSourceIsGroup, v))
+}
+
+// SourceGroupIdEQ applies the EQ predicate on the "sourceGroupId" field.
+func SourceGroupIdEQ(v int) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldEQ(FieldSourceGroupId, v))
+}
+
+// SourceGroupIdNEQ applies the NEQ predicate on the "sourceGroupId" field.
+func SourceGroupIdNEQ(v int) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldNEQ(FieldSourceGroupId, v))
+}
+
+// SourceGroupIdIn applies the In predicate on the "sourceGroupId" field.
+func SourceGroupIdIn(vs ...int) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldIn(FieldSourceGroupId, vs...))
+}
+
+// SourceGroupIdNotIn applies the NotIn predicate on the "sourceGroupId" field.
+func SourceGroupIdNotIn(vs ...int) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldNotIn(FieldSourceGroupId, vs...))
+}
+
+// SourceGroupIdGT applies the GT predicate on the "sourceGroupId" field.
+func SourceGroupIdGT(v int) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldGT(FieldSourceGroupId, v))
+}
+
+// SourceGroupIdGTE applies the GTE predicate on the "sourceGroupId" field.
+func SourceGroupIdGTE(v int) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldGTE(FieldSourceGroupId, v))
+}
+
+// SourceGroupIdLT applies the LT predicate on the "sourceGroupId" field.
+func SourceGroupIdLT(v int) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldLT(FieldSourceGroupId, v))
+}
+
+// SourceGroupIdLTE applies the LTE predicate on the "sourceGroupId" field.
+func SourceGroupIdLTE(v int) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldLTE(FieldSourceGroupId, v))
+}
+
+// SourceGroupIdIsNil applies the IsNil predicate on the "sourceGroupId" field.
+func SourceGroupIdIsNil() predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldIsNull(FieldSourceGroupId))
+}
+
+// SourceGroupIdNotNil applies the NotNil predicate on the "sourceGroupId" field.
+func SourceGroupIdNotNil() predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldNotNull(FieldSourceGroupId))
+}
+
+// SourceCreateTimeEQ applies the EQ predicate on the "sourceCreateTime" field.
+func SourceCreateTimeEQ(v time.Time) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldEQ(FieldSourceCreateTime, v))
+}
+
+// SourceCreateTimeNEQ applies the NEQ predicate on the "sourceCreateTime" field.
+func SourceCreateTimeNEQ(v time.Time) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldNEQ(FieldSourceCreateTime, v))
+}
+
+// SourceCreateTimeIn applies the In predicate on the "sourceCreateTime" field.
+func SourceCreateTimeIn(vs ...time.Time) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldIn(FieldSourceCreateTime, vs...))
+}
+
+// SourceCreateTimeNotIn applies the NotIn predicate on the "sourceCreateTime" field.
+func SourceCreateTimeNotIn(vs ...time.Time) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldNotIn(FieldSourceCreateTime, vs...))
+}
+
+// SourceCreateTimeGT applies the GT predicate on the "sourceCreateTime" field.
+func SourceCreateTimeGT(v time.Time) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldGT(FieldSourceCreateTime, v))
+}
+
+// SourceCreateTimeGTE applies the GTE predicate on the "sourceCreateTime" field.
+func SourceCreateTimeGTE(v time.Time) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldGTE(FieldSourceCreateTime, v))
+}
+
+// SourceCreateTimeLT applies the LT predicate on the "sourceCreateTime" field.
+func SourceCreateTimeLT(v time.Time) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldLT(FieldSourceCreateTime, v))
+}
+
+// SourceCreateTimeLTE applies the LTE predicate on the "sourceCreateTime" field.
+func SourceCreateTimeLTE(v time.Time) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldLTE(FieldSourceCreateTime, v))
+}
+
+// ForwardUserIdEQ applies the EQ predicate on the "forwardUserId" field.
+func ForwardUserIdEQ(v int) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldEQ(FieldForwardUserId, v))
+}
+
+// ForwardUserIdNEQ applies the NEQ predicate on the "forwardUserId" field.
+func ForwardUserIdNEQ(v int) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldNEQ(FieldForwardUserId, v))
+}
+
+// ForwardUserIdIn applies the In predicate on the "forwardUserId" field.
+func ForwardUserIdIn(vs ...int) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldIn(FieldForwardUserId, vs...))
+}
+
+// ForwardUserIdNotIn applies the NotIn predicate on the "forwardUserId" field.
+func ForwardUserIdNotIn(vs ...int) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldNotIn(FieldForwardUserId, vs...))
+}
+
+// ForwardUserIdGT applies the GT predicate on the "forwardUserId" field.
+func ForwardUserIdGT(v int) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldGT(FieldForwardUserId, v))
+}
+
+// ForwardUserIdGTE applies the GTE predicate on the "forwardUserId" field.
+func ForwardUserIdGTE(v int) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldGTE(FieldForwardUserId, v))
+}
+
+// ForwardUserIdLT applies the LT predicate on the "forwardUserId" field.
+func ForwardUserIdLT(v int) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldLT(FieldForwardUserId, v))
+}
+
+// ForwardUserIdLTE applies the LTE predicate on the "forwardUserId" field.
+func ForwardUserIdLTE(v int) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldLTE(FieldForwardUserId, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "createTime" field.
+func CreateTimeEQ(v time.Time) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "createTime" field.
+func CreateTimeNEQ(v time.Time) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "createTime" field.
+func CreateTimeIn(vs ...time.Time) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "createTime" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "createTime" field.
+func CreateTimeGT(v time.Time) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "createTime" field.
+func CreateTimeGTE(v time.Time) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "createTime" field.
+func CreateTimeLT(v time.Time) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "createTime" field.
+func CreateTimeLTE(v time.Time) predicate.MessageForward {
+	return predicate.MessageForward(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MessageForward) predicate.MessageForward {
+	return predicate.MessageForward(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MessageForward) predicate.MessageForward {
+	return predicate.MessageForward(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MessageForward) predicate.MessageForward {
+	return predicate.MessageForward(sql.NotPredicates(p))
+}
@@ -0,0 +1,320 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gochat_server/ent/messageforward"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MessageForwardCreate is the builder for creating a MessageForward entity.
+type MessageForwardCreate struct {
+	config
+	mutation *MessageForwardMutation
+	hooks    []Hook
+}
+
+// SetMsgId sets the "msgId" field.
+func (mfc *MessageForwardCreate) SetMsgId(s string) *MessageForwardCreate {
+	mfc.mutation.SetMsgId(s)
+	return mfc
+}
+
+// SetSourceMsgId sets the "sourceMsgId" field.
+func (mfc *MessageForwardCreate) SetSourceMsgId(s string) *MessageForwardCreate {
+	mfc.mutation.SetSourceMsgId(s)
+	return mfc
+}
+
+// SetSourceFromUserId sets the "sourceFromUserId" field.
+func (mfc *MessageForwardCreate) SetSourceFromUserId(i int) *MessageForwardCreate {
+	mfc.mutation.SetSourceFromUserId(i)
+	return mfc
+}
+
+// SetSourceIsGroup sets the "sourceIsGroup" field.
+func (mfc *MessageForwardCreate) SetSourceIsGroup(b bool) *MessageForwardCreate {
+	mfc.mutation.SetSourceIsGroup(b)
+	return mfc
+}
+
+// SetNillableSourceIsGroup sets the "sourceIsGroup" field if the given value is not nil.
+func (mfc *MessageForwardCreate) SetNillableSourceIsGroup(b *bool) *MessageForwardCreate {
+	if b != nil {
+		mfc.SetSourceIsGroup(*b)
+	}
+	return mfc
+}
+
+// SetSourceGroupId sets the "sourceGroupId" field.
+func (mfc *MessageForwardCreate) SetSourceGroupId(i int) *MessageForwardCreate {
+	mfc.mutation.SetSourceGroupId(i)
+	return mfc
+}
+
+// SetNillableSourceGroupId sets the "sourceGroupId" field if the given value is not nil.
+func (mfc *MessageForwardCreate) SetNillableSourceGroupId(i *int) *MessageForwardCreate {
+	if i != nil {
+		mfc.SetSourceGroupId(*i)
+	}
+	return mfc
+}
+
+// SetSourceCreateTime sets the "sourceCreateTime" field.
+func (mfc *MessageForwardCreate) SetSourceCreateTime(t time.Time) *MessageForwardCreate {
+	mfc.mutation.SetSourceCreateTime(t)
+	return mfc
+}
+
+// SetForwardUserId sets the "forwardUserId" field.
+func (mfc *MessageForwardCreate) SetForwardUserId(i int) *MessageForwardCreate {
+	mfc.mutation.SetForwardUserId(i)
+	return mfc
+}
+
+// SetCreateTime sets the "createTime" field.
+func (mfc *MessageForwardCreate) SetCreateTime(t time.Time) *MessageForwardCreate {
+	mfc.mutation.SetCreateTime(t)
+	return mfc
+}
+
+// SetNillableCreateTime sets the "createTime" field if the given value is not nil.
+func (mfc *MessageForwardCreate) SetNillableCreateTime(t *time.Time) *MessageForwardCreate {
+	if t != nil {
+		mfc.SetCreateTime(*t)
+	}
+	return mfc
+}
+
+// Mutation returns the MessageForwardMutation object of the builder.
+func (mfc *MessageForwardCreate) Mutation() *MessageForwardMutation {
+	return mfc.mutation
+}
+
+// Save creates the MessageForward in the database.
+func (mfc *MessageForwardCreate) Save(ctx context.Context) (*MessageForward, error) {
+	mfc.defaults()
+	return withHooks(ctx, mfc.sqlSave, mfc.mutation, mfc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mfc *MessageForwardCreate) SaveX(ctx context.Context) *MessageForward {
+	v, err := mfc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mfc *MessageForwardCreate) Exec(ctx context.Context) error {
+	_, err := mfc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mfc *MessageForwardCreate) ExecX(ctx context.Context) {
+	if err := mfc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mfc *MessageForwardCreate) defaults() {
+	if _, ok := mfc.mutation.SourceIsGroup(); !ok {
+		v := messageforward.DefaultSourceIsGroup
+		mfc.mutation.SetSourceIsGroup(v)
+	}
+	if _, ok := mfc.mutation.CreateTime(); !ok {
+		v := messageforward.DefaultCreateTime()
+		mfc.mutation.SetCreateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mfc *MessageForwardCreate) check() error {
+	if _, ok := mfc.mutation.MsgId(); !ok {
+		return &ValidationError{Name: "msgId", err: errors.New(`ent: missing required field "MessageForward.msgId"`)}
+	}
+	if v, ok := mfc.mutation.MsgId(); ok {
+		if err := messageforward.MsgIdValidator(v); err != nil {
+			return &ValidationError{Name: "msgId", err: fmt.Errorf(`ent: validator failed for field "MessageForward.msgId": %w`, err)}
+		}
+	}
+	if _, ok := mfc.mutation.SourceMsgId(); !ok {
+		return &ValidationError{Name: "sourceMsgId", err: errors.New(`ent: missing required field "MessageForward.sourceMsgId"`)}
+	}
+	if v, ok := mfc.mutation.SourceMsgId(); ok {
+		if err := messageforward.SourceMsgIdValidator(v); err != nil {
+			return &ValidationError{Name: "sourceMsgId", err: fmt.Errorf(`ent: validator failed for field "MessageForward.sourceMsgId": %w`, err)}
+		}
+	}
+	if _, ok := mfc.mutation.SourceFromUserId(); !ok {
+		return &ValidationError{Name: "sourceFromUserId", err: errors.New(`ent: missing required field "MessageForward.sourceFromUserId"`)}
+	}
+	if _, ok := mfc.mutation.SourceIsGroup(); !ok {
+		return &ValidationError{Name: "sourceIsGroup", err: errors.New(`ent: missing required field "MessageForward.sourceIsGroup"`)}
+	}
+	if _, ok := mfc.mutation.SourceCreateTime(); !ok {
+		return &ValidationError{Name: "sourceCreateTime", err: errors.New(`ent: missing required field "MessageForward.sourceCreateTime"`)}
+	}
+	if _, ok := mfc.mutation.ForwardUserId(); !ok {
+		return &ValidationError{Name: "forwardUserId", err: errors.New(`ent: missing required field "MessageForward.forwardUserId"`)}
+	}
+	if _, ok := mfc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "createTime", err: errors.New(`ent: missing required field "MessageForward.createTime"`)}
+	}
+	return nil
+}
+
+func (mfc *MessageForwardCreate) sqlSave(ctx context.Context) (*MessageForward, error) {
+	if err := mfc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mfc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mfc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	mfc.mutation.id = &_node.ID
+	mfc.mutation.done = true
+	return _node, nil
+}
+
+func (mfc *MessageForwardCreate) createSpec() (*MessageForward, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MessageForward{config: mfc.config}
+		_spec = sqlgraph.NewCreateSpec(messageforward.Table, sqlgraph.NewFieldSpec(messageforward.FieldID, field.TypeInt))
+	)
+	if value, ok := mfc.mutation.MsgId(); ok {
+		_spec.SetField(messageforward.FieldMsgId, field.TypeString, value)
+		_node.MsgId = value
+	}
+	if value, ok := mfc.mutation.SourceMsgId(); ok {
+		_spec.SetField(messageforward.FieldSourceMsgId, field.TypeString, value)
+		_node.SourceMsgId = value
+	}
+	if value, ok := mfc.mutation.SourceFromUserId(); ok {
+		_spec.SetField(messageforward.FieldSourceFromUserId, field.TypeInt, value)
+		_node.SourceFromUserId = value
+	}
+	if value, ok := mfc.mutation.SourceIsGroup(); ok {
+		_spec.SetField(messageforward.FieldSourceIsGroup, field.TypeBool, value)
+		_node.SourceIsGroup = value
+	}
+	if value, ok := mfc.mutation.SourceGroupId(); ok {
+		_spec.SetField(messageforward.FieldSourceGroupId, field.TypeInt, value)
+		_node.SourceGroupId = value
+	}
+	if value, ok := mfc.mutation.SourceCreateTime(); ok {
+		_spec.SetField(messageforward.FieldSourceCreateTime, field.TypeTime, value)
+		_node.SourceCreateTime = value
+	}
+	if value, ok := mfc.mutation.ForwardUserId(); ok {
+		_spec.SetField(messageforward.FieldForwardUserId, field.TypeInt, value)
+		_node.ForwardUserId = value
+	}
+	if value, ok := mfc.mutation.CreateTime(); ok {
+		_spec.SetField(messageforward.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	return _node, _spec
+}
+
+// MessageForwardCreateBulk is the builder for creating many MessageForward entities in bulk.
+type MessageForwardCreateBulk struct {
+	config
+	err      error
+	builders []*MessageForwardCreate
+}
+
+// Save creates the MessageForward entities in the database.
+func (mfcb *MessageForwardCreateBulk) Save(ctx context.Context) ([]*MessageForward, error) {
+	if mfcb.err != nil {
+		return nil, mfcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mfcb.builders))
+	nodes := make([]*MessageForward, len(mfcb.builders))
+	mutators := make([]Mutator, len(mfcb.builders))
+	for i := range mfcb.builders {
+		func(i int, root context.Context) {
+			builder := mfcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MessageForwardMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mfcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mfcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mfcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mfcb *MessageForwardCreateBulk) SaveX(ctx context.Context) []*MessageForward {
+	v, err := mfcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mfcb *MessageForwardCreateBulk) Exec(ctx context.Context) error {
+	_, err := mfcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mfcb *MessageForwardCreateBulk) ExecX(ctx context.Context) {
+	if err := mfcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
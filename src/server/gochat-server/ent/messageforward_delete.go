@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"gochat_server/ent/messageforward"
+	"gochat_server/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MessageForwardDelete is the builder for deleting a MessageForward entity.
+type MessageForwardDelete struct {
+	config
+	hooks    []Hook
+	mutation *MessageForwardMutation
+}
+
+// Where appends a list predicates to the MessageForwardDelete builder.
+func (mfd *MessageForwardDelete) Where(ps ...predicate.MessageForward) *MessageForwardDelete {
+	mfd.mutation.Where(ps...)
+	return mfd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mfd *MessageForwardDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, mfd.sqlExec, mfd.mutation, mfd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mfd *MessageForwardDelete) ExecX(ctx context.Context) int {
+	n, err := mfd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mfd *MessageForwardDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(messageforward.Table, sqlgraph.NewFieldSpec(messageforward.FieldID, field.TypeInt))
+	if ps := mfd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mfd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mfd.mutation.done = true
+	return affected, err
+}
+
+// MessageForwardDeleteOne is the builder for deleting a single MessageForward entity.
+type MessageForwardDeleteOne struct {
+	mfd *MessageForwardDelete
+}
+
+// Where appends a list predicates to the MessageForwardDelete builder.
+func (mfdo *MessageForwardDeleteOne) Where(ps ...predicate.MessageForward) *MessageForwardDeleteOne {
+	mfdo.mfd.mutation.Where(ps...)
+	return mfdo
+}
+
+// Exec executes the deletion query.
+func (mfdo *MessageForwardDeleteOne) Exec(ctx context.Context) error {
+	n, err := mfdo.mfd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{messageforward.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mfdo *MessageForwardDeleteOne) ExecX(ctx context.Context) {
+	if err := mfdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
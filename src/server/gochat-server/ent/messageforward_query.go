@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"gochat_server/ent/messageforward"
+	"gochat_server/ent/predicate"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MessageForwardQuery is the builder for querying MessageForward entities.
+type MessageForwardQuery struct {
+	config
+	ctx        *QueryContext
+	order      []messageforward.OrderOption
+	inters     []Interceptor
+	predicates []predicate.MessageForward
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MessageForwardQuery builder.
+func (mfq *MessageForwardQuery) Where(ps ...predicate.MessageForward) *MessageForwardQuery {
+	mfq.predicates = append(mfq.predicates, ps...)
+	return mfq
+}
+
+// Limit the number of records to be returned by this query.
+func (mfq *MessageForwardQuery) Limit(limit int) *MessageForwardQuery {
+	mfq.ctx.Limit = &limit
+	return mfq
+}
+
+// Offset to start from.
+func (mfq *MessageForwardQuery) Offset(offset int) *MessageForwardQuery {
+	mfq.ctx.Offset = &offset
+	return mfq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mfq *MessageForwardQuery) Unique(unique bool) *MessageForwardQuery {
+	mfq.ctx.Unique = &unique
+	return mfq
+}
+
+// Order specifies how the records should be ordered.
+func (mfq *MessageForwardQuery) Order(o ...messageforward.OrderOption) *MessageForwardQuery {
+	mfq.order = append(mfq.order, o...)
+	return mfq
+}
+
+// First returns the first MessageForward entity from the query.
+// Returns a *NotFoundError when no MessageForward was found.
+func (mfq *MessageForwardQuery) First(ctx context.Context) (*MessageForward, error) {
+	nodes, err := mfq.Limit(1).All(setContextOp(ctx, mfq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{messageforward.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mfq *MessageForwardQuery) FirstX(ctx context.Context) *MessageForward {
+	node, err := mfq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MessageForward ID from the query.
+// Returns a *NotFoundError when no MessageForward ID was found.
+func (mfq *MessageForwardQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mfq.Limit(1).IDs(setContextOp(ctx, mfq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{messageforward.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mfq *MessageForwardQuery) FirstIDX(ctx context.Context) int {
+	id, err := mfq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MessageForward entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MessageForward entity is found.
+// Returns a *NotFoundError when no MessageForward entities are found.
+func (mfq *MessageForwardQuery) Only(ctx context.Context) (*MessageForward, error) {
+	nodes, err := mfq.Limit(2).All(setContextOp(ctx, mfq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{messageforward.Label}
+	default:
+		return nil, &NotSingularError{messageforward.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mfq *MessageForwardQuery) OnlyX(ctx context.Context) *MessageForward {
+	node, err := mfq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MessageForward ID in the query.
+// Returns a *NotSingularError when more than one MessageForward ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (mfq *MessageForwardQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mfq.Limit(2).IDs(setContextOp(ctx, mfq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{messageforward.Label}
+	default:
+		err = &NotSingularError{messageforward.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mfq *MessageForwardQuery) OnlyIDX(ctx context.Context) int {
+	id, err := mfq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MessageForwards.
+func (mfq *MessageForwardQuery) All(ctx context.Context) ([]*MessageForward, error) {
+	ctx = setContextOp(ctx, mfq.ctx, ent.OpQueryAll)
+	if err := mfq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*MessageForward, *MessageForwardQuery]()
+	return withInterceptors[[]*MessageForward](ctx, mfq, qr, mfq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mfq *MessageForwardQuery) AllX(ctx context.Context) []*MessageForward {
+	nodes, err := mfq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MessageForward IDs.
+func (mfq *MessageForwardQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if mfq.ctx.Unique == nil && mfq.path != nil {
+		mfq.Unique(true)
+	}
+	ctx = setContextOp(ctx, mfq.ctx, ent.OpQueryIDs)
+	if err = mfq.Select(messageforward.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mfq *MessageForwardQuery) IDsX(ctx context.Context) []int {
+	ids, err := mfq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mfq *MessageForwardQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, mfq.ctx, ent.OpQueryCount)
+	if err := mfq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, mfq, querierCount[*MessageForwardQuery](), mfq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mfq *MessageForwardQuery) CountX(ctx context.Context) int {
+	count, err := mfq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mfq *MessageForwardQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, mfq.ctx, ent.OpQueryExist)
+	switch _, err := mfq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mfq *MessageForwardQuery) ExistX(ctx context.Context) bool {
+	exist, err := mfq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MessageForwardQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mfq *MessageForwardQuery) Clone() *MessageForwardQuery {
+	if mfq == nil {
+		return nil
+	}
+	return &MessageForwardQuery{
+		config:     mfq.config,
+		ctx:        mfq.ctx.Clone(),
+		order:      append([]messageforward.OrderOption{}, mfq.order...),
+		inters:     append([]Interceptor{}, mfq.inters...),
+		predicates: append([]predicate.MessageForward{}, mfq.predicates...),
+		// clone intermediate query.
+		sql:  mfq.sql.Clone(),
+		path: mfq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		MsgId string `json:"msgId,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MessageForward.Query().
+//		GroupBy(messageforward.FieldMsgId).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (mfq *MessageForwardQuery) GroupBy(field string, fields ...string) *MessageForwardGroupBy {
+	mfq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &MessageForwardGroupBy{build: mfq}
+	grbuild.flds = &mfq.ctx.Fields
+	grbuild.label = messageforward.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		MsgId string `json:"msgId,omitempty"`
+//	}
+//
+//	client.MessageForward.Query().
+//		Select(messageforward.FieldMsgId).
+//		Scan(ctx, &v)
+func (mfq *MessageForwardQuery) Select(fields ...string) *MessageForwardSelect {
+	mfq.ctx.Fields = append(mfq.ctx.Fields, fields...)
+	sbuild := &MessageForwardSelect{MessageForwardQuery: mfq}
+	sbuild.label = messageforward.Label
+	sbuild.flds, sbuild.scan = &mfq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a MessageForwardSelect configured with the given aggregations.
+func (mfq *MessageForwardQuery) Aggregate(fns ...AggregateFunc) *MessageForwardSelect {
+	return mfq.Select().Aggregate(fns...)
+}
+
+func (mfq *MessageForwardQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range mfq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, mfq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range mfq.ctx.Fields {
+		if !messageforward.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if mfq.path != nil {
+		prev, err := mfq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mfq.sql = prev
+	}
+	return nil
+}
+
+func (mfq *MessageForwardQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MessageForward, error) {
+	var (
+		nodes = []*MessageForward{}
+		_spec = mfq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MessageForward).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MessageForward{config: mfq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mfq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (mfq *MessageForwardQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mfq.querySpec()
+	_spec.Node.Columns = mfq.ctx.Fields
+	if len(mfq.ctx.Fields) > 0 {
+		_spec.Unique = mfq.ctx.Unique != nil && *mfq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, mfq.driver, _spec)
+}
+
+func (mfq *MessageForwardQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(messageforward.Table, messageforward.Columns, sqlgraph.NewFieldSpec(messageforward.FieldID, field.TypeInt))
+	_spec.From = mfq.sql
+	if unique := mfq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if mfq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := mfq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, messageforward.FieldID)
+		for i := range fields {
+			if fields[i] != messageforward.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := mfq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mfq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mfq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mfq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mfq *MessageForwardQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mfq.driver.Dialect())
+	t1 := builder.Table(messageforward.Table)
+	columns := mfq.ctx.Fields
+	if len(columns) == 0 {
+		columns = messageforward.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mfq.sql != nil {
+		selector = mfq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if mfq.ctx.Unique != nil && *mfq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range mfq.predicates {
+		p(selector)
+	}
+	for _, p := range mfq.order {
+		p(selector)
+	}
+	if offset := mfq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mfq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// MessageForwardGroupBy is the group-by builder for MessageForward entities.
+type MessageForwardGroupBy struct {
+	selector
+	build *MessageForwardQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mfgb *MessageForwardGroupBy) Aggregate(fns ...AggregateFunc) *MessageForwardGroupBy {
+	mfgb.fns = append(mfgb.fns, fns...)
+	return mfgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mfgb *MessageForwardGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mfgb.build.ctx, ent.OpQueryGroupBy)
+	if err := mfgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MessageForwardQuery, *MessageForwardGroupBy](ctx, mfgb.build, mfgb, mfgb.build.inters, v)
+}
+
+func (mfgb *MessageForwardGroupBy) sqlScan(ctx context.Context, root *MessageForwardQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(mfgb.fns))
+	for _, fn := range mfgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*mfgb.flds)+len(mfgb.fns))
+		for _, f := range *mfgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*mfgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mfgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// MessageForwardSelect is the builder for selecting fields of MessageForward entities.
+type MessageForwardSelect struct {
+	*MessageForwardQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mfs *MessageForwardSelect) Aggregate(fns ...AggregateFunc) *MessageForwardSelect {
+	mfs.fns = append(mfs.fns, fns...)
+	return mfs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mfs *MessageForwardSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mfs.ctx, ent.OpQuerySelect)
+	if err := mfs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MessageForwardQuery, *MessageForwardSelect](ctx, mfs.MessageForwardQuery, mfs, mfs.inters, v)
+}
+
+func (mfs *MessageForwardSelect) sqlScan(ctx context.Context, root *MessageForwardQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mfs.fns))
+	for _, fn := range mfs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mfs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mfs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
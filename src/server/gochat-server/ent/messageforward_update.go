@@ -0,0 +1,562 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gochat_server/ent/messageforward"
+	"gochat_server/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MessageForwardUpdate is the builder for updating MessageForward entities.
+type MessageForwardUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MessageForwardMutation
+}
+
+// Where appends a list predicates to the MessageForwardUpdate builder.
+func (mfu *MessageForwardUpdate) Where(ps ...predicate.MessageForward) *MessageForwardUpdate {
+	mfu.mutation.Where(ps...)
+	return mfu
+}
+
+// SetMsgId sets the "msgId" field.
+func (mfu *MessageForwardUpdate) SetMsgId(s string) *MessageForwardUpdate {
+	mfu.mutation.SetMsgId(s)
+	return mfu
+}
+
+// SetNillableMsgId sets the "msgId" field if the given value is not nil.
+func (mfu *MessageForwardUpdate) SetNillableMsgId(s *string) *MessageForwardUpdate {
+	if s != nil {
+		mfu.SetMsgId(*s)
+	}
+	return mfu
+}
+
+// SetSourceMsgId sets the "sourceMsgId" field.
+func (mfu *MessageForwardUpdate) SetSourceMsgId(s string) *MessageForwardUpdate {
+	mfu.mutation.SetSourceMsgId(s)
+	return mfu
+}
+
+// SetNillableSourceMsgId sets the "sourceMsgId" field if the given value is not nil.
+func (mfu *MessageForwardUpdate) SetNillableSourceMsgId(s *string) *MessageForwardUpdate {
+	if s != nil {
+		mfu.SetSourceMsgId(*s)
+	}
+	return mfu
+}
+
+// SetSourceFromUserId sets the "sourceFromUserId" field.
+func (mfu *MessageForwardUpdate) SetSourceFromUserId(i int) *MessageForwardUpdate {
+	mfu.mutation.ResetSourceFromUserId()
+	mfu.mutation.SetSourceFromUserId(i)
+	return mfu
+}
+
+// SetNillableSourceFromUserId sets the "sourceFromUserId" field if the given value is not nil.
+func (mfu *MessageForwardUpdate) SetNillableSourceFromUserId(i *int) *MessageForwardUpdate {
+	if i != nil {
+		mfu.SetSourceFromUserId(*i)
+	}
+	return mfu
+}
+
+// AddSourceFromUserId adds i to the "sourceFromUserId" field.
+func (mfu *MessageForwardUpdate) AddSourceFromUserId(i int) *MessageForwardUpdate {
+	mfu.mutation.AddSourceFromUserId(i)
+	return mfu
+}
+
+// SetSourceIsGroup sets the "sourceIsGroup" field.
+func (mfu *MessageForwardUpdate) SetSourceIsGroup(b bool) *MessageForwardUpdate {
+	mfu.mutation.SetSourceIsGroup(b)
+	return mfu
+}
+
+// SetNillableSourceIsGroup sets the "sourceIsGroup" field if the given value is not nil.
+func (mfu *MessageForwardUpdate) SetNillableSourceIsGroup(b *bool) *MessageForwardUpdate {
+	if b != nil {
+		mfu.SetSourceIsGroup(*b)
+	}
+	return mfu
+}
+
+// SetSourceGroupId sets the "sourceGroupId" field.
+func (mfu *MessageForwardUpdate) SetSourceGroupId(i int) *MessageForwardUpdate {
+	mfu.mutation.ResetSourceGroupId()
+	mfu.mutation.SetSourceGroupId(i)
+	return mfu
+}
+
+// SetNillableSourceGroupId sets the "sourceGroupId" field if the given value is not nil.
+func (mfu *MessageForwardUpdate) SetNillableSourceGroupId(i *int) *MessageForwardUpdate {
+	if i != nil {
+		mfu.SetSourceGroupId(*i)
+	}
+	return mfu
+}
+
+// AddSourceGroupId adds i to the "sourceGroupId" field.
+func (mfu *MessageForwardUpdate) AddSourceGroupId(i int) *MessageForwardUpdate {
+	mfu.mutation.AddSourceGroupId(i)
+	return mfu
+}
+
+// ClearSourceGroupId clears the value of the "sourceGroupId" field.
+func (mfu *MessageForwardUpdate) ClearSourceGroupId() *MessageForwardUpdate {
+	mfu.mutation.ClearSourceGroupId()
+	return mfu
+}
+
+// SetSourceCreateTime sets the "sourceCreateTime" field.
+func (mfu *MessageForwardUpdate) SetSourceCreateTime(t time.Time) *MessageForwardUpdate {
+	mfu.mutation.SetSourceCreateTime(t)
+	return mfu
+}
+
+// SetNillableSourceCreateTime sets the "sourceCreateTime" field if the given value is not nil.
+func (mfu *MessageForwardUpdate) SetNillableSourceCreateTime(t *time.Time) *MessageForwardUpdate {
+	if t != nil {
+		mfu.SetSourceCreateTime(*t)
+	}
+	return mfu
+}
+
+// SetForwardUserId sets the "forwardUserId" field.
+func (mfu *MessageForwardUpdate) SetForwardUserId(i int) *MessageForwardUpdate {
+	mfu.mutation.ResetForwardUserId()
+	mfu.mutation.SetForwardUserId(i)
+	return mfu
+}
+
+// SetNillableForwardUserId sets the "forwardUserId" field if the given value is not nil.
+func (mfu *MessageForwardUpdate) SetNillableForwardUserId(i *int) *MessageForwardUpdate {
+	if i != nil {
+		mfu.SetForwardUserId(*i)
+	}
+	return mfu
+}
+
+// AddForwardUserId adds i to the "forwardUserId" field.
+func (mfu *MessageForwardUpdate) AddForwardUserId(i int) *MessageForwardUpdate {
+	mfu.mutation.AddForwardUserId(i)
+	return mfu
+}
+
+// SetCreateTime sets the "createTime" field.
+func (mfu *MessageForwardUpdate) SetCreateTime(t time.Time) *MessageForwardUpdate {
+	mfu.mutation.SetCreateTime(t)
+	return mfu
+}
+
+// SetNillableCreateTime sets the "createTime" field if the given value is not nil.
+func (mfu *MessageForwardUpdate) SetNillableCreateTime(t *time.Time) *MessageForwardUpdate {
+	if t != nil {
+		mfu.SetCreateTime(*t)
+	}
+	return mfu
+}
+
+// Mutation returns the MessageForwardMutation object of the builder.
+func (mfu *MessageForwardUpdate) Mutation() *MessageForwardMutation {
+	return mfu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mfu *MessageForwardUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, mfu.sqlSave, mfu.mutation, mfu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mfu *MessageForwardUpdate) SaveX(ctx context.Context) int {
+	affected, err := mfu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mfu *MessageForwardUpdate) Exec(ctx context.Context) error {
+	_, err := mfu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mfu *MessageForwardUpdate) ExecX(ctx context.Context) {
+	if err := mfu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mfu *MessageForwardUpdate) check() error {
+	if v, ok := mfu.mutation.MsgId(); ok {
+		if err := messageforward.MsgIdValidator(v); err != nil {
+			return &ValidationError{Name: "msgId", err: fmt.Errorf(`ent: validator failed for field "MessageForward.msgId": %w`, err)}
+		}
+	}
+	if v, ok := mfu.mutation.SourceMsgId(); ok {
+		if err := messageforward.SourceMsgIdValidator(v); err != nil {
+			return &ValidationError{Name: "sourceMsgId", err: fmt.Errorf(`ent: validator failed for field "MessageForward.sourceMsgId": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (mfu *MessageForwardUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mfu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(messageforward.Table, messageforward.Columns, sqlgraph.NewFieldSpec(messageforward.FieldID, field.TypeInt))
+	if ps := mfu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mfu.mutation.MsgId(); ok {
+		_spec.SetField(messageforward.FieldMsgId, field.TypeString, value)
+	}
+	if value, ok := mfu.mutation.SourceMsgId(); ok {
+		_spec.SetField(messageforward.FieldSourceMsgId, field.TypeString, value)
+	}
+	if value, ok := mfu.mutation.SourceFromUserId(); ok {
+		_spec.SetField(messageforward.FieldSourceFromUserId, field.TypeInt, value)
+	}
+	if value, ok := mfu.mutation.AddedSourceFromUserId(); ok {
+		_spec.AddField(messageforward.FieldSourceFromUserId, field.TypeInt, value)
+	}
+	if value, ok := mfu.mutation.SourceIsGroup(); ok {
+		_spec.SetField(messageforward.FieldSourceIsGroup, field.TypeBool, value)
+	}
+	if value, ok := mfu.mutation.SourceGroupId(); ok {
+		_spec.SetField(messageforward.FieldSourceGroupId, field.TypeInt, value)
+	}
+	if value, ok := mfu.mutation.AddedSourceGroupId(); ok {
+		_spec.AddField(messageforward.FieldSourceGroupId, field.TypeInt, value)
+	}
+	if mfu.mutation.SourceGroupIdCleared() {
+		_spec.ClearField(messageforward.FieldSourceGroupId, field.TypeInt)
+	}
+	if value, ok := mfu.mutation.SourceCreateTime(); ok {
+		_spec.SetField(messageforward.FieldSourceCreateTime, field.TypeTime, value)
+	}
+	if value, ok := mfu.mutation.ForwardUserId(); ok {
+		_spec.SetField(messageforward.FieldForwardUserId, field.TypeInt, value)
+	}
+	if value, ok := mfu.mutation.AddedForwardUserId(); ok {
+		_spec.AddField(messageforward.FieldForwardUserId, field.TypeInt, value)
+	}
+	if value, ok := mfu.mutation.CreateTime(); ok {
+		_spec.SetField(messageforward.FieldCreateTime, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mfu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messageforward.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mfu.mutation.done = true
+	return n, nil
+}
+
+// MessageForwardUpdateOne is the builder for updating a single MessageForward entity.
+type MessageForwardUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MessageForwardMutation
+}
+
+// SetMsgId sets the "msgId" field.
+func (mfuo *MessageForwardUpdateOne) SetMsgId(s string) *MessageForwardUpdateOne {
+	mfuo.mutation.SetMsgId(s)
+	return mfuo
+}
+
+// SetNillableMsgId sets the "msgId" field if the given value is not nil.
+func (mfuo *MessageForwardUpdateOne) SetNillableMsgId(s *string) *MessageForwardUpdateOne {
+	if s != nil {
+		mfuo.SetMsgId(*s)
+	}
+	return mfuo
+}
+
+// SetSourceMsgId sets the "sourceMsgId" field.
+func (mfuo *MessageForwardUpdateOne) SetSourceMsgId(s string) *MessageForwardUpdateOne {
+	mfuo.mutation.SetSourceMsgId(s)
+	return mfuo
+}
+
+// SetNillableSourceMsgId sets the "sourceMsgId" field if the given value is not nil.
+func (mfuo *MessageForwardUpdateOne) SetNillableSourceMsgId(s *string) *MessageForwardUpdateOne {
+	if s != nil {
+		mfuo.SetSourceMsgId(*s)
+	}
+	return mfuo
+}
+
+// SetSourceFromUserId sets the "sourceFromUserId" field.
+func (mfuo *MessageForwardUpdateOne) SetSourceFromUserId(i int) *MessageForwardUpdateOne {
+	mfuo.mutation.ResetSourceFromUserId()
+	mfuo.mutation.SetSourceFromUserId(i)
+	return mfuo
+}
+
+// SetNillableSourceFromUserId sets the "sourceFromUserId" field if the given value is not nil.
+func (mfuo *MessageForwardUpdateOne) SetNillableSourceFromUserId(i *int) *MessageForwardUpdateOne {
+	if i != nil {
+		mfuo.SetSourceFromUserId(*i)
+	}
+	return mfuo
+}
+
+// AddSourceFromUserId adds i to the "sourceFromUserId" field.
+func (mfuo *MessageForwardUpdateOne) AddSourceFromUserId(i int) *MessageForwardUpdateOne {
+	mfuo.mutation.AddSourceFromUserId(i)
+	return mfuo
+}
+
+// SetSourceIsGroup sets the "sourceIsGroup" field.
+func (mfuo *MessageForwardUpdateOne) SetSourceIsGroup(b bool) *MessageForwardUpdateOne {
+	mfuo.mutation.SetSourceIsGroup(b)
+	return mfuo
+}
+
+// SetNillableSourceIsGroup sets the "sourceIsGroup" field if the given value is not nil.
+func (mfuo *MessageForwardUpdateOne) SetNillableSourceIsGroup(b *bool) *MessageForwardUpdateOne {
+	if b != nil {
+		mfuo.SetSourceIsGroup(*b)
+	}
+	return mfuo
+}
+
+// SetSourceGroupId sets the "sourceGroupId" field.
+func (mfuo *MessageForwardUpdateOne) SetSourceGroupId(i int) *MessageForwardUpdateOne {
+	mfuo.mutation.ResetSourceGroupId()
+	mfuo.mutation.SetSourceGroupId(i)
+	return mfuo
+}
+
+// SetNillableSourceGroupId sets the "sourceGroupId" field if the given value is not nil.
+func (mfuo *MessageForwardUpdateOne) SetNillableSourceGroupId(i *int) *MessageForwardUpdateOne {
+	if i != nil {
+		mfuo.SetSourceGroupId(*i)
+	}
+	return mfuo
+}
+
+// AddSourceGroupId adds i to the "sourceGroupId" field.
+func (mfuo *MessageForwardUpdateOne) AddSourceGroupId(i int) *MessageForwardUpdateOne {
+	mfuo.mutation.AddSourceGroupId(i)
+	return mfuo
+}
+
+// ClearSourceGroupId clears the value of the "sourceGroupId" field.
+func (mfuo *MessageForwardUpdateOne) ClearSourceGroupId() *MessageForwardUpdateOne {
+	mfuo.mutation.ClearSourceGroupId()
+	return mfuo
+}
+
+// SetSourceCreateTime sets the "sourceCreateTime" field.
+func (mfuo *MessageForwardUpdateOne) SetSourceCreateTime(t time.Time) *MessageForwardUpdateOne {
+	mfuo.mutation.SetSourceCreateTime(t)
+	return mfuo
+}
+
+// SetNillableSourceCreateTime sets the "sourceCreateTime" field if the given value is not nil.
+func (mfuo *MessageForwardUpdateOne) SetNillableSourceCreateTime(t *time.Time) *MessageForwardUpdateOne {
+	if t != nil {
+		mfuo.SetSourceCreateTime(*t)
+	}
+	return mfuo
+}
+
+// SetForwardUserId sets the "forwardUserId" field.
+func (mfuo *MessageForwardUpdateOne) SetForwardUserId(i int) *MessageForwardUpdateOne {
+	mfuo.mutation.ResetForwardUserId()
+	mfuo.mutation.SetForwardUserId(i)
+	return mfuo
+}
+
+// SetNillableForwardUserId sets the "forwardUserId" field if the given value is not nil.
+func (mfuo *MessageForwardUpdateOne) SetNillableForwardUserId(i *int) *MessageForwardUpdateOne {
+	if i != nil {
+		mfuo.SetForwardUserId(*i)
+	}
+	return mfuo
+}
+
+// AddForwardUserId adds i to the "forwardUserId" field.
+func (mfuo *MessageForwardUpdateOne) AddForwardUserId(i int) *MessageForwardUpdateOne {
+	mfuo.mutation.AddForwardUserId(i)
+	return mfuo
+}
+
+// SetCreateTime sets the "createTime" field.
+func (mfuo *MessageForwardUpdateOne) SetCreateTime(t time.Time) *MessageForwardUpdateOne {
+	mfuo.mutation.SetCreateTime(t)
+	return mfuo
+}
+
+// SetNillableCreateTime sets the "createTime" field if the given value is not nil.
+func (mfuo *MessageForwardUpdateOne) SetNillableCreateTime(t *time.Time) *MessageForwardUpdateOne {
+	if t != nil {
+		mfuo.SetCreateTime(*t)
+	}
+	return mfuo
+}
+
+// Mutation returns the MessageForwardMutation object of the builder.
+func (mfuo *MessageForwardUpdateOne) Mutation() *MessageForwardMutation {
+	return mfuo.mutation
+}
+
+// Where appends a list predicates to the MessageForwardUpdate builder.
+func (mfuo *MessageForwardUpdateOne) Where(ps ...predicate.MessageForward) *MessageForwardUpdateOne {
+	mfuo.mutation.Where(ps...)
+	return mfuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mfuo *MessageForwardUpdateOne) Select(field string, fields ...string) *MessageForwardUpdateOne {
+	mfuo.fields = append([]string{field}, fields...)
+	return mfuo
+}
+
+// Save executes the query and returns the updated MessageForward entity.
+func (mfuo *MessageForwardUpdateOne) Save(ctx context.Context) (*MessageForward, error) {
+	return withHooks(ctx, mfuo.sqlSave, mfuo.mutation, mfuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mfuo *MessageForwardUpdateOne) SaveX(ctx context.Context) *MessageForward {
+	node, err := mfuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mfuo *MessageForwardUpdateOne) Exec(ctx context.Context) error {
+	_, err := mfuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mfuo *MessageForwardUpdateOne) ExecX(ctx context.Context) {
+	if err := mfuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mfuo *MessageForwardUpdateOne) check() error {
+	if v, ok := mfuo.mutation.MsgId(); ok {
+		if err := messageforward.MsgIdValidator(v); err != nil {
+			return &ValidationError{Name: "msgId", err: fmt.Errorf(`ent: validator failed for field "MessageForward.msgId": %w`, err)}
+		}
+	}
+	if v, ok := mfuo.mutation.SourceMsgId(); ok {
+		if err := messageforward.SourceMsgIdValidator(v); err != nil {
+			return &ValidationError{Name: "sourceMsgId", err: fmt.Errorf(`ent: validator failed for field "MessageForward.sourceMsgId": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (mfuo *MessageForwardUpdateOne) sqlSave(ctx context.Context) (_node *MessageForward, err error) {
+	if err := mfuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(messageforward.Table, messageforward.Columns, sqlgraph.NewFieldSpec(messageforward.FieldID, field.TypeInt))
+	id, ok := mfuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MessageForward.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mfuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, messageforward.FieldID)
+		for _, f := range fields {
+			if !messageforward.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != messageforward.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mfuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mfuo.mutation.MsgId(); ok {
+		_spec.SetField(messageforward.FieldMsgId, field.TypeString, value)
+	}
+	if value, ok := mfuo.mutation.SourceMsgId(); ok {
+		_spec.SetField(messageforward.FieldSourceMsgId, field.TypeString, value)
+	}
+	if value, ok := mfuo.mutation.SourceFromUserId(); ok {
+		_spec.SetField(messageforward.FieldSourceFromUserId, field.TypeInt, value)
+	}
+	if value, ok := mfuo.mutation.AddedSourceFromUserId(); ok {
+		_spec.AddField(messageforward.FieldSourceFromUserId, field.TypeInt, value)
+	}
+	if value, ok := mfuo.mutation.SourceIsGroup(); ok {
+		_spec.SetField(messageforward.FieldSourceIsGroup, field.TypeBool, value)
+	}
+	if value, ok := mfuo.mutation.SourceGroupId(); ok {
+		_spec.SetField(messageforward.FieldSourceGroupId, field.TypeInt, value)
+	}
+	if value, ok := mfuo.mutation.AddedSourceGroupId(); ok {
+		_spec.AddField(messageforward.FieldSourceGroupId, field.TypeInt, value)
+	}
+	if mfuo.mutation.SourceGroupIdCleared() {
+		_spec.ClearField(messageforward.FieldSourceGroupId, field.TypeInt)
+	}
+	if value, ok := mfuo.mutation.SourceCreateTime(); ok {
+		_spec.SetField(messageforward.FieldSourceCreateTime, field.TypeTime, value)
+	}
+	if value, ok := mfuo.mutation.ForwardUserId(); ok {
+		_spec.SetField(messageforward.FieldForwardUserId, field.TypeInt, value)
+	}
+	if value, ok := mfuo.mutation.AddedForwardUserId(); ok {
+		_spec.AddField(messageforward.FieldForwardUserId, field.TypeInt, value)
+	}
+	if value, ok := mfuo.mutation.CreateTime(); ok {
+		_spec.SetField(messageforward.FieldCreateTime, field.TypeTime, value)
+	}
+	_node = &MessageForward{config: mfuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mfuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messageforward.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mfuo.mutation.done = true
+	return _node, nil
+}
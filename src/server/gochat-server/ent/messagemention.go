@@ -0,0 +1,185 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"gochat_server/ent/messagemention"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// MessageMention is the model entity for the MessageMention schema.
+type MessageMention struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 消息ID
+	MsgId string `json:"msgId,omitempty"`
+	// 群组ID
+	GroupId int `json:"groupId,omitempty"`
+	// 发送者ID
+	FromUserId int `json:"fromUserId,omitempty"`
+	// 被提及的用户ID，@所有人时为0
+	MentionedUserId int `json:"mentionedUserId,omitempty"`
+	// 是否为@所有人
+	IsAll bool `json:"isAll,omitempty"`
+	// 提及在消息文本中的起始位置（字符）
+	Offset int `json:"offset,omitempty"`
+	// 提及文本长度（字符）
+	Length int `json:"length,omitempty"`
+	// 创建时间
+	CreateTime   time.Time `json:"createTime,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MessageMention) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case messagemention.FieldIsAll:
+			values[i] = new(sql.NullBool)
+		case messagemention.FieldID, messagemention.FieldGroupId, messagemention.FieldFromUserId, messagemention.FieldMentionedUserId, messagemention.FieldOffset, messagemention.FieldLength:
+			values[i] = new(sql.NullInt64)
+		case messagemention.FieldMsgId:
+			values[i] = new(sql.NullString)
+		case messagemention.FieldCreateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MessageMention fields.
+func (mm *MessageMention) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case messagemention.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			mm.ID = int(value.Int64)
+		case messagemention.FieldMsgId:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field msgId", values[i])
+			} else if value.Valid {
+				mm.MsgId = value.String
+			}
+		case messagemention.FieldGroupId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field groupId", values[i])
+			} else if value.Valid {
+				mm.GroupId = int(value.Int64)
+			}
+		case messagemention.FieldFromUserId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field fromUserId", values[i])
+			} else if value.Valid {
+				mm.FromUserId = int(value.Int64)
+			}
+		case messagemention.FieldMentionedUserId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field mentionedUserId", values[i])
+			} else if value.Valid {
+				mm.MentionedUserId = int(value.Int64)
+			}
+		case messagemention.FieldIsAll:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field isAll", values[i])
+			} else if value.Valid {
+				mm.IsAll = value.Bool
+			}
+		case messagemention.FieldOffset:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field offset", values[i])
+			} else if value.Valid {
+				mm.Offset = int(value.Int64)
+			}
+		case messagemention.FieldLength:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field length", values[i])
+			} else if value.Valid {
+				mm.Length = int(value.Int64)
+			}
+		case messagemention.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field createTime", values[i])
+			} else if value.Valid {
+				mm.CreateTime = value.Time
+			}
+		default:
+			mm.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MessageMention.
+// This includes values selected through modifiers, order, etc.
+func (mm *MessageMention) Value(name string) (ent.Value, error) {
+	return mm.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this MessageMention.
+// Note that you need to call MessageMention.Unwrap() before calling this method if this MessageMention
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mm *MessageMention) Update() *MessageMentionUpdateOne {
+	return NewMessageMentionClient(mm.config).UpdateOne(mm)
+}
+
+// Unwrap unwraps the MessageMention entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mm *MessageMention) Unwrap() *MessageMention {
+	_tx, ok := mm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MessageMention is not a transactional entity")
+	}
+	mm.config.driver = _tx.drv
+	return mm
+}
+
+// String implements the fmt.Stringer.
+func (mm *MessageMention) String() string {
+	var builder strings.Builder
+	builder.WriteString("MessageMention(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mm.ID))
+	builder.WriteString("msgId=")
+	builder.WriteString(mm.MsgId)
+	builder.WriteString(", ")
+	builder.WriteString("groupId=")
+	builder.WriteString(fmt.Sprintf("%v", mm.GroupId))
+	builder.WriteString(", ")
+	builder.WriteString("fromUserId=")
+	builder.WriteString(fmt.Sprintf("%v", mm.FromUserId))
+	builder.WriteString(", ")
+	builder.WriteString("mentionedUserId=")
+	builder.WriteString(fmt.Sprintf("%v", mm.MentionedUserId))
+	builder.WriteString(", ")
+	builder.WriteString("isAll=")
+	builder.WriteString(fmt.Sprintf("%v", mm.IsAll))
+	builder.WriteString(", ")
+	builder.WriteString("offset=")
+	builder.WriteString(fmt.Sprintf("%v", mm.Offset))
+	builder.WriteString(", ")
+	builder.WriteString("length=")
+	builder.WriteString(fmt.Sprintf("%v", mm.Length))
+	builder.WriteString(", ")
+	builder.WriteString("createTime=")
+	builder.WriteString(mm.CreateTime.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MessageMentions is a parsable slice of MessageMention.
+type MessageMentions []*MessageMention
@@ -0,0 +1,120 @@
+// Code generated by ent, DO NOT EDIT.
+
+package messagemention
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the messagemention type in the database.
+	Label = "message_mention"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldMsgId holds the string denoting the msgid field in the database.
+	FieldMsgId = "msg_id"
+	// FieldGroupId holds the string denoting the groupid field in the database.
+	FieldGroupId = "group_id"
+	// FieldFromUserId holds the string denoting the fromuserid field in the database.
+	FieldFromUserId = "from_user_id"
+	// FieldMentionedUserId holds the string denoting the mentioneduserid field in the database.
+	FieldMentionedUserId = "mentioned_user_id"
+	// FieldIsAll holds the string denoting the isall field in the database.
+	FieldIsAll = "is_all"
+	// FieldOffset holds the string denoting the offset field in the database.
+	FieldOffset = "offset"
+	// FieldLength holds the string denoting the length field in the database.
+	FieldLength = "length"
+	// FieldCreateTime holds the string denoting the createtime field in the database.
+	FieldCreateTime = "create_time"
+	// Table holds the table name of the messagemention in the database.
+	Table = "message_mentions"
+)
+
+// Columns holds all SQL columns for messagemention fields.
+var Columns = []string{
+	FieldID,
+	FieldMsgId,
+	FieldGroupId,
+	FieldFromUserId,
+	FieldMentionedUserId,
+	FieldIsAll,
+	FieldOffset,
+	FieldLength,
+	FieldCreateTime,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// MsgIdValidator is a validator for the "msgId" field. It is called by the builders before save.
+	MsgIdValidator func(string) error
+	// DefaultMentionedUserId holds the default value on creation for the "mentionedUserId" field.
+	DefaultMentionedUserId int
+	// DefaultIsAll holds the default value on creation for the "isAll" field.
+	DefaultIsAll bool
+	// DefaultOffset holds the default value on creation for the "offset" field.
+	DefaultOffset int
+	// DefaultLength holds the default value on creation for the "length" field.
+	DefaultLength int
+	// DefaultCreateTime holds the default value on creation for the "createTime" field.
+	DefaultCreateTime func() time.Time
+)
+
+// OrderOption defines the ordering options for the MessageMention queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByMsgId orders the results by the msgId field.
+func ByMsgId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMsgId, opts...).ToFunc()
+}
+
+// ByGroupId orders the results by the groupId field.
+func ByGroupId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGroupId, opts...).ToFunc()
+}
+
+// ByFromUserId orders the results by the fromUserId field.
+func ByFromUserId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFromUserId, opts...).ToFunc()
+}
+
+// ByMentionedUserId orders the results by the mentionedUserId field.
+func ByMentionedUserId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMentionedUserId, opts...).ToFunc()
+}
+
+// ByIsAll orders the results by the isAll field.
+func ByIsAll(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsAll, opts...).ToFunc()
+}
+
+// ByOffset orders the results by the offset field.
+func ByOffset(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOffset, opts...).ToFunc()
+}
+
+// ByLength orders the results by the length field.
+func ByLength(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLength, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the createTime field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
@@ -0,0 +1,425 @@
+// Code generated by ent, DO NOT EDIT.
+
+package messagemention
+
+import (
+	"gochat_server/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldLTE(FieldID, id))
+}
+
+// MsgId applies equality check predicate on the "msgId" field. It's identical to MsgIdEQ.
+func MsgId(v string) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldEQ(FieldMsgId, v))
+}
+
+// GroupId applies equality check predicate on the "groupId" field. It's identical to GroupIdEQ.
+func GroupId(v int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldEQ(FieldGroupId, v))
+}
+
+// FromUserId applies equality check predicate on the "fromUserId" field. It's identical to FromUserIdEQ.
+func FromUserId(v int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldEQ(FieldFromUserId, v))
+}
+
+// MentionedUserId applies equality check predicate on the "mentionedUserId" field. It's identical to MentionedUserIdEQ.
+func MentionedUserId(v int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldEQ(FieldMentionedUserId, v))
+}
+
+// IsAll applies equality check predicate on the "isAll" field. It's identical to IsAllEQ.
+func IsAll(v bool) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldEQ(FieldIsAll, v))
+}
+
+// Offset applies equality check predicate on the "offset" field. It's identical to OffsetEQ.
+func Offset(v int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldEQ(FieldOffset, v))
+}
+
+// Length applies equality check predicate on the "length" field. It's identical to LengthEQ.
+func Length(v int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldEQ(FieldLength, v))
+}
+
+// CreateTime applies equality check predicate on the "createTime" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// MsgIdEQ applies the EQ predicate on the "msgId" field.
+func MsgIdEQ(v string) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldEQ(FieldMsgId, v))
+}
+
+// MsgIdNEQ applies the NEQ predicate on the "msgId" field.
+func MsgIdNEQ(v string) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldNEQ(FieldMsgId, v))
+}
+
+// MsgIdIn applies the In predicate on the "msgId" field.
+func MsgIdIn(vs ...string) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldIn(FieldMsgId, vs...))
+}
+
+// MsgIdNotIn applies the NotIn predicate on the "msgId" field.
+func MsgIdNotIn(vs ...string) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldNotIn(FieldMsgId, vs...))
+}
+
+// MsgIdGT applies the GT predicate on the "msgId" field.
+func MsgIdGT(v string) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldGT(FieldMsgId, v))
+}
+
+// MsgIdGTE applies the GTE predicate on the "msgId" field.
+func MsgIdGTE(v string) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldGTE(FieldMsgId, v))
+}
+
+// MsgIdLT applies the LT predicate on the "msgId" field.
+func MsgIdLT(v string) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldLT(FieldMsgId, v))
+}
+
+// MsgIdLTE applies the LTE predicate on the "msgId" field.
+func MsgIdLTE(v string) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldLTE(FieldMsgId, v))
+}
+
+// MsgIdContains applies the Contains predicate on the "msgId" field.
+func MsgIdContains(v string) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldContains(FieldMsgId, v))
+}
+
+// MsgIdHasPrefix applies the HasPrefix predicate on the "msgId" field.
+func MsgIdHasPrefix(v string) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldHasPrefix(FieldMsgId, v))
+}
+
+// MsgIdHasSuffix applies the HasSuffix predicate on the "msgId" field.
+func MsgIdHasSuffix(v string) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldHasSuffix(FieldMsgId, v))
+}
+
+// MsgIdEqualFold applies the EqualFold predicate on the "msgId" field.
+func MsgIdEqualFold(v string) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldEqualFold(FieldMsgId, v))
+}
+
+// MsgIdContainsFold applies the ContainsFold predicate on the "msgId" field.
+func MsgIdContainsFold(v string) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldContainsFold(FieldMsgId, v))
+}
+
+// GroupIdEQ applies the EQ predicate on the "groupId" field.
+func GroupIdEQ(v int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldEQ(FieldGroupId, v))
+}
+
+// GroupIdNEQ applies the NEQ predicate on the "groupId" field.
+func GroupIdNEQ(v int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldNEQ(FieldGroupId, v))
+}
+
+// GroupIdIn applies the In predicate on the "groupId" field.
+func GroupIdIn(vs ...int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldIn(FieldGroupId, vs...))
+}
+
+// GroupIdNotIn applies the NotIn predicate on the "groupId" field.
+func GroupIdNotIn(vs ...int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldNotIn(FieldGroupId, vs...))
+}
+
+// GroupIdGT applies the GT predicate on the "groupId" field.
+func GroupIdGT(v int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldGT(FieldGroupId, v))
+}
+
+// GroupIdGTE applies the GTE predicate on the "groupId" field.
+func GroupIdGTE(v int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldGTE(FieldGroupId, v))
+}
+
+// GroupIdLT applies the LT predicate on the "groupId" field.
+func GroupIdLT(v int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldLT(FieldGroupId, v))
+}
+
+// GroupIdLTE applies the LTE predicate on the "groupId" field.
+func GroupIdLTE(v int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldLTE(FieldGroupId, v))
+}
+
+// FromUserIdEQ applies the EQ predicate on the "fromUserId" field.
+func FromUserIdEQ(v int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldEQ(FieldFromUserId, v))
+}
+
+// FromUserIdNEQ applies the NEQ predicate on the "fromUserId" field.
+func FromUserIdNEQ(v int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldNEQ(FieldFromUserId, v))
+}
+
+// FromUserIdIn applies the In predicate on the "fromUserId" field.
+func FromUserIdIn(vs ...int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldIn(FieldFromUserId, vs...))
+}
+
+// FromUserIdNotIn applies the NotIn predicate on the "fromUserId" field.
+func FromUserIdNotIn(vs ...int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldNotIn(FieldFromUserId, vs...))
+}
+
+// FromUserIdGT applies the GT predicate on the "fromUserId" field.
+func FromUserIdGT(v int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldGT(FieldFromUserId, v))
+}
+
+// FromUserIdGTE applies the GTE predicate on the "fromUserId" field.
+func FromUserIdGTE(v int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldGTE(FieldFromUserId, v))
+}
+
+// FromUserIdLT applies the LT predicate on the "fromUserId" field.
+func FromUserIdLT(v int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldLT(FieldFromUserId, v))
+}
+
+// FromUserIdLTE applies the LTE predicate on the "fromUserId" field.
+func FromUserIdLTE(v int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldLTE(FieldFromUserId, v))
+}
+
+// MentionedUserIdEQ applies the EQ predicate on the "mentionedUserId" field.
+func MentionedUserIdEQ(v int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldEQ(FieldMentionedUserId, v))
+}
+
+// MentionedUserIdNEQ applies the NEQ predicate on the "mentionedUserId" field.
+func MentionedUserIdNEQ(v int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldNEQ(FieldMentionedUserId, v))
+}
+
+// MentionedUserIdIn applies the In predicate on the "mentionedUserId" field.
+func MentionedUserIdIn(vs ...int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldIn(FieldMentionedUserId, vs...))
+}
+
+// MentionedUserIdNotIn applies the NotIn predicate on the "mentionedUserId" field.
+func MentionedUserIdNotIn(vs ...int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldNotIn(FieldMentionedUserId, vs...))
+}
+
+// MentionedUserIdGT applies the GT predicate on the "mentionedUserId" field.
+func MentionedUserIdGT(v int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldGT(FieldMentionedUserId, v))
+}
+
+// MentionedUserIdGTE applies the GTE predicate on the "mentionedUserId" field.
+func MentionedUserIdGTE(v int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldGTE(FieldMentionedUserId, v))
+}
+
+// MentionedUserIdLT applies the LT predicate on the "mentionedUserId" field.
+func MentionedUserIdLT(v int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldLT(FieldMentionedUserId, v))
+}
+
+// MentionedUserIdLTE applies the LTE predicate on the "mentionedUserId" field.
+func MentionedUserIdLTE(v int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldLTE(FieldMentionedUserId, v))
+}
+
+// IsAllEQ applies the EQ predicate on the "isAll" field.
+func IsAllEQ(v bool) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldEQ(FieldIsAll, v))
+}
+
+// IsAllNEQ applies the NEQ predicate on the "isAll" field.
+func IsAllNEQ(v bool) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldNEQ(FieldIsAll, v))
+}
+
+// OffsetEQ applies the EQ predicate on the "offset" field.
+func OffsetEQ(v int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldEQ(FieldOffset, v))
+}
+
+// OffsetNEQ applies the NEQ predicate on the "offset" field.
+func OffsetNEQ(v int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldNEQ(FieldOffset, v))
+}
+
+// OffsetIn applies the In predicate on the "offset" field.
+func OffsetIn(vs ...int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldIn(FieldOffset, vs...))
+}
+
+// OffsetNotIn applies the NotIn predicate on the "offset" field.
+func OffsetNotIn(vs ...int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldNotIn(FieldOffset, vs...))
+}
+
+// OffsetGT applies the GT predicate on the "offset" field.
+func OffsetGT(v int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldGT(FieldOffset, v))
+}
+
+// OffsetGTE applies the GTE predicate on the "offset" field.
+func OffsetGTE(v int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldGTE(FieldOffset, v))
+}
+
+// OffsetLT applies the LT predicate on the "offset" field.
+func OffsetLT(v int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldLT(FieldOffset, v))
+}
+
+// OffsetLTE applies the LTE predicate on the "offset" field.
+func OffsetLTE(v int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldLTE(FieldOffset, v))
+}
+
+// LengthEQ applies the EQ predicate on the "length" field.
+func LengthEQ(v int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldEQ(FieldLength, v))
+}
+
+// LengthNEQ applies the NEQ predicate on the "length" field.
+func LengthNEQ(v int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldNEQ(FieldLength, v))
+}
+
+// LengthIn applies the In predicate on the "length" field.
+func LengthIn(vs ...int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldIn(FieldLength, vs...))
+}
+
+// LengthNotIn applies the NotIn predicate on the "length" field.
+func LengthNotIn(vs ...int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldNotIn(FieldLength, vs...))
+}
+
+// LengthGT applies the GT predicate on the "length" field.
+func LengthGT(v int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldGT(FieldLength, v))
+}
+
+// LengthGTE applies the GTE predicate on the "length" field.
+func LengthGTE(v int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldGTE(FieldLength, v))
+}
+
+// LengthLT applies the LT predicate on the "length" field.
+func LengthLT(v int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldLT(FieldLength, v))
+}
+
+// LengthLTE applies the LTE predicate on the "length" field.
+func LengthLTE(v int) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldLTE(FieldLength, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "createTime" field.
+func CreateTimeEQ(v time.Time) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "createTime" field.
+func CreateTimeNEQ(v time.Time) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "createTime" field.
+func CreateTimeIn(vs ...time.Time) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "createTime" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "createTime" field.
+func CreateTimeGT(v time.Time) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "createTime" field.
+func CreateTimeGTE(v time.Time) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "createTime" field.
+func CreateTimeLT(v time.Time) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "createTime" field.
+func CreateTimeLTE(v time.Time) predicate.MessageMention {
+	return predicate.MessageMention(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MessageMention) predicate.MessageMention {
+	return predicate.MessageMention(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MessageMention) predicate.MessageMention {
+	return predicate.MessageMention(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MessageMention) predicate.MessageMention {
+	return predicate.MessageMention(sql.NotPredicates(p))
+}
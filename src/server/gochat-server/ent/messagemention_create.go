@@ -0,0 +1,346 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gochat_server/ent/messagemention"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MessageMentionCreate is the builder for creating a MessageMention entity.
+type MessageMentionCreate struct {
+	config
+	mutation *MessageMentionMutation
+	hooks    []Hook
+}
+
+// SetMsgId sets the "msgId" field.
+func (mmc *MessageMentionCreate) SetMsgId(s string) *MessageMentionCreate {
+	mmc.mutation.SetMsgId(s)
+	return mmc
+}
+
+// SetGroupId sets the "groupId" field.
+func (mmc *MessageMentionCreate) SetGroupId(i int) *MessageMentionCreate {
+	mmc.mutation.SetGroupId(i)
+	return mmc
+}
+
+// SetFromUserId sets the "fromUserId" field.
+func (mmc *MessageMentionCreate) SetFromUserId(i int) *MessageMentionCreate {
+	mmc.mutation.SetFromUserId(i)
+	return mmc
+}
+
+// SetMentionedUserId sets the "mentionedUserId" field.
+func (mmc *MessageMentionCreate) SetMentionedUserId(i int) *MessageMentionCreate {
+	mmc.mutation.SetMentionedUserId(i)
+	return mmc
+}
+
+// SetNillableMentionedUserId sets the "mentionedUserId" field if the given value is not nil.
+func (mmc *MessageMentionCreate) SetNillableMentionedUserId(i *int) *MessageMentionCreate {
+	if i != nil {
+		mmc.SetMentionedUserId(*i)
+	}
+	return mmc
+}
+
+// SetIsAll sets the "isAll" field.
+func (mmc *MessageMentionCreate) SetIsAll(b bool) *MessageMentionCreate {
+	mmc.mutation.SetIsAll(b)
+	return mmc
+}
+
+// SetNillableIsAll sets the "isAll" field if the given value is not nil.
+func (mmc *MessageMentionCreate) SetNillableIsAll(b *bool) *MessageMentionCreate {
+	if b != nil {
+		mmc.SetIsAll(*b)
+	}
+	return mmc
+}
+
+// SetOffset sets the "offset" field.
+func (mmc *MessageMentionCreate) SetOffset(i int) *MessageMentionCreate {
+	mmc.mutation.SetOffset(i)
+	return mmc
+}
+
+// SetNillableOffset sets the "offset" field if the given value is not nil.
+func (mmc *MessageMentionCreate) SetNillableOffset(i *int) *MessageMentionCreate {
+	if i != nil {
+		mmc.SetOffset(*i)
+	}
+	return mmc
+}
+
+// SetLength sets the "length" field.
+func (mmc *MessageMentionCreate) SetLength(i int) *MessageMentionCreate {
+	mmc.mutation.SetLength(i)
+	return mmc
+}
+
+// SetNillableLength sets the "length" field if the given value is not nil.
+func (mmc *MessageMentionCreate) SetNillableLength(i *int) *MessageMentionCreate {
+	if i != nil {
+		mmc.SetLength(*i)
+	}
+	return mmc
+}
+
+// SetCreateTime sets the "createTime" field.
+func (mmc *MessageMentionCreate) SetCreateTime(t time.Time) *MessageMentionCreate {
+	mmc.mutation.SetCreateTime(t)
+	return mmc
+}
+
+// SetNillableCreateTime sets the "createTime" field if the given value is not nil.
+func (mmc *MessageMentionCreate) SetNillableCreateTime(t *time.Time) *MessageMentionCreate {
+	if t != nil {
+		mmc.SetCreateTime(*t)
+	}
+	return mmc
+}
+
+// Mutation returns the MessageMentionMutation object of the builder.
+func (mmc *MessageMentionCreate) Mutation() *MessageMentionMutation {
+	return mmc.mutation
+}
+
+// Save creates the MessageMention in the database.
+func (mmc *MessageMentionCreate) Save(ctx context.Context) (*MessageMention, error) {
+	mmc.defaults()
+	return withHooks(ctx, mmc.sqlSave, mmc.mutation, mmc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mmc *MessageMentionCreate) SaveX(ctx context.Context) *MessageMention {
+	v, err := mmc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mmc *MessageMentionCreate) Exec(ctx context.Context) error {
+	_, err := mmc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mmc *MessageMentionCreate) ExecX(ctx context.Context) {
+	if err := mmc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mmc *MessageMentionCreate) defaults() {
+	if _, ok := mmc.mutation.MentionedUserId(); !ok {
+		v := messagemention.DefaultMentionedUserId
+		mmc.mutation.SetMentionedUserId(v)
+	}
+	if _, ok := mmc.mutation.IsAll(); !ok {
+		v := messagemention.DefaultIsAll
+		mmc.mutation.SetIsAll(v)
+	}
+	if _, ok := mmc.mutation.Offset(); !ok {
+		v := messagemention.DefaultOffset
+		mmc.mutation.SetOffset(v)
+	}
+	if _, ok := mmc.mutation.Length(); !ok {
+		v := messagemention.DefaultLength
+		mmc.mutation.SetLength(v)
+	}
+	if _, ok := mmc.mutation.CreateTime(); !ok {
+		v := messagemention.DefaultCreateTime()
+		mmc.mutation.SetCreateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mmc *MessageMentionCreate) check() error {
+	if _, ok := mmc.mutation.MsgId(); !ok {
+		return &ValidationError{Name: "msgId", err: errors.New(`ent: missing required field "MessageMention.msgId"`)}
+	}
+	if v, ok := mmc.mutation.MsgId(); ok {
+		if err := messagemention.MsgIdValidator(v); err != nil {
+			return &ValidationError{Name: "msgId", err: fmt.Errorf(`ent: validator failed for field "MessageMention.msgId": %w`, err)}
+		}
+	}
+	if _, ok := mmc.mutation.GroupId(); !ok {
+		return &ValidationError{Name: "groupId", err: errors.New(`ent: missing required field "MessageMention.groupId"`)}
+	}
+	if _, ok := mmc.mutation.FromUserId(); !ok {
+		return &ValidationError{Name: "fromUserId", err: errors.New(`ent: missing required field "MessageMention.fromUserId"`)}
+	}
+	if _, ok := mmc.mutation.MentionedUserId(); !ok {
+		return &ValidationError{Name: "mentionedUserId", err: errors.New(`ent: missing required field "MessageMention.mentionedUserId"`)}
+	}
+	if _, ok := mmc.mutation.IsAll(); !ok {
+		return &ValidationError{Name: "isAll", err: errors.New(`ent: missing required field "MessageMention.isAll"`)}
+	}
+	if _, ok := mmc.mutation.Offset(); !ok {
+		return &ValidationError{Name: "offset", err: errors.New(`ent: missing required field "MessageMention.offset"`)}
+	}
+	if _, ok := mmc.mutation.Length(); !ok {
+		return &ValidationError{Name: "length", err: errors.New(`ent: missing required field "MessageMention.length"`)}
+	}
+	if _, ok := mmc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "createTime", err: errors.New(`ent: missing required field "MessageMention.createTime"`)}
+	}
+	return nil
+}
+
+func (mmc *MessageMentionCreate) sqlSave(ctx context.Context) (*MessageMention, error) {
+	if err := mmc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mmc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mmc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	mmc.mutation.id = &_node.ID
+	mmc.mutation.done = true
+	return _node, nil
+}
+
+func (mmc *MessageMentionCreate) createSpec() (*MessageMention, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MessageMention{config: mmc.config}
+		_spec = sqlgraph.NewCreateSpec(messagemention.Table, sqlgraph.NewFieldSpec(messagemention.FieldID, field.TypeInt))
+	)
+	if value, ok := mmc.mutation.MsgId(); ok {
+		_spec.SetField(messagemention.FieldMsgId, field.TypeString, value)
+		_node.MsgId = value
+	}
+	if value, ok := mmc.mutation.GroupId(); ok {
+		_spec.SetField(messagemention.FieldGroupId, field.TypeInt, value)
+		_node.GroupId = value
+	}
+	if value, ok := mmc.mutation.FromUserId(); ok {
+		_spec.SetField(messagemention.FieldFromUserId, field.TypeInt, value)
+		_node.FromUserId = value
+	}
+	if value, ok := mmc.mutation.MentionedUserId(); ok {
+		_spec.SetField(messagemention.FieldMentionedUserId, field.TypeInt, value)
+		_node.MentionedUserId = value
+	}
+	if value, ok := mmc.mutation.IsAll(); ok {
+		_spec.SetField(messagemention.FieldIsAll, field.TypeBool, value)
+		_node.IsAll = value
+	}
+	if value, ok := mmc.mutation.Offset(); ok {
+		_spec.SetField(messagemention.FieldOffset, field.TypeInt, value)
+		_node.Offset = value
+	}
+	if value, ok := mmc.mutation.Length(); ok {
+		_spec.SetField(messagemention.FieldLength, field.TypeInt, value)
+		_node.Length = value
+	}
+	if value, ok := mmc.mutation.CreateTime(); ok {
+		_spec.SetField(messagemention.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	return _node, _spec
+}
+
+// MessageMentionCreateBulk is the builder for creating many MessageMention entities in bulk.
+type MessageMentionCreateBulk struct {
+	config
+	err      error
+	builders []*MessageMentionCreate
+}
+
+// Save creates the MessageMention entities in the database.
+func (mmcb *MessageMentionCreateBulk) Save(ctx context.Context) ([]*MessageMention, error) {
+	if mmcb.err != nil {
+		return nil, mmcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mmcb.builders))
+	nodes := make([]*MessageMention, len(mmcb.builders))
+	mutators := make([]Mutator, len(mmcb.builders))
+	for i := range mmcb.builders {
+		func(i int, root context.Context) {
+			builder := mmcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MessageMentionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mmcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mmcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mmcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mmcb *MessageMentionCreateBulk) SaveX(ctx context.Context) []*MessageMention {
+	v, err := mmcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mmcb *MessageMentionCreateBulk) Exec(ctx context.Context) error {
+	_, err := mmcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mmcb *MessageMentionCreateBulk) ExecX(ctx context.Context) {
+	if err := mmcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,574 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gochat_server/ent/messagemention"
+	"gochat_server/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MessageMentionUpdate is the builder for updating MessageMention entities.
+type MessageMentionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MessageMentionMutation
+}
+
+// Where appends a list predicates to the MessageMentionUpdate builder.
+func (mmu *MessageMentionUpdate) Where(ps ...predicate.MessageMention) *MessageMentionUpdate {
+	mmu.mutation.Where(ps...)
+	return mmu
+}
+
+// SetMsgId sets the "msgId" field.
+func (mmu *MessageMentionUpdate) SetMsgId(s string) *MessageMentionUpdate {
+	mmu.mutation.SetMsgId(s)
+	return mmu
+}
+
+// SetNillableMsgId sets the "msgId" field if the given value is not nil.
+func (mmu *MessageMentionUpdate) SetNillableMsgId(s *string) *MessageMentionUpdate {
+	if s != nil {
+		mmu.SetMsgId(*s)
+	}
+	return mmu
+}
+
+// SetGroupId sets the "groupId" field.
+func (mmu *MessageMentionUpdate) SetGroupId(i int) *MessageMentionUpdate {
+	mmu.mutation.ResetGroupId()
+	mmu.mutation.SetGroupId(i)
+	return mmu
+}
+
+// SetNillableGroupId sets the "groupId" field if the given value is not nil.
+func (mmu *MessageMentionUpdate) SetNillableGroupId(i *int) *MessageMentionUpdate {
+	if i != nil {
+		mmu.SetGroupId(*i)
+	}
+	return mmu
+}
+
+// AddGroupId adds i to the "groupId" field.
+func (mmu *MessageMentionUpdate) AddGroupId(i int) *MessageMentionUpdate {
+	mmu.mutation.AddGroupId(i)
+	return mmu
+}
+
+// SetFromUserId sets the "fromUserId" field.
+func (mmu *MessageMentionUpdate) SetFromUserId(i int) *MessageMentionUpdate {
+	mmu.mutation.ResetFromUserId()
+	mmu.mutation.SetFromUserId(i)
+	return mmu
+}
+
+// SetNillableFromUserId sets the "fromUserId" field if the given value is not nil.
+func (mmu *MessageMentionUpdate) SetNillableFromUserId(i *int) *MessageMentionUpdate {
+	if i != nil {
+		mmu.SetFromUserId(*i)
+	}
+	return mmu
+}
+
+// AddFromUserId adds i to the "fromUserId" field.
+func (mmu *MessageMentionUpdate) AddFromUserId(i int) *MessageMentionUpdate {
+	mmu.mutation.AddFromUserId(i)
+	return mmu
+}
+
+// SetMentionedUserId sets the "mentionedUserId" field.
+func (mmu *MessageMentionUpdate) SetMentionedUserId(i int) *MessageMentionUpdate {
+	mmu.mutation.ResetMentionedUserId()
+	mmu.mutation.SetMentionedUserId(i)
+	return mmu
+}
+
+// SetNillableMentionedUserId sets the "mentionedUserId" field if the given value is not nil.
+func (mmu *MessageMentionUpdate) SetNillableMentionedUserId(i *int) *MessageMentionUpdate {
+	if i != nil {
+		mmu.SetMentionedUserId(*i)
+	}
+	return mmu
+}
+
+// AddMentionedUserId adds i to the "mentionedUserId" field.
+func (mmu *MessageMentionUpdate) AddMentionedUserId(i int) *MessageMentionUpdate {
+	mmu.mutation.AddMentionedUserId(i)
+	return mmu
+}
+
+// SetIsAll sets the "isAll" field.
+func (mmu *MessageMentionUpdate) SetIsAll(b bool) *MessageMentionUpdate {
+	mmu.mutation.SetIsAll(b)
+	return mmu
+}
+
+// SetNillableIsAll sets the "isAll" field if the given value is not nil.
+func (mmu *MessageMentionUpdate) SetNillableIsAll(b *bool) *MessageMentionUpdate {
+	if b != nil {
+		mmu.SetIsAll(*b)
+	}
+	return mmu
+}
+
+// SetOffset sets the "offset" field.
+func (mmu *MessageMentionUpdate) SetOffset(i int) *MessageMentionUpdate {
+	mmu.mutation.ResetOffset()
+	mmu.mutation.SetOffset(i)
+	return mmu
+}
+
+// SetNillableOffset sets the "offset" field if the given value is not nil.
+func (mmu *MessageMentionUpdate) SetNillableOffset(i *int) *MessageMentionUpdate {
+	if i != nil {
+		mmu.SetOffset(*i)
+	}
+	return mmu
+}
+
+// AddOffset adds i to the "offset" field.
+func (mmu *MessageMentionUpdate) AddOffset(i int) *MessageMentionUpdate {
+	mmu.mutation.AddOffset(i)
+	return mmu
+}
+
+// SetLength sets the "length" field.
+func (mmu *MessageMentionUpdate) SetLength(i int) *MessageMentionUpdate {
+	mmu.mutation.ResetLength()
+	mmu.mutation.SetLength(i)
+	return mmu
+}
+
+// SetNillableLength sets the "length" field if the given value is not nil.
+func (mmu *MessageMentionUpdate) SetNillableLength(i *int) *MessageMentionUpdate {
+	if i != nil {
+		mmu.SetLength(*i)
+	}
+	return mmu
+}
+
+// AddLength adds i to the "length" field.
+func (mmu *MessageMentionUpdate) AddLength(i int) *MessageMentionUpdate {
+	mmu.mutation.AddLength(i)
+	return mmu
+}
+
+// SetCreateTime sets the "createTime" field.
+func (mmu *MessageMentionUpdate) SetCreateTime(t time.Time) *MessageMentionUpdate {
+	mmu.mutation.SetCreateTime(t)
+	return mmu
+}
+
+// SetNillableCreateTime sets the "createTime" field if the given value is not nil.
+func (mmu *MessageMentionUpdate) SetNillableCreateTime(t *time.Time) *MessageMentionUpdate {
+	if t != nil {
+		mmu.SetCreateTime(*t)
+	}
+	return mmu
+}
+
+// Mutation returns the MessageMentionMutation object of the builder.
+func (mmu *MessageMentionUpdate) Mutation() *MessageMentionMutation {
+	return mmu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mmu *MessageMentionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, mmu.sqlSave, mmu.mutation, mmu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mmu *MessageMentionUpdate) SaveX(ctx context.Context) int {
+	affected, err := mmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mmu *MessageMentionUpdate) Exec(ctx context.Context) error {
+	_, err := mmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mmu *MessageMentionUpdate) ExecX(ctx context.Context) {
+	if err := mmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mmu *MessageMentionUpdate) check() error {
+	if v, ok := mmu.mutation.MsgId(); ok {
+		if err := messagemention.MsgIdValidator(v); err != nil {
+			return &ValidationError{Name: "msgId", err: fmt.Errorf(`ent: validator failed for field "MessageMention.msgId": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (mmu *MessageMentionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mmu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(messagemention.Table, messagemention.Columns, sqlgraph.NewFieldSpec(messagemention.FieldID, field.TypeInt))
+	if ps := mmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mmu.mutation.MsgId(); ok {
+		_spec.SetField(messagemention.FieldMsgId, field.TypeString, value)
+	}
+	if value, ok := mmu.mutation.GroupId(); ok {
+		_spec.SetField(messagemention.FieldGroupId, field.TypeInt, value)
+	}
+	if value, ok := mmu.mutation.AddedGroupId(); ok {
+		_spec.AddField(messagemention.FieldGroupId, field.TypeInt, value)
+	}
+	if value, ok := mmu.mutation.FromUserId(); ok {
+		_spec.SetField(messagemention.FieldFromUserId, field.TypeInt, value)
+	}
+	if value, ok := mmu.mutation.AddedFromUserId(); ok {
+		_spec.AddField(messagemention.FieldFromUserId, field.TypeInt, value)
+	}
+	if value, ok := mmu.mutation.MentionedUserId(); ok {
+		_spec.SetField(messagemention.FieldMentionedUserId, field.TypeInt, value)
+	}
+	if value, ok := mmu.mutation.AddedMentionedUserId(); ok {
+		_spec.AddField(messagemention.FieldMentionedUserId, field.TypeInt, value)
+	}
+	if value, ok := mmu.mutation.IsAll(); ok {
+		_spec.SetField(messagemention.FieldIsAll, field.TypeBool, value)
+	}
+	if value, ok := mmu.mutation.Offset(); ok {
+		_spec.SetField(messagemention.FieldOffset, field.TypeInt, value)
+	}
+	if value, ok := mmu.mutation.AddedOffset(); ok {
+		_spec.AddField(messagemention.FieldOffset, field.TypeInt, value)
+	}
+	if value, ok := mmu.mutation.Length(); ok {
+		_spec.SetField(messagemention.FieldLength, field.TypeInt, value)
+	}
+	if value, ok := mmu.mutation.AddedLength(); ok {
+		_spec.AddField(messagemention.FieldLength, field.TypeInt, value)
+	}
+	if value, ok := mmu.mutation.CreateTime(); ok {
+		_spec.SetField(messagemention.FieldCreateTime, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messagemention.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mmu.mutation.done = true
+	return n, nil
+}
+
+// MessageMentionUpdateOne is the builder for updating a single MessageMention entity.
+type MessageMentionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MessageMentionMutation
+}
+
+// SetMsgId sets the "msgId" field.
+func (mmuo *MessageMentionUpdateOne) SetMsgId(s string) *MessageMentionUpdateOne {
+	mmuo.mutation.SetMsgId(s)
+	return mmuo
+}
+
+// SetNillableMsgId sets the "msgId" field if the given value is not nil.
+func (mmuo *MessageMentionUpdateOne) SetNillableMsgId(s *string) *MessageMentionUpdateOne {
+	if s != nil {
+		mmuo.SetMsgId(*s)
+	}
+	return mmuo
+}
+
+// SetGroupId sets the "groupId" field.
+func (mmuo *MessageMentionUpdateOne) SetGroupId(i int) *MessageMentionUpdateOne {
+	mmuo.mutation.ResetGroupId()
+	mmuo.mutation.SetGroupId(i)
+	return mmuo
+}
+
+// SetNillableGroupId sets the "groupId" field if the given value is not nil.
+func (mmuo *MessageMentionUpdateOne) SetNillableGroupId(i *int) *MessageMentionUpdateOne {
+	if i != nil {
+		mmuo.SetGroupId(*i)
+	}
+	return mmuo
+}
+
+// AddGroupId adds i to the "groupId" field.
+func (mmuo *MessageMentionUpdateOne) AddGroupId(i int) *MessageMentionUpdateOne {
+	mmuo.mutation.AddGroupId(i)
+	return mmuo
+}
+
+// SetFromUserId sets the "fromUserId" field.
+func (mmuo *MessageMentionUpdateOne) SetFromUserId(i int) *MessageMentionUpdateOne {
+	mmuo.mutation.ResetFromUserId()
+	mmuo.mutation.SetFromUserId(i)
+	return mmuo
+}
+
+// SetNillableFromUserId sets the "fromUserId" field if the given value is not nil.
+func (mmuo *MessageMentionUpdateOne) SetNillableFromUserId(i *int) *MessageMentionUpdateOne {
+	if i != nil {
+		mmuo.SetFromUserId(*i)
+	}
+	return mmuo
+}
+
+// AddFromUserId adds i to the "fromUserId" field.
+func (mmuo *MessageMentionUpdateOne) AddFromUserId(i int) *MessageMentionUpdateOne {
+	mmuo.mutation.AddFromUserId(i)
+	return mmuo
+}
+
+// SetMentionedUserId sets the "mentionedUserId" field.
+func (mmuo *MessageMentionUpdateOne) SetMentionedUserId(i int) *MessageMentionUpdateOne {
+	mmuo.mutation.ResetMentionedUserId()
+	mmuo.mutation.SetMentionedUserId(i)
+	return mmuo
+}
+
+// SetNillableMentionedUserId sets the "mentionedUserId" field if the given value is not nil.
+func (mmuo *MessageMentionUpdateOne) SetNillableMentionedUserId(i *int) *MessageMentionUpdateOne {
+	if i != nil {
+		mmuo.SetMentionedUserId(*i)
+	}
+	return mmuo
+}
+
+// AddMentionedUserId adds i to the "mentionedUserId" field.
+func (mmuo *MessageMentionUpdateOne) AddMentionedUserId(i int) *MessageMentionUpdateOne {
+	mmuo.mutation.AddMentionedUserId(i)
+	return mmuo
+}
+
+// SetIsAll sets the "isAll" field.
+func (mmuo *MessageMentionUpdateOne) SetIsAll(b bool) *MessageMentionUpdateOne {
+	mmuo.mutation.SetIsAll(b)
+	return mmuo
+}
+
+// SetNillableIsAll sets the "isAll" field if the given value is not nil.
+func (mmuo *MessageMentionUpdateOne) SetNillableIsAll(b *bool) *MessageMentionUpdateOne {
+	if b != nil {
+		mmuo.SetIsAll(*b)
+	}
+	return mmuo
+}
+
+// SetOffset sets the "offset" field.
+func (mmuo *MessageMentionUpdateOne) SetOffset(i int) *MessageMentionUpdateOne {
+	mmuo.mutation.ResetOffset()
+	mmuo.mutation.SetOffset(i)
+	return mmuo
+}
+
+// SetNillableOffset sets the "offset" field if the given value is not nil.
+func (mmuo *MessageMentionUpdateOne) SetNillableOffset(i *int) *MessageMentionUpdateOne {
+	if i != nil {
+		mmuo.SetOffset(*i)
+	}
+	return mmuo
+}
+
+// AddOffset adds i to the "offset" field.
+func (mmuo *MessageMentionUpdateOne) AddOffset(i int) *MessageMentionUpdateOne {
+	mmuo.mutation.AddOffset(i)
+	return mmuo
+}
+
+// SetLength sets the "length" field.
+func (mmuo *MessageMentionUpdateOne) SetLength(i int) *MessageMentionUpdateOne {
+	mmuo.mutation.ResetLength()
+	mmuo.mutation.SetLength(i)
+	return mmuo
+}
+
+// SetNillableLength sets the "length" field if the given value is not nil.
+func (mmuo *MessageMentionUpdateOne) SetNillableLength(i *int) *MessageMentionUpdateOne {
+	if i != nil {
+		mmuo.SetLength(*i)
+	}
+	return mmuo
+}
+
+// AddLength adds i to the "length" field.
+func (mmuo *MessageMentionUpdateOne) AddLength(i int) *MessageMentionUpdateOne {
+	mmuo.mutation.AddLength(i)
+	return mmuo
+}
+
+// SetCreateTime sets the "createTime" field.
+func (mmuo *MessageMentionUpdateOne) SetCreateTime(t time.Time) *MessageMentionUpdateOne {
+	mmuo.mutation.SetCreateTime(t)
+	return mmuo
+}
+
+// SetNillableCreateTime sets the "createTime" field if the given value is not nil.
+func (mmuo *MessageMentionUpdateOne) SetNillableCreateTime(t *time.Time) *MessageMentionUpdateOne {
+	if t != nil {
+		mmuo.SetCreateTime(*t)
+	}
+	return mmuo
+}
+
+// Mutation returns the MessageMentionMutation object of the builder.
+func (mmuo *MessageMentionUpdateOne) Mutation() *MessageMentionMutation {
+	return mmuo.mutation
+}
+
+// Where appends a list predicates to the MessageMentionUpdate builder.
+func (mmuo *MessageMentionUpdateOne) Where(ps ...predicate.MessageMention) *MessageMentionUpdateOne {
+	mmuo.mutation.Where(ps...)
+	return mmuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mmuo *MessageMentionUpdateOne) Select(field string, fields ...string) *MessageMentionUpdateOne {
+	mmuo.fields = append([]string{field}, fields...)
+	return mmuo
+}
+
+// Save executes the query and returns the updated MessageMention entity.
+func (mmuo *MessageMentionUpdateOne) Save(ctx context.Context) (*MessageMention, error) {
+	return withHooks(ctx, mmuo.sqlSave, mmuo.mutation, mmuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mmuo *MessageMentionUpdateOne) SaveX(ctx context.Context) *MessageMention {
+	node, err := mmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mmuo *MessageMentionUpdateOne) Exec(ctx context.Context) error {
+	_, err := mmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mmuo *MessageMentionUpdateOne) ExecX(ctx context.Context) {
+	if err := mmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mmuo *MessageMentionUpdateOne) check() error {
+	if v, ok := mmuo.mutation.MsgId(); ok {
+		if err := messagemention.MsgIdValidator(v); err != nil {
+			return &ValidationError{Name: "msgId", err: fmt.Errorf(`ent: validator failed for field "MessageMention.msgId": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (mmuo *MessageMentionUpdateOne) sqlSave(ctx context.Context) (_node *MessageMention, err error) {
+	if err := mmuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(messagemention.Table, messagemention.Columns, sqlgraph.NewFieldSpec(messagemention.FieldID, field.TypeInt))
+	id, ok := mmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MessageMention.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, messagemention.FieldID)
+		for _, f := range fields {
+			if !messagemention.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != messagemention.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mmuo.mutation.MsgId(); ok {
+		_spec.SetField(messagemention.FieldMsgId, field.TypeString, value)
+	}
+	if value, ok := mmuo.mutation.GroupId(); ok {
+		_spec.SetField(messagemention.FieldGroupId, field.TypeInt, value)
+	}
+	if value, ok := mmuo.mutation.AddedGroupId(); ok {
+		_spec.AddField(messagemention.FieldGroupId, field.TypeInt, value)
+	}
+	if value, ok := mmuo.mutation.FromUserId(); ok {
+		_spec.SetField(messagemention.FieldFromUserId, field.TypeInt, value)
+	}
+	if value, ok := mmuo.mutation.AddedFromUserId(); ok {
+		_spec.AddField(messagemention.FieldFromUserId, field.TypeInt, value)
+	}
+	if value, ok := mmuo.mutation.MentionedUserId(); ok {
+		_spec.SetField(messagemention.FieldMentionedUserId, field.TypeInt, value)
+	}
+	if value, ok := mmuo.mutation.AddedMentionedUserId(); ok {
+		_spec.AddField(messagemention.FieldMentionedUserId, field.TypeInt, value)
+	}
+	if value, ok := mmuo.mutation.IsAll(); ok {
+		_spec.SetField(messagemention.FieldIsAll, field.TypeBool, value)
+	}
+	if value, ok := mmuo.mutation.Offset(); ok {
+		_spec.SetField(messagemention.FieldOffset, field.TypeInt, value)
+	}
+	if value, ok := mmuo.mutation.AddedOffset(); ok {
+		_spec.AddField(messagemention.FieldOffset, field.TypeInt, value)
+	}
+	if value, ok := mmuo.mutation.Length(); ok {
+		_spec.SetField(messagemention.FieldLength, field.TypeInt, value)
+	}
+	if value, ok := mmuo.mutation.AddedLength(); ok {
+		_spec.AddField(messagemention.FieldLength, field.TypeInt, value)
+	}
+	if value, ok := mmuo.mutation.CreateTime(); ok {
+		_spec.SetField(messagemention.FieldCreateTime, field.TypeTime, value)
+	}
+	_node = &MessageMention{config: mmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messagemention.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mmuo.mutation.done = true
+	return _node, nil
+}
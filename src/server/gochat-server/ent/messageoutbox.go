@@ -0,0 +1,183 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"gochat_server/ent/messageoutbox"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// MessageOutbox is the model entity for the MessageOutbox schema.
+type MessageOutbox struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 消息ID
+	MsgId string `json:"msgId,omitempty"`
+	// 接收者ID
+	UserId int `json:"userId,omitempty"`
+	// 状态: pending 待推送, dead 多次失败后放弃
+	Status string `json:"status,omitempty"`
+	// 已尝试推送的次数
+	Attempts int `json:"attempts,omitempty"`
+	// 下次可以推送的时间，推送中的记录为租约到期时间
+	NextAttemptTime time.Time `json:"nextAttemptTime,omitempty"`
+	// 最近一次推送失败的原因
+	LastError string `json:"lastError,omitempty"`
+	// 领取时写入的租约标识，分发器一次更新领取一批记录后按该标识查出领取的记录
+	LeaseToken string `json:"leaseToken,omitempty"`
+	// 创建时间
+	CreateTime   time.Time `json:"createTime,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MessageOutbox) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case messageoutbox.FieldID, messageoutbox.FieldUserId, messageoutbox.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case messageoutbox.FieldMsgId, messageoutbox.FieldStatus, messageoutbox.FieldLastError, messageoutbox.FieldLeaseToken:
+			values[i] = new(sql.NullString)
+		case messageoutbox.FieldNextAttemptTime, messageoutbox.FieldCreateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MessageOutbox fields.
+func (mo *MessageOutbox) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case messageoutbox.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			mo.ID = int(value.Int64)
+		case messageoutbox.FieldMsgId:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field msgId", values[i])
+			} else if value.Valid {
+				mo.MsgId = value.String
+			}
+		case messageoutbox.FieldUserId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field userId", values[i])
+			} else if value.Valid {
+				mo.UserId = int(value.Int64)
+			}
+		case messageoutbox.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				mo.Status = value.String
+			}
+		case messageoutbox.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				mo.Attempts = int(value.Int64)
+			}
+		case messageoutbox.FieldNextAttemptTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field nextAttemptTime", values[i])
+			} else if value.Valid {
+				mo.NextAttemptTime = value.Time
+			}
+		case messageoutbox.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field lastError", values[i])
+			} else if value.Valid {
+				mo.LastError = value.String
+			}
+		case messageoutbox.FieldLeaseToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field leaseToken", values[i])
+			} else if value.Valid {
+				mo.LeaseToken = value.String
+			}
+		case messageoutbox.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field createTime", values[i])
+			} else if value.Valid {
+				mo.CreateTime = value.Time
+			}
+		default:
+			mo.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MessageOutbox.
+// This includes values selected through modifiers, order, etc.
+func (mo *MessageOutbox) Value(name string) (ent.Value, error) {
+	return mo.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this MessageOutbox.
+// Note that you need to call MessageOutbox.Unwrap() before calling this method if this MessageOutbox
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mo *MessageOutbox) Update() *MessageOutboxUpdateOne {
+	return NewMessageOutboxClient(mo.config).UpdateOne(mo)
+}
+
+// Unwrap unwraps the MessageOutbox entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mo *MessageOutbox) Unwrap() *MessageOutbox {
+	_tx, ok := mo.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MessageOutbox is not a transactional entity")
+	}
+	mo.config.driver = _tx.drv
+	return mo
+}
+
+// String implements the fmt.Stringer.
+func (mo *MessageOutbox) String() string {
+	var builder strings.Builder
+	builder.WriteString("MessageOutbox(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mo.ID))
+	builder.WriteString("msgId=")
+	builder.WriteString(mo.MsgId)
+	builder.WriteString(", ")
+	builder.WriteString("userId=")
+	builder.WriteString(fmt.Sprintf("%v", mo.UserId))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(mo.Status)
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", mo.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("nextAttemptTime=")
+	builder.WriteString(mo.NextAttemptTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("lastError=")
+	builder.WriteString(mo.LastError)
+	builder.WriteString(", ")
+	builder.WriteString("leaseToken=")
+	builder.WriteString(mo.LeaseToken)
+	builder.WriteString(", ")
+	builder.WriteString("createTime=")
+	builder.WriteString(mo.CreateTime.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MessageOutboxes is a parsable slice of MessageOutbox.
+type MessageOutboxes []*MessageOutbox
@@ -0,0 +1,120 @@
+// Code generated by ent, DO NOT EDIT.
+
+package messageoutbox
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the messageoutbox type in the database.
+	Label = "message_outbox"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldMsgId holds the string denoting the msgid field in the database.
+	FieldMsgId = "msg_id"
+	// FieldUserId holds the string denoting the userid field in the database.
+	FieldUserId = "user_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldAttempts holds the string denoting the attempts field in the database.
+	FieldAttempts = "attempts"
+	// FieldNextAttemptTime holds the string denoting the nextattempttime field in the database.
+	FieldNextAttemptTime = "next_attempt_time"
+	// FieldLastError holds the string denoting the lasterror field in the database.
+	FieldLastError = "last_error"
+	// FieldLeaseToken holds the string denoting the leasetoken field in the database.
+	FieldLeaseToken = "lease_token"
+	// FieldCreateTime holds the string denoting the createtime field in the database.
+	FieldCreateTime = "create_time"
+	// Table holds the table name of the messageoutbox in the database.
+	Table = "message_outboxes"
+)
+
+// Columns holds all SQL columns for messageoutbox fields.
+var Columns = []string{
+	FieldID,
+	FieldMsgId,
+	FieldUserId,
+	FieldStatus,
+	FieldAttempts,
+	FieldNextAttemptTime,
+	FieldLastError,
+	FieldLeaseToken,
+	FieldCreateTime,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// MsgIdValidator is a validator for the "msgId" field. It is called by the builders before save.
+	MsgIdValidator func(string) error
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultAttempts holds the default value on creation for the "attempts" field.
+	DefaultAttempts int
+	// DefaultNextAttemptTime holds the default value on creation for the "nextAttemptTime" field.
+	DefaultNextAttemptTime func() time.Time
+	// DefaultLeaseToken holds the default value on creation for the "leaseToken" field.
+	DefaultLeaseToken string
+	// DefaultCreateTime holds the default value on creation for the "createTime" field.
+	DefaultCreateTime func() time.Time
+)
+
+// OrderOption defines the ordering options for the MessageOutbox queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByMsgId orders the results by the msgId field.
+func ByMsgId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMsgId, opts...).ToFunc()
+}
+
+// ByUserId orders the results by the userId field.
+func ByUserId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserId, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByAttempts orders the results by the attempts field.
+func ByAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttempts, opts...).ToFunc()
+}
+
+// ByNextAttemptTime orders the results by the nextAttemptTime field.
+func ByNextAttemptTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextAttemptTime, opts...).ToFunc()
+}
+
+// ByLastError orders the results by the lastError field.
+func ByLastError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastError, opts...).ToFunc()
+}
+
+// ByLeaseToken orders the results by the leaseToken field.
+func ByLeaseToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLeaseToken, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the createTime field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
@@ -0,0 +1,540 @@
+// Code generated by ent, DO NOT EDIT.
+
+package messageoutbox
+
+import (
+	"gochat_server/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldLTE(FieldID, id))
+}
+
+// MsgId applies equality check predicate on the "msgId" field. It's identical to MsgIdEQ.
+func MsgId(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldEQ(FieldMsgId, v))
+}
+
+// UserId applies equality check predicate on the "userId" field. It's identical to UserIdEQ.
+func UserId(v int) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldEQ(FieldUserId, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldEQ(FieldStatus, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldEQ(FieldAttempts, v))
+}
+
+// NextAttemptTime applies equality check predicate on the "nextAttemptTime" field. It's identical to NextAttemptTimeEQ.
+func NextAttemptTime(v time.Time) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldEQ(FieldNextAttemptTime, v))
+}
+
+// LastError applies equality check predicate on the "lastError" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldEQ(FieldLastError, v))
+}
+
+// LeaseToken applies equality check predicate on the "leaseToken" field. It's identical to LeaseTokenEQ.
+func LeaseToken(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldEQ(FieldLeaseToken, v))
+}
+
+// CreateTime applies equality check predicate on the "createTime" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// MsgIdEQ applies the EQ predicate on the "msgId" field.
+func MsgIdEQ(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldEQ(FieldMsgId, v))
+}
+
+// MsgIdNEQ applies the NEQ predicate on the "msgId" field.
+func MsgIdNEQ(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldNEQ(FieldMsgId, v))
+}
+
+// MsgIdIn applies the In predicate on the "msgId" field.
+func MsgIdIn(vs ...string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldIn(FieldMsgId, vs...))
+}
+
+// MsgIdNotIn applies the NotIn predicate on the "msgId" field.
+func MsgIdNotIn(vs ...string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldNotIn(FieldMsgId, vs...))
+}
+
+// MsgIdGT applies the GT predicate on the "msgId" field.
+func MsgIdGT(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldGT(FieldMsgId, v))
+}
+
+// MsgIdGTE applies the GTE predicate on the "msgId" field.
+func MsgIdGTE(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldGTE(FieldMsgId, v))
+}
+
+// MsgIdLT applies the LT predicate on the "msgId" field.
+func MsgIdLT(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldLT(FieldMsgId, v))
+}
+
+// MsgIdLTE applies the LTE predicate on the "msgId" field.
+func MsgIdLTE(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldLTE(FieldMsgId, v))
+}
+
+// MsgIdContains applies the Contains predicate on the "msgId" field.
+func MsgIdContains(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldContains(FieldMsgId, v))
+}
+
+// MsgIdHasPrefix applies the HasPrefix predicate on the "msgId" field.
+func MsgIdHasPrefix(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldHasPrefix(FieldMsgId, v))
+}
+
+// MsgIdHasSuffix applies the HasSuffix predicate on the "msgId" field.
+func MsgIdHasSuffix(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldHasSuffix(FieldMsgId, v))
+}
+
+// MsgIdEqualFold applies the EqualFold predicate on the "msgId" field.
+func MsgIdEqualFold(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldEqualFold(FieldMsgId, v))
+}
+
+// MsgIdContainsFold applies the ContainsFold predicate on the "msgId" field.
+func MsgIdContainsFold(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldContainsFold(FieldMsgId, v))
+}
+
+// UserIdEQ applies the EQ predicate on the "userId" field.
+func UserIdEQ(v int) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldEQ(FieldUserId, v))
+}
+
+// UserIdNEQ applies the NEQ predicate on the "userId" field.
+func UserIdNEQ(v int) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldNEQ(FieldUserId, v))
+}
+
+// UserIdIn applies the In predicate on the "userId" field.
+func UserIdIn(vs ...int) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldIn(FieldUserId, vs...))
+}
+
+// UserIdNotIn applies the NotIn predicate on the "userId" field.
+func UserIdNotIn(vs ...int) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldNotIn(FieldUserId, vs...))
+}
+
+// UserIdGT applies the GT predicate on the "userId" field.
+func UserIdGT(v int) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldGT(FieldUserId, v))
+}
+
+// UserIdGTE applies the GTE predicate on the "userId" field.
+func UserIdGTE(v int) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldGTE(FieldUserId, v))
+}
+
+// UserIdLT applies the LT predicate on the "userId" field.
+func UserIdLT(v int) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldLT(FieldUserId, v))
+}
+
+// UserIdLTE applies the LTE predicate on the "userId" field.
+func UserIdLTE(v int) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldLTE(FieldUserId, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldLTE(FieldAttempts, v))
+}
+
+// NextAttemptTimeEQ applies the EQ predicate on the "nextAttemptTime" field.
+func NextAttemptTimeEQ(v time.Time) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldEQ(FieldNextAttemptTime, v))
+}
+
+// NextAttemptTimeNEQ applies the NEQ predicate on the "nextAttemptTime" field.
+func NextAttemptTimeNEQ(v time.Time) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldNEQ(FieldNextAttemptTime, v))
+}
+
+// NextAttemptTimeIn applies the In predicate on the "nextAttemptTime" field.
+func NextAttemptTimeIn(vs ...time.Time) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldIn(FieldNextAttemptTime, vs...))
+}
+
+// NextAttemptTimeNotIn applies the NotIn predicate on the "nextAttemptTime" field.
+func NextAttemptTimeNotIn(vs ...time.Time) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldNotIn(FieldNextAttemptTime, vs...))
+}
+
+// NextAttemptTimeGT applies the GT predicate on the "nextAttemptTime" field.
+func NextAttemptTimeGT(v time.Time) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldGT(FieldNextAttemptTime, v))
+}
+
+// NextAttemptTimeGTE applies the GTE predicate on the "nextAttemptTime" field.
+func NextAttemptTimeGTE(v time.Time) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldGTE(FieldNextAttemptTime, v))
+}
+
+// NextAttemptTimeLT applies the LT predicate on the "nextAttemptTime" field.
+func NextAttemptTimeLT(v time.Time) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldLT(FieldNextAttemptTime, v))
+}
+
+// NextAttemptTimeLTE applies the LTE predicate on the "nextAttemptTime" field.
+func NextAttemptTimeLTE(v time.Time) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldLTE(FieldNextAttemptTime, v))
+}
+
+// LastErrorEQ applies the EQ predicate on the "lastError" field.
+func LastErrorEQ(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "lastError" field.
+func LastErrorNEQ(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "lastError" field.
+func LastErrorIn(vs ...string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "lastError" field.
+func LastErrorNotIn(vs ...string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "lastError" field.
+func LastErrorGT(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "lastError" field.
+func LastErrorGTE(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "lastError" field.
+func LastErrorLT(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "lastError" field.
+func LastErrorLTE(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "lastError" field.
+func LastErrorContains(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "lastError" field.
+func LastErrorHasPrefix(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "lastError" field.
+func LastErrorHasSuffix(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "lastError" field.
+func LastErrorIsNil() predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "lastError" field.
+func LastErrorNotNil() predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "lastError" field.
+func LastErrorEqualFold(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "lastError" field.
+func LastErrorContainsFold(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// LeaseTokenEQ applies the EQ predicate on the "leaseToken" field.
+func LeaseTokenEQ(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldEQ(FieldLeaseToken, v))
+}
+
+// LeaseTokenNEQ applies the NEQ predicate on the "leaseToken" field.
+func LeaseTokenNEQ(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldNEQ(FieldLeaseToken, v))
+}
+
+// LeaseTokenIn applies the In predicate on the "leaseToken" field.
+func LeaseTokenIn(vs ...string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldIn(FieldLeaseToken, vs...))
+}
+
+// LeaseTokenNotIn applies the NotIn predicate on the "leaseToken" field.
+func LeaseTokenNotIn(vs ...string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldNotIn(FieldLeaseToken, vs...))
+}
+
+// LeaseTokenGT applies the GT predicate on the "leaseToken" field.
+func LeaseTokenGT(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldGT(FieldLeaseToken, v))
+}
+
+// LeaseTokenGTE applies the GTE predicate on the "leaseToken" field.
+func LeaseTokenGTE(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldGTE(FieldLeaseToken, v))
+}
+
+// LeaseTokenLT applies the LT predicate on the "leaseToken" field.
+func LeaseTokenLT(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldLT(FieldLeaseToken, v))
+}
+
+// LeaseTokenLTE applies the LTE predicate on the "leaseToken" field.
+func LeaseTokenLTE(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldLTE(FieldLeaseToken, v))
+}
+
+// LeaseTokenContains applies the Contains predicate on the "leaseToken" field.
+func LeaseTokenContains(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldContains(FieldLeaseToken, v))
+}
+
+// LeaseTokenHasPrefix applies the HasPrefix predicate on the "leaseToken" field.
+func LeaseTokenHasPrefix(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldHasPrefix(FieldLeaseToken, v))
+}
+
+// LeaseTokenHasSuffix applies the HasSuffix predicate on the "leaseToken" field.
+func LeaseTokenHasSuffix(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldHasSuffix(FieldLeaseToken, v))
+}
+
+// LeaseTokenEqualFold applies the EqualFold predicate on the "leaseToken" field.
+func LeaseTokenEqualFold(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldEqualFold(FieldLeaseToken, v))
+}
+
+// LeaseTokenContainsFold applies the ContainsFold predicate on the "leaseToken" field.
+func LeaseTokenContainsFold(v string) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldContainsFold(FieldLeaseToken, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "createTime" field.
+func CreateTimeEQ(v time.Time) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "createTime" field.
+func CreateTimeNEQ(v time.Time) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "createTime" field.
+func CreateTimeIn(vs ...time.Time) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "createTime" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "createTime" field.
+func CreateTimeGT(v time.Time) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "createTime" field.
+func CreateTimeGTE(v time.Time) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "createTime" field.
+func CreateTimeLT(v time.Time) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "createTime" field.
+func CreateTimeLTE(v time.Time) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MessageOutbox) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MessageOutbox) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MessageOutbox) predicate.MessageOutbox {
+	return predicate.MessageOutbox(sql.NotPredicates(p))
+}
@@ -0,0 +1,351 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gochat_server/ent/messageoutbox"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MessageOutboxCreate is the builder for creating a MessageOutbox entity.
+type MessageOutboxCreate struct {
+	config
+	mutation *MessageOutboxMutation
+	hooks    []Hook
+}
+
+// SetMsgId sets the "msgId" field.
+func (moc *MessageOutboxCreate) SetMsgId(s string) *MessageOutboxCreate {
+	moc.mutation.SetMsgId(s)
+	return moc
+}
+
+// SetUserId sets the "userId" field.
+func (moc *MessageOutboxCreate) SetUserId(i int) *MessageOutboxCreate {
+	moc.mutation.SetUserId(i)
+	return moc
+}
+
+// SetStatus sets the "status" field.
+func (moc *MessageOutboxCreate) SetStatus(s string) *MessageOutboxCreate {
+	moc.mutation.SetStatus(s)
+	return moc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (moc *MessageOutboxCreate) SetNillableStatus(s *string) *MessageOutboxCreate {
+	if s != nil {
+		moc.SetStatus(*s)
+	}
+	return moc
+}
+
+// SetAttempts sets the "attempts" field.
+func (moc *MessageOutboxCreate) SetAttempts(i int) *MessageOutboxCreate {
+	moc.mutation.SetAttempts(i)
+	return moc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (moc *MessageOutboxCreate) SetNillableAttempts(i *int) *MessageOutboxCreate {
+	if i != nil {
+		moc.SetAttempts(*i)
+	}
+	return moc
+}
+
+// SetNextAttemptTime sets the "nextAttemptTime" field.
+func (moc *MessageOutboxCreate) SetNextAttemptTime(t time.Time) *MessageOutboxCreate {
+	moc.mutation.SetNextAttemptTime(t)
+	return moc
+}
+
+// SetNillableNextAttemptTime sets the "nextAttemptTime" field if the given value is not nil.
+func (moc *MessageOutboxCreate) SetNillableNextAttemptTime(t *time.Time) *MessageOutboxCreate {
+	if t != nil {
+		moc.SetNextAttemptTime(*t)
+	}
+	return moc
+}
+
+// SetLastError sets the "lastError" field.
+func (moc *MessageOutboxCreate) SetLastError(s string) *MessageOutboxCreate {
+	moc.mutation.SetLastError(s)
+	return moc
+}
+
+// SetNillableLastError sets the "lastError" field if the given value is not nil.
+func (moc *MessageOutboxCreate) SetNillableLastError(s *string) *MessageOutboxCreate {
+	if s != nil {
+		moc.SetLastError(*s)
+	}
+	return moc
+}
+
+// SetLeaseToken sets the "leaseToken" field.
+func (moc *MessageOutboxCreate) SetLeaseToken(s string) *MessageOutboxCreate {
+	moc.mutation.SetLeaseToken(s)
+	return moc
+}
+
+// SetNillableLeaseToken sets the "leaseToken" field if the given value is not nil.
+func (moc *MessageOutboxCreate) SetNillableLeaseToken(s *string) *MessageOutboxCreate {
+	if s != nil {
+		moc.SetLeaseToken(*s)
+	}
+	return moc
+}
+
+// SetCreateTime sets the "createTime" field.
+func (moc *MessageOutboxCreate) SetCreateTime(t time.Time) *MessageOutboxCreate {
+	moc.mutation.SetCreateTime(t)
+	return moc
+}
+
+// SetNillableCreateTime sets the "createTime" field if the given value is not nil.
+func (moc *MessageOutboxCreate) SetNillableCreateTime(t *time.Time) *MessageOutboxCreate {
+	if t != nil {
+		moc.SetCreateTime(*t)
+	}
+	return moc
+}
+
+// Mutation returns the MessageOutboxMutation object of the builder.
+func (moc *MessageOutboxCreate) Mutation() *MessageOutboxMutation {
+	return moc.mutation
+}
+
+// Save creates the MessageOutbox in the database.
+func (moc *MessageOutboxCreate) Save(ctx context.Context) (*MessageOutbox, error) {
+	moc.defaults()
+	return withHooks(ctx, moc.sqlSave, moc.mutation, moc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (moc *MessageOutboxCreate) SaveX(ctx context.Context) *MessageOutbox {
+	v, err := moc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (moc *MessageOutboxCreate) Exec(ctx context.Context) error {
+	_, err := moc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (moc *MessageOutboxCreate) ExecX(ctx context.Context) {
+	if err := moc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (moc *MessageOutboxCreate) defaults() {
+	if _, ok := moc.mutation.Status(); !ok {
+		v := messageoutbox.DefaultStatus
+		moc.mutation.SetStatus(v)
+	}
+	if _, ok := moc.mutation.Attempts(); !ok {
+		v := messageoutbox.DefaultAttempts
+		moc.mutation.SetAttempts(v)
+	}
+	if _, ok := moc.mutation.NextAttemptTime(); !ok {
+		v := messageoutbox.DefaultNextAttemptTime()
+		moc.mutation.SetNextAttemptTime(v)
+	}
+	if _, ok := moc.mutation.LeaseToken(); !ok {
+		v := messageoutbox.DefaultLeaseToken
+		moc.mutation.SetLeaseToken(v)
+	}
+	if _, ok := moc.mutation.CreateTime(); !ok {
+		v := messageoutbox.DefaultCreateTime()
+		moc.mutation.SetCreateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (moc *MessageOutboxCreate) check() error {
+	if _, ok := moc.mutation.MsgId(); !ok {
+		return &ValidationError{Name: "msgId", err: errors.New(`ent: missing required field "MessageOutbox.msgId"`)}
+	}
+	if v, ok := moc.mutation.MsgId(); ok {
+		if err := messageoutbox.MsgIdValidator(v); err != nil {
+			return &ValidationError{Name: "msgId", err: fmt.Errorf(`ent: validator failed for field "MessageOutbox.msgId": %w`, err)}
+		}
+	}
+	if _, ok := moc.mutation.UserId(); !ok {
+		return &ValidationError{Name: "userId", err: errors.New(`ent: missing required field "MessageOutbox.userId"`)}
+	}
+	if _, ok := moc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "MessageOutbox.status"`)}
+	}
+	if _, ok := moc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "MessageOutbox.attempts"`)}
+	}
+	if _, ok := moc.mutation.NextAttemptTime(); !ok {
+		return &ValidationError{Name: "nextAttemptTime", err: errors.New(`ent: missing required field "MessageOutbox.nextAttemptTime"`)}
+	}
+	if _, ok := moc.mutation.LeaseToken(); !ok {
+		return &ValidationError{Name: "leaseToken", err: errors.New(`ent: missing required field "MessageOutbox.leaseToken"`)}
+	}
+	if _, ok := moc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "createTime", err: errors.New(`ent: missing required field "MessageOutbox.createTime"`)}
+	}
+	return nil
+}
+
+func (moc *MessageOutboxCreate) sqlSave(ctx context.Context) (*MessageOutbox, error) {
+	if err := moc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := moc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, moc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	moc.mutation.id = &_node.ID
+	moc.mutation.done = true
+	return _node, nil
+}
+
+func (moc *MessageOutboxCreate) createSpec() (*MessageOutbox, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MessageOutbox{config: moc.config}
+		_spec = sqlgraph.NewCreateSpec(messageoutbox.Table, sqlgraph.NewFieldSpec(messageoutbox.FieldID, field.TypeInt))
+	)
+	if value, ok := moc.mutation.MsgId(); ok {
+		_spec.SetField(messageoutbox.FieldMsgId, field.TypeString, value)
+		_node.MsgId = value
+	}
+	if value, ok := moc.mutation.UserId(); ok {
+		_spec.SetField(messageoutbox.FieldUserId, field.TypeInt, value)
+		_node.UserId = value
+	}
+	if value, ok := moc.mutation.Status(); ok {
+		_spec.SetField(messageoutbox.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := moc.mutation.Attempts(); ok {
+		_spec.SetField(messageoutbox.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := moc.mutation.NextAttemptTime(); ok {
+		_spec.SetField(messageoutbox.FieldNextAttemptTime, field.TypeTime, value)
+		_node.NextAttemptTime = value
+	}
+	if value, ok := moc.mutation.LastError(); ok {
+		_spec.SetField(messageoutbox.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := moc.mutation.LeaseToken(); ok {
+		_spec.SetField(messageoutbox.FieldLeaseToken, field.TypeString, value)
+		_node.LeaseToken = value
+	}
+	if value, ok := moc.mutation.CreateTime(); ok {
+		_spec.SetField(messageoutbox.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	return _node, _spec
+}
+
+// MessageOutboxCreateBulk is the builder for creating many MessageOutbox entities in bulk.
+type MessageOutboxCreateBulk struct {
+	config
+	err      error
+	builders []*MessageOutboxCreate
+}
+
+// Save creates the MessageOutbox entities in the database.
+func (mocb *MessageOutboxCreateBulk) Save(ctx context.Context) ([]*MessageOutbox, error) {
+	if mocb.err != nil {
+		return nil, mocb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mocb.builders))
+	nodes := make([]*MessageOutbox, len(mocb.builders))
+	mutators := make([]Mutator, len(mocb.builders))
+	for i := range mocb.builders {
+		func(i int, root context.Context) {
+			builder := mocb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MessageOutboxMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mocb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mocb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mocb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mocb *MessageOutboxCreateBulk) SaveX(ctx context.Context) []*MessageOutbox {
+	v, err := mocb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mocb *MessageOutboxCreateBulk) Exec(ctx context.Context) error {
+	_, err := mocb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mocb *MessageOutboxCreateBulk) ExecX(ctx context.Context) {
+	if err := mocb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"gochat_server/ent/messageoutbox"
+	"gochat_server/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MessageOutboxDelete is the builder for deleting a MessageOutbox entity.
+type MessageOutboxDelete struct {
+	config
+	hooks    []Hook
+	mutation *MessageOutboxMutation
+}
+
+// Where appends a list predicates to the MessageOutboxDelete builder.
+func (mod *MessageOutboxDelete) Where(ps ...predicate.MessageOutbox) *MessageOutboxDelete {
+	mod.mutation.Where(ps...)
+	return mod
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mod *MessageOutboxDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, mod.sqlExec, mod.mutation, mod.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mod *MessageOutboxDelete) ExecX(ctx context.Context) int {
+	n, err := mod.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mod *MessageOutboxDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(messageoutbox.Table, sqlgraph.NewFieldSpec(messageoutbox.FieldID, field.TypeInt))
+	if ps := mod.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mod.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mod.mutation.done = true
+	return affected, err
+}
+
+// MessageOutboxDeleteOne is the builder for deleting a single MessageOutbox entity.
+type MessageOutboxDeleteOne struct {
+	mod *MessageOutboxDelete
+}
+
+// Where appends a list predicates to the MessageOutboxDelete builder.
+func (modo *MessageOutboxDeleteOne) Where(ps ...predicate.MessageOutbox) *MessageOutboxDeleteOne {
+	modo.mod.mutation.Where(ps...)
+	return modo
+}
+
+// Exec executes the deletion query.
+func (modo *MessageOutboxDeleteOne) Exec(ctx context.Context) error {
+	n, err := modo.mod.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{messageoutbox.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (modo *MessageOutboxDeleteOne) ExecX(ctx context.Context) {
+	if err := modo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
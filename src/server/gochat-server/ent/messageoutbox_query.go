@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"gochat_server/ent/messageoutbox"
+	"gochat_server/ent/predicate"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MessageOutboxQuery is the builder for querying MessageOutbox entities.
+type MessageOutboxQuery struct {
+	config
+	ctx        *QueryContext
+	order      []messageoutbox.OrderOption
+	inters     []Interceptor
+	predicates []predicate.MessageOutbox
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MessageOutboxQuery builder.
+func (moq *MessageOutboxQuery) Where(ps ...predicate.MessageOutbox) *MessageOutboxQuery {
+	moq.predicates = append(moq.predicates, ps...)
+	return moq
+}
+
+// Limit the number of records to be returned by this query.
+func (moq *MessageOutboxQuery) Limit(limit int) *MessageOutboxQuery {
+	moq.ctx.Limit = &limit
+	return moq
+}
+
+// Offset to start from.
+func (moq *MessageOutboxQuery) Offset(offset int) *MessageOutboxQuery {
+	moq.ctx.Offset = &offset
+	return moq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (moq *MessageOutboxQuery) Unique(unique bool) *MessageOutboxQuery {
+	moq.ctx.Unique = &unique
+	return moq
+}
+
+// Order specifies how the records should be ordered.
+func (moq *MessageOutboxQuery) Order(o ...messageoutbox.OrderOption) *MessageOutboxQuery {
+	moq.order = append(moq.order, o...)
+	return moq
+}
+
+// First returns the first MessageOutbox entity from the query.
+// Returns a *NotFoundError when no MessageOutbox was found.
+func (moq *MessageOutboxQuery) First(ctx context.Context) (*MessageOutbox, error) {
+	nodes, err := moq.Limit(1).All(setContextOp(ctx, moq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{messageoutbox.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (moq *MessageOutboxQuery) FirstX(ctx context.Context) *MessageOutbox {
+	node, err := moq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MessageOutbox ID from the query.
+// Returns a *NotFoundError when no MessageOutbox ID was found.
+func (moq *MessageOutboxQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = moq.Limit(1).IDs(setContextOp(ctx, moq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{messageoutbox.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (moq *MessageOutboxQuery) FirstIDX(ctx context.Context) int {
+	id, err := moq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MessageOutbox entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MessageOutbox entity is found.
+// Returns a *NotFoundError when no MessageOutbox entities are found.
+func (moq *MessageOutboxQuery) Only(ctx context.Context) (*MessageOutbox, error) {
+	nodes, err := moq.Limit(2).All(setContextOp(ctx, moq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{messageoutbox.Label}
+	default:
+		return nil, &NotSingularError{messageoutbox.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (moq *MessageOutboxQuery) OnlyX(ctx context.Context) *MessageOutbox {
+	node, err := moq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MessageOutbox ID in the query.
+// Returns a *NotSingularError when more than one MessageOutbox ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (moq *MessageOutboxQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = moq.Limit(2).IDs(setContextOp(ctx, moq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{messageoutbox.Label}
+	default:
+		err = &NotSingularError{messageoutbox.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (moq *MessageOutboxQuery) OnlyIDX(ctx context.Context) int {
+	id, err := moq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MessageOutboxes.
+func (moq *MessageOutboxQuery) All(ctx context.Context) ([]*MessageOutbox, error) {
+	ctx = setContextOp(ctx, moq.ctx, ent.OpQueryAll)
+	if err := moq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*MessageOutbox, *MessageOutboxQuery]()
+	return withInterceptors[[]*MessageOutbox](ctx, moq, qr, moq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (moq *MessageOutboxQuery) AllX(ctx context.Context) []*MessageOutbox {
+	nodes, err := moq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MessageOutbox IDs.
+func (moq *MessageOutboxQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if moq.ctx.Unique == nil && moq.path != nil {
+		moq.Unique(true)
+	}
+	ctx = setContextOp(ctx, moq.ctx, ent.OpQueryIDs)
+	if err = moq.Select(messageoutbox.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (moq *MessageOutboxQuery) IDsX(ctx context.Context) []int {
+	ids, err := moq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (moq *MessageOutboxQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, moq.ctx, ent.OpQueryCount)
+	if err := moq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, moq, querierCount[*MessageOutboxQuery](), moq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (moq *MessageOutboxQuery) CountX(ctx context.Context) int {
+	count, err := moq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (moq *MessageOutboxQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, moq.ctx, ent.OpQueryExist)
+	switch _, err := moq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (moq *MessageOutboxQuery) ExistX(ctx context.Context) bool {
+	exist, err := moq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MessageOutboxQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (moq *MessageOutboxQuery) Clone() *MessageOutboxQuery {
+	if moq == nil {
+		return nil
+	}
+	return &MessageOutboxQuery{
+		config:     moq.config,
+		ctx:        moq.ctx.Clone(),
+		order:      append([]messageoutbox.OrderOption{}, moq.order...),
+		inters:     append([]Interceptor{}, moq.inters...),
+		predicates: append([]predicate.MessageOutbox{}, moq.predicates...),
+		// clone intermediate query.
+		sql:  moq.sql.Clone(),
+		path: moq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		MsgId string `json:"msgId,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MessageOutbox.Query().
+//		GroupBy(messageoutbox.FieldMsgId).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (moq *MessageOutboxQuery) GroupBy(field string, fields ...string) *MessageOutboxGroupBy {
+	moq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &MessageOutboxGroupBy{build: moq}
+	grbuild.flds = &moq.ctx.Fields
+	grbuild.label = messageoutbox.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		MsgId string `json:"msgId,omitempty"`
+//	}
+//
+//	client.MessageOutbox.Query().
+//		Select(messageoutbox.FieldMsgId).
+//		Scan(ctx, &v)
+func (moq *MessageOutboxQuery) Select(fields ...string) *MessageOutboxSelect {
+	moq.ctx.Fields = append(moq.ctx.Fields, fields...)
+	sbuild := &MessageOutboxSelect{MessageOutboxQuery: moq}
+	sbuild.label = messageoutbox.Label
+	sbuild.flds, sbuild.scan = &moq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a MessageOutboxSelect configured with the given aggregations.
+func (moq *MessageOutboxQuery) Aggregate(fns ...AggregateFunc) *MessageOutboxSelect {
+	return moq.Select().Aggregate(fns...)
+}
+
+func (moq *MessageOutboxQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range moq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, moq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range moq.ctx.Fields {
+		if !messageoutbox.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if moq.path != nil {
+		prev, err := moq.path(ctx)
+		if err != nil {
+			return err
+		}
+		moq.sql = prev
+	}
+	return nil
+}
+
+func (moq *MessageOutboxQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MessageOutbox, error) {
+	var (
+		nodes = []*MessageOutbox{}
+		_spec = moq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MessageOutbox).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MessageOutbox{config: moq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, moq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (moq *MessageOutboxQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := moq.querySpec()
+	_spec.Node.Columns = moq.ctx.Fields
+	if len(moq.ctx.Fields) > 0 {
+		_spec.Unique = moq.ctx.Unique != nil && *moq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, moq.driver, _spec)
+}
+
+func (moq *MessageOutboxQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(messageoutbox.Table, messageoutbox.Columns, sqlgraph.NewFieldSpec(messageoutbox.FieldID, field.TypeInt))
+	_spec.From = moq.sql
+	if unique := moq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if moq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := moq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, messageoutbox.FieldID)
+		for i := range fields {
+			if fields[i] != messageoutbox.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := moq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := moq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := moq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := moq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (moq *MessageOutboxQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(moq.driver.Dialect())
+	t1 := builder.Table(messageoutbox.Table)
+	columns := moq.ctx.Fields
+	if len(columns) == 0 {
+		columns = messageoutbox.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if moq.sql != nil {
+		selector = moq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if moq.ctx.Unique != nil && *moq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range moq.predicates {
+		p(selector)
+	}
+	for _, p := range moq.order {
+		p(selector)
+	}
+	if offset := moq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := moq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// MessageOutboxGroupBy is the group-by builder for MessageOutbox entities.
+type MessageOutboxGroupBy struct {
+	selector
+	build *MessageOutboxQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mogb *MessageOutboxGroupBy) Aggregate(fns ...AggregateFunc) *MessageOutboxGroupBy {
+	mogb.fns = append(mogb.fns, fns...)
+	return mogb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mogb *MessageOutboxGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mogb.build.ctx, ent.OpQueryGroupBy)
+	if err := mogb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MessageOutboxQuery, *MessageOutboxGroupBy](ctx, mogb.build, mogb, mogb.build.inters, v)
+}
+
+func (mogb *MessageOutboxGroupBy) sqlScan(ctx context.Context, root *MessageOutboxQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(mogb.fns))
+	for _, fn := range mogb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*mogb.flds)+len(mogb.fns))
+		for _, f := range *mogb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*mogb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mogb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// MessageOutboxSelect is the builder for selecting fields of MessageOutbox entities.
+type MessageOutboxSelect struct {
+	*MessageOutboxQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mos *MessageOutboxSelect) Aggregate(fns ...AggregateFunc) *MessageOutboxSelect {
+	mos.fns = append(mos.fns, fns...)
+	return mos
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mos *MessageOutboxSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mos.ctx, ent.OpQuerySelect)
+	if err := mos.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MessageOutboxQuery, *MessageOutboxSelect](ctx, mos.MessageOutboxQuery, mos, mos.inters, v)
+}
+
+func (mos *MessageOutboxSelect) sqlScan(ctx context.Context, root *MessageOutboxQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mos.fns))
+	for _, fn := range mos.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mos.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mos.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
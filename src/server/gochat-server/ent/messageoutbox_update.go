@@ -0,0 +1,532 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gochat_server/ent/messageoutbox"
+	"gochat_server/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MessageOutboxUpdate is the builder for updating MessageOutbox entities.
+type MessageOutboxUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MessageOutboxMutation
+}
+
+// Where appends a list predicates to the MessageOutboxUpdate builder.
+func (mou *MessageOutboxUpdate) Where(ps ...predicate.MessageOutbox) *MessageOutboxUpdate {
+	mou.mutation.Where(ps...)
+	return mou
+}
+
+// SetMsgId sets the "msgId" field.
+func (mou *MessageOutboxUpdate) SetMsgId(s string) *MessageOutboxUpdate {
+	mou.mutation.SetMsgId(s)
+	return mou
+}
+
+// SetNillableMsgId sets the "msgId" field if the given value is not nil.
+func (mou *MessageOutboxUpdate) SetNillableMsgId(s *string) *MessageOutboxUpdate {
+	if s != nil {
+		mou.SetMsgId(*s)
+	}
+	return mou
+}
+
+// SetUserId sets the "userId" field.
+func (mou *MessageOutboxUpdate) SetUserId(i int) *MessageOutboxUpdate {
+	mou.mutation.ResetUserId()
+	mou.mutation.SetUserId(i)
+	return mou
+}
+
+// SetNillableUserId sets the "userId" field if the given value is not nil.
+func (mou *MessageOutboxUpdate) SetNillableUserId(i *int) *MessageOutboxUpdate {
+	if i != nil {
+		mou.SetUserId(*i)
+	}
+	return mou
+}
+
+// AddUserId adds i to the "userId" field.
+func (mou *MessageOutboxUpdate) AddUserId(i int) *MessageOutboxUpdate {
+	mou.mutation.AddUserId(i)
+	return mou
+}
+
+// SetStatus sets the "status" field.
+func (mou *MessageOutboxUpdate) SetStatus(s string) *MessageOutboxUpdate {
+	mou.mutation.SetStatus(s)
+	return mou
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (mou *MessageOutboxUpdate) SetNillableStatus(s *string) *MessageOutboxUpdate {
+	if s != nil {
+		mou.SetStatus(*s)
+	}
+	return mou
+}
+
+// SetAttempts sets the "attempts" field.
+func (mou *MessageOutboxUpdate) SetAttempts(i int) *MessageOutboxUpdate {
+	mou.mutation.ResetAttempts()
+	mou.mutation.SetAttempts(i)
+	return mou
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (mou *MessageOutboxUpdate) SetNillableAttempts(i *int) *MessageOutboxUpdate {
+	if i != nil {
+		mou.SetAttempts(*i)
+	}
+	return mou
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (mou *MessageOutboxUpdate) AddAttempts(i int) *MessageOutboxUpdate {
+	mou.mutation.AddAttempts(i)
+	return mou
+}
+
+// SetNextAttemptTime sets the "nextAttemptTime" field.
+func (mou *MessageOutboxUpdate) SetNextAttemptTime(t time.Time) *MessageOutboxUpdate {
+	mou.mutation.SetNextAttemptTime(t)
+	return mou
+}
+
+// SetNillableNextAttemptTime sets the "nextAttemptTime" field if the given value is not nil.
+func (mou *MessageOutboxUpdate) SetNillableNextAttemptTime(t *time.Time) *MessageOutboxUpdate {
+	if t != nil {
+		mou.SetNextAttemptTime(*t)
+	}
+	return mou
+}
+
+// SetLastError sets the "lastError" field.
+func (mou *MessageOutboxUpdate) SetLastError(s string) *MessageOutboxUpdate {
+	mou.mutation.SetLastError(s)
+	return mou
+}
+
+// SetNillableLastError sets the "lastError" field if the given value is not nil.
+func (mou *MessageOutboxUpdate) SetNillableLastError(s *string) *MessageOutboxUpdate {
+	if s != nil {
+		mou.SetLastError(*s)
+	}
+	return mou
+}
+
+// ClearLastError clears the value of the "lastError" field.
+func (mou *MessageOutboxUpdate) ClearLastError() *MessageOutboxUpdate {
+	mou.mutation.ClearLastError()
+	return mou
+}
+
+// SetLeaseToken sets the "leaseToken" field.
+func (mou *MessageOutboxUpdate) SetLeaseToken(s string) *MessageOutboxUpdate {
+	mou.mutation.SetLeaseToken(s)
+	return mou
+}
+
+// SetNillableLeaseToken sets the "leaseToken" field if the given value is not nil.
+func (mou *MessageOutboxUpdate) SetNillableLeaseToken(s *string) *MessageOutboxUpdate {
+	if s != nil {
+		mou.SetLeaseToken(*s)
+	}
+	return mou
+}
+
+// SetCreateTime sets the "createTime" field.
+func (mou *MessageOutboxUpdate) SetCreateTime(t time.Time) *MessageOutboxUpdate {
+	mou.mutation.SetCreateTime(t)
+	return mou
+}
+
+// SetNillableCreateTime sets the "createTime" field if the given value is not nil.
+func (mou *MessageOutboxUpdate) SetNillableCreateTime(t *time.Time) *MessageOutboxUpdate {
+	if t != nil {
+		mou.SetCreateTime(*t)
+	}
+	return mou
+}
+
+// Mutation returns the MessageOutboxMutation object of the builder.
+func (mou *MessageOutboxUpdate) Mutation() *MessageOutboxMutation {
+	return mou.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mou *MessageOutboxUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, mou.sqlSave, mou.mutation, mou.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mou *MessageOutboxUpdate) SaveX(ctx context.Context) int {
+	affected, err := mou.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mou *MessageOutboxUpdate) Exec(ctx context.Context) error {
+	_, err := mou.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mou *MessageOutboxUpdate) ExecX(ctx context.Context) {
+	if err := mou.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mou *MessageOutboxUpdate) check() error {
+	if v, ok := mou.mutation.MsgId(); ok {
+		if err := messageoutbox.MsgIdValidator(v); err != nil {
+			return &ValidationError{Name: "msgId", err: fmt.Errorf(`ent: validator failed for field "MessageOutbox.msgId": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (mou *MessageOutboxUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mou.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(messageoutbox.Table, messageoutbox.Columns, sqlgraph.NewFieldSpec(messageoutbox.FieldID, field.TypeInt))
+	if ps := mou.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mou.mutation.MsgId(); ok {
+		_spec.SetField(messageoutbox.FieldMsgId, field.TypeString, value)
+	}
+	if value, ok := mou.mutation.UserId(); ok {
+		_spec.SetField(messageoutbox.FieldUserId, field.TypeInt, value)
+	}
+	if value, ok := mou.mutation.AddedUserId(); ok {
+		_spec.AddField(messageoutbox.FieldUserId, field.TypeInt, value)
+	}
+	if value, ok := mou.mutation.Status(); ok {
+		_spec.SetField(messageoutbox.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := mou.mutation.Attempts(); ok {
+		_spec.SetField(messageoutbox.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := mou.mutation.AddedAttempts(); ok {
+		_spec.AddField(messageoutbox.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := mou.mutation.NextAttemptTime(); ok {
+		_spec.SetField(messageoutbox.FieldNextAttemptTime, field.TypeTime, value)
+	}
+	if value, ok := mou.mutation.LastError(); ok {
+		_spec.SetField(messageoutbox.FieldLastError, field.TypeString, value)
+	}
+	if mou.mutation.LastErrorCleared() {
+		_spec.ClearField(messageoutbox.FieldLastError, field.TypeString)
+	}
+	if value, ok := mou.mutation.LeaseToken(); ok {
+		_spec.SetField(messageoutbox.FieldLeaseToken, field.TypeString, value)
+	}
+	if value, ok := mou.mutation.CreateTime(); ok {
+		_spec.SetField(messageoutbox.FieldCreateTime, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mou.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messageoutbox.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mou.mutation.done = true
+	return n, nil
+}
+
+// MessageOutboxUpdateOne is the builder for updating a single MessageOutbox entity.
+type MessageOutboxUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MessageOutboxMutation
+}
+
+// SetMsgId sets the "msgId" field.
+func (mouo *MessageOutboxUpdateOne) SetMsgId(s string) *MessageOutboxUpdateOne {
+	mouo.mutation.SetMsgId(s)
+	return mouo
+}
+
+// SetNillableMsgId sets the "msgId" field if the given value is not nil.
+func (mouo *MessageOutboxUpdateOne) SetNillableMsgId(s *string) *MessageOutboxUpdateOne {
+	if s != nil {
+		mouo.SetMsgId(*s)
+	}
+	return mouo
+}
+
+// SetUserId sets the "userId" field.
+func (mouo *MessageOutboxUpdateOne) SetUserId(i int) *MessageOutboxUpdateOne {
+	mouo.mutation.ResetUserId()
+	mouo.mutation.SetUserId(i)
+	return mouo
+}
+
+// SetNillableUserId sets the "userId" field if the given value is not nil.
+func (mouo *MessageOutboxUpdateOne) SetNillableUserId(i *int) *MessageOutboxUpdateOne {
+	if i != nil {
+		mouo.SetUserId(*i)
+	}
+	return mouo
+}
+
+// AddUserId adds i to the "userId" field.
+func (mouo *MessageOutboxUpdateOne) AddUserId(i int) *MessageOutboxUpdateOne {
+	mouo.mutation.AddUserId(i)
+	return mouo
+}
+
+// SetStatus sets the "status" field.
+func (mouo *MessageOutboxUpdateOne) SetStatus(s string) *MessageOutboxUpdateOne {
+	mouo.mutation.SetStatus(s)
+	return mouo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (mouo *MessageOutboxUpdateOne) SetNillableStatus(s *string) *MessageOutboxUpdateOne {
+	if s != nil {
+		mouo.SetStatus(*s)
+	}
+	return mouo
+}
+
+// SetAttempts sets the "attempts" field.
+func (mouo *MessageOutboxUpdateOne) SetAttempts(i int) *MessageOutboxUpdateOne {
+	mouo.mutation.ResetAttempts()
+	mouo.mutation.SetAttempts(i)
+	return mouo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (mouo *MessageOutboxUpdateOne) SetNillableAttempts(i *int) *MessageOutboxUpdateOne {
+	if i != nil {
+		mouo.SetAttempts(*i)
+	}
+	return mouo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (mouo *MessageOutboxUpdateOne) AddAttempts(i int) *MessageOutboxUpdateOne {
+	mouo.mutation.AddAttempts(i)
+	return mouo
+}
+
+// SetNextAttemptTime sets the "nextAttemptTime" field.
+func (mouo *MessageOutboxUpdateOne) SetNextAttemptTime(t time.Time) *MessageOutboxUpdateOne {
+	mouo.mutation.SetNextAttemptTime(t)
+	return mouo
+}
+
+// SetNillableNextAttemptTime sets the "nextAttemptTime" field if the given value is not nil.
+func (mouo *MessageOutboxUpdateOne) SetNillableNextAttemptTime(t *time.Time) *MessageOutboxUpdateOne {
+	if t != nil {
+		mouo.SetNextAttemptTime(*t)
+	}
+	return mouo
+}
+
+// SetLastError sets the "lastError" field.
+func (mouo *MessageOutboxUpdateOne) SetLastError(s string) *MessageOutboxUpdateOne {
+	mouo.mutation.SetLastError(s)
+	return mouo
+}
+
+// SetNillableLastError sets the "lastError" field if the given value is not nil.
+func (mouo *MessageOutboxUpdateOne) SetNillableLastError(s *string) *MessageOutboxUpdateOne {
+	if s != nil {
+		mouo.SetLastError(*s)
+	}
+	return mouo
+}
+
+// ClearLastError clears the value of the "lastError" field.
+func (mouo *MessageOutboxUpdateOne) ClearLastError() *MessageOutboxUpdateOne {
+	mouo.mutation.ClearLastError()
+	return mouo
+}
+
+// SetLeaseToken sets the "leaseToken" field.
+func (mouo *MessageOutboxUpdateOne) SetLeaseToken(s string) *MessageOutboxUpdateOne {
+	mouo.mutation.SetLeaseToken(s)
+	return mouo
+}
+
+// SetNillableLeaseToken sets the "leaseToken" field if the given value is not nil.
+func (mouo *MessageOutboxUpdateOne) SetNillableLeaseToken(s *string) *MessageOutboxUpdateOne {
+	if s != nil {
+		mouo.SetLeaseToken(*s)
+	}
+	return mouo
+}
+
+// SetCreateTime sets the "createTime" field.
+func (mouo *MessageOutboxUpdateOne) SetCreateTime(t time.Time) *MessageOutboxUpdateOne {
+	mouo.mutation.SetCreateTime(t)
+	return mouo
+}
+
+// SetNillableCreateTime sets the "createTime" field if the given value is not nil.
+func (mouo *MessageOutboxUpdateOne) SetNillableCreateTime(t *time.Time) *MessageOutboxUpdateOne {
+	if t != nil {
+		mouo.SetCreateTime(*t)
+	}
+	return mouo
+}
+
+// Mutation returns the MessageOutboxMutation object of the builder.
+func (mouo *MessageOutboxUpdateOne) Mutation() *MessageOutboxMutation {
+	return mouo.mutation
+}
+
+// Where appends a list predicates to the MessageOutboxUpdate builder.
+func (mouo *MessageOutboxUpdateOne) Where(ps ...predicate.MessageOutbox) *MessageOutboxUpdateOne {
+	mouo.mutation.Where(ps...)
+	return mouo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mouo *MessageOutboxUpdateOne) Select(field string, fields ...string) *MessageOutboxUpdateOne {
+	mouo.fields = append([]string{field}, fields...)
+	return mouo
+}
+
+// Save executes the query and returns the updated MessageOutbox entity.
+func (mouo *MessageOutboxUpdateOne) Save(ctx context.Context) (*MessageOutbox, error) {
+	return withHooks(ctx, mouo.sqlSave, mouo.mutation, mouo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mouo *MessageOutboxUpdateOne) SaveX(ctx context.Context) *MessageOutbox {
+	node, err := mouo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mouo *MessageOutboxUpdateOne) Exec(ctx context.Context) error {
+	_, err := mouo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mouo *MessageOutboxUpdateOne) ExecX(ctx context.Context) {
+	if err := mouo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mouo *MessageOutboxUpdateOne) check() error {
+	if v, ok := mouo.mutation.MsgId(); ok {
+		if err := messageoutbox.MsgIdValidator(v); err != nil {
+			return &ValidationError{Name: "msgId", err: fmt.Errorf(`ent: validator failed for field "MessageOutbox.msgId": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (mouo *MessageOutboxUpdateOne) sqlSave(ctx context.Context) (_node *MessageOutbox, err error) {
+	if err := mouo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(messageoutbox.Table, messageoutbox.Columns, sqlgraph.NewFieldSpec(messageoutbox.FieldID, field.TypeInt))
+	id, ok := mouo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MessageOutbox.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mouo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, messageoutbox.FieldID)
+		for _, f := range fields {
+			if !messageoutbox.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != messageoutbox.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mouo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mouo.mutation.MsgId(); ok {
+		_spec.SetField(messageoutbox.FieldMsgId, field.TypeString, value)
+	}
+	if value, ok := mouo.mutation.UserId(); ok {
+		_spec.SetField(messageoutbox.FieldUserId, field.TypeInt, value)
+	}
+	if value, ok := mouo.mutation.AddedUserId(); ok {
+		_spec.AddField(messageoutbox.FieldUserId, field.TypeInt, value)
+	}
+	if value, ok := mouo.mutation.Status(); ok {
+		_spec.SetField(messageoutbox.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := mouo.mutation.Attempts(); ok {
+		_spec.SetField(messageoutbox.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := mouo.mutation.AddedAttempts(); ok {
+		_spec.AddField(messageoutbox.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := mouo.mutation.NextAttemptTime(); ok {
+		_spec.SetField(messageoutbox.FieldNextAttemptTime, field.TypeTime, value)
+	}
+	if value, ok := mouo.mutation.LastError(); ok {
+		_spec.SetField(messageoutbox.FieldLastError, field.TypeString, value)
+	}
+	if mouo.mutation.LastErrorCleared() {
+		_spec.ClearField(messageoutbox.FieldLastError, field.TypeString)
+	}
+	if value, ok := mouo.mutation.LeaseToken(); ok {
+		_spec.SetField(messageoutbox.FieldLeaseToken, field.TypeString, value)
+	}
+	if value, ok := mouo.mutation.CreateTime(); ok {
+		_spec.SetField(messageoutbox.FieldCreateTime, field.TypeTime, value)
+	}
+	_node = &MessageOutbox{config: mouo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mouo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messageoutbox.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mouo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,139 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"gochat_server/ent/messagereaction"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// MessageReaction is the model entity for the MessageReaction schema.
+type MessageReaction struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 消息ID
+	MsgId string `json:"msgId,omitempty"`
+	// 表态用户ID
+	UserId int `json:"userId,omitempty"`
+	// 表情
+	Emoji string `json:"emoji,omitempty"`
+	// 创建时间
+	CreateTime   time.Time `json:"createTime,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MessageReaction) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case messagereaction.FieldID, messagereaction.FieldUserId:
+			values[i] = new(sql.NullInt64)
+		case messagereaction.FieldMsgId, messagereaction.FieldEmoji:
+			values[i] = new(sql.NullString)
+		case messagereaction.FieldCreateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MessageReaction fields.
+func (mr *MessageReaction) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case messagereaction.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			mr.ID = int(value.Int64)
+		case messagereaction.FieldMsgId:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field msgId", values[i])
+			} else if value.Valid {
+				mr.MsgId = value.String
+			}
+		case messagereaction.FieldUserId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field userId", values[i])
+			} else if value.Valid {
+				mr.UserId = int(value.Int64)
+			}
+		case messagereaction.FieldEmoji:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field emoji", values[i])
+			} else if value.Valid {
+				mr.Emoji = value.String
+			}
+		case messagereaction.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field createTime", values[i])
+			} else if value.Valid {
+				mr.CreateTime = value.Time
+			}
+		default:
+			mr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MessageReaction.
+// This includes values selected through modifiers, order, etc.
+func (mr *MessageReaction) Value(name string) (ent.Value, error) {
+	return mr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this MessageReaction.
+// Note that you need to call MessageReaction.Unwrap() before calling this method if this MessageReaction
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mr *MessageReaction) Update() *MessageReactionUpdateOne {
+	return NewMessageReactionClient(mr.config).UpdateOne(mr)
+}
+
+// Unwrap unwraps the MessageReaction entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mr *MessageReaction) Unwrap() *MessageReaction {
+	_tx, ok := mr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MessageReaction is not a transactional entity")
+	}
+	mr.config.driver = _tx.drv
+	return mr
+}
+
+// String implements the fmt.Stringer.
+func (mr *MessageReaction) String() string {
+	var builder strings.Builder
+	builder.WriteString("MessageReaction(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mr.ID))
+	builder.WriteString("msgId=")
+	builder.WriteString(mr.MsgId)
+	builder.WriteString(", ")
+	builder.WriteString("userId=")
+	builder.WriteString(fmt.Sprintf("%v", mr.UserId))
+	builder.WriteString(", ")
+	builder.WriteString("emoji=")
+	builder.WriteString(mr.Emoji)
+	builder.WriteString(", ")
+	builder.WriteString("createTime=")
+	builder.WriteString(mr.CreateTime.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MessageReactions is a parsable slice of MessageReaction.
+type MessageReactions []*MessageReaction
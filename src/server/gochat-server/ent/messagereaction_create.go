@@ -0,0 +1,251 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gochat_server/ent/messagereaction"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MessageReactionCreate is the builder for creating a MessageReaction entity.
+type MessageReactionCreate struct {
+	config
+	mutation *MessageReactionMutation
+	hooks    []Hook
+}
+
+// SetMsgId sets the "msgId" field.
+func (mrc *MessageReactionCreate) SetMsgId(s string) *MessageReactionCreate {
+	mrc.mutation.SetMsgId(s)
+	return mrc
+}
+
+// SetUserId sets the "userId" field.
+func (mrc *MessageReactionCreate) SetUserId(i int) *MessageReactionCreate {
+	mrc.mutation.SetUserId(i)
+	return mrc
+}
+
+// SetEmoji sets the "emoji" field.
+func (mrc *MessageReactionCreate) SetEmoji(s string) *MessageReactionCreate {
+	mrc.mutation.SetEmoji(s)
+	return mrc
+}
+
+// SetCreateTime sets the "createTime" field.
+func (mrc *MessageReactionCreate) SetCreateTime(t time.Time) *MessageReactionCreate {
+	mrc.mutation.SetCreateTime(t)
+	return mrc
+}
+
+// SetNillableCreateTime sets the "createTime" field if the given value is not nil.
+func (mrc *MessageReactionCreate) SetNillableCreateTime(t *time.Time) *MessageReactionCreate {
+	if t != nil {
+		mrc.SetCreateTime(*t)
+	}
+	return mrc
+}
+
+// Mutation returns the MessageReactionMutation object of the builder.
+func (mrc *MessageReactionCreate) Mutation() *MessageReactionMutation {
+	return mrc.mutation
+}
+
+// Save creates the MessageReaction in the database.
+func (mrc *MessageReactionCreate) Save(ctx context.Context) (*MessageReaction, error) {
+	mrc.defaults()
+	return withHooks(ctx, mrc.sqlSave, mrc.mutation, mrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mrc *MessageReactionCreate) SaveX(ctx context.Context) *MessageReaction {
+	v, err := mrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mrc *MessageReactionCreate) Exec(ctx context.Context) error {
+	_, err := mrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mrc *MessageReactionCreate) ExecX(ctx context.Context) {
+	if err := mrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mrc *MessageReactionCreate) defaults() {
+	if _, ok := mrc.mutation.CreateTime(); !ok {
+		v := messagereaction.DefaultCreateTime()
+		mrc.mutation.SetCreateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mrc *MessageReactionCreate) check() error {
+	if _, ok := mrc.mutation.MsgId(); !ok {
+		return &ValidationError{Name: "msgId", err: errors.New(`ent: missing required field "MessageReaction.msgId"`)}
+	}
+	if v, ok := mrc.mutation.MsgId(); ok {
+		if err := messagereaction.MsgIdValidator(v); err != nil {
+			return &ValidationError{Name: "msgId", err: fmt.Errorf(`ent: validator failed for field "MessageReaction.msgId": %w`, err)}
+		}
+	}
+	if _, ok := mrc.mutation.UserId(); !ok {
+		return &ValidationError{Name: "userId", err: errors.New(`ent: missing required field "MessageReaction.userId"`)}
+	}
+	if _, ok := mrc.mutation.Emoji(); !ok {
+		return &ValidationError{Name: "emoji", err: errors.New(`ent: missing required field "MessageReaction.emoji"`)}
+	}
+	if v, ok := mrc.mutation.Emoji(); ok {
+		if err := messagereaction.EmojiValidator(v); err != nil {
+			return &ValidationError{Name: "emoji", err: fmt.Errorf(`ent: validator failed for field "MessageReaction.emoji": %w`, err)}
+		}
+	}
+	if _, ok := mrc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "createTime", err: errors.New(`ent: missing required field "MessageReaction.createTime"`)}
+	}
+	return nil
+}
+
+func (mrc *MessageReactionCreate) sqlSave(ctx context.Context) (*MessageReaction, error) {
+	if err := mrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	mrc.mutation.id = &_node.ID
+	mrc.mutation.done = true
+	return _node, nil
+}
+
+func (mrc *MessageReactionCreate) createSpec() (*MessageReaction, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MessageReaction{config: mrc.config}
+		_spec = sqlgraph.NewCreateSpec(messagereaction.Table, sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeInt))
+	)
+	if value, ok := mrc.mutation.MsgId(); ok {
+		_spec.SetField(messagereaction.FieldMsgId, field.TypeString, value)
+		_node.MsgId = value
+	}
+	if value, ok := mrc.mutation.UserId(); ok {
+		_spec.SetField(messagereaction.FieldUserId, field.TypeInt, value)
+		_node.UserId = value
+	}
+	if value, ok := mrc.mutation.Emoji(); ok {
+		_spec.SetField(messagereaction.FieldEmoji, field.TypeString, value)
+		_node.Emoji = value
+	}
+	if value, ok := mrc.mutation.CreateTime(); ok {
+		_spec.SetField(messagereaction.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	return _node, _spec
+}
+
+// MessageReactionCreateBulk is the builder for creating many MessageReaction entities in bulk.
+type MessageReactionCreateBulk struct {
+	config
+	err      error
+	builders []*MessageReactionCreate
+}
+
+// Save creates the MessageReaction entities in the database.
+func (mrcb *MessageReactionCreateBulk) Save(ctx context.Context) ([]*MessageReaction, error) {
+	if mrcb.err != nil {
+		return nil, mrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mrcb.builders))
+	nodes := make([]*MessageReaction, len(mrcb.builders))
+	mutators := make([]Mutator, len(mrcb.builders))
+	for i := range mrcb.builders {
+		func(i int, root context.Context) {
+			builder := mrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MessageReactionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mrcb *MessageReactionCreateBulk) SaveX(ctx context.Context) []*MessageReaction {
+	v, err := mrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mrcb *MessageReactionCreateBulk) Exec(ctx context.Context) error {
+	_, err := mrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mrcb *MessageReactionCreateBulk) ExecX(ctx context.Context) {
+	if err := mrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
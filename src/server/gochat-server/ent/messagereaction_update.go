@@ -0,0 +1,368 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gochat_server/ent/messagereaction"
+	"gochat_server/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MessageReactionUpdate is the builder for updating MessageReaction entities.
+type MessageReactionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MessageReactionMutation
+}
+
+// Where appends a list predicates to the MessageReactionUpdate builder.
+func (mru *MessageReactionUpdate) Where(ps ...predicate.MessageReaction) *MessageReactionUpdate {
+	mru.mutation.Where(ps...)
+	return mru
+}
+
+// SetMsgId sets the "msgId" field.
+func (mru *MessageReactionUpdate) SetMsgId(s string) *MessageReactionUpdate {
+	mru.mutation.SetMsgId(s)
+	return mru
+}
+
+// SetNillableMsgId sets the "msgId" field if the given value is not nil.
+func (mru *MessageReactionUpdate) SetNillableMsgId(s *string) *MessageReactionUpdate {
+	if s != nil {
+		mru.SetMsgId(*s)
+	}
+	return mru
+}
+
+// SetUserId sets the "userId" field.
+func (mru *MessageReactionUpdate) SetUserId(i int) *MessageReactionUpdate {
+	mru.mutation.ResetUserId()
+	mru.mutation.SetUserId(i)
+	return mru
+}
+
+// SetNillableUserId sets the "userId" field if the given value is not nil.
+func (mru *MessageReactionUpdate) SetNillableUserId(i *int) *MessageReactionUpdate {
+	if i != nil {
+		mru.SetUserId(*i)
+	}
+	return mru
+}
+
+// AddUserId adds i to the "userId" field.
+func (mru *MessageReactionUpdate) AddUserId(i int) *MessageReactionUpdate {
+	mru.mutation.AddUserId(i)
+	return mru
+}
+
+// SetEmoji sets the "emoji" field.
+func (mru *MessageReactionUpdate) SetEmoji(s string) *MessageReactionUpdate {
+	mru.mutation.SetEmoji(s)
+	return mru
+}
+
+// SetNillableEmoji sets the "emoji" field if the given value is not nil.
+func (mru *MessageReactionUpdate) SetNillableEmoji(s *string) *MessageReactionUpdate {
+	if s != nil {
+		mru.SetEmoji(*s)
+	}
+	return mru
+}
+
+// SetCreateTime sets the "createTime" field.
+func (mru *MessageReactionUpdate) SetCreateTime(t time.Time) *MessageReactionUpdate {
+	mru.mutation.SetCreateTime(t)
+	return mru
+}
+
+// SetNillableCreateTime sets the "createTime" field if the given value is not nil.
+func (mru *MessageReactionUpdate) SetNillableCreateTime(t *time.Time) *MessageReactionUpdate {
+	if t != nil {
+		mru.SetCreateTime(*t)
+	}
+	return mru
+}
+
+// Mutation returns the MessageReactionMutation object of the builder.
+func (mru *MessageReactionUpdate) Mutation() *MessageReactionMutation {
+	return mru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mru *MessageReactionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, mru.sqlSave, mru.mutation, mru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mru *MessageReactionUpdate) SaveX(ctx context.Context) int {
+	affected, err := mru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mru *MessageReactionUpdate) Exec(ctx context.Context) error {
+	_, err := mru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mru *MessageReactionUpdate) ExecX(ctx context.Context) {
+	if err := mru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mru *MessageReactionUpdate) check() error {
+	if v, ok := mru.mutation.MsgId(); ok {
+		if err := messagereaction.MsgIdValidator(v); err != nil {
+			return &ValidationError{Name: "msgId", err: fmt.Errorf(`ent: validator failed for field "MessageReaction.msgId": %w`, err)}
+		}
+	}
+	if v, ok := mru.mutation.Emoji(); ok {
+		if err := messagereaction.EmojiValidator(v); err != nil {
+			return &ValidationError{Name: "emoji", err: fmt.Errorf(`ent: validator failed for field "MessageReaction.emoji": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (mru *MessageReactionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(messagereaction.Table, messagereaction.Columns, sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeInt))
+	if ps := mru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mru.mutation.MsgId(); ok {
+		_spec.SetField(messagereaction.FieldMsgId, field.TypeString, value)
+	}
+	if value, ok := mru.mutation.UserId(); ok {
+		_spec.SetField(messagereaction.FieldUserId, field.TypeInt, value)
+	}
+	if value, ok := mru.mutation.AddedUserId(); ok {
+		_spec.AddField(messagereaction.FieldUserId, field.TypeInt, value)
+	}
+	if value, ok := mru.mutation.Emoji(); ok {
+		_spec.SetField(messagereaction.FieldEmoji, field.TypeString, value)
+	}
+	if value, ok := mru.mutation.CreateTime(); ok {
+		_spec.SetField(messagereaction.FieldCreateTime, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messagereaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mru.mutation.done = true
+	return n, nil
+}
+
+// MessageReactionUpdateOne is the builder for updating a single MessageReaction entity.
+type MessageReactionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MessageReactionMutation
+}
+
+// SetMsgId sets the "msgId" field.
+func (mruo *MessageReactionUpdateOne) SetMsgId(s string) *MessageReactionUpdateOne {
+	mruo.mutation.SetMsgId(s)
+	return mruo
+}
+
+// SetNillableMsgId sets the "msgId" field if the given value is not nil.
+func (mruo *MessageReactionUpdateOne) SetNillableMsgId(s *string) *MessageReactionUpdateOne {
+	if s != nil {
+		mruo.SetMsgId(*s)
+	}
+	return mruo
+}
+
+// SetUserId sets the "userId" field.
+func (mruo *MessageReactionUpdateOne) SetUserId(i int) *MessageReactionUpdateOne {
+	mruo.mutation.ResetUserId()
+	mruo.mutation.SetUserId(i)
+	return mruo
+}
+
+// SetNillableUserId sets the "userId" field if the given value is not nil.
+func (mruo *MessageReactionUpdateOne) SetNillableUserId(i *int) *MessageReactionUpdateOne {
+	if i != nil {
+		mruo.SetUserId(*i)
+	}
+	return mruo
+}
+
+// AddUserId adds i to the "userId" field.
+func (mruo *MessageReactionUpdateOne) AddUserId(i int) *MessageReactionUpdateOne {
+	mruo.mutation.AddUserId(i)
+	return mruo
+}
+
+// SetEmoji sets the "emoji" field.
+func (mruo *MessageReactionUpdateOne) SetEmoji(s string) *MessageReactionUpdateOne {
+	mruo.mutation.SetEmoji(s)
+	return mruo
+}
+
+// SetNillableEmoji sets the "emoji" field if the given value is not nil.
+func (mruo *MessageReactionUpdateOne) SetNillableEmoji(s *string) *MessageReactionUpdateOne {
+	if s != nil {
+		mruo.SetEmoji(*s)
+	}
+	return mruo
+}
+
+// SetCreateTime sets the "createTime" field.
+func (mruo *MessageReactionUpdateOne) SetCreateTime(t time.Time) *MessageReactionUpdateOne {
+	mruo.mutation.SetCreateTime(t)
+	return mruo
+}
+
+// SetNillableCreateTime sets the "createTime" field if the given value is not nil.
+func (mruo *MessageReactionUpdateOne) SetNillableCreateTime(t *time.Time) *MessageReactionUpdateOne {
+	if t != nil {
+		mruo.SetCreateTime(*t)
+	}
+	return mruo
+}
+
+// Mutation returns the MessageReactionMutation object of the builder.
+func (mruo *MessageReactionUpdateOne) Mutation() *MessageReactionMutation {
+	return mruo.mutation
+}
+
+// Where appends a list predicates to the MessageReactionUpdate builder.
+func (mruo *MessageReactionUpdateOne) Where(ps ...predicate.MessageReaction) *MessageReactionUpdateOne {
+	mruo.mutation.Where(ps...)
+	return mruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mruo *MessageReactionUpdateOne) Select(field string, fields ...string) *MessageReactionUpdateOne {
+	mruo.fields = append([]string{field}, fields...)
+	return mruo
+}
+
+// Save executes the query and returns the updated MessageReaction entity.
+func (mruo *MessageReactionUpdateOne) Save(ctx context.Context) (*MessageReaction, error) {
+	return withHooks(ctx, mruo.sqlSave, mruo.mutation, mruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mruo *MessageReactionUpdateOne) SaveX(ctx context.Context) *MessageReaction {
+	node, err := mruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mruo *MessageReactionUpdateOne) Exec(ctx context.Context) error {
+	_, err := mruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mruo *MessageReactionUpdateOne) ExecX(ctx context.Context) {
+	if err := mruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mruo *MessageReactionUpdateOne) check() error {
+	if v, ok := mruo.mutation.MsgId(); ok {
+		if err := messagereaction.MsgIdValidator(v); err != nil {
+			return &ValidationError{Name: "msgId", err: fmt.Errorf(`ent: validator failed for field "MessageReaction.msgId": %w`, err)}
+		}
+	}
+	if v, ok := mruo.mutation.Emoji(); ok {
+		if err := messagereaction.EmojiValidator(v); err != nil {
+			return &ValidationError{Name: "emoji", err: fmt.Errorf(`ent: validator failed for field "MessageReaction.emoji": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (mruo *MessageReactionUpdateOne) sqlSave(ctx context.Context) (_node *MessageReaction, err error) {
+	if err := mruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(messagereaction.Table, messagereaction.Columns, sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeInt))
+	id, ok := mruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MessageReaction.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, messagereaction.FieldID)
+		for _, f := range fields {
+			if !messagereaction.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != messagereaction.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mruo.mutation.MsgId(); ok {
+		_spec.SetField(messagereaction.FieldMsgId, field.TypeString, value)
+	}
+	if value, ok := mruo.mutation.UserId(); ok {
+		_spec.SetField(messagereaction.FieldUserId, field.TypeInt, value)
+	}
+	if value, ok := mruo.mutation.AddedUserId(); ok {
+		_spec.AddField(messagereaction.FieldUserId, field.TypeInt, value)
+	}
+	if value, ok := mruo.mutation.Emoji(); ok {
+		_spec.SetField(messagereaction.FieldEmoji, field.TypeString, value)
+	}
+	if value, ok := mruo.mutation.CreateTime(); ok {
+		_spec.SetField(messagereaction.FieldCreateTime, field.TypeTime, value)
+	}
+	_node = &MessageReaction{config: mruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messagereaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mruo.mutation.done = true
+	return _node, nil
+}
@@ -8,6 +8,31 @@ import (
 )
 
 var (
+	// BlocksColumns holds the columns for the "blocks" table.
+	BlocksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "blocked_user_id", Type: field.TypeInt},
+		{Name: "create_time", Type: field.TypeTime},
+	}
+	// BlocksTable holds the schema information for the "blocks" table.
+	BlocksTable = &schema.Table{
+		Name:       "blocks",
+		Columns:    BlocksColumns,
+		PrimaryKey: []*schema.Column{BlocksColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "block_user_id_blocked_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{BlocksColumns[1], BlocksColumns[2]},
+			},
+			{
+				Name:    "block_blocked_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{BlocksColumns[2]},
+			},
+		},
+	}
 	// ChatRecordsColumns holds the columns for the "chat_records" table.
 	ChatRecordsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -17,6 +42,7 @@ var (
 		{Name: "msg_type", Type: field.TypeInt},
 		{Name: "is_group", Type: field.TypeBool, Default: false},
 		{Name: "group_id", Type: field.TypeInt, Nullable: true},
+		{Name: "conversation_id", Type: field.TypeString, Nullable: true},
 		{Name: "create_time", Type: field.TypeTime},
 	}
 	// ChatRecordsTable holds the schema information for the "chat_records" table.
@@ -38,15 +64,100 @@ var (
 			{
 				Name:    "chatrecord_to_user_id_create_time",
 				Unique:  false,
-				Columns: []*schema.Column{ChatRecordsColumns[3], ChatRecordsColumns[7]},
+				Columns: []*schema.Column{ChatRecordsColumns[3], ChatRecordsColumns[8]},
 			},
 			{
 				Name:    "chatrecord_group_id_create_time",
 				Unique:  false,
-				Columns: []*schema.Column{ChatRecordsColumns[6], ChatRecordsColumns[7]},
+				Columns: []*schema.Column{ChatRecordsColumns[6], ChatRecordsColumns[8]},
+			},
+			{
+				Name:    "chatrecord_conversation_id_create_time_msg_id",
+				Unique:  false,
+				Columns: []*schema.Column{ChatRecordsColumns[7], ChatRecordsColumns[8], ChatRecordsColumns[1]},
+			},
+		},
+	}
+	// ContactCardMessagesColumns holds the columns for the "contact_card_messages" table.
+	ContactCardMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "msg_id", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// ContactCardMessagesTable holds the schema information for the "contact_card_messages" table.
+	ContactCardMessagesTable = &schema.Table{
+		Name:       "contact_card_messages",
+		Columns:    ContactCardMessagesColumns,
+		PrimaryKey: []*schema.Column{ContactCardMessagesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "contactcardmessage_msg_id",
+				Unique:  true,
+				Columns: []*schema.Column{ContactCardMessagesColumns[1]},
 			},
 		},
 	}
+	// ConversationClearsColumns holds the columns for the "conversation_clears" table.
+	ConversationClearsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "is_group", Type: field.TypeBool, Default: false},
+		{Name: "target_id", Type: field.TypeInt},
+		{Name: "clear_time", Type: field.TypeTime},
+		{Name: "hidden", Type: field.TypeBool, Default: false},
+	}
+	// ConversationClearsTable holds the schema information for the "conversation_clears" table.
+	ConversationClearsTable = &schema.Table{
+		Name:       "conversation_clears",
+		Columns:    ConversationClearsColumns,
+		PrimaryKey: []*schema.Column{ConversationClearsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "conversationclear_user_id_is_group_target_id",
+				Unique:  true,
+				Columns: []*schema.Column{ConversationClearsColumns[1], ConversationClearsColumns[2], ConversationClearsColumns[3]},
+			},
+		},
+	}
+	// ConversationTimersColumns holds the columns for the "conversation_timers" table.
+	ConversationTimersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "conversation_id", Type: field.TypeString},
+		{Name: "is_group", Type: field.TypeBool, Default: false},
+		{Name: "ttl_seconds", Type: field.TypeInt, Default: 0},
+		{Name: "update_user_id", Type: field.TypeInt},
+		{Name: "update_time", Type: field.TypeTime},
+	}
+	// ConversationTimersTable holds the schema information for the "conversation_timers" table.
+	ConversationTimersTable = &schema.Table{
+		Name:       "conversation_timers",
+		Columns:    ConversationTimersColumns,
+		PrimaryKey: []*schema.Column{ConversationTimersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "conversationtimer_conversation_id",
+				Unique:  true,
+				Columns: []*schema.Column{ConversationTimersColumns[1]},
+			},
+		},
+	}
+	// DataMigrationsColumns holds the columns for the "data_migrations" table.
+	DataMigrationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "cursor", Type: field.TypeInt, Default: 0},
+		{Name: "migrated", Type: field.TypeInt, Default: 0},
+		{Name: "is_completed", Type: field.TypeBool, Default: false},
+		{Name: "start_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "finish_time", Type: field.TypeTime, Nullable: true},
+	}
+	// DataMigrationsTable holds the schema information for the "data_migrations" table.
+	DataMigrationsTable = &schema.Table{
+		Name:       "data_migrations",
+		Columns:    DataMigrationsColumns,
+		PrimaryKey: []*schema.Column{DataMigrationsColumns[0]},
+	}
 	// DoNotDisturbsColumns holds the columns for the "do_not_disturbs" table.
 	DoNotDisturbsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -87,6 +198,65 @@ var (
 			},
 		},
 	}
+	// ExportJobsColumns holds the columns for the "export_jobs" table.
+	ExportJobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "is_group", Type: field.TypeBool, Default: false},
+		{Name: "target_id", Type: field.TypeInt},
+		{Name: "format", Type: field.TypeString},
+		{Name: "status", Type: field.TypeString, Default: "pending"},
+		{Name: "lease_time", Type: field.TypeTime, Nullable: true},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "total", Type: field.TypeInt, Default: 0},
+		{Name: "processed", Type: field.TypeInt, Default: 0},
+		{Name: "object_name", Type: field.TypeString, Default: ""},
+		{Name: "file_size", Type: field.TypeInt64, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Default: ""},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "finish_time", Type: field.TypeTime, Nullable: true},
+	}
+	// ExportJobsTable holds the schema information for the "export_jobs" table.
+	ExportJobsTable = &schema.Table{
+		Name:       "export_jobs",
+		Columns:    ExportJobsColumns,
+		PrimaryKey: []*schema.Column{ExportJobsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "exportjob_user_id_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{ExportJobsColumns[1], ExportJobsColumns[13]},
+			},
+			{
+				Name:    "exportjob_status",
+				Unique:  false,
+				Columns: []*schema.Column{ExportJobsColumns[5]},
+			},
+		},
+	}
+	// FileMessagesColumns holds the columns for the "file_messages" table.
+	FileMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "msg_id", Type: field.TypeString},
+		{Name: "file_name", Type: field.TypeString},
+		{Name: "file_size", Type: field.TypeInt64},
+		{Name: "mime_type", Type: field.TypeString, Default: "application/octet-stream"},
+		{Name: "checksum", Type: field.TypeString},
+		{Name: "storage_key", Type: field.TypeString},
+	}
+	// FileMessagesTable holds the schema information for the "file_messages" table.
+	FileMessagesTable = &schema.Table{
+		Name:       "file_messages",
+		Columns:    FileMessagesColumns,
+		PrimaryKey: []*schema.Column{FileMessagesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "filemessage_msg_id",
+				Unique:  true,
+				Columns: []*schema.Column{FileMessagesColumns[1]},
+			},
+		},
+	}
 	// FriendRelationshipsColumns holds the columns for the "friend_relationships" table.
 	FriendRelationshipsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -121,7 +291,10 @@ var (
 		{Name: "to_user_id", Type: field.TypeInt},
 		{Name: "remark", Type: field.TypeString, Nullable: true},
 		{Name: "status", Type: field.TypeInt, Default: 0},
+		{Name: "source", Type: field.TypeString, Nullable: true},
+		{Name: "source_msg_id", Type: field.TypeString, Nullable: true},
 		{Name: "create_time", Type: field.TypeTime},
+		{Name: "handle_time", Type: field.TypeTime, Nullable: true},
 	}
 	// FriendRequestsTable holds the schema information for the "friend_requests" table.
 	FriendRequestsTable = &schema.Table{
@@ -139,6 +312,11 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{FriendRequestsColumns[1], FriendRequestsColumns[2]},
 			},
+			{
+				Name:    "friendrequest_status_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{FriendRequestsColumns[4], FriendRequestsColumns[7]},
+			},
 		},
 	}
 	// GroupsColumns holds the columns for the "groups" table.
@@ -150,6 +328,8 @@ var (
 		{Name: "create_user_id", Type: field.TypeInt},
 		{Name: "create_time", Type: field.TypeTime},
 		{Name: "members", Type: field.TypeJSON},
+		{Name: "read_receipts_enabled", Type: field.TypeBool, Default: true},
+		{Name: "retention_days", Type: field.TypeInt, Default: 0},
 	}
 	// GroupsTable holds the schema information for the "groups" table.
 	GroupsTable = &schema.Table{
@@ -200,9 +380,39 @@ var (
 				Columns: []*schema.Column{GroupChatRecordsColumns[1]},
 			},
 			{
-				Name:    "groupchatrecord_group_id_create_time",
+				Name:    "groupchatrecord_group_id_create_time_msg_id",
+				Unique:  false,
+				Columns: []*schema.Column{GroupChatRecordsColumns[3], GroupChatRecordsColumns[5], GroupChatRecordsColumns[1]},
+			},
+		},
+	}
+	// GroupWatermarksColumns holds the columns for the "group_watermarks" table.
+	GroupWatermarksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "group_id", Type: field.TypeInt},
+		{Name: "read_seq", Type: field.TypeInt, Default: 0},
+		{Name: "delivered_seq", Type: field.TypeInt, Default: 0},
+		{Name: "join_seq", Type: field.TypeInt, Default: 0},
+		{Name: "read_time", Type: field.TypeTime, Nullable: true},
+		{Name: "delivered_time", Type: field.TypeTime, Nullable: true},
+		{Name: "create_time", Type: field.TypeTime},
+	}
+	// GroupWatermarksTable holds the schema information for the "group_watermarks" table.
+	GroupWatermarksTable = &schema.Table{
+		Name:       "group_watermarks",
+		Columns:    GroupWatermarksColumns,
+		PrimaryKey: []*schema.Column{GroupWatermarksColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "groupwatermark_user_id_group_id",
+				Unique:  true,
+				Columns: []*schema.Column{GroupWatermarksColumns[1], GroupWatermarksColumns[2]},
+			},
+			{
+				Name:    "groupwatermark_group_id_read_seq",
 				Unique:  false,
-				Columns: []*schema.Column{GroupChatRecordsColumns[3], GroupChatRecordsColumns[5]},
+				Columns: []*schema.Column{GroupWatermarksColumns[2], GroupWatermarksColumns[3]},
 			},
 		},
 	}
@@ -218,21 +428,238 @@ var (
 		Columns:    ImageMessagesColumns,
 		PrimaryKey: []*schema.Column{ImageMessagesColumns[0]},
 	}
+	// LocationMessagesColumns holds the columns for the "location_messages" table.
+	LocationMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "msg_id", Type: field.TypeString},
+		{Name: "latitude", Type: field.TypeFloat64},
+		{Name: "longitude", Type: field.TypeFloat64},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "address", Type: field.TypeString, Nullable: true},
+	}
+	// LocationMessagesTable holds the schema information for the "location_messages" table.
+	LocationMessagesTable = &schema.Table{
+		Name:       "location_messages",
+		Columns:    LocationMessagesColumns,
+		PrimaryKey: []*schema.Column{LocationMessagesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "locationmessage_msg_id",
+				Unique:  true,
+				Columns: []*schema.Column{LocationMessagesColumns[1]},
+			},
+		},
+	}
+	// MergedForwardMessagesColumns holds the columns for the "merged_forward_messages" table.
+	MergedForwardMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "msg_id", Type: field.TypeString},
+		{Name: "title", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+	}
+	// MergedForwardMessagesTable holds the schema information for the "merged_forward_messages" table.
+	MergedForwardMessagesTable = &schema.Table{
+		Name:       "merged_forward_messages",
+		Columns:    MergedForwardMessagesColumns,
+		PrimaryKey: []*schema.Column{MergedForwardMessagesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "mergedforwardmessage_msg_id",
+				Unique:  true,
+				Columns: []*schema.Column{MergedForwardMessagesColumns[1]},
+			},
+		},
+	}
 	// MessagesColumns holds the columns for the "messages" table.
 	MessagesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "msg_id", Type: field.TypeString},
 		{Name: "msg_type", Type: field.TypeString},
 		{Name: "content", Type: field.TypeString},
+		{Name: "body", Type: field.TypeString, Size: 2147483647, Default: ""},
 		{Name: "is_revoked", Type: field.TypeBool, Default: false},
 		{Name: "revoke_time", Type: field.TypeTime, Nullable: true},
 		{Name: "create_time", Type: field.TypeTime},
+		{Name: "expire_time", Type: field.TypeTime, Nullable: true},
+		{Name: "is_purged", Type: field.TypeBool, Default: false},
+		{Name: "purge_time", Type: field.TypeTime, Nullable: true},
+		{Name: "storage_key", Type: field.TypeString, Default: ""},
 	}
 	// MessagesTable holds the schema information for the "messages" table.
 	MessagesTable = &schema.Table{
 		Name:       "messages",
 		Columns:    MessagesColumns,
 		PrimaryKey: []*schema.Column{MessagesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "message_msg_id",
+				Unique:  true,
+				Columns: []*schema.Column{MessagesColumns[1]},
+			},
+			{
+				Name:    "message_expire_time",
+				Unique:  false,
+				Columns: []*schema.Column{MessagesColumns[8]},
+			},
+			{
+				Name:    "message_storage_key",
+				Unique:  false,
+				Columns: []*schema.Column{MessagesColumns[11]},
+			},
+		},
+	}
+	// MessageDeletionsColumns holds the columns for the "message_deletions" table.
+	MessageDeletionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "msg_id", Type: field.TypeString},
+		{Name: "is_group", Type: field.TypeBool, Default: false},
+		{Name: "target_id", Type: field.TypeInt},
+		{Name: "create_time", Type: field.TypeTime},
+	}
+	// MessageDeletionsTable holds the schema information for the "message_deletions" table.
+	MessageDeletionsTable = &schema.Table{
+		Name:       "message_deletions",
+		Columns:    MessageDeletionsColumns,
+		PrimaryKey: []*schema.Column{MessageDeletionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "messagedeletion_user_id_msg_id",
+				Unique:  true,
+				Columns: []*schema.Column{MessageDeletionsColumns[1], MessageDeletionsColumns[2]},
+			},
+			{
+				Name:    "messagedeletion_user_id_is_group_target_id",
+				Unique:  false,
+				Columns: []*schema.Column{MessageDeletionsColumns[1], MessageDeletionsColumns[3], MessageDeletionsColumns[4]},
+			},
+		},
+	}
+	// MessageForwardsColumns holds the columns for the "message_forwards" table.
+	MessageForwardsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "msg_id", Type: field.TypeString},
+		{Name: "source_msg_id", Type: field.TypeString},
+		{Name: "source_from_user_id", Type: field.TypeInt},
+		{Name: "source_is_group", Type: field.TypeBool, Default: false},
+		{Name: "source_group_id", Type: field.TypeInt, Nullable: true},
+		{Name: "source_create_time", Type: field.TypeTime},
+		{Name: "forward_user_id", Type: field.TypeInt},
+		{Name: "create_time", Type: field.TypeTime},
+	}
+	// MessageForwardsTable holds the schema information for the "message_forwards" table.
+	MessageForwardsTable = &schema.Table{
+		Name:       "message_forwards",
+		Columns:    MessageForwardsColumns,
+		PrimaryKey: []*schema.Column{MessageForwardsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "messageforward_msg_id",
+				Unique:  true,
+				Columns: []*schema.Column{MessageForwardsColumns[1]},
+			},
+			{
+				Name:    "messageforward_source_msg_id",
+				Unique:  false,
+				Columns: []*schema.Column{MessageForwardsColumns[2]},
+			},
+		},
+	}
+	// MessageMentionsColumns holds the columns for the "message_mentions" table.
+	MessageMentionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "msg_id", Type: field.TypeString},
+		{Name: "group_id", Type: field.TypeInt},
+		{Name: "from_user_id", Type: field.TypeInt},
+		{Name: "mentioned_user_id", Type: field.TypeInt, Default: 0},
+		{Name: "is_all", Type: field.TypeBool, Default: false},
+		{Name: "offset", Type: field.TypeInt, Default: 0},
+		{Name: "length", Type: field.TypeInt, Default: 0},
+		{Name: "create_time", Type: field.TypeTime},
+	}
+	// MessageMentionsTable holds the schema information for the "message_mentions" table.
+	MessageMentionsTable = &schema.Table{
+		Name:       "message_mentions",
+		Columns:    MessageMentionsColumns,
+		PrimaryKey: []*schema.Column{MessageMentionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "messagemention_msg_id",
+				Unique:  false,
+				Columns: []*schema.Column{MessageMentionsColumns[1]},
+			},
+			{
+				Name:    "messagemention_mentioned_user_id_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{MessageMentionsColumns[4], MessageMentionsColumns[8]},
+			},
+			{
+				Name:    "messagemention_group_id_is_all_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{MessageMentionsColumns[2], MessageMentionsColumns[5], MessageMentionsColumns[8]},
+			},
+		},
+	}
+	// MessageOutboxesColumns holds the columns for the "message_outboxes" table.
+	MessageOutboxesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "msg_id", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "status", Type: field.TypeString, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_time", Type: field.TypeTime},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "lease_token", Type: field.TypeString, Default: ""},
+		{Name: "create_time", Type: field.TypeTime},
+	}
+	// MessageOutboxesTable holds the schema information for the "message_outboxes" table.
+	MessageOutboxesTable = &schema.Table{
+		Name:       "message_outboxes",
+		Columns:    MessageOutboxesColumns,
+		PrimaryKey: []*schema.Column{MessageOutboxesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "messageoutbox_status_next_attempt_time",
+				Unique:  false,
+				Columns: []*schema.Column{MessageOutboxesColumns[3], MessageOutboxesColumns[5]},
+			},
+			{
+				Name:    "messageoutbox_msg_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{MessageOutboxesColumns[1], MessageOutboxesColumns[2]},
+			},
+			{
+				Name:    "messageoutbox_lease_token",
+				Unique:  false,
+				Columns: []*schema.Column{MessageOutboxesColumns[7]},
+			},
+		},
+	}
+	// MessageReactionsColumns holds the columns for the "message_reactions" table.
+	MessageReactionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "msg_id", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "emoji", Type: field.TypeString, Size: 32},
+		{Name: "create_time", Type: field.TypeTime},
+	}
+	// MessageReactionsTable holds the schema information for the "message_reactions" table.
+	MessageReactionsTable = &schema.Table{
+		Name:       "message_reactions",
+		Columns:    MessageReactionsColumns,
+		PrimaryKey: []*schema.Column{MessageReactionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "messagereaction_msg_id_user_id_emoji",
+				Unique:  true,
+				Columns: []*schema.Column{MessageReactionsColumns[1], MessageReactionsColumns[2], MessageReactionsColumns[3]},
+			},
+			{
+				Name:    "messagereaction_msg_id",
+				Unique:  false,
+				Columns: []*schema.Column{MessageReactionsColumns[1]},
+			},
+		},
 	}
 	// MessageStatusColumns holds the columns for the "message_status" table.
 	MessageStatusColumns = []*schema.Column{
@@ -263,6 +690,72 @@ var (
 			},
 		},
 	}
+	// RetentionJobsColumns holds the columns for the "retention_jobs" table.
+	RetentionJobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "status", Type: field.TypeString, Default: "running"},
+		{Name: "cursor", Type: field.TypeInt, Default: 0},
+		{Name: "max_message_id", Type: field.TypeInt, Default: 0},
+		{Name: "scanned", Type: field.TypeInt, Default: 0},
+		{Name: "purged", Type: field.TypeInt, Default: 0},
+		{Name: "archived", Type: field.TypeInt, Default: 0},
+		{Name: "media_deleted", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Default: ""},
+		{Name: "start_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "finish_time", Type: field.TypeTime, Nullable: true},
+	}
+	// RetentionJobsTable holds the schema information for the "retention_jobs" table.
+	RetentionJobsTable = &schema.Table{
+		Name:       "retention_jobs",
+		Columns:    RetentionJobsColumns,
+		PrimaryKey: []*schema.Column{RetentionJobsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "retentionjob_status",
+				Unique:  false,
+				Columns: []*schema.Column{RetentionJobsColumns[1]},
+			},
+		},
+	}
+	// ScheduledMessagesColumns holds the columns for the "scheduled_messages" table.
+	ScheduledMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "to_user_id", Type: field.TypeInt, Default: 0},
+		{Name: "group_id", Type: field.TypeInt, Default: 0},
+		{Name: "msg_type", Type: field.TypeInt},
+		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "mentions", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "reply_to_msg_id", Type: field.TypeString, Default: ""},
+		{Name: "send_time", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeString, Default: "pending"},
+		{Name: "lease_time", Type: field.TypeTime, Nullable: true},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "retries", Type: field.TypeInt, Default: 0},
+		{Name: "msg_id", Type: field.TypeString, Default: ""},
+		{Name: "fail_reason", Type: field.TypeString, Default: ""},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+	}
+	// ScheduledMessagesTable holds the schema information for the "scheduled_messages" table.
+	ScheduledMessagesTable = &schema.Table{
+		Name:       "scheduled_messages",
+		Columns:    ScheduledMessagesColumns,
+		PrimaryKey: []*schema.Column{ScheduledMessagesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "scheduledmessage_status_send_time",
+				Unique:  false,
+				Columns: []*schema.Column{ScheduledMessagesColumns[9], ScheduledMessagesColumns[8]},
+			},
+			{
+				Name:    "scheduledmessage_user_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{ScheduledMessagesColumns[1], ScheduledMessagesColumns[9]},
+			},
+		},
+	}
 	// TextMessagesColumns holds the columns for the "text_messages" table.
 	TextMessagesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -314,20 +807,61 @@ var (
 		Columns:    VideoMessagesColumns,
 		PrimaryKey: []*schema.Column{VideoMessagesColumns[0]},
 	}
+	// VoiceMessagesColumns holds the columns for the "voice_messages" table.
+	VoiceMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "msg_id", Type: field.TypeString},
+		{Name: "storage_key", Type: field.TypeString},
+		{Name: "mime_type", Type: field.TypeString, Default: "application/octet-stream"},
+		{Name: "file_size", Type: field.TypeInt64},
+		{Name: "duration", Type: field.TypeInt},
+		{Name: "waveform", Type: field.TypeJSON},
+	}
+	// VoiceMessagesTable holds the schema information for the "voice_messages" table.
+	VoiceMessagesTable = &schema.Table{
+		Name:       "voice_messages",
+		Columns:    VoiceMessagesColumns,
+		PrimaryKey: []*schema.Column{VoiceMessagesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "voicemessage_msg_id",
+				Unique:  true,
+				Columns: []*schema.Column{VoiceMessagesColumns[1]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		BlocksTable,
 		ChatRecordsTable,
+		ContactCardMessagesTable,
+		ConversationClearsTable,
+		ConversationTimersTable,
+		DataMigrationsTable,
 		DoNotDisturbsTable,
+		ExportJobsTable,
+		FileMessagesTable,
 		FriendRelationshipsTable,
 		FriendRequestsTable,
 		GroupsTable,
 		GroupChatRecordsTable,
+		GroupWatermarksTable,
 		ImageMessagesTable,
+		LocationMessagesTable,
+		MergedForwardMessagesTable,
 		MessagesTable,
+		MessageDeletionsTable,
+		MessageForwardsTable,
+		MessageMentionsTable,
+		MessageOutboxesTable,
+		MessageReactionsTable,
 		MessageStatusTable,
+		RetentionJobsTable,
+		ScheduledMessagesTable,
 		TextMessagesTable,
 		UsersTable,
 		VideoMessagesTable,
+		VoiceMessagesTable,
 	}
 )
 
@@ -6,19 +6,37 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"gochat_server/ent/block"
 	"gochat_server/ent/chatrecord"
+	"gochat_server/ent/contactcardmessage"
+	"gochat_server/ent/conversationclear"
+	"gochat_server/ent/conversationtimer"
+	"gochat_server/ent/datamigration"
 	"gochat_server/ent/donotdisturb"
+	"gochat_server/ent/exportjob"
+	"gochat_server/ent/filemessage"
 	"gochat_server/ent/friendrelationship"
 	"gochat_server/ent/friendrequest"
 	"gochat_server/ent/group"
 	"gochat_server/ent/groupchatrecord"
+	"gochat_server/ent/groupwatermark"
 	"gochat_server/ent/imagemessage"
+	"gochat_server/ent/locationmessage"
+	"gochat_server/ent/mergedforwardmessage"
 	"gochat_server/ent/message"
+	"gochat_server/ent/messagedeletion"
+	"gochat_server/ent/messageforward"
+	"gochat_server/ent/messagemention"
+	"gochat_server/ent/messageoutbox"
+	"gochat_server/ent/messagereaction"
 	"gochat_server/ent/messagestatus"
 	"gochat_server/ent/predicate"
+	"gochat_server/ent/retentionjob"
+	"gochat_server/ent/scheduledmessage"
 	"gochat_server/ent/textmessage"
 	"gochat_server/ent/user"
 	"gochat_server/ent/videomessage"
+	"gochat_server/ent/voicemessage"
 	"sync"
 	"time"
 
@@ -35,54 +53,66 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeChatRecord         = "ChatRecord"
-	TypeDoNotDisturb       = "DoNotDisturb"
-	TypeFriendRelationship = "FriendRelationship"
-	TypeFriendRequest      = "FriendRequest"
-	TypeGroup              = "Group"
-	TypeGroupChatRecord    = "GroupChatRecord"
-	TypeImageMessage       = "ImageMessage"
-	TypeMessage            = "Message"
-	TypeMessageStatus      = "MessageStatus"
-	TypeTextMessage        = "TextMessage"
-	TypeUser               = "User"
-	TypeVideoMessage       = "VideoMessage"
+	TypeBlock                = "Block"
+	TypeChatRecord           = "ChatRecord"
+	TypeContactCardMessage   = "ContactCardMessage"
+	TypeConversationClear    = "ConversationClear"
+	TypeConversationTimer    = "ConversationTimer"
+	TypeDataMigration        = "DataMigration"
+	TypeDoNotDisturb         = "DoNotDisturb"
+	TypeExportJob            = "ExportJob"
+	TypeFileMessage          = "FileMessage"
+	TypeFriendRelationship   = "FriendRelationship"
+	TypeFriendRequest        = "FriendRequest"
+	TypeGroup                = "Group"
+	TypeGroupChatRecord      = "GroupChatRecord"
+	TypeGroupWatermark       = "GroupWatermark"
+	TypeImageMessage         = "ImageMessage"
+	TypeLocationMessage      = "LocationMessage"
+	TypeMergedForwardMessage = "MergedForwardMessage"
+	TypeMessage              = "Message"
+	TypeMessageDeletion      = "MessageDeletion"
+	TypeMessageForward       = "MessageForward"
+	TypeMessageMention       = "MessageMention"
+	TypeMessageOutbox        = "MessageOutbox"
+	TypeMessageReaction      = "MessageReaction"
+	TypeMessageStatus        = "MessageStatus"
+	TypeRetentionJob         = "RetentionJob"
+	TypeScheduledMessage     = "ScheduledMessage"
+	TypeTextMessage          = "TextMessage"
+	TypeUser                 = "User"
+	TypeVideoMessage         = "VideoMessage"
+	TypeVoiceMessage         = "VoiceMessage"
 )
 
-// ChatRecordMutation represents an operation that mutates the ChatRecord nodes in the graph.
-type ChatRecordMutation struct {
+// BlockMutation represents an operation that mutates the Block nodes in the graph.
+type BlockMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	msgId         *string
-	fromUserId    *int
-	addfromUserId *int
-	toUserId      *int
-	addtoUserId   *int
-	msgType       *int
-	addmsgType    *int
-	isGroup       *bool
-	groupId       *int
-	addgroupId    *int
-	createTime    *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*ChatRecord, error)
-	predicates    []predicate.ChatRecord
-}
-
-var _ ent.Mutation = (*ChatRecordMutation)(nil)
-
-// chatrecordOption allows management of the mutation configuration using functional options.
-type chatrecordOption func(*ChatRecordMutation)
-
-// newChatRecordMutation creates new mutation for the ChatRecord entity.
-func newChatRecordMutation(c config, op Op, opts ...chatrecordOption) *ChatRecordMutation {
-	m := &ChatRecordMutation{
+	op               Op
+	typ              string
+	id               *int
+	userId           *int
+	adduserId        *int
+	blockedUserId    *int
+	addblockedUserId *int
+	createTime       *time.Time
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*Block, error)
+	predicates       []predicate.Block
+}
+
+var _ ent.Mutation = (*BlockMutation)(nil)
+
+// blockOption allows management of the mutation configuration using functional options.
+type blockOption func(*BlockMutation)
+
+// newBlockMutation creates new mutation for the Block entity.
+func newBlockMutation(c config, op Op, opts ...blockOption) *BlockMutation {
+	m := &BlockMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeChatRecord,
+		typ:           TypeBlock,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -91,20 +121,20 @@ func newChatRecordMutation(c config, op Op, opts ...chatrecordOption) *ChatRecor
 	return m
 }
 
-// withChatRecordID sets the ID field of the mutation.
-func withChatRecordID(id int) chatrecordOption {
-	return func(m *ChatRecordMutation) {
+// withBlockID sets the ID field of the mutation.
+func withBlockID(id int) blockOption {
+	return func(m *BlockMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ChatRecord
+			value *Block
 		)
-		m.oldValue = func(ctx context.Context) (*ChatRecord, error) {
+		m.oldValue = func(ctx context.Context) (*Block, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ChatRecord.Get(ctx, id)
+					value, err = m.Client().Block.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -113,10 +143,10 @@ func withChatRecordID(id int) chatrecordOption {
 	}
 }
 
-// withChatRecord sets the old ChatRecord of the mutation.
-func withChatRecord(node *ChatRecord) chatrecordOption {
-	return func(m *ChatRecordMutation) {
-		m.oldValue = func(context.Context) (*ChatRecord, error) {
+// withBlock sets the old Block of the mutation.
+func withBlock(node *Block) blockOption {
+	return func(m *BlockMutation) {
+		m.oldValue = func(context.Context) (*Block, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -125,7 +155,7 @@ func withChatRecord(node *ChatRecord) chatrecordOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ChatRecordMutation) Client() *Client {
+func (m BlockMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -133,7 +163,7 @@ func (m ChatRecordMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ChatRecordMutation) Tx() (*Tx, error) {
+func (m BlockMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -144,7 +174,7 @@ func (m ChatRecordMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ChatRecordMutation) ID() (id int, exists bool) {
+func (m *BlockMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -155,7 +185,7 @@ func (m *ChatRecordMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ChatRecordMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *BlockMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -164,1286 +194,975 @@ func (m *ChatRecordMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ChatRecord.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Block.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetMsgId sets the "msgId" field.
-func (m *ChatRecordMutation) SetMsgId(s string) {
-	m.msgId = &s
-}
-
-// MsgId returns the value of the "msgId" field in the mutation.
-func (m *ChatRecordMutation) MsgId() (r string, exists bool) {
-	v := m.msgId
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldMsgId returns the old "msgId" field's value of the ChatRecord entity.
-// If the ChatRecord object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChatRecordMutation) OldMsgId(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMsgId is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMsgId requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMsgId: %w", err)
-	}
-	return oldValue.MsgId, nil
-}
-
-// ResetMsgId resets all changes to the "msgId" field.
-func (m *ChatRecordMutation) ResetMsgId() {
-	m.msgId = nil
-}
-
-// SetFromUserId sets the "fromUserId" field.
-func (m *ChatRecordMutation) SetFromUserId(i int) {
-	m.fromUserId = &i
-	m.addfromUserId = nil
+// SetUserId sets the "userId" field.
+func (m *BlockMutation) SetUserId(i int) {
+	m.userId = &i
+	m.adduserId = nil
 }
 
-// FromUserId returns the value of the "fromUserId" field in the mutation.
-func (m *ChatRecordMutation) FromUserId() (r int, exists bool) {
-	v := m.fromUserId
+// UserId returns the value of the "userId" field in the mutation.
+func (m *BlockMutation) UserId() (r int, exists bool) {
+	v := m.userId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFromUserId returns the old "fromUserId" field's value of the ChatRecord entity.
-// If the ChatRecord object wasn't provided to the builder, the object is fetched from the database.
+// OldUserId returns the old "userId" field's value of the Block entity.
+// If the Block object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChatRecordMutation) OldFromUserId(ctx context.Context) (v int, err error) {
+func (m *BlockMutation) OldUserId(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFromUserId is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFromUserId requires an ID field in the mutation")
+		return v, errors.New("OldUserId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFromUserId: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserId: %w", err)
 	}
-	return oldValue.FromUserId, nil
+	return oldValue.UserId, nil
 }
 
-// AddFromUserId adds i to the "fromUserId" field.
-func (m *ChatRecordMutation) AddFromUserId(i int) {
-	if m.addfromUserId != nil {
-		*m.addfromUserId += i
+// AddUserId adds i to the "userId" field.
+func (m *BlockMutation) AddUserId(i int) {
+	if m.adduserId != nil {
+		*m.adduserId += i
 	} else {
-		m.addfromUserId = &i
+		m.adduserId = &i
 	}
 }
 
-// AddedFromUserId returns the value that was added to the "fromUserId" field in this mutation.
-func (m *ChatRecordMutation) AddedFromUserId() (r int, exists bool) {
-	v := m.addfromUserId
+// AddedUserId returns the value that was added to the "userId" field in this mutation.
+func (m *BlockMutation) AddedUserId() (r int, exists bool) {
+	v := m.adduserId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetFromUserId resets all changes to the "fromUserId" field.
-func (m *ChatRecordMutation) ResetFromUserId() {
-	m.fromUserId = nil
-	m.addfromUserId = nil
+// ResetUserId resets all changes to the "userId" field.
+func (m *BlockMutation) ResetUserId() {
+	m.userId = nil
+	m.adduserId = nil
 }
 
-// SetToUserId sets the "toUserId" field.
-func (m *ChatRecordMutation) SetToUserId(i int) {
-	m.toUserId = &i
-	m.addtoUserId = nil
+// SetBlockedUserId sets the "blockedUserId" field.
+func (m *BlockMutation) SetBlockedUserId(i int) {
+	m.blockedUserId = &i
+	m.addblockedUserId = nil
 }
 
-// ToUserId returns the value of the "toUserId" field in the mutation.
-func (m *ChatRecordMutation) ToUserId() (r int, exists bool) {
-	v := m.toUserId
+// BlockedUserId returns the value of the "blockedUserId" field in the mutation.
+func (m *BlockMutation) BlockedUserId() (r int, exists bool) {
+	v := m.blockedUserId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldToUserId returns the old "toUserId" field's value of the ChatRecord entity.
-// If the ChatRecord object wasn't provided to the builder, the object is fetched from the database.
+// OldBlockedUserId returns the old "blockedUserId" field's value of the Block entity.
+// If the Block object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChatRecordMutation) OldToUserId(ctx context.Context) (v int, err error) {
+func (m *BlockMutation) OldBlockedUserId(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldToUserId is only allowed on UpdateOne operations")
+		return v, errors.New("OldBlockedUserId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldToUserId requires an ID field in the mutation")
+		return v, errors.New("OldBlockedUserId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldToUserId: %w", err)
+		return v, fmt.Errorf("querying old value for OldBlockedUserId: %w", err)
 	}
-	return oldValue.ToUserId, nil
+	return oldValue.BlockedUserId, nil
 }
 
-// AddToUserId adds i to the "toUserId" field.
-func (m *ChatRecordMutation) AddToUserId(i int) {
-	if m.addtoUserId != nil {
-		*m.addtoUserId += i
+// AddBlockedUserId adds i to the "blockedUserId" field.
+func (m *BlockMutation) AddBlockedUserId(i int) {
+	if m.addblockedUserId != nil {
+		*m.addblockedUserId += i
 	} else {
-		m.addtoUserId = &i
+		m.addblockedUserId = &i
 	}
 }
 
-// AddedToUserId returns the value that was added to the "toUserId" field in this mutation.
-func (m *ChatRecordMutation) AddedToUserId() (r int, exists bool) {
-	v := m.addtoUserId
+// AddedBlockedUserId returns the value that was added to the "blockedUserId" field in this mutation.
+func (m *BlockMutation) AddedBlockedUserId() (r int, exists bool) {
+	v := m.addblockedUserId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetToUserId resets all changes to the "toUserId" field.
-func (m *ChatRecordMutation) ResetToUserId() {
-	m.toUserId = nil
-	m.addtoUserId = nil
+// ResetBlockedUserId resets all changes to the "blockedUserId" field.
+func (m *BlockMutation) ResetBlockedUserId() {
+	m.blockedUserId = nil
+	m.addblockedUserId = nil
 }
 
-// SetMsgType sets the "msgType" field.
-func (m *ChatRecordMutation) SetMsgType(i int) {
-	m.msgType = &i
-	m.addmsgType = nil
+// SetCreateTime sets the "createTime" field.
+func (m *BlockMutation) SetCreateTime(t time.Time) {
+	m.createTime = &t
 }
 
-// MsgType returns the value of the "msgType" field in the mutation.
-func (m *ChatRecordMutation) MsgType() (r int, exists bool) {
-	v := m.msgType
+// CreateTime returns the value of the "createTime" field in the mutation.
+func (m *BlockMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.createTime
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMsgType returns the old "msgType" field's value of the ChatRecord entity.
-// If the ChatRecord object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "createTime" field's value of the Block entity.
+// If the Block object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChatRecordMutation) OldMsgType(ctx context.Context) (v int, err error) {
+func (m *BlockMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMsgType is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMsgType requires an ID field in the mutation")
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMsgType: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
 	}
-	return oldValue.MsgType, nil
+	return oldValue.CreateTime, nil
 }
 
-// AddMsgType adds i to the "msgType" field.
-func (m *ChatRecordMutation) AddMsgType(i int) {
-	if m.addmsgType != nil {
-		*m.addmsgType += i
-	} else {
-		m.addmsgType = &i
-	}
+// ResetCreateTime resets all changes to the "createTime" field.
+func (m *BlockMutation) ResetCreateTime() {
+	m.createTime = nil
 }
 
-// AddedMsgType returns the value that was added to the "msgType" field in this mutation.
-func (m *ChatRecordMutation) AddedMsgType() (r int, exists bool) {
-	v := m.addmsgType
-	if v == nil {
-		return
+// Where appends a list predicates to the BlockMutation builder.
+func (m *BlockMutation) Where(ps ...predicate.Block) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BlockMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BlockMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Block, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// ResetMsgType resets all changes to the "msgType" field.
-func (m *ChatRecordMutation) ResetMsgType() {
-	m.msgType = nil
-	m.addmsgType = nil
+// Op returns the operation name.
+func (m *BlockMutation) Op() Op {
+	return m.op
 }
 
-// SetIsGroup sets the "isGroup" field.
-func (m *ChatRecordMutation) SetIsGroup(b bool) {
-	m.isGroup = &b
+// SetOp allows setting the mutation operation.
+func (m *BlockMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// IsGroup returns the value of the "isGroup" field in the mutation.
-func (m *ChatRecordMutation) IsGroup() (r bool, exists bool) {
-	v := m.isGroup
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (Block).
+func (m *BlockMutation) Type() string {
+	return m.typ
 }
 
-// OldIsGroup returns the old "isGroup" field's value of the ChatRecord entity.
-// If the ChatRecord object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChatRecordMutation) OldIsGroup(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsGroup is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BlockMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.userId != nil {
+		fields = append(fields, block.FieldUserId)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsGroup requires an ID field in the mutation")
+	if m.blockedUserId != nil {
+		fields = append(fields, block.FieldBlockedUserId)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsGroup: %w", err)
+	if m.createTime != nil {
+		fields = append(fields, block.FieldCreateTime)
 	}
-	return oldValue.IsGroup, nil
+	return fields
 }
 
-// ResetIsGroup resets all changes to the "isGroup" field.
-func (m *ChatRecordMutation) ResetIsGroup() {
-	m.isGroup = nil
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BlockMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case block.FieldUserId:
+		return m.UserId()
+	case block.FieldBlockedUserId:
+		return m.BlockedUserId()
+	case block.FieldCreateTime:
+		return m.CreateTime()
+	}
+	return nil, false
 }
 
-// SetGroupId sets the "groupId" field.
-func (m *ChatRecordMutation) SetGroupId(i int) {
-	m.groupId = &i
-	m.addgroupId = nil
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BlockMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case block.FieldUserId:
+		return m.OldUserId(ctx)
+	case block.FieldBlockedUserId:
+		return m.OldBlockedUserId(ctx)
+	case block.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	}
+	return nil, fmt.Errorf("unknown Block field %s", name)
 }
 
-// GroupId returns the value of the "groupId" field in the mutation.
-func (m *ChatRecordMutation) GroupId() (r int, exists bool) {
-	v := m.groupId
-	if v == nil {
-		return
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BlockMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case block.FieldUserId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserId(v)
+		return nil
+	case block.FieldBlockedUserId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlockedUserId(v)
+		return nil
+	case block.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Block field %s", name)
 }
 
-// OldGroupId returns the old "groupId" field's value of the ChatRecord entity.
-// If the ChatRecord object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChatRecordMutation) OldGroupId(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGroupId is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGroupId requires an ID field in the mutation")
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BlockMutation) AddedFields() []string {
+	var fields []string
+	if m.adduserId != nil {
+		fields = append(fields, block.FieldUserId)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGroupId: %w", err)
+	if m.addblockedUserId != nil {
+		fields = append(fields, block.FieldBlockedUserId)
 	}
-	return oldValue.GroupId, nil
+	return fields
 }
 
-// AddGroupId adds i to the "groupId" field.
-func (m *ChatRecordMutation) AddGroupId(i int) {
-	if m.addgroupId != nil {
-		*m.addgroupId += i
-	} else {
-		m.addgroupId = &i
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BlockMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case block.FieldUserId:
+		return m.AddedUserId()
+	case block.FieldBlockedUserId:
+		return m.AddedBlockedUserId()
 	}
+	return nil, false
 }
 
-// AddedGroupId returns the value that was added to the "groupId" field in this mutation.
-func (m *ChatRecordMutation) AddedGroupId() (r int, exists bool) {
-	v := m.addgroupId
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BlockMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case block.FieldUserId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserId(v)
+		return nil
+	case block.FieldBlockedUserId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBlockedUserId(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Block numeric field %s", name)
 }
 
-// ClearGroupId clears the value of the "groupId" field.
-func (m *ChatRecordMutation) ClearGroupId() {
-	m.groupId = nil
-	m.addgroupId = nil
-	m.clearedFields[chatrecord.FieldGroupId] = struct{}{}
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BlockMutation) ClearedFields() []string {
+	return nil
 }
 
-// GroupIdCleared returns if the "groupId" field was cleared in this mutation.
-func (m *ChatRecordMutation) GroupIdCleared() bool {
-	_, ok := m.clearedFields[chatrecord.FieldGroupId]
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BlockMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetGroupId resets all changes to the "groupId" field.
-func (m *ChatRecordMutation) ResetGroupId() {
-	m.groupId = nil
-	m.addgroupId = nil
-	delete(m.clearedFields, chatrecord.FieldGroupId)
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BlockMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Block nullable field %s", name)
 }
 
-// SetCreateTime sets the "createTime" field.
-func (m *ChatRecordMutation) SetCreateTime(t time.Time) {
-	m.createTime = &t
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BlockMutation) ResetField(name string) error {
+	switch name {
+	case block.FieldUserId:
+		m.ResetUserId()
+		return nil
+	case block.FieldBlockedUserId:
+		m.ResetBlockedUserId()
+		return nil
+	case block.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	}
+	return fmt.Errorf("unknown Block field %s", name)
 }
 
-// CreateTime returns the value of the "createTime" field in the mutation.
-func (m *ChatRecordMutation) CreateTime() (r time.Time, exists bool) {
-	v := m.createTime
-	if v == nil {
-		return
-	}
-	return *v, true
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BlockMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// OldCreateTime returns the old "createTime" field's value of the ChatRecord entity.
-// If the ChatRecord object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChatRecordMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreateTime requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
-	}
-	return oldValue.CreateTime, nil
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BlockMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ResetCreateTime resets all changes to the "createTime" field.
-func (m *ChatRecordMutation) ResetCreateTime() {
-	m.createTime = nil
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BlockMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// Where appends a list predicates to the ChatRecordMutation builder.
-func (m *ChatRecordMutation) Where(ps ...predicate.ChatRecord) {
-	m.predicates = append(m.predicates, ps...)
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BlockMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// WhereP appends storage-level predicates to the ChatRecordMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ChatRecordMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ChatRecord, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BlockMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// Op returns the operation name.
-func (m *ChatRecordMutation) Op() Op {
-	return m.op
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BlockMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// SetOp allows setting the mutation operation.
-func (m *ChatRecordMutation) SetOp(op Op) {
-	m.op = op
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BlockMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Block unique edge %s", name)
 }
 
-// Type returns the node type of this mutation (ChatRecord).
-func (m *ChatRecordMutation) Type() string {
-	return m.typ
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BlockMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Block edge %s", name)
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *ChatRecordMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.msgId != nil {
-		fields = append(fields, chatrecord.FieldMsgId)
-	}
-	if m.fromUserId != nil {
-		fields = append(fields, chatrecord.FieldFromUserId)
-	}
-	if m.toUserId != nil {
-		fields = append(fields, chatrecord.FieldToUserId)
-	}
-	if m.msgType != nil {
-		fields = append(fields, chatrecord.FieldMsgType)
+// ChatRecordMutation represents an operation that mutates the ChatRecord nodes in the graph.
+type ChatRecordMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	msgId          *string
+	fromUserId     *int
+	addfromUserId  *int
+	toUserId       *int
+	addtoUserId    *int
+	msgType        *int
+	addmsgType     *int
+	isGroup        *bool
+	groupId        *int
+	addgroupId     *int
+	conversationId *string
+	createTime     *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*ChatRecord, error)
+	predicates     []predicate.ChatRecord
+}
+
+var _ ent.Mutation = (*ChatRecordMutation)(nil)
+
+// chatrecordOption allows management of the mutation configuration using functional options.
+type chatrecordOption func(*ChatRecordMutation)
+
+// newChatRecordMutation creates new mutation for the ChatRecord entity.
+func newChatRecordMutation(c config, op Op, opts ...chatrecordOption) *ChatRecordMutation {
+	m := &ChatRecordMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeChatRecord,
+		clearedFields: make(map[string]struct{}),
 	}
-	if m.isGroup != nil {
-		fields = append(fields, chatrecord.FieldIsGroup)
+	for _, opt := range opts {
+		opt(m)
 	}
-	if m.groupId != nil {
-		fields = append(fields, chatrecord.FieldGroupId)
+	return m
+}
+
+// withChatRecordID sets the ID field of the mutation.
+func withChatRecordID(id int) chatrecordOption {
+	return func(m *ChatRecordMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ChatRecord
+		)
+		m.oldValue = func(ctx context.Context) (*ChatRecord, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ChatRecord.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	if m.createTime != nil {
-		fields = append(fields, chatrecord.FieldCreateTime)
+}
+
+// withChatRecord sets the old ChatRecord of the mutation.
+func withChatRecord(node *ChatRecord) chatrecordOption {
+	return func(m *ChatRecordMutation) {
+		m.oldValue = func(context.Context) (*ChatRecord, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return fields
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *ChatRecordMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case chatrecord.FieldMsgId:
-		return m.MsgId()
-	case chatrecord.FieldFromUserId:
-		return m.FromUserId()
-	case chatrecord.FieldToUserId:
-		return m.ToUserId()
-	case chatrecord.FieldMsgType:
-		return m.MsgType()
-	case chatrecord.FieldIsGroup:
-		return m.IsGroup()
-	case chatrecord.FieldGroupId:
-		return m.GroupId()
-	case chatrecord.FieldCreateTime:
-		return m.CreateTime()
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ChatRecordMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ChatRecordMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return nil, false
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *ChatRecordMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case chatrecord.FieldMsgId:
-		return m.OldMsgId(ctx)
-	case chatrecord.FieldFromUserId:
-		return m.OldFromUserId(ctx)
-	case chatrecord.FieldToUserId:
-		return m.OldToUserId(ctx)
-	case chatrecord.FieldMsgType:
-		return m.OldMsgType(ctx)
-	case chatrecord.FieldIsGroup:
-		return m.OldIsGroup(ctx)
-	case chatrecord.FieldGroupId:
-		return m.OldGroupId(ctx)
-	case chatrecord.FieldCreateTime:
-		return m.OldCreateTime(ctx)
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ChatRecordMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown ChatRecord field %s", name)
+	return *m.id, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ChatRecordMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case chatrecord.FieldMsgId:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMsgId(v)
-		return nil
-	case chatrecord.FieldFromUserId:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFromUserId(v)
-		return nil
-	case chatrecord.FieldToUserId:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetToUserId(v)
-		return nil
-	case chatrecord.FieldMsgType:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMsgType(v)
-		return nil
-	case chatrecord.FieldIsGroup:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsGroup(v)
-		return nil
-	case chatrecord.FieldGroupId:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetGroupId(v)
-		return nil
-	case chatrecord.FieldCreateTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ChatRecordMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
 		}
-		m.SetCreateTime(v)
-		return nil
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ChatRecord.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return fmt.Errorf("unknown ChatRecord field %s", name)
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *ChatRecordMutation) AddedFields() []string {
-	var fields []string
-	if m.addfromUserId != nil {
-		fields = append(fields, chatrecord.FieldFromUserId)
-	}
-	if m.addtoUserId != nil {
-		fields = append(fields, chatrecord.FieldToUserId)
-	}
-	if m.addmsgType != nil {
-		fields = append(fields, chatrecord.FieldMsgType)
-	}
-	if m.addgroupId != nil {
-		fields = append(fields, chatrecord.FieldGroupId)
-	}
-	return fields
+// SetMsgId sets the "msgId" field.
+func (m *ChatRecordMutation) SetMsgId(s string) {
+	m.msgId = &s
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *ChatRecordMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case chatrecord.FieldFromUserId:
-		return m.AddedFromUserId()
-	case chatrecord.FieldToUserId:
-		return m.AddedToUserId()
-	case chatrecord.FieldMsgType:
-		return m.AddedMsgType()
-	case chatrecord.FieldGroupId:
-		return m.AddedGroupId()
+// MsgId returns the value of the "msgId" field in the mutation.
+func (m *ChatRecordMutation) MsgId() (r string, exists bool) {
+	v := m.msgId
+	if v == nil {
+		return
 	}
-	return nil, false
+	return *v, true
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ChatRecordMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case chatrecord.FieldFromUserId:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddFromUserId(v)
-		return nil
-	case chatrecord.FieldToUserId:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddToUserId(v)
-		return nil
-	case chatrecord.FieldMsgType:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddMsgType(v)
-		return nil
-	case chatrecord.FieldGroupId:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddGroupId(v)
-		return nil
+// OldMsgId returns the old "msgId" field's value of the ChatRecord entity.
+// If the ChatRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatRecordMutation) OldMsgId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMsgId is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown ChatRecord numeric field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMsgId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMsgId: %w", err)
+	}
+	return oldValue.MsgId, nil
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *ChatRecordMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(chatrecord.FieldGroupId) {
-		fields = append(fields, chatrecord.FieldGroupId)
-	}
-	return fields
+// ResetMsgId resets all changes to the "msgId" field.
+func (m *ChatRecordMutation) ResetMsgId() {
+	m.msgId = nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *ChatRecordMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// SetFromUserId sets the "fromUserId" field.
+func (m *ChatRecordMutation) SetFromUserId(i int) {
+	m.fromUserId = &i
+	m.addfromUserId = nil
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *ChatRecordMutation) ClearField(name string) error {
-	switch name {
-	case chatrecord.FieldGroupId:
-		m.ClearGroupId()
-		return nil
+// FromUserId returns the value of the "fromUserId" field in the mutation.
+func (m *ChatRecordMutation) FromUserId() (r int, exists bool) {
+	v := m.fromUserId
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown ChatRecord nullable field %s", name)
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *ChatRecordMutation) ResetField(name string) error {
-	switch name {
-	case chatrecord.FieldMsgId:
-		m.ResetMsgId()
-		return nil
-	case chatrecord.FieldFromUserId:
-		m.ResetFromUserId()
-		return nil
-	case chatrecord.FieldToUserId:
-		m.ResetToUserId()
-		return nil
-	case chatrecord.FieldMsgType:
-		m.ResetMsgType()
-		return nil
-	case chatrecord.FieldIsGroup:
-		m.ResetIsGroup()
-		return nil
-	case chatrecord.FieldGroupId:
-		m.ResetGroupId()
-		return nil
-	case chatrecord.FieldCreateTime:
-		m.ResetCreateTime()
-		return nil
-	}
-	return fmt.Errorf("unknown ChatRecord field %s", name)
-}
-
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ChatRecordMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
-}
-
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *ChatRecordMutation) AddedIDs(name string) []ent.Value {
-	return nil
-}
-
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ChatRecordMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
-}
-
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *ChatRecordMutation) RemovedIDs(name string) []ent.Value {
-	return nil
-}
-
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ChatRecordMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
-}
-
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *ChatRecordMutation) EdgeCleared(name string) bool {
-	return false
-}
-
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *ChatRecordMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown ChatRecord unique edge %s", name)
-}
-
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *ChatRecordMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown ChatRecord edge %s", name)
-}
-
-// DoNotDisturbMutation represents an operation that mutates the DoNotDisturb nodes in the graph.
-type DoNotDisturbMutation struct {
-	config
-	op                 Op
-	typ                string
-	id                 *int
-	user_id            *int
-	adduser_id         *int
-	target_user_id     *int
-	addtarget_user_id  *int
-	target_group_id    *int
-	addtarget_group_id *int
-	is_global          *bool
-	start_time         *time.Time
-	end_time           *time.Time
-	created_at         *time.Time
-	updated_at         *time.Time
-	clearedFields      map[string]struct{}
-	done               bool
-	oldValue           func(context.Context) (*DoNotDisturb, error)
-	predicates         []predicate.DoNotDisturb
-}
-
-var _ ent.Mutation = (*DoNotDisturbMutation)(nil)
-
-// donotdisturbOption allows management of the mutation configuration using functional options.
-type donotdisturbOption func(*DoNotDisturbMutation)
-
-// newDoNotDisturbMutation creates new mutation for the DoNotDisturb entity.
-func newDoNotDisturbMutation(c config, op Op, opts ...donotdisturbOption) *DoNotDisturbMutation {
-	m := &DoNotDisturbMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeDoNotDisturb,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
+// OldFromUserId returns the old "fromUserId" field's value of the ChatRecord entity.
+// If the ChatRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatRecordMutation) OldFromUserId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFromUserId is only allowed on UpdateOne operations")
 	}
-	return m
-}
-
-// withDoNotDisturbID sets the ID field of the mutation.
-func withDoNotDisturbID(id int) donotdisturbOption {
-	return func(m *DoNotDisturbMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *DoNotDisturb
-		)
-		m.oldValue = func(ctx context.Context) (*DoNotDisturb, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().DoNotDisturb.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFromUserId requires an ID field in the mutation")
 	}
-}
-
-// withDoNotDisturb sets the old DoNotDisturb of the mutation.
-func withDoNotDisturb(node *DoNotDisturb) donotdisturbOption {
-	return func(m *DoNotDisturbMutation) {
-		m.oldValue = func(context.Context) (*DoNotDisturb, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFromUserId: %w", err)
 	}
+	return oldValue.FromUserId, nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DoNotDisturbMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
-}
-
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m DoNotDisturbMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// AddFromUserId adds i to the "fromUserId" field.
+func (m *ChatRecordMutation) AddFromUserId(i int) {
+	if m.addfromUserId != nil {
+		*m.addfromUserId += i
+	} else {
+		m.addfromUserId = &i
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
-}
-
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of DoNotDisturb entities.
-func (m *DoNotDisturbMutation) SetID(id int) {
-	m.id = &id
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *DoNotDisturbMutation) ID() (id int, exists bool) {
-	if m.id == nil {
+// AddedFromUserId returns the value that was added to the "fromUserId" field in this mutation.
+func (m *ChatRecordMutation) AddedFromUserId() (r int, exists bool) {
+	v := m.addfromUserId
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *DoNotDisturbMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DoNotDisturb.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetFromUserId resets all changes to the "fromUserId" field.
+func (m *ChatRecordMutation) ResetFromUserId() {
+	m.fromUserId = nil
+	m.addfromUserId = nil
 }
 
-// SetUserID sets the "user_id" field.
-func (m *DoNotDisturbMutation) SetUserID(i int) {
-	m.user_id = &i
-	m.adduser_id = nil
+// SetToUserId sets the "toUserId" field.
+func (m *ChatRecordMutation) SetToUserId(i int) {
+	m.toUserId = &i
+	m.addtoUserId = nil
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *DoNotDisturbMutation) UserID() (r int, exists bool) {
-	v := m.user_id
+// ToUserId returns the value of the "toUserId" field in the mutation.
+func (m *ChatRecordMutation) ToUserId() (r int, exists bool) {
+	v := m.toUserId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the DoNotDisturb entity.
-// If the DoNotDisturb object wasn't provided to the builder, the object is fetched from the database.
+// OldToUserId returns the old "toUserId" field's value of the ChatRecord entity.
+// If the ChatRecord object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DoNotDisturbMutation) OldUserID(ctx context.Context) (v int, err error) {
+func (m *ChatRecordMutation) OldToUserId(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldToUserId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldToUserId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldToUserId: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.ToUserId, nil
 }
 
-// AddUserID adds i to the "user_id" field.
-func (m *DoNotDisturbMutation) AddUserID(i int) {
-	if m.adduser_id != nil {
-		*m.adduser_id += i
+// AddToUserId adds i to the "toUserId" field.
+func (m *ChatRecordMutation) AddToUserId(i int) {
+	if m.addtoUserId != nil {
+		*m.addtoUserId += i
 	} else {
-		m.adduser_id = &i
+		m.addtoUserId = &i
 	}
 }
 
-// AddedUserID returns the value that was added to the "user_id" field in this mutation.
-func (m *DoNotDisturbMutation) AddedUserID() (r int, exists bool) {
-	v := m.adduser_id
+// AddedToUserId returns the value that was added to the "toUserId" field in this mutation.
+func (m *ChatRecordMutation) AddedToUserId() (r int, exists bool) {
+	v := m.addtoUserId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *DoNotDisturbMutation) ResetUserID() {
-	m.user_id = nil
-	m.adduser_id = nil
-}
-
-// SetTargetUserID sets the "target_user_id" field.
-func (m *DoNotDisturbMutation) SetTargetUserID(i int) {
-	m.target_user_id = &i
-	m.addtarget_user_id = nil
+// ResetToUserId resets all changes to the "toUserId" field.
+func (m *ChatRecordMutation) ResetToUserId() {
+	m.toUserId = nil
+	m.addtoUserId = nil
 }
 
-// TargetUserID returns the value of the "target_user_id" field in the mutation.
-func (m *DoNotDisturbMutation) TargetUserID() (r int, exists bool) {
-	v := m.target_user_id
+// SetMsgType sets the "msgType" field.
+func (m *ChatRecordMutation) SetMsgType(i int) {
+	m.msgType = &i
+	m.addmsgType = nil
+}
+
+// MsgType returns the value of the "msgType" field in the mutation.
+func (m *ChatRecordMutation) MsgType() (r int, exists bool) {
+	v := m.msgType
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTargetUserID returns the old "target_user_id" field's value of the DoNotDisturb entity.
-// If the DoNotDisturb object wasn't provided to the builder, the object is fetched from the database.
+// OldMsgType returns the old "msgType" field's value of the ChatRecord entity.
+// If the ChatRecord object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DoNotDisturbMutation) OldTargetUserID(ctx context.Context) (v *int, err error) {
+func (m *ChatRecordMutation) OldMsgType(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTargetUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldMsgType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTargetUserID requires an ID field in the mutation")
+		return v, errors.New("OldMsgType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTargetUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldMsgType: %w", err)
 	}
-	return oldValue.TargetUserID, nil
+	return oldValue.MsgType, nil
 }
 
-// AddTargetUserID adds i to the "target_user_id" field.
-func (m *DoNotDisturbMutation) AddTargetUserID(i int) {
-	if m.addtarget_user_id != nil {
-		*m.addtarget_user_id += i
+// AddMsgType adds i to the "msgType" field.
+func (m *ChatRecordMutation) AddMsgType(i int) {
+	if m.addmsgType != nil {
+		*m.addmsgType += i
 	} else {
-		m.addtarget_user_id = &i
+		m.addmsgType = &i
 	}
 }
 
-// AddedTargetUserID returns the value that was added to the "target_user_id" field in this mutation.
-func (m *DoNotDisturbMutation) AddedTargetUserID() (r int, exists bool) {
-	v := m.addtarget_user_id
+// AddedMsgType returns the value that was added to the "msgType" field in this mutation.
+func (m *ChatRecordMutation) AddedMsgType() (r int, exists bool) {
+	v := m.addmsgType
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearTargetUserID clears the value of the "target_user_id" field.
-func (m *DoNotDisturbMutation) ClearTargetUserID() {
-	m.target_user_id = nil
-	m.addtarget_user_id = nil
-	m.clearedFields[donotdisturb.FieldTargetUserID] = struct{}{}
+// ResetMsgType resets all changes to the "msgType" field.
+func (m *ChatRecordMutation) ResetMsgType() {
+	m.msgType = nil
+	m.addmsgType = nil
 }
 
-// TargetUserIDCleared returns if the "target_user_id" field was cleared in this mutation.
-func (m *DoNotDisturbMutation) TargetUserIDCleared() bool {
-	_, ok := m.clearedFields[donotdisturb.FieldTargetUserID]
-	return ok
+// SetIsGroup sets the "isGroup" field.
+func (m *ChatRecordMutation) SetIsGroup(b bool) {
+	m.isGroup = &b
 }
 
-// ResetTargetUserID resets all changes to the "target_user_id" field.
-func (m *DoNotDisturbMutation) ResetTargetUserID() {
-	m.target_user_id = nil
-	m.addtarget_user_id = nil
-	delete(m.clearedFields, donotdisturb.FieldTargetUserID)
+// IsGroup returns the value of the "isGroup" field in the mutation.
+func (m *ChatRecordMutation) IsGroup() (r bool, exists bool) {
+	v := m.isGroup
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetTargetGroupID sets the "target_group_id" field.
-func (m *DoNotDisturbMutation) SetTargetGroupID(i int) {
-	m.target_group_id = &i
-	m.addtarget_group_id = nil
+// OldIsGroup returns the old "isGroup" field's value of the ChatRecord entity.
+// If the ChatRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatRecordMutation) OldIsGroup(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsGroup is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsGroup requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsGroup: %w", err)
+	}
+	return oldValue.IsGroup, nil
 }
 
-// TargetGroupID returns the value of the "target_group_id" field in the mutation.
-func (m *DoNotDisturbMutation) TargetGroupID() (r int, exists bool) {
-	v := m.target_group_id
+// ResetIsGroup resets all changes to the "isGroup" field.
+func (m *ChatRecordMutation) ResetIsGroup() {
+	m.isGroup = nil
+}
+
+// SetGroupId sets the "groupId" field.
+func (m *ChatRecordMutation) SetGroupId(i int) {
+	m.groupId = &i
+	m.addgroupId = nil
+}
+
+// GroupId returns the value of the "groupId" field in the mutation.
+func (m *ChatRecordMutation) GroupId() (r int, exists bool) {
+	v := m.groupId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTargetGroupID returns the old "target_group_id" field's value of the DoNotDisturb entity.
-// If the DoNotDisturb object wasn't provided to the builder, the object is fetched from the database.
+// OldGroupId returns the old "groupId" field's value of the ChatRecord entity.
+// If the ChatRecord object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DoNotDisturbMutation) OldTargetGroupID(ctx context.Context) (v *int, err error) {
+func (m *ChatRecordMutation) OldGroupId(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTargetGroupID is only allowed on UpdateOne operations")
+		return v, errors.New("OldGroupId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTargetGroupID requires an ID field in the mutation")
+		return v, errors.New("OldGroupId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTargetGroupID: %w", err)
+		return v, fmt.Errorf("querying old value for OldGroupId: %w", err)
 	}
-	return oldValue.TargetGroupID, nil
+	return oldValue.GroupId, nil
 }
 
-// AddTargetGroupID adds i to the "target_group_id" field.
-func (m *DoNotDisturbMutation) AddTargetGroupID(i int) {
-	if m.addtarget_group_id != nil {
-		*m.addtarget_group_id += i
+// AddGroupId adds i to the "groupId" field.
+func (m *ChatRecordMutation) AddGroupId(i int) {
+	if m.addgroupId != nil {
+		*m.addgroupId += i
 	} else {
-		m.addtarget_group_id = &i
+		m.addgroupId = &i
 	}
 }
 
-// AddedTargetGroupID returns the value that was added to the "target_group_id" field in this mutation.
-func (m *DoNotDisturbMutation) AddedTargetGroupID() (r int, exists bool) {
-	v := m.addtarget_group_id
+// AddedGroupId returns the value that was added to the "groupId" field in this mutation.
+func (m *ChatRecordMutation) AddedGroupId() (r int, exists bool) {
+	v := m.addgroupId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearTargetGroupID clears the value of the "target_group_id" field.
-func (m *DoNotDisturbMutation) ClearTargetGroupID() {
-	m.target_group_id = nil
-	m.addtarget_group_id = nil
-	m.clearedFields[donotdisturb.FieldTargetGroupID] = struct{}{}
+// ClearGroupId clears the value of the "groupId" field.
+func (m *ChatRecordMutation) ClearGroupId() {
+	m.groupId = nil
+	m.addgroupId = nil
+	m.clearedFields[chatrecord.FieldGroupId] = struct{}{}
 }
 
-// TargetGroupIDCleared returns if the "target_group_id" field was cleared in this mutation.
-func (m *DoNotDisturbMutation) TargetGroupIDCleared() bool {
-	_, ok := m.clearedFields[donotdisturb.FieldTargetGroupID]
+// GroupIdCleared returns if the "groupId" field was cleared in this mutation.
+func (m *ChatRecordMutation) GroupIdCleared() bool {
+	_, ok := m.clearedFields[chatrecord.FieldGroupId]
 	return ok
 }
 
-// ResetTargetGroupID resets all changes to the "target_group_id" field.
-func (m *DoNotDisturbMutation) ResetTargetGroupID() {
-	m.target_group_id = nil
-	m.addtarget_group_id = nil
-	delete(m.clearedFields, donotdisturb.FieldTargetGroupID)
+// ResetGroupId resets all changes to the "groupId" field.
+func (m *ChatRecordMutation) ResetGroupId() {
+	m.groupId = nil
+	m.addgroupId = nil
+	delete(m.clearedFields, chatrecord.FieldGroupId)
 }
 
-// SetIsGlobal sets the "is_global" field.
-func (m *DoNotDisturbMutation) SetIsGlobal(b bool) {
-	m.is_global = &b
+// SetConversationId sets the "conversationId" field.
+func (m *ChatRecordMutation) SetConversationId(s string) {
+	m.conversationId = &s
 }
 
-// IsGlobal returns the value of the "is_global" field in the mutation.
-func (m *DoNotDisturbMutation) IsGlobal() (r bool, exists bool) {
-	v := m.is_global
+// ConversationId returns the value of the "conversationId" field in the mutation.
+func (m *ChatRecordMutation) ConversationId() (r string, exists bool) {
+	v := m.conversationId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsGlobal returns the old "is_global" field's value of the DoNotDisturb entity.
-// If the DoNotDisturb object wasn't provided to the builder, the object is fetched from the database.
+// OldConversationId returns the old "conversationId" field's value of the ChatRecord entity.
+// If the ChatRecord object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DoNotDisturbMutation) OldIsGlobal(ctx context.Context) (v bool, err error) {
+func (m *ChatRecordMutation) OldConversationId(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsGlobal is only allowed on UpdateOne operations")
+		return v, errors.New("OldConversationId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsGlobal requires an ID field in the mutation")
+		return v, errors.New("OldConversationId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsGlobal: %w", err)
+		return v, fmt.Errorf("querying old value for OldConversationId: %w", err)
 	}
-	return oldValue.IsGlobal, nil
+	return oldValue.ConversationId, nil
 }
 
-// ResetIsGlobal resets all changes to the "is_global" field.
-func (m *DoNotDisturbMutation) ResetIsGlobal() {
-	m.is_global = nil
+// ClearConversationId clears the value of the "conversationId" field.
+func (m *ChatRecordMutation) ClearConversationId() {
+	m.conversationId = nil
+	m.clearedFields[chatrecord.FieldConversationId] = struct{}{}
 }
 
-// SetStartTime sets the "start_time" field.
-func (m *DoNotDisturbMutation) SetStartTime(t time.Time) {
-	m.start_time = &t
+// ConversationIdCleared returns if the "conversationId" field was cleared in this mutation.
+func (m *ChatRecordMutation) ConversationIdCleared() bool {
+	_, ok := m.clearedFields[chatrecord.FieldConversationId]
+	return ok
 }
 
-// StartTime returns the value of the "start_time" field in the mutation.
-func (m *DoNotDisturbMutation) StartTime() (r time.Time, exists bool) {
-	v := m.start_time
+// ResetConversationId resets all changes to the "conversationId" field.
+func (m *ChatRecordMutation) ResetConversationId() {
+	m.conversationId = nil
+	delete(m.clearedFields, chatrecord.FieldConversationId)
+}
+
+// SetCreateTime sets the "createTime" field.
+func (m *ChatRecordMutation) SetCreateTime(t time.Time) {
+	m.createTime = &t
+}
+
+// CreateTime returns the value of the "createTime" field in the mutation.
+func (m *ChatRecordMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.createTime
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStartTime returns the old "start_time" field's value of the DoNotDisturb entity.
-// If the DoNotDisturb object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "createTime" field's value of the ChatRecord entity.
+// If the ChatRecord object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DoNotDisturbMutation) OldStartTime(ctx context.Context) (v *time.Time, err error) {
+func (m *ChatRecordMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartTime requires an ID field in the mutation")
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
 	}
-	return oldValue.StartTime, nil
+	return oldValue.CreateTime, nil
 }
 
-// ClearStartTime clears the value of the "start_time" field.
-func (m *DoNotDisturbMutation) ClearStartTime() {
-	m.start_time = nil
-	m.clearedFields[donotdisturb.FieldStartTime] = struct{}{}
+// ResetCreateTime resets all changes to the "createTime" field.
+func (m *ChatRecordMutation) ResetCreateTime() {
+	m.createTime = nil
 }
 
-// StartTimeCleared returns if the "start_time" field was cleared in this mutation.
-func (m *DoNotDisturbMutation) StartTimeCleared() bool {
-	_, ok := m.clearedFields[donotdisturb.FieldStartTime]
-	return ok
+// Where appends a list predicates to the ChatRecordMutation builder.
+func (m *ChatRecordMutation) Where(ps ...predicate.ChatRecord) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ResetStartTime resets all changes to the "start_time" field.
-func (m *DoNotDisturbMutation) ResetStartTime() {
-	m.start_time = nil
-	delete(m.clearedFields, donotdisturb.FieldStartTime)
+// WhereP appends storage-level predicates to the ChatRecordMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ChatRecordMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ChatRecord, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// SetEndTime sets the "end_time" field.
-func (m *DoNotDisturbMutation) SetEndTime(t time.Time) {
-	m.end_time = &t
-}
-
-// EndTime returns the value of the "end_time" field in the mutation.
-func (m *DoNotDisturbMutation) EndTime() (r time.Time, exists bool) {
-	v := m.end_time
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldEndTime returns the old "end_time" field's value of the DoNotDisturb entity.
-// If the DoNotDisturb object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DoNotDisturbMutation) OldEndTime(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEndTime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEndTime requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEndTime: %w", err)
-	}
-	return oldValue.EndTime, nil
-}
-
-// ClearEndTime clears the value of the "end_time" field.
-func (m *DoNotDisturbMutation) ClearEndTime() {
-	m.end_time = nil
-	m.clearedFields[donotdisturb.FieldEndTime] = struct{}{}
-}
-
-// EndTimeCleared returns if the "end_time" field was cleared in this mutation.
-func (m *DoNotDisturbMutation) EndTimeCleared() bool {
-	_, ok := m.clearedFields[donotdisturb.FieldEndTime]
-	return ok
-}
-
-// ResetEndTime resets all changes to the "end_time" field.
-func (m *DoNotDisturbMutation) ResetEndTime() {
-	m.end_time = nil
-	delete(m.clearedFields, donotdisturb.FieldEndTime)
-}
-
-// SetCreatedAt sets the "created_at" field.
-func (m *DoNotDisturbMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
-}
-
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *DoNotDisturbMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldCreatedAt returns the old "created_at" field's value of the DoNotDisturb entity.
-// If the DoNotDisturb object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DoNotDisturbMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
-	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *DoNotDisturbMutation) ResetCreatedAt() {
-	m.created_at = nil
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (m *DoNotDisturbMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
-}
-
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *DoNotDisturbMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldUpdatedAt returns the old "updated_at" field's value of the DoNotDisturb entity.
-// If the DoNotDisturb object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DoNotDisturbMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *DoNotDisturbMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// Where appends a list predicates to the DoNotDisturbMutation builder.
-func (m *DoNotDisturbMutation) Where(ps ...predicate.DoNotDisturb) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the DoNotDisturbMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DoNotDisturbMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.DoNotDisturb, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *DoNotDisturbMutation) Op() Op {
-	return m.op
+// Op returns the operation name.
+func (m *ChatRecordMutation) Op() Op {
+	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DoNotDisturbMutation) SetOp(op Op) {
+func (m *ChatRecordMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (DoNotDisturb).
-func (m *DoNotDisturbMutation) Type() string {
+// Type returns the node type of this mutation (ChatRecord).
+func (m *ChatRecordMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DoNotDisturbMutation) Fields() []string {
+func (m *ChatRecordMutation) Fields() []string {
 	fields := make([]string, 0, 8)
-	if m.user_id != nil {
-		fields = append(fields, donotdisturb.FieldUserID)
+	if m.msgId != nil {
+		fields = append(fields, chatrecord.FieldMsgId)
 	}
-	if m.target_user_id != nil {
-		fields = append(fields, donotdisturb.FieldTargetUserID)
+	if m.fromUserId != nil {
+		fields = append(fields, chatrecord.FieldFromUserId)
 	}
-	if m.target_group_id != nil {
-		fields = append(fields, donotdisturb.FieldTargetGroupID)
+	if m.toUserId != nil {
+		fields = append(fields, chatrecord.FieldToUserId)
 	}
-	if m.is_global != nil {
-		fields = append(fields, donotdisturb.FieldIsGlobal)
+	if m.msgType != nil {
+		fields = append(fields, chatrecord.FieldMsgType)
 	}
-	if m.start_time != nil {
-		fields = append(fields, donotdisturb.FieldStartTime)
+	if m.isGroup != nil {
+		fields = append(fields, chatrecord.FieldIsGroup)
 	}
-	if m.end_time != nil {
-		fields = append(fields, donotdisturb.FieldEndTime)
+	if m.groupId != nil {
+		fields = append(fields, chatrecord.FieldGroupId)
 	}
-	if m.created_at != nil {
-		fields = append(fields, donotdisturb.FieldCreatedAt)
+	if m.conversationId != nil {
+		fields = append(fields, chatrecord.FieldConversationId)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, donotdisturb.FieldUpdatedAt)
+	if m.createTime != nil {
+		fields = append(fields, chatrecord.FieldCreateTime)
 	}
 	return fields
 }
@@ -1451,24 +1170,24 @@ func (m *DoNotDisturbMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DoNotDisturbMutation) Field(name string) (ent.Value, bool) {
+func (m *ChatRecordMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case donotdisturb.FieldUserID:
-		return m.UserID()
-	case donotdisturb.FieldTargetUserID:
-		return m.TargetUserID()
-	case donotdisturb.FieldTargetGroupID:
-		return m.TargetGroupID()
-	case donotdisturb.FieldIsGlobal:
-		return m.IsGlobal()
-	case donotdisturb.FieldStartTime:
-		return m.StartTime()
-	case donotdisturb.FieldEndTime:
-		return m.EndTime()
-	case donotdisturb.FieldCreatedAt:
-		return m.CreatedAt()
-	case donotdisturb.FieldUpdatedAt:
-		return m.UpdatedAt()
+	case chatrecord.FieldMsgId:
+		return m.MsgId()
+	case chatrecord.FieldFromUserId:
+		return m.FromUserId()
+	case chatrecord.FieldToUserId:
+		return m.ToUserId()
+	case chatrecord.FieldMsgType:
+		return m.MsgType()
+	case chatrecord.FieldIsGroup:
+		return m.IsGroup()
+	case chatrecord.FieldGroupId:
+		return m.GroupId()
+	case chatrecord.FieldConversationId:
+		return m.ConversationId()
+	case chatrecord.FieldCreateTime:
+		return m.CreateTime()
 	}
 	return nil, false
 }
@@ -1476,105 +1195,108 @@ func (m *DoNotDisturbMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DoNotDisturbMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ChatRecordMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case donotdisturb.FieldUserID:
-		return m.OldUserID(ctx)
-	case donotdisturb.FieldTargetUserID:
-		return m.OldTargetUserID(ctx)
-	case donotdisturb.FieldTargetGroupID:
-		return m.OldTargetGroupID(ctx)
-	case donotdisturb.FieldIsGlobal:
-		return m.OldIsGlobal(ctx)
-	case donotdisturb.FieldStartTime:
-		return m.OldStartTime(ctx)
-	case donotdisturb.FieldEndTime:
-		return m.OldEndTime(ctx)
-	case donotdisturb.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case donotdisturb.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+	case chatrecord.FieldMsgId:
+		return m.OldMsgId(ctx)
+	case chatrecord.FieldFromUserId:
+		return m.OldFromUserId(ctx)
+	case chatrecord.FieldToUserId:
+		return m.OldToUserId(ctx)
+	case chatrecord.FieldMsgType:
+		return m.OldMsgType(ctx)
+	case chatrecord.FieldIsGroup:
+		return m.OldIsGroup(ctx)
+	case chatrecord.FieldGroupId:
+		return m.OldGroupId(ctx)
+	case chatrecord.FieldConversationId:
+		return m.OldConversationId(ctx)
+	case chatrecord.FieldCreateTime:
+		return m.OldCreateTime(ctx)
 	}
-	return nil, fmt.Errorf("unknown DoNotDisturb field %s", name)
+	return nil, fmt.Errorf("unknown ChatRecord field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DoNotDisturbMutation) SetField(name string, value ent.Value) error {
+func (m *ChatRecordMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case donotdisturb.FieldUserID:
-		v, ok := value.(int)
+	case chatrecord.FieldMsgId:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetMsgId(v)
 		return nil
-	case donotdisturb.FieldTargetUserID:
+	case chatrecord.FieldFromUserId:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTargetUserID(v)
+		m.SetFromUserId(v)
 		return nil
-	case donotdisturb.FieldTargetGroupID:
+	case chatrecord.FieldToUserId:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTargetGroupID(v)
+		m.SetToUserId(v)
 		return nil
-	case donotdisturb.FieldIsGlobal:
-		v, ok := value.(bool)
+	case chatrecord.FieldMsgType:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsGlobal(v)
+		m.SetMsgType(v)
 		return nil
-	case donotdisturb.FieldStartTime:
-		v, ok := value.(time.Time)
+	case chatrecord.FieldIsGroup:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStartTime(v)
+		m.SetIsGroup(v)
 		return nil
-	case donotdisturb.FieldEndTime:
-		v, ok := value.(time.Time)
+	case chatrecord.FieldGroupId:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEndTime(v)
+		m.SetGroupId(v)
 		return nil
-	case donotdisturb.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case chatrecord.FieldConversationId:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetConversationId(v)
 		return nil
-	case donotdisturb.FieldUpdatedAt:
+	case chatrecord.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCreateTime(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DoNotDisturb field %s", name)
+	return fmt.Errorf("unknown ChatRecord field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DoNotDisturbMutation) AddedFields() []string {
+func (m *ChatRecordMutation) AddedFields() []string {
 	var fields []string
-	if m.adduser_id != nil {
-		fields = append(fields, donotdisturb.FieldUserID)
+	if m.addfromUserId != nil {
+		fields = append(fields, chatrecord.FieldFromUserId)
 	}
-	if m.addtarget_user_id != nil {
-		fields = append(fields, donotdisturb.FieldTargetUserID)
+	if m.addtoUserId != nil {
+		fields = append(fields, chatrecord.FieldToUserId)
 	}
-	if m.addtarget_group_id != nil {
-		fields = append(fields, donotdisturb.FieldTargetGroupID)
+	if m.addmsgType != nil {
+		fields = append(fields, chatrecord.FieldMsgType)
+	}
+	if m.addgroupId != nil {
+		fields = append(fields, chatrecord.FieldGroupId)
 	}
 	return fields
 }
@@ -1582,14 +1304,16 @@ func (m *DoNotDisturbMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DoNotDisturbMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ChatRecordMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case donotdisturb.FieldUserID:
-		return m.AddedUserID()
-	case donotdisturb.FieldTargetUserID:
-		return m.AddedTargetUserID()
-	case donotdisturb.FieldTargetGroupID:
-		return m.AddedTargetGroupID()
+	case chatrecord.FieldFromUserId:
+		return m.AddedFromUserId()
+	case chatrecord.FieldToUserId:
+		return m.AddedToUserId()
+	case chatrecord.FieldMsgType:
+		return m.AddedMsgType()
+	case chatrecord.FieldGroupId:
+		return m.AddedGroupId()
 	}
 	return nil, false
 }
@@ -1597,190 +1321,180 @@ func (m *DoNotDisturbMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DoNotDisturbMutation) AddField(name string, value ent.Value) error {
+func (m *ChatRecordMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case donotdisturb.FieldUserID:
+	case chatrecord.FieldFromUserId:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddUserID(v)
+		m.AddFromUserId(v)
 		return nil
-	case donotdisturb.FieldTargetUserID:
+	case chatrecord.FieldToUserId:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddTargetUserID(v)
+		m.AddToUserId(v)
 		return nil
-	case donotdisturb.FieldTargetGroupID:
+	case chatrecord.FieldMsgType:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddTargetGroupID(v)
+		m.AddMsgType(v)
+		return nil
+	case chatrecord.FieldGroupId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGroupId(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DoNotDisturb numeric field %s", name)
+	return fmt.Errorf("unknown ChatRecord numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DoNotDisturbMutation) ClearedFields() []string {
+func (m *ChatRecordMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(donotdisturb.FieldTargetUserID) {
-		fields = append(fields, donotdisturb.FieldTargetUserID)
-	}
-	if m.FieldCleared(donotdisturb.FieldTargetGroupID) {
-		fields = append(fields, donotdisturb.FieldTargetGroupID)
-	}
-	if m.FieldCleared(donotdisturb.FieldStartTime) {
-		fields = append(fields, donotdisturb.FieldStartTime)
+	if m.FieldCleared(chatrecord.FieldGroupId) {
+		fields = append(fields, chatrecord.FieldGroupId)
 	}
-	if m.FieldCleared(donotdisturb.FieldEndTime) {
-		fields = append(fields, donotdisturb.FieldEndTime)
+	if m.FieldCleared(chatrecord.FieldConversationId) {
+		fields = append(fields, chatrecord.FieldConversationId)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DoNotDisturbMutation) FieldCleared(name string) bool {
+func (m *ChatRecordMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DoNotDisturbMutation) ClearField(name string) error {
+func (m *ChatRecordMutation) ClearField(name string) error {
 	switch name {
-	case donotdisturb.FieldTargetUserID:
-		m.ClearTargetUserID()
-		return nil
-	case donotdisturb.FieldTargetGroupID:
-		m.ClearTargetGroupID()
-		return nil
-	case donotdisturb.FieldStartTime:
-		m.ClearStartTime()
+	case chatrecord.FieldGroupId:
+		m.ClearGroupId()
 		return nil
-	case donotdisturb.FieldEndTime:
-		m.ClearEndTime()
+	case chatrecord.FieldConversationId:
+		m.ClearConversationId()
 		return nil
 	}
-	return fmt.Errorf("unknown DoNotDisturb nullable field %s", name)
+	return fmt.Errorf("unknown ChatRecord nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DoNotDisturbMutation) ResetField(name string) error {
+func (m *ChatRecordMutation) ResetField(name string) error {
 	switch name {
-	case donotdisturb.FieldUserID:
-		m.ResetUserID()
+	case chatrecord.FieldMsgId:
+		m.ResetMsgId()
 		return nil
-	case donotdisturb.FieldTargetUserID:
-		m.ResetTargetUserID()
+	case chatrecord.FieldFromUserId:
+		m.ResetFromUserId()
 		return nil
-	case donotdisturb.FieldTargetGroupID:
-		m.ResetTargetGroupID()
+	case chatrecord.FieldToUserId:
+		m.ResetToUserId()
 		return nil
-	case donotdisturb.FieldIsGlobal:
-		m.ResetIsGlobal()
+	case chatrecord.FieldMsgType:
+		m.ResetMsgType()
 		return nil
-	case donotdisturb.FieldStartTime:
-		m.ResetStartTime()
+	case chatrecord.FieldIsGroup:
+		m.ResetIsGroup()
 		return nil
-	case donotdisturb.FieldEndTime:
-		m.ResetEndTime()
+	case chatrecord.FieldGroupId:
+		m.ResetGroupId()
 		return nil
-	case donotdisturb.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case chatrecord.FieldConversationId:
+		m.ResetConversationId()
 		return nil
-	case donotdisturb.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case chatrecord.FieldCreateTime:
+		m.ResetCreateTime()
 		return nil
 	}
-	return fmt.Errorf("unknown DoNotDisturb field %s", name)
+	return fmt.Errorf("unknown ChatRecord field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DoNotDisturbMutation) AddedEdges() []string {
+func (m *ChatRecordMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DoNotDisturbMutation) AddedIDs(name string) []ent.Value {
+func (m *ChatRecordMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DoNotDisturbMutation) RemovedEdges() []string {
+func (m *ChatRecordMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DoNotDisturbMutation) RemovedIDs(name string) []ent.Value {
+func (m *ChatRecordMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DoNotDisturbMutation) ClearedEdges() []string {
+func (m *ChatRecordMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DoNotDisturbMutation) EdgeCleared(name string) bool {
+func (m *ChatRecordMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DoNotDisturbMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown DoNotDisturb unique edge %s", name)
+func (m *ChatRecordMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ChatRecord unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DoNotDisturbMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown DoNotDisturb edge %s", name)
+func (m *ChatRecordMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ChatRecord edge %s", name)
 }
 
-// FriendRelationshipMutation represents an operation that mutates the FriendRelationship nodes in the graph.
-type FriendRelationshipMutation struct {
+// ContactCardMessageMutation represents an operation that mutates the ContactCardMessage nodes in the graph.
+type ContactCardMessageMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
+	msgId         *string
 	userId        *int
 	adduserId     *int
-	friendId      *int
-	addfriendId   *int
-	remarkName    *string
-	category      *string
-	tags          *[]string
-	appendtags    []string
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*FriendRelationship, error)
-	predicates    []predicate.FriendRelationship
+	oldValue      func(context.Context) (*ContactCardMessage, error)
+	predicates    []predicate.ContactCardMessage
 }
 
-var _ ent.Mutation = (*FriendRelationshipMutation)(nil)
+var _ ent.Mutation = (*ContactCardMessageMutation)(nil)
 
-// friendrelationshipOption allows management of the mutation configuration using functional options.
-type friendrelationshipOption func(*FriendRelationshipMutation)
+// contactcardmessageOption allows management of the mutation configuration using functional options.
+type contactcardmessageOption func(*ContactCardMessageMutation)
 
-// newFriendRelationshipMutation creates new mutation for the FriendRelationship entity.
-func newFriendRelationshipMutation(c config, op Op, opts ...friendrelationshipOption) *FriendRelationshipMutation {
-	m := &FriendRelationshipMutation{
+// newContactCardMessageMutation creates new mutation for the ContactCardMessage entity.
+func newContactCardMessageMutation(c config, op Op, opts ...contactcardmessageOption) *ContactCardMessageMutation {
+	m := &ContactCardMessageMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeFriendRelationship,
+		typ:           TypeContactCardMessage,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1789,20 +1503,20 @@ func newFriendRelationshipMutation(c config, op Op, opts ...friendrelationshipOp
 	return m
 }
 
-// withFriendRelationshipID sets the ID field of the mutation.
-func withFriendRelationshipID(id int) friendrelationshipOption {
-	return func(m *FriendRelationshipMutation) {
+// withContactCardMessageID sets the ID field of the mutation.
+func withContactCardMessageID(id int) contactcardmessageOption {
+	return func(m *ContactCardMessageMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *FriendRelationship
+			value *ContactCardMessage
 		)
-		m.oldValue = func(ctx context.Context) (*FriendRelationship, error) {
+		m.oldValue = func(ctx context.Context) (*ContactCardMessage, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().FriendRelationship.Get(ctx, id)
+					value, err = m.Client().ContactCardMessage.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1811,10 +1525,10 @@ func withFriendRelationshipID(id int) friendrelationshipOption {
 	}
 }
 
-// withFriendRelationship sets the old FriendRelationship of the mutation.
-func withFriendRelationship(node *FriendRelationship) friendrelationshipOption {
-	return func(m *FriendRelationshipMutation) {
-		m.oldValue = func(context.Context) (*FriendRelationship, error) {
+// withContactCardMessage sets the old ContactCardMessage of the mutation.
+func withContactCardMessage(node *ContactCardMessage) contactcardmessageOption {
+	return func(m *ContactCardMessageMutation) {
+		m.oldValue = func(context.Context) (*ContactCardMessage, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1823,7 +1537,7 @@ func withFriendRelationship(node *FriendRelationship) friendrelationshipOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m FriendRelationshipMutation) Client() *Client {
+func (m ContactCardMessageMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1831,7 +1545,7 @@ func (m FriendRelationshipMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m FriendRelationshipMutation) Tx() (*Tx, error) {
+func (m ContactCardMessageMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1842,7 +1556,7 @@ func (m FriendRelationshipMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *FriendRelationshipMutation) ID() (id int, exists bool) {
+func (m *ContactCardMessageMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1853,7 +1567,7 @@ func (m *FriendRelationshipMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *FriendRelationshipMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ContactCardMessageMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1862,20 +1576,56 @@ func (m *FriendRelationshipMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().FriendRelationship.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ContactCardMessage.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
+// SetMsgId sets the "msgId" field.
+func (m *ContactCardMessageMutation) SetMsgId(s string) {
+	m.msgId = &s
+}
+
+// MsgId returns the value of the "msgId" field in the mutation.
+func (m *ContactCardMessageMutation) MsgId() (r string, exists bool) {
+	v := m.msgId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMsgId returns the old "msgId" field's value of the ContactCardMessage entity.
+// If the ContactCardMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ContactCardMessageMutation) OldMsgId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMsgId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMsgId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMsgId: %w", err)
+	}
+	return oldValue.MsgId, nil
+}
+
+// ResetMsgId resets all changes to the "msgId" field.
+func (m *ContactCardMessageMutation) ResetMsgId() {
+	m.msgId = nil
+}
+
 // SetUserId sets the "userId" field.
-func (m *FriendRelationshipMutation) SetUserId(i int) {
+func (m *ContactCardMessageMutation) SetUserId(i int) {
 	m.userId = &i
 	m.adduserId = nil
 }
 
 // UserId returns the value of the "userId" field in the mutation.
-func (m *FriendRelationshipMutation) UserId() (r int, exists bool) {
+func (m *ContactCardMessageMutation) UserId() (r int, exists bool) {
 	v := m.userId
 	if v == nil {
 		return
@@ -1883,10 +1633,10 @@ func (m *FriendRelationshipMutation) UserId() (r int, exists bool) {
 	return *v, true
 }
 
-// OldUserId returns the old "userId" field's value of the FriendRelationship entity.
-// If the FriendRelationship object wasn't provided to the builder, the object is fetched from the database.
+// OldUserId returns the old "userId" field's value of the ContactCardMessage entity.
+// If the ContactCardMessage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FriendRelationshipMutation) OldUserId(ctx context.Context) (v int, err error) {
+func (m *ContactCardMessageMutation) OldUserId(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUserId is only allowed on UpdateOne operations")
 	}
@@ -1901,7 +1651,7 @@ func (m *FriendRelationshipMutation) OldUserId(ctx context.Context) (v int, err
 }
 
 // AddUserId adds i to the "userId" field.
-func (m *FriendRelationshipMutation) AddUserId(i int) {
+func (m *ContactCardMessageMutation) AddUserId(i int) {
 	if m.adduserId != nil {
 		*m.adduserId += i
 	} else {
@@ -1910,7 +1660,7 @@ func (m *FriendRelationshipMutation) AddUserId(i int) {
 }
 
 // AddedUserId returns the value that was added to the "userId" field in this mutation.
-func (m *FriendRelationshipMutation) AddedUserId() (r int, exists bool) {
+func (m *ContactCardMessageMutation) AddedUserId() (r int, exists bool) {
 	v := m.adduserId
 	if v == nil {
 		return
@@ -1919,239 +1669,568 @@ func (m *FriendRelationshipMutation) AddedUserId() (r int, exists bool) {
 }
 
 // ResetUserId resets all changes to the "userId" field.
-func (m *FriendRelationshipMutation) ResetUserId() {
+func (m *ContactCardMessageMutation) ResetUserId() {
 	m.userId = nil
 	m.adduserId = nil
 }
 
-// SetFriendId sets the "friendId" field.
-func (m *FriendRelationshipMutation) SetFriendId(i int) {
-	m.friendId = &i
-	m.addfriendId = nil
+// Where appends a list predicates to the ContactCardMessageMutation builder.
+func (m *ContactCardMessageMutation) Where(ps ...predicate.ContactCardMessage) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// FriendId returns the value of the "friendId" field in the mutation.
-func (m *FriendRelationshipMutation) FriendId() (r int, exists bool) {
-	v := m.friendId
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the ContactCardMessageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ContactCardMessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ContactCardMessage, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldFriendId returns the old "friendId" field's value of the FriendRelationship entity.
-// If the FriendRelationship object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FriendRelationshipMutation) OldFriendId(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFriendId is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFriendId requires an ID field in the mutation")
+// Op returns the operation name.
+func (m *ContactCardMessageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ContactCardMessageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ContactCardMessage).
+func (m *ContactCardMessageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ContactCardMessageMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.msgId != nil {
+		fields = append(fields, contactcardmessage.FieldMsgId)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFriendId: %w", err)
+	if m.userId != nil {
+		fields = append(fields, contactcardmessage.FieldUserId)
 	}
-	return oldValue.FriendId, nil
+	return fields
 }
 
-// AddFriendId adds i to the "friendId" field.
-func (m *FriendRelationshipMutation) AddFriendId(i int) {
-	if m.addfriendId != nil {
-		*m.addfriendId += i
-	} else {
-		m.addfriendId = &i
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ContactCardMessageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case contactcardmessage.FieldMsgId:
+		return m.MsgId()
+	case contactcardmessage.FieldUserId:
+		return m.UserId()
 	}
+	return nil, false
 }
 
-// AddedFriendId returns the value that was added to the "friendId" field in this mutation.
-func (m *FriendRelationshipMutation) AddedFriendId() (r int, exists bool) {
-	v := m.addfriendId
-	if v == nil {
-		return
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ContactCardMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case contactcardmessage.FieldMsgId:
+		return m.OldMsgId(ctx)
+	case contactcardmessage.FieldUserId:
+		return m.OldUserId(ctx)
 	}
-	return *v, true
+	return nil, fmt.Errorf("unknown ContactCardMessage field %s", name)
 }
 
-// ResetFriendId resets all changes to the "friendId" field.
-func (m *FriendRelationshipMutation) ResetFriendId() {
-	m.friendId = nil
-	m.addfriendId = nil
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ContactCardMessageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case contactcardmessage.FieldMsgId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMsgId(v)
+		return nil
+	case contactcardmessage.FieldUserId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserId(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ContactCardMessage field %s", name)
 }
 
-// SetRemarkName sets the "remarkName" field.
-func (m *FriendRelationshipMutation) SetRemarkName(s string) {
-	m.remarkName = &s
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ContactCardMessageMutation) AddedFields() []string {
+	var fields []string
+	if m.adduserId != nil {
+		fields = append(fields, contactcardmessage.FieldUserId)
+	}
+	return fields
 }
 
-// RemarkName returns the value of the "remarkName" field in the mutation.
-func (m *FriendRelationshipMutation) RemarkName() (r string, exists bool) {
-	v := m.remarkName
-	if v == nil {
-		return
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ContactCardMessageMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case contactcardmessage.FieldUserId:
+		return m.AddedUserId()
 	}
-	return *v, true
+	return nil, false
 }
 
-// OldRemarkName returns the old "remarkName" field's value of the FriendRelationship entity.
-// If the FriendRelationship object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FriendRelationshipMutation) OldRemarkName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRemarkName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRemarkName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRemarkName: %w", err)
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ContactCardMessageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case contactcardmessage.FieldUserId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserId(v)
+		return nil
 	}
-	return oldValue.RemarkName, nil
+	return fmt.Errorf("unknown ContactCardMessage numeric field %s", name)
 }
 
-// ClearRemarkName clears the value of the "remarkName" field.
-func (m *FriendRelationshipMutation) ClearRemarkName() {
-	m.remarkName = nil
-	m.clearedFields[friendrelationship.FieldRemarkName] = struct{}{}
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ContactCardMessageMutation) ClearedFields() []string {
+	return nil
 }
 
-// RemarkNameCleared returns if the "remarkName" field was cleared in this mutation.
-func (m *FriendRelationshipMutation) RemarkNameCleared() bool {
-	_, ok := m.clearedFields[friendrelationship.FieldRemarkName]
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ContactCardMessageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetRemarkName resets all changes to the "remarkName" field.
-func (m *FriendRelationshipMutation) ResetRemarkName() {
-	m.remarkName = nil
-	delete(m.clearedFields, friendrelationship.FieldRemarkName)
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ContactCardMessageMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ContactCardMessage nullable field %s", name)
 }
 
-// SetCategory sets the "category" field.
-func (m *FriendRelationshipMutation) SetCategory(s string) {
-	m.category = &s
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ContactCardMessageMutation) ResetField(name string) error {
+	switch name {
+	case contactcardmessage.FieldMsgId:
+		m.ResetMsgId()
+		return nil
+	case contactcardmessage.FieldUserId:
+		m.ResetUserId()
+		return nil
+	}
+	return fmt.Errorf("unknown ContactCardMessage field %s", name)
 }
 
-// Category returns the value of the "category" field in the mutation.
-func (m *FriendRelationshipMutation) Category() (r string, exists bool) {
-	v := m.category
-	if v == nil {
-		return
-	}
-	return *v, true
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ContactCardMessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// OldCategory returns the old "category" field's value of the FriendRelationship entity.
-// If the FriendRelationship object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FriendRelationshipMutation) OldCategory(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCategory requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
-	}
-	return oldValue.Category, nil
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ContactCardMessageMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ClearCategory clears the value of the "category" field.
-func (m *FriendRelationshipMutation) ClearCategory() {
-	m.category = nil
-	m.clearedFields[friendrelationship.FieldCategory] = struct{}{}
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ContactCardMessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// CategoryCleared returns if the "category" field was cleared in this mutation.
-func (m *FriendRelationshipMutation) CategoryCleared() bool {
-	_, ok := m.clearedFields[friendrelationship.FieldCategory]
-	return ok
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ContactCardMessageMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ResetCategory resets all changes to the "category" field.
-func (m *FriendRelationshipMutation) ResetCategory() {
-	m.category = nil
-	delete(m.clearedFields, friendrelationship.FieldCategory)
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ContactCardMessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// SetTags sets the "tags" field.
-func (m *FriendRelationshipMutation) SetTags(s []string) {
-	m.tags = &s
-	m.appendtags = nil
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ContactCardMessageMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// Tags returns the value of the "tags" field in the mutation.
-func (m *FriendRelationshipMutation) Tags() (r []string, exists bool) {
-	v := m.tags
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ContactCardMessageMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ContactCardMessage unique edge %s", name)
 }
 
-// OldTags returns the old "tags" field's value of the FriendRelationship entity.
-// If the FriendRelationship object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FriendRelationshipMutation) OldTags(ctx context.Context) (v []string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTags is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTags requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTags: %w", err)
-	}
-	return oldValue.Tags, nil
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ContactCardMessageMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ContactCardMessage edge %s", name)
 }
 
-// AppendTags adds s to the "tags" field.
-func (m *FriendRelationshipMutation) AppendTags(s []string) {
-	m.appendtags = append(m.appendtags, s...)
+// ConversationClearMutation represents an operation that mutates the ConversationClear nodes in the graph.
+type ConversationClearMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	userId        *int
+	adduserId     *int
+	isGroup       *bool
+	targetId      *int
+	addtargetId   *int
+	clearTime     *time.Time
+	hidden        *bool
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ConversationClear, error)
+	predicates    []predicate.ConversationClear
 }
 
-// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
-func (m *FriendRelationshipMutation) AppendedTags() ([]string, bool) {
-	if len(m.appendtags) == 0 {
-		return nil, false
+var _ ent.Mutation = (*ConversationClearMutation)(nil)
+
+// conversationclearOption allows management of the mutation configuration using functional options.
+type conversationclearOption func(*ConversationClearMutation)
+
+// newConversationClearMutation creates new mutation for the ConversationClear entity.
+func newConversationClearMutation(c config, op Op, opts ...conversationclearOption) *ConversationClearMutation {
+	m := &ConversationClearMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeConversationClear,
+		clearedFields: make(map[string]struct{}),
 	}
-	return m.appendtags, true
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// ClearTags clears the value of the "tags" field.
-func (m *FriendRelationshipMutation) ClearTags() {
-	m.tags = nil
-	m.appendtags = nil
-	m.clearedFields[friendrelationship.FieldTags] = struct{}{}
+// withConversationClearID sets the ID field of the mutation.
+func withConversationClearID(id int) conversationclearOption {
+	return func(m *ConversationClearMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ConversationClear
+		)
+		m.oldValue = func(ctx context.Context) (*ConversationClear, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ConversationClear.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// TagsCleared returns if the "tags" field was cleared in this mutation.
-func (m *FriendRelationshipMutation) TagsCleared() bool {
-	_, ok := m.clearedFields[friendrelationship.FieldTags]
-	return ok
+// withConversationClear sets the old ConversationClear of the mutation.
+func withConversationClear(node *ConversationClear) conversationclearOption {
+	return func(m *ConversationClearMutation) {
+		m.oldValue = func(context.Context) (*ConversationClear, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// ResetTags resets all changes to the "tags" field.
-func (m *FriendRelationshipMutation) ResetTags() {
-	m.tags = nil
-	m.appendtags = nil
-	delete(m.clearedFields, friendrelationship.FieldTags)
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ConversationClearMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// Where appends a list predicates to the FriendRelationshipMutation builder.
-func (m *FriendRelationshipMutation) Where(ps ...predicate.FriendRelationship) {
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ConversationClearMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ConversationClearMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ConversationClearMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ConversationClear.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserId sets the "userId" field.
+func (m *ConversationClearMutation) SetUserId(i int) {
+	m.userId = &i
+	m.adduserId = nil
+}
+
+// UserId returns the value of the "userId" field in the mutation.
+func (m *ConversationClearMutation) UserId() (r int, exists bool) {
+	v := m.userId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserId returns the old "userId" field's value of the ConversationClear entity.
+// If the ConversationClear object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConversationClearMutation) OldUserId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserId: %w", err)
+	}
+	return oldValue.UserId, nil
+}
+
+// AddUserId adds i to the "userId" field.
+func (m *ConversationClearMutation) AddUserId(i int) {
+	if m.adduserId != nil {
+		*m.adduserId += i
+	} else {
+		m.adduserId = &i
+	}
+}
+
+// AddedUserId returns the value that was added to the "userId" field in this mutation.
+func (m *ConversationClearMutation) AddedUserId() (r int, exists bool) {
+	v := m.adduserId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUserId resets all changes to the "userId" field.
+func (m *ConversationClearMutation) ResetUserId() {
+	m.userId = nil
+	m.adduserId = nil
+}
+
+// SetIsGroup sets the "isGroup" field.
+func (m *ConversationClearMutation) SetIsGroup(b bool) {
+	m.isGroup = &b
+}
+
+// IsGroup returns the value of the "isGroup" field in the mutation.
+func (m *ConversationClearMutation) IsGroup() (r bool, exists bool) {
+	v := m.isGroup
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsGroup returns the old "isGroup" field's value of the ConversationClear entity.
+// If the ConversationClear object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConversationClearMutation) OldIsGroup(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsGroup is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsGroup requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsGroup: %w", err)
+	}
+	return oldValue.IsGroup, nil
+}
+
+// ResetIsGroup resets all changes to the "isGroup" field.
+func (m *ConversationClearMutation) ResetIsGroup() {
+	m.isGroup = nil
+}
+
+// SetTargetId sets the "targetId" field.
+func (m *ConversationClearMutation) SetTargetId(i int) {
+	m.targetId = &i
+	m.addtargetId = nil
+}
+
+// TargetId returns the value of the "targetId" field in the mutation.
+func (m *ConversationClearMutation) TargetId() (r int, exists bool) {
+	v := m.targetId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetId returns the old "targetId" field's value of the ConversationClear entity.
+// If the ConversationClear object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConversationClearMutation) OldTargetId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetId: %w", err)
+	}
+	return oldValue.TargetId, nil
+}
+
+// AddTargetId adds i to the "targetId" field.
+func (m *ConversationClearMutation) AddTargetId(i int) {
+	if m.addtargetId != nil {
+		*m.addtargetId += i
+	} else {
+		m.addtargetId = &i
+	}
+}
+
+// AddedTargetId returns the value that was added to the "targetId" field in this mutation.
+func (m *ConversationClearMutation) AddedTargetId() (r int, exists bool) {
+	v := m.addtargetId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTargetId resets all changes to the "targetId" field.
+func (m *ConversationClearMutation) ResetTargetId() {
+	m.targetId = nil
+	m.addtargetId = nil
+}
+
+// SetClearTime sets the "clearTime" field.
+func (m *ConversationClearMutation) SetClearTime(t time.Time) {
+	m.clearTime = &t
+}
+
+// ClearTime returns the value of the "clearTime" field in the mutation.
+func (m *ConversationClearMutation) ClearTime() (r time.Time, exists bool) {
+	v := m.clearTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClearTime returns the old "clearTime" field's value of the ConversationClear entity.
+// If the ConversationClear object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConversationClearMutation) OldClearTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClearTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClearTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClearTime: %w", err)
+	}
+	return oldValue.ClearTime, nil
+}
+
+// ResetClearTime resets all changes to the "clearTime" field.
+func (m *ConversationClearMutation) ResetClearTime() {
+	m.clearTime = nil
+}
+
+// SetHidden sets the "hidden" field.
+func (m *ConversationClearMutation) SetHidden(b bool) {
+	m.hidden = &b
+}
+
+// Hidden returns the value of the "hidden" field in the mutation.
+func (m *ConversationClearMutation) Hidden() (r bool, exists bool) {
+	v := m.hidden
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHidden returns the old "hidden" field's value of the ConversationClear entity.
+// If the ConversationClear object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConversationClearMutation) OldHidden(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHidden is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHidden requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHidden: %w", err)
+	}
+	return oldValue.Hidden, nil
+}
+
+// ResetHidden resets all changes to the "hidden" field.
+func (m *ConversationClearMutation) ResetHidden() {
+	m.hidden = nil
+}
+
+// Where appends a list predicates to the ConversationClearMutation builder.
+func (m *ConversationClearMutation) Where(ps ...predicate.ConversationClear) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the FriendRelationshipMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ConversationClearMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *FriendRelationshipMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.FriendRelationship, len(ps))
+func (m *ConversationClearMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ConversationClear, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2159,39 +2238,39 @@ func (m *FriendRelationshipMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *FriendRelationshipMutation) Op() Op {
+func (m *ConversationClearMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *FriendRelationshipMutation) SetOp(op Op) {
+func (m *ConversationClearMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (FriendRelationship).
-func (m *FriendRelationshipMutation) Type() string {
+// Type returns the node type of this mutation (ConversationClear).
+func (m *ConversationClearMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *FriendRelationshipMutation) Fields() []string {
+func (m *ConversationClearMutation) Fields() []string {
 	fields := make([]string, 0, 5)
 	if m.userId != nil {
-		fields = append(fields, friendrelationship.FieldUserId)
+		fields = append(fields, conversationclear.FieldUserId)
 	}
-	if m.friendId != nil {
-		fields = append(fields, friendrelationship.FieldFriendId)
+	if m.isGroup != nil {
+		fields = append(fields, conversationclear.FieldIsGroup)
 	}
-	if m.remarkName != nil {
-		fields = append(fields, friendrelationship.FieldRemarkName)
+	if m.targetId != nil {
+		fields = append(fields, conversationclear.FieldTargetId)
 	}
-	if m.category != nil {
-		fields = append(fields, friendrelationship.FieldCategory)
+	if m.clearTime != nil {
+		fields = append(fields, conversationclear.FieldClearTime)
 	}
-	if m.tags != nil {
-		fields = append(fields, friendrelationship.FieldTags)
+	if m.hidden != nil {
+		fields = append(fields, conversationclear.FieldHidden)
 	}
 	return fields
 }
@@ -2199,18 +2278,18 @@ func (m *FriendRelationshipMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *FriendRelationshipMutation) Field(name string) (ent.Value, bool) {
+func (m *ConversationClearMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case friendrelationship.FieldUserId:
+	case conversationclear.FieldUserId:
 		return m.UserId()
-	case friendrelationship.FieldFriendId:
-		return m.FriendId()
-	case friendrelationship.FieldRemarkName:
-		return m.RemarkName()
-	case friendrelationship.FieldCategory:
-		return m.Category()
-	case friendrelationship.FieldTags:
-		return m.Tags()
+	case conversationclear.FieldIsGroup:
+		return m.IsGroup()
+	case conversationclear.FieldTargetId:
+		return m.TargetId()
+	case conversationclear.FieldClearTime:
+		return m.ClearTime()
+	case conversationclear.FieldHidden:
+		return m.Hidden()
 	}
 	return nil, false
 }
@@ -2218,75 +2297,75 @@ func (m *FriendRelationshipMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *FriendRelationshipMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ConversationClearMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case friendrelationship.FieldUserId:
+	case conversationclear.FieldUserId:
 		return m.OldUserId(ctx)
-	case friendrelationship.FieldFriendId:
-		return m.OldFriendId(ctx)
-	case friendrelationship.FieldRemarkName:
-		return m.OldRemarkName(ctx)
-	case friendrelationship.FieldCategory:
-		return m.OldCategory(ctx)
-	case friendrelationship.FieldTags:
-		return m.OldTags(ctx)
+	case conversationclear.FieldIsGroup:
+		return m.OldIsGroup(ctx)
+	case conversationclear.FieldTargetId:
+		return m.OldTargetId(ctx)
+	case conversationclear.FieldClearTime:
+		return m.OldClearTime(ctx)
+	case conversationclear.FieldHidden:
+		return m.OldHidden(ctx)
 	}
-	return nil, fmt.Errorf("unknown FriendRelationship field %s", name)
+	return nil, fmt.Errorf("unknown ConversationClear field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FriendRelationshipMutation) SetField(name string, value ent.Value) error {
+func (m *ConversationClearMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case friendrelationship.FieldUserId:
+	case conversationclear.FieldUserId:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUserId(v)
 		return nil
-	case friendrelationship.FieldFriendId:
-		v, ok := value.(int)
+	case conversationclear.FieldIsGroup:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFriendId(v)
+		m.SetIsGroup(v)
 		return nil
-	case friendrelationship.FieldRemarkName:
-		v, ok := value.(string)
+	case conversationclear.FieldTargetId:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRemarkName(v)
+		m.SetTargetId(v)
 		return nil
-	case friendrelationship.FieldCategory:
-		v, ok := value.(string)
+	case conversationclear.FieldClearTime:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCategory(v)
+		m.SetClearTime(v)
 		return nil
-	case friendrelationship.FieldTags:
-		v, ok := value.([]string)
+	case conversationclear.FieldHidden:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTags(v)
+		m.SetHidden(v)
 		return nil
 	}
-	return fmt.Errorf("unknown FriendRelationship field %s", name)
+	return fmt.Errorf("unknown ConversationClear field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *FriendRelationshipMutation) AddedFields() []string {
+func (m *ConversationClearMutation) AddedFields() []string {
 	var fields []string
 	if m.adduserId != nil {
-		fields = append(fields, friendrelationship.FieldUserId)
+		fields = append(fields, conversationclear.FieldUserId)
 	}
-	if m.addfriendId != nil {
-		fields = append(fields, friendrelationship.FieldFriendId)
+	if m.addtargetId != nil {
+		fields = append(fields, conversationclear.FieldTargetId)
 	}
 	return fields
 }
@@ -2294,12 +2373,12 @@ func (m *FriendRelationshipMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *FriendRelationshipMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ConversationClearMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case friendrelationship.FieldUserId:
+	case conversationclear.FieldUserId:
 		return m.AddedUserId()
-	case friendrelationship.FieldFriendId:
-		return m.AddedFriendId()
+	case conversationclear.FieldTargetId:
+		return m.AddedTargetId()
 	}
 	return nil, false
 }
@@ -2307,168 +2386,146 @@ func (m *FriendRelationshipMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FriendRelationshipMutation) AddField(name string, value ent.Value) error {
+func (m *ConversationClearMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case friendrelationship.FieldUserId:
+	case conversationclear.FieldUserId:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddUserId(v)
 		return nil
-	case friendrelationship.FieldFriendId:
+	case conversationclear.FieldTargetId:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddFriendId(v)
+		m.AddTargetId(v)
 		return nil
 	}
-	return fmt.Errorf("unknown FriendRelationship numeric field %s", name)
+	return fmt.Errorf("unknown ConversationClear numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *FriendRelationshipMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(friendrelationship.FieldRemarkName) {
-		fields = append(fields, friendrelationship.FieldRemarkName)
-	}
-	if m.FieldCleared(friendrelationship.FieldCategory) {
-		fields = append(fields, friendrelationship.FieldCategory)
-	}
-	if m.FieldCleared(friendrelationship.FieldTags) {
-		fields = append(fields, friendrelationship.FieldTags)
-	}
-	return fields
+func (m *ConversationClearMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *FriendRelationshipMutation) FieldCleared(name string) bool {
+func (m *ConversationClearMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *FriendRelationshipMutation) ClearField(name string) error {
-	switch name {
-	case friendrelationship.FieldRemarkName:
-		m.ClearRemarkName()
-		return nil
-	case friendrelationship.FieldCategory:
-		m.ClearCategory()
-		return nil
-	case friendrelationship.FieldTags:
-		m.ClearTags()
-		return nil
-	}
-	return fmt.Errorf("unknown FriendRelationship nullable field %s", name)
+func (m *ConversationClearMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ConversationClear nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *FriendRelationshipMutation) ResetField(name string) error {
+func (m *ConversationClearMutation) ResetField(name string) error {
 	switch name {
-	case friendrelationship.FieldUserId:
+	case conversationclear.FieldUserId:
 		m.ResetUserId()
 		return nil
-	case friendrelationship.FieldFriendId:
-		m.ResetFriendId()
+	case conversationclear.FieldIsGroup:
+		m.ResetIsGroup()
 		return nil
-	case friendrelationship.FieldRemarkName:
-		m.ResetRemarkName()
+	case conversationclear.FieldTargetId:
+		m.ResetTargetId()
 		return nil
-	case friendrelationship.FieldCategory:
-		m.ResetCategory()
+	case conversationclear.FieldClearTime:
+		m.ResetClearTime()
 		return nil
-	case friendrelationship.FieldTags:
-		m.ResetTags()
+	case conversationclear.FieldHidden:
+		m.ResetHidden()
 		return nil
 	}
-	return fmt.Errorf("unknown FriendRelationship field %s", name)
+	return fmt.Errorf("unknown ConversationClear field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *FriendRelationshipMutation) AddedEdges() []string {
+func (m *ConversationClearMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *FriendRelationshipMutation) AddedIDs(name string) []ent.Value {
+func (m *ConversationClearMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *FriendRelationshipMutation) RemovedEdges() []string {
+func (m *ConversationClearMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *FriendRelationshipMutation) RemovedIDs(name string) []ent.Value {
+func (m *ConversationClearMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *FriendRelationshipMutation) ClearedEdges() []string {
+func (m *ConversationClearMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *FriendRelationshipMutation) EdgeCleared(name string) bool {
+func (m *ConversationClearMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *FriendRelationshipMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown FriendRelationship unique edge %s", name)
+func (m *ConversationClearMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ConversationClear unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *FriendRelationshipMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown FriendRelationship edge %s", name)
+func (m *ConversationClearMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ConversationClear edge %s", name)
 }
 
-// FriendRequestMutation represents an operation that mutates the FriendRequest nodes in the graph.
-type FriendRequestMutation struct {
+// ConversationTimerMutation represents an operation that mutates the ConversationTimer nodes in the graph.
+type ConversationTimerMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	fromUserId    *int
-	addfromUserId *int
-	toUserId      *int
-	addtoUserId   *int
-	remark        *string
-	status        *int
-	addstatus     *int
-	createTime    *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*FriendRequest, error)
-	predicates    []predicate.FriendRequest
+	op              Op
+	typ             string
+	id              *int
+	conversationId  *string
+	isGroup         *bool
+	ttlSeconds      *int
+	addttlSeconds   *int
+	updateUserId    *int
+	addupdateUserId *int
+	updateTime      *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*ConversationTimer, error)
+	predicates      []predicate.ConversationTimer
 }
 
-var _ ent.Mutation = (*FriendRequestMutation)(nil)
+var _ ent.Mutation = (*ConversationTimerMutation)(nil)
 
-// friendrequestOption allows management of the mutation configuration using functional options.
-type friendrequestOption func(*FriendRequestMutation)
+// conversationtimerOption allows management of the mutation configuration using functional options.
+type conversationtimerOption func(*ConversationTimerMutation)
 
-// newFriendRequestMutation creates new mutation for the FriendRequest entity.
-func newFriendRequestMutation(c config, op Op, opts ...friendrequestOption) *FriendRequestMutation {
-	m := &FriendRequestMutation{
+// newConversationTimerMutation creates new mutation for the ConversationTimer entity.
+func newConversationTimerMutation(c config, op Op, opts ...conversationtimerOption) *ConversationTimerMutation {
+	m := &ConversationTimerMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeFriendRequest,
+		typ:           TypeConversationTimer,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2477,20 +2534,20 @@ func newFriendRequestMutation(c config, op Op, opts ...friendrequestOption) *Fri
 	return m
 }
 
-// withFriendRequestID sets the ID field of the mutation.
-func withFriendRequestID(id int) friendrequestOption {
-	return func(m *FriendRequestMutation) {
+// withConversationTimerID sets the ID field of the mutation.
+func withConversationTimerID(id int) conversationtimerOption {
+	return func(m *ConversationTimerMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *FriendRequest
+			value *ConversationTimer
 		)
-		m.oldValue = func(ctx context.Context) (*FriendRequest, error) {
+		m.oldValue = func(ctx context.Context) (*ConversationTimer, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().FriendRequest.Get(ctx, id)
+					value, err = m.Client().ConversationTimer.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2499,10 +2556,10 @@ func withFriendRequestID(id int) friendrequestOption {
 	}
 }
 
-// withFriendRequest sets the old FriendRequest of the mutation.
-func withFriendRequest(node *FriendRequest) friendrequestOption {
-	return func(m *FriendRequestMutation) {
-		m.oldValue = func(context.Context) (*FriendRequest, error) {
+// withConversationTimer sets the old ConversationTimer of the mutation.
+func withConversationTimer(node *ConversationTimer) conversationtimerOption {
+	return func(m *ConversationTimerMutation) {
+		m.oldValue = func(context.Context) (*ConversationTimer, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2511,7 +2568,7 @@ func withFriendRequest(node *FriendRequest) friendrequestOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m FriendRequestMutation) Client() *Client {
+func (m ConversationTimerMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2519,7 +2576,7 @@ func (m FriendRequestMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m FriendRequestMutation) Tx() (*Tx, error) {
+func (m ConversationTimerMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2530,7 +2587,7 @@ func (m FriendRequestMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *FriendRequestMutation) ID() (id int, exists bool) {
+func (m *ConversationTimerMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2541,7 +2598,7 @@ func (m *FriendRequestMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *FriendRequestMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ConversationTimerMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2550,274 +2607,241 @@ func (m *FriendRequestMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().FriendRequest.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ConversationTimer.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetFromUserId sets the "fromUserId" field.
-func (m *FriendRequestMutation) SetFromUserId(i int) {
-	m.fromUserId = &i
-	m.addfromUserId = nil
+// SetConversationId sets the "conversationId" field.
+func (m *ConversationTimerMutation) SetConversationId(s string) {
+	m.conversationId = &s
 }
 
-// FromUserId returns the value of the "fromUserId" field in the mutation.
-func (m *FriendRequestMutation) FromUserId() (r int, exists bool) {
-	v := m.fromUserId
+// ConversationId returns the value of the "conversationId" field in the mutation.
+func (m *ConversationTimerMutation) ConversationId() (r string, exists bool) {
+	v := m.conversationId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFromUserId returns the old "fromUserId" field's value of the FriendRequest entity.
-// If the FriendRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldConversationId returns the old "conversationId" field's value of the ConversationTimer entity.
+// If the ConversationTimer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FriendRequestMutation) OldFromUserId(ctx context.Context) (v int, err error) {
+func (m *ConversationTimerMutation) OldConversationId(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFromUserId is only allowed on UpdateOne operations")
+		return v, errors.New("OldConversationId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFromUserId requires an ID field in the mutation")
+		return v, errors.New("OldConversationId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFromUserId: %w", err)
+		return v, fmt.Errorf("querying old value for OldConversationId: %w", err)
 	}
-	return oldValue.FromUserId, nil
+	return oldValue.ConversationId, nil
 }
 
-// AddFromUserId adds i to the "fromUserId" field.
-func (m *FriendRequestMutation) AddFromUserId(i int) {
-	if m.addfromUserId != nil {
-		*m.addfromUserId += i
-	} else {
-		m.addfromUserId = &i
-	}
+// ResetConversationId resets all changes to the "conversationId" field.
+func (m *ConversationTimerMutation) ResetConversationId() {
+	m.conversationId = nil
 }
 
-// AddedFromUserId returns the value that was added to the "fromUserId" field in this mutation.
-func (m *FriendRequestMutation) AddedFromUserId() (r int, exists bool) {
-	v := m.addfromUserId
+// SetIsGroup sets the "isGroup" field.
+func (m *ConversationTimerMutation) SetIsGroup(b bool) {
+	m.isGroup = &b
+}
+
+// IsGroup returns the value of the "isGroup" field in the mutation.
+func (m *ConversationTimerMutation) IsGroup() (r bool, exists bool) {
+	v := m.isGroup
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetFromUserId resets all changes to the "fromUserId" field.
-func (m *FriendRequestMutation) ResetFromUserId() {
-	m.fromUserId = nil
-	m.addfromUserId = nil
+// OldIsGroup returns the old "isGroup" field's value of the ConversationTimer entity.
+// If the ConversationTimer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConversationTimerMutation) OldIsGroup(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsGroup is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsGroup requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsGroup: %w", err)
+	}
+	return oldValue.IsGroup, nil
 }
 
-// SetToUserId sets the "toUserId" field.
-func (m *FriendRequestMutation) SetToUserId(i int) {
-	m.toUserId = &i
-	m.addtoUserId = nil
+// ResetIsGroup resets all changes to the "isGroup" field.
+func (m *ConversationTimerMutation) ResetIsGroup() {
+	m.isGroup = nil
 }
 
-// ToUserId returns the value of the "toUserId" field in the mutation.
-func (m *FriendRequestMutation) ToUserId() (r int, exists bool) {
-	v := m.toUserId
+// SetTtlSeconds sets the "ttlSeconds" field.
+func (m *ConversationTimerMutation) SetTtlSeconds(i int) {
+	m.ttlSeconds = &i
+	m.addttlSeconds = nil
+}
+
+// TtlSeconds returns the value of the "ttlSeconds" field in the mutation.
+func (m *ConversationTimerMutation) TtlSeconds() (r int, exists bool) {
+	v := m.ttlSeconds
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldToUserId returns the old "toUserId" field's value of the FriendRequest entity.
-// If the FriendRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldTtlSeconds returns the old "ttlSeconds" field's value of the ConversationTimer entity.
+// If the ConversationTimer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FriendRequestMutation) OldToUserId(ctx context.Context) (v int, err error) {
+func (m *ConversationTimerMutation) OldTtlSeconds(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldToUserId is only allowed on UpdateOne operations")
+		return v, errors.New("OldTtlSeconds is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldToUserId requires an ID field in the mutation")
+		return v, errors.New("OldTtlSeconds requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldToUserId: %w", err)
+		return v, fmt.Errorf("querying old value for OldTtlSeconds: %w", err)
 	}
-	return oldValue.ToUserId, nil
+	return oldValue.TtlSeconds, nil
 }
 
-// AddToUserId adds i to the "toUserId" field.
-func (m *FriendRequestMutation) AddToUserId(i int) {
-	if m.addtoUserId != nil {
-		*m.addtoUserId += i
+// AddTtlSeconds adds i to the "ttlSeconds" field.
+func (m *ConversationTimerMutation) AddTtlSeconds(i int) {
+	if m.addttlSeconds != nil {
+		*m.addttlSeconds += i
 	} else {
-		m.addtoUserId = &i
+		m.addttlSeconds = &i
 	}
 }
 
-// AddedToUserId returns the value that was added to the "toUserId" field in this mutation.
-func (m *FriendRequestMutation) AddedToUserId() (r int, exists bool) {
-	v := m.addtoUserId
+// AddedTtlSeconds returns the value that was added to the "ttlSeconds" field in this mutation.
+func (m *ConversationTimerMutation) AddedTtlSeconds() (r int, exists bool) {
+	v := m.addttlSeconds
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetToUserId resets all changes to the "toUserId" field.
-func (m *FriendRequestMutation) ResetToUserId() {
-	m.toUserId = nil
-	m.addtoUserId = nil
+// ResetTtlSeconds resets all changes to the "ttlSeconds" field.
+func (m *ConversationTimerMutation) ResetTtlSeconds() {
+	m.ttlSeconds = nil
+	m.addttlSeconds = nil
 }
 
-// SetRemark sets the "remark" field.
-func (m *FriendRequestMutation) SetRemark(s string) {
-	m.remark = &s
+// SetUpdateUserId sets the "updateUserId" field.
+func (m *ConversationTimerMutation) SetUpdateUserId(i int) {
+	m.updateUserId = &i
+	m.addupdateUserId = nil
 }
 
-// Remark returns the value of the "remark" field in the mutation.
-func (m *FriendRequestMutation) Remark() (r string, exists bool) {
-	v := m.remark
+// UpdateUserId returns the value of the "updateUserId" field in the mutation.
+func (m *ConversationTimerMutation) UpdateUserId() (r int, exists bool) {
+	v := m.updateUserId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRemark returns the old "remark" field's value of the FriendRequest entity.
-// If the FriendRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateUserId returns the old "updateUserId" field's value of the ConversationTimer entity.
+// If the ConversationTimer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FriendRequestMutation) OldRemark(ctx context.Context) (v string, err error) {
+func (m *ConversationTimerMutation) OldUpdateUserId(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRemark is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdateUserId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRemark requires an ID field in the mutation")
+		return v, errors.New("OldUpdateUserId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRemark: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdateUserId: %w", err)
 	}
-	return oldValue.Remark, nil
+	return oldValue.UpdateUserId, nil
 }
 
-// ClearRemark clears the value of the "remark" field.
-func (m *FriendRequestMutation) ClearRemark() {
-	m.remark = nil
-	m.clearedFields[friendrequest.FieldRemark] = struct{}{}
+// AddUpdateUserId adds i to the "updateUserId" field.
+func (m *ConversationTimerMutation) AddUpdateUserId(i int) {
+	if m.addupdateUserId != nil {
+		*m.addupdateUserId += i
+	} else {
+		m.addupdateUserId = &i
+	}
 }
 
-// RemarkCleared returns if the "remark" field was cleared in this mutation.
-func (m *FriendRequestMutation) RemarkCleared() bool {
-	_, ok := m.clearedFields[friendrequest.FieldRemark]
-	return ok
+// AddedUpdateUserId returns the value that was added to the "updateUserId" field in this mutation.
+func (m *ConversationTimerMutation) AddedUpdateUserId() (r int, exists bool) {
+	v := m.addupdateUserId
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetRemark resets all changes to the "remark" field.
-func (m *FriendRequestMutation) ResetRemark() {
-	m.remark = nil
-	delete(m.clearedFields, friendrequest.FieldRemark)
+// ResetUpdateUserId resets all changes to the "updateUserId" field.
+func (m *ConversationTimerMutation) ResetUpdateUserId() {
+	m.updateUserId = nil
+	m.addupdateUserId = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *FriendRequestMutation) SetStatus(i int) {
-	m.status = &i
-	m.addstatus = nil
+// SetUpdateTime sets the "updateTime" field.
+func (m *ConversationTimerMutation) SetUpdateTime(t time.Time) {
+	m.updateTime = &t
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *FriendRequestMutation) Status() (r int, exists bool) {
-	v := m.status
+// UpdateTime returns the value of the "updateTime" field in the mutation.
+func (m *ConversationTimerMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.updateTime
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the FriendRequest entity.
-// If the FriendRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "updateTime" field's value of the ConversationTimer entity.
+// If the ConversationTimer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FriendRequestMutation) OldStatus(ctx context.Context) (v int, err error) {
+func (m *ConversationTimerMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.UpdateTime, nil
 }
 
-// AddStatus adds i to the "status" field.
-func (m *FriendRequestMutation) AddStatus(i int) {
-	if m.addstatus != nil {
-		*m.addstatus += i
-	} else {
-		m.addstatus = &i
-	}
-}
-
-// AddedStatus returns the value that was added to the "status" field in this mutation.
-func (m *FriendRequestMutation) AddedStatus() (r int, exists bool) {
-	v := m.addstatus
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetStatus resets all changes to the "status" field.
-func (m *FriendRequestMutation) ResetStatus() {
-	m.status = nil
-	m.addstatus = nil
-}
-
-// SetCreateTime sets the "createTime" field.
-func (m *FriendRequestMutation) SetCreateTime(t time.Time) {
-	m.createTime = &t
-}
-
-// CreateTime returns the value of the "createTime" field in the mutation.
-func (m *FriendRequestMutation) CreateTime() (r time.Time, exists bool) {
-	v := m.createTime
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetUpdateTime resets all changes to the "updateTime" field.
+func (m *ConversationTimerMutation) ResetUpdateTime() {
+	m.updateTime = nil
 }
 
-// OldCreateTime returns the old "createTime" field's value of the FriendRequest entity.
-// If the FriendRequest object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FriendRequestMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreateTime requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
-	}
-	return oldValue.CreateTime, nil
-}
-
-// ResetCreateTime resets all changes to the "createTime" field.
-func (m *FriendRequestMutation) ResetCreateTime() {
-	m.createTime = nil
-}
-
-// Where appends a list predicates to the FriendRequestMutation builder.
-func (m *FriendRequestMutation) Where(ps ...predicate.FriendRequest) {
+// Where appends a list predicates to the ConversationTimerMutation builder.
+func (m *ConversationTimerMutation) Where(ps ...predicate.ConversationTimer) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the FriendRequestMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ConversationTimerMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *FriendRequestMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.FriendRequest, len(ps))
+func (m *ConversationTimerMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ConversationTimer, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2825,39 +2849,39 @@ func (m *FriendRequestMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *FriendRequestMutation) Op() Op {
+func (m *ConversationTimerMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *FriendRequestMutation) SetOp(op Op) {
+func (m *ConversationTimerMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (FriendRequest).
-func (m *FriendRequestMutation) Type() string {
+// Type returns the node type of this mutation (ConversationTimer).
+func (m *ConversationTimerMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *FriendRequestMutation) Fields() []string {
+func (m *ConversationTimerMutation) Fields() []string {
 	fields := make([]string, 0, 5)
-	if m.fromUserId != nil {
-		fields = append(fields, friendrequest.FieldFromUserId)
+	if m.conversationId != nil {
+		fields = append(fields, conversationtimer.FieldConversationId)
 	}
-	if m.toUserId != nil {
-		fields = append(fields, friendrequest.FieldToUserId)
+	if m.isGroup != nil {
+		fields = append(fields, conversationtimer.FieldIsGroup)
 	}
-	if m.remark != nil {
-		fields = append(fields, friendrequest.FieldRemark)
+	if m.ttlSeconds != nil {
+		fields = append(fields, conversationtimer.FieldTtlSeconds)
 	}
-	if m.status != nil {
-		fields = append(fields, friendrequest.FieldStatus)
+	if m.updateUserId != nil {
+		fields = append(fields, conversationtimer.FieldUpdateUserId)
 	}
-	if m.createTime != nil {
-		fields = append(fields, friendrequest.FieldCreateTime)
+	if m.updateTime != nil {
+		fields = append(fields, conversationtimer.FieldUpdateTime)
 	}
 	return fields
 }
@@ -2865,18 +2889,18 @@ func (m *FriendRequestMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *FriendRequestMutation) Field(name string) (ent.Value, bool) {
+func (m *ConversationTimerMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case friendrequest.FieldFromUserId:
-		return m.FromUserId()
-	case friendrequest.FieldToUserId:
-		return m.ToUserId()
-	case friendrequest.FieldRemark:
-		return m.Remark()
-	case friendrequest.FieldStatus:
-		return m.Status()
-	case friendrequest.FieldCreateTime:
-		return m.CreateTime()
+	case conversationtimer.FieldConversationId:
+		return m.ConversationId()
+	case conversationtimer.FieldIsGroup:
+		return m.IsGroup()
+	case conversationtimer.FieldTtlSeconds:
+		return m.TtlSeconds()
+	case conversationtimer.FieldUpdateUserId:
+		return m.UpdateUserId()
+	case conversationtimer.FieldUpdateTime:
+		return m.UpdateTime()
 	}
 	return nil, false
 }
@@ -2884,78 +2908,75 @@ func (m *FriendRequestMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *FriendRequestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ConversationTimerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case friendrequest.FieldFromUserId:
-		return m.OldFromUserId(ctx)
-	case friendrequest.FieldToUserId:
-		return m.OldToUserId(ctx)
-	case friendrequest.FieldRemark:
-		return m.OldRemark(ctx)
-	case friendrequest.FieldStatus:
-		return m.OldStatus(ctx)
-	case friendrequest.FieldCreateTime:
-		return m.OldCreateTime(ctx)
+	case conversationtimer.FieldConversationId:
+		return m.OldConversationId(ctx)
+	case conversationtimer.FieldIsGroup:
+		return m.OldIsGroup(ctx)
+	case conversationtimer.FieldTtlSeconds:
+		return m.OldTtlSeconds(ctx)
+	case conversationtimer.FieldUpdateUserId:
+		return m.OldUpdateUserId(ctx)
+	case conversationtimer.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
 	}
-	return nil, fmt.Errorf("unknown FriendRequest field %s", name)
+	return nil, fmt.Errorf("unknown ConversationTimer field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FriendRequestMutation) SetField(name string, value ent.Value) error {
+func (m *ConversationTimerMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case friendrequest.FieldFromUserId:
-		v, ok := value.(int)
+	case conversationtimer.FieldConversationId:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFromUserId(v)
+		m.SetConversationId(v)
 		return nil
-	case friendrequest.FieldToUserId:
-		v, ok := value.(int)
+	case conversationtimer.FieldIsGroup:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetToUserId(v)
+		m.SetIsGroup(v)
 		return nil
-	case friendrequest.FieldRemark:
-		v, ok := value.(string)
+	case conversationtimer.FieldTtlSeconds:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRemark(v)
+		m.SetTtlSeconds(v)
 		return nil
-	case friendrequest.FieldStatus:
+	case conversationtimer.FieldUpdateUserId:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetUpdateUserId(v)
 		return nil
-	case friendrequest.FieldCreateTime:
+	case conversationtimer.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreateTime(v)
+		m.SetUpdateTime(v)
 		return nil
 	}
-	return fmt.Errorf("unknown FriendRequest field %s", name)
+	return fmt.Errorf("unknown ConversationTimer field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *FriendRequestMutation) AddedFields() []string {
+func (m *ConversationTimerMutation) AddedFields() []string {
 	var fields []string
-	if m.addfromUserId != nil {
-		fields = append(fields, friendrequest.FieldFromUserId)
-	}
-	if m.addtoUserId != nil {
-		fields = append(fields, friendrequest.FieldToUserId)
+	if m.addttlSeconds != nil {
+		fields = append(fields, conversationtimer.FieldTtlSeconds)
 	}
-	if m.addstatus != nil {
-		fields = append(fields, friendrequest.FieldStatus)
+	if m.addupdateUserId != nil {
+		fields = append(fields, conversationtimer.FieldUpdateUserId)
 	}
 	return fields
 }
@@ -2963,14 +2984,12 @@ func (m *FriendRequestMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *FriendRequestMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ConversationTimerMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case friendrequest.FieldFromUserId:
-		return m.AddedFromUserId()
-	case friendrequest.FieldToUserId:
-		return m.AddedToUserId()
-	case friendrequest.FieldStatus:
-		return m.AddedStatus()
+	case conversationtimer.FieldTtlSeconds:
+		return m.AddedTtlSeconds()
+	case conversationtimer.FieldUpdateUserId:
+		return m.AddedUpdateUserId()
 	}
 	return nil, false
 }
@@ -2978,164 +2997,148 @@ func (m *FriendRequestMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FriendRequestMutation) AddField(name string, value ent.Value) error {
+func (m *ConversationTimerMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case friendrequest.FieldFromUserId:
+	case conversationtimer.FieldTtlSeconds:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddFromUserId(v)
-		return nil
-	case friendrequest.FieldToUserId:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddToUserId(v)
+		m.AddTtlSeconds(v)
 		return nil
-	case friendrequest.FieldStatus:
+	case conversationtimer.FieldUpdateUserId:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddStatus(v)
+		m.AddUpdateUserId(v)
 		return nil
 	}
-	return fmt.Errorf("unknown FriendRequest numeric field %s", name)
+	return fmt.Errorf("unknown ConversationTimer numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *FriendRequestMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(friendrequest.FieldRemark) {
-		fields = append(fields, friendrequest.FieldRemark)
-	}
-	return fields
+func (m *ConversationTimerMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *FriendRequestMutation) FieldCleared(name string) bool {
+func (m *ConversationTimerMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *FriendRequestMutation) ClearField(name string) error {
-	switch name {
-	case friendrequest.FieldRemark:
-		m.ClearRemark()
-		return nil
-	}
-	return fmt.Errorf("unknown FriendRequest nullable field %s", name)
+func (m *ConversationTimerMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ConversationTimer nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *FriendRequestMutation) ResetField(name string) error {
+func (m *ConversationTimerMutation) ResetField(name string) error {
 	switch name {
-	case friendrequest.FieldFromUserId:
-		m.ResetFromUserId()
+	case conversationtimer.FieldConversationId:
+		m.ResetConversationId()
 		return nil
-	case friendrequest.FieldToUserId:
-		m.ResetToUserId()
+	case conversationtimer.FieldIsGroup:
+		m.ResetIsGroup()
 		return nil
-	case friendrequest.FieldRemark:
-		m.ResetRemark()
+	case conversationtimer.FieldTtlSeconds:
+		m.ResetTtlSeconds()
 		return nil
-	case friendrequest.FieldStatus:
-		m.ResetStatus()
+	case conversationtimer.FieldUpdateUserId:
+		m.ResetUpdateUserId()
 		return nil
-	case friendrequest.FieldCreateTime:
-		m.ResetCreateTime()
+	case conversationtimer.FieldUpdateTime:
+		m.ResetUpdateTime()
 		return nil
 	}
-	return fmt.Errorf("unknown FriendRequest field %s", name)
+	return fmt.Errorf("unknown ConversationTimer field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *FriendRequestMutation) AddedEdges() []string {
+func (m *ConversationTimerMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *FriendRequestMutation) AddedIDs(name string) []ent.Value {
+func (m *ConversationTimerMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *FriendRequestMutation) RemovedEdges() []string {
+func (m *ConversationTimerMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *FriendRequestMutation) RemovedIDs(name string) []ent.Value {
+func (m *ConversationTimerMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *FriendRequestMutation) ClearedEdges() []string {
+func (m *ConversationTimerMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *FriendRequestMutation) EdgeCleared(name string) bool {
+func (m *ConversationTimerMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *FriendRequestMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown FriendRequest unique edge %s", name)
+func (m *ConversationTimerMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ConversationTimer unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *FriendRequestMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown FriendRequest edge %s", name)
+func (m *ConversationTimerMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ConversationTimer edge %s", name)
 }
 
-// GroupMutation represents an operation that mutates the Group nodes in the graph.
-type GroupMutation struct {
+// DataMigrationMutation represents an operation that mutates the DataMigration nodes in the graph.
+type DataMigrationMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int
-	groupId         *string
-	groupName       *string
-	ownerId         *int
-	addownerId      *int
-	createUserId    *int
-	addcreateUserId *int
-	createTime      *time.Time
-	members         *[]int
-	appendmembers   []int
-	clearedFields   map[string]struct{}
-	done            bool
-	oldValue        func(context.Context) (*Group, error)
-	predicates      []predicate.Group
+	op            Op
+	typ           string
+	id            *int
+	name          *string
+	cursor        *int
+	addcursor     *int
+	migrated      *int
+	addmigrated   *int
+	isCompleted   *bool
+	startTime     *time.Time
+	updateTime    *time.Time
+	finishTime    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*DataMigration, error)
+	predicates    []predicate.DataMigration
 }
 
-var _ ent.Mutation = (*GroupMutation)(nil)
+var _ ent.Mutation = (*DataMigrationMutation)(nil)
 
-// groupOption allows management of the mutation configuration using functional options.
-type groupOption func(*GroupMutation)
+// datamigrationOption allows management of the mutation configuration using functional options.
+type datamigrationOption func(*DataMigrationMutation)
 
-// newGroupMutation creates new mutation for the Group entity.
-func newGroupMutation(c config, op Op, opts ...groupOption) *GroupMutation {
-	m := &GroupMutation{
+// newDataMigrationMutation creates new mutation for the DataMigration entity.
+func newDataMigrationMutation(c config, op Op, opts ...datamigrationOption) *DataMigrationMutation {
+	m := &DataMigrationMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeGroup,
+		typ:           TypeDataMigration,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3144,20 +3147,20 @@ func newGroupMutation(c config, op Op, opts ...groupOption) *GroupMutation {
 	return m
 }
 
-// withGroupID sets the ID field of the mutation.
-func withGroupID(id int) groupOption {
-	return func(m *GroupMutation) {
+// withDataMigrationID sets the ID field of the mutation.
+func withDataMigrationID(id int) datamigrationOption {
+	return func(m *DataMigrationMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Group
+			value *DataMigration
 		)
-		m.oldValue = func(ctx context.Context) (*Group, error) {
+		m.oldValue = func(ctx context.Context) (*DataMigration, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Group.Get(ctx, id)
+					value, err = m.Client().DataMigration.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3166,10 +3169,10 @@ func withGroupID(id int) groupOption {
 	}
 }
 
-// withGroup sets the old Group of the mutation.
-func withGroup(node *Group) groupOption {
-	return func(m *GroupMutation) {
-		m.oldValue = func(context.Context) (*Group, error) {
+// withDataMigration sets the old DataMigration of the mutation.
+func withDataMigration(node *DataMigration) datamigrationOption {
+	return func(m *DataMigrationMutation) {
+		m.oldValue = func(context.Context) (*DataMigration, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3178,7 +3181,7 @@ func withGroup(node *Group) groupOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m GroupMutation) Client() *Client {
+func (m DataMigrationMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3186,7 +3189,7 @@ func (m GroupMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m GroupMutation) Tx() (*Tx, error) {
+func (m DataMigrationMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3197,7 +3200,7 @@ func (m GroupMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *GroupMutation) ID() (id int, exists bool) {
+func (m *DataMigrationMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3208,7 +3211,7 @@ func (m *GroupMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *GroupMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *DataMigrationMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3217,292 +3220,326 @@ func (m *GroupMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Group.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().DataMigration.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetGroupId sets the "groupId" field.
-func (m *GroupMutation) SetGroupId(s string) {
-	m.groupId = &s
+// SetName sets the "name" field.
+func (m *DataMigrationMutation) SetName(s string) {
+	m.name = &s
 }
 
-// GroupId returns the value of the "groupId" field in the mutation.
-func (m *GroupMutation) GroupId() (r string, exists bool) {
-	v := m.groupId
+// Name returns the value of the "name" field in the mutation.
+func (m *DataMigrationMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGroupId returns the old "groupId" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the DataMigration entity.
+// If the DataMigration object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldGroupId(ctx context.Context) (v string, err error) {
+func (m *DataMigrationMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGroupId is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGroupId requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGroupId: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.GroupId, nil
+	return oldValue.Name, nil
 }
 
-// ResetGroupId resets all changes to the "groupId" field.
-func (m *GroupMutation) ResetGroupId() {
-	m.groupId = nil
+// ResetName resets all changes to the "name" field.
+func (m *DataMigrationMutation) ResetName() {
+	m.name = nil
 }
 
-// SetGroupName sets the "groupName" field.
-func (m *GroupMutation) SetGroupName(s string) {
-	m.groupName = &s
+// SetCursor sets the "cursor" field.
+func (m *DataMigrationMutation) SetCursor(i int) {
+	m.cursor = &i
+	m.addcursor = nil
 }
 
-// GroupName returns the value of the "groupName" field in the mutation.
-func (m *GroupMutation) GroupName() (r string, exists bool) {
-	v := m.groupName
+// Cursor returns the value of the "cursor" field in the mutation.
+func (m *DataMigrationMutation) Cursor() (r int, exists bool) {
+	v := m.cursor
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGroupName returns the old "groupName" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// OldCursor returns the old "cursor" field's value of the DataMigration entity.
+// If the DataMigration object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldGroupName(ctx context.Context) (v string, err error) {
+func (m *DataMigrationMutation) OldCursor(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGroupName is only allowed on UpdateOne operations")
+		return v, errors.New("OldCursor is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGroupName requires an ID field in the mutation")
+		return v, errors.New("OldCursor requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGroupName: %w", err)
+		return v, fmt.Errorf("querying old value for OldCursor: %w", err)
 	}
-	return oldValue.GroupName, nil
+	return oldValue.Cursor, nil
 }
 
-// ResetGroupName resets all changes to the "groupName" field.
-func (m *GroupMutation) ResetGroupName() {
-	m.groupName = nil
+// AddCursor adds i to the "cursor" field.
+func (m *DataMigrationMutation) AddCursor(i int) {
+	if m.addcursor != nil {
+		*m.addcursor += i
+	} else {
+		m.addcursor = &i
+	}
 }
 
-// SetOwnerId sets the "ownerId" field.
-func (m *GroupMutation) SetOwnerId(i int) {
-	m.ownerId = &i
-	m.addownerId = nil
+// AddedCursor returns the value that was added to the "cursor" field in this mutation.
+func (m *DataMigrationMutation) AddedCursor() (r int, exists bool) {
+	v := m.addcursor
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// OwnerId returns the value of the "ownerId" field in the mutation.
-func (m *GroupMutation) OwnerId() (r int, exists bool) {
-	v := m.ownerId
+// ResetCursor resets all changes to the "cursor" field.
+func (m *DataMigrationMutation) ResetCursor() {
+	m.cursor = nil
+	m.addcursor = nil
+}
+
+// SetMigrated sets the "migrated" field.
+func (m *DataMigrationMutation) SetMigrated(i int) {
+	m.migrated = &i
+	m.addmigrated = nil
+}
+
+// Migrated returns the value of the "migrated" field in the mutation.
+func (m *DataMigrationMutation) Migrated() (r int, exists bool) {
+	v := m.migrated
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOwnerId returns the old "ownerId" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// OldMigrated returns the old "migrated" field's value of the DataMigration entity.
+// If the DataMigration object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldOwnerId(ctx context.Context) (v int, err error) {
+func (m *DataMigrationMutation) OldMigrated(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOwnerId is only allowed on UpdateOne operations")
+		return v, errors.New("OldMigrated is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOwnerId requires an ID field in the mutation")
+		return v, errors.New("OldMigrated requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOwnerId: %w", err)
+		return v, fmt.Errorf("querying old value for OldMigrated: %w", err)
 	}
-	return oldValue.OwnerId, nil
+	return oldValue.Migrated, nil
 }
 
-// AddOwnerId adds i to the "ownerId" field.
-func (m *GroupMutation) AddOwnerId(i int) {
-	if m.addownerId != nil {
-		*m.addownerId += i
+// AddMigrated adds i to the "migrated" field.
+func (m *DataMigrationMutation) AddMigrated(i int) {
+	if m.addmigrated != nil {
+		*m.addmigrated += i
 	} else {
-		m.addownerId = &i
+		m.addmigrated = &i
 	}
 }
 
-// AddedOwnerId returns the value that was added to the "ownerId" field in this mutation.
-func (m *GroupMutation) AddedOwnerId() (r int, exists bool) {
-	v := m.addownerId
+// AddedMigrated returns the value that was added to the "migrated" field in this mutation.
+func (m *DataMigrationMutation) AddedMigrated() (r int, exists bool) {
+	v := m.addmigrated
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetOwnerId resets all changes to the "ownerId" field.
-func (m *GroupMutation) ResetOwnerId() {
-	m.ownerId = nil
-	m.addownerId = nil
+// ResetMigrated resets all changes to the "migrated" field.
+func (m *DataMigrationMutation) ResetMigrated() {
+	m.migrated = nil
+	m.addmigrated = nil
 }
 
-// SetCreateUserId sets the "createUserId" field.
-func (m *GroupMutation) SetCreateUserId(i int) {
-	m.createUserId = &i
-	m.addcreateUserId = nil
+// SetIsCompleted sets the "isCompleted" field.
+func (m *DataMigrationMutation) SetIsCompleted(b bool) {
+	m.isCompleted = &b
 }
 
-// CreateUserId returns the value of the "createUserId" field in the mutation.
-func (m *GroupMutation) CreateUserId() (r int, exists bool) {
-	v := m.createUserId
+// IsCompleted returns the value of the "isCompleted" field in the mutation.
+func (m *DataMigrationMutation) IsCompleted() (r bool, exists bool) {
+	v := m.isCompleted
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreateUserId returns the old "createUserId" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// OldIsCompleted returns the old "isCompleted" field's value of the DataMigration entity.
+// If the DataMigration object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldCreateUserId(ctx context.Context) (v int, err error) {
+func (m *DataMigrationMutation) OldIsCompleted(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreateUserId is only allowed on UpdateOne operations")
+		return v, errors.New("OldIsCompleted is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreateUserId requires an ID field in the mutation")
+		return v, errors.New("OldIsCompleted requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreateUserId: %w", err)
+		return v, fmt.Errorf("querying old value for OldIsCompleted: %w", err)
 	}
-	return oldValue.CreateUserId, nil
+	return oldValue.IsCompleted, nil
 }
 
-// AddCreateUserId adds i to the "createUserId" field.
-func (m *GroupMutation) AddCreateUserId(i int) {
-	if m.addcreateUserId != nil {
-		*m.addcreateUserId += i
-	} else {
-		m.addcreateUserId = &i
-	}
+// ResetIsCompleted resets all changes to the "isCompleted" field.
+func (m *DataMigrationMutation) ResetIsCompleted() {
+	m.isCompleted = nil
 }
 
-// AddedCreateUserId returns the value that was added to the "createUserId" field in this mutation.
-func (m *GroupMutation) AddedCreateUserId() (r int, exists bool) {
-	v := m.addcreateUserId
+// SetStartTime sets the "startTime" field.
+func (m *DataMigrationMutation) SetStartTime(t time.Time) {
+	m.startTime = &t
+}
+
+// StartTime returns the value of the "startTime" field in the mutation.
+func (m *DataMigrationMutation) StartTime() (r time.Time, exists bool) {
+	v := m.startTime
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetCreateUserId resets all changes to the "createUserId" field.
-func (m *GroupMutation) ResetCreateUserId() {
-	m.createUserId = nil
-	m.addcreateUserId = nil
+// OldStartTime returns the old "startTime" field's value of the DataMigration entity.
+// If the DataMigration object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DataMigrationMutation) OldStartTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartTime: %w", err)
+	}
+	return oldValue.StartTime, nil
 }
 
-// SetCreateTime sets the "createTime" field.
-func (m *GroupMutation) SetCreateTime(t time.Time) {
-	m.createTime = &t
+// ResetStartTime resets all changes to the "startTime" field.
+func (m *DataMigrationMutation) ResetStartTime() {
+	m.startTime = nil
 }
 
-// CreateTime returns the value of the "createTime" field in the mutation.
-func (m *GroupMutation) CreateTime() (r time.Time, exists bool) {
-	v := m.createTime
+// SetUpdateTime sets the "updateTime" field.
+func (m *DataMigrationMutation) SetUpdateTime(t time.Time) {
+	m.updateTime = &t
+}
+
+// UpdateTime returns the value of the "updateTime" field in the mutation.
+func (m *DataMigrationMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.updateTime
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreateTime returns the old "createTime" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "updateTime" field's value of the DataMigration entity.
+// If the DataMigration object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *DataMigrationMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
 	}
-	return oldValue.CreateTime, nil
+	return oldValue.UpdateTime, nil
 }
 
-// ResetCreateTime resets all changes to the "createTime" field.
-func (m *GroupMutation) ResetCreateTime() {
-	m.createTime = nil
+// ResetUpdateTime resets all changes to the "updateTime" field.
+func (m *DataMigrationMutation) ResetUpdateTime() {
+	m.updateTime = nil
 }
 
-// SetMembers sets the "members" field.
-func (m *GroupMutation) SetMembers(i []int) {
-	m.members = &i
-	m.appendmembers = nil
+// SetFinishTime sets the "finishTime" field.
+func (m *DataMigrationMutation) SetFinishTime(t time.Time) {
+	m.finishTime = &t
 }
 
-// Members returns the value of the "members" field in the mutation.
-func (m *GroupMutation) Members() (r []int, exists bool) {
-	v := m.members
+// FinishTime returns the value of the "finishTime" field in the mutation.
+func (m *DataMigrationMutation) FinishTime() (r time.Time, exists bool) {
+	v := m.finishTime
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMembers returns the old "members" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// OldFinishTime returns the old "finishTime" field's value of the DataMigration entity.
+// If the DataMigration object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldMembers(ctx context.Context) (v []int, err error) {
+func (m *DataMigrationMutation) OldFinishTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMembers is only allowed on UpdateOne operations")
+		return v, errors.New("OldFinishTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMembers requires an ID field in the mutation")
+		return v, errors.New("OldFinishTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMembers: %w", err)
+		return v, fmt.Errorf("querying old value for OldFinishTime: %w", err)
 	}
-	return oldValue.Members, nil
+	return oldValue.FinishTime, nil
 }
 
-// AppendMembers adds i to the "members" field.
-func (m *GroupMutation) AppendMembers(i []int) {
-	m.appendmembers = append(m.appendmembers, i...)
+// ClearFinishTime clears the value of the "finishTime" field.
+func (m *DataMigrationMutation) ClearFinishTime() {
+	m.finishTime = nil
+	m.clearedFields[datamigration.FieldFinishTime] = struct{}{}
 }
 
-// AppendedMembers returns the list of values that were appended to the "members" field in this mutation.
-func (m *GroupMutation) AppendedMembers() ([]int, bool) {
-	if len(m.appendmembers) == 0 {
-		return nil, false
-	}
-	return m.appendmembers, true
+// FinishTimeCleared returns if the "finishTime" field was cleared in this mutation.
+func (m *DataMigrationMutation) FinishTimeCleared() bool {
+	_, ok := m.clearedFields[datamigration.FieldFinishTime]
+	return ok
 }
 
-// ResetMembers resets all changes to the "members" field.
-func (m *GroupMutation) ResetMembers() {
-	m.members = nil
-	m.appendmembers = nil
+// ResetFinishTime resets all changes to the "finishTime" field.
+func (m *DataMigrationMutation) ResetFinishTime() {
+	m.finishTime = nil
+	delete(m.clearedFields, datamigration.FieldFinishTime)
 }
 
-// Where appends a list predicates to the GroupMutation builder.
-func (m *GroupMutation) Where(ps ...predicate.Group) {
+// Where appends a list predicates to the DataMigrationMutation builder.
+func (m *DataMigrationMutation) Where(ps ...predicate.DataMigration) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the GroupMutation builder. Using this method,
+// WhereP appends storage-level predicates to the DataMigrationMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *GroupMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Group, len(ps))
+func (m *DataMigrationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DataMigration, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3510,42 +3547,45 @@ func (m *GroupMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *GroupMutation) Op() Op {
+func (m *DataMigrationMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *GroupMutation) SetOp(op Op) {
+func (m *DataMigrationMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Group).
-func (m *GroupMutation) Type() string {
+// Type returns the node type of this mutation (DataMigration).
+func (m *DataMigrationMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *GroupMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.groupId != nil {
-		fields = append(fields, group.FieldGroupId)
+func (m *DataMigrationMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.name != nil {
+		fields = append(fields, datamigration.FieldName)
 	}
-	if m.groupName != nil {
-		fields = append(fields, group.FieldGroupName)
+	if m.cursor != nil {
+		fields = append(fields, datamigration.FieldCursor)
 	}
-	if m.ownerId != nil {
-		fields = append(fields, group.FieldOwnerId)
+	if m.migrated != nil {
+		fields = append(fields, datamigration.FieldMigrated)
 	}
-	if m.createUserId != nil {
-		fields = append(fields, group.FieldCreateUserId)
+	if m.isCompleted != nil {
+		fields = append(fields, datamigration.FieldIsCompleted)
 	}
-	if m.createTime != nil {
-		fields = append(fields, group.FieldCreateTime)
+	if m.startTime != nil {
+		fields = append(fields, datamigration.FieldStartTime)
 	}
-	if m.members != nil {
-		fields = append(fields, group.FieldMembers)
+	if m.updateTime != nil {
+		fields = append(fields, datamigration.FieldUpdateTime)
+	}
+	if m.finishTime != nil {
+		fields = append(fields, datamigration.FieldFinishTime)
 	}
 	return fields
 }
@@ -3553,20 +3593,22 @@ func (m *GroupMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *GroupMutation) Field(name string) (ent.Value, bool) {
+func (m *DataMigrationMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case group.FieldGroupId:
-		return m.GroupId()
-	case group.FieldGroupName:
-		return m.GroupName()
-	case group.FieldOwnerId:
-		return m.OwnerId()
-	case group.FieldCreateUserId:
-		return m.CreateUserId()
-	case group.FieldCreateTime:
-		return m.CreateTime()
-	case group.FieldMembers:
-		return m.Members()
+	case datamigration.FieldName:
+		return m.Name()
+	case datamigration.FieldCursor:
+		return m.Cursor()
+	case datamigration.FieldMigrated:
+		return m.Migrated()
+	case datamigration.FieldIsCompleted:
+		return m.IsCompleted()
+	case datamigration.FieldStartTime:
+		return m.StartTime()
+	case datamigration.FieldUpdateTime:
+		return m.UpdateTime()
+	case datamigration.FieldFinishTime:
+		return m.FinishTime()
 	}
 	return nil, false
 }
@@ -3574,84 +3616,93 @@ func (m *GroupMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *GroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *DataMigrationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case group.FieldGroupId:
-		return m.OldGroupId(ctx)
-	case group.FieldGroupName:
-		return m.OldGroupName(ctx)
-	case group.FieldOwnerId:
-		return m.OldOwnerId(ctx)
-	case group.FieldCreateUserId:
-		return m.OldCreateUserId(ctx)
-	case group.FieldCreateTime:
-		return m.OldCreateTime(ctx)
-	case group.FieldMembers:
-		return m.OldMembers(ctx)
+	case datamigration.FieldName:
+		return m.OldName(ctx)
+	case datamigration.FieldCursor:
+		return m.OldCursor(ctx)
+	case datamigration.FieldMigrated:
+		return m.OldMigrated(ctx)
+	case datamigration.FieldIsCompleted:
+		return m.OldIsCompleted(ctx)
+	case datamigration.FieldStartTime:
+		return m.OldStartTime(ctx)
+	case datamigration.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case datamigration.FieldFinishTime:
+		return m.OldFinishTime(ctx)
 	}
-	return nil, fmt.Errorf("unknown Group field %s", name)
+	return nil, fmt.Errorf("unknown DataMigration field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GroupMutation) SetField(name string, value ent.Value) error {
+func (m *DataMigrationMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case group.FieldGroupId:
+	case datamigration.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetGroupId(v)
+		m.SetName(v)
 		return nil
-	case group.FieldGroupName:
-		v, ok := value.(string)
+	case datamigration.FieldCursor:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetGroupName(v)
+		m.SetCursor(v)
 		return nil
-	case group.FieldOwnerId:
+	case datamigration.FieldMigrated:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOwnerId(v)
+		m.SetMigrated(v)
 		return nil
-	case group.FieldCreateUserId:
-		v, ok := value.(int)
+	case datamigration.FieldIsCompleted:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreateUserId(v)
+		m.SetIsCompleted(v)
 		return nil
-	case group.FieldCreateTime:
+	case datamigration.FieldStartTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreateTime(v)
+		m.SetStartTime(v)
 		return nil
-	case group.FieldMembers:
-		v, ok := value.([]int)
+	case datamigration.FieldUpdateTime:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMembers(v)
+		m.SetUpdateTime(v)
+		return nil
+	case datamigration.FieldFinishTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishTime(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Group field %s", name)
+	return fmt.Errorf("unknown DataMigration field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *GroupMutation) AddedFields() []string {
+func (m *DataMigrationMutation) AddedFields() []string {
 	var fields []string
-	if m.addownerId != nil {
-		fields = append(fields, group.FieldOwnerId)
+	if m.addcursor != nil {
+		fields = append(fields, datamigration.FieldCursor)
 	}
-	if m.addcreateUserId != nil {
-		fields = append(fields, group.FieldCreateUserId)
+	if m.addmigrated != nil {
+		fields = append(fields, datamigration.FieldMigrated)
 	}
 	return fields
 }
@@ -3659,12 +3710,12 @@ func (m *GroupMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *GroupMutation) AddedField(name string) (ent.Value, bool) {
+func (m *DataMigrationMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case group.FieldOwnerId:
-		return m.AddedOwnerId()
-	case group.FieldCreateUserId:
-		return m.AddedCreateUserId()
+	case datamigration.FieldCursor:
+		return m.AddedCursor()
+	case datamigration.FieldMigrated:
+		return m.AddedMigrated()
 	}
 	return nil, false
 }
@@ -3672,147 +3723,165 @@ func (m *GroupMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GroupMutation) AddField(name string, value ent.Value) error {
+func (m *DataMigrationMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case group.FieldOwnerId:
+	case datamigration.FieldCursor:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddOwnerId(v)
+		m.AddCursor(v)
 		return nil
-	case group.FieldCreateUserId:
+	case datamigration.FieldMigrated:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddCreateUserId(v)
+		m.AddMigrated(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Group numeric field %s", name)
+	return fmt.Errorf("unknown DataMigration numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *GroupMutation) ClearedFields() []string {
-	return nil
+func (m *DataMigrationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(datamigration.FieldFinishTime) {
+		fields = append(fields, datamigration.FieldFinishTime)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *GroupMutation) FieldCleared(name string) bool {
+func (m *DataMigrationMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *GroupMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Group nullable field %s", name)
+func (m *DataMigrationMutation) ClearField(name string) error {
+	switch name {
+	case datamigration.FieldFinishTime:
+		m.ClearFinishTime()
+		return nil
+	}
+	return fmt.Errorf("unknown DataMigration nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *GroupMutation) ResetField(name string) error {
+func (m *DataMigrationMutation) ResetField(name string) error {
 	switch name {
-	case group.FieldGroupId:
-		m.ResetGroupId()
+	case datamigration.FieldName:
+		m.ResetName()
 		return nil
-	case group.FieldGroupName:
-		m.ResetGroupName()
+	case datamigration.FieldCursor:
+		m.ResetCursor()
 		return nil
-	case group.FieldOwnerId:
-		m.ResetOwnerId()
+	case datamigration.FieldMigrated:
+		m.ResetMigrated()
 		return nil
-	case group.FieldCreateUserId:
-		m.ResetCreateUserId()
+	case datamigration.FieldIsCompleted:
+		m.ResetIsCompleted()
 		return nil
-	case group.FieldCreateTime:
-		m.ResetCreateTime()
+	case datamigration.FieldStartTime:
+		m.ResetStartTime()
 		return nil
-	case group.FieldMembers:
-		m.ResetMembers()
+	case datamigration.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case datamigration.FieldFinishTime:
+		m.ResetFinishTime()
 		return nil
 	}
-	return fmt.Errorf("unknown Group field %s", name)
+	return fmt.Errorf("unknown DataMigration field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *GroupMutation) AddedEdges() []string {
+func (m *DataMigrationMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *GroupMutation) AddedIDs(name string) []ent.Value {
+func (m *DataMigrationMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *GroupMutation) RemovedEdges() []string {
+func (m *DataMigrationMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *GroupMutation) RemovedIDs(name string) []ent.Value {
+func (m *DataMigrationMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *GroupMutation) ClearedEdges() []string {
+func (m *DataMigrationMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *GroupMutation) EdgeCleared(name string) bool {
+func (m *DataMigrationMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *GroupMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Group unique edge %s", name)
+func (m *DataMigrationMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DataMigration unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *GroupMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Group edge %s", name)
+func (m *DataMigrationMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DataMigration edge %s", name)
 }
 
-// GroupChatRecordMutation represents an operation that mutates the GroupChatRecord nodes in the graph.
-type GroupChatRecordMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *int
-	msgId         *string
-	fromUserId    *string
-	groupId       *string
-	msgType       *string
-	createTime    *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*GroupChatRecord, error)
-	predicates    []predicate.GroupChatRecord
+// DoNotDisturbMutation represents an operation that mutates the DoNotDisturb nodes in the graph.
+type DoNotDisturbMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	user_id            *int
+	adduser_id         *int
+	target_user_id     *int
+	addtarget_user_id  *int
+	target_group_id    *int
+	addtarget_group_id *int
+	is_global          *bool
+	start_time         *time.Time
+	end_time           *time.Time
+	created_at         *time.Time
+	updated_at         *time.Time
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*DoNotDisturb, error)
+	predicates         []predicate.DoNotDisturb
 }
 
-var _ ent.Mutation = (*GroupChatRecordMutation)(nil)
+var _ ent.Mutation = (*DoNotDisturbMutation)(nil)
 
-// groupchatrecordOption allows management of the mutation configuration using functional options.
-type groupchatrecordOption func(*GroupChatRecordMutation)
+// donotdisturbOption allows management of the mutation configuration using functional options.
+type donotdisturbOption func(*DoNotDisturbMutation)
 
-// newGroupChatRecordMutation creates new mutation for the GroupChatRecord entity.
-func newGroupChatRecordMutation(c config, op Op, opts ...groupchatrecordOption) *GroupChatRecordMutation {
-	m := &GroupChatRecordMutation{
+// newDoNotDisturbMutation creates new mutation for the DoNotDisturb entity.
+func newDoNotDisturbMutation(c config, op Op, opts ...donotdisturbOption) *DoNotDisturbMutation {
+	m := &DoNotDisturbMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeGroupChatRecord,
+		typ:           TypeDoNotDisturb,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3821,20 +3890,20 @@ func newGroupChatRecordMutation(c config, op Op, opts ...groupchatrecordOption)
 	return m
 }
 
-// withGroupChatRecordID sets the ID field of the mutation.
-func withGroupChatRecordID(id int) groupchatrecordOption {
-	return func(m *GroupChatRecordMutation) {
+// withDoNotDisturbID sets the ID field of the mutation.
+func withDoNotDisturbID(id int) donotdisturbOption {
+	return func(m *DoNotDisturbMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *GroupChatRecord
+			value *DoNotDisturb
 		)
-		m.oldValue = func(ctx context.Context) (*GroupChatRecord, error) {
+		m.oldValue = func(ctx context.Context) (*DoNotDisturb, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().GroupChatRecord.Get(ctx, id)
+					value, err = m.Client().DoNotDisturb.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3843,10 +3912,10 @@ func withGroupChatRecordID(id int) groupchatrecordOption {
 	}
 }
 
-// withGroupChatRecord sets the old GroupChatRecord of the mutation.
-func withGroupChatRecord(node *GroupChatRecord) groupchatrecordOption {
-	return func(m *GroupChatRecordMutation) {
-		m.oldValue = func(context.Context) (*GroupChatRecord, error) {
+// withDoNotDisturb sets the old DoNotDisturb of the mutation.
+func withDoNotDisturb(node *DoNotDisturb) donotdisturbOption {
+	return func(m *DoNotDisturbMutation) {
+		m.oldValue = func(context.Context) (*DoNotDisturb, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3855,7 +3924,7 @@ func withGroupChatRecord(node *GroupChatRecord) groupchatrecordOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m GroupChatRecordMutation) Client() *Client {
+func (m DoNotDisturbMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3863,7 +3932,7 @@ func (m GroupChatRecordMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m GroupChatRecordMutation) Tx() (*Tx, error) {
+func (m DoNotDisturbMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3872,9 +3941,15 @@ func (m GroupChatRecordMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DoNotDisturb entities.
+func (m *DoNotDisturbMutation) SetID(id int) {
+	m.id = &id
+}
+
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *GroupChatRecordMutation) ID() (id int, exists bool) {
+func (m *DoNotDisturbMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3885,7 +3960,7 @@ func (m *GroupChatRecordMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *GroupChatRecordMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *DoNotDisturbMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3894,632 +3969,423 @@ func (m *GroupChatRecordMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().GroupChatRecord.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().DoNotDisturb.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetMsgId sets the "msgId" field.
-func (m *GroupChatRecordMutation) SetMsgId(s string) {
-	m.msgId = &s
+// SetUserID sets the "user_id" field.
+func (m *DoNotDisturbMutation) SetUserID(i int) {
+	m.user_id = &i
+	m.adduser_id = nil
 }
 
-// MsgId returns the value of the "msgId" field in the mutation.
-func (m *GroupChatRecordMutation) MsgId() (r string, exists bool) {
-	v := m.msgId
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *DoNotDisturbMutation) UserID() (r int, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMsgId returns the old "msgId" field's value of the GroupChatRecord entity.
-// If the GroupChatRecord object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the DoNotDisturb entity.
+// If the DoNotDisturb object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupChatRecordMutation) OldMsgId(ctx context.Context) (v string, err error) {
+func (m *DoNotDisturbMutation) OldUserID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMsgId is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMsgId requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMsgId: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.MsgId, nil
+	return oldValue.UserID, nil
 }
 
-// ResetMsgId resets all changes to the "msgId" field.
-func (m *GroupChatRecordMutation) ResetMsgId() {
-	m.msgId = nil
+// AddUserID adds i to the "user_id" field.
+func (m *DoNotDisturbMutation) AddUserID(i int) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
+	}
 }
 
-// SetFromUserId sets the "fromUserId" field.
-func (m *GroupChatRecordMutation) SetFromUserId(s string) {
-	m.fromUserId = &s
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *DoNotDisturbMutation) AddedUserID() (r int, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// FromUserId returns the value of the "fromUserId" field in the mutation.
-func (m *GroupChatRecordMutation) FromUserId() (r string, exists bool) {
-	v := m.fromUserId
+// ResetUserID resets all changes to the "user_id" field.
+func (m *DoNotDisturbMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+}
+
+// SetTargetUserID sets the "target_user_id" field.
+func (m *DoNotDisturbMutation) SetTargetUserID(i int) {
+	m.target_user_id = &i
+	m.addtarget_user_id = nil
+}
+
+// TargetUserID returns the value of the "target_user_id" field in the mutation.
+func (m *DoNotDisturbMutation) TargetUserID() (r int, exists bool) {
+	v := m.target_user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFromUserId returns the old "fromUserId" field's value of the GroupChatRecord entity.
-// If the GroupChatRecord object wasn't provided to the builder, the object is fetched from the database.
+// OldTargetUserID returns the old "target_user_id" field's value of the DoNotDisturb entity.
+// If the DoNotDisturb object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupChatRecordMutation) OldFromUserId(ctx context.Context) (v string, err error) {
+func (m *DoNotDisturbMutation) OldTargetUserID(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFromUserId is only allowed on UpdateOne operations")
+		return v, errors.New("OldTargetUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFromUserId requires an ID field in the mutation")
+		return v, errors.New("OldTargetUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFromUserId: %w", err)
+		return v, fmt.Errorf("querying old value for OldTargetUserID: %w", err)
 	}
-	return oldValue.FromUserId, nil
-}
-
-// ResetFromUserId resets all changes to the "fromUserId" field.
-func (m *GroupChatRecordMutation) ResetFromUserId() {
-	m.fromUserId = nil
+	return oldValue.TargetUserID, nil
 }
 
-// SetGroupId sets the "groupId" field.
-func (m *GroupChatRecordMutation) SetGroupId(s string) {
-	m.groupId = &s
+// AddTargetUserID adds i to the "target_user_id" field.
+func (m *DoNotDisturbMutation) AddTargetUserID(i int) {
+	if m.addtarget_user_id != nil {
+		*m.addtarget_user_id += i
+	} else {
+		m.addtarget_user_id = &i
+	}
 }
 
-// GroupId returns the value of the "groupId" field in the mutation.
-func (m *GroupChatRecordMutation) GroupId() (r string, exists bool) {
-	v := m.groupId
+// AddedTargetUserID returns the value that was added to the "target_user_id" field in this mutation.
+func (m *DoNotDisturbMutation) AddedTargetUserID() (r int, exists bool) {
+	v := m.addtarget_user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGroupId returns the old "groupId" field's value of the GroupChatRecord entity.
-// If the GroupChatRecord object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupChatRecordMutation) OldGroupId(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGroupId is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGroupId requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGroupId: %w", err)
-	}
-	return oldValue.GroupId, nil
+// ClearTargetUserID clears the value of the "target_user_id" field.
+func (m *DoNotDisturbMutation) ClearTargetUserID() {
+	m.target_user_id = nil
+	m.addtarget_user_id = nil
+	m.clearedFields[donotdisturb.FieldTargetUserID] = struct{}{}
 }
 
-// ResetGroupId resets all changes to the "groupId" field.
-func (m *GroupChatRecordMutation) ResetGroupId() {
-	m.groupId = nil
+// TargetUserIDCleared returns if the "target_user_id" field was cleared in this mutation.
+func (m *DoNotDisturbMutation) TargetUserIDCleared() bool {
+	_, ok := m.clearedFields[donotdisturb.FieldTargetUserID]
+	return ok
 }
 
-// SetMsgType sets the "msgType" field.
-func (m *GroupChatRecordMutation) SetMsgType(s string) {
-	m.msgType = &s
+// ResetTargetUserID resets all changes to the "target_user_id" field.
+func (m *DoNotDisturbMutation) ResetTargetUserID() {
+	m.target_user_id = nil
+	m.addtarget_user_id = nil
+	delete(m.clearedFields, donotdisturb.FieldTargetUserID)
 }
 
-// MsgType returns the value of the "msgType" field in the mutation.
-func (m *GroupChatRecordMutation) MsgType() (r string, exists bool) {
-	v := m.msgType
+// SetTargetGroupID sets the "target_group_id" field.
+func (m *DoNotDisturbMutation) SetTargetGroupID(i int) {
+	m.target_group_id = &i
+	m.addtarget_group_id = nil
+}
+
+// TargetGroupID returns the value of the "target_group_id" field in the mutation.
+func (m *DoNotDisturbMutation) TargetGroupID() (r int, exists bool) {
+	v := m.target_group_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMsgType returns the old "msgType" field's value of the GroupChatRecord entity.
-// If the GroupChatRecord object wasn't provided to the builder, the object is fetched from the database.
+// OldTargetGroupID returns the old "target_group_id" field's value of the DoNotDisturb entity.
+// If the DoNotDisturb object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupChatRecordMutation) OldMsgType(ctx context.Context) (v string, err error) {
+func (m *DoNotDisturbMutation) OldTargetGroupID(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMsgType is only allowed on UpdateOne operations")
+		return v, errors.New("OldTargetGroupID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMsgType requires an ID field in the mutation")
+		return v, errors.New("OldTargetGroupID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMsgType: %w", err)
+		return v, fmt.Errorf("querying old value for OldTargetGroupID: %w", err)
 	}
-	return oldValue.MsgType, nil
+	return oldValue.TargetGroupID, nil
 }
 
-// ResetMsgType resets all changes to the "msgType" field.
-func (m *GroupChatRecordMutation) ResetMsgType() {
-	m.msgType = nil
+// AddTargetGroupID adds i to the "target_group_id" field.
+func (m *DoNotDisturbMutation) AddTargetGroupID(i int) {
+	if m.addtarget_group_id != nil {
+		*m.addtarget_group_id += i
+	} else {
+		m.addtarget_group_id = &i
+	}
 }
 
-// SetCreateTime sets the "createTime" field.
-func (m *GroupChatRecordMutation) SetCreateTime(t time.Time) {
-	m.createTime = &t
+// AddedTargetGroupID returns the value that was added to the "target_group_id" field in this mutation.
+func (m *DoNotDisturbMutation) AddedTargetGroupID() (r int, exists bool) {
+	v := m.addtarget_group_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// CreateTime returns the value of the "createTime" field in the mutation.
-func (m *GroupChatRecordMutation) CreateTime() (r time.Time, exists bool) {
-	v := m.createTime
+// ClearTargetGroupID clears the value of the "target_group_id" field.
+func (m *DoNotDisturbMutation) ClearTargetGroupID() {
+	m.target_group_id = nil
+	m.addtarget_group_id = nil
+	m.clearedFields[donotdisturb.FieldTargetGroupID] = struct{}{}
+}
+
+// TargetGroupIDCleared returns if the "target_group_id" field was cleared in this mutation.
+func (m *DoNotDisturbMutation) TargetGroupIDCleared() bool {
+	_, ok := m.clearedFields[donotdisturb.FieldTargetGroupID]
+	return ok
+}
+
+// ResetTargetGroupID resets all changes to the "target_group_id" field.
+func (m *DoNotDisturbMutation) ResetTargetGroupID() {
+	m.target_group_id = nil
+	m.addtarget_group_id = nil
+	delete(m.clearedFields, donotdisturb.FieldTargetGroupID)
+}
+
+// SetIsGlobal sets the "is_global" field.
+func (m *DoNotDisturbMutation) SetIsGlobal(b bool) {
+	m.is_global = &b
+}
+
+// IsGlobal returns the value of the "is_global" field in the mutation.
+func (m *DoNotDisturbMutation) IsGlobal() (r bool, exists bool) {
+	v := m.is_global
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreateTime returns the old "createTime" field's value of the GroupChatRecord entity.
-// If the GroupChatRecord object wasn't provided to the builder, the object is fetched from the database.
+// OldIsGlobal returns the old "is_global" field's value of the DoNotDisturb entity.
+// If the DoNotDisturb object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupChatRecordMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *DoNotDisturbMutation) OldIsGlobal(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldIsGlobal is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+		return v, errors.New("OldIsGlobal requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldIsGlobal: %w", err)
 	}
-	return oldValue.CreateTime, nil
+	return oldValue.IsGlobal, nil
 }
 
-// ResetCreateTime resets all changes to the "createTime" field.
-func (m *GroupChatRecordMutation) ResetCreateTime() {
-	m.createTime = nil
+// ResetIsGlobal resets all changes to the "is_global" field.
+func (m *DoNotDisturbMutation) ResetIsGlobal() {
+	m.is_global = nil
 }
 
-// Where appends a list predicates to the GroupChatRecordMutation builder.
-func (m *GroupChatRecordMutation) Where(ps ...predicate.GroupChatRecord) {
-	m.predicates = append(m.predicates, ps...)
+// SetStartTime sets the "start_time" field.
+func (m *DoNotDisturbMutation) SetStartTime(t time.Time) {
+	m.start_time = &t
 }
 
-// WhereP appends storage-level predicates to the GroupChatRecordMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *GroupChatRecordMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.GroupChatRecord, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// StartTime returns the value of the "start_time" field in the mutation.
+func (m *DoNotDisturbMutation) StartTime() (r time.Time, exists bool) {
+	v := m.start_time
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *GroupChatRecordMutation) Op() Op {
-	return m.op
+// OldStartTime returns the old "start_time" field's value of the DoNotDisturb entity.
+// If the DoNotDisturb object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DoNotDisturbMutation) OldStartTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartTime: %w", err)
+	}
+	return oldValue.StartTime, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *GroupChatRecordMutation) SetOp(op Op) {
-	m.op = op
+// ClearStartTime clears the value of the "start_time" field.
+func (m *DoNotDisturbMutation) ClearStartTime() {
+	m.start_time = nil
+	m.clearedFields[donotdisturb.FieldStartTime] = struct{}{}
 }
 
-// Type returns the node type of this mutation (GroupChatRecord).
-func (m *GroupChatRecordMutation) Type() string {
-	return m.typ
+// StartTimeCleared returns if the "start_time" field was cleared in this mutation.
+func (m *DoNotDisturbMutation) StartTimeCleared() bool {
+	_, ok := m.clearedFields[donotdisturb.FieldStartTime]
+	return ok
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *GroupChatRecordMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.msgId != nil {
-		fields = append(fields, groupchatrecord.FieldMsgId)
-	}
-	if m.fromUserId != nil {
-		fields = append(fields, groupchatrecord.FieldFromUserId)
-	}
-	if m.groupId != nil {
-		fields = append(fields, groupchatrecord.FieldGroupId)
-	}
-	if m.msgType != nil {
-		fields = append(fields, groupchatrecord.FieldMsgType)
-	}
-	if m.createTime != nil {
-		fields = append(fields, groupchatrecord.FieldCreateTime)
-	}
-	return fields
+// ResetStartTime resets all changes to the "start_time" field.
+func (m *DoNotDisturbMutation) ResetStartTime() {
+	m.start_time = nil
+	delete(m.clearedFields, donotdisturb.FieldStartTime)
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *GroupChatRecordMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case groupchatrecord.FieldMsgId:
-		return m.MsgId()
-	case groupchatrecord.FieldFromUserId:
-		return m.FromUserId()
-	case groupchatrecord.FieldGroupId:
-		return m.GroupId()
-	case groupchatrecord.FieldMsgType:
-		return m.MsgType()
-	case groupchatrecord.FieldCreateTime:
-		return m.CreateTime()
-	}
-	return nil, false
+// SetEndTime sets the "end_time" field.
+func (m *DoNotDisturbMutation) SetEndTime(t time.Time) {
+	m.end_time = &t
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *GroupChatRecordMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case groupchatrecord.FieldMsgId:
-		return m.OldMsgId(ctx)
-	case groupchatrecord.FieldFromUserId:
-		return m.OldFromUserId(ctx)
-	case groupchatrecord.FieldGroupId:
-		return m.OldGroupId(ctx)
-	case groupchatrecord.FieldMsgType:
-		return m.OldMsgType(ctx)
-	case groupchatrecord.FieldCreateTime:
-		return m.OldCreateTime(ctx)
+// EndTime returns the value of the "end_time" field in the mutation.
+func (m *DoNotDisturbMutation) EndTime() (r time.Time, exists bool) {
+	v := m.end_time
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown GroupChatRecord field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *GroupChatRecordMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case groupchatrecord.FieldMsgId:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMsgId(v)
-		return nil
-	case groupchatrecord.FieldFromUserId:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFromUserId(v)
-		return nil
-	case groupchatrecord.FieldGroupId:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetGroupId(v)
-		return nil
-	case groupchatrecord.FieldMsgType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMsgType(v)
-		return nil
-	case groupchatrecord.FieldCreateTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreateTime(v)
-		return nil
+// OldEndTime returns the old "end_time" field's value of the DoNotDisturb entity.
+// If the DoNotDisturb object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DoNotDisturbMutation) OldEndTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndTime is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown GroupChatRecord field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndTime: %w", err)
+	}
+	return oldValue.EndTime, nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *GroupChatRecordMutation) AddedFields() []string {
-	return nil
+// ClearEndTime clears the value of the "end_time" field.
+func (m *DoNotDisturbMutation) ClearEndTime() {
+	m.end_time = nil
+	m.clearedFields[donotdisturb.FieldEndTime] = struct{}{}
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *GroupChatRecordMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// EndTimeCleared returns if the "end_time" field was cleared in this mutation.
+func (m *DoNotDisturbMutation) EndTimeCleared() bool {
+	_, ok := m.clearedFields[donotdisturb.FieldEndTime]
+	return ok
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *GroupChatRecordMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown GroupChatRecord numeric field %s", name)
-}
-
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *GroupChatRecordMutation) ClearedFields() []string {
-	return nil
-}
-
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *GroupChatRecordMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
-}
-
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *GroupChatRecordMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown GroupChatRecord nullable field %s", name)
-}
-
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *GroupChatRecordMutation) ResetField(name string) error {
-	switch name {
-	case groupchatrecord.FieldMsgId:
-		m.ResetMsgId()
-		return nil
-	case groupchatrecord.FieldFromUserId:
-		m.ResetFromUserId()
-		return nil
-	case groupchatrecord.FieldGroupId:
-		m.ResetGroupId()
-		return nil
-	case groupchatrecord.FieldMsgType:
-		m.ResetMsgType()
-		return nil
-	case groupchatrecord.FieldCreateTime:
-		m.ResetCreateTime()
-		return nil
-	}
-	return fmt.Errorf("unknown GroupChatRecord field %s", name)
-}
-
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *GroupChatRecordMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
-}
-
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *GroupChatRecordMutation) AddedIDs(name string) []ent.Value {
-	return nil
-}
-
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *GroupChatRecordMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
-}
-
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *GroupChatRecordMutation) RemovedIDs(name string) []ent.Value {
-	return nil
-}
-
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *GroupChatRecordMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
-}
-
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *GroupChatRecordMutation) EdgeCleared(name string) bool {
-	return false
-}
-
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *GroupChatRecordMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown GroupChatRecord unique edge %s", name)
-}
-
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *GroupChatRecordMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown GroupChatRecord edge %s", name)
-}
-
-// ImageMessageMutation represents an operation that mutates the ImageMessage nodes in the graph.
-type ImageMessageMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *int
-	msgId         *string
-	imageUrl      *string
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*ImageMessage, error)
-	predicates    []predicate.ImageMessage
+// ResetEndTime resets all changes to the "end_time" field.
+func (m *DoNotDisturbMutation) ResetEndTime() {
+	m.end_time = nil
+	delete(m.clearedFields, donotdisturb.FieldEndTime)
 }
 
-var _ ent.Mutation = (*ImageMessageMutation)(nil)
-
-// imagemessageOption allows management of the mutation configuration using functional options.
-type imagemessageOption func(*ImageMessageMutation)
-
-// newImageMessageMutation creates new mutation for the ImageMessage entity.
-func newImageMessageMutation(c config, op Op, opts ...imagemessageOption) *ImageMessageMutation {
-	m := &ImageMessageMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeImageMessage,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
+// SetCreatedAt sets the "created_at" field.
+func (m *DoNotDisturbMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// withImageMessageID sets the ID field of the mutation.
-func withImageMessageID(id int) imagemessageOption {
-	return func(m *ImageMessageMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *ImageMessage
-		)
-		m.oldValue = func(ctx context.Context) (*ImageMessage, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().ImageMessage.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DoNotDisturbMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// withImageMessage sets the old ImageMessage of the mutation.
-func withImageMessage(node *ImageMessage) imagemessageOption {
-	return func(m *ImageMessageMutation) {
-		m.oldValue = func(context.Context) (*ImageMessage, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// OldCreatedAt returns the old "created_at" field's value of the DoNotDisturb entity.
+// If the DoNotDisturb object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DoNotDisturbMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-}
-
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ImageMessageMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
-}
-
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m ImageMessageMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
-}
-
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *ImageMessageMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return *m.id, true
+	return oldValue.CreatedAt, nil
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *ImageMessageMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ImageMessage.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DoNotDisturbMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetMsgId sets the "msgId" field.
-func (m *ImageMessageMutation) SetMsgId(s string) {
-	m.msgId = &s
+// SetUpdatedAt sets the "updated_at" field.
+func (m *DoNotDisturbMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// MsgId returns the value of the "msgId" field in the mutation.
-func (m *ImageMessageMutation) MsgId() (r string, exists bool) {
-	v := m.msgId
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *DoNotDisturbMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMsgId returns the old "msgId" field's value of the ImageMessage entity.
-// If the ImageMessage object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the DoNotDisturb entity.
+// If the DoNotDisturb object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ImageMessageMutation) OldMsgId(ctx context.Context) (v string, err error) {
+func (m *DoNotDisturbMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMsgId is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMsgId requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMsgId: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.MsgId, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetMsgId resets all changes to the "msgId" field.
-func (m *ImageMessageMutation) ResetMsgId() {
-	m.msgId = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *DoNotDisturbMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetImageUrl sets the "imageUrl" field.
-func (m *ImageMessageMutation) SetImageUrl(s string) {
-	m.imageUrl = &s
+// Where appends a list predicates to the DoNotDisturbMutation builder.
+func (m *DoNotDisturbMutation) Where(ps ...predicate.DoNotDisturb) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ImageUrl returns the value of the "imageUrl" field in the mutation.
-func (m *ImageMessageMutation) ImageUrl() (r string, exists bool) {
-	v := m.imageUrl
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldImageUrl returns the old "imageUrl" field's value of the ImageMessage entity.
-// If the ImageMessage object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ImageMessageMutation) OldImageUrl(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldImageUrl is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldImageUrl requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldImageUrl: %w", err)
-	}
-	return oldValue.ImageUrl, nil
-}
-
-// ResetImageUrl resets all changes to the "imageUrl" field.
-func (m *ImageMessageMutation) ResetImageUrl() {
-	m.imageUrl = nil
-}
-
-// Where appends a list predicates to the ImageMessageMutation builder.
-func (m *ImageMessageMutation) Where(ps ...predicate.ImageMessage) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the ImageMessageMutation builder. Using this method,
+// WhereP appends storage-level predicates to the DoNotDisturbMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ImageMessageMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ImageMessage, len(ps))
+func (m *DoNotDisturbMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DoNotDisturb, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4527,30 +4393,48 @@ func (m *ImageMessageMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ImageMessageMutation) Op() Op {
+func (m *DoNotDisturbMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ImageMessageMutation) SetOp(op Op) {
+func (m *DoNotDisturbMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ImageMessage).
-func (m *ImageMessageMutation) Type() string {
+// Type returns the node type of this mutation (DoNotDisturb).
+func (m *DoNotDisturbMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ImageMessageMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.msgId != nil {
-		fields = append(fields, imagemessage.FieldMsgId)
+func (m *DoNotDisturbMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.user_id != nil {
+		fields = append(fields, donotdisturb.FieldUserID)
 	}
-	if m.imageUrl != nil {
-		fields = append(fields, imagemessage.FieldImageUrl)
+	if m.target_user_id != nil {
+		fields = append(fields, donotdisturb.FieldTargetUserID)
+	}
+	if m.target_group_id != nil {
+		fields = append(fields, donotdisturb.FieldTargetGroupID)
+	}
+	if m.is_global != nil {
+		fields = append(fields, donotdisturb.FieldIsGlobal)
+	}
+	if m.start_time != nil {
+		fields = append(fields, donotdisturb.FieldStartTime)
+	}
+	if m.end_time != nil {
+		fields = append(fields, donotdisturb.FieldEndTime)
+	}
+	if m.created_at != nil {
+		fields = append(fields, donotdisturb.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, donotdisturb.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -4558,12 +4442,24 @@ func (m *ImageMessageMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ImageMessageMutation) Field(name string) (ent.Value, bool) {
+func (m *DoNotDisturbMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case imagemessage.FieldMsgId:
-		return m.MsgId()
-	case imagemessage.FieldImageUrl:
-		return m.ImageUrl()
+	case donotdisturb.FieldUserID:
+		return m.UserID()
+	case donotdisturb.FieldTargetUserID:
+		return m.TargetUserID()
+	case donotdisturb.FieldTargetGroupID:
+		return m.TargetGroupID()
+	case donotdisturb.FieldIsGlobal:
+		return m.IsGlobal()
+	case donotdisturb.FieldStartTime:
+		return m.StartTime()
+	case donotdisturb.FieldEndTime:
+		return m.EndTime()
+	case donotdisturb.FieldCreatedAt:
+		return m.CreatedAt()
+	case donotdisturb.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -4571,171 +4467,323 @@ func (m *ImageMessageMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ImageMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *DoNotDisturbMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case imagemessage.FieldMsgId:
-		return m.OldMsgId(ctx)
-	case imagemessage.FieldImageUrl:
-		return m.OldImageUrl(ctx)
+	case donotdisturb.FieldUserID:
+		return m.OldUserID(ctx)
+	case donotdisturb.FieldTargetUserID:
+		return m.OldTargetUserID(ctx)
+	case donotdisturb.FieldTargetGroupID:
+		return m.OldTargetGroupID(ctx)
+	case donotdisturb.FieldIsGlobal:
+		return m.OldIsGlobal(ctx)
+	case donotdisturb.FieldStartTime:
+		return m.OldStartTime(ctx)
+	case donotdisturb.FieldEndTime:
+		return m.OldEndTime(ctx)
+	case donotdisturb.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case donotdisturb.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown ImageMessage field %s", name)
+	return nil, fmt.Errorf("unknown DoNotDisturb field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ImageMessageMutation) SetField(name string, value ent.Value) error {
+func (m *DoNotDisturbMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case imagemessage.FieldMsgId:
-		v, ok := value.(string)
+	case donotdisturb.FieldUserID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMsgId(v)
+		m.SetUserID(v)
 		return nil
-	case imagemessage.FieldImageUrl:
-		v, ok := value.(string)
+	case donotdisturb.FieldTargetUserID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetImageUrl(v)
+		m.SetTargetUserID(v)
+		return nil
+	case donotdisturb.FieldTargetGroupID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetGroupID(v)
+		return nil
+	case donotdisturb.FieldIsGlobal:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsGlobal(v)
+		return nil
+	case donotdisturb.FieldStartTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartTime(v)
+		return nil
+	case donotdisturb.FieldEndTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndTime(v)
+		return nil
+	case donotdisturb.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case donotdisturb.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ImageMessage field %s", name)
+	return fmt.Errorf("unknown DoNotDisturb field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ImageMessageMutation) AddedFields() []string {
-	return nil
+func (m *DoNotDisturbMutation) AddedFields() []string {
+	var fields []string
+	if m.adduser_id != nil {
+		fields = append(fields, donotdisturb.FieldUserID)
+	}
+	if m.addtarget_user_id != nil {
+		fields = append(fields, donotdisturb.FieldTargetUserID)
+	}
+	if m.addtarget_group_id != nil {
+		fields = append(fields, donotdisturb.FieldTargetGroupID)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ImageMessageMutation) AddedField(name string) (ent.Value, bool) {
+func (m *DoNotDisturbMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case donotdisturb.FieldUserID:
+		return m.AddedUserID()
+	case donotdisturb.FieldTargetUserID:
+		return m.AddedTargetUserID()
+	case donotdisturb.FieldTargetGroupID:
+		return m.AddedTargetGroupID()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ImageMessageMutation) AddField(name string, value ent.Value) error {
+func (m *DoNotDisturbMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case donotdisturb.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
+	case donotdisturb.FieldTargetUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTargetUserID(v)
+		return nil
+	case donotdisturb.FieldTargetGroupID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTargetGroupID(v)
+		return nil
 	}
-	return fmt.Errorf("unknown ImageMessage numeric field %s", name)
+	return fmt.Errorf("unknown DoNotDisturb numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ImageMessageMutation) ClearedFields() []string {
-	return nil
+func (m *DoNotDisturbMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(donotdisturb.FieldTargetUserID) {
+		fields = append(fields, donotdisturb.FieldTargetUserID)
+	}
+	if m.FieldCleared(donotdisturb.FieldTargetGroupID) {
+		fields = append(fields, donotdisturb.FieldTargetGroupID)
+	}
+	if m.FieldCleared(donotdisturb.FieldStartTime) {
+		fields = append(fields, donotdisturb.FieldStartTime)
+	}
+	if m.FieldCleared(donotdisturb.FieldEndTime) {
+		fields = append(fields, donotdisturb.FieldEndTime)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ImageMessageMutation) FieldCleared(name string) bool {
+func (m *DoNotDisturbMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ImageMessageMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown ImageMessage nullable field %s", name)
-}
-
+func (m *DoNotDisturbMutation) ClearField(name string) error {
+	switch name {
+	case donotdisturb.FieldTargetUserID:
+		m.ClearTargetUserID()
+		return nil
+	case donotdisturb.FieldTargetGroupID:
+		m.ClearTargetGroupID()
+		return nil
+	case donotdisturb.FieldStartTime:
+		m.ClearStartTime()
+		return nil
+	case donotdisturb.FieldEndTime:
+		m.ClearEndTime()
+		return nil
+	}
+	return fmt.Errorf("unknown DoNotDisturb nullable field %s", name)
+}
+
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ImageMessageMutation) ResetField(name string) error {
+func (m *DoNotDisturbMutation) ResetField(name string) error {
 	switch name {
-	case imagemessage.FieldMsgId:
-		m.ResetMsgId()
+	case donotdisturb.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case imagemessage.FieldImageUrl:
-		m.ResetImageUrl()
+	case donotdisturb.FieldTargetUserID:
+		m.ResetTargetUserID()
+		return nil
+	case donotdisturb.FieldTargetGroupID:
+		m.ResetTargetGroupID()
+		return nil
+	case donotdisturb.FieldIsGlobal:
+		m.ResetIsGlobal()
+		return nil
+	case donotdisturb.FieldStartTime:
+		m.ResetStartTime()
+		return nil
+	case donotdisturb.FieldEndTime:
+		m.ResetEndTime()
+		return nil
+	case donotdisturb.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case donotdisturb.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown ImageMessage field %s", name)
+	return fmt.Errorf("unknown DoNotDisturb field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ImageMessageMutation) AddedEdges() []string {
+func (m *DoNotDisturbMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ImageMessageMutation) AddedIDs(name string) []ent.Value {
+func (m *DoNotDisturbMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ImageMessageMutation) RemovedEdges() []string {
+func (m *DoNotDisturbMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ImageMessageMutation) RemovedIDs(name string) []ent.Value {
+func (m *DoNotDisturbMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ImageMessageMutation) ClearedEdges() []string {
+func (m *DoNotDisturbMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ImageMessageMutation) EdgeCleared(name string) bool {
+func (m *DoNotDisturbMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ImageMessageMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown ImageMessage unique edge %s", name)
+func (m *DoNotDisturbMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DoNotDisturb unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ImageMessageMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown ImageMessage edge %s", name)
+func (m *DoNotDisturbMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DoNotDisturb edge %s", name)
 }
 
-// MessageMutation represents an operation that mutates the Message nodes in the graph.
-type MessageMutation struct {
+// ExportJobMutation represents an operation that mutates the ExportJob nodes in the graph.
+type ExportJobMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
-	msgId         *string
-	msgType       *string
-	content       *string
-	isRevoked     *bool
-	revokeTime    *time.Time
+	userId        *int
+	adduserId     *int
+	isGroup       *bool
+	targetId      *int
+	addtargetId   *int
+	format        *string
+	status        *string
+	leaseTime     *time.Time
+	attempts      *int
+	addattempts   *int
+	total         *int
+	addtotal      *int
+	processed     *int
+	addprocessed  *int
+	objectName    *string
+	fileSize      *int64
+	addfileSize   *int64
+	lastError     *string
 	createTime    *time.Time
+	finishTime    *time.Time
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*Message, error)
-	predicates    []predicate.Message
+	oldValue      func(context.Context) (*ExportJob, error)
+	predicates    []predicate.ExportJob
 }
 
-var _ ent.Mutation = (*MessageMutation)(nil)
+var _ ent.Mutation = (*ExportJobMutation)(nil)
 
-// messageOption allows management of the mutation configuration using functional options.
-type messageOption func(*MessageMutation)
+// exportjobOption allows management of the mutation configuration using functional options.
+type exportjobOption func(*ExportJobMutation)
 
-// newMessageMutation creates new mutation for the Message entity.
-func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation {
-	m := &MessageMutation{
+// newExportJobMutation creates new mutation for the ExportJob entity.
+func newExportJobMutation(c config, op Op, opts ...exportjobOption) *ExportJobMutation {
+	m := &ExportJobMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMessage,
+		typ:           TypeExportJob,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4744,20 +4792,20 @@ func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation
 	return m
 }
 
-// withMessageID sets the ID field of the mutation.
-func withMessageID(id int) messageOption {
-	return func(m *MessageMutation) {
+// withExportJobID sets the ID field of the mutation.
+func withExportJobID(id int) exportjobOption {
+	return func(m *ExportJobMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Message
+			value *ExportJob
 		)
-		m.oldValue = func(ctx context.Context) (*Message, error) {
+		m.oldValue = func(ctx context.Context) (*ExportJob, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Message.Get(ctx, id)
+					value, err = m.Client().ExportJob.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4766,10 +4814,10 @@ func withMessageID(id int) messageOption {
 	}
 }
 
-// withMessage sets the old Message of the mutation.
-func withMessage(node *Message) messageOption {
-	return func(m *MessageMutation) {
-		m.oldValue = func(context.Context) (*Message, error) {
+// withExportJob sets the old ExportJob of the mutation.
+func withExportJob(node *ExportJob) exportjobOption {
+	return func(m *ExportJobMutation) {
+		m.oldValue = func(context.Context) (*ExportJob, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4778,7 +4826,7 @@ func withMessage(node *Message) messageOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MessageMutation) Client() *Client {
+func (m ExportJobMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4786,7 +4834,7 @@ func (m MessageMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MessageMutation) Tx() (*Tx, error) {
+func (m ExportJobMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4797,7 +4845,7 @@ func (m MessageMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MessageMutation) ID() (id int, exists bool) {
+func (m *ExportJobMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4808,7 +4856,7 @@ func (m *MessageMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MessageMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ExportJobMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4817,212 +4865,584 @@ func (m *MessageMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Message.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ExportJob.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetMsgId sets the "msgId" field.
-func (m *MessageMutation) SetMsgId(s string) {
-	m.msgId = &s
+// SetUserId sets the "userId" field.
+func (m *ExportJobMutation) SetUserId(i int) {
+	m.userId = &i
+	m.adduserId = nil
 }
 
-// MsgId returns the value of the "msgId" field in the mutation.
-func (m *MessageMutation) MsgId() (r string, exists bool) {
-	v := m.msgId
+// UserId returns the value of the "userId" field in the mutation.
+func (m *ExportJobMutation) UserId() (r int, exists bool) {
+	v := m.userId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMsgId returns the old "msgId" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldUserId returns the old "userId" field's value of the ExportJob entity.
+// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldMsgId(ctx context.Context) (v string, err error) {
+func (m *ExportJobMutation) OldUserId(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMsgId is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMsgId requires an ID field in the mutation")
+		return v, errors.New("OldUserId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMsgId: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserId: %w", err)
 	}
-	return oldValue.MsgId, nil
+	return oldValue.UserId, nil
 }
 
-// ResetMsgId resets all changes to the "msgId" field.
-func (m *MessageMutation) ResetMsgId() {
-	m.msgId = nil
+// AddUserId adds i to the "userId" field.
+func (m *ExportJobMutation) AddUserId(i int) {
+	if m.adduserId != nil {
+		*m.adduserId += i
+	} else {
+		m.adduserId = &i
+	}
 }
 
-// SetMsgType sets the "msgType" field.
-func (m *MessageMutation) SetMsgType(s string) {
-	m.msgType = &s
+// AddedUserId returns the value that was added to the "userId" field in this mutation.
+func (m *ExportJobMutation) AddedUserId() (r int, exists bool) {
+	v := m.adduserId
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// MsgType returns the value of the "msgType" field in the mutation.
-func (m *MessageMutation) MsgType() (r string, exists bool) {
-	v := m.msgType
+// ResetUserId resets all changes to the "userId" field.
+func (m *ExportJobMutation) ResetUserId() {
+	m.userId = nil
+	m.adduserId = nil
+}
+
+// SetIsGroup sets the "isGroup" field.
+func (m *ExportJobMutation) SetIsGroup(b bool) {
+	m.isGroup = &b
+}
+
+// IsGroup returns the value of the "isGroup" field in the mutation.
+func (m *ExportJobMutation) IsGroup() (r bool, exists bool) {
+	v := m.isGroup
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMsgType returns the old "msgType" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldIsGroup returns the old "isGroup" field's value of the ExportJob entity.
+// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldMsgType(ctx context.Context) (v string, err error) {
+func (m *ExportJobMutation) OldIsGroup(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMsgType is only allowed on UpdateOne operations")
+		return v, errors.New("OldIsGroup is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMsgType requires an ID field in the mutation")
+		return v, errors.New("OldIsGroup requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMsgType: %w", err)
+		return v, fmt.Errorf("querying old value for OldIsGroup: %w", err)
 	}
-	return oldValue.MsgType, nil
+	return oldValue.IsGroup, nil
 }
 
-// ResetMsgType resets all changes to the "msgType" field.
-func (m *MessageMutation) ResetMsgType() {
-	m.msgType = nil
+// ResetIsGroup resets all changes to the "isGroup" field.
+func (m *ExportJobMutation) ResetIsGroup() {
+	m.isGroup = nil
 }
 
-// SetContent sets the "content" field.
-func (m *MessageMutation) SetContent(s string) {
-	m.content = &s
+// SetTargetId sets the "targetId" field.
+func (m *ExportJobMutation) SetTargetId(i int) {
+	m.targetId = &i
+	m.addtargetId = nil
 }
 
-// Content returns the value of the "content" field in the mutation.
-func (m *MessageMutation) Content() (r string, exists bool) {
-	v := m.content
+// TargetId returns the value of the "targetId" field in the mutation.
+func (m *ExportJobMutation) TargetId() (r int, exists bool) {
+	v := m.targetId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContent returns the old "content" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldTargetId returns the old "targetId" field's value of the ExportJob entity.
+// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldContent(ctx context.Context) (v string, err error) {
+func (m *ExportJobMutation) OldTargetId(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+		return v, errors.New("OldTargetId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContent requires an ID field in the mutation")
+		return v, errors.New("OldTargetId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+		return v, fmt.Errorf("querying old value for OldTargetId: %w", err)
 	}
-	return oldValue.Content, nil
+	return oldValue.TargetId, nil
 }
 
-// ResetContent resets all changes to the "content" field.
-func (m *MessageMutation) ResetContent() {
-	m.content = nil
+// AddTargetId adds i to the "targetId" field.
+func (m *ExportJobMutation) AddTargetId(i int) {
+	if m.addtargetId != nil {
+		*m.addtargetId += i
+	} else {
+		m.addtargetId = &i
+	}
 }
 
-// SetIsRevoked sets the "isRevoked" field.
-func (m *MessageMutation) SetIsRevoked(b bool) {
-	m.isRevoked = &b
+// AddedTargetId returns the value that was added to the "targetId" field in this mutation.
+func (m *ExportJobMutation) AddedTargetId() (r int, exists bool) {
+	v := m.addtargetId
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// IsRevoked returns the value of the "isRevoked" field in the mutation.
-func (m *MessageMutation) IsRevoked() (r bool, exists bool) {
-	v := m.isRevoked
+// ResetTargetId resets all changes to the "targetId" field.
+func (m *ExportJobMutation) ResetTargetId() {
+	m.targetId = nil
+	m.addtargetId = nil
+}
+
+// SetFormat sets the "format" field.
+func (m *ExportJobMutation) SetFormat(s string) {
+	m.format = &s
+}
+
+// Format returns the value of the "format" field in the mutation.
+func (m *ExportJobMutation) Format() (r string, exists bool) {
+	v := m.format
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsRevoked returns the old "isRevoked" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldFormat returns the old "format" field's value of the ExportJob entity.
+// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldIsRevoked(ctx context.Context) (v bool, err error) {
+func (m *ExportJobMutation) OldFormat(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsRevoked is only allowed on UpdateOne operations")
+		return v, errors.New("OldFormat is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsRevoked requires an ID field in the mutation")
+		return v, errors.New("OldFormat requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsRevoked: %w", err)
+		return v, fmt.Errorf("querying old value for OldFormat: %w", err)
 	}
-	return oldValue.IsRevoked, nil
+	return oldValue.Format, nil
 }
 
-// ResetIsRevoked resets all changes to the "isRevoked" field.
-func (m *MessageMutation) ResetIsRevoked() {
-	m.isRevoked = nil
+// ResetFormat resets all changes to the "format" field.
+func (m *ExportJobMutation) ResetFormat() {
+	m.format = nil
 }
 
-// SetRevokeTime sets the "revokeTime" field.
-func (m *MessageMutation) SetRevokeTime(t time.Time) {
-	m.revokeTime = &t
+// SetStatus sets the "status" field.
+func (m *ExportJobMutation) SetStatus(s string) {
+	m.status = &s
 }
 
-// RevokeTime returns the value of the "revokeTime" field in the mutation.
-func (m *MessageMutation) RevokeTime() (r time.Time, exists bool) {
-	v := m.revokeTime
+// Status returns the value of the "status" field in the mutation.
+func (m *ExportJobMutation) Status() (r string, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRevokeTime returns the old "revokeTime" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the ExportJob entity.
+// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldRevokeTime(ctx context.Context) (v *time.Time, err error) {
+func (m *ExportJobMutation) OldStatus(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRevokeTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRevokeTime requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRevokeTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.RevokeTime, nil
+	return oldValue.Status, nil
 }
 
-// ClearRevokeTime clears the value of the "revokeTime" field.
-func (m *MessageMutation) ClearRevokeTime() {
-	m.revokeTime = nil
-	m.clearedFields[message.FieldRevokeTime] = struct{}{}
+// ResetStatus resets all changes to the "status" field.
+func (m *ExportJobMutation) ResetStatus() {
+	m.status = nil
 }
 
-// RevokeTimeCleared returns if the "revokeTime" field was cleared in this mutation.
-func (m *MessageMutation) RevokeTimeCleared() bool {
-	_, ok := m.clearedFields[message.FieldRevokeTime]
-	return ok
+// SetLeaseTime sets the "leaseTime" field.
+func (m *ExportJobMutation) SetLeaseTime(t time.Time) {
+	m.leaseTime = &t
 }
 
-// ResetRevokeTime resets all changes to the "revokeTime" field.
-func (m *MessageMutation) ResetRevokeTime() {
-	m.revokeTime = nil
-	delete(m.clearedFields, message.FieldRevokeTime)
+// LeaseTime returns the value of the "leaseTime" field in the mutation.
+func (m *ExportJobMutation) LeaseTime() (r time.Time, exists bool) {
+	v := m.leaseTime
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetCreateTime sets the "createTime" field.
-func (m *MessageMutation) SetCreateTime(t time.Time) {
+// OldLeaseTime returns the old "leaseTime" field's value of the ExportJob entity.
+// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportJobMutation) OldLeaseTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLeaseTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLeaseTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLeaseTime: %w", err)
+	}
+	return oldValue.LeaseTime, nil
+}
+
+// ClearLeaseTime clears the value of the "leaseTime" field.
+func (m *ExportJobMutation) ClearLeaseTime() {
+	m.leaseTime = nil
+	m.clearedFields[exportjob.FieldLeaseTime] = struct{}{}
+}
+
+// LeaseTimeCleared returns if the "leaseTime" field was cleared in this mutation.
+func (m *ExportJobMutation) LeaseTimeCleared() bool {
+	_, ok := m.clearedFields[exportjob.FieldLeaseTime]
+	return ok
+}
+
+// ResetLeaseTime resets all changes to the "leaseTime" field.
+func (m *ExportJobMutation) ResetLeaseTime() {
+	m.leaseTime = nil
+	delete(m.clearedFields, exportjob.FieldLeaseTime)
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *ExportJobMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *ExportJobMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the ExportJob entity.
+// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportJobMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *ExportJobMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *ExportJobMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *ExportJobMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetTotal sets the "total" field.
+func (m *ExportJobMutation) SetTotal(i int) {
+	m.total = &i
+	m.addtotal = nil
+}
+
+// Total returns the value of the "total" field in the mutation.
+func (m *ExportJobMutation) Total() (r int, exists bool) {
+	v := m.total
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotal returns the old "total" field's value of the ExportJob entity.
+// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportJobMutation) OldTotal(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotal: %w", err)
+	}
+	return oldValue.Total, nil
+}
+
+// AddTotal adds i to the "total" field.
+func (m *ExportJobMutation) AddTotal(i int) {
+	if m.addtotal != nil {
+		*m.addtotal += i
+	} else {
+		m.addtotal = &i
+	}
+}
+
+// AddedTotal returns the value that was added to the "total" field in this mutation.
+func (m *ExportJobMutation) AddedTotal() (r int, exists bool) {
+	v := m.addtotal
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotal resets all changes to the "total" field.
+func (m *ExportJobMutation) ResetTotal() {
+	m.total = nil
+	m.addtotal = nil
+}
+
+// SetProcessed sets the "processed" field.
+func (m *ExportJobMutation) SetProcessed(i int) {
+	m.processed = &i
+	m.addprocessed = nil
+}
+
+// Processed returns the value of the "processed" field in the mutation.
+func (m *ExportJobMutation) Processed() (r int, exists bool) {
+	v := m.processed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProcessed returns the old "processed" field's value of the ExportJob entity.
+// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportJobMutation) OldProcessed(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProcessed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProcessed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProcessed: %w", err)
+	}
+	return oldValue.Processed, nil
+}
+
+// AddProcessed adds i to the "processed" field.
+func (m *ExportJobMutation) AddProcessed(i int) {
+	if m.addprocessed != nil {
+		*m.addprocessed += i
+	} else {
+		m.addprocessed = &i
+	}
+}
+
+// AddedProcessed returns the value that was added to the "processed" field in this mutation.
+func (m *ExportJobMutation) AddedProcessed() (r int, exists bool) {
+	v := m.addprocessed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetProcessed resets all changes to the "processed" field.
+func (m *ExportJobMutation) ResetProcessed() {
+	m.processed = nil
+	m.addprocessed = nil
+}
+
+// SetObjectName sets the "objectName" field.
+func (m *ExportJobMutation) SetObjectName(s string) {
+	m.objectName = &s
+}
+
+// ObjectName returns the value of the "objectName" field in the mutation.
+func (m *ExportJobMutation) ObjectName() (r string, exists bool) {
+	v := m.objectName
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldObjectName returns the old "objectName" field's value of the ExportJob entity.
+// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportJobMutation) OldObjectName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldObjectName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldObjectName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldObjectName: %w", err)
+	}
+	return oldValue.ObjectName, nil
+}
+
+// ResetObjectName resets all changes to the "objectName" field.
+func (m *ExportJobMutation) ResetObjectName() {
+	m.objectName = nil
+}
+
+// SetFileSize sets the "fileSize" field.
+func (m *ExportJobMutation) SetFileSize(i int64) {
+	m.fileSize = &i
+	m.addfileSize = nil
+}
+
+// FileSize returns the value of the "fileSize" field in the mutation.
+func (m *ExportJobMutation) FileSize() (r int64, exists bool) {
+	v := m.fileSize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFileSize returns the old "fileSize" field's value of the ExportJob entity.
+// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportJobMutation) OldFileSize(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileSize: %w", err)
+	}
+	return oldValue.FileSize, nil
+}
+
+// AddFileSize adds i to the "fileSize" field.
+func (m *ExportJobMutation) AddFileSize(i int64) {
+	if m.addfileSize != nil {
+		*m.addfileSize += i
+	} else {
+		m.addfileSize = &i
+	}
+}
+
+// AddedFileSize returns the value that was added to the "fileSize" field in this mutation.
+func (m *ExportJobMutation) AddedFileSize() (r int64, exists bool) {
+	v := m.addfileSize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFileSize resets all changes to the "fileSize" field.
+func (m *ExportJobMutation) ResetFileSize() {
+	m.fileSize = nil
+	m.addfileSize = nil
+}
+
+// SetLastError sets the "lastError" field.
+func (m *ExportJobMutation) SetLastError(s string) {
+	m.lastError = &s
+}
+
+// LastError returns the value of the "lastError" field in the mutation.
+func (m *ExportJobMutation) LastError() (r string, exists bool) {
+	v := m.lastError
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastError returns the old "lastError" field's value of the ExportJob entity.
+// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportJobMutation) OldLastError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ResetLastError resets all changes to the "lastError" field.
+func (m *ExportJobMutation) ResetLastError() {
+	m.lastError = nil
+}
+
+// SetCreateTime sets the "createTime" field.
+func (m *ExportJobMutation) SetCreateTime(t time.Time) {
 	m.createTime = &t
 }
 
 // CreateTime returns the value of the "createTime" field in the mutation.
-func (m *MessageMutation) CreateTime() (r time.Time, exists bool) {
+func (m *ExportJobMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.createTime
 	if v == nil {
 		return
@@ -5030,10 +5450,10 @@ func (m *MessageMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "createTime" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "createTime" field's value of the ExportJob entity.
+// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *ExportJobMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -5048,19 +5468,68 @@ func (m *MessageMutation) OldCreateTime(ctx context.Context) (v time.Time, err e
 }
 
 // ResetCreateTime resets all changes to the "createTime" field.
-func (m *MessageMutation) ResetCreateTime() {
+func (m *ExportJobMutation) ResetCreateTime() {
 	m.createTime = nil
 }
 
-// Where appends a list predicates to the MessageMutation builder.
-func (m *MessageMutation) Where(ps ...predicate.Message) {
+// SetFinishTime sets the "finishTime" field.
+func (m *ExportJobMutation) SetFinishTime(t time.Time) {
+	m.finishTime = &t
+}
+
+// FinishTime returns the value of the "finishTime" field in the mutation.
+func (m *ExportJobMutation) FinishTime() (r time.Time, exists bool) {
+	v := m.finishTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFinishTime returns the old "finishTime" field's value of the ExportJob entity.
+// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportJobMutation) OldFinishTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinishTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinishTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinishTime: %w", err)
+	}
+	return oldValue.FinishTime, nil
+}
+
+// ClearFinishTime clears the value of the "finishTime" field.
+func (m *ExportJobMutation) ClearFinishTime() {
+	m.finishTime = nil
+	m.clearedFields[exportjob.FieldFinishTime] = struct{}{}
+}
+
+// FinishTimeCleared returns if the "finishTime" field was cleared in this mutation.
+func (m *ExportJobMutation) FinishTimeCleared() bool {
+	_, ok := m.clearedFields[exportjob.FieldFinishTime]
+	return ok
+}
+
+// ResetFinishTime resets all changes to the "finishTime" field.
+func (m *ExportJobMutation) ResetFinishTime() {
+	m.finishTime = nil
+	delete(m.clearedFields, exportjob.FieldFinishTime)
+}
+
+// Where appends a list predicates to the ExportJobMutation builder.
+func (m *ExportJobMutation) Where(ps ...predicate.ExportJob) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MessageMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ExportJobMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Message, len(ps))
+func (m *ExportJobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ExportJob, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5068,42 +5537,66 @@ func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MessageMutation) Op() Op {
+func (m *ExportJobMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MessageMutation) SetOp(op Op) {
+func (m *ExportJobMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Message).
-func (m *MessageMutation) Type() string {
+// Type returns the node type of this mutation (ExportJob).
+func (m *ExportJobMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MessageMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.msgId != nil {
-		fields = append(fields, message.FieldMsgId)
+func (m *ExportJobMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.userId != nil {
+		fields = append(fields, exportjob.FieldUserId)
 	}
-	if m.msgType != nil {
-		fields = append(fields, message.FieldMsgType)
+	if m.isGroup != nil {
+		fields = append(fields, exportjob.FieldIsGroup)
 	}
-	if m.content != nil {
-		fields = append(fields, message.FieldContent)
+	if m.targetId != nil {
+		fields = append(fields, exportjob.FieldTargetId)
 	}
-	if m.isRevoked != nil {
-		fields = append(fields, message.FieldIsRevoked)
+	if m.format != nil {
+		fields = append(fields, exportjob.FieldFormat)
 	}
-	if m.revokeTime != nil {
-		fields = append(fields, message.FieldRevokeTime)
+	if m.status != nil {
+		fields = append(fields, exportjob.FieldStatus)
+	}
+	if m.leaseTime != nil {
+		fields = append(fields, exportjob.FieldLeaseTime)
+	}
+	if m.attempts != nil {
+		fields = append(fields, exportjob.FieldAttempts)
+	}
+	if m.total != nil {
+		fields = append(fields, exportjob.FieldTotal)
+	}
+	if m.processed != nil {
+		fields = append(fields, exportjob.FieldProcessed)
+	}
+	if m.objectName != nil {
+		fields = append(fields, exportjob.FieldObjectName)
+	}
+	if m.fileSize != nil {
+		fields = append(fields, exportjob.FieldFileSize)
+	}
+	if m.lastError != nil {
+		fields = append(fields, exportjob.FieldLastError)
 	}
 	if m.createTime != nil {
-		fields = append(fields, message.FieldCreateTime)
+		fields = append(fields, exportjob.FieldCreateTime)
+	}
+	if m.finishTime != nil {
+		fields = append(fields, exportjob.FieldFinishTime)
 	}
 	return fields
 }
@@ -5111,20 +5604,36 @@ func (m *MessageMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MessageMutation) Field(name string) (ent.Value, bool) {
+func (m *ExportJobMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case message.FieldMsgId:
-		return m.MsgId()
-	case message.FieldMsgType:
-		return m.MsgType()
-	case message.FieldContent:
-		return m.Content()
-	case message.FieldIsRevoked:
-		return m.IsRevoked()
-	case message.FieldRevokeTime:
-		return m.RevokeTime()
-	case message.FieldCreateTime:
+	case exportjob.FieldUserId:
+		return m.UserId()
+	case exportjob.FieldIsGroup:
+		return m.IsGroup()
+	case exportjob.FieldTargetId:
+		return m.TargetId()
+	case exportjob.FieldFormat:
+		return m.Format()
+	case exportjob.FieldStatus:
+		return m.Status()
+	case exportjob.FieldLeaseTime:
+		return m.LeaseTime()
+	case exportjob.FieldAttempts:
+		return m.Attempts()
+	case exportjob.FieldTotal:
+		return m.Total()
+	case exportjob.FieldProcessed:
+		return m.Processed()
+	case exportjob.FieldObjectName:
+		return m.ObjectName()
+	case exportjob.FieldFileSize:
+		return m.FileSize()
+	case exportjob.FieldLastError:
+		return m.LastError()
+	case exportjob.FieldCreateTime:
 		return m.CreateTime()
+	case exportjob.FieldFinishTime:
+		return m.FinishTime()
 	}
 	return nil, false
 }
@@ -5132,230 +5641,406 @@ func (m *MessageMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ExportJobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case message.FieldMsgId:
-		return m.OldMsgId(ctx)
-	case message.FieldMsgType:
-		return m.OldMsgType(ctx)
-	case message.FieldContent:
-		return m.OldContent(ctx)
-	case message.FieldIsRevoked:
-		return m.OldIsRevoked(ctx)
-	case message.FieldRevokeTime:
-		return m.OldRevokeTime(ctx)
-	case message.FieldCreateTime:
+	case exportjob.FieldUserId:
+		return m.OldUserId(ctx)
+	case exportjob.FieldIsGroup:
+		return m.OldIsGroup(ctx)
+	case exportjob.FieldTargetId:
+		return m.OldTargetId(ctx)
+	case exportjob.FieldFormat:
+		return m.OldFormat(ctx)
+	case exportjob.FieldStatus:
+		return m.OldStatus(ctx)
+	case exportjob.FieldLeaseTime:
+		return m.OldLeaseTime(ctx)
+	case exportjob.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case exportjob.FieldTotal:
+		return m.OldTotal(ctx)
+	case exportjob.FieldProcessed:
+		return m.OldProcessed(ctx)
+	case exportjob.FieldObjectName:
+		return m.OldObjectName(ctx)
+	case exportjob.FieldFileSize:
+		return m.OldFileSize(ctx)
+	case exportjob.FieldLastError:
+		return m.OldLastError(ctx)
+	case exportjob.FieldCreateTime:
 		return m.OldCreateTime(ctx)
+	case exportjob.FieldFinishTime:
+		return m.OldFinishTime(ctx)
 	}
-	return nil, fmt.Errorf("unknown Message field %s", name)
+	return nil, fmt.Errorf("unknown ExportJob field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageMutation) SetField(name string, value ent.Value) error {
+func (m *ExportJobMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case message.FieldMsgId:
+	case exportjob.FieldUserId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserId(v)
+		return nil
+	case exportjob.FieldIsGroup:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsGroup(v)
+		return nil
+	case exportjob.FieldTargetId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetId(v)
+		return nil
+	case exportjob.FieldFormat:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMsgId(v)
+		m.SetFormat(v)
 		return nil
-	case message.FieldMsgType:
+	case exportjob.FieldStatus:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMsgType(v)
+		m.SetStatus(v)
 		return nil
-	case message.FieldContent:
+	case exportjob.FieldLeaseTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLeaseTime(v)
+		return nil
+	case exportjob.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case exportjob.FieldTotal:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotal(v)
+		return nil
+	case exportjob.FieldProcessed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProcessed(v)
+		return nil
+	case exportjob.FieldObjectName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContent(v)
+		m.SetObjectName(v)
 		return nil
-	case message.FieldIsRevoked:
-		v, ok := value.(bool)
+	case exportjob.FieldFileSize:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsRevoked(v)
+		m.SetFileSize(v)
 		return nil
-	case message.FieldRevokeTime:
-		v, ok := value.(time.Time)
+	case exportjob.FieldLastError:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRevokeTime(v)
+		m.SetLastError(v)
 		return nil
-	case message.FieldCreateTime:
+	case exportjob.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
+	case exportjob.FieldFinishTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishTime(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Message field %s", name)
+	return fmt.Errorf("unknown ExportJob field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MessageMutation) AddedFields() []string {
-	return nil
+func (m *ExportJobMutation) AddedFields() []string {
+	var fields []string
+	if m.adduserId != nil {
+		fields = append(fields, exportjob.FieldUserId)
+	}
+	if m.addtargetId != nil {
+		fields = append(fields, exportjob.FieldTargetId)
+	}
+	if m.addattempts != nil {
+		fields = append(fields, exportjob.FieldAttempts)
+	}
+	if m.addtotal != nil {
+		fields = append(fields, exportjob.FieldTotal)
+	}
+	if m.addprocessed != nil {
+		fields = append(fields, exportjob.FieldProcessed)
+	}
+	if m.addfileSize != nil {
+		fields = append(fields, exportjob.FieldFileSize)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MessageMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ExportJobMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case exportjob.FieldUserId:
+		return m.AddedUserId()
+	case exportjob.FieldTargetId:
+		return m.AddedTargetId()
+	case exportjob.FieldAttempts:
+		return m.AddedAttempts()
+	case exportjob.FieldTotal:
+		return m.AddedTotal()
+	case exportjob.FieldProcessed:
+		return m.AddedProcessed()
+	case exportjob.FieldFileSize:
+		return m.AddedFileSize()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageMutation) AddField(name string, value ent.Value) error {
+func (m *ExportJobMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case exportjob.FieldUserId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserId(v)
+		return nil
+	case exportjob.FieldTargetId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTargetId(v)
+		return nil
+	case exportjob.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	case exportjob.FieldTotal:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotal(v)
+		return nil
+	case exportjob.FieldProcessed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddProcessed(v)
+		return nil
+	case exportjob.FieldFileSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFileSize(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Message numeric field %s", name)
+	return fmt.Errorf("unknown ExportJob numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MessageMutation) ClearedFields() []string {
+func (m *ExportJobMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(message.FieldRevokeTime) {
-		fields = append(fields, message.FieldRevokeTime)
+	if m.FieldCleared(exportjob.FieldLeaseTime) {
+		fields = append(fields, exportjob.FieldLeaseTime)
+	}
+	if m.FieldCleared(exportjob.FieldFinishTime) {
+		fields = append(fields, exportjob.FieldFinishTime)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MessageMutation) FieldCleared(name string) bool {
+func (m *ExportJobMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MessageMutation) ClearField(name string) error {
+func (m *ExportJobMutation) ClearField(name string) error {
 	switch name {
-	case message.FieldRevokeTime:
-		m.ClearRevokeTime()
+	case exportjob.FieldLeaseTime:
+		m.ClearLeaseTime()
+		return nil
+	case exportjob.FieldFinishTime:
+		m.ClearFinishTime()
 		return nil
 	}
-	return fmt.Errorf("unknown Message nullable field %s", name)
+	return fmt.Errorf("unknown ExportJob nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MessageMutation) ResetField(name string) error {
+func (m *ExportJobMutation) ResetField(name string) error {
 	switch name {
-	case message.FieldMsgId:
-		m.ResetMsgId()
+	case exportjob.FieldUserId:
+		m.ResetUserId()
 		return nil
-	case message.FieldMsgType:
-		m.ResetMsgType()
+	case exportjob.FieldIsGroup:
+		m.ResetIsGroup()
 		return nil
-	case message.FieldContent:
-		m.ResetContent()
+	case exportjob.FieldTargetId:
+		m.ResetTargetId()
 		return nil
-	case message.FieldIsRevoked:
-		m.ResetIsRevoked()
+	case exportjob.FieldFormat:
+		m.ResetFormat()
 		return nil
-	case message.FieldRevokeTime:
-		m.ResetRevokeTime()
+	case exportjob.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case message.FieldCreateTime:
+	case exportjob.FieldLeaseTime:
+		m.ResetLeaseTime()
+		return nil
+	case exportjob.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case exportjob.FieldTotal:
+		m.ResetTotal()
+		return nil
+	case exportjob.FieldProcessed:
+		m.ResetProcessed()
+		return nil
+	case exportjob.FieldObjectName:
+		m.ResetObjectName()
+		return nil
+	case exportjob.FieldFileSize:
+		m.ResetFileSize()
+		return nil
+	case exportjob.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case exportjob.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
+	case exportjob.FieldFinishTime:
+		m.ResetFinishTime()
+		return nil
 	}
-	return fmt.Errorf("unknown Message field %s", name)
+	return fmt.Errorf("unknown ExportJob field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MessageMutation) AddedEdges() []string {
+func (m *ExportJobMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MessageMutation) AddedIDs(name string) []ent.Value {
+func (m *ExportJobMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MessageMutation) RemovedEdges() []string {
+func (m *ExportJobMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MessageMutation) RemovedIDs(name string) []ent.Value {
+func (m *ExportJobMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MessageMutation) ClearedEdges() []string {
+func (m *ExportJobMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MessageMutation) EdgeCleared(name string) bool {
+func (m *ExportJobMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MessageMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Message unique edge %s", name)
+func (m *ExportJobMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ExportJob unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MessageMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Message edge %s", name)
+func (m *ExportJobMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ExportJob edge %s", name)
 }
 
-// MessageStatusMutation represents an operation that mutates the MessageStatus nodes in the graph.
-type MessageStatusMutation struct {
+// FileMessageMutation represents an operation that mutates the FileMessage nodes in the graph.
+type FileMessageMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
 	msgId         *string
-	userId        *int
-	adduserId     *int
-	isDelivered   *bool
-	isRead        *bool
-	deliveredTime *time.Time
-	readTime      *time.Time
-	createTime    *time.Time
+	fileName      *string
+	fileSize      *int64
+	addfileSize   *int64
+	mimeType      *string
+	checksum      *string
+	storageKey    *string
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*MessageStatus, error)
-	predicates    []predicate.MessageStatus
+	oldValue      func(context.Context) (*FileMessage, error)
+	predicates    []predicate.FileMessage
 }
 
-var _ ent.Mutation = (*MessageStatusMutation)(nil)
+var _ ent.Mutation = (*FileMessageMutation)(nil)
 
-// messagestatusOption allows management of the mutation configuration using functional options.
-type messagestatusOption func(*MessageStatusMutation)
+// filemessageOption allows management of the mutation configuration using functional options.
+type filemessageOption func(*FileMessageMutation)
 
-// newMessageStatusMutation creates new mutation for the MessageStatus entity.
-func newMessageStatusMutation(c config, op Op, opts ...messagestatusOption) *MessageStatusMutation {
-	m := &MessageStatusMutation{
+// newFileMessageMutation creates new mutation for the FileMessage entity.
+func newFileMessageMutation(c config, op Op, opts ...filemessageOption) *FileMessageMutation {
+	m := &FileMessageMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMessageStatus,
+		typ:           TypeFileMessage,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5364,20 +6049,20 @@ func newMessageStatusMutation(c config, op Op, opts ...messagestatusOption) *Mes
 	return m
 }
 
-// withMessageStatusID sets the ID field of the mutation.
-func withMessageStatusID(id int) messagestatusOption {
-	return func(m *MessageStatusMutation) {
+// withFileMessageID sets the ID field of the mutation.
+func withFileMessageID(id int) filemessageOption {
+	return func(m *FileMessageMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *MessageStatus
+			value *FileMessage
 		)
-		m.oldValue = func(ctx context.Context) (*MessageStatus, error) {
+		m.oldValue = func(ctx context.Context) (*FileMessage, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().MessageStatus.Get(ctx, id)
+					value, err = m.Client().FileMessage.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5386,10 +6071,10 @@ func withMessageStatusID(id int) messagestatusOption {
 	}
 }
 
-// withMessageStatus sets the old MessageStatus of the mutation.
-func withMessageStatus(node *MessageStatus) messagestatusOption {
-	return func(m *MessageStatusMutation) {
-		m.oldValue = func(context.Context) (*MessageStatus, error) {
+// withFileMessage sets the old FileMessage of the mutation.
+func withFileMessage(node *FileMessage) filemessageOption {
+	return func(m *FileMessageMutation) {
+		m.oldValue = func(context.Context) (*FileMessage, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5398,7 +6083,7 @@ func withMessageStatus(node *MessageStatus) messagestatusOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MessageStatusMutation) Client() *Client {
+func (m FileMessageMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5406,7 +6091,7 @@ func (m MessageStatusMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MessageStatusMutation) Tx() (*Tx, error) {
+func (m FileMessageMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -5417,7 +6102,7 @@ func (m MessageStatusMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MessageStatusMutation) ID() (id int, exists bool) {
+func (m *FileMessageMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5428,7 +6113,7 @@ func (m *MessageStatusMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MessageStatusMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *FileMessageMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -5437,19 +6122,19 @@ func (m *MessageStatusMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().MessageStatus.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().FileMessage.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetMsgId sets the "msgId" field.
-func (m *MessageStatusMutation) SetMsgId(s string) {
+func (m *FileMessageMutation) SetMsgId(s string) {
 	m.msgId = &s
 }
 
 // MsgId returns the value of the "msgId" field in the mutation.
-func (m *MessageStatusMutation) MsgId() (r string, exists bool) {
+func (m *FileMessageMutation) MsgId() (r string, exists bool) {
 	v := m.msgId
 	if v == nil {
 		return
@@ -5457,10 +6142,10 @@ func (m *MessageStatusMutation) MsgId() (r string, exists bool) {
 	return *v, true
 }
 
-// OldMsgId returns the old "msgId" field's value of the MessageStatus entity.
-// If the MessageStatus object wasn't provided to the builder, the object is fetched from the database.
+// OldMsgId returns the old "msgId" field's value of the FileMessage entity.
+// If the FileMessage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageStatusMutation) OldMsgId(ctx context.Context) (v string, err error) {
+func (m *FileMessageMutation) OldMsgId(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldMsgId is only allowed on UpdateOne operations")
 	}
@@ -5475,281 +6160,219 @@ func (m *MessageStatusMutation) OldMsgId(ctx context.Context) (v string, err err
 }
 
 // ResetMsgId resets all changes to the "msgId" field.
-func (m *MessageStatusMutation) ResetMsgId() {
+func (m *FileMessageMutation) ResetMsgId() {
 	m.msgId = nil
 }
 
-// SetUserId sets the "userId" field.
-func (m *MessageStatusMutation) SetUserId(i int) {
-	m.userId = &i
-	m.adduserId = nil
+// SetFileName sets the "fileName" field.
+func (m *FileMessageMutation) SetFileName(s string) {
+	m.fileName = &s
 }
 
-// UserId returns the value of the "userId" field in the mutation.
-func (m *MessageStatusMutation) UserId() (r int, exists bool) {
-	v := m.userId
+// FileName returns the value of the "fileName" field in the mutation.
+func (m *FileMessageMutation) FileName() (r string, exists bool) {
+	v := m.fileName
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserId returns the old "userId" field's value of the MessageStatus entity.
-// If the MessageStatus object wasn't provided to the builder, the object is fetched from the database.
+// OldFileName returns the old "fileName" field's value of the FileMessage entity.
+// If the FileMessage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageStatusMutation) OldUserId(ctx context.Context) (v int, err error) {
+func (m *FileMessageMutation) OldFileName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserId is only allowed on UpdateOne operations")
+		return v, errors.New("OldFileName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserId requires an ID field in the mutation")
+		return v, errors.New("OldFileName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserId: %w", err)
-	}
-	return oldValue.UserId, nil
-}
-
-// AddUserId adds i to the "userId" field.
-func (m *MessageStatusMutation) AddUserId(i int) {
-	if m.adduserId != nil {
-		*m.adduserId += i
-	} else {
-		m.adduserId = &i
-	}
-}
-
-// AddedUserId returns the value that was added to the "userId" field in this mutation.
-func (m *MessageStatusMutation) AddedUserId() (r int, exists bool) {
-	v := m.adduserId
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldFileName: %w", err)
 	}
-	return *v, true
+	return oldValue.FileName, nil
 }
 
-// ResetUserId resets all changes to the "userId" field.
-func (m *MessageStatusMutation) ResetUserId() {
-	m.userId = nil
-	m.adduserId = nil
+// ResetFileName resets all changes to the "fileName" field.
+func (m *FileMessageMutation) ResetFileName() {
+	m.fileName = nil
 }
 
-// SetIsDelivered sets the "isDelivered" field.
-func (m *MessageStatusMutation) SetIsDelivered(b bool) {
-	m.isDelivered = &b
+// SetFileSize sets the "fileSize" field.
+func (m *FileMessageMutation) SetFileSize(i int64) {
+	m.fileSize = &i
+	m.addfileSize = nil
 }
 
-// IsDelivered returns the value of the "isDelivered" field in the mutation.
-func (m *MessageStatusMutation) IsDelivered() (r bool, exists bool) {
-	v := m.isDelivered
+// FileSize returns the value of the "fileSize" field in the mutation.
+func (m *FileMessageMutation) FileSize() (r int64, exists bool) {
+	v := m.fileSize
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsDelivered returns the old "isDelivered" field's value of the MessageStatus entity.
-// If the MessageStatus object wasn't provided to the builder, the object is fetched from the database.
+// OldFileSize returns the old "fileSize" field's value of the FileMessage entity.
+// If the FileMessage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageStatusMutation) OldIsDelivered(ctx context.Context) (v bool, err error) {
+func (m *FileMessageMutation) OldFileSize(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsDelivered is only allowed on UpdateOne operations")
+		return v, errors.New("OldFileSize is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsDelivered requires an ID field in the mutation")
+		return v, errors.New("OldFileSize requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsDelivered: %w", err)
+		return v, fmt.Errorf("querying old value for OldFileSize: %w", err)
 	}
-	return oldValue.IsDelivered, nil
+	return oldValue.FileSize, nil
 }
 
-// ResetIsDelivered resets all changes to the "isDelivered" field.
-func (m *MessageStatusMutation) ResetIsDelivered() {
-	m.isDelivered = nil
-}
-
-// SetIsRead sets the "isRead" field.
-func (m *MessageStatusMutation) SetIsRead(b bool) {
-	m.isRead = &b
+// AddFileSize adds i to the "fileSize" field.
+func (m *FileMessageMutation) AddFileSize(i int64) {
+	if m.addfileSize != nil {
+		*m.addfileSize += i
+	} else {
+		m.addfileSize = &i
+	}
 }
 
-// IsRead returns the value of the "isRead" field in the mutation.
-func (m *MessageStatusMutation) IsRead() (r bool, exists bool) {
-	v := m.isRead
+// AddedFileSize returns the value that was added to the "fileSize" field in this mutation.
+func (m *FileMessageMutation) AddedFileSize() (r int64, exists bool) {
+	v := m.addfileSize
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsRead returns the old "isRead" field's value of the MessageStatus entity.
-// If the MessageStatus object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageStatusMutation) OldIsRead(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsRead is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsRead requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsRead: %w", err)
-	}
-	return oldValue.IsRead, nil
-}
-
-// ResetIsRead resets all changes to the "isRead" field.
-func (m *MessageStatusMutation) ResetIsRead() {
-	m.isRead = nil
+// ResetFileSize resets all changes to the "fileSize" field.
+func (m *FileMessageMutation) ResetFileSize() {
+	m.fileSize = nil
+	m.addfileSize = nil
 }
 
-// SetDeliveredTime sets the "deliveredTime" field.
-func (m *MessageStatusMutation) SetDeliveredTime(t time.Time) {
-	m.deliveredTime = &t
+// SetMimeType sets the "mimeType" field.
+func (m *FileMessageMutation) SetMimeType(s string) {
+	m.mimeType = &s
 }
 
-// DeliveredTime returns the value of the "deliveredTime" field in the mutation.
-func (m *MessageStatusMutation) DeliveredTime() (r time.Time, exists bool) {
-	v := m.deliveredTime
+// MimeType returns the value of the "mimeType" field in the mutation.
+func (m *FileMessageMutation) MimeType() (r string, exists bool) {
+	v := m.mimeType
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeliveredTime returns the old "deliveredTime" field's value of the MessageStatus entity.
-// If the MessageStatus object wasn't provided to the builder, the object is fetched from the database.
+// OldMimeType returns the old "mimeType" field's value of the FileMessage entity.
+// If the FileMessage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageStatusMutation) OldDeliveredTime(ctx context.Context) (v *time.Time, err error) {
+func (m *FileMessageMutation) OldMimeType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeliveredTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldMimeType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeliveredTime requires an ID field in the mutation")
+		return v, errors.New("OldMimeType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeliveredTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldMimeType: %w", err)
 	}
-	return oldValue.DeliveredTime, nil
-}
-
-// ClearDeliveredTime clears the value of the "deliveredTime" field.
-func (m *MessageStatusMutation) ClearDeliveredTime() {
-	m.deliveredTime = nil
-	m.clearedFields[messagestatus.FieldDeliveredTime] = struct{}{}
-}
-
-// DeliveredTimeCleared returns if the "deliveredTime" field was cleared in this mutation.
-func (m *MessageStatusMutation) DeliveredTimeCleared() bool {
-	_, ok := m.clearedFields[messagestatus.FieldDeliveredTime]
-	return ok
+	return oldValue.MimeType, nil
 }
 
-// ResetDeliveredTime resets all changes to the "deliveredTime" field.
-func (m *MessageStatusMutation) ResetDeliveredTime() {
-	m.deliveredTime = nil
-	delete(m.clearedFields, messagestatus.FieldDeliveredTime)
+// ResetMimeType resets all changes to the "mimeType" field.
+func (m *FileMessageMutation) ResetMimeType() {
+	m.mimeType = nil
 }
 
-// SetReadTime sets the "readTime" field.
-func (m *MessageStatusMutation) SetReadTime(t time.Time) {
-	m.readTime = &t
+// SetChecksum sets the "checksum" field.
+func (m *FileMessageMutation) SetChecksum(s string) {
+	m.checksum = &s
 }
 
-// ReadTime returns the value of the "readTime" field in the mutation.
-func (m *MessageStatusMutation) ReadTime() (r time.Time, exists bool) {
-	v := m.readTime
+// Checksum returns the value of the "checksum" field in the mutation.
+func (m *FileMessageMutation) Checksum() (r string, exists bool) {
+	v := m.checksum
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldReadTime returns the old "readTime" field's value of the MessageStatus entity.
-// If the MessageStatus object wasn't provided to the builder, the object is fetched from the database.
+// OldChecksum returns the old "checksum" field's value of the FileMessage entity.
+// If the FileMessage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageStatusMutation) OldReadTime(ctx context.Context) (v *time.Time, err error) {
+func (m *FileMessageMutation) OldChecksum(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReadTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldChecksum is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReadTime requires an ID field in the mutation")
+		return v, errors.New("OldChecksum requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReadTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldChecksum: %w", err)
 	}
-	return oldValue.ReadTime, nil
-}
-
-// ClearReadTime clears the value of the "readTime" field.
-func (m *MessageStatusMutation) ClearReadTime() {
-	m.readTime = nil
-	m.clearedFields[messagestatus.FieldReadTime] = struct{}{}
-}
-
-// ReadTimeCleared returns if the "readTime" field was cleared in this mutation.
-func (m *MessageStatusMutation) ReadTimeCleared() bool {
-	_, ok := m.clearedFields[messagestatus.FieldReadTime]
-	return ok
+	return oldValue.Checksum, nil
 }
 
-// ResetReadTime resets all changes to the "readTime" field.
-func (m *MessageStatusMutation) ResetReadTime() {
-	m.readTime = nil
-	delete(m.clearedFields, messagestatus.FieldReadTime)
+// ResetChecksum resets all changes to the "checksum" field.
+func (m *FileMessageMutation) ResetChecksum() {
+	m.checksum = nil
 }
 
-// SetCreateTime sets the "createTime" field.
-func (m *MessageStatusMutation) SetCreateTime(t time.Time) {
-	m.createTime = &t
+// SetStorageKey sets the "storageKey" field.
+func (m *FileMessageMutation) SetStorageKey(s string) {
+	m.storageKey = &s
 }
 
-// CreateTime returns the value of the "createTime" field in the mutation.
-func (m *MessageStatusMutation) CreateTime() (r time.Time, exists bool) {
-	v := m.createTime
+// StorageKey returns the value of the "storageKey" field in the mutation.
+func (m *FileMessageMutation) StorageKey() (r string, exists bool) {
+	v := m.storageKey
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreateTime returns the old "createTime" field's value of the MessageStatus entity.
-// If the MessageStatus object wasn't provided to the builder, the object is fetched from the database.
+// OldStorageKey returns the old "storageKey" field's value of the FileMessage entity.
+// If the FileMessage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageStatusMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *FileMessageMutation) OldStorageKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldStorageKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+		return v, errors.New("OldStorageKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldStorageKey: %w", err)
 	}
-	return oldValue.CreateTime, nil
+	return oldValue.StorageKey, nil
 }
 
-// ResetCreateTime resets all changes to the "createTime" field.
-func (m *MessageStatusMutation) ResetCreateTime() {
-	m.createTime = nil
+// ResetStorageKey resets all changes to the "storageKey" field.
+func (m *FileMessageMutation) ResetStorageKey() {
+	m.storageKey = nil
 }
 
-// Where appends a list predicates to the MessageStatusMutation builder.
-func (m *MessageStatusMutation) Where(ps ...predicate.MessageStatus) {
+// Where appends a list predicates to the FileMessageMutation builder.
+func (m *FileMessageMutation) Where(ps ...predicate.FileMessage) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MessageStatusMutation builder. Using this method,
+// WhereP appends storage-level predicates to the FileMessageMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MessageStatusMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.MessageStatus, len(ps))
+func (m *FileMessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FileMessage, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5757,45 +6380,42 @@ func (m *MessageStatusMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MessageStatusMutation) Op() Op {
+func (m *FileMessageMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MessageStatusMutation) SetOp(op Op) {
+func (m *FileMessageMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (MessageStatus).
-func (m *MessageStatusMutation) Type() string {
+// Type returns the node type of this mutation (FileMessage).
+func (m *FileMessageMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MessageStatusMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+func (m *FileMessageMutation) Fields() []string {
+	fields := make([]string, 0, 6)
 	if m.msgId != nil {
-		fields = append(fields, messagestatus.FieldMsgId)
-	}
-	if m.userId != nil {
-		fields = append(fields, messagestatus.FieldUserId)
+		fields = append(fields, filemessage.FieldMsgId)
 	}
-	if m.isDelivered != nil {
-		fields = append(fields, messagestatus.FieldIsDelivered)
+	if m.fileName != nil {
+		fields = append(fields, filemessage.FieldFileName)
 	}
-	if m.isRead != nil {
-		fields = append(fields, messagestatus.FieldIsRead)
+	if m.fileSize != nil {
+		fields = append(fields, filemessage.FieldFileSize)
 	}
-	if m.deliveredTime != nil {
-		fields = append(fields, messagestatus.FieldDeliveredTime)
+	if m.mimeType != nil {
+		fields = append(fields, filemessage.FieldMimeType)
 	}
-	if m.readTime != nil {
-		fields = append(fields, messagestatus.FieldReadTime)
+	if m.checksum != nil {
+		fields = append(fields, filemessage.FieldChecksum)
 	}
-	if m.createTime != nil {
-		fields = append(fields, messagestatus.FieldCreateTime)
+	if m.storageKey != nil {
+		fields = append(fields, filemessage.FieldStorageKey)
 	}
 	return fields
 }
@@ -5803,22 +6423,20 @@ func (m *MessageStatusMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MessageStatusMutation) Field(name string) (ent.Value, bool) {
+func (m *FileMessageMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case messagestatus.FieldMsgId:
+	case filemessage.FieldMsgId:
 		return m.MsgId()
-	case messagestatus.FieldUserId:
-		return m.UserId()
-	case messagestatus.FieldIsDelivered:
-		return m.IsDelivered()
-	case messagestatus.FieldIsRead:
-		return m.IsRead()
-	case messagestatus.FieldDeliveredTime:
-		return m.DeliveredTime()
-	case messagestatus.FieldReadTime:
-		return m.ReadTime()
-	case messagestatus.FieldCreateTime:
-		return m.CreateTime()
+	case filemessage.FieldFileName:
+		return m.FileName()
+	case filemessage.FieldFileSize:
+		return m.FileSize()
+	case filemessage.FieldMimeType:
+		return m.MimeType()
+	case filemessage.FieldChecksum:
+		return m.Checksum()
+	case filemessage.FieldStorageKey:
+		return m.StorageKey()
 	}
 	return nil, false
 }
@@ -5826,90 +6444,81 @@ func (m *MessageStatusMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MessageStatusMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *FileMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case messagestatus.FieldMsgId:
+	case filemessage.FieldMsgId:
 		return m.OldMsgId(ctx)
-	case messagestatus.FieldUserId:
-		return m.OldUserId(ctx)
-	case messagestatus.FieldIsDelivered:
-		return m.OldIsDelivered(ctx)
-	case messagestatus.FieldIsRead:
-		return m.OldIsRead(ctx)
-	case messagestatus.FieldDeliveredTime:
-		return m.OldDeliveredTime(ctx)
-	case messagestatus.FieldReadTime:
-		return m.OldReadTime(ctx)
-	case messagestatus.FieldCreateTime:
-		return m.OldCreateTime(ctx)
+	case filemessage.FieldFileName:
+		return m.OldFileName(ctx)
+	case filemessage.FieldFileSize:
+		return m.OldFileSize(ctx)
+	case filemessage.FieldMimeType:
+		return m.OldMimeType(ctx)
+	case filemessage.FieldChecksum:
+		return m.OldChecksum(ctx)
+	case filemessage.FieldStorageKey:
+		return m.OldStorageKey(ctx)
 	}
-	return nil, fmt.Errorf("unknown MessageStatus field %s", name)
+	return nil, fmt.Errorf("unknown FileMessage field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageStatusMutation) SetField(name string, value ent.Value) error {
+func (m *FileMessageMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case messagestatus.FieldMsgId:
+	case filemessage.FieldMsgId:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetMsgId(v)
 		return nil
-	case messagestatus.FieldUserId:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUserId(v)
-		return nil
-	case messagestatus.FieldIsDelivered:
-		v, ok := value.(bool)
+	case filemessage.FieldFileName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsDelivered(v)
+		m.SetFileName(v)
 		return nil
-	case messagestatus.FieldIsRead:
-		v, ok := value.(bool)
+	case filemessage.FieldFileSize:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsRead(v)
+		m.SetFileSize(v)
 		return nil
-	case messagestatus.FieldDeliveredTime:
-		v, ok := value.(time.Time)
+	case filemessage.FieldMimeType:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeliveredTime(v)
+		m.SetMimeType(v)
 		return nil
-	case messagestatus.FieldReadTime:
-		v, ok := value.(time.Time)
+	case filemessage.FieldChecksum:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReadTime(v)
+		m.SetChecksum(v)
 		return nil
-	case messagestatus.FieldCreateTime:
-		v, ok := value.(time.Time)
+	case filemessage.FieldStorageKey:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreateTime(v)
+		m.SetStorageKey(v)
 		return nil
 	}
-	return fmt.Errorf("unknown MessageStatus field %s", name)
+	return fmt.Errorf("unknown FileMessage field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MessageStatusMutation) AddedFields() []string {
+func (m *FileMessageMutation) AddedFields() []string {
 	var fields []string
-	if m.adduserId != nil {
-		fields = append(fields, messagestatus.FieldUserId)
+	if m.addfileSize != nil {
+		fields = append(fields, filemessage.FieldFileSize)
 	}
 	return fields
 }
@@ -5917,10 +6526,10 @@ func (m *MessageStatusMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MessageStatusMutation) AddedField(name string) (ent.Value, bool) {
+func (m *FileMessageMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case messagestatus.FieldUserId:
-		return m.AddedUserId()
+	case filemessage.FieldFileSize:
+		return m.AddedFileSize()
 	}
 	return nil, false
 }
@@ -5928,155 +6537,143 @@ func (m *MessageStatusMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageStatusMutation) AddField(name string, value ent.Value) error {
+func (m *FileMessageMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case messagestatus.FieldUserId:
-		v, ok := value.(int)
+	case filemessage.FieldFileSize:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddUserId(v)
+		m.AddFileSize(v)
 		return nil
 	}
-	return fmt.Errorf("unknown MessageStatus numeric field %s", name)
+	return fmt.Errorf("unknown FileMessage numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MessageStatusMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(messagestatus.FieldDeliveredTime) {
-		fields = append(fields, messagestatus.FieldDeliveredTime)
-	}
-	if m.FieldCleared(messagestatus.FieldReadTime) {
-		fields = append(fields, messagestatus.FieldReadTime)
-	}
-	return fields
+func (m *FileMessageMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MessageStatusMutation) FieldCleared(name string) bool {
+func (m *FileMessageMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MessageStatusMutation) ClearField(name string) error {
-	switch name {
-	case messagestatus.FieldDeliveredTime:
-		m.ClearDeliveredTime()
-		return nil
-	case messagestatus.FieldReadTime:
-		m.ClearReadTime()
-		return nil
-	}
-	return fmt.Errorf("unknown MessageStatus nullable field %s", name)
+func (m *FileMessageMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown FileMessage nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MessageStatusMutation) ResetField(name string) error {
+func (m *FileMessageMutation) ResetField(name string) error {
 	switch name {
-	case messagestatus.FieldMsgId:
+	case filemessage.FieldMsgId:
 		m.ResetMsgId()
 		return nil
-	case messagestatus.FieldUserId:
-		m.ResetUserId()
-		return nil
-	case messagestatus.FieldIsDelivered:
-		m.ResetIsDelivered()
+	case filemessage.FieldFileName:
+		m.ResetFileName()
 		return nil
-	case messagestatus.FieldIsRead:
-		m.ResetIsRead()
+	case filemessage.FieldFileSize:
+		m.ResetFileSize()
 		return nil
-	case messagestatus.FieldDeliveredTime:
-		m.ResetDeliveredTime()
+	case filemessage.FieldMimeType:
+		m.ResetMimeType()
 		return nil
-	case messagestatus.FieldReadTime:
-		m.ResetReadTime()
+	case filemessage.FieldChecksum:
+		m.ResetChecksum()
 		return nil
-	case messagestatus.FieldCreateTime:
-		m.ResetCreateTime()
+	case filemessage.FieldStorageKey:
+		m.ResetStorageKey()
 		return nil
 	}
-	return fmt.Errorf("unknown MessageStatus field %s", name)
+	return fmt.Errorf("unknown FileMessage field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MessageStatusMutation) AddedEdges() []string {
+func (m *FileMessageMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MessageStatusMutation) AddedIDs(name string) []ent.Value {
+func (m *FileMessageMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MessageStatusMutation) RemovedEdges() []string {
+func (m *FileMessageMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MessageStatusMutation) RemovedIDs(name string) []ent.Value {
+func (m *FileMessageMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MessageStatusMutation) ClearedEdges() []string {
+func (m *FileMessageMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MessageStatusMutation) EdgeCleared(name string) bool {
+func (m *FileMessageMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MessageStatusMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown MessageStatus unique edge %s", name)
+func (m *FileMessageMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown FileMessage unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MessageStatusMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown MessageStatus edge %s", name)
+func (m *FileMessageMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown FileMessage edge %s", name)
 }
 
-// TextMessageMutation represents an operation that mutates the TextMessage nodes in the graph.
-type TextMessageMutation struct {
+// FriendRelationshipMutation represents an operation that mutates the FriendRelationship nodes in the graph.
+type FriendRelationshipMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
-	msgId         *string
-	text          *string
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*TextMessage, error)
-	predicates    []predicate.TextMessage
+	userId        *int
+	adduserId     *int
+	friendId      *int
+	addfriendId   *int
+	remarkName    *string
+	category      *string
+	tags          *[]string
+	appendtags    []string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*FriendRelationship, error)
+	predicates    []predicate.FriendRelationship
 }
 
-var _ ent.Mutation = (*TextMessageMutation)(nil)
+var _ ent.Mutation = (*FriendRelationshipMutation)(nil)
 
-// textmessageOption allows management of the mutation configuration using functional options.
-type textmessageOption func(*TextMessageMutation)
+// friendrelationshipOption allows management of the mutation configuration using functional options.
+type friendrelationshipOption func(*FriendRelationshipMutation)
 
-// newTextMessageMutation creates new mutation for the TextMessage entity.
-func newTextMessageMutation(c config, op Op, opts ...textmessageOption) *TextMessageMutation {
-	m := &TextMessageMutation{
+// newFriendRelationshipMutation creates new mutation for the FriendRelationship entity.
+func newFriendRelationshipMutation(c config, op Op, opts ...friendrelationshipOption) *FriendRelationshipMutation {
+	m := &FriendRelationshipMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTextMessage,
+		typ:           TypeFriendRelationship,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -6085,20 +6682,20 @@ func newTextMessageMutation(c config, op Op, opts ...textmessageOption) *TextMes
 	return m
 }
 
-// withTextMessageID sets the ID field of the mutation.
-func withTextMessageID(id int) textmessageOption {
-	return func(m *TextMessageMutation) {
+// withFriendRelationshipID sets the ID field of the mutation.
+func withFriendRelationshipID(id int) friendrelationshipOption {
+	return func(m *FriendRelationshipMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *TextMessage
+			value *FriendRelationship
 		)
-		m.oldValue = func(ctx context.Context) (*TextMessage, error) {
+		m.oldValue = func(ctx context.Context) (*FriendRelationship, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().TextMessage.Get(ctx, id)
+					value, err = m.Client().FriendRelationship.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -6107,10 +6704,10 @@ func withTextMessageID(id int) textmessageOption {
 	}
 }
 
-// withTextMessage sets the old TextMessage of the mutation.
-func withTextMessage(node *TextMessage) textmessageOption {
-	return func(m *TextMessageMutation) {
-		m.oldValue = func(context.Context) (*TextMessage, error) {
+// withFriendRelationship sets the old FriendRelationship of the mutation.
+func withFriendRelationship(node *FriendRelationship) friendrelationshipOption {
+	return func(m *FriendRelationshipMutation) {
+		m.oldValue = func(context.Context) (*FriendRelationship, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -6119,7 +6716,7 @@ func withTextMessage(node *TextMessage) textmessageOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TextMessageMutation) Client() *Client {
+func (m FriendRelationshipMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -6127,7 +6724,7 @@ func (m TextMessageMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TextMessageMutation) Tx() (*Tx, error) {
+func (m FriendRelationshipMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -6138,7 +6735,7 @@ func (m TextMessageMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TextMessageMutation) ID() (id int, exists bool) {
+func (m *FriendRelationshipMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -6149,7 +6746,7 @@ func (m *TextMessageMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TextMessageMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *FriendRelationshipMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -6158,882 +6755,14500 @@ func (m *TextMessageMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().TextMessage.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().FriendRelationship.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetMsgId sets the "msgId" field.
-func (m *TextMessageMutation) SetMsgId(s string) {
-	m.msgId = &s
+// SetUserId sets the "userId" field.
+func (m *FriendRelationshipMutation) SetUserId(i int) {
+	m.userId = &i
+	m.adduserId = nil
 }
 
-// MsgId returns the value of the "msgId" field in the mutation.
-func (m *TextMessageMutation) MsgId() (r string, exists bool) {
-	v := m.msgId
+// UserId returns the value of the "userId" field in the mutation.
+func (m *FriendRelationshipMutation) UserId() (r int, exists bool) {
+	v := m.userId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMsgId returns the old "msgId" field's value of the TextMessage entity.
-// If the TextMessage object wasn't provided to the builder, the object is fetched from the database.
+// OldUserId returns the old "userId" field's value of the FriendRelationship entity.
+// If the FriendRelationship object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TextMessageMutation) OldMsgId(ctx context.Context) (v string, err error) {
+func (m *FriendRelationshipMutation) OldUserId(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMsgId is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMsgId requires an ID field in the mutation")
+		return v, errors.New("OldUserId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMsgId: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserId: %w", err)
 	}
-	return oldValue.MsgId, nil
+	return oldValue.UserId, nil
 }
 
-// ResetMsgId resets all changes to the "msgId" field.
-func (m *TextMessageMutation) ResetMsgId() {
-	m.msgId = nil
+// AddUserId adds i to the "userId" field.
+func (m *FriendRelationshipMutation) AddUserId(i int) {
+	if m.adduserId != nil {
+		*m.adduserId += i
+	} else {
+		m.adduserId = &i
+	}
 }
 
-// SetText sets the "text" field.
-func (m *TextMessageMutation) SetText(s string) {
-	m.text = &s
+// AddedUserId returns the value that was added to the "userId" field in this mutation.
+func (m *FriendRelationshipMutation) AddedUserId() (r int, exists bool) {
+	v := m.adduserId
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Text returns the value of the "text" field in the mutation.
-func (m *TextMessageMutation) Text() (r string, exists bool) {
-	v := m.text
+// ResetUserId resets all changes to the "userId" field.
+func (m *FriendRelationshipMutation) ResetUserId() {
+	m.userId = nil
+	m.adduserId = nil
+}
+
+// SetFriendId sets the "friendId" field.
+func (m *FriendRelationshipMutation) SetFriendId(i int) {
+	m.friendId = &i
+	m.addfriendId = nil
+}
+
+// FriendId returns the value of the "friendId" field in the mutation.
+func (m *FriendRelationshipMutation) FriendId() (r int, exists bool) {
+	v := m.friendId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldText returns the old "text" field's value of the TextMessage entity.
-// If the TextMessage object wasn't provided to the builder, the object is fetched from the database.
+// OldFriendId returns the old "friendId" field's value of the FriendRelationship entity.
+// If the FriendRelationship object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TextMessageMutation) OldText(ctx context.Context) (v string, err error) {
+func (m *FriendRelationshipMutation) OldFriendId(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldText is only allowed on UpdateOne operations")
+		return v, errors.New("OldFriendId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldText requires an ID field in the mutation")
+		return v, errors.New("OldFriendId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldText: %w", err)
+		return v, fmt.Errorf("querying old value for OldFriendId: %w", err)
 	}
-	return oldValue.Text, nil
-}
-
-// ResetText resets all changes to the "text" field.
-func (m *TextMessageMutation) ResetText() {
-	m.text = nil
+	return oldValue.FriendId, nil
 }
 
-// Where appends a list predicates to the TextMessageMutation builder.
-func (m *TextMessageMutation) Where(ps ...predicate.TextMessage) {
-	m.predicates = append(m.predicates, ps...)
+// AddFriendId adds i to the "friendId" field.
+func (m *FriendRelationshipMutation) AddFriendId(i int) {
+	if m.addfriendId != nil {
+		*m.addfriendId += i
+	} else {
+		m.addfriendId = &i
+	}
 }
 
-// WhereP appends storage-level predicates to the TextMessageMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TextMessageMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.TextMessage, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// AddedFriendId returns the value that was added to the "friendId" field in this mutation.
+func (m *FriendRelationshipMutation) AddedFriendId() (r int, exists bool) {
+	v := m.addfriendId
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *TextMessageMutation) Op() Op {
-	return m.op
+// ResetFriendId resets all changes to the "friendId" field.
+func (m *FriendRelationshipMutation) ResetFriendId() {
+	m.friendId = nil
+	m.addfriendId = nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *TextMessageMutation) SetOp(op Op) {
-	m.op = op
+// SetRemarkName sets the "remarkName" field.
+func (m *FriendRelationshipMutation) SetRemarkName(s string) {
+	m.remarkName = &s
 }
 
-// Type returns the node type of this mutation (TextMessage).
-func (m *TextMessageMutation) Type() string {
-	return m.typ
+// RemarkName returns the value of the "remarkName" field in the mutation.
+func (m *FriendRelationshipMutation) RemarkName() (r string, exists bool) {
+	v := m.remarkName
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *TextMessageMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.msgId != nil {
-		fields = append(fields, textmessage.FieldMsgId)
+// OldRemarkName returns the old "remarkName" field's value of the FriendRelationship entity.
+// If the FriendRelationship object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FriendRelationshipMutation) OldRemarkName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRemarkName is only allowed on UpdateOne operations")
 	}
-	if m.text != nil {
-		fields = append(fields, textmessage.FieldText)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRemarkName requires an ID field in the mutation")
 	}
-	return fields
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRemarkName: %w", err)
+	}
+	return oldValue.RemarkName, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *TextMessageMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case textmessage.FieldMsgId:
-		return m.MsgId()
-	case textmessage.FieldText:
-		return m.Text()
-	}
-	return nil, false
+// ClearRemarkName clears the value of the "remarkName" field.
+func (m *FriendRelationshipMutation) ClearRemarkName() {
+	m.remarkName = nil
+	m.clearedFields[friendrelationship.FieldRemarkName] = struct{}{}
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *TextMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case textmessage.FieldMsgId:
-		return m.OldMsgId(ctx)
-	case textmessage.FieldText:
-		return m.OldText(ctx)
-	}
-	return nil, fmt.Errorf("unknown TextMessage field %s", name)
+// RemarkNameCleared returns if the "remarkName" field was cleared in this mutation.
+func (m *FriendRelationshipMutation) RemarkNameCleared() bool {
+	_, ok := m.clearedFields[friendrelationship.FieldRemarkName]
+	return ok
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *TextMessageMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case textmessage.FieldMsgId:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMsgId(v)
-		return nil
-	case textmessage.FieldText:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetText(v)
-		return nil
-	}
-	return fmt.Errorf("unknown TextMessage field %s", name)
+// ResetRemarkName resets all changes to the "remarkName" field.
+func (m *FriendRelationshipMutation) ResetRemarkName() {
+	m.remarkName = nil
+	delete(m.clearedFields, friendrelationship.FieldRemarkName)
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *TextMessageMutation) AddedFields() []string {
-	return nil
+// SetCategory sets the "category" field.
+func (m *FriendRelationshipMutation) SetCategory(s string) {
+	m.category = &s
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *TextMessageMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// Category returns the value of the "category" field in the mutation.
+func (m *FriendRelationshipMutation) Category() (r string, exists bool) {
+	v := m.category
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *TextMessageMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// OldCategory returns the old "category" field's value of the FriendRelationship entity.
+// If the FriendRelationship object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FriendRelationshipMutation) OldCategory(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown TextMessage numeric field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+	}
+	return oldValue.Category, nil
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *TextMessageMutation) ClearedFields() []string {
-	return nil
+// ClearCategory clears the value of the "category" field.
+func (m *FriendRelationshipMutation) ClearCategory() {
+	m.category = nil
+	m.clearedFields[friendrelationship.FieldCategory] = struct{}{}
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *TextMessageMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// CategoryCleared returns if the "category" field was cleared in this mutation.
+func (m *FriendRelationshipMutation) CategoryCleared() bool {
+	_, ok := m.clearedFields[friendrelationship.FieldCategory]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *TextMessageMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown TextMessage nullable field %s", name)
-}
-
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *TextMessageMutation) ResetField(name string) error {
-	switch name {
-	case textmessage.FieldMsgId:
-		m.ResetMsgId()
-		return nil
-	case textmessage.FieldText:
-		m.ResetText()
-		return nil
-	}
-	return fmt.Errorf("unknown TextMessage field %s", name)
+// ResetCategory resets all changes to the "category" field.
+func (m *FriendRelationshipMutation) ResetCategory() {
+	m.category = nil
+	delete(m.clearedFields, friendrelationship.FieldCategory)
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TextMessageMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// SetTags sets the "tags" field.
+func (m *FriendRelationshipMutation) SetTags(s []string) {
+	m.tags = &s
+	m.appendtags = nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *TextMessageMutation) AddedIDs(name string) []ent.Value {
-	return nil
+// Tags returns the value of the "tags" field in the mutation.
+func (m *FriendRelationshipMutation) Tags() (r []string, exists bool) {
+	v := m.tags
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TextMessageMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// OldTags returns the old "tags" field's value of the FriendRelationship entity.
+// If the FriendRelationship object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FriendRelationshipMutation) OldTags(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTags is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTags requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTags: %w", err)
+	}
+	return oldValue.Tags, nil
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *TextMessageMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// AppendTags adds s to the "tags" field.
+func (m *FriendRelationshipMutation) AppendTags(s []string) {
+	m.appendtags = append(m.appendtags, s...)
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TextMessageMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
+func (m *FriendRelationshipMutation) AppendedTags() ([]string, bool) {
+	if len(m.appendtags) == 0 {
+		return nil, false
+	}
+	return m.appendtags, true
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *TextMessageMutation) EdgeCleared(name string) bool {
-	return false
+// ClearTags clears the value of the "tags" field.
+func (m *FriendRelationshipMutation) ClearTags() {
+	m.tags = nil
+	m.appendtags = nil
+	m.clearedFields[friendrelationship.FieldTags] = struct{}{}
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *TextMessageMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown TextMessage unique edge %s", name)
+// TagsCleared returns if the "tags" field was cleared in this mutation.
+func (m *FriendRelationshipMutation) TagsCleared() bool {
+	_, ok := m.clearedFields[friendrelationship.FieldTags]
+	return ok
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *TextMessageMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown TextMessage edge %s", name)
+// ResetTags resets all changes to the "tags" field.
+func (m *FriendRelationshipMutation) ResetTags() {
+	m.tags = nil
+	m.appendtags = nil
+	delete(m.clearedFields, friendrelationship.FieldTags)
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *int
-	username      *string
-	password      *string
-	nickname      *string
-	sex           *int
-	addsex        *int
-	avatar        *string
-	signature     *string
-	region        *string
-	birthday      *time.Time
-	lastSeen      *time.Time
-	status        *string
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*User, error)
-	predicates    []predicate.User
+// Where appends a list predicates to the FriendRelationshipMutation builder.
+func (m *FriendRelationshipMutation) Where(ps ...predicate.FriendRelationship) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-var _ ent.Mutation = (*UserMutation)(nil)
-
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
-
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeUser,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
+// WhereP appends storage-level predicates to the FriendRelationshipMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FriendRelationshipMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FriendRelationship, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return m
+	m.Where(p...)
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id int) userOption {
-	return func(m *UserMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *User
-		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().User.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
+// Op returns the operation name.
+func (m *FriendRelationshipMutation) Op() Op {
+	return m.op
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// SetOp allows setting the mutation operation.
+func (m *FriendRelationshipMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// Type returns the node type of this mutation (FriendRelationship).
+func (m *FriendRelationshipMutation) Type() string {
+	return m.typ
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FriendRelationshipMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.userId != nil {
+		fields = append(fields, friendrelationship.FieldUserId)
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
-}
-
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
+	if m.friendId != nil {
+		fields = append(fields, friendrelationship.FieldFriendId)
 	}
-	return *m.id, true
+	if m.remarkName != nil {
+		fields = append(fields, friendrelationship.FieldRemarkName)
+	}
+	if m.category != nil {
+		fields = append(fields, friendrelationship.FieldCategory)
+	}
+	if m.tags != nil {
+		fields = append(fields, friendrelationship.FieldTags)
+	}
+	return fields
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FriendRelationshipMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case friendrelationship.FieldUserId:
+		return m.UserId()
+	case friendrelationship.FieldFriendId:
+		return m.FriendId()
+	case friendrelationship.FieldRemarkName:
+		return m.RemarkName()
+	case friendrelationship.FieldCategory:
+		return m.Category()
+	case friendrelationship.FieldTags:
+		return m.Tags()
 	}
+	return nil, false
 }
 
-// SetUsername sets the "username" field.
-func (m *UserMutation) SetUsername(s string) {
-	m.username = &s
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FriendRelationshipMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case friendrelationship.FieldUserId:
+		return m.OldUserId(ctx)
+	case friendrelationship.FieldFriendId:
+		return m.OldFriendId(ctx)
+	case friendrelationship.FieldRemarkName:
+		return m.OldRemarkName(ctx)
+	case friendrelationship.FieldCategory:
+		return m.OldCategory(ctx)
+	case friendrelationship.FieldTags:
+		return m.OldTags(ctx)
+	}
+	return nil, fmt.Errorf("unknown FriendRelationship field %s", name)
 }
 
-// Username returns the value of the "username" field in the mutation.
-func (m *UserMutation) Username() (r string, exists bool) {
-	v := m.username
-	if v == nil {
-		return
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FriendRelationshipMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case friendrelationship.FieldUserId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserId(v)
+		return nil
+	case friendrelationship.FieldFriendId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFriendId(v)
+		return nil
+	case friendrelationship.FieldRemarkName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRemarkName(v)
+		return nil
+	case friendrelationship.FieldCategory:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategory(v)
+		return nil
+	case friendrelationship.FieldTags:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTags(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown FriendRelationship field %s", name)
 }
 
-// OldUsername returns the old "username" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUsername(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUsername requires an ID field in the mutation")
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FriendRelationshipMutation) AddedFields() []string {
+	var fields []string
+	if m.adduserId != nil {
+		fields = append(fields, friendrelationship.FieldUserId)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	if m.addfriendId != nil {
+		fields = append(fields, friendrelationship.FieldFriendId)
 	}
-	return oldValue.Username, nil
-}
-
-// ResetUsername resets all changes to the "username" field.
-func (m *UserMutation) ResetUsername() {
-	m.username = nil
+	return fields
 }
 
-// SetPassword sets the "password" field.
-func (m *UserMutation) SetPassword(s string) {
-	m.password = &s
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FriendRelationshipMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case friendrelationship.FieldUserId:
+		return m.AddedUserId()
+	case friendrelationship.FieldFriendId:
+		return m.AddedFriendId()
+	}
+	return nil, false
 }
 
-// Password returns the value of the "password" field in the mutation.
-func (m *UserMutation) Password() (r string, exists bool) {
-	v := m.password
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FriendRelationshipMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case friendrelationship.FieldUserId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserId(v)
+		return nil
+	case friendrelationship.FieldFriendId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFriendId(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown FriendRelationship numeric field %s", name)
 }
 
-// OldPassword returns the old "password" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FriendRelationshipMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(friendrelationship.FieldRemarkName) {
+		fields = append(fields, friendrelationship.FieldRemarkName)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPassword requires an ID field in the mutation")
+	if m.FieldCleared(friendrelationship.FieldCategory) {
+		fields = append(fields, friendrelationship.FieldCategory)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+	if m.FieldCleared(friendrelationship.FieldTags) {
+		fields = append(fields, friendrelationship.FieldTags)
 	}
-	return oldValue.Password, nil
+	return fields
 }
 
-// ResetPassword resets all changes to the "password" field.
-func (m *UserMutation) ResetPassword() {
-	m.password = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FriendRelationshipMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// SetNickname sets the "nickname" field.
-func (m *UserMutation) SetNickname(s string) {
-	m.nickname = &s
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FriendRelationshipMutation) ClearField(name string) error {
+	switch name {
+	case friendrelationship.FieldRemarkName:
+		m.ClearRemarkName()
+		return nil
+	case friendrelationship.FieldCategory:
+		m.ClearCategory()
+		return nil
+	case friendrelationship.FieldTags:
+		m.ClearTags()
+		return nil
+	}
+	return fmt.Errorf("unknown FriendRelationship nullable field %s", name)
 }
 
-// Nickname returns the value of the "nickname" field in the mutation.
-func (m *UserMutation) Nickname() (r string, exists bool) {
-	v := m.nickname
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FriendRelationshipMutation) ResetField(name string) error {
+	switch name {
+	case friendrelationship.FieldUserId:
+		m.ResetUserId()
+		return nil
+	case friendrelationship.FieldFriendId:
+		m.ResetFriendId()
+		return nil
+	case friendrelationship.FieldRemarkName:
+		m.ResetRemarkName()
+		return nil
+	case friendrelationship.FieldCategory:
+		m.ResetCategory()
+		return nil
+	case friendrelationship.FieldTags:
+		m.ResetTags()
+		return nil
+	}
+	return fmt.Errorf("unknown FriendRelationship field %s", name)
 }
 
-// OldNickname returns the old "nickname" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldNickname(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNickname is only allowed on UpdateOne operations")
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FriendRelationshipMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FriendRelationshipMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FriendRelationshipMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FriendRelationshipMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FriendRelationshipMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FriendRelationshipMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FriendRelationshipMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown FriendRelationship unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FriendRelationshipMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown FriendRelationship edge %s", name)
+}
+
+// FriendRequestMutation represents an operation that mutates the FriendRequest nodes in the graph.
+type FriendRequestMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	fromUserId    *int
+	addfromUserId *int
+	toUserId      *int
+	addtoUserId   *int
+	remark        *string
+	status        *int
+	addstatus     *int
+	source        *string
+	sourceMsgId   *string
+	createTime    *time.Time
+	handleTime    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*FriendRequest, error)
+	predicates    []predicate.FriendRequest
+}
+
+var _ ent.Mutation = (*FriendRequestMutation)(nil)
+
+// friendrequestOption allows management of the mutation configuration using functional options.
+type friendrequestOption func(*FriendRequestMutation)
+
+// newFriendRequestMutation creates new mutation for the FriendRequest entity.
+func newFriendRequestMutation(c config, op Op, opts ...friendrequestOption) *FriendRequestMutation {
+	m := &FriendRequestMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFriendRequest,
+		clearedFields: make(map[string]struct{}),
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNickname requires an ID field in the mutation")
+	for _, opt := range opts {
+		opt(m)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNickname: %w", err)
+	return m
+}
+
+// withFriendRequestID sets the ID field of the mutation.
+func withFriendRequestID(id int) friendrequestOption {
+	return func(m *FriendRequestMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *FriendRequest
+		)
+		m.oldValue = func(ctx context.Context) (*FriendRequest, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().FriendRequest.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return oldValue.Nickname, nil
 }
 
-// ResetNickname resets all changes to the "nickname" field.
-func (m *UserMutation) ResetNickname() {
-	m.nickname = nil
+// withFriendRequest sets the old FriendRequest of the mutation.
+func withFriendRequest(node *FriendRequest) friendrequestOption {
+	return func(m *FriendRequestMutation) {
+		m.oldValue = func(context.Context) (*FriendRequest, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// SetSex sets the "sex" field.
-func (m *UserMutation) SetSex(i int) {
-	m.sex = &i
-	m.addsex = nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FriendRequestMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// Sex returns the value of the "sex" field in the mutation.
-func (m *UserMutation) Sex() (r int, exists bool) {
-	v := m.sex
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FriendRequestMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FriendRequestMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FriendRequestMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().FriendRequest.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetFromUserId sets the "fromUserId" field.
+func (m *FriendRequestMutation) SetFromUserId(i int) {
+	m.fromUserId = &i
+	m.addfromUserId = nil
+}
+
+// FromUserId returns the value of the "fromUserId" field in the mutation.
+func (m *FriendRequestMutation) FromUserId() (r int, exists bool) {
+	v := m.fromUserId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSex returns the old "sex" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldFromUserId returns the old "fromUserId" field's value of the FriendRequest entity.
+// If the FriendRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldSex(ctx context.Context) (v int, err error) {
+func (m *FriendRequestMutation) OldFromUserId(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSex is only allowed on UpdateOne operations")
+		return v, errors.New("OldFromUserId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSex requires an ID field in the mutation")
+		return v, errors.New("OldFromUserId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSex: %w", err)
+		return v, fmt.Errorf("querying old value for OldFromUserId: %w", err)
 	}
-	return oldValue.Sex, nil
+	return oldValue.FromUserId, nil
 }
 
-// AddSex adds i to the "sex" field.
-func (m *UserMutation) AddSex(i int) {
-	if m.addsex != nil {
-		*m.addsex += i
+// AddFromUserId adds i to the "fromUserId" field.
+func (m *FriendRequestMutation) AddFromUserId(i int) {
+	if m.addfromUserId != nil {
+		*m.addfromUserId += i
 	} else {
-		m.addsex = &i
+		m.addfromUserId = &i
 	}
 }
 
-// AddedSex returns the value that was added to the "sex" field in this mutation.
-func (m *UserMutation) AddedSex() (r int, exists bool) {
-	v := m.addsex
+// AddedFromUserId returns the value that was added to the "fromUserId" field in this mutation.
+func (m *FriendRequestMutation) AddedFromUserId() (r int, exists bool) {
+	v := m.addfromUserId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearSex clears the value of the "sex" field.
-func (m *UserMutation) ClearSex() {
-	m.sex = nil
-	m.addsex = nil
-	m.clearedFields[user.FieldSex] = struct{}{}
-}
-
-// SexCleared returns if the "sex" field was cleared in this mutation.
-func (m *UserMutation) SexCleared() bool {
-	_, ok := m.clearedFields[user.FieldSex]
-	return ok
+// ResetFromUserId resets all changes to the "fromUserId" field.
+func (m *FriendRequestMutation) ResetFromUserId() {
+	m.fromUserId = nil
+	m.addfromUserId = nil
 }
 
-// ResetSex resets all changes to the "sex" field.
+// SetToUserId sets the "toUserId" field.
+func (m *FriendRequestMutation) SetToUserId(i int) {
+	m.toUserId = &i
+	m.addtoUserId = nil
+}
+
+// ToUserId returns the value of the "toUserId" field in the mutation.
+func (m *FriendRequestMutation) ToUserId() (r int, exists bool) {
+	v := m.toUserId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToUserId returns the old "toUserId" field's value of the FriendRequest entity.
+// If the FriendRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FriendRequestMutation) OldToUserId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToUserId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToUserId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToUserId: %w", err)
+	}
+	return oldValue.ToUserId, nil
+}
+
+// AddToUserId adds i to the "toUserId" field.
+func (m *FriendRequestMutation) AddToUserId(i int) {
+	if m.addtoUserId != nil {
+		*m.addtoUserId += i
+	} else {
+		m.addtoUserId = &i
+	}
+}
+
+// AddedToUserId returns the value that was added to the "toUserId" field in this mutation.
+func (m *FriendRequestMutation) AddedToUserId() (r int, exists bool) {
+	v := m.addtoUserId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetToUserId resets all changes to the "toUserId" field.
+func (m *FriendRequestMutation) ResetToUserId() {
+	m.toUserId = nil
+	m.addtoUserId = nil
+}
+
+// SetRemark sets the "remark" field.
+func (m *FriendRequestMutation) SetRemark(s string) {
+	m.remark = &s
+}
+
+// Remark returns the value of the "remark" field in the mutation.
+func (m *FriendRequestMutation) Remark() (r string, exists bool) {
+	v := m.remark
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRemark returns the old "remark" field's value of the FriendRequest entity.
+// If the FriendRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FriendRequestMutation) OldRemark(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRemark is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRemark requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRemark: %w", err)
+	}
+	return oldValue.Remark, nil
+}
+
+// ClearRemark clears the value of the "remark" field.
+func (m *FriendRequestMutation) ClearRemark() {
+	m.remark = nil
+	m.clearedFields[friendrequest.FieldRemark] = struct{}{}
+}
+
+// RemarkCleared returns if the "remark" field was cleared in this mutation.
+func (m *FriendRequestMutation) RemarkCleared() bool {
+	_, ok := m.clearedFields[friendrequest.FieldRemark]
+	return ok
+}
+
+// ResetRemark resets all changes to the "remark" field.
+func (m *FriendRequestMutation) ResetRemark() {
+	m.remark = nil
+	delete(m.clearedFields, friendrequest.FieldRemark)
+}
+
+// SetStatus sets the "status" field.
+func (m *FriendRequestMutation) SetStatus(i int) {
+	m.status = &i
+	m.addstatus = nil
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *FriendRequestMutation) Status() (r int, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the FriendRequest entity.
+// If the FriendRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FriendRequestMutation) OldStatus(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// AddStatus adds i to the "status" field.
+func (m *FriendRequestMutation) AddStatus(i int) {
+	if m.addstatus != nil {
+		*m.addstatus += i
+	} else {
+		m.addstatus = &i
+	}
+}
+
+// AddedStatus returns the value that was added to the "status" field in this mutation.
+func (m *FriendRequestMutation) AddedStatus() (r int, exists bool) {
+	v := m.addstatus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *FriendRequestMutation) ResetStatus() {
+	m.status = nil
+	m.addstatus = nil
+}
+
+// SetSource sets the "source" field.
+func (m *FriendRequestMutation) SetSource(s string) {
+	m.source = &s
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *FriendRequestMutation) Source() (r string, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the FriendRequest entity.
+// If the FriendRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FriendRequestMutation) OldSource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ClearSource clears the value of the "source" field.
+func (m *FriendRequestMutation) ClearSource() {
+	m.source = nil
+	m.clearedFields[friendrequest.FieldSource] = struct{}{}
+}
+
+// SourceCleared returns if the "source" field was cleared in this mutation.
+func (m *FriendRequestMutation) SourceCleared() bool {
+	_, ok := m.clearedFields[friendrequest.FieldSource]
+	return ok
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *FriendRequestMutation) ResetSource() {
+	m.source = nil
+	delete(m.clearedFields, friendrequest.FieldSource)
+}
+
+// SetSourceMsgId sets the "sourceMsgId" field.
+func (m *FriendRequestMutation) SetSourceMsgId(s string) {
+	m.sourceMsgId = &s
+}
+
+// SourceMsgId returns the value of the "sourceMsgId" field in the mutation.
+func (m *FriendRequestMutation) SourceMsgId() (r string, exists bool) {
+	v := m.sourceMsgId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceMsgId returns the old "sourceMsgId" field's value of the FriendRequest entity.
+// If the FriendRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FriendRequestMutation) OldSourceMsgId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceMsgId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceMsgId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceMsgId: %w", err)
+	}
+	return oldValue.SourceMsgId, nil
+}
+
+// ClearSourceMsgId clears the value of the "sourceMsgId" field.
+func (m *FriendRequestMutation) ClearSourceMsgId() {
+	m.sourceMsgId = nil
+	m.clearedFields[friendrequest.FieldSourceMsgId] = struct{}{}
+}
+
+// SourceMsgIdCleared returns if the "sourceMsgId" field was cleared in this mutation.
+func (m *FriendRequestMutation) SourceMsgIdCleared() bool {
+	_, ok := m.clearedFields[friendrequest.FieldSourceMsgId]
+	return ok
+}
+
+// ResetSourceMsgId resets all changes to the "sourceMsgId" field.
+func (m *FriendRequestMutation) ResetSourceMsgId() {
+	m.sourceMsgId = nil
+	delete(m.clearedFields, friendrequest.FieldSourceMsgId)
+}
+
+// SetCreateTime sets the "createTime" field.
+func (m *FriendRequestMutation) SetCreateTime(t time.Time) {
+	m.createTime = &t
+}
+
+// CreateTime returns the value of the "createTime" field in the mutation.
+func (m *FriendRequestMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.createTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "createTime" field's value of the FriendRequest entity.
+// If the FriendRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FriendRequestMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "createTime" field.
+func (m *FriendRequestMutation) ResetCreateTime() {
+	m.createTime = nil
+}
+
+// SetHandleTime sets the "handleTime" field.
+func (m *FriendRequestMutation) SetHandleTime(t time.Time) {
+	m.handleTime = &t
+}
+
+// HandleTime returns the value of the "handleTime" field in the mutation.
+func (m *FriendRequestMutation) HandleTime() (r time.Time, exists bool) {
+	v := m.handleTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHandleTime returns the old "handleTime" field's value of the FriendRequest entity.
+// If the FriendRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FriendRequestMutation) OldHandleTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHandleTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHandleTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHandleTime: %w", err)
+	}
+	return oldValue.HandleTime, nil
+}
+
+// ClearHandleTime clears the value of the "handleTime" field.
+func (m *FriendRequestMutation) ClearHandleTime() {
+	m.handleTime = nil
+	m.clearedFields[friendrequest.FieldHandleTime] = struct{}{}
+}
+
+// HandleTimeCleared returns if the "handleTime" field was cleared in this mutation.
+func (m *FriendRequestMutation) HandleTimeCleared() bool {
+	_, ok := m.clearedFields[friendrequest.FieldHandleTime]
+	return ok
+}
+
+// ResetHandleTime resets all changes to the "handleTime" field.
+func (m *FriendRequestMutation) ResetHandleTime() {
+	m.handleTime = nil
+	delete(m.clearedFields, friendrequest.FieldHandleTime)
+}
+
+// Where appends a list predicates to the FriendRequestMutation builder.
+func (m *FriendRequestMutation) Where(ps ...predicate.FriendRequest) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FriendRequestMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FriendRequestMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FriendRequest, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FriendRequestMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FriendRequestMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (FriendRequest).
+func (m *FriendRequestMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FriendRequestMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.fromUserId != nil {
+		fields = append(fields, friendrequest.FieldFromUserId)
+	}
+	if m.toUserId != nil {
+		fields = append(fields, friendrequest.FieldToUserId)
+	}
+	if m.remark != nil {
+		fields = append(fields, friendrequest.FieldRemark)
+	}
+	if m.status != nil {
+		fields = append(fields, friendrequest.FieldStatus)
+	}
+	if m.source != nil {
+		fields = append(fields, friendrequest.FieldSource)
+	}
+	if m.sourceMsgId != nil {
+		fields = append(fields, friendrequest.FieldSourceMsgId)
+	}
+	if m.createTime != nil {
+		fields = append(fields, friendrequest.FieldCreateTime)
+	}
+	if m.handleTime != nil {
+		fields = append(fields, friendrequest.FieldHandleTime)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FriendRequestMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case friendrequest.FieldFromUserId:
+		return m.FromUserId()
+	case friendrequest.FieldToUserId:
+		return m.ToUserId()
+	case friendrequest.FieldRemark:
+		return m.Remark()
+	case friendrequest.FieldStatus:
+		return m.Status()
+	case friendrequest.FieldSource:
+		return m.Source()
+	case friendrequest.FieldSourceMsgId:
+		return m.SourceMsgId()
+	case friendrequest.FieldCreateTime:
+		return m.CreateTime()
+	case friendrequest.FieldHandleTime:
+		return m.HandleTime()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FriendRequestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case friendrequest.FieldFromUserId:
+		return m.OldFromUserId(ctx)
+	case friendrequest.FieldToUserId:
+		return m.OldToUserId(ctx)
+	case friendrequest.FieldRemark:
+		return m.OldRemark(ctx)
+	case friendrequest.FieldStatus:
+		return m.OldStatus(ctx)
+	case friendrequest.FieldSource:
+		return m.OldSource(ctx)
+	case friendrequest.FieldSourceMsgId:
+		return m.OldSourceMsgId(ctx)
+	case friendrequest.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case friendrequest.FieldHandleTime:
+		return m.OldHandleTime(ctx)
+	}
+	return nil, fmt.Errorf("unknown FriendRequest field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FriendRequestMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case friendrequest.FieldFromUserId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFromUserId(v)
+		return nil
+	case friendrequest.FieldToUserId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToUserId(v)
+		return nil
+	case friendrequest.FieldRemark:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRemark(v)
+		return nil
+	case friendrequest.FieldStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case friendrequest.FieldSource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case friendrequest.FieldSourceMsgId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceMsgId(v)
+		return nil
+	case friendrequest.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case friendrequest.FieldHandleTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHandleTime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FriendRequest field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FriendRequestMutation) AddedFields() []string {
+	var fields []string
+	if m.addfromUserId != nil {
+		fields = append(fields, friendrequest.FieldFromUserId)
+	}
+	if m.addtoUserId != nil {
+		fields = append(fields, friendrequest.FieldToUserId)
+	}
+	if m.addstatus != nil {
+		fields = append(fields, friendrequest.FieldStatus)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FriendRequestMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case friendrequest.FieldFromUserId:
+		return m.AddedFromUserId()
+	case friendrequest.FieldToUserId:
+		return m.AddedToUserId()
+	case friendrequest.FieldStatus:
+		return m.AddedStatus()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FriendRequestMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case friendrequest.FieldFromUserId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFromUserId(v)
+		return nil
+	case friendrequest.FieldToUserId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddToUserId(v)
+		return nil
+	case friendrequest.FieldStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatus(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FriendRequest numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FriendRequestMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(friendrequest.FieldRemark) {
+		fields = append(fields, friendrequest.FieldRemark)
+	}
+	if m.FieldCleared(friendrequest.FieldSource) {
+		fields = append(fields, friendrequest.FieldSource)
+	}
+	if m.FieldCleared(friendrequest.FieldSourceMsgId) {
+		fields = append(fields, friendrequest.FieldSourceMsgId)
+	}
+	if m.FieldCleared(friendrequest.FieldHandleTime) {
+		fields = append(fields, friendrequest.FieldHandleTime)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FriendRequestMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FriendRequestMutation) ClearField(name string) error {
+	switch name {
+	case friendrequest.FieldRemark:
+		m.ClearRemark()
+		return nil
+	case friendrequest.FieldSource:
+		m.ClearSource()
+		return nil
+	case friendrequest.FieldSourceMsgId:
+		m.ClearSourceMsgId()
+		return nil
+	case friendrequest.FieldHandleTime:
+		m.ClearHandleTime()
+		return nil
+	}
+	return fmt.Errorf("unknown FriendRequest nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FriendRequestMutation) ResetField(name string) error {
+	switch name {
+	case friendrequest.FieldFromUserId:
+		m.ResetFromUserId()
+		return nil
+	case friendrequest.FieldToUserId:
+		m.ResetToUserId()
+		return nil
+	case friendrequest.FieldRemark:
+		m.ResetRemark()
+		return nil
+	case friendrequest.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case friendrequest.FieldSource:
+		m.ResetSource()
+		return nil
+	case friendrequest.FieldSourceMsgId:
+		m.ResetSourceMsgId()
+		return nil
+	case friendrequest.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case friendrequest.FieldHandleTime:
+		m.ResetHandleTime()
+		return nil
+	}
+	return fmt.Errorf("unknown FriendRequest field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FriendRequestMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FriendRequestMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FriendRequestMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FriendRequestMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FriendRequestMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FriendRequestMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FriendRequestMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown FriendRequest unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FriendRequestMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown FriendRequest edge %s", name)
+}
+
+// GroupMutation represents an operation that mutates the Group nodes in the graph.
+type GroupMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	groupId             *string
+	groupName           *string
+	ownerId             *int
+	addownerId          *int
+	createUserId        *int
+	addcreateUserId     *int
+	createTime          *time.Time
+	members             *[]int
+	appendmembers       []int
+	readReceiptsEnabled *bool
+	retentionDays       *int
+	addretentionDays    *int
+	clearedFields       map[string]struct{}
+	done                bool
+	oldValue            func(context.Context) (*Group, error)
+	predicates          []predicate.Group
+}
+
+var _ ent.Mutation = (*GroupMutation)(nil)
+
+// groupOption allows management of the mutation configuration using functional options.
+type groupOption func(*GroupMutation)
+
+// newGroupMutation creates new mutation for the Group entity.
+func newGroupMutation(c config, op Op, opts ...groupOption) *GroupMutation {
+	m := &GroupMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGroup,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGroupID sets the ID field of the mutation.
+func withGroupID(id int) groupOption {
+	return func(m *GroupMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Group
+		)
+		m.oldValue = func(ctx context.Context) (*Group, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Group.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGroup sets the old Group of the mutation.
+func withGroup(node *Group) groupOption {
+	return func(m *GroupMutation) {
+		m.oldValue = func(context.Context) (*Group, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GroupMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GroupMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GroupMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GroupMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Group.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetGroupId sets the "groupId" field.
+func (m *GroupMutation) SetGroupId(s string) {
+	m.groupId = &s
+}
+
+// GroupId returns the value of the "groupId" field in the mutation.
+func (m *GroupMutation) GroupId() (r string, exists bool) {
+	v := m.groupId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGroupId returns the old "groupId" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldGroupId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGroupId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGroupId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGroupId: %w", err)
+	}
+	return oldValue.GroupId, nil
+}
+
+// ResetGroupId resets all changes to the "groupId" field.
+func (m *GroupMutation) ResetGroupId() {
+	m.groupId = nil
+}
+
+// SetGroupName sets the "groupName" field.
+func (m *GroupMutation) SetGroupName(s string) {
+	m.groupName = &s
+}
+
+// GroupName returns the value of the "groupName" field in the mutation.
+func (m *GroupMutation) GroupName() (r string, exists bool) {
+	v := m.groupName
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGroupName returns the old "groupName" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldGroupName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGroupName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGroupName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGroupName: %w", err)
+	}
+	return oldValue.GroupName, nil
+}
+
+// ResetGroupName resets all changes to the "groupName" field.
+func (m *GroupMutation) ResetGroupName() {
+	m.groupName = nil
+}
+
+// SetOwnerId sets the "ownerId" field.
+func (m *GroupMutation) SetOwnerId(i int) {
+	m.ownerId = &i
+	m.addownerId = nil
+}
+
+// OwnerId returns the value of the "ownerId" field in the mutation.
+func (m *GroupMutation) OwnerId() (r int, exists bool) {
+	v := m.ownerId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerId returns the old "ownerId" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldOwnerId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerId: %w", err)
+	}
+	return oldValue.OwnerId, nil
+}
+
+// AddOwnerId adds i to the "ownerId" field.
+func (m *GroupMutation) AddOwnerId(i int) {
+	if m.addownerId != nil {
+		*m.addownerId += i
+	} else {
+		m.addownerId = &i
+	}
+}
+
+// AddedOwnerId returns the value that was added to the "ownerId" field in this mutation.
+func (m *GroupMutation) AddedOwnerId() (r int, exists bool) {
+	v := m.addownerId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetOwnerId resets all changes to the "ownerId" field.
+func (m *GroupMutation) ResetOwnerId() {
+	m.ownerId = nil
+	m.addownerId = nil
+}
+
+// SetCreateUserId sets the "createUserId" field.
+func (m *GroupMutation) SetCreateUserId(i int) {
+	m.createUserId = &i
+	m.addcreateUserId = nil
+}
+
+// CreateUserId returns the value of the "createUserId" field in the mutation.
+func (m *GroupMutation) CreateUserId() (r int, exists bool) {
+	v := m.createUserId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateUserId returns the old "createUserId" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldCreateUserId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateUserId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateUserId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateUserId: %w", err)
+	}
+	return oldValue.CreateUserId, nil
+}
+
+// AddCreateUserId adds i to the "createUserId" field.
+func (m *GroupMutation) AddCreateUserId(i int) {
+	if m.addcreateUserId != nil {
+		*m.addcreateUserId += i
+	} else {
+		m.addcreateUserId = &i
+	}
+}
+
+// AddedCreateUserId returns the value that was added to the "createUserId" field in this mutation.
+func (m *GroupMutation) AddedCreateUserId() (r int, exists bool) {
+	v := m.addcreateUserId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreateUserId resets all changes to the "createUserId" field.
+func (m *GroupMutation) ResetCreateUserId() {
+	m.createUserId = nil
+	m.addcreateUserId = nil
+}
+
+// SetCreateTime sets the "createTime" field.
+func (m *GroupMutation) SetCreateTime(t time.Time) {
+	m.createTime = &t
+}
+
+// CreateTime returns the value of the "createTime" field in the mutation.
+func (m *GroupMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.createTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "createTime" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "createTime" field.
+func (m *GroupMutation) ResetCreateTime() {
+	m.createTime = nil
+}
+
+// SetMembers sets the "members" field.
+func (m *GroupMutation) SetMembers(i []int) {
+	m.members = &i
+	m.appendmembers = nil
+}
+
+// Members returns the value of the "members" field in the mutation.
+func (m *GroupMutation) Members() (r []int, exists bool) {
+	v := m.members
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMembers returns the old "members" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldMembers(ctx context.Context) (v []int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMembers is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMembers requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMembers: %w", err)
+	}
+	return oldValue.Members, nil
+}
+
+// AppendMembers adds i to the "members" field.
+func (m *GroupMutation) AppendMembers(i []int) {
+	m.appendmembers = append(m.appendmembers, i...)
+}
+
+// AppendedMembers returns the list of values that were appended to the "members" field in this mutation.
+func (m *GroupMutation) AppendedMembers() ([]int, bool) {
+	if len(m.appendmembers) == 0 {
+		return nil, false
+	}
+	return m.appendmembers, true
+}
+
+// ResetMembers resets all changes to the "members" field.
+func (m *GroupMutation) ResetMembers() {
+	m.members = nil
+	m.appendmembers = nil
+}
+
+// SetReadReceiptsEnabled sets the "readReceiptsEnabled" field.
+func (m *GroupMutation) SetReadReceiptsEnabled(b bool) {
+	m.readReceiptsEnabled = &b
+}
+
+// ReadReceiptsEnabled returns the value of the "readReceiptsEnabled" field in the mutation.
+func (m *GroupMutation) ReadReceiptsEnabled() (r bool, exists bool) {
+	v := m.readReceiptsEnabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReadReceiptsEnabled returns the old "readReceiptsEnabled" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldReadReceiptsEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReadReceiptsEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReadReceiptsEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReadReceiptsEnabled: %w", err)
+	}
+	return oldValue.ReadReceiptsEnabled, nil
+}
+
+// ResetReadReceiptsEnabled resets all changes to the "readReceiptsEnabled" field.
+func (m *GroupMutation) ResetReadReceiptsEnabled() {
+	m.readReceiptsEnabled = nil
+}
+
+// SetRetentionDays sets the "retentionDays" field.
+func (m *GroupMutation) SetRetentionDays(i int) {
+	m.retentionDays = &i
+	m.addretentionDays = nil
+}
+
+// RetentionDays returns the value of the "retentionDays" field in the mutation.
+func (m *GroupMutation) RetentionDays() (r int, exists bool) {
+	v := m.retentionDays
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetentionDays returns the old "retentionDays" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldRetentionDays(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRetentionDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRetentionDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetentionDays: %w", err)
+	}
+	return oldValue.RetentionDays, nil
+}
+
+// AddRetentionDays adds i to the "retentionDays" field.
+func (m *GroupMutation) AddRetentionDays(i int) {
+	if m.addretentionDays != nil {
+		*m.addretentionDays += i
+	} else {
+		m.addretentionDays = &i
+	}
+}
+
+// AddedRetentionDays returns the value that was added to the "retentionDays" field in this mutation.
+func (m *GroupMutation) AddedRetentionDays() (r int, exists bool) {
+	v := m.addretentionDays
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRetentionDays resets all changes to the "retentionDays" field.
+func (m *GroupMutation) ResetRetentionDays() {
+	m.retentionDays = nil
+	m.addretentionDays = nil
+}
+
+// Where appends a list predicates to the GroupMutation builder.
+func (m *GroupMutation) Where(ps ...predicate.Group) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GroupMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GroupMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Group, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GroupMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GroupMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Group).
+func (m *GroupMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GroupMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.groupId != nil {
+		fields = append(fields, group.FieldGroupId)
+	}
+	if m.groupName != nil {
+		fields = append(fields, group.FieldGroupName)
+	}
+	if m.ownerId != nil {
+		fields = append(fields, group.FieldOwnerId)
+	}
+	if m.createUserId != nil {
+		fields = append(fields, group.FieldCreateUserId)
+	}
+	if m.createTime != nil {
+		fields = append(fields, group.FieldCreateTime)
+	}
+	if m.members != nil {
+		fields = append(fields, group.FieldMembers)
+	}
+	if m.readReceiptsEnabled != nil {
+		fields = append(fields, group.FieldReadReceiptsEnabled)
+	}
+	if m.retentionDays != nil {
+		fields = append(fields, group.FieldRetentionDays)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GroupMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case group.FieldGroupId:
+		return m.GroupId()
+	case group.FieldGroupName:
+		return m.GroupName()
+	case group.FieldOwnerId:
+		return m.OwnerId()
+	case group.FieldCreateUserId:
+		return m.CreateUserId()
+	case group.FieldCreateTime:
+		return m.CreateTime()
+	case group.FieldMembers:
+		return m.Members()
+	case group.FieldReadReceiptsEnabled:
+		return m.ReadReceiptsEnabled()
+	case group.FieldRetentionDays:
+		return m.RetentionDays()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case group.FieldGroupId:
+		return m.OldGroupId(ctx)
+	case group.FieldGroupName:
+		return m.OldGroupName(ctx)
+	case group.FieldOwnerId:
+		return m.OldOwnerId(ctx)
+	case group.FieldCreateUserId:
+		return m.OldCreateUserId(ctx)
+	case group.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case group.FieldMembers:
+		return m.OldMembers(ctx)
+	case group.FieldReadReceiptsEnabled:
+		return m.OldReadReceiptsEnabled(ctx)
+	case group.FieldRetentionDays:
+		return m.OldRetentionDays(ctx)
+	}
+	return nil, fmt.Errorf("unknown Group field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GroupMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case group.FieldGroupId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGroupId(v)
+		return nil
+	case group.FieldGroupName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGroupName(v)
+		return nil
+	case group.FieldOwnerId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerId(v)
+		return nil
+	case group.FieldCreateUserId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateUserId(v)
+		return nil
+	case group.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case group.FieldMembers:
+		v, ok := value.([]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMembers(v)
+		return nil
+	case group.FieldReadReceiptsEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReadReceiptsEnabled(v)
+		return nil
+	case group.FieldRetentionDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetentionDays(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Group field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GroupMutation) AddedFields() []string {
+	var fields []string
+	if m.addownerId != nil {
+		fields = append(fields, group.FieldOwnerId)
+	}
+	if m.addcreateUserId != nil {
+		fields = append(fields, group.FieldCreateUserId)
+	}
+	if m.addretentionDays != nil {
+		fields = append(fields, group.FieldRetentionDays)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GroupMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case group.FieldOwnerId:
+		return m.AddedOwnerId()
+	case group.FieldCreateUserId:
+		return m.AddedCreateUserId()
+	case group.FieldRetentionDays:
+		return m.AddedRetentionDays()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GroupMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case group.FieldOwnerId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOwnerId(v)
+		return nil
+	case group.FieldCreateUserId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreateUserId(v)
+		return nil
+	case group.FieldRetentionDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRetentionDays(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Group numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GroupMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GroupMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GroupMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Group nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GroupMutation) ResetField(name string) error {
+	switch name {
+	case group.FieldGroupId:
+		m.ResetGroupId()
+		return nil
+	case group.FieldGroupName:
+		m.ResetGroupName()
+		return nil
+	case group.FieldOwnerId:
+		m.ResetOwnerId()
+		return nil
+	case group.FieldCreateUserId:
+		m.ResetCreateUserId()
+		return nil
+	case group.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case group.FieldMembers:
+		m.ResetMembers()
+		return nil
+	case group.FieldReadReceiptsEnabled:
+		m.ResetReadReceiptsEnabled()
+		return nil
+	case group.FieldRetentionDays:
+		m.ResetRetentionDays()
+		return nil
+	}
+	return fmt.Errorf("unknown Group field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GroupMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GroupMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GroupMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GroupMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GroupMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GroupMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GroupMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Group unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GroupMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Group edge %s", name)
+}
+
+// GroupChatRecordMutation represents an operation that mutates the GroupChatRecord nodes in the graph.
+type GroupChatRecordMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	msgId         *string
+	fromUserId    *string
+	groupId       *string
+	msgType       *string
+	createTime    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*GroupChatRecord, error)
+	predicates    []predicate.GroupChatRecord
+}
+
+var _ ent.Mutation = (*GroupChatRecordMutation)(nil)
+
+// groupchatrecordOption allows management of the mutation configuration using functional options.
+type groupchatrecordOption func(*GroupChatRecordMutation)
+
+// newGroupChatRecordMutation creates new mutation for the GroupChatRecord entity.
+func newGroupChatRecordMutation(c config, op Op, opts ...groupchatrecordOption) *GroupChatRecordMutation {
+	m := &GroupChatRecordMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGroupChatRecord,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGroupChatRecordID sets the ID field of the mutation.
+func withGroupChatRecordID(id int) groupchatrecordOption {
+	return func(m *GroupChatRecordMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *GroupChatRecord
+		)
+		m.oldValue = func(ctx context.Context) (*GroupChatRecord, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().GroupChatRecord.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGroupChatRecord sets the old GroupChatRecord of the mutation.
+func withGroupChatRecord(node *GroupChatRecord) groupchatrecordOption {
+	return func(m *GroupChatRecordMutation) {
+		m.oldValue = func(context.Context) (*GroupChatRecord, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GroupChatRecordMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GroupChatRecordMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GroupChatRecordMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GroupChatRecordMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().GroupChatRecord.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMsgId sets the "msgId" field.
+func (m *GroupChatRecordMutation) SetMsgId(s string) {
+	m.msgId = &s
+}
+
+// MsgId returns the value of the "msgId" field in the mutation.
+func (m *GroupChatRecordMutation) MsgId() (r string, exists bool) {
+	v := m.msgId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMsgId returns the old "msgId" field's value of the GroupChatRecord entity.
+// If the GroupChatRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupChatRecordMutation) OldMsgId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMsgId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMsgId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMsgId: %w", err)
+	}
+	return oldValue.MsgId, nil
+}
+
+// ResetMsgId resets all changes to the "msgId" field.
+func (m *GroupChatRecordMutation) ResetMsgId() {
+	m.msgId = nil
+}
+
+// SetFromUserId sets the "fromUserId" field.
+func (m *GroupChatRecordMutation) SetFromUserId(s string) {
+	m.fromUserId = &s
+}
+
+// FromUserId returns the value of the "fromUserId" field in the mutation.
+func (m *GroupChatRecordMutation) FromUserId() (r string, exists bool) {
+	v := m.fromUserId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFromUserId returns the old "fromUserId" field's value of the GroupChatRecord entity.
+// If the GroupChatRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupChatRecordMutation) OldFromUserId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFromUserId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFromUserId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFromUserId: %w", err)
+	}
+	return oldValue.FromUserId, nil
+}
+
+// ResetFromUserId resets all changes to the "fromUserId" field.
+func (m *GroupChatRecordMutation) ResetFromUserId() {
+	m.fromUserId = nil
+}
+
+// SetGroupId sets the "groupId" field.
+func (m *GroupChatRecordMutation) SetGroupId(s string) {
+	m.groupId = &s
+}
+
+// GroupId returns the value of the "groupId" field in the mutation.
+func (m *GroupChatRecordMutation) GroupId() (r string, exists bool) {
+	v := m.groupId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGroupId returns the old "groupId" field's value of the GroupChatRecord entity.
+// If the GroupChatRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupChatRecordMutation) OldGroupId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGroupId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGroupId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGroupId: %w", err)
+	}
+	return oldValue.GroupId, nil
+}
+
+// ResetGroupId resets all changes to the "groupId" field.
+func (m *GroupChatRecordMutation) ResetGroupId() {
+	m.groupId = nil
+}
+
+// SetMsgType sets the "msgType" field.
+func (m *GroupChatRecordMutation) SetMsgType(s string) {
+	m.msgType = &s
+}
+
+// MsgType returns the value of the "msgType" field in the mutation.
+func (m *GroupChatRecordMutation) MsgType() (r string, exists bool) {
+	v := m.msgType
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMsgType returns the old "msgType" field's value of the GroupChatRecord entity.
+// If the GroupChatRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupChatRecordMutation) OldMsgType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMsgType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMsgType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMsgType: %w", err)
+	}
+	return oldValue.MsgType, nil
+}
+
+// ResetMsgType resets all changes to the "msgType" field.
+func (m *GroupChatRecordMutation) ResetMsgType() {
+	m.msgType = nil
+}
+
+// SetCreateTime sets the "createTime" field.
+func (m *GroupChatRecordMutation) SetCreateTime(t time.Time) {
+	m.createTime = &t
+}
+
+// CreateTime returns the value of the "createTime" field in the mutation.
+func (m *GroupChatRecordMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.createTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "createTime" field's value of the GroupChatRecord entity.
+// If the GroupChatRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupChatRecordMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "createTime" field.
+func (m *GroupChatRecordMutation) ResetCreateTime() {
+	m.createTime = nil
+}
+
+// Where appends a list predicates to the GroupChatRecordMutation builder.
+func (m *GroupChatRecordMutation) Where(ps ...predicate.GroupChatRecord) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GroupChatRecordMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GroupChatRecordMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GroupChatRecord, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GroupChatRecordMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GroupChatRecordMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (GroupChatRecord).
+func (m *GroupChatRecordMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GroupChatRecordMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.msgId != nil {
+		fields = append(fields, groupchatrecord.FieldMsgId)
+	}
+	if m.fromUserId != nil {
+		fields = append(fields, groupchatrecord.FieldFromUserId)
+	}
+	if m.groupId != nil {
+		fields = append(fields, groupchatrecord.FieldGroupId)
+	}
+	if m.msgType != nil {
+		fields = append(fields, groupchatrecord.FieldMsgType)
+	}
+	if m.createTime != nil {
+		fields = append(fields, groupchatrecord.FieldCreateTime)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GroupChatRecordMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case groupchatrecord.FieldMsgId:
+		return m.MsgId()
+	case groupchatrecord.FieldFromUserId:
+		return m.FromUserId()
+	case groupchatrecord.FieldGroupId:
+		return m.GroupId()
+	case groupchatrecord.FieldMsgType:
+		return m.MsgType()
+	case groupchatrecord.FieldCreateTime:
+		return m.CreateTime()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GroupChatRecordMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case groupchatrecord.FieldMsgId:
+		return m.OldMsgId(ctx)
+	case groupchatrecord.FieldFromUserId:
+		return m.OldFromUserId(ctx)
+	case groupchatrecord.FieldGroupId:
+		return m.OldGroupId(ctx)
+	case groupchatrecord.FieldMsgType:
+		return m.OldMsgType(ctx)
+	case groupchatrecord.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	}
+	return nil, fmt.Errorf("unknown GroupChatRecord field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GroupChatRecordMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case groupchatrecord.FieldMsgId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMsgId(v)
+		return nil
+	case groupchatrecord.FieldFromUserId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFromUserId(v)
+		return nil
+	case groupchatrecord.FieldGroupId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGroupId(v)
+		return nil
+	case groupchatrecord.FieldMsgType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMsgType(v)
+		return nil
+	case groupchatrecord.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GroupChatRecord field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GroupChatRecordMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GroupChatRecordMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GroupChatRecordMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown GroupChatRecord numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GroupChatRecordMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GroupChatRecordMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GroupChatRecordMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown GroupChatRecord nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GroupChatRecordMutation) ResetField(name string) error {
+	switch name {
+	case groupchatrecord.FieldMsgId:
+		m.ResetMsgId()
+		return nil
+	case groupchatrecord.FieldFromUserId:
+		m.ResetFromUserId()
+		return nil
+	case groupchatrecord.FieldGroupId:
+		m.ResetGroupId()
+		return nil
+	case groupchatrecord.FieldMsgType:
+		m.ResetMsgType()
+		return nil
+	case groupchatrecord.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	}
+	return fmt.Errorf("unknown GroupChatRecord field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GroupChatRecordMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GroupChatRecordMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GroupChatRecordMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GroupChatRecordMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GroupChatRecordMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GroupChatRecordMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GroupChatRecordMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown GroupChatRecord unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GroupChatRecordMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown GroupChatRecord edge %s", name)
+}
+
+// GroupWatermarkMutation represents an operation that mutates the GroupWatermark nodes in the graph.
+type GroupWatermarkMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	userId          *int
+	adduserId       *int
+	groupId         *int
+	addgroupId      *int
+	readSeq         *int
+	addreadSeq      *int
+	deliveredSeq    *int
+	adddeliveredSeq *int
+	joinSeq         *int
+	addjoinSeq      *int
+	readTime        *time.Time
+	deliveredTime   *time.Time
+	createTime      *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*GroupWatermark, error)
+	predicates      []predicate.GroupWatermark
+}
+
+var _ ent.Mutation = (*GroupWatermarkMutation)(nil)
+
+// groupwatermarkOption allows management of the mutation configuration using functional options.
+type groupwatermarkOption func(*GroupWatermarkMutation)
+
+// newGroupWatermarkMutation creates new mutation for the GroupWatermark entity.
+func newGroupWatermarkMutation(c config, op Op, opts ...groupwatermarkOption) *GroupWatermarkMutation {
+	m := &GroupWatermarkMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGroupWatermark,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGroupWatermarkID sets the ID field of the mutation.
+func withGroupWatermarkID(id int) groupwatermarkOption {
+	return func(m *GroupWatermarkMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *GroupWatermark
+		)
+		m.oldValue = func(ctx context.Context) (*GroupWatermark, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().GroupWatermark.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGroupWatermark sets the old GroupWatermark of the mutation.
+func withGroupWatermark(node *GroupWatermark) groupwatermarkOption {
+	return func(m *GroupWatermarkMutation) {
+		m.oldValue = func(context.Context) (*GroupWatermark, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GroupWatermarkMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GroupWatermarkMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GroupWatermarkMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GroupWatermarkMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().GroupWatermark.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserId sets the "userId" field.
+func (m *GroupWatermarkMutation) SetUserId(i int) {
+	m.userId = &i
+	m.adduserId = nil
+}
+
+// UserId returns the value of the "userId" field in the mutation.
+func (m *GroupWatermarkMutation) UserId() (r int, exists bool) {
+	v := m.userId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserId returns the old "userId" field's value of the GroupWatermark entity.
+// If the GroupWatermark object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupWatermarkMutation) OldUserId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserId: %w", err)
+	}
+	return oldValue.UserId, nil
+}
+
+// AddUserId adds i to the "userId" field.
+func (m *GroupWatermarkMutation) AddUserId(i int) {
+	if m.adduserId != nil {
+		*m.adduserId += i
+	} else {
+		m.adduserId = &i
+	}
+}
+
+// AddedUserId returns the value that was added to the "userId" field in this mutation.
+func (m *GroupWatermarkMutation) AddedUserId() (r int, exists bool) {
+	v := m.adduserId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUserId resets all changes to the "userId" field.
+func (m *GroupWatermarkMutation) ResetUserId() {
+	m.userId = nil
+	m.adduserId = nil
+}
+
+// SetGroupId sets the "groupId" field.
+func (m *GroupWatermarkMutation) SetGroupId(i int) {
+	m.groupId = &i
+	m.addgroupId = nil
+}
+
+// GroupId returns the value of the "groupId" field in the mutation.
+func (m *GroupWatermarkMutation) GroupId() (r int, exists bool) {
+	v := m.groupId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGroupId returns the old "groupId" field's value of the GroupWatermark entity.
+// If the GroupWatermark object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupWatermarkMutation) OldGroupId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGroupId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGroupId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGroupId: %w", err)
+	}
+	return oldValue.GroupId, nil
+}
+
+// AddGroupId adds i to the "groupId" field.
+func (m *GroupWatermarkMutation) AddGroupId(i int) {
+	if m.addgroupId != nil {
+		*m.addgroupId += i
+	} else {
+		m.addgroupId = &i
+	}
+}
+
+// AddedGroupId returns the value that was added to the "groupId" field in this mutation.
+func (m *GroupWatermarkMutation) AddedGroupId() (r int, exists bool) {
+	v := m.addgroupId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetGroupId resets all changes to the "groupId" field.
+func (m *GroupWatermarkMutation) ResetGroupId() {
+	m.groupId = nil
+	m.addgroupId = nil
+}
+
+// SetReadSeq sets the "readSeq" field.
+func (m *GroupWatermarkMutation) SetReadSeq(i int) {
+	m.readSeq = &i
+	m.addreadSeq = nil
+}
+
+// ReadSeq returns the value of the "readSeq" field in the mutation.
+func (m *GroupWatermarkMutation) ReadSeq() (r int, exists bool) {
+	v := m.readSeq
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReadSeq returns the old "readSeq" field's value of the GroupWatermark entity.
+// If the GroupWatermark object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupWatermarkMutation) OldReadSeq(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReadSeq is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReadSeq requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReadSeq: %w", err)
+	}
+	return oldValue.ReadSeq, nil
+}
+
+// AddReadSeq adds i to the "readSeq" field.
+func (m *GroupWatermarkMutation) AddReadSeq(i int) {
+	if m.addreadSeq != nil {
+		*m.addreadSeq += i
+	} else {
+		m.addreadSeq = &i
+	}
+}
+
+// AddedReadSeq returns the value that was added to the "readSeq" field in this mutation.
+func (m *GroupWatermarkMutation) AddedReadSeq() (r int, exists bool) {
+	v := m.addreadSeq
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetReadSeq resets all changes to the "readSeq" field.
+func (m *GroupWatermarkMutation) ResetReadSeq() {
+	m.readSeq = nil
+	m.addreadSeq = nil
+}
+
+// SetDeliveredSeq sets the "deliveredSeq" field.
+func (m *GroupWatermarkMutation) SetDeliveredSeq(i int) {
+	m.deliveredSeq = &i
+	m.adddeliveredSeq = nil
+}
+
+// DeliveredSeq returns the value of the "deliveredSeq" field in the mutation.
+func (m *GroupWatermarkMutation) DeliveredSeq() (r int, exists bool) {
+	v := m.deliveredSeq
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeliveredSeq returns the old "deliveredSeq" field's value of the GroupWatermark entity.
+// If the GroupWatermark object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupWatermarkMutation) OldDeliveredSeq(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeliveredSeq is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeliveredSeq requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeliveredSeq: %w", err)
+	}
+	return oldValue.DeliveredSeq, nil
+}
+
+// AddDeliveredSeq adds i to the "deliveredSeq" field.
+func (m *GroupWatermarkMutation) AddDeliveredSeq(i int) {
+	if m.adddeliveredSeq != nil {
+		*m.adddeliveredSeq += i
+	} else {
+		m.adddeliveredSeq = &i
+	}
+}
+
+// AddedDeliveredSeq returns the value that was added to the "deliveredSeq" field in this mutation.
+func (m *GroupWatermarkMutation) AddedDeliveredSeq() (r int, exists bool) {
+	v := m.adddeliveredSeq
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDeliveredSeq resets all changes to the "deliveredSeq" field.
+func (m *GroupWatermarkMutation) ResetDeliveredSeq() {
+	m.deliveredSeq = nil
+	m.adddeliveredSeq = nil
+}
+
+// SetJoinSeq sets the "joinSeq" field.
+func (m *GroupWatermarkMutation) SetJoinSeq(i int) {
+	m.joinSeq = &i
+	m.addjoinSeq = nil
+}
+
+// JoinSeq returns the value of the "joinSeq" field in the mutation.
+func (m *GroupWatermarkMutation) JoinSeq() (r int, exists bool) {
+	v := m.joinSeq
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJoinSeq returns the old "joinSeq" field's value of the GroupWatermark entity.
+// If the GroupWatermark object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupWatermarkMutation) OldJoinSeq(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJoinSeq is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJoinSeq requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJoinSeq: %w", err)
+	}
+	return oldValue.JoinSeq, nil
+}
+
+// AddJoinSeq adds i to the "joinSeq" field.
+func (m *GroupWatermarkMutation) AddJoinSeq(i int) {
+	if m.addjoinSeq != nil {
+		*m.addjoinSeq += i
+	} else {
+		m.addjoinSeq = &i
+	}
+}
+
+// AddedJoinSeq returns the value that was added to the "joinSeq" field in this mutation.
+func (m *GroupWatermarkMutation) AddedJoinSeq() (r int, exists bool) {
+	v := m.addjoinSeq
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetJoinSeq resets all changes to the "joinSeq" field.
+func (m *GroupWatermarkMutation) ResetJoinSeq() {
+	m.joinSeq = nil
+	m.addjoinSeq = nil
+}
+
+// SetReadTime sets the "readTime" field.
+func (m *GroupWatermarkMutation) SetReadTime(t time.Time) {
+	m.readTime = &t
+}
+
+// ReadTime returns the value of the "readTime" field in the mutation.
+func (m *GroupWatermarkMutation) ReadTime() (r time.Time, exists bool) {
+	v := m.readTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReadTime returns the old "readTime" field's value of the GroupWatermark entity.
+// If the GroupWatermark object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupWatermarkMutation) OldReadTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReadTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReadTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReadTime: %w", err)
+	}
+	return oldValue.ReadTime, nil
+}
+
+// ClearReadTime clears the value of the "readTime" field.
+func (m *GroupWatermarkMutation) ClearReadTime() {
+	m.readTime = nil
+	m.clearedFields[groupwatermark.FieldReadTime] = struct{}{}
+}
+
+// ReadTimeCleared returns if the "readTime" field was cleared in this mutation.
+func (m *GroupWatermarkMutation) ReadTimeCleared() bool {
+	_, ok := m.clearedFields[groupwatermark.FieldReadTime]
+	return ok
+}
+
+// ResetReadTime resets all changes to the "readTime" field.
+func (m *GroupWatermarkMutation) ResetReadTime() {
+	m.readTime = nil
+	delete(m.clearedFields, groupwatermark.FieldReadTime)
+}
+
+// SetDeliveredTime sets the "deliveredTime" field.
+func (m *GroupWatermarkMutation) SetDeliveredTime(t time.Time) {
+	m.deliveredTime = &t
+}
+
+// DeliveredTime returns the value of the "deliveredTime" field in the mutation.
+func (m *GroupWatermarkMutation) DeliveredTime() (r time.Time, exists bool) {
+	v := m.deliveredTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeliveredTime returns the old "deliveredTime" field's value of the GroupWatermark entity.
+// If the GroupWatermark object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupWatermarkMutation) OldDeliveredTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeliveredTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeliveredTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeliveredTime: %w", err)
+	}
+	return oldValue.DeliveredTime, nil
+}
+
+// ClearDeliveredTime clears the value of the "deliveredTime" field.
+func (m *GroupWatermarkMutation) ClearDeliveredTime() {
+	m.deliveredTime = nil
+	m.clearedFields[groupwatermark.FieldDeliveredTime] = struct{}{}
+}
+
+// DeliveredTimeCleared returns if the "deliveredTime" field was cleared in this mutation.
+func (m *GroupWatermarkMutation) DeliveredTimeCleared() bool {
+	_, ok := m.clearedFields[groupwatermark.FieldDeliveredTime]
+	return ok
+}
+
+// ResetDeliveredTime resets all changes to the "deliveredTime" field.
+func (m *GroupWatermarkMutation) ResetDeliveredTime() {
+	m.deliveredTime = nil
+	delete(m.clearedFields, groupwatermark.FieldDeliveredTime)
+}
+
+// SetCreateTime sets the "createTime" field.
+func (m *GroupWatermarkMutation) SetCreateTime(t time.Time) {
+	m.createTime = &t
+}
+
+// CreateTime returns the value of the "createTime" field in the mutation.
+func (m *GroupWatermarkMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.createTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "createTime" field's value of the GroupWatermark entity.
+// If the GroupWatermark object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupWatermarkMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "createTime" field.
+func (m *GroupWatermarkMutation) ResetCreateTime() {
+	m.createTime = nil
+}
+
+// Where appends a list predicates to the GroupWatermarkMutation builder.
+func (m *GroupWatermarkMutation) Where(ps ...predicate.GroupWatermark) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GroupWatermarkMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GroupWatermarkMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GroupWatermark, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GroupWatermarkMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GroupWatermarkMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (GroupWatermark).
+func (m *GroupWatermarkMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GroupWatermarkMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.userId != nil {
+		fields = append(fields, groupwatermark.FieldUserId)
+	}
+	if m.groupId != nil {
+		fields = append(fields, groupwatermark.FieldGroupId)
+	}
+	if m.readSeq != nil {
+		fields = append(fields, groupwatermark.FieldReadSeq)
+	}
+	if m.deliveredSeq != nil {
+		fields = append(fields, groupwatermark.FieldDeliveredSeq)
+	}
+	if m.joinSeq != nil {
+		fields = append(fields, groupwatermark.FieldJoinSeq)
+	}
+	if m.readTime != nil {
+		fields = append(fields, groupwatermark.FieldReadTime)
+	}
+	if m.deliveredTime != nil {
+		fields = append(fields, groupwatermark.FieldDeliveredTime)
+	}
+	if m.createTime != nil {
+		fields = append(fields, groupwatermark.FieldCreateTime)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GroupWatermarkMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case groupwatermark.FieldUserId:
+		return m.UserId()
+	case groupwatermark.FieldGroupId:
+		return m.GroupId()
+	case groupwatermark.FieldReadSeq:
+		return m.ReadSeq()
+	case groupwatermark.FieldDeliveredSeq:
+		return m.DeliveredSeq()
+	case groupwatermark.FieldJoinSeq:
+		return m.JoinSeq()
+	case groupwatermark.FieldReadTime:
+		return m.ReadTime()
+	case groupwatermark.FieldDeliveredTime:
+		return m.DeliveredTime()
+	case groupwatermark.FieldCreateTime:
+		return m.CreateTime()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GroupWatermarkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case groupwatermark.FieldUserId:
+		return m.OldUserId(ctx)
+	case groupwatermark.FieldGroupId:
+		return m.OldGroupId(ctx)
+	case groupwatermark.FieldReadSeq:
+		return m.OldReadSeq(ctx)
+	case groupwatermark.FieldDeliveredSeq:
+		return m.OldDeliveredSeq(ctx)
+	case groupwatermark.FieldJoinSeq:
+		return m.OldJoinSeq(ctx)
+	case groupwatermark.FieldReadTime:
+		return m.OldReadTime(ctx)
+	case groupwatermark.FieldDeliveredTime:
+		return m.OldDeliveredTime(ctx)
+	case groupwatermark.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	}
+	return nil, fmt.Errorf("unknown GroupWatermark field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GroupWatermarkMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case groupwatermark.FieldUserId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserId(v)
+		return nil
+	case groupwatermark.FieldGroupId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGroupId(v)
+		return nil
+	case groupwatermark.FieldReadSeq:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReadSeq(v)
+		return nil
+	case groupwatermark.FieldDeliveredSeq:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeliveredSeq(v)
+		return nil
+	case groupwatermark.FieldJoinSeq:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJoinSeq(v)
+		return nil
+	case groupwatermark.FieldReadTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReadTime(v)
+		return nil
+	case groupwatermark.FieldDeliveredTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeliveredTime(v)
+		return nil
+	case groupwatermark.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GroupWatermark field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GroupWatermarkMutation) AddedFields() []string {
+	var fields []string
+	if m.adduserId != nil {
+		fields = append(fields, groupwatermark.FieldUserId)
+	}
+	if m.addgroupId != nil {
+		fields = append(fields, groupwatermark.FieldGroupId)
+	}
+	if m.addreadSeq != nil {
+		fields = append(fields, groupwatermark.FieldReadSeq)
+	}
+	if m.adddeliveredSeq != nil {
+		fields = append(fields, groupwatermark.FieldDeliveredSeq)
+	}
+	if m.addjoinSeq != nil {
+		fields = append(fields, groupwatermark.FieldJoinSeq)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GroupWatermarkMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case groupwatermark.FieldUserId:
+		return m.AddedUserId()
+	case groupwatermark.FieldGroupId:
+		return m.AddedGroupId()
+	case groupwatermark.FieldReadSeq:
+		return m.AddedReadSeq()
+	case groupwatermark.FieldDeliveredSeq:
+		return m.AddedDeliveredSeq()
+	case groupwatermark.FieldJoinSeq:
+		return m.AddedJoinSeq()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GroupWatermarkMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case groupwatermark.FieldUserId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserId(v)
+		return nil
+	case groupwatermark.FieldGroupId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGroupId(v)
+		return nil
+	case groupwatermark.FieldReadSeq:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReadSeq(v)
+		return nil
+	case groupwatermark.FieldDeliveredSeq:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDeliveredSeq(v)
+		return nil
+	case groupwatermark.FieldJoinSeq:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddJoinSeq(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GroupWatermark numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GroupWatermarkMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(groupwatermark.FieldReadTime) {
+		fields = append(fields, groupwatermark.FieldReadTime)
+	}
+	if m.FieldCleared(groupwatermark.FieldDeliveredTime) {
+		fields = append(fields, groupwatermark.FieldDeliveredTime)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GroupWatermarkMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GroupWatermarkMutation) ClearField(name string) error {
+	switch name {
+	case groupwatermark.FieldReadTime:
+		m.ClearReadTime()
+		return nil
+	case groupwatermark.FieldDeliveredTime:
+		m.ClearDeliveredTime()
+		return nil
+	}
+	return fmt.Errorf("unknown GroupWatermark nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GroupWatermarkMutation) ResetField(name string) error {
+	switch name {
+	case groupwatermark.FieldUserId:
+		m.ResetUserId()
+		return nil
+	case groupwatermark.FieldGroupId:
+		m.ResetGroupId()
+		return nil
+	case groupwatermark.FieldReadSeq:
+		m.ResetReadSeq()
+		return nil
+	case groupwatermark.FieldDeliveredSeq:
+		m.ResetDeliveredSeq()
+		return nil
+	case groupwatermark.FieldJoinSeq:
+		m.ResetJoinSeq()
+		return nil
+	case groupwatermark.FieldReadTime:
+		m.ResetReadTime()
+		return nil
+	case groupwatermark.FieldDeliveredTime:
+		m.ResetDeliveredTime()
+		return nil
+	case groupwatermark.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	}
+	return fmt.Errorf("unknown GroupWatermark field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GroupWatermarkMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GroupWatermarkMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GroupWatermarkMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GroupWatermarkMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GroupWatermarkMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GroupWatermarkMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GroupWatermarkMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown GroupWatermark unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GroupWatermarkMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown GroupWatermark edge %s", name)
+}
+
+// ImageMessageMutation represents an operation that mutates the ImageMessage nodes in the graph.
+type ImageMessageMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	msgId         *string
+	imageUrl      *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ImageMessage, error)
+	predicates    []predicate.ImageMessage
+}
+
+var _ ent.Mutation = (*ImageMessageMutation)(nil)
+
+// imagemessageOption allows management of the mutation configuration using functional options.
+type imagemessageOption func(*ImageMessageMutation)
+
+// newImageMessageMutation creates new mutation for the ImageMessage entity.
+func newImageMessageMutation(c config, op Op, opts ...imagemessageOption) *ImageMessageMutation {
+	m := &ImageMessageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeImageMessage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withImageMessageID sets the ID field of the mutation.
+func withImageMessageID(id int) imagemessageOption {
+	return func(m *ImageMessageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ImageMessage
+		)
+		m.oldValue = func(ctx context.Context) (*ImageMessage, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ImageMessage.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withImageMessage sets the old ImageMessage of the mutation.
+func withImageMessage(node *ImageMessage) imagemessageOption {
+	return func(m *ImageMessageMutation) {
+		m.oldValue = func(context.Context) (*ImageMessage, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ImageMessageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ImageMessageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ImageMessageMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ImageMessageMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ImageMessage.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMsgId sets the "msgId" field.
+func (m *ImageMessageMutation) SetMsgId(s string) {
+	m.msgId = &s
+}
+
+// MsgId returns the value of the "msgId" field in the mutation.
+func (m *ImageMessageMutation) MsgId() (r string, exists bool) {
+	v := m.msgId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMsgId returns the old "msgId" field's value of the ImageMessage entity.
+// If the ImageMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImageMessageMutation) OldMsgId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMsgId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMsgId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMsgId: %w", err)
+	}
+	return oldValue.MsgId, nil
+}
+
+// ResetMsgId resets all changes to the "msgId" field.
+func (m *ImageMessageMutation) ResetMsgId() {
+	m.msgId = nil
+}
+
+// SetImageUrl sets the "imageUrl" field.
+func (m *ImageMessageMutation) SetImageUrl(s string) {
+	m.imageUrl = &s
+}
+
+// ImageUrl returns the value of the "imageUrl" field in the mutation.
+func (m *ImageMessageMutation) ImageUrl() (r string, exists bool) {
+	v := m.imageUrl
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImageUrl returns the old "imageUrl" field's value of the ImageMessage entity.
+// If the ImageMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImageMessageMutation) OldImageUrl(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImageUrl is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImageUrl requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImageUrl: %w", err)
+	}
+	return oldValue.ImageUrl, nil
+}
+
+// ResetImageUrl resets all changes to the "imageUrl" field.
+func (m *ImageMessageMutation) ResetImageUrl() {
+	m.imageUrl = nil
+}
+
+// Where appends a list predicates to the ImageMessageMutation builder.
+func (m *ImageMessageMutation) Where(ps ...predicate.ImageMessage) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ImageMessageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ImageMessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ImageMessage, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ImageMessageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ImageMessageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ImageMessage).
+func (m *ImageMessageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ImageMessageMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.msgId != nil {
+		fields = append(fields, imagemessage.FieldMsgId)
+	}
+	if m.imageUrl != nil {
+		fields = append(fields, imagemessage.FieldImageUrl)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ImageMessageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case imagemessage.FieldMsgId:
+		return m.MsgId()
+	case imagemessage.FieldImageUrl:
+		return m.ImageUrl()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ImageMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case imagemessage.FieldMsgId:
+		return m.OldMsgId(ctx)
+	case imagemessage.FieldImageUrl:
+		return m.OldImageUrl(ctx)
+	}
+	return nil, fmt.Errorf("unknown ImageMessage field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ImageMessageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case imagemessage.FieldMsgId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMsgId(v)
+		return nil
+	case imagemessage.FieldImageUrl:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImageUrl(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ImageMessage field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ImageMessageMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ImageMessageMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ImageMessageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ImageMessage numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ImageMessageMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ImageMessageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ImageMessageMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ImageMessage nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ImageMessageMutation) ResetField(name string) error {
+	switch name {
+	case imagemessage.FieldMsgId:
+		m.ResetMsgId()
+		return nil
+	case imagemessage.FieldImageUrl:
+		m.ResetImageUrl()
+		return nil
+	}
+	return fmt.Errorf("unknown ImageMessage field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ImageMessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ImageMessageMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ImageMessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ImageMessageMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ImageMessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ImageMessageMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ImageMessageMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ImageMessage unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ImageMessageMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ImageMessage edge %s", name)
+}
+
+// LocationMessageMutation represents an operation that mutates the LocationMessage nodes in the graph.
+type LocationMessageMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	msgId         *string
+	latitude      *float64
+	addlatitude   *float64
+	longitude     *float64
+	addlongitude  *float64
+	name          *string
+	address       *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*LocationMessage, error)
+	predicates    []predicate.LocationMessage
+}
+
+var _ ent.Mutation = (*LocationMessageMutation)(nil)
+
+// locationmessageOption allows management of the mutation configuration using functional options.
+type locationmessageOption func(*LocationMessageMutation)
+
+// newLocationMessageMutation creates new mutation for the LocationMessage entity.
+func newLocationMessageMutation(c config, op Op, opts ...locationmessageOption) *LocationMessageMutation {
+	m := &LocationMessageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLocationMessage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLocationMessageID sets the ID field of the mutation.
+func withLocationMessageID(id int) locationmessageOption {
+	return func(m *LocationMessageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LocationMessage
+		)
+		m.oldValue = func(ctx context.Context) (*LocationMessage, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LocationMessage.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLocationMessage sets the old LocationMessage of the mutation.
+func withLocationMessage(node *LocationMessage) locationmessageOption {
+	return func(m *LocationMessageMutation) {
+		m.oldValue = func(context.Context) (*LocationMessage, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LocationMessageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LocationMessageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LocationMessageMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LocationMessageMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LocationMessage.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMsgId sets the "msgId" field.
+func (m *LocationMessageMutation) SetMsgId(s string) {
+	m.msgId = &s
+}
+
+// MsgId returns the value of the "msgId" field in the mutation.
+func (m *LocationMessageMutation) MsgId() (r string, exists bool) {
+	v := m.msgId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMsgId returns the old "msgId" field's value of the LocationMessage entity.
+// If the LocationMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocationMessageMutation) OldMsgId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMsgId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMsgId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMsgId: %w", err)
+	}
+	return oldValue.MsgId, nil
+}
+
+// ResetMsgId resets all changes to the "msgId" field.
+func (m *LocationMessageMutation) ResetMsgId() {
+	m.msgId = nil
+}
+
+// SetLatitude sets the "latitude" field.
+func (m *LocationMessageMutation) SetLatitude(f float64) {
+	m.latitude = &f
+	m.addlatitude = nil
+}
+
+// Latitude returns the value of the "latitude" field in the mutation.
+func (m *LocationMessageMutation) Latitude() (r float64, exists bool) {
+	v := m.latitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLatitude returns the old "latitude" field's value of the LocationMessage entity.
+// If the LocationMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocationMessageMutation) OldLatitude(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLatitude is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLatitude requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLatitude: %w", err)
+	}
+	return oldValue.Latitude, nil
+}
+
+// AddLatitude adds f to the "latitude" field.
+func (m *LocationMessageMutation) AddLatitude(f float64) {
+	if m.addlatitude != nil {
+		*m.addlatitude += f
+	} else {
+		m.addlatitude = &f
+	}
+}
+
+// AddedLatitude returns the value that was added to the "latitude" field in this mutation.
+func (m *LocationMessageMutation) AddedLatitude() (r float64, exists bool) {
+	v := m.addlatitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLatitude resets all changes to the "latitude" field.
+func (m *LocationMessageMutation) ResetLatitude() {
+	m.latitude = nil
+	m.addlatitude = nil
+}
+
+// SetLongitude sets the "longitude" field.
+func (m *LocationMessageMutation) SetLongitude(f float64) {
+	m.longitude = &f
+	m.addlongitude = nil
+}
+
+// Longitude returns the value of the "longitude" field in the mutation.
+func (m *LocationMessageMutation) Longitude() (r float64, exists bool) {
+	v := m.longitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLongitude returns the old "longitude" field's value of the LocationMessage entity.
+// If the LocationMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocationMessageMutation) OldLongitude(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLongitude is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLongitude requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLongitude: %w", err)
+	}
+	return oldValue.Longitude, nil
+}
+
+// AddLongitude adds f to the "longitude" field.
+func (m *LocationMessageMutation) AddLongitude(f float64) {
+	if m.addlongitude != nil {
+		*m.addlongitude += f
+	} else {
+		m.addlongitude = &f
+	}
+}
+
+// AddedLongitude returns the value that was added to the "longitude" field in this mutation.
+func (m *LocationMessageMutation) AddedLongitude() (r float64, exists bool) {
+	v := m.addlongitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLongitude resets all changes to the "longitude" field.
+func (m *LocationMessageMutation) ResetLongitude() {
+	m.longitude = nil
+	m.addlongitude = nil
+}
+
+// SetName sets the "name" field.
+func (m *LocationMessageMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *LocationMessageMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the LocationMessage entity.
+// If the LocationMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocationMessageMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ClearName clears the value of the "name" field.
+func (m *LocationMessageMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[locationmessage.FieldName] = struct{}{}
+}
+
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *LocationMessageMutation) NameCleared() bool {
+	_, ok := m.clearedFields[locationmessage.FieldName]
+	return ok
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *LocationMessageMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, locationmessage.FieldName)
+}
+
+// SetAddress sets the "address" field.
+func (m *LocationMessageMutation) SetAddress(s string) {
+	m.address = &s
+}
+
+// Address returns the value of the "address" field in the mutation.
+func (m *LocationMessageMutation) Address() (r string, exists bool) {
+	v := m.address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAddress returns the old "address" field's value of the LocationMessage entity.
+// If the LocationMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocationMessageMutation) OldAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddress: %w", err)
+	}
+	return oldValue.Address, nil
+}
+
+// ClearAddress clears the value of the "address" field.
+func (m *LocationMessageMutation) ClearAddress() {
+	m.address = nil
+	m.clearedFields[locationmessage.FieldAddress] = struct{}{}
+}
+
+// AddressCleared returns if the "address" field was cleared in this mutation.
+func (m *LocationMessageMutation) AddressCleared() bool {
+	_, ok := m.clearedFields[locationmessage.FieldAddress]
+	return ok
+}
+
+// ResetAddress resets all changes to the "address" field.
+func (m *LocationMessageMutation) ResetAddress() {
+	m.address = nil
+	delete(m.clearedFields, locationmessage.FieldAddress)
+}
+
+// Where appends a list predicates to the LocationMessageMutation builder.
+func (m *LocationMessageMutation) Where(ps ...predicate.LocationMessage) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LocationMessageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LocationMessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LocationMessage, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LocationMessageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LocationMessageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LocationMessage).
+func (m *LocationMessageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LocationMessageMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.msgId != nil {
+		fields = append(fields, locationmessage.FieldMsgId)
+	}
+	if m.latitude != nil {
+		fields = append(fields, locationmessage.FieldLatitude)
+	}
+	if m.longitude != nil {
+		fields = append(fields, locationmessage.FieldLongitude)
+	}
+	if m.name != nil {
+		fields = append(fields, locationmessage.FieldName)
+	}
+	if m.address != nil {
+		fields = append(fields, locationmessage.FieldAddress)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LocationMessageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case locationmessage.FieldMsgId:
+		return m.MsgId()
+	case locationmessage.FieldLatitude:
+		return m.Latitude()
+	case locationmessage.FieldLongitude:
+		return m.Longitude()
+	case locationmessage.FieldName:
+		return m.Name()
+	case locationmessage.FieldAddress:
+		return m.Address()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LocationMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case locationmessage.FieldMsgId:
+		return m.OldMsgId(ctx)
+	case locationmessage.FieldLatitude:
+		return m.OldLatitude(ctx)
+	case locationmessage.FieldLongitude:
+		return m.OldLongitude(ctx)
+	case locationmessage.FieldName:
+		return m.OldName(ctx)
+	case locationmessage.FieldAddress:
+		return m.OldAddress(ctx)
+	}
+	return nil, fmt.Errorf("unknown LocationMessage field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LocationMessageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case locationmessage.FieldMsgId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMsgId(v)
+		return nil
+	case locationmessage.FieldLatitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLatitude(v)
+		return nil
+	case locationmessage.FieldLongitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLongitude(v)
+		return nil
+	case locationmessage.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case locationmessage.FieldAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddress(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LocationMessage field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LocationMessageMutation) AddedFields() []string {
+	var fields []string
+	if m.addlatitude != nil {
+		fields = append(fields, locationmessage.FieldLatitude)
+	}
+	if m.addlongitude != nil {
+		fields = append(fields, locationmessage.FieldLongitude)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LocationMessageMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case locationmessage.FieldLatitude:
+		return m.AddedLatitude()
+	case locationmessage.FieldLongitude:
+		return m.AddedLongitude()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LocationMessageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case locationmessage.FieldLatitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLatitude(v)
+		return nil
+	case locationmessage.FieldLongitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLongitude(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LocationMessage numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LocationMessageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(locationmessage.FieldName) {
+		fields = append(fields, locationmessage.FieldName)
+	}
+	if m.FieldCleared(locationmessage.FieldAddress) {
+		fields = append(fields, locationmessage.FieldAddress)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LocationMessageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LocationMessageMutation) ClearField(name string) error {
+	switch name {
+	case locationmessage.FieldName:
+		m.ClearName()
+		return nil
+	case locationmessage.FieldAddress:
+		m.ClearAddress()
+		return nil
+	}
+	return fmt.Errorf("unknown LocationMessage nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LocationMessageMutation) ResetField(name string) error {
+	switch name {
+	case locationmessage.FieldMsgId:
+		m.ResetMsgId()
+		return nil
+	case locationmessage.FieldLatitude:
+		m.ResetLatitude()
+		return nil
+	case locationmessage.FieldLongitude:
+		m.ResetLongitude()
+		return nil
+	case locationmessage.FieldName:
+		m.ResetName()
+		return nil
+	case locationmessage.FieldAddress:
+		m.ResetAddress()
+		return nil
+	}
+	return fmt.Errorf("unknown LocationMessage field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LocationMessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LocationMessageMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LocationMessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LocationMessageMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LocationMessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LocationMessageMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LocationMessageMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown LocationMessage unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LocationMessageMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown LocationMessage edge %s", name)
+}
+
+// MergedForwardMessageMutation represents an operation that mutates the MergedForwardMessage nodes in the graph.
+type MergedForwardMessageMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	msgId         *string
+	title         *string
+	payload       *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*MergedForwardMessage, error)
+	predicates    []predicate.MergedForwardMessage
+}
+
+var _ ent.Mutation = (*MergedForwardMessageMutation)(nil)
+
+// mergedforwardmessageOption allows management of the mutation configuration using functional options.
+type mergedforwardmessageOption func(*MergedForwardMessageMutation)
+
+// newMergedForwardMessageMutation creates new mutation for the MergedForwardMessage entity.
+func newMergedForwardMessageMutation(c config, op Op, opts ...mergedforwardmessageOption) *MergedForwardMessageMutation {
+	m := &MergedForwardMessageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMergedForwardMessage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMergedForwardMessageID sets the ID field of the mutation.
+func withMergedForwardMessageID(id int) mergedforwardmessageOption {
+	return func(m *MergedForwardMessageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MergedForwardMessage
+		)
+		m.oldValue = func(ctx context.Context) (*MergedForwardMessage, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MergedForwardMessage.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMergedForwardMessage sets the old MergedForwardMessage of the mutation.
+func withMergedForwardMessage(node *MergedForwardMessage) mergedforwardmessageOption {
+	return func(m *MergedForwardMessageMutation) {
+		m.oldValue = func(context.Context) (*MergedForwardMessage, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MergedForwardMessageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MergedForwardMessageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MergedForwardMessageMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MergedForwardMessageMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MergedForwardMessage.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMsgId sets the "msgId" field.
+func (m *MergedForwardMessageMutation) SetMsgId(s string) {
+	m.msgId = &s
+}
+
+// MsgId returns the value of the "msgId" field in the mutation.
+func (m *MergedForwardMessageMutation) MsgId() (r string, exists bool) {
+	v := m.msgId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMsgId returns the old "msgId" field's value of the MergedForwardMessage entity.
+// If the MergedForwardMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MergedForwardMessageMutation) OldMsgId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMsgId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMsgId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMsgId: %w", err)
+	}
+	return oldValue.MsgId, nil
+}
+
+// ResetMsgId resets all changes to the "msgId" field.
+func (m *MergedForwardMessageMutation) ResetMsgId() {
+	m.msgId = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *MergedForwardMessageMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *MergedForwardMessageMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the MergedForwardMessage entity.
+// If the MergedForwardMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MergedForwardMessageMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *MergedForwardMessageMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetPayload sets the "payload" field.
+func (m *MergedForwardMessageMutation) SetPayload(s string) {
+	m.payload = &s
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *MergedForwardMessageMutation) Payload() (r string, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayload returns the old "payload" field's value of the MergedForwardMessage entity.
+// If the MergedForwardMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MergedForwardMessageMutation) OldPayload(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
+}
+
+// ResetPayload resets all changes to the "payload" field.
+func (m *MergedForwardMessageMutation) ResetPayload() {
+	m.payload = nil
+}
+
+// Where appends a list predicates to the MergedForwardMessageMutation builder.
+func (m *MergedForwardMessageMutation) Where(ps ...predicate.MergedForwardMessage) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MergedForwardMessageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MergedForwardMessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MergedForwardMessage, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MergedForwardMessageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MergedForwardMessageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MergedForwardMessage).
+func (m *MergedForwardMessageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MergedForwardMessageMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.msgId != nil {
+		fields = append(fields, mergedforwardmessage.FieldMsgId)
+	}
+	if m.title != nil {
+		fields = append(fields, mergedforwardmessage.FieldTitle)
+	}
+	if m.payload != nil {
+		fields = append(fields, mergedforwardmessage.FieldPayload)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MergedForwardMessageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case mergedforwardmessage.FieldMsgId:
+		return m.MsgId()
+	case mergedforwardmessage.FieldTitle:
+		return m.Title()
+	case mergedforwardmessage.FieldPayload:
+		return m.Payload()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MergedForwardMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case mergedforwardmessage.FieldMsgId:
+		return m.OldMsgId(ctx)
+	case mergedforwardmessage.FieldTitle:
+		return m.OldTitle(ctx)
+	case mergedforwardmessage.FieldPayload:
+		return m.OldPayload(ctx)
+	}
+	return nil, fmt.Errorf("unknown MergedForwardMessage field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MergedForwardMessageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case mergedforwardmessage.FieldMsgId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMsgId(v)
+		return nil
+	case mergedforwardmessage.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case mergedforwardmessage.FieldPayload:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MergedForwardMessage field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MergedForwardMessageMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MergedForwardMessageMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MergedForwardMessageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MergedForwardMessage numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MergedForwardMessageMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MergedForwardMessageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MergedForwardMessageMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MergedForwardMessage nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MergedForwardMessageMutation) ResetField(name string) error {
+	switch name {
+	case mergedforwardmessage.FieldMsgId:
+		m.ResetMsgId()
+		return nil
+	case mergedforwardmessage.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case mergedforwardmessage.FieldPayload:
+		m.ResetPayload()
+		return nil
+	}
+	return fmt.Errorf("unknown MergedForwardMessage field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MergedForwardMessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MergedForwardMessageMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MergedForwardMessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MergedForwardMessageMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MergedForwardMessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MergedForwardMessageMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MergedForwardMessageMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown MergedForwardMessage unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MergedForwardMessageMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown MergedForwardMessage edge %s", name)
+}
+
+// MessageMutation represents an operation that mutates the Message nodes in the graph.
+type MessageMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	msgId         *string
+	msgType       *string
+	content       *string
+	body          *string
+	isRevoked     *bool
+	revokeTime    *time.Time
+	createTime    *time.Time
+	expireTime    *time.Time
+	isPurged      *bool
+	purgeTime     *time.Time
+	storageKey    *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Message, error)
+	predicates    []predicate.Message
+}
+
+var _ ent.Mutation = (*MessageMutation)(nil)
+
+// messageOption allows management of the mutation configuration using functional options.
+type messageOption func(*MessageMutation)
+
+// newMessageMutation creates new mutation for the Message entity.
+func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation {
+	m := &MessageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageID sets the ID field of the mutation.
+func withMessageID(id int) messageOption {
+	return func(m *MessageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Message
+		)
+		m.oldValue = func(ctx context.Context) (*Message, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Message.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessage sets the old Message of the mutation.
+func withMessage(node *Message) messageOption {
+	return func(m *MessageMutation) {
+		m.oldValue = func(context.Context) (*Message, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Message.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMsgId sets the "msgId" field.
+func (m *MessageMutation) SetMsgId(s string) {
+	m.msgId = &s
+}
+
+// MsgId returns the value of the "msgId" field in the mutation.
+func (m *MessageMutation) MsgId() (r string, exists bool) {
+	v := m.msgId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMsgId returns the old "msgId" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldMsgId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMsgId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMsgId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMsgId: %w", err)
+	}
+	return oldValue.MsgId, nil
+}
+
+// ResetMsgId resets all changes to the "msgId" field.
+func (m *MessageMutation) ResetMsgId() {
+	m.msgId = nil
+}
+
+// SetMsgType sets the "msgType" field.
+func (m *MessageMutation) SetMsgType(s string) {
+	m.msgType = &s
+}
+
+// MsgType returns the value of the "msgType" field in the mutation.
+func (m *MessageMutation) MsgType() (r string, exists bool) {
+	v := m.msgType
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMsgType returns the old "msgType" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldMsgType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMsgType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMsgType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMsgType: %w", err)
+	}
+	return oldValue.MsgType, nil
+}
+
+// ResetMsgType resets all changes to the "msgType" field.
+func (m *MessageMutation) ResetMsgType() {
+	m.msgType = nil
+}
+
+// SetContent sets the "content" field.
+func (m *MessageMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *MessageMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *MessageMutation) ResetContent() {
+	m.content = nil
+}
+
+// SetBody sets the "body" field.
+func (m *MessageMutation) SetBody(s string) {
+	m.body = &s
+}
+
+// Body returns the value of the "body" field in the mutation.
+func (m *MessageMutation) Body() (r string, exists bool) {
+	v := m.body
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBody returns the old "body" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldBody(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+	}
+	return oldValue.Body, nil
+}
+
+// ResetBody resets all changes to the "body" field.
+func (m *MessageMutation) ResetBody() {
+	m.body = nil
+}
+
+// SetIsRevoked sets the "isRevoked" field.
+func (m *MessageMutation) SetIsRevoked(b bool) {
+	m.isRevoked = &b
+}
+
+// IsRevoked returns the value of the "isRevoked" field in the mutation.
+func (m *MessageMutation) IsRevoked() (r bool, exists bool) {
+	v := m.isRevoked
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsRevoked returns the old "isRevoked" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldIsRevoked(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsRevoked is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsRevoked requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsRevoked: %w", err)
+	}
+	return oldValue.IsRevoked, nil
+}
+
+// ResetIsRevoked resets all changes to the "isRevoked" field.
+func (m *MessageMutation) ResetIsRevoked() {
+	m.isRevoked = nil
+}
+
+// SetRevokeTime sets the "revokeTime" field.
+func (m *MessageMutation) SetRevokeTime(t time.Time) {
+	m.revokeTime = &t
+}
+
+// RevokeTime returns the value of the "revokeTime" field in the mutation.
+func (m *MessageMutation) RevokeTime() (r time.Time, exists bool) {
+	v := m.revokeTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevokeTime returns the old "revokeTime" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldRevokeTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevokeTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevokeTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevokeTime: %w", err)
+	}
+	return oldValue.RevokeTime, nil
+}
+
+// ClearRevokeTime clears the value of the "revokeTime" field.
+func (m *MessageMutation) ClearRevokeTime() {
+	m.revokeTime = nil
+	m.clearedFields[message.FieldRevokeTime] = struct{}{}
+}
+
+// RevokeTimeCleared returns if the "revokeTime" field was cleared in this mutation.
+func (m *MessageMutation) RevokeTimeCleared() bool {
+	_, ok := m.clearedFields[message.FieldRevokeTime]
+	return ok
+}
+
+// ResetRevokeTime resets all changes to the "revokeTime" field.
+func (m *MessageMutation) ResetRevokeTime() {
+	m.revokeTime = nil
+	delete(m.clearedFields, message.FieldRevokeTime)
+}
+
+// SetCreateTime sets the "createTime" field.
+func (m *MessageMutation) SetCreateTime(t time.Time) {
+	m.createTime = &t
+}
+
+// CreateTime returns the value of the "createTime" field in the mutation.
+func (m *MessageMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.createTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "createTime" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "createTime" field.
+func (m *MessageMutation) ResetCreateTime() {
+	m.createTime = nil
+}
+
+// SetExpireTime sets the "expireTime" field.
+func (m *MessageMutation) SetExpireTime(t time.Time) {
+	m.expireTime = &t
+}
+
+// ExpireTime returns the value of the "expireTime" field in the mutation.
+func (m *MessageMutation) ExpireTime() (r time.Time, exists bool) {
+	v := m.expireTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpireTime returns the old "expireTime" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldExpireTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpireTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpireTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpireTime: %w", err)
+	}
+	return oldValue.ExpireTime, nil
+}
+
+// ClearExpireTime clears the value of the "expireTime" field.
+func (m *MessageMutation) ClearExpireTime() {
+	m.expireTime = nil
+	m.clearedFields[message.FieldExpireTime] = struct{}{}
+}
+
+// ExpireTimeCleared returns if the "expireTime" field was cleared in this mutation.
+func (m *MessageMutation) ExpireTimeCleared() bool {
+	_, ok := m.clearedFields[message.FieldExpireTime]
+	return ok
+}
+
+// ResetExpireTime resets all changes to the "expireTime" field.
+func (m *MessageMutation) ResetExpireTime() {
+	m.expireTime = nil
+	delete(m.clearedFields, message.FieldExpireTime)
+}
+
+// SetIsPurged sets the "isPurged" field.
+func (m *MessageMutation) SetIsPurged(b bool) {
+	m.isPurged = &b
+}
+
+// IsPurged returns the value of the "isPurged" field in the mutation.
+func (m *MessageMutation) IsPurged() (r bool, exists bool) {
+	v := m.isPurged
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsPurged returns the old "isPurged" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldIsPurged(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsPurged is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsPurged requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsPurged: %w", err)
+	}
+	return oldValue.IsPurged, nil
+}
+
+// ResetIsPurged resets all changes to the "isPurged" field.
+func (m *MessageMutation) ResetIsPurged() {
+	m.isPurged = nil
+}
+
+// SetPurgeTime sets the "purgeTime" field.
+func (m *MessageMutation) SetPurgeTime(t time.Time) {
+	m.purgeTime = &t
+}
+
+// PurgeTime returns the value of the "purgeTime" field in the mutation.
+func (m *MessageMutation) PurgeTime() (r time.Time, exists bool) {
+	v := m.purgeTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPurgeTime returns the old "purgeTime" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldPurgeTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPurgeTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPurgeTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPurgeTime: %w", err)
+	}
+	return oldValue.PurgeTime, nil
+}
+
+// ClearPurgeTime clears the value of the "purgeTime" field.
+func (m *MessageMutation) ClearPurgeTime() {
+	m.purgeTime = nil
+	m.clearedFields[message.FieldPurgeTime] = struct{}{}
+}
+
+// PurgeTimeCleared returns if the "purgeTime" field was cleared in this mutation.
+func (m *MessageMutation) PurgeTimeCleared() bool {
+	_, ok := m.clearedFields[message.FieldPurgeTime]
+	return ok
+}
+
+// ResetPurgeTime resets all changes to the "purgeTime" field.
+func (m *MessageMutation) ResetPurgeTime() {
+	m.purgeTime = nil
+	delete(m.clearedFields, message.FieldPurgeTime)
+}
+
+// SetStorageKey sets the "storageKey" field.
+func (m *MessageMutation) SetStorageKey(s string) {
+	m.storageKey = &s
+}
+
+// StorageKey returns the value of the "storageKey" field in the mutation.
+func (m *MessageMutation) StorageKey() (r string, exists bool) {
+	v := m.storageKey
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStorageKey returns the old "storageKey" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldStorageKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStorageKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStorageKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStorageKey: %w", err)
+	}
+	return oldValue.StorageKey, nil
+}
+
+// ResetStorageKey resets all changes to the "storageKey" field.
+func (m *MessageMutation) ResetStorageKey() {
+	m.storageKey = nil
+}
+
+// Where appends a list predicates to the MessageMutation builder.
+func (m *MessageMutation) Where(ps ...predicate.Message) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Message, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Message).
+func (m *MessageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.msgId != nil {
+		fields = append(fields, message.FieldMsgId)
+	}
+	if m.msgType != nil {
+		fields = append(fields, message.FieldMsgType)
+	}
+	if m.content != nil {
+		fields = append(fields, message.FieldContent)
+	}
+	if m.body != nil {
+		fields = append(fields, message.FieldBody)
+	}
+	if m.isRevoked != nil {
+		fields = append(fields, message.FieldIsRevoked)
+	}
+	if m.revokeTime != nil {
+		fields = append(fields, message.FieldRevokeTime)
+	}
+	if m.createTime != nil {
+		fields = append(fields, message.FieldCreateTime)
+	}
+	if m.expireTime != nil {
+		fields = append(fields, message.FieldExpireTime)
+	}
+	if m.isPurged != nil {
+		fields = append(fields, message.FieldIsPurged)
+	}
+	if m.purgeTime != nil {
+		fields = append(fields, message.FieldPurgeTime)
+	}
+	if m.storageKey != nil {
+		fields = append(fields, message.FieldStorageKey)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case message.FieldMsgId:
+		return m.MsgId()
+	case message.FieldMsgType:
+		return m.MsgType()
+	case message.FieldContent:
+		return m.Content()
+	case message.FieldBody:
+		return m.Body()
+	case message.FieldIsRevoked:
+		return m.IsRevoked()
+	case message.FieldRevokeTime:
+		return m.RevokeTime()
+	case message.FieldCreateTime:
+		return m.CreateTime()
+	case message.FieldExpireTime:
+		return m.ExpireTime()
+	case message.FieldIsPurged:
+		return m.IsPurged()
+	case message.FieldPurgeTime:
+		return m.PurgeTime()
+	case message.FieldStorageKey:
+		return m.StorageKey()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case message.FieldMsgId:
+		return m.OldMsgId(ctx)
+	case message.FieldMsgType:
+		return m.OldMsgType(ctx)
+	case message.FieldContent:
+		return m.OldContent(ctx)
+	case message.FieldBody:
+		return m.OldBody(ctx)
+	case message.FieldIsRevoked:
+		return m.OldIsRevoked(ctx)
+	case message.FieldRevokeTime:
+		return m.OldRevokeTime(ctx)
+	case message.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case message.FieldExpireTime:
+		return m.OldExpireTime(ctx)
+	case message.FieldIsPurged:
+		return m.OldIsPurged(ctx)
+	case message.FieldPurgeTime:
+		return m.OldPurgeTime(ctx)
+	case message.FieldStorageKey:
+		return m.OldStorageKey(ctx)
+	}
+	return nil, fmt.Errorf("unknown Message field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case message.FieldMsgId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMsgId(v)
+		return nil
+	case message.FieldMsgType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMsgType(v)
+		return nil
+	case message.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case message.FieldBody:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBody(v)
+		return nil
+	case message.FieldIsRevoked:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsRevoked(v)
+		return nil
+	case message.FieldRevokeTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokeTime(v)
+		return nil
+	case message.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case message.FieldExpireTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpireTime(v)
+		return nil
+	case message.FieldIsPurged:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsPurged(v)
+		return nil
+	case message.FieldPurgeTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPurgeTime(v)
+		return nil
+	case message.FieldStorageKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStorageKey(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Message field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Message numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(message.FieldRevokeTime) {
+		fields = append(fields, message.FieldRevokeTime)
+	}
+	if m.FieldCleared(message.FieldExpireTime) {
+		fields = append(fields, message.FieldExpireTime)
+	}
+	if m.FieldCleared(message.FieldPurgeTime) {
+		fields = append(fields, message.FieldPurgeTime)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageMutation) ClearField(name string) error {
+	switch name {
+	case message.FieldRevokeTime:
+		m.ClearRevokeTime()
+		return nil
+	case message.FieldExpireTime:
+		m.ClearExpireTime()
+		return nil
+	case message.FieldPurgeTime:
+		m.ClearPurgeTime()
+		return nil
+	}
+	return fmt.Errorf("unknown Message nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageMutation) ResetField(name string) error {
+	switch name {
+	case message.FieldMsgId:
+		m.ResetMsgId()
+		return nil
+	case message.FieldMsgType:
+		m.ResetMsgType()
+		return nil
+	case message.FieldContent:
+		m.ResetContent()
+		return nil
+	case message.FieldBody:
+		m.ResetBody()
+		return nil
+	case message.FieldIsRevoked:
+		m.ResetIsRevoked()
+		return nil
+	case message.FieldRevokeTime:
+		m.ResetRevokeTime()
+		return nil
+	case message.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case message.FieldExpireTime:
+		m.ResetExpireTime()
+		return nil
+	case message.FieldIsPurged:
+		m.ResetIsPurged()
+		return nil
+	case message.FieldPurgeTime:
+		m.ResetPurgeTime()
+		return nil
+	case message.FieldStorageKey:
+		m.ResetStorageKey()
+		return nil
+	}
+	return fmt.Errorf("unknown Message field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Message unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Message edge %s", name)
+}
+
+// MessageDeletionMutation represents an operation that mutates the MessageDeletion nodes in the graph.
+type MessageDeletionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	userId        *int
+	adduserId     *int
+	msgId         *string
+	isGroup       *bool
+	targetId      *int
+	addtargetId   *int
+	createTime    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*MessageDeletion, error)
+	predicates    []predicate.MessageDeletion
+}
+
+var _ ent.Mutation = (*MessageDeletionMutation)(nil)
+
+// messagedeletionOption allows management of the mutation configuration using functional options.
+type messagedeletionOption func(*MessageDeletionMutation)
+
+// newMessageDeletionMutation creates new mutation for the MessageDeletion entity.
+func newMessageDeletionMutation(c config, op Op, opts ...messagedeletionOption) *MessageDeletionMutation {
+	m := &MessageDeletionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessageDeletion,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageDeletionID sets the ID field of the mutation.
+func withMessageDeletionID(id int) messagedeletionOption {
+	return func(m *MessageDeletionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MessageDeletion
+		)
+		m.oldValue = func(ctx context.Context) (*MessageDeletion, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MessageDeletion.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessageDeletion sets the old MessageDeletion of the mutation.
+func withMessageDeletion(node *MessageDeletion) messagedeletionOption {
+	return func(m *MessageDeletionMutation) {
+		m.oldValue = func(context.Context) (*MessageDeletion, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageDeletionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageDeletionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageDeletionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageDeletionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MessageDeletion.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserId sets the "userId" field.
+func (m *MessageDeletionMutation) SetUserId(i int) {
+	m.userId = &i
+	m.adduserId = nil
+}
+
+// UserId returns the value of the "userId" field in the mutation.
+func (m *MessageDeletionMutation) UserId() (r int, exists bool) {
+	v := m.userId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserId returns the old "userId" field's value of the MessageDeletion entity.
+// If the MessageDeletion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageDeletionMutation) OldUserId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserId: %w", err)
+	}
+	return oldValue.UserId, nil
+}
+
+// AddUserId adds i to the "userId" field.
+func (m *MessageDeletionMutation) AddUserId(i int) {
+	if m.adduserId != nil {
+		*m.adduserId += i
+	} else {
+		m.adduserId = &i
+	}
+}
+
+// AddedUserId returns the value that was added to the "userId" field in this mutation.
+func (m *MessageDeletionMutation) AddedUserId() (r int, exists bool) {
+	v := m.adduserId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUserId resets all changes to the "userId" field.
+func (m *MessageDeletionMutation) ResetUserId() {
+	m.userId = nil
+	m.adduserId = nil
+}
+
+// SetMsgId sets the "msgId" field.
+func (m *MessageDeletionMutation) SetMsgId(s string) {
+	m.msgId = &s
+}
+
+// MsgId returns the value of the "msgId" field in the mutation.
+func (m *MessageDeletionMutation) MsgId() (r string, exists bool) {
+	v := m.msgId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMsgId returns the old "msgId" field's value of the MessageDeletion entity.
+// If the MessageDeletion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageDeletionMutation) OldMsgId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMsgId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMsgId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMsgId: %w", err)
+	}
+	return oldValue.MsgId, nil
+}
+
+// ResetMsgId resets all changes to the "msgId" field.
+func (m *MessageDeletionMutation) ResetMsgId() {
+	m.msgId = nil
+}
+
+// SetIsGroup sets the "isGroup" field.
+func (m *MessageDeletionMutation) SetIsGroup(b bool) {
+	m.isGroup = &b
+}
+
+// IsGroup returns the value of the "isGroup" field in the mutation.
+func (m *MessageDeletionMutation) IsGroup() (r bool, exists bool) {
+	v := m.isGroup
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsGroup returns the old "isGroup" field's value of the MessageDeletion entity.
+// If the MessageDeletion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageDeletionMutation) OldIsGroup(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsGroup is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsGroup requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsGroup: %w", err)
+	}
+	return oldValue.IsGroup, nil
+}
+
+// ResetIsGroup resets all changes to the "isGroup" field.
+func (m *MessageDeletionMutation) ResetIsGroup() {
+	m.isGroup = nil
+}
+
+// SetTargetId sets the "targetId" field.
+func (m *MessageDeletionMutation) SetTargetId(i int) {
+	m.targetId = &i
+	m.addtargetId = nil
+}
+
+// TargetId returns the value of the "targetId" field in the mutation.
+func (m *MessageDeletionMutation) TargetId() (r int, exists bool) {
+	v := m.targetId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetId returns the old "targetId" field's value of the MessageDeletion entity.
+// If the MessageDeletion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageDeletionMutation) OldTargetId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetId: %w", err)
+	}
+	return oldValue.TargetId, nil
+}
+
+// AddTargetId adds i to the "targetId" field.
+func (m *MessageDeletionMutation) AddTargetId(i int) {
+	if m.addtargetId != nil {
+		*m.addtargetId += i
+	} else {
+		m.addtargetId = &i
+	}
+}
+
+// AddedTargetId returns the value that was added to the "targetId" field in this mutation.
+func (m *MessageDeletionMutation) AddedTargetId() (r int, exists bool) {
+	v := m.addtargetId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTargetId resets all changes to the "targetId" field.
+func (m *MessageDeletionMutation) ResetTargetId() {
+	m.targetId = nil
+	m.addtargetId = nil
+}
+
+// SetCreateTime sets the "createTime" field.
+func (m *MessageDeletionMutation) SetCreateTime(t time.Time) {
+	m.createTime = &t
+}
+
+// CreateTime returns the value of the "createTime" field in the mutation.
+func (m *MessageDeletionMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.createTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "createTime" field's value of the MessageDeletion entity.
+// If the MessageDeletion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageDeletionMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "createTime" field.
+func (m *MessageDeletionMutation) ResetCreateTime() {
+	m.createTime = nil
+}
+
+// Where appends a list predicates to the MessageDeletionMutation builder.
+func (m *MessageDeletionMutation) Where(ps ...predicate.MessageDeletion) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageDeletionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageDeletionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MessageDeletion, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageDeletionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageDeletionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MessageDeletion).
+func (m *MessageDeletionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageDeletionMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.userId != nil {
+		fields = append(fields, messagedeletion.FieldUserId)
+	}
+	if m.msgId != nil {
+		fields = append(fields, messagedeletion.FieldMsgId)
+	}
+	if m.isGroup != nil {
+		fields = append(fields, messagedeletion.FieldIsGroup)
+	}
+	if m.targetId != nil {
+		fields = append(fields, messagedeletion.FieldTargetId)
+	}
+	if m.createTime != nil {
+		fields = append(fields, messagedeletion.FieldCreateTime)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageDeletionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case messagedeletion.FieldUserId:
+		return m.UserId()
+	case messagedeletion.FieldMsgId:
+		return m.MsgId()
+	case messagedeletion.FieldIsGroup:
+		return m.IsGroup()
+	case messagedeletion.FieldTargetId:
+		return m.TargetId()
+	case messagedeletion.FieldCreateTime:
+		return m.CreateTime()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageDeletionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case messagedeletion.FieldUserId:
+		return m.OldUserId(ctx)
+	case messagedeletion.FieldMsgId:
+		return m.OldMsgId(ctx)
+	case messagedeletion.FieldIsGroup:
+		return m.OldIsGroup(ctx)
+	case messagedeletion.FieldTargetId:
+		return m.OldTargetId(ctx)
+	case messagedeletion.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	}
+	return nil, fmt.Errorf("unknown MessageDeletion field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageDeletionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case messagedeletion.FieldUserId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserId(v)
+		return nil
+	case messagedeletion.FieldMsgId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMsgId(v)
+		return nil
+	case messagedeletion.FieldIsGroup:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsGroup(v)
+		return nil
+	case messagedeletion.FieldTargetId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetId(v)
+		return nil
+	case messagedeletion.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageDeletion field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageDeletionMutation) AddedFields() []string {
+	var fields []string
+	if m.adduserId != nil {
+		fields = append(fields, messagedeletion.FieldUserId)
+	}
+	if m.addtargetId != nil {
+		fields = append(fields, messagedeletion.FieldTargetId)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageDeletionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case messagedeletion.FieldUserId:
+		return m.AddedUserId()
+	case messagedeletion.FieldTargetId:
+		return m.AddedTargetId()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageDeletionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case messagedeletion.FieldUserId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserId(v)
+		return nil
+	case messagedeletion.FieldTargetId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTargetId(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageDeletion numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageDeletionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageDeletionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageDeletionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MessageDeletion nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageDeletionMutation) ResetField(name string) error {
+	switch name {
+	case messagedeletion.FieldUserId:
+		m.ResetUserId()
+		return nil
+	case messagedeletion.FieldMsgId:
+		m.ResetMsgId()
+		return nil
+	case messagedeletion.FieldIsGroup:
+		m.ResetIsGroup()
+		return nil
+	case messagedeletion.FieldTargetId:
+		m.ResetTargetId()
+		return nil
+	case messagedeletion.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageDeletion field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageDeletionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageDeletionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageDeletionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageDeletionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageDeletionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageDeletionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageDeletionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown MessageDeletion unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageDeletionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown MessageDeletion edge %s", name)
+}
+
+// MessageForwardMutation represents an operation that mutates the MessageForward nodes in the graph.
+type MessageForwardMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	msgId               *string
+	sourceMsgId         *string
+	sourceFromUserId    *int
+	addsourceFromUserId *int
+	sourceIsGroup       *bool
+	sourceGroupId       *int
+	addsourceGroupId    *int
+	sourceCreateTime    *time.Time
+	forwardUserId       *int
+	addforwardUserId    *int
+	createTime          *time.Time
+	clearedFields       map[string]struct{}
+	done                bool
+	oldValue            func(context.Context) (*MessageForward, error)
+	predicates          []predicate.MessageForward
+}
+
+var _ ent.Mutation = (*MessageForwardMutation)(nil)
+
+// messageforwardOption allows management of the mutation configuration using functional options.
+type messageforwardOption func(*MessageForwardMutation)
+
+// newMessageForwardMutation creates new mutation for the MessageForward entity.
+func newMessageForwardMutation(c config, op Op, opts ...messageforwardOption) *MessageForwardMutation {
+	m := &MessageForwardMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessageForward,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageForwardID sets the ID field of the mutation.
+func withMessageForwardID(id int) messageforwardOption {
+	return func(m *MessageForwardMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MessageForward
+		)
+		m.oldValue = func(ctx context.Context) (*MessageForward, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MessageForward.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessageForward sets the old MessageForward of the mutation.
+func withMessageForward(node *MessageForward) messageforwardOption {
+	return func(m *MessageForwardMutation) {
+		m.oldValue = func(context.Context) (*MessageForward, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageForwardMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageForwardMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageForwardMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageForwardMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MessageForward.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMsgId sets the "msgId" field.
+func (m *MessageForwardMutation) SetMsgId(s string) {
+	m.msgId = &s
+}
+
+// MsgId returns the value of the "msgId" field in the mutation.
+func (m *MessageForwardMutation) MsgId() (r string, exists bool) {
+	v := m.msgId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMsgId returns the old "msgId" field's value of the MessageForward entity.
+// If the MessageForward object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageForwardMutation) OldMsgId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMsgId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMsgId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMsgId: %w", err)
+	}
+	return oldValue.MsgId, nil
+}
+
+// ResetMsgId resets all changes to the "msgId" field.
+func (m *MessageForwardMutation) ResetMsgId() {
+	m.msgId = nil
+}
+
+// SetSourceMsgId sets the "sourceMsgId" field.
+func (m *MessageForwardMutation) SetSourceMsgId(s string) {
+	m.sourceMsgId = &s
+}
+
+// SourceMsgId returns the value of the "sourceMsgId" field in the mutation.
+func (m *MessageForwardMutation) SourceMsgId() (r string, exists bool) {
+	v := m.sourceMsgId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceMsgId returns the old "sourceMsgId" field's value of the MessageForward entity.
+// If the MessageForward object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageForwardMutation) OldSourceMsgId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceMsgId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceMsgId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceMsgId: %w", err)
+	}
+	return oldValue.SourceMsgId, nil
+}
+
+// ResetSourceMsgId resets all changes to the "sourceMsgId" field.
+func (m *MessageForwardMutation) ResetSourceMsgId() {
+	m.sourceMsgId = nil
+}
+
+// SetSourceFromUserId sets the "sourceFromUserId" field.
+func (m *MessageForwardMutation) SetSourceFromUserId(i int) {
+	m.sourceFromUserId = &i
+	m.addsourceFromUserId = nil
+}
+
+// SourceFromUserId returns the value of the "sourceFromUserId" field in the mutation.
+func (m *MessageForwardMutation) SourceFromUserId() (r int, exists bool) {
+	v := m.sourceFromUserId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceFromUserId returns the old "sourceFromUserId" field's value of the MessageForward entity.
+// If the MessageForward object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageForwardMutation) OldSourceFromUserId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceFromUserId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceFromUserId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceFromUserId: %w", err)
+	}
+	return oldValue.SourceFromUserId, nil
+}
+
+// AddSourceFromUserId adds i to the "sourceFromUserId" field.
+func (m *MessageForwardMutation) AddSourceFromUserId(i int) {
+	if m.addsourceFromUserId != nil {
+		*m.addsourceFromUserId += i
+	} else {
+		m.addsourceFromUserId = &i
+	}
+}
+
+// AddedSourceFromUserId returns the value that was added to the "sourceFromUserId" field in this mutation.
+func (m *MessageForwardMutation) AddedSourceFromUserId() (r int, exists bool) {
+	v := m.addsourceFromUserId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSourceFromUserId resets all changes to the "sourceFromUserId" field.
+func (m *MessageForwardMutation) ResetSourceFromUserId() {
+	m.sourceFromUserId = nil
+	m.addsourceFromUserId = nil
+}
+
+// SetSourceIsGroup sets the "sourceIsGroup" field.
+func (m *MessageForwardMutation) SetSourceIsGroup(b bool) {
+	m.sourceIsGroup = &b
+}
+
+// SourceIsGroup returns the value of the "sourceIsGroup" field in the mutation.
+func (m *MessageForwardMutation) SourceIsGroup() (r bool, exists bool) {
+	v := m.sourceIsGroup
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceIsGroup returns the old "sourceIsGroup" field's value of the MessageForward entity.
+// If the MessageForward object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageForwardMutation) OldSourceIsGroup(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceIsGroup is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceIsGroup requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceIsGroup: %w", err)
+	}
+	return oldValue.SourceIsGroup, nil
+}
+
+// ResetSourceIsGroup resets all changes to the "sourceIsGroup" field.
+func (m *MessageForwardMutation) ResetSourceIsGroup() {
+	m.sourceIsGroup = nil
+}
+
+// SetSourceGroupId sets the "sourceGroupId" field.
+func (m *MessageForwardMutation) SetSourceGroupId(i int) {
+	m.sourceGroupId = &i
+	m.addsourceGroupId = nil
+}
+
+// SourceGroupId returns the value of the "sourceGroupId" field in the mutation.
+func (m *MessageForwardMutation) SourceGroupId() (r int, exists bool) {
+	v := m.sourceGroupId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceGroupId returns the old "sourceGroupId" field's value of the MessageForward entity.
+// If the MessageForward object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageForwardMutation) OldSourceGroupId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceGroupId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceGroupId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceGroupId: %w", err)
+	}
+	return oldValue.SourceGroupId, nil
+}
+
+// AddSourceGroupId adds i to the "sourceGroupId" field.
+func (m *MessageForwardMutation) AddSourceGroupId(i int) {
+	if m.addsourceGroupId != nil {
+		*m.addsourceGroupId += i
+	} else {
+		m.addsourceGroupId = &i
+	}
+}
+
+// AddedSourceGroupId returns the value that was added to the "sourceGroupId" field in this mutation.
+func (m *MessageForwardMutation) AddedSourceGroupId() (r int, exists bool) {
+	v := m.addsourceGroupId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSourceGroupId clears the value of the "sourceGroupId" field.
+func (m *MessageForwardMutation) ClearSourceGroupId() {
+	m.sourceGroupId = nil
+	m.addsourceGroupId = nil
+	m.clearedFields[messageforward.FieldSourceGroupId] = struct{}{}
+}
+
+// SourceGroupIdCleared returns if the "sourceGroupId" field was cleared in this mutation.
+func (m *MessageForwardMutation) SourceGroupIdCleared() bool {
+	_, ok := m.clearedFields[messageforward.FieldSourceGroupId]
+	return ok
+}
+
+// ResetSourceGroupId resets all changes to the "sourceGroupId" field.
+func (m *MessageForwardMutation) ResetSourceGroupId() {
+	m.sourceGroupId = nil
+	m.addsourceGroupId = nil
+	delete(m.clearedFields, messageforward.FieldSourceGroupId)
+}
+
+// SetSourceCreateTime sets the "sourceCreateTime" field.
+func (m *MessageForwardMutation) SetSourceCreateTime(t time.Time) {
+	m.sourceCreateTime = &t
+}
+
+// SourceCreateTime returns the value of the "sourceCreateTime" field in the mutation.
+func (m *MessageForwardMutation) SourceCreateTime() (r time.Time, exists bool) {
+	v := m.sourceCreateTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceCreateTime returns the old "sourceCreateTime" field's value of the MessageForward entity.
+// If the MessageForward object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageForwardMutation) OldSourceCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceCreateTime: %w", err)
+	}
+	return oldValue.SourceCreateTime, nil
+}
+
+// ResetSourceCreateTime resets all changes to the "sourceCreateTime" field.
+func (m *MessageForwardMutation) ResetSourceCreateTime() {
+	m.sourceCreateTime = nil
+}
+
+// SetForwardUserId sets the "forwardUserId" field.
+func (m *MessageForwardMutation) SetForwardUserId(i int) {
+	m.forwardUserId = &i
+	m.addforwardUserId = nil
+}
+
+// ForwardUserId returns the value of the "forwardUserId" field in the mutation.
+func (m *MessageForwardMutation) ForwardUserId() (r int, exists bool) {
+	v := m.forwardUserId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldForwardUserId returns the old "forwardUserId" field's value of the MessageForward entity.
+// If the MessageForward object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageForwardMutation) OldForwardUserId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldForwardUserId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldForwardUserId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldForwardUserId: %w", err)
+	}
+	return oldValue.ForwardUserId, nil
+}
+
+// AddForwardUserId adds i to the "forwardUserId" field.
+func (m *MessageForwardMutation) AddForwardUserId(i int) {
+	if m.addforwardUserId != nil {
+		*m.addforwardUserId += i
+	} else {
+		m.addforwardUserId = &i
+	}
+}
+
+// AddedForwardUserId returns the value that was added to the "forwardUserId" field in this mutation.
+func (m *MessageForwardMutation) AddedForwardUserId() (r int, exists bool) {
+	v := m.addforwardUserId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetForwardUserId resets all changes to the "forwardUserId" field.
+func (m *MessageForwardMutation) ResetForwardUserId() {
+	m.forwardUserId = nil
+	m.addforwardUserId = nil
+}
+
+// SetCreateTime sets the "createTime" field.
+func (m *MessageForwardMutation) SetCreateTime(t time.Time) {
+	m.createTime = &t
+}
+
+// CreateTime returns the value of the "createTime" field in the mutation.
+func (m *MessageForwardMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.createTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "createTime" field's value of the MessageForward entity.
+// If the MessageForward object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageForwardMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "createTime" field.
+func (m *MessageForwardMutation) ResetCreateTime() {
+	m.createTime = nil
+}
+
+// Where appends a list predicates to the MessageForwardMutation builder.
+func (m *MessageForwardMutation) Where(ps ...predicate.MessageForward) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageForwardMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageForwardMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MessageForward, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageForwardMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageForwardMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MessageForward).
+func (m *MessageForwardMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageForwardMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.msgId != nil {
+		fields = append(fields, messageforward.FieldMsgId)
+	}
+	if m.sourceMsgId != nil {
+		fields = append(fields, messageforward.FieldSourceMsgId)
+	}
+	if m.sourceFromUserId != nil {
+		fields = append(fields, messageforward.FieldSourceFromUserId)
+	}
+	if m.sourceIsGroup != nil {
+		fields = append(fields, messageforward.FieldSourceIsGroup)
+	}
+	if m.sourceGroupId != nil {
+		fields = append(fields, messageforward.FieldSourceGroupId)
+	}
+	if m.sourceCreateTime != nil {
+		fields = append(fields, messageforward.FieldSourceCreateTime)
+	}
+	if m.forwardUserId != nil {
+		fields = append(fields, messageforward.FieldForwardUserId)
+	}
+	if m.createTime != nil {
+		fields = append(fields, messageforward.FieldCreateTime)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageForwardMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case messageforward.FieldMsgId:
+		return m.MsgId()
+	case messageforward.FieldSourceMsgId:
+		return m.SourceMsgId()
+	case messageforward.FieldSourceFromUserId:
+		return m.SourceFromUserId()
+	case messageforward.FieldSourceIsGroup:
+		return m.SourceIsGroup()
+	case messageforward.FieldSourceGroupId:
+		return m.SourceGroupId()
+	case messageforward.FieldSourceCreateTime:
+		return m.SourceCreateTime()
+	case messageforward.FieldForwardUserId:
+		return m.ForwardUserId()
+	case messageforward.FieldCreateTime:
+		return m.CreateTime()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageForwardMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case messageforward.FieldMsgId:
+		return m.OldMsgId(ctx)
+	case messageforward.FieldSourceMsgId:
+		return m.OldSourceMsgId(ctx)
+	case messageforward.FieldSourceFromUserId:
+		return m.OldSourceFromUserId(ctx)
+	case messageforward.FieldSourceIsGroup:
+		return m.OldSourceIsGroup(ctx)
+	case messageforward.FieldSourceGroupId:
+		return m.OldSourceGroupId(ctx)
+	case messageforward.FieldSourceCreateTime:
+		return m.OldSourceCreateTime(ctx)
+	case messageforward.FieldForwardUserId:
+		return m.OldForwardUserId(ctx)
+	case messageforward.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	}
+	return nil, fmt.Errorf("unknown MessageForward field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageForwardMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case messageforward.FieldMsgId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMsgId(v)
+		return nil
+	case messageforward.FieldSourceMsgId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceMsgId(v)
+		return nil
+	case messageforward.FieldSourceFromUserId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceFromUserId(v)
+		return nil
+	case messageforward.FieldSourceIsGroup:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceIsGroup(v)
+		return nil
+	case messageforward.FieldSourceGroupId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceGroupId(v)
+		return nil
+	case messageforward.FieldSourceCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceCreateTime(v)
+		return nil
+	case messageforward.FieldForwardUserId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetForwardUserId(v)
+		return nil
+	case messageforward.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageForward field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageForwardMutation) AddedFields() []string {
+	var fields []string
+	if m.addsourceFromUserId != nil {
+		fields = append(fields, messageforward.FieldSourceFromUserId)
+	}
+	if m.addsourceGroupId != nil {
+		fields = append(fields, messageforward.FieldSourceGroupId)
+	}
+	if m.addforwardUserId != nil {
+		fields = append(fields, messageforward.FieldForwardUserId)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageForwardMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case messageforward.FieldSourceFromUserId:
+		return m.AddedSourceFromUserId()
+	case messageforward.FieldSourceGroupId:
+		return m.AddedSourceGroupId()
+	case messageforward.FieldForwardUserId:
+		return m.AddedForwardUserId()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageForwardMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case messageforward.FieldSourceFromUserId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSourceFromUserId(v)
+		return nil
+	case messageforward.FieldSourceGroupId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSourceGroupId(v)
+		return nil
+	case messageforward.FieldForwardUserId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddForwardUserId(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageForward numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageForwardMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(messageforward.FieldSourceGroupId) {
+		fields = append(fields, messageforward.FieldSourceGroupId)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageForwardMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageForwardMutation) ClearField(name string) error {
+	switch name {
+	case messageforward.FieldSourceGroupId:
+		m.ClearSourceGroupId()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageForward nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageForwardMutation) ResetField(name string) error {
+	switch name {
+	case messageforward.FieldMsgId:
+		m.ResetMsgId()
+		return nil
+	case messageforward.FieldSourceMsgId:
+		m.ResetSourceMsgId()
+		return nil
+	case messageforward.FieldSourceFromUserId:
+		m.ResetSourceFromUserId()
+		return nil
+	case messageforward.FieldSourceIsGroup:
+		m.ResetSourceIsGroup()
+		return nil
+	case messageforward.FieldSourceGroupId:
+		m.ResetSourceGroupId()
+		return nil
+	case messageforward.FieldSourceCreateTime:
+		m.ResetSourceCreateTime()
+		return nil
+	case messageforward.FieldForwardUserId:
+		m.ResetForwardUserId()
+		return nil
+	case messageforward.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageForward field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageForwardMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageForwardMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageForwardMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageForwardMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageForwardMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageForwardMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageForwardMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown MessageForward unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageForwardMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown MessageForward edge %s", name)
+}
+
+// MessageMentionMutation represents an operation that mutates the MessageMention nodes in the graph.
+type MessageMentionMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	msgId              *string
+	groupId            *int
+	addgroupId         *int
+	fromUserId         *int
+	addfromUserId      *int
+	mentionedUserId    *int
+	addmentionedUserId *int
+	isAll              *bool
+	_offset            *int
+	add_offset         *int
+	length             *int
+	addlength          *int
+	createTime         *time.Time
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*MessageMention, error)
+	predicates         []predicate.MessageMention
+}
+
+var _ ent.Mutation = (*MessageMentionMutation)(nil)
+
+// messagementionOption allows management of the mutation configuration using functional options.
+type messagementionOption func(*MessageMentionMutation)
+
+// newMessageMentionMutation creates new mutation for the MessageMention entity.
+func newMessageMentionMutation(c config, op Op, opts ...messagementionOption) *MessageMentionMutation {
+	m := &MessageMentionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessageMention,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageMentionID sets the ID field of the mutation.
+func withMessageMentionID(id int) messagementionOption {
+	return func(m *MessageMentionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MessageMention
+		)
+		m.oldValue = func(ctx context.Context) (*MessageMention, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MessageMention.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessageMention sets the old MessageMention of the mutation.
+func withMessageMention(node *MessageMention) messagementionOption {
+	return func(m *MessageMentionMutation) {
+		m.oldValue = func(context.Context) (*MessageMention, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageMentionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageMentionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageMentionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageMentionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MessageMention.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMsgId sets the "msgId" field.
+func (m *MessageMentionMutation) SetMsgId(s string) {
+	m.msgId = &s
+}
+
+// MsgId returns the value of the "msgId" field in the mutation.
+func (m *MessageMentionMutation) MsgId() (r string, exists bool) {
+	v := m.msgId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMsgId returns the old "msgId" field's value of the MessageMention entity.
+// If the MessageMention object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMentionMutation) OldMsgId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMsgId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMsgId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMsgId: %w", err)
+	}
+	return oldValue.MsgId, nil
+}
+
+// ResetMsgId resets all changes to the "msgId" field.
+func (m *MessageMentionMutation) ResetMsgId() {
+	m.msgId = nil
+}
+
+// SetGroupId sets the "groupId" field.
+func (m *MessageMentionMutation) SetGroupId(i int) {
+	m.groupId = &i
+	m.addgroupId = nil
+}
+
+// GroupId returns the value of the "groupId" field in the mutation.
+func (m *MessageMentionMutation) GroupId() (r int, exists bool) {
+	v := m.groupId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGroupId returns the old "groupId" field's value of the MessageMention entity.
+// If the MessageMention object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMentionMutation) OldGroupId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGroupId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGroupId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGroupId: %w", err)
+	}
+	return oldValue.GroupId, nil
+}
+
+// AddGroupId adds i to the "groupId" field.
+func (m *MessageMentionMutation) AddGroupId(i int) {
+	if m.addgroupId != nil {
+		*m.addgroupId += i
+	} else {
+		m.addgroupId = &i
+	}
+}
+
+// AddedGroupId returns the value that was added to the "groupId" field in this mutation.
+func (m *MessageMentionMutation) AddedGroupId() (r int, exists bool) {
+	v := m.addgroupId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetGroupId resets all changes to the "groupId" field.
+func (m *MessageMentionMutation) ResetGroupId() {
+	m.groupId = nil
+	m.addgroupId = nil
+}
+
+// SetFromUserId sets the "fromUserId" field.
+func (m *MessageMentionMutation) SetFromUserId(i int) {
+	m.fromUserId = &i
+	m.addfromUserId = nil
+}
+
+// FromUserId returns the value of the "fromUserId" field in the mutation.
+func (m *MessageMentionMutation) FromUserId() (r int, exists bool) {
+	v := m.fromUserId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFromUserId returns the old "fromUserId" field's value of the MessageMention entity.
+// If the MessageMention object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMentionMutation) OldFromUserId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFromUserId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFromUserId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFromUserId: %w", err)
+	}
+	return oldValue.FromUserId, nil
+}
+
+// AddFromUserId adds i to the "fromUserId" field.
+func (m *MessageMentionMutation) AddFromUserId(i int) {
+	if m.addfromUserId != nil {
+		*m.addfromUserId += i
+	} else {
+		m.addfromUserId = &i
+	}
+}
+
+// AddedFromUserId returns the value that was added to the "fromUserId" field in this mutation.
+func (m *MessageMentionMutation) AddedFromUserId() (r int, exists bool) {
+	v := m.addfromUserId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFromUserId resets all changes to the "fromUserId" field.
+func (m *MessageMentionMutation) ResetFromUserId() {
+	m.fromUserId = nil
+	m.addfromUserId = nil
+}
+
+// SetMentionedUserId sets the "mentionedUserId" field.
+func (m *MessageMentionMutation) SetMentionedUserId(i int) {
+	m.mentionedUserId = &i
+	m.addmentionedUserId = nil
+}
+
+// MentionedUserId returns the value of the "mentionedUserId" field in the mutation.
+func (m *MessageMentionMutation) MentionedUserId() (r int, exists bool) {
+	v := m.mentionedUserId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMentionedUserId returns the old "mentionedUserId" field's value of the MessageMention entity.
+// If the MessageMention object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMentionMutation) OldMentionedUserId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMentionedUserId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMentionedUserId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMentionedUserId: %w", err)
+	}
+	return oldValue.MentionedUserId, nil
+}
+
+// AddMentionedUserId adds i to the "mentionedUserId" field.
+func (m *MessageMentionMutation) AddMentionedUserId(i int) {
+	if m.addmentionedUserId != nil {
+		*m.addmentionedUserId += i
+	} else {
+		m.addmentionedUserId = &i
+	}
+}
+
+// AddedMentionedUserId returns the value that was added to the "mentionedUserId" field in this mutation.
+func (m *MessageMentionMutation) AddedMentionedUserId() (r int, exists bool) {
+	v := m.addmentionedUserId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMentionedUserId resets all changes to the "mentionedUserId" field.
+func (m *MessageMentionMutation) ResetMentionedUserId() {
+	m.mentionedUserId = nil
+	m.addmentionedUserId = nil
+}
+
+// SetIsAll sets the "isAll" field.
+func (m *MessageMentionMutation) SetIsAll(b bool) {
+	m.isAll = &b
+}
+
+// IsAll returns the value of the "isAll" field in the mutation.
+func (m *MessageMentionMutation) IsAll() (r bool, exists bool) {
+	v := m.isAll
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsAll returns the old "isAll" field's value of the MessageMention entity.
+// If the MessageMention object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMentionMutation) OldIsAll(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsAll is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsAll requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsAll: %w", err)
+	}
+	return oldValue.IsAll, nil
+}
+
+// ResetIsAll resets all changes to the "isAll" field.
+func (m *MessageMentionMutation) ResetIsAll() {
+	m.isAll = nil
+}
+
+// SetOffset sets the "offset" field.
+func (m *MessageMentionMutation) SetOffset(i int) {
+	m._offset = &i
+	m.add_offset = nil
+}
+
+// Offset returns the value of the "offset" field in the mutation.
+func (m *MessageMentionMutation) Offset() (r int, exists bool) {
+	v := m._offset
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOffset returns the old "offset" field's value of the MessageMention entity.
+// If the MessageMention object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMentionMutation) OldOffset(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOffset is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOffset requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOffset: %w", err)
+	}
+	return oldValue.Offset, nil
+}
+
+// AddOffset adds i to the "offset" field.
+func (m *MessageMentionMutation) AddOffset(i int) {
+	if m.add_offset != nil {
+		*m.add_offset += i
+	} else {
+		m.add_offset = &i
+	}
+}
+
+// AddedOffset returns the value that was added to the "offset" field in this mutation.
+func (m *MessageMentionMutation) AddedOffset() (r int, exists bool) {
+	v := m.add_offset
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetOffset resets all changes to the "offset" field.
+func (m *MessageMentionMutation) ResetOffset() {
+	m._offset = nil
+	m.add_offset = nil
+}
+
+// SetLength sets the "length" field.
+func (m *MessageMentionMutation) SetLength(i int) {
+	m.length = &i
+	m.addlength = nil
+}
+
+// Length returns the value of the "length" field in the mutation.
+func (m *MessageMentionMutation) Length() (r int, exists bool) {
+	v := m.length
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLength returns the old "length" field's value of the MessageMention entity.
+// If the MessageMention object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMentionMutation) OldLength(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLength is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLength requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLength: %w", err)
+	}
+	return oldValue.Length, nil
+}
+
+// AddLength adds i to the "length" field.
+func (m *MessageMentionMutation) AddLength(i int) {
+	if m.addlength != nil {
+		*m.addlength += i
+	} else {
+		m.addlength = &i
+	}
+}
+
+// AddedLength returns the value that was added to the "length" field in this mutation.
+func (m *MessageMentionMutation) AddedLength() (r int, exists bool) {
+	v := m.addlength
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLength resets all changes to the "length" field.
+func (m *MessageMentionMutation) ResetLength() {
+	m.length = nil
+	m.addlength = nil
+}
+
+// SetCreateTime sets the "createTime" field.
+func (m *MessageMentionMutation) SetCreateTime(t time.Time) {
+	m.createTime = &t
+}
+
+// CreateTime returns the value of the "createTime" field in the mutation.
+func (m *MessageMentionMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.createTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "createTime" field's value of the MessageMention entity.
+// If the MessageMention object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMentionMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "createTime" field.
+func (m *MessageMentionMutation) ResetCreateTime() {
+	m.createTime = nil
+}
+
+// Where appends a list predicates to the MessageMentionMutation builder.
+func (m *MessageMentionMutation) Where(ps ...predicate.MessageMention) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageMentionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageMentionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MessageMention, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageMentionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageMentionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MessageMention).
+func (m *MessageMentionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageMentionMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.msgId != nil {
+		fields = append(fields, messagemention.FieldMsgId)
+	}
+	if m.groupId != nil {
+		fields = append(fields, messagemention.FieldGroupId)
+	}
+	if m.fromUserId != nil {
+		fields = append(fields, messagemention.FieldFromUserId)
+	}
+	if m.mentionedUserId != nil {
+		fields = append(fields, messagemention.FieldMentionedUserId)
+	}
+	if m.isAll != nil {
+		fields = append(fields, messagemention.FieldIsAll)
+	}
+	if m._offset != nil {
+		fields = append(fields, messagemention.FieldOffset)
+	}
+	if m.length != nil {
+		fields = append(fields, messagemention.FieldLength)
+	}
+	if m.createTime != nil {
+		fields = append(fields, messagemention.FieldCreateTime)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageMentionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case messagemention.FieldMsgId:
+		return m.MsgId()
+	case messagemention.FieldGroupId:
+		return m.GroupId()
+	case messagemention.FieldFromUserId:
+		return m.FromUserId()
+	case messagemention.FieldMentionedUserId:
+		return m.MentionedUserId()
+	case messagemention.FieldIsAll:
+		return m.IsAll()
+	case messagemention.FieldOffset:
+		return m.Offset()
+	case messagemention.FieldLength:
+		return m.Length()
+	case messagemention.FieldCreateTime:
+		return m.CreateTime()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageMentionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case messagemention.FieldMsgId:
+		return m.OldMsgId(ctx)
+	case messagemention.FieldGroupId:
+		return m.OldGroupId(ctx)
+	case messagemention.FieldFromUserId:
+		return m.OldFromUserId(ctx)
+	case messagemention.FieldMentionedUserId:
+		return m.OldMentionedUserId(ctx)
+	case messagemention.FieldIsAll:
+		return m.OldIsAll(ctx)
+	case messagemention.FieldOffset:
+		return m.OldOffset(ctx)
+	case messagemention.FieldLength:
+		return m.OldLength(ctx)
+	case messagemention.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	}
+	return nil, fmt.Errorf("unknown MessageMention field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageMentionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case messagemention.FieldMsgId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMsgId(v)
+		return nil
+	case messagemention.FieldGroupId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGroupId(v)
+		return nil
+	case messagemention.FieldFromUserId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFromUserId(v)
+		return nil
+	case messagemention.FieldMentionedUserId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMentionedUserId(v)
+		return nil
+	case messagemention.FieldIsAll:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsAll(v)
+		return nil
+	case messagemention.FieldOffset:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOffset(v)
+		return nil
+	case messagemention.FieldLength:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLength(v)
+		return nil
+	case messagemention.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageMention field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageMentionMutation) AddedFields() []string {
+	var fields []string
+	if m.addgroupId != nil {
+		fields = append(fields, messagemention.FieldGroupId)
+	}
+	if m.addfromUserId != nil {
+		fields = append(fields, messagemention.FieldFromUserId)
+	}
+	if m.addmentionedUserId != nil {
+		fields = append(fields, messagemention.FieldMentionedUserId)
+	}
+	if m.add_offset != nil {
+		fields = append(fields, messagemention.FieldOffset)
+	}
+	if m.addlength != nil {
+		fields = append(fields, messagemention.FieldLength)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageMentionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case messagemention.FieldGroupId:
+		return m.AddedGroupId()
+	case messagemention.FieldFromUserId:
+		return m.AddedFromUserId()
+	case messagemention.FieldMentionedUserId:
+		return m.AddedMentionedUserId()
+	case messagemention.FieldOffset:
+		return m.AddedOffset()
+	case messagemention.FieldLength:
+		return m.AddedLength()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageMentionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case messagemention.FieldGroupId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGroupId(v)
+		return nil
+	case messagemention.FieldFromUserId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFromUserId(v)
+		return nil
+	case messagemention.FieldMentionedUserId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMentionedUserId(v)
+		return nil
+	case messagemention.FieldOffset:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOffset(v)
+		return nil
+	case messagemention.FieldLength:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLength(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageMention numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageMentionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageMentionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageMentionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MessageMention nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageMentionMutation) ResetField(name string) error {
+	switch name {
+	case messagemention.FieldMsgId:
+		m.ResetMsgId()
+		return nil
+	case messagemention.FieldGroupId:
+		m.ResetGroupId()
+		return nil
+	case messagemention.FieldFromUserId:
+		m.ResetFromUserId()
+		return nil
+	case messagemention.FieldMentionedUserId:
+		m.ResetMentionedUserId()
+		return nil
+	case messagemention.FieldIsAll:
+		m.ResetIsAll()
+		return nil
+	case messagemention.FieldOffset:
+		m.ResetOffset()
+		return nil
+	case messagemention.FieldLength:
+		m.ResetLength()
+		return nil
+	case messagemention.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageMention field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageMentionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageMentionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageMentionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageMentionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageMentionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageMentionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageMentionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown MessageMention unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageMentionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown MessageMention edge %s", name)
+}
+
+// MessageOutboxMutation represents an operation that mutates the MessageOutbox nodes in the graph.
+type MessageOutboxMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	msgId           *string
+	userId          *int
+	adduserId       *int
+	status          *string
+	attempts        *int
+	addattempts     *int
+	nextAttemptTime *time.Time
+	lastError       *string
+	leaseToken      *string
+	createTime      *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*MessageOutbox, error)
+	predicates      []predicate.MessageOutbox
+}
+
+var _ ent.Mutation = (*MessageOutboxMutation)(nil)
+
+// messageoutboxOption allows management of the mutation configuration using functional options.
+type messageoutboxOption func(*MessageOutboxMutation)
+
+// newMessageOutboxMutation creates new mutation for the MessageOutbox entity.
+func newMessageOutboxMutation(c config, op Op, opts ...messageoutboxOption) *MessageOutboxMutation {
+	m := &MessageOutboxMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessageOutbox,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageOutboxID sets the ID field of the mutation.
+func withMessageOutboxID(id int) messageoutboxOption {
+	return func(m *MessageOutboxMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MessageOutbox
+		)
+		m.oldValue = func(ctx context.Context) (*MessageOutbox, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MessageOutbox.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessageOutbox sets the old MessageOutbox of the mutation.
+func withMessageOutbox(node *MessageOutbox) messageoutboxOption {
+	return func(m *MessageOutboxMutation) {
+		m.oldValue = func(context.Context) (*MessageOutbox, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageOutboxMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageOutboxMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageOutboxMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageOutboxMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MessageOutbox.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMsgId sets the "msgId" field.
+func (m *MessageOutboxMutation) SetMsgId(s string) {
+	m.msgId = &s
+}
+
+// MsgId returns the value of the "msgId" field in the mutation.
+func (m *MessageOutboxMutation) MsgId() (r string, exists bool) {
+	v := m.msgId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMsgId returns the old "msgId" field's value of the MessageOutbox entity.
+// If the MessageOutbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageOutboxMutation) OldMsgId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMsgId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMsgId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMsgId: %w", err)
+	}
+	return oldValue.MsgId, nil
+}
+
+// ResetMsgId resets all changes to the "msgId" field.
+func (m *MessageOutboxMutation) ResetMsgId() {
+	m.msgId = nil
+}
+
+// SetUserId sets the "userId" field.
+func (m *MessageOutboxMutation) SetUserId(i int) {
+	m.userId = &i
+	m.adduserId = nil
+}
+
+// UserId returns the value of the "userId" field in the mutation.
+func (m *MessageOutboxMutation) UserId() (r int, exists bool) {
+	v := m.userId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserId returns the old "userId" field's value of the MessageOutbox entity.
+// If the MessageOutbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageOutboxMutation) OldUserId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserId: %w", err)
+	}
+	return oldValue.UserId, nil
+}
+
+// AddUserId adds i to the "userId" field.
+func (m *MessageOutboxMutation) AddUserId(i int) {
+	if m.adduserId != nil {
+		*m.adduserId += i
+	} else {
+		m.adduserId = &i
+	}
+}
+
+// AddedUserId returns the value that was added to the "userId" field in this mutation.
+func (m *MessageOutboxMutation) AddedUserId() (r int, exists bool) {
+	v := m.adduserId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUserId resets all changes to the "userId" field.
+func (m *MessageOutboxMutation) ResetUserId() {
+	m.userId = nil
+	m.adduserId = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *MessageOutboxMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *MessageOutboxMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the MessageOutbox entity.
+// If the MessageOutbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageOutboxMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *MessageOutboxMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *MessageOutboxMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *MessageOutboxMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the MessageOutbox entity.
+// If the MessageOutbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageOutboxMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *MessageOutboxMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *MessageOutboxMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *MessageOutboxMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetNextAttemptTime sets the "nextAttemptTime" field.
+func (m *MessageOutboxMutation) SetNextAttemptTime(t time.Time) {
+	m.nextAttemptTime = &t
+}
+
+// NextAttemptTime returns the value of the "nextAttemptTime" field in the mutation.
+func (m *MessageOutboxMutation) NextAttemptTime() (r time.Time, exists bool) {
+	v := m.nextAttemptTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextAttemptTime returns the old "nextAttemptTime" field's value of the MessageOutbox entity.
+// If the MessageOutbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageOutboxMutation) OldNextAttemptTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextAttemptTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextAttemptTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextAttemptTime: %w", err)
+	}
+	return oldValue.NextAttemptTime, nil
+}
+
+// ResetNextAttemptTime resets all changes to the "nextAttemptTime" field.
+func (m *MessageOutboxMutation) ResetNextAttemptTime() {
+	m.nextAttemptTime = nil
+}
+
+// SetLastError sets the "lastError" field.
+func (m *MessageOutboxMutation) SetLastError(s string) {
+	m.lastError = &s
+}
+
+// LastError returns the value of the "lastError" field in the mutation.
+func (m *MessageOutboxMutation) LastError() (r string, exists bool) {
+	v := m.lastError
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastError returns the old "lastError" field's value of the MessageOutbox entity.
+// If the MessageOutbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageOutboxMutation) OldLastError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ClearLastError clears the value of the "lastError" field.
+func (m *MessageOutboxMutation) ClearLastError() {
+	m.lastError = nil
+	m.clearedFields[messageoutbox.FieldLastError] = struct{}{}
+}
+
+// LastErrorCleared returns if the "lastError" field was cleared in this mutation.
+func (m *MessageOutboxMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[messageoutbox.FieldLastError]
+	return ok
+}
+
+// ResetLastError resets all changes to the "lastError" field.
+func (m *MessageOutboxMutation) ResetLastError() {
+	m.lastError = nil
+	delete(m.clearedFields, messageoutbox.FieldLastError)
+}
+
+// SetLeaseToken sets the "leaseToken" field.
+func (m *MessageOutboxMutation) SetLeaseToken(s string) {
+	m.leaseToken = &s
+}
+
+// LeaseToken returns the value of the "leaseToken" field in the mutation.
+func (m *MessageOutboxMutation) LeaseToken() (r string, exists bool) {
+	v := m.leaseToken
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLeaseToken returns the old "leaseToken" field's value of the MessageOutbox entity.
+// If the MessageOutbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageOutboxMutation) OldLeaseToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLeaseToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLeaseToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLeaseToken: %w", err)
+	}
+	return oldValue.LeaseToken, nil
+}
+
+// ResetLeaseToken resets all changes to the "leaseToken" field.
+func (m *MessageOutboxMutation) ResetLeaseToken() {
+	m.leaseToken = nil
+}
+
+// SetCreateTime sets the "createTime" field.
+func (m *MessageOutboxMutation) SetCreateTime(t time.Time) {
+	m.createTime = &t
+}
+
+// CreateTime returns the value of the "createTime" field in the mutation.
+func (m *MessageOutboxMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.createTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "createTime" field's value of the MessageOutbox entity.
+// If the MessageOutbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageOutboxMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "createTime" field.
+func (m *MessageOutboxMutation) ResetCreateTime() {
+	m.createTime = nil
+}
+
+// Where appends a list predicates to the MessageOutboxMutation builder.
+func (m *MessageOutboxMutation) Where(ps ...predicate.MessageOutbox) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageOutboxMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageOutboxMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MessageOutbox, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageOutboxMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageOutboxMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MessageOutbox).
+func (m *MessageOutboxMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageOutboxMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.msgId != nil {
+		fields = append(fields, messageoutbox.FieldMsgId)
+	}
+	if m.userId != nil {
+		fields = append(fields, messageoutbox.FieldUserId)
+	}
+	if m.status != nil {
+		fields = append(fields, messageoutbox.FieldStatus)
+	}
+	if m.attempts != nil {
+		fields = append(fields, messageoutbox.FieldAttempts)
+	}
+	if m.nextAttemptTime != nil {
+		fields = append(fields, messageoutbox.FieldNextAttemptTime)
+	}
+	if m.lastError != nil {
+		fields = append(fields, messageoutbox.FieldLastError)
+	}
+	if m.leaseToken != nil {
+		fields = append(fields, messageoutbox.FieldLeaseToken)
+	}
+	if m.createTime != nil {
+		fields = append(fields, messageoutbox.FieldCreateTime)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageOutboxMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case messageoutbox.FieldMsgId:
+		return m.MsgId()
+	case messageoutbox.FieldUserId:
+		return m.UserId()
+	case messageoutbox.FieldStatus:
+		return m.Status()
+	case messageoutbox.FieldAttempts:
+		return m.Attempts()
+	case messageoutbox.FieldNextAttemptTime:
+		return m.NextAttemptTime()
+	case messageoutbox.FieldLastError:
+		return m.LastError()
+	case messageoutbox.FieldLeaseToken:
+		return m.LeaseToken()
+	case messageoutbox.FieldCreateTime:
+		return m.CreateTime()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageOutboxMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case messageoutbox.FieldMsgId:
+		return m.OldMsgId(ctx)
+	case messageoutbox.FieldUserId:
+		return m.OldUserId(ctx)
+	case messageoutbox.FieldStatus:
+		return m.OldStatus(ctx)
+	case messageoutbox.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case messageoutbox.FieldNextAttemptTime:
+		return m.OldNextAttemptTime(ctx)
+	case messageoutbox.FieldLastError:
+		return m.OldLastError(ctx)
+	case messageoutbox.FieldLeaseToken:
+		return m.OldLeaseToken(ctx)
+	case messageoutbox.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	}
+	return nil, fmt.Errorf("unknown MessageOutbox field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageOutboxMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case messageoutbox.FieldMsgId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMsgId(v)
+		return nil
+	case messageoutbox.FieldUserId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserId(v)
+		return nil
+	case messageoutbox.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case messageoutbox.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case messageoutbox.FieldNextAttemptTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextAttemptTime(v)
+		return nil
+	case messageoutbox.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case messageoutbox.FieldLeaseToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLeaseToken(v)
+		return nil
+	case messageoutbox.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageOutbox field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageOutboxMutation) AddedFields() []string {
+	var fields []string
+	if m.adduserId != nil {
+		fields = append(fields, messageoutbox.FieldUserId)
+	}
+	if m.addattempts != nil {
+		fields = append(fields, messageoutbox.FieldAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageOutboxMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case messageoutbox.FieldUserId:
+		return m.AddedUserId()
+	case messageoutbox.FieldAttempts:
+		return m.AddedAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageOutboxMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case messageoutbox.FieldUserId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserId(v)
+		return nil
+	case messageoutbox.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageOutbox numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageOutboxMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(messageoutbox.FieldLastError) {
+		fields = append(fields, messageoutbox.FieldLastError)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageOutboxMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageOutboxMutation) ClearField(name string) error {
+	switch name {
+	case messageoutbox.FieldLastError:
+		m.ClearLastError()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageOutbox nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageOutboxMutation) ResetField(name string) error {
+	switch name {
+	case messageoutbox.FieldMsgId:
+		m.ResetMsgId()
+		return nil
+	case messageoutbox.FieldUserId:
+		m.ResetUserId()
+		return nil
+	case messageoutbox.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case messageoutbox.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case messageoutbox.FieldNextAttemptTime:
+		m.ResetNextAttemptTime()
+		return nil
+	case messageoutbox.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case messageoutbox.FieldLeaseToken:
+		m.ResetLeaseToken()
+		return nil
+	case messageoutbox.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageOutbox field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageOutboxMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageOutboxMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageOutboxMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageOutboxMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageOutboxMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageOutboxMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageOutboxMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown MessageOutbox unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageOutboxMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown MessageOutbox edge %s", name)
+}
+
+// MessageReactionMutation represents an operation that mutates the MessageReaction nodes in the graph.
+type MessageReactionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	msgId         *string
+	userId        *int
+	adduserId     *int
+	emoji         *string
+	createTime    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*MessageReaction, error)
+	predicates    []predicate.MessageReaction
+}
+
+var _ ent.Mutation = (*MessageReactionMutation)(nil)
+
+// messagereactionOption allows management of the mutation configuration using functional options.
+type messagereactionOption func(*MessageReactionMutation)
+
+// newMessageReactionMutation creates new mutation for the MessageReaction entity.
+func newMessageReactionMutation(c config, op Op, opts ...messagereactionOption) *MessageReactionMutation {
+	m := &MessageReactionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessageReaction,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageReactionID sets the ID field of the mutation.
+func withMessageReactionID(id int) messagereactionOption {
+	return func(m *MessageReactionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MessageReaction
+		)
+		m.oldValue = func(ctx context.Context) (*MessageReaction, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MessageReaction.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessageReaction sets the old MessageReaction of the mutation.
+func withMessageReaction(node *MessageReaction) messagereactionOption {
+	return func(m *MessageReactionMutation) {
+		m.oldValue = func(context.Context) (*MessageReaction, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageReactionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageReactionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageReactionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageReactionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MessageReaction.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMsgId sets the "msgId" field.
+func (m *MessageReactionMutation) SetMsgId(s string) {
+	m.msgId = &s
+}
+
+// MsgId returns the value of the "msgId" field in the mutation.
+func (m *MessageReactionMutation) MsgId() (r string, exists bool) {
+	v := m.msgId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMsgId returns the old "msgId" field's value of the MessageReaction entity.
+// If the MessageReaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageReactionMutation) OldMsgId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMsgId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMsgId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMsgId: %w", err)
+	}
+	return oldValue.MsgId, nil
+}
+
+// ResetMsgId resets all changes to the "msgId" field.
+func (m *MessageReactionMutation) ResetMsgId() {
+	m.msgId = nil
+}
+
+// SetUserId sets the "userId" field.
+func (m *MessageReactionMutation) SetUserId(i int) {
+	m.userId = &i
+	m.adduserId = nil
+}
+
+// UserId returns the value of the "userId" field in the mutation.
+func (m *MessageReactionMutation) UserId() (r int, exists bool) {
+	v := m.userId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserId returns the old "userId" field's value of the MessageReaction entity.
+// If the MessageReaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageReactionMutation) OldUserId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserId: %w", err)
+	}
+	return oldValue.UserId, nil
+}
+
+// AddUserId adds i to the "userId" field.
+func (m *MessageReactionMutation) AddUserId(i int) {
+	if m.adduserId != nil {
+		*m.adduserId += i
+	} else {
+		m.adduserId = &i
+	}
+}
+
+// AddedUserId returns the value that was added to the "userId" field in this mutation.
+func (m *MessageReactionMutation) AddedUserId() (r int, exists bool) {
+	v := m.adduserId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUserId resets all changes to the "userId" field.
+func (m *MessageReactionMutation) ResetUserId() {
+	m.userId = nil
+	m.adduserId = nil
+}
+
+// SetEmoji sets the "emoji" field.
+func (m *MessageReactionMutation) SetEmoji(s string) {
+	m.emoji = &s
+}
+
+// Emoji returns the value of the "emoji" field in the mutation.
+func (m *MessageReactionMutation) Emoji() (r string, exists bool) {
+	v := m.emoji
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmoji returns the old "emoji" field's value of the MessageReaction entity.
+// If the MessageReaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageReactionMutation) OldEmoji(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmoji is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmoji requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmoji: %w", err)
+	}
+	return oldValue.Emoji, nil
+}
+
+// ResetEmoji resets all changes to the "emoji" field.
+func (m *MessageReactionMutation) ResetEmoji() {
+	m.emoji = nil
+}
+
+// SetCreateTime sets the "createTime" field.
+func (m *MessageReactionMutation) SetCreateTime(t time.Time) {
+	m.createTime = &t
+}
+
+// CreateTime returns the value of the "createTime" field in the mutation.
+func (m *MessageReactionMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.createTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "createTime" field's value of the MessageReaction entity.
+// If the MessageReaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageReactionMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "createTime" field.
+func (m *MessageReactionMutation) ResetCreateTime() {
+	m.createTime = nil
+}
+
+// Where appends a list predicates to the MessageReactionMutation builder.
+func (m *MessageReactionMutation) Where(ps ...predicate.MessageReaction) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageReactionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageReactionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MessageReaction, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageReactionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageReactionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MessageReaction).
+func (m *MessageReactionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageReactionMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.msgId != nil {
+		fields = append(fields, messagereaction.FieldMsgId)
+	}
+	if m.userId != nil {
+		fields = append(fields, messagereaction.FieldUserId)
+	}
+	if m.emoji != nil {
+		fields = append(fields, messagereaction.FieldEmoji)
+	}
+	if m.createTime != nil {
+		fields = append(fields, messagereaction.FieldCreateTime)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageReactionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case messagereaction.FieldMsgId:
+		return m.MsgId()
+	case messagereaction.FieldUserId:
+		return m.UserId()
+	case messagereaction.FieldEmoji:
+		return m.Emoji()
+	case messagereaction.FieldCreateTime:
+		return m.CreateTime()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageReactionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case messagereaction.FieldMsgId:
+		return m.OldMsgId(ctx)
+	case messagereaction.FieldUserId:
+		return m.OldUserId(ctx)
+	case messagereaction.FieldEmoji:
+		return m.OldEmoji(ctx)
+	case messagereaction.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	}
+	return nil, fmt.Errorf("unknown MessageReaction field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageReactionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case messagereaction.FieldMsgId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMsgId(v)
+		return nil
+	case messagereaction.FieldUserId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserId(v)
+		return nil
+	case messagereaction.FieldEmoji:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmoji(v)
+		return nil
+	case messagereaction.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageReaction field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageReactionMutation) AddedFields() []string {
+	var fields []string
+	if m.adduserId != nil {
+		fields = append(fields, messagereaction.FieldUserId)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageReactionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case messagereaction.FieldUserId:
+		return m.AddedUserId()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageReactionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case messagereaction.FieldUserId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserId(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageReaction numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageReactionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageReactionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageReactionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MessageReaction nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageReactionMutation) ResetField(name string) error {
+	switch name {
+	case messagereaction.FieldMsgId:
+		m.ResetMsgId()
+		return nil
+	case messagereaction.FieldUserId:
+		m.ResetUserId()
+		return nil
+	case messagereaction.FieldEmoji:
+		m.ResetEmoji()
+		return nil
+	case messagereaction.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageReaction field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageReactionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageReactionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageReactionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageReactionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageReactionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageReactionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageReactionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown MessageReaction unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageReactionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown MessageReaction edge %s", name)
+}
+
+// MessageStatusMutation represents an operation that mutates the MessageStatus nodes in the graph.
+type MessageStatusMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	msgId         *string
+	userId        *int
+	adduserId     *int
+	isDelivered   *bool
+	isRead        *bool
+	deliveredTime *time.Time
+	readTime      *time.Time
+	createTime    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*MessageStatus, error)
+	predicates    []predicate.MessageStatus
+}
+
+var _ ent.Mutation = (*MessageStatusMutation)(nil)
+
+// messagestatusOption allows management of the mutation configuration using functional options.
+type messagestatusOption func(*MessageStatusMutation)
+
+// newMessageStatusMutation creates new mutation for the MessageStatus entity.
+func newMessageStatusMutation(c config, op Op, opts ...messagestatusOption) *MessageStatusMutation {
+	m := &MessageStatusMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessageStatus,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageStatusID sets the ID field of the mutation.
+func withMessageStatusID(id int) messagestatusOption {
+	return func(m *MessageStatusMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MessageStatus
+		)
+		m.oldValue = func(ctx context.Context) (*MessageStatus, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MessageStatus.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessageStatus sets the old MessageStatus of the mutation.
+func withMessageStatus(node *MessageStatus) messagestatusOption {
+	return func(m *MessageStatusMutation) {
+		m.oldValue = func(context.Context) (*MessageStatus, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageStatusMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageStatusMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageStatusMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageStatusMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MessageStatus.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMsgId sets the "msgId" field.
+func (m *MessageStatusMutation) SetMsgId(s string) {
+	m.msgId = &s
+}
+
+// MsgId returns the value of the "msgId" field in the mutation.
+func (m *MessageStatusMutation) MsgId() (r string, exists bool) {
+	v := m.msgId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMsgId returns the old "msgId" field's value of the MessageStatus entity.
+// If the MessageStatus object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageStatusMutation) OldMsgId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMsgId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMsgId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMsgId: %w", err)
+	}
+	return oldValue.MsgId, nil
+}
+
+// ResetMsgId resets all changes to the "msgId" field.
+func (m *MessageStatusMutation) ResetMsgId() {
+	m.msgId = nil
+}
+
+// SetUserId sets the "userId" field.
+func (m *MessageStatusMutation) SetUserId(i int) {
+	m.userId = &i
+	m.adduserId = nil
+}
+
+// UserId returns the value of the "userId" field in the mutation.
+func (m *MessageStatusMutation) UserId() (r int, exists bool) {
+	v := m.userId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserId returns the old "userId" field's value of the MessageStatus entity.
+// If the MessageStatus object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageStatusMutation) OldUserId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserId: %w", err)
+	}
+	return oldValue.UserId, nil
+}
+
+// AddUserId adds i to the "userId" field.
+func (m *MessageStatusMutation) AddUserId(i int) {
+	if m.adduserId != nil {
+		*m.adduserId += i
+	} else {
+		m.adduserId = &i
+	}
+}
+
+// AddedUserId returns the value that was added to the "userId" field in this mutation.
+func (m *MessageStatusMutation) AddedUserId() (r int, exists bool) {
+	v := m.adduserId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUserId resets all changes to the "userId" field.
+func (m *MessageStatusMutation) ResetUserId() {
+	m.userId = nil
+	m.adduserId = nil
+}
+
+// SetIsDelivered sets the "isDelivered" field.
+func (m *MessageStatusMutation) SetIsDelivered(b bool) {
+	m.isDelivered = &b
+}
+
+// IsDelivered returns the value of the "isDelivered" field in the mutation.
+func (m *MessageStatusMutation) IsDelivered() (r bool, exists bool) {
+	v := m.isDelivered
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsDelivered returns the old "isDelivered" field's value of the MessageStatus entity.
+// If the MessageStatus object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageStatusMutation) OldIsDelivered(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsDelivered is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsDelivered requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsDelivered: %w", err)
+	}
+	return oldValue.IsDelivered, nil
+}
+
+// ResetIsDelivered resets all changes to the "isDelivered" field.
+func (m *MessageStatusMutation) ResetIsDelivered() {
+	m.isDelivered = nil
+}
+
+// SetIsRead sets the "isRead" field.
+func (m *MessageStatusMutation) SetIsRead(b bool) {
+	m.isRead = &b
+}
+
+// IsRead returns the value of the "isRead" field in the mutation.
+func (m *MessageStatusMutation) IsRead() (r bool, exists bool) {
+	v := m.isRead
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsRead returns the old "isRead" field's value of the MessageStatus entity.
+// If the MessageStatus object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageStatusMutation) OldIsRead(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsRead is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsRead requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsRead: %w", err)
+	}
+	return oldValue.IsRead, nil
+}
+
+// ResetIsRead resets all changes to the "isRead" field.
+func (m *MessageStatusMutation) ResetIsRead() {
+	m.isRead = nil
+}
+
+// SetDeliveredTime sets the "deliveredTime" field.
+func (m *MessageStatusMutation) SetDeliveredTime(t time.Time) {
+	m.deliveredTime = &t
+}
+
+// DeliveredTime returns the value of the "deliveredTime" field in the mutation.
+func (m *MessageStatusMutation) DeliveredTime() (r time.Time, exists bool) {
+	v := m.deliveredTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeliveredTime returns the old "deliveredTime" field's value of the MessageStatus entity.
+// If the MessageStatus object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageStatusMutation) OldDeliveredTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeliveredTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeliveredTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeliveredTime: %w", err)
+	}
+	return oldValue.DeliveredTime, nil
+}
+
+// ClearDeliveredTime clears the value of the "deliveredTime" field.
+func (m *MessageStatusMutation) ClearDeliveredTime() {
+	m.deliveredTime = nil
+	m.clearedFields[messagestatus.FieldDeliveredTime] = struct{}{}
+}
+
+// DeliveredTimeCleared returns if the "deliveredTime" field was cleared in this mutation.
+func (m *MessageStatusMutation) DeliveredTimeCleared() bool {
+	_, ok := m.clearedFields[messagestatus.FieldDeliveredTime]
+	return ok
+}
+
+// ResetDeliveredTime resets all changes to the "deliveredTime" field.
+func (m *MessageStatusMutation) ResetDeliveredTime() {
+	m.deliveredTime = nil
+	delete(m.clearedFields, messagestatus.FieldDeliveredTime)
+}
+
+// SetReadTime sets the "readTime" field.
+func (m *MessageStatusMutation) SetReadTime(t time.Time) {
+	m.readTime = &t
+}
+
+// ReadTime returns the value of the "readTime" field in the mutation.
+func (m *MessageStatusMutation) ReadTime() (r time.Time, exists bool) {
+	v := m.readTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReadTime returns the old "readTime" field's value of the MessageStatus entity.
+// If the MessageStatus object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageStatusMutation) OldReadTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReadTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReadTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReadTime: %w", err)
+	}
+	return oldValue.ReadTime, nil
+}
+
+// ClearReadTime clears the value of the "readTime" field.
+func (m *MessageStatusMutation) ClearReadTime() {
+	m.readTime = nil
+	m.clearedFields[messagestatus.FieldReadTime] = struct{}{}
+}
+
+// ReadTimeCleared returns if the "readTime" field was cleared in this mutation.
+func (m *MessageStatusMutation) ReadTimeCleared() bool {
+	_, ok := m.clearedFields[messagestatus.FieldReadTime]
+	return ok
+}
+
+// ResetReadTime resets all changes to the "readTime" field.
+func (m *MessageStatusMutation) ResetReadTime() {
+	m.readTime = nil
+	delete(m.clearedFields, messagestatus.FieldReadTime)
+}
+
+// SetCreateTime sets the "createTime" field.
+func (m *MessageStatusMutation) SetCreateTime(t time.Time) {
+	m.createTime = &t
+}
+
+// CreateTime returns the value of the "createTime" field in the mutation.
+func (m *MessageStatusMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.createTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "createTime" field's value of the MessageStatus entity.
+// If the MessageStatus object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageStatusMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "createTime" field.
+func (m *MessageStatusMutation) ResetCreateTime() {
+	m.createTime = nil
+}
+
+// Where appends a list predicates to the MessageStatusMutation builder.
+func (m *MessageStatusMutation) Where(ps ...predicate.MessageStatus) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageStatusMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageStatusMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MessageStatus, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageStatusMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageStatusMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MessageStatus).
+func (m *MessageStatusMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageStatusMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.msgId != nil {
+		fields = append(fields, messagestatus.FieldMsgId)
+	}
+	if m.userId != nil {
+		fields = append(fields, messagestatus.FieldUserId)
+	}
+	if m.isDelivered != nil {
+		fields = append(fields, messagestatus.FieldIsDelivered)
+	}
+	if m.isRead != nil {
+		fields = append(fields, messagestatus.FieldIsRead)
+	}
+	if m.deliveredTime != nil {
+		fields = append(fields, messagestatus.FieldDeliveredTime)
+	}
+	if m.readTime != nil {
+		fields = append(fields, messagestatus.FieldReadTime)
+	}
+	if m.createTime != nil {
+		fields = append(fields, messagestatus.FieldCreateTime)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageStatusMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case messagestatus.FieldMsgId:
+		return m.MsgId()
+	case messagestatus.FieldUserId:
+		return m.UserId()
+	case messagestatus.FieldIsDelivered:
+		return m.IsDelivered()
+	case messagestatus.FieldIsRead:
+		return m.IsRead()
+	case messagestatus.FieldDeliveredTime:
+		return m.DeliveredTime()
+	case messagestatus.FieldReadTime:
+		return m.ReadTime()
+	case messagestatus.FieldCreateTime:
+		return m.CreateTime()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageStatusMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case messagestatus.FieldMsgId:
+		return m.OldMsgId(ctx)
+	case messagestatus.FieldUserId:
+		return m.OldUserId(ctx)
+	case messagestatus.FieldIsDelivered:
+		return m.OldIsDelivered(ctx)
+	case messagestatus.FieldIsRead:
+		return m.OldIsRead(ctx)
+	case messagestatus.FieldDeliveredTime:
+		return m.OldDeliveredTime(ctx)
+	case messagestatus.FieldReadTime:
+		return m.OldReadTime(ctx)
+	case messagestatus.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	}
+	return nil, fmt.Errorf("unknown MessageStatus field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageStatusMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case messagestatus.FieldMsgId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMsgId(v)
+		return nil
+	case messagestatus.FieldUserId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserId(v)
+		return nil
+	case messagestatus.FieldIsDelivered:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsDelivered(v)
+		return nil
+	case messagestatus.FieldIsRead:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsRead(v)
+		return nil
+	case messagestatus.FieldDeliveredTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeliveredTime(v)
+		return nil
+	case messagestatus.FieldReadTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReadTime(v)
+		return nil
+	case messagestatus.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageStatus field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageStatusMutation) AddedFields() []string {
+	var fields []string
+	if m.adduserId != nil {
+		fields = append(fields, messagestatus.FieldUserId)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageStatusMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case messagestatus.FieldUserId:
+		return m.AddedUserId()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageStatusMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case messagestatus.FieldUserId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserId(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageStatus numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageStatusMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(messagestatus.FieldDeliveredTime) {
+		fields = append(fields, messagestatus.FieldDeliveredTime)
+	}
+	if m.FieldCleared(messagestatus.FieldReadTime) {
+		fields = append(fields, messagestatus.FieldReadTime)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageStatusMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageStatusMutation) ClearField(name string) error {
+	switch name {
+	case messagestatus.FieldDeliveredTime:
+		m.ClearDeliveredTime()
+		return nil
+	case messagestatus.FieldReadTime:
+		m.ClearReadTime()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageStatus nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageStatusMutation) ResetField(name string) error {
+	switch name {
+	case messagestatus.FieldMsgId:
+		m.ResetMsgId()
+		return nil
+	case messagestatus.FieldUserId:
+		m.ResetUserId()
+		return nil
+	case messagestatus.FieldIsDelivered:
+		m.ResetIsDelivered()
+		return nil
+	case messagestatus.FieldIsRead:
+		m.ResetIsRead()
+		return nil
+	case messagestatus.FieldDeliveredTime:
+		m.ResetDeliveredTime()
+		return nil
+	case messagestatus.FieldReadTime:
+		m.ResetReadTime()
+		return nil
+	case messagestatus.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageStatus field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageStatusMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageStatusMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageStatusMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageStatusMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageStatusMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageStatusMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageStatusMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown MessageStatus unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageStatusMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown MessageStatus edge %s", name)
+}
+
+// RetentionJobMutation represents an operation that mutates the RetentionJob nodes in the graph.
+type RetentionJobMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	status          *string
+	cursor          *int
+	addcursor       *int
+	maxMessageId    *int
+	addmaxMessageId *int
+	scanned         *int
+	addscanned      *int
+	purged          *int
+	addpurged       *int
+	archived        *int
+	addarchived     *int
+	mediaDeleted    *int
+	addmediaDeleted *int
+	lastError       *string
+	startTime       *time.Time
+	updateTime      *time.Time
+	finishTime      *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*RetentionJob, error)
+	predicates      []predicate.RetentionJob
+}
+
+var _ ent.Mutation = (*RetentionJobMutation)(nil)
+
+// retentionjobOption allows management of the mutation configuration using functional options.
+type retentionjobOption func(*RetentionJobMutation)
+
+// newRetentionJobMutation creates new mutation for the RetentionJob entity.
+func newRetentionJobMutation(c config, op Op, opts ...retentionjobOption) *RetentionJobMutation {
+	m := &RetentionJobMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRetentionJob,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRetentionJobID sets the ID field of the mutation.
+func withRetentionJobID(id int) retentionjobOption {
+	return func(m *RetentionJobMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RetentionJob
+		)
+		m.oldValue = func(ctx context.Context) (*RetentionJob, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RetentionJob.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRetentionJob sets the old RetentionJob of the mutation.
+func withRetentionJob(node *RetentionJob) retentionjobOption {
+	return func(m *RetentionJobMutation) {
+		m.oldValue = func(context.Context) (*RetentionJob, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RetentionJobMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RetentionJobMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RetentionJobMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RetentionJobMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RetentionJob.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetStatus sets the "status" field.
+func (m *RetentionJobMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *RetentionJobMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the RetentionJob entity.
+// If the RetentionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RetentionJobMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *RetentionJobMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetCursor sets the "cursor" field.
+func (m *RetentionJobMutation) SetCursor(i int) {
+	m.cursor = &i
+	m.addcursor = nil
+}
+
+// Cursor returns the value of the "cursor" field in the mutation.
+func (m *RetentionJobMutation) Cursor() (r int, exists bool) {
+	v := m.cursor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCursor returns the old "cursor" field's value of the RetentionJob entity.
+// If the RetentionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RetentionJobMutation) OldCursor(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCursor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCursor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCursor: %w", err)
+	}
+	return oldValue.Cursor, nil
+}
+
+// AddCursor adds i to the "cursor" field.
+func (m *RetentionJobMutation) AddCursor(i int) {
+	if m.addcursor != nil {
+		*m.addcursor += i
+	} else {
+		m.addcursor = &i
+	}
+}
+
+// AddedCursor returns the value that was added to the "cursor" field in this mutation.
+func (m *RetentionJobMutation) AddedCursor() (r int, exists bool) {
+	v := m.addcursor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCursor resets all changes to the "cursor" field.
+func (m *RetentionJobMutation) ResetCursor() {
+	m.cursor = nil
+	m.addcursor = nil
+}
+
+// SetMaxMessageId sets the "maxMessageId" field.
+func (m *RetentionJobMutation) SetMaxMessageId(i int) {
+	m.maxMessageId = &i
+	m.addmaxMessageId = nil
+}
+
+// MaxMessageId returns the value of the "maxMessageId" field in the mutation.
+func (m *RetentionJobMutation) MaxMessageId() (r int, exists bool) {
+	v := m.maxMessageId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxMessageId returns the old "maxMessageId" field's value of the RetentionJob entity.
+// If the RetentionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RetentionJobMutation) OldMaxMessageId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxMessageId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxMessageId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxMessageId: %w", err)
+	}
+	return oldValue.MaxMessageId, nil
+}
+
+// AddMaxMessageId adds i to the "maxMessageId" field.
+func (m *RetentionJobMutation) AddMaxMessageId(i int) {
+	if m.addmaxMessageId != nil {
+		*m.addmaxMessageId += i
+	} else {
+		m.addmaxMessageId = &i
+	}
+}
+
+// AddedMaxMessageId returns the value that was added to the "maxMessageId" field in this mutation.
+func (m *RetentionJobMutation) AddedMaxMessageId() (r int, exists bool) {
+	v := m.addmaxMessageId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxMessageId resets all changes to the "maxMessageId" field.
+func (m *RetentionJobMutation) ResetMaxMessageId() {
+	m.maxMessageId = nil
+	m.addmaxMessageId = nil
+}
+
+// SetScanned sets the "scanned" field.
+func (m *RetentionJobMutation) SetScanned(i int) {
+	m.scanned = &i
+	m.addscanned = nil
+}
+
+// Scanned returns the value of the "scanned" field in the mutation.
+func (m *RetentionJobMutation) Scanned() (r int, exists bool) {
+	v := m.scanned
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScanned returns the old "scanned" field's value of the RetentionJob entity.
+// If the RetentionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RetentionJobMutation) OldScanned(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScanned is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScanned requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScanned: %w", err)
+	}
+	return oldValue.Scanned, nil
+}
+
+// AddScanned adds i to the "scanned" field.
+func (m *RetentionJobMutation) AddScanned(i int) {
+	if m.addscanned != nil {
+		*m.addscanned += i
+	} else {
+		m.addscanned = &i
+	}
+}
+
+// AddedScanned returns the value that was added to the "scanned" field in this mutation.
+func (m *RetentionJobMutation) AddedScanned() (r int, exists bool) {
+	v := m.addscanned
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetScanned resets all changes to the "scanned" field.
+func (m *RetentionJobMutation) ResetScanned() {
+	m.scanned = nil
+	m.addscanned = nil
+}
+
+// SetPurged sets the "purged" field.
+func (m *RetentionJobMutation) SetPurged(i int) {
+	m.purged = &i
+	m.addpurged = nil
+}
+
+// Purged returns the value of the "purged" field in the mutation.
+func (m *RetentionJobMutation) Purged() (r int, exists bool) {
+	v := m.purged
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPurged returns the old "purged" field's value of the RetentionJob entity.
+// If the RetentionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RetentionJobMutation) OldPurged(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPurged is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPurged requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPurged: %w", err)
+	}
+	return oldValue.Purged, nil
+}
+
+// AddPurged adds i to the "purged" field.
+func (m *RetentionJobMutation) AddPurged(i int) {
+	if m.addpurged != nil {
+		*m.addpurged += i
+	} else {
+		m.addpurged = &i
+	}
+}
+
+// AddedPurged returns the value that was added to the "purged" field in this mutation.
+func (m *RetentionJobMutation) AddedPurged() (r int, exists bool) {
+	v := m.addpurged
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPurged resets all changes to the "purged" field.
+func (m *RetentionJobMutation) ResetPurged() {
+	m.purged = nil
+	m.addpurged = nil
+}
+
+// SetArchived sets the "archived" field.
+func (m *RetentionJobMutation) SetArchived(i int) {
+	m.archived = &i
+	m.addarchived = nil
+}
+
+// Archived returns the value of the "archived" field in the mutation.
+func (m *RetentionJobMutation) Archived() (r int, exists bool) {
+	v := m.archived
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArchived returns the old "archived" field's value of the RetentionJob entity.
+// If the RetentionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RetentionJobMutation) OldArchived(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArchived is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArchived requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArchived: %w", err)
+	}
+	return oldValue.Archived, nil
+}
+
+// AddArchived adds i to the "archived" field.
+func (m *RetentionJobMutation) AddArchived(i int) {
+	if m.addarchived != nil {
+		*m.addarchived += i
+	} else {
+		m.addarchived = &i
+	}
+}
+
+// AddedArchived returns the value that was added to the "archived" field in this mutation.
+func (m *RetentionJobMutation) AddedArchived() (r int, exists bool) {
+	v := m.addarchived
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetArchived resets all changes to the "archived" field.
+func (m *RetentionJobMutation) ResetArchived() {
+	m.archived = nil
+	m.addarchived = nil
+}
+
+// SetMediaDeleted sets the "mediaDeleted" field.
+func (m *RetentionJobMutation) SetMediaDeleted(i int) {
+	m.mediaDeleted = &i
+	m.addmediaDeleted = nil
+}
+
+// MediaDeleted returns the value of the "mediaDeleted" field in the mutation.
+func (m *RetentionJobMutation) MediaDeleted() (r int, exists bool) {
+	v := m.mediaDeleted
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMediaDeleted returns the old "mediaDeleted" field's value of the RetentionJob entity.
+// If the RetentionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RetentionJobMutation) OldMediaDeleted(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMediaDeleted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMediaDeleted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMediaDeleted: %w", err)
+	}
+	return oldValue.MediaDeleted, nil
+}
+
+// AddMediaDeleted adds i to the "mediaDeleted" field.
+func (m *RetentionJobMutation) AddMediaDeleted(i int) {
+	if m.addmediaDeleted != nil {
+		*m.addmediaDeleted += i
+	} else {
+		m.addmediaDeleted = &i
+	}
+}
+
+// AddedMediaDeleted returns the value that was added to the "mediaDeleted" field in this mutation.
+func (m *RetentionJobMutation) AddedMediaDeleted() (r int, exists bool) {
+	v := m.addmediaDeleted
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMediaDeleted resets all changes to the "mediaDeleted" field.
+func (m *RetentionJobMutation) ResetMediaDeleted() {
+	m.mediaDeleted = nil
+	m.addmediaDeleted = nil
+}
+
+// SetLastError sets the "lastError" field.
+func (m *RetentionJobMutation) SetLastError(s string) {
+	m.lastError = &s
+}
+
+// LastError returns the value of the "lastError" field in the mutation.
+func (m *RetentionJobMutation) LastError() (r string, exists bool) {
+	v := m.lastError
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastError returns the old "lastError" field's value of the RetentionJob entity.
+// If the RetentionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RetentionJobMutation) OldLastError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ResetLastError resets all changes to the "lastError" field.
+func (m *RetentionJobMutation) ResetLastError() {
+	m.lastError = nil
+}
+
+// SetStartTime sets the "startTime" field.
+func (m *RetentionJobMutation) SetStartTime(t time.Time) {
+	m.startTime = &t
+}
+
+// StartTime returns the value of the "startTime" field in the mutation.
+func (m *RetentionJobMutation) StartTime() (r time.Time, exists bool) {
+	v := m.startTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartTime returns the old "startTime" field's value of the RetentionJob entity.
+// If the RetentionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RetentionJobMutation) OldStartTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartTime: %w", err)
+	}
+	return oldValue.StartTime, nil
+}
+
+// ResetStartTime resets all changes to the "startTime" field.
+func (m *RetentionJobMutation) ResetStartTime() {
+	m.startTime = nil
+}
+
+// SetUpdateTime sets the "updateTime" field.
+func (m *RetentionJobMutation) SetUpdateTime(t time.Time) {
+	m.updateTime = &t
+}
+
+// UpdateTime returns the value of the "updateTime" field in the mutation.
+func (m *RetentionJobMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.updateTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "updateTime" field's value of the RetentionJob entity.
+// If the RetentionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RetentionJobMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "updateTime" field.
+func (m *RetentionJobMutation) ResetUpdateTime() {
+	m.updateTime = nil
+}
+
+// SetFinishTime sets the "finishTime" field.
+func (m *RetentionJobMutation) SetFinishTime(t time.Time) {
+	m.finishTime = &t
+}
+
+// FinishTime returns the value of the "finishTime" field in the mutation.
+func (m *RetentionJobMutation) FinishTime() (r time.Time, exists bool) {
+	v := m.finishTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFinishTime returns the old "finishTime" field's value of the RetentionJob entity.
+// If the RetentionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RetentionJobMutation) OldFinishTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinishTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinishTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinishTime: %w", err)
+	}
+	return oldValue.FinishTime, nil
+}
+
+// ClearFinishTime clears the value of the "finishTime" field.
+func (m *RetentionJobMutation) ClearFinishTime() {
+	m.finishTime = nil
+	m.clearedFields[retentionjob.FieldFinishTime] = struct{}{}
+}
+
+// FinishTimeCleared returns if the "finishTime" field was cleared in this mutation.
+func (m *RetentionJobMutation) FinishTimeCleared() bool {
+	_, ok := m.clearedFields[retentionjob.FieldFinishTime]
+	return ok
+}
+
+// ResetFinishTime resets all changes to the "finishTime" field.
+func (m *RetentionJobMutation) ResetFinishTime() {
+	m.finishTime = nil
+	delete(m.clearedFields, retentionjob.FieldFinishTime)
+}
+
+// Where appends a list predicates to the RetentionJobMutation builder.
+func (m *RetentionJobMutation) Where(ps ...predicate.RetentionJob) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RetentionJobMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RetentionJobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RetentionJob, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RetentionJobMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RetentionJobMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RetentionJob).
+func (m *RetentionJobMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RetentionJobMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.status != nil {
+		fields = append(fields, retentionjob.FieldStatus)
+	}
+	if m.cursor != nil {
+		fields = append(fields, retentionjob.FieldCursor)
+	}
+	if m.maxMessageId != nil {
+		fields = append(fields, retentionjob.FieldMaxMessageId)
+	}
+	if m.scanned != nil {
+		fields = append(fields, retentionjob.FieldScanned)
+	}
+	if m.purged != nil {
+		fields = append(fields, retentionjob.FieldPurged)
+	}
+	if m.archived != nil {
+		fields = append(fields, retentionjob.FieldArchived)
+	}
+	if m.mediaDeleted != nil {
+		fields = append(fields, retentionjob.FieldMediaDeleted)
+	}
+	if m.lastError != nil {
+		fields = append(fields, retentionjob.FieldLastError)
+	}
+	if m.startTime != nil {
+		fields = append(fields, retentionjob.FieldStartTime)
+	}
+	if m.updateTime != nil {
+		fields = append(fields, retentionjob.FieldUpdateTime)
+	}
+	if m.finishTime != nil {
+		fields = append(fields, retentionjob.FieldFinishTime)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RetentionJobMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case retentionjob.FieldStatus:
+		return m.Status()
+	case retentionjob.FieldCursor:
+		return m.Cursor()
+	case retentionjob.FieldMaxMessageId:
+		return m.MaxMessageId()
+	case retentionjob.FieldScanned:
+		return m.Scanned()
+	case retentionjob.FieldPurged:
+		return m.Purged()
+	case retentionjob.FieldArchived:
+		return m.Archived()
+	case retentionjob.FieldMediaDeleted:
+		return m.MediaDeleted()
+	case retentionjob.FieldLastError:
+		return m.LastError()
+	case retentionjob.FieldStartTime:
+		return m.StartTime()
+	case retentionjob.FieldUpdateTime:
+		return m.UpdateTime()
+	case retentionjob.FieldFinishTime:
+		return m.FinishTime()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RetentionJobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case retentionjob.FieldStatus:
+		return m.OldStatus(ctx)
+	case retentionjob.FieldCursor:
+		return m.OldCursor(ctx)
+	case retentionjob.FieldMaxMessageId:
+		return m.OldMaxMessageId(ctx)
+	case retentionjob.FieldScanned:
+		return m.OldScanned(ctx)
+	case retentionjob.FieldPurged:
+		return m.OldPurged(ctx)
+	case retentionjob.FieldArchived:
+		return m.OldArchived(ctx)
+	case retentionjob.FieldMediaDeleted:
+		return m.OldMediaDeleted(ctx)
+	case retentionjob.FieldLastError:
+		return m.OldLastError(ctx)
+	case retentionjob.FieldStartTime:
+		return m.OldStartTime(ctx)
+	case retentionjob.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case retentionjob.FieldFinishTime:
+		return m.OldFinishTime(ctx)
+	}
+	return nil, fmt.Errorf("unknown RetentionJob field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RetentionJobMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case retentionjob.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case retentionjob.FieldCursor:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCursor(v)
+		return nil
+	case retentionjob.FieldMaxMessageId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxMessageId(v)
+		return nil
+	case retentionjob.FieldScanned:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScanned(v)
+		return nil
+	case retentionjob.FieldPurged:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPurged(v)
+		return nil
+	case retentionjob.FieldArchived:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArchived(v)
+		return nil
+	case retentionjob.FieldMediaDeleted:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMediaDeleted(v)
+		return nil
+	case retentionjob.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case retentionjob.FieldStartTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartTime(v)
+		return nil
+	case retentionjob.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case retentionjob.FieldFinishTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishTime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RetentionJob field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RetentionJobMutation) AddedFields() []string {
+	var fields []string
+	if m.addcursor != nil {
+		fields = append(fields, retentionjob.FieldCursor)
+	}
+	if m.addmaxMessageId != nil {
+		fields = append(fields, retentionjob.FieldMaxMessageId)
+	}
+	if m.addscanned != nil {
+		fields = append(fields, retentionjob.FieldScanned)
+	}
+	if m.addpurged != nil {
+		fields = append(fields, retentionjob.FieldPurged)
+	}
+	if m.addarchived != nil {
+		fields = append(fields, retentionjob.FieldArchived)
+	}
+	if m.addmediaDeleted != nil {
+		fields = append(fields, retentionjob.FieldMediaDeleted)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RetentionJobMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case retentionjob.FieldCursor:
+		return m.AddedCursor()
+	case retentionjob.FieldMaxMessageId:
+		return m.AddedMaxMessageId()
+	case retentionjob.FieldScanned:
+		return m.AddedScanned()
+	case retentionjob.FieldPurged:
+		return m.AddedPurged()
+	case retentionjob.FieldArchived:
+		return m.AddedArchived()
+	case retentionjob.FieldMediaDeleted:
+		return m.AddedMediaDeleted()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RetentionJobMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case retentionjob.FieldCursor:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCursor(v)
+		return nil
+	case retentionjob.FieldMaxMessageId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxMessageId(v)
+		return nil
+	case retentionjob.FieldScanned:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScanned(v)
+		return nil
+	case retentionjob.FieldPurged:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPurged(v)
+		return nil
+	case retentionjob.FieldArchived:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddArchived(v)
+		return nil
+	case retentionjob.FieldMediaDeleted:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMediaDeleted(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RetentionJob numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RetentionJobMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(retentionjob.FieldFinishTime) {
+		fields = append(fields, retentionjob.FieldFinishTime)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RetentionJobMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RetentionJobMutation) ClearField(name string) error {
+	switch name {
+	case retentionjob.FieldFinishTime:
+		m.ClearFinishTime()
+		return nil
+	}
+	return fmt.Errorf("unknown RetentionJob nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RetentionJobMutation) ResetField(name string) error {
+	switch name {
+	case retentionjob.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case retentionjob.FieldCursor:
+		m.ResetCursor()
+		return nil
+	case retentionjob.FieldMaxMessageId:
+		m.ResetMaxMessageId()
+		return nil
+	case retentionjob.FieldScanned:
+		m.ResetScanned()
+		return nil
+	case retentionjob.FieldPurged:
+		m.ResetPurged()
+		return nil
+	case retentionjob.FieldArchived:
+		m.ResetArchived()
+		return nil
+	case retentionjob.FieldMediaDeleted:
+		m.ResetMediaDeleted()
+		return nil
+	case retentionjob.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case retentionjob.FieldStartTime:
+		m.ResetStartTime()
+		return nil
+	case retentionjob.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case retentionjob.FieldFinishTime:
+		m.ResetFinishTime()
+		return nil
+	}
+	return fmt.Errorf("unknown RetentionJob field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RetentionJobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RetentionJobMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RetentionJobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RetentionJobMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RetentionJobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RetentionJobMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RetentionJobMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown RetentionJob unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RetentionJobMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown RetentionJob edge %s", name)
+}
+
+// ScheduledMessageMutation represents an operation that mutates the ScheduledMessage nodes in the graph.
+type ScheduledMessageMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	userId        *int
+	adduserId     *int
+	toUserId      *int
+	addtoUserId   *int
+	groupId       *int
+	addgroupId    *int
+	msgType       *int
+	addmsgType    *int
+	content       *string
+	mentions      *string
+	replyToMsgId  *string
+	sendTime      *time.Time
+	status        *string
+	leaseTime     *time.Time
+	attempts      *int
+	addattempts   *int
+	retries       *int
+	addretries    *int
+	msgId         *string
+	failReason    *string
+	createTime    *time.Time
+	updateTime    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ScheduledMessage, error)
+	predicates    []predicate.ScheduledMessage
+}
+
+var _ ent.Mutation = (*ScheduledMessageMutation)(nil)
+
+// scheduledmessageOption allows management of the mutation configuration using functional options.
+type scheduledmessageOption func(*ScheduledMessageMutation)
+
+// newScheduledMessageMutation creates new mutation for the ScheduledMessage entity.
+func newScheduledMessageMutation(c config, op Op, opts ...scheduledmessageOption) *ScheduledMessageMutation {
+	m := &ScheduledMessageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeScheduledMessage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withScheduledMessageID sets the ID field of the mutation.
+func withScheduledMessageID(id int) scheduledmessageOption {
+	return func(m *ScheduledMessageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ScheduledMessage
+		)
+		m.oldValue = func(ctx context.Context) (*ScheduledMessage, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ScheduledMessage.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withScheduledMessage sets the old ScheduledMessage of the mutation.
+func withScheduledMessage(node *ScheduledMessage) scheduledmessageOption {
+	return func(m *ScheduledMessageMutation) {
+		m.oldValue = func(context.Context) (*ScheduledMessage, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ScheduledMessageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ScheduledMessageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ScheduledMessageMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ScheduledMessageMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ScheduledMessage.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserId sets the "userId" field.
+func (m *ScheduledMessageMutation) SetUserId(i int) {
+	m.userId = &i
+	m.adduserId = nil
+}
+
+// UserId returns the value of the "userId" field in the mutation.
+func (m *ScheduledMessageMutation) UserId() (r int, exists bool) {
+	v := m.userId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserId returns the old "userId" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldUserId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserId: %w", err)
+	}
+	return oldValue.UserId, nil
+}
+
+// AddUserId adds i to the "userId" field.
+func (m *ScheduledMessageMutation) AddUserId(i int) {
+	if m.adduserId != nil {
+		*m.adduserId += i
+	} else {
+		m.adduserId = &i
+	}
+}
+
+// AddedUserId returns the value that was added to the "userId" field in this mutation.
+func (m *ScheduledMessageMutation) AddedUserId() (r int, exists bool) {
+	v := m.adduserId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUserId resets all changes to the "userId" field.
+func (m *ScheduledMessageMutation) ResetUserId() {
+	m.userId = nil
+	m.adduserId = nil
+}
+
+// SetToUserId sets the "toUserId" field.
+func (m *ScheduledMessageMutation) SetToUserId(i int) {
+	m.toUserId = &i
+	m.addtoUserId = nil
+}
+
+// ToUserId returns the value of the "toUserId" field in the mutation.
+func (m *ScheduledMessageMutation) ToUserId() (r int, exists bool) {
+	v := m.toUserId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToUserId returns the old "toUserId" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldToUserId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToUserId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToUserId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToUserId: %w", err)
+	}
+	return oldValue.ToUserId, nil
+}
+
+// AddToUserId adds i to the "toUserId" field.
+func (m *ScheduledMessageMutation) AddToUserId(i int) {
+	if m.addtoUserId != nil {
+		*m.addtoUserId += i
+	} else {
+		m.addtoUserId = &i
+	}
+}
+
+// AddedToUserId returns the value that was added to the "toUserId" field in this mutation.
+func (m *ScheduledMessageMutation) AddedToUserId() (r int, exists bool) {
+	v := m.addtoUserId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetToUserId resets all changes to the "toUserId" field.
+func (m *ScheduledMessageMutation) ResetToUserId() {
+	m.toUserId = nil
+	m.addtoUserId = nil
+}
+
+// SetGroupId sets the "groupId" field.
+func (m *ScheduledMessageMutation) SetGroupId(i int) {
+	m.groupId = &i
+	m.addgroupId = nil
+}
+
+// GroupId returns the value of the "groupId" field in the mutation.
+func (m *ScheduledMessageMutation) GroupId() (r int, exists bool) {
+	v := m.groupId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGroupId returns the old "groupId" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldGroupId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGroupId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGroupId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGroupId: %w", err)
+	}
+	return oldValue.GroupId, nil
+}
+
+// AddGroupId adds i to the "groupId" field.
+func (m *ScheduledMessageMutation) AddGroupId(i int) {
+	if m.addgroupId != nil {
+		*m.addgroupId += i
+	} else {
+		m.addgroupId = &i
+	}
+}
+
+// AddedGroupId returns the value that was added to the "groupId" field in this mutation.
+func (m *ScheduledMessageMutation) AddedGroupId() (r int, exists bool) {
+	v := m.addgroupId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetGroupId resets all changes to the "groupId" field.
+func (m *ScheduledMessageMutation) ResetGroupId() {
+	m.groupId = nil
+	m.addgroupId = nil
+}
+
+// SetMsgType sets the "msgType" field.
+func (m *ScheduledMessageMutation) SetMsgType(i int) {
+	m.msgType = &i
+	m.addmsgType = nil
+}
+
+// MsgType returns the value of the "msgType" field in the mutation.
+func (m *ScheduledMessageMutation) MsgType() (r int, exists bool) {
+	v := m.msgType
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMsgType returns the old "msgType" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldMsgType(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMsgType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMsgType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMsgType: %w", err)
+	}
+	return oldValue.MsgType, nil
+}
+
+// AddMsgType adds i to the "msgType" field.
+func (m *ScheduledMessageMutation) AddMsgType(i int) {
+	if m.addmsgType != nil {
+		*m.addmsgType += i
+	} else {
+		m.addmsgType = &i
+	}
+}
+
+// AddedMsgType returns the value that was added to the "msgType" field in this mutation.
+func (m *ScheduledMessageMutation) AddedMsgType() (r int, exists bool) {
+	v := m.addmsgType
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMsgType resets all changes to the "msgType" field.
+func (m *ScheduledMessageMutation) ResetMsgType() {
+	m.msgType = nil
+	m.addmsgType = nil
+}
+
+// SetContent sets the "content" field.
+func (m *ScheduledMessageMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *ScheduledMessageMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *ScheduledMessageMutation) ResetContent() {
+	m.content = nil
+}
+
+// SetMentions sets the "mentions" field.
+func (m *ScheduledMessageMutation) SetMentions(s string) {
+	m.mentions = &s
+}
+
+// Mentions returns the value of the "mentions" field in the mutation.
+func (m *ScheduledMessageMutation) Mentions() (r string, exists bool) {
+	v := m.mentions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMentions returns the old "mentions" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldMentions(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMentions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMentions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMentions: %w", err)
+	}
+	return oldValue.Mentions, nil
+}
+
+// ResetMentions resets all changes to the "mentions" field.
+func (m *ScheduledMessageMutation) ResetMentions() {
+	m.mentions = nil
+}
+
+// SetReplyToMsgId sets the "replyToMsgId" field.
+func (m *ScheduledMessageMutation) SetReplyToMsgId(s string) {
+	m.replyToMsgId = &s
+}
+
+// ReplyToMsgId returns the value of the "replyToMsgId" field in the mutation.
+func (m *ScheduledMessageMutation) ReplyToMsgId() (r string, exists bool) {
+	v := m.replyToMsgId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReplyToMsgId returns the old "replyToMsgId" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldReplyToMsgId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReplyToMsgId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReplyToMsgId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReplyToMsgId: %w", err)
+	}
+	return oldValue.ReplyToMsgId, nil
+}
+
+// ResetReplyToMsgId resets all changes to the "replyToMsgId" field.
+func (m *ScheduledMessageMutation) ResetReplyToMsgId() {
+	m.replyToMsgId = nil
+}
+
+// SetSendTime sets the "sendTime" field.
+func (m *ScheduledMessageMutation) SetSendTime(t time.Time) {
+	m.sendTime = &t
+}
+
+// SendTime returns the value of the "sendTime" field in the mutation.
+func (m *ScheduledMessageMutation) SendTime() (r time.Time, exists bool) {
+	v := m.sendTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSendTime returns the old "sendTime" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldSendTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSendTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSendTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSendTime: %w", err)
+	}
+	return oldValue.SendTime, nil
+}
+
+// ResetSendTime resets all changes to the "sendTime" field.
+func (m *ScheduledMessageMutation) ResetSendTime() {
+	m.sendTime = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ScheduledMessageMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ScheduledMessageMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ScheduledMessageMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetLeaseTime sets the "leaseTime" field.
+func (m *ScheduledMessageMutation) SetLeaseTime(t time.Time) {
+	m.leaseTime = &t
+}
+
+// LeaseTime returns the value of the "leaseTime" field in the mutation.
+func (m *ScheduledMessageMutation) LeaseTime() (r time.Time, exists bool) {
+	v := m.leaseTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLeaseTime returns the old "leaseTime" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldLeaseTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLeaseTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLeaseTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLeaseTime: %w", err)
+	}
+	return oldValue.LeaseTime, nil
+}
+
+// ClearLeaseTime clears the value of the "leaseTime" field.
+func (m *ScheduledMessageMutation) ClearLeaseTime() {
+	m.leaseTime = nil
+	m.clearedFields[scheduledmessage.FieldLeaseTime] = struct{}{}
+}
+
+// LeaseTimeCleared returns if the "leaseTime" field was cleared in this mutation.
+func (m *ScheduledMessageMutation) LeaseTimeCleared() bool {
+	_, ok := m.clearedFields[scheduledmessage.FieldLeaseTime]
+	return ok
+}
+
+// ResetLeaseTime resets all changes to the "leaseTime" field.
+func (m *ScheduledMessageMutation) ResetLeaseTime() {
+	m.leaseTime = nil
+	delete(m.clearedFields, scheduledmessage.FieldLeaseTime)
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *ScheduledMessageMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *ScheduledMessageMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *ScheduledMessageMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *ScheduledMessageMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *ScheduledMessageMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetRetries sets the "retries" field.
+func (m *ScheduledMessageMutation) SetRetries(i int) {
+	m.retries = &i
+	m.addretries = nil
+}
+
+// Retries returns the value of the "retries" field in the mutation.
+func (m *ScheduledMessageMutation) Retries() (r int, exists bool) {
+	v := m.retries
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetries returns the old "retries" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldRetries(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRetries is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRetries requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetries: %w", err)
+	}
+	return oldValue.Retries, nil
+}
+
+// AddRetries adds i to the "retries" field.
+func (m *ScheduledMessageMutation) AddRetries(i int) {
+	if m.addretries != nil {
+		*m.addretries += i
+	} else {
+		m.addretries = &i
+	}
+}
+
+// AddedRetries returns the value that was added to the "retries" field in this mutation.
+func (m *ScheduledMessageMutation) AddedRetries() (r int, exists bool) {
+	v := m.addretries
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRetries resets all changes to the "retries" field.
+func (m *ScheduledMessageMutation) ResetRetries() {
+	m.retries = nil
+	m.addretries = nil
+}
+
+// SetMsgId sets the "msgId" field.
+func (m *ScheduledMessageMutation) SetMsgId(s string) {
+	m.msgId = &s
+}
+
+// MsgId returns the value of the "msgId" field in the mutation.
+func (m *ScheduledMessageMutation) MsgId() (r string, exists bool) {
+	v := m.msgId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMsgId returns the old "msgId" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldMsgId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMsgId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMsgId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMsgId: %w", err)
+	}
+	return oldValue.MsgId, nil
+}
+
+// ResetMsgId resets all changes to the "msgId" field.
+func (m *ScheduledMessageMutation) ResetMsgId() {
+	m.msgId = nil
+}
+
+// SetFailReason sets the "failReason" field.
+func (m *ScheduledMessageMutation) SetFailReason(s string) {
+	m.failReason = &s
+}
+
+// FailReason returns the value of the "failReason" field in the mutation.
+func (m *ScheduledMessageMutation) FailReason() (r string, exists bool) {
+	v := m.failReason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFailReason returns the old "failReason" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldFailReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailReason: %w", err)
+	}
+	return oldValue.FailReason, nil
+}
+
+// ResetFailReason resets all changes to the "failReason" field.
+func (m *ScheduledMessageMutation) ResetFailReason() {
+	m.failReason = nil
+}
+
+// SetCreateTime sets the "createTime" field.
+func (m *ScheduledMessageMutation) SetCreateTime(t time.Time) {
+	m.createTime = &t
+}
+
+// CreateTime returns the value of the "createTime" field in the mutation.
+func (m *ScheduledMessageMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.createTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "createTime" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "createTime" field.
+func (m *ScheduledMessageMutation) ResetCreateTime() {
+	m.createTime = nil
+}
+
+// SetUpdateTime sets the "updateTime" field.
+func (m *ScheduledMessageMutation) SetUpdateTime(t time.Time) {
+	m.updateTime = &t
+}
+
+// UpdateTime returns the value of the "updateTime" field in the mutation.
+func (m *ScheduledMessageMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.updateTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "updateTime" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "updateTime" field.
+func (m *ScheduledMessageMutation) ResetUpdateTime() {
+	m.updateTime = nil
+}
+
+// Where appends a list predicates to the ScheduledMessageMutation builder.
+func (m *ScheduledMessageMutation) Where(ps ...predicate.ScheduledMessage) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ScheduledMessageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ScheduledMessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ScheduledMessage, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ScheduledMessageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ScheduledMessageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ScheduledMessage).
+func (m *ScheduledMessageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ScheduledMessageMutation) Fields() []string {
+	fields := make([]string, 0, 16)
+	if m.userId != nil {
+		fields = append(fields, scheduledmessage.FieldUserId)
+	}
+	if m.toUserId != nil {
+		fields = append(fields, scheduledmessage.FieldToUserId)
+	}
+	if m.groupId != nil {
+		fields = append(fields, scheduledmessage.FieldGroupId)
+	}
+	if m.msgType != nil {
+		fields = append(fields, scheduledmessage.FieldMsgType)
+	}
+	if m.content != nil {
+		fields = append(fields, scheduledmessage.FieldContent)
+	}
+	if m.mentions != nil {
+		fields = append(fields, scheduledmessage.FieldMentions)
+	}
+	if m.replyToMsgId != nil {
+		fields = append(fields, scheduledmessage.FieldReplyToMsgId)
+	}
+	if m.sendTime != nil {
+		fields = append(fields, scheduledmessage.FieldSendTime)
+	}
+	if m.status != nil {
+		fields = append(fields, scheduledmessage.FieldStatus)
+	}
+	if m.leaseTime != nil {
+		fields = append(fields, scheduledmessage.FieldLeaseTime)
+	}
+	if m.attempts != nil {
+		fields = append(fields, scheduledmessage.FieldAttempts)
+	}
+	if m.retries != nil {
+		fields = append(fields, scheduledmessage.FieldRetries)
+	}
+	if m.msgId != nil {
+		fields = append(fields, scheduledmessage.FieldMsgId)
+	}
+	if m.failReason != nil {
+		fields = append(fields, scheduledmessage.FieldFailReason)
+	}
+	if m.createTime != nil {
+		fields = append(fields, scheduledmessage.FieldCreateTime)
+	}
+	if m.updateTime != nil {
+		fields = append(fields, scheduledmessage.FieldUpdateTime)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ScheduledMessageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case scheduledmessage.FieldUserId:
+		return m.UserId()
+	case scheduledmessage.FieldToUserId:
+		return m.ToUserId()
+	case scheduledmessage.FieldGroupId:
+		return m.GroupId()
+	case scheduledmessage.FieldMsgType:
+		return m.MsgType()
+	case scheduledmessage.FieldContent:
+		return m.Content()
+	case scheduledmessage.FieldMentions:
+		return m.Mentions()
+	case scheduledmessage.FieldReplyToMsgId:
+		return m.ReplyToMsgId()
+	case scheduledmessage.FieldSendTime:
+		return m.SendTime()
+	case scheduledmessage.FieldStatus:
+		return m.Status()
+	case scheduledmessage.FieldLeaseTime:
+		return m.LeaseTime()
+	case scheduledmessage.FieldAttempts:
+		return m.Attempts()
+	case scheduledmessage.FieldRetries:
+		return m.Retries()
+	case scheduledmessage.FieldMsgId:
+		return m.MsgId()
+	case scheduledmessage.FieldFailReason:
+		return m.FailReason()
+	case scheduledmessage.FieldCreateTime:
+		return m.CreateTime()
+	case scheduledmessage.FieldUpdateTime:
+		return m.UpdateTime()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ScheduledMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case scheduledmessage.FieldUserId:
+		return m.OldUserId(ctx)
+	case scheduledmessage.FieldToUserId:
+		return m.OldToUserId(ctx)
+	case scheduledmessage.FieldGroupId:
+		return m.OldGroupId(ctx)
+	case scheduledmessage.FieldMsgType:
+		return m.OldMsgType(ctx)
+	case scheduledmessage.FieldContent:
+		return m.OldContent(ctx)
+	case scheduledmessage.FieldMentions:
+		return m.OldMentions(ctx)
+	case scheduledmessage.FieldReplyToMsgId:
+		return m.OldReplyToMsgId(ctx)
+	case scheduledmessage.FieldSendTime:
+		return m.OldSendTime(ctx)
+	case scheduledmessage.FieldStatus:
+		return m.OldStatus(ctx)
+	case scheduledmessage.FieldLeaseTime:
+		return m.OldLeaseTime(ctx)
+	case scheduledmessage.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case scheduledmessage.FieldRetries:
+		return m.OldRetries(ctx)
+	case scheduledmessage.FieldMsgId:
+		return m.OldMsgId(ctx)
+	case scheduledmessage.FieldFailReason:
+		return m.OldFailReason(ctx)
+	case scheduledmessage.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case scheduledmessage.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	}
+	return nil, fmt.Errorf("unknown ScheduledMessage field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ScheduledMessageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case scheduledmessage.FieldUserId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserId(v)
+		return nil
+	case scheduledmessage.FieldToUserId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToUserId(v)
+		return nil
+	case scheduledmessage.FieldGroupId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGroupId(v)
+		return nil
+	case scheduledmessage.FieldMsgType:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMsgType(v)
+		return nil
+	case scheduledmessage.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case scheduledmessage.FieldMentions:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMentions(v)
+		return nil
+	case scheduledmessage.FieldReplyToMsgId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReplyToMsgId(v)
+		return nil
+	case scheduledmessage.FieldSendTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSendTime(v)
+		return nil
+	case scheduledmessage.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case scheduledmessage.FieldLeaseTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLeaseTime(v)
+		return nil
+	case scheduledmessage.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case scheduledmessage.FieldRetries:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetries(v)
+		return nil
+	case scheduledmessage.FieldMsgId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMsgId(v)
+		return nil
+	case scheduledmessage.FieldFailReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFailReason(v)
+		return nil
+	case scheduledmessage.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case scheduledmessage.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ScheduledMessage field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ScheduledMessageMutation) AddedFields() []string {
+	var fields []string
+	if m.adduserId != nil {
+		fields = append(fields, scheduledmessage.FieldUserId)
+	}
+	if m.addtoUserId != nil {
+		fields = append(fields, scheduledmessage.FieldToUserId)
+	}
+	if m.addgroupId != nil {
+		fields = append(fields, scheduledmessage.FieldGroupId)
+	}
+	if m.addmsgType != nil {
+		fields = append(fields, scheduledmessage.FieldMsgType)
+	}
+	if m.addattempts != nil {
+		fields = append(fields, scheduledmessage.FieldAttempts)
+	}
+	if m.addretries != nil {
+		fields = append(fields, scheduledmessage.FieldRetries)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ScheduledMessageMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case scheduledmessage.FieldUserId:
+		return m.AddedUserId()
+	case scheduledmessage.FieldToUserId:
+		return m.AddedToUserId()
+	case scheduledmessage.FieldGroupId:
+		return m.AddedGroupId()
+	case scheduledmessage.FieldMsgType:
+		return m.AddedMsgType()
+	case scheduledmessage.FieldAttempts:
+		return m.AddedAttempts()
+	case scheduledmessage.FieldRetries:
+		return m.AddedRetries()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ScheduledMessageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case scheduledmessage.FieldUserId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserId(v)
+		return nil
+	case scheduledmessage.FieldToUserId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddToUserId(v)
+		return nil
+	case scheduledmessage.FieldGroupId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGroupId(v)
+		return nil
+	case scheduledmessage.FieldMsgType:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMsgType(v)
+		return nil
+	case scheduledmessage.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	case scheduledmessage.FieldRetries:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRetries(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ScheduledMessage numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ScheduledMessageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(scheduledmessage.FieldLeaseTime) {
+		fields = append(fields, scheduledmessage.FieldLeaseTime)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ScheduledMessageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ScheduledMessageMutation) ClearField(name string) error {
+	switch name {
+	case scheduledmessage.FieldLeaseTime:
+		m.ClearLeaseTime()
+		return nil
+	}
+	return fmt.Errorf("unknown ScheduledMessage nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ScheduledMessageMutation) ResetField(name string) error {
+	switch name {
+	case scheduledmessage.FieldUserId:
+		m.ResetUserId()
+		return nil
+	case scheduledmessage.FieldToUserId:
+		m.ResetToUserId()
+		return nil
+	case scheduledmessage.FieldGroupId:
+		m.ResetGroupId()
+		return nil
+	case scheduledmessage.FieldMsgType:
+		m.ResetMsgType()
+		return nil
+	case scheduledmessage.FieldContent:
+		m.ResetContent()
+		return nil
+	case scheduledmessage.FieldMentions:
+		m.ResetMentions()
+		return nil
+	case scheduledmessage.FieldReplyToMsgId:
+		m.ResetReplyToMsgId()
+		return nil
+	case scheduledmessage.FieldSendTime:
+		m.ResetSendTime()
+		return nil
+	case scheduledmessage.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case scheduledmessage.FieldLeaseTime:
+		m.ResetLeaseTime()
+		return nil
+	case scheduledmessage.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case scheduledmessage.FieldRetries:
+		m.ResetRetries()
+		return nil
+	case scheduledmessage.FieldMsgId:
+		m.ResetMsgId()
+		return nil
+	case scheduledmessage.FieldFailReason:
+		m.ResetFailReason()
+		return nil
+	case scheduledmessage.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case scheduledmessage.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	}
+	return fmt.Errorf("unknown ScheduledMessage field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ScheduledMessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ScheduledMessageMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ScheduledMessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ScheduledMessageMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ScheduledMessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ScheduledMessageMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ScheduledMessageMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ScheduledMessage unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ScheduledMessageMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ScheduledMessage edge %s", name)
+}
+
+// TextMessageMutation represents an operation that mutates the TextMessage nodes in the graph.
+type TextMessageMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	msgId         *string
+	text          *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*TextMessage, error)
+	predicates    []predicate.TextMessage
+}
+
+var _ ent.Mutation = (*TextMessageMutation)(nil)
+
+// textmessageOption allows management of the mutation configuration using functional options.
+type textmessageOption func(*TextMessageMutation)
+
+// newTextMessageMutation creates new mutation for the TextMessage entity.
+func newTextMessageMutation(c config, op Op, opts ...textmessageOption) *TextMessageMutation {
+	m := &TextMessageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTextMessage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTextMessageID sets the ID field of the mutation.
+func withTextMessageID(id int) textmessageOption {
+	return func(m *TextMessageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TextMessage
+		)
+		m.oldValue = func(ctx context.Context) (*TextMessage, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TextMessage.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTextMessage sets the old TextMessage of the mutation.
+func withTextMessage(node *TextMessage) textmessageOption {
+	return func(m *TextMessageMutation) {
+		m.oldValue = func(context.Context) (*TextMessage, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TextMessageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TextMessageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TextMessageMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TextMessageMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TextMessage.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMsgId sets the "msgId" field.
+func (m *TextMessageMutation) SetMsgId(s string) {
+	m.msgId = &s
+}
+
+// MsgId returns the value of the "msgId" field in the mutation.
+func (m *TextMessageMutation) MsgId() (r string, exists bool) {
+	v := m.msgId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMsgId returns the old "msgId" field's value of the TextMessage entity.
+// If the TextMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TextMessageMutation) OldMsgId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMsgId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMsgId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMsgId: %w", err)
+	}
+	return oldValue.MsgId, nil
+}
+
+// ResetMsgId resets all changes to the "msgId" field.
+func (m *TextMessageMutation) ResetMsgId() {
+	m.msgId = nil
+}
+
+// SetText sets the "text" field.
+func (m *TextMessageMutation) SetText(s string) {
+	m.text = &s
+}
+
+// Text returns the value of the "text" field in the mutation.
+func (m *TextMessageMutation) Text() (r string, exists bool) {
+	v := m.text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldText returns the old "text" field's value of the TextMessage entity.
+// If the TextMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TextMessageMutation) OldText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldText: %w", err)
+	}
+	return oldValue.Text, nil
+}
+
+// ResetText resets all changes to the "text" field.
+func (m *TextMessageMutation) ResetText() {
+	m.text = nil
+}
+
+// Where appends a list predicates to the TextMessageMutation builder.
+func (m *TextMessageMutation) Where(ps ...predicate.TextMessage) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TextMessageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TextMessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TextMessage, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TextMessageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TextMessageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TextMessage).
+func (m *TextMessageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TextMessageMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.msgId != nil {
+		fields = append(fields, textmessage.FieldMsgId)
+	}
+	if m.text != nil {
+		fields = append(fields, textmessage.FieldText)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TextMessageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case textmessage.FieldMsgId:
+		return m.MsgId()
+	case textmessage.FieldText:
+		return m.Text()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TextMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case textmessage.FieldMsgId:
+		return m.OldMsgId(ctx)
+	case textmessage.FieldText:
+		return m.OldText(ctx)
+	}
+	return nil, fmt.Errorf("unknown TextMessage field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TextMessageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case textmessage.FieldMsgId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMsgId(v)
+		return nil
+	case textmessage.FieldText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetText(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TextMessage field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TextMessageMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TextMessageMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TextMessageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown TextMessage numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TextMessageMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TextMessageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TextMessageMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TextMessage nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TextMessageMutation) ResetField(name string) error {
+	switch name {
+	case textmessage.FieldMsgId:
+		m.ResetMsgId()
+		return nil
+	case textmessage.FieldText:
+		m.ResetText()
+		return nil
+	}
+	return fmt.Errorf("unknown TextMessage field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TextMessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TextMessageMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TextMessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TextMessageMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TextMessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TextMessageMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TextMessageMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TextMessage unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TextMessageMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TextMessage edge %s", name)
+}
+
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	username      *string
+	password      *string
+	nickname      *string
+	sex           *int
+	addsex        *int
+	avatar        *string
+	signature     *string
+	region        *string
+	birthday      *time.Time
+	lastSeen      *time.Time
+	status        *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*User, error)
+	predicates    []predicate.User
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserID sets the ID field of the mutation.
+func withUserID(id int) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUsername sets the "username" field.
+func (m *UserMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *UserMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsername returns the old "username" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
+}
+
+// ResetUsername resets all changes to the "username" field.
+func (m *UserMutation) ResetUsername() {
+	m.username = nil
+}
+
+// SetPassword sets the "password" field.
+func (m *UserMutation) SetPassword(s string) {
+	m.password = &s
+}
+
+// Password returns the value of the "password" field in the mutation.
+func (m *UserMutation) Password() (r string, exists bool) {
+	v := m.password
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPassword returns the old "password" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPassword requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+	}
+	return oldValue.Password, nil
+}
+
+// ResetPassword resets all changes to the "password" field.
+func (m *UserMutation) ResetPassword() {
+	m.password = nil
+}
+
+// SetNickname sets the "nickname" field.
+func (m *UserMutation) SetNickname(s string) {
+	m.nickname = &s
+}
+
+// Nickname returns the value of the "nickname" field in the mutation.
+func (m *UserMutation) Nickname() (r string, exists bool) {
+	v := m.nickname
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNickname returns the old "nickname" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldNickname(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNickname is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNickname requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNickname: %w", err)
+	}
+	return oldValue.Nickname, nil
+}
+
+// ResetNickname resets all changes to the "nickname" field.
+func (m *UserMutation) ResetNickname() {
+	m.nickname = nil
+}
+
+// SetSex sets the "sex" field.
+func (m *UserMutation) SetSex(i int) {
+	m.sex = &i
+	m.addsex = nil
+}
+
+// Sex returns the value of the "sex" field in the mutation.
+func (m *UserMutation) Sex() (r int, exists bool) {
+	v := m.sex
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSex returns the old "sex" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldSex(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSex: %w", err)
+	}
+	return oldValue.Sex, nil
+}
+
+// AddSex adds i to the "sex" field.
+func (m *UserMutation) AddSex(i int) {
+	if m.addsex != nil {
+		*m.addsex += i
+	} else {
+		m.addsex = &i
+	}
+}
+
+// AddedSex returns the value that was added to the "sex" field in this mutation.
+func (m *UserMutation) AddedSex() (r int, exists bool) {
+	v := m.addsex
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSex clears the value of the "sex" field.
+func (m *UserMutation) ClearSex() {
+	m.sex = nil
+	m.addsex = nil
+	m.clearedFields[user.FieldSex] = struct{}{}
+}
+
+// SexCleared returns if the "sex" field was cleared in this mutation.
+func (m *UserMutation) SexCleared() bool {
+	_, ok := m.clearedFields[user.FieldSex]
+	return ok
+}
+
+// ResetSex resets all changes to the "sex" field.
 func (m *UserMutation) ResetSex() {
 	m.sex = nil
 	m.addsex = nil
 	delete(m.clearedFields, user.FieldSex)
 }
 
-// SetAvatar sets the "avatar" field.
-func (m *UserMutation) SetAvatar(s string) {
-	m.avatar = &s
+// SetAvatar sets the "avatar" field.
+func (m *UserMutation) SetAvatar(s string) {
+	m.avatar = &s
+}
+
+// Avatar returns the value of the "avatar" field in the mutation.
+func (m *UserMutation) Avatar() (r string, exists bool) {
+	v := m.avatar
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAvatar returns the old "avatar" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldAvatar(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvatar is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvatar requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvatar: %w", err)
+	}
+	return oldValue.Avatar, nil
+}
+
+// ClearAvatar clears the value of the "avatar" field.
+func (m *UserMutation) ClearAvatar() {
+	m.avatar = nil
+	m.clearedFields[user.FieldAvatar] = struct{}{}
+}
+
+// AvatarCleared returns if the "avatar" field was cleared in this mutation.
+func (m *UserMutation) AvatarCleared() bool {
+	_, ok := m.clearedFields[user.FieldAvatar]
+	return ok
+}
+
+// ResetAvatar resets all changes to the "avatar" field.
+func (m *UserMutation) ResetAvatar() {
+	m.avatar = nil
+	delete(m.clearedFields, user.FieldAvatar)
+}
+
+// SetSignature sets the "signature" field.
+func (m *UserMutation) SetSignature(s string) {
+	m.signature = &s
+}
+
+// Signature returns the value of the "signature" field in the mutation.
+func (m *UserMutation) Signature() (r string, exists bool) {
+	v := m.signature
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSignature returns the old "signature" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldSignature(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSignature is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSignature requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSignature: %w", err)
+	}
+	return oldValue.Signature, nil
+}
+
+// ClearSignature clears the value of the "signature" field.
+func (m *UserMutation) ClearSignature() {
+	m.signature = nil
+	m.clearedFields[user.FieldSignature] = struct{}{}
+}
+
+// SignatureCleared returns if the "signature" field was cleared in this mutation.
+func (m *UserMutation) SignatureCleared() bool {
+	_, ok := m.clearedFields[user.FieldSignature]
+	return ok
+}
+
+// ResetSignature resets all changes to the "signature" field.
+func (m *UserMutation) ResetSignature() {
+	m.signature = nil
+	delete(m.clearedFields, user.FieldSignature)
+}
+
+// SetRegion sets the "region" field.
+func (m *UserMutation) SetRegion(s string) {
+	m.region = &s
+}
+
+// Region returns the value of the "region" field in the mutation.
+func (m *UserMutation) Region() (r string, exists bool) {
+	v := m.region
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRegion returns the old "region" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldRegion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRegion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRegion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRegion: %w", err)
+	}
+	return oldValue.Region, nil
+}
+
+// ClearRegion clears the value of the "region" field.
+func (m *UserMutation) ClearRegion() {
+	m.region = nil
+	m.clearedFields[user.FieldRegion] = struct{}{}
+}
+
+// RegionCleared returns if the "region" field was cleared in this mutation.
+func (m *UserMutation) RegionCleared() bool {
+	_, ok := m.clearedFields[user.FieldRegion]
+	return ok
+}
+
+// ResetRegion resets all changes to the "region" field.
+func (m *UserMutation) ResetRegion() {
+	m.region = nil
+	delete(m.clearedFields, user.FieldRegion)
+}
+
+// SetBirthday sets the "birthday" field.
+func (m *UserMutation) SetBirthday(t time.Time) {
+	m.birthday = &t
+}
+
+// Birthday returns the value of the "birthday" field in the mutation.
+func (m *UserMutation) Birthday() (r time.Time, exists bool) {
+	v := m.birthday
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBirthday returns the old "birthday" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldBirthday(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBirthday is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBirthday requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBirthday: %w", err)
+	}
+	return oldValue.Birthday, nil
+}
+
+// ClearBirthday clears the value of the "birthday" field.
+func (m *UserMutation) ClearBirthday() {
+	m.birthday = nil
+	m.clearedFields[user.FieldBirthday] = struct{}{}
+}
+
+// BirthdayCleared returns if the "birthday" field was cleared in this mutation.
+func (m *UserMutation) BirthdayCleared() bool {
+	_, ok := m.clearedFields[user.FieldBirthday]
+	return ok
+}
+
+// ResetBirthday resets all changes to the "birthday" field.
+func (m *UserMutation) ResetBirthday() {
+	m.birthday = nil
+	delete(m.clearedFields, user.FieldBirthday)
+}
+
+// SetLastSeen sets the "lastSeen" field.
+func (m *UserMutation) SetLastSeen(t time.Time) {
+	m.lastSeen = &t
+}
+
+// LastSeen returns the value of the "lastSeen" field in the mutation.
+func (m *UserMutation) LastSeen() (r time.Time, exists bool) {
+	v := m.lastSeen
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastSeen returns the old "lastSeen" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldLastSeen(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSeen is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSeen requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSeen: %w", err)
+	}
+	return oldValue.LastSeen, nil
+}
+
+// ClearLastSeen clears the value of the "lastSeen" field.
+func (m *UserMutation) ClearLastSeen() {
+	m.lastSeen = nil
+	m.clearedFields[user.FieldLastSeen] = struct{}{}
+}
+
+// LastSeenCleared returns if the "lastSeen" field was cleared in this mutation.
+func (m *UserMutation) LastSeenCleared() bool {
+	_, ok := m.clearedFields[user.FieldLastSeen]
+	return ok
+}
+
+// ResetLastSeen resets all changes to the "lastSeen" field.
+func (m *UserMutation) ResetLastSeen() {
+	m.lastSeen = nil
+	delete(m.clearedFields, user.FieldLastSeen)
+}
+
+// SetStatus sets the "status" field.
+func (m *UserMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *UserMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ClearStatus clears the value of the "status" field.
+func (m *UserMutation) ClearStatus() {
+	m.status = nil
+	m.clearedFields[user.FieldStatus] = struct{}{}
+}
+
+// StatusCleared returns if the "status" field was cleared in this mutation.
+func (m *UserMutation) StatusCleared() bool {
+	_, ok := m.clearedFields[user.FieldStatus]
+	return ok
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *UserMutation) ResetStatus() {
+	m.status = nil
+	delete(m.clearedFields, user.FieldStatus)
+}
+
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *UserMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *UserMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.username != nil {
+		fields = append(fields, user.FieldUsername)
+	}
+	if m.password != nil {
+		fields = append(fields, user.FieldPassword)
+	}
+	if m.nickname != nil {
+		fields = append(fields, user.FieldNickname)
+	}
+	if m.sex != nil {
+		fields = append(fields, user.FieldSex)
+	}
+	if m.avatar != nil {
+		fields = append(fields, user.FieldAvatar)
+	}
+	if m.signature != nil {
+		fields = append(fields, user.FieldSignature)
+	}
+	if m.region != nil {
+		fields = append(fields, user.FieldRegion)
+	}
+	if m.birthday != nil {
+		fields = append(fields, user.FieldBirthday)
+	}
+	if m.lastSeen != nil {
+		fields = append(fields, user.FieldLastSeen)
+	}
+	if m.status != nil {
+		fields = append(fields, user.FieldStatus)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldUsername:
+		return m.Username()
+	case user.FieldPassword:
+		return m.Password()
+	case user.FieldNickname:
+		return m.Nickname()
+	case user.FieldSex:
+		return m.Sex()
+	case user.FieldAvatar:
+		return m.Avatar()
+	case user.FieldSignature:
+		return m.Signature()
+	case user.FieldRegion:
+		return m.Region()
+	case user.FieldBirthday:
+		return m.Birthday()
+	case user.FieldLastSeen:
+		return m.LastSeen()
+	case user.FieldStatus:
+		return m.Status()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case user.FieldUsername:
+		return m.OldUsername(ctx)
+	case user.FieldPassword:
+		return m.OldPassword(ctx)
+	case user.FieldNickname:
+		return m.OldNickname(ctx)
+	case user.FieldSex:
+		return m.OldSex(ctx)
+	case user.FieldAvatar:
+		return m.OldAvatar(ctx)
+	case user.FieldSignature:
+		return m.OldSignature(ctx)
+	case user.FieldRegion:
+		return m.OldRegion(ctx)
+	case user.FieldBirthday:
+		return m.OldBirthday(ctx)
+	case user.FieldLastSeen:
+		return m.OldLastSeen(ctx)
+	case user.FieldStatus:
+		return m.OldStatus(ctx)
+	}
+	return nil, fmt.Errorf("unknown User field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsername(v)
+		return nil
+	case user.FieldPassword:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPassword(v)
+		return nil
+	case user.FieldNickname:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNickname(v)
+		return nil
+	case user.FieldSex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSex(v)
+		return nil
+	case user.FieldAvatar:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvatar(v)
+		return nil
+	case user.FieldSignature:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSignature(v)
+		return nil
+	case user.FieldRegion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRegion(v)
+		return nil
+	case user.FieldBirthday:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBirthday(v)
+		return nil
+	case user.FieldLastSeen:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastSeen(v)
+		return nil
+	case user.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UserMutation) AddedFields() []string {
+	var fields []string
+	if m.addsex != nil {
+		fields = append(fields, user.FieldSex)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldSex:
+		return m.AddedSex()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldSex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSex(v)
+		return nil
+	}
+	return fmt.Errorf("unknown User numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UserMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(user.FieldSex) {
+		fields = append(fields, user.FieldSex)
+	}
+	if m.FieldCleared(user.FieldAvatar) {
+		fields = append(fields, user.FieldAvatar)
+	}
+	if m.FieldCleared(user.FieldSignature) {
+		fields = append(fields, user.FieldSignature)
+	}
+	if m.FieldCleared(user.FieldRegion) {
+		fields = append(fields, user.FieldRegion)
+	}
+	if m.FieldCleared(user.FieldBirthday) {
+		fields = append(fields, user.FieldBirthday)
+	}
+	if m.FieldCleared(user.FieldLastSeen) {
+		fields = append(fields, user.FieldLastSeen)
+	}
+	if m.FieldCleared(user.FieldStatus) {
+		fields = append(fields, user.FieldStatus)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UserMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// Avatar returns the value of the "avatar" field in the mutation.
-func (m *UserMutation) Avatar() (r string, exists bool) {
-	v := m.avatar
-	if v == nil {
-		return
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UserMutation) ClearField(name string) error {
+	switch name {
+	case user.FieldSex:
+		m.ClearSex()
+		return nil
+	case user.FieldAvatar:
+		m.ClearAvatar()
+		return nil
+	case user.FieldSignature:
+		m.ClearSignature()
+		return nil
+	case user.FieldRegion:
+		m.ClearRegion()
+		return nil
+	case user.FieldBirthday:
+		m.ClearBirthday()
+		return nil
+	case user.FieldLastSeen:
+		m.ClearLastSeen()
+		return nil
+	case user.FieldStatus:
+		m.ClearStatus()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown User nullable field %s", name)
 }
 
-// OldAvatar returns the old "avatar" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldAvatar(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAvatar is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAvatar requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAvatar: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UserMutation) ResetField(name string) error {
+	switch name {
+	case user.FieldUsername:
+		m.ResetUsername()
+		return nil
+	case user.FieldPassword:
+		m.ResetPassword()
+		return nil
+	case user.FieldNickname:
+		m.ResetNickname()
+		return nil
+	case user.FieldSex:
+		m.ResetSex()
+		return nil
+	case user.FieldAvatar:
+		m.ResetAvatar()
+		return nil
+	case user.FieldSignature:
+		m.ResetSignature()
+		return nil
+	case user.FieldRegion:
+		m.ResetRegion()
+		return nil
+	case user.FieldBirthday:
+		m.ResetBirthday()
+		return nil
+	case user.FieldLastSeen:
+		m.ResetLastSeen()
+		return nil
+	case user.FieldStatus:
+		m.ResetStatus()
+		return nil
 	}
-	return oldValue.Avatar, nil
+	return fmt.Errorf("unknown User field %s", name)
 }
 
-// ClearAvatar clears the value of the "avatar" field.
-func (m *UserMutation) ClearAvatar() {
-	m.avatar = nil
-	m.clearedFields[user.FieldAvatar] = struct{}{}
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// AvatarCleared returns if the "avatar" field was cleared in this mutation.
-func (m *UserMutation) AvatarCleared() bool {
-	_, ok := m.clearedFields[user.FieldAvatar]
-	return ok
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ResetAvatar resets all changes to the "avatar" field.
-func (m *UserMutation) ResetAvatar() {
-	m.avatar = nil
-	delete(m.clearedFields, user.FieldAvatar)
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// SetSignature sets the "signature" field.
-func (m *UserMutation) SetSignature(s string) {
-	m.signature = &s
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// Signature returns the value of the "signature" field in the mutation.
-func (m *UserMutation) Signature() (r string, exists bool) {
-	v := m.signature
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// OldSignature returns the old "signature" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldSignature(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSignature is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSignature requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSignature: %w", err)
-	}
-	return oldValue.Signature, nil
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UserMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// ClearSignature clears the value of the "signature" field.
-func (m *UserMutation) ClearSignature() {
-	m.signature = nil
-	m.clearedFields[user.FieldSignature] = struct{}{}
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UserMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown User unique edge %s", name)
 }
 
-// SignatureCleared returns if the "signature" field was cleared in this mutation.
-func (m *UserMutation) SignatureCleared() bool {
-	_, ok := m.clearedFields[user.FieldSignature]
-	return ok
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UserMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown User edge %s", name)
 }
 
-// ResetSignature resets all changes to the "signature" field.
-func (m *UserMutation) ResetSignature() {
-	m.signature = nil
-	delete(m.clearedFields, user.FieldSignature)
+// VideoMessageMutation represents an operation that mutates the VideoMessage nodes in the graph.
+type VideoMessageMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	msgId         *string
+	videoUrl      *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*VideoMessage, error)
+	predicates    []predicate.VideoMessage
 }
 
-// SetRegion sets the "region" field.
-func (m *UserMutation) SetRegion(s string) {
-	m.region = &s
-}
+var _ ent.Mutation = (*VideoMessageMutation)(nil)
 
-// Region returns the value of the "region" field in the mutation.
-func (m *UserMutation) Region() (r string, exists bool) {
-	v := m.region
-	if v == nil {
-		return
-	}
-	return *v, true
-}
+// videomessageOption allows management of the mutation configuration using functional options.
+type videomessageOption func(*VideoMessageMutation)
 
-// OldRegion returns the old "region" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldRegion(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRegion is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRegion requires an ID field in the mutation")
+// newVideoMessageMutation creates new mutation for the VideoMessage entity.
+func newVideoMessageMutation(c config, op Op, opts ...videomessageOption) *VideoMessageMutation {
+	m := &VideoMessageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeVideoMessage,
+		clearedFields: make(map[string]struct{}),
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRegion: %w", err)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return oldValue.Region, nil
-}
-
-// ClearRegion clears the value of the "region" field.
-func (m *UserMutation) ClearRegion() {
-	m.region = nil
-	m.clearedFields[user.FieldRegion] = struct{}{}
+	return m
 }
 
-// RegionCleared returns if the "region" field was cleared in this mutation.
-func (m *UserMutation) RegionCleared() bool {
-	_, ok := m.clearedFields[user.FieldRegion]
-	return ok
+// withVideoMessageID sets the ID field of the mutation.
+func withVideoMessageID(id int) videomessageOption {
+	return func(m *VideoMessageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *VideoMessage
+		)
+		m.oldValue = func(ctx context.Context) (*VideoMessage, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().VideoMessage.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// ResetRegion resets all changes to the "region" field.
-func (m *UserMutation) ResetRegion() {
-	m.region = nil
-	delete(m.clearedFields, user.FieldRegion)
+// withVideoMessage sets the old VideoMessage of the mutation.
+func withVideoMessage(node *VideoMessage) videomessageOption {
+	return func(m *VideoMessageMutation) {
+		m.oldValue = func(context.Context) (*VideoMessage, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// SetBirthday sets the "birthday" field.
-func (m *UserMutation) SetBirthday(t time.Time) {
-	m.birthday = &t
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m VideoMessageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// Birthday returns the value of the "birthday" field in the mutation.
-func (m *UserMutation) Birthday() (r time.Time, exists bool) {
-	v := m.birthday
-	if v == nil {
-		return
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m VideoMessageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return *v, true
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// OldBirthday returns the old "birthday" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldBirthday(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBirthday is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBirthday requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBirthday: %w", err)
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *VideoMessageMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return oldValue.Birthday, nil
-}
-
-// ClearBirthday clears the value of the "birthday" field.
-func (m *UserMutation) ClearBirthday() {
-	m.birthday = nil
-	m.clearedFields[user.FieldBirthday] = struct{}{}
-}
-
-// BirthdayCleared returns if the "birthday" field was cleared in this mutation.
-func (m *UserMutation) BirthdayCleared() bool {
-	_, ok := m.clearedFields[user.FieldBirthday]
-	return ok
+	return *m.id, true
 }
 
-// ResetBirthday resets all changes to the "birthday" field.
-func (m *UserMutation) ResetBirthday() {
-	m.birthday = nil
-	delete(m.clearedFields, user.FieldBirthday)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *VideoMessageMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().VideoMessage.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetLastSeen sets the "lastSeen" field.
-func (m *UserMutation) SetLastSeen(t time.Time) {
-	m.lastSeen = &t
+// SetMsgId sets the "msgId" field.
+func (m *VideoMessageMutation) SetMsgId(s string) {
+	m.msgId = &s
 }
 
-// LastSeen returns the value of the "lastSeen" field in the mutation.
-func (m *UserMutation) LastSeen() (r time.Time, exists bool) {
-	v := m.lastSeen
+// MsgId returns the value of the "msgId" field in the mutation.
+func (m *VideoMessageMutation) MsgId() (r string, exists bool) {
+	v := m.msgId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastSeen returns the old "lastSeen" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldMsgId returns the old "msgId" field's value of the VideoMessage entity.
+// If the VideoMessage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldLastSeen(ctx context.Context) (v *time.Time, err error) {
+func (m *VideoMessageMutation) OldMsgId(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastSeen is only allowed on UpdateOne operations")
+		return v, errors.New("OldMsgId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastSeen requires an ID field in the mutation")
+		return v, errors.New("OldMsgId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastSeen: %w", err)
+		return v, fmt.Errorf("querying old value for OldMsgId: %w", err)
 	}
-	return oldValue.LastSeen, nil
-}
-
-// ClearLastSeen clears the value of the "lastSeen" field.
-func (m *UserMutation) ClearLastSeen() {
-	m.lastSeen = nil
-	m.clearedFields[user.FieldLastSeen] = struct{}{}
-}
-
-// LastSeenCleared returns if the "lastSeen" field was cleared in this mutation.
-func (m *UserMutation) LastSeenCleared() bool {
-	_, ok := m.clearedFields[user.FieldLastSeen]
-	return ok
+	return oldValue.MsgId, nil
 }
 
-// ResetLastSeen resets all changes to the "lastSeen" field.
-func (m *UserMutation) ResetLastSeen() {
-	m.lastSeen = nil
-	delete(m.clearedFields, user.FieldLastSeen)
+// ResetMsgId resets all changes to the "msgId" field.
+func (m *VideoMessageMutation) ResetMsgId() {
+	m.msgId = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *UserMutation) SetStatus(s string) {
-	m.status = &s
+// SetVideoUrl sets the "videoUrl" field.
+func (m *VideoMessageMutation) SetVideoUrl(s string) {
+	m.videoUrl = &s
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *UserMutation) Status() (r string, exists bool) {
-	v := m.status
+// VideoUrl returns the value of the "videoUrl" field in the mutation.
+func (m *VideoMessageMutation) VideoUrl() (r string, exists bool) {
+	v := m.videoUrl
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldVideoUrl returns the old "videoUrl" field's value of the VideoMessage entity.
+// If the VideoMessage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldStatus(ctx context.Context) (v string, err error) {
+func (m *VideoMessageMutation) OldVideoUrl(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldVideoUrl is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldVideoUrl requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldVideoUrl: %w", err)
 	}
-	return oldValue.Status, nil
-}
-
-// ClearStatus clears the value of the "status" field.
-func (m *UserMutation) ClearStatus() {
-	m.status = nil
-	m.clearedFields[user.FieldStatus] = struct{}{}
-}
-
-// StatusCleared returns if the "status" field was cleared in this mutation.
-func (m *UserMutation) StatusCleared() bool {
-	_, ok := m.clearedFields[user.FieldStatus]
-	return ok
+	return oldValue.VideoUrl, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *UserMutation) ResetStatus() {
-	m.status = nil
-	delete(m.clearedFields, user.FieldStatus)
+// ResetVideoUrl resets all changes to the "videoUrl" field.
+func (m *VideoMessageMutation) ResetVideoUrl() {
+	m.videoUrl = nil
 }
 
-// Where appends a list predicates to the UserMutation builder.
-func (m *UserMutation) Where(ps ...predicate.User) {
+// Where appends a list predicates to the VideoMessageMutation builder.
+func (m *VideoMessageMutation) Where(ps ...predicate.VideoMessage) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// WhereP appends storage-level predicates to the VideoMessageMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.User, len(ps))
+func (m *VideoMessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.VideoMessage, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7041,54 +21256,30 @@ func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserMutation) Op() Op {
+func (m *VideoMessageMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserMutation) SetOp(op Op) {
+func (m *VideoMessageMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
+// Type returns the node type of this mutation (VideoMessage).
+func (m *VideoMessageMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 10)
-	if m.username != nil {
-		fields = append(fields, user.FieldUsername)
-	}
-	if m.password != nil {
-		fields = append(fields, user.FieldPassword)
-	}
-	if m.nickname != nil {
-		fields = append(fields, user.FieldNickname)
-	}
-	if m.sex != nil {
-		fields = append(fields, user.FieldSex)
-	}
-	if m.avatar != nil {
-		fields = append(fields, user.FieldAvatar)
-	}
-	if m.signature != nil {
-		fields = append(fields, user.FieldSignature)
-	}
-	if m.region != nil {
-		fields = append(fields, user.FieldRegion)
-	}
-	if m.birthday != nil {
-		fields = append(fields, user.FieldBirthday)
-	}
-	if m.lastSeen != nil {
-		fields = append(fields, user.FieldLastSeen)
+func (m *VideoMessageMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.msgId != nil {
+		fields = append(fields, videomessage.FieldMsgId)
 	}
-	if m.status != nil {
-		fields = append(fields, user.FieldStatus)
+	if m.videoUrl != nil {
+		fields = append(fields, videomessage.FieldVideoUrl)
 	}
 	return fields
 }
@@ -7096,28 +21287,12 @@ func (m *UserMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case user.FieldUsername:
-		return m.Username()
-	case user.FieldPassword:
-		return m.Password()
-	case user.FieldNickname:
-		return m.Nickname()
-	case user.FieldSex:
-		return m.Sex()
-	case user.FieldAvatar:
-		return m.Avatar()
-	case user.FieldSignature:
-		return m.Signature()
-	case user.FieldRegion:
-		return m.Region()
-	case user.FieldBirthday:
-		return m.Birthday()
-	case user.FieldLastSeen:
-		return m.LastSeen()
-	case user.FieldStatus:
-		return m.Status()
+func (m *VideoMessageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case videomessage.FieldMsgId:
+		return m.MsgId()
+	case videomessage.FieldVideoUrl:
+		return m.VideoUrl()
 	}
 	return nil, false
 }
@@ -7125,323 +21300,174 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *VideoMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case user.FieldUsername:
-		return m.OldUsername(ctx)
-	case user.FieldPassword:
-		return m.OldPassword(ctx)
-	case user.FieldNickname:
-		return m.OldNickname(ctx)
-	case user.FieldSex:
-		return m.OldSex(ctx)
-	case user.FieldAvatar:
-		return m.OldAvatar(ctx)
-	case user.FieldSignature:
-		return m.OldSignature(ctx)
-	case user.FieldRegion:
-		return m.OldRegion(ctx)
-	case user.FieldBirthday:
-		return m.OldBirthday(ctx)
-	case user.FieldLastSeen:
-		return m.OldLastSeen(ctx)
-	case user.FieldStatus:
-		return m.OldStatus(ctx)
+	case videomessage.FieldMsgId:
+		return m.OldMsgId(ctx)
+	case videomessage.FieldVideoUrl:
+		return m.OldVideoUrl(ctx)
 	}
-	return nil, fmt.Errorf("unknown User field %s", name)
+	return nil, fmt.Errorf("unknown VideoMessage field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
+func (m *VideoMessageMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldUsername:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUsername(v)
-		return nil
-	case user.FieldPassword:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPassword(v)
-		return nil
-	case user.FieldNickname:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetNickname(v)
-		return nil
-	case user.FieldSex:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSex(v)
-		return nil
-	case user.FieldAvatar:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAvatar(v)
-		return nil
-	case user.FieldSignature:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSignature(v)
-		return nil
-	case user.FieldRegion:
+	case videomessage.FieldMsgId:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRegion(v)
-		return nil
-	case user.FieldBirthday:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBirthday(v)
-		return nil
-	case user.FieldLastSeen:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastSeen(v)
+		m.SetMsgId(v)
 		return nil
-	case user.FieldStatus:
+	case videomessage.FieldVideoUrl:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetVideoUrl(v)
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown VideoMessage field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserMutation) AddedFields() []string {
-	var fields []string
-	if m.addsex != nil {
-		fields = append(fields, user.FieldSex)
-	}
-	return fields
+func (m *VideoMessageMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case user.FieldSex:
-		return m.AddedSex()
-	}
+func (m *VideoMessageMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
+func (m *VideoMessageMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldSex:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSex(v)
-		return nil
 	}
-	return fmt.Errorf("unknown User numeric field %s", name)
+	return fmt.Errorf("unknown VideoMessage numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(user.FieldSex) {
-		fields = append(fields, user.FieldSex)
-	}
-	if m.FieldCleared(user.FieldAvatar) {
-		fields = append(fields, user.FieldAvatar)
-	}
-	if m.FieldCleared(user.FieldSignature) {
-		fields = append(fields, user.FieldSignature)
-	}
-	if m.FieldCleared(user.FieldRegion) {
-		fields = append(fields, user.FieldRegion)
-	}
-	if m.FieldCleared(user.FieldBirthday) {
-		fields = append(fields, user.FieldBirthday)
-	}
-	if m.FieldCleared(user.FieldLastSeen) {
-		fields = append(fields, user.FieldLastSeen)
-	}
-	if m.FieldCleared(user.FieldStatus) {
-		fields = append(fields, user.FieldStatus)
-	}
-	return fields
+func (m *VideoMessageMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
+func (m *VideoMessageMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
-	switch name {
-	case user.FieldSex:
-		m.ClearSex()
-		return nil
-	case user.FieldAvatar:
-		m.ClearAvatar()
-		return nil
-	case user.FieldSignature:
-		m.ClearSignature()
-		return nil
-	case user.FieldRegion:
-		m.ClearRegion()
-		return nil
-	case user.FieldBirthday:
-		m.ClearBirthday()
-		return nil
-	case user.FieldLastSeen:
-		m.ClearLastSeen()
-		return nil
-	case user.FieldStatus:
-		m.ClearStatus()
-		return nil
-	}
-	return fmt.Errorf("unknown User nullable field %s", name)
+func (m *VideoMessageMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown VideoMessage nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
+func (m *VideoMessageMutation) ResetField(name string) error {
 	switch name {
-	case user.FieldUsername:
-		m.ResetUsername()
-		return nil
-	case user.FieldPassword:
-		m.ResetPassword()
-		return nil
-	case user.FieldNickname:
-		m.ResetNickname()
-		return nil
-	case user.FieldSex:
-		m.ResetSex()
-		return nil
-	case user.FieldAvatar:
-		m.ResetAvatar()
-		return nil
-	case user.FieldSignature:
-		m.ResetSignature()
-		return nil
-	case user.FieldRegion:
-		m.ResetRegion()
-		return nil
-	case user.FieldBirthday:
-		m.ResetBirthday()
-		return nil
-	case user.FieldLastSeen:
-		m.ResetLastSeen()
+	case videomessage.FieldMsgId:
+		m.ResetMsgId()
 		return nil
-	case user.FieldStatus:
-		m.ResetStatus()
+	case videomessage.FieldVideoUrl:
+		m.ResetVideoUrl()
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown VideoMessage field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserMutation) AddedEdges() []string {
+func (m *VideoMessageMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
+func (m *VideoMessageMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserMutation) RemovedEdges() []string {
+func (m *VideoMessageMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+func (m *VideoMessageMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserMutation) ClearedEdges() []string {
+func (m *VideoMessageMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserMutation) EdgeCleared(name string) bool {
+func (m *VideoMessageMutation) EdgeCleared(name string) bool {
 	return false
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *UserMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown User unique edge %s", name)
-}
-
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *UserMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown User edge %s", name)
-}
-
-// VideoMessageMutation represents an operation that mutates the VideoMessage nodes in the graph.
-type VideoMessageMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *int
-	msgId         *string
-	videoUrl      *string
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*VideoMessage, error)
-	predicates    []predicate.VideoMessage
-}
-
-var _ ent.Mutation = (*VideoMessageMutation)(nil)
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *VideoMessageMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown VideoMessage unique edge %s", name)
+}
 
-// videomessageOption allows management of the mutation configuration using functional options.
-type videomessageOption func(*VideoMessageMutation)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *VideoMessageMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown VideoMessage edge %s", name)
+}
 
-// newVideoMessageMutation creates new mutation for the VideoMessage entity.
-func newVideoMessageMutation(c config, op Op, opts ...videomessageOption) *VideoMessageMutation {
-	m := &VideoMessageMutation{
+// VoiceMessageMutation represents an operation that mutates the VoiceMessage nodes in the graph.
+type VoiceMessageMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	msgId          *string
+	storageKey     *string
+	mimeType       *string
+	fileSize       *int64
+	addfileSize    *int64
+	duration       *int
+	addduration    *int
+	waveform       *[]int
+	appendwaveform []int
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*VoiceMessage, error)
+	predicates     []predicate.VoiceMessage
+}
+
+var _ ent.Mutation = (*VoiceMessageMutation)(nil)
+
+// voicemessageOption allows management of the mutation configuration using functional options.
+type voicemessageOption func(*VoiceMessageMutation)
+
+// newVoiceMessageMutation creates new mutation for the VoiceMessage entity.
+func newVoiceMessageMutation(c config, op Op, opts ...voicemessageOption) *VoiceMessageMutation {
+	m := &VoiceMessageMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeVideoMessage,
+		typ:           TypeVoiceMessage,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -7450,20 +21476,20 @@ func newVideoMessageMutation(c config, op Op, opts ...videomessageOption) *Video
 	return m
 }
 
-// withVideoMessageID sets the ID field of the mutation.
-func withVideoMessageID(id int) videomessageOption {
-	return func(m *VideoMessageMutation) {
+// withVoiceMessageID sets the ID field of the mutation.
+func withVoiceMessageID(id int) voicemessageOption {
+	return func(m *VoiceMessageMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *VideoMessage
+			value *VoiceMessage
 		)
-		m.oldValue = func(ctx context.Context) (*VideoMessage, error) {
+		m.oldValue = func(ctx context.Context) (*VoiceMessage, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().VideoMessage.Get(ctx, id)
+					value, err = m.Client().VoiceMessage.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -7472,10 +21498,10 @@ func withVideoMessageID(id int) videomessageOption {
 	}
 }
 
-// withVideoMessage sets the old VideoMessage of the mutation.
-func withVideoMessage(node *VideoMessage) videomessageOption {
-	return func(m *VideoMessageMutation) {
-		m.oldValue = func(context.Context) (*VideoMessage, error) {
+// withVoiceMessage sets the old VoiceMessage of the mutation.
+func withVoiceMessage(node *VoiceMessage) voicemessageOption {
+	return func(m *VoiceMessageMutation) {
+		m.oldValue = func(context.Context) (*VoiceMessage, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -7484,7 +21510,7 @@ func withVideoMessage(node *VideoMessage) videomessageOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m VideoMessageMutation) Client() *Client {
+func (m VoiceMessageMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -7492,7 +21518,7 @@ func (m VideoMessageMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m VideoMessageMutation) Tx() (*Tx, error) {
+func (m VoiceMessageMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -7503,7 +21529,7 @@ func (m VideoMessageMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *VideoMessageMutation) ID() (id int, exists bool) {
+func (m *VoiceMessageMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -7514,7 +21540,7 @@ func (m *VideoMessageMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *VideoMessageMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *VoiceMessageMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -7523,19 +21549,19 @@ func (m *VideoMessageMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().VideoMessage.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().VoiceMessage.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetMsgId sets the "msgId" field.
-func (m *VideoMessageMutation) SetMsgId(s string) {
+func (m *VoiceMessageMutation) SetMsgId(s string) {
 	m.msgId = &s
 }
 
 // MsgId returns the value of the "msgId" field in the mutation.
-func (m *VideoMessageMutation) MsgId() (r string, exists bool) {
+func (m *VoiceMessageMutation) MsgId() (r string, exists bool) {
 	v := m.msgId
 	if v == nil {
 		return
@@ -7543,10 +21569,10 @@ func (m *VideoMessageMutation) MsgId() (r string, exists bool) {
 	return *v, true
 }
 
-// OldMsgId returns the old "msgId" field's value of the VideoMessage entity.
-// If the VideoMessage object wasn't provided to the builder, the object is fetched from the database.
+// OldMsgId returns the old "msgId" field's value of the VoiceMessage entity.
+// If the VoiceMessage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *VideoMessageMutation) OldMsgId(ctx context.Context) (v string, err error) {
+func (m *VoiceMessageMutation) OldMsgId(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldMsgId is only allowed on UpdateOne operations")
 	}
@@ -7561,55 +21587,254 @@ func (m *VideoMessageMutation) OldMsgId(ctx context.Context) (v string, err erro
 }
 
 // ResetMsgId resets all changes to the "msgId" field.
-func (m *VideoMessageMutation) ResetMsgId() {
+func (m *VoiceMessageMutation) ResetMsgId() {
 	m.msgId = nil
 }
 
-// SetVideoUrl sets the "videoUrl" field.
-func (m *VideoMessageMutation) SetVideoUrl(s string) {
-	m.videoUrl = &s
+// SetStorageKey sets the "storageKey" field.
+func (m *VoiceMessageMutation) SetStorageKey(s string) {
+	m.storageKey = &s
 }
 
-// VideoUrl returns the value of the "videoUrl" field in the mutation.
-func (m *VideoMessageMutation) VideoUrl() (r string, exists bool) {
-	v := m.videoUrl
+// StorageKey returns the value of the "storageKey" field in the mutation.
+func (m *VoiceMessageMutation) StorageKey() (r string, exists bool) {
+	v := m.storageKey
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldVideoUrl returns the old "videoUrl" field's value of the VideoMessage entity.
-// If the VideoMessage object wasn't provided to the builder, the object is fetched from the database.
+// OldStorageKey returns the old "storageKey" field's value of the VoiceMessage entity.
+// If the VoiceMessage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *VideoMessageMutation) OldVideoUrl(ctx context.Context) (v string, err error) {
+func (m *VoiceMessageMutation) OldStorageKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVideoUrl is only allowed on UpdateOne operations")
+		return v, errors.New("OldStorageKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVideoUrl requires an ID field in the mutation")
+		return v, errors.New("OldStorageKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVideoUrl: %w", err)
+		return v, fmt.Errorf("querying old value for OldStorageKey: %w", err)
 	}
-	return oldValue.VideoUrl, nil
+	return oldValue.StorageKey, nil
 }
 
-// ResetVideoUrl resets all changes to the "videoUrl" field.
-func (m *VideoMessageMutation) ResetVideoUrl() {
-	m.videoUrl = nil
+// ResetStorageKey resets all changes to the "storageKey" field.
+func (m *VoiceMessageMutation) ResetStorageKey() {
+	m.storageKey = nil
 }
 
-// Where appends a list predicates to the VideoMessageMutation builder.
-func (m *VideoMessageMutation) Where(ps ...predicate.VideoMessage) {
+// SetMimeType sets the "mimeType" field.
+func (m *VoiceMessageMutation) SetMimeType(s string) {
+	m.mimeType = &s
+}
+
+// MimeType returns the value of the "mimeType" field in the mutation.
+func (m *VoiceMessageMutation) MimeType() (r string, exists bool) {
+	v := m.mimeType
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMimeType returns the old "mimeType" field's value of the VoiceMessage entity.
+// If the VoiceMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VoiceMessageMutation) OldMimeType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMimeType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMimeType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMimeType: %w", err)
+	}
+	return oldValue.MimeType, nil
+}
+
+// ResetMimeType resets all changes to the "mimeType" field.
+func (m *VoiceMessageMutation) ResetMimeType() {
+	m.mimeType = nil
+}
+
+// SetFileSize sets the "fileSize" field.
+func (m *VoiceMessageMutation) SetFileSize(i int64) {
+	m.fileSize = &i
+	m.addfileSize = nil
+}
+
+// FileSize returns the value of the "fileSize" field in the mutation.
+func (m *VoiceMessageMutation) FileSize() (r int64, exists bool) {
+	v := m.fileSize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFileSize returns the old "fileSize" field's value of the VoiceMessage entity.
+// If the VoiceMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VoiceMessageMutation) OldFileSize(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileSize: %w", err)
+	}
+	return oldValue.FileSize, nil
+}
+
+// AddFileSize adds i to the "fileSize" field.
+func (m *VoiceMessageMutation) AddFileSize(i int64) {
+	if m.addfileSize != nil {
+		*m.addfileSize += i
+	} else {
+		m.addfileSize = &i
+	}
+}
+
+// AddedFileSize returns the value that was added to the "fileSize" field in this mutation.
+func (m *VoiceMessageMutation) AddedFileSize() (r int64, exists bool) {
+	v := m.addfileSize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFileSize resets all changes to the "fileSize" field.
+func (m *VoiceMessageMutation) ResetFileSize() {
+	m.fileSize = nil
+	m.addfileSize = nil
+}
+
+// SetDuration sets the "duration" field.
+func (m *VoiceMessageMutation) SetDuration(i int) {
+	m.duration = &i
+	m.addduration = nil
+}
+
+// Duration returns the value of the "duration" field in the mutation.
+func (m *VoiceMessageMutation) Duration() (r int, exists bool) {
+	v := m.duration
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDuration returns the old "duration" field's value of the VoiceMessage entity.
+// If the VoiceMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VoiceMessageMutation) OldDuration(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDuration is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDuration requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDuration: %w", err)
+	}
+	return oldValue.Duration, nil
+}
+
+// AddDuration adds i to the "duration" field.
+func (m *VoiceMessageMutation) AddDuration(i int) {
+	if m.addduration != nil {
+		*m.addduration += i
+	} else {
+		m.addduration = &i
+	}
+}
+
+// AddedDuration returns the value that was added to the "duration" field in this mutation.
+func (m *VoiceMessageMutation) AddedDuration() (r int, exists bool) {
+	v := m.addduration
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDuration resets all changes to the "duration" field.
+func (m *VoiceMessageMutation) ResetDuration() {
+	m.duration = nil
+	m.addduration = nil
+}
+
+// SetWaveform sets the "waveform" field.
+func (m *VoiceMessageMutation) SetWaveform(i []int) {
+	m.waveform = &i
+	m.appendwaveform = nil
+}
+
+// Waveform returns the value of the "waveform" field in the mutation.
+func (m *VoiceMessageMutation) Waveform() (r []int, exists bool) {
+	v := m.waveform
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWaveform returns the old "waveform" field's value of the VoiceMessage entity.
+// If the VoiceMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VoiceMessageMutation) OldWaveform(ctx context.Context) (v []int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWaveform is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWaveform requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWaveform: %w", err)
+	}
+	return oldValue.Waveform, nil
+}
+
+// AppendWaveform adds i to the "waveform" field.
+func (m *VoiceMessageMutation) AppendWaveform(i []int) {
+	m.appendwaveform = append(m.appendwaveform, i...)
+}
+
+// AppendedWaveform returns the list of values that were appended to the "waveform" field in this mutation.
+func (m *VoiceMessageMutation) AppendedWaveform() ([]int, bool) {
+	if len(m.appendwaveform) == 0 {
+		return nil, false
+	}
+	return m.appendwaveform, true
+}
+
+// ResetWaveform resets all changes to the "waveform" field.
+func (m *VoiceMessageMutation) ResetWaveform() {
+	m.waveform = nil
+	m.appendwaveform = nil
+}
+
+// Where appends a list predicates to the VoiceMessageMutation builder.
+func (m *VoiceMessageMutation) Where(ps ...predicate.VoiceMessage) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the VideoMessageMutation builder. Using this method,
+// WhereP appends storage-level predicates to the VoiceMessageMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *VideoMessageMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.VideoMessage, len(ps))
+func (m *VoiceMessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.VoiceMessage, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7617,30 +21842,42 @@ func (m *VideoMessageMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *VideoMessageMutation) Op() Op {
+func (m *VoiceMessageMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *VideoMessageMutation) SetOp(op Op) {
+func (m *VoiceMessageMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (VideoMessage).
-func (m *VideoMessageMutation) Type() string {
+// Type returns the node type of this mutation (VoiceMessage).
+func (m *VoiceMessageMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *VideoMessageMutation) Fields() []string {
-	fields := make([]string, 0, 2)
+func (m *VoiceMessageMutation) Fields() []string {
+	fields := make([]string, 0, 6)
 	if m.msgId != nil {
-		fields = append(fields, videomessage.FieldMsgId)
+		fields = append(fields, voicemessage.FieldMsgId)
 	}
-	if m.videoUrl != nil {
-		fields = append(fields, videomessage.FieldVideoUrl)
+	if m.storageKey != nil {
+		fields = append(fields, voicemessage.FieldStorageKey)
+	}
+	if m.mimeType != nil {
+		fields = append(fields, voicemessage.FieldMimeType)
+	}
+	if m.fileSize != nil {
+		fields = append(fields, voicemessage.FieldFileSize)
+	}
+	if m.duration != nil {
+		fields = append(fields, voicemessage.FieldDuration)
+	}
+	if m.waveform != nil {
+		fields = append(fields, voicemessage.FieldWaveform)
 	}
 	return fields
 }
@@ -7648,12 +21885,20 @@ func (m *VideoMessageMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *VideoMessageMutation) Field(name string) (ent.Value, bool) {
+func (m *VoiceMessageMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case videomessage.FieldMsgId:
+	case voicemessage.FieldMsgId:
 		return m.MsgId()
-	case videomessage.FieldVideoUrl:
-		return m.VideoUrl()
+	case voicemessage.FieldStorageKey:
+		return m.StorageKey()
+	case voicemessage.FieldMimeType:
+		return m.MimeType()
+	case voicemessage.FieldFileSize:
+		return m.FileSize()
+	case voicemessage.FieldDuration:
+		return m.Duration()
+	case voicemessage.FieldWaveform:
+		return m.Waveform()
 	}
 	return nil, false
 }
@@ -7661,138 +21906,213 @@ func (m *VideoMessageMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *VideoMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *VoiceMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case videomessage.FieldMsgId:
+	case voicemessage.FieldMsgId:
 		return m.OldMsgId(ctx)
-	case videomessage.FieldVideoUrl:
-		return m.OldVideoUrl(ctx)
+	case voicemessage.FieldStorageKey:
+		return m.OldStorageKey(ctx)
+	case voicemessage.FieldMimeType:
+		return m.OldMimeType(ctx)
+	case voicemessage.FieldFileSize:
+		return m.OldFileSize(ctx)
+	case voicemessage.FieldDuration:
+		return m.OldDuration(ctx)
+	case voicemessage.FieldWaveform:
+		return m.OldWaveform(ctx)
 	}
-	return nil, fmt.Errorf("unknown VideoMessage field %s", name)
+	return nil, fmt.Errorf("unknown VoiceMessage field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *VideoMessageMutation) SetField(name string, value ent.Value) error {
+func (m *VoiceMessageMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case videomessage.FieldMsgId:
+	case voicemessage.FieldMsgId:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetMsgId(v)
 		return nil
-	case videomessage.FieldVideoUrl:
+	case voicemessage.FieldStorageKey:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetVideoUrl(v)
+		m.SetStorageKey(v)
+		return nil
+	case voicemessage.FieldMimeType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMimeType(v)
+		return nil
+	case voicemessage.FieldFileSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFileSize(v)
+		return nil
+	case voicemessage.FieldDuration:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDuration(v)
+		return nil
+	case voicemessage.FieldWaveform:
+		v, ok := value.([]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWaveform(v)
 		return nil
 	}
-	return fmt.Errorf("unknown VideoMessage field %s", name)
+	return fmt.Errorf("unknown VoiceMessage field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *VideoMessageMutation) AddedFields() []string {
-	return nil
+func (m *VoiceMessageMutation) AddedFields() []string {
+	var fields []string
+	if m.addfileSize != nil {
+		fields = append(fields, voicemessage.FieldFileSize)
+	}
+	if m.addduration != nil {
+		fields = append(fields, voicemessage.FieldDuration)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *VideoMessageMutation) AddedField(name string) (ent.Value, bool) {
+func (m *VoiceMessageMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case voicemessage.FieldFileSize:
+		return m.AddedFileSize()
+	case voicemessage.FieldDuration:
+		return m.AddedDuration()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *VideoMessageMutation) AddField(name string, value ent.Value) error {
+func (m *VoiceMessageMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case voicemessage.FieldFileSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFileSize(v)
+		return nil
+	case voicemessage.FieldDuration:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDuration(v)
+		return nil
 	}
-	return fmt.Errorf("unknown VideoMessage numeric field %s", name)
+	return fmt.Errorf("unknown VoiceMessage numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *VideoMessageMutation) ClearedFields() []string {
+func (m *VoiceMessageMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *VideoMessageMutation) FieldCleared(name string) bool {
+func (m *VoiceMessageMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *VideoMessageMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown VideoMessage nullable field %s", name)
+func (m *VoiceMessageMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown VoiceMessage nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *VideoMessageMutation) ResetField(name string) error {
+func (m *VoiceMessageMutation) ResetField(name string) error {
 	switch name {
-	case videomessage.FieldMsgId:
+	case voicemessage.FieldMsgId:
 		m.ResetMsgId()
 		return nil
-	case videomessage.FieldVideoUrl:
-		m.ResetVideoUrl()
+	case voicemessage.FieldStorageKey:
+		m.ResetStorageKey()
+		return nil
+	case voicemessage.FieldMimeType:
+		m.ResetMimeType()
+		return nil
+	case voicemessage.FieldFileSize:
+		m.ResetFileSize()
+		return nil
+	case voicemessage.FieldDuration:
+		m.ResetDuration()
+		return nil
+	case voicemessage.FieldWaveform:
+		m.ResetWaveform()
 		return nil
 	}
-	return fmt.Errorf("unknown VideoMessage field %s", name)
+	return fmt.Errorf("unknown VoiceMessage field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *VideoMessageMutation) AddedEdges() []string {
+func (m *VoiceMessageMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *VideoMessageMutation) AddedIDs(name string) []ent.Value {
+func (m *VoiceMessageMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *VideoMessageMutation) RemovedEdges() []string {
+func (m *VoiceMessageMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *VideoMessageMutation) RemovedIDs(name string) []ent.Value {
+func (m *VoiceMessageMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *VideoMessageMutation) ClearedEdges() []string {
+func (m *VoiceMessageMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *VideoMessageMutation) EdgeCleared(name string) bool {
+func (m *VoiceMessageMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *VideoMessageMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown VideoMessage unique edge %s", name)
+func (m *VoiceMessageMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown VoiceMessage unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *VideoMessageMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown VideoMessage edge %s", name)
+func (m *VoiceMessageMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown VoiceMessage edge %s", name)
 }
@@ -6,12 +6,33 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Block is the predicate function for block builders.
+type Block func(*sql.Selector)
+
 // ChatRecord is the predicate function for chatrecord builders.
 type ChatRecord func(*sql.Selector)
 
+// ContactCardMessage is the predicate function for contactcardmessage builders.
+type ContactCardMessage func(*sql.Selector)
+
+// ConversationClear is the predicate function for conversationclear builders.
+type ConversationClear func(*sql.Selector)
+
+// ConversationTimer is the predicate function for conversationtimer builders.
+type ConversationTimer func(*sql.Selector)
+
+// DataMigration is the predicate function for datamigration builders.
+type DataMigration func(*sql.Selector)
+
 // DoNotDisturb is the predicate function for donotdisturb builders.
 type DoNotDisturb func(*sql.Selector)
 
+// ExportJob is the predicate function for exportjob builders.
+type ExportJob func(*sql.Selector)
+
+// FileMessage is the predicate function for filemessage builders.
+type FileMessage func(*sql.Selector)
+
 // FriendRelationship is the predicate function for friendrelationship builders.
 type FriendRelationship func(*sql.Selector)
 
@@ -24,15 +45,45 @@ type Group func(*sql.Selector)
 // GroupChatRecord is the predicate function for groupchatrecord builders.
 type GroupChatRecord func(*sql.Selector)
 
+// GroupWatermark is the predicate function for groupwatermark builders.
+type GroupWatermark func(*sql.Selector)
+
 // ImageMessage is the predicate function for imagemessage builders.
 type ImageMessage func(*sql.Selector)
 
+// LocationMessage is the predicate function for locationmessage builders.
+type LocationMessage func(*sql.Selector)
+
+// MergedForwardMessage is the predicate function for mergedforwardmessage builders.
+type MergedForwardMessage func(*sql.Selector)
+
 // Message is the predicate function for message builders.
 type Message func(*sql.Selector)
 
+// MessageDeletion is the predicate function for messagedeletion builders.
+type MessageDeletion func(*sql.Selector)
+
+// MessageForward is the predicate function for messageforward builders.
+type MessageForward func(*sql.Selector)
+
+// MessageMention is the predicate function for messagemention builders.
+type MessageMention func(*sql.Selector)
+
+// MessageOutbox is the predicate function for messageoutbox builders.
+type MessageOutbox func(*sql.Selector)
+
+// MessageReaction is the predicate function for messagereaction builders.
+type MessageReaction func(*sql.Selector)
+
 // MessageStatus is the predicate function for messagestatus builders.
 type MessageStatus func(*sql.Selector)
 
+// RetentionJob is the predicate function for retentionjob builders.
+type RetentionJob func(*sql.Selector)
+
+// ScheduledMessage is the predicate function for scheduledmessage builders.
+type ScheduledMessage func(*sql.Selector)
+
 // TextMessage is the predicate function for textmessage builders.
 type TextMessage func(*sql.Selector)
 
@@ -41,3 +92,6 @@ type User func(*sql.Selector)
 
 // VideoMessage is the predicate function for videomessage builders.
 type VideoMessage func(*sql.Selector)
+
+// VoiceMessage is the predicate function for voicemessage builders.
+type VoiceMessage func(*sql.Selector)
@@ -0,0 +1,219 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"gochat_server/ent/retentionjob"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// RetentionJob is the model entity for the RetentionJob schema.
+type RetentionJob struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 状态: running 执行中, completed 已完成, failed 失败
+	Status string `json:"status,omitempty"`
+	// 已处理到的消息记录ID
+	Cursor int `json:"cursor,omitempty"`
+	// 任务开始时最大的消息记录ID，之后的消息不处理
+	MaxMessageId int `json:"maxMessageId,omitempty"`
+	// 已检查的消息数
+	Scanned int `json:"scanned,omitempty"`
+	// 已清理的消息数
+	Purged int `json:"purged,omitempty"`
+	// 已归档的消息数
+	Archived int `json:"archived,omitempty"`
+	// 已删除的媒体文件数
+	MediaDeleted int `json:"mediaDeleted,omitempty"`
+	// 失败原因
+	LastError string `json:"lastError,omitempty"`
+	// 开始时间，各会话的保留期限以此计算
+	StartTime time.Time `json:"startTime,omitempty"`
+	// 最近一次更新进度的时间
+	UpdateTime time.Time `json:"updateTime,omitempty"`
+	// 结束时间
+	FinishTime   *time.Time `json:"finishTime,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RetentionJob) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case retentionjob.FieldID, retentionjob.FieldCursor, retentionjob.FieldMaxMessageId, retentionjob.FieldScanned, retentionjob.FieldPurged, retentionjob.FieldArchived, retentionjob.FieldMediaDeleted:
+			values[i] = new(sql.NullInt64)
+		case retentionjob.FieldStatus, retentionjob.FieldLastError:
+			values[i] = new(sql.NullString)
+		case retentionjob.FieldStartTime, retentionjob.FieldUpdateTime, retentionjob.FieldFinishTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RetentionJob fields.
+func (rj *RetentionJob) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case retentionjob.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			rj.ID = int(value.Int64)
+		case retentionjob.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				rj.Status = value.String
+			}
+		case retentionjob.FieldCursor:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field cursor", values[i])
+			} else if value.Valid {
+				rj.Cursor = int(value.Int64)
+			}
+		case retentionjob.FieldMaxMessageId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field maxMessageId", values[i])
+			} else if value.Valid {
+				rj.MaxMessageId = int(value.Int64)
+			}
+		case retentionjob.FieldScanned:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field scanned", values[i])
+			} else if value.Valid {
+				rj.Scanned = int(value.Int64)
+			}
+		case retentionjob.FieldPurged:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field purged", values[i])
+			} else if value.Valid {
+				rj.Purged = int(value.Int64)
+			}
+		case retentionjob.FieldArchived:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field archived", values[i])
+			} else if value.Valid {
+				rj.Archived = int(value.Int64)
+			}
+		case retentionjob.FieldMediaDeleted:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field mediaDeleted", values[i])
+			} else if value.Valid {
+				rj.MediaDeleted = int(value.Int64)
+			}
+		case retentionjob.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field lastError", values[i])
+			} else if value.Valid {
+				rj.LastError = value.String
+			}
+		case retentionjob.FieldStartTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field startTime", values[i])
+			} else if value.Valid {
+				rj.StartTime = value.Time
+			}
+		case retentionjob.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updateTime", values[i])
+			} else if value.Valid {
+				rj.UpdateTime = value.Time
+			}
+		case retentionjob.FieldFinishTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finishTime", values[i])
+			} else if value.Valid {
+				rj.FinishTime = new(time.Time)
+				*rj.FinishTime = value.Time
+			}
+		default:
+			rj.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RetentionJob.
+// This includes values selected through modifiers, order, etc.
+func (rj *RetentionJob) Value(name string) (ent.Value, error) {
+	return rj.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RetentionJob.
+// Note that you need to call RetentionJob.Unwrap() before calling this method if this RetentionJob
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rj *RetentionJob) Update() *RetentionJobUpdateOne {
+	return NewRetentionJobClient(rj.config).UpdateOne(rj)
+}
+
+// Unwrap unwraps the RetentionJob entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rj *RetentionJob) Unwrap() *RetentionJob {
+	_tx, ok := rj.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RetentionJob is not a transactional entity")
+	}
+	rj.config.driver = _tx.drv
+	return rj
+}
+
+// String implements the fmt.Stringer.
+func (rj *RetentionJob) String() string {
+	var builder strings.Builder
+	builder.WriteString("RetentionJob(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rj.ID))
+	builder.WriteString("status=")
+	builder.WriteString(rj.Status)
+	builder.WriteString(", ")
+	builder.WriteString("cursor=")
+	builder.WriteString(fmt.Sprintf("%v", rj.Cursor))
+	builder.WriteString(", ")
+	builder.WriteString("maxMessageId=")
+	builder.WriteString(fmt.Sprintf("%v", rj.MaxMessageId))
+	builder.WriteString(", ")
+	builder.WriteString("scanned=")
+	builder.WriteString(fmt.Sprintf("%v", rj.Scanned))
+	builder.WriteString(", ")
+	builder.WriteString("purged=")
+	builder.WriteString(fmt.Sprintf("%v", rj.Purged))
+	builder.WriteString(", ")
+	builder.WriteString("archived=")
+	builder.WriteString(fmt.Sprintf("%v", rj.Archived))
+	builder.WriteString(", ")
+	builder.WriteString("mediaDeleted=")
+	builder.WriteString(fmt.Sprintf("%v", rj.MediaDeleted))
+	builder.WriteString(", ")
+	builder.WriteString("lastError=")
+	builder.WriteString(rj.LastError)
+	builder.WriteString(", ")
+	builder.WriteString("startTime=")
+	builder.WriteString(rj.StartTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updateTime=")
+	builder.WriteString(rj.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := rj.FinishTime; v != nil {
+		builder.WriteString("finishTime=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RetentionJobs is a parsable slice of RetentionJob.
+type RetentionJobs []*RetentionJob
@@ -0,0 +1,154 @@
+// Code generated by ent, DO NOT EDIT.
+
+package retentionjob
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the retentionjob type in the database.
+	Label = "retention_job"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldCursor holds the string denoting the cursor field in the database.
+	FieldCursor = "cursor"
+	// FieldMaxMessageId holds the string denoting the maxmessageid field in the database.
+	FieldMaxMessageId = "max_message_id"
+	// FieldScanned holds the string denoting the scanned field in the database.
+	FieldScanned = "scanned"
+	// FieldPurged holds the string denoting the purged field in the database.
+	FieldPurged = "purged"
+	// FieldArchived holds the string denoting the archived field in the database.
+	FieldArchived = "archived"
+	// FieldMediaDeleted holds the string denoting the mediadeleted field in the database.
+	FieldMediaDeleted = "media_deleted"
+	// FieldLastError holds the string denoting the lasterror field in the database.
+	FieldLastError = "last_error"
+	// FieldStartTime holds the string denoting the starttime field in the database.
+	FieldStartTime = "start_time"
+	// FieldUpdateTime holds the string denoting the updatetime field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldFinishTime holds the string denoting the finishtime field in the database.
+	FieldFinishTime = "finish_time"
+	// Table holds the table name of the retentionjob in the database.
+	Table = "retention_jobs"
+)
+
+// Columns holds all SQL columns for retentionjob fields.
+var Columns = []string{
+	FieldID,
+	FieldStatus,
+	FieldCursor,
+	FieldMaxMessageId,
+	FieldScanned,
+	FieldPurged,
+	FieldArchived,
+	FieldMediaDeleted,
+	FieldLastError,
+	FieldStartTime,
+	FieldUpdateTime,
+	FieldFinishTime,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultCursor holds the default value on creation for the "cursor" field.
+	DefaultCursor int
+	// DefaultMaxMessageId holds the default value on creation for the "maxMessageId" field.
+	DefaultMaxMessageId int
+	// DefaultScanned holds the default value on creation for the "scanned" field.
+	DefaultScanned int
+	// DefaultPurged holds the default value on creation for the "purged" field.
+	DefaultPurged int
+	// DefaultArchived holds the default value on creation for the "archived" field.
+	DefaultArchived int
+	// DefaultMediaDeleted holds the default value on creation for the "mediaDeleted" field.
+	DefaultMediaDeleted int
+	// DefaultLastError holds the default value on creation for the "lastError" field.
+	DefaultLastError string
+	// DefaultStartTime holds the default value on creation for the "startTime" field.
+	DefaultStartTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "updateTime" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "updateTime" field.
+	UpdateDefaultUpdateTime func() time.Time
+)
+
+// OrderOption defines the ordering options for the RetentionJob queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByCursor orders the results by the cursor field.
+func ByCursor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCursor, opts...).ToFunc()
+}
+
+// ByMaxMessageId orders the results by the maxMessageId field.
+func ByMaxMessageId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxMessageId, opts...).ToFunc()
+}
+
+// ByScanned orders the results by the scanned field.
+func ByScanned(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScanned, opts...).ToFunc()
+}
+
+// ByPurged orders the results by the purged field.
+func ByPurged(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPurged, opts...).ToFunc()
+}
+
+// ByArchived orders the results by the archived field.
+func ByArchived(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArchived, opts...).ToFunc()
+}
+
+// ByMediaDeleted orders the results by the mediaDeleted field.
+func ByMediaDeleted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMediaDeleted, opts...).ToFunc()
+}
+
+// ByLastError orders the results by the lastError field.
+func ByLastError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastError, opts...).ToFunc()
+}
+
+// ByStartTime orders the results by the startTime field.
+func ByStartTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the updateTime field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByFinishTime orders the results by the finishTime field.
+func ByFinishTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinishTime, opts...).ToFunc()
+}
@@ -0,0 +1,625 @@
+// Code generated by ent, DO NOT EDIT.
+
+package retentionjob
+
+import (
+	"gochat_server/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldLTE(FieldID, id))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldEQ(FieldStatus, v))
+}
+
+// Cursor applies equality check predicate on the "cursor" field. It's identical to CursorEQ.
+func Cursor(v int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldEQ(FieldCursor, v))
+}
+
+// MaxMessageId applies equality check predicate on the "maxMessageId" field. It's identical to MaxMessageIdEQ.
+func MaxMessageId(v int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldEQ(FieldMaxMessageId, v))
+}
+
+// Scanned applies equality check predicate on the "scanned" field. It's identical to ScannedEQ.
+func Scanned(v int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldEQ(FieldScanned, v))
+}
+
+// Purged applies equality check predicate on the "purged" field. It's identical to PurgedEQ.
+func Purged(v int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldEQ(FieldPurged, v))
+}
+
+// Archived applies equality check predicate on the "archived" field. It's identical to ArchivedEQ.
+func Archived(v int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldEQ(FieldArchived, v))
+}
+
+// MediaDeleted applies equality check predicate on the "mediaDeleted" field. It's identical to MediaDeletedEQ.
+func MediaDeleted(v int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldEQ(FieldMediaDeleted, v))
+}
+
+// LastError applies equality check predicate on the "lastError" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldEQ(FieldLastError, v))
+}
+
+// StartTime applies equality check predicate on the "startTime" field. It's identical to StartTimeEQ.
+func StartTime(v time.Time) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldEQ(FieldStartTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "updateTime" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// FinishTime applies equality check predicate on the "finishTime" field. It's identical to FinishTimeEQ.
+func FinishTime(v time.Time) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldEQ(FieldFinishTime, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CursorEQ applies the EQ predicate on the "cursor" field.
+func CursorEQ(v int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldEQ(FieldCursor, v))
+}
+
+// CursorNEQ applies the NEQ predicate on the "cursor" field.
+func CursorNEQ(v int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldNEQ(FieldCursor, v))
+}
+
+// CursorIn applies the In predicate on the "cursor" field.
+func CursorIn(vs ...int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldIn(FieldCursor, vs...))
+}
+
+// CursorNotIn applies the NotIn predicate on the "cursor" field.
+func CursorNotIn(vs ...int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldNotIn(FieldCursor, vs...))
+}
+
+// CursorGT applies the GT predicate on the "cursor" field.
+func CursorGT(v int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldGT(FieldCursor, v))
+}
+
+// CursorGTE applies the GTE predicate on the "cursor" field.
+func CursorGTE(v int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldGTE(FieldCursor, v))
+}
+
+// CursorLT applies the LT predicate on the "cursor" field.
+func CursorLT(v int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldLT(FieldCursor, v))
+}
+
+// CursorLTE applies the LTE predicate on the "cursor" field.
+func CursorLTE(v int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldLTE(FieldCursor, v))
+}
+
+// MaxMessageIdEQ applies the EQ predicate on the "maxMessageId" field.
+func MaxMessageIdEQ(v int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldEQ(FieldMaxMessageId, v))
+}
+
+// MaxMessageIdNEQ applies the NEQ predicate on the "maxMessageId" field.
+func MaxMessageIdNEQ(v int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldNEQ(FieldMaxMessageId, v))
+}
+
+// MaxMessageIdIn applies the In predicate on the "maxMessageId" field.
+func MaxMessageIdIn(vs ...int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldIn(FieldMaxMessageId, vs...))
+}
+
+// MaxMessageIdNotIn applies the NotIn predicate on the "maxMessageId" field.
+func MaxMessageIdNotIn(vs ...int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldNotIn(FieldMaxMessageId, vs...))
+}
+
+// MaxMessageIdGT applies the GT predicate on the "maxMessageId" field.
+func MaxMessageIdGT(v int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldGT(FieldMaxMessageId, v))
+}
+
+// MaxMessageIdGTE applies the GTE predicate on the "maxMessageId" field.
+func MaxMessageIdGTE(v int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldGTE(FieldMaxMessageId, v))
+}
+
+// MaxMessageIdLT applies the LT predicate on the "maxMessageId" field.
+func MaxMessageIdLT(v int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldLT(FieldMaxMessageId, v))
+}
+
+// MaxMessageIdLTE applies the LTE predicate on the "maxMessageId" field.
+func MaxMessageIdLTE(v int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldLTE(FieldMaxMessageId, v))
+}
+
+// ScannedEQ applies the EQ predicate on the "scanned" field.
+func ScannedEQ(v int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldEQ(FieldScanned, v))
+}
+
+// ScannedNEQ applies the NEQ predicate on the "scanned" field.
+func ScannedNEQ(v int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldNEQ(FieldScanned, v))
+}
+
+// ScannedIn applies the In predicate on the "scanned" field.
+func ScannedIn(vs ...int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldIn(FieldScanned, vs...))
+}
+
+// ScannedNotIn applies the NotIn predicate on the "scanned" field.
+func ScannedNotIn(vs ...int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldNotIn(FieldScanned, vs...))
+}
+
+// ScannedGT applies the GT predicate on the "scanned" field.
+func ScannedGT(v int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldGT(FieldScanned, v))
+}
+
+// ScannedGTE applies the GTE predicate on the "scanned" field.
+func ScannedGTE(v int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldGTE(FieldScanned, v))
+}
+
+// ScannedLT applies the LT predicate on the "scanned" field.
+func ScannedLT(v int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldLT(FieldScanned, v))
+}
+
+// ScannedLTE applies the LTE predicate on the "scanned" field.
+func ScannedLTE(v int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldLTE(FieldScanned, v))
+}
+
+// PurgedEQ applies the EQ predicate on the "purged" field.
+func PurgedEQ(v int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldEQ(FieldPurged, v))
+}
+
+// PurgedNEQ applies the NEQ predicate on the "purged" field.
+func PurgedNEQ(v int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldNEQ(FieldPurged, v))
+}
+
+// PurgedIn applies the In predicate on the "purged" field.
+func PurgedIn(vs ...int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldIn(FieldPurged, vs...))
+}
+
+// PurgedNotIn applies the NotIn predicate on the "purged" field.
+func PurgedNotIn(vs ...int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldNotIn(FieldPurged, vs...))
+}
+
+// PurgedGT applies the GT predicate on the "purged" field.
+func PurgedGT(v int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldGT(FieldPurged, v))
+}
+
+// PurgedGTE applies the GTE predicate on the "purged" field.
+func PurgedGTE(v int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldGTE(FieldPurged, v))
+}
+
+// PurgedLT applies the LT predicate on the "purged" field.
+func PurgedLT(v int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldLT(FieldPurged, v))
+}
+
+// PurgedLTE applies the LTE predicate on the "purged" field.
+func PurgedLTE(v int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldLTE(FieldPurged, v))
+}
+
+// ArchivedEQ applies the EQ predicate on the "archived" field.
+func ArchivedEQ(v int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldEQ(FieldArchived, v))
+}
+
+// ArchivedNEQ applies the NEQ predicate on the "archived" field.
+func ArchivedNEQ(v int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldNEQ(FieldArchived, v))
+}
+
+// ArchivedIn applies the In predicate on the "archived" field.
+func ArchivedIn(vs ...int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldIn(FieldArchived, vs...))
+}
+
+// ArchivedNotIn applies the NotIn predicate on the "archived" field.
+func ArchivedNotIn(vs ...int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldNotIn(FieldArchived, vs...))
+}
+
+// ArchivedGT applies the GT predicate on the "archived" field.
+func ArchivedGT(v int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldGT(FieldArchived, v))
+}
+
+// ArchivedGTE applies the GTE predicate on the "archived" field.
+func ArchivedGTE(v int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldGTE(FieldArchived, v))
+}
+
+// ArchivedLT applies the LT predicate on the "archived" field.
+func ArchivedLT(v int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldLT(FieldArchived, v))
+}
+
+// ArchivedLTE applies the LTE predicate on the "archived" field.
+func ArchivedLTE(v int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldLTE(FieldArchived, v))
+}
+
+// MediaDeletedEQ applies the EQ predicate on the "mediaDeleted" field.
+func MediaDeletedEQ(v int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldEQ(FieldMediaDeleted, v))
+}
+
+// MediaDeletedNEQ applies the NEQ predicate on the "mediaDeleted" field.
+func MediaDeletedNEQ(v int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldNEQ(FieldMediaDeleted, v))
+}
+
+// MediaDeletedIn applies the In predicate on the "mediaDeleted" field.
+func MediaDeletedIn(vs ...int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldIn(FieldMediaDeleted, vs...))
+}
+
+// MediaDeletedNotIn applies the NotIn predicate on the "mediaDeleted" field.
+func MediaDeletedNotIn(vs ...int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldNotIn(FieldMediaDeleted, vs...))
+}
+
+// MediaDeletedGT applies the GT predicate on the "mediaDeleted" field.
+func MediaDeletedGT(v int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldGT(FieldMediaDeleted, v))
+}
+
+// MediaDeletedGTE applies the GTE predicate on the "mediaDeleted" field.
+func MediaDeletedGTE(v int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldGTE(FieldMediaDeleted, v))
+}
+
+// MediaDeletedLT applies the LT predicate on the "mediaDeleted" field.
+func MediaDeletedLT(v int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldLT(FieldMediaDeleted, v))
+}
+
+// MediaDeletedLTE applies the LTE predicate on the "mediaDeleted" field.
+func MediaDeletedLTE(v int) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldLTE(FieldMediaDeleted, v))
+}
+
+// LastErrorEQ applies the EQ predicate on the "lastError" field.
+func LastErrorEQ(v string) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "lastError" field.
+func LastErrorNEQ(v string) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "lastError" field.
+func LastErrorIn(vs ...string) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "lastError" field.
+func LastErrorNotIn(vs ...string) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "lastError" field.
+func LastErrorGT(v string) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "lastError" field.
+func LastErrorGTE(v string) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "lastError" field.
+func LastErrorLT(v string) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "lastError" field.
+func LastErrorLTE(v string) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "lastError" field.
+func LastErrorContains(v string) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "lastError" field.
+func LastErrorHasPrefix(v string) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "lastError" field.
+func LastErrorHasSuffix(v string) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "lastError" field.
+func LastErrorEqualFold(v string) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "lastError" field.
+func LastErrorContainsFold(v string) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// StartTimeEQ applies the EQ predicate on the "startTime" field.
+func StartTimeEQ(v time.Time) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldEQ(FieldStartTime, v))
+}
+
+// StartTimeNEQ applies the NEQ predicate on the "startTime" field.
+func StartTimeNEQ(v time.Time) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldNEQ(FieldStartTime, v))
+}
+
+// StartTimeIn applies the In predicate on the "startTime" field.
+func StartTimeIn(vs ...time.Time) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldIn(FieldStartTime, vs...))
+}
+
+// StartTimeNotIn applies the NotIn predicate on the "startTime" field.
+func StartTimeNotIn(vs ...time.Time) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldNotIn(FieldStartTime, vs...))
+}
+
+// StartTimeGT applies the GT predicate on the "startTime" field.
+func StartTimeGT(v time.Time) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldGT(FieldStartTime, v))
+}
+
+// StartTimeGTE applies the GTE predicate on the "startTime" field.
+func StartTimeGTE(v time.Time) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldGTE(FieldStartTime, v))
+}
+
+// StartTimeLT applies the LT predicate on the "startTime" field.
+func StartTimeLT(v time.Time) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldLT(FieldStartTime, v))
+}
+
+// StartTimeLTE applies the LTE predicate on the "startTime" field.
+func StartTimeLTE(v time.Time) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldLTE(FieldStartTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "updateTime" field.
+func UpdateTimeEQ(v time.Time) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "updateTime" field.
+func UpdateTimeNEQ(v time.Time) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "updateTime" field.
+func UpdateTimeIn(vs ...time.Time) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "updateTime" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "updateTime" field.
+func UpdateTimeGT(v time.Time) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "updateTime" field.
+func UpdateTimeGTE(v time.Time) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "updateTime" field.
+func UpdateTimeLT(v time.Time) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "updateTime" field.
+func UpdateTimeLTE(v time.Time) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// FinishTimeEQ applies the EQ predicate on the "finishTime" field.
+func FinishTimeEQ(v time.Time) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldEQ(FieldFinishTime, v))
+}
+
+// FinishTimeNEQ applies the NEQ predicate on the "finishTime" field.
+func FinishTimeNEQ(v time.Time) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldNEQ(FieldFinishTime, v))
+}
+
+// FinishTimeIn applies the In predicate on the "finishTime" field.
+func FinishTimeIn(vs ...time.Time) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldIn(FieldFinishTime, vs...))
+}
+
+// FinishTimeNotIn applies the NotIn predicate on the "finishTime" field.
+func FinishTimeNotIn(vs ...time.Time) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldNotIn(FieldFinishTime, vs...))
+}
+
+// FinishTimeGT applies the GT predicate on the "finishTime" field.
+func FinishTimeGT(v time.Time) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldGT(FieldFinishTime, v))
+}
+
+// FinishTimeGTE applies the GTE predicate on the "finishTime" field.
+func FinishTimeGTE(v time.Time) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldGTE(FieldFinishTime, v))
+}
+
+// FinishTimeLT applies the LT predicate on the "finishTime" field.
+func FinishTimeLT(v time.Time) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldLT(FieldFinishTime, v))
+}
+
+// FinishTimeLTE applies the LTE predicate on the "finishTime" field.
+func FinishTimeLTE(v time.Time) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldLTE(FieldFinishTime, v))
+}
+
+// FinishTimeIsNil applies the IsNil predicate on the "finishTime" field.
+func FinishTimeIsNil() predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldIsNull(FieldFinishTime))
+}
+
+// FinishTimeNotNil applies the NotNil predicate on the "finishTime" field.
+func FinishTimeNotNil() predicate.RetentionJob {
+	return predicate.RetentionJob(sql.FieldNotNull(FieldFinishTime))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RetentionJob) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RetentionJob) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RetentionJob) predicate.RetentionJob {
+	return predicate.RetentionJob(sql.NotPredicates(p))
+}
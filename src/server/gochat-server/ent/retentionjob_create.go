@@ -0,0 +1,445 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gochat_server/ent/retentionjob"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RetentionJobCreate is the builder for creating a RetentionJob entity.
+type RetentionJobCreate struct {
+	config
+	mutation *RetentionJobMutation
+	hooks    []Hook
+}
+
+// SetStatus sets the "status" field.
+func (rjc *RetentionJobCreate) SetStatus(s string) *RetentionJobCreate {
+	rjc.mutation.SetStatus(s)
+	return rjc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rjc *RetentionJobCreate) SetNillableStatus(s *string) *RetentionJobCreate {
+	if s != nil {
+		rjc.SetStatus(*s)
+	}
+	return rjc
+}
+
+// SetCursor sets the "cursor" field.
+func (rjc *RetentionJobCreate) SetCursor(i int) *RetentionJobCreate {
+	rjc.mutation.SetCursor(i)
+	return rjc
+}
+
+// SetNillableCursor sets the "cursor" field if the given value is not nil.
+func (rjc *RetentionJobCreate) SetNillableCursor(i *int) *RetentionJobCreate {
+	if i != nil {
+		rjc.SetCursor(*i)
+	}
+	return rjc
+}
+
+// SetMaxMessageId sets the "maxMessageId" field.
+func (rjc *RetentionJobCreate) SetMaxMessageId(i int) *RetentionJobCreate {
+	rjc.mutation.SetMaxMessageId(i)
+	return rjc
+}
+
+// SetNillableMaxMessageId sets the "maxMessageId" field if the given value is not nil.
+func (rjc *RetentionJobCreate) SetNillableMaxMessageId(i *int) *RetentionJobCreate {
+	if i != nil {
+		rjc.SetMaxMessageId(*i)
+	}
+	return rjc
+}
+
+// SetScanned sets the "scanned" field.
+func (rjc *RetentionJobCreate) SetScanned(i int) *RetentionJobCreate {
+	rjc.mutation.SetScanned(i)
+	return rjc
+}
+
+// SetNillableScanned sets the "scanned" field if the given value is not nil.
+func (rjc *RetentionJobCreate) SetNillableScanned(i *int) *RetentionJobCreate {
+	if i != nil {
+		rjc.SetScanned(*i)
+	}
+	return rjc
+}
+
+// SetPurged sets the "purged" field.
+func (rjc *RetentionJobCreate) SetPurged(i int) *RetentionJobCreate {
+	rjc.mutation.SetPurged(i)
+	return rjc
+}
+
+// SetNillablePurged sets the "purged" field if the given value is not nil.
+func (rjc *RetentionJobCreate) SetNillablePurged(i *int) *RetentionJobCreate {
+	if i != nil {
+		rjc.SetPurged(*i)
+	}
+	return rjc
+}
+
+// SetArchived sets the "archived" field.
+func (rjc *RetentionJobCreate) SetArchived(i int) *RetentionJobCreate {
+	rjc.mutation.SetArchived(i)
+	return rjc
+}
+
+// SetNillableArchived sets the "archived" field if the given value is not nil.
+func (rjc *RetentionJobCreate) SetNillableArchived(i *int) *RetentionJobCreate {
+	if i != nil {
+		rjc.SetArchived(*i)
+	}
+	return rjc
+}
+
+// SetMediaDeleted sets the "mediaDeleted" field.
+func (rjc *RetentionJobCreate) SetMediaDeleted(i int) *RetentionJobCreate {
+	rjc.mutation.SetMediaDeleted(i)
+	return rjc
+}
+
+// SetNillableMediaDeleted sets the "mediaDeleted" field if the given value is not nil.
+func (rjc *RetentionJobCreate) SetNillableMediaDeleted(i *int) *RetentionJobCreate {
+	if i != nil {
+		rjc.SetMediaDeleted(*i)
+	}
+	return rjc
+}
+
+// SetLastError sets the "lastError" field.
+func (rjc *RetentionJobCreate) SetLastError(s string) *RetentionJobCreate {
+	rjc.mutation.SetLastError(s)
+	return rjc
+}
+
+// SetNillableLastError sets the "lastError" field if the given value is not nil.
+func (rjc *RetentionJobCreate) SetNillableLastError(s *string) *RetentionJobCreate {
+	if s != nil {
+		rjc.SetLastError(*s)
+	}
+	return rjc
+}
+
+// SetStartTime sets the "startTime" field.
+func (rjc *RetentionJobCreate) SetStartTime(t time.Time) *RetentionJobCreate {
+	rjc.mutation.SetStartTime(t)
+	return rjc
+}
+
+// SetNillableStartTime sets the "startTime" field if the given value is not nil.
+func (rjc *RetentionJobCreate) SetNillableStartTime(t *time.Time) *RetentionJobCreate {
+	if t != nil {
+		rjc.SetStartTime(*t)
+	}
+	return rjc
+}
+
+// SetUpdateTime sets the "updateTime" field.
+func (rjc *RetentionJobCreate) SetUpdateTime(t time.Time) *RetentionJobCreate {
+	rjc.mutation.SetUpdateTime(t)
+	return rjc
+}
+
+// SetNillableUpdateTime sets the "updateTime" field if the given value is not nil.
+func (rjc *RetentionJobCreate) SetNillableUpdateTime(t *time.Time) *RetentionJobCreate {
+	if t != nil {
+		rjc.SetUpdateTime(*t)
+	}
+	return rjc
+}
+
+// SetFinishTime sets the "finishTime" field.
+func (rjc *RetentionJobCreate) SetFinishTime(t time.Time) *RetentionJobCreate {
+	rjc.mutation.SetFinishTime(t)
+	return rjc
+}
+
+// SetNillableFinishTime sets the "finishTime" field if the given value is not nil.
+func (rjc *RetentionJobCreate) SetNillableFinishTime(t *time.Time) *RetentionJobCreate {
+	if t != nil {
+		rjc.SetFinishTime(*t)
+	}
+	return rjc
+}
+
+// Mutation returns the RetentionJobMutation object of the builder.
+func (rjc *RetentionJobCreate) Mutation() *RetentionJobMutation {
+	return rjc.mutation
+}
+
+// Save creates the RetentionJob in the database.
+func (rjc *RetentionJobCreate) Save(ctx context.Context) (*RetentionJob, error) {
+	rjc.defaults()
+	return withHooks(ctx, rjc.sqlSave, rjc.mutation, rjc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rjc *RetentionJobCreate) SaveX(ctx context.Context) *RetentionJob {
+	v, err := rjc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rjc *RetentionJobCreate) Exec(ctx context.Context) error {
+	_, err := rjc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rjc *RetentionJobCreate) ExecX(ctx context.Context) {
+	if err := rjc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rjc *RetentionJobCreate) defaults() {
+	if _, ok := rjc.mutation.Status(); !ok {
+		v := retentionjob.DefaultStatus
+		rjc.mutation.SetStatus(v)
+	}
+	if _, ok := rjc.mutation.Cursor(); !ok {
+		v := retentionjob.DefaultCursor
+		rjc.mutation.SetCursor(v)
+	}
+	if _, ok := rjc.mutation.MaxMessageId(); !ok {
+		v := retentionjob.DefaultMaxMessageId
+		rjc.mutation.SetMaxMessageId(v)
+	}
+	if _, ok := rjc.mutation.Scanned(); !ok {
+		v := retentionjob.DefaultScanned
+		rjc.mutation.SetScanned(v)
+	}
+	if _, ok := rjc.mutation.Purged(); !ok {
+		v := retentionjob.DefaultPurged
+		rjc.mutation.SetPurged(v)
+	}
+	if _, ok := rjc.mutation.Archived(); !ok {
+		v := retentionjob.DefaultArchived
+		rjc.mutation.SetArchived(v)
+	}
+	if _, ok := rjc.mutation.MediaDeleted(); !ok {
+		v := retentionjob.DefaultMediaDeleted
+		rjc.mutation.SetMediaDeleted(v)
+	}
+	if _, ok := rjc.mutation.LastError(); !ok {
+		v := retentionjob.DefaultLastError
+		rjc.mutation.SetLastError(v)
+	}
+	if _, ok := rjc.mutation.StartTime(); !ok {
+		v := retentionjob.DefaultStartTime()
+		rjc.mutation.SetStartTime(v)
+	}
+	if _, ok := rjc.mutation.UpdateTime(); !ok {
+		v := retentionjob.DefaultUpdateTime()
+		rjc.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rjc *RetentionJobCreate) check() error {
+	if _, ok := rjc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "RetentionJob.status"`)}
+	}
+	if _, ok := rjc.mutation.Cursor(); !ok {
+		return &ValidationError{Name: "cursor", err: errors.New(`ent: missing required field "RetentionJob.cursor"`)}
+	}
+	if _, ok := rjc.mutation.MaxMessageId(); !ok {
+		return &ValidationError{Name: "maxMessageId", err: errors.New(`ent: missing required field "RetentionJob.maxMessageId"`)}
+	}
+	if _, ok := rjc.mutation.Scanned(); !ok {
+		return &ValidationError{Name: "scanned", err: errors.New(`ent: missing required field "RetentionJob.scanned"`)}
+	}
+	if _, ok := rjc.mutation.Purged(); !ok {
+		return &ValidationError{Name: "purged", err: errors.New(`ent: missing required field "RetentionJob.purged"`)}
+	}
+	if _, ok := rjc.mutation.Archived(); !ok {
+		return &ValidationError{Name: "archived", err: errors.New(`ent: missing required field "RetentionJob.archived"`)}
+	}
+	if _, ok := rjc.mutation.MediaDeleted(); !ok {
+		return &ValidationError{Name: "mediaDeleted", err: errors.New(`ent: missing required field "RetentionJob.mediaDeleted"`)}
+	}
+	if _, ok := rjc.mutation.LastError(); !ok {
+		return &ValidationError{Name: "lastError", err: errors.New(`ent: missing required field "RetentionJob.lastError"`)}
+	}
+	if _, ok := rjc.mutation.StartTime(); !ok {
+		return &ValidationError{Name: "startTime", err: errors.New(`ent: missing required field "RetentionJob.startTime"`)}
+	}
+	if _, ok := rjc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "updateTime", err: errors.New(`ent: missing required field "RetentionJob.updateTime"`)}
+	}
+	return nil
+}
+
+func (rjc *RetentionJobCreate) sqlSave(ctx context.Context) (*RetentionJob, error) {
+	if err := rjc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rjc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rjc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	rjc.mutation.id = &_node.ID
+	rjc.mutation.done = true
+	return _node, nil
+}
+
+func (rjc *RetentionJobCreate) createSpec() (*RetentionJob, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RetentionJob{config: rjc.config}
+		_spec = sqlgraph.NewCreateSpec(retentionjob.Table, sqlgraph.NewFieldSpec(retentionjob.FieldID, field.TypeInt))
+	)
+	if value, ok := rjc.mutation.Status(); ok {
+		_spec.SetField(retentionjob.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := rjc.mutation.Cursor(); ok {
+		_spec.SetField(retentionjob.FieldCursor, field.TypeInt, value)
+		_node.Cursor = value
+	}
+	if value, ok := rjc.mutation.MaxMessageId(); ok {
+		_spec.SetField(retentionjob.FieldMaxMessageId, field.TypeInt, value)
+		_node.MaxMessageId = value
+	}
+	if value, ok := rjc.mutation.Scanned(); ok {
+		_spec.SetField(retentionjob.FieldScanned, field.TypeInt, value)
+		_node.Scanned = value
+	}
+	if value, ok := rjc.mutation.Purged(); ok {
+		_spec.SetField(retentionjob.FieldPurged, field.TypeInt, value)
+		_node.Purged = value
+	}
+	if value, ok := rjc.mutation.Archived(); ok {
+		_spec.SetField(retentionjob.FieldArchived, field.TypeInt, value)
+		_node.Archived = value
+	}
+	if value, ok := rjc.mutation.MediaDeleted(); ok {
+		_spec.SetField(retentionjob.FieldMediaDeleted, field.TypeInt, value)
+		_node.MediaDeleted = value
+	}
+	if value, ok := rjc.mutation.LastError(); ok {
+		_spec.SetField(retentionjob.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := rjc.mutation.StartTime(); ok {
+		_spec.SetField(retentionjob.FieldStartTime, field.TypeTime, value)
+		_node.StartTime = value
+	}
+	if value, ok := rjc.mutation.UpdateTime(); ok {
+		_spec.SetField(retentionjob.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := rjc.mutation.FinishTime(); ok {
+		_spec.SetField(retentionjob.FieldFinishTime, field.TypeTime, value)
+		_node.FinishTime = &value
+	}
+	return _node, _spec
+}
+
+// RetentionJobCreateBulk is the builder for creating many RetentionJob entities in bulk.
+type RetentionJobCreateBulk struct {
+	config
+	err      error
+	builders []*RetentionJobCreate
+}
+
+// Save creates the RetentionJob entities in the database.
+func (rjcb *RetentionJobCreateBulk) Save(ctx context.Context) ([]*RetentionJob, error) {
+	if rjcb.err != nil {
+		return nil, rjcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rjcb.builders))
+	nodes := make([]*RetentionJob, len(rjcb.builders))
+	mutators := make([]Mutator, len(rjcb.builders))
+	for i := range rjcb.builders {
+		func(i int, root context.Context) {
+			builder := rjcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RetentionJobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rjcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rjcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rjcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rjcb *RetentionJobCreateBulk) SaveX(ctx context.Context) []*RetentionJob {
+	v, err := rjcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rjcb *RetentionJobCreateBulk) Exec(ctx context.Context) error {
+	_, err := rjcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rjcb *RetentionJobCreateBulk) ExecX(ctx context.Context) {
+	if err := rjcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
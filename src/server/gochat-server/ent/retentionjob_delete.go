@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"gochat_server/ent/predicate"
+	"gochat_server/ent/retentionjob"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RetentionJobDelete is the builder for deleting a RetentionJob entity.
+type RetentionJobDelete struct {
+	config
+	hooks    []Hook
+	mutation *RetentionJobMutation
+}
+
+// Where appends a list predicates to the RetentionJobDelete builder.
+func (rjd *RetentionJobDelete) Where(ps ...predicate.RetentionJob) *RetentionJobDelete {
+	rjd.mutation.Where(ps...)
+	return rjd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rjd *RetentionJobDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rjd.sqlExec, rjd.mutation, rjd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rjd *RetentionJobDelete) ExecX(ctx context.Context) int {
+	n, err := rjd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rjd *RetentionJobDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(retentionjob.Table, sqlgraph.NewFieldSpec(retentionjob.FieldID, field.TypeInt))
+	if ps := rjd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rjd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rjd.mutation.done = true
+	return affected, err
+}
+
+// RetentionJobDeleteOne is the builder for deleting a single RetentionJob entity.
+type RetentionJobDeleteOne struct {
+	rjd *RetentionJobDelete
+}
+
+// Where appends a list predicates to the RetentionJobDelete builder.
+func (rjdo *RetentionJobDeleteOne) Where(ps ...predicate.RetentionJob) *RetentionJobDeleteOne {
+	rjdo.rjd.mutation.Where(ps...)
+	return rjdo
+}
+
+// Exec executes the deletion query.
+func (rjdo *RetentionJobDeleteOne) Exec(ctx context.Context) error {
+	n, err := rjdo.rjd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{retentionjob.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rjdo *RetentionJobDeleteOne) ExecX(ctx context.Context) {
+	if err := rjdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"gochat_server/ent/predicate"
+	"gochat_server/ent/retentionjob"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RetentionJobQuery is the builder for querying RetentionJob entities.
+type RetentionJobQuery struct {
+	config
+	ctx        *QueryContext
+	order      []retentionjob.OrderOption
+	inters     []Interceptor
+	predicates []predicate.RetentionJob
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RetentionJobQuery builder.
+func (rjq *RetentionJobQuery) Where(ps ...predicate.RetentionJob) *RetentionJobQuery {
+	rjq.predicates = append(rjq.predicates, ps...)
+	return rjq
+}
+
+// Limit the number of records to be returned by this query.
+func (rjq *RetentionJobQuery) Limit(limit int) *RetentionJobQuery {
+	rjq.ctx.Limit = &limit
+	return rjq
+}
+
+// Offset to start from.
+func (rjq *RetentionJobQuery) Offset(offset int) *RetentionJobQuery {
+	rjq.ctx.Offset = &offset
+	return rjq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rjq *RetentionJobQuery) Unique(unique bool) *RetentionJobQuery {
+	rjq.ctx.Unique = &unique
+	return rjq
+}
+
+// Order specifies how the records should be ordered.
+func (rjq *RetentionJobQuery) Order(o ...retentionjob.OrderOption) *RetentionJobQuery {
+	rjq.order = append(rjq.order, o...)
+	return rjq
+}
+
+// First returns the first RetentionJob entity from the query.
+// Returns a *NotFoundError when no RetentionJob was found.
+func (rjq *RetentionJobQuery) First(ctx context.Context) (*RetentionJob, error) {
+	nodes, err := rjq.Limit(1).All(setContextOp(ctx, rjq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{retentionjob.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rjq *RetentionJobQuery) FirstX(ctx context.Context) *RetentionJob {
+	node, err := rjq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RetentionJob ID from the query.
+// Returns a *NotFoundError when no RetentionJob ID was found.
+func (rjq *RetentionJobQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rjq.Limit(1).IDs(setContextOp(ctx, rjq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{retentionjob.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rjq *RetentionJobQuery) FirstIDX(ctx context.Context) int {
+	id, err := rjq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RetentionJob entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one RetentionJob entity is found.
+// Returns a *NotFoundError when no RetentionJob entities are found.
+func (rjq *RetentionJobQuery) Only(ctx context.Context) (*RetentionJob, error) {
+	nodes, err := rjq.Limit(2).All(setContextOp(ctx, rjq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{retentionjob.Label}
+	default:
+		return nil, &NotSingularError{retentionjob.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rjq *RetentionJobQuery) OnlyX(ctx context.Context) *RetentionJob {
+	node, err := rjq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RetentionJob ID in the query.
+// Returns a *NotSingularError when more than one RetentionJob ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rjq *RetentionJobQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rjq.Limit(2).IDs(setContextOp(ctx, rjq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{retentionjob.Label}
+	default:
+		err = &NotSingularError{retentionjob.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rjq *RetentionJobQuery) OnlyIDX(ctx context.Context) int {
+	id, err := rjq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RetentionJobs.
+func (rjq *RetentionJobQuery) All(ctx context.Context) ([]*RetentionJob, error) {
+	ctx = setContextOp(ctx, rjq.ctx, ent.OpQueryAll)
+	if err := rjq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*RetentionJob, *RetentionJobQuery]()
+	return withInterceptors[[]*RetentionJob](ctx, rjq, qr, rjq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rjq *RetentionJobQuery) AllX(ctx context.Context) []*RetentionJob {
+	nodes, err := rjq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RetentionJob IDs.
+func (rjq *RetentionJobQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if rjq.ctx.Unique == nil && rjq.path != nil {
+		rjq.Unique(true)
+	}
+	ctx = setContextOp(ctx, rjq.ctx, ent.OpQueryIDs)
+	if err = rjq.Select(retentionjob.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rjq *RetentionJobQuery) IDsX(ctx context.Context) []int {
+	ids, err := rjq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rjq *RetentionJobQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, rjq.ctx, ent.OpQueryCount)
+	if err := rjq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, rjq, querierCount[*RetentionJobQuery](), rjq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rjq *RetentionJobQuery) CountX(ctx context.Context) int {
+	count, err := rjq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rjq *RetentionJobQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, rjq.ctx, ent.OpQueryExist)
+	switch _, err := rjq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rjq *RetentionJobQuery) ExistX(ctx context.Context) bool {
+	exist, err := rjq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RetentionJobQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rjq *RetentionJobQuery) Clone() *RetentionJobQuery {
+	if rjq == nil {
+		return nil
+	}
+	return &RetentionJobQuery{
+		config:     rjq.config,
+		ctx:        rjq.ctx.Clone(),
+		order:      append([]retentionjob.OrderOption{}, rjq.order...),
+		inters:     append([]Interceptor{}, rjq.inters...),
+		predicates: append([]predicate.RetentionJob{}, rjq.predicates...),
+		// clone intermediate query.
+		sql:  rjq.sql.Clone(),
+		path: rjq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Status string `json:"status,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RetentionJob.Query().
+//		GroupBy(retentionjob.FieldStatus).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rjq *RetentionJobQuery) GroupBy(field string, fields ...string) *RetentionJobGroupBy {
+	rjq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &RetentionJobGroupBy{build: rjq}
+	grbuild.flds = &rjq.ctx.Fields
+	grbuild.label = retentionjob.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Status string `json:"status,omitempty"`
+//	}
+//
+//	client.RetentionJob.Query().
+//		Select(retentionjob.FieldStatus).
+//		Scan(ctx, &v)
+func (rjq *RetentionJobQuery) Select(fields ...string) *RetentionJobSelect {
+	rjq.ctx.Fields = append(rjq.ctx.Fields, fields...)
+	sbuild := &RetentionJobSelect{RetentionJobQuery: rjq}
+	sbuild.label = retentionjob.Label
+	sbuild.flds, sbuild.scan = &rjq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a RetentionJobSelect configured with the given aggregations.
+func (rjq *RetentionJobQuery) Aggregate(fns ...AggregateFunc) *RetentionJobSelect {
+	return rjq.Select().Aggregate(fns...)
+}
+
+func (rjq *RetentionJobQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range rjq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rjq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range rjq.ctx.Fields {
+		if !retentionjob.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rjq.path != nil {
+		prev, err := rjq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rjq.sql = prev
+	}
+	return nil
+}
+
+func (rjq *RetentionJobQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*RetentionJob, error) {
+	var (
+		nodes = []*RetentionJob{}
+		_spec = rjq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*RetentionJob).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &RetentionJob{config: rjq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rjq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (rjq *RetentionJobQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rjq.querySpec()
+	_spec.Node.Columns = rjq.ctx.Fields
+	if len(rjq.ctx.Fields) > 0 {
+		_spec.Unique = rjq.ctx.Unique != nil && *rjq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, rjq.driver, _spec)
+}
+
+func (rjq *RetentionJobQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(retentionjob.Table, retentionjob.Columns, sqlgraph.NewFieldSpec(retentionjob.FieldID, field.TypeInt))
+	_spec.From = rjq.sql
+	if unique := rjq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if rjq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := rjq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, retentionjob.FieldID)
+		for i := range fields {
+			if fields[i] != retentionjob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := rjq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rjq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rjq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rjq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rjq *RetentionJobQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rjq.driver.Dialect())
+	t1 := builder.Table(retentionjob.Table)
+	columns := rjq.ctx.Fields
+	if len(columns) == 0 {
+		columns = retentionjob.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rjq.sql != nil {
+		selector = rjq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rjq.ctx.Unique != nil && *rjq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range rjq.predicates {
+		p(selector)
+	}
+	for _, p := range rjq.order {
+		p(selector)
+	}
+	if offset := rjq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rjq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// RetentionJobGroupBy is the group-by builder for RetentionJob entities.
+type RetentionJobGroupBy struct {
+	selector
+	build *RetentionJobQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rjgb *RetentionJobGroupBy) Aggregate(fns ...AggregateFunc) *RetentionJobGroupBy {
+	rjgb.fns = append(rjgb.fns, fns...)
+	return rjgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rjgb *RetentionJobGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rjgb.build.ctx, ent.OpQueryGroupBy)
+	if err := rjgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RetentionJobQuery, *RetentionJobGroupBy](ctx, rjgb.build, rjgb, rjgb.build.inters, v)
+}
+
+func (rjgb *RetentionJobGroupBy) sqlScan(ctx context.Context, root *RetentionJobQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rjgb.fns))
+	for _, fn := range rjgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rjgb.flds)+len(rjgb.fns))
+		for _, f := range *rjgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rjgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rjgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// RetentionJobSelect is the builder for selecting fields of RetentionJob entities.
+type RetentionJobSelect struct {
+	*RetentionJobQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rjs *RetentionJobSelect) Aggregate(fns ...AggregateFunc) *RetentionJobSelect {
+	rjs.fns = append(rjs.fns, fns...)
+	return rjs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rjs *RetentionJobSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rjs.ctx, ent.OpQuerySelect)
+	if err := rjs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RetentionJobQuery, *RetentionJobSelect](ctx, rjs.RetentionJobQuery, rjs, rjs.inters, v)
+}
+
+func (rjs *RetentionJobSelect) sqlScan(ctx context.Context, root *RetentionJobQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rjs.fns))
+	for _, fn := range rjs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rjs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rjs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
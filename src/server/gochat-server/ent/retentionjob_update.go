@@ -0,0 +1,690 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gochat_server/ent/predicate"
+	"gochat_server/ent/retentionjob"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RetentionJobUpdate is the builder for updating RetentionJob entities.
+type RetentionJobUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RetentionJobMutation
+}
+
+// Where appends a list predicates to the RetentionJobUpdate builder.
+func (rju *RetentionJobUpdate) Where(ps ...predicate.RetentionJob) *RetentionJobUpdate {
+	rju.mutation.Where(ps...)
+	return rju
+}
+
+// SetStatus sets the "status" field.
+func (rju *RetentionJobUpdate) SetStatus(s string) *RetentionJobUpdate {
+	rju.mutation.SetStatus(s)
+	return rju
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rju *RetentionJobUpdate) SetNillableStatus(s *string) *RetentionJobUpdate {
+	if s != nil {
+		rju.SetStatus(*s)
+	}
+	return rju
+}
+
+// SetCursor sets the "cursor" field.
+func (rju *RetentionJobUpdate) SetCursor(i int) *RetentionJobUpdate {
+	rju.mutation.ResetCursor()
+	rju.mutation.SetCursor(i)
+	return rju
+}
+
+// SetNillableCursor sets the "cursor" field if the given value is not nil.
+func (rju *RetentionJobUpdate) SetNillableCursor(i *int) *RetentionJobUpdate {
+	if i != nil {
+		rju.SetCursor(*i)
+	}
+	return rju
+}
+
+// AddCursor adds i to the "cursor" field.
+func (rju *RetentionJobUpdate) AddCursor(i int) *RetentionJobUpdate {
+	rju.mutation.AddCursor(i)
+	return rju
+}
+
+// SetMaxMessageId sets the "maxMessageId" field.
+func (rju *RetentionJobUpdate) SetMaxMessageId(i int) *RetentionJobUpdate {
+	rju.mutation.ResetMaxMessageId()
+	rju.mutation.SetMaxMessageId(i)
+	return rju
+}
+
+// SetNillableMaxMessageId sets the "maxMessageId" field if the given value is not nil.
+func (rju *RetentionJobUpdate) SetNillableMaxMessageId(i *int) *RetentionJobUpdate {
+	if i != nil {
+		rju.SetMaxMessageId(*i)
+	}
+	return rju
+}
+
+// AddMaxMessageId adds i to the "maxMessageId" field.
+func (rju *RetentionJobUpdate) AddMaxMessageId(i int) *RetentionJobUpdate {
+	rju.mutation.AddMaxMessageId(i)
+	return rju
+}
+
+// SetScanned sets the "scanned" field.
+func (rju *RetentionJobUpdate) SetScanned(i int) *RetentionJobUpdate {
+	rju.mutation.ResetScanned()
+	rju.mutation.SetScanned(i)
+	return rju
+}
+
+// SetNillableScanned sets the "scanned" field if the given value is not nil.
+func (rju *RetentionJobUpdate) SetNillableScanned(i *int) *RetentionJobUpdate {
+	if i != nil {
+		rju.SetScanned(*i)
+	}
+	return rju
+}
+
+// AddScanned adds i to the "scanned" field.
+func (rju *RetentionJobUpdate) AddScanned(i int) *RetentionJobUpdate {
+	rju.mutation.AddScanned(i)
+	return rju
+}
+
+// SetPurged sets the "purged" field.
+func (rju *RetentionJobUpdate) SetPurged(i int) *RetentionJobUpdate {
+	rju.mutation.ResetPurged()
+	rju.mutation.SetPurged(i)
+	return rju
+}
+
+// SetNillablePurged sets the "purged" field if the given value is not nil.
+func (rju *RetentionJobUpdate) SetNillablePurged(i *int) *RetentionJobUpdate {
+	if i != nil {
+		rju.SetPurged(*i)
+	}
+	return rju
+}
+
+// AddPurged adds i to the "purged" field.
+func (rju *RetentionJobUpdate) AddPurged(i int) *RetentionJobUpdate {
+	rju.mutation.AddPurged(i)
+	return rju
+}
+
+// SetArchived sets the "archived" field.
+func (rju *RetentionJobUpdate) SetArchived(i int) *RetentionJobUpdate {
+	rju.mutation.ResetArchived()
+	rju.mutation.SetArchived(i)
+	return rju
+}
+
+// SetNillableArchived sets the "archived" field if the given value is not nil.
+func (rju *RetentionJobUpdate) SetNillableArchived(i *int) *RetentionJobUpdate {
+	if i != nil {
+		rju.SetArchived(*i)
+	}
+	return rju
+}
+
+// AddArchived adds i to the "archived" field.
+func (rju *RetentionJobUpdate) AddArchived(i int) *RetentionJobUpdate {
+	rju.mutation.AddArchived(i)
+	return rju
+}
+
+// SetMediaDeleted sets the "mediaDeleted" field.
+func (rju *RetentionJobUpdate) SetMediaDeleted(i int) *RetentionJobUpdate {
+	rju.mutation.ResetMediaDeleted()
+	rju.mutation.SetMediaDeleted(i)
+	return rju
+}
+
+// SetNillableMediaDeleted sets the "mediaDeleted" field if the given value is not nil.
+func (rju *RetentionJobUpdate) SetNillableMediaDeleted(i *int) *RetentionJobUpdate {
+	if i != nil {
+		rju.SetMediaDeleted(*i)
+	}
+	return rju
+}
+
+// AddMediaDeleted adds i to the "mediaDeleted" field.
+func (rju *RetentionJobUpdate) AddMediaDeleted(i int) *RetentionJobUpdate {
+	rju.mutation.AddMediaDeleted(i)
+	return rju
+}
+
+// SetLastError sets the "lastError" field.
+func (rju *RetentionJobUpdate) SetLastError(s string) *RetentionJobUpdate {
+	rju.mutation.SetLastError(s)
+	return rju
+}
+
+// SetNillableLastError sets the "lastError" field if the given value is not nil.
+func (rju *RetentionJobUpdate) SetNillableLastError(s *string) *RetentionJobUpdate {
+	if s != nil {
+		rju.SetLastError(*s)
+	}
+	return rju
+}
+
+// SetStartTime sets the "startTime" field.
+func (rju *RetentionJobUpdate) SetStartTime(t time.Time) *RetentionJobUpdate {
+	rju.mutation.SetStartTime(t)
+	return rju
+}
+
+// SetNillableStartTime sets the "startTime" field if the given value is not nil.
+func (rju *RetentionJobUpdate) SetNillableStartTime(t *time.Time) *RetentionJobUpdate {
+	if t != nil {
+		rju.SetStartTime(*t)
+	}
+	return rju
+}
+
+// SetUpdateTime sets the "updateTime" field.
+func (rju *RetentionJobUpdate) SetUpdateTime(t time.Time) *RetentionJobUpdate {
+	rju.mutation.SetUpdateTime(t)
+	return rju
+}
+
+// SetFinishTime sets the "finishTime" field.
+func (rju *RetentionJobUpdate) SetFinishTime(t time.Time) *RetentionJobUpdate {
+	rju.mutation.SetFinishTime(t)
+	return rju
+}
+
+// SetNillableFinishTime sets the "finishTime" field if the given value is not nil.
+func (rju *RetentionJobUpdate) SetNillableFinishTime(t *time.Time) *RetentionJobUpdate {
+	if t != nil {
+		rju.SetFinishTime(*t)
+	}
+	return rju
+}
+
+// ClearFinishTime clears the value of the "finishTime" field.
+func (rju *RetentionJobUpdate) ClearFinishTime() *RetentionJobUpdate {
+	rju.mutation.ClearFinishTime()
+	return rju
+}
+
+// Mutation returns the RetentionJobMutation object of the builder.
+func (rju *RetentionJobUpdate) Mutation() *RetentionJobMutation {
+	return rju.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rju *RetentionJobUpdate) Save(ctx context.Context) (int, error) {
+	rju.defaults()
+	return withHooks(ctx, rju.sqlSave, rju.mutation, rju.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rju *RetentionJobUpdate) SaveX(ctx context.Context) int {
+	affected, err := rju.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rju *RetentionJobUpdate) Exec(ctx context.Context) error {
+	_, err := rju.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rju *RetentionJobUpdate) ExecX(ctx context.Context) {
+	if err := rju.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rju *RetentionJobUpdate) defaults() {
+	if _, ok := rju.mutation.UpdateTime(); !ok {
+		v := retentionjob.UpdateDefaultUpdateTime()
+		rju.mutation.SetUpdateTime(v)
+	}
+}
+
+func (rju *RetentionJobUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(retentionjob.Table, retentionjob.Columns, sqlgraph.NewFieldSpec(retentionjob.FieldID, field.TypeInt))
+	if ps := rju.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rju.mutation.Status(); ok {
+		_spec.SetField(retentionjob.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := rju.mutation.Cursor(); ok {
+		_spec.SetField(retentionjob.FieldCursor, field.TypeInt, value)
+	}
+	if value, ok := rju.mutation.AddedCursor(); ok {
+		_spec.AddField(retentionjob.FieldCursor, field.TypeInt, value)
+	}
+	if value, ok := rju.mutation.MaxMessageId(); ok {
+		_spec.SetField(retentionjob.FieldMaxMessageId, field.TypeInt, value)
+	}
+	if value, ok := rju.mutation.AddedMaxMessageId(); ok {
+		_spec.AddField(retentionjob.FieldMaxMessageId, field.TypeInt, value)
+	}
+	if value, ok := rju.mutation.Scanned(); ok {
+		_spec.SetField(retentionjob.FieldScanned, field.TypeInt, value)
+	}
+	if value, ok := rju.mutation.AddedScanned(); ok {
+		_spec.AddField(retentionjob.FieldScanned, field.TypeInt, value)
+	}
+	if value, ok := rju.mutation.Purged(); ok {
+		_spec.SetField(retentionjob.FieldPurged, field.TypeInt, value)
+	}
+	if value, ok := rju.mutation.AddedPurged(); ok {
+		_spec.AddField(retentionjob.FieldPurged, field.TypeInt, value)
+	}
+	if value, ok := rju.mutation.Archived(); ok {
+		_spec.SetField(retentionjob.FieldArchived, field.TypeInt, value)
+	}
+	if value, ok := rju.mutation.AddedArchived(); ok {
+		_spec.AddField(retentionjob.FieldArchived, field.TypeInt, value)
+	}
+	if value, ok := rju.mutation.MediaDeleted(); ok {
+		_spec.SetField(retentionjob.FieldMediaDeleted, field.TypeInt, value)
+	}
+	if value, ok := rju.mutation.AddedMediaDeleted(); ok {
+		_spec.AddField(retentionjob.FieldMediaDeleted, field.TypeInt, value)
+	}
+	if value, ok := rju.mutation.LastError(); ok {
+		_spec.SetField(retentionjob.FieldLastError, field.TypeString, value)
+	}
+	if value, ok := rju.mutation.StartTime(); ok {
+		_spec.SetField(retentionjob.FieldStartTime, field.TypeTime, value)
+	}
+	if value, ok := rju.mutation.UpdateTime(); ok {
+		_spec.SetField(retentionjob.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := rju.mutation.FinishTime(); ok {
+		_spec.SetField(retentionjob.FieldFinishTime, field.TypeTime, value)
+	}
+	if rju.mutation.FinishTimeCleared() {
+		_spec.ClearField(retentionjob.FieldFinishTime, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rju.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{retentionjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rju.mutation.done = true
+	return n, nil
+}
+
+// RetentionJobUpdateOne is the builder for updating a single RetentionJob entity.
+type RetentionJobUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RetentionJobMutation
+}
+
+// SetStatus sets the "status" field.
+func (rjuo *RetentionJobUpdateOne) SetStatus(s string) *RetentionJobUpdateOne {
+	rjuo.mutation.SetStatus(s)
+	return rjuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rjuo *RetentionJobUpdateOne) SetNillableStatus(s *string) *RetentionJobUpdateOne {
+	if s != nil {
+		rjuo.SetStatus(*s)
+	}
+	return rjuo
+}
+
+// SetCursor sets the "cursor" field.
+func (rjuo *RetentionJobUpdateOne) SetCursor(i int) *RetentionJobUpdateOne {
+	rjuo.mutation.ResetCursor()
+	rjuo.mutation.SetCursor(i)
+	return rjuo
+}
+
+// SetNillableCursor sets the "cursor" field if the given value is not nil.
+func (rjuo *RetentionJobUpdateOne) SetNillableCursor(i *int) *RetentionJobUpdateOne {
+	if i != nil {
+		rjuo.SetCursor(*i)
+	}
+	return rjuo
+}
+
+// AddCursor adds i to the "cursor" field.
+func (rjuo *RetentionJobUpdateOne) AddCursor(i int) *RetentionJobUpdateOne {
+	rjuo.mutation.AddCursor(i)
+	return rjuo
+}
+
+// SetMaxMessageId sets the "maxMessageId" field.
+func (rjuo *RetentionJobUpdateOne) SetMaxMessageId(i int) *RetentionJobUpdateOne {
+	rjuo.mutation.ResetMaxMessageId()
+	rjuo.mutation.SetMaxMessageId(i)
+	return rjuo
+}
+
+// SetNillableMaxMessageId sets the "maxMessageId" field if the given value is not nil.
+func (rjuo *RetentionJobUpdateOne) SetNillableMaxMessageId(i *int) *RetentionJobUpdateOne {
+	if i != nil {
+		rjuo.SetMaxMessageId(*i)
+	}
+	return rjuo
+}
+
+// AddMaxMessageId adds i to the "maxMessageId" field.
+func (rjuo *RetentionJobUpdateOne) AddMaxMessageId(i int) *RetentionJobUpdateOne {
+	rjuo.mutation.AddMaxMessageId(i)
+	return rjuo
+}
+
+// SetScanned sets the "scanned" field.
+func (rjuo *RetentionJobUpdateOne) SetScanned(i int) *RetentionJobUpdateOne {
+	rjuo.mutation.ResetScanned()
+	rjuo.mutation.SetScanned(i)
+	return rjuo
+}
+
+// SetNillableScanned sets the "scanned" field if the given value is not nil.
+func (rjuo *RetentionJobUpdateOne) SetNillableScanned(i *int) *RetentionJobUpdateOne {
+	if i != nil {
+		rjuo.SetScanned(*i)
+	}
+	return rjuo
+}
+
+// AddScanned adds i to the "scanned" field.
+func (rjuo *RetentionJobUpdateOne) AddScanned(i int) *RetentionJobUpdateOne {
+	rjuo.mutation.AddScanned(i)
+	return rjuo
+}
+
+// SetPurged sets the "purged" field.
+func (rjuo *RetentionJobUpdateOne) SetPurged(i int) *RetentionJobUpdateOne {
+	rjuo.mutation.ResetPurged()
+	rjuo.mutation.SetPurged(i)
+	return rjuo
+}
+
+// SetNillablePurged sets the "purged" field if the given value is not nil.
+func (rjuo *RetentionJobUpdateOne) SetNillablePurged(i *int) *RetentionJobUpdateOne {
+	if i != nil {
+		rjuo.SetPurged(*i)
+	}
+	return rjuo
+}
+
+// AddPurged adds i to the "purged" field.
+func (rjuo *RetentionJobUpdateOne) AddPurged(i int) *RetentionJobUpdateOne {
+	rjuo.mutation.AddPurged(i)
+	return rjuo
+}
+
+// SetArchived sets the "archived" field.
+func (rjuo *RetentionJobUpdateOne) SetArchived(i int) *RetentionJobUpdateOne {
+	rjuo.mutation.ResetArchived()
+	rjuo.mutation.SetArchived(i)
+	return rjuo
+}
+
+// SetNillableArchived sets the "archived" field if the given value is not nil.
+func (rjuo *RetentionJobUpdateOne) SetNillableArchived(i *int) *RetentionJobUpdateOne {
+	if i != nil {
+		rjuo.SetArchived(*i)
+	}
+	return rjuo
+}
+
+// AddArchived adds i to the "archived" field.
+func (rjuo *RetentionJobUpdateOne) AddArchived(i int) *RetentionJobUpdateOne {
+	rjuo.mutation.AddArchived(i)
+	return rjuo
+}
+
+// SetMediaDeleted sets the "mediaDeleted" field.
+func (rjuo *RetentionJobUpdateOne) SetMediaDeleted(i int) *RetentionJobUpdateOne {
+	rjuo.mutation.ResetMediaDeleted()
+	rjuo.mutation.SetMediaDeleted(i)
+	return rjuo
+}
+
+// SetNillableMediaDeleted sets the "mediaDeleted" field if the given value is not nil.
+func (rjuo *RetentionJobUpdateOne) SetNillableMediaDeleted(i *int) *RetentionJobUpdateOne {
+	if i != nil {
+		rjuo.SetMediaDeleted(*i)
+	}
+	return rjuo
+}
+
+// AddMediaDeleted adds i to the "mediaDeleted" field.
+func (rjuo *RetentionJobUpdateOne) AddMediaDeleted(i int) *RetentionJobUpdateOne {
+	rjuo.mutation.AddMediaDeleted(i)
+	return rjuo
+}
+
+// SetLastError sets the "lastError" field.
+func (rjuo *RetentionJobUpdateOne) SetLastError(s string) *RetentionJobUpdateOne {
+	rjuo.mutation.SetLastError(s)
+	return rjuo
+}
+
+// SetNillableLastError sets the "lastError" field if the given value is not nil.
+func (rjuo *RetentionJobUpdateOne) SetNillableLastError(s *string) *RetentionJobUpdateOne {
+	if s != nil {
+		rjuo.SetLastError(*s)
+	}
+	return rjuo
+}
+
+// SetStartTime sets the "startTime" field.
+func (rjuo *RetentionJobUpdateOne) SetStartTime(t time.Time) *RetentionJobUpdateOne {
+	rjuo.mutation.SetStartTime(t)
+	return rjuo
+}
+
+// SetNillableStartTime sets the "startTime" field if the given value is not nil.
+func (rjuo *RetentionJobUpdateOne) SetNillableStartTime(t *time.Time) *RetentionJobUpdateOne {
+	if t != nil {
+		rjuo.SetStartTime(*t)
+	}
+	return rjuo
+}
+
+// SetUpdateTime sets the "updateTime" field.
+func (rjuo *RetentionJobUpdateOne) SetUpdateTime(t time.Time) *RetentionJobUpdateOne {
+	rjuo.mutation.SetUpdateTime(t)
+	return rjuo
+}
+
+// SetFinishTime sets the "finishTime" field.
+func (rjuo *RetentionJobUpdateOne) SetFinishTime(t time.Time) *RetentionJobUpdateOne {
+	rjuo.mutation.SetFinishTime(t)
+	return rjuo
+}
+
+// SetNillableFinishTime sets the "finishTime" field if the given value is not nil.
+func (rjuo *RetentionJobUpdateOne) SetNillableFinishTime(t *time.Time) *RetentionJobUpdateOne {
+	if t != nil {
+		rjuo.SetFinishTime(*t)
+	}
+	return rjuo
+}
+
+// ClearFinishTime clears the value of the "finishTime" field.
+func (rjuo *RetentionJobUpdateOne) ClearFinishTime() *RetentionJobUpdateOne {
+	rjuo.mutation.ClearFinishTime()
+	return rjuo
+}
+
+// Mutation returns the RetentionJobMutation object of the builder.
+func (rjuo *RetentionJobUpdateOne) Mutation() *RetentionJobMutation {
+	return rjuo.mutation
+}
+
+// Where appends a list predicates to the RetentionJobUpdate builder.
+func (rjuo *RetentionJobUpdateOne) Where(ps ...predicate.RetentionJob) *RetentionJobUpdateOne {
+	rjuo.mutation.Where(ps...)
+	return rjuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rjuo *RetentionJobUpdateOne) Select(field string, fields ...string) *RetentionJobUpdateOne {
+	rjuo.fields = append([]string{field}, fields...)
+	return rjuo
+}
+
+// Save executes the query and returns the updated RetentionJob entity.
+func (rjuo *RetentionJobUpdateOne) Save(ctx context.Context) (*RetentionJob, error) {
+	rjuo.defaults()
+	return withHooks(ctx, rjuo.sqlSave, rjuo.mutation, rjuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rjuo *RetentionJobUpdateOne) SaveX(ctx context.Context) *RetentionJob {
+	node, err := rjuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rjuo *RetentionJobUpdateOne) Exec(ctx context.Context) error {
+	_, err := rjuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rjuo *RetentionJobUpdateOne) ExecX(ctx context.Context) {
+	if err := rjuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rjuo *RetentionJobUpdateOne) defaults() {
+	if _, ok := rjuo.mutation.UpdateTime(); !ok {
+		v := retentionjob.UpdateDefaultUpdateTime()
+		rjuo.mutation.SetUpdateTime(v)
+	}
+}
+
+func (rjuo *RetentionJobUpdateOne) sqlSave(ctx context.Context) (_node *RetentionJob, err error) {
+	_spec := sqlgraph.NewUpdateSpec(retentionjob.Table, retentionjob.Columns, sqlgraph.NewFieldSpec(retentionjob.FieldID, field.TypeInt))
+	id, ok := rjuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RetentionJob.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rjuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, retentionjob.FieldID)
+		for _, f := range fields {
+			if !retentionjob.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != retentionjob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rjuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rjuo.mutation.Status(); ok {
+		_spec.SetField(retentionjob.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := rjuo.mutation.Cursor(); ok {
+		_spec.SetField(retentionjob.FieldCursor, field.TypeInt, value)
+	}
+	if value, ok := rjuo.mutation.AddedCursor(); ok {
+		_spec.AddField(retentionjob.FieldCursor, field.TypeInt, value)
+	}
+	if value, ok := rjuo.mutation.MaxMessageId(); ok {
+		_spec.SetField(retentionjob.FieldMaxMessageId, field.TypeInt, value)
+	}
+	if value, ok := rjuo.mutation.AddedMaxMessageId(); ok {
+		_spec.AddField(retentionjob.FieldMaxMessageId, field.TypeInt, value)
+	}
+	if value, ok := rjuo.mutation.Scanned(); ok {
+		_spec.SetField(retentionjob.FieldScanned, field.TypeInt, value)
+	}
+	if value, ok := rjuo.mutation.AddedScanned(); ok {
+		_spec.AddField(retentionjob.FieldScanned, field.TypeInt, value)
+	}
+	if value, ok := rjuo.mutation.Purged(); ok {
+		_spec.SetField(retentionjob.FieldPurged, field.TypeInt, value)
+	}
+	if value, ok := rjuo.mutation.AddedPurged(); ok {
+		_spec.AddField(retentionjob.FieldPurged, field.TypeInt, value)
+	}
+	if value, ok := rjuo.mutation.Archived(); ok {
+		_spec.SetField(retentionjob.FieldArchived, field.TypeInt, value)
+	}
+	if value, ok := rjuo.mutation.AddedArchived(); ok {
+		_spec.AddField(retentionjob.FieldArchived, field.TypeInt, value)
+	}
+	if value, ok := rjuo.mutation.MediaDeleted(); ok {
+		_spec.SetField(retentionjob.FieldMediaDeleted, field.TypeInt, value)
+	}
+	if value, ok := rjuo.mutation.AddedMediaDeleted(); ok {
+		_spec.AddField(retentionjob.FieldMediaDeleted, field.TypeInt, value)
+	}
+	if value, ok := rjuo.mutation.LastError(); ok {
+		_spec.SetField(retentionjob.FieldLastError, field.TypeString, value)
+	}
+	if value, ok := rjuo.mutation.StartTime(); ok {
+		_spec.SetField(retentionjob.FieldStartTime, field.TypeTime, value)
+	}
+	if value, ok := rjuo.mutation.UpdateTime(); ok {
+		_spec.SetField(retentionjob.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := rjuo.mutation.FinishTime(); ok {
+		_spec.SetField(retentionjob.FieldFinishTime, field.TypeTime, value)
+	}
+	if rjuo.mutation.FinishTimeCleared() {
+		_spec.ClearField(retentionjob.FieldFinishTime, field.TypeTime)
+	}
+	_node = &RetentionJob{config: rjuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rjuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{retentionjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rjuo.mutation.done = true
+	return _node, nil
+}
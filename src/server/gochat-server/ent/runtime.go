@@ -3,18 +3,36 @@
 package ent
 
 import (
+	"gochat_server/ent/block"
 	"gochat_server/ent/chatrecord"
+	"gochat_server/ent/contactcardmessage"
+	"gochat_server/ent/conversationclear"
+	"gochat_server/ent/conversationtimer"
+	"gochat_server/ent/datamigration"
 	"gochat_server/ent/donotdisturb"
+	"gochat_server/ent/exportjob"
+	"gochat_server/ent/filemessage"
 	"gochat_server/ent/friendrequest"
 	"gochat_server/ent/group"
 	"gochat_server/ent/groupchatrecord"
+	"gochat_server/ent/groupwatermark"
 	"gochat_server/ent/imagemessage"
+	"gochat_server/ent/locationmessage"
+	"gochat_server/ent/mergedforwardmessage"
 	"gochat_server/ent/message"
+	"gochat_server/ent/messagedeletion"
+	"gochat_server/ent/messageforward"
+	"gochat_server/ent/messagemention"
+	"gochat_server/ent/messageoutbox"
+	"gochat_server/ent/messagereaction"
 	"gochat_server/ent/messagestatus"
+	"gochat_server/ent/retentionjob"
+	"gochat_server/ent/scheduledmessage"
 	"gochat_server/ent/schema"
 	"gochat_server/ent/textmessage"
 	"gochat_server/ent/user"
 	"gochat_server/ent/videomessage"
+	"gochat_server/ent/voicemessage"
 	"time"
 )
 
@@ -22,6 +40,12 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	blockFields := schema.Block{}.Fields()
+	_ = blockFields
+	// blockDescCreateTime is the schema descriptor for createTime field.
+	blockDescCreateTime := blockFields[2].Descriptor()
+	// block.DefaultCreateTime holds the default value on creation for the createTime field.
+	block.DefaultCreateTime = blockDescCreateTime.Default.(func() time.Time)
 	chatrecordFields := schema.ChatRecord{}.Fields()
 	_ = chatrecordFields
 	// chatrecordDescMsgId is the schema descriptor for msgId field.
@@ -33,9 +57,71 @@ func init() {
 	// chatrecord.DefaultIsGroup holds the default value on creation for the isGroup field.
 	chatrecord.DefaultIsGroup = chatrecordDescIsGroup.Default.(bool)
 	// chatrecordDescCreateTime is the schema descriptor for createTime field.
-	chatrecordDescCreateTime := chatrecordFields[6].Descriptor()
+	chatrecordDescCreateTime := chatrecordFields[7].Descriptor()
 	// chatrecord.DefaultCreateTime holds the default value on creation for the createTime field.
 	chatrecord.DefaultCreateTime = chatrecordDescCreateTime.Default.(func() time.Time)
+	contactcardmessageFields := schema.ContactCardMessage{}.Fields()
+	_ = contactcardmessageFields
+	// contactcardmessageDescMsgId is the schema descriptor for msgId field.
+	contactcardmessageDescMsgId := contactcardmessageFields[0].Descriptor()
+	// contactcardmessage.MsgIdValidator is a validator for the "msgId" field. It is called by the builders before save.
+	contactcardmessage.MsgIdValidator = contactcardmessageDescMsgId.Validators[0].(func(string) error)
+	conversationclearFields := schema.ConversationClear{}.Fields()
+	_ = conversationclearFields
+	// conversationclearDescIsGroup is the schema descriptor for isGroup field.
+	conversationclearDescIsGroup := conversationclearFields[1].Descriptor()
+	// conversationclear.DefaultIsGroup holds the default value on creation for the isGroup field.
+	conversationclear.DefaultIsGroup = conversationclearDescIsGroup.Default.(bool)
+	// conversationclearDescHidden is the schema descriptor for hidden field.
+	conversationclearDescHidden := conversationclearFields[4].Descriptor()
+	// conversationclear.DefaultHidden holds the default value on creation for the hidden field.
+	conversationclear.DefaultHidden = conversationclearDescHidden.Default.(bool)
+	conversationtimerFields := schema.ConversationTimer{}.Fields()
+	_ = conversationtimerFields
+	// conversationtimerDescConversationId is the schema descriptor for conversationId field.
+	conversationtimerDescConversationId := conversationtimerFields[0].Descriptor()
+	// conversationtimer.ConversationIdValidator is a validator for the "conversationId" field. It is called by the builders before save.
+	conversationtimer.ConversationIdValidator = conversationtimerDescConversationId.Validators[0].(func(string) error)
+	// conversationtimerDescIsGroup is the schema descriptor for isGroup field.
+	conversationtimerDescIsGroup := conversationtimerFields[1].Descriptor()
+	// conversationtimer.DefaultIsGroup holds the default value on creation for the isGroup field.
+	conversationtimer.DefaultIsGroup = conversationtimerDescIsGroup.Default.(bool)
+	// conversationtimerDescTtlSeconds is the schema descriptor for ttlSeconds field.
+	conversationtimerDescTtlSeconds := conversationtimerFields[2].Descriptor()
+	// conversationtimer.DefaultTtlSeconds holds the default value on creation for the ttlSeconds field.
+	conversationtimer.DefaultTtlSeconds = conversationtimerDescTtlSeconds.Default.(int)
+	// conversationtimerDescUpdateTime is the schema descriptor for updateTime field.
+	conversationtimerDescUpdateTime := conversationtimerFields[4].Descriptor()
+	// conversationtimer.DefaultUpdateTime holds the default value on creation for the updateTime field.
+	conversationtimer.DefaultUpdateTime = conversationtimerDescUpdateTime.Default.(func() time.Time)
+	datamigrationFields := schema.DataMigration{}.Fields()
+	_ = datamigrationFields
+	// datamigrationDescName is the schema descriptor for name field.
+	datamigrationDescName := datamigrationFields[0].Descriptor()
+	// datamigration.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	datamigration.NameValidator = datamigrationDescName.Validators[0].(func(string) error)
+	// datamigrationDescCursor is the schema descriptor for cursor field.
+	datamigrationDescCursor := datamigrationFields[1].Descriptor()
+	// datamigration.DefaultCursor holds the default value on creation for the cursor field.
+	datamigration.DefaultCursor = datamigrationDescCursor.Default.(int)
+	// datamigrationDescMigrated is the schema descriptor for migrated field.
+	datamigrationDescMigrated := datamigrationFields[2].Descriptor()
+	// datamigration.DefaultMigrated holds the default value on creation for the migrated field.
+	datamigration.DefaultMigrated = datamigrationDescMigrated.Default.(int)
+	// datamigrationDescIsCompleted is the schema descriptor for isCompleted field.
+	datamigrationDescIsCompleted := datamigrationFields[3].Descriptor()
+	// datamigration.DefaultIsCompleted holds the default value on creation for the isCompleted field.
+	datamigration.DefaultIsCompleted = datamigrationDescIsCompleted.Default.(bool)
+	// datamigrationDescStartTime is the schema descriptor for startTime field.
+	datamigrationDescStartTime := datamigrationFields[4].Descriptor()
+	// datamigration.DefaultStartTime holds the default value on creation for the startTime field.
+	datamigration.DefaultStartTime = datamigrationDescStartTime.Default.(func() time.Time)
+	// datamigrationDescUpdateTime is the schema descriptor for updateTime field.
+	datamigrationDescUpdateTime := datamigrationFields[5].Descriptor()
+	// datamigration.DefaultUpdateTime holds the default value on creation for the updateTime field.
+	datamigration.DefaultUpdateTime = datamigrationDescUpdateTime.Default.(func() time.Time)
+	// datamigration.UpdateDefaultUpdateTime holds the default value on update for the updateTime field.
+	datamigration.UpdateDefaultUpdateTime = datamigrationDescUpdateTime.UpdateDefault.(func() time.Time)
 	donotdisturbFields := schema.DoNotDisturb{}.Fields()
 	_ = donotdisturbFields
 	// donotdisturbDescUserID is the schema descriptor for user_id field.
@@ -60,6 +146,66 @@ func init() {
 	donotdisturbDescID := donotdisturbFields[0].Descriptor()
 	// donotdisturb.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	donotdisturb.IDValidator = donotdisturbDescID.Validators[0].(func(int) error)
+	exportjobFields := schema.ExportJob{}.Fields()
+	_ = exportjobFields
+	// exportjobDescIsGroup is the schema descriptor for isGroup field.
+	exportjobDescIsGroup := exportjobFields[1].Descriptor()
+	// exportjob.DefaultIsGroup holds the default value on creation for the isGroup field.
+	exportjob.DefaultIsGroup = exportjobDescIsGroup.Default.(bool)
+	// exportjobDescStatus is the schema descriptor for status field.
+	exportjobDescStatus := exportjobFields[4].Descriptor()
+	// exportjob.DefaultStatus holds the default value on creation for the status field.
+	exportjob.DefaultStatus = exportjobDescStatus.Default.(string)
+	// exportjobDescAttempts is the schema descriptor for attempts field.
+	exportjobDescAttempts := exportjobFields[6].Descriptor()
+	// exportjob.DefaultAttempts holds the default value on creation for the attempts field.
+	exportjob.DefaultAttempts = exportjobDescAttempts.Default.(int)
+	// exportjobDescTotal is the schema descriptor for total field.
+	exportjobDescTotal := exportjobFields[7].Descriptor()
+	// exportjob.DefaultTotal holds the default value on creation for the total field.
+	exportjob.DefaultTotal = exportjobDescTotal.Default.(int)
+	// exportjobDescProcessed is the schema descriptor for processed field.
+	exportjobDescProcessed := exportjobFields[8].Descriptor()
+	// exportjob.DefaultProcessed holds the default value on creation for the processed field.
+	exportjob.DefaultProcessed = exportjobDescProcessed.Default.(int)
+	// exportjobDescObjectName is the schema descriptor for objectName field.
+	exportjobDescObjectName := exportjobFields[9].Descriptor()
+	// exportjob.DefaultObjectName holds the default value on creation for the objectName field.
+	exportjob.DefaultObjectName = exportjobDescObjectName.Default.(string)
+	// exportjobDescFileSize is the schema descriptor for fileSize field.
+	exportjobDescFileSize := exportjobFields[10].Descriptor()
+	// exportjob.DefaultFileSize holds the default value on creation for the fileSize field.
+	exportjob.DefaultFileSize = exportjobDescFileSize.Default.(int64)
+	// exportjobDescLastError is the schema descriptor for lastError field.
+	exportjobDescLastError := exportjobFields[11].Descriptor()
+	// exportjob.DefaultLastError holds the default value on creation for the lastError field.
+	exportjob.DefaultLastError = exportjobDescLastError.Default.(string)
+	// exportjobDescCreateTime is the schema descriptor for createTime field.
+	exportjobDescCreateTime := exportjobFields[12].Descriptor()
+	// exportjob.DefaultCreateTime holds the default value on creation for the createTime field.
+	exportjob.DefaultCreateTime = exportjobDescCreateTime.Default.(func() time.Time)
+	filemessageFields := schema.FileMessage{}.Fields()
+	_ = filemessageFields
+	// filemessageDescMsgId is the schema descriptor for msgId field.
+	filemessageDescMsgId := filemessageFields[0].Descriptor()
+	// filemessage.MsgIdValidator is a validator for the "msgId" field. It is called by the builders before save.
+	filemessage.MsgIdValidator = filemessageDescMsgId.Validators[0].(func(string) error)
+	// filemessageDescFileName is the schema descriptor for fileName field.
+	filemessageDescFileName := filemessageFields[1].Descriptor()
+	// filemessage.FileNameValidator is a validator for the "fileName" field. It is called by the builders before save.
+	filemessage.FileNameValidator = filemessageDescFileName.Validators[0].(func(string) error)
+	// filemessageDescFileSize is the schema descriptor for fileSize field.
+	filemessageDescFileSize := filemessageFields[2].Descriptor()
+	// filemessage.FileSizeValidator is a validator for the "fileSize" field. It is called by the builders before save.
+	filemessage.FileSizeValidator = filemessageDescFileSize.Validators[0].(func(int64) error)
+	// filemessageDescMimeType is the schema descriptor for mimeType field.
+	filemessageDescMimeType := filemessageFields[3].Descriptor()
+	// filemessage.DefaultMimeType holds the default value on creation for the mimeType field.
+	filemessage.DefaultMimeType = filemessageDescMimeType.Default.(string)
+	// filemessageDescStorageKey is the schema descriptor for storageKey field.
+	filemessageDescStorageKey := filemessageFields[5].Descriptor()
+	// filemessage.StorageKeyValidator is a validator for the "storageKey" field. It is called by the builders before save.
+	filemessage.StorageKeyValidator = filemessageDescStorageKey.Validators[0].(func(string) error)
 	friendrequestFields := schema.FriendRequest{}.Fields()
 	_ = friendrequestFields
 	// friendrequestDescStatus is the schema descriptor for status field.
@@ -67,7 +213,7 @@ func init() {
 	// friendrequest.DefaultStatus holds the default value on creation for the status field.
 	friendrequest.DefaultStatus = friendrequestDescStatus.Default.(int)
 	// friendrequestDescCreateTime is the schema descriptor for createTime field.
-	friendrequestDescCreateTime := friendrequestFields[4].Descriptor()
+	friendrequestDescCreateTime := friendrequestFields[6].Descriptor()
 	// friendrequest.DefaultCreateTime holds the default value on creation for the createTime field.
 	friendrequest.DefaultCreateTime = friendrequestDescCreateTime.Default.(func() time.Time)
 	groupFields := schema.Group{}.Fields()
@@ -84,6 +230,14 @@ func init() {
 	groupDescCreateTime := groupFields[4].Descriptor()
 	// group.DefaultCreateTime holds the default value on creation for the createTime field.
 	group.DefaultCreateTime = groupDescCreateTime.Default.(func() time.Time)
+	// groupDescReadReceiptsEnabled is the schema descriptor for readReceiptsEnabled field.
+	groupDescReadReceiptsEnabled := groupFields[6].Descriptor()
+	// group.DefaultReadReceiptsEnabled holds the default value on creation for the readReceiptsEnabled field.
+	group.DefaultReadReceiptsEnabled = groupDescReadReceiptsEnabled.Default.(bool)
+	// groupDescRetentionDays is the schema descriptor for retentionDays field.
+	groupDescRetentionDays := groupFields[7].Descriptor()
+	// group.DefaultRetentionDays holds the default value on creation for the retentionDays field.
+	group.DefaultRetentionDays = groupDescRetentionDays.Default.(int)
 	groupchatrecordFields := schema.GroupChatRecord{}.Fields()
 	_ = groupchatrecordFields
 	// groupchatrecordDescMsgId is the schema descriptor for msgId field.
@@ -106,6 +260,24 @@ func init() {
 	groupchatrecordDescCreateTime := groupchatrecordFields[4].Descriptor()
 	// groupchatrecord.DefaultCreateTime holds the default value on creation for the createTime field.
 	groupchatrecord.DefaultCreateTime = groupchatrecordDescCreateTime.Default.(func() time.Time)
+	groupwatermarkFields := schema.GroupWatermark{}.Fields()
+	_ = groupwatermarkFields
+	// groupwatermarkDescReadSeq is the schema descriptor for readSeq field.
+	groupwatermarkDescReadSeq := groupwatermarkFields[2].Descriptor()
+	// groupwatermark.DefaultReadSeq holds the default value on creation for the readSeq field.
+	groupwatermark.DefaultReadSeq = groupwatermarkDescReadSeq.Default.(int)
+	// groupwatermarkDescDeliveredSeq is the schema descriptor for deliveredSeq field.
+	groupwatermarkDescDeliveredSeq := groupwatermarkFields[3].Descriptor()
+	// groupwatermark.DefaultDeliveredSeq holds the default value on creation for the deliveredSeq field.
+	groupwatermark.DefaultDeliveredSeq = groupwatermarkDescDeliveredSeq.Default.(int)
+	// groupwatermarkDescJoinSeq is the schema descriptor for joinSeq field.
+	groupwatermarkDescJoinSeq := groupwatermarkFields[4].Descriptor()
+	// groupwatermark.DefaultJoinSeq holds the default value on creation for the joinSeq field.
+	groupwatermark.DefaultJoinSeq = groupwatermarkDescJoinSeq.Default.(int)
+	// groupwatermarkDescCreateTime is the schema descriptor for createTime field.
+	groupwatermarkDescCreateTime := groupwatermarkFields[7].Descriptor()
+	// groupwatermark.DefaultCreateTime holds the default value on creation for the createTime field.
+	groupwatermark.DefaultCreateTime = groupwatermarkDescCreateTime.Default.(func() time.Time)
 	imagemessageFields := schema.ImageMessage{}.Fields()
 	_ = imagemessageFields
 	// imagemessageDescMsgId is the schema descriptor for msgId field.
@@ -116,6 +288,62 @@ func init() {
 	imagemessageDescImageUrl := imagemessageFields[1].Descriptor()
 	// imagemessage.ImageUrlValidator is a validator for the "imageUrl" field. It is called by the builders before save.
 	imagemessage.ImageUrlValidator = imagemessageDescImageUrl.Validators[0].(func(string) error)
+	locationmessageFields := schema.LocationMessage{}.Fields()
+	_ = locationmessageFields
+	// locationmessageDescMsgId is the schema descriptor for msgId field.
+	locationmessageDescMsgId := locationmessageFields[0].Descriptor()
+	// locationmessage.MsgIdValidator is a validator for the "msgId" field. It is called by the builders before save.
+	locationmessage.MsgIdValidator = locationmessageDescMsgId.Validators[0].(func(string) error)
+	// locationmessageDescLatitude is the schema descriptor for latitude field.
+	locationmessageDescLatitude := locationmessageFields[1].Descriptor()
+	// locationmessage.LatitudeValidator is a validator for the "latitude" field. It is called by the builders before save.
+	locationmessage.LatitudeValidator = func() func(float64) error {
+		validators := locationmessageDescLatitude.Validators
+		fns := [...]func(float64) error{
+			validators[0].(func(float64) error),
+			validators[1].(func(float64) error),
+		}
+		return func(latitude float64) error {
+			for _, fn := range fns {
+				if err := fn(latitude); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// locationmessageDescLongitude is the schema descriptor for longitude field.
+	locationmessageDescLongitude := locationmessageFields[2].Descriptor()
+	// locationmessage.LongitudeValidator is a validator for the "longitude" field. It is called by the builders before save.
+	locationmessage.LongitudeValidator = func() func(float64) error {
+		validators := locationmessageDescLongitude.Validators
+		fns := [...]func(float64) error{
+			validators[0].(func(float64) error),
+			validators[1].(func(float64) error),
+		}
+		return func(longitude float64) error {
+			for _, fn := range fns {
+				if err := fn(longitude); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	mergedforwardmessageFields := schema.MergedForwardMessage{}.Fields()
+	_ = mergedforwardmessageFields
+	// mergedforwardmessageDescMsgId is the schema descriptor for msgId field.
+	mergedforwardmessageDescMsgId := mergedforwardmessageFields[0].Descriptor()
+	// mergedforwardmessage.MsgIdValidator is a validator for the "msgId" field. It is called by the builders before save.
+	mergedforwardmessage.MsgIdValidator = mergedforwardmessageDescMsgId.Validators[0].(func(string) error)
+	// mergedforwardmessageDescTitle is the schema descriptor for title field.
+	mergedforwardmessageDescTitle := mergedforwardmessageFields[1].Descriptor()
+	// mergedforwardmessage.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	mergedforwardmessage.TitleValidator = mergedforwardmessageDescTitle.Validators[0].(func(string) error)
+	// mergedforwardmessageDescPayload is the schema descriptor for payload field.
+	mergedforwardmessageDescPayload := mergedforwardmessageFields[2].Descriptor()
+	// mergedforwardmessage.PayloadValidator is a validator for the "payload" field. It is called by the builders before save.
+	mergedforwardmessage.PayloadValidator = mergedforwardmessageDescPayload.Validators[0].(func(string) error)
 	messageFields := schema.Message{}.Fields()
 	_ = messageFields
 	// messageDescMsgId is the schema descriptor for msgId field.
@@ -130,14 +358,138 @@ func init() {
 	messageDescContent := messageFields[2].Descriptor()
 	// message.ContentValidator is a validator for the "content" field. It is called by the builders before save.
 	message.ContentValidator = messageDescContent.Validators[0].(func(string) error)
+	// messageDescBody is the schema descriptor for body field.
+	messageDescBody := messageFields[3].Descriptor()
+	// message.DefaultBody holds the default value on creation for the body field.
+	message.DefaultBody = messageDescBody.Default.(string)
 	// messageDescIsRevoked is the schema descriptor for isRevoked field.
-	messageDescIsRevoked := messageFields[3].Descriptor()
+	messageDescIsRevoked := messageFields[4].Descriptor()
 	// message.DefaultIsRevoked holds the default value on creation for the isRevoked field.
 	message.DefaultIsRevoked = messageDescIsRevoked.Default.(bool)
 	// messageDescCreateTime is the schema descriptor for createTime field.
-	messageDescCreateTime := messageFields[5].Descriptor()
+	messageDescCreateTime := messageFields[6].Descriptor()
 	// message.DefaultCreateTime holds the default value on creation for the createTime field.
 	message.DefaultCreateTime = messageDescCreateTime.Default.(func() time.Time)
+	// messageDescIsPurged is the schema descriptor for isPurged field.
+	messageDescIsPurged := messageFields[8].Descriptor()
+	// message.DefaultIsPurged holds the default value on creation for the isPurged field.
+	message.DefaultIsPurged = messageDescIsPurged.Default.(bool)
+	// messageDescStorageKey is the schema descriptor for storageKey field.
+	messageDescStorageKey := messageFields[10].Descriptor()
+	// message.DefaultStorageKey holds the default value on creation for the storageKey field.
+	message.DefaultStorageKey = messageDescStorageKey.Default.(string)
+	messagedeletionFields := schema.MessageDeletion{}.Fields()
+	_ = messagedeletionFields
+	// messagedeletionDescMsgId is the schema descriptor for msgId field.
+	messagedeletionDescMsgId := messagedeletionFields[1].Descriptor()
+	// messagedeletion.MsgIdValidator is a validator for the "msgId" field. It is called by the builders before save.
+	messagedeletion.MsgIdValidator = messagedeletionDescMsgId.Validators[0].(func(string) error)
+	// messagedeletionDescIsGroup is the schema descriptor for isGroup field.
+	messagedeletionDescIsGroup := messagedeletionFields[2].Descriptor()
+	// messagedeletion.DefaultIsGroup holds the default value on creation for the isGroup field.
+	messagedeletion.DefaultIsGroup = messagedeletionDescIsGroup.Default.(bool)
+	// messagedeletionDescCreateTime is the schema descriptor for createTime field.
+	messagedeletionDescCreateTime := messagedeletionFields[4].Descriptor()
+	// messagedeletion.DefaultCreateTime holds the default value on creation for the createTime field.
+	messagedeletion.DefaultCreateTime = messagedeletionDescCreateTime.Default.(func() time.Time)
+	messageforwardFields := schema.MessageForward{}.Fields()
+	_ = messageforwardFields
+	// messageforwardDescMsgId is the schema descriptor for msgId field.
+	messageforwardDescMsgId := messageforwardFields[0].Descriptor()
+	// messageforward.MsgIdValidator is a validator for the "msgId" field. It is called by the builders before save.
+	messageforward.MsgIdValidator = messageforwardDescMsgId.Validators[0].(func(string) error)
+	// messageforwardDescSourceMsgId is the schema descriptor for sourceMsgId field.
+	messageforwardDescSourceMsgId := messageforwardFields[1].Descriptor()
+	// messageforward.SourceMsgIdValidator is a validator for the "sourceMsgId" field. It is called by the builders before save.
+	messageforward.SourceMsgIdValidator = messageforwardDescSourceMsgId.Validators[0].(func(string) error)
+	// messageforwardDescSourceIsGroup is the schema descriptor for sourceIsGroup field.
+	messageforwardDescSourceIsGroup := messageforwardFields[3].Descriptor()
+	// messageforward.DefaultSourceIsGroup holds the default value on creation for the sourceIsGroup field.
+	messageforward.DefaultSourceIsGroup = messageforwardDescSourceIsGroup.Default.(bool)
+	// messageforwardDescCreateTime is the schema descriptor for createTime field.
+	messageforwardDescCreateTime := messageforwardFields[7].Descriptor()
+	// messageforward.DefaultCreateTime holds the default value on creation for the createTime field.
+	messageforward.DefaultCreateTime = messageforwardDescCreateTime.Default.(func() time.Time)
+	messagementionFields := schema.MessageMention{}.Fields()
+	_ = messagementionFields
+	// messagementionDescMsgId is the schema descriptor for msgId field.
+	messagementionDescMsgId := messagementionFields[0].Descriptor()
+	// messagemention.MsgIdValidator is a validator for the "msgId" field. It is called by the builders before save.
+	messagemention.MsgIdValidator = messagementionDescMsgId.Validators[0].(func(string) error)
+	// messagementionDescMentionedUserId is the schema descriptor for mentionedUserId field.
+	messagementionDescMentionedUserId := messagementionFields[3].Descriptor()
+	// messagemention.DefaultMentionedUserId holds the default value on creation for the mentionedUserId field.
+	messagemention.DefaultMentionedUserId = messagementionDescMentionedUserId.Default.(int)
+	// messagementionDescIsAll is the schema descriptor for isAll field.
+	messagementionDescIsAll := messagementionFields[4].Descriptor()
+	// messagemention.DefaultIsAll holds the default value on creation for the isAll field.
+	messagemention.DefaultIsAll = messagementionDescIsAll.Default.(bool)
+	// messagementionDescOffset is the schema descriptor for offset field.
+	messagementionDescOffset := messagementionFields[5].Descriptor()
+	// messagemention.DefaultOffset holds the default value on creation for the offset field.
+	messagemention.DefaultOffset = messagementionDescOffset.Default.(int)
+	// messagementionDescLength is the schema descriptor for length field.
+	messagementionDescLength := messagementionFields[6].Descriptor()
+	// messagemention.DefaultLength holds the default value on creation for the length field.
+	messagemention.DefaultLength = messagementionDescLength.Default.(int)
+	// messagementionDescCreateTime is the schema descriptor for createTime field.
+	messagementionDescCreateTime := messagementionFields[7].Descriptor()
+	// messagemention.DefaultCreateTime holds the default value on creation for the createTime field.
+	messagemention.DefaultCreateTime = messagementionDescCreateTime.Default.(func() time.Time)
+	messageoutboxFields := schema.MessageOutbox{}.Fields()
+	_ = messageoutboxFields
+	// messageoutboxDescMsgId is the schema descriptor for msgId field.
+	messageoutboxDescMsgId := messageoutboxFields[0].Descriptor()
+	// messageoutbox.MsgIdValidator is a validator for the "msgId" field. It is called by the builders before save.
+	messageoutbox.MsgIdValidator = messageoutboxDescMsgId.Validators[0].(func(string) error)
+	// messageoutboxDescStatus is the schema descriptor for status field.
+	messageoutboxDescStatus := messageoutboxFields[2].Descriptor()
+	// messageoutbox.DefaultStatus holds the default value on creation for the status field.
+	messageoutbox.DefaultStatus = messageoutboxDescStatus.Default.(string)
+	// messageoutboxDescAttempts is the schema descriptor for attempts field.
+	messageoutboxDescAttempts := messageoutboxFields[3].Descriptor()
+	// messageoutbox.DefaultAttempts holds the default value on creation for the attempts field.
+	messageoutbox.DefaultAttempts = messageoutboxDescAttempts.Default.(int)
+	// messageoutboxDescNextAttemptTime is the schema descriptor for nextAttemptTime field.
+	messageoutboxDescNextAttemptTime := messageoutboxFields[4].Descriptor()
+	// messageoutbox.DefaultNextAttemptTime holds the default value on creation for the nextAttemptTime field.
+	messageoutbox.DefaultNextAttemptTime = messageoutboxDescNextAttemptTime.Default.(func() time.Time)
+	// messageoutboxDescLeaseToken is the schema descriptor for leaseToken field.
+	messageoutboxDescLeaseToken := messageoutboxFields[6].Descriptor()
+	// messageoutbox.DefaultLeaseToken holds the default value on creation for the leaseToken field.
+	messageoutbox.DefaultLeaseToken = messageoutboxDescLeaseToken.Default.(string)
+	// messageoutboxDescCreateTime is the schema descriptor for createTime field.
+	messageoutboxDescCreateTime := messageoutboxFields[7].Descriptor()
+	// messageoutbox.DefaultCreateTime holds the default value on creation for the createTime field.
+	messageoutbox.DefaultCreateTime = messageoutboxDescCreateTime.Default.(func() time.Time)
+	messagereactionFields := schema.MessageReaction{}.Fields()
+	_ = messagereactionFields
+	// messagereactionDescMsgId is the schema descriptor for msgId field.
+	messagereactionDescMsgId := messagereactionFields[0].Descriptor()
+	// messagereaction.MsgIdValidator is a validator for the "msgId" field. It is called by the builders before save.
+	messagereaction.MsgIdValidator = messagereactionDescMsgId.Validators[0].(func(string) error)
+	// messagereactionDescEmoji is the schema descriptor for emoji field.
+	messagereactionDescEmoji := messagereactionFields[2].Descriptor()
+	// messagereaction.EmojiValidator is a validator for the "emoji" field. It is called by the builders before save.
+	messagereaction.EmojiValidator = func() func(string) error {
+		validators := messagereactionDescEmoji.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(emoji string) error {
+			for _, fn := range fns {
+				if err := fn(emoji); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// messagereactionDescCreateTime is the schema descriptor for createTime field.
+	messagereactionDescCreateTime := messagereactionFields[3].Descriptor()
+	// messagereaction.DefaultCreateTime holds the default value on creation for the createTime field.
+	messagereaction.DefaultCreateTime = messagereactionDescCreateTime.Default.(func() time.Time)
 	messagestatusFields := schema.MessageStatus{}.Fields()
 	_ = messagestatusFields
 	// messagestatusDescMsgId is the schema descriptor for msgId field.
@@ -156,6 +508,98 @@ func init() {
 	messagestatusDescCreateTime := messagestatusFields[6].Descriptor()
 	// messagestatus.DefaultCreateTime holds the default value on creation for the createTime field.
 	messagestatus.DefaultCreateTime = messagestatusDescCreateTime.Default.(func() time.Time)
+	retentionjobFields := schema.RetentionJob{}.Fields()
+	_ = retentionjobFields
+	// retentionjobDescStatus is the schema descriptor for status field.
+	retentionjobDescStatus := retentionjobFields[0].Descriptor()
+	// retentionjob.DefaultStatus holds the default value on creation for the status field.
+	retentionjob.DefaultStatus = retentionjobDescStatus.Default.(string)
+	// retentionjobDescCursor is the schema descriptor for cursor field.
+	retentionjobDescCursor := retentionjobFields[1].Descriptor()
+	// retentionjob.DefaultCursor holds the default value on creation for the cursor field.
+	retentionjob.DefaultCursor = retentionjobDescCursor.Default.(int)
+	// retentionjobDescMaxMessageId is the schema descriptor for maxMessageId field.
+	retentionjobDescMaxMessageId := retentionjobFields[2].Descriptor()
+	// retentionjob.DefaultMaxMessageId holds the default value on creation for the maxMessageId field.
+	retentionjob.DefaultMaxMessageId = retentionjobDescMaxMessageId.Default.(int)
+	// retentionjobDescScanned is the schema descriptor for scanned field.
+	retentionjobDescScanned := retentionjobFields[3].Descriptor()
+	// retentionjob.DefaultScanned holds the default value on creation for the scanned field.
+	retentionjob.DefaultScanned = retentionjobDescScanned.Default.(int)
+	// retentionjobDescPurged is the schema descriptor for purged field.
+	retentionjobDescPurged := retentionjobFields[4].Descriptor()
+	// retentionjob.DefaultPurged holds the default value on creation for the purged field.
+	retentionjob.DefaultPurged = retentionjobDescPurged.Default.(int)
+	// retentionjobDescArchived is the schema descriptor for archived field.
+	retentionjobDescArchived := retentionjobFields[5].Descriptor()
+	// retentionjob.DefaultArchived holds the default value on creation for the archived field.
+	retentionjob.DefaultArchived = retentionjobDescArchived.Default.(int)
+	// retentionjobDescMediaDeleted is the schema descriptor for mediaDeleted field.
+	retentionjobDescMediaDeleted := retentionjobFields[6].Descriptor()
+	// retentionjob.DefaultMediaDeleted holds the default value on creation for the mediaDeleted field.
+	retentionjob.DefaultMediaDeleted = retentionjobDescMediaDeleted.Default.(int)
+	// retentionjobDescLastError is the schema descriptor for lastError field.
+	retentionjobDescLastError := retentionjobFields[7].Descriptor()
+	// retentionjob.DefaultLastError holds the default value on creation for the lastError field.
+	retentionjob.DefaultLastError = retentionjobDescLastError.Default.(string)
+	// retentionjobDescStartTime is the schema descriptor for startTime field.
+	retentionjobDescStartTime := retentionjobFields[8].Descriptor()
+	// retentionjob.DefaultStartTime holds the default value on creation for the startTime field.
+	retentionjob.DefaultStartTime = retentionjobDescStartTime.Default.(func() time.Time)
+	// retentionjobDescUpdateTime is the schema descriptor for updateTime field.
+	retentionjobDescUpdateTime := retentionjobFields[9].Descriptor()
+	// retentionjob.DefaultUpdateTime holds the default value on creation for the updateTime field.
+	retentionjob.DefaultUpdateTime = retentionjobDescUpdateTime.Default.(func() time.Time)
+	// retentionjob.UpdateDefaultUpdateTime holds the default value on update for the updateTime field.
+	retentionjob.UpdateDefaultUpdateTime = retentionjobDescUpdateTime.UpdateDefault.(func() time.Time)
+	scheduledmessageFields := schema.ScheduledMessage{}.Fields()
+	_ = scheduledmessageFields
+	// scheduledmessageDescToUserId is the schema descriptor for toUserId field.
+	scheduledmessageDescToUserId := scheduledmessageFields[1].Descriptor()
+	// scheduledmessage.DefaultToUserId holds the default value on creation for the toUserId field.
+	scheduledmessage.DefaultToUserId = scheduledmessageDescToUserId.Default.(int)
+	// scheduledmessageDescGroupId is the schema descriptor for groupId field.
+	scheduledmessageDescGroupId := scheduledmessageFields[2].Descriptor()
+	// scheduledmessage.DefaultGroupId holds the default value on creation for the groupId field.
+	scheduledmessage.DefaultGroupId = scheduledmessageDescGroupId.Default.(int)
+	// scheduledmessageDescMentions is the schema descriptor for mentions field.
+	scheduledmessageDescMentions := scheduledmessageFields[5].Descriptor()
+	// scheduledmessage.DefaultMentions holds the default value on creation for the mentions field.
+	scheduledmessage.DefaultMentions = scheduledmessageDescMentions.Default.(string)
+	// scheduledmessageDescReplyToMsgId is the schema descriptor for replyToMsgId field.
+	scheduledmessageDescReplyToMsgId := scheduledmessageFields[6].Descriptor()
+	// scheduledmessage.DefaultReplyToMsgId holds the default value on creation for the replyToMsgId field.
+	scheduledmessage.DefaultReplyToMsgId = scheduledmessageDescReplyToMsgId.Default.(string)
+	// scheduledmessageDescStatus is the schema descriptor for status field.
+	scheduledmessageDescStatus := scheduledmessageFields[8].Descriptor()
+	// scheduledmessage.DefaultStatus holds the default value on creation for the status field.
+	scheduledmessage.DefaultStatus = scheduledmessageDescStatus.Default.(string)
+	// scheduledmessageDescAttempts is the schema descriptor for attempts field.
+	scheduledmessageDescAttempts := scheduledmessageFields[10].Descriptor()
+	// scheduledmessage.DefaultAttempts holds the default value on creation for the attempts field.
+	scheduledmessage.DefaultAttempts = scheduledmessageDescAttempts.Default.(int)
+	// scheduledmessageDescRetries is the schema descriptor for retries field.
+	scheduledmessageDescRetries := scheduledmessageFields[11].Descriptor()
+	// scheduledmessage.DefaultRetries holds the default value on creation for the retries field.
+	scheduledmessage.DefaultRetries = scheduledmessageDescRetries.Default.(int)
+	// scheduledmessageDescMsgId is the schema descriptor for msgId field.
+	scheduledmessageDescMsgId := scheduledmessageFields[12].Descriptor()
+	// scheduledmessage.DefaultMsgId holds the default value on creation for the msgId field.
+	scheduledmessage.DefaultMsgId = scheduledmessageDescMsgId.Default.(string)
+	// scheduledmessageDescFailReason is the schema descriptor for failReason field.
+	scheduledmessageDescFailReason := scheduledmessageFields[13].Descriptor()
+	// scheduledmessage.DefaultFailReason holds the default value on creation for the failReason field.
+	scheduledmessage.DefaultFailReason = scheduledmessageDescFailReason.Default.(string)
+	// scheduledmessageDescCreateTime is the schema descriptor for createTime field.
+	scheduledmessageDescCreateTime := scheduledmessageFields[14].Descriptor()
+	// scheduledmessage.DefaultCreateTime holds the default value on creation for the createTime field.
+	scheduledmessage.DefaultCreateTime = scheduledmessageDescCreateTime.Default.(func() time.Time)
+	// scheduledmessageDescUpdateTime is the schema descriptor for updateTime field.
+	scheduledmessageDescUpdateTime := scheduledmessageFields[15].Descriptor()
+	// scheduledmessage.DefaultUpdateTime holds the default value on creation for the updateTime field.
+	scheduledmessage.DefaultUpdateTime = scheduledmessageDescUpdateTime.Default.(func() time.Time)
+	// scheduledmessage.UpdateDefaultUpdateTime holds the default value on update for the updateTime field.
+	scheduledmessage.UpdateDefaultUpdateTime = scheduledmessageDescUpdateTime.UpdateDefault.(func() time.Time)
 	textmessageFields := schema.TextMessage{}.Fields()
 	_ = textmessageFields
 	// textmessageDescMsgId is the schema descriptor for msgId field.
@@ -194,4 +638,26 @@ func init() {
 	videomessageDescVideoUrl := videomessageFields[1].Descriptor()
 	// videomessage.VideoUrlValidator is a validator for the "videoUrl" field. It is called by the builders before save.
 	videomessage.VideoUrlValidator = videomessageDescVideoUrl.Validators[0].(func(string) error)
+	voicemessageFields := schema.VoiceMessage{}.Fields()
+	_ = voicemessageFields
+	// voicemessageDescMsgId is the schema descriptor for msgId field.
+	voicemessageDescMsgId := voicemessageFields[0].Descriptor()
+	// voicemessage.MsgIdValidator is a validator for the "msgId" field. It is called by the builders before save.
+	voicemessage.MsgIdValidator = voicemessageDescMsgId.Validators[0].(func(string) error)
+	// voicemessageDescStorageKey is the schema descriptor for storageKey field.
+	voicemessageDescStorageKey := voicemessageFields[1].Descriptor()
+	// voicemessage.StorageKeyValidator is a validator for the "storageKey" field. It is called by the builders before save.
+	voicemessage.StorageKeyValidator = voicemessageDescStorageKey.Validators[0].(func(string) error)
+	// voicemessageDescMimeType is the schema descriptor for mimeType field.
+	voicemessageDescMimeType := voicemessageFields[2].Descriptor()
+	// voicemessage.DefaultMimeType holds the default value on creation for the mimeType field.
+	voicemessage.DefaultMimeType = voicemessageDescMimeType.Default.(string)
+	// voicemessageDescFileSize is the schema descriptor for fileSize field.
+	voicemessageDescFileSize := voicemessageFields[3].Descriptor()
+	// voicemessage.FileSizeValidator is a validator for the "fileSize" field. It is called by the builders before save.
+	voicemessage.FileSizeValidator = voicemessageDescFileSize.Validators[0].(func(int64) error)
+	// voicemessageDescDuration is the schema descriptor for duration field.
+	voicemessageDescDuration := voicemessageFields[4].Descriptor()
+	// voicemessage.DurationValidator is a validator for the "duration" field. It is called by the builders before save.
+	voicemessage.DurationValidator = voicemessageDescDuration.Validators[0].(func(int) error)
 }
@@ -0,0 +1,274 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"gochat_server/ent/scheduledmessage"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ScheduledMessage is the model entity for the ScheduledMessage schema.
+type ScheduledMessage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 发送者ID
+	UserId int `json:"userId,omitempty"`
+	// 接收者ID，群消息为0
+	ToUserId int `json:"toUserId,omitempty"`
+	// 群组ID，私聊消息为0
+	GroupId int `json:"groupId,omitempty"`
+	// 消息类型
+	MsgType int `json:"msgType,omitempty"`
+	// 消息内容，与发送消息接口的 content 相同
+	Content string `json:"content,omitempty"`
+	// @提及(JSON)
+	Mentions string `json:"mentions,omitempty"`
+	// 被回复的消息ID
+	ReplyToMsgId string `json:"replyToMsgId,omitempty"`
+	// 计划发送时间
+	SendTime time.Time `json:"sendTime,omitempty"`
+	// 状态: pending 待发送, sending 发送中, sent 已发送, failed 发送失败, canceled 已取消
+	Status string `json:"status,omitempty"`
+	// 发送中记录的租约到期时间，调度器崩溃后由其他调度器重新领取；待发送记录因临时错误等待重试时为下次重试时间
+	LeaseTime *time.Time `json:"leaseTime,omitempty"`
+	// 被调度器领取的次数，用于判断领取是否仍然有效
+	Attempts int `json:"attempts,omitempty"`
+	// 因临时错误（如数据库超时）重试的次数，修改后清零
+	Retries int `json:"retries,omitempty"`
+	// 发送后生成的消息ID
+	MsgId string `json:"msgId,omitempty"`
+	// 发送失败的原因
+	FailReason string `json:"failReason,omitempty"`
+	// 创建时间
+	CreateTime time.Time `json:"createTime,omitempty"`
+	// 更新时间
+	UpdateTime   time.Time `json:"updateTime,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ScheduledMessage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case scheduledmessage.FieldID, scheduledmessage.FieldUserId, scheduledmessage.FieldToUserId, scheduledmessage.FieldGroupId, scheduledmessage.FieldMsgType, scheduledmessage.FieldAttempts, scheduledmessage.FieldRetries:
+			values[i] = new(sql.NullInt64)
+		case scheduledmessage.FieldContent, scheduledmessage.FieldMentions, scheduledmessage.FieldReplyToMsgId, scheduledmessage.FieldStatus, scheduledmessage.FieldMsgId, scheduledmessage.FieldFailReason:
+			values[i] = new(sql.NullString)
+		case scheduledmessage.FieldSendTime, scheduledmessage.FieldLeaseTime, scheduledmessage.FieldCreateTime, scheduledmessage.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ScheduledMessage fields.
+func (sm *ScheduledMessage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case scheduledmessage.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sm.ID = int(value.Int64)
+		case scheduledmessage.FieldUserId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field userId", values[i])
+			} else if value.Valid {
+				sm.UserId = int(value.Int64)
+			}
+		case scheduledmessage.FieldToUserId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field toUserId", values[i])
+			} else if value.Valid {
+				sm.ToUserId = int(value.Int64)
+			}
+		case scheduledmessage.FieldGroupId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field groupId", values[i])
+			} else if value.Valid {
+				sm.GroupId = int(value.Int64)
+			}
+		case scheduledmessage.FieldMsgType:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field msgType", values[i])
+			} else if value.Valid {
+				sm.MsgType = int(value.Int64)
+			}
+		case scheduledmessage.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				sm.Content = value.String
+			}
+		case scheduledmessage.FieldMentions:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mentions", values[i])
+			} else if value.Valid {
+				sm.Mentions = value.String
+			}
+		case scheduledmessage.FieldReplyToMsgId:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field replyToMsgId", values[i])
+			} else if value.Valid {
+				sm.ReplyToMsgId = value.String
+			}
+		case scheduledmessage.FieldSendTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field sendTime", values[i])
+			} else if value.Valid {
+				sm.SendTime = value.Time
+			}
+		case scheduledmessage.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				sm.Status = value.String
+			}
+		case scheduledmessage.FieldLeaseTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field leaseTime", values[i])
+			} else if value.Valid {
+				sm.LeaseTime = new(time.Time)
+				*sm.LeaseTime = value.Time
+			}
+		case scheduledmessage.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				sm.Attempts = int(value.Int64)
+			}
+		case scheduledmessage.FieldRetries:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field retries", values[i])
+			} else if value.Valid {
+				sm.Retries = int(value.Int64)
+			}
+		case scheduledmessage.FieldMsgId:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field msgId", values[i])
+			} else if value.Valid {
+				sm.MsgId = value.String
+			}
+		case scheduledmessage.FieldFailReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field failReason", values[i])
+			} else if value.Valid {
+				sm.FailReason = value.String
+			}
+		case scheduledmessage.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field createTime", values[i])
+			} else if value.Valid {
+				sm.CreateTime = value.Time
+			}
+		case scheduledmessage.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updateTime", values[i])
+			} else if value.Valid {
+				sm.UpdateTime = value.Time
+			}
+		default:
+			sm.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ScheduledMessage.
+// This includes values selected through modifiers, order, etc.
+func (sm *ScheduledMessage) Value(name string) (ent.Value, error) {
+	return sm.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ScheduledMessage.
+// Note that you need to call ScheduledMessage.Unwrap() before calling this method if this ScheduledMessage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sm *ScheduledMessage) Update() *ScheduledMessageUpdateOne {
+	return NewScheduledMessageClient(sm.config).UpdateOne(sm)
+}
+
+// Unwrap unwraps the ScheduledMessage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sm *ScheduledMessage) Unwrap() *ScheduledMessage {
+	_tx, ok := sm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ScheduledMessage is not a transactional entity")
+	}
+	sm.config.driver = _tx.drv
+	return sm
+}
+
+// String implements the fmt.Stringer.
+func (sm *ScheduledMessage) String() string {
+	var builder strings.Builder
+	builder.WriteString("ScheduledMessage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sm.ID))
+	builder.WriteString("userId=")
+	builder.WriteString(fmt.Sprintf("%v", sm.UserId))
+	builder.WriteString(", ")
+	builder.WriteString("toUserId=")
+	builder.WriteString(fmt.Sprintf("%v", sm.ToUserId))
+	builder.WriteString(", ")
+	builder.WriteString("groupId=")
+	builder.WriteString(fmt.Sprintf("%v", sm.GroupId))
+	builder.WriteString(", ")
+	builder.WriteString("msgType=")
+	builder.WriteString(fmt.Sprintf("%v", sm.MsgType))
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(sm.Content)
+	builder.WriteString(", ")
+	builder.WriteString("mentions=")
+	builder.WriteString(sm.Mentions)
+	builder.WriteString(", ")
+	builder.WriteString("replyToMsgId=")
+	builder.WriteString(sm.ReplyToMsgId)
+	builder.WriteString(", ")
+	builder.WriteString("sendTime=")
+	builder.WriteString(sm.SendTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(sm.Status)
+	builder.WriteString(", ")
+	if v := sm.LeaseTime; v != nil {
+		builder.WriteString("leaseTime=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", sm.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("retries=")
+	builder.WriteString(fmt.Sprintf("%v", sm.Retries))
+	builder.WriteString(", ")
+	builder.WriteString("msgId=")
+	builder.WriteString(sm.MsgId)
+	builder.WriteString(", ")
+	builder.WriteString("failReason=")
+	builder.WriteString(sm.FailReason)
+	builder.WriteString(", ")
+	builder.WriteString("createTime=")
+	builder.WriteString(sm.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updateTime=")
+	builder.WriteString(sm.UpdateTime.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ScheduledMessages is a parsable slice of ScheduledMessage.
+type ScheduledMessages []*ScheduledMessage
@@ -0,0 +1,196 @@
+// Code generated by ent, DO NOT EDIT.
+
+package scheduledmessage
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the scheduledmessage type in the database.
+	Label = "scheduled_message"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserId holds the string denoting the userid field in the database.
+	FieldUserId = "user_id"
+	// FieldToUserId holds the string denoting the touserid field in the database.
+	FieldToUserId = "to_user_id"
+	// FieldGroupId holds the string denoting the groupid field in the database.
+	FieldGroupId = "group_id"
+	// FieldMsgType holds the string denoting the msgtype field in the database.
+	FieldMsgType = "msg_type"
+	// FieldContent holds the string denoting the content field in the database.
+	FieldContent = "content"
+	// FieldMentions holds the string denoting the mentions field in the database.
+	FieldMentions = "mentions"
+	// FieldReplyToMsgId holds the string denoting the replytomsgid field in the database.
+	FieldReplyToMsgId = "reply_to_msg_id"
+	// FieldSendTime holds the string denoting the sendtime field in the database.
+	FieldSendTime = "send_time"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldLeaseTime holds the string denoting the leasetime field in the database.
+	FieldLeaseTime = "lease_time"
+	// FieldAttempts holds the string denoting the attempts field in the database.
+	FieldAttempts = "attempts"
+	// FieldRetries holds the string denoting the retries field in the database.
+	FieldRetries = "retries"
+	// FieldMsgId holds the string denoting the msgid field in the database.
+	FieldMsgId = "msg_id"
+	// FieldFailReason holds the string denoting the failreason field in the database.
+	FieldFailReason = "fail_reason"
+	// FieldCreateTime holds the string denoting the createtime field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the updatetime field in the database.
+	FieldUpdateTime = "update_time"
+	// Table holds the table name of the scheduledmessage in the database.
+	Table = "scheduled_messages"
+)
+
+// Columns holds all SQL columns for scheduledmessage fields.
+var Columns = []string{
+	FieldID,
+	FieldUserId,
+	FieldToUserId,
+	FieldGroupId,
+	FieldMsgType,
+	FieldContent,
+	FieldMentions,
+	FieldReplyToMsgId,
+	FieldSendTime,
+	FieldStatus,
+	FieldLeaseTime,
+	FieldAttempts,
+	FieldRetries,
+	FieldMsgId,
+	FieldFailReason,
+	FieldCreateTime,
+	FieldUpdateTime,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultToUserId holds the default value on creation for the "toUserId" field.
+	DefaultToUserId int
+	// DefaultGroupId holds the default value on creation for the "groupId" field.
+	DefaultGroupId int
+	// DefaultMentions holds the default value on creation for the "mentions" field.
+	DefaultMentions string
+	// DefaultReplyToMsgId holds the default value on creation for the "replyToMsgId" field.
+	DefaultReplyToMsgId string
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultAttempts holds the default value on creation for the "attempts" field.
+	DefaultAttempts int
+	// DefaultRetries holds the default value on creation for the "retries" field.
+	DefaultRetries int
+	// DefaultMsgId holds the default value on creation for the "msgId" field.
+	DefaultMsgId string
+	// DefaultFailReason holds the default value on creation for the "failReason" field.
+	DefaultFailReason string
+	// DefaultCreateTime holds the default value on creation for the "createTime" field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "updateTime" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "updateTime" field.
+	UpdateDefaultUpdateTime func() time.Time
+)
+
+// OrderOption defines the ordering options for the ScheduledMessage queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserId orders the results by the userId field.
+func ByUserId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserId, opts...).ToFunc()
+}
+
+// ByToUserId orders the results by the toUserId field.
+func ByToUserId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToUserId, opts...).ToFunc()
+}
+
+// ByGroupId orders the results by the groupId field.
+func ByGroupId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGroupId, opts...).ToFunc()
+}
+
+// ByMsgType orders the results by the msgType field.
+func ByMsgType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMsgType, opts...).ToFunc()
+}
+
+// ByContent orders the results by the content field.
+func ByContent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContent, opts...).ToFunc()
+}
+
+// ByMentions orders the results by the mentions field.
+func ByMentions(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMentions, opts...).ToFunc()
+}
+
+// ByReplyToMsgId orders the results by the replyToMsgId field.
+func ByReplyToMsgId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReplyToMsgId, opts...).ToFunc()
+}
+
+// BySendTime orders the results by the sendTime field.
+func BySendTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSendTime, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByLeaseTime orders the results by the leaseTime field.
+func ByLeaseTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLeaseTime, opts...).ToFunc()
+}
+
+// ByAttempts orders the results by the attempts field.
+func ByAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttempts, opts...).ToFunc()
+}
+
+// ByRetries orders the results by the retries field.
+func ByRetries(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRetries, opts...).ToFunc()
+}
+
+// ByMsgId orders the results by the msgId field.
+func ByMsgId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMsgId, opts...).ToFunc()
+}
+
+// ByFailReason orders the results by the failReason field.
+func ByFailReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailReason, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the createTime field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the updateTime field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
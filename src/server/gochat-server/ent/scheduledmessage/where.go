@@ -0,0 +1,950 @@
+// Code generated by ent, DO NOT EDIT.
+
+package scheduledmessage
+
+import (
+	"gochat_server/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldID, id))
+}
+
+// UserId applies equality check predicate on the "userId" field. It's identical to UserIdEQ.
+func UserId(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldUserId, v))
+}
+
+// ToUserId applies equality check predicate on the "toUserId" field. It's identical to ToUserIdEQ.
+func ToUserId(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldToUserId, v))
+}
+
+// GroupId applies equality check predicate on the "groupId" field. It's identical to GroupIdEQ.
+func GroupId(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldGroupId, v))
+}
+
+// MsgType applies equality check predicate on the "msgType" field. It's identical to MsgTypeEQ.
+func MsgType(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldMsgType, v))
+}
+
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldContent, v))
+}
+
+// Mentions applies equality check predicate on the "mentions" field. It's identical to MentionsEQ.
+func Mentions(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldMentions, v))
+}
+
+// ReplyToMsgId applies equality check predicate on the "replyToMsgId" field. It's identical to ReplyToMsgIdEQ.
+func ReplyToMsgId(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldReplyToMsgId, v))
+}
+
+// SendTime applies equality check predicate on the "sendTime" field. It's identical to SendTimeEQ.
+func SendTime(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldSendTime, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldStatus, v))
+}
+
+// LeaseTime applies equality check predicate on the "leaseTime" field. It's identical to LeaseTimeEQ.
+func LeaseTime(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldLeaseTime, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldAttempts, v))
+}
+
+// Retries applies equality check predicate on the "retries" field. It's identical to RetriesEQ.
+func Retries(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldRetries, v))
+}
+
+// MsgId applies equality check predicate on the "msgId" field. It's identical to MsgIdEQ.
+func MsgId(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldMsgId, v))
+}
+
+// FailReason applies equality check predicate on the "failReason" field. It's identical to FailReasonEQ.
+func FailReason(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldFailReason, v))
+}
+
+// CreateTime applies equality check predicate on the "createTime" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "updateTime" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UserIdEQ applies the EQ predicate on the "userId" field.
+func UserIdEQ(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldUserId, v))
+}
+
+// UserIdNEQ applies the NEQ predicate on the "userId" field.
+func UserIdNEQ(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldUserId, v))
+}
+
+// UserIdIn applies the In predicate on the "userId" field.
+func UserIdIn(vs ...int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldUserId, vs...))
+}
+
+// UserIdNotIn applies the NotIn predicate on the "userId" field.
+func UserIdNotIn(vs ...int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldUserId, vs...))
+}
+
+// UserIdGT applies the GT predicate on the "userId" field.
+func UserIdGT(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldUserId, v))
+}
+
+// UserIdGTE applies the GTE predicate on the "userId" field.
+func UserIdGTE(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldUserId, v))
+}
+
+// UserIdLT applies the LT predicate on the "userId" field.
+func UserIdLT(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldUserId, v))
+}
+
+// UserIdLTE applies the LTE predicate on the "userId" field.
+func UserIdLTE(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldUserId, v))
+}
+
+// ToUserIdEQ applies the EQ predicate on the "toUserId" field.
+func ToUserIdEQ(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldToUserId, v))
+}
+
+// ToUserIdNEQ applies the NEQ predicate on the "toUserId" field.
+func ToUserIdNEQ(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldToUserId, v))
+}
+
+// ToUserIdIn applies the In predicate on the "toUserId" field.
+func ToUserIdIn(vs ...int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldToUserId, vs...))
+}
+
+// ToUserIdNotIn applies the NotIn predicate on the "toUserId" field.
+func ToUserIdNotIn(vs ...int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldToUserId, vs...))
+}
+
+// ToUserIdGT applies the GT predicate on the "toUserId" field.
+func ToUserIdGT(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldToUserId, v))
+}
+
+// ToUserIdGTE applies the GTE predicate on the "toUserId" field.
+func ToUserIdGTE(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldToUserId, v))
+}
+
+// ToUserIdLT applies the LT predicate on the "toUserId" field.
+func ToUserIdLT(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldToUserId, v))
+}
+
+// ToUserIdLTE applies the LTE predicate on the "toUserId" field.
+func ToUserIdLTE(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldToUserId, v))
+}
+
+// GroupIdEQ applies the EQ predicate on the "groupId" field.
+func GroupIdEQ(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldGroupId, v))
+}
+
+// GroupIdNEQ applies the NEQ predicate on the "groupId" field.
+func GroupIdNEQ(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldGroupId, v))
+}
+
+// GroupIdIn applies the In predicate on the "groupId" field.
+func GroupIdIn(vs ...int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldGroupId, vs...))
+}
+
+// GroupIdNotIn applies the NotIn predicate on the "groupId" field.
+func GroupIdNotIn(vs ...int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldGroupId, vs...))
+}
+
+// GroupIdGT applies the GT predicate on the "groupId" field.
+func GroupIdGT(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldGroupId, v))
+}
+
+// GroupIdGTE applies the GTE predicate on the "groupId" field.
+func GroupIdGTE(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldGroupId, v))
+}
+
+// GroupIdLT applies the LT predicate on the "groupId" field.
+func GroupIdLT(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldGroupId, v))
+}
+
+// GroupIdLTE applies the LTE predicate on the "groupId" field.
+func GroupIdLTE(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldGroupId, v))
+}
+
+// MsgTypeEQ applies the EQ predicate on the "msgType" field.
+func MsgTypeEQ(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldMsgType, v))
+}
+
+// MsgTypeNEQ applies the NEQ predicate on the "msgType" field.
+func MsgTypeNEQ(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldMsgType, v))
+}
+
+// MsgTypeIn applies the In predicate on the "msgType" field.
+func MsgTypeIn(vs ...int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldMsgType, vs...))
+}
+
+// MsgTypeNotIn applies the NotIn predicate on the "msgType" field.
+func MsgTypeNotIn(vs ...int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldMsgType, vs...))
+}
+
+// MsgTypeGT applies the GT predicate on the "msgType" field.
+func MsgTypeGT(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldMsgType, v))
+}
+
+// MsgTypeGTE applies the GTE predicate on the "msgType" field.
+func MsgTypeGTE(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldMsgType, v))
+}
+
+// MsgTypeLT applies the LT predicate on the "msgType" field.
+func MsgTypeLT(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldMsgType, v))
+}
+
+// MsgTypeLTE applies the LTE predicate on the "msgType" field.
+func MsgTypeLTE(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldMsgType, v))
+}
+
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldContent, v))
+}
+
+// ContentContains applies the Contains predicate on the "content" field.
+func ContentContains(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContains(FieldContent, v))
+}
+
+// ContentHasPrefix applies the HasPrefix predicate on the "content" field.
+func ContentHasPrefix(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldHasPrefix(FieldContent, v))
+}
+
+// ContentHasSuffix applies the HasSuffix predicate on the "content" field.
+func ContentHasSuffix(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldHasSuffix(FieldContent, v))
+}
+
+// ContentEqualFold applies the EqualFold predicate on the "content" field.
+func ContentEqualFold(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEqualFold(FieldContent, v))
+}
+
+// ContentContainsFold applies the ContainsFold predicate on the "content" field.
+func ContentContainsFold(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContainsFold(FieldContent, v))
+}
+
+// MentionsEQ applies the EQ predicate on the "mentions" field.
+func MentionsEQ(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldMentions, v))
+}
+
+// MentionsNEQ applies the NEQ predicate on the "mentions" field.
+func MentionsNEQ(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldMentions, v))
+}
+
+// MentionsIn applies the In predicate on the "mentions" field.
+func MentionsIn(vs ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldMentions, vs...))
+}
+
+// MentionsNotIn applies the NotIn predicate on the "mentions" field.
+func MentionsNotIn(vs ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldMentions, vs...))
+}
+
+// MentionsGT applies the GT predicate on the "mentions" field.
+func MentionsGT(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldMentions, v))
+}
+
+// MentionsGTE applies the GTE predicate on the "mentions" field.
+func MentionsGTE(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldMentions, v))
+}
+
+// MentionsLT applies the LT predicate on the "mentions" field.
+func MentionsLT(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldMentions, v))
+}
+
+// MentionsLTE applies the LTE predicate on the "mentions" field.
+func MentionsLTE(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldMentions, v))
+}
+
+// MentionsContains applies the Contains predicate on the "mentions" field.
+func MentionsContains(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContains(FieldMentions, v))
+}
+
+// MentionsHasPrefix applies the HasPrefix predicate on the "mentions" field.
+func MentionsHasPrefix(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldHasPrefix(FieldMentions, v))
+}
+
+// MentionsHasSuffix applies the HasSuffix predicate on the "mentions" field.
+func MentionsHasSuffix(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldHasSuffix(FieldMentions, v))
+}
+
+// MentionsEqualFold applies the EqualFold predicate on the "mentions" field.
+func MentionsEqualFold(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEqualFold(FieldMentions, v))
+}
+
+// MentionsContainsFold applies the ContainsFold predicate on the "mentions" field.
+func MentionsContainsFold(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContainsFold(FieldMentions, v))
+}
+
+// ReplyToMsgIdEQ applies the EQ predicate on the "replyToMsgId" field.
+func ReplyToMsgIdEQ(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldReplyToMsgId, v))
+}
+
+// ReplyToMsgIdNEQ applies the NEQ predicate on the "replyToMsgId" field.
+func ReplyToMsgIdNEQ(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldReplyToMsgId, v))
+}
+
+// ReplyToMsgIdIn applies the In predicate on the "replyToMsgId" field.
+func ReplyToMsgIdIn(vs ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldReplyToMsgId, vs...))
+}
+
+// ReplyToMsgIdNotIn applies the NotIn predicate on the "replyToMsgId" field.
+func ReplyToMsgIdNotIn(vs ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldReplyToMsgId, vs...))
+}
+
+// ReplyToMsgIdGT applies the GT predicate on the "replyToMsgId" field.
+func ReplyToMsgIdGT(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldReplyToMsgId, v))
+}
+
+// ReplyToMsgIdGTE applies the GTE predicate on the "replyToMsgId" field.
+func ReplyToMsgIdGTE(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldReplyToMsgId, v))
+}
+
+// ReplyToMsgIdLT applies the LT predicate on the "replyToMsgId" field.
+func ReplyToMsgIdLT(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldReplyToMsgId, v))
+}
+
+// ReplyToMsgIdLTE applies the LTE predicate on the "replyToMsgId" field.
+func ReplyToMsgIdLTE(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldReplyToMsgId, v))
+}
+
+// ReplyToMsgIdContains applies the Contains predicate on the "replyToMsgId" field.
+func ReplyToMsgIdContains(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContains(FieldReplyToMsgId, v))
+}
+
+// ReplyToMsgIdHasPrefix applies the HasPrefix predicate on the "replyToMsgId" field.
+func ReplyToMsgIdHasPrefix(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldHasPrefix(FieldReplyToMsgId, v))
+}
+
+// ReplyToMsgIdHasSuffix applies the HasSuffix predicate on the "replyToMsgId" field.
+func ReplyToMsgIdHasSuffix(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldHasSuffix(FieldReplyToMsgId, v))
+}
+
+// ReplyToMsgIdEqualFold applies the EqualFold predicate on the "replyToMsgId" field.
+func ReplyToMsgIdEqualFold(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEqualFold(FieldReplyToMsgId, v))
+}
+
+// ReplyToMsgIdContainsFold applies the ContainsFold predicate on the "replyToMsgId" field.
+func ReplyToMsgIdContainsFold(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContainsFold(FieldReplyToMsgId, v))
+}
+
+// SendTimeEQ applies the EQ predicate on the "sendTime" field.
+func SendTimeEQ(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldSendTime, v))
+}
+
+// SendTimeNEQ applies the NEQ predicate on the "sendTime" field.
+func SendTimeNEQ(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldSendTime, v))
+}
+
+// SendTimeIn applies the In predicate on the "sendTime" field.
+func SendTimeIn(vs ...time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldSendTime, vs...))
+}
+
+// SendTimeNotIn applies the NotIn predicate on the "sendTime" field.
+func SendTimeNotIn(vs ...time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldSendTime, vs...))
+}
+
+// SendTimeGT applies the GT predicate on the "sendTime" field.
+func SendTimeGT(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldSendTime, v))
+}
+
+// SendTimeGTE applies the GTE predicate on the "sendTime" field.
+func SendTimeGTE(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldSendTime, v))
+}
+
+// SendTimeLT applies the LT predicate on the "sendTime" field.
+func SendTimeLT(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldSendTime, v))
+}
+
+// SendTimeLTE applies the LTE predicate on the "sendTime" field.
+func SendTimeLTE(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldSendTime, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// LeaseTimeEQ applies the EQ predicate on the "leaseTime" field.
+func LeaseTimeEQ(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldLeaseTime, v))
+}
+
+// LeaseTimeNEQ applies the NEQ predicate on the "leaseTime" field.
+func LeaseTimeNEQ(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldLeaseTime, v))
+}
+
+// LeaseTimeIn applies the In predicate on the "leaseTime" field.
+func LeaseTimeIn(vs ...time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldLeaseTime, vs...))
+}
+
+// LeaseTimeNotIn applies the NotIn predicate on the "leaseTime" field.
+func LeaseTimeNotIn(vs ...time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldLeaseTime, vs...))
+}
+
+// LeaseTimeGT applies the GT predicate on the "leaseTime" field.
+func LeaseTimeGT(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldLeaseTime, v))
+}
+
+// LeaseTimeGTE applies the GTE predicate on the "leaseTime" field.
+func LeaseTimeGTE(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldLeaseTime, v))
+}
+
+// LeaseTimeLT applies the LT predicate on the "leaseTime" field.
+func LeaseTimeLT(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldLeaseTime, v))
+}
+
+// LeaseTimeLTE applies the LTE predicate on the "leaseTime" field.
+func LeaseTimeLTE(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldLeaseTime, v))
+}
+
+// LeaseTimeIsNil applies the IsNil predicate on the "leaseTime" field.
+func LeaseTimeIsNil() predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIsNull(FieldLeaseTime))
+}
+
+// LeaseTimeNotNil applies the NotNil predicate on the "leaseTime" field.
+func LeaseTimeNotNil() predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotNull(FieldLeaseTime))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldAttempts, v))
+}
+
+// RetriesEQ applies the EQ predicate on the "retries" field.
+func RetriesEQ(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldRetries, v))
+}
+
+// RetriesNEQ applies the NEQ predicate on the "retries" field.
+func RetriesNEQ(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldRetries, v))
+}
+
+// RetriesIn applies the In predicate on the "retries" field.
+func RetriesIn(vs ...int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldRetries, vs...))
+}
+
+// RetriesNotIn applies the NotIn predicate on the "retries" field.
+func RetriesNotIn(vs ...int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldRetries, vs...))
+}
+
+// RetriesGT applies the GT predicate on the "retries" field.
+func RetriesGT(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldRetries, v))
+}
+
+// RetriesGTE applies the GTE predicate on the "retries" field.
+func RetriesGTE(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldRetries, v))
+}
+
+// RetriesLT applies the LT predicate on the "retries" field.
+func RetriesLT(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldRetries, v))
+}
+
+// RetriesLTE applies the LTE predicate on the "retries" field.
+func RetriesLTE(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldRetries, v))
+}
+
+// MsgIdEQ applies the EQ predicate on the "msgId" field.
+func MsgIdEQ(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldMsgId, v))
+}
+
+// MsgIdNEQ applies the NEQ predicate on the "msgId" field.
+func MsgIdNEQ(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldMsgId, v))
+}
+
+// MsgIdIn applies the In predicate on the "msgId" field.
+func MsgIdIn(vs ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldMsgId, vs...))
+}
+
+// MsgIdNotIn applies the NotIn predicate on the "msgId" field.
+func MsgIdNotIn(vs ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldMsgId, vs...))
+}
+
+// MsgIdGT applies the GT predicate on the "msgId" field.
+func MsgIdGT(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldMsgId, v))
+}
+
+// MsgIdGTE applies the GTE predicate on the "msgId" field.
+func MsgIdGTE(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldMsgId, v))
+}
+
+// MsgIdLT applies the LT predicate on the "msgId" field.
+func MsgIdLT(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldMsgId, v))
+}
+
+// MsgIdLTE applies the LTE predicate on the "msgId" field.
+func MsgIdLTE(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldMsgId, v))
+}
+
+// MsgIdContains applies the Contains predicate on the "msgId" field.
+func MsgIdContains(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContains(FieldMsgId, v))
+}
+
+// MsgIdHasPrefix applies the HasPrefix predicate on the "msgId" field.
+func MsgIdHasPrefix(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldHasPrefix(FieldMsgId, v))
+}
+
+// MsgIdHasSuffix applies the HasSuffix predicate on the "msgId" field.
+func MsgIdHasSuffix(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldHasSuffix(FieldMsgId, v))
+}
+
+// MsgIdEqualFold applies the EqualFold predicate on the "msgId" field.
+func MsgIdEqualFold(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEqualFold(FieldMsgId, v))
+}
+
+// MsgIdContainsFold applies the ContainsFold predicate on the "msgId" field.
+func MsgIdContainsFold(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContainsFold(FieldMsgId, v))
+}
+
+// FailReasonEQ applies the EQ predicate on the "failReason" field.
+func FailReasonEQ(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldFailReason, v))
+}
+
+// FailReasonNEQ applies the NEQ predicate on the "failReason" field.
+func FailReasonNEQ(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldFailReason, v))
+}
+
+// FailReasonIn applies the In predicate on the "failReason" field.
+func FailReasonIn(vs ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldFailReason, vs...))
+}
+
+// FailReasonNotIn applies the NotIn predicate on the "failReason" field.
+func FailReasonNotIn(vs ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldFailReason, vs...))
+}
+
+// FailReasonGT applies the GT predicate on the "failReason" field.
+func FailReasonGT(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldFailReason, v))
+}
+
+// FailReasonGTE applies the GTE predicate on the "failReason" field.
+func FailReasonGTE(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldFailReason, v))
+}
+
+// FailReasonLT applies the LT predicate on the "failReason" field.
+func FailReasonLT(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldFailReason, v))
+}
+
+// FailReasonLTE applies the LTE predicate on the "failReason" field.
+func FailReasonLTE(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldFailReason, v))
+}
+
+// FailReasonContains applies the Contains predicate on the "failReason" field.
+func FailReasonContains(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContains(FieldFailReason, v))
+}
+
+// FailReasonHasPrefix applies the HasPrefix predicate on the "failReason" field.
+func FailReasonHasPrefix(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldHasPrefix(FieldFailReason, v))
+}
+
+// FailReasonHasSuffix applies the HasSuffix predicate on the "failReason" field.
+func FailReasonHasSuffix(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldHasSuffix(FieldFailReason, v))
+}
+
+// FailReasonEqualFold applies the EqualFold predicate on the "failReason" field.
+func FailReasonEqualFold(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEqualFold(FieldFailReason, v))
+}
+
+// FailReasonContainsFold applies the ContainsFold predicate on the "failReason" field.
+func FailReasonContainsFold(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContainsFold(FieldFailReason, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "createTime" field.
+func CreateTimeEQ(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "createTime" field.
+func CreateTimeNEQ(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "createTime" field.
+func CreateTimeIn(vs ...time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "createTime" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "createTime" field.
+func CreateTimeGT(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "createTime" field.
+func CreateTimeGTE(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "createTime" field.
+func CreateTimeLT(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "createTime" field.
+func CreateTimeLTE(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "updateTime" field.
+func UpdateTimeEQ(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "updateTime" field.
+func UpdateTimeNEQ(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "updateTime" field.
+func UpdateTimeIn(vs ...time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "updateTime" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "updateTime" field.
+func UpdateTimeGT(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "updateTime" field.
+func UpdateTimeGTE(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "updateTime" field.
+func UpdateTimeLT(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "updateTime" field.
+func UpdateTimeLTE(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ScheduledMessage) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ScheduledMessage) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ScheduledMessage) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.NotPredicates(p))
+}
@@ -0,0 +1,522 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gochat_server/ent/scheduledmessage"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ScheduledMessageCreate is the builder for creating a ScheduledMessage entity.
+type ScheduledMessageCreate struct {
+	config
+	mutation *ScheduledMessageMutation
+	hooks    []Hook
+}
+
+// SetUserId sets the "userId" field.
+func (smc *ScheduledMessageCreate) SetUserId(i int) *ScheduledMessageCreate {
+	smc.mutation.SetUserId(i)
+	return smc
+}
+
+// SetToUserId sets the "toUserId" field.
+func (smc *ScheduledMessageCreate) SetToUserId(i int) *ScheduledMessageCreate {
+	smc.mutation.SetToUserId(i)
+	return smc
+}
+
+// SetNillableToUserId sets the "toUserId" field if the given value is not nil.
+func (smc *ScheduledMessageCreate) SetNillableToUserId(i *int) *ScheduledMessageCreate {
+	if i != nil {
+		smc.SetToUserId(*i)
+	}
+	return smc
+}
+
+// SetGroupId sets the "groupId" field.
+func (smc *ScheduledMessageCreate) SetGroupId(i int) *ScheduledMessageCreate {
+	smc.mutation.SetGroupId(i)
+	return smc
+}
+
+// SetNillableGroupId sets the "groupId" field if the given value is not nil.
+func (smc *ScheduledMessageCreate) SetNillableGroupId(i *int) *ScheduledMessageCreate {
+	if i != nil {
+		smc.SetGroupId(*i)
+	}
+	return smc
+}
+
+// SetMsgType sets the "msgType" field.
+func (smc *ScheduledMessageCreate) SetMsgType(i int) *ScheduledMessageCreate {
+	smc.mutation.SetMsgType(i)
+	return smc
+}
+
+// SetContent sets the "content" field.
+func (smc *ScheduledMessageCreate) SetContent(s string) *ScheduledMessageCreate {
+	smc.mutation.SetContent(s)
+	return smc
+}
+
+// SetMentions sets the "mentions" field.
+func (smc *ScheduledMessageCreate) SetMentions(s string) *ScheduledMessageCreate {
+	smc.mutation.SetMentions(s)
+	return smc
+}
+
+// SetNillableMentions sets the "mentions" field if the given value is not nil.
+func (smc *ScheduledMessageCreate) SetNillableMentions(s *string) *ScheduledMessageCreate {
+	if s != nil {
+		smc.SetMentions(*s)
+	}
+	return smc
+}
+
+// SetReplyToMsgId sets the "replyToMsgId" field.
+func (smc *ScheduledMessageCreate) SetReplyToMsgId(s string) *ScheduledMessageCreate {
+	smc.mutation.SetReplyToMsgId(s)
+	return smc
+}
+
+// SetNillableReplyToMsgId sets the "replyToMsgId" field if the given value is not nil.
+func (smc *ScheduledMessageCreate) SetNillableReplyToMsgId(s *string) *ScheduledMessageCreate {
+	if s != nil {
+		smc.SetReplyToMsgId(*s)
+	}
+	return smc
+}
+
+// SetSendTime sets the "sendTime" field.
+func (smc *ScheduledMessageCreate) SetSendTime(t time.Time) *ScheduledMessageCreate {
+	smc.mutation.SetSendTime(t)
+	return smc
+}
+
+// SetStatus sets the "status" field.
+func (smc *ScheduledMessageCreate) SetStatus(s string) *ScheduledMessageCreate {
+	smc.mutation.SetStatus(s)
+	return smc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (smc *ScheduledMessageCreate) SetNillableStatus(s *string) *ScheduledMessageCreate {
+	if s != nil {
+		smc.SetStatus(*s)
+	}
+	return smc
+}
+
+// SetLeaseTime sets the "leaseTime" field.
+func (smc *ScheduledMessageCreate) SetLeaseTime(t time.Time) *ScheduledMessageCreate {
+	smc.mutation.SetLeaseTime(t)
+	return smc
+}
+
+// SetNillableLeaseTime sets the "leaseTime" field if the given value is not nil.
+func (smc *ScheduledMessageCreate) SetNillableLeaseTime(t *time.Time) *ScheduledMessageCreate {
+	if t != nil {
+		smc.SetLeaseTime(*t)
+	}
+	return smc
+}
+
+// SetAttempts sets the "attempts" field.
+func (smc *ScheduledMessageCreate) SetAttempts(i int) *ScheduledMessageCreate {
+	smc.mutation.SetAttempts(i)
+	return smc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (smc *ScheduledMessageCreate) SetNillableAttempts(i *int) *ScheduledMessageCreate {
+	if i != nil {
+		smc.SetAttempts(*i)
+	}
+	return smc
+}
+
+// SetRetries sets the "retries" field.
+func (smc *ScheduledMessageCreate) SetRetries(i int) *ScheduledMessageCreate {
+	smc.mutation.SetRetries(i)
+	return smc
+}
+
+// SetNillableRetries sets the "retries" field if the given value is not nil.
+func (smc *ScheduledMessageCreate) SetNillableRetries(i *int) *ScheduledMessageCreate {
+	if i != nil {
+		smc.SetRetries(*i)
+	}
+	return smc
+}
+
+// SetMsgId sets the "msgId" field.
+func (smc *ScheduledMessageCreate) SetMsgId(s string) *ScheduledMessageCreate {
+	smc.mutation.SetMsgId(s)
+	return smc
+}
+
+// SetNillableMsgId sets the "msgId" field if the given value is not nil.
+func (smc *ScheduledMessageCreate) SetNillableMsgId(s *string) *ScheduledMessageCreate {
+	if s != nil {
+		smc.SetMsgId(*s)
+	}
+	return smc
+}
+
+// SetFailReason sets the "failReason" field.
+func (smc *ScheduledMessageCreate) SetFailReason(s string) *ScheduledMessageCreate {
+	smc.mutation.SetFailReason(s)
+	return smc
+}
+
+// SetNillableFailReason sets the "failReason" field if the given value is not nil.
+func (smc *ScheduledMessageCreate) SetNillableFailReason(s *string) *ScheduledMessageCreate {
+	if s != nil {
+		smc.SetFailReason(*s)
+	}
+	return smc
+}
+
+// SetCreateTime sets the "createTime" field.
+func (smc *ScheduledMessageCreate) SetCreateTime(t time.Time) *ScheduledMessageCreate {
+	smc.mutation.SetCreateTime(t)
+	return smc
+}
+
+// SetNillableCreateTime sets the "createTime" field if the given value is not nil.
+func (smc *ScheduledMessageCreate) SetNillableCreateTime(t *time.Time) *ScheduledMessageCreate {
+	if t != nil {
+		smc.SetCreateTime(*t)
+	}
+	return smc
+}
+
+// SetUpdateTime sets the "updateTime" field.
+func (smc *ScheduledMessageCreate) SetUpdateTime(t time.Time) *ScheduledMessageCreate {
+	smc.mutation.SetUpdateTime(t)
+	return smc
+}
+
+// SetNillableUpdateTime sets the "updateTime" field if the given value is not nil.
+func (smc *ScheduledMessageCreate) SetNillableUpdateTime(t *time.Time) *ScheduledMessageCreate {
+	if t != nil {
+		smc.SetUpdateTime(*t)
+	}
+	return smc
+}
+
+// Mutation returns the ScheduledMessageMutation object of the builder.
+func (smc *ScheduledMessageCreate) Mutation() *ScheduledMessageMutation {
+	return smc.mutation
+}
+
+// Save creates the ScheduledMessage in the database.
+func (smc *ScheduledMessageCreate) Save(ctx context.Context) (*ScheduledMessage, error) {
+	smc.defaults()
+	return withHooks(ctx, smc.sqlSave, smc.mutation, smc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (smc *ScheduledMessageCreate) SaveX(ctx context.Context) *ScheduledMessage {
+	v, err := smc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (smc *ScheduledMessageCreate) Exec(ctx context.Context) error {
+	_, err := smc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smc *ScheduledMessageCreate) ExecX(ctx context.Context) {
+	if err := smc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (smc *ScheduledMessageCreate) defaults() {
+	if _, ok := smc.mutation.ToUserId(); !ok {
+		v := scheduledmessage.DefaultToUserId
+		smc.mutation.SetToUserId(v)
+	}
+	if _, ok := smc.mutation.GroupId(); !ok {
+		v := scheduledmessage.DefaultGroupId
+		smc.mutation.SetGroupId(v)
+	}
+	if _, ok := smc.mutation.Mentions(); !ok {
+		v := scheduledmessage.DefaultMentions
+		smc.mutation.SetMentions(v)
+	}
+	if _, ok := smc.mutation.ReplyToMsgId(); !ok {
+		v := scheduledmessage.DefaultReplyToMsgId
+		smc.mutation.SetReplyToMsgId(v)
+	}
+	if _, ok := smc.mutation.Status(); !ok {
+		v := scheduledmessage.DefaultStatus
+		smc.mutation.SetStatus(v)
+	}
+	if _, ok := smc.mutation.Attempts(); !ok {
+		v := scheduledmessage.DefaultAttempts
+		smc.mutation.SetAttempts(v)
+	}
+	if _, ok := smc.mutation.Retries(); !ok {
+		v := scheduledmessage.DefaultRetries
+		smc.mutation.SetRetries(v)
+	}
+	if _, ok := smc.mutation.MsgId(); !ok {
+		v := scheduledmessage.DefaultMsgId
+		smc.mutation.SetMsgId(v)
+	}
+	if _, ok := smc.mutation.FailReason(); !ok {
+		v := scheduledmessage.DefaultFailReason
+		smc.mutation.SetFailReason(v)
+	}
+	if _, ok := smc.mutation.CreateTime(); !ok {
+		v := scheduledmessage.DefaultCreateTime()
+		smc.mutation.SetCreateTime(v)
+	}
+	if _, ok := smc.mutation.UpdateTime(); !ok {
+		v := scheduledmessage.DefaultUpdateTime()
+		smc.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (smc *ScheduledMessageCreate) check() error {
+	if _, ok := smc.mutation.UserId(); !ok {
+		return &ValidationError{Name: "userId", err: errors.New(`ent: missing required field "ScheduledMessage.userId"`)}
+	}
+	if _, ok := smc.mutation.ToUserId(); !ok {
+		return &ValidationError{Name: "toUserId", err: errors.New(`ent: missing required field "ScheduledMessage.toUserId"`)}
+	}
+	if _, ok := smc.mutation.GroupId(); !ok {
+		return &ValidationError{Name: "groupId", err: errors.New(`ent: missing required field "ScheduledMessage.groupId"`)}
+	}
+	if _, ok := smc.mutation.MsgType(); !ok {
+		return &ValidationError{Name: "msgType", err: errors.New(`ent: missing required field "ScheduledMessage.msgType"`)}
+	}
+	if _, ok := smc.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "ScheduledMessage.content"`)}
+	}
+	if _, ok := smc.mutation.Mentions(); !ok {
+		return &ValidationError{Name: "mentions", err: errors.New(`ent: missing required field "ScheduledMessage.mentions"`)}
+	}
+	if _, ok := smc.mutation.ReplyToMsgId(); !ok {
+		return &ValidationError{Name: "replyToMsgId", err: errors.New(`ent: missing required field "ScheduledMessage.replyToMsgId"`)}
+	}
+	if _, ok := smc.mutation.SendTime(); !ok {
+		return &ValidationError{Name: "sendTime", err: errors.New(`ent: missing required field "ScheduledMessage.sendTime"`)}
+	}
+	if _, ok := smc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ScheduledMessage.status"`)}
+	}
+	if _, ok := smc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "ScheduledMessage.attempts"`)}
+	}
+	if _, ok := smc.mutation.Retries(); !ok {
+		return &ValidationError{Name: "retries", err: errors.New(`ent: missing required field "ScheduledMessage.retries"`)}
+	}
+	if _, ok := smc.mutation.MsgId(); !ok {
+		return &ValidationError{Name: "msgId", err: errors.New(`ent: missing required field "ScheduledMessage.msgId"`)}
+	}
+	if _, ok := smc.mutation.FailReason(); !ok {
+		return &ValidationError{Name: "failReason", err: errors.New(`ent: missing required field "ScheduledMessage.failReason"`)}
+	}
+	if _, ok := smc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "createTime", err: errors.New(`ent: missing required field "ScheduledMessage.createTime"`)}
+	}
+	if _, ok := smc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "updateTime", err: errors.New(`ent: missing required field "ScheduledMessage.updateTime"`)}
+	}
+	return nil
+}
+
+func (smc *ScheduledMessageCreate) sqlSave(ctx context.Context) (*ScheduledMessage, error) {
+	if err := smc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := smc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, smc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	smc.mutation.id = &_node.ID
+	smc.mutation.done = true
+	return _node, nil
+}
+
+func (smc *ScheduledMessageCreate) createSpec() (*ScheduledMessage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ScheduledMessage{config: smc.config}
+		_spec = sqlgraph.NewCreateSpec(scheduledmessage.Table, sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeInt))
+	)
+	if value, ok := smc.mutation.UserId(); ok {
+		_spec.SetField(scheduledmessage.FieldUserId, field.TypeInt, value)
+		_node.UserId = value
+	}
+	if value, ok := smc.mutation.ToUserId(); ok {
+		_spec.SetField(scheduledmessage.FieldToUserId, field.TypeInt, value)
+		_node.ToUserId = value
+	}
+	if value, ok := smc.mutation.GroupId(); ok {
+		_spec.SetField(scheduledmessage.FieldGroupId, field.TypeInt, value)
+		_node.GroupId = value
+	}
+	if value, ok := smc.mutation.MsgType(); ok {
+		_spec.SetField(scheduledmessage.FieldMsgType, field.TypeInt, value)
+		_node.MsgType = value
+	}
+	if value, ok := smc.mutation.Content(); ok {
+		_spec.SetField(scheduledmessage.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := smc.mutation.Mentions(); ok {
+		_spec.SetField(scheduledmessage.FieldMentions, field.TypeString, value)
+		_node.Mentions = value
+	}
+	if value, ok := smc.mutation.ReplyToMsgId(); ok {
+		_spec.SetField(scheduledmessage.FieldReplyToMsgId, field.TypeString, value)
+		_node.ReplyToMsgId = value
+	}
+	if value, ok := smc.mutation.SendTime(); ok {
+		_spec.SetField(scheduledmessage.FieldSendTime, field.TypeTime, value)
+		_node.SendTime = value
+	}
+	if value, ok := smc.mutation.Status(); ok {
+		_spec.SetField(scheduledmessage.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := smc.mutation.LeaseTime(); ok {
+		_spec.SetField(scheduledmessage.FieldLeaseTime, field.TypeTime, value)
+		_node.LeaseTime = &value
+	}
+	if value, ok := smc.mutation.Attempts(); ok {
+		_spec.SetField(scheduledmessage.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := smc.mutation.Retries(); ok {
+		_spec.SetField(scheduledmessage.FieldRetries, field.TypeInt, value)
+		_node.Retries = value
+	}
+	if value, ok := smc.mutation.MsgId(); ok {
+		_spec.SetField(scheduledmessage.FieldMsgId, field.TypeString, value)
+		_node.MsgId = value
+	}
+	if value, ok := smc.mutation.FailReason(); ok {
+		_spec.SetField(scheduledmessage.FieldFailReason, field.TypeString, value)
+		_node.FailReason = value
+	}
+	if value, ok := smc.mutation.CreateTime(); ok {
+		_spec.SetField(scheduledmessage.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := smc.mutation.UpdateTime(); ok {
+		_spec.SetField(scheduledmessage.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	return _node, _spec
+}
+
+// ScheduledMessageCreateBulk is the builder for creating many ScheduledMessage entities in bulk.
+type ScheduledMessageCreateBulk struct {
+	config
+	err      error
+	builders []*ScheduledMessageCreate
+}
+
+// Save creates the ScheduledMessage entities in the database.
+func (smcb *ScheduledMessageCreateBulk) Save(ctx context.Context) ([]*ScheduledMessage, error) {
+	if smcb.err != nil {
+		return nil, smcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(smcb.builders))
+	nodes := make([]*ScheduledMessage, len(smcb.builders))
+	mutators := make([]Mutator, len(smcb.builders))
+	for i := range smcb.builders {
+		func(i int, root context.Context) {
+			builder := smcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScheduledMessageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, smcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, smcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, smcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (smcb *ScheduledMessageCreateBulk) SaveX(ctx context.Context) []*ScheduledMessage {
+	v, err := smcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (smcb *ScheduledMessageCreateBulk) Exec(ctx context.Context) error {
+	_, err := smcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smcb *ScheduledMessageCreateBulk) ExecX(ctx context.Context) {
+	if err := smcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,860 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gochat_server/ent/predicate"
+	"gochat_server/ent/scheduledmessage"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ScheduledMessageUpdate is the builder for updating ScheduledMessage entities.
+type ScheduledMessageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ScheduledMessageMutation
+}
+
+// Where appends a list predicates to the ScheduledMessageUpdate builder.
+func (smu *ScheduledMessageUpdate) Where(ps ...predicate.ScheduledMessage) *ScheduledMessageUpdate {
+	smu.mutation.Where(ps...)
+	return smu
+}
+
+// SetUserId sets the "userId" field.
+func (smu *ScheduledMessageUpdate) SetUserId(i int) *ScheduledMessageUpdate {
+	smu.mutation.ResetUserId()
+	smu.mutation.SetUserId(i)
+	return smu
+}
+
+// SetNillableUserId sets the "userId" field if the given value is not nil.
+func (smu *ScheduledMessageUpdate) SetNillableUserId(i *int) *ScheduledMessageUpdate {
+	if i != nil {
+		smu.SetUserId(*i)
+	}
+	return smu
+}
+
+// AddUserId adds i to the "userId" field.
+func (smu *ScheduledMessageUpdate) AddUserId(i int) *ScheduledMessageUpdate {
+	smu.mutation.AddUserId(i)
+	return smu
+}
+
+// SetToUserId sets the "toUserId" field.
+func (smu *ScheduledMessageUpdate) SetToUserId(i int) *ScheduledMessageUpdate {
+	smu.mutation.ResetToUserId()
+	smu.mutation.SetToUserId(i)
+	return smu
+}
+
+// SetNillableToUserId sets the "toUserId" field if the given value is not nil.
+func (smu *ScheduledMessageUpdate) SetNillableToUserId(i *int) *ScheduledMessageUpdate {
+	if i != nil {
+		smu.SetToUserId(*i)
+	}
+	return smu
+}
+
+// AddToUserId adds i to the "toUserId" field.
+func (smu *ScheduledMessageUpdate) AddToUserId(i int) *ScheduledMessageUpdate {
+	smu.mutation.AddToUserId(i)
+	return smu
+}
+
+// SetGroupId sets the "groupId" field.
+func (smu *ScheduledMessageUpdate) SetGroupId(i int) *ScheduledMessageUpdate {
+	smu.mutation.ResetGroupId()
+	smu.mutation.SetGroupId(i)
+	return smu
+}
+
+// SetNillableGroupId sets the "groupId" field if the given value is not nil.
+func (smu *ScheduledMessageUpdate) SetNillableGroupId(i *int) *ScheduledMessageUpdate {
+	if i != nil {
+		smu.SetGroupId(*i)
+	}
+	return smu
+}
+
+// AddGroupId adds i to the "groupId" field.
+func (smu *ScheduledMessageUpdate) AddGroupId(i int) *ScheduledMessageUpdate {
+	smu.mutation.AddGroupId(i)
+	return smu
+}
+
+// SetMsgType sets the "msgType" field.
+func (smu *ScheduledMessageUpdate) SetMsgType(i int) *ScheduledMessageUpdate {
+	smu.mutation.ResetMsgType()
+	smu.mutation.SetMsgType(i)
+	return smu
+}
+
+// SetNillableMsgType sets the "msgType" field if the given value is not nil.
+func (smu *ScheduledMessageUpdate) SetNillableMsgType(i *int) *ScheduledMessageUpdate {
+	if i != nil {
+		smu.SetMsgType(*i)
+	}
+	return smu
+}
+
+// AddMsgType adds i to the "msgType" field.
+func (smu *ScheduledMessageUpdate) AddMsgType(i int) *ScheduledMessageUpdate {
+	smu.mutation.AddMsgType(i)
+	return smu
+}
+
+// SetContent sets the "content" field.
+func (smu *ScheduledMessageUpdate) SetContent(s string) *ScheduledMessageUpdate {
+	smu.mutation.SetContent(s)
+	return smu
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (smu *ScheduledMessageUpdate) SetNillableContent(s *string) *ScheduledMessageUpdate {
+	if s != nil {
+		smu.SetContent(*s)
+	}
+	return smu
+}
+
+// SetMentions sets the "mentions" field.
+func (smu *ScheduledMessageUpdate) SetMentions(s string) *ScheduledMessageUpdate {
+	smu.mutation.SetMentions(s)
+	return smu
+}
+
+// SetNillableMentions sets the "mentions" field if the given value is not nil.
+func (smu *ScheduledMessageUpdate) SetNillableMentions(s *string) *ScheduledMessageUpdate {
+	if s != nil {
+		smu.SetMentions(*s)
+	}
+	return smu
+}
+
+// SetReplyToMsgId sets the "replyToMsgId" field.
+func (smu *ScheduledMessageUpdate) SetReplyToMsgId(s string) *ScheduledMessageUpdate {
+	smu.mutation.SetReplyToMsgId(s)
+	return smu
+}
+
+// SetNillableReplyToMsgId sets the "replyToMsgId" field if the given value is not nil.
+func (smu *ScheduledMessageUpdate) SetNillableReplyToMsgId(s *string) *ScheduledMessageUpdate {
+	if s != nil {
+		smu.SetReplyToMsgId(*s)
+	}
+	return smu
+}
+
+// SetSendTime sets the "sendTime" field.
+func (smu *ScheduledMessageUpdate) SetSendTime(t time.Time) *ScheduledMessageUpdate {
+	smu.mutation.SetSendTime(t)
+	return smu
+}
+
+// SetNillableSendTime sets the "sendTime" field if the given value is not nil.
+func (smu *ScheduledMessageUpdate) SetNillableSendTime(t *time.Time) *ScheduledMessageUpdate {
+	if t != nil {
+		smu.SetSendTime(*t)
+	}
+	return smu
+}
+
+// SetStatus sets the "status" field.
+func (smu *ScheduledMessageUpdate) SetStatus(s string) *ScheduledMessageUpdate {
+	smu.mutation.SetStatus(s)
+	return smu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (smu *ScheduledMessageUpdate) SetNillableStatus(s *string) *ScheduledMessageUpdate {
+	if s != nil {
+		smu.SetStatus(*s)
+	}
+	return smu
+}
+
+// SetLeaseTime sets the "leaseTime" field.
+func (smu *ScheduledMessageUpdate) SetLeaseTime(t time.Time) *ScheduledMessageUpdate {
+	smu.mutation.SetLeaseTime(t)
+	return smu
+}
+
+// SetNillableLeaseTime sets the "leaseTime" field if the given value is not nil.
+func (smu *ScheduledMessageUpdate) SetNillableLeaseTime(t *time.Time) *ScheduledMessageUpdate {
+	if t != nil {
+		smu.SetLeaseTime(*t)
+	}
+	return smu
+}
+
+// ClearLeaseTime clears the value of the "leaseTime" field.
+func (smu *ScheduledMessageUpdate) ClearLeaseTime() *ScheduledMessageUpdate {
+	smu.mutation.ClearLeaseTime()
+	return smu
+}
+
+// SetAttempts sets the "attempts" field.
+func (smu *ScheduledMessageUpdate) SetAttempts(i int) *ScheduledMessageUpdate {
+	smu.mutation.ResetAttempts()
+	smu.mutation.SetAttempts(i)
+	return smu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (smu *ScheduledMessageUpdate) SetNillableAttempts(i *int) *ScheduledMessageUpdate {
+	if i != nil {
+		smu.SetAttempts(*i)
+	}
+	return smu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (smu *ScheduledMessageUpdate) AddAttempts(i int) *ScheduledMessageUpdate {
+	smu.mutation.AddAttempts(i)
+	return smu
+}
+
+// SetRetries sets the "retries" field.
+func (smu *ScheduledMessageUpdate) SetRetries(i int) *ScheduledMessageUpdate {
+	smu.mutation.ResetRetries()
+	smu.mutation.SetRetries(i)
+	return smu
+}
+
+// SetNillableRetries sets the "retries" field if the given value is not nil.
+func (smu *ScheduledMessageUpdate) SetNillableRetries(i *int) *ScheduledMessageUpdate {
+	if i != nil {
+		smu.SetRetries(*i)
+	}
+	return smu
+}
+
+// AddRetries adds i to the "retries" field.
+func (smu *ScheduledMessageUpdate) AddRetries(i int) *ScheduledMessageUpdate {
+	smu.mutation.AddRetries(i)
+	return smu
+}
+
+// SetMsgId sets the "msgId" field.
+func (smu *ScheduledMessageUpdate) SetMsgId(s string) *ScheduledMessageUpdate {
+	smu.mutation.SetMsgId(s)
+	return smu
+}
+
+// SetNillableMsgId sets the "msgId" field if the given value is not nil.
+func (smu *ScheduledMessageUpdate) SetNillableMsgId(s *string) *ScheduledMessageUpdate {
+	if s != nil {
+		smu.SetMsgId(*s)
+	}
+	return smu
+}
+
+// SetFailReason sets the "failReason" field.
+func (smu *ScheduledMessageUpdate) SetFailReason(s string) *ScheduledMessageUpdate {
+	smu.mutation.SetFailReason(s)
+	return smu
+}
+
+// SetNillableFailReason sets the "failReason" field if the given value is not nil.
+func (smu *ScheduledMessageUpdate) SetNillableFailReason(s *string) *ScheduledMessageUpdate {
+	if s != nil {
+		smu.SetFailReason(*s)
+	}
+	return smu
+}
+
+// SetCreateTime sets the "createTime" field.
+func (smu *ScheduledMessageUpdate) SetCreateTime(t time.Time) *ScheduledMessageUpdate {
+	smu.mutation.SetCreateTime(t)
+	return smu
+}
+
+// SetNillableCreateTime sets the "createTime" field if the given value is not nil.
+func (smu *ScheduledMessageUpdate) SetNillableCreateTime(t *time.Time) *ScheduledMessageUpdate {
+	if t != nil {
+		smu.SetCreateTime(*t)
+	}
+	return smu
+}
+
+// SetUpdateTime sets the "updateTime" field.
+func (smu *ScheduledMessageUpdate) SetUpdateTime(t time.Time) *ScheduledMessageUpdate {
+	smu.mutation.SetUpdateTime(t)
+	return smu
+}
+
+// Mutation returns the ScheduledMessageMutation object of the builder.
+func (smu *ScheduledMessageUpdate) Mutation() *ScheduledMessageMutation {
+	return smu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (smu *ScheduledMessageUpdate) Save(ctx context.Context) (int, error) {
+	smu.defaults()
+	return withHooks(ctx, smu.sqlSave, smu.mutation, smu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (smu *ScheduledMessageUpdate) SaveX(ctx context.Context) int {
+	affected, err := smu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (smu *ScheduledMessageUpdate) Exec(ctx context.Context) error {
+	_, err := smu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smu *ScheduledMessageUpdate) ExecX(ctx context.Context) {
+	if err := smu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (smu *ScheduledMessageUpdate) defaults() {
+	if _, ok := smu.mutation.UpdateTime(); !ok {
+		v := scheduledmessage.UpdateDefaultUpdateTime()
+		smu.mutation.SetUpdateTime(v)
+	}
+}
+
+func (smu *ScheduledMessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(scheduledmessage.Table, scheduledmessage.Columns, sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeInt))
+	if ps := smu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := smu.mutation.UserId(); ok {
+		_spec.SetField(scheduledmessage.FieldUserId, field.TypeInt, value)
+	}
+	if value, ok := smu.mutation.AddedUserId(); ok {
+		_spec.AddField(scheduledmessage.FieldUserId, field.TypeInt, value)
+	}
+	if value, ok := smu.mutation.ToUserId(); ok {
+		_spec.SetField(scheduledmessage.FieldToUserId, field.TypeInt, value)
+	}
+	if value, ok := smu.mutation.AddedToUserId(); ok {
+		_spec.AddField(scheduledmessage.FieldToUserId, field.TypeInt, value)
+	}
+	if value, ok := smu.mutation.GroupId(); ok {
+		_spec.SetField(scheduledmessage.FieldGroupId, field.TypeInt, value)
+	}
+	if value, ok := smu.mutation.AddedGroupId(); ok {
+		_spec.AddField(scheduledmessage.FieldGroupId, field.TypeInt, value)
+	}
+	if value, ok := smu.mutation.MsgType(); ok {
+		_spec.SetField(scheduledmessage.FieldMsgType, field.TypeInt, value)
+	}
+	if value, ok := smu.mutation.AddedMsgType(); ok {
+		_spec.AddField(scheduledmessage.FieldMsgType, field.TypeInt, value)
+	}
+	if value, ok := smu.mutation.Content(); ok {
+		_spec.SetField(scheduledmessage.FieldContent, field.TypeString, value)
+	}
+	if value, ok := smu.mutation.Mentions(); ok {
+		_spec.SetField(scheduledmessage.FieldMentions, field.TypeString, value)
+	}
+	if value, ok := smu.mutation.ReplyToMsgId(); ok {
+		_spec.SetField(scheduledmessage.FieldReplyToMsgId, field.TypeString, value)
+	}
+	if value, ok := smu.mutation.SendTime(); ok {
+		_spec.SetField(scheduledmessage.FieldSendTime, field.TypeTime, value)
+	}
+	if value, ok := smu.mutation.Status(); ok {
+		_spec.SetField(scheduledmessage.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := smu.mutation.LeaseTime(); ok {
+		_spec.SetField(scheduledmessage.FieldLeaseTime, field.TypeTime, value)
+	}
+	if smu.mutation.LeaseTimeCleared() {
+		_spec.ClearField(scheduledmessage.FieldLeaseTime, field.TypeTime)
+	}
+	if value, ok := smu.mutation.Attempts(); ok {
+		_spec.SetField(scheduledmessage.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := smu.mutation.AddedAttempts(); ok {
+		_spec.AddField(scheduledmessage.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := smu.mutation.Retries(); ok {
+		_spec.SetField(scheduledmessage.FieldRetries, field.TypeInt, value)
+	}
+	if value, ok := smu.mutation.AddedRetries(); ok {
+		_spec.AddField(scheduledmessage.FieldRetries, field.TypeInt, value)
+	}
+	if value, ok := smu.mutation.MsgId(); ok {
+		_spec.SetField(scheduledmessage.FieldMsgId, field.TypeString, value)
+	}
+	if value, ok := smu.mutation.FailReason(); ok {
+		_spec.SetField(scheduledmessage.FieldFailReason, field.TypeString, value)
+	}
+	if value, ok := smu.mutation.CreateTime(); ok {
+		_spec.SetField(scheduledmessage.FieldCreateTime, field.TypeTime, value)
+	}
+	if value, ok := smu.mutation.UpdateTime(); ok {
+		_spec.SetField(scheduledmessage.FieldUpdateTime, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, smu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scheduledmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	smu.mutation.done = true
+	return n, nil
+}
+
+// ScheduledMessageUpdateOne is the builder for updating a single ScheduledMessage entity.
+type ScheduledMessageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ScheduledMessageMutation
+}
+
+// SetUserId sets the "userId" field.
+func (smuo *ScheduledMessageUpdateOne) SetUserId(i int) *ScheduledMessageUpdateOne {
+	smuo.mutation.ResetUserId()
+	smuo.mutation.SetUserId(i)
+	return smuo
+}
+
+// SetNillableUserId sets the "userId" field if the given value is not nil.
+func (smuo *ScheduledMessageUpdateOne) SetNillableUserId(i *int) *ScheduledMessageUpdateOne {
+	if i != nil {
+		smuo.SetUserId(*i)
+	}
+	return smuo
+}
+
+// AddUserId adds i to the "userId" field.
+func (smuo *ScheduledMessageUpdateOne) AddUserId(i int) *ScheduledMessageUpdateOne {
+	smuo.mutation.AddUserId(i)
+	return smuo
+}
+
+// SetToUserId sets the "toUserId" field.
+func (smuo *ScheduledMessageUpdateOne) SetToUserId(i int) *ScheduledMessageUpdateOne {
+	smuo.mutation.ResetToUserId()
+	smuo.mutation.SetToUserId(i)
+	return smuo
+}
+
+// SetNillableToUserId sets the "toUserId" field if the given value is not nil.
+func (smuo *ScheduledMessageUpdateOne) SetNillableToUserId(i *int) *ScheduledMessageUpdateOne {
+	if i != nil {
+		smuo.SetToUserId(*i)
+	}
+	return smuo
+}
+
+// AddToUserId adds i to the "toUserId" field.
+func (smuo *ScheduledMessageUpdateOne) AddToUserId(i int) *ScheduledMessageUpdateOne {
+	smuo.mutation.AddToUserId(i)
+	return smuo
+}
+
+// SetGroupId sets the "groupId" field.
+func (smuo *ScheduledMessageUpdateOne) SetGroupId(i int) *ScheduledMessageUpdateOne {
+	smuo.mutation.ResetGroupId()
+	smuo.mutation.SetGroupId(i)
+	return smuo
+}
+
+// SetNillableGroupId sets the "groupId" field if the given value is not nil.
+func (smuo *ScheduledMessageUpdateOne) SetNillableGroupId(i *int) *ScheduledMessageUpdateOne {
+	if i != nil {
+		smuo.SetGroupId(*i)
+	}
+	return smuo
+}
+
+// AddGroupId adds i to the "groupId" field.
+func (smuo *ScheduledMessageUpdateOne) AddGroupId(i int) *ScheduledMessageUpdateOne {
+	smuo.mutation.AddGroupId(i)
+	return smuo
+}
+
+// SetMsgType sets the "msgType" field.
+func (smuo *ScheduledMessageUpdateOne) SetMsgType(i int) *ScheduledMessageUpdateOne {
+	smuo.mutation.ResetMsgType()
+	smuo.mutation.SetMsgType(i)
+	return smuo
+}
+
+// SetNillableMsgType sets the "msgType" field if the given value is not nil.
+func (smuo *ScheduledMessageUpdateOne) SetNillableMsgType(i *int) *ScheduledMessageUpdateOne {
+	if i != nil {
+		smuo.SetMsgType(*i)
+	}
+	return smuo
+}
+
+// AddMsgType adds i to the "msgType" field.
+func (smuo *ScheduledMessageUpdateOne) AddMsgType(i int) *ScheduledMessageUpdateOne {
+	smuo.mutation.AddMsgType(i)
+	return smuo
+}
+
+// SetContent sets the "content" field.
+func (smuo *ScheduledMessageUpdateOne) SetContent(s string) *ScheduledMessageUpdateOne {
+	smuo.mutation.SetContent(s)
+	return smuo
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (smuo *ScheduledMessageUpdateOne) SetNillableContent(s *string) *ScheduledMessageUpdateOne {
+	if s != nil {
+		smuo.SetContent(*s)
+	}
+	return smuo
+}
+
+// SetMentions sets the "mentions" field.
+func (smuo *ScheduledMessageUpdateOne) SetMentions(s string) *ScheduledMessageUpdateOne {
+	smuo.mutation.SetMentions(s)
+	return smuo
+}
+
+// SetNillableMentions sets the "mentions" field if the given value is not nil.
+func (smuo *ScheduledMessageUpdateOne) SetNillableMentions(s *string) *ScheduledMessageUpdateOne {
+	if s != nil {
+		smuo.SetMentions(*s)
+	}
+	return smuo
+}
+
+// SetReplyToMsgId sets the "replyToMsgId" field.
+func (smuo *ScheduledMessageUpdateOne) SetReplyToMsgId(s string) *ScheduledMessageUpdateOne {
+	smuo.mutation.SetReplyToMsgId(s)
+	return smuo
+}
+
+// SetNillableReplyToMsgId sets the "replyToMsgId" field if the given value is not nil.
+func (smuo *ScheduledMessageUpdateOne) SetNillableReplyToMsgId(s *string) *ScheduledMessageUpdateOne {
+	if s != nil {
+		smuo.SetReplyToMsgId(*s)
+	}
+	return smuo
+}
+
+// SetSendTime sets the "sendTime" field.
+func (smuo *ScheduledMessageUpdateOne) SetSendTime(t time.Time) *ScheduledMessageUpdateOne {
+	smuo.mutation.SetSendTime(t)
+	return smuo
+}
+
+// SetNillableSendTime sets the "sendTime" field if the given value is not nil.
+func (smuo *ScheduledMessageUpdateOne) SetNillableSendTime(t *time.Time) *ScheduledMessageUpdateOne {
+	if t != nil {
+		smuo.SetSendTime(*t)
+	}
+	return smuo
+}
+
+// SetStatus sets the "status" field.
+func (smuo *ScheduledMessageUpdateOne) SetStatus(s string) *ScheduledMessageUpdateOne {
+	smuo.mutation.SetStatus(s)
+	return smuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (smuo *ScheduledMessageUpdateOne) SetNillableStatus(s *string) *ScheduledMessageUpdateOne {
+	if s != nil {
+		smuo.SetStatus(*s)
+	}
+	return smuo
+}
+
+// SetLeaseTime sets the "leaseTime" field.
+func (smuo *ScheduledMessageUpdateOne) SetLeaseTime(t time.Time) *ScheduledMessageUpdateOne {
+	smuo.mutation.SetLeaseTime(t)
+	return smuo
+}
+
+// SetNillableLeaseTime sets the "leaseTime" field if the given value is not nil.
+func (smuo *ScheduledMessageUpdateOne) SetNillableLeaseTime(t *time.Time) *ScheduledMessageUpdateOne {
+	if t != nil {
+		smuo.SetLeaseTime(*t)
+	}
+	return smuo
+}
+
+// ClearLeaseTime clears the value of the "leaseTime" field.
+func (smuo *ScheduledMessageUpdateOne) ClearLeaseTime() *ScheduledMessageUpdateOne {
+	smuo.mutation.ClearLeaseTime()
+	return smuo
+}
+
+// SetAttempts sets the "attempts" field.
+func (smuo *ScheduledMessageUpdateOne) SetAttempts(i int) *ScheduledMessageUpdateOne {
+	smuo.mutation.ResetAttempts()
+	smuo.mutation.SetAttempts(i)
+	return smuo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (smuo *ScheduledMessageUpdateOne) SetNillableAttempts(i *int) *ScheduledMessageUpdateOne {
+	if i != nil {
+		smuo.SetAttempts(*i)
+	}
+	return smuo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (smuo *ScheduledMessageUpdateOne) AddAttempts(i int) *ScheduledMessageUpdateOne {
+	smuo.mutation.AddAttempts(i)
+	return smuo
+}
+
+// SetRetries sets the "retries" field.
+func (smuo *ScheduledMessageUpdateOne) SetRetries(i int) *ScheduledMessageUpdateOne {
+	smuo.mutation.ResetRetries()
+	smuo.mutation.SetRetries(i)
+	return smuo
+}
+
+// SetNillableRetries sets the "retries" field if the given value is not nil.
+func (smuo *ScheduledMessageUpdateOne) SetNillableRetries(i *int) *ScheduledMessageUpdateOne {
+	if i != nil {
+		smuo.SetRetries(*i)
+	}
+	return smuo
+}
+
+// AddRetries adds i to the "retries" field.
+func (smuo *ScheduledMessageUpdateOne) AddRetries(i int) *ScheduledMessageUpdateOne {
+	smuo.mutation.AddRetries(i)
+	return smuo
+}
+
+// SetMsgId sets the "msgId" field.
+func (smuo *ScheduledMessageUpdateOne) SetMsgId(s string) *ScheduledMessageUpdateOne {
+	smuo.mutation.SetMsgId(s)
+	return smuo
+}
+
+// SetNillableMsgId sets the "msgId" field if the given value is not nil.
+func (smuo *ScheduledMessageUpdateOne) SetNillableMsgId(s *string) *ScheduledMessageUpdateOne {
+	if s != nil {
+		smuo.SetMsgId(*s)
+	}
+	return smuo
+}
+
+// SetFailReason sets the "failReason" field.
+func (smuo *ScheduledMessageUpdateOne) SetFailReason(s string) *ScheduledMessageUpdateOne {
+	smuo.mutation.SetFailReason(s)
+	return smuo
+}
+
+// SetNillableFailReason sets the "failReason" field if the given value is not nil.
+func (smuo *ScheduledMessageUpdateOne) SetNillableFailReason(s *string) *ScheduledMessageUpdateOne {
+	if s != nil {
+		smuo.SetFailReason(*s)
+	}
+	return smuo
+}
+
+// SetCreateTime sets the "createTime" field.
+func (smuo *ScheduledMessageUpdateOne) SetCreateTime(t time.Time) *ScheduledMessageUpdateOne {
+	smuo.mutation.SetCreateTime(t)
+	return smuo
+}
+
+// SetNillableCreateTime sets the "createTime" field if the given value is not nil.
+func (smuo *ScheduledMessageUpdateOne) SetNillableCreateTime(t *time.Time) *ScheduledMessageUpdateOne {
+	if t != nil {
+		smuo.SetCreateTime(*t)
+	}
+	return smuo
+}
+
+// SetUpdateTime sets the "updateTime" field.
+func (smuo *ScheduledMessageUpdateOne) SetUpdateTime(t time.Time) *ScheduledMessageUpdateOne {
+	smuo.mutation.SetUpdateTime(t)
+	return smuo
+}
+
+// Mutation returns the ScheduledMessageMutation object of the builder.
+func (smuo *ScheduledMessageUpdateOne) Mutation() *ScheduledMessageMutation {
+	return smuo.mutation
+}
+
+// Where appends a list predicates to the ScheduledMessageUpdate builder.
+func (smuo *ScheduledMessageUpdateOne) Where(ps ...predicate.ScheduledMessage) *ScheduledMessageUpdateOne {
+	smuo.mutation.Where(ps...)
+	return smuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (smuo *ScheduledMessageUpdateOne) Select(field string, fields ...string) *ScheduledMessageUpdateOne {
+	smuo.fields = append([]string{field}, fields...)
+	return smuo
+}
+
+// Save executes the query and returns the updated ScheduledMessage entity.
+func (smuo *ScheduledMessageUpdateOne) Save(ctx context.Context) (*ScheduledMessage, error) {
+	smuo.defaults()
+	return withHooks(ctx, smuo.sqlSave, smuo.mutation, smuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (smuo *ScheduledMessageUpdateOne) SaveX(ctx context.Context) *ScheduledMessage {
+	node, err := smuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (smuo *ScheduledMessageUpdateOne) Exec(ctx context.Context) error {
+	_, err := smuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smuo *ScheduledMessageUpdateOne) ExecX(ctx context.Context) {
+	if err := smuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (smuo *ScheduledMessageUpdateOne) defaults() {
+	if _, ok := smuo.mutation.UpdateTime(); !ok {
+		v := scheduledmessage.UpdateDefaultUpdateTime()
+		smuo.mutation.SetUpdateTime(v)
+	}
+}
+
+func (smuo *ScheduledMessageUpdateOne) sqlSave(ctx context.Context) (_node *ScheduledMessage, err error) {
+	_spec := sqlgraph.NewUpdateSpec(scheduledmessage.Table, scheduledmessage.Columns, sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeInt))
+	id, ok := smuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ScheduledMessage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := smuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, scheduledmessage.FieldID)
+		for _, f := range fields {
+			if !scheduledmessage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != scheduledmessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := smuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := smuo.mutation.UserId(); ok {
+		_spec.SetField(scheduledmessage.FieldUserId, field.TypeInt, value)
+	}
+	if value, ok := smuo.mutation.AddedUserId(); ok {
+		_spec.AddField(scheduledmessage.FieldUserId, field.TypeInt, value)
+	}
+	if value, ok := smuo.mutation.ToUserId(); ok {
+		_spec.SetField(scheduledmessage.FieldToUserId, field.TypeInt, value)
+	}
+	if value, ok := smuo.mutation.AddedToUserId(); ok {
+		_spec.AddField(scheduledmessage.FieldToUserId, field.TypeInt, value)
+	}
+	if value, ok := smuo.mutation.GroupId(); ok {
+		_spec.SetField(scheduledmessage.FieldGroupId, field.TypeInt, value)
+	}
+	if value, ok := smuo.mutation.AddedGroupId(); ok {
+		_spec.AddField(scheduledmessage.FieldGroupId, field.TypeInt, value)
+	}
+	if value, ok := smuo.mutation.MsgType(); ok {
+		_spec.SetField(scheduledmessage.FieldMsgType, field.TypeInt, value)
+	}
+	if value, ok := smuo.mutation.AddedMsgType(); ok {
+		_spec.AddField(scheduledmessage.FieldMsgType, field.TypeInt, value)
+	}
+	if value, ok := smuo.mutation.Content(); ok {
+		_spec.SetField(scheduledmessage.FieldContent, field.TypeString, value)
+	}
+	if value, ok := smuo.mutation.Mentions(); ok {
+		_spec.SetField(scheduledmessage.FieldMentions, field.TypeString, value)
+	}
+	if value, ok := smuo.mutation.ReplyToMsgId(); ok {
+		_spec.SetField(scheduledmessage.FieldReplyToMsgId, field.TypeString, value)
+	}
+	if value, ok := smuo.mutation.SendTime(); ok {
+		_spec.SetField(scheduledmessage.FieldSendTime, field.TypeTime, value)
+	}
+	if value, ok := smuo.mutation.Status(); ok {
+		_spec.SetField(scheduledmessage.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := smuo.mutation.LeaseTime(); ok {
+		_spec.SetField(scheduledmessage.FieldLeaseTime, field.TypeTime, value)
+	}
+	if smuo.mutation.LeaseTimeCleared() {
+		_spec.ClearField(scheduledmessage.FieldLeaseTime, field.TypeTime)
+	}
+	if value, ok := smuo.mutation.Attempts(); ok {
+		_spec.SetField(scheduledmessage.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := smuo.mutation.AddedAttempts(); ok {
+		_spec.AddField(scheduledmessage.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := smuo.mutation.Retries(); ok {
+		_spec.SetField(scheduledmessage.FieldRetries, field.TypeInt, value)
+	}
+	if value, ok := smuo.mutation.AddedRetries(); ok {
+		_spec.AddField(scheduledmessage.FieldRetries, field.TypeInt, value)
+	}
+	if value, ok := smuo.mutation.MsgId(); ok {
+		_spec.SetField(scheduledmessage.FieldMsgId, field.TypeString, value)
+	}
+	if value, ok := smuo.mutation.FailReason(); ok {
+		_spec.SetField(scheduledmessage.FieldFailReason, field.TypeString, value)
+	}
+	if value, ok := smuo.mutation.CreateTime(); ok {
+		_spec.SetField(scheduledmessage.FieldCreateTime, field.TypeTime, value)
+	}
+	if value, ok := smuo.mutation.UpdateTime(); ok {
+		_spec.SetField(scheduledmessage.FieldUpdateTime, field.TypeTime, value)
+	}
+	_node = &ScheduledMessage{config: smuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, smuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scheduledmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	smuo.mutation.done = true
+	return _node, nil
+}
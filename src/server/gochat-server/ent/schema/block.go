@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Block 黑名单，被拉黑的用户不能给拉黑者发消息和好友请求，也看不到拉黑者的在线状态
+type Block struct {
+	ent.Schema
+}
+
+// Fields of the Block.
+func (Block) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("userId").Comment("拉黑者ID"),
+		field.Int("blockedUserId").Comment("被拉黑的用户ID"),
+		field.Time("createTime").Default(time.Now).Comment("拉黑时间"),
+	}
+}
+
+// Edges of the Block.
+func (Block) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Block.
+func (Block) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("userId", "blockedUserId").Unique(),
+		// 用于查询拉黑了某个用户的所有用户
+		index.Fields("blockedUserId"),
+	}
+}
@@ -22,6 +22,7 @@ func (ChatRecord) Fields() []ent.Field {
 		field.Int("msgType").Comment("消息类型: 1-文本, 2-图片, 3-视频"),
 		field.Bool("isGroup").Default(false).Comment("是否为群聊"),
 		field.Int("groupId").Optional().Comment("群聊ID，仅群聊时有值"),
+		field.String("conversationId").Optional().Comment("私聊会话ID，由双方ID按从小到大拼接，用于按会话分页"),
 		field.Time("createTime").Default(time.Now).Comment("创建时间"),
 	}
 }
@@ -42,5 +43,7 @@ func (ChatRecord) Indexes() []ent.Index {
 		index.Fields("toUserId", "createTime"),
 		// 群聊ID和创建时间索引，用于查询群聊历史
 		index.Fields("groupId", "createTime"),
+		// 会话、创建时间和消息ID组合索引，用于按游标分页查询私聊历史
+		index.Fields("conversationId", "createTime", "msgId"),
 	}
 }
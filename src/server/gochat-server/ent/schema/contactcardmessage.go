@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ContactCardMessage holds the schema definition for the ContactCardMessage entity.
+// Deprecated: 消息内容已统一保存在 Message.body 中，该表仅用于迁移历史数据。
+type ContactCardMessage struct {
+	ent.Schema
+}
+
+// Fields of the ContactCardMessage.
+func (ContactCardMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("msgId").NotEmpty().Comment("消息ID,由发送者产生"),
+		field.Int("userId").Comment("名片中的用户ID,资料在读取时实时查询"),
+	}
+}
+
+// Edges of the ContactCardMessage.
+func (ContactCardMessage) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the ContactCardMessage.
+func (ContactCardMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		// 消息ID索引，用于快速查找消息内容
+		index.Fields("msgId").Unique(),
+	}
+}
@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ConversationClear 用户清空聊天记录或删除会话的记录，只对该用户生效
+// 清空时间及之前的消息对该用户隐藏；删除的会话在收到新消息前不出现在会话列表中
+type ConversationClear struct {
+	ent.Schema
+}
+
+// Fields of the ConversationClear.
+func (ConversationClear) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("userId").Comment("用户ID"),
+		field.Bool("isGroup").Default(false).Comment("是否为群聊会话"),
+		field.Int("targetId").Comment("私聊为对方用户ID，群聊为群组ID"),
+		field.Time("clearTime").Comment("清空时间，之前的消息对该用户隐藏"),
+		field.Bool("hidden").Default(false).Comment("会话是否已删除"),
+	}
+}
+
+// Edges of the ConversationClear.
+func (ConversationClear) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the ConversationClear.
+func (ConversationClear) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("userId", "isGroup", "targetId").Unique(),
+	}
+}
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ConversationTimer 会话的阅后即焚计时器，开启后发送的消息在指定时间后自动删除
+// 私聊会话ID为双方用户ID按从小到大拼接，群聊为 group_ 加群组ID
+type ConversationTimer struct {
+	ent.Schema
+}
+
+// Fields of the ConversationTimer.
+func (ConversationTimer) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("conversationId").NotEmpty().Comment("会话ID"),
+		field.Bool("isGroup").Default(false).Comment("是否为群聊会话"),
+		field.Int("ttlSeconds").Default(0).Comment("消息保留时长(秒)，0表示关闭"),
+		field.Int("updateUserId").Comment("最后设置的用户ID"),
+		field.Time("updateTime").Default(time.Now).Comment("最后设置时间"),
+	}
+}
+
+// Edges of the ConversationTimer.
+func (ConversationTimer) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the ConversationTimer.
+func (ConversationTimer) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("conversationId").Unique(),
+	}
+}
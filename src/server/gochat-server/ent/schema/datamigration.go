@@ -0,0 +1,31 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// DataMigration 启动时执行的数据迁移的进度，完成后不再执行；按记录ID分批处理，中断后从上次的位置继续
+type DataMigration struct {
+	ent.Schema
+}
+
+// Fields of the DataMigration.
+func (DataMigration) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").NotEmpty().Unique().Comment("迁移名称"),
+		field.Int("cursor").Default(0).Comment("已处理到的记录ID"),
+		field.Int("migrated").Default(0).Comment("已迁移的记录数"),
+		field.Bool("isCompleted").Default(false).Comment("是否已完成"),
+		field.Time("startTime").Default(time.Now).Comment("开始时间"),
+		field.Time("updateTime").Default(time.Now).UpdateDefault(time.Now).Comment("最近一次更新进度的时间"),
+		field.Time("finishTime").Optional().Nillable().Comment("完成时间"),
+	}
+}
+
+// Edges of the DataMigration.
+func (DataMigration) Edges() []ent.Edge {
+	return nil
+}
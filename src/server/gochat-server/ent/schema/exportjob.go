@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ExportJob 聊天记录导出任务，导出文件保存在对象存储中，通过短时有效的预签名URL下载
+type ExportJob struct {
+	ent.Schema
+}
+
+// Fields of the ExportJob.
+func (ExportJob) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("userId").Comment("导出用户ID"),
+		field.Bool("isGroup").Default(false).Comment("是否为群聊会话"),
+		field.Int("targetId").Comment("私聊为好友ID，群聊为群组ID"),
+		field.String("format").Comment("导出格式: json, html(包含媒体文件的ZIP压缩包)"),
+		field.String("status").Default("pending").Comment("状态: pending 等待中, running 导出中, completed 已完成, failed 失败, expired 文件已过期删除"),
+		field.Time("leaseTime").Optional().Nillable().Comment("导出中任务的租约到期时间，到期未完成时由其他实例重新导出"),
+		field.Int("attempts").Default(0).Comment("领取次数，用于条件更新保证同一时间只有一个实例导出"),
+		field.Int("total").Default(0).Comment("需要导出的消息数"),
+		field.Int("processed").Default(0).Comment("已导出的消息数"),
+		field.String("objectName").Default("").Comment("导出文件在对象存储中的键"),
+		field.Int64("fileSize").Default(0).Comment("导出文件大小(字节)"),
+		field.String("lastError").Default("").Comment("失败原因"),
+		field.Time("createTime").Default(time.Now).Comment("创建时间"),
+		field.Time("finishTime").Optional().Nillable().Comment("完成时间"),
+	}
+}
+
+// Edges of the ExportJob.
+func (ExportJob) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the ExportJob.
+func (ExportJob) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("userId", "createTime"),
+		index.Fields("status"),
+	}
+}
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// FileMessage holds the schema definition for the FileMessage entity.
+// Deprecated: 消息内容已统一保存在 Message.body 中，该表仅用于迁移历史数据。
+type FileMessage struct {
+	ent.Schema
+}
+
+// Fields of the FileMessage.
+func (FileMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("msgId").NotEmpty().Comment("消息ID,由发送者产生"),
+		field.String("fileName").NotEmpty().Comment("原始文件名"),
+		field.Int64("fileSize").NonNegative().Comment("文件大小(字节)"),
+		field.String("mimeType").Default("application/octet-stream").Comment("文件MIME类型"),
+		field.String("checksum").Comment("文件内容的SHA-256校验和(十六进制)"),
+		field.String("storageKey").NotEmpty().Comment("文件在对象存储中的键"),
+	}
+}
+
+// Edges of the FileMessage.
+func (FileMessage) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the FileMessage.
+func (FileMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		// 消息ID索引，用于快速查找消息内容
+		index.Fields("msgId").Unique(),
+	}
+}
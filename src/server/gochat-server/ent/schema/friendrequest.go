@@ -19,8 +19,11 @@ func (FriendRequest) Fields() []ent.Field {
 		field.Int("fromUserId").Comment("发送者ID"),
 		field.Int("toUserId").Comment("接收者ID"),
 		field.String("remark").Optional().Comment("备注信息"),
-		field.Int("status").Default(0).Comment("状态: 0-待处理, 1-已接受, 2-已拒绝"),
+		field.Int("status").Default(0).Comment("状态: 0-待处理, 1-已接受, 2-已拒绝, 3-已撤回, 4-已过期"),
+		field.String("source").Optional().Comment("来源: search-搜索, shared_card-名片分享"),
+		field.String("sourceMsgId").Optional().Comment("来源为名片分享时,对应的名片消息ID"),
 		field.Time("createTime").Default(time.Now).Comment("创建时间"),
+		field.Time("handleTime").Optional().Nillable().Comment("处理时间（接受、拒绝、撤回或过期的时间）"),
 	}
 }
 
@@ -36,5 +39,7 @@ func (FriendRequest) Indexes() []ent.Index {
 		index.Fields("toUserId", "status"),
 		// 发送者和接收者组合索引，防止重复请求
 		index.Fields("fromUserId", "toUserId"),
+		// 状态和创建时间索引，用于清理过期的好友请求
+		index.Fields("status", "createTime"),
 	}
 }
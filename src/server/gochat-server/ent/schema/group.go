@@ -22,6 +22,8 @@ func (Group) Fields() []ent.Field {
 		field.Int("createUserId").Comment("创建者ID"),
 		field.Time("createTime").Default(time.Now).Comment("群组创建时间"),
 		field.JSON("members", []int{}).Comment("群组成员ID列表"),
+		field.Bool("readReceiptsEnabled").Default(true).StructTag(`json:"readReceiptsEnabled"`).Comment("是否开启已读回执"),
+		field.Int("retentionDays").Default(0).StructTag(`json:"retentionDays"`).Comment("消息保留天数，0表示使用全局设置"),
 	}
 }
 
@@ -34,7 +34,7 @@ func (GroupChatRecord) Indexes() []ent.Index {
 	return []ent.Index{
 		// 消息ID索引，用于快速查找消息
 		index.Fields("msgId").Unique(),
-		// 群组ID和创建时间索引，用于查询群聊历史
-		index.Fields("groupId", "createTime"),
+		// 群组ID、创建时间和消息ID组合索引，用于按游标分页查询群聊历史
+		index.Fields("groupId", "createTime", "msgId"),
 	}
 }
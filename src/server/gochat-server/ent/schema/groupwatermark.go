@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// GroupWatermark 群成员在群聊中的已读、已送达水位
+// 水位为群聊记录（GroupChatRecord）的ID，不大于水位的消息视为已读/已送达，
+// 群消息不再为每个成员创建 MessageStatus 记录
+type GroupWatermark struct {
+	ent.Schema
+}
+
+// Fields of the GroupWatermark.
+func (GroupWatermark) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("userId").Comment("群成员ID"),
+		field.Int("groupId").Comment("群组ID"),
+		field.Int("readSeq").Default(0).Comment("已读水位"),
+		field.Int("deliveredSeq").Default(0).Comment("已送达水位"),
+		field.Int("joinSeq").Default(0).Comment("加入群聊时的最新消息序号，之前的消息不计入该成员的已读回执"),
+		field.Time("readTime").Optional().Nillable().Comment("已读水位最近更新时间"),
+		field.Time("deliveredTime").Optional().Nillable().Comment("已送达水位最近更新时间"),
+		field.Time("createTime").Default(time.Now).Comment("创建时间"),
+	}
+}
+
+// Edges of the GroupWatermark.
+func (GroupWatermark) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the GroupWatermark.
+func (GroupWatermark) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("userId", "groupId").Unique(),
+		// 统计群消息的已读人数
+		index.Fields("groupId", "readSeq"),
+	}
+}
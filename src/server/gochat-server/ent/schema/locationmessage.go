@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LocationMessage holds the schema definition for the LocationMessage entity.
+// Deprecated: 消息内容已统一保存在 Message.body 中，该表仅用于迁移历史数据。
+type LocationMessage struct {
+	ent.Schema
+}
+
+// Fields of the LocationMessage.
+func (LocationMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("msgId").NotEmpty().Comment("消息ID,由发送者产生"),
+		field.Float("latitude").Min(-90).Max(90).Comment("纬度"),
+		field.Float("longitude").Min(-180).Max(180).Comment("经度"),
+		field.String("name").Optional().Comment("地点名称"),
+		field.String("address").Optional().Comment("详细地址"),
+	}
+}
+
+// Edges of the LocationMessage.
+func (LocationMessage) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the LocationMessage.
+func (LocationMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		// 消息ID索引，用于快速查找消息内容
+		index.Fields("msgId").Unique(),
+	}
+}
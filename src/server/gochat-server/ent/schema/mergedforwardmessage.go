@@ -0,0 +1,35 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MergedForwardMessage holds the schema definition for the MergedForwardMessage entity.
+// Deprecated: 消息内容已统一保存在 Message.body 中，该表仅用于迁移历史数据。
+type MergedForwardMessage struct {
+	ent.Schema
+}
+
+// Fields of the MergedForwardMessage.
+func (MergedForwardMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("msgId").NotEmpty().Comment("消息ID,由发送者产生"),
+		field.String("title").NotEmpty().Comment("聊天记录标题"),
+		field.Text("payload").NotEmpty().Comment("合并转发的聊天记录(JSON)"),
+	}
+}
+
+// Edges of the MergedForwardMessage.
+func (MergedForwardMessage) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the MergedForwardMessage.
+func (MergedForwardMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		// 消息ID索引，用于快速查找消息内容
+		index.Fields("msgId").Unique(),
+	}
+}
@@ -5,10 +5,11 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
-// Message 基础消息表：存储消息元信息（类型、内容、创建时间）
-// 具体内容表 TextMessage/ImageMessage/VideoMessage 通过 msgId 关联
+// Message 消息表：存储消息类型、带版本号的结构化消息体和撤回状态
+// 消息体的结构由各消息类型的编解码器定义，新增消息类型不需要新建表
 type Message struct {
 	ent.Schema
 }
@@ -19,9 +20,14 @@ func (Message) Fields() []ent.Field {
 		field.String("msgId").NotEmpty().Comment("消息ID,由发送者产生"),
 		field.String("msgType").NotEmpty().Comment("消息类型: text/image/video 或数值枚举"),
 		field.String("content").NotEmpty().Comment("消息内容(冗余存储,便于快速列表展示)"),
+		field.Text("body").Default("").Comment("结构化消息体(JSON),包含类型、版本、内容、@提及和回复引用"),
 		field.Bool("isRevoked").Default(false).Comment("是否已撤回"),
 		field.Time("revokeTime").Optional().Nillable().Comment("撤回时间"),
 		field.Time("createTime").Default(time.Now).Comment("创建时间"),
+		field.Time("expireTime").Optional().Nillable().Comment("过期时间，阅后即焚的消息到期后删除"),
+		field.Bool("isPurged").Default(false).Comment("是否已超过保留期限被清理，清理后只保留占位记录"),
+		field.Time("purgeTime").Optional().Nillable().Comment("清理时间"),
+		field.String("storageKey").Default("").Comment("消息体引用的由本服务存储的媒体文件，用于判断文件是否仍被引用"),
 	}
 }
 
@@ -30,3 +36,14 @@ func (Message) Edges() []ent.Edge {
 	return nil
 }
 
+// Indexes of the Message.
+func (Message) Indexes() []ent.Index {
+	return []ent.Index{
+		// 消息ID索引，用于快速查找消息
+		index.Fields("msgId").Unique(),
+		// 过期时间索引，用于清理到期的消息
+		index.Fields("expireTime"),
+		// 媒体文件索引，删除文件前检查是否仍被其他消息引用
+		index.Fields("storageKey"),
+	}
+}
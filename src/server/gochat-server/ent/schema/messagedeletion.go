@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MessageDeletion 用户删除的消息，只对该用户隐藏，其他人仍可见
+type MessageDeletion struct {
+	ent.Schema
+}
+
+// Fields of the MessageDeletion.
+func (MessageDeletion) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("userId").Comment("删除消息的用户ID"),
+		field.String("msgId").NotEmpty().Comment("消息ID"),
+		field.Bool("isGroup").Default(false).Comment("是否为群聊消息"),
+		field.Int("targetId").Comment("所在会话：私聊为对方用户ID，群聊为群组ID"),
+		field.Time("createTime").Default(time.Now).Comment("删除时间"),
+	}
+}
+
+// Edges of the MessageDeletion.
+func (MessageDeletion) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the MessageDeletion.
+func (MessageDeletion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("userId", "msgId").Unique(),
+		// 按会话查询用户删除的消息
+		index.Fields("userId", "isGroup", "targetId"),
+	}
+}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MessageForward 转发来源信息：记录逐条转发生成的消息来自哪条原始消息
+type MessageForward struct {
+	ent.Schema
+}
+
+// Fields of the MessageForward.
+func (MessageForward) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("msgId").NotEmpty().Comment("转发生成的新消息ID"),
+		field.String("sourceMsgId").NotEmpty().Comment("原始消息ID"),
+		field.Int("sourceFromUserId").Comment("原始消息发送者ID"),
+		field.Bool("sourceIsGroup").Default(false).Comment("原始消息是否为群聊消息"),
+		field.Int("sourceGroupId").Optional().Comment("原始消息所在群组ID"),
+		field.Time("sourceCreateTime").Comment("原始消息发送时间"),
+		field.Int("forwardUserId").Comment("转发者ID"),
+		field.Time("createTime").Default(time.Now).Comment("转发时间"),
+	}
+}
+
+// Edges of the MessageForward.
+func (MessageForward) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the MessageForward.
+func (MessageForward) Indexes() []ent.Index {
+	return []ent.Index{
+		// 消息ID索引，每条转发消息只有一个来源
+		index.Fields("msgId").Unique(),
+		// 原始消息ID索引，用于统计消息被转发的情况
+		index.Fields("sourceMsgId"),
+	}
+}
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MessageMention holds the schema definition for the MessageMention entity.
+type MessageMention struct {
+	ent.Schema
+}
+
+// Fields of the MessageMention.
+func (MessageMention) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("msgId").NotEmpty().Comment("消息ID"),
+		field.Int("groupId").Comment("群组ID"),
+		field.Int("fromUserId").Comment("发送者ID"),
+		field.Int("mentionedUserId").Default(0).Comment("被提及的用户ID，@所有人时为0"),
+		field.Bool("isAll").Default(false).Comment("是否为@所有人"),
+		field.Int("offset").Default(0).Comment("提及在消息文本中的起始位置（字符）"),
+		field.Int("length").Default(0).Comment("提及文本长度（字符）"),
+		field.Time("createTime").Default(time.Now).Comment("创建时间"),
+	}
+}
+
+// Edges of the MessageMention.
+func (MessageMention) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the MessageMention.
+func (MessageMention) Indexes() []ent.Index {
+	return []ent.Index{
+		// 消息ID索引，用于查询消息的提及信息
+		index.Fields("msgId"),
+		// 被提及用户和创建时间索引，用于查询"提及我的"消息
+		index.Fields("mentionedUserId", "createTime"),
+		// 群组和@所有人索引，用于查询群内@所有人的消息
+		index.Fields("groupId", "isAll", "createTime"),
+	}
+}
@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MessageOutbox 消息推送发件箱：与消息在同一事务中写入，由后台分发器推送给接收者
+// 每个接收者一条记录，推送成功后删除
+type MessageOutbox struct {
+	ent.Schema
+}
+
+// Fields of the MessageOutbox.
+func (MessageOutbox) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("msgId").NotEmpty().Comment("消息ID"),
+		field.Int("userId").Comment("接收者ID"),
+		field.String("status").Default("pending").Comment("状态: pending 待推送, dead 多次失败后放弃"),
+		field.Int("attempts").Default(0).Comment("已尝试推送的次数"),
+		field.Time("nextAttemptTime").Default(time.Now).Comment("下次可以推送的时间，推送中的记录为租约到期时间"),
+		field.String("lastError").Optional().Comment("最近一次推送失败的原因"),
+		field.String("leaseToken").Default("").Comment("领取时写入的租约标识，分发器一次更新领取一批记录后按该标识查出领取的记录"),
+		field.Time("createTime").Default(time.Now).Comment("创建时间"),
+	}
+}
+
+// Edges of the MessageOutbox.
+func (MessageOutbox) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the MessageOutbox.
+func (MessageOutbox) Indexes() []ent.Index {
+	return []ent.Index{
+		// 分发器按状态和下次推送时间领取记录
+		index.Fields("status", "nextAttemptTime"),
+		index.Fields("msgId", "userId").Unique(),
+		index.Fields("leaseToken"),
+	}
+}
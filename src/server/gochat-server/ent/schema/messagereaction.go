@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MessageReaction holds the schema definition for the MessageReaction entity.
+type MessageReaction struct {
+	ent.Schema
+}
+
+// Fields of the MessageReaction.
+func (MessageReaction) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("msgId").NotEmpty().Comment("消息ID"),
+		field.Int("userId").Comment("表态用户ID"),
+		field.String("emoji").NotEmpty().MaxLen(32).Comment("表情"),
+		field.Time("createTime").Default(time.Now).Comment("创建时间"),
+	}
+}
+
+// Edges of the MessageReaction.
+func (MessageReaction) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the MessageReaction.
+func (MessageReaction) Indexes() []ent.Index {
+	return []ent.Index{
+		// 同一用户对同一消息的同一表情只能表态一次
+		index.Fields("msgId", "userId", "emoji").Unique(),
+		// 消息ID索引，用于汇总消息的表态
+		index.Fields("msgId"),
+	}
+}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RetentionJob 消息保留期限清理任务，按消息ID分批处理并记录进度，服务重启后从上次的位置继续
+type RetentionJob struct {
+	ent.Schema
+}
+
+// Fields of the RetentionJob.
+func (RetentionJob) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("status").Default("running").Comment("状态: running 执行中, completed 已完成, failed 失败"),
+		field.Int("cursor").Default(0).Comment("已处理到的消息记录ID"),
+		field.Int("maxMessageId").Default(0).Comment("任务开始时最大的消息记录ID，之后的消息不处理"),
+		field.Int("scanned").Default(0).Comment("已检查的消息数"),
+		field.Int("purged").Default(0).Comment("已清理的消息数"),
+		field.Int("archived").Default(0).Comment("已归档的消息数"),
+		field.Int("mediaDeleted").Default(0).Comment("已删除的媒体文件数"),
+		field.String("lastError").Default("").Comment("失败原因"),
+		field.Time("startTime").Default(time.Now).Comment("开始时间，各会话的保留期限以此计算"),
+		field.Time("updateTime").Default(time.Now).UpdateDefault(time.Now).Comment("最近一次更新进度的时间"),
+		field.Time("finishTime").Optional().Nillable().Comment("结束时间"),
+	}
+}
+
+// Edges of the RetentionJob.
+func (RetentionJob) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the RetentionJob.
+func (RetentionJob) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status"),
+	}
+}
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ScheduledMessage 定时消息：用户编辑好的消息在指定时间由调度器发送
+type ScheduledMessage struct {
+	ent.Schema
+}
+
+// Fields of the ScheduledMessage.
+func (ScheduledMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("userId").Comment("发送者ID"),
+		field.Int("toUserId").Default(0).Comment("接收者ID，群消息为0"),
+		field.Int("groupId").Default(0).Comment("群组ID，私聊消息为0"),
+		field.Int("msgType").Comment("消息类型"),
+		field.Text("content").Comment("消息内容，与发送消息接口的 content 相同"),
+		field.Text("mentions").Default("").Comment("@提及(JSON)"),
+		field.String("replyToMsgId").Default("").Comment("被回复的消息ID"),
+		field.Time("sendTime").Comment("计划发送时间"),
+		field.String("status").Default("pending").Comment("状态: pending 待发送, sending 发送中, sent 已发送, failed 发送失败, canceled 已取消"),
+		field.Time("leaseTime").Optional().Nillable().Comment("发送中记录的租约到期时间，调度器崩溃后由其他调度器重新领取；待发送记录因临时错误等待重试时为下次重试时间"),
+		field.Int("attempts").Default(0).Comment("被调度器领取的次数，用于判断领取是否仍然有效"),
+		field.Int("retries").Default(0).Comment("因临时错误（如数据库超时）重试的次数，修改后清零"),
+		field.String("msgId").Default("").Comment("发送后生成的消息ID"),
+		field.String("failReason").Default("").Comment("发送失败的原因"),
+		field.Time("createTime").Default(time.Now).Comment("创建时间"),
+		field.Time("updateTime").Default(time.Now).UpdateDefault(time.Now).Comment("更新时间"),
+	}
+}
+
+// Edges of the ScheduledMessage.
+func (ScheduledMessage) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the ScheduledMessage.
+func (ScheduledMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		// 调度器按状态和计划发送时间领取记录
+		index.Fields("status", "sendTime"),
+		index.Fields("userId", "status"),
+	}
+}
@@ -6,6 +6,7 @@ import (
 )
 
 // TextMessage holds the schema definition for the TextMessage entity.
+// Deprecated: 消息内容已统一保存在 Message.body 中，该表仅用于迁移历史数据。
 type TextMessage struct {
 	ent.Schema
 }
@@ -6,6 +6,7 @@ import (
 )
 
 // VideoMessage holds the schema definition for the VideoMessage entity.
+// Deprecated: 消息内容已统一保存在 Message.body 中，该表仅用于迁移历史数据。
 type VideoMessage struct {
 	ent.Schema
 }
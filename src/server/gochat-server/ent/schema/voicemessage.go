@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// VoiceMessage holds the schema definition for the VoiceMessage entity.
+// Deprecated: 消息内容已统一保存在 Message.body 中，该表仅用于迁移历史数据。
+type VoiceMessage struct {
+	ent.Schema
+}
+
+// Fields of the VoiceMessage.
+func (VoiceMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("msgId").NotEmpty().Comment("消息ID,由发送者产生"),
+		field.String("storageKey").NotEmpty().Comment("音频在对象存储中的键"),
+		field.String("mimeType").Default("application/octet-stream").Comment("音频MIME类型"),
+		field.Int64("fileSize").NonNegative().Comment("音频大小(字节)"),
+		field.Int("duration").NonNegative().Comment("时长(毫秒),上传时由服务端解析"),
+		field.JSON("waveform", []int{}).Comment("降采样后的振幅波形,上传时由服务端计算"),
+	}
+}
+
+// Edges of the VoiceMessage.
+func (VoiceMessage) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the VoiceMessage.
+func (VoiceMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		// 消息ID索引，用于快速查找消息内容
+		index.Fields("msgId").Unique(),
+	}
+}
@@ -12,10 +12,24 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Block is the client for interacting with the Block builders.
+	Block *BlockClient
 	// ChatRecord is the client for interacting with the ChatRecord builders.
 	ChatRecord *ChatRecordClient
+	// ContactCardMessage is the client for interacting with the ContactCardMessage builders.
+	ContactCardMessage *ContactCardMessageClient
+	// ConversationClear is the client for interacting with the ConversationClear builders.
+	ConversationClear *ConversationClearClient
+	// ConversationTimer is the client for interacting with the ConversationTimer builders.
+	ConversationTimer *ConversationTimerClient
+	// DataMigration is the client for interacting with the DataMigration builders.
+	DataMigration *DataMigrationClient
 	// DoNotDisturb is the client for interacting with the DoNotDisturb builders.
 	DoNotDisturb *DoNotDisturbClient
+	// ExportJob is the client for interacting with the ExportJob builders.
+	ExportJob *ExportJobClient
+	// FileMessage is the client for interacting with the FileMessage builders.
+	FileMessage *FileMessageClient
 	// FriendRelationship is the client for interacting with the FriendRelationship builders.
 	FriendRelationship *FriendRelationshipClient
 	// FriendRequest is the client for interacting with the FriendRequest builders.
@@ -24,18 +38,40 @@ type Tx struct {
 	Group *GroupClient
 	// GroupChatRecord is the client for interacting with the GroupChatRecord builders.
 	GroupChatRecord *GroupChatRecordClient
+	// GroupWatermark is the client for interacting with the GroupWatermark builders.
+	GroupWatermark *GroupWatermarkClient
 	// ImageMessage is the client for interacting with the ImageMessage builders.
 	ImageMessage *ImageMessageClient
+	// LocationMessage is the client for interacting with the LocationMessage builders.
+	LocationMessage *LocationMessageClient
+	// MergedForwardMessage is the client for interacting with the MergedForwardMessage builders.
+	MergedForwardMessage *MergedForwardMessageClient
 	// Message is the client for interacting with the Message builders.
 	Message *MessageClient
+	// MessageDeletion is the client for interacting with the MessageDeletion builders.
+	MessageDeletion *MessageDeletionClient
+	// MessageForward is the client for interacting with the MessageForward builders.
+	MessageForward *MessageForwardClient
+	// MessageMention is the client for interacting with the MessageMention builders.
+	MessageMention *MessageMentionClient
+	// MessageOutbox is the client for interacting with the MessageOutbox builders.
+	MessageOutbox *MessageOutboxClient
+	// MessageReaction is the client for interacting with the MessageReaction builders.
+	MessageReaction *MessageReactionClient
 	// MessageStatus is the client for interacting with the MessageStatus builders.
 	MessageStatus *MessageStatusClient
+	// RetentionJob is the client for interacting with the RetentionJob builders.
+	RetentionJob *RetentionJobClient
+	// ScheduledMessage is the client for interacting with the ScheduledMessage builders.
+	ScheduledMessage *ScheduledMessageClient
 	// TextMessage is the client for interacting with the TextMessage builders.
 	TextMessage *TextMessageClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// VideoMessage is the client for interacting with the VideoMessage builders.
 	VideoMessage *VideoMessageClient
+	// VoiceMessage is the client for interacting with the VoiceMessage builders.
+	VoiceMessage *VoiceMessageClient
 
 	// lazily loaded.
 	client     *Client
@@ -167,18 +203,36 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Block = NewBlockClient(tx.config)
 	tx.ChatRecord = NewChatRecordClient(tx.config)
+	tx.ContactCardMessage = NewContactCardMessageClient(tx.config)
+	tx.ConversationClear = NewConversationClearClient(tx.config)
+	tx.ConversationTimer = NewConversationTimerClient(tx.config)
+	tx.DataMigration = NewDataMigrationClient(tx.config)
 	tx.DoNotDisturb = NewDoNotDisturbClient(tx.config)
+	tx.ExportJob = NewExportJobClient(tx.config)
+	tx.FileMessage = NewFileMessageClient(tx.config)
 	tx.FriendRelationship = NewFriendRelationshipClient(tx.config)
 	tx.FriendRequest = NewFriendRequestClient(tx.config)
 	tx.Group = NewGroupClient(tx.config)
 	tx.GroupChatRecord = NewGroupChatRecordClient(tx.config)
+	tx.GroupWatermark = NewGroupWatermarkClient(tx.config)
 	tx.ImageMessage = NewImageMessageClient(tx.config)
+	tx.LocationMessage = NewLocationMessageClient(tx.config)
+	tx.MergedForwardMessage = NewMergedForwardMessageClient(tx.config)
 	tx.Message = NewMessageClient(tx.config)
+	tx.MessageDeletion = NewMessageDeletionClient(tx.config)
+	tx.MessageForward = NewMessageForwardClient(tx.config)
+	tx.MessageMention = NewMessageMentionClient(tx.config)
+	tx.MessageOutbox = NewMessageOutboxClient(tx.config)
+	tx.MessageReaction = NewMessageReactionClient(tx.config)
 	tx.MessageStatus = NewMessageStatusClient(tx.config)
+	tx.RetentionJob = NewRetentionJobClient(tx.config)
+	tx.ScheduledMessage = NewScheduledMessageClient(tx.config)
 	tx.TextMessage = NewTextMessageClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 	tx.VideoMessage = NewVideoMessageClient(tx.config)
+	tx.VoiceMessage = NewVoiceMessageClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -188,7 +242,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: ChatRecord.QueryXXX(), the query will be executed
+// applies a query, for example: Block.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -0,0 +1,163 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"gochat_server/ent/voicemessage"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// VoiceMessage is the model entity for the VoiceMessage schema.
+type VoiceMessage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 消息ID,由发送者产生
+	MsgId string `json:"msgId,omitempty"`
+	// 音频在对象存储中的键
+	StorageKey string `json:"storageKey,omitempty"`
+	// 音频MIME类型
+	MimeType string `json:"mimeType,omitempty"`
+	// 音频大小(字节)
+	FileSize int64 `json:"fileSize,omitempty"`
+	// 时长(毫秒),上传时由服务端解析
+	Duration int `json:"duration,omitempty"`
+	// 降采样后的振幅波形,上传时由服务端计算
+	Waveform     []int `json:"waveform,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*VoiceMessage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case voicemessage.FieldWaveform:
+			values[i] = new([]byte)
+		case voicemessage.FieldID, voicemessage.FieldFileSize, voicemessage.FieldDuration:
+			values[i] = new(sql.NullInt64)
+		case voicemessage.FieldMsgId, voicemessage.FieldStorageKey, voicemessage.FieldMimeType:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the VoiceMessage fields.
+func (vm *VoiceMessage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case voicemessage.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			vm.ID = int(value.Int64)
+		case voicemessage.FieldMsgId:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field msgId", values[i])
+			} else if value.Valid {
+				vm.MsgId = value.String
+			}
+		case voicemessage.FieldStorageKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field storageKey", values[i])
+			} else if value.Valid {
+				vm.StorageKey = value.String
+			}
+		case voicemessage.FieldMimeType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mimeType", values[i])
+			} else if value.Valid {
+				vm.MimeType = value.String
+			}
+		case voicemessage.FieldFileSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field fileSize", values[i])
+			} else if value.Valid {
+				vm.FileSize = value.Int64
+			}
+		case voicemessage.FieldDuration:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration", values[i])
+			} else if value.Valid {
+				vm.Duration = int(value.Int64)
+			}
+		case voicemessage.FieldWaveform:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field waveform", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &vm.Waveform); err != nil {
+					return fmt.Errorf("unmarshal field waveform: %w", err)
+				}
+			}
+		default:
+			vm.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the VoiceMessage.
+// This includes values selected through modifiers, order, etc.
+func (vm *VoiceMessage) Value(name string) (ent.Value, error) {
+	return vm.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this VoiceMessage.
+// Note that you need to call VoiceMessage.Unwrap() before calling this method if this VoiceMessage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (vm *VoiceMessage) Update() *VoiceMessageUpdateOne {
+	return NewVoiceMessageClient(vm.config).UpdateOne(vm)
+}
+
+// Unwrap unwraps the VoiceMessage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (vm *VoiceMessage) Unwrap() *VoiceMessage {
+	_tx, ok := vm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: VoiceMessage is not a transactional entity")
+	}
+	vm.config.driver = _tx.drv
+	return vm
+}
+
+// String implements the fmt.Stringer.
+func (vm *VoiceMessage) String() string {
+	var builder strings.Builder
+	builder.WriteString("VoiceMessage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", vm.ID))
+	builder.WriteString("msgId=")
+	builder.WriteString(vm.MsgId)
+	builder.WriteString(", ")
+	builder.WriteString("storageKey=")
+	builder.WriteString(vm.StorageKey)
+	builder.WriteString(", ")
+	builder.WriteString("mimeType=")
+	builder.WriteString(vm.MimeType)
+	builder.WriteString(", ")
+	builder.WriteString("fileSize=")
+	builder.WriteString(fmt.Sprintf("%v", vm.FileSize))
+	builder.WriteString(", ")
+	builder.WriteString("duration=")
+	builder.WriteString(fmt.Sprintf("%v", vm.Duration))
+	builder.WriteString(", ")
+	builder.WriteString("waveform=")
+	builder.WriteString(fmt.Sprintf("%v", vm.Waveform))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// VoiceMessages is a parsable slice of VoiceMessage.
+type VoiceMessages []*VoiceMessage
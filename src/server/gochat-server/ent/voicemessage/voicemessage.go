@@ -0,0 +1,95 @@
+// Code generated by ent, DO NOT EDIT.
+
+package voicemessage
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the voicemessage type in the database.
+	Label = "voice_message"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldMsgId holds the string denoting the msgid field in the database.
+	FieldMsgId = "msg_id"
+	// FieldStorageKey holds the string denoting the storagekey field in the database.
+	FieldStorageKey = "storage_key"
+	// FieldMimeType holds the string denoting the mimetype field in the database.
+	FieldMimeType = "mime_type"
+	// FieldFileSize holds the string denoting the filesize field in the database.
+	FieldFileSize = "file_size"
+	// FieldDuration holds the string denoting the duration field in the database.
+	FieldDuration = "duration"
+	// FieldWaveform holds the string denoting the waveform field in the database.
+	FieldWaveform = "waveform"
+	// Table holds the table name of the voicemessage in the database.
+	Table = "voice_messages"
+)
+
+// Columns holds all SQL columns for voicemessage fields.
+var Columns = []string{
+	FieldID,
+	FieldMsgId,
+	FieldStorageKey,
+	FieldMimeType,
+	FieldFileSize,
+	FieldDuration,
+	FieldWaveform,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// MsgIdValidator is a validator for the "msgId" field. It is called by the builders before save.
+	MsgIdValidator func(string) error
+	// StorageKeyValidator is a validator for the "storageKey" field. It is called by the builders before save.
+	StorageKeyValidator func(string) error
+	// DefaultMimeType holds the default value on creation for the "mimeType" field.
+	DefaultMimeType string
+	// FileSizeValidator is a validator for the "fileSize" field. It is called by the builders before save.
+	FileSizeValidator func(int64) error
+	// DurationValidator is a validator for the "duration" field. It is called by the builders before save.
+	DurationValidator func(int) error
+)
+
+// OrderOption defines the ordering options for the VoiceMessage queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByMsgId orders the results by the msgId field.
+func ByMsgId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMsgId, opts...).ToFunc()
+}
+
+// ByStorageKey orders the results by the storageKey field.
+func ByStorageKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStorageKey, opts...).ToFunc()
+}
+
+// ByMimeType orders the results by the mimeType field.
+func ByMimeType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMimeType, opts...).ToFunc()
+}
+
+// ByFileSize orders the results by the fileSize field.
+func ByFileSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFileSize, opts...).ToFunc()
+}
+
+// ByDuration orders the results by the duration field.
+func ByDuration(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDuration, opts...).ToFunc()
+}
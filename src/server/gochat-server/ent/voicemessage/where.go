@@ -0,0 +1,369 @@
+// Code generated by ent, DO NOT EDIT.
+
+package voicemessage
+
+import (
+	"gochat_server/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldLTE(FieldID, id))
+}
+
+// MsgId applies equality check predicate on the "msgId" field. It's identical to MsgIdEQ.
+func MsgId(v string) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldEQ(FieldMsgId, v))
+}
+
+// StorageKey applies equality check predicate on the "storageKey" field. It's identical to StorageKeyEQ.
+func StorageKey(v string) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldEQ(FieldStorageKey, v))
+}
+
+// MimeType applies equality check predicate on the "mimeType" field. It's identical to MimeTypeEQ.
+func MimeType(v string) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldEQ(FieldMimeType, v))
+}
+
+// FileSize applies equality check predicate on the "fileSize" field. It's identical to FileSizeEQ.
+func FileSize(v int64) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldEQ(FieldFileSize, v))
+}
+
+// Duration applies equality check predicate on the "duration" field. It's identical to DurationEQ.
+func Duration(v int) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldEQ(FieldDuration, v))
+}
+
+// MsgIdEQ applies the EQ predicate on the "msgId" field.
+func MsgIdEQ(v string) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldEQ(FieldMsgId, v))
+}
+
+// MsgIdNEQ applies the NEQ predicate on the "msgId" field.
+func MsgIdNEQ(v string) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldNEQ(FieldMsgId, v))
+}
+
+// MsgIdIn applies the In predicate on the "msgId" field.
+func MsgIdIn(vs ...string) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldIn(FieldMsgId, vs...))
+}
+
+// MsgIdNotIn applies the NotIn predicate on the "msgId" field.
+func MsgIdNotIn(vs ...string) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldNotIn(FieldMsgId, vs...))
+}
+
+// MsgIdGT applies the GT predicate on the "msgId" field.
+func MsgIdGT(v string) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldGT(FieldMsgId, v))
+}
+
+// MsgIdGTE applies the GTE predicate on the "msgId" field.
+func MsgIdGTE(v string) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldGTE(FieldMsgId, v))
+}
+
+// MsgIdLT applies the LT predicate on the "msgId" field.
+func MsgIdLT(v string) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldLT(FieldMsgId, v))
+}
+
+// MsgIdLTE applies the LTE predicate on the "msgId" field.
+func MsgIdLTE(v string) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldLTE(FieldMsgId, v))
+}
+
+// MsgIdContains applies the Contains predicate on the "msgId" field.
+func MsgIdContains(v string) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldContains(FieldMsgId, v))
+}
+
+// MsgIdHasPrefix applies the HasPrefix predicate on the "msgId" field.
+func MsgIdHasPrefix(v string) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldHasPrefix(FieldMsgId, v))
+}
+
+// MsgIdHasSuffix applies the HasSuffix predicate on the "msgId" field.
+func MsgIdHasSuffix(v string) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldHasSuffix(FieldMsgId, v))
+}
+
+// MsgIdEqualFold applies the EqualFold predicate on the "msgId" field.
+func MsgIdEqualFold(v string) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldEqualFold(FieldMsgId, v))
+}
+
+// MsgIdContainsFold applies the ContainsFold predicate on the "msgId" field.
+func MsgIdContainsFold(v string) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldContainsFold(FieldMsgId, v))
+}
+
+// StorageKeyEQ applies the EQ predicate on the "storageKey" field.
+func StorageKeyEQ(v string) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldEQ(FieldStorageKey, v))
+}
+
+// StorageKeyNEQ applies the NEQ predicate on the "storageKey" field.
+func StorageKeyNEQ(v string) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldNEQ(FieldStorageKey, v))
+}
+
+// StorageKeyIn applies the In predicate on the "storageKey" field.
+func StorageKeyIn(vs ...string) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldIn(FieldStorageKey, vs...))
+}
+
+// StorageKeyNotIn applies the NotIn predicate on the "storageKey" field.
+func StorageKeyNotIn(vs ...string) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldNotIn(FieldStorageKey, vs...))
+}
+
+// StorageKeyGT applies the GT predicate on the "storageKey" field.
+func StorageKeyGT(v string) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldGT(FieldStorageKey, v))
+}
+
+// StorageKeyGTE applies the GTE predicate on the "storageKey" field.
+func StorageKeyGTE(v string) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldGTE(FieldStorageKey, v))
+}
+
+// StorageKeyLT applies the LT predicate on the "storageKey" field.
+func StorageKeyLT(v string) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldLT(FieldStorageKey, v))
+}
+
+// StorageKeyLTE applies the LTE predicate on the "storageKey" field.
+func StorageKeyLTE(v string) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldLTE(FieldStorageKey, v))
+}
+
+// StorageKeyContains applies the Contains predicate on the "storageKey" field.
+func StorageKeyContains(v string) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldContains(FieldStorageKey, v))
+}
+
+// StorageKeyHasPrefix applies the HasPrefix predicate on the "storageKey" field.
+func StorageKeyHasPrefix(v string) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldHasPrefix(FieldStorageKey, v))
+}
+
+// StorageKeyHasSuffix applies the HasSuffix predicate on the "storageKey" field.
+func StorageKeyHasSuffix(v string) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldHasSuffix(FieldStorageKey, v))
+}
+
+// StorageKeyEqualFold applies the EqualFold predicate on the "storageKey" field.
+func StorageKeyEqualFold(v string) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldEqualFold(FieldStorageKey, v))
+}
+
+// StorageKeyContainsFold applies the ContainsFold predicate on the "storageKey" field.
+func StorageKeyContainsFold(v string) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldContainsFold(FieldStorageKey, v))
+}
+
+// MimeTypeEQ applies the EQ predicate on the "mimeType" field.
+func MimeTypeEQ(v string) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldEQ(FieldMimeType, v))
+}
+
+// MimeTypeNEQ applies the NEQ predicate on the "mimeType" field.
+func MimeTypeNEQ(v string) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldNEQ(FieldMimeType, v))
+}
+
+// MimeTypeIn applies the In predicate on the "mimeType" field.
+func MimeTypeIn(vs ...string) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldIn(FieldMimeType, vs...))
+}
+
+// MimeTypeNotIn applies the NotIn predicate on the "mimeType" field.
+func MimeTypeNotIn(vs ...string) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldNotIn(FieldMimeType, vs...))
+}
+
+// MimeTypeGT applies the GT predicate on the "mimeType" field.
+func MimeTypeGT(v string) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldGT(FieldMimeType, v))
+}
+
+// MimeTypeGTE applies the GTE predicate on the "mimeType" field.
+func MimeTypeGTE(v string) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldGTE(FieldMimeType, v))
+}
+
+// MimeTypeLT applies the LT predicate on the "mimeType" field.
+func MimeTypeLT(v string) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldLT(FieldMimeType, v))
+}
+
+// MimeTypeLTE applies the LTE predicate on the "mimeType" field.
+func MimeTypeLTE(v string) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldLTE(FieldMimeType, v))
+}
+
+// MimeTypeContains applies the Contains predicate on the "mimeType" field.
+func MimeTypeContains(v string) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldContains(FieldMimeType, v))
+}
+
+// MimeTypeHasPrefix applies the HasPrefix predicate on the "mimeType" field.
+func MimeTypeHasPrefix(v string) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldHasPrefix(FieldMimeType, v))
+}
+
+// MimeTypeHasSuffix applies the HasSuffix predicate on the "mimeType" field.
+func MimeTypeHasSuffix(v string) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldHasSuffix(FieldMimeType, v))
+}
+
+// MimeTypeEqualFold applies the EqualFold predicate on the "mimeType" field.
+func MimeTypeEqualFold(v string) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldEqualFold(FieldMimeType, v))
+}
+
+// MimeTypeContainsFold applies the ContainsFold predicate on the "mimeType" field.
+func MimeTypeContainsFold(v string) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldContainsFold(FieldMimeType, v))
+}
+
+// FileSizeEQ applies the EQ predicate on the "fileSize" field.
+func FileSizeEQ(v int64) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldEQ(FieldFileSize, v))
+}
+
+// FileSizeNEQ applies the NEQ predicate on the "fileSize" field.
+func FileSizeNEQ(v int64) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldNEQ(FieldFileSize, v))
+}
+
+// FileSizeIn applies the In predicate on the "fileSize" field.
+func FileSizeIn(vs ...int64) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldIn(FieldFileSize, vs...))
+}
+
+// FileSizeNotIn applies the NotIn predicate on the "fileSize" field.
+func FileSizeNotIn(vs ...int64) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldNotIn(FieldFileSize, vs...))
+}
+
+// FileSizeGT applies the GT predicate on the "fileSize" field.
+func FileSizeGT(v int64) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldGT(FieldFileSize, v))
+}
+
+// FileSizeGTE applies the GTE predicate on the "fileSize" field.
+func FileSizeGTE(v int64) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldGTE(FieldFileSize, v))
+}
+
+// FileSizeLT applies the LT predicate on the "fileSize" field.
+func FileSizeLT(v int64) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldLT(FieldFileSize, v))
+}
+
+// FileSizeLTE applies the LTE predicate on the "fileSize" field.
+func FileSizeLTE(v int64) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldLTE(FieldFileSize, v))
+}
+
+// DurationEQ applies the EQ predicate on the "duration" field.
+func DurationEQ(v int) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldEQ(FieldDuration, v))
+}
+
+// DurationNEQ applies the NEQ predicate on the "duration" field.
+func DurationNEQ(v int) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldNEQ(FieldDuration, v))
+}
+
+// DurationIn applies the In predicate on the "duration" field.
+func DurationIn(vs ...int) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldIn(FieldDuration, vs...))
+}
+
+// DurationNotIn applies the NotIn predicate on the "duration" field.
+func DurationNotIn(vs ...int) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldNotIn(FieldDuration, vs...))
+}
+
+// DurationGT applies the GT predicate on the "duration" field.
+func DurationGT(v int) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldGT(FieldDuration, v))
+}
+
+// DurationGTE applies the GTE predicate on the "duration" field.
+func DurationGTE(v int) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldGTE(FieldDuration, v))
+}
+
+// DurationLT applies the LT predicate on the "duration" field.
+func DurationLT(v int) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldLT(FieldDuration, v))
+}
+
+// DurationLTE applies the LTE predicate on the "duration" field.
+func DurationLTE(v int) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.FieldLTE(FieldDuration, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.VoiceMessage) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.VoiceMessage) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.VoiceMessage) predicate.VoiceMessage {
+	return predicate.VoiceMessage(sql.NotPredicates(p))
+}
@@ -0,0 +1,286 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gochat_server/ent/voicemessage"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// VoiceMessageCreate is the builder for creating a VoiceMessage entity.
+type VoiceMessageCreate struct {
+	config
+	mutation *VoiceMessageMutation
+	hooks    []Hook
+}
+
+// SetMsgId sets the "msgId" field.
+func (vmc *VoiceMessageCreate) SetMsgId(s string) *VoiceMessageCreate {
+	vmc.mutation.SetMsgId(s)
+	return vmc
+}
+
+// SetStorageKey sets the "storageKey" field.
+func (vmc *VoiceMessageCreate) SetStorageKey(s string) *VoiceMessageCreate {
+	vmc.mutation.SetStorageKey(s)
+	return vmc
+}
+
+// SetMimeType sets the "mimeType" field.
+func (vmc *VoiceMessageCreate) SetMimeType(s string) *VoiceMessageCreate {
+	vmc.mutation.SetMimeType(s)
+	return vmc
+}
+
+// SetNillableMimeType sets the "mimeType" field if the given value is not nil.
+func (vmc *VoiceMessageCreate) SetNillableMimeType(s *string) *VoiceMessageCreate {
+	if s != nil {
+		vmc.SetMimeType(*s)
+	}
+	return vmc
+}
+
+// SetFileSize sets the "fileSize" field.
+func (vmc *VoiceMessageCreate) SetFileSize(i int64) *VoiceMessageCreate {
+	vmc.mutation.SetFileSize(i)
+	return vmc
+}
+
+// SetDuration sets the "duration" field.
+func (vmc *VoiceMessageCreate) SetDuration(i int) *VoiceMessageCreate {
+	vmc.mutation.SetDuration(i)
+	return vmc
+}
+
+// SetWaveform sets the "waveform" field.
+func (vmc *VoiceMessageCreate) SetWaveform(i []int) *VoiceMessageCreate {
+	vmc.mutation.SetWaveform(i)
+	return vmc
+}
+
+// Mutation returns the VoiceMessageMutation object of the builder.
+func (vmc *VoiceMessageCreate) Mutation() *VoiceMessageMutation {
+	return vmc.mutation
+}
+
+// Save creates the VoiceMessage in the database.
+func (vmc *VoiceMessageCreate) Save(ctx context.Context) (*VoiceMessage, error) {
+	vmc.defaults()
+	return withHooks(ctx, vmc.sqlSave, vmc.mutation, vmc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (vmc *VoiceMessageCreate) SaveX(ctx context.Context) *VoiceMessage {
+	v, err := vmc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vmc *VoiceMessageCreate) Exec(ctx context.Context) error {
+	_, err := vmc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vmc *VoiceMessageCreate) ExecX(ctx context.Context) {
+	if err := vmc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (vmc *VoiceMessageCreate) defaults() {
+	if _, ok := vmc.mutation.MimeType(); !ok {
+		v := voicemessage.DefaultMimeType
+		vmc.mutation.SetMimeType(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vmc *VoiceMessageCreate) check() error {
+	if _, ok := vmc.mutation.MsgId(); !ok {
+		return &ValidationError{Name: "msgId", err: errors.New(`ent: missing required field "VoiceMessage.msgId"`)}
+	}
+	if v, ok := vmc.mutation.MsgId(); ok {
+		if err := voicemessage.MsgIdValidator(v); err != nil {
+			return &ValidationError{Name: "msgId", err: fmt.Errorf(`ent: validator failed for field "VoiceMessage.msgId": %w`, err)}
+		}
+	}
+	if _, ok := vmc.mutation.StorageKey(); !ok {
+		return &ValidationError{Name: "storageKey", err: errors.New(`ent: missing required field "VoiceMessage.storageKey"`)}
+	}
+	if v, ok := vmc.mutation.StorageKey(); ok {
+		if err := voicemessage.StorageKeyValidator(v); err != nil {
+			return &ValidationError{Name: "storageKey", err: fmt.Errorf(`ent: validator failed for field "VoiceMessage.storageKey": %w`, err)}
+		}
+	}
+	if _, ok := vmc.mutation.MimeType(); !ok {
+		return &ValidationError{Name: "mimeType", err: errors.New(`ent: missing required field "VoiceMessage.mimeType"`)}
+	}
+	if _, ok := vmc.mutation.FileSize(); !ok {
+		return &ValidationError{Name: "fileSize", err: errors.New(`ent: missing required field "VoiceMessage.fileSize"`)}
+	}
+	if v, ok := vmc.mutation.FileSize(); ok {
+		if err := voicemessage.FileSizeValidator(v); err != nil {
+			return &ValidationError{Name: "fileSize", err: fmt.Errorf(`ent: validator failed for field "VoiceMessage.fileSize": %w`, err)}
+		}
+	}
+	if _, ok := vmc.mutation.Duration(); !ok {
+		return &ValidationError{Name: "duration", err: errors.New(`ent: missing required field "VoiceMessage.duration"`)}
+	}
+	if v, ok := vmc.mutation.Duration(); ok {
+		if err := voicemessage.DurationValidator(v); err != nil {
+			return &ValidationError{Name: "duration", err: fmt.Errorf(`ent: validator failed for field "VoiceMessage.duration": %w`, err)}
+		}
+	}
+	if _, ok := vmc.mutation.Waveform(); !ok {
+		return &ValidationError{Name: "waveform", err: errors.New(`ent: missing required field "VoiceMessage.waveform"`)}
+	}
+	return nil
+}
+
+func (vmc *VoiceMessageCreate) sqlSave(ctx context.Context) (*VoiceMessage, error) {
+	if err := vmc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := vmc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, vmc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	vmc.mutation.id = &_node.ID
+	vmc.mutation.done = true
+	return _node, nil
+}
+
+func (vmc *VoiceMessageCreate) createSpec() (*VoiceMessage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &VoiceMessage{config: vmc.config}
+		_spec = sqlgraph.NewCreateSpec(voicemessage.Table, sqlgraph.NewFieldSpec(voicemessage.FieldID, field.TypeInt))
+	)
+	if value, ok := vmc.mutation.MsgId(); ok {
+		_spec.SetField(voicemessage.FieldMsgId, field.TypeString, value)
+		_node.MsgId = value
+	}
+	if value, ok := vmc.mutation.StorageKey(); ok {
+		_spec.SetField(voicemessage.FieldStorageKey, field.TypeString, value)
+		_node.StorageKey = value
+	}
+	if value, ok := vmc.mutation.MimeType(); ok {
+		_spec.SetField(voicemessage.FieldMimeType, field.TypeString, value)
+		_node.MimeType = value
+	}
+	if value, ok := vmc.mutation.FileSize(); ok {
+		_spec.SetField(voicemessage.FieldFileSize, field.TypeInt64, value)
+		_node.FileSize = value
+	}
+	if value, ok := vmc.mutation.Duration(); ok {
+		_spec.SetField(voicemessage.FieldDuration, field.TypeInt, value)
+		_node.Duration = value
+	}
+	if value, ok := vmc.mutation.Waveform(); ok {
+		_spec.SetField(voicemessage.FieldWaveform, field.TypeJSON, value)
+		_node.Waveform = value
+	}
+	return _node, _spec
+}
+
+// VoiceMessageCreateBulk is the builder for creating many VoiceMessage entities in bulk.
+type VoiceMessageCreateBulk struct {
+	config
+	err      error
+	builders []*VoiceMessageCreate
+}
+
+// Save creates the VoiceMessage entities in the database.
+func (vmcb *VoiceMessageCreateBulk) Save(ctx context.Context) ([]*VoiceMessage, error) {
+	if vmcb.err != nil {
+		return nil, vmcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(vmcb.builders))
+	nodes := make([]*VoiceMessage, len(vmcb.builders))
+	mutators := make([]Mutator, len(vmcb.builders))
+	for i := range vmcb.builders {
+		func(i int, root context.Context) {
+			builder := vmcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*VoiceMessageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, vmcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, vmcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, vmcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vmcb *VoiceMessageCreateBulk) SaveX(ctx context.Context) []*VoiceMessage {
+	v, err := vmcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vmcb *VoiceMessageCreateBulk) Exec(ctx context.Context) error {
+	_, err := vmcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vmcb *VoiceMessageCreateBulk) ExecX(ctx context.Context) {
+	if err := vmcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"gochat_server/ent/predicate"
+	"gochat_server/ent/voicemessage"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// VoiceMessageDelete is the builder for deleting a VoiceMessage entity.
+type VoiceMessageDelete struct {
+	config
+	hooks    []Hook
+	mutation *VoiceMessageMutation
+}
+
+// Where appends a list predicates to the VoiceMessageDelete builder.
+func (vmd *VoiceMessageDelete) Where(ps ...predicate.VoiceMessage) *VoiceMessageDelete {
+	vmd.mutation.Where(ps...)
+	return vmd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (vmd *VoiceMessageDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, vmd.sqlExec, vmd.mutation, vmd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vmd *VoiceMessageDelete) ExecX(ctx context.Context) int {
+	n, err := vmd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (vmd *VoiceMessageDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(voicemessage.Table, sqlgraph.NewFieldSpec(voicemessage.FieldID, field.TypeInt))
+	if ps := vmd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, vmd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	vmd.mutation.done = true
+	return affected, err
+}
+
+// VoiceMessageDeleteOne is the builder for deleting a single VoiceMessage entity.
+type VoiceMessageDeleteOne struct {
+	vmd *VoiceMessageDelete
+}
+
+// Where appends a list predicates to the VoiceMessageDelete builder.
+func (vmdo *VoiceMessageDeleteOne) Where(ps ...predicate.VoiceMessage) *VoiceMessageDeleteOne {
+	vmdo.vmd.mutation.Where(ps...)
+	return vmdo
+}
+
+// Exec executes the deletion query.
+func (vmdo *VoiceMessageDeleteOne) Exec(ctx context.Context) error {
+	n, err := vmdo.vmd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{voicemessage.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vmdo *VoiceMessageDeleteOne) ExecX(ctx context.Context) {
+	if err := vmdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
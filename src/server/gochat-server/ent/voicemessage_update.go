@@ -0,0 +1,482 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gochat_server/ent/predicate"
+	"gochat_server/ent/voicemessage"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// VoiceMessageUpdate is the builder for updating VoiceMessage entities.
+type VoiceMessageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *VoiceMessageMutation
+}
+
+// Where appends a list predicates to the VoiceMessageUpdate builder.
+func (vmu *VoiceMessageUpdate) Where(ps ...predicate.VoiceMessage) *VoiceMessageUpdate {
+	vmu.mutation.Where(ps...)
+	return vmu
+}
+
+// SetMsgId sets the "msgId" field.
+func (vmu *VoiceMessageUpdate) SetMsgId(s string) *VoiceMessageUpdate {
+	vmu.mutation.SetMsgId(s)
+	return vmu
+}
+
+// SetNillableMsgId sets the "msgId" field if the given value is not nil.
+func (vmu *VoiceMessageUpdate) SetNillableMsgId(s *string) *VoiceMessageUpdate {
+	if s != nil {
+		vmu.SetMsgId(*s)
+	}
+	return vmu
+}
+
+// SetStorageKey sets the "storageKey" field.
+func (vmu *VoiceMessageUpdate) SetStorageKey(s string) *VoiceMessageUpdate {
+	vmu.mutation.SetStorageKey(s)
+	return vmu
+}
+
+// SetNillableStorageKey sets the "storageKey" field if the given value is not nil.
+func (vmu *VoiceMessageUpdate) SetNillableStorageKey(s *string) *VoiceMessageUpdate {
+	if s != nil {
+		vmu.SetStorageKey(*s)
+	}
+	return vmu
+}
+
+// SetMimeType sets the "mimeType" field.
+func (vmu *VoiceMessageUpdate) SetMimeType(s string) *VoiceMessageUpdate {
+	vmu.mutation.SetMimeType(s)
+	return vmu
+}
+
+// SetNillableMimeType sets the "mimeType" field if the given value is not nil.
+func (vmu *VoiceMessageUpdate) SetNillableMimeType(s *string) *VoiceMessageUpdate {
+	if s != nil {
+		vmu.SetMimeType(*s)
+	}
+	return vmu
+}
+
+// SetFileSize sets the "fileSize" field.
+func (vmu *VoiceMessageUpdate) SetFileSize(i int64) *VoiceMessageUpdate {
+	vmu.mutation.ResetFileSize()
+	vmu.mutation.SetFileSize(i)
+	return vmu
+}
+
+// SetNillableFileSize sets the "fileSize" field if the given value is not nil.
+func (vmu *VoiceMessageUpdate) SetNillableFileSize(i *int64) *VoiceMessageUpdate {
+	if i != nil {
+		vmu.SetFileSize(*i)
+	}
+	return vmu
+}
+
+// AddFileSize adds i to the "fileSize" field.
+func (vmu *VoiceMessageUpdate) AddFileSize(i int64) *VoiceMessageUpdate {
+	vmu.mutation.AddFileSize(i)
+	return vmu
+}
+
+// SetDuration sets the "duration" field.
+func (vmu *VoiceMessageUpdate) SetDuration(i int) *VoiceMessageUpdate {
+	vmu.mutation.ResetDuration()
+	vmu.mutation.SetDuration(i)
+	return vmu
+}
+
+// SetNillableDuration sets the "duration" field if the given value is not nil.
+func (vmu *VoiceMessageUpdate) SetNillableDuration(i *int) *VoiceMessageUpdate {
+	if i != nil {
+		vmu.SetDuration(*i)
+	}
+	return vmu
+}
+
+// AddDuration adds i to the "duration" field.
+func (vmu *VoiceMessageUpdate) AddDuration(i int) *VoiceMessageUpdate {
+	vmu.mutation.AddDuration(i)
+	return vmu
+}
+
+// SetWaveform sets the "waveform" field.
+func (vmu *VoiceMessageUpdate) SetWaveform(i []int) *VoiceMessageUpdate {
+	vmu.mutation.SetWaveform(i)
+	return vmu
+}
+
+// AppendWaveform appends i to the "waveform" field.
+func (vmu *VoiceMessageUpdate) AppendWaveform(i []int) *VoiceMessageUpdate {
+	vmu.mutation.AppendWaveform(i)
+	return vmu
+}
+
+// Mutation returns the VoiceMessageMutation object of the builder.
+func (vmu *VoiceMessageUpdate) Mutation() *VoiceMessageMutation {
+	return vmu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (vmu *VoiceMessageUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, vmu.sqlSave, vmu.mutation, vmu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vmu *VoiceMessageUpdate) SaveX(ctx context.Context) int {
+	affected, err := vmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (vmu *VoiceMessageUpdate) Exec(ctx context.Context) error {
+	_, err := vmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vmu *VoiceMessageUpdate) ExecX(ctx context.Context) {
+	if err := vmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vmu *VoiceMessageUpdate) check() error {
+	if v, ok := vmu.mutation.MsgId(); ok {
+		if err := voicemessage.MsgIdValidator(v); err != nil {
+			return &ValidationError{Name: "msgId", err: fmt.Errorf(`ent: validator failed for field "VoiceMessage.msgId": %w`, err)}
+		}
+	}
+	if v, ok := vmu.mutation.StorageKey(); ok {
+		if err := voicemessage.StorageKeyValidator(v); err != nil {
+			return &ValidationError{Name: "storageKey", err: fmt.Errorf(`ent: validator failed for field "VoiceMessage.storageKey": %w`, err)}
+		}
+	}
+	if v, ok := vmu.mutation.FileSize(); ok {
+		if err := voicemessage.FileSizeValidator(v); err != nil {
+			return &ValidationError{Name: "fileSize", err: fmt.Errorf(`ent: validator failed for field "VoiceMessage.fileSize": %w`, err)}
+		}
+	}
+	if v, ok := vmu.mutation.Duration(); ok {
+		if err := voicemessage.DurationValidator(v); err != nil {
+			return &ValidationError{Name: "duration", err: fmt.Errorf(`ent: validator failed for field "VoiceMessage.duration": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (vmu *VoiceMessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := vmu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(voicemessage.Table, voicemessage.Columns, sqlgraph.NewFieldSpec(voicemessage.FieldID, field.TypeInt))
+	if ps := vmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := vmu.mutation.MsgId(); ok {
+		_spec.SetField(voicemessage.FieldMsgId, field.TypeString, value)
+	}
+	if value, ok := vmu.mutation.StorageKey(); ok {
+		_spec.SetField(voicemessage.FieldStorageKey, field.TypeString, value)
+	}
+	if value, ok := vmu.mutation.MimeType(); ok {
+		_spec.SetField(voicemessage.FieldMimeType, field.TypeString, value)
+	}
+	if value, ok := vmu.mutation.FileSize(); ok {
+		_spec.SetField(voicemessage.FieldFileSize, field.TypeInt64, value)
+	}
+	if value, ok := vmu.mutation.AddedFileSize(); ok {
+		_spec.AddField(voicemessage.FieldFileSize, field.TypeInt64, value)
+	}
+	if value, ok := vmu.mutation.Duration(); ok {
+		_spec.SetField(voicemessage.FieldDuration, field.TypeInt, value)
+	}
+	if value, ok := vmu.mutation.AddedDuration(); ok {
+		_spec.AddField(voicemessage.FieldDuration, field.TypeInt, value)
+	}
+	if value, ok := vmu.mutation.Waveform(); ok {
+		_spec.SetField(voicemessage.FieldWaveform, field.TypeJSON, value)
+	}
+	if value, ok := vmu.mutation.AppendedWaveform(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, voicemessage.FieldWaveform, value)
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, vmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{voicemessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	vmu.mutation.done = true
+	return n, nil
+}
+
+// VoiceMessageUpdateOne is the builder for updating a single VoiceMessage entity.
+type VoiceMessageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *VoiceMessageMutation
+}
+
+// SetMsgId sets the "msgId" field.
+func (vmuo *VoiceMessageUpdateOne) SetMsgId(s string) *VoiceMessageUpdateOne {
+	vmuo.mutation.SetMsgId(s)
+	return vmuo
+}
+
+// SetNillableMsgId sets the "msgId" field if the given value is not nil.
+func (vmuo *VoiceMessageUpdateOne) SetNillableMsgId(s *string) *VoiceMessageUpdateOne {
+	if s != nil {
+		vmuo.SetMsgId(*s)
+	}
+	return vmuo
+}
+
+// SetStorageKey sets the "storageKey" field.
+func (vmuo *VoiceMessageUpdateOne) SetStorageKey(s string) *VoiceMessageUpdateOne {
+	vmuo.mutation.SetStorageKey(s)
+	return vmuo
+}
+
+// SetNillableStorageKey sets the "storageKey" field if the given value is not nil.
+func (vmuo *VoiceMessageUpdateOne) SetNillableStorageKey(s *string) *VoiceMessageUpdateOne {
+	if s != nil {
+		vmuo.SetStorageKey(*s)
+	}
+	return vmuo
+}
+
+// SetMimeType sets the "mimeType" field.
+func (vmuo *VoiceMessageUpdateOne) SetMimeType(s string) *VoiceMessageUpdateOne {
+	vmuo.mutation.SetMimeType(s)
+	return vmuo
+}
+
+// SetNillableMimeType sets the "mimeType" field if the given value is not nil.
+func (vmuo *VoiceMessageUpdateOne) SetNillableMimeType(s *string) *VoiceMessageUpdateOne {
+	if s != nil {
+		vmuo.SetMimeType(*s)
+	}
+	return vmuo
+}
+
+// SetFileSize sets the "fileSize" field.
+func (vmuo *VoiceMessageUpdateOne) SetFileSize(i int64) *VoiceMessageUpdateOne {
+	vmuo.mutation.ResetFileSize()
+	vmuo.mutation.SetFileSize(i)
+	return vmuo
+}
+
+// SetNillableFileSize sets the "fileSize" field if the given value is not nil.
+func (vmuo *VoiceMessageUpdateOne) SetNillableFileSize(i *int64) *VoiceMessageUpdateOne {
+	if i != nil {
+		vmuo.SetFileSize(*i)
+	}
+	return vmuo
+}
+
+// AddFileSize adds i to the "fileSize" field.
+func (vmuo *VoiceMessageUpdateOne) AddFileSize(i int64) *VoiceMessageUpdateOne {
+	vmuo.mutation.AddFileSize(i)
+	return vmuo
+}
+
+// SetDuration sets the "duration" field.
+func (vmuo *VoiceMessageUpdateOne) SetDuration(i int) *VoiceMessageUpdateOne {
+	vmuo.mutation.ResetDuration()
+	vmuo.mutation.SetDuration(i)
+	return vmuo
+}
+
+// SetNillableDuration sets the "duration" field if the given value is not nil.
+func (vmuo *VoiceMessageUpdateOne) SetNillableDuration(i *int) *VoiceMessageUpdateOne {
+	if i != nil {
+		vmuo.SetDuration(*i)
+	}
+	return vmuo
+}
+
+// AddDuration adds i to the "duration" field.
+func (vmuo *VoiceMessageUpdateOne) AddDuration(i int) *VoiceMessageUpdateOne {
+	vmuo.mutation.AddDuration(i)
+	return vmuo
+}
+
+// SetWaveform sets the "waveform" field.
+func (vmuo *VoiceMessageUpdateOne) SetWaveform(i []int) *VoiceMessageUpdateOne {
+	vmuo.mutation.SetWaveform(i)
+	return vmuo
+}
+
+// AppendWaveform appends i to the "waveform" field.
+func (vmuo *VoiceMessageUpdateOne) AppendWaveform(i []int) *VoiceMessageUpdateOne {
+	vmuo.mutation.AppendWaveform(i)
+	return vmuo
+}
+
+// Mutation returns the VoiceMessageMutation object of the builder.
+func (vmuo *VoiceMessageUpdateOne) Mutation() *VoiceMessageMutation {
+	return vmuo.mutation
+}
+
+// Where appends a list predicates to the VoiceMessageUpdate builder.
+func (vmuo *VoiceMessageUpdateOne) Where(ps ...predicate.VoiceMessage) *VoiceMessageUpdateOne {
+	vmuo.mutation.Where(ps...)
+	return vmuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (vmuo *VoiceMessageUpdateOne) Select(field string, fields ...string) *VoiceMessageUpdateOne {
+	vmuo.fields = append([]string{field}, fields...)
+	return vmuo
+}
+
+// Save executes the query and returns the updated VoiceMessage entity.
+func (vmuo *VoiceMessageUpdateOne) Save(ctx context.Context) (*VoiceMessage, error) {
+	return withHooks(ctx, vmuo.sqlSave, vmuo.mutation, vmuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vmuo *VoiceMessageUpdateOne) SaveX(ctx context.Context) *VoiceMessage {
+	node, err := vmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (vmuo *VoiceMessageUpdateOne) Exec(ctx context.Context) error {
+	_, err := vmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vmuo *VoiceMessageUpdateOne) ExecX(ctx context.Context) {
+	if err := vmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vmuo *VoiceMessageUpdateOne) check() error {
+	if v, ok := vmuo.mutation.MsgId(); ok {
+		if err := voicemessage.MsgIdValidator(v); err != nil {
+			return &ValidationError{Name: "msgId", err: fmt.Errorf(`ent: validator failed for field "VoiceMessage.msgId": %w`, err)}
+		}
+	}
+	if v, ok := vmuo.mutation.StorageKey(); ok {
+		if err := voicemessage.StorageKeyValidator(v); err != nil {
+			return &ValidationError{Name: "storageKey", err: fmt.Errorf(`ent: validator failed for field "VoiceMessage.storageKey": %w`, err)}
+		}
+	}
+	if v, ok := vmuo.mutation.FileSize(); ok {
+		if err := voicemessage.FileSizeValidator(v); err != nil {
+			return &ValidationError{Name: "fileSize", err: fmt.Errorf(`ent: validator failed for field "VoiceMessage.fileSize": %w`, err)}
+		}
+	}
+	if v, ok := vmuo.mutation.Duration(); ok {
+		if err := voicemessage.DurationValidator(v); err != nil {
+			return &ValidationError{Name: "duration", err: fmt.Errorf(`ent: validator failed for field "VoiceMessage.duration": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (vmuo *VoiceMessageUpdateOne) sqlSave(ctx context.Context) (_node *VoiceMessage, err error) {
+	if err := vmuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(voicemessage.Table, voicemessage.Columns, sqlgraph.NewFieldSpec(voicemessage.FieldID, field.TypeInt))
+	id, ok := vmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "VoiceMessage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := vmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, voicemessage.FieldID)
+		for _, f := range fields {
+			if !voicemessage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != voicemessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := vmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := vmuo.mutation.MsgId(); ok {
+		_spec.SetField(voicemessage.FieldMsgId, field.TypeString, value)
+	}
+	if value, ok := vmuo.mutation.StorageKey(); ok {
+		_spec.SetField(voicemessage.FieldStorageKey, field.TypeString, value)
+	}
+	if value, ok := vmuo.mutation.MimeType(); ok {
+		_spec.SetField(voicemessage.FieldMimeType, field.TypeString, value)
+	}
+	if value, ok := vmuo.mutation.FileSize(); ok {
+		_spec.SetField(voicemessage.FieldFileSize, field.TypeInt64, value)
+	}
+	if value, ok := vmuo.mutation.AddedFileSize(); ok {
+		_spec.AddField(voicemessage.FieldFileSize, field.TypeInt64, value)
+	}
+	if value, ok := vmuo.mutation.Duration(); ok {
+		_spec.SetField(voicemessage.FieldDuration, field.TypeInt, value)
+	}
+	if value, ok := vmuo.mutation.AddedDuration(); ok {
+		_spec.AddField(voicemessage.FieldDuration, field.TypeInt, value)
+	}
+	if value, ok := vmuo.mutation.Waveform(); ok {
+		_spec.SetField(voicemessage.FieldWaveform, field.TypeJSON, value)
+	}
+	if value, ok := vmuo.mutation.AppendedWaveform(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, voicemessage.FieldWaveform, value)
+		})
+	}
+	_node = &VoiceMessage{config: vmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, vmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{voicemessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	vmuo.mutation.done = true
+	return _node, nil
+}
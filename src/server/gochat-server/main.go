@@ -1,12 +1,15 @@
 package main
 
 import (
+	"encoding/json"
+	"fmt"
 	"gochat_server/configs"
 	"gochat_server/routers"
 	"gochat_server/services"
 	"gochat_server/utils"
 	wsmanager "gochat_server/ws_manager"
 	"log"
+	"os"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -18,6 +21,11 @@ func main() {
 	// 这里使用默认配置（输出到标准输出）
 	utils.Info("GoChat Server starting...")
 
+	// 设置生成消息ID使用的节点ID
+	if err := utils.SetMessageIdNode(configs.Cfg.Server.NodeId); err != nil {
+		utils.Fatal("Invalid server node id: %v", err)
+	}
+
 	// 使用 Ent 自动迁移同步数据库结构
 	utils.Info("Running Ent schema migration...")
 	if err := services.RunEntMigrations(); err != nil {
@@ -27,6 +35,9 @@ func main() {
 		utils.Info("Ent schema migration completed successfully")
 	}
 
+	// 执行尚未完成的数据迁移，已完成的迁移记录在数据库中，不会在每次启动时重复扫描
+	services.RunDataMigrations()
+
 	// 初始化 MinIO
 	err := services.InitMinIO()
 	if err != nil {
@@ -50,12 +61,46 @@ func main() {
 		}
 	}
 
+	// 初始化全文搜索
+	if err := services.InitSearch(); err != nil {
+		utils.Warn("Search initialization failed: %v", err)
+		utils.Warn("聊天记录搜索功能将不可用")
+	} else {
+		utils.Info("Search initialized successfully")
+	}
+
+	// 命令行导入数据: gochat-server import <文件>，导入完成后输出报告并退出
+	if len(os.Args) > 1 && os.Args[1] == "import" {
+		os.Exit(runImport(os.Args[2:]))
+	}
+
 	// 启动性能监控（每5分钟记录一次）
 	services.StartPerformanceMonitoring(5 * time.Minute)
 
 	// 设置通知发送器，避免循环依赖
 	services.SetNotificationSender(wsmanager.GetWSManager())
 
+	// 启动消息发件箱分发器，将已保存的消息推送给在线用户
+	services.StartOutboxDispatcher()
+
+	// 启动群消息已读人数推送任务
+	services.StartReadReceiptPublisher()
+
+	// 启动阅后即焚消息清理任务
+	services.StartMessageReaper()
+
+	// 启动定时消息调度器
+	services.StartMessageScheduler()
+
+	// 启动消息保留期限清理任务
+	services.StartRetentionScheduler()
+
+	// 启动聊天记录导出任务
+	services.StartExportWorker()
+
+	// 启动过期好友请求清理任务
+	services.StartFriendRequestCleaner()
+
 	// 确保程序退出时关闭缓存连接
 	defer func() {
 		if err := services.CloseCache(); err != nil {
@@ -84,3 +129,22 @@ func main() {
 		utils.Fatal("Failed to start server: %v", err)
 	}
 }
+
+// runImport 执行命令行导入，返回进程退出码
+func runImport(args []string) int {
+	if len(args) != 1 {
+		fmt.Fprintln(os.Stderr, "usage: gochat-server import <file>")
+		return 2
+	}
+
+	report, err := services.ImportDataFromFile(args[0])
+	if report != nil {
+		output, _ := json.MarshalIndent(report, "", "  ")
+		fmt.Println(string(output))
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "导入失败:", err)
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,41 @@
+package middlewares
+
+import (
+	"log"
+	"net/http"
+
+	"gochat_server/configs"
+	"gochat_server/dto"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AdminMiddleware 管理员权限中间件，需要在 AuthMiddleware 之后使用
+// 管理员通过配置文件中的 Admin.UserIds 指定
+func AdminMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, ok := GetUserID(c)
+		if !ok {
+			c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+				Code:    401,
+				Message: "未授权",
+			})
+			c.Abort()
+			return
+		}
+
+		for _, adminId := range configs.Cfg.Admin.UserIds {
+			if adminId == userID {
+				c.Next()
+				return
+			}
+		}
+
+		log.Printf("AdminMiddleware: user %d is not an admin", userID)
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{
+			Code:    403,
+			Message: "需要管理员权限",
+		})
+		c.Abort()
+	}
+}
@@ -1,7 +1,10 @@
 package msgrecvhandler
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
+	"gochat_server/dto"
 	msgsendhandler "gochat_server/msg_send_handler"
 	"gochat_server/services"
 	"log"
@@ -87,6 +90,23 @@ func handleChatMessage(userId string, wsMsg WSMessage) error {
 		groupId = &gid
 	}
 
+	// 解析@提及
+	var mentions []dto.MessageMention
+	if rawMentions, ok := wsMsg.Data["mentions"]; ok {
+		// 格式错误时拒绝整条消息，与 HTTP 发送接口的参数校验一致
+		data, err := json.Marshal(rawMentions)
+		if err == nil {
+			err = json.Unmarshal(data, &mentions)
+		}
+		if err != nil {
+			log.Printf("Invalid mentions in message: %v", err)
+			return fmt.Errorf("参数错误: @提及格式无效: %v", err)
+		}
+	}
+
+	// 被回复的消息ID（可选）
+	replyToMsgId, _ := wsMsg.Data["replyToMsgId"].(string)
+
 	// 转换userId为int
 	fromUserId, err := strconv.Atoi(userId)
 	if err != nil {
@@ -95,21 +115,16 @@ func handleChatMessage(userId string, wsMsg WSMessage) error {
 	}
 
 	// 保存消息到数据库
-	msgId, err := services.SendMessage(fromUserId, toUserId, msgType, content, groupId)
+	msgId, err := services.SendMessage(context.Background(), fromUserId, toUserId, msgType, content, groupId, mentions, replyToMsgId)
 	if err != nil {
 		log.Printf("Error saving message: %v", err)
 		// 发送错误响应给发送者（实际发送在 ws_manager 中处理）
 		return err
 	}
 
+	// 消息由发件箱分发器推送给接收者
 	log.Printf("Message saved with ID: %s", msgId)
 
-	// 分发消息给接收者
-	err = msgsendhandler.DispatchMessage(msgId, toUserId, groupId)
-	if err != nil {
-		log.Printf("Error dispatching message: %v", err)
-	}
-
 	// 发送确认消息给发送者（实际发送在 ws_manager 中处理）
 	log.Printf("Message %s acknowledged", msgId)
 
@@ -219,6 +234,11 @@ func notifyMessageStatus(msgId string, userId int, status string) error {
 		return err
 	}
 
+	// 群消息的已读人数由已读回执任务合并后推送
+	if messageDetail.IsGroup {
+		return nil
+	}
+
 	// 通过msg_send_handler发送状态更新给发送者
 	err = msgsendhandler.SendMessageStatusUpdate(msgId, messageDetail.FromUserId, status, userId)
 	if err != nil {
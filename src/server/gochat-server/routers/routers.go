@@ -42,13 +42,24 @@ func RegisterRoutes(r *gin.Engine) {
 			friends.POST("/request", controllers.SendFriendRequest)
 			friends.POST("/accept", controllers.AcceptFriendRequest)
 			friends.POST("/reject", controllers.RejectFriendRequest)
+			friends.POST("/withdraw", controllers.WithdrawFriendRequest)
 			friends.GET("/requests", controllers.GetFriendRequests)
 			friends.GET("/requests/sent", controllers.GetSentFriendRequests)
+			friends.GET("/suggestions", controllers.GetFriendSuggestions)
 			friends.DELETE("/:friendId", controllers.DeleteFriend)
 			friends.PUT("/:friendId/remark", controllers.UpdateFriendRemark)
 			friends.GET("/:friendId", controllers.GetFriendWithRemark)
 		}
 
+		// 黑名单相关路由（需要认证）
+		blocks := api.Group("/blocks")
+		blocks.Use(middlewares.AuthMiddleware())
+		{
+			blocks.GET("", controllers.GetBlockedUsers)
+			blocks.POST("", controllers.BlockUser)
+			blocks.DELETE("/:userId", controllers.UnblockUser)
+		}
+
 		// 消息相关路由（需要认证）
 		messages := api.Group("/messages")
 		messages.Use(middlewares.AuthMiddleware())
@@ -61,9 +72,27 @@ func RegisterRoutes(r *gin.Engine) {
 			messages.POST("/delivered", controllers.MarkMessageDelivered)
 			messages.POST("/read", controllers.MarkMessageRead)
 			messages.POST("/recall", controllers.RecallMessage)
+			messages.POST("/delete", controllers.DeleteMessages)
+			messages.POST("/clear", controllers.ClearChatHistory)
+			messages.POST("/conversations/delete", controllers.DeleteConversation)
+			messages.GET("/timer", controllers.GetMessageTimer)
+			messages.PUT("/timer", controllers.SetMessageTimer)
+			messages.POST("/scheduled", controllers.CreateScheduledMessage)
+			messages.GET("/scheduled", controllers.GetScheduledMessages)
+			messages.PUT("/scheduled/:id", controllers.UpdateScheduledMessage)
+			messages.DELETE("/scheduled/:id", controllers.CancelScheduledMessage)
+			messages.POST("/export", controllers.CreateExportJob)
+			messages.GET("/export", controllers.GetExportJobs)
+			messages.GET("/export/:id", controllers.GetExportJob)
 			messages.GET("/status", controllers.GetMessageStatus)
+			messages.GET("/receipts", controllers.GetMessageReceipts)
 			messages.GET("/unread", controllers.GetUnreadMessageCount)
 			messages.POST("/readall", controllers.MarkAllMessagesAsRead)
+			messages.POST("/reactions", controllers.AddMessageReaction)
+			messages.DELETE("/reactions", controllers.RemoveMessageReaction)
+			messages.GET("/mentions", controllers.GetMentionMessages)
+			messages.POST("/forward", controllers.ForwardMessages)
+			messages.GET("/search", controllers.SearchMessages)
 		}
 
 		// 群组相关路由（需要认证）
@@ -76,6 +105,8 @@ func RegisterRoutes(r *gin.Engine) {
 			groups.POST("/:groupId/members", controllers.AddGroupMembers)
 			groups.DELETE("/:groupId/members/:userId", controllers.RemoveGroupMember)
 			groups.GET("/:groupId/members", controllers.GetGroupMembers)
+			groups.PUT("/:groupId/read-receipts", controllers.SetGroupReadReceipts)
+			groups.PUT("/:groupId/retention", controllers.SetGroupRetention)
 		}
 
 		// 性能监控相关路由（需要认证）
@@ -86,6 +117,17 @@ func RegisterRoutes(r *gin.Engine) {
 			performance.GET("/optimization", controllers.GetDatabaseOptimizationSuggestions)
 			performance.POST("/cache/warmup", controllers.WarmupCache)
 			performance.GET("/cache/stats", controllers.GetCacheStats)
+			performance.GET("/retention", controllers.GetRetentionStatus)
+			performance.GET("/outbox", controllers.GetOutboxStats)
+		}
+
+		// 管理相关路由（需要认证和管理员权限）
+		admin := api.Group("/admin")
+		admin.Use(middlewares.AuthMiddleware(), middlewares.AdminMiddleware())
+		{
+			admin.POST("/import", controllers.ImportData)
+			admin.POST("/retention/run", controllers.RunRetentionJob)
+			admin.POST("/outbox/retry", controllers.RetryDeadOutboxEntries)
 		}
 
 		// 免打扰相关路由（需要认证）
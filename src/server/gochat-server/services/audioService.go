@@ -0,0 +1,334 @@
+package services
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+)
+
+// 语音波形参数
+const (
+	WaveformSamples  = 64  // 波形采样点数
+	WaveformMaxValue = 255 // 波形采样值上限
+)
+
+// opus 在 Ogg 中的时间戳固定以 48kHz 计
+const opusGranuleRate = 48000
+
+// AudioInfo 音频解析结果
+type AudioInfo struct {
+	Duration int   // 时长（毫秒）
+	Waveform []int // 降采样后的振幅波形，共 WaveformSamples 个点，取值 0~WaveformMaxValue
+}
+
+// AnalyzeAudio 解析音频容器，计算时长和波形
+// 支持 WAV（PCM/IEEE float）和 Ogg/Opus
+func AnalyzeAudio(r io.Reader, ext string) (*AudioInfo, error) {
+	switch ext {
+	case ".wav":
+		return analyzeWAV(r)
+	case ".ogg", ".opus":
+		return analyzeOggOpus(r)
+	default:
+		return nil, errors.New("不支持的音频格式")
+	}
+}
+
+// wavFormat WAV 文件 fmt 块中的格式信息
+type wavFormat struct {
+	AudioFormat   uint16
+	Channels      uint16
+	SampleRate    uint32
+	ByteRate      uint32
+	BlockAlign    uint16
+	BitsPerSample uint16
+}
+
+// WAV 编码格式
+const (
+	wavFormatPCM        = 1
+	wavFormatFloat      = 3
+	wavFormatExtensible = 0xFFFE
+)
+
+// analyzeWAV 解析 RIFF/WAVE 文件，按块读取 fmt 和 data
+func analyzeWAV(r io.Reader) (*AudioInfo, error) {
+	br := bufio.NewReader(r)
+
+	var header [12]byte
+	if _, err := io.ReadFull(br, header[:]); err != nil {
+		return nil, errors.New("无效的WAV文件")
+	}
+	if string(header[0:4]) != "RIFF" || string(header[8:12]) != "WAVE" {
+		return nil, errors.New("无效的WAV文件")
+	}
+
+	var format *wavFormat
+	for {
+		var chunk [8]byte
+		if _, err := io.ReadFull(br, chunk[:]); err != nil {
+			return nil, errors.New("WAV文件缺少音频数据")
+		}
+		id := string(chunk[0:4])
+		size := int64(binary.LittleEndian.Uint32(chunk[4:8]))
+
+		switch id {
+		case "fmt ":
+			if size < 16 {
+				return nil, errors.New("无效的WAV格式块")
+			}
+			data := make([]byte, size)
+			if _, err := io.ReadFull(br, data); err != nil {
+				return nil, errors.New("无效的WAV格式块")
+			}
+			format = &wavFormat{
+				AudioFormat:   binary.LittleEndian.Uint16(data[0:2]),
+				Channels:      binary.LittleEndian.Uint16(data[2:4]),
+				SampleRate:    binary.LittleEndian.Uint32(data[4:8]),
+				ByteRate:      binary.LittleEndian.Uint32(data[8:12]),
+				BlockAlign:    binary.LittleEndian.Uint16(data[12:14]),
+				BitsPerSample: binary.LittleEndian.Uint16(data[14:16]),
+			}
+			// WAVE_FORMAT_EXTENSIBLE 的实际编码在子格式 GUID 的前两个字节
+			if format.AudioFormat == wavFormatExtensible && size >= 26 {
+				format.AudioFormat = binary.LittleEndian.Uint16(data[24:26])
+			}
+
+		case "data":
+			if format == nil {
+				return nil, errors.New("WAV文件缺少格式块")
+			}
+			return readWAVData(br, format, size)
+
+		default:
+			if _, err := io.CopyN(io.Discard, br, size); err != nil {
+				return nil, errors.New("WAV文件缺少音频数据")
+			}
+		}
+
+		// RIFF 块按偶数字节对齐
+		if size%2 == 1 {
+			if _, err := br.Discard(1); err != nil {
+				return nil, errors.New("WAV文件缺少音频数据")
+			}
+		}
+	}
+}
+
+// readWAVData 读取 data 块，计算时长和各区间的峰值振幅
+func readWAVData(r io.Reader, format *wavFormat, size int64) (*AudioInfo, error) {
+	if format.AudioFormat != wavFormatPCM && format.AudioFormat != wavFormatFloat {
+		return nil, errors.New("只支持PCM编码的WAV文件")
+	}
+	if format.Channels == 0 || format.SampleRate == 0 {
+		return nil, errors.New("无效的WAV格式块")
+	}
+
+	bytesPerSample := int(format.BitsPerSample) / 8
+	switch {
+	case format.AudioFormat == wavFormatPCM && bytesPerSample >= 1 && bytesPerSample <= 4:
+	case format.AudioFormat == wavFormatFloat && bytesPerSample == 4:
+	default:
+		return nil, errors.New("不支持的WAV采样位数")
+	}
+
+	blockAlign := int(format.BlockAlign)
+	if blockAlign < bytesPerSample*int(format.Channels) {
+		blockAlign = bytesPerSample * int(format.Channels)
+	}
+
+	// 录音中断时 data 块的长度可能是 0 或 0xFFFFFFFF，此时以实际读到的数据为准
+	data, err := io.ReadAll(io.LimitReader(r, size))
+	if err != nil {
+		return nil, errors.New("读取WAV音频数据失败")
+	}
+	frames := len(data) / blockAlign
+	if frames == 0 {
+		return nil, errors.New("WAV文件没有音频数据")
+	}
+
+	peaks := make([]float64, WaveformSamples)
+	for frame := 0; frame < frames; frame++ {
+		bucket := frame * WaveformSamples / frames
+		offset := frame * blockAlign
+		for ch := 0; ch < int(format.Channels); ch++ {
+			sample := data[offset+ch*bytesPerSample : offset+(ch+1)*bytesPerSample]
+			amplitude := wavSampleAmplitude(sample, format.AudioFormat)
+			if amplitude > peaks[bucket] {
+				peaks[bucket] = amplitude
+			}
+		}
+	}
+
+	return &AudioInfo{
+		Duration: int(int64(frames) * 1000 / int64(format.SampleRate)),
+		Waveform: normalizeWaveform(peaks),
+	}, nil
+}
+
+// wavSampleAmplitude 将单个采样转换为 0~1 的振幅
+func wavSampleAmplitude(sample []byte, audioFormat uint16) float64 {
+	if audioFormat == wavFormatFloat {
+		return math.Min(math.Abs(float64(math.Float32frombits(binary.LittleEndian.Uint32(sample)))), 1)
+	}
+
+	switch len(sample) {
+	case 1:
+		// 8 位 PCM 为无符号数，128 为静音
+		return math.Abs(float64(int(sample[0])-128)) / 128
+	case 2:
+		return math.Abs(float64(int16(binary.LittleEndian.Uint16(sample)))) / 32768
+	case 3:
+		value := int32(uint32(sample[0])<<8|uint32(sample[1])<<16|uint32(sample[2])<<24) >> 8
+		return math.Abs(float64(value)) / 8388608
+	default:
+		return math.Abs(float64(int32(binary.LittleEndian.Uint32(sample)))) / 2147483648
+	}
+}
+
+// oggPage Ogg 页中用到的字段
+type oggPage struct {
+	HeaderType      byte
+	GranulePosition int64
+	Serial          uint32
+	Segments        []byte
+}
+
+// readOggPage 读取一个 Ogg 页，返回页头和页内数据
+func readOggPage(r io.Reader) (*oggPage, []byte, error) {
+	var header [27]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return nil, nil, err
+	}
+	if string(header[0:4]) != "OggS" || header[4] != 0 {
+		return nil, nil, errors.New("无效的Ogg页")
+	}
+
+	page := &oggPage{
+		HeaderType:      header[5],
+		GranulePosition: int64(binary.LittleEndian.Uint64(header[6:14])),
+		Serial:          binary.LittleEndian.Uint32(header[14:18]),
+		Segments:        make([]byte, header[26]),
+	}
+	if _, err := io.ReadFull(r, page.Segments); err != nil {
+		return nil, nil, err
+	}
+
+	size := 0
+	for _, s := range page.Segments {
+		size += int(s)
+	}
+	body := make([]byte, size)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return nil, nil, err
+	}
+	return page, body, nil
+}
+
+// analyzeOggOpus 解析 Ogg/Opus 文件
+// 时长由最后一页的 granule position 减去 pre-skip 得到
+// 服务端不解码 Opus，波形以各区间的数据包大小近似（Opus 为可变码率，响度越高包越大）
+func analyzeOggOpus(r io.Reader) (*AudioInfo, error) {
+	br := bufio.NewReader(r)
+
+	var serial uint32
+	var preSkip int64
+	headersFound := false
+	lastGranule := int64(-1)
+	packets := make([]int, 0) // 音频数据包大小
+	pending := 0              // 跨页数据包已读取的长度
+	packetIndex := 0
+
+	for {
+		page, body, err := readOggPage(br)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			if headersFound && lastGranule >= 0 {
+				break // 文件末尾被截断时使用已读取的部分
+			}
+			return nil, errors.New("无效的Ogg文件")
+		}
+
+		if !headersFound {
+			if !bytes.HasPrefix(body, []byte("OpusHead")) || len(body) < 19 {
+				return nil, errors.New("只支持Opus编码的Ogg文件")
+			}
+			serial = page.Serial
+			preSkip = int64(binary.LittleEndian.Uint16(body[10:12]))
+			headersFound = true
+			continue
+		}
+		if page.Serial != serial {
+			continue
+		}
+
+		// 按段表拆分数据包，长度为 255 的段表示数据包在下一段继续
+		for _, segment := range page.Segments {
+			pending += int(segment)
+			if segment < 255 {
+				// 前两个数据包是 OpusHead 和 OpusTags，不计入波形
+				if packetIndex >= 1 {
+					packets = append(packets, pending)
+				}
+				packetIndex++
+				pending = 0
+			}
+		}
+		if page.GranulePosition >= 0 {
+			lastGranule = page.GranulePosition
+		}
+	}
+
+	if !headersFound || lastGranule < 0 {
+		return nil, errors.New("无效的Ogg文件")
+	}
+
+	samples := lastGranule - preSkip
+	if samples < 0 {
+		samples = 0
+	}
+
+	// 每个区间取其覆盖的数据包中最大的一个，数据包少于采样点时相邻区间共用同一个数据包
+	peaks := make([]float64, WaveformSamples)
+	if len(packets) > 0 {
+		for bucket := range peaks {
+			start := bucket * len(packets) / WaveformSamples
+			end := (bucket + 1) * len(packets) / WaveformSamples
+			if end <= start {
+				end = start + 1
+			}
+			for _, size := range packets[start:end] {
+				peaks[bucket] = math.Max(peaks[bucket], float64(size))
+			}
+		}
+	}
+
+	return &AudioInfo{
+		Duration: int(samples * 1000 / opusGranuleRate),
+		Waveform: normalizeWaveform(peaks),
+	}, nil
+}
+
+// normalizeWaveform 以最大峰值为基准将波形缩放到 0~WaveformMaxValue
+func normalizeWaveform(peaks []float64) []int {
+	maxPeak := 0.0
+	for _, p := range peaks {
+		if p > maxPeak {
+			maxPeak = p
+		}
+	}
+
+	waveform := make([]int, len(peaks))
+	if maxPeak == 0 {
+		return waveform
+	}
+	for i, p := range peaks {
+		waveform[i] = int(math.Round(p / maxPeak * WaveformMaxValue))
+	}
+	return waveform
+}
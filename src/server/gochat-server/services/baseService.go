@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"gochat_server/configs"
 	"gochat_server/ent"
 	"gochat_server/utils"
@@ -17,20 +18,23 @@ import (
 
 var db *ent.Client
 
+// rawDB Ent 客户端底层的数据库连接，用于 Ent 无法表达的原生 SQL（如全文索引）
+var rawDB *sql.DB
+
 func init() {
 	var err error
 
 	// 首先创建标准的 sql.DB 连接以配置连接池
-	sqlDB, err := sql.Open(configs.Cfg.DBType, configs.Cfg.ConnectionString)
+	rawDB, err = sql.Open(configs.Cfg.DBType, configs.Cfg.ConnectionString)
 	if err != nil {
 		log.Fatalf("failed opening connection to database: %v", err)
 	}
 
 	// 配置数据库连接池
-	configureDBPool(sqlDB)
+	configureDBPool(rawDB)
 
 	// 使用配置好的 sql.DB 创建 Ent 客户端
-	drv := ent.Driver(entsql.OpenDB(configs.Cfg.DBType, sqlDB))
+	drv := ent.Driver(entsql.OpenDB(configs.Cfg.DBType, rawDB))
 	db = ent.NewClient(drv)
 
 	utils.Info("Database connection pool configured: MaxOpen=%d, MaxIdle=%d",
@@ -69,3 +73,30 @@ func configureDBPool(sqlDB *sql.DB) {
 	// 设置连接最大空闲时间
 	sqlDB.SetConnMaxIdleTime(time.Duration(configs.Cfg.DBPool.ConnMaxIdleTime) * time.Second)
 }
+
+// withTx 在事务中执行 fn，fn 返回错误或发生 panic 时回滚
+func withTx(ctx context.Context, fn func(tx *ent.Tx) error) error {
+	tx, err := db.Tx(ctx)
+	if err != nil {
+		utils.Error("开启事务失败: %v", err)
+		return errors.New("开启事务失败")
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			utils.Error("事务回滚失败: %v", rerr)
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		utils.Error("提交事务失败: %v", err)
+		return errors.New("提交事务失败")
+	}
+	return nil
+}
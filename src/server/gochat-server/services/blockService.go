@@ -0,0 +1,207 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"gochat_server/ent"
+	"gochat_server/ent/block"
+	"gochat_server/ent/friendrequest"
+	"gochat_server/ent/user"
+	"time"
+)
+
+// errMessageRejected 被拉黑时发送消息的错误，不透露被拉黑
+var errMessageRejected = rejectMessage(errors.New("消息发送失败"))
+
+// BlockedUser 黑名单中的用户
+type BlockedUser struct {
+	UserId     int       `json:"userId"`
+	Username   string    `json:"username"`
+	Nickname   string    `json:"nickname"`
+	Avatar     string    `json:"avatar"`
+	CreateTime time.Time `json:"createTime"`
+}
+
+// BlockUser 拉黑用户，对方发来的待处理好友请求同时被拒绝（不通知对方）
+func BlockUser(userId, targetId int) error {
+	if userId == targetId {
+		return errors.New("不能拉黑自己")
+	}
+
+	ctx := context.TODO()
+	if _, err := db.User.Get(ctx, targetId); err != nil {
+		return errors.New("用户不存在")
+	}
+
+	blocked, err := IsBlocked(userId, targetId)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return errors.New("已经拉黑该用户")
+	}
+
+	return withTx(ctx, func(tx *ent.Tx) error {
+		if err := tx.Block.Create().
+			SetUserId(userId).
+			SetBlockedUserId(targetId).
+			Exec(ctx); err != nil {
+			return errors.New("拉黑用户失败")
+		}
+		if _, err := tx.FriendRequest.Update().
+			Where(
+				friendrequest.FromUserId(targetId),
+				friendrequest.ToUserId(userId),
+				friendrequest.Status(FriendRequestPending),
+			).
+			SetStatus(FriendRequestRejected).
+			SetHandleTime(time.Now()).
+			Save(ctx); err != nil {
+			return errors.New("更新好友请求失败")
+		}
+		return nil
+	})
+}
+
+// UnblockUser 将用户移出黑名单
+func UnblockUser(userId, targetId int) error {
+	deleted, err := db.Block.Delete().
+		Where(
+			block.UserId(userId),
+			block.BlockedUserId(targetId),
+		).
+		Exec(context.TODO())
+	if err != nil {
+		return errors.New("取消拉黑失败")
+	}
+	if deleted == 0 {
+		return errors.New("未拉黑该用户")
+	}
+	return nil
+}
+
+// GetBlockedUsers 获取黑名单，按拉黑时间从新到旧排序
+func GetBlockedUsers(userId int) ([]*BlockedUser, error) {
+	ctx := context.TODO()
+	blocks, err := db.Block.Query().
+		Where(block.UserId(userId)).
+		Order(ent.Desc(block.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.New("查询黑名单失败")
+	}
+
+	userIds := make([]int, 0, len(blocks))
+	for _, b := range blocks {
+		userIds = append(userIds, b.BlockedUserId)
+	}
+	users, err := db.User.Query().
+		Where(user.IDIn(userIds...)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.New("查询用户信息失败")
+	}
+	userMap := make(map[int]*ent.User, len(users))
+	for _, u := range users {
+		userMap[u.ID] = u
+	}
+
+	result := make([]*BlockedUser, 0, len(blocks))
+	for _, b := range blocks {
+		info := &BlockedUser{UserId: b.BlockedUserId, CreateTime: b.CreateTime}
+		if u, ok := userMap[b.BlockedUserId]; ok {
+			info.Username = u.Username
+			info.Nickname = u.Nickname
+			info.Avatar = u.Avatar
+		}
+		result = append(result, info)
+	}
+	return result, nil
+}
+
+// IsBlocked 检查 userId 是否拉黑了 targetId
+func IsBlocked(userId, targetId int) (bool, error) {
+	blocked, err := db.Block.Query().
+		Where(
+			block.UserId(userId),
+			block.BlockedUserId(targetId),
+		).
+		Exist(context.TODO())
+	if err != nil {
+		return false, errors.New("查询黑名单失败")
+	}
+	return blocked, nil
+}
+
+// blockedUserIds 用户拉黑的所有用户
+func blockedUserIds(userId int) map[int]bool {
+	ids, err := db.Block.Query().
+		Where(block.UserId(userId)).
+		Select(block.FieldBlockedUserId).
+		Ints(context.TODO())
+	result := make(map[int]bool, len(ids))
+	if err != nil {
+		return result
+	}
+	for _, id := range ids {
+		result[id] = true
+	}
+	return result
+}
+
+// blockerIds 拉黑了该用户的所有用户
+func blockerIds(userId int) map[int]bool {
+	ids, err := db.Block.Query().
+		Where(block.BlockedUserId(userId)).
+		Select(block.FieldUserId).
+		Ints(context.TODO())
+	result := make(map[int]bool, len(ids))
+	if err != nil {
+		return result
+	}
+	for _, id := range ids {
+		result[id] = true
+	}
+	return result
+}
+
+// HideBlockedPresence 隐藏拉黑了查看者的用户的在线状态和最后在线时间，返回新的列表，不修改缓存中的用户
+func HideBlockedPresence(users []*ent.User, viewerId int) []*ent.User {
+	blockers := blockerIds(viewerId)
+	if len(blockers) == 0 {
+		return users
+	}
+
+	result := make([]*ent.User, 0, len(users))
+	for _, u := range users {
+		if blockers[u.ID] {
+			u = hidePresence(u)
+		}
+		result = append(result, u)
+	}
+	return result
+}
+
+// hidePresence 返回隐藏了在线状态的用户副本
+func hidePresence(u *ent.User) *ent.User {
+	hidden := *u
+	hidden.Status = "offline"
+	hidden.LastSeen = nil
+	return &hidden
+}
+
+// attachBlockedFlags 标记查看者拉黑的用户发送的群消息，客户端可以折叠显示
+func attachBlockedFlags(messages []map[string]interface{}, viewerId int) {
+	blocked := blockedUserIds(viewerId)
+	if len(blocked) == 0 {
+		return
+	}
+	for _, m := range messages {
+		if _, isGroup := m["groupId"]; !isGroup {
+			continue
+		}
+		if fromUserId, ok := m["fromUserId"].(int); ok && blocked[fromUserId] {
+			m["senderBlocked"] = true
+		}
+	}
+}
@@ -53,6 +53,10 @@ func groupMembersCacheKey(groupId int) string {
 	return fmt.Sprintf("group_members:%d", groupId)
 }
 
+func friendSuggestionsCacheKey(userId int, sameRegion bool) string {
+	return fmt.Sprintf("friend_suggestions:%d:%t", userId, sameRegion)
+}
+
 func setWithTTL(key, value string, ttl time.Duration) error {
 	if cache == nil {
 		return nil
@@ -267,6 +271,45 @@ func InvalidateGroupMembersCache(groupId int) error {
 	return err
 }
 
+func GetCachedFriendSuggestions(userId int, sameRegion bool) ([]*FriendSuggestion, bool) {
+	if cache == nil {
+		return nil, false
+	}
+
+	key := friendSuggestionsCacheKey(userId, sameRegion)
+	data, err := cache.Str().Get(key)
+	if err != nil {
+		return nil, false
+	}
+
+	dataStr := data.String()
+	if dataStr == "" {
+		return nil, false
+	}
+
+	var suggestions []*FriendSuggestion
+	if err := json.Unmarshal([]byte(dataStr), &suggestions); err != nil {
+		return nil, false
+	}
+
+	return suggestions, true
+}
+
+// CacheFriendSuggestions 缓存推荐列表，好友关系变化由读取时的排除处理，缓存只按过期时间刷新
+func CacheFriendSuggestions(userId int, sameRegion bool, suggestions []*FriendSuggestion) error {
+	if cache == nil {
+		return nil
+	}
+
+	key := friendSuggestionsCacheKey(userId, sameRegion)
+	data, err := json.Marshal(suggestions)
+	if err != nil {
+		return err
+	}
+
+	return setWithTTL(key, string(data), friendSuggestionCacheTTL)
+}
+
 func CacheOnlineUser(userId int) error {
 	if cache == nil {
 		return nil
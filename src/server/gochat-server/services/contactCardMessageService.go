@@ -0,0 +1,50 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"gochat_server/dto"
+	"gochat_server/ent/user"
+)
+
+// parseContactCardContent 解析并校验名片消息内容
+// 只能分享自己或好友的名片
+func parseContactCardContent(fromUserId int, content string) (*dto.ContactCardContent, error) {
+	var card dto.ContactCardContent
+	if err := json.Unmarshal([]byte(content), &card); err != nil || card.UserId <= 0 {
+		return nil, rejectMessage(errors.New("无效的名片消息内容"))
+	}
+
+	exists, err := db.User.Query().Where(user.ID(card.UserId)).Exist(context.TODO())
+	if err != nil {
+		return nil, errors.New("查询名片用户失败")
+	}
+	if !exists {
+		return nil, rejectMessage(errors.New("名片用户不存在"))
+	}
+
+	if card.UserId != fromUserId {
+		isFriend, err := IsFriend(fromUserId, card.UserId)
+		if err != nil {
+			return nil, err
+		}
+		if !isFriend {
+			return nil, rejectMessage(errors.New("只能分享好友的名片"))
+		}
+	}
+
+	return &dto.ContactCardContent{UserId: card.UserId}, nil
+}
+
+// resolveContactCard 读取名片消息时实时查询名片用户的资料
+func resolveContactCard(body interface{}) {
+	card := body.(*dto.ContactCardContent)
+	if u, err := GetUserByID(card.UserId); err == nil {
+		card.Username = u.Username
+		card.Nickname = u.Nickname
+		card.Avatar = u.Avatar
+		card.Signature = u.Signature
+		card.Region = u.Region
+	}
+}
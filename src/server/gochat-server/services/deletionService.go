@@ -0,0 +1,272 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"gochat_server/ent"
+	"gochat_server/ent/chatrecord"
+	"gochat_server/ent/conversationclear"
+	"gochat_server/ent/groupchatrecord"
+	"gochat_server/ent/messagedeletion"
+	"gochat_server/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// MaxDeleteMessages 一次最多删除的消息数
+const MaxDeleteMessages = 100
+
+// conversationKey 用户视角下的会话：私聊为对方用户ID，群聊为群组ID
+type conversationKey struct {
+	isGroup  bool
+	targetId int
+}
+
+// messageConversation 消息在指定用户视角下所在的会话
+func messageConversation(detail *MessageDetail, userId int) conversationKey {
+	if detail.IsGroup && detail.GroupId != nil {
+		return conversationKey{isGroup: true, targetId: *detail.GroupId}
+	}
+	if detail.FromUserId == userId {
+		return conversationKey{targetId: detail.ToUserId}
+	}
+	return conversationKey{targetId: detail.FromUserId}
+}
+
+// DeleteMessagesForUser 删除消息，只对当前用户隐藏，其他人仍可见
+// 删除的未读消息同时标记为已读
+func DeleteMessagesForUser(userId int, msgIds []string) error {
+	msgIds = uniqueStrings(msgIds)
+	if len(msgIds) == 0 {
+		return errors.New("请选择要删除的消息")
+	}
+	if len(msgIds) > MaxDeleteMessages {
+		return errors.New("一次最多删除100条消息")
+	}
+
+	ctx := context.TODO()
+	existing, err := db.MessageDeletion.Query().
+		Where(
+			messagedeletion.UserId(userId),
+			messagedeletion.MsgIdIn(msgIds...),
+		).
+		Select(messagedeletion.FieldMsgId).
+		Strings(ctx)
+	if err != nil {
+		return errors.New("查询已删除消息失败")
+	}
+	deleted := make(map[string]bool, len(existing))
+	for _, msgId := range existing {
+		deleted[msgId] = true
+	}
+
+	builders := make([]*ent.MessageDeletionCreate, 0, len(msgIds))
+	privateMsgIds := make([]string, 0, len(msgIds))
+	for _, msgId := range msgIds {
+		if deleted[msgId] {
+			continue
+		}
+		detail, err := CanUserAccessMessage(msgId, userId)
+		if err != nil {
+			return err
+		}
+
+		conversation := messageConversation(detail, userId)
+		builders = append(builders, db.MessageDeletion.Create().
+			SetUserId(userId).
+			SetMsgId(msgId).
+			SetIsGroup(conversation.isGroup).
+			SetTargetId(conversation.targetId))
+		if !conversation.isGroup {
+			privateMsgIds = append(privateMsgIds, msgId)
+		}
+	}
+	if len(builders) == 0 {
+		return nil
+	}
+
+	if _, err := db.MessageDeletion.CreateBulk(builders...).Save(ctx); err != nil {
+		return errors.New("删除消息失败")
+	}
+
+	// 私聊未读数来自消息状态，删除的消息不再计入；群聊未读数计算时排除已删除的消息
+	if len(privateMsgIds) > 0 {
+		markMessageStatusesRead(userId, privateMsgIds)
+	}
+	return nil
+}
+
+// ClearConversation 清空与好友或群的聊天记录，只对当前用户生效
+// hide 为 true 时同时从会话列表中删除该会话，直到收到新消息
+func ClearConversation(userId, friendId, groupId int, hide bool) error {
+	var conversation conversationKey
+	switch {
+	case friendId > 0 && groupId > 0:
+		return errors.New("不能同时指定好友和群组")
+	case friendId > 0:
+		isFriend, err := IsFriend(userId, friendId)
+		if err != nil {
+			return err
+		}
+		if !isFriend {
+			return errors.New("不是好友关系")
+		}
+		conversation = conversationKey{targetId: friendId}
+	case groupId > 0:
+		isMember, err := IsGroupMember(groupId, userId)
+		if err != nil {
+			return err
+		}
+		if !isMember {
+			return errors.New("不是群成员")
+		}
+		conversation = conversationKey{isGroup: true, targetId: groupId}
+	default:
+		return errors.New("请提供friendId或groupId参数")
+	}
+
+	ctx := context.TODO()
+	now := time.Now()
+	err := withTx(ctx, func(tx *ent.Tx) error {
+		updated, err := tx.ConversationClear.Update().
+			Where(
+				conversationclear.UserId(userId),
+				conversationclear.IsGroup(conversation.isGroup),
+				conversationclear.TargetId(conversation.targetId),
+			).
+			SetClearTime(now).
+			SetHidden(hide).
+			Save(ctx)
+		if err != nil {
+			return errors.New("清空聊天记录失败")
+		}
+		if updated == 0 {
+			_, err = tx.ConversationClear.Create().
+				SetUserId(userId).
+				SetIsGroup(conversation.isGroup).
+				SetTargetId(conversation.targetId).
+				SetClearTime(now).
+				SetHidden(hide).
+				Save(ctx)
+			if err != nil {
+				return errors.New("清空聊天记录失败")
+			}
+		}
+
+		// 清空时间之前的消息都已隐藏，单独删除的记录不再需要
+		_, err = tx.MessageDeletion.Delete().
+			Where(
+				messagedeletion.UserId(userId),
+				messagedeletion.IsGroup(conversation.isGroup),
+				messagedeletion.TargetId(conversation.targetId),
+			).
+			Exec(ctx)
+		if err != nil {
+			return errors.New("清空聊天记录失败")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// 清空的消息不再计入未读数
+	if conversation.isGroup {
+		return markGroupAsRead(userId, groupId)
+	}
+	return MarkAllMessagesAsRead(userId, &friendId, nil)
+}
+
+// conversationVisibility 用户在一个会话中隐藏的消息
+type conversationVisibility struct {
+	userId     int
+	clearTime  *time.Time // 清空时间，之前的消息隐藏
+	hidden     bool       // 清空时是否从会话列表中删除
+	hasDeleted bool       // 是否有单独删除的消息，查询时通过子查询排除
+}
+
+// getConversationVisibility 查询用户在会话中隐藏的消息
+func getConversationVisibility(userId int, conversation conversationKey) (*conversationVisibility, error) {
+	ctx := context.TODO()
+	visibility := &conversationVisibility{userId: userId}
+
+	clear, err := db.ConversationClear.Query().
+		Where(
+			conversationclear.UserId(userId),
+			conversationclear.IsGroup(conversation.isGroup),
+			conversationclear.TargetId(conversation.targetId),
+		).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, errors.New("查询会话清空记录失败")
+	}
+	if clear != nil {
+		visibility.clearTime = &clear.ClearTime
+		visibility.hidden = clear.Hidden
+	}
+
+	visibility.hasDeleted, err = db.MessageDeletion.Query().
+		Where(
+			messagedeletion.UserId(userId),
+			messagedeletion.IsGroup(conversation.isGroup),
+			messagedeletion.TargetId(conversation.targetId),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, errors.New("查询已删除消息失败")
+	}
+	return visibility, nil
+}
+
+// hidesMessages 是否有隐藏的消息
+func (v *conversationVisibility) hidesMessages() bool {
+	return v != nil && (v.clearTime != nil || v.hasDeleted)
+}
+
+// notDeleted 排除用户删除的消息，使用 NOT EXISTS 子查询，删除的消息很多时也不会超出数据库的参数个数限制
+func (v *conversationVisibility) notDeleted(s *sql.Selector, msgIdColumn string) {
+	deletions := sql.Table(messagedeletion.Table)
+	s.Where(sql.NotExists(
+		sql.Select(deletions.C(messagedeletion.FieldID)).
+			From(deletions).
+			Where(sql.And(
+				sql.EQ(deletions.C(messagedeletion.FieldUserId), v.userId),
+				sql.ColumnsEQ(deletions.C(messagedeletion.FieldMsgId), s.C(msgIdColumn)),
+			)),
+	))
+}
+
+// chatRecordPredicates 私聊记录的可见性查询条件
+func (v *conversationVisibility) chatRecordPredicates() []predicate.ChatRecord {
+	predicates := make([]predicate.ChatRecord, 0, 2)
+	if v == nil {
+		return predicates
+	}
+	if v.clearTime != nil {
+		predicates = append(predicates, chatrecord.CreateTimeGT(*v.clearTime))
+	}
+	if v.hasDeleted {
+		predicates = append(predicates, predicate.ChatRecord(func(s *sql.Selector) {
+			v.notDeleted(s, chatrecord.FieldMsgId)
+		}))
+	}
+	return predicates
+}
+
+// groupChatRecordPredicates 群聊记录的可见性查询条件
+func (v *conversationVisibility) groupChatRecordPredicates() []predicate.GroupChatRecord {
+	predicates := make([]predicate.GroupChatRecord, 0, 2)
+	if v == nil {
+		return predicates
+	}
+	if v.clearTime != nil {
+		predicates = append(predicates, groupchatrecord.CreateTimeGT(*v.clearTime))
+	}
+	if v.hasDeleted {
+		predicates = append(predicates, predicate.GroupChatRecord(func(s *sql.Selector) {
+			v.notDeleted(s, groupchatrecord.FieldMsgId)
+		}))
+	}
+	return predicates
+}
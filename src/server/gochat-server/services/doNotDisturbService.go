@@ -343,7 +343,7 @@ func IsDoNotDisturbActive(userID int, targetUserID *int, targetGroupID *int) (bo
 				return true, nil
 			}
 		}
-	} else if !ent.IsNotFound(err) {
+	} else if err != nil && !ent.IsNotFound(err) {
 		return false, fmt.Errorf("查询特定免打扰设置失败: %v", err)
 	}
 	
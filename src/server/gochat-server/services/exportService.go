@@ -0,0 +1,868 @@
+package services
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gochat_server/dto"
+	"gochat_server/ent"
+	"gochat_server/ent/exportjob"
+	"html/template"
+	"io"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// 导出任务状态
+const (
+	ExportStatusPending   = "pending"   // 等待导出
+	ExportStatusRunning   = "running"   // 导出中
+	ExportStatusCompleted = "completed" // 已完成，可以下载
+	ExportStatusFailed    = "failed"    // 失败
+	ExportStatusExpired   = "expired"   // 导出文件已过期删除
+)
+
+// 导出格式
+const (
+	ExportFormatJSON = "json" // 单个JSON文件
+	ExportFormatHTML = "html" // 包含 index.html 和媒体文件的ZIP压缩包
+)
+
+const (
+	exportBatchSize     = 200
+	exportPollInterval  = 2 * time.Second
+	exportLease         = 2 * time.Minute
+	exportListLimit     = 20
+	exportDownloadTTL   = 15 * time.Minute // 下载链接有效期
+	exportFileTTL       = 24 * time.Hour   // 导出文件保留时间，过期后删除
+	exportCleanInterval = 10 * time.Minute
+
+	// exportMaxMediaSize 一次HTML导出中打包的媒体文件总大小上限，超出后只保留文字说明
+	exportMaxMediaSize int64 = 1 << 30
+)
+
+var (
+	exportWorkerOnce sync.Once
+	exportWake       = make(chan struct{}, 1)
+)
+
+// ExportJobInfo 导出任务信息
+type ExportJobInfo struct {
+	Id          int        `json:"id"`
+	FriendId    int        `json:"friendId,omitempty"`
+	GroupId     int        `json:"groupId,omitempty"`
+	Format      string     `json:"format"`
+	Status      string     `json:"status"`
+	Total       int        `json:"total"`
+	Processed   int        `json:"processed"`
+	Progress    int        `json:"progress"` // 百分比
+	FileSize    int64      `json:"fileSize,omitempty"`
+	DownloadUrl string     `json:"downloadUrl,omitempty"`
+	ExpireTime  *time.Time `json:"expireTime,omitempty"` // 导出文件的删除时间
+	LastError   string     `json:"lastError,omitempty"`
+	CreateTime  time.Time  `json:"createTime"`
+	FinishTime  *time.Time `json:"finishTime,omitempty"`
+}
+
+// toExportJobInfo 转换为返回给客户端的结构
+func toExportJobInfo(job *ent.ExportJob) *ExportJobInfo {
+	info := &ExportJobInfo{
+		Id:         job.ID,
+		Format:     job.Format,
+		Status:     job.Status,
+		Total:      job.Total,
+		Processed:  job.Processed,
+		FileSize:   job.FileSize,
+		LastError:  job.LastError,
+		CreateTime: job.CreateTime,
+		FinishTime: job.FinishTime,
+	}
+	if job.IsGroup {
+		info.GroupId = job.TargetId
+	} else {
+		info.FriendId = job.TargetId
+	}
+	switch {
+	case job.Status == ExportStatusCompleted:
+		info.Progress = 100
+	case job.Total > 0:
+		info.Progress = job.Processed * 100 / job.Total
+	}
+	if job.Status == ExportStatusCompleted && job.FinishTime != nil {
+		expireTime := job.FinishTime.Add(exportFileTTL)
+		info.ExpireTime = &expireTime
+	}
+	return info
+}
+
+// checkExportAccess 私聊只能导出好友的会话，群聊只有当前群成员可以导出
+func checkExportAccess(userId int, isGroup bool, targetId int) error {
+	if isGroup {
+		isMember, err := IsGroupMember(targetId, userId)
+		if err != nil {
+			return err
+		}
+		if !isMember {
+			return errors.New("只有群成员才能导出群聊记录")
+		}
+		return nil
+	}
+
+	isFriend, err := IsFriend(userId, targetId)
+	if err != nil {
+		return err
+	}
+	if !isFriend {
+		return errors.New("不是好友关系")
+	}
+	return nil
+}
+
+// CreateExportJob 创建聊天记录导出任务，friendId 和 groupId 二选一，导出在后台异步执行
+func CreateExportJob(userId, friendId, groupId int, format string) (*ExportJobInfo, error) {
+	if format != ExportFormatJSON && format != ExportFormatHTML {
+		return nil, errors.New("不支持的导出格式")
+	}
+	if (friendId == 0) == (groupId == 0) {
+		return nil, errors.New("请指定好友或群组")
+	}
+
+	isGroup := groupId != 0
+	targetId := friendId
+	if isGroup {
+		targetId = groupId
+	}
+	if err := checkExportAccess(userId, isGroup, targetId); err != nil {
+		return nil, err
+	}
+
+	ctx := context.TODO()
+	active, err := db.ExportJob.Query().
+		Where(
+			exportjob.UserId(userId),
+			exportjob.StatusIn(ExportStatusPending, ExportStatusRunning),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, errors.New("查询导出任务失败")
+	}
+	if active {
+		return nil, errors.New("已有正在进行的导出任务，请等待完成后再试")
+	}
+
+	job, err := db.ExportJob.Create().
+		SetUserId(userId).
+		SetIsGroup(isGroup).
+		SetTargetId(targetId).
+		SetFormat(format).
+		Save(ctx)
+	if err != nil {
+		return nil, errors.New("创建导出任务失败")
+	}
+
+	select {
+	case exportWake <- struct{}{}:
+	default:
+	}
+	return toExportJobInfo(job), nil
+}
+
+// GetExportJob 查询导出任务进度，已完成的任务返回短时有效的下载链接
+func GetExportJob(userId, id int) (*ExportJobInfo, error) {
+	job, err := db.ExportJob.Query().
+		Where(exportjob.ID(id), exportjob.UserId(userId)).
+		Only(context.TODO())
+	if err != nil {
+		return nil, errors.New("导出任务不存在")
+	}
+
+	info := toExportJobInfo(job)
+	if job.Status == ExportStatusCompleted {
+		url, err := GetFileURL(job.ObjectName, exportDownloadTTL)
+		if err != nil {
+			return nil, err
+		}
+		info.DownloadUrl = url
+	}
+	return info, nil
+}
+
+// GetExportJobs 获取用户最近的导出任务
+func GetExportJobs(userId int) ([]*ExportJobInfo, error) {
+	jobs, err := db.ExportJob.Query().
+		Where(exportjob.UserId(userId)).
+		Order(ent.Desc(exportjob.FieldCreateTime), ent.Desc(exportjob.FieldID)).
+		Limit(exportListLimit).
+		All(context.TODO())
+	if err != nil {
+		return nil, errors.New("查询导出任务失败")
+	}
+
+	result := make([]*ExportJobInfo, 0, len(jobs))
+	for _, job := range jobs {
+		result = append(result, toExportJobInfo(job))
+	}
+	return result, nil
+}
+
+// StartExportWorker 启动导出任务的后台执行，等待中和租约到期的任务都会被领取
+func StartExportWorker() {
+	exportWorkerOnce.Do(func() {
+		go func() {
+			ticker := time.NewTicker(exportPollInterval)
+			defer ticker.Stop()
+			var lastClean time.Time
+			for {
+				select {
+				case <-ticker.C:
+				case <-exportWake:
+				}
+				runPendingExports()
+				if time.Since(lastClean) >= exportCleanInterval {
+					cleanExpiredExports()
+					lastClean = time.Now()
+				}
+			}
+		}()
+		log.Printf("Export worker started")
+	})
+}
+
+// runPendingExports 依次执行所有可领取的导出任务
+func runPendingExports() {
+	ctx := context.Background()
+	for {
+		job, err := claimExportJob(ctx)
+		if err != nil {
+			log.Printf("Failed to claim export job: %v", err)
+			return
+		}
+		if job == nil {
+			return
+		}
+		runExportJob(ctx, job)
+	}
+}
+
+// claimExportJob 领取一个导出任务，条件更新保证同一个任务同时只被一个实例执行
+func claimExportJob(ctx context.Context) (*ent.ExportJob, error) {
+	now := time.Now()
+	// 等待中的任务，以及租约已到期（执行的实例已退出）的导出中任务
+	claimable := exportjob.Or(
+		exportjob.Status(ExportStatusPending),
+		exportjob.And(
+			exportjob.Status(ExportStatusRunning),
+			exportjob.LeaseTimeLTE(now),
+		),
+	)
+
+	for {
+		job, err := db.ExportJob.Query().
+			Where(claimable).
+			Order(ent.Asc(exportjob.FieldID)).
+			First(ctx)
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		lease := now.Add(exportLease)
+		n, err := db.ExportJob.Update().
+			Where(exportjob.ID(job.ID), exportjob.Attempts(job.Attempts), claimable).
+			SetStatus(ExportStatusRunning).
+			SetLeaseTime(lease).
+			SetProcessed(0).
+			AddAttempts(1).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			// 已被其他实例领取
+			continue
+		}
+		job.Status = ExportStatusRunning
+		job.LeaseTime = &lease
+		job.Processed = 0
+		job.Attempts++
+		return job, nil
+	}
+}
+
+// exportRun 一次导出的执行状态，进度更新以领取次数为条件，任务被其他实例重新领取后停止
+type exportRun struct {
+	ctx context.Context
+	job *ent.ExportJob
+}
+
+// update 更新任务并续租，任务已被其他实例接管时返回 errExportLeaseLost
+func (r *exportRun) update(apply func(*ent.ExportJobUpdate) *ent.ExportJobUpdate) error {
+	n, err := apply(db.ExportJob.Update().
+		Where(
+			exportjob.ID(r.job.ID),
+			exportjob.Status(ExportStatusRunning),
+			exportjob.Attempts(r.job.Attempts),
+		).
+		SetLeaseTime(time.Now().Add(exportLease))).
+		Save(r.ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return errExportLeaseLost
+	}
+	return nil
+}
+
+// updateProgress 更新导出进度，数据库暂时不可用时只记录日志，由下一批次再更新
+func (r *exportRun) updateProgress(apply func(*ent.ExportJobUpdate) *ent.ExportJobUpdate) error {
+	err := r.update(apply)
+	if err != nil && !errors.Is(err, errExportLeaseLost) {
+		log.Printf("Failed to update export job %d: %v", r.job.ID, err)
+		return nil
+	}
+	return err
+}
+
+// runExportJob 执行导出任务，结束后通知用户
+func runExportJob(ctx context.Context, job *ent.ExportJob) {
+	run := &exportRun{ctx: ctx, job: job}
+
+	tempDir, err := os.MkdirTemp("", "gochat-export-")
+	if err != nil {
+		finishExportJob(run, "", 0, errors.New("创建临时目录失败"))
+		return
+	}
+	defer os.RemoveAll(tempDir)
+
+	objectName, size, err := exportConversation(run, tempDir)
+	if errors.Is(err, errExportLeaseLost) {
+		log.Printf("Export job %d was taken over by another instance", job.ID)
+		return
+	}
+	finishExportJob(run, objectName, size, err)
+}
+
+// errExportLeaseLost 任务租约已被其他实例接管
+var errExportLeaseLost = errors.New("导出任务已被接管")
+
+// finishExportJob 记录导出结果，并通过WebSocket通知用户
+func finishExportJob(run *exportRun, objectName string, size int64, exportErr error) {
+	now := time.Now()
+	err := run.update(func(u *ent.ExportJobUpdate) *ent.ExportJobUpdate {
+		u = u.SetFinishTime(now).ClearLeaseTime()
+		if exportErr != nil {
+			return u.SetStatus(ExportStatusFailed).SetLastError(exportErr.Error())
+		}
+		return u.SetStatus(ExportStatusCompleted).
+			SetObjectName(objectName).
+			SetFileSize(size).
+			SetProcessed(run.job.Total)
+	})
+	if err != nil {
+		// 未能记录结果时任务保持导出中，租约到期后重新导出
+		log.Printf("Failed to finish export job %d: %v", run.job.ID, err)
+		return
+	}
+	if exportErr != nil {
+		log.Printf("Export job %d failed: %v", run.job.ID, exportErr)
+	}
+
+	if updated, err := db.ExportJob.Get(run.ctx, run.job.ID); err == nil {
+		_ = SendNotificationToUser(strconv.Itoa(updated.UserId), map[string]interface{}{
+			"type": "export_job",
+			"data": toExportJobInfo(updated),
+		})
+	}
+}
+
+// ExportConversation 导出文件中的会话信息
+// 用户名和群组的 groupId 用于将导出文件导入到其他服务器（见 ImportData）
+type ExportConversation struct {
+	Type           string    `json:"type"` // private 或 group
+	TargetId       int       `json:"targetId"`
+	Title          string    `json:"title"`
+	ExportUserId   int       `json:"exportUserId"`
+	ExportUsername string    `json:"exportUsername"`
+	TargetUsername string    `json:"targetUsername,omitempty"` // 私聊好友的用户名
+	GroupId        string    `json:"groupId,omitempty"`        // 群组的 groupId
+	ExportTime     time.Time `json:"exportTime"`
+	Total          int       `json:"total"`
+}
+
+// ExportMessage 导出文件中的一条消息
+type ExportMessage struct {
+	MsgId        string            `json:"msgId"`
+	FromUserId   int               `json:"fromUserId"`
+	FromUsername string            `json:"fromUsername"`
+	FromNickname string            `json:"fromNickname"`
+	MsgType      int               `json:"msgType"`
+	Content      string            `json:"content"`
+	Payload      interface{}       `json:"payload,omitempty"`
+	ReplyTo      *dto.MessageReply `json:"replyTo,omitempty"`
+	ForwardFrom  interface{}       `json:"forwardFrom,omitempty"`
+	Mentions     interface{}       `json:"mentions,omitempty"`
+	Reactions    interface{}       `json:"reactions,omitempty"`
+	IsRevoked    bool              `json:"isRevoked,omitempty"`
+	IsPurged     bool              `json:"isPurged,omitempty"`
+	CreateTime   time.Time         `json:"createTime"`
+	MediaFile    string            `json:"mediaFile,omitempty"` // HTML导出中媒体文件在压缩包内的路径
+
+	storageKey string
+}
+
+// exportWriter 按批写入导出文件
+type exportWriter interface {
+	writeMessages(messages []*ExportMessage) error
+	// close 完成文件，返回文件名后缀和内容类型
+	close() (ext, contentType string, err error)
+}
+
+// exportConversation 逐批读取会话记录写入导出文件，完成后上传到对象存储
+// 导出内容与用户在客户端看到的一致，已删除和清空前的消息不导出
+func exportConversation(run *exportRun, tempDir string) (string, int64, error) {
+	job := run.job
+	if err := checkExportAccess(job.UserId, job.IsGroup, job.TargetId); err != nil {
+		return "", 0, err
+	}
+
+	users := make(map[int]*ent.User)
+	conversation := &ExportConversation{
+		TargetId:       job.TargetId,
+		ExportUserId:   job.UserId,
+		ExportUsername: exportUser(job.UserId, users).Username,
+		ExportTime:     time.Now(),
+	}
+	var source historySource
+	if job.IsGroup {
+		visibility, err := getConversationVisibility(job.UserId, conversationKey{isGroup: true, targetId: job.TargetId})
+		if err != nil {
+			return "", 0, err
+		}
+		group, err := GetGroupByID(job.TargetId)
+		if err != nil {
+			return "", 0, err
+		}
+		conversation.Type = "group"
+		conversation.Title = group.GroupName
+		conversation.GroupId = group.GroupId
+		source = groupHistory{groupId: strconv.Itoa(job.TargetId), visibility: visibility}
+	} else {
+		visibility, err := getConversationVisibility(job.UserId, conversationKey{targetId: job.TargetId})
+		if err != nil {
+			return "", 0, err
+		}
+		conversation.Type = "private"
+		target := exportUser(job.TargetId, users)
+		conversation.Title = target.Nickname
+		conversation.TargetUsername = target.Username
+		source = privateHistory{
+			conversationId: privateConversationId(job.UserId, job.TargetId),
+			visibility:     visibility,
+		}
+	}
+
+	total, err := source.count(run.ctx)
+	if err != nil {
+		return "", 0, err
+	}
+	conversation.Total = total
+	job.Total = total
+	if err := run.updateProgress(func(u *ent.ExportJobUpdate) *ent.ExportJobUpdate { return u.SetTotal(total) }); err != nil {
+		return "", 0, err
+	}
+
+	var writer exportWriter
+	if job.Format == ExportFormatHTML {
+		writer, err = newHTMLExportWriter(tempDir, conversation)
+	} else {
+		writer, err = newJSONExportWriter(tempDir, conversation)
+	}
+	if err != nil {
+		return "", 0, err
+	}
+
+	processed := 0
+	var cursor *HistoryCursor
+	for {
+		rows, err := source.newer(run.ctx, cursor, exportBatchSize)
+		if err != nil {
+			writer.close()
+			return "", 0, err
+		}
+		if len(rows) == 0 {
+			break
+		}
+
+		messages := make([]map[string]interface{}, 0, len(rows))
+		for _, row := range rows {
+			messages = append(messages, row.message)
+		}
+		attachReactions(messages, job.UserId)
+		if job.IsGroup {
+			attachMentions(messages)
+		}
+		attachForwardInfo(messages)
+		attachPayloads(messages)
+
+		exported := make([]*ExportMessage, 0, len(messages))
+		for _, m := range messages {
+			exported = append(exported, toExportMessage(m, users))
+		}
+		if err := writer.writeMessages(exported); err != nil {
+			writer.close()
+			return "", 0, err
+		}
+
+		processed += len(rows)
+		if err := run.updateProgress(func(u *ent.ExportJobUpdate) *ent.ExportJobUpdate { return u.SetProcessed(processed) }); err != nil {
+			writer.close()
+			return "", 0, err
+		}
+		if len(rows) < exportBatchSize {
+			break
+		}
+		cursor = &rows[len(rows)-1].cursor
+	}
+
+	ext, contentType, err := writer.close()
+	if err != nil {
+		return "", 0, err
+	}
+	objectName := fmt.Sprintf("export/%d/%d.%s", job.UserId, job.ID, ext)
+	size, err := UploadLocalFile(objectName, filepath.Join(tempDir, "export."+ext), contentType)
+	if err != nil {
+		return "", 0, err
+	}
+	return objectName, size, nil
+}
+
+// exportUser 查询用户资料，users 用于在一次导出中缓存，用户不存在时以ID作为昵称
+func exportUser(userId int, users map[int]*ent.User) *ent.User {
+	if user, ok := users[userId]; ok {
+		return user
+	}
+	user, err := GetUserByIDWithCache(userId)
+	if err != nil {
+		user = &ent.User{ID: userId, Nickname: strconv.Itoa(userId)}
+	}
+	users[userId] = user
+	return user
+}
+
+// toExportMessage 将历史记录中的消息转换为导出结构
+func toExportMessage(m map[string]interface{}, users map[int]*ent.User) *ExportMessage {
+	e := &ExportMessage{
+		MsgId:       fmt.Sprint(m["msgId"]),
+		Payload:     m["payload"],
+		ForwardFrom: m["forwardFrom"],
+		Mentions:    m["mentions"],
+		Reactions:   m["reactions"],
+	}
+	e.FromUserId, _ = m["fromUserId"].(int)
+	sender := exportUser(e.FromUserId, users)
+	e.FromUsername = sender.Username
+	e.FromNickname = sender.Nickname
+	e.MsgType, _ = m["msgType"].(int)
+	e.Content, _ = m["content"].(string)
+	e.ReplyTo, _ = m["replyTo"].(*dto.MessageReply)
+	e.IsRevoked, _ = m["isRevoked"].(bool)
+	e.IsPurged, _ = m["isPurged"].(bool)
+	e.CreateTime, _ = m["createTime"].(time.Time)
+	e.storageKey = messageStorageKey(e.Payload)
+	return e
+}
+
+// jsonExportWriter 导出为单个JSON文件: {"conversation": {...}, "messages": [...]}
+type jsonExportWriter struct {
+	file  *os.File
+	count int
+}
+
+func newJSONExportWriter(tempDir string, conversation *ExportConversation) (*jsonExportWriter, error) {
+	file, err := os.Create(filepath.Join(tempDir, "export.json"))
+	if err != nil {
+		return nil, errors.New("创建导出文件失败")
+	}
+	header, _ := json.Marshal(conversation)
+	if _, err := fmt.Fprintf(file, "{\"conversation\":%s,\"messages\":[", header); err != nil {
+		file.Close()
+		return nil, errors.New("写入导出文件失败")
+	}
+	return &jsonExportWriter{file: file}, nil
+}
+
+func (w *jsonExportWriter) writeMessages(messages []*ExportMessage) error {
+	for _, m := range messages {
+		data, err := json.Marshal(m)
+		if err != nil {
+			return errors.New("序列化消息失败")
+		}
+		if w.count > 0 {
+			data = append([]byte{','}, data...)
+		}
+		if _, err := w.file.Write(data); err != nil {
+			return errors.New("写入导出文件失败")
+		}
+		w.count++
+	}
+	return nil
+}
+
+func (w *jsonExportWriter) close() (string, string, error) {
+	_, writeErr := w.file.WriteString("]}")
+	closeErr := w.file.Close()
+	if writeErr != nil || closeErr != nil {
+		return "", "", errors.New("写入导出文件失败")
+	}
+	return "json", "application/json", nil
+}
+
+// htmlExportWriter 导出为ZIP压缩包，包含可离线打开的 index.html 和 media 目录下引用的媒体文件
+// 媒体文件在读取每批消息时写入压缩包，页面先写到临时文件，最后作为 index.html 加入压缩包
+type htmlExportWriter struct {
+	zipFile   *os.File
+	zip       *zip.Writer
+	page      *os.File
+	viewerId  int
+	users     map[int]*ent.User // 回复引用中的用户昵称
+	media     map[string]string // 存储键 -> 压缩包内路径，转发的消息复用同一个文件
+	mediaSize int64
+}
+
+// exportHTMLRow 页面中一条消息的展示内容
+type exportHTMLRow struct {
+	Sender    string
+	Time      string
+	Self      bool
+	Kind      string // text, image, video, voice, file, note
+	Content   string
+	Media     string
+	FileName  string
+	Reply     string
+	Forwarded bool
+}
+
+func newHTMLExportWriter(tempDir string, conversation *ExportConversation) (*htmlExportWriter, error) {
+	zipFile, err := os.Create(filepath.Join(tempDir, "export.zip"))
+	if err != nil {
+		return nil, errors.New("创建导出文件失败")
+	}
+	page, err := os.Create(filepath.Join(tempDir, "index.html"))
+	if err != nil {
+		zipFile.Close()
+		return nil, errors.New("创建导出文件失败")
+	}
+
+	w := &htmlExportWriter{
+		zipFile:  zipFile,
+		zip:      zip.NewWriter(zipFile),
+		page:     page,
+		viewerId: conversation.ExportUserId,
+		users:    make(map[int]*ent.User),
+		media:    make(map[string]string),
+	}
+	if err := exportHTMLTemplate.ExecuteTemplate(page, "header", conversation); err != nil {
+		w.close()
+		return nil, errors.New("写入导出文件失败")
+	}
+	return w, nil
+}
+
+func (w *htmlExportWriter) writeMessages(messages []*ExportMessage) error {
+	for _, m := range messages {
+		if m.storageKey != "" {
+			m.MediaFile = w.addMedia(m.MsgId, m.storageKey, exportMediaSize(m.Payload))
+		}
+		if err := exportHTMLTemplate.ExecuteTemplate(w.page, "message", w.row(m)); err != nil {
+			return errors.New("写入导出文件失败")
+		}
+	}
+	return nil
+}
+
+// addMedia 将媒体文件写入压缩包，下载失败或超出大小上限时返回空
+func (w *htmlExportWriter) addMedia(msgId, storageKey string, size int64) string {
+	if name, ok := w.media[storageKey]; ok {
+		return name
+	}
+	w.media[storageKey] = ""
+	if w.mediaSize+size > exportMaxMediaSize {
+		return ""
+	}
+
+	object, err := DownloadFile(storageKey)
+	if err != nil {
+		log.Printf("Failed to download export media %s: %v", storageKey, err)
+		return ""
+	}
+	defer object.Close()
+
+	name := "media/" + msgId + path.Ext(storageKey)
+	entry, err := w.zip.Create(name)
+	if err != nil {
+		return ""
+	}
+	n, err := io.Copy(entry, object)
+	w.mediaSize += n
+	if err != nil {
+		log.Printf("Failed to export media %s: %v", storageKey, err)
+		return ""
+	}
+	w.media[storageKey] = name
+	return name
+}
+
+// exportMediaSize 消息体中记录的媒体文件大小
+func exportMediaSize(body interface{}) int64 {
+	switch b := body.(type) {
+	case *dto.MediaBody:
+		return b.FileSize
+	case *dto.FileContent:
+		return b.FileSize
+	case *dto.VoiceContent:
+		return b.FileSize
+	}
+	return 0
+}
+
+// row 生成消息的展示内容
+func (w *htmlExportWriter) row(m *ExportMessage) exportHTMLRow {
+	row := exportHTMLRow{
+		Sender:    m.FromNickname,
+		Time:      m.CreateTime.Local().Format("2006-01-02 15:04:05"),
+		Self:      m.FromUserId == w.viewerId,
+		Kind:      "text",
+		Content:   m.Content,
+		Media:     m.MediaFile,
+		Forwarded: m.ForwardFrom != nil,
+	}
+	if m.ReplyTo != nil {
+		row.Reply = exportUser(m.ReplyTo.FromUserId, w.users).Nickname + ": " + m.ReplyTo.Preview
+	}
+
+	switch {
+	case m.IsRevoked || m.IsPurged || m.MsgType == dto.SYSTEM_MESSAGE:
+		row.Kind = "note"
+	case m.MediaFile == "":
+	case m.MsgType == dto.IMAGE_MESSAGE:
+		row.Kind = "image"
+	case m.MsgType == dto.VIDEO_MESSAGE:
+		row.Kind = "video"
+	case m.MsgType == dto.VOICE_MESSAGE:
+		row.Kind = "voice"
+	case m.MsgType == dto.FILE_MESSAGE:
+		row.Kind = "file"
+		row.FileName = path.Base(m.MediaFile)
+		if file, ok := m.Payload.(*dto.FileContent); ok && file.FileName != "" {
+			row.FileName = file.FileName
+		}
+	}
+	return row
+}
+
+func (w *htmlExportWriter) close() (string, string, error) {
+	var err error
+	if w.page != nil {
+		if execErr := exportHTMLTemplate.ExecuteTemplate(w.page, "footer", nil); execErr != nil {
+			err = execErr
+		}
+		if _, seekErr := w.page.Seek(0, io.SeekStart); seekErr != nil && err == nil {
+			err = seekErr
+		}
+		if err == nil {
+			var entry io.Writer
+			if entry, err = w.zip.Create("index.html"); err == nil {
+				_, err = io.Copy(entry, w.page)
+			}
+		}
+		w.page.Close()
+	}
+	if closeErr := w.zip.Close(); closeErr != nil && err == nil {
+		err = closeErr
+	}
+	if closeErr := w.zipFile.Close(); closeErr != nil && err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", "", errors.New("写入导出文件失败")
+	}
+	return "zip", "application/zip", nil
+}
+
+// cleanExpiredExports 删除超过保留时间的导出文件
+func cleanExpiredExports() {
+	ctx := context.Background()
+	jobs, err := db.ExportJob.Query().
+		Where(
+			exportjob.Status(ExportStatusCompleted),
+			exportjob.FinishTimeLT(time.Now().Add(-exportFileTTL)),
+		).
+		All(ctx)
+	if err != nil {
+		log.Printf("Failed to query expired exports: %v", err)
+		return
+	}
+
+	for _, job := range jobs {
+		if err := DeleteFile(job.ObjectName); err != nil {
+			log.Printf("Failed to delete export %s: %v", job.ObjectName, err)
+			continue
+		}
+		_ = db.ExportJob.UpdateOne(job).
+			SetStatus(ExportStatusExpired).
+			SetObjectName("").
+			Exec(ctx)
+	}
+}
+
+// exportHTMLTemplate 导出页面，样式内联，不依赖外部资源
+var exportHTMLTemplate = template.Must(template.New("export").Parse(`
+{{define "header"}}<!DOCTYPE html>
+<html lang="zh-CN">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Title}} - 聊天记录</title>
+<style>
+body{margin:0;background:#f0f2f5;font-family:-apple-system,"PingFang SC","Microsoft YaHei",sans-serif;color:#222}
+header{position:sticky;top:0;background:#fff;border-bottom:1px solid #ddd;padding:12px 20px}
+header h1{margin:0;font-size:18px}
+header p{margin:4px 0 0;font-size:12px;color:#888}
+main{max-width:820px;margin:0 auto;padding:16px}
+.msg{display:flex;flex-direction:column;align-items:flex-start;margin:12px 0}
+.msg.self{align-items:flex-end}
+.meta{font-size:12px;color:#888;margin-bottom:4px}
+.bubble{max-width:70%;background:#fff;border-radius:8px;padding:8px 12px;white-space:pre-wrap;word-break:break-word}
+.self .bubble{background:#95ec69}
+.bubble img,.bubble video{max-width:100%;border-radius:4px}
+.reply{border-left:3px solid #ccc;padding-left:8px;margin-bottom:6px;font-size:12px;color:#666}
+.forwarded{font-size:12px;color:#888;margin-bottom:4px}
+.note{align-items:center}
+.note .bubble{background:none;color:#888;font-size:12px}
+</style>
+</head>
+<body>
+<header><h1>{{.Title}}</h1><p>共 {{.Total}} 条消息，导出于 {{.ExportTime.Local.Format "2006-01-02 15:04:05"}}</p></header>
+<main>
+{{end}}
+
+{{define "message"}}<div class="msg{{if .Self}} self{{end}}{{if eq .Kind "note"}} note{{end}}">
+{{if ne .Kind "note"}}<div class="meta">{{.Sender}} {{.Time}}</div>{{end}}
+<div class="bubble">{{if .Forwarded}}<div class="forwarded">转发的消息</div>{{end}}{{if .Reply}}<div class="reply">{{.Reply}}</div>{{end}}{{if eq .Kind "image"}}<a href="{{.Media}}"><img src="{{.Media}}" alt="图片"></a>{{else if eq .Kind "video"}}<video controls preload="none" src="{{.Media}}"></video>{{else if eq .Kind "voice"}}<audio controls preload="none" src="{{.Media}}"></audio>{{else if eq .Kind "file"}}<a href="{{.Media}}" download="{{.FileName}}">{{.FileName}}</a>{{else}}{{.Content}}{{end}}</div>
+</div>
+{{end}}
+
+{{define "footer"}}</main>
+</body>
+</html>
+{{end}}`))
@@ -0,0 +1,52 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"gochat_server/configs"
+	"gochat_server/dto"
+	"path/filepath"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+)
+
+// parseFileContent 解析并校验文件消息内容
+// 文件必须是通过 file 类别上传的，存储键以 "file/" 开头
+func parseFileContent(content string) (*dto.FileContent, error) {
+	var file dto.FileContent
+	if err := json.Unmarshal([]byte(content), &file); err != nil {
+		return nil, rejectMessage(errors.New("无效的文件消息内容"))
+	}
+
+	file.FileName = filepath.Base(strings.TrimSpace(file.FileName))
+	if file.FileName == "" || file.FileName == "." || file.FileName == "/" {
+		return nil, rejectMessage(errors.New("文件名不能为空"))
+	}
+	if !strings.HasPrefix(file.StorageKey, "file/") || strings.Contains(file.StorageKey, "..") {
+		return nil, rejectMessage(errors.New("无效的文件存储键"))
+	}
+	if file.FileSize <= 0 || file.FileSize > maxFileSize() {
+		return nil, rejectMessage(errors.New("无效的文件大小"))
+	}
+	if file.MimeType == "" {
+		file.MimeType = "application/octet-stream"
+	}
+
+	// 对象存储可用时确认文件确实已上传
+	if minioClient != nil {
+		info, err := minioClient.StatObject(context.Background(), configs.Cfg.MinIO.BucketName, file.StorageKey, minio.StatObjectOptions{})
+		if isObjectNotFound(err) {
+			return nil, rejectMessage(errors.New("文件不存在"))
+		}
+		if err != nil {
+			return nil, errors.New("查询文件失败")
+		}
+		if info.Size != file.FileSize {
+			return nil, rejectMessage(errors.New("文件大小不匹配"))
+		}
+	}
+
+	return &file, nil
+}
@@ -1,7 +1,10 @@
 package services
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"gochat_server/configs"
@@ -20,20 +23,36 @@ var minioClient *minio.Client
 
 // 文件大小限制
 const (
-	MaxImageSize = 10 * 1024 * 1024  // 10MB
-	MaxVideoSize = 100 * 1024 * 1024 // 100MB
+	MaxImageSize       = 10 * 1024 * 1024  // 10MB
+	MaxVideoSize       = 100 * 1024 * 1024 // 100MB
+	MaxAudioSize       = 20 * 1024 * 1024  // 20MB
+	DefaultMaxFileSize = 50 * 1024 * 1024  // 50MB，普通文件未配置大小上限时使用
 )
 
 // 支持的文件类型
 var (
 	ImageExtensions = []string{".jpg", ".jpeg", ".png", ".gif", ".bmp", ".webp"}
 	VideoExtensions = []string{".mp4", ".avi", ".mov", ".wmv", ".flv", ".mkv"}
+	AudioExtensions = []string{".wav", ".ogg", ".opus"}
+	// DefaultFileExtensions 普通文件未配置扩展名白名单时使用
+	DefaultFileExtensions = []string{".pdf", ".doc", ".docx", ".xls", ".xlsx", ".ppt", ".pptx", ".txt", ".md", ".csv", ".zip", ".rar", ".7z"}
 )
 
+// UploadedFile 上传成功的文件信息
+type UploadedFile struct {
+	Url        string     // 文件访问URL
+	StorageKey string     // 文件在对象存储中的键
+	FileName   string     // 原始文件名
+	FileSize   int64      // 文件大小（字节）
+	MimeType   string     // 文件MIME类型
+	Checksum   string     // SHA-256 校验和（十六进制）
+	Audio      *AudioInfo // 音频的时长和波形，仅 audio 类别
+}
+
 // InitMinIO 初始化 MinIO 客户端
 func InitMinIO() error {
 	cfg := configs.Cfg.MinIO
-	
+
 	var err error
 	minioClient, err = minio.New(cfg.Endpoint, &minio.Options{
 		Creds:  credentials.NewStaticV4(cfg.AccessKey, cfg.SecretKey, ""),
@@ -78,8 +97,17 @@ func InitMinIO() error {
 
 // UploadFile 上传文件
 func UploadFile(file multipart.File, fileHeader *multipart.FileHeader, fileType string) (string, error) {
+	uploaded, err := UploadFileWithInfo(file, fileHeader, fileType)
+	if err != nil {
+		return "", err
+	}
+	return uploaded.Url, nil
+}
+
+// UploadFileWithInfo 上传文件并返回文件信息（文件名、大小、MIME类型、校验和、存储键）
+func UploadFileWithInfo(file multipart.File, fileHeader *multipart.FileHeader, fileType string) (*UploadedFile, error) {
 	if minioClient == nil {
-		return "", errors.New("MinIO客户端未初始化")
+		return nil, errors.New("MinIO客户端未初始化")
 	}
 
 	// 获取文件扩展名
@@ -88,20 +116,34 @@ func UploadFile(file multipart.File, fileHeader *multipart.FileHeader, fileType
 	// 验证文件类型
 	if fileType == "image" {
 		if !contains(ImageExtensions, ext) {
-			return "", errors.New("不支持的图片格式")
+			return nil, errors.New("不支持的图片格式")
 		}
 		if fileHeader.Size > MaxImageSize {
-			return "", fmt.Errorf("图片大小超过限制（最大%dMB）", MaxImageSize/(1024*1024))
+			return nil, fmt.Errorf("图片大小超过限制（最大%dMB）", MaxImageSize/(1024*1024))
 		}
 	} else if fileType == "video" {
 		if !contains(VideoExtensions, ext) {
-			return "", errors.New("不支持的视频格式")
+			return nil, errors.New("不支持的视频格式")
 		}
 		if fileHeader.Size > MaxVideoSize {
-			return "", fmt.Errorf("视频大小超过限制（最大%dMB）", MaxVideoSize/(1024*1024))
+			return nil, fmt.Errorf("视频大小超过限制（最大%dMB）", MaxVideoSize/(1024*1024))
+		}
+	} else if fileType == "audio" {
+		if !contains(AudioExtensions, ext) {
+			return nil, errors.New("不支持的音频格式")
+		}
+		if fileHeader.Size > MaxAudioSize {
+			return nil, fmt.Errorf("音频大小超过限制（最大%dMB）", MaxAudioSize/(1024*1024))
+		}
+	} else if fileType == "file" {
+		if !contains(fileExtensions(), ext) {
+			return nil, errors.New("不支持的文件格式")
+		}
+		if maxSize := maxFileSize(); fileHeader.Size > maxSize {
+			return nil, fmt.Errorf("文件大小超过限制（最大%dMB）", maxSize/(1024*1024))
 		}
 	} else {
-		return "", errors.New("不支持的文件类型")
+		return nil, errors.New("不支持的文件类型")
 	}
 
 	// 生成唯一文件名
@@ -113,24 +155,78 @@ func UploadFile(file multipart.File, fileHeader *multipart.FileHeader, fileType
 		contentType = "application/octet-stream"
 	}
 
-	// 上传文件
+	// 音频在上传前解析时长和波形，结果保存在对象元数据中，发送语音消息时读取
+	var audio *AudioInfo
+	var userMetadata map[string]string
+	if fileType == "audio" {
+		var err error
+		audio, err = AnalyzeAudio(file, ext)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := file.Seek(0, io.SeekStart); err != nil {
+			return nil, fmt.Errorf("读取文件失败: %v", err)
+		}
+		userMetadata = encodeAudioMetadata(audio)
+	}
+
+	// 上传文件，同时计算校验和
+	hasher := sha256.New()
 	cfg := configs.Cfg.MinIO
 	ctx := context.Background()
-	_, err := minioClient.PutObject(ctx, cfg.BucketName, fileName, file, fileHeader.Size, minio.PutObjectOptions{
-		ContentType: contentType,
+	_, err := minioClient.PutObject(ctx, cfg.BucketName, fileName, io.TeeReader(file, hasher), fileHeader.Size, minio.PutObjectOptions{
+		ContentType:  contentType,
+		UserMetadata: userMetadata,
 	})
 	if err != nil {
-		return "", fmt.Errorf("上传文件失败: %v", err)
+		return nil, fmt.Errorf("上传文件失败: %v", err)
 	}
 
-	// 生成文件URL
+	return &UploadedFile{
+		Url:        GetPublicFileURL(fileName),
+		StorageKey: fileName,
+		FileName:   filepath.Base(fileHeader.Filename),
+		FileSize:   fileHeader.Size,
+		MimeType:   contentType,
+		Checksum:   hex.EncodeToString(hasher.Sum(nil)),
+		Audio:      audio,
+	}, nil
+}
+
+// GetPublicFileURL 根据存储键生成文件的公开访问URL
+func GetPublicFileURL(fileName string) string {
+	cfg := configs.Cfg.MinIO
 	protocol := "http"
 	if cfg.UseSSL {
 		protocol = "https"
 	}
-	url := fmt.Sprintf("%s://%s/%s/%s", protocol, cfg.Endpoint, cfg.BucketName, fileName)
+	return fmt.Sprintf("%s://%s/%s/%s", protocol, cfg.Endpoint, cfg.BucketName, fileName)
+}
+
+// fileExtensions 普通文件的扩展名白名单
+func fileExtensions() []string {
+	configured := configs.Cfg.Upload.FileExtensions
+	if len(configured) == 0 {
+		return DefaultFileExtensions
+	}
+
+	extensions := make([]string, 0, len(configured))
+	for _, ext := range configured {
+		ext = strings.ToLower(strings.TrimSpace(ext))
+		if ext != "" && !strings.HasPrefix(ext, ".") {
+			ext = "." + ext
+		}
+		extensions = append(extensions, ext)
+	}
+	return extensions
+}
 
-	return url, nil
+// maxFileSize 普通文件的大小上限（字节）
+func maxFileSize() int64 {
+	if configs.Cfg.Upload.MaxFileSize <= 0 {
+		return DefaultMaxFileSize
+	}
+	return int64(configs.Cfg.Upload.MaxFileSize) * 1024 * 1024
 }
 
 // GetFileURL 获取文件URL（预签名URL，用于私有文件）
@@ -165,6 +261,42 @@ func DeleteFile(fileName string) error {
 	return nil
 }
 
+// UploadObject 将内存中的数据上传到对象存储的指定位置，用于服务端生成的文件（如归档）
+func UploadObject(objectName string, data []byte, contentType string) error {
+	if minioClient == nil {
+		return errors.New("MinIO客户端未初始化")
+	}
+
+	cfg := configs.Cfg.MinIO
+	ctx := context.Background()
+	_, err := minioClient.PutObject(ctx, cfg.BucketName, objectName, bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+	if err != nil {
+		return fmt.Errorf("上传文件失败: %v", err)
+	}
+
+	return nil
+}
+
+// UploadLocalFile 将服务端生成的本地文件上传到对象存储的指定位置，返回文件大小，用于较大的文件（如导出）
+func UploadLocalFile(objectName, filePath, contentType string) (int64, error) {
+	if minioClient == nil {
+		return 0, errors.New("MinIO客户端未初始化")
+	}
+
+	cfg := configs.Cfg.MinIO
+	ctx := context.Background()
+	info, err := minioClient.FPutObject(ctx, cfg.BucketName, objectName, filePath, minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("上传文件失败: %v", err)
+	}
+
+	return info.Size, nil
+}
+
 // DownloadFile 下载文件
 func DownloadFile(fileName string) (io.ReadCloser, error) {
 	if minioClient == nil {
@@ -181,6 +313,11 @@ func DownloadFile(fileName string) (io.ReadCloser, error) {
 	return object, nil
 }
 
+// isObjectNotFound 对象存储中不存在该文件，与网络等临时错误区分
+func isObjectNotFound(err error) bool {
+	return err != nil && minio.ToErrorResponse(err).Code == "NoSuchKey"
+}
+
 // contains 检查切片是否包含指定元素
 func contains(slice []string, item string) bool {
 	for _, s := range slice {
@@ -0,0 +1,345 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gochat_server/dto"
+	"gochat_server/ent"
+	"gochat_server/ent/messageforward"
+	"sort"
+	"time"
+)
+
+// 转发数量限制
+const (
+	MaxForwardMessages = 100 // 单次最多转发的消息数
+	MaxForwardTargets  = 9   // 单次最多转发的目标数
+)
+
+// forwardSource 转发来源
+type forwardSource struct {
+	MsgId      string
+	FromUserId int
+	IsGroup    bool
+	GroupId    int
+	CreateTime time.Time
+}
+
+// ForwardResult 转发结果
+type ForwardResult struct {
+	MsgId      string `json:"msgId"`
+	MsgType    int    `json:"msgType"`
+	TargetType string `json:"targetType"`
+	TargetId   int    `json:"targetId"`
+}
+
+// ForwardMessages 转发消息到好友或群聊
+// merged 为 true 时将多条消息打包成一条聊天记录消息，否则逐条转发
+func ForwardMessages(ctx context.Context, userId int, msgIds []string, targets []dto.ForwardTarget, merged bool, title string) ([]*ForwardResult, error) {
+	msgIds = uniqueStrings(msgIds)
+	if len(msgIds) == 0 {
+		return nil, errors.New("请选择要转发的消息")
+	}
+	if len(msgIds) > MaxForwardMessages {
+		return nil, fmt.Errorf("单次最多转发%d条消息", MaxForwardMessages)
+	}
+
+	targets = uniqueForwardTargets(targets)
+	if len(targets) == 0 {
+		return nil, errors.New("请选择转发目标")
+	}
+	if len(targets) > MaxForwardTargets {
+		return nil, fmt.Errorf("单次最多转发给%d个会话", MaxForwardTargets)
+	}
+
+	// 校验原始消息：必须有权查看且未被撤回、未过期
+	sources := make([]*MessageDetail, 0, len(msgIds))
+	for _, msgId := range msgIds {
+		detail, err := CanUserAccessMessage(msgId, userId)
+		if err != nil {
+			return nil, err
+		}
+		if isMessageRevoked(msgId) {
+			return nil, errors.New("已撤回的消息不能转发")
+		}
+		if detail.IsPurged {
+			return nil, errors.New("已过期的消息不能转发")
+		}
+		sources = append(sources, detail)
+	}
+
+	// 校验转发目标：只能转发给好友或自己所在的群
+	for _, target := range targets {
+		if err := checkForwardTarget(userId, target); err != nil {
+			return nil, err
+		}
+	}
+
+	results := make([]*ForwardResult, 0)
+
+	if merged {
+		content := buildMergedForwardContent(sources, title)
+		for _, target := range targets {
+			toUserId, groupId := forwardTargetReceiver(target)
+			msgId, err := sendMessage(ctx, userId, toUserId, groupId, &outgoingMessage{
+				MsgType: dto.MERGED_FORWARD_MESSAGE,
+				Body:    content,
+			}, nil)
+			if err != nil {
+				return results, err
+			}
+			results = append(results, &ForwardResult{
+				MsgId:      msgId,
+				MsgType:    dto.MERGED_FORWARD_MESSAGE,
+				TargetType: target.Type,
+				TargetId:   target.Id,
+			})
+		}
+		return results, nil
+	}
+
+	for _, source := range sources {
+		origin := resolveForwardOrigin(source)
+		for _, target := range targets {
+			toUserId, groupId := forwardTargetReceiver(target)
+			// 直接复用原消息的消息体，不再重新解析和校验内容（如名片不要求转发者与名片用户是好友）
+			msgId, err := sendMessage(ctx, userId, toUserId, groupId, &outgoingMessage{
+				MsgType: source.MsgType,
+				Content: source.Content,
+				Body:    source.Payload,
+			}, origin)
+			if err != nil {
+				return results, err
+			}
+			results = append(results, &ForwardResult{
+				MsgId:      msgId,
+				MsgType:    source.MsgType,
+				TargetType: target.Type,
+				TargetId:   target.Id,
+			})
+		}
+	}
+
+	return results, nil
+}
+
+// checkForwardTarget 检查用户是否可以向目标会话转发消息
+func checkForwardTarget(userId int, target dto.ForwardTarget) error {
+	switch target.Type {
+	case dto.FORWARD_TARGET_FRIEND:
+		isFriend, err := IsFriend(userId, target.Id)
+		if err != nil {
+			return err
+		}
+		if !isFriend {
+			return errors.New("只能转发给好友")
+		}
+	case dto.FORWARD_TARGET_GROUP:
+		isMember, err := IsGroupMember(target.Id, userId)
+		if err != nil {
+			return err
+		}
+		if !isMember {
+			return errors.New("只能转发到自己所在的群")
+		}
+	default:
+		return errors.New("不支持的转发目标类型")
+	}
+	return nil
+}
+
+// forwardTargetReceiver 将转发目标转换为 sendMessage 的接收者参数
+func forwardTargetReceiver(target dto.ForwardTarget) (int, *int) {
+	if target.Type == dto.FORWARD_TARGET_GROUP {
+		groupId := target.Id
+		return 0, &groupId
+	}
+	return target.Id, nil
+}
+
+// resolveForwardOrigin 获取消息的最初来源，转发已转发的消息时保留最初的发送者
+func resolveForwardOrigin(detail *MessageDetail) *forwardSource {
+	record, err := db.MessageForward.Query().
+		Where(messageforward.MsgId(detail.MsgId)).
+		First(context.TODO())
+	if err == nil {
+		return &forwardSource{
+			MsgId:      record.SourceMsgId,
+			FromUserId: record.SourceFromUserId,
+			IsGroup:    record.SourceIsGroup,
+			GroupId:    record.SourceGroupId,
+			CreateTime: record.SourceCreateTime,
+		}
+	}
+
+	origin := &forwardSource{
+		MsgId:      detail.MsgId,
+		FromUserId: detail.FromUserId,
+		IsGroup:    detail.IsGroup,
+		CreateTime: detail.CreateTime,
+	}
+	if detail.IsGroup && detail.GroupId != nil {
+		origin.GroupId = *detail.GroupId
+	}
+	return origin
+}
+
+// saveForwardSource 保存转发来源
+func saveForwardSource(ctx context.Context, tx *ent.Tx, msgId string, forwardUserId int, source *forwardSource) error {
+	create := tx.MessageForward.Create().
+		SetMsgId(msgId).
+		SetSourceMsgId(source.MsgId).
+		SetSourceFromUserId(source.FromUserId).
+		SetSourceIsGroup(source.IsGroup).
+		SetSourceCreateTime(source.CreateTime).
+		SetForwardUserId(forwardUserId)
+	if source.IsGroup {
+		create = create.SetSourceGroupId(source.GroupId)
+	}
+
+	if _, err := create.Save(ctx); err != nil {
+		return errors.New("保存转发来源失败")
+	}
+	return nil
+}
+
+// buildMergedForwardContent 构建合并转发消息内容，消息按发送时间排序
+func buildMergedForwardContent(sources []*MessageDetail, title string) *dto.MergedForwardContent {
+	sorted := make([]*MessageDetail, len(sources))
+	copy(sorted, sources)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].CreateTime.Before(sorted[j].CreateTime)
+	})
+
+	nicknames := make(map[int]string)
+	nickname := func(userId int) string {
+		if name, ok := nicknames[userId]; ok {
+			return name
+		}
+		name := ""
+		if u, err := GetUserByID(userId); err == nil {
+			name = u.Nickname
+		}
+		nicknames[userId] = name
+		return name
+	}
+
+	items := make([]dto.MergedForwardItem, 0, len(sorted))
+	for _, source := range sorted {
+		items = append(items, dto.MergedForwardItem{
+			MsgId:        source.MsgId,
+			FromUserId:   source.FromUserId,
+			FromNickname: nickname(source.FromUserId),
+			MsgType:      source.MsgType,
+			Content:      source.Content,
+			CreateTime:   source.CreateTime.Unix(),
+		})
+	}
+
+	if title == "" {
+		title = defaultMergedForwardTitle(sorted, nickname)
+	}
+
+	return &dto.MergedForwardContent{
+		Title: title,
+		Items: items,
+	}
+}
+
+// defaultMergedForwardTitle 生成默认的聊天记录标题
+func defaultMergedForwardTitle(sources []*MessageDetail, nickname func(int) string) string {
+	first := sources[0]
+	if first.IsGroup && first.GroupId != nil {
+		if g, err := GetGroupByID(*first.GroupId); err == nil {
+			return g.GroupName + "的聊天记录"
+		}
+		return "群聊的聊天记录"
+	}
+
+	from := nickname(first.FromUserId)
+	to := nickname(first.ToUserId)
+	if from == "" || to == "" {
+		return "聊天记录"
+	}
+	return from + "和" + to + "的聊天记录"
+}
+
+// getForwardInfo 批量查询消息的转发来源信息
+func getForwardInfo(msgIds []string) map[string]map[string]interface{} {
+	result := make(map[string]map[string]interface{})
+	if len(msgIds) == 0 {
+		return result
+	}
+
+	records, err := db.MessageForward.Query().
+		Where(messageforward.MsgIdIn(msgIds...)).
+		All(context.TODO())
+	if err != nil {
+		return result
+	}
+
+	for _, r := range records {
+		info := map[string]interface{}{
+			"sourceMsgId":      r.SourceMsgId,
+			"sourceFromUserId": r.SourceFromUserId,
+			"sourceIsGroup":    r.SourceIsGroup,
+			"sourceCreateTime": r.SourceCreateTime,
+		}
+		if u, err := GetUserByID(r.SourceFromUserId); err == nil {
+			info["sourceFromNickname"] = u.Nickname
+		}
+		if r.SourceIsGroup {
+			info["sourceGroupId"] = r.SourceGroupId
+		}
+		result[r.MsgId] = info
+	}
+
+	return result
+}
+
+// attachForwardInfo 为消息列表附加转发来源信息
+func attachForwardInfo(messages []map[string]interface{}) {
+	msgIds := make([]string, 0, len(messages))
+	for _, m := range messages {
+		if msgId, ok := m["msgId"].(string); ok {
+			msgIds = append(msgIds, msgId)
+		}
+	}
+
+	forwardInfo := getForwardInfo(msgIds)
+	for _, m := range messages {
+		msgId, _ := m["msgId"].(string)
+		if info, ok := forwardInfo[msgId]; ok {
+			m["forwardFrom"] = info
+		}
+	}
+}
+
+// uniqueStrings 去重并保持原有顺序
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if v == "" || seen[v] {
+			continue
+		}
+		seen[v] = true
+		result = append(result, v)
+	}
+	return result
+}
+
+// uniqueForwardTargets 转发目标去重并保持原有顺序
+func uniqueForwardTargets(targets []dto.ForwardTarget) []dto.ForwardTarget {
+	seen := make(map[dto.ForwardTarget]bool, len(targets))
+	result := make([]dto.ForwardTarget, 0, len(targets))
+	for _, t := range targets {
+		if seen[t] {
+			continue
+		}
+		seen[t] = true
+		result = append(result, t)
+	}
+	return result
+}
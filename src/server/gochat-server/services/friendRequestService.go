@@ -0,0 +1,184 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gochat_server/configs"
+	"gochat_server/ent"
+	"gochat_server/ent/friendrequest"
+	"log"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// 好友请求状态
+const (
+	FriendRequestPending   = 0 // 待处理
+	FriendRequestAccepted  = 1 // 已接受
+	FriendRequestRejected  = 2 // 已拒绝
+	FriendRequestWithdrawn = 3 // 已撤回
+	FriendRequestExpired   = 4 // 已过期
+)
+
+const (
+	defaultFriendRequestExpireDays     = 7
+	defaultFriendRequestRejectCooldown = 24 * time.Hour
+	friendRequestCleanupInterval       = time.Hour
+)
+
+var friendRequestCleanerOnce sync.Once
+
+// friendRequestExpiry 待处理的好友请求有效期
+func friendRequestExpiry() time.Duration {
+	days := configs.Cfg.FriendRequest.ExpireDays
+	if days <= 0 {
+		days = defaultFriendRequestExpireDays
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// friendRequestExpireBefore 在该时间之前（含）创建的待处理请求已过期
+func friendRequestExpireBefore() time.Time {
+	return time.Now().Add(-friendRequestExpiry())
+}
+
+// friendRequestRejectCooldown 请求被拒绝后再次发送的间隔
+func friendRequestRejectCooldown() time.Duration {
+	if hours := configs.Cfg.FriendRequest.RejectCooldownHours; hours > 0 {
+		return time.Duration(hours) * time.Hour
+	}
+	return defaultFriendRequestRejectCooldown
+}
+
+// getReceivedFriendRequest 查询用户收到的待处理好友请求，已过期的请求同时标记为过期
+func getReceivedFriendRequest(userId, requestId int) (*ent.FriendRequest, error) {
+	request, err := db.FriendRequest.Get(context.TODO(), requestId)
+	if err != nil {
+		return nil, errors.New("好友请求不存在")
+	}
+	if request.ToUserId != userId {
+		return nil, errors.New("无权操作此请求")
+	}
+	if request.Status != FriendRequestPending {
+		return nil, errors.New("该请求已被处理")
+	}
+	if !request.CreateTime.After(friendRequestExpireBefore()) {
+		_, _ = db.FriendRequest.Update().
+			Where(
+				friendrequest.ID(requestId),
+				friendrequest.Status(FriendRequestPending),
+			).
+			SetStatus(FriendRequestExpired).
+			SetHandleTime(time.Now()).
+			Save(context.TODO())
+		return nil, errors.New("好友请求已过期")
+	}
+	return request, nil
+}
+
+// checkRejectCooldown 检查最近是否被对方拒绝过
+func checkRejectCooldown(fromUserId, toUserId int) error {
+	cooldown := friendRequestRejectCooldown()
+	last, err := db.FriendRequest.Query().
+		Where(
+			friendrequest.FromUserId(fromUserId),
+			friendrequest.ToUserId(toUserId),
+			friendrequest.Status(FriendRequestRejected),
+			friendrequest.HandleTimeGT(time.Now().Add(-cooldown)),
+		).
+		Order(ent.Desc(friendrequest.FieldHandleTime)).
+		First(context.TODO())
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.New("查询好友请求失败")
+	}
+
+	wait := time.Until(last.HandleTime.Add(cooldown))
+	hours := int(wait.Hours())
+	if wait > time.Duration(hours)*time.Hour {
+		hours++
+	}
+	return fmt.Errorf("对方已拒绝你的好友请求，请在%d小时后再试", hours)
+}
+
+// WithdrawFriendRequest 撤回自己发送的待处理好友请求
+func WithdrawFriendRequest(userId, requestId int) error {
+	request, err := db.FriendRequest.Get(context.TODO(), requestId)
+	if err != nil {
+		return errors.New("好友请求不存在")
+	}
+	if request.FromUserId != userId {
+		return errors.New("无权操作此请求")
+	}
+
+	updated, err := db.FriendRequest.Update().
+		Where(
+			friendrequest.ID(requestId),
+			friendrequest.Status(FriendRequestPending),
+			friendrequest.CreateTimeGT(friendRequestExpireBefore()),
+		).
+		SetStatus(FriendRequestWithdrawn).
+		SetHandleTime(time.Now()).
+		Save(context.TODO())
+	if err != nil {
+		return errors.New("撤回好友请求失败")
+	}
+	if updated == 0 {
+		return errors.New("该请求已被处理或已过期")
+	}
+
+	// 通知接收者从请求列表中移除
+	go func() {
+		notification := map[string]interface{}{
+			"type": "friend_request_withdrawn",
+			"data": map[string]interface{}{
+				"id":         request.ID,
+				"fromUserId": request.FromUserId,
+			},
+			"time": getCurrentTimestamp(),
+		}
+		if err := SendNotificationToUser(strconv.Itoa(request.ToUserId), notification); err != nil {
+			log.Printf("Failed to send friend request withdrawn notification: %v", err)
+		}
+	}()
+
+	return nil
+}
+
+// StartFriendRequestCleaner 启动过期好友请求清理任务
+func StartFriendRequestCleaner() {
+	friendRequestCleanerOnce.Do(func() {
+		go func() {
+			expireFriendRequests()
+			ticker := time.NewTicker(friendRequestCleanupInterval)
+			defer ticker.Stop()
+			for range ticker.C {
+				expireFriendRequests()
+			}
+		}()
+		log.Printf("Friend request cleaner started")
+	})
+}
+
+// expireFriendRequests 将超过有效期的待处理请求标记为已过期
+func expireFriendRequests() {
+	expired, err := db.FriendRequest.Update().
+		Where(
+			friendrequest.Status(FriendRequestPending),
+			friendrequest.CreateTimeLTE(friendRequestExpireBefore()),
+		).
+		SetStatus(FriendRequestExpired).
+		SetHandleTime(time.Now()).
+		Save(context.TODO())
+	if err != nil {
+		log.Printf("Failed to expire friend requests: %v", err)
+		return
+	}
+	if expired > 0 {
+		log.Printf("Expired %d friend requests", expired)
+	}
+}
@@ -3,40 +3,77 @@ package services
 import (
 	"context"
 	"errors"
+	"gochat_server/dto"
 	"gochat_server/ent"
 	"gochat_server/ent/friendrelationship"
 	"gochat_server/ent/friendrequest"
 	"gochat_server/ent/user"
 	"log"
 	"strconv"
+	"time"
 )
 
 // SendFriendRequest 发送好友请求
-func SendFriendRequest(fromUserId, toUserId int, remark string) error {
+// source 为请求来源，来源为名片分享时 sourceMsgId 为收到的名片消息ID
+// 对方也向自己发送了待处理的请求时直接成为好友，返回 true
+func SendFriendRequest(fromUserId, toUserId int, remark string, source string, sourceMsgId string) (bool, error) {
 	// 检查发送者是否存在
 	_, err := db.User.Get(context.TODO(), fromUserId)
 	if err != nil {
-		return errors.New("发送者不存在")
+		return false, errors.New("发送者不存在")
 	}
 
 	// 检查接收者是否存在
 	_, err = db.User.Get(context.TODO(), toUserId)
 	if err != nil {
-		return errors.New("接收者不存在")
+		return false, errors.New("接收者不存在")
 	}
 
 	// 不能给自己发送好友请求
 	if fromUserId == toUserId {
-		return errors.New("不能给自己发送好友请求")
+		return false, errors.New("不能给自己发送好友请求")
 	}
 
 	// 检查是否已经是好友
 	isFriend, err := IsFriend(fromUserId, toUserId)
 	if err != nil {
-		return err
+		return false, err
 	}
 	if isFriend {
-		return errors.New("已经是好友关系")
+		return false, errors.New("已经是好友关系")
+	}
+
+	// 校验请求来源
+	if err := validateFriendRequestSource(fromUserId, toUserId, source, sourceMsgId); err != nil {
+		return false, err
+	}
+
+	// 被对方拉黑时请求直接丢弃，对请求者表现为发送成功
+	blocked, err := IsBlocked(toUserId, fromUserId)
+	if err != nil {
+		return false, err
+	}
+	if blocked {
+		return false, nil
+	}
+
+	// 对方也发送了待处理的请求，接受对方的请求即可成为好友
+	reverseRequest, err := db.FriendRequest.Query().
+		Where(
+			friendrequest.FromUserId(toUserId),
+			friendrequest.ToUserId(fromUserId),
+			friendrequest.Status(FriendRequestPending),
+			friendrequest.CreateTimeGT(friendRequestExpireBefore()),
+		).
+		First(context.TODO())
+	if err == nil {
+		if err := AcceptFriendRequest(fromUserId, reverseRequest.ID); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+	if !ent.IsNotFound(err) {
+		return false, errors.New("查询好友请求失败")
 	}
 
 	// 检查是否已经发送过请求（待处理状态）
@@ -44,12 +81,18 @@ func SendFriendRequest(fromUserId, toUserId int, remark string) error {
 		Where(
 			friendrequest.FromUserId(fromUserId),
 			friendrequest.ToUserId(toUserId),
-			friendrequest.Status(0),
+			friendrequest.Status(FriendRequestPending),
+			friendrequest.CreateTimeGT(friendRequestExpireBefore()),
 		).
 		First(context.TODO())
 
 	if existingRequest != nil {
-		return errors.New("已经发送过好友请求，请等待对方处理")
+		return false, errors.New("已经发送过好友请求，请等待对方处理")
+	}
+
+	// 被拒绝后一段时间内不能再次发送
+	if err := checkRejectCooldown(fromUserId, toUserId); err != nil {
+		return false, err
 	}
 
 	// 创建好友请求
@@ -57,11 +100,13 @@ func SendFriendRequest(fromUserId, toUserId int, remark string) error {
 		SetFromUserId(fromUserId).
 		SetToUserId(toUserId).
 		SetRemark(remark).
-		SetStatus(0).
+		SetStatus(FriendRequestPending).
+		SetSource(source).
+		SetSourceMsgId(sourceMsgId).
 		Save(context.TODO())
 
 	if err != nil {
-		return errors.New("发送好友请求失败")
+		return false, errors.New("发送好友请求失败")
 	}
 
 	// 发送实时通知给接收者
@@ -72,54 +117,103 @@ func SendFriendRequest(fromUserId, toUserId int, remark string) error {
 		}
 	}()
 
-	return nil
+	return false, nil
 }
 
-// AcceptFriendRequest 接受好友请求
-func AcceptFriendRequest(requestId int) error {
-	// 查询好友请求
-	request, err := db.FriendRequest.Get(context.TODO(), requestId)
-	if err != nil {
-		return errors.New("好友请求不存在")
-	}
-
-	// 检查请求状态
-	if request.Status != 0 {
-		return errors.New("该请求已被处理")
+// validateFriendRequestSource 校验好友请求来源
+// 来源为名片分享时，请求者必须能查看该名片消息，且名片中的用户就是请求的接收者
+func validateFriendRequestSource(fromUserId, toUserId int, source string, sourceMsgId string) error {
+	switch source {
+	case "", dto.FRIEND_SOURCE_SEARCH:
+		if sourceMsgId != "" {
+			return errors.New("无效的好友请求来源")
+		}
+		return nil
+	case dto.FRIEND_SOURCE_SHARED_CARD:
+		detail, err := CanUserAccessMessage(sourceMsgId, fromUserId)
+		if err != nil {
+			return err
+		}
+		if detail.MsgType != dto.CONTACT_CARD_MESSAGE {
+			return errors.New("来源消息不是名片消息")
+		}
+		card, ok := detail.Payload.(*dto.ContactCardContent)
+		if !ok || card.UserId != toUserId {
+			return errors.New("名片用户与请求对象不一致")
+		}
+		return nil
+	default:
+		return errors.New("无效的好友请求来源")
 	}
+}
 
-	// 更新请求状态为已接受
-	_, err = db.FriendRequest.UpdateOneID(requestId).
-		SetStatus(1).
-		Save(context.TODO())
+// AcceptFriendRequest 接受好友请求，只有请求的接收者可以接受
+// 自己发给对方的待处理请求同时标记为已接受
+func AcceptFriendRequest(userId, requestId int) error {
+	request, err := getReceivedFriendRequest(userId, requestId)
 	if err != nil {
-		return errors.New("更新请求状态失败")
+		return err
 	}
 
-	// 创建双向好友关系
-	// 关系1: fromUser -> toUser
-	_, err = db.FriendRelationship.Create().
-		SetUserId(request.FromUserId).
-		SetFriendId(request.ToUserId).
-		Save(context.TODO())
-	if err != nil {
-		return errors.New("创建好友关系失败")
-	}
+	ctx := context.TODO()
+	now := time.Now()
+	err = withTx(ctx, func(tx *ent.Tx) error {
+		// 条件更新，同一请求只能被处理一次
+		updated, err := tx.FriendRequest.Update().
+			Where(
+				friendrequest.ID(requestId),
+				friendrequest.Status(FriendRequestPending),
+			).
+			SetStatus(FriendRequestAccepted).
+			SetHandleTime(now).
+			Save(ctx)
+		if err != nil {
+			return errors.New("更新请求状态失败")
+		}
+		if updated == 0 {
+			return errors.New("该请求已被处理")
+		}
 
-	// 关系2: toUser -> fromUser
-	_, err = db.FriendRelationship.Create().
-		SetUserId(request.ToUserId).
-		SetFriendId(request.FromUserId).
-		Save(context.TODO())
-	if err != nil {
-		// 如果第二个关系创建失败，删除第一个关系
-		db.FriendRelationship.Delete().
+		if _, err := tx.FriendRequest.Update().
 			Where(
-				friendrelationship.UserId(request.FromUserId),
-				friendrelationship.FriendId(request.ToUserId),
+				friendrequest.FromUserId(request.ToUserId),
+				friendrequest.ToUserId(request.FromUserId),
+				friendrequest.Status(FriendRequestPending),
 			).
-			Exec(context.TODO())
-		return errors.New("创建好友关系失败")
+			SetStatus(FriendRequestAccepted).
+			SetHandleTime(now).
+			Save(ctx); err != nil {
+			return errors.New("更新请求状态失败")
+		}
+
+		// 创建双向好友关系，已存在的关系保留
+		for _, pair := range [][2]int{
+			{request.FromUserId, request.ToUserId},
+			{request.ToUserId, request.FromUserId},
+		} {
+			exists, err := tx.FriendRelationship.Query().
+				Where(
+					friendrelationship.UserId(pair[0]),
+					friendrelationship.FriendId(pair[1]),
+				).
+				Exist(ctx)
+			if err != nil {
+				return errors.New("创建好友关系失败")
+			}
+			if exists {
+				continue
+			}
+			if err := tx.FriendRelationship.Create().
+				SetUserId(pair[0]).
+				SetFriendId(pair[1]).
+				Exec(ctx); err != nil {
+				return errors.New("创建好友关系失败")
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
 	// 使好友列表缓存失效
@@ -130,7 +224,7 @@ func AcceptFriendRequest(requestId int) error {
 	go func() {
 		accepter, err := db.User.Get(context.TODO(), request.ToUserId)
 		if err == nil {
-			err = SendFriendRequestAcceptedNotification(strconv.Itoa(request.FromUserId), accepter.Nickname)
+			err = SendFriendRequestAcceptedNotification(request, accepter)
 			if err != nil {
 				log.Printf("Failed to send friend request accepted notification: %v", err)
 			}
@@ -140,26 +234,39 @@ func AcceptFriendRequest(requestId int) error {
 	return nil
 }
 
-// RejectFriendRequest 拒绝好友请求
-func RejectFriendRequest(requestId int) error {
-	// 查询好友请求
-	request, err := db.FriendRequest.Get(context.TODO(), requestId)
+// RejectFriendRequest 拒绝好友请求，只有请求的接收者可以拒绝
+func RejectFriendRequest(userId, requestId int) error {
+	request, err := getReceivedFriendRequest(userId, requestId)
 	if err != nil {
-		return errors.New("好友请求不存在")
-	}
-
-	// 检查请求状态
-	if request.Status != 0 {
-		return errors.New("该请求已被处理")
+		return err
 	}
 
 	// 更新请求状态为已拒绝
-	_, err = db.FriendRequest.UpdateOneID(requestId).
-		SetStatus(2).
+	updated, err := db.FriendRequest.Update().
+		Where(
+			friendrequest.ID(requestId),
+			friendrequest.Status(FriendRequestPending),
+		).
+		SetStatus(FriendRequestRejected).
+		SetHandleTime(time.Now()).
 		Save(context.TODO())
 	if err != nil {
 		return errors.New("更新请求状态失败")
 	}
+	if updated == 0 {
+		return errors.New("该请求已被处理")
+	}
+
+	// 发送好友请求被拒绝的通知给发送者
+	go func() {
+		rejecter, err := db.User.Get(context.TODO(), request.ToUserId)
+		if err == nil {
+			err = SendFriendRequestRejectedNotification(request, rejecter)
+			if err != nil {
+				log.Printf("Failed to send friend request rejected notification: %v", err)
+			}
+		}
+	}()
 
 	return nil
 }
@@ -212,7 +319,8 @@ func GetFriendRequests(userId int) ([]*ent.FriendRequest, error) {
 	requests, err := db.FriendRequest.Query().
 		Where(
 			friendrequest.ToUserId(userId),
-			friendrequest.Status(0), // 只查询待处理的请求
+			friendrequest.Status(FriendRequestPending), // 只查询待处理且未过期的请求
+			friendrequest.CreateTimeGT(friendRequestExpireBefore()),
 		).
 		All(context.TODO())
 
@@ -233,6 +341,14 @@ func GetSentFriendRequests(userId int) ([]*ent.FriendRequest, error) {
 		return nil, errors.New("查询好友请求失败")
 	}
 
+	// 清理任务尚未处理的过期请求按已过期返回
+	expireBefore := friendRequestExpireBefore()
+	for _, request := range requests {
+		if request.Status == FriendRequestPending && !request.CreateTime.After(expireBefore) {
+			request.Status = FriendRequestExpired
+		}
+	}
+
 	return requests, nil
 }
 
@@ -303,6 +419,7 @@ type FriendRequestResponse struct {
 	CreateTime       string `json:"createTime"`
 	FromUserNickname string `json:"fromUserNickname"`
 	FromUserAvatar   string `json:"fromUserAvatar"`
+	Source           string `json:"source,omitempty"`
 }
 
 func GetFriendRequestsWithUsers(userId int) ([]*FriendRequestResponse, error) {
@@ -327,6 +444,7 @@ func GetFriendRequestsWithUsers(userId int) ([]*FriendRequestResponse, error) {
 			CreateTime:       req.CreateTime.Format("2006-01-02T15:04:05Z07:00"),
 			FromUserNickname: fromUser.Nickname,
 			FromUserAvatar:   "", // 暂时为空，后续可以添加头像功能
+			Source:           req.Source,
 		}
 
 		result = append(result, response)
@@ -357,6 +475,7 @@ func SendFriendRequestNotification(request *ent.FriendRequest) error {
 			"fromUserNickname": fromUser.Nickname,
 			"fromUserAvatar":   "", // 暂时为空
 			"remark":           request.Remark,
+			"source":           request.Source,
 			"createTime":       request.CreateTime.Format("2006-01-02T15:04:05Z07:00"),
 		},
 		"message": fromUser.Nickname + " 请求添加您为好友",
@@ -439,6 +558,13 @@ func GetFriendWithRemark(userId, friendId int) (map[string]interface{}, error) {
 	if err != nil {
 		return nil, errors.New("好友不存在")
 	}
+	blocked, err := IsBlocked(friendId, userId)
+	if err != nil {
+		return nil, err
+	}
+	if blocked {
+		friend = hidePresence(friend)
+	}
 
 	result := map[string]interface{}{
 		"friend":     friend,
@@ -53,6 +53,11 @@ func CreateGroup(groupName string, ownerId int, memberIds []int) (*ent.Group, er
 		return nil, errors.New("创建群组失败")
 	}
 
+	// 创建成员的已读水位
+	if err := initGroupWatermarks(context.TODO(), db, newGroup.ID, memberIds); err != nil {
+		return nil, err
+	}
+
 	// 使所有成员的用户群组缓存失效
 	for _, memberId := range memberIds {
 		_ = InvalidateUserGroupsCache(memberId)
@@ -152,6 +157,11 @@ func AddGroupMembers(groupId int, userIds []int) error {
 		return errors.New("添加群成员失败")
 	}
 
+	// 新成员加入前的消息视为已读
+	if err := initGroupWatermarks(context.TODO(), db, groupId, userIds); err != nil {
+		return err
+	}
+
 	// 使群成员缓存失效
 	_ = InvalidateGroupMembersCache(groupId)
 	
@@ -200,6 +210,9 @@ func RemoveGroupMember(groupId, userId int) error {
 		return errors.New("移除群成员失败")
 	}
 
+	// 删除被移除用户的已读水位，再次加入时重新创建
+	deleteGroupWatermarks(groupId, userId)
+
 	// 使群成员缓存失效
 	_ = InvalidateGroupMembersCache(groupId)
 	
@@ -218,9 +231,9 @@ func GetGroupMembers(groupId int) ([]*ent.User, error) {
 	}
 
 	// 获取群组
-	g, err := db.Group.Get(context.TODO(), groupId)
+	g, err := getGroup(groupId)
 	if err != nil {
-		return nil, errors.New("群组不存在")
+		return nil, err
 	}
 
 	// 查询所有成员信息
@@ -239,11 +252,23 @@ func GetGroupMembers(groupId int) ([]*ent.User, error) {
 	return members, nil
 }
 
+// getGroup 查询群组，群组不存在（已解散）时返回被拒绝的错误，定时消息等不再重试
+func getGroup(groupId int) (*ent.Group, error) {
+	g, err := db.Group.Get(context.TODO(), groupId)
+	if ent.IsNotFound(err) {
+		return nil, rejectMessage(errors.New("群组不存在"))
+	}
+	if err != nil {
+		return nil, errors.New("查询群组失败")
+	}
+	return g, nil
+}
+
 // IsGroupMember 检查用户是否是群成员
 func IsGroupMember(groupId, userId int) (bool, error) {
-	g, err := db.Group.Get(context.TODO(), groupId)
+	g, err := getGroup(groupId)
 	if err != nil {
-		return false, errors.New("群组不存在")
+		return false, err
 	}
 
 	for _, memberId := range g.Members {
@@ -257,14 +282,19 @@ func IsGroupMember(groupId, userId int) (bool, error) {
 
 // IsGroupOwner 检查用户是否是群主
 func IsGroupOwner(groupId, userId int) (bool, error) {
-	g, err := db.Group.Get(context.TODO(), groupId)
+	g, err := getGroup(groupId)
 	if err != nil {
-		return false, errors.New("群组不存在")
+		return false, err
 	}
 
 	return g.OwnerId == userId, nil
 }
 
+// IsGroupAdmin 检查用户是否是群管理员（目前群主即管理员）
+func IsGroupAdmin(groupId, userId int) (bool, error) {
+	return IsGroupOwner(groupId, userId)
+}
+
 // UpdateGroupName 更新群组名称
 func UpdateGroupName(groupId int, groupName string) error {
 	_, err := db.Group.UpdateOneID(groupId).
@@ -278,6 +308,39 @@ func UpdateGroupName(groupId int, groupName string) error {
 	return nil
 }
 
+// SetGroupReadReceipts 开启或关闭群消息的已读回执
+func SetGroupReadReceipts(groupId int, enabled bool) error {
+	_, err := db.Group.UpdateOneID(groupId).
+		SetReadReceiptsEnabled(enabled).
+		Save(context.TODO())
+
+	if err != nil {
+		return errors.New("更新已读回执设置失败")
+	}
+
+	return nil
+}
+
+// MaxRetentionDays 群消息保留天数的上限
+const MaxRetentionDays = 3650
+
+// SetGroupRetention 设置群消息保留天数，0 表示使用全局设置
+func SetGroupRetention(groupId, days int) error {
+	if days < 0 || days > MaxRetentionDays {
+		return errors.New("保留天数必须在 0-3650 之间")
+	}
+
+	_, err := db.Group.UpdateOneID(groupId).
+		SetRetentionDays(days).
+		Save(context.TODO())
+
+	if err != nil {
+		return errors.New("更新消息保留设置失败")
+	}
+
+	return nil
+}
+
 // TransferGroupOwner 转让群主
 func TransferGroupOwner(groupId, newOwnerId int) error {
 	// 检查新群主是否是群成员
@@ -308,6 +371,8 @@ func DeleteGroup(groupId int) error {
 		return errors.New("解散群组失败")
 	}
 
+	deleteGroupWatermarks(groupId)
+
 	return nil
 }
 // GetGroupById 根据ID获取群组信息（别名）
@@ -0,0 +1,467 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"gochat_server/ent"
+	"gochat_server/ent/chatrecord"
+	"gochat_server/ent/groupchatrecord"
+	"gochat_server/ent/predicate"
+	"gochat_server/utils"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// privateConversationId 私聊会话ID，由双方ID按从小到大拼接，与发送方向无关
+func privateConversationId(userId, friendId int) string {
+	if userId > friendId {
+		userId, friendId = friendId, userId
+	}
+	return fmt.Sprintf("%d_%d", userId, friendId)
+}
+
+// HistoryCursor 消息在会话中的位置，按 (createTime, msgId) 排序
+// 新消息的创建时间取自消息ID，顺序与消息ID一致；旧版本的UUID消息按创建时间排序
+type HistoryCursor struct {
+	CreateTime time.Time
+	MsgId      string
+}
+
+// EncodeHistoryCursor 将消息位置编码为不透明的游标字符串
+func EncodeHistoryCursor(createTime time.Time, msgId string) string {
+	raw := fmt.Sprintf("%d:%s", createTime.UnixNano(), msgId)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeHistoryCursor 解析游标字符串，也可以直接使用按时间排序的消息ID作为游标
+func DecodeHistoryCursor(cursor string) (*HistoryCursor, error) {
+	if createTime, _, ok := utils.ParseMessageId(cursor); ok {
+		return &HistoryCursor{CreateTime: createTime, MsgId: strings.ToUpper(cursor)}, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, errors.New("无效的游标")
+	}
+	parts := strings.SplitN(string(raw), ":", 2)
+	if len(parts) != 2 {
+		return nil, errors.New("无效的游标")
+	}
+	nanos, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, errors.New("无效的游标")
+	}
+	if parts[1] == "" {
+		return nil, errors.New("无效的游标")
+	}
+	return &HistoryCursor{CreateTime: time.Unix(0, nanos), MsgId: parts[1]}, nil
+}
+
+// HistoryQuery 游标分页参数，Before、After、Around、AroundMsgId 只能指定一个
+// 都为空时返回最新的消息
+type HistoryQuery struct {
+	Before      string // 返回早于该位置的消息
+	After       string // 返回晚于该位置的消息
+	Around      string // 返回该位置前后的消息（包括该位置）
+	AroundMsgId string // 跳转到指定消息，返回该消息前后的消息
+	Limit       int
+}
+
+// HistoryPage 游标分页结果，消息按时间从新到旧排列
+// NextCursor 用于继续加载更早的消息，PrevCursor 用于加载更新的消息，没有更多时为空
+type HistoryPage struct {
+	Messages   []map[string]interface{} `json:"messages"`
+	NextCursor string                   `json:"nextCursor"`
+	PrevCursor string                   `json:"prevCursor"`
+}
+
+// historyRow 会话中的一条记录
+type historyRow struct {
+	cursor  HistoryCursor
+	message map[string]interface{}
+}
+
+// historySource 一个会话的聊天记录查询，私聊和群聊分别实现
+type historySource interface {
+	// older 查询早于（inclusive 时包括）游标位置的记录，按时间从新到旧，cursor 为 nil 时从最新开始
+	older(ctx context.Context, cursor *HistoryCursor, inclusive bool, limit int) ([]historyRow, error)
+	// newer 查询晚于游标位置的记录，按时间从旧到新
+	newer(ctx context.Context, cursor *HistoryCursor, limit int) ([]historyRow, error)
+	// locate 查询会话中指定消息的位置
+	locate(ctx context.Context, msgId string) (*HistoryCursor, error)
+	// count 统计会话中可见的记录数
+	count(ctx context.Context) (int, error)
+}
+
+// privateHistory 私聊会话的聊天记录
+type privateHistory struct {
+	conversationId string
+	visibility     *conversationVisibility
+}
+
+func (h privateHistory) query(cursor *HistoryCursor, older, inclusive bool) *ent.ChatRecordQuery {
+	query := db.ChatRecord.Query().
+		Where(chatrecord.ConversationId(h.conversationId)).
+		Where(h.visibility.chatRecordPredicates()...)
+	if cursor == nil {
+		return query
+	}
+
+	var timeCmp func(time.Time) predicate.ChatRecord
+	var idCmp func(string) predicate.ChatRecord
+	switch {
+	case older && inclusive:
+		timeCmp, idCmp = chatrecord.CreateTimeLT, chatrecord.MsgIdLTE
+	case older:
+		timeCmp, idCmp = chatrecord.CreateTimeLT, chatrecord.MsgIdLT
+	default:
+		timeCmp, idCmp = chatrecord.CreateTimeGT, chatrecord.MsgIdGT
+	}
+	return query.Where(chatrecord.Or(
+		timeCmp(cursor.CreateTime),
+		chatrecord.And(chatrecord.CreateTime(cursor.CreateTime), idCmp(cursor.MsgId)),
+	))
+}
+
+func (h privateHistory) rows(records []*ent.ChatRecord) []historyRow {
+	rows := make([]historyRow, 0, len(records))
+	for _, record := range records {
+		rows = append(rows, historyRow{
+			cursor:  HistoryCursor{CreateTime: record.CreateTime, MsgId: record.MsgId},
+			message: privateHistoryMessage(record),
+		})
+	}
+	return rows
+}
+
+func (h privateHistory) older(ctx context.Context, cursor *HistoryCursor, inclusive bool, limit int) ([]historyRow, error) {
+	records, err := h.query(cursor, true, inclusive).
+		Order(ent.Desc(chatrecord.FieldCreateTime), ent.Desc(chatrecord.FieldMsgId)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, errors.New("查询聊天记录失败")
+	}
+	return h.rows(records), nil
+}
+
+func (h privateHistory) newer(ctx context.Context, cursor *HistoryCursor, limit int) ([]historyRow, error) {
+	records, err := h.query(cursor, false, false).
+		Order(ent.Asc(chatrecord.FieldCreateTime), ent.Asc(chatrecord.FieldMsgId)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, errors.New("查询聊天记录失败")
+	}
+	return h.rows(records), nil
+}
+
+func (h privateHistory) count(ctx context.Context) (int, error) {
+	total, err := h.query(nil, false, false).Count(ctx)
+	if err != nil {
+		return 0, errors.New("统计聊天记录失败")
+	}
+	return total, nil
+}
+
+func (h privateHistory) locate(ctx context.Context, msgId string) (*HistoryCursor, error) {
+	record, err := db.ChatRecord.Query().
+		Where(
+			chatrecord.MsgId(msgId),
+			chatrecord.ConversationId(h.conversationId),
+		).
+		Where(h.visibility.chatRecordPredicates()...).
+		First(ctx)
+	if err != nil {
+		return nil, errors.New("消息不在该会话中")
+	}
+	return &HistoryCursor{CreateTime: record.CreateTime, MsgId: record.MsgId}, nil
+}
+
+// groupHistory 群聊会话的聊天记录
+type groupHistory struct {
+	groupId    string
+	visibility *conversationVisibility
+}
+
+func (h groupHistory) query(cursor *HistoryCursor, older, inclusive bool) *ent.GroupChatRecordQuery {
+	query := db.GroupChatRecord.Query().
+		Where(groupchatrecord.GroupId(h.groupId)).
+		Where(h.visibility.groupChatRecordPredicates()...)
+	if cursor == nil {
+		return query
+	}
+
+	var timeCmp func(time.Time) predicate.GroupChatRecord
+	var idCmp func(string) predicate.GroupChatRecord
+	switch {
+	case older && inclusive:
+		timeCmp, idCmp = groupchatrecord.CreateTimeLT, groupchatrecord.MsgIdLTE
+	case older:
+		timeCmp, idCmp = groupchatrecord.CreateTimeLT, groupchatrecord.MsgIdLT
+	default:
+		timeCmp, idCmp = groupchatrecord.CreateTimeGT, groupchatrecord.MsgIdGT
+	}
+	return query.Where(groupchatrecord.Or(
+		timeCmp(cursor.CreateTime),
+		groupchatrecord.And(groupchatrecord.CreateTime(cursor.CreateTime), idCmp(cursor.MsgId)),
+	))
+}
+
+func (h groupHistory) rows(records []*ent.GroupChatRecord) []historyRow {
+	rows := make([]historyRow, 0, len(records))
+	for _, record := range records {
+		rows = append(rows, historyRow{
+			cursor:  HistoryCursor{CreateTime: record.CreateTime, MsgId: record.MsgId},
+			message: groupHistoryMessage(record),
+		})
+	}
+	return rows
+}
+
+func (h groupHistory) older(ctx context.Context, cursor *HistoryCursor, inclusive bool, limit int) ([]historyRow, error) {
+	records, err := h.query(cursor, true, inclusive).
+		Order(ent.Desc(groupchatrecord.FieldCreateTime), ent.Desc(groupchatrecord.FieldMsgId)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, errors.New("查询群聊记录失败")
+	}
+	return h.rows(records), nil
+}
+
+func (h groupHistory) newer(ctx context.Context, cursor *HistoryCursor, limit int) ([]historyRow, error) {
+	records, err := h.query(cursor, false, false).
+		Order(ent.Asc(groupchatrecord.FieldCreateTime), ent.Asc(groupchatrecord.FieldMsgId)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, errors.New("查询群聊记录失败")
+	}
+	return h.rows(records), nil
+}
+
+func (h groupHistory) count(ctx context.Context) (int, error) {
+	total, err := h.query(nil, false, false).Count(ctx)
+	if err != nil {
+		return 0, errors.New("统计聊天记录失败")
+	}
+	return total, nil
+}
+
+func (h groupHistory) locate(ctx context.Context, msgId string) (*HistoryCursor, error) {
+	record, err := db.GroupChatRecord.Query().
+		Where(
+			groupchatrecord.MsgId(msgId),
+			groupchatrecord.GroupId(h.groupId),
+		).
+		Where(h.visibility.groupChatRecordPredicates()...).
+		First(ctx)
+	if err != nil {
+		return nil, errors.New("消息不在该会话中")
+	}
+	return &HistoryCursor{CreateTime: record.CreateTime, MsgId: record.MsgId}, nil
+}
+
+// privateHistoryMessage 组装私聊历史消息
+func privateHistoryMessage(record *ent.ChatRecord) map[string]interface{} {
+	message := map[string]interface{}{
+		"msgId":      record.MsgId,
+		"fromUserId": record.FromUserId,
+		"toUserId":   record.ToUserId,
+		"msgType":    record.MsgType,
+		"createTime": record.CreateTime,
+	}
+
+	// 根据消息类型获取消息内容
+	content, err := getMessageContent(record.MsgId)
+	if err == nil {
+		message["content"] = content
+	}
+	return message
+}
+
+// groupHistoryMessage 组装群聊历史消息
+func groupHistoryMessage(record *ent.GroupChatRecord) map[string]interface{} {
+	fromUserId, _ := strconv.Atoi(record.FromUserId)
+	msgType, _ := strconv.Atoi(record.MsgType)
+	groupId, _ := strconv.Atoi(record.GroupId)
+
+	message := map[string]interface{}{
+		"msgId":      record.MsgId,
+		"fromUserId": fromUserId,
+		"groupId":    groupId,
+		"msgType":    msgType,
+		"createTime": record.CreateTime,
+	}
+
+	// 根据消息类型获取消息内容
+	content, err := getMessageContent(record.MsgId)
+	if err == nil {
+		message["content"] = content
+	}
+	return message
+}
+
+// GetChatHistoryByCursor 按游标分页获取私聊历史记录
+func GetChatHistoryByCursor(userId, friendId int, q HistoryQuery) (*HistoryPage, error) {
+	isFriend, err := IsFriend(userId, friendId)
+	if err != nil {
+		return nil, err
+	}
+	if !isFriend {
+		return nil, errors.New("不是好友关系")
+	}
+
+	visibility, err := getConversationVisibility(userId, conversationKey{targetId: friendId})
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := loadHistoryPage(privateHistory{
+		conversationId: privateConversationId(userId, friendId),
+		visibility:     visibility,
+	}, q)
+	if err != nil {
+		return nil, err
+	}
+
+	attachReactions(page.Messages, userId)
+	attachForwardInfo(page.Messages)
+	attachPayloads(page.Messages)
+	return page, nil
+}
+
+// GetGroupChatHistoryByCursor 按游标分页获取群聊历史记录
+func GetGroupChatHistoryByCursor(userId, groupId int, q HistoryQuery) (*HistoryPage, error) {
+	isMember, err := IsGroupMember(groupId, userId)
+	if err != nil {
+		return nil, err
+	}
+	if !isMember {
+		return nil, errors.New("不是群成员")
+	}
+
+	visibility, err := getConversationVisibility(userId, conversationKey{isGroup: true, targetId: groupId})
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := loadHistoryPage(groupHistory{
+		groupId:    strconv.Itoa(groupId),
+		visibility: visibility,
+	}, q)
+	if err != nil {
+		return nil, err
+	}
+
+	attachReactions(page.Messages, userId)
+	attachMentions(page.Messages)
+	attachForwardInfo(page.Messages)
+	attachPayloads(page.Messages)
+	attachBlockedFlags(page.Messages, userId)
+	return page, nil
+}
+
+// loadHistoryPage 按游标查询一页记录，多查一条用于判断是否还有更多
+func loadHistoryPage(source historySource, q HistoryQuery) (*HistoryPage, error) {
+	ctx := context.TODO()
+	limit := q.Limit
+
+	var older, newer []historyRow
+	var moreOlder, moreNewer bool
+
+	switch {
+	case q.AroundMsgId != "" || q.Around != "":
+		var cursor *HistoryCursor
+		var err error
+		if q.AroundMsgId != "" {
+			cursor, err = source.locate(ctx, q.AroundMsgId)
+		} else {
+			cursor, err = DecodeHistoryCursor(q.Around)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// 目标位置及更早的消息占一半多，更新的消息占其余
+		newerLimit := limit / 2
+		olderLimit := limit - newerLimit
+		if older, err = source.older(ctx, cursor, true, olderLimit+1); err != nil {
+			return nil, err
+		}
+		if newer, err = source.newer(ctx, cursor, newerLimit+1); err != nil {
+			return nil, err
+		}
+		moreOlder, older = trimHistoryRows(older, olderLimit)
+		moreNewer, newer = trimHistoryRows(newer, newerLimit)
+
+	case q.After != "":
+		cursor, err := DecodeHistoryCursor(q.After)
+		if err != nil {
+			return nil, err
+		}
+		if newer, err = source.newer(ctx, cursor, limit+1); err != nil {
+			return nil, err
+		}
+		moreNewer, newer = trimHistoryRows(newer, limit)
+		// 游标位置本身及更早的消息一定存在
+		moreOlder = true
+
+	default:
+		var cursor *HistoryCursor
+		if q.Before != "" {
+			var err error
+			if cursor, err = DecodeHistoryCursor(q.Before); err != nil {
+				return nil, err
+			}
+		}
+		var err error
+		if older, err = source.older(ctx, cursor, false, limit+1); err != nil {
+			return nil, err
+		}
+		moreOlder, older = trimHistoryRows(older, limit)
+		moreNewer = cursor != nil
+	}
+
+	// 合并为从新到旧的顺序
+	rows := make([]historyRow, 0, len(newer)+len(older))
+	for i := len(newer) - 1; i >= 0; i-- {
+		rows = append(rows, newer[i])
+	}
+	rows = append(rows, older...)
+
+	page := &HistoryPage{Messages: make([]map[string]interface{}, 0, len(rows))}
+	for _, row := range rows {
+		row.message["cursor"] = EncodeHistoryCursor(row.cursor.CreateTime, row.cursor.MsgId)
+		page.Messages = append(page.Messages, row.message)
+	}
+	if len(rows) > 0 {
+		if moreOlder {
+			page.NextCursor = rows[len(rows)-1].message["cursor"].(string)
+		}
+		if moreNewer {
+			page.PrevCursor = rows[0].message["cursor"].(string)
+		}
+	} else {
+		// 没有结果时沿用请求的游标，客户端可以从同一位置继续加载
+		if moreOlder {
+			page.NextCursor = q.After
+		}
+		if moreNewer {
+			page.PrevCursor = q.Before
+		}
+	}
+	return page, nil
+}
+
+// trimHistoryRows 截断多查的一条记录，返回是否还有更多
+func trimHistoryRows(rows []historyRow, limit int) (bool, []historyRow) {
+	if len(rows) > limit {
+		return true, rows[:limit]
+	}
+	return false, rows
+}
@@ -0,0 +1,685 @@
+package services
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	authmanager "gochat_server/auth_manager"
+	"gochat_server/dto"
+	"gochat_server/ent"
+	"gochat_server/ent/friendrelationship"
+	"gochat_server/ent/group"
+	"gochat_server/ent/groupwatermark"
+	"gochat_server/ent/message"
+	"gochat_server/ent/user"
+	"io"
+	"os"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// 导入记录类型，NDJSON 格式中每条记录的 type 字段
+const (
+	ImportTypeUser       = "user"
+	ImportTypeFriendship = "friendship"
+	ImportTypeGroup      = "group"
+	ImportTypeMessage    = "message"
+)
+
+// importMaxRejections 报告中最多列出的被拒绝记录数，超出后只计数
+const importMaxRejections = 10000
+
+// ImportUser 导入的用户，按用户名去重
+type ImportUser struct {
+	Username     string `json:"username"`
+	Nickname     string `json:"nickname"`
+	Password     string `json:"password,omitempty"`     // 初始密码
+	PasswordHash string `json:"passwordHash,omitempty"` // bcrypt 哈希，与 password 二选一
+	Sex          int    `json:"sex"`
+	Avatar       string `json:"avatar,omitempty"`
+	Signature    string `json:"signature,omitempty"`
+	Region       string `json:"region,omitempty"`
+}
+
+// ImportFriendship 导入的好友关系，备注和分组属于 username 一方，反向关系不存在时同时创建
+type ImportFriendship struct {
+	Username       string `json:"username"`
+	FriendUsername string `json:"friendUsername"`
+	Remark         string `json:"remark,omitempty"`
+	Category       string `json:"category,omitempty"`
+}
+
+// ImportGroup 导入的群组，按 groupId 去重
+type ImportGroup struct {
+	GroupId         string    `json:"groupId"`
+	GroupName       string    `json:"groupName"`
+	OwnerUsername   string    `json:"ownerUsername"`
+	MemberUsernames []string  `json:"memberUsernames"`
+	CreateTime      time.Time `json:"createTime"`
+}
+
+// ImportMessage 导入的历史消息，按 msgId 去重，字段与导出文件中的消息相同
+// toUsername 和 groupId 二选一；导入导出文件时由文件中的会话信息补充
+type ImportMessage struct {
+	MsgId        string            `json:"msgId,omitempty"` // 为空时根据消息内容生成，重复导入时仍能去重
+	FromUsername string            `json:"fromUsername"`
+	ToUsername   string            `json:"toUsername,omitempty"`
+	GroupId      string            `json:"groupId,omitempty"`
+	MsgType      int               `json:"msgType"`
+	Content      string            `json:"content,omitempty"` // 与发送消息接口的 content 相同，payload 为空时使用
+	Payload      json.RawMessage   `json:"payload,omitempty"` // 消息体，与导出文件中的 payload 相同
+	ReplyTo      *dto.MessageReply `json:"replyTo,omitempty"` // 只使用其中的 msgId，被回复的消息需已导入
+	IsRevoked    bool              `json:"isRevoked,omitempty"`
+	IsPurged     bool              `json:"isPurged,omitempty"`
+	CreateTime   time.Time         `json:"createTime"`
+}
+
+// ImportBundle JSON 格式的导入文件
+type ImportBundle struct {
+	Users       []*ImportUser       `json:"users"`
+	Friendships []*ImportFriendship `json:"friendships"`
+	Groups      []*ImportGroup      `json:"groups"`
+	Messages    []*ImportMessage    `json:"messages"`
+}
+
+// importExportFile 聊天记录导出的 JSON 文件，可以直接导入
+type importExportFile struct {
+	Conversation *ExportConversation `json:"conversation"`
+	Messages     []*ImportMessage    `json:"messages"`
+}
+
+// ImportCounts 一种记录的导入结果
+type ImportCounts struct {
+	Imported   int `json:"imported"`
+	Duplicated int `json:"duplicated"` // 已存在，跳过
+	Rejected   int `json:"rejected"`
+}
+
+// ImportRejection 被拒绝的记录
+type ImportRejection struct {
+	Type   string `json:"type"`
+	Record string `json:"record"` // 记录位置，如 users[3] 或 NDJSON 中的第几条记录
+	Key    string `json:"key"`    // 用户名、groupId 或 msgId
+	Reason string `json:"reason"`
+}
+
+// ImportReport 导入报告
+type ImportReport struct {
+	Users       ImportCounts       `json:"users"`
+	Friendships ImportCounts       `json:"friendships"`
+	Groups      ImportCounts       `json:"groups"`
+	Messages    ImportCounts       `json:"messages"`
+	Rejected    []*ImportRejection `json:"rejected"`
+	Truncated   bool               `json:"truncated,omitempty"` // 被拒绝的记录过多，列表不完整
+}
+
+// errImportDuplicated 记录已存在
+var errImportDuplicated = errors.New("记录已存在")
+
+// importer 一次导入的状态
+type importer struct {
+	ctx    context.Context
+	report *ImportReport
+
+	users  map[string]int // 用户名 -> 用户ID
+	groups map[string]int // groupId -> 群组ID
+
+	// 导入了消息的会话，结束时使缓存失效；群聊记录导入前的最新序号，用于推进已读水位
+	privatePairs map[[2]int]bool
+	groupSeqs    map[int]int
+}
+
+// ImportData 导入用户、好友关系、群组和历史消息
+// 支持三种格式：包含 users/friendships/groups/messages 数组的 JSON 文件、每行一条带 type 字段记录的 NDJSON，
+// 以及聊天记录导出的 JSON 文件。重复导入时已存在的记录会被跳过。
+// 文件格式错误时返回已处理部分的报告和错误
+func ImportData(r io.Reader) (*ImportReport, error) {
+	im := &importer{
+		ctx:          context.TODO(),
+		report:       &ImportReport{Rejected: []*ImportRejection{}},
+		users:        make(map[string]int),
+		groups:       make(map[string]int),
+		privatePairs: make(map[[2]int]bool),
+		groupSeqs:    make(map[int]int),
+	}
+	defer im.finish()
+
+	decoder := json.NewDecoder(bufio.NewReader(r))
+	var first json.RawMessage
+	if err := decoder.Decode(&first); err != nil {
+		return im.report, errors.New("无效的导入文件")
+	}
+
+	var probe map[string]json.RawMessage
+	if err := json.Unmarshal(first, &probe); err != nil {
+		return im.report, errors.New("无效的导入文件")
+	}
+
+	switch {
+	case probe["type"] != nil:
+		return im.report, im.importRecords(first, decoder)
+	case probe["conversation"] != nil:
+		if decoder.More() {
+			return im.report, errors.New("无效的导入文件")
+		}
+		var file importExportFile
+		if err := json.Unmarshal(first, &file); err != nil {
+			return im.report, errors.New("无效的导出文件")
+		}
+		im.importExportFile(&file)
+		return im.report, nil
+	default:
+		if decoder.More() {
+			return im.report, errors.New("无效的导入文件")
+		}
+		var bundle ImportBundle
+		if err := json.Unmarshal(first, &bundle); err != nil {
+			return im.report, errors.New("无效的导入文件: " + err.Error())
+		}
+		im.importBundle(&bundle)
+		return im.report, nil
+	}
+}
+
+// importBundle 按用户、好友关系、群组、消息的顺序导入
+func (im *importer) importBundle(bundle *ImportBundle) {
+	for i, u := range bundle.Users {
+		im.importUser(fmt.Sprintf("users[%d]", i), u)
+	}
+	for i, f := range bundle.Friendships {
+		im.importFriendship(fmt.Sprintf("friendships[%d]", i), f)
+	}
+	for i, g := range bundle.Groups {
+		im.importGroup(fmt.Sprintf("groups[%d]", i), g)
+	}
+	for i, m := range bundle.Messages {
+		im.importMessage(fmt.Sprintf("messages[%d]", i), m)
+	}
+}
+
+// importRecords 逐条导入 NDJSON 记录，记录引用的用户和群组需要在之前的记录中或已存在
+func (im *importer) importRecords(first json.RawMessage, decoder *json.Decoder) error {
+	raw := first
+	for index := 1; ; index++ {
+		location := fmt.Sprintf("#%d", index)
+
+		var header struct {
+			Type string `json:"type"`
+		}
+		if err := json.Unmarshal(raw, &header); err != nil {
+			im.reject("", location, "", "无效的记录")
+		} else {
+			im.importRecord(location, header.Type, raw)
+		}
+
+		if !decoder.More() {
+			return nil
+		}
+		raw = nil
+		if err := decoder.Decode(&raw); err != nil {
+			return fmt.Errorf("第 %d 条记录格式错误，导入已停止", index+1)
+		}
+	}
+}
+
+// importRecord 按类型导入一条 NDJSON 记录
+func (im *importer) importRecord(location, recordType string, raw json.RawMessage) {
+	var err error
+	switch recordType {
+	case ImportTypeUser:
+		var u ImportUser
+		if err = json.Unmarshal(raw, &u); err == nil {
+			im.importUser(location, &u)
+		}
+	case ImportTypeFriendship:
+		var f ImportFriendship
+		if err = json.Unmarshal(raw, &f); err == nil {
+			im.importFriendship(location, &f)
+		}
+	case ImportTypeGroup:
+		var g ImportGroup
+		if err = json.Unmarshal(raw, &g); err == nil {
+			im.importGroup(location, &g)
+		}
+	case ImportTypeMessage:
+		var m ImportMessage
+		if err = json.Unmarshal(raw, &m); err == nil {
+			im.importMessage(location, &m)
+		}
+	default:
+		im.reject(recordType, location, "", "未知的记录类型")
+		return
+	}
+	if err != nil {
+		im.reject(recordType, location, "", "无效的记录: "+err.Error())
+	}
+}
+
+// importExportFile 导入聊天记录导出文件，会话双方或群组需已存在
+func (im *importer) importExportFile(file *importExportFile) {
+	conversation := file.Conversation
+	for i, m := range file.Messages {
+		switch conversation.Type {
+		case "group":
+			m.GroupId = conversation.GroupId
+		default:
+			m.ToUsername = conversation.TargetUsername
+			if m.FromUsername == conversation.TargetUsername {
+				m.ToUsername = conversation.ExportUsername
+			}
+		}
+		im.importMessage(fmt.Sprintf("messages[%d]", i), m)
+	}
+}
+
+// reject 记录被拒绝的记录
+func (im *importer) reject(recordType, location, key, reason string) {
+	if len(im.report.Rejected) >= importMaxRejections {
+		im.report.Truncated = true
+		return
+	}
+	im.report.Rejected = append(im.report.Rejected, &ImportRejection{
+		Type:   recordType,
+		Record: location,
+		Key:    key,
+		Reason: reason,
+	})
+}
+
+// record 统计一条记录的导入结果
+func (im *importer) record(counts *ImportCounts, recordType, location, key string, err error) {
+	switch {
+	case err == nil:
+		counts.Imported++
+	case errors.Is(err, errImportDuplicated):
+		counts.Duplicated++
+	default:
+		counts.Rejected++
+		im.reject(recordType, location, key, err.Error())
+	}
+}
+
+// lookupUser 根据用户名查询用户ID
+func (im *importer) lookupUser(username string) (int, error) {
+	if username == "" {
+		return 0, errors.New("缺少用户名")
+	}
+	if id, ok := im.users[username]; ok {
+		return id, nil
+	}
+	u, err := db.User.Query().
+		Where(user.Username(username)).
+		Only(im.ctx)
+	if err != nil {
+		return 0, fmt.Errorf("用户不存在: %s", username)
+	}
+	im.users[username] = u.ID
+	return u.ID, nil
+}
+
+// lookupGroup 根据 groupId 查询群组ID
+func (im *importer) lookupGroup(groupId string) (int, error) {
+	if id, ok := im.groups[groupId]; ok {
+		return id, nil
+	}
+	g, err := db.Group.Query().
+		Where(group.GroupId(groupId)).
+		Only(im.ctx)
+	if err != nil {
+		return 0, fmt.Errorf("群组不存在: %s", groupId)
+	}
+	im.groups[groupId] = g.ID
+	return g.ID, nil
+}
+
+func (im *importer) importUser(location string, u *ImportUser) {
+	im.record(&im.report.Users, ImportTypeUser, location, u.Username, im.createUser(u))
+}
+
+// createUser 创建用户，只提供初始密码时加密保存
+func (im *importer) createUser(u *ImportUser) error {
+	if u.Username == "" {
+		return errors.New("缺少用户名")
+	}
+	if _, err := im.lookupUser(u.Username); err == nil {
+		return errImportDuplicated
+	}
+
+	nickname := u.Nickname
+	if nickname == "" {
+		nickname = u.Username
+	}
+
+	password := u.PasswordHash
+	switch {
+	case password != "":
+		if _, err := bcrypt.Cost([]byte(password)); err != nil {
+			return errors.New("passwordHash 不是有效的 bcrypt 哈希")
+		}
+	case u.Password != "":
+		hashed, err := authmanager.HashPassword(u.Password)
+		if err != nil {
+			return errors.New("密码加密失败")
+		}
+		password = hashed
+	default:
+		return errors.New("缺少密码")
+	}
+
+	created, err := db.User.Create().
+		SetUsername(u.Username).
+		SetPassword(password).
+		SetNickname(nickname).
+		SetSex(u.Sex).
+		SetAvatar(u.Avatar).
+		SetSignature(u.Signature).
+		SetRegion(u.Region).
+		Save(im.ctx)
+	if err != nil {
+		return errors.New("创建用户失败")
+	}
+	im.users[u.Username] = created.ID
+	return nil
+}
+
+func (im *importer) importFriendship(location string, f *ImportFriendship) {
+	key := f.Username + "->" + f.FriendUsername
+	im.record(&im.report.Friendships, ImportTypeFriendship, location, key, im.createFriendship(f))
+}
+
+// createFriendship 创建双向好友关系，反向关系已存在时保留其备注和分组
+func (im *importer) createFriendship(f *ImportFriendship) error {
+	userId, err := im.lookupUser(f.Username)
+	if err != nil {
+		return err
+	}
+	friendId, err := im.lookupUser(f.FriendUsername)
+	if err != nil {
+		return err
+	}
+	if userId == friendId {
+		return errors.New("不能添加自己为好友")
+	}
+
+	exists, err := db.FriendRelationship.Query().
+		Where(
+			friendrelationship.UserId(userId),
+			friendrelationship.FriendId(friendId),
+		).
+		Exist(im.ctx)
+	if err != nil {
+		return errors.New("查询好友关系失败")
+	}
+	if exists {
+		return errImportDuplicated
+	}
+
+	err = withTx(im.ctx, func(tx *ent.Tx) error {
+		if err := tx.FriendRelationship.Create().
+			SetUserId(userId).
+			SetFriendId(friendId).
+			SetRemarkName(f.Remark).
+			SetCategory(f.Category).
+			Exec(im.ctx); err != nil {
+			return errors.New("创建好友关系失败")
+		}
+
+		reverse, err := tx.FriendRelationship.Query().
+			Where(
+				friendrelationship.UserId(friendId),
+				friendrelationship.FriendId(userId),
+			).
+			Exist(im.ctx)
+		if err != nil {
+			return errors.New("查询好友关系失败")
+		}
+		if reverse {
+			return nil
+		}
+		if err := tx.FriendRelationship.Create().
+			SetUserId(friendId).
+			SetFriendId(userId).
+			Exec(im.ctx); err != nil {
+			return errors.New("创建好友关系失败")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	_ = InvalidateFriendListCache(userId)
+	_ = InvalidateFriendListCache(friendId)
+	return nil
+}
+
+func (im *importer) importGroup(location string, g *ImportGroup) {
+	im.record(&im.report.Groups, ImportTypeGroup, location, g.GroupId, im.createGroup(g))
+}
+
+// createGroup 创建群组，保留原来的 groupId 和创建时间
+func (im *importer) createGroup(g *ImportGroup) error {
+	if g.GroupId == "" {
+		return errors.New("缺少 groupId")
+	}
+	if g.GroupName == "" {
+		return errors.New("缺少群组名称")
+	}
+	if _, err := im.lookupGroup(g.GroupId); err == nil {
+		return errImportDuplicated
+	}
+
+	ownerId, err := im.lookupUser(g.OwnerUsername)
+	if err != nil {
+		return err
+	}
+	memberIds := []int{ownerId}
+	seen := map[int]bool{ownerId: true}
+	for _, username := range g.MemberUsernames {
+		memberId, err := im.lookupUser(username)
+		if err != nil {
+			return err
+		}
+		if !seen[memberId] {
+			seen[memberId] = true
+			memberIds = append(memberIds, memberId)
+		}
+	}
+
+	createTime := g.CreateTime
+	if createTime.IsZero() {
+		createTime = time.Now()
+	}
+
+	var groupId int
+	err = withTx(im.ctx, func(tx *ent.Tx) error {
+		created, err := tx.Group.Create().
+			SetGroupId(g.GroupId).
+			SetGroupName(g.GroupName).
+			SetOwnerId(ownerId).
+			SetCreateUserId(ownerId).
+			SetMembers(memberIds).
+			SetCreateTime(createTime).
+			Save(im.ctx)
+		if err != nil {
+			return errors.New("创建群组失败")
+		}
+		groupId = created.ID
+		return initGroupWatermarks(im.ctx, tx.Client(), created.ID, memberIds)
+	})
+	if err != nil {
+		return err
+	}
+
+	im.groups[g.GroupId] = groupId
+	for _, memberId := range memberIds {
+		_ = InvalidateUserGroupsCache(memberId)
+	}
+	return nil
+}
+
+func (im *importer) importMessage(location string, m *ImportMessage) {
+	msgId, err := im.createMessage(m)
+	im.record(&im.report.Messages, ImportTypeMessage, location, msgId, err)
+}
+
+// createMessage 保存历史消息，保留原来的发送时间，不推送也不计入未读
+func (im *importer) createMessage(m *ImportMessage) (string, error) {
+	if m.CreateTime.IsZero() {
+		return m.MsgId, errors.New("缺少发送时间")
+	}
+	if m.IsRevoked || m.IsPurged {
+		return m.MsgId, errors.New("已撤回或已过期的消息没有内容，不导入")
+	}
+	if (m.ToUsername == "") == (m.GroupId == "") {
+		return m.MsgId, errors.New("toUsername 和 groupId 必须指定一个")
+	}
+
+	fromUserId, err := im.lookupUser(m.FromUsername)
+	if err != nil {
+		return m.MsgId, err
+	}
+	var toUserId int
+	var groupId *int
+	if m.GroupId != "" {
+		id, err := im.lookupGroup(m.GroupId)
+		if err != nil {
+			return m.MsgId, err
+		}
+		groupId = &id
+	} else if toUserId, err = im.lookupUser(m.ToUsername); err != nil {
+		return m.MsgId, err
+	}
+
+	msgId := m.MsgId
+	if msgId == "" {
+		msgId = importMessageId(m)
+	}
+	exists, err := db.Message.Query().
+		Where(message.MsgId(msgId)).
+		Exist(im.ctx)
+	if err != nil {
+		return msgId, errors.New("查询消息失败")
+	}
+	if exists {
+		return msgId, errImportDuplicated
+	}
+
+	codec, err := getMessageCodec(m.MsgType)
+	if err != nil {
+		return msgId, err
+	}
+	if codec.Internal {
+		return msgId, errors.New("不支持导入该类型的消息")
+	}
+	var body interface{}
+	if len(m.Payload) > 0 {
+		body = codec.NewBody()
+		if err := json.Unmarshal(m.Payload, body); err != nil {
+			return msgId, errors.New("无效的消息体")
+		}
+	} else if body, err = codec.Parse(&codecContext{FromUserId: fromUserId}, m.Content); err != nil {
+		return msgId, err
+	}
+
+	// 被回复的消息不在同一会话或还未导入时不保留回复引用
+	var replyTo *dto.MessageReply
+	if m.ReplyTo != nil && m.ReplyTo.MsgId != "" {
+		replyTo, _ = buildMessageReply(m.ReplyTo.MsgId, fromUserId, toUserId, groupId)
+	}
+
+	encodedBody, err := encodeMessageBody(m.MsgType, body, nil, replyTo)
+	if err != nil {
+		return msgId, err
+	}
+	preview := codec.Preview(body)
+	if preview == "" {
+		preview = " "
+	}
+
+	if groupId != nil {
+		if err := im.trackGroup(*groupId); err != nil {
+			return msgId, err
+		}
+	}
+	err = withTx(im.ctx, func(tx *ent.Tx) error {
+		return saveMessageRecords(im.ctx, tx, msgId, fromUserId, toUserId, groupId, m.MsgType, preview, encodedBody, m.CreateTime, nil)
+	})
+	if err != nil {
+		return msgId, err
+	}
+
+	if groupId == nil {
+		im.privatePairs[[2]int{fromUserId, toUserId}] = true
+	}
+	if text, ok := body.(*dto.TextBody); ok {
+		indexTextMessage(msgId, fromUserId, toUserId, groupId, text.Text, m.CreateTime)
+	}
+	return msgId, nil
+}
+
+// importMessageId 为没有消息ID的记录生成ID，相同的记录生成相同的ID
+func importMessageId(m *ImportMessage) string {
+	hash := sha1.New()
+	fmt.Fprintf(hash, "%s\x00%s\x00%s\x00%d\x00%s\x00%s\x00%s",
+		m.FromUsername, m.ToUsername, m.GroupId, m.MsgType, m.CreateTime.UTC().Format(time.RFC3339Nano), m.Content, m.Payload)
+	return "imp-" + hex.EncodeToString(hash.Sum(nil))[:24]
+}
+
+// trackGroup 记录群聊导入前的最新序号
+func (im *importer) trackGroup(groupId int) error {
+	if _, ok := im.groupSeqs[groupId]; ok {
+		return nil
+	}
+	seq, err := latestGroupSeq(im.ctx, db, groupId)
+	if err != nil {
+		return err
+	}
+	im.groupSeqs[groupId] = seq
+	return nil
+}
+
+// finish 导入结束后使缓存失效，并推进群成员的水位
+// 导入的是历史消息，导入前已读完的成员不应看到新的未读消息
+func (im *importer) finish() {
+	for pair := range im.privatePairs {
+		_ = InvalidateChatHistoryCache(pair[0], pair[1])
+	}
+
+	for groupId, before := range im.groupSeqs {
+		latest, err := latestGroupSeq(im.ctx, db, groupId)
+		if err != nil || latest <= before {
+			continue
+		}
+		_, _ = db.GroupWatermark.Update().
+			Where(
+				groupwatermark.GroupId(groupId),
+				groupwatermark.ReadSeqGTE(before),
+			).
+			SetReadSeq(latest).
+			Save(im.ctx)
+		_, _ = db.GroupWatermark.Update().
+			Where(
+				groupwatermark.GroupId(groupId),
+				groupwatermark.DeliveredSeqGTE(before),
+			).
+			SetDeliveredSeq(latest).
+			Save(im.ctx)
+		_ = InvalidateGroupChatHistoryCache(groupId)
+	}
+}
+
+// ImportDataFromFile 从文件导入，用于命令行
+func ImportDataFromFile(path string) (*ImportReport, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("打开导入文件失败: %v", err)
+	}
+	defer file.Close()
+	return ImportData(file)
+}
@@ -0,0 +1,42 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"gochat_server/dto"
+	"math"
+	"strings"
+	"unicode/utf8"
+)
+
+// 位置消息文本长度限制（字符）
+const (
+	MaxLocationNameLength    = 100
+	MaxLocationAddressLength = 255
+)
+
+// parseLocationContent 解析并校验位置消息内容
+func parseLocationContent(content string) (*dto.LocationContent, error) {
+	var location dto.LocationContent
+	if err := json.Unmarshal([]byte(content), &location); err != nil {
+		return nil, rejectMessage(errors.New("无效的位置消息内容"))
+	}
+
+	if math.IsNaN(location.Latitude) || location.Latitude < -90 || location.Latitude > 90 {
+		return nil, rejectMessage(errors.New("纬度必须在-90到90之间"))
+	}
+	if math.IsNaN(location.Longitude) || location.Longitude < -180 || location.Longitude > 180 {
+		return nil, rejectMessage(errors.New("经度必须在-180到180之间"))
+	}
+
+	location.Name = strings.TrimSpace(location.Name)
+	location.Address = strings.TrimSpace(location.Address)
+	if utf8.RuneCountInString(location.Name) > MaxLocationNameLength {
+		return nil, rejectMessage(errors.New("地点名称过长"))
+	}
+	if utf8.RuneCountInString(location.Address) > MaxLocationAddressLength {
+		return nil, rejectMessage(errors.New("详细地址过长"))
+	}
+
+	return &location, nil
+}
@@ -0,0 +1,316 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"gochat_server/dto"
+	"gochat_server/ent"
+	"gochat_server/ent/groupchatrecord"
+	"gochat_server/ent/messagemention"
+	"gochat_server/ent/predicate"
+	"unicode/utf8"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// validateMentions 校验群消息中的@提及
+// 只有文本消息可以@成员，被@的用户必须是群成员，@所有人仅限群管理员使用
+func validateMentions(groupId, fromUserId, msgType int, content string, mentions []dto.MessageMention) ([]dto.MessageMention, error) {
+	if len(mentions) == 0 {
+		return nil, nil
+	}
+	if msgType != dto.TEXT_MESSAGE {
+		return nil, rejectMessage(errors.New("只有文本消息支持@提及"))
+	}
+
+	members, err := GetGroupMembers(groupId)
+	if err != nil {
+		return nil, err
+	}
+	memberSet := make(map[int]bool, len(members))
+	for _, member := range members {
+		memberSet[member.ID] = true
+	}
+
+	textLength := utf8.RuneCountInString(content)
+	seen := make(map[dto.MessageMention]bool)
+	result := make([]dto.MessageMention, 0, len(mentions))
+
+	for _, mention := range mentions {
+		if mention.Offset < 0 || mention.Length <= 0 || mention.Offset+mention.Length > textLength {
+			return nil, rejectMessage(errors.New("@提及位置无效"))
+		}
+
+		switch mention.Type {
+		case dto.MENTION_USER:
+			if !memberSet[mention.UserId] {
+				return nil, rejectMessage(errors.New("被@的用户不是群成员"))
+			}
+		case dto.MENTION_ALL:
+			isAdmin, err := IsGroupAdmin(groupId, fromUserId)
+			if err != nil {
+				return nil, err
+			}
+			if !isAdmin {
+				return nil, rejectMessage(errors.New("只有群管理员可以@所有人"))
+			}
+			mention.UserId = 0
+		default:
+			return nil, rejectMessage(errors.New("不支持的@提及类型"))
+		}
+
+		if seen[mention] {
+			continue
+		}
+		seen[mention] = true
+		result = append(result, mention)
+	}
+
+	return result, nil
+}
+
+// saveMessageMentions 保存消息的@提及记录
+func saveMessageMentions(ctx context.Context, tx *ent.Tx, msgId string, groupId, fromUserId int, mentions []dto.MessageMention) error {
+	if len(mentions) == 0 {
+		return nil
+	}
+
+	builders := make([]*ent.MessageMentionCreate, 0, len(mentions))
+	for _, mention := range mentions {
+		builders = append(builders, tx.MessageMention.Create().
+			SetMsgId(msgId).
+			SetGroupId(groupId).
+			SetFromUserId(fromUserId).
+			SetMentionedUserId(mention.UserId).
+			SetIsAll(mention.Type == dto.MENTION_ALL).
+			SetOffset(mention.Offset).
+			SetLength(mention.Length))
+	}
+
+	_, err := tx.MessageMention.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return errors.New("保存@提及失败")
+	}
+	return nil
+}
+
+// GetMessageMentions 批量查询消息的@提及，按消息ID分组
+func GetMessageMentions(msgIds []string) (map[string][]dto.MessageMention, error) {
+	result := make(map[string][]dto.MessageMention)
+	if len(msgIds) == 0 {
+		return result, nil
+	}
+
+	records, err := db.MessageMention.Query().
+		Where(messagemention.MsgIdIn(msgIds...)).
+		Order(ent.Asc(messagemention.FieldOffset)).
+		All(context.TODO())
+	if err != nil {
+		return nil, errors.New("查询@提及失败")
+	}
+
+	for _, r := range records {
+		result[r.MsgId] = append(result[r.MsgId], toMentionDTO(r))
+	}
+
+	return result, nil
+}
+
+// IsUserMentioned 判断用户是否被提及（包括@所有人）
+func IsUserMentioned(mentions []dto.MessageMention, userId int) bool {
+	for _, mention := range mentions {
+		if mention.Type == dto.MENTION_ALL || mention.UserId == userId {
+			return true
+		}
+	}
+	return false
+}
+
+// GetMentionsOfUser 查询提及当前用户的群消息（包括@所有人），按时间倒序分页
+// 只返回用户当前所在群组中的提及
+func GetMentionsOfUser(userId int, groupId *int, page, pageSize int) ([]map[string]interface{}, int, error) {
+	groupIds, err := mentionGroupIds(userId, groupId)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(groupIds) == 0 {
+		return []map[string]interface{}{}, 0, nil
+	}
+
+	ctx := context.TODO()
+	query := db.MessageMention.Query().
+		Where(mentionsOfUserPredicates(userId, groupIds)...)
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, errors.New("查询@提及失败")
+	}
+
+	records, err := query.
+		Order(ent.Desc(messagemention.FieldCreateTime), ent.Desc(messagemention.FieldID)).
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		All(ctx)
+	if err != nil {
+		return nil, 0, errors.New("查询@提及失败")
+	}
+
+	msgIds := make([]string, 0, len(records))
+	for _, r := range records {
+		msgIds = append(msgIds, r.MsgId)
+	}
+
+	// 根据群聊已读水位判断当前用户是否已读
+	readSet := getGroupMessagesReadState(userId, msgIds)
+	decoded := loadMessages(msgIds)
+
+	messages := make([]map[string]interface{}, 0, len(records))
+	for _, r := range records {
+		message := map[string]interface{}{
+			"msgId":      r.MsgId,
+			"groupId":    r.GroupId,
+			"fromUserId": r.FromUserId,
+			"msgType":    dto.TEXT_MESSAGE,
+			"isAll":      r.IsAll,
+			"isRead":     readSet[r.MsgId],
+			"createTime": r.CreateTime,
+		}
+		if d, ok := decoded[r.MsgId]; ok {
+			message["content"] = d.Content()
+		}
+
+		messages = append(messages, message)
+	}
+
+	attachMentions(messages)
+
+	return messages, total, nil
+}
+
+// GetUnreadMentionCount 获取提及当前用户的未读消息数
+// groupId 为 nil 时统计所有群；与群聊未读数一样，不计入用户删除或清空的消息
+func GetUnreadMentionCount(userId int, groupId *int) (int, error) {
+	groupIds, err := mentionGroupIds(userId, groupId)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, id := range groupIds {
+		groupCount, err := countGroupUnread(userId, id, mentionsUser(userId, id))
+		if err != nil {
+			return 0, err
+		}
+		count += groupCount
+	}
+
+	return count, nil
+}
+
+// mentionsUser 群聊记录中@了用户（包括@所有人）的消息
+func mentionsUser(userId, groupId int) predicate.GroupChatRecord {
+	return predicate.GroupChatRecord(func(s *sql.Selector) {
+		mentions := sql.Table(messagemention.Table)
+		s.Where(sql.In(
+			s.C(groupchatrecord.FieldMsgId),
+			sql.Select(mentions.C(messagemention.FieldMsgId)).
+				From(mentions).
+				Where(sql.And(
+					sql.EQ(mentions.C(messagemention.FieldGroupId), groupId),
+					sql.Or(
+						sql.EQ(mentions.C(messagemention.FieldMentionedUserId), userId),
+						sql.EQ(mentions.C(messagemention.FieldIsAll), true),
+					),
+				)),
+		))
+	})
+}
+
+// mentionGroupIds 查询提及时使用的群组，groupId 为 nil 时为用户当前所在的所有群组
+func mentionGroupIds(userId int, groupId *int) ([]int, error) {
+	if groupId != nil {
+		isMember, err := IsGroupMember(*groupId, userId)
+		if err != nil {
+			return nil, err
+		}
+		if !isMember {
+			return nil, errors.New("不是群成员")
+		}
+		return []int{*groupId}, nil
+	}
+
+	groups, err := GetUserGroups(userId)
+	if err != nil {
+		return nil, err
+	}
+	groupIds := make([]int, 0, len(groups))
+	for _, g := range groups {
+		groupIds = append(groupIds, g.ID)
+	}
+	return groupIds, nil
+}
+
+// mentionsOfUserPredicates 查询提及用户的记录的条件
+// 同一条消息可能同时@了用户和所有人，只保留ID最小的一条
+func mentionsOfUserPredicates(userId int, groupIds []int) []predicate.MessageMention {
+	return []predicate.MessageMention{
+		messagemention.GroupIdIn(groupIds...),
+		messagemention.FromUserIdNEQ(userId),
+		messagemention.Or(
+			messagemention.MentionedUserId(userId),
+			messagemention.IsAll(true),
+		),
+		func(s *sql.Selector) {
+			other := sql.Table(messagemention.Table).As("earlier_mentions")
+			s.Where(sql.NotExists(
+				sql.Select(other.C(messagemention.FieldID)).
+					From(other).
+					Where(sql.And(
+						sql.ColumnsEQ(other.C(messagemention.FieldMsgId), s.C(messagemention.FieldMsgId)),
+						sql.ColumnsLT(other.C(messagemention.FieldID), s.C(messagemention.FieldID)),
+						sql.Or(
+							sql.EQ(other.C(messagemention.FieldMentionedUserId), userId),
+							sql.EQ(other.C(messagemention.FieldIsAll), true),
+						),
+					)),
+			))
+		},
+	}
+}
+
+// attachMentions 为消息列表附加@提及信息
+func attachMentions(messages []map[string]interface{}) {
+	msgIds := make([]string, 0, len(messages))
+	for _, m := range messages {
+		if msgId, ok := m["msgId"].(string); ok {
+			msgIds = append(msgIds, msgId)
+		}
+	}
+
+	mentions, err := GetMessageMentions(msgIds)
+	if err != nil {
+		return
+	}
+
+	for _, m := range messages {
+		msgId, _ := m["msgId"].(string)
+		if list, ok := mentions[msgId]; ok {
+			m["mentions"] = list
+		}
+	}
+}
+
+// toMentionDTO 将提及记录转换为DTO
+func toMentionDTO(r *ent.MessageMention) dto.MessageMention {
+	mention := dto.MessageMention{
+		Type:   dto.MENTION_USER,
+		UserId: r.MentionedUserId,
+		Offset: r.Offset,
+		Length: r.Length,
+	}
+	if r.IsAll {
+		mention.Type = dto.MENTION_ALL
+		mention.UserId = 0
+	}
+	return mention
+}
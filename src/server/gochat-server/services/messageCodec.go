@@ -0,0 +1,269 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gochat_server/dto"
+	"gochat_server/ent"
+	"gochat_server/ent/message"
+	"strconv"
+	"time"
+)
+
+// MessageCodec 消息类型的编解码器
+// 每种消息类型注册一个编解码器，新增消息类型只需注册编解码器，不需要新建表
+type MessageCodec struct {
+	Type    int // 消息类型
+	Version int // 当前消息体版本
+
+	// Internal 为 true 时只能由服务端生成（如合并转发），SendMessage 不接受该类型
+	Internal bool
+
+	// NewBody 创建空的消息体，用于反序列化
+	NewBody func() interface{}
+
+	// Parse 解析并校验客户端提交的内容，返回消息体；内容无效时返回 rejectMessage 标记的错误
+	Parse func(ctx *codecContext, content string) (interface{}, error)
+
+	// Content 将消息体转换为 content 字符串，兼容只读取 content 的旧客户端
+	Content func(body interface{}) string
+
+	// Preview 生成会话列表、回复引用中的预览文本
+	Preview func(body interface{}) string
+
+	// Resolve 读取时补充动态数据（如名片资料、文件URL），可为空
+	Resolve func(body interface{})
+
+	// Upgrades 消息体版本升级，key 为源版本，返回升级到下一个版本的数据
+	Upgrades map[int]func(data json.RawMessage) (json.RawMessage, error)
+}
+
+// codecContext 解析消息内容时的上下文
+type codecContext struct {
+	FromUserId int
+	Mentions   []dto.MessageMention
+}
+
+// decodedMessage 解码后的消息
+type decodedMessage struct {
+	MsgType    int
+	Body       interface{}
+	Mentions   []dto.MessageMention
+	ReplyTo    *dto.MessageReply
+	Revoked    bool       // 已撤回的消息不解码消息体，Body 为空
+	Purged     bool       // 超过保留期限被清理的消息只有占位记录，Body 为空
+	ExpireTime *time.Time // 阅后即焚消息的过期时间
+	codec      *MessageCodec
+}
+
+// Content 兼容旧客户端的 content 字符串
+func (m *decodedMessage) Content() string {
+	if m.Purged {
+		return dto.PURGED_MESSAGE_CONTENT
+	}
+	if m.Revoked {
+		return dto.RECALLED_MESSAGE_CONTENT
+	}
+	return m.codec.Content(m.Body)
+}
+
+// Preview 预览文本
+func (m *decodedMessage) Preview() string {
+	if m.Purged {
+		return dto.PURGED_MESSAGE_CONTENT
+	}
+	if m.Revoked {
+		return dto.RECALLED_MESSAGE_CONTENT
+	}
+	return m.codec.Preview(m.Body)
+}
+
+var messageCodecs = make(map[int]*MessageCodec)
+
+// RegisterMessageCodec 注册消息编解码器
+func RegisterMessageCodec(codec *MessageCodec) {
+	if _, exists := messageCodecs[codec.Type]; exists {
+		panic(fmt.Sprintf("消息类型 %d 的编解码器重复注册", codec.Type))
+	}
+	if codec.Version <= 0 {
+		codec.Version = 1
+	}
+	messageCodecs[codec.Type] = codec
+}
+
+// getMessageCodec 获取消息类型的编解码器
+func getMessageCodec(msgType int) (*MessageCodec, error) {
+	codec, ok := messageCodecs[msgType]
+	if !ok {
+		return nil, errors.New("不支持的消息类型")
+	}
+	return codec, nil
+}
+
+// encodeMessageBody 将消息体编码为带版本号的 JSON
+func encodeMessageBody(msgType int, body interface{}, mentions []dto.MessageMention, replyTo *dto.MessageReply) (string, error) {
+	codec, err := getMessageCodec(msgType)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		return "", errors.New("编码消息体失败")
+	}
+
+	envelope, err := json.Marshal(dto.MessageBody{
+		Type:     msgType,
+		Version:  codec.Version,
+		Data:     data,
+		Mentions: mentions,
+		ReplyTo:  replyTo,
+	})
+	if err != nil {
+		return "", errors.New("编码消息体失败")
+	}
+	return string(envelope), nil
+}
+
+// decodeMessageBody 解码消息体，旧版本的消息体会先升级到当前版本
+func decodeMessageBody(raw string) (*decodedMessage, error) {
+	var envelope dto.MessageBody
+	if err := json.Unmarshal([]byte(raw), &envelope); err != nil {
+		return nil, errors.New("无效的消息体")
+	}
+
+	codec, err := getMessageCodec(envelope.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	data := envelope.Data
+	for version := envelope.Version; version < codec.Version; version++ {
+		upgrade, ok := codec.Upgrades[version]
+		if !ok {
+			return nil, fmt.Errorf("无法升级消息体版本: %d", version)
+		}
+		if data, err = upgrade(data); err != nil {
+			return nil, err
+		}
+	}
+
+	body := codec.NewBody()
+	if err := json.Unmarshal(data, body); err != nil {
+		return nil, errors.New("无效的消息体")
+	}
+	if codec.Resolve != nil {
+		codec.Resolve(body)
+	}
+
+	return &decodedMessage{
+		MsgType:  envelope.Type,
+		Body:     body,
+		Mentions: envelope.Mentions,
+		ReplyTo:  envelope.ReplyTo,
+		codec:    codec,
+	}, nil
+}
+
+// decodeMessageRecord 解码 Message 记录，已清理和已撤回的消息只返回对应标记
+func decodeMessageRecord(m *ent.Message) (*decodedMessage, error) {
+	if m.IsPurged {
+		msgType, _ := strconv.Atoi(m.MsgType)
+		return &decodedMessage{MsgType: msgType, Purged: true, Revoked: m.IsRevoked}, nil
+	}
+	if m.IsRevoked {
+		msgType, _ := strconv.Atoi(m.MsgType)
+		return &decodedMessage{MsgType: msgType, Revoked: true, ExpireTime: m.ExpireTime}, nil
+	}
+	decoded, err := decodeMessageBody(m.Body)
+	if err != nil {
+		return nil, err
+	}
+	decoded.ExpireTime = m.ExpireTime
+	return decoded, nil
+}
+
+// loadMessage 查询并解码消息
+func loadMessage(msgId string) (*decodedMessage, error) {
+	m, err := db.Message.Query().
+		Where(message.MsgId(msgId)).
+		First(context.TODO())
+	if err != nil {
+		return nil, errors.New("消息不存在")
+	}
+	return decodeMessageRecord(m)
+}
+
+// loadMessages 批量查询并解码消息，解码失败的消息会被跳过
+func loadMessages(msgIds []string) map[string]*decodedMessage {
+	result := make(map[string]*decodedMessage)
+	if len(msgIds) == 0 {
+		return result
+	}
+
+	records, err := db.Message.Query().
+		Where(message.MsgIdIn(msgIds...)).
+		All(context.TODO())
+	if err != nil {
+		return result
+	}
+
+	for _, r := range records {
+		if decoded, err := decodeMessageRecord(r); err == nil {
+			result[r.MsgId] = decoded
+		}
+	}
+	return result
+}
+
+// getMessageContent 根据消息ID获取兼容旧客户端的 content 字符串
+func getMessageContent(msgId string) (string, error) {
+	decoded, err := loadMessage(msgId)
+	if err != nil {
+		return "", err
+	}
+	return decoded.Content(), nil
+}
+
+// attachPayloads 为消息列表附加解码后的消息体和回复引用
+// 已撤回的消息以撤回提示替换内容，并去掉@提及、转发来源等附加信息
+func attachPayloads(messages []map[string]interface{}) {
+	msgIds := make([]string, 0, len(messages))
+	for _, m := range messages {
+		if msgId, ok := m["msgId"].(string); ok {
+			msgIds = append(msgIds, msgId)
+		}
+	}
+
+	decoded := loadMessages(msgIds)
+	for _, m := range messages {
+		msgId, _ := m["msgId"].(string)
+		d, ok := decoded[msgId]
+		if !ok {
+			continue
+		}
+		if d.ExpireTime != nil {
+			m["expireTime"] = d.ExpireTime
+		}
+		if d.Purged {
+			m["content"] = d.Content()
+			m["isPurged"] = true
+			delete(m, "mentions")
+			delete(m, "forwardFrom")
+			continue
+		}
+		if d.Revoked {
+			m["content"] = d.Content()
+			m["isRevoked"] = true
+			delete(m, "mentions")
+			delete(m, "forwardFrom")
+			continue
+		}
+		m["payload"] = d.Body
+		if d.ReplyTo != nil {
+			m["replyTo"] = d.ReplyTo
+		}
+	}
+}
@@ -0,0 +1,217 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gochat_server/dto"
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// 内置消息类型的编解码器
+func init() {
+	RegisterMessageCodec(&MessageCodec{
+		Type:    dto.TEXT_MESSAGE,
+		Version: 1,
+		NewBody: func() interface{} { return &dto.TextBody{} },
+		Parse:   parseTextBody,
+		Content: func(body interface{}) string { return body.(*dto.TextBody).Text },
+		Preview: func(body interface{}) string { return body.(*dto.TextBody).Text },
+	})
+
+	RegisterMessageCodec(&MessageCodec{
+		Type:    dto.IMAGE_MESSAGE,
+		Version: 1,
+		NewBody: func() interface{} { return &dto.MediaBody{} },
+		Parse:   func(_ *codecContext, content string) (interface{}, error) { return parseMediaBody(content, "图片") },
+		Content: func(body interface{}) string { return body.(*dto.MediaBody).Url },
+		Preview: func(interface{}) string { return "[图片]" },
+	})
+
+	RegisterMessageCodec(&MessageCodec{
+		Type:    dto.VIDEO_MESSAGE,
+		Version: 1,
+		NewBody: func() interface{} { return &dto.MediaBody{} },
+		Parse:   func(_ *codecContext, content string) (interface{}, error) { return parseMediaBody(content, "视频") },
+		Content: func(body interface{}) string { return body.(*dto.MediaBody).Url },
+		Preview: func(interface{}) string { return "[视频]" },
+	})
+
+	RegisterMessageCodec(&MessageCodec{
+		Type:     dto.MERGED_FORWARD_MESSAGE,
+		Version:  1,
+		Internal: true,
+		NewBody:  func() interface{} { return &dto.MergedForwardContent{} },
+		Parse: func(_ *codecContext, content string) (interface{}, error) {
+			var merged dto.MergedForwardContent
+			if err := json.Unmarshal([]byte(content), &merged); err != nil || len(merged.Items) == 0 {
+				return nil, rejectMessage(errors.New("无效的合并转发内容"))
+			}
+			return &merged, nil
+		},
+		Content: jsonContent,
+		Preview: func(body interface{}) string { return "[聊天记录] " + body.(*dto.MergedForwardContent).Title },
+	})
+
+	RegisterMessageCodec(&MessageCodec{
+		Type:    dto.FILE_MESSAGE,
+		Version: 1,
+		NewBody: func() interface{} { return &dto.FileContent{} },
+		Parse:   func(_ *codecContext, content string) (interface{}, error) { return parseFileContent(content) },
+		Content: jsonContent,
+		Preview: func(body interface{}) string { return "[文件] " + body.(*dto.FileContent).FileName },
+		Resolve: func(body interface{}) {
+			file := body.(*dto.FileContent)
+			file.Url = GetPublicFileURL(file.StorageKey)
+		},
+	})
+
+	RegisterMessageCodec(&MessageCodec{
+		Type:    dto.VOICE_MESSAGE,
+		Version: 1,
+		NewBody: func() interface{} { return &dto.VoiceContent{} },
+		Parse:   func(_ *codecContext, content string) (interface{}, error) { return parseVoiceContent(content) },
+		Content: jsonContent,
+		Preview: func(body interface{}) string {
+			return fmt.Sprintf("[语音] %d\"", (body.(*dto.VoiceContent).Duration+999)/1000)
+		},
+		Resolve: func(body interface{}) {
+			voice := body.(*dto.VoiceContent)
+			voice.Url = GetPublicFileURL(voice.StorageKey)
+		},
+	})
+
+	RegisterMessageCodec(&MessageCodec{
+		Type:    dto.LOCATION_MESSAGE,
+		Version: 1,
+		NewBody: func() interface{} { return &dto.LocationContent{} },
+		Parse:   func(_ *codecContext, content string) (interface{}, error) { return parseLocationContent(content) },
+		Content: jsonContent,
+		Preview: func(body interface{}) string {
+			if name := body.(*dto.LocationContent).Name; name != "" {
+				return "[位置] " + name
+			}
+			return "[位置]"
+		},
+	})
+
+	RegisterMessageCodec(&MessageCodec{
+		Type:    dto.CONTACT_CARD_MESSAGE,
+		Version: 1,
+		NewBody: func() interface{} { return &dto.ContactCardContent{} },
+		Parse: func(ctx *codecContext, content string) (interface{}, error) {
+			return parseContactCardContent(ctx.FromUserId, content)
+		},
+		Content: jsonContent,
+		Preview: func(body interface{}) string {
+			if nickname := body.(*dto.ContactCardContent).Nickname; nickname != "" {
+				return "[名片] " + nickname
+			}
+			return "[名片]"
+		},
+		Resolve: resolveContactCard,
+	})
+
+	RegisterMessageCodec(&MessageCodec{
+		Type:     dto.SYSTEM_MESSAGE,
+		Version:  1,
+		Internal: true,
+		NewBody:  func() interface{} { return &dto.SystemContent{} },
+		Parse: func(_ *codecContext, content string) (interface{}, error) {
+			var system dto.SystemContent
+			if err := json.Unmarshal([]byte(content), &system); err != nil || system.Event == "" {
+				return nil, rejectMessage(errors.New("无效的系统消息内容"))
+			}
+			return &system, nil
+		},
+		Content: func(body interface{}) string { return body.(*dto.SystemContent).Text },
+		Preview: func(body interface{}) string { return body.(*dto.SystemContent).Text },
+	})
+}
+
+// jsonContent 结构化消息的 content 字符串为消息体的 JSON
+func jsonContent(body interface{}) string {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// 文本中自动识别的链接和邮箱
+var (
+	textURLPattern   = regexp.MustCompile(`https?://[^\s<>"'，。！？、；：“”‘’（）]+`)
+	textEmailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+)
+
+// parseTextBody 解析文本消息，识别链接、邮箱，并将@提及转换为文本实体
+func parseTextBody(ctx *codecContext, content string) (interface{}, error) {
+	if strings.TrimSpace(content) == "" {
+		return nil, rejectMessage(errors.New("消息内容不能为空"))
+	}
+
+	body := &dto.TextBody{Text: content}
+
+	// 正则返回字节位置，实体位置以字符为单位
+	runeOffset := func(byteOffset int) int {
+		return utf8.RuneCountInString(content[:byteOffset])
+	}
+	addMatches := func(pattern *regexp.Regexp, entityType string) {
+		for _, loc := range pattern.FindAllStringIndex(content, -1) {
+			start := runeOffset(loc[0])
+			body.Entities = append(body.Entities, dto.TextEntity{
+				Type:   entityType,
+				Offset: start,
+				Length: runeOffset(loc[1]) - start,
+			})
+		}
+	}
+	addMatches(textURLPattern, dto.TEXT_ENTITY_URL)
+	addMatches(textEmailPattern, dto.TEXT_ENTITY_EMAIL)
+
+	for _, mention := range ctx.Mentions {
+		body.Entities = append(body.Entities, dto.TextEntity{
+			Type:   dto.TEXT_ENTITY_MENTION,
+			Offset: mention.Offset,
+			Length: mention.Length,
+			UserId: mention.UserId,
+		})
+	}
+
+	return body, nil
+}
+
+// parseMediaBody 解析图片、视频消息
+// 内容可以是上传接口返回的URL，也可以是 dto.MediaBody 的 JSON
+func parseMediaBody(content string, name string) (*dto.MediaBody, error) {
+	content = strings.TrimSpace(content)
+
+	var body dto.MediaBody
+	if strings.HasPrefix(content, "{") {
+		if err := json.Unmarshal([]byte(content), &body); err != nil {
+			return nil, rejectMessage(fmt.Errorf("无效的%s消息内容", name))
+		}
+	} else {
+		body.Url = content
+	}
+
+	u, err := url.Parse(body.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, rejectMessage(fmt.Errorf("无效的%sURL", name))
+	}
+	if body.Width < 0 || body.Height < 0 || body.Duration < 0 || body.FileSize < 0 {
+		return nil, rejectMessage(fmt.Errorf("无效的%s信息", name))
+	}
+
+	// 本服务上传的文件记录存储键，便于之后清理
+	if prefix := GetPublicFileURL(""); strings.HasPrefix(body.Url, prefix) {
+		body.StorageKey = strings.TrimPrefix(body.Url, prefix)
+	} else {
+		body.StorageKey = ""
+	}
+
+	return &body, nil
+}
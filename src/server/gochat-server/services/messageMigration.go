@@ -0,0 +1,776 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"gochat_server/dto"
+	"gochat_server/ent"
+	"gochat_server/ent/chatrecord"
+	"gochat_server/ent/contactcardmessage"
+	"gochat_server/ent/datamigration"
+	"gochat_server/ent/filemessage"
+	"gochat_server/ent/group"
+	"gochat_server/ent/groupchatrecord"
+	"gochat_server/ent/groupwatermark"
+	"gochat_server/ent/imagemessage"
+	"gochat_server/ent/locationmessage"
+	"gochat_server/ent/mergedforwardmessage"
+	"gochat_server/ent/message"
+	entmessagestatus "gochat_server/ent/messagestatus"
+	"gochat_server/ent/textmessage"
+	"gochat_server/ent/videomessage"
+	"gochat_server/ent/voicemessage"
+	"gochat_server/utils"
+	"strconv"
+	"time"
+)
+
+const (
+	// messageBackfillBatchSize 数据迁移每批处理的记录数
+	messageBackfillBatchSize = 500
+	// groupStatusMigrationBatchSize 迁移群消息状态时每批处理的群数
+	groupStatusMigrationBatchSize = 20
+)
+
+// dataMigration 启动时执行的数据迁移，完成后记录在 DataMigration 表中不再执行
+// batch 处理记录ID大于 cursor 的一批记录，返回这批最后一条记录的ID和迁移的记录数，没有更多记录时返回 0
+type dataMigration struct {
+	name  string
+	batch func(ctx context.Context, cursor int) (lastId int, migrated int, err error)
+}
+
+// dataMigrations 按顺序执行的数据迁移，新的迁移追加在末尾，已有迁移的名称不能修改
+var dataMigrations = []dataMigration{
+	// 将旧的按类型分表存储的消息内容迁移到 Message 表
+	{"legacy_text_messages", legacyMessageBatch(loadLegacyTextMessages)},
+	{"legacy_image_messages", legacyMessageBatch(loadLegacyImageMessages)},
+	{"legacy_video_messages", legacyMessageBatch(loadLegacyVideoMessages)},
+	{"legacy_merged_forward_messages", legacyMessageBatch(loadLegacyMergedForwardMessages)},
+	{"legacy_file_messages", legacyMessageBatch(loadLegacyFileMessages)},
+	{"legacy_voice_messages", legacyMessageBatch(loadLegacyVoiceMessages)},
+	{"legacy_location_messages", legacyMessageBatch(loadLegacyLocationMessages)},
+	{"legacy_contact_card_messages", legacyMessageBatch(loadLegacyContactCardMessages)},
+	// 为缺少 Message 记录的历史聊天记录补建记录
+	{"private_message_records", backfillPrivateMessageRecords},
+	{"group_message_records", backfillGroupMessageRecords},
+	// 将群消息的逐条消息状态转换为群聊已读水位
+	{"group_message_statuses", migrateGroupMessageStatuses},
+	// 为历史私聊记录补全会话ID
+	{"conversation_ids", backfillConversationIds},
+	// 为已有消息补全引用的媒体文件
+	{"message_storage_keys", backfillMessageStorageKeys},
+}
+
+// RunDataMigrations 执行尚未完成的数据迁移
+// 每批处理后记录进度，服务重启后从上次的位置继续；一个迁移失败时不影响后面的迁移，下次启动时重试
+func RunDataMigrations() {
+	for _, m := range dataMigrations {
+		if err := runDataMigration(m); err != nil {
+			utils.Error("Data migration %s failed: %v", m.name, err)
+		}
+	}
+}
+
+// runDataMigration 分批执行一个数据迁移，已完成的迁移直接跳过
+func runDataMigration(m dataMigration) error {
+	ctx := context.Background()
+
+	progress, err := db.DataMigration.Query().
+		Where(datamigration.Name(m.name)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		progress, err = db.DataMigration.Create().
+			SetName(m.name).
+			Save(ctx)
+	}
+	if err != nil {
+		return errors.New("查询迁移进度失败")
+	}
+	if progress.IsCompleted {
+		return nil
+	}
+
+	cursor, migrated := progress.Cursor, progress.Migrated
+	for {
+		lastId, n, err := m.batch(ctx, cursor)
+		if err != nil {
+			return err
+		}
+		if lastId == 0 {
+			break
+		}
+		cursor = lastId
+		migrated += n
+		if err := db.DataMigration.UpdateOneID(progress.ID).
+			SetCursor(cursor).
+			SetMigrated(migrated).
+			Exec(ctx); err != nil {
+			return errors.New("更新迁移进度失败")
+		}
+	}
+
+	if err := db.DataMigration.UpdateOneID(progress.ID).
+		SetIsCompleted(true).
+		SetFinishTime(time.Now()).
+		Exec(ctx); err != nil {
+		return errors.New("更新迁移进度失败")
+	}
+	if migrated > 0 {
+		utils.Info("Data migration %s completed, migrated %d records", m.name, migrated)
+	}
+	return nil
+}
+
+// legacyMessage 旧内容表中的一条消息，Body 为空表示内容无法解析
+type legacyMessage struct {
+	ID      int
+	MsgId   string
+	MsgType int
+	Body    interface{}
+}
+
+// legacyMessageBatch 迁移一个旧内容表，load 按ID顺序读取ID大于 cursor 的一批消息
+// 已有消息体的消息会被跳过
+func legacyMessageBatch(load func(ctx context.Context, cursor int) ([]*legacyMessage, error)) func(context.Context, int) (int, int, error) {
+	return func(ctx context.Context, cursor int) (int, int, error) {
+		legacy, err := load(ctx, cursor)
+		if err != nil {
+			return 0, 0, err
+		}
+		if len(legacy) == 0 {
+			return 0, 0, nil
+		}
+		migrated, err := migrateLegacyMessages(ctx, legacy)
+		if err != nil {
+			return 0, 0, err
+		}
+		return legacy[len(legacy)-1].ID, migrated, nil
+	}
+}
+
+// migrateLegacyMessages 将一批旧消息的内容写入 Message 表
+func migrateLegacyMessages(ctx context.Context, legacy []*legacyMessage) (int, error) {
+	msgIds := make([]string, 0, len(legacy))
+	for _, m := range legacy {
+		msgIds = append(msgIds, m.MsgId)
+	}
+
+	// 已有消息体的消息不再迁移，超过保留期限被清理的消息同样跳过，不能重新写回内容
+	existing, err := db.Message.Query().
+		Where(message.MsgIdIn(msgIds...)).
+		Select(message.FieldMsgId, message.FieldBody, message.FieldIsPurged).
+		All(ctx)
+	if err != nil {
+		return 0, errors.New("查询消息失败")
+	}
+	migrated := make(map[string]bool, len(existing))
+	for _, m := range existing {
+		if m.Body != "" || m.IsPurged {
+			migrated[m.MsgId] = true
+		}
+	}
+
+	createTimes, err := loadMessageCreateTimes(ctx, msgIds)
+	if err != nil {
+		return 0, err
+	}
+	mentions, err := GetMessageMentions(msgIds)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, m := range legacy {
+		if m.Body == nil || migrated[m.MsgId] {
+			continue
+		}
+		codec, err := getMessageCodec(m.MsgType)
+		if err != nil {
+			continue
+		}
+
+		// 旧的文本消息补充链接、邮箱和@提及实体
+		if text, ok := m.Body.(*dto.TextBody); ok {
+			if parsed, err := parseTextBody(&codecContext{Mentions: mentions[m.MsgId]}, text.Text); err == nil {
+				m.Body = parsed
+			}
+		}
+
+		body, err := encodeMessageBody(m.MsgType, m.Body, mentions[m.MsgId], nil)
+		if err != nil {
+			continue
+		}
+		preview := codec.Preview(m.Body)
+		if preview == "" {
+			preview = " "
+		}
+
+		// 旧版本发送消息时可能已写入不含消息体的 Message 记录，此时只补充消息体
+		storageKey := messageStorageKey(m.Body)
+		updated, err := db.Message.Update().
+			Where(
+				message.MsgId(m.MsgId),
+				message.IsPurged(false),
+			).
+			SetBody(body).
+			SetStorageKey(storageKey).
+			Save(ctx)
+		if err != nil {
+			continue
+		}
+		if updated == 0 {
+			createTime, ok := createTimes[m.MsgId]
+			if !ok {
+				createTime = time.Now()
+			}
+			_, err = db.Message.Create().
+				SetMsgId(m.MsgId).
+				SetMsgType(strconv.Itoa(m.MsgType)).
+				SetContent(preview).
+				SetBody(body).
+				SetStorageKey(storageKey).
+				SetCreateTime(createTime).
+				Save(ctx)
+			if err != nil {
+				continue
+			}
+		}
+		count++
+	}
+
+	return count, nil
+}
+
+// loadLegacyTextMessages 读取一批旧的文本消息
+func loadLegacyTextMessages(ctx context.Context, cursor int) ([]*legacyMessage, error) {
+	rows, err := db.TextMessage.Query().
+		Where(textmessage.IDGT(cursor)).
+		Order(ent.Asc(textmessage.FieldID)).
+		Limit(messageBackfillBatchSize).
+		All(ctx)
+	if err != nil {
+		return nil, errors.New("查询文本消息失败")
+	}
+	result := make([]*legacyMessage, 0, len(rows))
+	for _, m := range rows {
+		result = append(result, &legacyMessage{ID: m.ID, MsgId: m.MsgId, MsgType: dto.TEXT_MESSAGE, Body: &dto.TextBody{Text: m.Text}})
+	}
+	return result, nil
+}
+
+// loadLegacyImageMessages 读取一批旧的图片消息
+func loadLegacyImageMessages(ctx context.Context, cursor int) ([]*legacyMessage, error) {
+	rows, err := db.ImageMessage.Query().
+		Where(imagemessage.IDGT(cursor)).
+		Order(ent.Asc(imagemessage.FieldID)).
+		Limit(messageBackfillBatchSize).
+		All(ctx)
+	if err != nil {
+		return nil, errors.New("查询图片消息失败")
+	}
+	result := make([]*legacyMessage, 0, len(rows))
+	for _, m := range rows {
+		result = append(result, &legacyMessage{ID: m.ID, MsgId: m.MsgId, MsgType: dto.IMAGE_MESSAGE, Body: legacyMediaBody(m.ImageUrl)})
+	}
+	return result, nil
+}
+
+// loadLegacyVideoMessages 读取一批旧的视频消息
+func loadLegacyVideoMessages(ctx context.Context, cursor int) ([]*legacyMessage, error) {
+	rows, err := db.VideoMessage.Query().
+		Where(videomessage.IDGT(cursor)).
+		Order(ent.Asc(videomessage.FieldID)).
+		Limit(messageBackfillBatchSize).
+		All(ctx)
+	if err != nil {
+		return nil, errors.New("查询视频消息失败")
+	}
+	result := make([]*legacyMessage, 0, len(rows))
+	for _, m := range rows {
+		result = append(result, &legacyMessage{ID: m.ID, MsgId: m.MsgId, MsgType: dto.VIDEO_MESSAGE, Body: legacyMediaBody(m.VideoUrl)})
+	}
+	return result, nil
+}
+
+// loadLegacyMergedForwardMessages 读取一批旧的合并转发消息，无法解析的消息不迁移
+func loadLegacyMergedForwardMessages(ctx context.Context, cursor int) ([]*legacyMessage, error) {
+	rows, err := db.MergedForwardMessage.Query().
+		Where(mergedforwardmessage.IDGT(cursor)).
+		Order(ent.Asc(mergedforwardmessage.FieldID)).
+		Limit(messageBackfillBatchSize).
+		All(ctx)
+	if err != nil {
+		return nil, errors.New("查询合并转发消息失败")
+	}
+	result := make([]*legacyMessage, 0, len(rows))
+	for _, m := range rows {
+		legacy := &legacyMessage{ID: m.ID, MsgId: m.MsgId, MsgType: dto.MERGED_FORWARD_MESSAGE}
+		var merged dto.MergedForwardContent
+		if err := json.Unmarshal([]byte(m.Payload), &merged); err == nil {
+			legacy.Body = &merged
+		}
+		result = append(result, legacy)
+	}
+	return result, nil
+}
+
+// loadLegacyFileMessages 读取一批旧的文件消息
+func loadLegacyFileMessages(ctx context.Context, cursor int) ([]*legacyMessage, error) {
+	rows, err := db.FileMessage.Query().
+		Where(filemessage.IDGT(cursor)).
+		Order(ent.Asc(filemessage.FieldID)).
+		Limit(messageBackfillBatchSize).
+		All(ctx)
+	if err != nil {
+		return nil, errors.New("查询文件消息失败")
+	}
+	result := make([]*legacyMessage, 0, len(rows))
+	for _, m := range rows {
+		result = append(result, &legacyMessage{ID: m.ID, MsgId: m.MsgId, MsgType: dto.FILE_MESSAGE, Body: &dto.FileContent{
+			FileName:   m.FileName,
+			FileSize:   m.FileSize,
+			MimeType:   m.MimeType,
+			Checksum:   m.Checksum,
+			StorageKey: m.StorageKey,
+		}})
+	}
+	return result, nil
+}
+
+// loadLegacyVoiceMessages 读取一批旧的语音消息
+func loadLegacyVoiceMessages(ctx context.Context, cursor int) ([]*legacyMessage, error) {
+	rows, err := db.VoiceMessage.Query().
+		Where(voicemessage.IDGT(cursor)).
+		Order(ent.Asc(voicemessage.FieldID)).
+		Limit(messageBackfillBatchSize).
+		All(ctx)
+	if err != nil {
+		return nil, errors.New("查询语音消息失败")
+	}
+	result := make([]*legacyMessage, 0, len(rows))
+	for _, m := range rows {
+		result = append(result, &legacyMessage{ID: m.ID, MsgId: m.MsgId, MsgType: dto.VOICE_MESSAGE, Body: &dto.VoiceContent{
+			StorageKey: m.StorageKey,
+			MimeType:   m.MimeType,
+			FileSize:   m.FileSize,
+			Duration:   m.Duration,
+			Waveform:   m.Waveform,
+		}})
+	}
+	return result, nil
+}
+
+// loadLegacyLocationMessages 读取一批旧的位置消息
+func loadLegacyLocationMessages(ctx context.Context, cursor int) ([]*legacyMessage, error) {
+	rows, err := db.LocationMessage.Query().
+		Where(locationmessage.IDGT(cursor)).
+		Order(ent.Asc(locationmessage.FieldID)).
+		Limit(messageBackfillBatchSize).
+		All(ctx)
+	if err != nil {
+		return nil, errors.New("查询位置消息失败")
+	}
+	result := make([]*legacyMessage, 0, len(rows))
+	for _, m := range rows {
+		result = append(result, &legacyMessage{ID: m.ID, MsgId: m.MsgId, MsgType: dto.LOCATION_MESSAGE, Body: &dto.LocationContent{
+			Latitude:  m.Latitude,
+			Longitude: m.Longitude,
+			Name:      m.Name,
+			Address:   m.Address,
+		}})
+	}
+	return result, nil
+}
+
+// loadLegacyContactCardMessages 读取一批旧的名片消息
+func loadLegacyContactCardMessages(ctx context.Context, cursor int) ([]*legacyMessage, error) {
+	rows, err := db.ContactCardMessage.Query().
+		Where(contactcardmessage.IDGT(cursor)).
+		Order(ent.Asc(contactcardmessage.FieldID)).
+		Limit(messageBackfillBatchSize).
+		All(ctx)
+	if err != nil {
+		return nil, errors.New("查询名片消息失败")
+	}
+	result := make([]*legacyMessage, 0, len(rows))
+	for _, m := range rows {
+		result = append(result, &legacyMessage{ID: m.ID, MsgId: m.MsgId, MsgType: dto.CONTACT_CARD_MESSAGE, Body: &dto.ContactCardContent{UserId: m.UserId}})
+	}
+	return result, nil
+}
+
+// legacyMediaBody 将旧的图片、视频URL转换为媒体消息体
+func legacyMediaBody(url string) *dto.MediaBody {
+	if body, err := parseMediaBody(url, "媒体"); err == nil {
+		return body
+	}
+	return &dto.MediaBody{Url: url}
+}
+
+// loadMessageCreateTimes 从聊天记录中读取消息的发送时间
+func loadMessageCreateTimes(ctx context.Context, msgIds []string) (map[string]time.Time, error) {
+	result := make(map[string]time.Time, len(msgIds))
+
+	records, err := db.ChatRecord.Query().
+		Where(chatrecord.MsgIdIn(msgIds...)).
+		Select(chatrecord.FieldMsgId, chatrecord.FieldCreateTime).
+		All(ctx)
+	if err != nil {
+		return nil, errors.New("查询聊天记录失败")
+	}
+	for _, r := range records {
+		result[r.MsgId] = r.CreateTime
+	}
+
+	groupRecords, err := db.GroupChatRecord.Query().
+		Where(groupchatrecord.MsgIdIn(msgIds...)).
+		Select(groupchatrecord.FieldMsgId, groupchatrecord.FieldCreateTime).
+		All(ctx)
+	if err != nil {
+		return nil, errors.New("查询群聊记录失败")
+	}
+	for _, r := range groupRecords {
+		result[r.MsgId] = r.CreateTime
+	}
+
+	return result, nil
+}
+
+// backfillRecord 需要补建 Message 记录的聊天记录
+type backfillRecord struct {
+	msgId      string
+	msgType    string
+	createTime time.Time
+}
+
+// backfillPrivateMessageRecords 为一批没有 Message 记录的私聊记录补建记录，使其可以撤回
+func backfillPrivateMessageRecords(ctx context.Context, cursor int) (int, int, error) {
+	records, err := db.ChatRecord.Query().
+		Where(chatrecord.IDGT(cursor)).
+		Order(ent.Asc(chatrecord.FieldID)).
+		Limit(messageBackfillBatchSize).
+		Select(chatrecord.FieldMsgId, chatrecord.FieldMsgType, chatrecord.FieldCreateTime).
+		All(ctx)
+	if err != nil {
+		return 0, 0, errors.New("查询聊天记录失败")
+	}
+	if len(records) == 0 {
+		return 0, 0, nil
+	}
+
+	backfill := make([]backfillRecord, 0, len(records))
+	for _, r := range records {
+		backfill = append(backfill, backfillRecord{r.MsgId, strconv.Itoa(r.MsgType), r.CreateTime})
+	}
+	created, err := backfillMessageRecords(ctx, backfill)
+	if err != nil {
+		return 0, 0, err
+	}
+	return records[len(records)-1].ID, created, nil
+}
+
+// backfillGroupMessageRecords 为一批没有 Message 记录的群聊记录补建记录
+func backfillGroupMessageRecords(ctx context.Context, cursor int) (int, int, error) {
+	records, err := db.GroupChatRecord.Query().
+		Where(groupchatrecord.IDGT(cursor)).
+		Order(ent.Asc(groupchatrecord.FieldID)).
+		Limit(messageBackfillBatchSize).
+		Select(groupchatrecord.FieldMsgId, groupchatrecord.FieldMsgType, groupchatrecord.FieldCreateTime).
+		All(ctx)
+	if err != nil {
+		return 0, 0, errors.New("查询群聊记录失败")
+	}
+	if len(records) == 0 {
+		return 0, 0, nil
+	}
+
+	backfill := make([]backfillRecord, 0, len(records))
+	for _, r := range records {
+		backfill = append(backfill, backfillRecord{r.MsgId, r.MsgType, r.CreateTime})
+	}
+	created, err := backfillMessageRecords(ctx, backfill)
+	if err != nil {
+		return 0, 0, err
+	}
+	return records[len(records)-1].ID, created, nil
+}
+
+// backfillMessageRecords 为没有 Message 记录的聊天记录补建记录
+// 旧版本发送消息时只写入内容表和聊天记录，内容表中的消息已由旧内容迁移补建，
+// 这里处理内容已丢失的记录，补建的记录没有消息体
+func backfillMessageRecords(ctx context.Context, records []backfillRecord) (int, error) {
+	msgIds := make([]string, 0, len(records))
+	for _, r := range records {
+		msgIds = append(msgIds, r.msgId)
+	}
+	existing, err := db.Message.Query().
+		Where(message.MsgIdIn(msgIds...)).
+		Select(message.FieldMsgId).
+		Strings(ctx)
+	if err != nil {
+		return 0, errors.New("查询消息失败")
+	}
+	exists := make(map[string]bool, len(existing))
+	for _, msgId := range existing {
+		exists[msgId] = true
+	}
+
+	builders := make([]*ent.MessageCreate, 0)
+	for _, r := range records {
+		if exists[r.msgId] {
+			continue
+		}
+		exists[r.msgId] = true
+		builders = append(builders, db.Message.Create().
+			SetMsgId(r.msgId).
+			SetMsgType(r.msgType).
+			SetContent(" ").
+			SetCreateTime(r.createTime))
+	}
+	if len(builders) == 0 {
+		return 0, nil
+	}
+
+	if _, err := db.Message.CreateBulk(builders...).Save(ctx); err != nil {
+		return 0, errors.New("补全消息记录失败")
+	}
+	return len(builders), nil
+}
+
+// groupStatusSummary 迁移时汇总的成员在一个群中的消息状态
+type groupStatusSummary struct {
+	maxSeq            int // 有状态记录的最新消息
+	minUnreadSeq      int // 最早的未读消息，0 表示没有
+	minUndeliveredSeq int // 最早的未送达消息，0 表示没有
+	readTime          *time.Time
+	deliveredTime     *time.Time
+}
+
+// migrateGroupMessageStatuses 将一批群的群消息 MessageStatus 记录转换为群聊水位并删除
+// 水位取最早一条未读（未送达）消息的前一条，保证原来的未读消息迁移后仍为未读；
+// 没有状态记录的群成员以群内最新消息为水位
+func migrateGroupMessageStatuses(ctx context.Context, cursor int) (int, int, error) {
+	groups, err := db.Group.Query().
+		Where(group.IDGT(cursor)).
+		Order(ent.Asc(group.FieldID)).
+		Limit(groupStatusMigrationBatchSize).
+		All(ctx)
+	if err != nil {
+		return 0, 0, errors.New("查询群组失败")
+	}
+	if len(groups) == 0 {
+		return 0, 0, nil
+	}
+
+	groupIds := make([]string, 0, len(groups))
+	for _, g := range groups {
+		groupIds = append(groupIds, strconv.Itoa(g.ID))
+	}
+	groupRecords, err := db.GroupChatRecord.Query().
+		Where(groupchatrecord.GroupIdIn(groupIds...)).
+		Select(groupchatrecord.FieldMsgId, groupchatrecord.FieldGroupId).
+		All(ctx)
+	if err != nil {
+		return 0, 0, errors.New("查询群聊记录失败")
+	}
+	seqs := make(map[string]*groupMessageSeq, len(groupRecords))
+	msgIds := make([]string, 0, len(groupRecords))
+	for _, r := range groupRecords {
+		groupId, _ := strconv.Atoi(r.GroupId)
+		seqs[r.MsgId] = &groupMessageSeq{GroupId: groupId, Seq: r.ID}
+		msgIds = append(msgIds, r.MsgId)
+	}
+
+	// 按成员和群汇总状态，分批查询避免超过数据库的参数数量限制
+	type summaryKey struct{ userId, groupId int }
+	summaries := make(map[summaryKey]*groupStatusSummary)
+	statusIds := make([]int, 0)
+	for start := 0; start < len(msgIds); start += messageBackfillBatchSize {
+		end := start + messageBackfillBatchSize
+		if end > len(msgIds) {
+			end = len(msgIds)
+		}
+		statuses, err := db.MessageStatus.Query().
+			Where(entmessagestatus.MsgIdIn(msgIds[start:end]...)).
+			All(ctx)
+		if err != nil {
+			return 0, 0, errors.New("查询消息状态失败")
+		}
+
+		for _, status := range statuses {
+			seq := seqs[status.MsgId]
+			key := summaryKey{status.UserId, seq.GroupId}
+			summary, ok := summaries[key]
+			if !ok {
+				summary = &groupStatusSummary{}
+				summaries[key] = summary
+			}
+
+			if seq.Seq > summary.maxSeq {
+				summary.maxSeq = seq.Seq
+			}
+			if !status.IsRead && (summary.minUnreadSeq == 0 || seq.Seq < summary.minUnreadSeq) {
+				summary.minUnreadSeq = seq.Seq
+			}
+			if !status.IsDelivered && (summary.minUndeliveredSeq == 0 || seq.Seq < summary.minUndeliveredSeq) {
+				summary.minUndeliveredSeq = seq.Seq
+			}
+			if status.ReadTime != nil && (summary.readTime == nil || status.ReadTime.After(*summary.readTime)) {
+				summary.readTime = status.ReadTime
+			}
+			if status.DeliveredTime != nil && (summary.deliveredTime == nil || status.DeliveredTime.After(*summary.deliveredTime)) {
+				summary.deliveredTime = status.DeliveredTime
+			}
+			statusIds = append(statusIds, status.ID)
+		}
+	}
+
+	err = withTx(ctx, func(tx *ent.Tx) error {
+		for key, summary := range summaries {
+			exists, err := tx.GroupWatermark.Query().
+				Where(
+					groupwatermark.UserId(key.userId),
+					groupwatermark.GroupId(key.groupId),
+				).
+				Exist(ctx)
+			if err != nil {
+				return errors.New("查询群聊水位失败")
+			}
+			if exists {
+				continue
+			}
+
+			readSeq := summary.maxSeq
+			if summary.minUnreadSeq > 0 {
+				readSeq = summary.minUnreadSeq - 1
+			}
+			deliveredSeq := summary.maxSeq
+			if summary.minUndeliveredSeq > 0 {
+				deliveredSeq = summary.minUndeliveredSeq - 1
+			}
+			if deliveredSeq < readSeq {
+				deliveredSeq = readSeq
+			}
+
+			_, err = tx.GroupWatermark.Create().
+				SetUserId(key.userId).
+				SetGroupId(key.groupId).
+				SetReadSeq(readSeq).
+				SetDeliveredSeq(deliveredSeq).
+				SetNillableReadTime(summary.readTime).
+				SetNillableDeliveredTime(summary.deliveredTime).
+				Save(ctx)
+			if err != nil {
+				return errors.New("创建群聊水位失败")
+			}
+		}
+
+		// 没有状态记录的成员
+		for _, g := range groups {
+			if err := initGroupWatermarks(ctx, tx.Client(), g.ID, g.Members); err != nil {
+				return err
+			}
+		}
+
+		for start := 0; start < len(statusIds); start += messageBackfillBatchSize {
+			end := start + messageBackfillBatchSize
+			if end > len(statusIds) {
+				end = len(statusIds)
+			}
+			if _, err := tx.MessageStatus.Delete().
+				Where(entmessagestatus.IDIn(statusIds[start:end]...)).
+				Exec(ctx); err != nil {
+				return errors.New("删除群消息状态失败")
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return groups[len(groups)-1].ID, len(statusIds), nil
+}
+
+// backfillConversationIds 为一批没有会话ID的历史私聊记录补全会话ID，使其可以按游标分页
+func backfillConversationIds(ctx context.Context, cursor int) (int, int, error) {
+	records, err := db.ChatRecord.Query().
+		Where(chatrecord.IDGT(cursor)).
+		Order(ent.Asc(chatrecord.FieldID)).
+		Limit(messageBackfillBatchSize).
+		Select(chatrecord.FieldFromUserId, chatrecord.FieldToUserId, chatrecord.FieldConversationId).
+		All(ctx)
+	if err != nil {
+		return 0, 0, errors.New("查询聊天记录失败")
+	}
+	if len(records) == 0 {
+		return 0, 0, nil
+	}
+	lastId := records[len(records)-1].ID
+
+	// 按发送者和接收者分组批量更新
+	type userPair struct{ fromUserId, toUserId int }
+	pairs := make(map[userPair]bool)
+	for _, r := range records {
+		if r.ConversationId == "" {
+			pairs[userPair{r.FromUserId, r.ToUserId}] = true
+		}
+	}
+
+	updated := 0
+	for pair := range pairs {
+		n, err := db.ChatRecord.Update().
+			Where(
+				chatrecord.IDGT(cursor),
+				chatrecord.IDLTE(lastId),
+				chatrecord.ConversationIdIsNil(),
+				chatrecord.FromUserId(pair.fromUserId),
+				chatrecord.ToUserId(pair.toUserId),
+			).
+			SetConversationId(privateConversationId(pair.fromUserId, pair.toUserId)).
+			Save(ctx)
+		if err != nil {
+			return 0, 0, errors.New("补全会话ID失败")
+		}
+		updated += n
+	}
+
+	return lastId, updated, nil
+}
+
+// backfillMessageStorageKeys 为一批消息补全消息体引用的媒体文件，删除媒体文件前按该字段检查引用
+func backfillMessageStorageKeys(ctx context.Context, cursor int) (int, int, error) {
+	messages, err := db.Message.Query().
+		Where(
+			message.IDGT(cursor),
+			message.IsPurged(false),
+		).
+		Order(ent.Asc(message.FieldID)).
+		Limit(messageBackfillBatchSize).
+		Select(message.FieldBody, message.FieldStorageKey).
+		All(ctx)
+	if err != nil {
+		return 0, 0, errors.New("查询消息失败")
+	}
+	if len(messages) == 0 {
+		return 0, 0, nil
+	}
+
+	updated := 0
+	for _, m := range messages {
+		if m.Body == "" || m.StorageKey != "" {
+			continue
+		}
+		key := bodyStorageKey(m.Body)
+		if key == "" {
+			continue
+		}
+		if err := db.Message.UpdateOneID(m.ID).SetStorageKey(key).Exec(ctx); err != nil {
+			return 0, 0, errors.New("补全消息媒体文件失败")
+		}
+		updated++
+	}
+
+	return messages[len(messages)-1].ID, updated, nil
+}
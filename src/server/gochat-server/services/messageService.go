@@ -7,17 +7,179 @@ import (
 	"gochat_server/dto"
 	"gochat_server/ent"
 	"gochat_server/ent/chatrecord"
+	"gochat_server/ent/groupchatrecord"
 	"gochat_server/ent/message"
 	entmessagestatus "gochat_server/ent/messagestatus"
+	"gochat_server/utils"
+	"sort"
+	"strconv"
 	"time"
-
-	"github.com/google/uuid"
 )
 
 // SendMessage 发送消息
-func SendMessage(fromUserId, toUserId int, msgType int, content string, groupId *int) (string, error) {
-	// 生成消息ID
-	msgId := uuid.New().String()
+// mentions 为群消息中的@提及，私聊消息不支持；replyToMsgId 为被回复的消息ID，可为空
+func SendMessage(ctx context.Context, fromUserId, toUserId int, msgType int, content string, groupId *int, mentions []dto.MessageMention, replyToMsgId string) (string, error) {
+	codec, err := getMessageCodec(msgType)
+	if err != nil {
+		return "", err
+	}
+	// 部分消息类型只能由服务端生成（如合并转发）
+	if codec.Internal {
+		return "", errors.New("不支持的消息类型")
+	}
+
+	return sendMessage(ctx, fromUserId, toUserId, groupId, &outgoingMessage{
+		MsgType:      msgType,
+		Content:      content,
+		Mentions:     mentions,
+		ReplyToMsgId: replyToMsgId,
+	}, nil)
+}
+
+// outgoingMessage 待发送的消息
+type outgoingMessage struct {
+	MsgType      int
+	Content      string      // 客户端提交的原始内容，Body 为空时由编解码器解析
+	Body         interface{} // 已解析的消息体，转发时直接复用原消息的消息体
+	Mentions     []dto.MessageMention
+	ReplyToMsgId string
+	Scheduled    *ent.ScheduledMessage // 定时消息，发送时在同一事务中标记为已发送
+}
+
+// sendMessage 保存消息，forward 不为空时同时记录转发来源
+func sendMessage(ctx context.Context, fromUserId, toUserId int, groupId *int, msg *outgoingMessage, forward *forwardSource) (string, error) {
+	// 生成按时间排序的消息ID，消息时间取自ID，使创建时间与ID顺序一致
+	msgId, now := utils.NewMessageId()
+	msgType := msg.MsgType
+
+	// 判断是否为群聊
+	isGroup := groupId != nil && *groupId > 0
+
+	prepared, err := prepareMessage(fromUserId, toUserId, groupId, msg)
+	if err != nil {
+		return "", err
+	}
+	codec, body, mentions, replyTo := prepared.codec, prepared.body, prepared.mentions, prepared.replyTo
+
+	// 保存消息体
+	encodedBody, err := encodeMessageBody(msgType, body, mentions, replyTo)
+	if err != nil {
+		return "", err
+	}
+	preview := codec.Preview(body)
+	if preview == "" {
+		preview = " "
+	}
+
+	// 会话开启阅后即焚时设置过期时间，系统消息不过期
+	var expireTime *time.Time
+	if msgType != dto.SYSTEM_MESSAGE {
+		expireTime, err = messageExpireTime(fromUserId, toUserId, groupId, now)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	// 需要推送的接收者（群聊为除发送者外的所有成员）
+	receiverIds := []int{toUserId}
+	if isGroup {
+		members, err := GetGroupMembers(*groupId)
+		if err != nil {
+			return "", err
+		}
+		receiverIds = make([]int, 0, len(members))
+		for _, member := range members {
+			if member.ID != fromUserId {
+				receiverIds = append(receiverIds, member.ID)
+			}
+		}
+	}
+
+	// 消息、聊天记录、@提及、消息状态、转发来源、定时消息状态和推送记录在同一事务中保存，任一步失败都会整体回滚
+	err = withTx(ctx, func(tx *ent.Tx) error {
+		if err := saveMessageRecords(ctx, tx, msgId, fromUserId, toUserId, groupId, msgType, preview, encodedBody, now, expireTime); err != nil {
+			return err
+		}
+		if isGroup {
+			if err := saveMessageMentions(ctx, tx, msgId, *groupId, fromUserId, mentions); err != nil {
+				return err
+			}
+		}
+		// 私聊消息记录接收者的消息状态，群消息的已读状态由群聊水位记录
+		if !isGroup {
+			if err := saveMessageStatuses(ctx, tx, msgId, receiverIds); err != nil {
+				return err
+			}
+		}
+		if forward != nil {
+			if err := saveForwardSource(ctx, tx, msgId, fromUserId, forward); err != nil {
+				return err
+			}
+		}
+		if msg.Scheduled != nil {
+			if err := markScheduledMessageSent(ctx, tx, msg.Scheduled, msgId); err != nil {
+				return err
+			}
+		}
+		return enqueueMessageDispatch(ctx, tx, msgId, receiverIds)
+	})
+	if err != nil {
+		return "", err
+	}
+	wakeOutboxDispatcher()
+
+	// 使历史记录缓存失效
+	if isGroup {
+		_ = InvalidateGroupChatHistoryCache(*groupId)
+	} else {
+		_ = InvalidateChatHistoryCache(fromUserId, toUserId)
+	}
+
+	// 文本消息写入全文索引
+	if text, ok := body.(*dto.TextBody); ok {
+		indexTextMessage(msgId, fromUserId, toUserId, groupId, text.Text, now)
+	}
+
+	return msgId, nil
+}
+
+// messageRejectedError 发送权限或消息内容校验失败，重新发送也不会成功
+type messageRejectedError struct {
+	err error
+}
+
+func (e *messageRejectedError) Error() string { return e.err.Error() }
+
+func (e *messageRejectedError) Unwrap() error { return e.err }
+
+// rejectMessage 将错误标记为发送权限或消息内容校验失败，与数据库超时等临时错误区分
+func rejectMessage(err error) error {
+	return &messageRejectedError{err: err}
+}
+
+// isMessageRejected 是否为发送权限或消息内容校验失败
+func isMessageRejected(err error) bool {
+	var rejected *messageRejectedError
+	return errors.As(err, &rejected)
+}
+
+// preparedMessage 校验通过、待保存的消息
+type preparedMessage struct {
+	codec    *MessageCodec
+	body     interface{}
+	mentions []dto.MessageMention
+	replyTo  *dto.MessageReply
+}
+
+// prepareMessage 检查发送权限，解析并校验消息内容、@提及和回复引用
+func prepareMessage(fromUserId, toUserId int, groupId *int, msg *outgoingMessage) (*preparedMessage, error) {
+	msgType := msg.MsgType
+	mentions := msg.Mentions
+
+	codec, err := getMessageCodec(msgType)
+	if err != nil {
+		return nil, rejectMessage(err)
+	}
 
 	// 判断是否为群聊
 	isGroup := groupId != nil && *groupId > 0
@@ -26,103 +188,143 @@ func SendMessage(fromUserId, toUserId int, msgType int, content string, groupId
 	if !isGroup {
 		isFriend, err := IsFriend(fromUserId, toUserId)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 		if !isFriend {
-			return "", errors.New("只能给好友发送消息")
+			return nil, rejectMessage(errors.New("只能给好友发送消息"))
+		}
+		// 被对方拉黑时不透露拉黑关系，只返回通用的失败
+		blocked, err := IsBlocked(toUserId, fromUserId)
+		if err != nil {
+			return nil, err
+		}
+		if blocked {
+			return nil, errMessageRejected
+		}
+		if len(mentions) > 0 {
+			return nil, rejectMessage(errors.New("私聊消息不支持@提及"))
 		}
 	} else {
 		// 如果是群聊，检查用户是否是群成员
 		isMember, err := IsGroupMember(*groupId, fromUserId)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 		if !isMember {
-			return "", errors.New("只有群成员才能发送群消息")
+			return nil, rejectMessage(errors.New("只有群成员才能发送群消息"))
 		}
-	}
 
-	// 根据消息类型存储消息内容
-	switch msgType {
-	case dto.TEXT_MESSAGE:
-		_, err := db.TextMessage.Create().
-			SetMsgId(msgId).
-			SetText(content).
-			Save(context.TODO())
+		// 校验@提及
+		mentions, err = validateMentions(*groupId, fromUserId, msgType, msg.Content, mentions)
 		if err != nil {
-			return "", errors.New("保存文本消息失败")
+			return nil, err
 		}
+	}
 
-	case dto.IMAGE_MESSAGE:
-		_, err := db.ImageMessage.Create().
-			SetMsgId(msgId).
-			SetImageUrl(content).
-			Save(context.TODO())
+	// 解析并校验消息内容，内容校验失败由编解码器标记为被拒绝，查询对象存储或数据库失败时可以重试
+	body := msg.Body
+	if body == nil {
+		body, err = codec.Parse(&codecContext{FromUserId: fromUserId, Mentions: mentions}, msg.Content)
 		if err != nil {
-			return "", errors.New("保存图片消息失败")
+			return nil, err
 		}
+	}
 
-	case dto.VIDEO_MESSAGE:
-		_, err := db.VideoMessage.Create().
-			SetMsgId(msgId).
-			SetVideoUrl(content).
-			Save(context.TODO())
+	// 回复引用
+	var replyTo *dto.MessageReply
+	if msg.ReplyToMsgId != "" {
+		replyTo, err = buildMessageReply(msg.ReplyToMsgId, fromUserId, toUserId, groupId)
 		if err != nil {
-			return "", errors.New("保存视频消息失败")
+			return nil, err
 		}
+	}
 
-	default:
-		return "", errors.New("不支持的消息类型")
+	return &preparedMessage{codec: codec, body: body, mentions: mentions, replyTo: replyTo}, nil
+}
+
+// saveMessageRecords 在事务中保存消息和聊天记录，expireTime 为空时消息不过期
+func saveMessageRecords(ctx context.Context, tx *ent.Tx, msgId string, fromUserId, toUserId int, groupId *int, msgType int, preview, body string, createTime time.Time, expireTime *time.Time) error {
+	_, err := tx.Message.Create().
+		SetMsgId(msgId).
+		SetMsgType(strconv.Itoa(msgType)).
+		SetContent(preview).
+		SetBody(body).
+		SetStorageKey(bodyStorageKey(body)).
+		SetCreateTime(createTime).
+		SetNillableExpireTime(expireTime).
+		Save(ctx)
+	if err != nil {
+		return errors.New("保存消息失败")
 	}
 
-	// 创建聊天记录
-	if isGroup {
+	if groupId != nil && *groupId > 0 {
 		// 群聊消息：存储到 GroupChatRecord
-		_, err := db.GroupChatRecord.Create().
+		_, err = tx.GroupChatRecord.Create().
 			SetMsgId(msgId).
 			SetFromUserId(fmt.Sprintf("%d", fromUserId)).
 			SetGroupId(fmt.Sprintf("%d", *groupId)).
 			SetMsgType(fmt.Sprintf("%d", msgType)).
-			Save(context.TODO())
+			SetCreateTime(createTime).
+			Save(ctx)
 		if err != nil {
-			return "", errors.New("保存群聊记录失败")
+			return errors.New("保存群聊记录失败")
 		}
+		return nil
+	}
 
-		// 为所有群成员创建消息状态记录（除了发送者）
-		members, err := GetGroupMembers(*groupId)
-		if err == nil {
-			for _, member := range members {
-				if member.ID != fromUserId {
-					CreateMessageStatus(msgId, member.ID)
-				}
-			}
+	// 私聊消息：存储到 ChatRecord
+	_, err = tx.ChatRecord.Create().
+		SetMsgId(msgId).
+		SetFromUserId(fromUserId).
+		SetToUserId(toUserId).
+		SetMsgType(msgType).
+		SetIsGroup(false).
+		SetConversationId(privateConversationId(fromUserId, toUserId)).
+		SetCreateTime(createTime).
+		Save(ctx)
+	if err != nil {
+		return errors.New("保存聊天记录失败")
+	}
+	return nil
+}
+
+// buildMessageReply 生成被回复消息的快照，被回复的消息必须在同一会话中且未撤回
+func buildMessageReply(replyToMsgId string, fromUserId, toUserId int, groupId *int) (*dto.MessageReply, error) {
+	detail, err := CanUserAccessMessage(replyToMsgId, fromUserId)
+	if err != nil {
+		return nil, rejectMessage(errors.New("被回复的消息不存在"))
+	}
+
+	if groupId != nil && *groupId > 0 {
+		if !detail.IsGroup || detail.GroupId == nil || *detail.GroupId != *groupId {
+			return nil, rejectMessage(errors.New("只能回复同一会话中的消息"))
 		}
 	} else {
-		// 私聊消息：存储到 ChatRecord
-		_, err := db.ChatRecord.Create().
-			SetMsgId(msgId).
-			SetFromUserId(fromUserId).
-			SetToUserId(toUserId).
-			SetMsgType(msgType).
-			SetIsGroup(false).
-			Save(context.TODO())
-		if err != nil {
-			return "", errors.New("保存聊天记录失败")
+		samePair := (detail.FromUserId == fromUserId && detail.ToUserId == toUserId) ||
+			(detail.FromUserId == toUserId && detail.ToUserId == fromUserId)
+		if detail.IsGroup || !samePair {
+			return nil, rejectMessage(errors.New("只能回复同一会话中的消息"))
 		}
+	}
 
-		// 为接收者创建消息状态记录
-		CreateMessageStatus(msgId, toUserId)
-
-		// 使私聊历史缓存失效
-		_ = InvalidateChatHistoryCache(fromUserId, toUserId)
+	if isMessageRevoked(replyToMsgId) {
+		return nil, rejectMessage(errors.New("不能回复已撤回的消息"))
 	}
 
-	// 如果是群聊，使群聊历史缓存失效
-	if isGroup {
-		_ = InvalidateGroupChatHistoryCache(*groupId)
+	decoded, err := loadMessage(replyToMsgId)
+	if err != nil {
+		return nil, err
+	}
+	if decoded.Purged {
+		return nil, rejectMessage(errors.New("不能回复已过期的消息"))
 	}
 
-	return msgId, nil
+	return &dto.MessageReply{
+		MsgId:      replyToMsgId,
+		FromUserId: detail.FromUserId,
+		MsgType:    detail.MsgType,
+		Preview:    decoded.Preview(),
+	}, nil
 }
 
 // GetChatHistory 获取私聊历史记录
@@ -136,48 +338,36 @@ func GetChatHistory(userId, friendId int, page, pageSize int) ([]map[string]inte
 		return nil, 0, errors.New("不是好友关系")
 	}
 
-	// 尝试从缓存获取
-	if cachedMessages, found := GetCachedChatHistory(userId, friendId, page); found {
+	// 用户删除或清空的消息不返回
+	visibility, err := getConversationVisibility(userId, conversationKey{targetId: friendId})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// 尝试从缓存获取，有隐藏的消息时缓存的结果不适用
+	if cachedMessages, found := GetCachedChatHistory(userId, friendId, page); found && !visibility.hidesMessages() {
 		// 从缓存获取总数（简化处理，实际应该单独缓存总数）
 		total, _ := db.ChatRecord.Query().
 			Where(
-				chatrecord.Or(
-					chatrecord.And(
-						chatrecord.FromUserId(userId),
-						chatrecord.ToUserId(friendId),
-						chatrecord.IsGroup(false),
-					),
-					chatrecord.And(
-						chatrecord.FromUserId(friendId),
-						chatrecord.ToUserId(userId),
-						chatrecord.IsGroup(false),
-					),
-				),
+				chatrecord.ConversationId(privateConversationId(userId, friendId)),
 			).
 			Count(context.TODO())
+		attachReactions(cachedMessages, userId)
+		attachForwardInfo(cachedMessages)
+		attachPayloads(cachedMessages)
 		return cachedMessages, total, nil
 	}
 
 	// 计算偏移量
 	offset := (page - 1) * pageSize
 
-	// 查询聊天记录（双向查询）
+	// 按会话查询聊天记录（包括双方发送的消息）
 	records, err := db.ChatRecord.Query().
 		Where(
-			chatrecord.Or(
-				chatrecord.And(
-					chatrecord.FromUserId(userId),
-					chatrecord.ToUserId(friendId),
-					chatrecord.IsGroup(false),
-				),
-				chatrecord.And(
-					chatrecord.FromUserId(friendId),
-					chatrecord.ToUserId(userId),
-					chatrecord.IsGroup(false),
-				),
-			),
+			chatrecord.ConversationId(privateConversationId(userId, friendId)),
 		).
-		Order(ent.Desc(chatrecord.FieldCreateTime)).
+		Where(visibility.chatRecordPredicates()...).
+		Order(ent.Desc(chatrecord.FieldCreateTime), ent.Desc(chatrecord.FieldMsgId)).
 		Limit(pageSize).
 		Offset(offset).
 		All(context.TODO())
@@ -189,19 +379,9 @@ func GetChatHistory(userId, friendId int, page, pageSize int) ([]map[string]inte
 	// 查询总数
 	total, err := db.ChatRecord.Query().
 		Where(
-			chatrecord.Or(
-				chatrecord.And(
-					chatrecord.FromUserId(userId),
-					chatrecord.ToUserId(friendId),
-					chatrecord.IsGroup(false),
-				),
-				chatrecord.And(
-					chatrecord.FromUserId(friendId),
-					chatrecord.ToUserId(userId),
-					chatrecord.IsGroup(false),
-				),
-			),
+			chatrecord.ConversationId(privateConversationId(userId, friendId)),
 		).
+		Where(visibility.chatRecordPredicates()...).
 		Count(context.TODO())
 
 	if err != nil {
@@ -211,107 +391,95 @@ func GetChatHistory(userId, friendId int, page, pageSize int) ([]map[string]inte
 	// 组装消息详情
 	messages := make([]map[string]interface{}, 0, len(records))
 	for _, record := range records {
-		message := map[string]interface{}{
-			"msgId":      record.MsgId,
-			"fromUserId": record.FromUserId,
-			"toUserId":   record.ToUserId,
-			"msgType":    record.MsgType,
-			"createTime": record.CreateTime,
-		}
-
-		// 根据消息类型获取消息内容
-		content, err := getMessageContent(record.MsgId, record.MsgType)
-		if err == nil {
-			message["content"] = content
-		}
+		messages = append(messages, privateHistoryMessage(record))
+	}
 
-		messages = append(messages, message)
+	// 缓存查询结果，只缓存没有隐藏消息的结果
+	if !visibility.hidesMessages() {
+		_ = CacheChatHistory(userId, friendId, page, messages)
 	}
 
-	// 缓存查询结果
-	_ = CacheChatHistory(userId, friendId, page, messages)
+	// 表态信息与当前用户相关，不写入缓存
+	attachReactions(messages, userId)
+	attachForwardInfo(messages)
+	attachPayloads(messages)
 
 	return messages, total, nil
 }
 
 // GetGroupChatHistory 获取群聊历史记录
-func GetGroupChatHistory(groupId, page, pageSize int) ([]map[string]interface{}, int, error) {
-	// 尝试从缓存获取
-	if cachedMessages, found := GetCachedGroupChatHistory(groupId, page); found {
+func GetGroupChatHistory(userId, groupId, page, pageSize int) ([]map[string]interface{}, int, error) {
+	// 检查是否是群成员
+	isMember, err := IsGroupMember(groupId, userId)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !isMember {
+		return nil, 0, errors.New("不是群成员")
+	}
+
+	// 用户删除或清空的消息不返回
+	visibility, err := getConversationVisibility(userId, conversationKey{isGroup: true, targetId: groupId})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// 尝试从缓存获取，缓存按群共享，有隐藏的消息时不适用
+	if cachedMessages, found := GetCachedGroupChatHistory(groupId, page); found && !visibility.hidesMessages() {
 		// 从缓存获取总数（简化处理）
-		allRecords, _ := db.GroupChatRecord.Query().All(context.TODO())
-		groupIdStr := fmt.Sprintf("%d", groupId)
-		total := 0
-		for _, record := range allRecords {
-			if record.GroupId == groupIdStr {
-				total++
-			}
-		}
+		total, _ := db.GroupChatRecord.Query().
+			Where(groupchatrecord.GroupId(strconv.Itoa(groupId))).
+			Count(context.TODO())
+		attachReactions(cachedMessages, userId)
+		attachMentions(cachedMessages)
+		attachForwardInfo(cachedMessages)
+		attachPayloads(cachedMessages)
+		attachBlockedFlags(cachedMessages, userId)
 		return cachedMessages, total, nil
 	}
 
-	// 查询所有群聊记录，然后在代码中过滤
-	allRecords, err := db.GroupChatRecord.Query().
-		Order(ent.Desc("create_time")).
+	// 计算偏移量
+	offset := (page - 1) * pageSize
+
+	records, err := db.GroupChatRecord.Query().
+		Where(groupchatrecord.GroupId(strconv.Itoa(groupId))).
+		Where(visibility.groupChatRecordPredicates()...).
+		Order(ent.Desc(groupchatrecord.FieldCreateTime), ent.Desc(groupchatrecord.FieldMsgId)).
+		Limit(pageSize).
+		Offset(offset).
 		All(context.TODO())
 
 	if err != nil {
 		return nil, 0, errors.New("查询群聊记录失败")
 	}
 
-	// 过滤出指定群组的记录
-	groupIdStr := fmt.Sprintf("%d", groupId)
-	filteredRecords := make([]*ent.GroupChatRecord, 0)
-	for _, record := range allRecords {
-		if record.GroupId == groupIdStr {
-			filteredRecords = append(filteredRecords, record)
-		}
-	}
-
-	// 计算总数
-	total := len(filteredRecords)
+	// 查询总数
+	total, err := db.GroupChatRecord.Query().
+		Where(groupchatrecord.GroupId(strconv.Itoa(groupId))).
+		Where(visibility.groupChatRecordPredicates()...).
+		Count(context.TODO())
 
-	// 应用分页
-	offset := (page - 1) * pageSize
-	end := offset + pageSize
-	if offset > len(filteredRecords) {
-		offset = len(filteredRecords)
-	}
-	if end > len(filteredRecords) {
-		end = len(filteredRecords)
+	if err != nil {
+		return nil, 0, errors.New("查询群聊记录总数失败")
 	}
-	
-	pagedRecords := filteredRecords[offset:end]
 
 	// 组装消息详情
-	messages := make([]map[string]interface{}, 0, len(pagedRecords))
-	for _, record := range pagedRecords {
-		// 解析 fromUserId 和 msgType
-		fromUserId := 0
-		fmt.Sscanf(record.FromUserId, "%d", &fromUserId)
-		
-		msgType := 0
-		fmt.Sscanf(record.MsgType, "%d", &msgType)
-
-		message := map[string]interface{}{
-			"msgId":      record.MsgId,
-			"fromUserId": fromUserId,
-			"groupId":    groupId,
-			"msgType":    msgType,
-			"createTime": record.CreateTime,
-		}
-
-		// 根据消息类型获取消息内容
-		content, err := getMessageContent(record.MsgId, msgType)
-		if err == nil {
-			message["content"] = content
-		}
+	messages := make([]map[string]interface{}, 0, len(records))
+	for _, record := range records {
+		messages = append(messages, groupHistoryMessage(record))
+	}
 
-		messages = append(messages, message)
+	// 缓存查询结果，缓存按群共享，只缓存没有隐藏消息的结果
+	if !visibility.hidesMessages() {
+		_ = CacheGroupChatHistory(groupId, page, messages)
 	}
 
-	// 缓存查询结果
-	_ = CacheGroupChatHistory(groupId, page, messages)
+	// 表态信息与当前用户相关，不写入缓存
+	attachReactions(messages, userId)
+	attachMentions(messages)
+	attachForwardInfo(messages)
+	attachPayloads(messages)
+	attachBlockedFlags(messages, userId)
 
 	return messages, total, nil
 }
@@ -324,20 +492,45 @@ func GetOfflineMessages(userId int) ([]map[string]interface{}, error) {
 
 	messages := make([]map[string]interface{}, 0)
 
-	// 1. 查询私聊离线消息
-	privateRecords, err := db.ChatRecord.Query().
+	// 1. 查询私聊离线消息，按会话排除用户删除或清空的消息
+	senderIds, err := db.ChatRecord.Query().
 		Where(
 			chatrecord.ToUserId(userId),
 			chatrecord.CreateTimeGT(lastOnlineTime),
 			chatrecord.IsGroup(false),
 		).
-		Order(ent.Asc(chatrecord.FieldCreateTime)).
-		All(context.TODO())
+		Unique(true).
+		Select(chatrecord.FieldFromUserId).
+		Ints(context.TODO())
 
 	if err != nil {
 		return nil, errors.New("查询私聊离线消息失败")
 	}
 
+	privateRecords := make([]*ent.ChatRecord, 0)
+	for _, friendId := range senderIds {
+		visibility, err := getConversationVisibility(userId, conversationKey{targetId: friendId})
+		if err != nil {
+			return nil, err
+		}
+		records, err := db.ChatRecord.Query().
+			Where(
+				chatrecord.FromUserId(friendId),
+				chatrecord.ToUserId(userId),
+				chatrecord.CreateTimeGT(lastOnlineTime),
+				chatrecord.IsGroup(false),
+			).
+			Where(visibility.chatRecordPredicates()...).
+			All(context.TODO())
+		if err != nil {
+			return nil, errors.New("查询私聊离线消息失败")
+		}
+		privateRecords = append(privateRecords, records...)
+	}
+	sort.SliceStable(privateRecords, func(i, j int) bool {
+		return privateRecords[i].CreateTime.Before(privateRecords[j].CreateTime)
+	})
+
 	// 组装私聊消息详情
 	for _, record := range privateRecords {
 		message := map[string]interface{}{
@@ -350,7 +543,7 @@ func GetOfflineMessages(userId int) ([]map[string]interface{}, error) {
 		}
 
 		// 根据消息类型获取消息内容
-		content, err := getMessageContent(record.MsgId, record.MsgType)
+		content, err := getMessageContent(record.MsgId)
 		if err == nil {
 			message["content"] = content
 		}
@@ -359,104 +552,68 @@ func GetOfflineMessages(userId int) ([]map[string]interface{}, error) {
 	}
 
 	// 2. 查询群聊离线消息
-	// 获取用户所在的所有群组
+	// 获取用户所在的所有群组，只查询这些群中其他人发送、用户没有删除或清空的消息
 	userGroups, err := GetUserGroups(userId)
-	if err == nil {
-		// 查询所有群聊消息
-		groupRecords, err := db.GroupChatRecord.Query().
-			Order(ent.Asc("create_time")).
-			All(context.TODO())
-
-		if err == nil {
-			// 过滤出用户所在群组的消息
-			for _, record := range groupRecords {
-				if record.CreateTime.After(lastOnlineTime) {
-					// 检查是否是用户所在的群组
-					for _, group := range userGroups {
-						if fmt.Sprintf("%d", group.ID) == record.GroupId {
-							// 解析 fromUserId 和 msgType
-							fromUserId := 0
-							fmt.Sscanf(record.FromUserId, "%d", &fromUserId)
-							
-							msgType := 0
-							fmt.Sscanf(record.MsgType, "%d", &msgType)
-
-							// 不推送自己发送的消息
-							if fromUserId != userId {
-								groupId := 0
-								fmt.Sscanf(record.GroupId, "%d", &groupId)
-
-								message := map[string]interface{}{
-									"msgId":      record.MsgId,
-									"fromUserId": fromUserId,
-									"groupId":    groupId,
-									"msgType":    msgType,
-									"isGroup":    true,
-									"createTime": record.CreateTime,
-								}
-
-								// 根据消息类型获取消息内容
-								content, err := getMessageContent(record.MsgId, msgType)
-								if err == nil {
-									message["content"] = content
-								}
-
-								messages = append(messages, message)
-							}
-							break
-						}
-					}
-				}
+	if err == nil && len(userGroups) > 0 {
+		groupRecords := make([]*ent.GroupChatRecord, 0)
+		for _, group := range userGroups {
+			visibility, err := getConversationVisibility(userId, conversationKey{isGroup: true, targetId: group.ID})
+			if err != nil {
+				continue
 			}
+			records, err := db.GroupChatRecord.Query().
+				Where(
+					groupchatrecord.GroupId(strconv.Itoa(group.ID)),
+					groupchatrecord.CreateTimeGT(lastOnlineTime),
+					groupchatrecord.FromUserIdNEQ(strconv.Itoa(userId)),
+				).
+				Where(visibility.groupChatRecordPredicates()...).
+				All(context.TODO())
+			if err != nil {
+				continue
+			}
+			groupRecords = append(groupRecords, records...)
 		}
-	}
+		sort.SliceStable(groupRecords, func(i, j int) bool {
+			return groupRecords[i].CreateTime.Before(groupRecords[j].CreateTime)
+		})
 
-	return messages, nil
-}
+		for _, record := range groupRecords {
+			// 解析 fromUserId、msgType 和 groupId
+			fromUserId := 0
+			fmt.Sscanf(record.FromUserId, "%d", &fromUserId)
 
-// getMessageContent 根据消息ID和类型获取消息内容
-func getMessageContent(msgId string, msgType int) (string, error) {
-	switch msgType {
-	case dto.TEXT_MESSAGE:
-		// 查询所有文本消息并过滤
-		msgs, err := db.TextMessage.Query().All(context.TODO())
-		if err != nil {
-			return "", err
-		}
-		for _, m := range msgs {
-			if m.MsgId == msgId {
-				return m.Text, nil
-			}
-		}
-		return "", errors.New("消息不存在")
+			msgType := 0
+			fmt.Sscanf(record.MsgType, "%d", &msgType)
 
-	case dto.IMAGE_MESSAGE:
-		msgs, err := db.ImageMessage.Query().All(context.TODO())
-		if err != nil {
-			return "", err
-		}
-		for _, m := range msgs {
-			if m.MsgId == msgId {
-				return m.ImageUrl, nil
+			groupId := 0
+			fmt.Sscanf(record.GroupId, "%d", &groupId)
+
+			message := map[string]interface{}{
+				"msgId":      record.MsgId,
+				"fromUserId": fromUserId,
+				"groupId":    groupId,
+				"msgType":    msgType,
+				"isGroup":    true,
+				"createTime": record.CreateTime,
 			}
-		}
-		return "", errors.New("消息不存在")
 
-	case dto.VIDEO_MESSAGE:
-		msgs, err := db.VideoMessage.Query().All(context.TODO())
-		if err != nil {
-			return "", err
-		}
-		for _, m := range msgs {
-			if m.MsgId == msgId {
-				return m.VideoUrl, nil
+			// 根据消息类型获取消息内容
+			content, err := getMessageContent(record.MsgId)
+			if err == nil {
+				message["content"] = content
 			}
-		}
-		return "", errors.New("消息不存在")
 
-	default:
-		return "", errors.New("不支持的消息类型")
+			messages = append(messages, message)
+		}
 	}
+
+	attachMentions(messages)
+	attachForwardInfo(messages)
+	attachPayloads(messages)
+	attachBlockedFlags(messages, userId)
+
+	return messages, nil
 }
 
 // MessageDetail 消息详情结构
@@ -468,7 +625,13 @@ type MessageDetail struct {
 	Content    string                 `json:"content"`
 	IsGroup    bool                   `json:"isGroup"`
 	GroupId    *int                   `json:"groupId,omitempty"`
+	Mentions   []dto.MessageMention   `json:"mentions,omitempty"`
+	Payload    interface{}            `json:"payload,omitempty"`
+	ReplyTo    *dto.MessageReply      `json:"replyTo,omitempty"`
+	IsRevoked  bool                   `json:"isRevoked,omitempty"`
+	IsPurged   bool                   `json:"isPurged,omitempty"`
 	CreateTime time.Time              `json:"createTime"`
+	ExpireTime *time.Time             `json:"expireTime,omitempty"`
 	Extra      map[string]interface{} `json:"extra,omitempty"`
 }
 
@@ -481,7 +644,7 @@ func GetMessageDetail(msgId string) (*MessageDetail, error) {
 
 	if err == nil {
 		// 获取消息内容
-		content, err := getMessageContent(record.MsgId, record.MsgType)
+		decoded, err := loadMessage(record.MsgId)
 		if err != nil {
 			return nil, err
 		}
@@ -491,58 +654,123 @@ func GetMessageDetail(msgId string) (*MessageDetail, error) {
 			FromUserId: record.FromUserId,
 			ToUserId:   record.ToUserId,
 			MsgType:    record.MsgType,
-			Content:    content,
+			Content:    decoded.Content(),
 			IsGroup:    record.IsGroup,
+			Payload:    decoded.Body,
+			ReplyTo:    decoded.ReplyTo,
+			IsRevoked:  decoded.Revoked,
+			IsPurged:   decoded.Purged,
 			CreateTime: record.CreateTime,
+			ExpireTime: decoded.ExpireTime,
 		}
 
 		if record.IsGroup {
 			detail.GroupId = &record.GroupId
 		}
 
+		if info, ok := getForwardInfo([]string{msgId})[msgId]; ok {
+			detail.Extra = map[string]interface{}{"forwardFrom": info}
+		}
+
 		return detail, nil
 	}
 
 	// 如果私聊记录中没有找到，尝试查询群聊记录
-	groupRecords, err := db.GroupChatRecord.Query().All(context.TODO())
+	groupRecord, err := db.GroupChatRecord.Query().
+		Where(groupchatrecord.MsgId(msgId)).
+		First(context.TODO())
+	if ent.IsNotFound(err) {
+		return nil, errors.New("消息不存在")
+	}
 	if err != nil {
 		return nil, errors.New("查询群聊记录失败")
 	}
 
-	for _, groupRecord := range groupRecords {
-		if groupRecord.MsgId == msgId {
-			// 解析 fromUserId 和 msgType
-			fromUserId := 0
-			fmt.Sscanf(groupRecord.FromUserId, "%d", &fromUserId)
-			
-			msgType := 0
-			fmt.Sscanf(groupRecord.MsgType, "%d", &msgType)
+	// 解析 fromUserId 和 msgType
+	fromUserId := 0
+	fmt.Sscanf(groupRecord.FromUserId, "%d", &fromUserId)
 
-			groupId := 0
-			fmt.Sscanf(groupRecord.GroupId, "%d", &groupId)
+	msgType := 0
+	fmt.Sscanf(groupRecord.MsgType, "%d", &msgType)
 
-			// 获取消息内容
-			content, err := getMessageContent(groupRecord.MsgId, msgType)
-			if err != nil {
-				return nil, err
-			}
+	groupId := 0
+	fmt.Sscanf(groupRecord.GroupId, "%d", &groupId)
 
-			detail := &MessageDetail{
-				MsgId:      groupRecord.MsgId,
-				FromUserId: fromUserId,
-				ToUserId:   0, // 群聊消息没有特定的接收者
-				MsgType:    msgType,
-				Content:    content,
-				IsGroup:    true,
-				GroupId:    &groupId,
-				CreateTime: groupRecord.CreateTime,
-			}
+	// 获取消息内容
+	decoded, err := loadMessage(groupRecord.MsgId)
+	if err != nil {
+		return nil, err
+	}
+
+	detail := &MessageDetail{
+		MsgId:      groupRecord.MsgId,
+		FromUserId: fromUserId,
+		ToUserId:   0, // 群聊消息没有特定的接收者
+		MsgType:    msgType,
+		Content:    decoded.Content(),
+		IsGroup:    true,
+		GroupId:    &groupId,
+		Payload:    decoded.Body,
+		ReplyTo:    decoded.ReplyTo,
+		IsRevoked:  decoded.Revoked,
+		IsPurged:   decoded.Purged,
+		CreateTime: groupRecord.CreateTime,
+		ExpireTime: decoded.ExpireTime,
+	}
+
+	// 获取@提及，已撤回的消息不返回
+	if mentions, err := GetMessageMentions([]string{msgId}); err == nil && !decoded.Revoked && !decoded.Purged {
+		detail.Mentions = mentions[msgId]
+	}
+
+	if info, ok := getForwardInfo([]string{msgId})[msgId]; ok {
+		detail.Extra = map[string]interface{}{"forwardFrom": info}
+	}
+
+	return detail, nil
+}
 
-			return detail, nil
+// CanUserAccessMessage 检查用户是否有权查看消息，返回消息详情
+// 私聊消息仅发送者和接收者可见，群聊消息仅群成员可见
+func CanUserAccessMessage(msgId string, userId int) (*MessageDetail, error) {
+	detail, err := GetMessageDetail(msgId)
+	if err != nil {
+		return nil, errors.New("消息不存在")
+	}
+
+	if detail.IsGroup && detail.GroupId != nil {
+		isMember, err := IsGroupMember(*detail.GroupId, userId)
+		if err != nil {
+			return nil, err
 		}
+		if !isMember {
+			return nil, errors.New("无权访问该消息")
+		}
+		return detail, nil
 	}
 
-	return nil, errors.New("消息不存在")
+	if detail.FromUserId != userId && detail.ToUserId != userId {
+		return nil, errors.New("无权访问该消息")
+	}
+
+	return detail, nil
+}
+
+// GetMessageParticipantIds 获取消息所在会话的参与者ID列表
+func GetMessageParticipantIds(detail *MessageDetail) []int {
+	if detail.IsGroup && detail.GroupId != nil {
+		members, err := GetGroupMembers(*detail.GroupId)
+		if err != nil {
+			return nil
+		}
+		ids := make([]int, 0, len(members))
+		for _, member := range members {
+			ids = append(ids, member.ID)
+		}
+		return ids
+	}
+
+	return []int{detail.FromUserId, detail.ToUserId}
 }
 
 // GetConversationList 获取会话列表
@@ -568,6 +796,7 @@ func GetConversationList(userId int) ([]map[string]interface{}, error) {
 
 	// 去重并获取每个好友的最后一条消息
 	conversationMap := make(map[int]*ent.ChatRecord)
+	friendIds := make([]int, 0)
 	for _, record := range privateRecords {
 		friendId := record.FromUserId
 		if friendId == userId {
@@ -576,18 +805,42 @@ func GetConversationList(userId int) ([]map[string]interface{}, error) {
 
 		if _, exists := conversationMap[friendId]; !exists {
 			conversationMap[friendId] = record
+			friendIds = append(friendIds, friendId)
 		}
 	}
 
 	// 组装会话列表
 	conversations := make([]map[string]interface{}, 0)
-	for friendId, record := range conversationMap {
-		friend, err := GetUserByID(friendId)
+	for _, friendId := range friendIds {
+		record := conversationMap[friendId]
+
+		// 用户删除或清空的消息不作为最后一条消息
+		visibility, err := getConversationVisibility(userId, conversationKey{targetId: friendId})
 		if err != nil {
+			return nil, err
+		}
+		if visibility.hidesMessages() {
+			record, err = db.ChatRecord.Query().
+				Where(
+					chatrecord.ConversationId(privateConversationId(userId, friendId)),
+				).
+				Where(visibility.chatRecordPredicates()...).
+				Order(ent.Desc(chatrecord.FieldCreateTime), ent.Desc(chatrecord.FieldMsgId)).
+				First(context.TODO())
+			if err != nil && !ent.IsNotFound(err) {
+				return nil, errors.New("查询会话失败")
+			}
+		}
+
+		// 删除的会话在收到新消息前不显示；清空的会话保留，但没有最后一条消息
+		if record == nil && (visibility.clearTime == nil || visibility.hidden) {
 			continue
 		}
 
-		content, _ := getMessageContent(record.MsgId, record.MsgType)
+		friend, err := GetUserByID(friendId)
+		if err != nil {
+			continue
+		}
 
 		// 计算未读消息数
 		unreadCount, _ := GetUnreadMessageCount(userId, friendId, nil)
@@ -596,10 +849,20 @@ func GetConversationList(userId int) ([]map[string]interface{}, error) {
 			"type":        "private",
 			"friendId":    friendId,
 			"friendName":  friend.Nickname,
-			"lastMessage": content,
-			"lastTime":    record.CreateTime,
 			"unreadCount": unreadCount,
 		}
+		if record != nil {
+			preview := ""
+			if decoded, err := loadMessage(record.MsgId); err == nil {
+				preview = decoded.Preview()
+			}
+			conversation["lastMessage"] = preview
+			conversation["lastMessageType"] = record.MsgType
+			conversation["lastTime"] = record.CreateTime
+		} else {
+			conversation["lastMessage"] = ""
+			conversation["lastTime"] = *visibility.clearTime
+		}
 
 		conversations = append(conversations, conversation)
 	}
@@ -612,7 +875,12 @@ func GetConversationList(userId int) ([]map[string]interface{}, error) {
 // GetUnreadMessageCount 获取未读消息数
 // friendId: 私聊好友ID（如果为0，则查询所有私聊）
 // groupId: 群聊ID（如果为nil，则查询所有群聊）
+// 私聊未读数来自消息状态记录，群聊未读数根据已读水位计算
 func GetUnreadMessageCount(userId int, friendId int, groupId *int) (int, error) {
+	if groupId != nil {
+		return countGroupUnread(userId, *groupId)
+	}
+
 	// 查询消息状态表中该用户的未读消息
 	query := db.MessageStatus.Query().
 		Where(
@@ -642,28 +910,37 @@ func GetUnreadMessageCount(userId int, friendId int, groupId *int) (int, error)
 					}
 				}
 			}
-		} else if groupId != nil {
-			// 如果是群聊
-			record, err := db.ChatRecord.Query().
-				Where(chatrecord.MsgId(status.MsgId)).
-				First(context.TODO())
-			if err == nil {
-				// GroupId是int类型，如果IsGroup为true，则GroupId应该有有效值
-				if record.IsGroup && record.GroupId == *groupId {
-					count++
-				}
-			}
 		} else {
 			// 查询所有未读消息
 			count++
 		}
 	}
 
+	// 未指定好友时加上所有群聊的未读数
+	if friendId == 0 {
+		groups, err := GetUserGroups(userId)
+		if err != nil {
+			return 0, err
+		}
+		for _, g := range groups {
+			groupCount, err := countGroupUnread(userId, g.ID)
+			if err != nil {
+				return 0, err
+			}
+			count += groupCount
+		}
+	}
+
 	return count, nil
 }
 
 // MarkAllMessagesAsRead 标记所有消息为已读
+// 群聊直接将已读水位推进到最新消息
 func MarkAllMessagesAsRead(userId int, friendId *int, groupId *int) error {
+	if groupId != nil {
+		return markGroupAsRead(userId, *groupId)
+	}
+
 	// 查询该用户的所有未读消息状态
 	query := db.MessageStatus.Query().
 		Where(
@@ -692,17 +969,6 @@ func MarkAllMessagesAsRead(userId int, friendId *int, groupId *int) error {
 						Save(context.TODO())
 				}
 			}
-		} else if groupId != nil {
-			// 如果指定了groupId，只标记该群聊的消息
-			record, err := db.ChatRecord.Query().
-				Where(chatrecord.MsgId(status.MsgId)).
-				First(context.TODO())
-			if err == nil && record.IsGroup && record.GroupId == *groupId {
-				_, _ = db.MessageStatus.UpdateOneID(status.ID).
-					SetIsRead(true).
-					SetReadTime(now).
-					Save(context.TODO())
-			}
 		} else {
 			// 标记所有消息为已读
 			_, _ = db.MessageStatus.UpdateOneID(status.ID).
@@ -712,45 +978,119 @@ func MarkAllMessagesAsRead(userId int, friendId *int, groupId *int) error {
 		}
 	}
 
+	// 未指定好友时同时标记所有群聊
+	if friendId == nil {
+		groups, err := GetUserGroups(userId)
+		if err != nil {
+			return err
+		}
+		for _, g := range groups {
+			if err := markGroupAsRead(userId, g.ID); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
-// CreateMessageStatus 创建消息状态记录
-func CreateMessageStatus(msgId string, userId int) error {
-	_, err := db.MessageStatus.Create().
-		SetMsgId(msgId).
-		SetUserId(userId).
-		SetIsDelivered(false).
-		SetIsRead(false).
-		Save(context.TODO())
-	
+// markGroupAsRead 将用户在群聊中的已读水位推进到最新消息
+func markGroupAsRead(userId, groupId int) error {
+	isMember, err := IsGroupMember(groupId, userId)
+	if err != nil {
+		return err
+	}
+	if !isMember {
+		return errors.New("不是群成员")
+	}
+
+	seq, err := latestGroupSeq(context.TODO(), db, groupId)
 	if err != nil {
+		return err
+	}
+	if seq == 0 {
+		return nil
+	}
+	return advanceGroupWatermark(userId, groupId, seq, seq)
+}
+
+// saveMessageStatuses 在事务中为接收者批量创建消息状态记录
+func saveMessageStatuses(ctx context.Context, tx *ent.Tx, msgId string, userIds []int) error {
+	if len(userIds) == 0 {
+		return nil
+	}
+
+	builders := make([]*ent.MessageStatusCreate, 0, len(userIds))
+	for _, userId := range userIds {
+		builders = append(builders, tx.MessageStatus.Create().
+			SetMsgId(msgId).
+			SetUserId(userId).
+			SetIsDelivered(false).
+			SetIsRead(false))
+	}
+
+	if _, err := tx.MessageStatus.CreateBulk(builders...).Save(ctx); err != nil {
 		return errors.New("创建消息状态失败")
 	}
 	return nil
 }
 
-// MarkMessageAsDelivered 标记消息为已送达
-func MarkMessageAsDelivered(msgId string, userId int) error {
-	// 查询所有消息状态并过滤
-	allStatus, err := db.MessageStatus.Query().
-		All(context.TODO())
-	
+// markGroupMessage 推进群消息所在群聊的水位，read 为 false 时只推进送达水位
+func markGroupMessage(seq *groupMessageSeq, userId int, read bool) error {
+	isMember, err := IsGroupMember(seq.GroupId, userId)
 	if err != nil {
-		return errors.New("查询消息状态失败")
+		return err
+	}
+	if !isMember {
+		return errors.New("不是群成员")
 	}
 
-	// 过滤出匹配的状态
-	var targetStatus *ent.MessageStatus
-	for _, s := range allStatus {
-		if s.MsgId == msgId && s.UserId == userId {
-			targetStatus = s
-			break
-		}
+	if read {
+		return advanceGroupWatermark(userId, seq.GroupId, seq.Seq, seq.Seq)
+	}
+	return advanceGroupWatermark(userId, seq.GroupId, 0, seq.Seq)
+}
+
+// markMessageStatusesRead 将用户的一组私聊消息状态标记为已读
+func markMessageStatusesRead(userId int, msgIds []string) {
+	_, _ = db.MessageStatus.Update().
+		Where(
+			entmessagestatus.UserId(userId),
+			entmessagestatus.MsgIdIn(msgIds...),
+			entmessagestatus.IsRead(false),
+		).
+		SetIsRead(true).
+		SetReadTime(time.Now()).
+		Save(context.TODO())
+}
+
+// findMessageStatus 查询私聊消息的状态记录
+func findMessageStatus(msgId string, userId int) (*ent.MessageStatus, error) {
+	status, err := db.MessageStatus.Query().
+		Where(
+			entmessagestatus.MsgId(msgId),
+			entmessagestatus.UserId(userId),
+		).
+		First(context.TODO())
+	if ent.IsNotFound(err) {
+		return nil, errors.New("消息状态不存在")
+	}
+	if err != nil {
+		return nil, errors.New("查询消息状态失败")
+	}
+	return status, nil
+}
+
+// MarkMessageAsDelivered 标记消息为已送达
+// 群消息推进送达水位，之前的消息一并视为已送达
+func MarkMessageAsDelivered(msgId string, userId int) error {
+	if seq, err := getGroupMessageSeq(msgId); err == nil {
+		return markGroupMessage(seq, userId, false)
 	}
 
-	if targetStatus == nil {
-		return errors.New("消息状态不存在")
+	targetStatus, err := findMessageStatus(msgId, userId)
+	if err != nil {
+		return err
 	}
 
 	// 更新为已送达
@@ -759,7 +1099,7 @@ func MarkMessageAsDelivered(msgId string, userId int) error {
 		SetIsDelivered(true).
 		SetDeliveredTime(now).
 		Save(context.TODO())
-	
+
 	if err != nil {
 		return errors.New("更新消息送达状态失败")
 	}
@@ -768,26 +1108,15 @@ func MarkMessageAsDelivered(msgId string, userId int) error {
 }
 
 // MarkMessageAsRead 标记消息为已读
+// 群消息推进已读水位，之前的消息一并视为已读
 func MarkMessageAsRead(msgId string, userId int) error {
-	// 查询消息状态
-	status, err := db.MessageStatus.Query().
-		All(context.TODO())
-	
-	if err != nil {
-		return errors.New("查询消息状态失败")
-	}
-
-	// 过滤出匹配的状态
-	var targetStatus *ent.MessageStatus
-	for _, s := range status {
-		if s.MsgId == msgId && s.UserId == userId {
-			targetStatus = s
-			break
-		}
+	if seq, err := getGroupMessageSeq(msgId); err == nil {
+		return markGroupMessage(seq, userId, true)
 	}
 
-	if targetStatus == nil {
-		return errors.New("消息状态不存在")
+	targetStatus, err := findMessageStatus(msgId, userId)
+	if err != nil {
+		return err
 	}
 
 	// 更新为已读
@@ -795,7 +1124,7 @@ func MarkMessageAsRead(msgId string, userId int) error {
 	updateQuery := targetStatus.Update().
 		SetIsRead(true).
 		SetReadTime(now)
-	
+
 	// 如果还未送达，同时标记为已送达
 	if !targetStatus.IsDelivered {
 		updateQuery = updateQuery.
@@ -804,7 +1133,7 @@ func MarkMessageAsRead(msgId string, userId int) error {
 	}
 
 	_, err = updateQuery.Save(context.TODO())
-	
+
 	if err != nil {
 		return errors.New("更新消息已读状态失败")
 	}
@@ -813,23 +1142,34 @@ func MarkMessageAsRead(msgId string, userId int) error {
 }
 
 // GetMessageStatus 获取消息状态
+// 群消息的状态由用户在群聊中的水位得出
 func GetMessageStatus(msgId string, userId int) (*ent.MessageStatus, error) {
-	// 查询所有消息状态
-	allStatus, err := db.MessageStatus.Query().
-		All(context.TODO())
-	
+	seq, err := getGroupMessageSeq(msgId)
 	if err != nil {
-		return nil, errors.New("查询消息状态失败")
+		return findMessageStatus(msgId, userId)
 	}
 
-	// 过滤出匹配的状态
-	for _, s := range allStatus {
-		if s.MsgId == msgId && s.UserId == userId {
-			return s, nil
-		}
+	watermark, err := getGroupWatermark(userId, seq.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	if watermark == nil {
+		return nil, errors.New("消息状态不存在")
 	}
 
-	return nil, errors.New("消息状态不存在")
+	status := &ent.MessageStatus{
+		MsgId:       msgId,
+		UserId:      userId,
+		IsDelivered: watermark.DeliveredSeq >= seq.Seq,
+		IsRead:      watermark.ReadSeq >= seq.Seq,
+	}
+	if status.IsDelivered {
+		status.DeliveredTime = watermark.DeliveredTime
+	}
+	if status.IsRead {
+		status.ReadTime = watermark.ReadTime
+	}
+	return status, nil
 }
 
 // RecallMessage 撤回消息（限制2分钟内）
@@ -874,18 +1214,19 @@ func RecallMessage(msgId string, userId int) (*MessageDetail, error) {
 		return nil, errors.New("撤回消息失败")
 	}
 
+	// 已撤回的消息不再出现在搜索结果中
+	RemoveMessageFromSearch(msgId)
+
 	return messageDetail, nil
 }
 
-// BuildGroupMessageDetail 构建群聊消息详情
-func BuildGroupMessageDetail(msgId string, fromUserId, groupId, msgType int, content string) map[string]interface{} {
-	return map[string]interface{}{
-		"msgId":      msgId,
-		"fromUserId": fromUserId,
-		"groupId":    groupId,
-		"msgType":    msgType,
-		"content":    content,
-		"isGroup":    true,
-		"createTime": time.Now(),
-	}
+// isMessageRevoked 检查消息是否已撤回
+func isMessageRevoked(msgId string) bool {
+	revoked, err := db.Message.Query().
+		Where(
+			message.MsgId(msgId),
+			message.IsRevoked(true),
+		).
+		Exist(context.TODO())
+	return err == nil && revoked
 }
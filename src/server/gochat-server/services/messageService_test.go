@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"gochat_server/dto"
+	"gochat_server/ent"
+	"gochat_server/ent/enttest"
+	"gochat_server/ent/hook"
+)
+
+// errInjected 测试中注入的写入失败
+var errInjected = errors.New("injected failure")
+
+// useTestClient 使用内存 SQLite 数据库替换全局客户端，测试结束后恢复
+func useTestClient(t *testing.T) *ent.Client {
+	t.Helper()
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name())
+	client := enttest.Open(t, "sqlite3", dsn)
+
+	previous := db
+	db = client
+	t.Cleanup(func() {
+		db = previous
+		client.Close()
+	})
+	return client
+}
+
+// failMessageStatusCreate 保存消息状态时返回错误
+func failMessageStatusCreate(client *ent.Client) {
+	client.MessageStatus.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.MessageStatusFunc(func(ctx context.Context, m *ent.MessageStatusMutation) (ent.Value, error) {
+			return nil, errInjected
+		})
+	})
+}
+
+// failMessageOutboxCreate 写入推送记录时返回错误
+func failMessageOutboxCreate(client *ent.Client) {
+	client.MessageOutbox.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.MessageOutboxFunc(func(ctx context.Context, m *ent.MessageOutboxMutation) (ent.Value, error) {
+			return nil, errInjected
+		})
+	})
+}
+
+// TestSendMessageRollsBackOnFailure 消息状态或推送记录写入失败时，消息和聊天记录一起回滚
+func TestSendMessageRollsBackOnFailure(t *testing.T) {
+	tests := []struct {
+		name   string
+		group  bool
+		inject func(client *ent.Client)
+	}{
+		{"private message status fails", false, failMessageStatusCreate},
+		{"private message outbox fails", false, failMessageOutboxCreate},
+		{"group message outbox fails", true, failMessageOutboxCreate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := useTestClient(t)
+
+			alice := client.User.Create().SetUsername("alice").SetPassword("pw").SetNickname("alice").SaveX(ctx)
+			bob := client.User.Create().SetUsername("bob").SetPassword("pw").SetNickname("bob").SaveX(ctx)
+			client.FriendRelationship.Create().SetUserId(alice.ID).SetFriendId(bob.ID).SaveX(ctx)
+			client.FriendRelationship.Create().SetUserId(bob.ID).SetFriendId(alice.ID).SaveX(ctx)
+
+			var groupId *int
+			if tt.group {
+				g := client.Group.Create().
+					SetGroupId("test-group").
+					SetGroupName("test").
+					SetOwnerId(alice.ID).
+					SetCreateUserId(alice.ID).
+					SetMembers([]int{alice.ID, bob.ID}).
+					SaveX(ctx)
+				groupId = &g.ID
+			}
+
+			tt.inject(client)
+
+			msgId, err := SendMessage(ctx, alice.ID, bob.ID, dto.TEXT_MESSAGE, "hello", groupId, nil, "")
+			if err == nil {
+				t.Fatalf("SendMessage succeeded with msgId %s, want error", msgId)
+			}
+
+			counts := map[string]int{
+				"Message":         client.Message.Query().CountX(ctx),
+				"ChatRecord":      client.ChatRecord.Query().CountX(ctx),
+				"GroupChatRecord": client.GroupChatRecord.Query().CountX(ctx),
+				"MessageStatus":   client.MessageStatus.Query().CountX(ctx),
+				"MessageOutbox":   client.MessageOutbox.Query().CountX(ctx),
+			}
+			for table, count := range counts {
+				if count != 0 {
+					t.Errorf("%s has %d rows after rollback, want 0", table, count)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,432 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gochat_server/dto"
+	"gochat_server/ent"
+	"gochat_server/ent/chatrecord"
+	"gochat_server/ent/conversationtimer"
+	"gochat_server/ent/groupchatrecord"
+	"gochat_server/ent/message"
+	"gochat_server/ent/messagedeletion"
+	"gochat_server/ent/messageforward"
+	"gochat_server/ent/messagemention"
+	"gochat_server/ent/messageoutbox"
+	"gochat_server/ent/messagereaction"
+	"gochat_server/ent/messagestatus"
+	"log"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// MessageTimerOptions 可选的阅后即焚时长（秒），0 表示关闭
+var MessageTimerOptions = []int{0, 30, 5 * 60, 60 * 60, 24 * 60 * 60, 7 * 24 * 60 * 60}
+
+const (
+	// messageReaperInterval 清理到期消息的间隔，决定消息实际删除时间的最大延迟
+	messageReaperInterval = 5 * time.Second
+	// messageReaperBatchSize 每批删除的消息数
+	messageReaperBatchSize = 200
+)
+
+// MessageTimer 会话的阅后即焚设置
+type MessageTimer struct {
+	TtlSeconds   int        `json:"ttlSeconds"`
+	UpdateUserId int        `json:"updateUserId,omitempty"`
+	UpdateTime   *time.Time `json:"updateTime,omitempty"`
+}
+
+// timerConversationId 计时器的会话ID，私聊与聊天记录的会话ID一致
+func timerConversationId(fromUserId, toUserId int, groupId *int) string {
+	if groupId != nil && *groupId > 0 {
+		return fmt.Sprintf("group_%d", *groupId)
+	}
+	return privateConversationId(fromUserId, toUserId)
+}
+
+// checkMessageTimerAccess 检查用户能否查看或设置会话的计时器
+// 私聊双方都可以设置，群聊只有管理员可以设置
+func checkMessageTimerAccess(userId, friendId, groupId int, update bool) error {
+	switch {
+	case friendId > 0 && groupId > 0:
+		return errors.New("不能同时指定好友和群组")
+	case friendId > 0:
+		isFriend, err := IsFriend(userId, friendId)
+		if err != nil {
+			return err
+		}
+		if !isFriend {
+			return errors.New("只能设置好友会话")
+		}
+	case groupId > 0:
+		if update {
+			isAdmin, err := IsGroupAdmin(groupId, userId)
+			if err != nil {
+				return err
+			}
+			if !isAdmin {
+				return errors.New("只有群管理员可以设置阅后即焚")
+			}
+			return nil
+		}
+		isMember, err := IsGroupMember(groupId, userId)
+		if err != nil {
+			return err
+		}
+		if !isMember {
+			return errors.New("不是群成员")
+		}
+	default:
+		return errors.New("请提供friendId或groupId参数")
+	}
+	return nil
+}
+
+// findMessageTimer 查询会话的计时器，未设置时返回 nil
+func findMessageTimer(conversationId string) (*ent.ConversationTimer, error) {
+	timer, err := db.ConversationTimer.Query().
+		Where(conversationtimer.ConversationId(conversationId)).
+		First(context.TODO())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.New("查询阅后即焚设置失败")
+	}
+	return timer, nil
+}
+
+// GetMessageTimer 获取会话的阅后即焚设置
+func GetMessageTimer(userId, friendId, groupId int) (*MessageTimer, error) {
+	if err := checkMessageTimerAccess(userId, friendId, groupId, false); err != nil {
+		return nil, err
+	}
+
+	timer, err := findMessageTimer(timerConversationId(userId, friendId, &groupId))
+	if err != nil {
+		return nil, err
+	}
+	if timer == nil {
+		return &MessageTimer{}, nil
+	}
+	return &MessageTimer{
+		TtlSeconds:   timer.TtlSeconds,
+		UpdateUserId: timer.UpdateUserId,
+		UpdateTime:   &timer.UpdateTime,
+	}, nil
+}
+
+// SetMessageTimer 设置会话的阅后即焚时长，之后发送的消息在该时长后自动删除
+// 设置变更时在会话中发送一条系统消息
+func SetMessageTimer(userId, friendId, groupId, ttlSeconds int) (*MessageTimer, error) {
+	valid := false
+	for _, option := range MessageTimerOptions {
+		if ttlSeconds == option {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return nil, errors.New("不支持的阅后即焚时长")
+	}
+	if err := checkMessageTimerAccess(userId, friendId, groupId, true); err != nil {
+		return nil, err
+	}
+
+	ctx := context.TODO()
+	conversationId := timerConversationId(userId, friendId, &groupId)
+	current, err := findMessageTimer(conversationId)
+	if err != nil {
+		return nil, err
+	}
+	if (current == nil && ttlSeconds == 0) || (current != nil && current.TtlSeconds == ttlSeconds) {
+		return GetMessageTimer(userId, friendId, groupId)
+	}
+
+	now := time.Now()
+	if current == nil {
+		current, err = db.ConversationTimer.Create().
+			SetConversationId(conversationId).
+			SetIsGroup(groupId > 0).
+			SetTtlSeconds(ttlSeconds).
+			SetUpdateUserId(userId).
+			SetUpdateTime(now).
+			Save(ctx)
+	} else {
+		current, err = current.Update().
+			SetTtlSeconds(ttlSeconds).
+			SetUpdateUserId(userId).
+			SetUpdateTime(now).
+			Save(ctx)
+	}
+	if err != nil {
+		return nil, errors.New("保存阅后即焚设置失败")
+	}
+
+	// 在会话中提示设置变更
+	nickname := ""
+	if u, err := GetUserByID(userId); err == nil {
+		nickname = u.Nickname
+	}
+	text := nickname + " 关闭了阅后即焚"
+	if ttlSeconds > 0 {
+		text = fmt.Sprintf("%s 开启了阅后即焚，消息将在%s后自动删除", nickname, formatTimerDuration(ttlSeconds))
+	}
+	var targetGroupId *int
+	if groupId > 0 {
+		targetGroupId = &groupId
+	}
+	_, err = sendMessage(ctx, userId, friendId, targetGroupId, &outgoingMessage{
+		MsgType: dto.SYSTEM_MESSAGE,
+		Body: &dto.SystemContent{
+			Event:      dto.SYSTEM_EVENT_MESSAGE_TIMER,
+			Text:       text,
+			OperatorId: userId,
+			TtlSeconds: ttlSeconds,
+		},
+	}, nil)
+	if err != nil {
+		log.Printf("Failed to send message timer notice: %v", err)
+	}
+
+	return &MessageTimer{
+		TtlSeconds:   current.TtlSeconds,
+		UpdateUserId: current.UpdateUserId,
+		UpdateTime:   &current.UpdateTime,
+	}, nil
+}
+
+// formatTimerDuration 计时器时长的显示文本
+func formatTimerDuration(seconds int) string {
+	switch {
+	case seconds%(24*60*60) == 0:
+		return fmt.Sprintf("%d天", seconds/(24*60*60))
+	case seconds%(60*60) == 0:
+		return fmt.Sprintf("%d小时", seconds/(60*60))
+	case seconds%60 == 0:
+		return fmt.Sprintf("%d分钟", seconds/60)
+	default:
+		return fmt.Sprintf("%d秒", seconds)
+	}
+}
+
+// messageExpireTime 根据会话的计时器计算新消息的过期时间，未开启时返回 nil
+func messageExpireTime(fromUserId, toUserId int, groupId *int, createTime time.Time) (*time.Time, error) {
+	timer, err := findMessageTimer(timerConversationId(fromUserId, toUserId, groupId))
+	if err != nil {
+		return nil, err
+	}
+	if timer == nil || timer.TtlSeconds <= 0 {
+		return nil, nil
+	}
+	expireTime := createTime.Add(time.Duration(timer.TtlSeconds) * time.Second)
+	return &expireTime, nil
+}
+
+var messageReaperOnce sync.Once
+
+// StartMessageReaper 启动到期消息清理任务
+func StartMessageReaper() {
+	messageReaperOnce.Do(func() {
+		go func() {
+			ticker := time.NewTicker(messageReaperInterval)
+			defer ticker.Stop()
+			for range ticker.C {
+				reapExpiredMessages()
+			}
+		}()
+		log.Printf("Message reaper started")
+	})
+}
+
+// reapExpiredMessages 分批删除所有到期的消息
+func reapExpiredMessages() {
+	for {
+		count, err := reapExpiredBatch()
+		if err != nil {
+			log.Printf("Failed to reap expired messages: %v", err)
+			return
+		}
+		if count < messageReaperBatchSize {
+			return
+		}
+	}
+}
+
+// reapExpiredBatch 删除一批到期的消息及其聊天记录、状态、媒体文件，并通知会话中的用户
+func reapExpiredBatch() (int, error) {
+	ctx := context.TODO()
+
+	expired, err := db.Message.Query().
+		Where(message.ExpireTimeLTE(time.Now())).
+		Order(ent.Asc(message.FieldExpireTime)).
+		Limit(messageReaperBatchSize).
+		All(ctx)
+	if err != nil {
+		return 0, errors.New("查询到期消息失败")
+	}
+	if len(expired) == 0 {
+		return 0, nil
+	}
+
+	msgIds := make([]string, 0, len(expired))
+	storageKeys := make([]string, 0)
+	for _, m := range expired {
+		msgIds = append(msgIds, m.MsgId)
+		// 已撤回的消息仍保留消息体，媒体文件同样需要删除
+		if decoded, err := decodeMessageBody(m.Body); err == nil {
+			if key := messageStorageKey(decoded.Body); key != "" {
+				storageKeys = append(storageKeys, key)
+			}
+		}
+	}
+
+	// 删除前查询消息所在的会话，用于通知和清除缓存
+	chatRecords, err := db.ChatRecord.Query().
+		Where(chatrecord.MsgIdIn(msgIds...)).
+		All(ctx)
+	if err != nil {
+		return 0, errors.New("查询聊天记录失败")
+	}
+	groupRecords, err := db.GroupChatRecord.Query().
+		Where(groupchatrecord.MsgIdIn(msgIds...)).
+		All(ctx)
+	if err != nil {
+		return 0, errors.New("查询群聊记录失败")
+	}
+
+	err = withTx(ctx, func(tx *ent.Tx) error {
+		if _, err := tx.Message.Delete().Where(message.MsgIdIn(msgIds...)).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.ChatRecord.Delete().Where(chatrecord.MsgIdIn(msgIds...)).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.GroupChatRecord.Delete().Where(groupchatrecord.MsgIdIn(msgIds...)).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.MessageStatus.Delete().Where(messagestatus.MsgIdIn(msgIds...)).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.MessageMention.Delete().Where(messagemention.MsgIdIn(msgIds...)).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.MessageReaction.Delete().Where(messagereaction.MsgIdIn(msgIds...)).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.MessageForward.Delete().Where(messageforward.MsgIdIn(msgIds...)).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.MessageDeletion.Delete().Where(messagedeletion.MsgIdIn(msgIds...)).Exec(ctx); err != nil {
+			return err
+		}
+		_, err := tx.MessageOutbox.Delete().Where(messageoutbox.MsgIdIn(msgIds...)).Exec(ctx)
+		return err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("删除到期消息失败: %v", err)
+	}
+
+	for _, msgId := range msgIds {
+		RemoveMessageFromSearch(msgId)
+	}
+	removeUnreferencedMedia(storageKeys)
+	notifyExpiredMessages(chatRecords, groupRecords)
+
+	return len(expired), nil
+}
+
+// messageStorageKey 消息体中由本服务存储的媒体文件
+func messageStorageKey(body interface{}) string {
+	switch b := body.(type) {
+	case *dto.MediaBody:
+		return b.StorageKey
+	case *dto.FileContent:
+		return b.StorageKey
+	case *dto.VoiceContent:
+		return b.StorageKey
+	}
+	return ""
+}
+
+// bodyStorageKey 编码后的消息体中由本服务存储的媒体文件，保存在 Message 的 storageKey 字段中
+func bodyStorageKey(raw string) string {
+	decoded, err := decodeMessageBody(raw)
+	if err != nil {
+		return ""
+	}
+	return messageStorageKey(decoded.Body)
+}
+
+// removeUnreferencedMedia 删除已删除或已清理消息的媒体文件，返回删除的文件数
+// 转发的消息复用原消息的消息体，仍被其他消息引用的文件不删除
+func removeUnreferencedMedia(storageKeys []string) int {
+	deleted := 0
+	for _, key := range uniqueStrings(storageKeys) {
+		referenced, err := db.Message.Query().
+			Where(message.StorageKey(key)).
+			Exist(context.TODO())
+		if err != nil || referenced {
+			continue
+		}
+		if err := DeleteFile(key); err != nil {
+			log.Printf("Failed to delete media %s: %v", key, err)
+			continue
+		}
+		deleted++
+	}
+	return deleted
+}
+
+// notifyExpiredMessages 按会话通知用户消息已过期删除，并清除历史记录缓存
+func notifyExpiredMessages(chatRecords []*ent.ChatRecord, groupRecords []*ent.GroupChatRecord) {
+	private := make(map[string][]*ent.ChatRecord)
+	for _, record := range chatRecords {
+		id := privateConversationId(record.FromUserId, record.ToUserId)
+		private[id] = append(private[id], record)
+	}
+	for _, records := range private {
+		fromUserId, toUserId := records[0].FromUserId, records[0].ToUserId
+		msgIds := make([]string, 0, len(records))
+		for _, record := range records {
+			msgIds = append(msgIds, record.MsgId)
+		}
+		_ = InvalidateChatHistoryCache(fromUserId, toUserId)
+
+		for _, pair := range [][2]int{{fromUserId, toUserId}, {toUserId, fromUserId}} {
+			_ = SendNotificationToUser(strconv.Itoa(pair[0]), map[string]interface{}{
+				"type": "message_expired",
+				"data": map[string]interface{}{
+					"friendId": pair[1],
+					"msgIds":   msgIds,
+				},
+			})
+		}
+	}
+
+	groups := make(map[int][]string)
+	for _, record := range groupRecords {
+		groupId, _ := strconv.Atoi(record.GroupId)
+		groups[groupId] = append(groups[groupId], record.MsgId)
+	}
+	for groupId, msgIds := range groups {
+		_ = InvalidateGroupChatHistoryCache(groupId)
+
+		members, err := GetGroupMembers(groupId)
+		if err != nil {
+			continue
+		}
+		for _, member := range members {
+			_ = SendNotificationToUser(strconv.Itoa(member.ID), map[string]interface{}{
+				"type": "message_expired",
+				"data": map[string]interface{}{
+					"groupId": groupId,
+					"msgIds":  msgIds,
+				},
+			})
+		}
+	}
+}
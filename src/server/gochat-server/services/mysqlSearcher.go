@@ -0,0 +1,81 @@
+package services
+
+import (
+	"context"
+	"strings"
+)
+
+// mysqlSearcher 基于 MySQL FULLTEXT 索引的全文搜索实现
+// 使用 ngram 解析器以支持中文，单个字符的关键字退化为 LIKE 匹配
+type mysqlSearcher struct{}
+
+func (s *mysqlSearcher) ensureIndex(ctx context.Context) error {
+	_, err := rawDB.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS message_search (
+		msg_id VARCHAR(64) NOT NULL PRIMARY KEY,
+		content TEXT NOT NULL,
+		from_user_id INT NOT NULL,
+		to_user_id INT NOT NULL DEFAULT 0,
+		group_id INT NOT NULL DEFAULT 0,
+		is_group TINYINT NOT NULL DEFAULT 0,
+		create_time BIGINT NOT NULL,
+		INDEX idx_message_search_conversation (is_group, group_id, create_time),
+		INDEX idx_message_search_private (from_user_id, to_user_id, create_time),
+		FULLTEXT INDEX ft_message_search_content (content) WITH PARSER ngram
+	) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`)
+	return err
+}
+
+func (s *mysqlSearcher) count(ctx context.Context) (int, error) {
+	var count int
+	err := rawDB.QueryRowContext(ctx, `SELECT COUNT(*) FROM message_search`).Scan(&count)
+	return count, err
+}
+
+func (s *mysqlSearcher) index(ctx context.Context, doc *searchDocument) error {
+	_, err := rawDB.ExecContext(ctx,
+		`INSERT INTO message_search (msg_id, content, from_user_id, to_user_id, group_id, is_group, create_time)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE content = VALUES(content)`,
+		doc.MsgId, doc.Content, doc.FromUserId, doc.ToUserId, doc.GroupId, boolToInt(doc.IsGroup), doc.CreateTime.UnixMilli())
+	return err
+}
+
+func (s *mysqlSearcher) remove(ctx context.Context, msgId string) error {
+	_, err := rawDB.ExecContext(ctx, `DELETE FROM message_search WHERE msg_id = ?`, msgId)
+	return err
+}
+
+func (s *mysqlSearcher) search(ctx context.Context, query *SearchQuery) ([]*searchDocument, int, error) {
+	placeholder := func(int) string { return "?" }
+
+	// ngram 默认以2个字符为单位切分，单字符关键字无法通过全文索引匹配
+	var match string
+	args := make([]interface{}, 0)
+	if keywordRuneCount(query.Keyword) >= 2 {
+		match = "MATCH(content) AGAINST(? IN BOOLEAN MODE)"
+		args = append(args, `"`+strings.ReplaceAll(query.Keyword, `"`, ` `)+`"`)
+	} else {
+		match = `content LIKE ?`
+		args = append(args, escapeLikePattern(query.Keyword))
+	}
+
+	conditions, args := buildSearchConditions(query, placeholder, args)
+	where := match + " AND " + conditions
+
+	var total int
+	if err := rawDB.QueryRowContext(ctx, `SELECT COUNT(*) FROM message_search WHERE `+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := rawDB.QueryContext(ctx,
+		`SELECT msg_id, content, from_user_id, to_user_id, group_id, is_group, create_time
+		FROM message_search WHERE `+where+` ORDER BY create_time DESC LIMIT ? OFFSET ?`,
+		append(args, query.PageSize, (query.Page-1)*query.PageSize)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	docs, err := scanSearchDocuments(rows)
+	return docs, total, err
+}
@@ -1,7 +1,9 @@
 package services
 
 import (
+	"gochat_server/ent"
 	"log"
+	"strconv"
 	"time"
 )
 
@@ -43,12 +45,27 @@ func SendNotificationToUser(userId string, notification map[string]interface{})
 }
 
 // SendChatMessageNotification 发送聊天消息通知
-func SendChatMessageNotification(toUserId string, fromUserId int, fromUserNickname string, msgId string, content string, isGroup bool, groupName string) error {
+// 接收者开启免打扰时不发送通知；群消息中被@的成员会绕过群聊免打扰，但仍遵守全局免打扰
+func SendChatMessageNotification(toUserId string, fromUserId int, fromUserNickname string, msgId string, content string, isGroup bool, groupId int, groupName string, isMentioned bool) error {
+	if isDoNotDisturbForNotification(toUserId, fromUserId, isGroup, groupId, isMentioned) {
+		log.Printf("User %s is in do-not-disturb mode, chat notification %s suppressed", toUserId, msgId)
+		return nil
+	}
+
+	return sendChatMessageNotification(toUserId, fromUserId, fromUserNickname, msgId, content, isGroup, groupId, groupName, isMentioned)
+}
+
+// sendChatMessageNotification 发送聊天消息通知，不检查免打扰，调用方已经检查过时使用
+func sendChatMessageNotification(toUserId string, fromUserId int, fromUserNickname string, msgId string, content string, isGroup bool, groupId int, groupName string, isMentioned bool) error {
 	var message string
 	var notificationType string
 
 	if isGroup {
-		message = fromUserNickname + " 在群 " + groupName + " 中发送了消息"
+		if isMentioned {
+			message = fromUserNickname + " 在群 " + groupName + " 中@了你"
+		} else {
+			message = fromUserNickname + " 在群 " + groupName + " 中发送了消息"
+		}
 		notificationType = "group_message"
 	} else {
 		message = fromUserNickname + " 发送了消息"
@@ -63,7 +80,9 @@ func SendChatMessageNotification(toUserId string, fromUserId int, fromUserNickna
 			"fromUserNickname": fromUserNickname,
 			"content":          content,
 			"isGroup":          isGroup,
+			"groupId":          groupId,
 			"groupName":        groupName,
+			"isMentioned":      isMentioned,
 		},
 		"message": message,
 		"time":    getCurrentTimestamp(),
@@ -72,18 +91,62 @@ func SendChatMessageNotification(toUserId string, fromUserId int, fromUserNickna
 	return SendNotificationToUser(toUserId, notification)
 }
 
-// SendFriendRequestAcceptedNotification 发送好友请求被接受的通知
-func SendFriendRequestAcceptedNotification(toUserId string, accepterNickname string) error {
+// isDoNotDisturbForNotification 判断接收者是否应屏蔽该聊天通知
+func isDoNotDisturbForNotification(toUserId string, fromUserId int, isGroup bool, groupId int, isMentioned bool) bool {
+	userId, err := strconv.Atoi(toUserId)
+	if err != nil {
+		return false
+	}
+
+	var active bool
+	switch {
+	case isGroup && isMentioned:
+		// 被@时只检查全局免打扰
+		active, err = IsDoNotDisturbActive(userId, nil, nil)
+	case isGroup:
+		active, err = IsDoNotDisturbActive(userId, nil, &groupId)
+	default:
+		active, err = IsDoNotDisturbActive(userId, &fromUserId, nil)
+	}
+	if err != nil {
+		// 免打扰检查失败，仍然发送通知
+		log.Printf("Failed to check do not disturb status for user %s: %v", toUserId, err)
+		return false
+	}
+
+	return active
+}
+
+// SendFriendRequestAcceptedNotification 发送好友请求被接受的通知给请求者
+func SendFriendRequestAcceptedNotification(request *ent.FriendRequest, accepter *ent.User) error {
 	notification := map[string]interface{}{
 		"type": "friend_request_accepted",
 		"data": map[string]interface{}{
-			"accepterNickname": accepterNickname,
+			"id":               request.ID,
+			"accepterId":       accepter.ID,
+			"accepterNickname": accepter.Nickname,
 		},
-		"message": accepterNickname + " 接受了您的好友请求",
+		"message": accepter.Nickname + " 接受了您的好友请求",
 		"time":    getCurrentTimestamp(),
 	}
 
-	return SendNotificationToUser(toUserId, notification)
+	return SendNotificationToUser(strconv.Itoa(request.FromUserId), notification)
+}
+
+// SendFriendRequestRejectedNotification 发送好友请求被拒绝的通知给请求者
+func SendFriendRequestRejectedNotification(request *ent.FriendRequest, rejecter *ent.User) error {
+	notification := map[string]interface{}{
+		"type": "friend_request_rejected",
+		"data": map[string]interface{}{
+			"id":               request.ID,
+			"rejecterId":       rejecter.ID,
+			"rejecterNickname": rejecter.Nickname,
+		},
+		"message": rejecter.Nickname + " 拒绝了您的好友请求",
+		"time":    getCurrentTimestamp(),
+	}
+
+	return SendNotificationToUser(strconv.Itoa(request.FromUserId), notification)
 }
 
 // getCurrentTimestamp 获取当前时间戳
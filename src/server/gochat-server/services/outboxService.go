@@ -0,0 +1,432 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"gochat_server/ent"
+	"gochat_server/ent/messageoutbox"
+	"gochat_server/ent/predicate"
+	"gochat_server/utils"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// 发件箱记录状态
+const (
+	OutboxStatusPending = "pending" // 待推送
+	OutboxStatusDead    = "dead"    // 多次推送失败，不再重试
+)
+
+// 发件箱分发参数
+const (
+	outboxBatchSize    = 100              // 每次领取的记录数
+	outboxPollInterval = time.Second      // 没有新消息时的轮询间隔
+	outboxLease        = 30 * time.Second // 领取后的租约，分发器崩溃时租约到期后由其他分发器重新领取
+	outboxMaxAttempts  = 8                // 超过该次数后进入 dead 状态
+	outboxBaseBackoff  = time.Second      // 首次重试间隔，之后每次翻倍
+	outboxMaxBackoff   = 5 * time.Minute  // 最大重试间隔
+	outboxWorkers      = 8                // 推送工作协程数
+	outboxFanoutChunk  = 50               // 每个推送任务包含的接收者数
+)
+
+// outboxWake 有新消息写入发件箱时唤醒分发器，不必等到下一次轮询
+var outboxWake = make(chan struct{}, 1)
+
+// 启动以来的分发计数和延迟
+var (
+	outboxDispatched   atomic.Int64
+	outboxRetried      atomic.Int64
+	outboxDeadLettered atomic.Int64
+
+	outboxFanoutLatency   latencyRecorder // 一条消息从开始推送到推送给所有接收者的耗时
+	outboxDeliveryLatency latencyRecorder // 消息保存到推送给接收者的端到端延迟
+)
+
+// enqueueMessageDispatch 在事务中为每个接收者写入一条发件箱记录
+func enqueueMessageDispatch(ctx context.Context, tx *ent.Tx, msgId string, userIds []int) error {
+	if len(userIds) == 0 {
+		return nil
+	}
+
+	builders := make([]*ent.MessageOutboxCreate, 0, len(userIds))
+	for _, userId := range userIds {
+		builders = append(builders, tx.MessageOutbox.Create().
+			SetMsgId(msgId).
+			SetUserId(userId))
+	}
+
+	if _, err := tx.MessageOutbox.CreateBulk(builders...).Save(ctx); err != nil {
+		return errors.New("保存消息推送记录失败")
+	}
+	return nil
+}
+
+// wakeOutboxDispatcher 通知分发器有新的待推送记录
+func wakeOutboxDispatcher() {
+	select {
+	case outboxWake <- struct{}{}:
+	default:
+	}
+}
+
+// StartOutboxDispatcher 启动发件箱分发器和推送工作协程
+func StartOutboxDispatcher() {
+	for i := 0; i < outboxWorkers; i++ {
+		go runOutboxWorker()
+	}
+
+	go func() {
+		ticker := time.NewTicker(outboxPollInterval)
+		defer ticker.Stop()
+
+		for {
+			dispatchOutbox()
+
+			select {
+			case <-ticker.C:
+			case <-outboxWake:
+			}
+		}
+	}()
+
+	utils.Info("Message outbox dispatcher started with %d workers", outboxWorkers)
+}
+
+// dispatchOutbox 领取并推送到期的发件箱记录，直到没有待推送的记录
+// 同一消息的接收者按 outboxFanoutChunk 分批交给工作协程并行推送，消息元数据只查询一次
+func dispatchOutbox() {
+	ctx := context.Background()
+
+	for {
+		entries, err := claimOutboxEntries(ctx)
+		if err != nil {
+			utils.Error("领取消息推送记录失败: %v", err)
+			return
+		}
+
+		// 按消息分组，保持领取顺序
+		order := make([]string, 0)
+		byMsg := make(map[string][]*ent.MessageOutbox)
+		for _, entry := range entries {
+			if _, ok := byMsg[entry.MsgId]; !ok {
+				order = append(order, entry.MsgId)
+			}
+			byMsg[entry.MsgId] = append(byMsg[entry.MsgId], entry)
+		}
+
+		var wg sync.WaitGroup
+		for _, msgId := range order {
+			msg := loadOutboxMessage(msgId)
+			recipients := byMsg[msgId]
+
+			chunks := (len(recipients) + outboxFanoutChunk - 1) / outboxFanoutChunk
+			msg.pending.Store(int32(chunks))
+			for start := 0; start < len(recipients); start += outboxFanoutChunk {
+				end := start + outboxFanoutChunk
+				if end > len(recipients) {
+					end = len(recipients)
+				}
+				wg.Add(1)
+				outboxJobs <- &outboxJob{message: msg, entries: recipients[start:end], wg: &wg}
+			}
+		}
+		wg.Wait()
+
+		if len(entries) < outboxBatchSize {
+			return
+		}
+	}
+}
+
+// outboxMessage 一条待推送消息的元数据，同一消息的所有接收者共用
+type outboxMessage struct {
+	detail       *MessageDetail
+	err          error // 元数据查询失败时，该消息的所有接收者都按推送失败处理
+	fromNickname string
+	groupId      int
+	groupName    string
+	blockedBy    map[int]bool // 拉黑了发送者的用户，群消息推送时附带标记
+	startTime    time.Time
+	pending      atomic.Int32 // 尚未完成的推送任务数
+}
+
+// loadOutboxMessage 查询消息详情、发送者昵称和群名称
+func loadOutboxMessage(msgId string) *outboxMessage {
+	msg := &outboxMessage{startTime: time.Now()}
+
+	msg.detail, msg.err = GetMessageDetail(msgId)
+	if msg.err != nil {
+		return msg
+	}
+
+	if u, err := GetUserByID(msg.detail.FromUserId); err == nil {
+		msg.fromNickname = u.Nickname
+	}
+	if msg.detail.IsGroup && msg.detail.GroupId != nil {
+		msg.groupId = *msg.detail.GroupId
+		if g, err := GetGroupById(msg.groupId); err == nil && g != nil {
+			msg.groupName = g.GroupName
+		}
+		msg.blockedBy = blockerIds(msg.detail.FromUserId)
+	}
+	return msg
+}
+
+// outboxJob 推送任务：一条消息的一批接收者
+type outboxJob struct {
+	message *outboxMessage
+	entries []*ent.MessageOutbox
+	wg      *sync.WaitGroup
+}
+
+// outboxJobs 推送任务队列，容量有限，工作协程处理不过来时分发器会等待
+var outboxJobs = make(chan *outboxJob, outboxWorkers*2)
+
+// runOutboxWorker 推送工作协程
+func runOutboxWorker() {
+	for job := range outboxJobs {
+		job.run()
+	}
+}
+
+// run 推送一批接收者，推送成功的记录批量删除
+func (job *outboxJob) run() {
+	defer job.wg.Done()
+	ctx := context.Background()
+
+	delivered := make([]int, 0, len(job.entries))
+	for _, entry := range job.entries {
+		if err := job.message.deliver(entry.UserId); err != nil {
+			failOutboxEntry(ctx, entry, err)
+			continue
+		}
+		delivered = append(delivered, entry.ID)
+		outboxDeliveryLatency.Observe(time.Since(entry.CreateTime))
+	}
+
+	if len(delivered) > 0 {
+		if err := deleteOutboxEntries(ctx, delivered); err != nil {
+			utils.Error("删除消息推送记录失败: %v", err)
+		}
+		outboxDispatched.Add(int64(len(delivered)))
+	}
+
+	// 最后一个任务完成时记录整条消息的扇出耗时
+	if job.message.pending.Add(-1) == 0 {
+		outboxFanoutLatency.Observe(time.Since(job.message.startTime))
+	}
+}
+
+// deleteOutboxEntries 删除已推送的记录
+// 删除失败的记录会在租约到期后被重复推送，因此遇到锁冲突（如 SQLite 共享缓存模式）时短暂等待后重试
+func deleteOutboxEntries(ctx context.Context, ids []int) error {
+	var err error
+	for attempt := 1; attempt <= 3; attempt++ {
+		if _, err = db.MessageOutbox.Delete().
+			Where(messageoutbox.IDIn(ids...)).
+			Exec(ctx); err == nil {
+			return nil
+		}
+		time.Sleep(time.Duration(attempt) * 50 * time.Millisecond)
+	}
+	return err
+}
+
+// deliver 通过 NotificationSender 将消息推送给接收者
+// 接收者不在线时视为成功，上线后通过离线消息接口获取
+func (m *outboxMessage) deliver(userId int) error {
+	if notificationSender == nil {
+		return errors.New("通知发送器未设置")
+	}
+
+	toUserId := strconv.Itoa(userId)
+	if !notificationSender.IsUserOnline(toUserId) {
+		return nil
+	}
+
+	if m.err != nil {
+		return m.err
+	}
+	// 推送前已撤回的消息不再推送，撤回通知会单独发送
+	if m.detail.IsRevoked {
+		return nil
+	}
+
+	detail := m.detail
+	isMentioned := detail.IsGroup && IsUserMentioned(detail.Mentions, userId)
+
+	// 被@的成员只受全局免打扰限制
+	isDoNotDisturb := isDoNotDisturbForNotification(toUserId, detail.FromUserId, detail.IsGroup, m.groupId, isMentioned)
+	// 接收者拉黑了发送者，客户端可以折叠显示该消息
+	senderBlocked := m.blockedBy[userId]
+
+	err := notificationSender.SendMessageToUser(toUserId, map[string]interface{}{
+		"type":          "message",
+		"data":          detail,
+		"doNotDisturb":  isDoNotDisturb,
+		"senderBlocked": senderBlocked,
+	})
+	if err != nil {
+		return err
+	}
+
+	// 消息已送达，通知失败不再重试，被拉黑的发送者不发通知
+	if !isDoNotDisturb && !senderBlocked {
+		if err := sendChatMessageNotification(toUserId, detail.FromUserId, m.fromNickname, detail.MsgId,
+			detail.Content, detail.IsGroup, m.groupId, m.groupName, isMentioned); err != nil {
+			utils.Warn("Failed to send chat message notification: %v", err)
+		}
+	}
+	return nil
+}
+
+// claimOutboxEntries 领取到期的发件箱记录
+// 一次条件更新领取一批记录：推迟下次推送时间一个租约并写入本次的租约标识，再按标识查出领取的记录；
+// 更新时重新检查状态和推送时间，多个分发器同时领取时同一条记录只会被一个分发器领取
+func claimOutboxEntries(ctx context.Context) ([]*ent.MessageOutbox, error) {
+	now := time.Now()
+	token := uuid.New().String()
+
+	// 在同一事务中领取和查询，查询失败时领取一起回滚，记录不会在租约期内无人推送
+	var claimed []*ent.MessageOutbox
+	err := withTx(ctx, func(tx *ent.Tx) error {
+		n, err := tx.MessageOutbox.Update().
+			Where(
+				messageoutbox.Status(OutboxStatusPending),
+				messageoutbox.NextAttemptTimeLTE(now),
+				dueOutboxBatch(now),
+			).
+			SetNextAttemptTime(now.Add(outboxLease)).
+			SetLeaseToken(token).
+			AddAttempts(1).
+			Save(ctx)
+		if err != nil || n == 0 {
+			return err
+		}
+
+		claimed, err = tx.MessageOutbox.Query().
+			Where(messageoutbox.LeaseToken(token)).
+			Order(ent.Asc(messageoutbox.FieldID)).
+			All(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return claimed, nil
+}
+
+// dueOutboxBatch 限制一次领取的记录数：最早到期的一批记录
+// 子查询包在派生表中，MySQL 不支持 IN 子查询中直接使用 LIMIT，也不能在更新时直接查询被更新的表
+func dueOutboxBatch(now time.Time) predicate.MessageOutbox {
+	return predicate.MessageOutbox(func(s *sql.Selector) {
+		t := sql.Table(messageoutbox.Table).As("due_outbox")
+		due := sql.Select(t.C(messageoutbox.FieldID)).
+			From(t).
+			Where(sql.And(
+				sql.EQ(t.C(messageoutbox.FieldStatus), OutboxStatusPending),
+				sql.LTE(t.C(messageoutbox.FieldNextAttemptTime), now),
+			)).
+			OrderBy(t.C(messageoutbox.FieldID)).
+			Limit(outboxBatchSize).
+			As("due_batch")
+		s.Where(sql.In(s.C(messageoutbox.FieldID), sql.Select(due.C(messageoutbox.FieldID)).From(due)))
+	})
+}
+
+// failOutboxEntry 记录推送失败，按指数退避安排重试，超过次数后进入 dead 状态
+func failOutboxEntry(ctx context.Context, entry *ent.MessageOutbox, cause error) {
+	update := db.MessageOutbox.UpdateOneID(entry.ID).
+		SetLastError(cause.Error())
+
+	if entry.Attempts >= outboxMaxAttempts {
+		update = update.SetStatus(OutboxStatusDead)
+		outboxDeadLettered.Add(1)
+		utils.Error("消息 %s 推送给用户 %d 失败 %d 次，不再重试: %v", entry.MsgId, entry.UserId, entry.Attempts, cause)
+	} else {
+		update = update.SetNextAttemptTime(time.Now().Add(outboxBackoff(entry.Attempts)))
+		outboxRetried.Add(1)
+	}
+
+	if _, err := update.Save(ctx); err != nil {
+		utils.Error("更新消息推送记录失败: %v", err)
+	}
+}
+
+// outboxBackoff 第 attempts 次失败后的重试间隔
+func outboxBackoff(attempts int) time.Duration {
+	backoff := outboxBaseBackoff
+	for i := 1; i < attempts && backoff < outboxMaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > outboxMaxBackoff {
+		backoff = outboxMaxBackoff
+	}
+	return backoff
+}
+
+// OutboxStats 发件箱统计信息
+type OutboxStats struct {
+	Pending           int          `json:"pending"`                     // 待推送记录数
+	Dead              int          `json:"dead"`                        // 放弃推送的记录数
+	OldestPendingTime *time.Time   `json:"oldestPendingTime,omitempty"` // 最早的待推送记录的创建时间
+	LagSeconds        float64      `json:"lagSeconds"`                  // 最早的待推送记录已等待的秒数
+	Dispatched        int64        `json:"dispatched"`                  // 启动以来推送成功的记录数
+	Retried           int64        `json:"retried"`                     // 启动以来推送失败后重试的次数
+	DeadLettered      int64        `json:"deadLettered"`                // 启动以来进入 dead 状态的记录数
+	FanoutLatency     LatencyStats `json:"fanoutLatency"`               // 单条消息扇出耗时
+	DeliveryLatency   LatencyStats `json:"deliveryLatency"`             // 保存到推送的端到端延迟
+}
+
+// GetOutboxStats 获取发件箱积压和延迟
+func GetOutboxStats() OutboxStats {
+	ctx := context.TODO()
+	stats := OutboxStats{
+		Dispatched:   outboxDispatched.Load(),
+		Retried:      outboxRetried.Load(),
+		DeadLettered: outboxDeadLettered.Load(),
+
+		FanoutLatency:   outboxFanoutLatency.Stats(),
+		DeliveryLatency: outboxDeliveryLatency.Stats(),
+	}
+
+	stats.Pending, _ = db.MessageOutbox.Query().
+		Where(messageoutbox.Status(OutboxStatusPending)).
+		Count(ctx)
+	stats.Dead, _ = db.MessageOutbox.Query().
+		Where(messageoutbox.Status(OutboxStatusDead)).
+		Count(ctx)
+
+	oldest, err := db.MessageOutbox.Query().
+		Where(messageoutbox.Status(OutboxStatusPending)).
+		Order(ent.Asc(messageoutbox.FieldCreateTime)).
+		First(ctx)
+	if err == nil {
+		stats.OldestPendingTime = &oldest.CreateTime
+		stats.LagSeconds = time.Since(oldest.CreateTime).Seconds()
+	}
+
+	return stats
+}
+
+// RetryDeadOutboxEntries 将 dead 状态的记录重新放回待推送队列
+func RetryDeadOutboxEntries() (int, error) {
+	n, err := db.MessageOutbox.Update().
+		Where(messageoutbox.Status(OutboxStatusDead)).
+		SetStatus(OutboxStatusPending).
+		SetAttempts(0).
+		SetNextAttemptTime(time.Now()).
+		Save(context.TODO())
+	if err != nil {
+		return 0, errors.New("重试失败的推送记录失败")
+	}
+	if n > 0 {
+		wakeOutboxDispatcher()
+	}
+	return n, nil
+}
@@ -8,6 +8,8 @@ import (
 	"gochat_server/ent"
 	"gochat_server/utils"
 	"runtime"
+	"sort"
+	"sync"
 	"time"
 )
 
@@ -15,6 +17,7 @@ import (
 type PerformanceStats struct {
 	DatabaseStats DatabaseStats `json:"database"`
 	CacheStats    interface{}   `json:"cache"`
+	OutboxStats   OutboxStats   `json:"outbox"`
 	SystemStats   SystemStats   `json:"system"`
 	Timestamp     time.Time     `json:"timestamp"`
 }
@@ -42,6 +45,64 @@ type SystemStats struct {
 	GCCPUFraction float64 `json:"gcCPUFraction"` // GC占用的CPU时间比例
 }
 
+// LatencyStats 延迟统计信息（毫秒），基于最近的采样
+type LatencyStats struct {
+	Count int64   `json:"count"` // 启动以来的采样总数
+	AvgMs float64 `json:"avgMs"`
+	P95Ms float64 `json:"p95Ms"`
+	MaxMs float64 `json:"maxMs"`
+}
+
+// latencySampleSize 延迟统计保留的最近采样数
+const latencySampleSize = 1024
+
+// latencyRecorder 记录最近的延迟采样
+type latencyRecorder struct {
+	mu      sync.Mutex
+	samples []time.Duration
+	next    int
+	count   int64
+}
+
+// Observe 记录一次延迟
+func (r *latencyRecorder) Observe(d time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.samples) < latencySampleSize {
+		r.samples = append(r.samples, d)
+	} else {
+		r.samples[r.next] = d
+		r.next = (r.next + 1) % latencySampleSize
+	}
+	r.count++
+}
+
+// Stats 计算最近采样的平均值、P95 和最大值
+func (r *latencyRecorder) Stats() LatencyStats {
+	r.mu.Lock()
+	sorted := make([]time.Duration, len(r.samples))
+	copy(sorted, r.samples)
+	count := r.count
+	r.mu.Unlock()
+
+	stats := LatencyStats{Count: count}
+	if len(sorted) == 0 {
+		return stats
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	var total time.Duration
+	for _, d := range sorted {
+		total += d
+	}
+	toMs := func(d time.Duration) float64 { return float64(d.Microseconds()) / 1000 }
+	stats.AvgMs = toMs(total / time.Duration(len(sorted)))
+	stats.P95Ms = toMs(sorted[(len(sorted)*95-1)/100])
+	stats.MaxMs = toMs(sorted[len(sorted)-1])
+	return stats
+}
+
 // GetPerformanceStats 获取性能统计信息
 func GetPerformanceStats() *PerformanceStats {
 	stats := &PerformanceStats{
@@ -54,6 +115,9 @@ func GetPerformanceStats() *PerformanceStats {
 	// 获取缓存统计信息
 	stats.CacheStats = GetCacheStats()
 
+	// 获取消息发件箱统计信息
+	stats.OutboxStats = GetOutboxStats()
+
 	// 获取系统统计信息
 	stats.SystemStats = getSystemStats()
 
@@ -129,6 +193,11 @@ func LogPerformanceStats() {
 		stats.SystemStats.NumGC,
 		stats.SystemStats.GCCPUFraction*100)
 	
+	utils.Info("Outbox - Pending: %d, Dead: %d, Lag: %.1fs",
+		stats.OutboxStats.Pending,
+		stats.OutboxStats.Dead,
+		stats.OutboxStats.LagSeconds)
+
 	if cacheStats, ok := stats.CacheStats.(map[string]interface{}); ok {
 		if enabled, ok := cacheStats["enabled"].(bool); ok && enabled {
 			utils.Info("Cache - Keys: %v, TTL: %vs",
@@ -154,6 +223,9 @@ func StartPerformanceMonitoring(interval time.Duration) {
 				
 				// 检查内存使用情况
 				checkMemoryUsage()
+
+				// 检查消息推送积压情况
+				checkOutboxHealth()
 			}
 		}
 	}()
@@ -201,6 +273,18 @@ func checkMemoryUsage() {
 	}
 }
 
+// checkOutboxHealth 检查消息发件箱积压情况
+func checkOutboxHealth() {
+	stats := GetOutboxStats()
+
+	if stats.LagSeconds > 60 {
+		utils.Warn("Message outbox lag high: %.1fs (%d pending)", stats.LagSeconds, stats.Pending)
+	}
+	if stats.Dead > 0 {
+		utils.Warn("Message outbox has %d dead entries", stats.Dead)
+	}
+}
+
 // OptimizeDatabase 数据库优化建议
 func OptimizeDatabase() []string {
 	suggestions := []string{}
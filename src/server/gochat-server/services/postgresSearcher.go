@@ -0,0 +1,83 @@
+package services
+
+import (
+	"context"
+	"fmt"
+)
+
+// postgresSearcher 基于 PostgreSQL tsvector 的全文搜索实现
+// simple 分词配置无法切分中文，因此同时使用 ILIKE 进行子串匹配
+type postgresSearcher struct{}
+
+func (s *postgresSearcher) ensureIndex(ctx context.Context) error {
+	statements := []string{
+		`CREATE TABLE IF NOT EXISTS message_search (
+			msg_id VARCHAR(64) PRIMARY KEY,
+			content TEXT NOT NULL,
+			from_user_id INTEGER NOT NULL,
+			to_user_id INTEGER NOT NULL DEFAULT 0,
+			group_id INTEGER NOT NULL DEFAULT 0,
+			is_group SMALLINT NOT NULL DEFAULT 0,
+			create_time BIGINT NOT NULL,
+			tsv TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', content)) STORED
+		)`,
+		`CREATE INDEX IF NOT EXISTS message_search_tsv_idx ON message_search USING GIN (tsv)`,
+		`CREATE INDEX IF NOT EXISTS message_search_conversation_idx ON message_search (is_group, group_id, create_time)`,
+		`CREATE INDEX IF NOT EXISTS message_search_private_idx ON message_search (from_user_id, to_user_id, create_time)`,
+	}
+	for _, statement := range statements {
+		if _, err := rawDB.ExecContext(ctx, statement); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *postgresSearcher) count(ctx context.Context) (int, error) {
+	var count int
+	err := rawDB.QueryRowContext(ctx, `SELECT COUNT(*) FROM message_search`).Scan(&count)
+	return count, err
+}
+
+func (s *postgresSearcher) index(ctx context.Context, doc *searchDocument) error {
+	_, err := rawDB.ExecContext(ctx,
+		`INSERT INTO message_search (msg_id, content, from_user_id, to_user_id, group_id, is_group, create_time)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (msg_id) DO UPDATE SET content = EXCLUDED.content`,
+		doc.MsgId, doc.Content, doc.FromUserId, doc.ToUserId, doc.GroupId, boolToInt(doc.IsGroup), doc.CreateTime.UnixMilli())
+	return err
+}
+
+func (s *postgresSearcher) remove(ctx context.Context, msgId string) error {
+	_, err := rawDB.ExecContext(ctx, `DELETE FROM message_search WHERE msg_id = $1`, msgId)
+	return err
+}
+
+func (s *postgresSearcher) search(ctx context.Context, query *SearchQuery) ([]*searchDocument, int, error) {
+	placeholder := func(n int) string { return fmt.Sprintf("$%d", n) }
+
+	args := []interface{}{query.Keyword, escapeLikePattern(query.Keyword)}
+	match := `(tsv @@ plainto_tsquery('simple', $1) OR content ILIKE $2 ESCAPE '\')`
+
+	conditions, args := buildSearchConditions(query, placeholder, args)
+	where := match + " AND " + conditions
+
+	var total int
+	if err := rawDB.QueryRowContext(ctx, `SELECT COUNT(*) FROM message_search WHERE `+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	limit := placeholder(len(args) + 1)
+	offset := placeholder(len(args) + 2)
+	rows, err := rawDB.QueryContext(ctx,
+		`SELECT msg_id, content, from_user_id, to_user_id, group_id, is_group, create_time
+		FROM message_search WHERE `+where+` ORDER BY create_time DESC LIMIT `+limit+` OFFSET `+offset,
+		append(args, query.PageSize, (query.Page-1)*query.PageSize)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	docs, err := scanSearchDocuments(rows)
+	return docs, total, err
+}
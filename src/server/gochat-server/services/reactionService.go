@@ -0,0 +1,173 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"gochat_server/ent"
+	"gochat_server/ent/messagereaction"
+	"strings"
+	"unicode/utf8"
+)
+
+// 单个表情的最大字符数（组合表情可能由多个码点组成）
+const maxReactionEmojiRunes = 16
+
+// ReactionSummary 消息表态汇总
+type ReactionSummary struct {
+	Emoji       string `json:"emoji"`
+	Count       int    `json:"count"`
+	ReactedByMe bool   `json:"reactedByMe"`
+	UserIds     []int  `json:"userIds"`
+}
+
+// AddMessageReaction 添加消息表态
+func AddMessageReaction(msgId string, userId int, emoji string) (*MessageDetail, error) {
+	emoji, err := normalizeReactionEmoji(emoji)
+	if err != nil {
+		return nil, err
+	}
+
+	detail, err := CanUserAccessMessage(msgId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	// 已经表态过则直接返回，保证接口幂等
+	exists, err := db.MessageReaction.Query().
+		Where(
+			messagereaction.MsgId(msgId),
+			messagereaction.UserId(userId),
+			messagereaction.Emoji(emoji),
+		).
+		Exist(context.TODO())
+	if err != nil {
+		return nil, errors.New("查询消息表态失败")
+	}
+	if exists {
+		return detail, nil
+	}
+
+	_, err = db.MessageReaction.Create().
+		SetMsgId(msgId).
+		SetUserId(userId).
+		SetEmoji(emoji).
+		Save(context.TODO())
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return detail, nil
+		}
+		return nil, errors.New("添加消息表态失败")
+	}
+
+	return detail, nil
+}
+
+// RemoveMessageReaction 取消消息表态
+func RemoveMessageReaction(msgId string, userId int, emoji string) (*MessageDetail, error) {
+	emoji, err := normalizeReactionEmoji(emoji)
+	if err != nil {
+		return nil, err
+	}
+
+	detail, err := CanUserAccessMessage(msgId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := db.MessageReaction.Delete().
+		Where(
+			messagereaction.MsgId(msgId),
+			messagereaction.UserId(userId),
+			messagereaction.Emoji(emoji),
+		).
+		Exec(context.TODO())
+	if err != nil {
+		return nil, errors.New("取消消息表态失败")
+	}
+	if affected == 0 {
+		return nil, errors.New("表态不存在")
+	}
+
+	return detail, nil
+}
+
+// GetMessageReactions 批量查询消息的表态记录，按消息ID分组
+func GetMessageReactions(msgIds []string) (map[string][]*ent.MessageReaction, error) {
+	result := make(map[string][]*ent.MessageReaction)
+	if len(msgIds) == 0 {
+		return result, nil
+	}
+
+	reactions, err := db.MessageReaction.Query().
+		Where(messagereaction.MsgIdIn(msgIds...)).
+		Order(ent.Asc(messagereaction.FieldCreateTime), ent.Asc(messagereaction.FieldID)).
+		All(context.TODO())
+	if err != nil {
+		return nil, errors.New("查询消息表态失败")
+	}
+
+	for _, r := range reactions {
+		result[r.MsgId] = append(result[r.MsgId], r)
+	}
+
+	return result, nil
+}
+
+// BuildReactionSummaries 按表情汇总表态，viewerId 用于计算当前用户是否已表态
+// 表情按首次出现的时间排序
+func BuildReactionSummaries(reactions []*ent.MessageReaction, viewerId int) []*ReactionSummary {
+	summaries := make([]*ReactionSummary, 0)
+	index := make(map[string]*ReactionSummary)
+
+	for _, r := range reactions {
+		summary, exists := index[r.Emoji]
+		if !exists {
+			summary = &ReactionSummary{
+				Emoji:   r.Emoji,
+				UserIds: make([]int, 0),
+			}
+			index[r.Emoji] = summary
+			summaries = append(summaries, summary)
+		}
+
+		summary.Count++
+		summary.UserIds = append(summary.UserIds, r.UserId)
+		if r.UserId == viewerId {
+			summary.ReactedByMe = true
+		}
+	}
+
+	return summaries
+}
+
+// attachReactions 为消息列表附加表态汇总信息
+func attachReactions(messages []map[string]interface{}, viewerId int) {
+	msgIds := make([]string, 0, len(messages))
+	for _, m := range messages {
+		if msgId, ok := m["msgId"].(string); ok {
+			msgIds = append(msgIds, msgId)
+		}
+	}
+
+	reactions, err := GetMessageReactions(msgIds)
+	if err != nil {
+		return
+	}
+
+	for _, m := range messages {
+		msgId, _ := m["msgId"].(string)
+		m["reactions"] = BuildReactionSummaries(reactions[msgId], viewerId)
+	}
+}
+
+// normalizeReactionEmoji 校验并规范化表情
+func normalizeReactionEmoji(emoji string) (string, error) {
+	emoji = strings.TrimSpace(emoji)
+	if emoji == "" {
+		return "", errors.New("表情不能为空")
+	}
+	if !utf8.ValidString(emoji) || utf8.RuneCountInString(emoji) > maxReactionEmojiRunes || len(emoji) > 32 {
+		return "", errors.New("无效的表情")
+	}
+	return emoji, nil
+}
@@ -0,0 +1,312 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"gochat_server/ent"
+	"gochat_server/ent/groupchatrecord"
+	"gochat_server/ent/groupwatermark"
+	"log"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// SmallGroupReceiptLimit 成员数不超过该值的群返回成员列表，更大的群只返回人数
+	SmallGroupReceiptLimit = 100
+
+	// readReceiptFlushInterval 已读人数推送的合并间隔
+	readReceiptFlushInterval = time.Second
+	// readReceiptMaxMessages 每次推送中每个群最多统计的最近消息数
+	readReceiptMaxMessages = 50
+)
+
+// ReceiptMember 已读回执中的成员
+// 群消息的时间取成员水位最近一次推进的时间，不早于实际读到（收到）该消息的时间
+type ReceiptMember struct {
+	UserId   int        `json:"userId"`
+	Nickname string     `json:"nickname"`
+	Time     *time.Time `json:"time,omitempty"`
+}
+
+// MessageReceipts 消息的已读回执
+// 私聊和小群返回成员列表，大群只返回人数
+type MessageReceipts struct {
+	MsgId          string          `json:"msgId"`
+	Total          int             `json:"total"`          // 接收者总数
+	ReadCount      int             `json:"readCount"`      // 已读人数
+	DeliveredCount int             `json:"deliveredCount"` // 已送达人数（包括已读）
+	Read           []ReceiptMember `json:"read,omitempty"`
+	Delivered      []ReceiptMember `json:"delivered,omitempty"`   // 已送达未读
+	Undelivered    []ReceiptMember `json:"undelivered,omitempty"` // 未送达
+}
+
+// GetMessageReceipts 获取消息的已读回执，只有发送者可以查看
+func GetMessageReceipts(msgId string, userId int) (*MessageReceipts, error) {
+	detail, err := CanUserAccessMessage(msgId, userId)
+	if err != nil {
+		return nil, err
+	}
+	if detail.FromUserId != userId {
+		return nil, errors.New("只有发送者可以查看已读回执")
+	}
+
+	if !detail.IsGroup || detail.GroupId == nil {
+		return getPrivateMessageReceipts(msgId, detail.ToUserId)
+	}
+	return getGroupMessageReceipts(msgId, userId)
+}
+
+// getPrivateMessageReceipts 私聊消息的回执，由接收者的消息状态得出
+func getPrivateMessageReceipts(msgId string, toUserId int) (*MessageReceipts, error) {
+	receipts := &MessageReceipts{MsgId: msgId, Total: 1}
+
+	member := ReceiptMember{UserId: toUserId}
+	if user, err := db.User.Get(context.TODO(), toUserId); err == nil {
+		member.Nickname = user.Nickname
+	}
+
+	status, err := findMessageStatus(msgId, toUserId)
+	switch {
+	case err == nil && status.IsRead:
+		member.Time = status.ReadTime
+		receipts.ReadCount, receipts.DeliveredCount = 1, 1
+		receipts.Read = []ReceiptMember{member}
+	case err == nil && status.IsDelivered:
+		member.Time = status.DeliveredTime
+		receipts.DeliveredCount = 1
+		receipts.Delivered = []ReceiptMember{member}
+	default:
+		receipts.Undelivered = []ReceiptMember{member}
+	}
+	return receipts, nil
+}
+
+// getGroupMessageReceipts 群消息的回执，比较各成员的水位和消息序号
+// 消息发送后才加入的成员不计入
+func getGroupMessageReceipts(msgId string, senderId int) (*MessageReceipts, error) {
+	seq, err := getGroupMessageSeq(msgId)
+	if err != nil {
+		return nil, err
+	}
+	g, err := GetGroupByID(seq.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	if !g.ReadReceiptsEnabled {
+		return nil, errors.New("该群已关闭已读回执")
+	}
+
+	receipts := &MessageReceipts{MsgId: msgId}
+
+	watermarks, err := db.GroupWatermark.Query().
+		Where(
+			groupwatermark.GroupId(seq.GroupId),
+			groupwatermark.UserIdNEQ(senderId),
+			groupwatermark.JoinSeqLT(seq.Seq),
+		).
+		All(context.TODO())
+	if err != nil {
+		return nil, errors.New("查询群聊水位失败")
+	}
+
+	// 只统计仍在群中的成员
+	isMember := make(map[int]bool, len(g.Members))
+	for _, memberId := range g.Members {
+		isMember[memberId] = true
+	}
+	recipients := make([]*ent.GroupWatermark, 0, len(watermarks))
+	for _, w := range watermarks {
+		if isMember[w.UserId] {
+			recipients = append(recipients, w)
+		}
+	}
+	receipts.Total = len(recipients)
+
+	// 大群只统计人数
+	if len(recipients) > SmallGroupReceiptLimit {
+		for _, w := range recipients {
+			if w.ReadSeq >= seq.Seq {
+				receipts.ReadCount++
+			}
+			if w.DeliveredSeq >= seq.Seq {
+				receipts.DeliveredCount++
+			}
+		}
+		return receipts, nil
+	}
+
+	nicknames := make(map[int]string)
+	if members, err := GetGroupMembers(seq.GroupId); err == nil {
+		for _, member := range members {
+			nicknames[member.ID] = member.Nickname
+		}
+	}
+
+	receipts.Read = make([]ReceiptMember, 0)
+	receipts.Delivered = make([]ReceiptMember, 0)
+	receipts.Undelivered = make([]ReceiptMember, 0)
+	for _, w := range recipients {
+		member := ReceiptMember{UserId: w.UserId, Nickname: nicknames[w.UserId]}
+		switch {
+		case w.ReadSeq >= seq.Seq:
+			member.Time = w.ReadTime
+			receipts.Read = append(receipts.Read, member)
+		case w.DeliveredSeq >= seq.Seq:
+			member.Time = w.DeliveredTime
+			receipts.Delivered = append(receipts.Delivered, member)
+		default:
+			receipts.Undelivered = append(receipts.Undelivered, member)
+		}
+	}
+	receipts.ReadCount = len(receipts.Read)
+	receipts.DeliveredCount = receipts.ReadCount + len(receipts.Delivered)
+
+	return receipts, nil
+}
+
+// readReceiptRange 待推送已读人数的消息序号范围 (fromSeq, toSeq]
+type readReceiptRange struct {
+	fromSeq int
+	toSeq   int
+}
+
+var (
+	readReceiptMu      sync.Mutex
+	readReceiptPending = make(map[int]*readReceiptRange)
+	readReceiptOnce    sync.Once
+)
+
+// queueReadReceiptUpdate 记录成员已读水位的推进，由后台任务合并后推送给发送者
+func queueReadReceiptUpdate(groupId, fromSeq, toSeq int) {
+	readReceiptMu.Lock()
+	defer readReceiptMu.Unlock()
+
+	pending, ok := readReceiptPending[groupId]
+	if !ok {
+		readReceiptPending[groupId] = &readReceiptRange{fromSeq: fromSeq, toSeq: toSeq}
+		return
+	}
+	if fromSeq < pending.fromSeq {
+		pending.fromSeq = fromSeq
+	}
+	if toSeq > pending.toSeq {
+		pending.toSeq = toSeq
+	}
+}
+
+// StartReadReceiptPublisher 启动已读人数推送任务
+// 同一群在一个合并间隔内的已读变化只推送一次，避免大群中每个成员的已读都触发推送
+func StartReadReceiptPublisher() {
+	readReceiptOnce.Do(func() {
+		go func() {
+			ticker := time.NewTicker(readReceiptFlushInterval)
+			defer ticker.Stop()
+			for range ticker.C {
+				flushReadReceiptUpdates()
+			}
+		}()
+		log.Printf("Read receipt publisher started")
+	})
+}
+
+// flushReadReceiptUpdates 推送所有待推送群的已读人数
+func flushReadReceiptUpdates() {
+	readReceiptMu.Lock()
+	pending := readReceiptPending
+	readReceiptPending = make(map[int]*readReceiptRange)
+	readReceiptMu.Unlock()
+
+	for groupId, r := range pending {
+		if err := publishGroupReadCounts(groupId, r); err != nil {
+			log.Printf("Failed to publish read counts for group %d: %v", groupId, err)
+		}
+	}
+}
+
+// GroupReadCount 群消息的已读人数
+type GroupReadCount struct {
+	MsgId     string `json:"msgId"`
+	ReadCount int    `json:"readCount"`
+	Total     int    `json:"total"`
+}
+
+// publishGroupReadCounts 计算范围内最近消息的已读人数，按发送者合并后推送
+func publishGroupReadCounts(groupId int, r *readReceiptRange) error {
+	ctx := context.TODO()
+
+	g, err := GetGroupByID(groupId)
+	if err != nil {
+		return err
+	}
+	if !g.ReadReceiptsEnabled {
+		return nil
+	}
+
+	records, err := db.GroupChatRecord.Query().
+		Where(
+			groupchatrecord.GroupId(strconv.Itoa(groupId)),
+			groupchatrecord.IDGT(r.fromSeq),
+			groupchatrecord.IDLTE(r.toSeq),
+		).
+		Order(ent.Desc(groupchatrecord.FieldID)).
+		Limit(readReceiptMaxMessages).
+		All(ctx)
+	if err != nil {
+		return errors.New("查询群聊记录失败")
+	}
+	if len(records) == 0 {
+		return nil
+	}
+
+	watermarks, err := db.GroupWatermark.Query().
+		Where(groupwatermark.GroupId(groupId)).
+		All(ctx)
+	if err != nil {
+		return errors.New("查询群聊水位失败")
+	}
+	isMember := make(map[int]bool, len(g.Members))
+	for _, memberId := range g.Members {
+		isMember[memberId] = true
+	}
+	recipients := make([]*ent.GroupWatermark, 0, len(watermarks))
+	for _, w := range watermarks {
+		if isMember[w.UserId] {
+			recipients = append(recipients, w)
+		}
+	}
+
+	// 按消息顺序（从旧到新）统计
+	bySender := make(map[int][]GroupReadCount)
+	for i := len(records) - 1; i >= 0; i-- {
+		record := records[i]
+		senderId, _ := strconv.Atoi(record.FromUserId)
+		if notificationSender == nil || !notificationSender.IsUserOnline(strconv.Itoa(senderId)) {
+			continue
+		}
+
+		count := GroupReadCount{MsgId: record.MsgId}
+		for _, w := range recipients {
+			if w.UserId == senderId || w.JoinSeq >= record.ID {
+				continue
+			}
+			count.Total++
+			if w.ReadSeq >= record.ID {
+				count.ReadCount++
+			}
+		}
+		bySender[senderId] = append(bySender[senderId], count)
+	}
+
+	for senderId, counts := range bySender {
+		_ = SendNotificationToUser(strconv.Itoa(senderId), map[string]interface{}{
+			"type": "read_count",
+			"data": map[string]interface{}{
+				"groupId":  groupId,
+				"receipts": counts,
+			},
+		})
+	}
+	return nil
+}
@@ -0,0 +1,554 @@
+package services
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gochat_server/configs"
+	"gochat_server/dto"
+	"gochat_server/ent"
+	"gochat_server/ent/chatrecord"
+	"gochat_server/ent/contactcardmessage"
+	"gochat_server/ent/filemessage"
+	"gochat_server/ent/group"
+	"gochat_server/ent/groupchatrecord"
+	"gochat_server/ent/imagemessage"
+	"gochat_server/ent/locationmessage"
+	"gochat_server/ent/mergedforwardmessage"
+	"gochat_server/ent/message"
+	"gochat_server/ent/messageforward"
+	"gochat_server/ent/messagemention"
+	"gochat_server/ent/messagereaction"
+	"gochat_server/ent/retentionjob"
+	"gochat_server/ent/textmessage"
+	"gochat_server/ent/videomessage"
+	"gochat_server/ent/voicemessage"
+	"log"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// 清理任务状态
+const (
+	RetentionJobRunning   = "running"   // 执行中
+	RetentionJobCompleted = "completed" // 已完成
+	RetentionJobFailed    = "failed"    // 失败，下次执行时重新开始
+)
+
+const (
+	defaultRetentionInterval  = 24 * time.Hour
+	defaultRetentionBatchSize = 500
+
+	// retentionCheckInterval 检查是否需要执行清理任务的间隔
+	retentionCheckInterval = time.Hour
+	// retentionBatchPause 批次之间的间隔，减少对正常读写的影响
+	retentionBatchPause = 100 * time.Millisecond
+)
+
+// errRetentionNotConfigured 全局和各群都没有设置保留期限
+var errRetentionNotConfigured = errors.New("未配置消息保留期限")
+
+var (
+	retentionMu      sync.Mutex
+	retentionRunning bool
+	retentionOnce    sync.Once
+)
+
+// retentionInterval 清理任务执行间隔
+func retentionInterval() time.Duration {
+	if hours := configs.Cfg.Retention.IntervalHours; hours > 0 {
+		return time.Duration(hours) * time.Hour
+	}
+	return defaultRetentionInterval
+}
+
+// retentionBatchSize 每批处理的消息数
+func retentionBatchSize() int {
+	if size := configs.Cfg.Retention.BatchSize; size > 0 {
+		return size
+	}
+	return defaultRetentionBatchSize
+}
+
+// retentionPolicy 一次清理任务使用的保留期限，各会话的截止时间以任务开始时间计算
+type retentionPolicy struct {
+	globalCutoff *time.Time        // 全局截止时间，未配置时为空
+	groupCutoffs map[int]time.Time // 单独设置了保留天数的群
+	latestCutoff time.Time         // 所有截止时间中最晚的一个，早于它的消息才需要检查
+}
+
+// loadRetentionPolicy 读取全局和各群的保留天数，没有任何保留期限时返回 nil
+func loadRetentionPolicy(start time.Time) (*retentionPolicy, error) {
+	policy := &retentionPolicy{groupCutoffs: make(map[int]time.Time)}
+
+	if days := configs.Cfg.Retention.Days; days > 0 {
+		cutoff := start.AddDate(0, 0, -days)
+		policy.globalCutoff = &cutoff
+		policy.latestCutoff = cutoff
+	}
+
+	groups, err := db.Group.Query().
+		Where(group.RetentionDaysGT(0)).
+		All(context.TODO())
+	if err != nil {
+		return nil, errors.New("查询群消息保留设置失败")
+	}
+	for _, g := range groups {
+		cutoff := start.AddDate(0, 0, -g.RetentionDays)
+		policy.groupCutoffs[g.ID] = cutoff
+		if cutoff.After(policy.latestCutoff) {
+			policy.latestCutoff = cutoff
+		}
+	}
+
+	if policy.globalCutoff == nil && len(policy.groupCutoffs) == 0 {
+		return nil, nil
+	}
+	return policy, nil
+}
+
+// cutoff 会话的截止时间，groupId 为 0 表示私聊；群设置优先于全局设置
+func (p *retentionPolicy) cutoff(groupId int) *time.Time {
+	if groupId > 0 {
+		if cutoff, ok := p.groupCutoffs[groupId]; ok {
+			return &cutoff
+		}
+	}
+	return p.globalCutoff
+}
+
+// RetentionJobInfo 清理任务进度
+type RetentionJobInfo struct {
+	Id           int        `json:"id"`
+	Status       string     `json:"status"`
+	Cursor       int        `json:"cursor"`
+	MaxMessageId int        `json:"maxMessageId"`
+	Scanned      int        `json:"scanned"`
+	Purged       int        `json:"purged"`
+	Archived     int        `json:"archived"`
+	MediaDeleted int        `json:"mediaDeleted"`
+	LastError    string     `json:"lastError,omitempty"`
+	StartTime    time.Time  `json:"startTime"`
+	UpdateTime   time.Time  `json:"updateTime"`
+	FinishTime   *time.Time `json:"finishTime,omitempty"`
+}
+
+// toRetentionJobInfo 转换为返回给客户端的结构
+func toRetentionJobInfo(job *ent.RetentionJob) *RetentionJobInfo {
+	return &RetentionJobInfo{
+		Id:           job.ID,
+		Status:       job.Status,
+		Cursor:       job.Cursor,
+		MaxMessageId: job.MaxMessageId,
+		Scanned:      job.Scanned,
+		Purged:       job.Purged,
+		Archived:     job.Archived,
+		MediaDeleted: job.MediaDeleted,
+		LastError:    job.LastError,
+		StartTime:    job.StartTime,
+		UpdateTime:   job.UpdateTime,
+		FinishTime:   job.FinishTime,
+	}
+}
+
+// RetentionStatus 消息保留期限配置和最近一次清理任务
+type RetentionStatus struct {
+	Days          int               `json:"days"`          // 全局保留天数，0 表示永久保留
+	Archive       bool              `json:"archive"`       // 清理前是否归档
+	GroupPolicies int               `json:"groupPolicies"` // 单独设置了保留天数的群数量
+	Running       bool              `json:"running"`       // 本节点是否正在执行清理任务
+	LastJob       *RetentionJobInfo `json:"lastJob,omitempty"`
+}
+
+// GetRetentionStatus 获取消息保留期限配置和清理任务进度
+func GetRetentionStatus() (*RetentionStatus, error) {
+	ctx := context.TODO()
+	status := &RetentionStatus{
+		Days:    configs.Cfg.Retention.Days,
+		Archive: configs.Cfg.Retention.Archive,
+	}
+
+	count, err := db.Group.Query().
+		Where(group.RetentionDaysGT(0)).
+		Count(ctx)
+	if err != nil {
+		return nil, errors.New("查询群消息保留设置失败")
+	}
+	status.GroupPolicies = count
+
+	retentionMu.Lock()
+	status.Running = retentionRunning
+	retentionMu.Unlock()
+
+	job, err := db.RetentionJob.Query().
+		Order(ent.Desc(retentionjob.FieldID)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, errors.New("查询清理任务失败")
+	}
+	if job != nil {
+		status.LastJob = toRetentionJobInfo(job)
+	}
+	return status, nil
+}
+
+// StartRetentionScheduler 启动消息保留期限清理任务
+// 启动时继续上次未完成的任务，之后每小时检查一次，距上次任务开始超过执行间隔时开始新任务
+func StartRetentionScheduler() {
+	retentionOnce.Do(func() {
+		go func() {
+			checkRetentionJob()
+			ticker := time.NewTicker(retentionCheckInterval)
+			defer ticker.Stop()
+			for range ticker.C {
+				checkRetentionJob()
+			}
+		}()
+		log.Printf("Retention scheduler started")
+	})
+}
+
+// checkRetentionJob 继续未完成的任务，或在到达执行间隔时开始新任务
+func checkRetentionJob() {
+	last, err := db.RetentionJob.Query().
+		Order(ent.Desc(retentionjob.FieldID)).
+		First(context.TODO())
+	if err != nil && !ent.IsNotFound(err) {
+		log.Printf("Failed to query retention job: %v", err)
+		return
+	}
+	if last != nil && last.Status != RetentionJobRunning && time.Since(last.StartTime) < retentionInterval() {
+		return
+	}
+
+	if _, err := RunRetentionJob(); err != nil && err != errRetentionNotConfigured {
+		log.Printf("Retention job not started: %v", err)
+	}
+}
+
+// RunRetentionJob 在后台执行清理任务，有未完成的任务时继续该任务
+func RunRetentionJob() (*RetentionJobInfo, error) {
+	retentionMu.Lock()
+	defer retentionMu.Unlock()
+	if retentionRunning {
+		return nil, errors.New("清理任务正在执行")
+	}
+
+	ctx := context.TODO()
+	job, err := db.RetentionJob.Query().
+		Where(retentionjob.Status(RetentionJobRunning)).
+		Order(ent.Desc(retentionjob.FieldID)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, errors.New("查询清理任务失败")
+	}
+
+	// 没有未完成的任务时开始新任务，只处理任务开始前已有的消息
+	if job == nil {
+		policy, err := loadRetentionPolicy(time.Now())
+		if err != nil {
+			return nil, err
+		}
+		if policy == nil {
+			return nil, errRetentionNotConfigured
+		}
+
+		maxMessageId := 0
+		if latest, err := db.Message.Query().Order(ent.Desc(message.FieldID)).First(ctx); err == nil {
+			maxMessageId = latest.ID
+		}
+		job, err = db.RetentionJob.Create().
+			SetMaxMessageId(maxMessageId).
+			Save(ctx)
+		if err != nil {
+			return nil, errors.New("创建清理任务失败")
+		}
+	}
+
+	retentionRunning = true
+	go runRetentionJob(job)
+	return toRetentionJobInfo(job), nil
+}
+
+// runRetentionJob 分批处理消息直到任务完成，每批完成后保存进度
+func runRetentionJob(job *ent.RetentionJob) {
+	defer func() {
+		retentionMu.Lock()
+		retentionRunning = false
+		retentionMu.Unlock()
+	}()
+
+	ctx := context.TODO()
+	log.Printf("Retention job %d started from message %d", job.ID, job.Cursor)
+
+	policy, err := loadRetentionPolicy(job.StartTime)
+	for err == nil && policy != nil {
+		var done bool
+		job, done, err = processRetentionBatch(job, policy)
+		if err != nil || done {
+			break
+		}
+		time.Sleep(retentionBatchPause)
+	}
+
+	update := job.Update().SetFinishTime(time.Now())
+	if err != nil {
+		update = update.SetStatus(RetentionJobFailed).SetLastError(err.Error())
+		log.Printf("Retention job %d failed: %v", job.ID, err)
+	} else {
+		update = update.SetStatus(RetentionJobCompleted)
+		log.Printf("Retention job %d completed: scanned %d, purged %d, archived %d, media deleted %d",
+			job.ID, job.Scanned, job.Purged, job.Archived, job.MediaDeleted)
+	}
+	if _, err := update.Save(ctx); err != nil {
+		log.Printf("Failed to update retention job %d: %v", job.ID, err)
+	}
+}
+
+// retentionMessage 待清理的消息及其所在会话
+type retentionMessage struct {
+	record     *ent.Message
+	fromUserId int
+	toUserId   int
+	groupId    int
+}
+
+// processRetentionBatch 处理一批消息：归档并清理超过保留期限的消息，保存任务进度
+// 每批在单独的事务中清理，不会长时间锁表；中断后从保存的位置继续，已清理的消息不会重复处理
+func processRetentionBatch(job *ent.RetentionJob, policy *retentionPolicy) (*ent.RetentionJob, bool, error) {
+	ctx := context.TODO()
+	batchSize := retentionBatchSize()
+
+	records, err := db.Message.Query().
+		Where(
+			message.IDGT(job.Cursor),
+			message.IDLTE(job.MaxMessageId),
+			message.IsPurged(false),
+			message.CreateTimeLT(policy.latestCutoff),
+		).
+		Order(ent.Asc(message.FieldID)).
+		Limit(batchSize).
+		All(ctx)
+	if err != nil {
+		return job, false, errors.New("查询消息失败")
+	}
+	if len(records) == 0 {
+		return job, true, nil
+	}
+
+	msgIds := make([]string, 0, len(records))
+	for _, record := range records {
+		msgIds = append(msgIds, record.MsgId)
+	}
+
+	// 查询消息所在的会话
+	chatRecords, err := db.ChatRecord.Query().
+		Where(chatrecord.MsgIdIn(msgIds...)).
+		All(ctx)
+	if err != nil {
+		return job, false, errors.New("查询聊天记录失败")
+	}
+	groupRecords, err := db.GroupChatRecord.Query().
+		Where(groupchatrecord.MsgIdIn(msgIds...)).
+		All(ctx)
+	if err != nil {
+		return job, false, errors.New("查询群聊记录失败")
+	}
+	conversations := make(map[string]*retentionMessage, len(records))
+	for _, r := range chatRecords {
+		conversations[r.MsgId] = &retentionMessage{fromUserId: r.FromUserId, toUserId: r.ToUserId}
+	}
+	for _, r := range groupRecords {
+		fromUserId, _ := strconv.Atoi(r.FromUserId)
+		groupId, _ := strconv.Atoi(r.GroupId)
+		conversations[r.MsgId] = &retentionMessage{fromUserId: fromUserId, groupId: groupId}
+	}
+
+	// 按各自会话的截止时间筛选
+	expired := make([]*retentionMessage, 0, len(records))
+	for _, record := range records {
+		m, ok := conversations[record.MsgId]
+		if !ok {
+			continue
+		}
+		if cutoff := policy.cutoff(m.groupId); cutoff != nil && record.CreateTime.Before(*cutoff) {
+			m.record = record
+			expired = append(expired, m)
+		}
+	}
+
+	purged, archived, mediaDeleted := 0, 0, 0
+	if len(expired) > 0 {
+		// 先归档再清理，归档失败时不清理
+		if configs.Cfg.Retention.Archive {
+			if err := archiveRetentionMessages(job.ID, expired); err != nil {
+				return job, false, err
+			}
+			archived = len(expired)
+		}
+
+		ids := make([]int, 0, len(expired))
+		expiredMsgIds := make([]string, 0, len(expired))
+		storageKeys := make([]string, 0)
+		for _, m := range expired {
+			ids = append(ids, m.record.ID)
+			expiredMsgIds = append(expiredMsgIds, m.record.MsgId)
+			if decoded, err := decodeMessageBody(m.record.Body); err == nil {
+				if key := messageStorageKey(decoded.Body); key != "" {
+					storageKeys = append(storageKeys, key)
+				}
+			}
+		}
+
+		// 只保留占位记录，聊天记录不删除，分页和群消息序号保持不变
+		// 旧内容表中的内容和消息的@提及、表态、转发信息在同一事务中删除
+		err = withTx(ctx, func(tx *ent.Tx) error {
+			purged, err = tx.Message.Update().
+				Where(
+					message.IDIn(ids...),
+					message.IsPurged(false),
+				).
+				SetIsPurged(true).
+				SetPurgeTime(time.Now()).
+				SetBody("").
+				SetStorageKey("").
+				SetContent(dto.PURGED_MESSAGE_CONTENT).
+				Save(ctx)
+			if err != nil {
+				return err
+			}
+			return deletePurgedMessageData(ctx, tx, expiredMsgIds)
+		})
+		if err != nil {
+			return job, false, errors.New("清理消息失败")
+		}
+
+		for _, m := range expired {
+			RemoveMessageFromSearch(m.record.MsgId)
+		}
+		mediaDeleted = removeUnreferencedMedia(storageKeys)
+		invalidateRetentionCaches(expired)
+	}
+
+	job, err = job.Update().
+		SetCursor(records[len(records)-1].ID).
+		AddScanned(len(records)).
+		AddPurged(purged).
+		AddArchived(archived).
+		AddMediaDeleted(mediaDeleted).
+		Save(ctx)
+	if err != nil {
+		return job, false, errors.New("保存清理进度失败")
+	}
+	log.Printf("Retention job %d progress: message %d/%d, scanned %d, purged %d",
+		job.ID, job.Cursor, job.MaxMessageId, job.Scanned, job.Purged)
+
+	return job, len(records) < batchSize, nil
+}
+
+// deletePurgedMessageData 删除已清理消息在旧内容表中的内容和@提及、表态、转发信息
+// 旧内容表中的内容不删除时，数据迁移会把清理后的消息当作未迁移的消息重新写回内容
+func deletePurgedMessageData(ctx context.Context, tx *ent.Tx, msgIds []string) error {
+	if _, err := tx.TextMessage.Delete().Where(textmessage.MsgIdIn(msgIds...)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.ImageMessage.Delete().Where(imagemessage.MsgIdIn(msgIds...)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.VideoMessage.Delete().Where(videomessage.MsgIdIn(msgIds...)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.FileMessage.Delete().Where(filemessage.MsgIdIn(msgIds...)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.VoiceMessage.Delete().Where(voicemessage.MsgIdIn(msgIds...)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.LocationMessage.Delete().Where(locationmessage.MsgIdIn(msgIds...)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.ContactCardMessage.Delete().Where(contactcardmessage.MsgIdIn(msgIds...)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.MergedForwardMessage.Delete().Where(mergedforwardmessage.MsgIdIn(msgIds...)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.MessageMention.Delete().Where(messagemention.MsgIdIn(msgIds...)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.MessageReaction.Delete().Where(messagereaction.MsgIdIn(msgIds...)).Exec(ctx); err != nil {
+		return err
+	}
+	_, err := tx.MessageForward.Delete().Where(messageforward.MsgIdIn(msgIds...)).Exec(ctx)
+	return err
+}
+
+// retentionArchiveRecord 归档文件中的一条消息
+type retentionArchiveRecord struct {
+	MsgId      string    `json:"msgId"`
+	MsgType    string    `json:"msgType"`
+	FromUserId int       `json:"fromUserId"`
+	ToUserId   int       `json:"toUserId,omitempty"`
+	GroupId    int       `json:"groupId,omitempty"`
+	Content    string    `json:"content"`
+	Body       string    `json:"body"`
+	IsRevoked  bool      `json:"isRevoked,omitempty"`
+	CreateTime time.Time `json:"createTime"`
+}
+
+// archiveRetentionMessages 将一批消息写成 gzip 压缩的 JSON Lines 文件上传到对象存储
+// 文件名包含消息记录ID范围，任务中断后重新处理同一批时覆盖之前的文件
+func archiveRetentionMessages(jobId int, messages []*retentionMessage) error {
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	encoder := json.NewEncoder(writer)
+	for _, m := range messages {
+		err := encoder.Encode(&retentionArchiveRecord{
+			MsgId:      m.record.MsgId,
+			MsgType:    m.record.MsgType,
+			FromUserId: m.fromUserId,
+			ToUserId:   m.toUserId,
+			GroupId:    m.groupId,
+			Content:    m.record.Content,
+			Body:       m.record.Body,
+			IsRevoked:  m.record.IsRevoked,
+			CreateTime: m.record.CreateTime,
+		})
+		if err != nil {
+			return fmt.Errorf("归档消息失败: %v", err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("归档消息失败: %v", err)
+	}
+
+	objectName := fmt.Sprintf("archive/retention/%d/%010d-%010d.jsonl.gz",
+		jobId, messages[0].record.ID, messages[len(messages)-1].record.ID)
+	if err := UploadObject(objectName, buf.Bytes(), "application/gzip"); err != nil {
+		return fmt.Errorf("归档消息失败: %v", err)
+	}
+	return nil
+}
+
+// invalidateRetentionCaches 清除被清理消息所在会话的历史记录缓存
+func invalidateRetentionCaches(messages []*retentionMessage) {
+	privates := make(map[string][2]int)
+	groups := make(map[int]bool)
+	for _, m := range messages {
+		if m.groupId > 0 {
+			groups[m.groupId] = true
+		} else {
+			privates[privateConversationId(m.fromUserId, m.toUserId)] = [2]int{m.fromUserId, m.toUserId}
+		}
+	}
+	for _, pair := range privates {
+		_ = InvalidateChatHistoryCache(pair[0], pair[1])
+	}
+	for groupId := range groups {
+		_ = InvalidateGroupChatHistoryCache(groupId)
+	}
+}
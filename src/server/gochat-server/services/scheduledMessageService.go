@@ -0,0 +1,475 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"gochat_server/dto"
+	"gochat_server/ent"
+	"gochat_server/ent/scheduledmessage"
+	"log"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// 定时消息状态
+const (
+	ScheduledStatusPending  = "pending"  // 待发送
+	ScheduledStatusSending  = "sending"  // 发送中
+	ScheduledStatusSent     = "sent"     // 已发送
+	ScheduledStatusFailed   = "failed"   // 发送失败，可以修改后重新安排
+	ScheduledStatusCanceled = "canceled" // 已取消
+)
+
+const (
+	// MaxScheduledMessages 每个用户最多的待发送定时消息数
+	MaxScheduledMessages = 100
+	// MaxScheduleAhead 定时消息最多可以提前安排的时间
+	MaxScheduleAhead = 365 * 24 * time.Hour
+
+	scheduledPollInterval = time.Second      // 调度器检查到期消息的间隔
+	scheduledBatchSize    = 100              // 每次领取的记录数
+	scheduledLease        = 30 * time.Second // 领取后的租约，调度器崩溃时租约到期后由其他调度器重新领取
+	scheduledMaxRetries   = 8                // 临时错误的最大重试次数，超过后标记为发送失败
+	scheduledRetryBackoff = 10 * time.Second // 第一次重试的等待时间，之后每次翻倍
+	scheduledMaxBackoff   = 10 * time.Minute // 重试等待时间的上限
+)
+
+// ScheduledMessageRequest 创建或修改定时消息的内容，与发送消息接口的参数相同
+type ScheduledMessageRequest struct {
+	ToUserId     int
+	GroupId      int
+	MsgType      int
+	Content      string
+	Mentions     []dto.MessageMention
+	ReplyToMsgId string
+	SendTime     time.Time
+}
+
+// ScheduledMessageInfo 定时消息
+type ScheduledMessageInfo struct {
+	Id           int                  `json:"id"`
+	ToUserId     int                  `json:"toUserId,omitempty"`
+	GroupId      int                  `json:"groupId,omitempty"`
+	MsgType      int                  `json:"msgType"`
+	Content      string               `json:"content"`
+	Mentions     []dto.MessageMention `json:"mentions,omitempty"`
+	ReplyToMsgId string               `json:"replyToMsgId,omitempty"`
+	SendTime     time.Time            `json:"sendTime"`
+	Status       string               `json:"status"`
+	MsgId        string               `json:"msgId,omitempty"`
+	FailReason   string               `json:"failReason,omitempty"`
+	CreateTime   time.Time            `json:"createTime"`
+}
+
+// toScheduledMessageInfo 转换为返回给客户端的结构
+func toScheduledMessageInfo(s *ent.ScheduledMessage) *ScheduledMessageInfo {
+	info := &ScheduledMessageInfo{
+		Id:           s.ID,
+		GroupId:      s.GroupId,
+		MsgType:      s.MsgType,
+		Content:      s.Content,
+		ReplyToMsgId: s.ReplyToMsgId,
+		SendTime:     s.SendTime,
+		Status:       s.Status,
+		MsgId:        s.MsgId,
+		FailReason:   s.FailReason,
+		CreateTime:   s.CreateTime,
+	}
+	if s.GroupId == 0 {
+		info.ToUserId = s.ToUserId
+	}
+	if s.Mentions != "" {
+		_ = json.Unmarshal([]byte(s.Mentions), &info.Mentions)
+	}
+	return info
+}
+
+// validateScheduledMessage 检查发送时间，并按发送消息的规则提前校验权限和内容
+func validateScheduledMessage(userId int, req *ScheduledMessageRequest) (string, error) {
+	now := time.Now()
+	if !req.SendTime.After(now) {
+		return "", errors.New("发送时间必须晚于当前时间")
+	}
+	if req.SendTime.After(now.Add(MaxScheduleAhead)) {
+		return "", errors.New("发送时间不能超过一年")
+	}
+
+	codec, err := getMessageCodec(req.MsgType)
+	if err != nil {
+		return "", err
+	}
+	if codec.Internal {
+		return "", errors.New("不支持的消息类型")
+	}
+
+	var groupId *int
+	if req.GroupId > 0 {
+		groupId = &req.GroupId
+	}
+	_, err = prepareMessage(userId, req.ToUserId, groupId, &outgoingMessage{
+		MsgType:      req.MsgType,
+		Content:      req.Content,
+		Mentions:     req.Mentions,
+		ReplyToMsgId: req.ReplyToMsgId,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	mentions := ""
+	if len(req.Mentions) > 0 {
+		data, err := json.Marshal(req.Mentions)
+		if err != nil {
+			return "", errors.New("无效的@提及")
+		}
+		mentions = string(data)
+	}
+	return mentions, nil
+}
+
+// CreateScheduledMessage 创建定时消息
+func CreateScheduledMessage(userId int, req *ScheduledMessageRequest) (*ScheduledMessageInfo, error) {
+	mentions, err := validateScheduledMessage(userId, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.TODO()
+	count, err := db.ScheduledMessage.Query().
+		Where(
+			scheduledmessage.UserId(userId),
+			scheduledmessage.StatusIn(ScheduledStatusPending, ScheduledStatusSending),
+		).
+		Count(ctx)
+	if err != nil {
+		return nil, errors.New("查询定时消息失败")
+	}
+	if count >= MaxScheduledMessages {
+		return nil, errors.New("待发送的定时消息不能超过100条")
+	}
+
+	toUserId := req.ToUserId
+	if req.GroupId > 0 {
+		toUserId = 0
+	}
+	scheduled, err := db.ScheduledMessage.Create().
+		SetUserId(userId).
+		SetToUserId(toUserId).
+		SetGroupId(req.GroupId).
+		SetMsgType(req.MsgType).
+		SetContent(req.Content).
+		SetMentions(mentions).
+		SetReplyToMsgId(req.ReplyToMsgId).
+		SetSendTime(req.SendTime).
+		Save(ctx)
+	if err != nil {
+		return nil, errors.New("创建定时消息失败")
+	}
+	return toScheduledMessageInfo(scheduled), nil
+}
+
+// GetScheduledMessages 获取用户的定时消息，status 为空时返回所有状态，按计划发送时间排序
+func GetScheduledMessages(userId int, status string) ([]*ScheduledMessageInfo, error) {
+	query := db.ScheduledMessage.Query().
+		Where(scheduledmessage.UserId(userId))
+	if status != "" {
+		query = query.Where(scheduledmessage.Status(status))
+	}
+
+	records, err := query.
+		Order(ent.Asc(scheduledmessage.FieldSendTime), ent.Asc(scheduledmessage.FieldID)).
+		All(context.TODO())
+	if err != nil {
+		return nil, errors.New("查询定时消息失败")
+	}
+
+	result := make([]*ScheduledMessageInfo, 0, len(records))
+	for _, record := range records {
+		result = append(result, toScheduledMessageInfo(record))
+	}
+	return result, nil
+}
+
+// findScheduledMessage 查询用户自己的定时消息
+func findScheduledMessage(userId, id int) (*ent.ScheduledMessage, error) {
+	scheduled, err := db.ScheduledMessage.Query().
+		Where(
+			scheduledmessage.ID(id),
+			scheduledmessage.UserId(userId),
+		).
+		First(context.TODO())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("定时消息不存在")
+		}
+		return nil, errors.New("查询定时消息失败")
+	}
+	return scheduled, nil
+}
+
+// UpdateScheduledMessage 修改待发送或发送失败的定时消息，修改后重新等待发送
+func UpdateScheduledMessage(userId, id int, req *ScheduledMessageRequest) (*ScheduledMessageInfo, error) {
+	scheduled, err := findScheduledMessage(userId, id)
+	if err != nil {
+		return nil, err
+	}
+	if scheduled.Status != ScheduledStatusPending && scheduled.Status != ScheduledStatusFailed {
+		return nil, errors.New("只能修改待发送或发送失败的定时消息")
+	}
+
+	mentions, err := validateScheduledMessage(userId, req)
+	if err != nil {
+		return nil, err
+	}
+
+	toUserId := req.ToUserId
+	if req.GroupId > 0 {
+		toUserId = 0
+	}
+	// 条件更新，避免修改调度器已经领取的消息
+	ctx := context.TODO()
+	n, err := db.ScheduledMessage.Update().
+		Where(
+			scheduledmessage.ID(id),
+			scheduledmessage.Status(scheduled.Status),
+			scheduledmessage.Attempts(scheduled.Attempts),
+		).
+		SetToUserId(toUserId).
+		SetGroupId(req.GroupId).
+		SetMsgType(req.MsgType).
+		SetContent(req.Content).
+		SetMentions(mentions).
+		SetReplyToMsgId(req.ReplyToMsgId).
+		SetSendTime(req.SendTime).
+		SetStatus(ScheduledStatusPending).
+		SetFailReason("").
+		SetRetries(0).
+		ClearLeaseTime().
+		Save(ctx)
+	if err != nil {
+		return nil, errors.New("修改定时消息失败")
+	}
+	if n == 0 {
+		return nil, errors.New("定时消息正在发送，无法修改")
+	}
+
+	scheduled, err = findScheduledMessage(userId, id)
+	if err != nil {
+		return nil, err
+	}
+	return toScheduledMessageInfo(scheduled), nil
+}
+
+// CancelScheduledMessage 取消待发送或发送失败的定时消息
+func CancelScheduledMessage(userId, id int) error {
+	scheduled, err := findScheduledMessage(userId, id)
+	if err != nil {
+		return err
+	}
+	if scheduled.Status != ScheduledStatusPending && scheduled.Status != ScheduledStatusFailed {
+		return errors.New("只能取消待发送或发送失败的定时消息")
+	}
+
+	n, err := db.ScheduledMessage.Update().
+		Where(
+			scheduledmessage.ID(id),
+			scheduledmessage.Status(scheduled.Status),
+			scheduledmessage.Attempts(scheduled.Attempts),
+		).
+		SetStatus(ScheduledStatusCanceled).
+		Save(context.TODO())
+	if err != nil {
+		return errors.New("取消定时消息失败")
+	}
+	if n == 0 {
+		return errors.New("定时消息正在发送，无法取消")
+	}
+	return nil
+}
+
+// markScheduledMessageSent 在发送消息的事务中标记定时消息已发送
+// 领取已失效（租约到期后被其他调度器重新领取）时返回错误，整个发送回滚，保证消息只发送一次
+func markScheduledMessageSent(ctx context.Context, tx *ent.Tx, scheduled *ent.ScheduledMessage, msgId string) error {
+	n, err := tx.ScheduledMessage.Update().
+		Where(
+			scheduledmessage.ID(scheduled.ID),
+			scheduledmessage.Status(ScheduledStatusSending),
+			scheduledmessage.Attempts(scheduled.Attempts),
+		).
+		SetStatus(ScheduledStatusSent).
+		SetMsgId(msgId).
+		SetFailReason("").
+		ClearLeaseTime().
+		Save(ctx)
+	if err != nil {
+		return errors.New("更新定时消息失败")
+	}
+	if n == 0 {
+		return errors.New("定时消息已被重新领取")
+	}
+	return nil
+}
+
+var messageSchedulerOnce sync.Once
+
+// StartMessageScheduler 启动定时消息调度器
+// 定时消息保存在数据库中，服务重启后未发送的消息仍会在到期后发送
+func StartMessageScheduler() {
+	messageSchedulerOnce.Do(func() {
+		go func() {
+			ticker := time.NewTicker(scheduledPollInterval)
+			defer ticker.Stop()
+			for range ticker.C {
+				dispatchScheduledMessages()
+			}
+		}()
+		log.Printf("Message scheduler started")
+	})
+}
+
+// dispatchScheduledMessages 领取并发送所有到期的定时消息
+func dispatchScheduledMessages() {
+	ctx := context.Background()
+	for {
+		claimed, err := claimScheduledMessages(ctx)
+		if err != nil {
+			log.Printf("Failed to claim scheduled messages: %v", err)
+			return
+		}
+		for _, scheduled := range claimed {
+			sendScheduledMessage(ctx, scheduled)
+		}
+		if len(claimed) < scheduledBatchSize {
+			return
+		}
+	}
+}
+
+// claimScheduledMessages 领取到期的定时消息（等待重试的消息需到达重试时间），以及租约已到期的发送中消息
+// 条件更新领取次数，保证同一条记录同时只被一个调度器领取
+func claimScheduledMessages(ctx context.Context) ([]*ent.ScheduledMessage, error) {
+	now := time.Now()
+	candidates, err := db.ScheduledMessage.Query().
+		Where(scheduledmessage.Or(
+			scheduledmessage.And(
+				scheduledmessage.Status(ScheduledStatusPending),
+				scheduledmessage.SendTimeLTE(now),
+				scheduledmessage.Or(
+					scheduledmessage.LeaseTimeIsNil(),
+					scheduledmessage.LeaseTimeLTE(now),
+				),
+			),
+			scheduledmessage.And(
+				scheduledmessage.Status(ScheduledStatusSending),
+				scheduledmessage.LeaseTimeLTE(now),
+			),
+		)).
+		Order(ent.Asc(scheduledmessage.FieldSendTime), ent.Asc(scheduledmessage.FieldID)).
+		Limit(scheduledBatchSize).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	claimed := make([]*ent.ScheduledMessage, 0, len(candidates))
+	for _, scheduled := range candidates {
+		n, err := db.ScheduledMessage.Update().
+			Where(
+				scheduledmessage.ID(scheduled.ID),
+				scheduledmessage.Status(scheduled.Status),
+				scheduledmessage.Attempts(scheduled.Attempts),
+			).
+			SetStatus(ScheduledStatusSending).
+			SetLeaseTime(now.Add(scheduledLease)).
+			AddAttempts(1).
+			Save(ctx)
+		if err != nil || n == 0 {
+			continue
+		}
+		scheduled.Status = ScheduledStatusSending
+		scheduled.Attempts++
+		claimed = append(claimed, scheduled)
+	}
+	return claimed, nil
+}
+
+// sendScheduledMessage 发送定时消息，发送时重新检查权限
+// 失去发送权限（如已不是好友、已退出群聊）或内容校验失败时记录原因，不再重试；
+// 数据库超时等临时错误释放领取并按退避时间重试，超过最大重试次数后才标记为发送失败
+func sendScheduledMessage(ctx context.Context, scheduled *ent.ScheduledMessage) {
+	var mentions []dto.MessageMention
+	if scheduled.Mentions != "" {
+		_ = json.Unmarshal([]byte(scheduled.Mentions), &mentions)
+	}
+	var groupId *int
+	if scheduled.GroupId > 0 {
+		groupId = &scheduled.GroupId
+	}
+
+	_, sendErr := sendMessage(ctx, scheduled.UserId, scheduled.ToUserId, groupId, &outgoingMessage{
+		MsgType:      scheduled.MsgType,
+		Content:      scheduled.Content,
+		Mentions:     mentions,
+		ReplyToMsgId: scheduled.ReplyToMsgId,
+		Scheduled:    scheduled,
+	}, nil)
+	if sendErr != nil && !isMessageRejected(sendErr) && scheduled.Retries < scheduledMaxRetries {
+		retryScheduledMessage(ctx, scheduled, sendErr)
+		return
+	}
+	if sendErr != nil {
+		n, err := db.ScheduledMessage.Update().
+			Where(
+				scheduledmessage.ID(scheduled.ID),
+				scheduledmessage.Status(ScheduledStatusSending),
+				scheduledmessage.Attempts(scheduled.Attempts),
+			).
+			SetStatus(ScheduledStatusFailed).
+			SetFailReason(sendErr.Error()).
+			ClearLeaseTime().
+			Save(ctx)
+		if err != nil {
+			log.Printf("Failed to update scheduled message %d: %v", scheduled.ID, err)
+			return
+		}
+		if n == 0 {
+			return
+		}
+	}
+
+	// 通知发送者定时消息的发送结果
+	if updated, err := db.ScheduledMessage.Get(ctx, scheduled.ID); err == nil {
+		_ = SendNotificationToUser(strconv.Itoa(scheduled.UserId), map[string]interface{}{
+			"type": "scheduled_message",
+			"data": toScheduledMessageInfo(updated),
+		})
+	}
+}
+
+// retryScheduledMessage 发送遇到临时错误时释放领取，恢复为待发送并设置下次重试时间
+func retryScheduledMessage(ctx context.Context, scheduled *ent.ScheduledMessage, sendErr error) {
+	backoff := scheduledRetryBackoff << scheduled.Retries
+	if backoff > scheduledMaxBackoff || backoff <= 0 {
+		backoff = scheduledMaxBackoff
+	}
+
+	_, err := db.ScheduledMessage.Update().
+		Where(
+			scheduledmessage.ID(scheduled.ID),
+			scheduledmessage.Status(ScheduledStatusSending),
+			scheduledmessage.Attempts(scheduled.Attempts),
+		).
+		SetStatus(ScheduledStatusPending).
+		SetLeaseTime(time.Now().Add(backoff)).
+		AddRetries(1).
+		SetFailReason(sendErr.Error()).
+		Save(ctx)
+	if err != nil {
+		// 更新失败时租约到期后仍会被重新领取
+		log.Printf("Failed to release scheduled message %d: %v", scheduled.ID, err)
+		return
+	}
+	log.Printf("Scheduled message %d will be retried in %v: %v", scheduled.ID, backoff, sendErr)
+}
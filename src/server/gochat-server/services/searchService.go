@@ -0,0 +1,417 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gochat_server/configs"
+	"gochat_server/dto"
+	"gochat_server/ent"
+	"gochat_server/ent/chatrecord"
+	"gochat_server/ent/conversationclear"
+	"gochat_server/ent/groupchatrecord"
+	"gochat_server/ent/message"
+	"gochat_server/utils"
+	"html"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// 搜索结果片段的上下文长度（字符）
+const searchSnippetRadius = 24
+
+// messageSearcher 聊天记录全文搜索接口，每种数据库一个实现
+// 索引表 message_search 不由 Ent 管理，由各实现通过原生 SQL 创建和维护
+type messageSearcher interface {
+	// ensureIndex 创建全文索引表（已存在时不做任何操作）
+	ensureIndex(ctx context.Context) error
+	// count 返回索引中的文档数
+	count(ctx context.Context) (int, error)
+	// index 写入或更新一条索引
+	index(ctx context.Context, doc *searchDocument) error
+	// remove 删除一条索引
+	remove(ctx context.Context, msgId string) error
+	// search 按关键字和过滤条件搜索，返回当前页结果和总数
+	search(ctx context.Context, query *SearchQuery) ([]*searchDocument, int, error)
+}
+
+// searchDocument 全文索引中的一条记录
+type searchDocument struct {
+	MsgId      string
+	Content    string
+	FromUserId int
+	ToUserId   int
+	GroupId    int
+	IsGroup    bool
+	CreateTime time.Time
+}
+
+// SearchQuery 聊天记录搜索条件
+type SearchQuery struct {
+	UserId     int        // 搜索者ID
+	Keyword    string     // 关键字
+	FriendId   int        // 只搜索与该好友的私聊，0 表示不限
+	GroupId    int        // 只搜索该群聊，0 表示不限
+	FromUserId int        // 只搜索该用户发送的消息，0 表示不限
+	StartTime  *time.Time // 开始时间
+	EndTime    *time.Time // 结束时间
+	Page       int
+	PageSize   int
+
+	groupIds []int                    // 搜索者所在的群组，用于限制搜索范围
+	clears   []*ent.ConversationClear // 搜索者清空过的会话，清空前的消息不返回
+}
+
+var searcher messageSearcher
+
+// InitSearch 初始化全文搜索，根据数据库类型选择实现
+// 索引为空时在后台为历史文本消息建立索引
+func InitSearch() error {
+	var s messageSearcher
+	switch configs.Cfg.DBType {
+	case "sqlite3":
+		s = &sqliteSearcher{}
+	case "postgres":
+		s = &postgresSearcher{}
+	case "mysql":
+		s = &mysqlSearcher{}
+	default:
+		return fmt.Errorf("不支持的数据库类型: %s", configs.Cfg.DBType)
+	}
+
+	ctx := context.Background()
+	if err := s.ensureIndex(ctx); err != nil {
+		return fmt.Errorf("创建全文索引失败: %v", err)
+	}
+	searcher = s
+
+	count, err := s.count(ctx)
+	if err == nil && count == 0 {
+		go func() {
+			indexed, err := RebuildSearchIndex()
+			if err != nil {
+				utils.Warn("Search index rebuild failed: %v", err)
+				return
+			}
+			utils.Info("Search index rebuilt: %d messages indexed", indexed)
+		}()
+	}
+
+	return nil
+}
+
+// RebuildSearchIndex 为所有历史文本消息建立全文索引
+func RebuildSearchIndex() (int, error) {
+	if searcher == nil {
+		return 0, errors.New("全文搜索未启用")
+	}
+
+	ctx := context.Background()
+	records, err := db.Message.Query().
+		Where(
+			message.MsgType(strconv.Itoa(dto.TEXT_MESSAGE)),
+			message.IsRevoked(false),
+			message.IsPurged(false),
+		).
+		All(ctx)
+	if err != nil {
+		return 0, errors.New("查询文本消息失败")
+	}
+
+	indexed := 0
+	for _, record := range records {
+		decoded, err := decodeMessageBody(record.Body)
+		if err != nil {
+			continue
+		}
+		text, ok := decoded.Body.(*dto.TextBody)
+		if !ok {
+			continue
+		}
+		doc, err := loadSearchDocument(ctx, record.MsgId, text.Text)
+		if err != nil {
+			continue
+		}
+		if err := searcher.index(ctx, doc); err != nil {
+			utils.Warn("Failed to index message %s: %v", record.MsgId, err)
+			continue
+		}
+		indexed++
+	}
+
+	return indexed, nil
+}
+
+// indexTextMessage 将文本消息写入全文索引，失败时只记录日志
+func indexTextMessage(msgId string, fromUserId, toUserId int, groupId *int, text string, createTime time.Time) {
+	if searcher == nil {
+		return
+	}
+
+	doc := &searchDocument{
+		MsgId:      msgId,
+		Content:    text,
+		FromUserId: fromUserId,
+		ToUserId:   toUserId,
+		CreateTime: createTime,
+	}
+	if groupId != nil && *groupId > 0 {
+		doc.IsGroup = true
+		doc.GroupId = *groupId
+		doc.ToUserId = 0
+	}
+
+	if err := searcher.index(context.Background(), doc); err != nil {
+		utils.Warn("Failed to index message %s: %v", msgId, err)
+	}
+}
+
+// RemoveMessageFromSearch 从全文索引中删除消息（撤回或删除消息时调用）
+func RemoveMessageFromSearch(msgId string) {
+	if searcher == nil {
+		return
+	}
+
+	if err := searcher.remove(context.Background(), msgId); err != nil {
+		utils.Warn("Failed to remove message %s from search index: %v", msgId, err)
+	}
+}
+
+// SearchMessages 搜索聊天记录，只返回搜索者所在会话中的消息
+func SearchMessages(query *SearchQuery) ([]map[string]interface{}, int, error) {
+	if searcher == nil {
+		return nil, 0, errors.New("全文搜索未启用")
+	}
+
+	query.Keyword = strings.TrimSpace(query.Keyword)
+	if query.Keyword == "" {
+		return nil, 0, errors.New("搜索关键字不能为空")
+	}
+	if query.FriendId > 0 && query.GroupId > 0 {
+		return nil, 0, errors.New("不能同时指定好友和群组")
+	}
+
+	if query.GroupId > 0 {
+		isMember, err := IsGroupMember(query.GroupId, query.UserId)
+		if err != nil {
+			return nil, 0, err
+		}
+		if !isMember {
+			return nil, 0, errors.New("不是群成员")
+		}
+	} else if query.FriendId == 0 {
+		groups, err := GetUserGroups(query.UserId)
+		if err != nil {
+			return nil, 0, err
+		}
+		query.groupIds = make([]int, 0, len(groups))
+		for _, g := range groups {
+			query.groupIds = append(query.groupIds, g.ID)
+		}
+	}
+
+	clears, err := db.ConversationClear.Query().
+		Where(conversationclear.UserId(query.UserId)).
+		All(context.Background())
+	if err != nil {
+		return nil, 0, errors.New("查询会话清空记录失败")
+	}
+	query.clears = clears
+
+	docs, total, err := searcher.search(context.Background(), query)
+	if err != nil {
+		utils.Error("Message search failed: %v", err)
+		return nil, 0, errors.New("搜索聊天记录失败")
+	}
+
+	results := make([]map[string]interface{}, 0, len(docs))
+	for _, doc := range docs {
+		result := map[string]interface{}{
+			"msgId":      doc.MsgId,
+			"fromUserId": doc.FromUserId,
+			"msgType":    dto.TEXT_MESSAGE,
+			"isGroup":    doc.IsGroup,
+			"content":    doc.Content,
+			"snippet":    buildSearchSnippet(doc.Content, query.Keyword),
+			"createTime": doc.CreateTime,
+		}
+		if doc.IsGroup {
+			result["groupId"] = doc.GroupId
+		} else {
+			result["toUserId"] = doc.ToUserId
+		}
+		results = append(results, result)
+	}
+
+	return results, total, nil
+}
+
+// loadSearchDocument 根据聊天记录组装索引文档
+func loadSearchDocument(ctx context.Context, msgId, text string) (*searchDocument, error) {
+	record, err := db.ChatRecord.Query().
+		Where(chatrecord.MsgId(msgId)).
+		First(ctx)
+	if err == nil {
+		return &searchDocument{
+			MsgId:      msgId,
+			Content:    text,
+			FromUserId: record.FromUserId,
+			ToUserId:   record.ToUserId,
+			CreateTime: record.CreateTime,
+		}, nil
+	}
+
+	groupRecord, err := db.GroupChatRecord.Query().
+		Where(groupchatrecord.MsgId(msgId)).
+		First(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fromUserId, _ := strconv.Atoi(groupRecord.FromUserId)
+	groupId, _ := strconv.Atoi(groupRecord.GroupId)
+	return &searchDocument{
+		MsgId:      msgId,
+		Content:    text,
+		FromUserId: fromUserId,
+		GroupId:    groupId,
+		IsGroup:    true,
+		CreateTime: groupRecord.CreateTime,
+	}, nil
+}
+
+// buildSearchConditions 生成搜索的访问范围和过滤条件（不含关键字匹配）
+// placeholder 根据参数序号生成占位符，用于兼容不同数据库
+func buildSearchConditions(query *SearchQuery, placeholder func(n int) string, args []interface{}) (string, []interface{}) {
+	next := func(value interface{}) string {
+		args = append(args, value)
+		return placeholder(len(args))
+	}
+
+	conditions := make([]string, 0)
+
+	// 访问范围：只能搜索自己所在的会话
+	switch {
+	case query.FriendId > 0:
+		conditions = append(conditions, fmt.Sprintf(
+			"(is_group = 0 AND ((from_user_id = %s AND to_user_id = %s) OR (from_user_id = %s AND to_user_id = %s)))",
+			next(query.UserId), next(query.FriendId), next(query.FriendId), next(query.UserId)))
+	case query.GroupId > 0:
+		conditions = append(conditions, fmt.Sprintf("(is_group = 1 AND group_id = %s)", next(query.GroupId)))
+	default:
+		scope := fmt.Sprintf("(is_group = 0 AND (from_user_id = %s OR to_user_id = %s))", next(query.UserId), next(query.UserId))
+		if len(query.groupIds) > 0 {
+			holders := make([]string, 0, len(query.groupIds))
+			for _, id := range query.groupIds {
+				holders = append(holders, next(id))
+			}
+			scope = fmt.Sprintf("(%s OR (is_group = 1 AND group_id IN (%s)))", scope, strings.Join(holders, ", "))
+		}
+		conditions = append(conditions, scope)
+	}
+
+	// 搜索者删除的消息和清空前的消息不返回
+	conditions = append(conditions, fmt.Sprintf(
+		"msg_id NOT IN (SELECT msg_id FROM message_deletions WHERE user_id = %s)", next(query.UserId)))
+	for _, clear := range query.clears {
+		if clear.IsGroup {
+			conditions = append(conditions, fmt.Sprintf(
+				"NOT (is_group = 1 AND group_id = %s AND create_time <= %s)",
+				next(clear.TargetId), next(clear.ClearTime.UnixMilli())))
+		} else {
+			conditions = append(conditions, fmt.Sprintf(
+				"NOT (is_group = 0 AND (from_user_id = %s OR to_user_id = %s) AND create_time <= %s)",
+				next(clear.TargetId), next(clear.TargetId), next(clear.ClearTime.UnixMilli())))
+		}
+	}
+
+	if query.FromUserId > 0 {
+		conditions = append(conditions, "from_user_id = "+next(query.FromUserId))
+	}
+	if query.StartTime != nil {
+		conditions = append(conditions, "create_time >= "+next(query.StartTime.UnixMilli()))
+	}
+	if query.EndTime != nil {
+		conditions = append(conditions, "create_time <= "+next(query.EndTime.UnixMilli()))
+	}
+
+	return strings.Join(conditions, " AND "), args
+}
+
+// escapeLikePattern 转义 LIKE 模式中的通配符，使用反斜杠作为转义字符
+func escapeLikePattern(keyword string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return "%" + replacer.Replace(keyword) + "%"
+}
+
+// buildSearchSnippet 截取关键字附近的文本并用 <em> 标记高亮，其余内容做 HTML 转义
+func buildSearchSnippet(content, keyword string) string {
+	runes := []rune(content)
+	lowerRunes := []rune(strings.ToLower(content))
+	keywordRunes := []rune(strings.ToLower(keyword))
+
+	// 大小写转换可能改变字符数，此时退化为不高亮
+	if len(lowerRunes) != len(runes) || len(keywordRunes) == 0 {
+		return html.EscapeString(truncateRunes(runes, 0, 2*searchSnippetRadius))
+	}
+
+	matches := make([]int, 0)
+	for i := 0; i+len(keywordRunes) <= len(lowerRunes); {
+		if string(lowerRunes[i:i+len(keywordRunes)]) == string(keywordRunes) {
+			matches = append(matches, i)
+			i += len(keywordRunes)
+		} else {
+			i++
+		}
+	}
+
+	if len(matches) == 0 {
+		return html.EscapeString(truncateRunes(runes, 0, 2*searchSnippetRadius))
+	}
+
+	start := matches[0] - searchSnippetRadius
+	if start < 0 {
+		start = 0
+	}
+	end := matches[0] + len(keywordRunes) + searchSnippetRadius
+	if end > len(runes) {
+		end = len(runes)
+	}
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("...")
+	}
+	pos := start
+	for _, m := range matches {
+		if m < start || m+len(keywordRunes) > end {
+			continue
+		}
+		b.WriteString(html.EscapeString(string(runes[pos:m])))
+		b.WriteString("<em>")
+		b.WriteString(html.EscapeString(string(runes[m : m+len(keywordRunes)])))
+		b.WriteString("</em>")
+		pos = m + len(keywordRunes)
+	}
+	b.WriteString(html.EscapeString(string(runes[pos:end])))
+	if end < len(runes) {
+		b.WriteString("...")
+	}
+
+	return b.String()
+}
+
+// truncateRunes 截取指定范围的字符，超出部分用省略号表示
+func truncateRunes(runes []rune, start, length int) string {
+	if start+length >= len(runes) {
+		return string(runes[start:])
+	}
+	return string(runes[start:start+length]) + "..."
+}
+
+// keywordRuneCount 关键字的字符数
+func keywordRuneCount(keyword string) int {
+	return utf8.RuneCountInString(keyword)
+}
@@ -0,0 +1,109 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+)
+
+// sqliteSearcher 基于 SQLite FTS5 的全文搜索实现
+// 使用 trigram 分词器以支持中文子串匹配，需要使用 sqlite_fts5 构建标签编译
+type sqliteSearcher struct{}
+
+func (s *sqliteSearcher) ensureIndex(ctx context.Context) error {
+	_, err := rawDB.ExecContext(ctx, `CREATE VIRTUAL TABLE IF NOT EXISTS message_search USING fts5(
+		content,
+		msg_id UNINDEXED,
+		from_user_id UNINDEXED,
+		to_user_id UNINDEXED,
+		group_id UNINDEXED,
+		is_group UNINDEXED,
+		create_time UNINDEXED,
+		tokenize = 'trigram'
+	)`)
+	return err
+}
+
+func (s *sqliteSearcher) count(ctx context.Context) (int, error) {
+	var count int
+	err := rawDB.QueryRowContext(ctx, `SELECT COUNT(*) FROM message_search`).Scan(&count)
+	return count, err
+}
+
+func (s *sqliteSearcher) index(ctx context.Context, doc *searchDocument) error {
+	// FTS5 虚拟表不支持唯一约束，先删除旧记录再写入
+	if err := s.remove(ctx, doc.MsgId); err != nil {
+		return err
+	}
+	_, err := rawDB.ExecContext(ctx,
+		`INSERT INTO message_search (content, msg_id, from_user_id, to_user_id, group_id, is_group, create_time)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		doc.Content, doc.MsgId, doc.FromUserId, doc.ToUserId, doc.GroupId, boolToInt(doc.IsGroup), doc.CreateTime.UnixMilli())
+	return err
+}
+
+func (s *sqliteSearcher) remove(ctx context.Context, msgId string) error {
+	_, err := rawDB.ExecContext(ctx, `DELETE FROM message_search WHERE msg_id = ?`, msgId)
+	return err
+}
+
+func (s *sqliteSearcher) search(ctx context.Context, query *SearchQuery) ([]*searchDocument, int, error) {
+	placeholder := func(int) string { return "?" }
+
+	// trigram 分词器要求关键字至少3个字符，更短的关键字退化为 LIKE 匹配
+	var match string
+	args := make([]interface{}, 0)
+	if keywordRuneCount(query.Keyword) >= 3 {
+		match = "message_search MATCH ?"
+		args = append(args, `"`+strings.ReplaceAll(query.Keyword, `"`, `""`)+`"`)
+	} else {
+		match = `content LIKE ? ESCAPE '\'`
+		args = append(args, escapeLikePattern(query.Keyword))
+	}
+
+	conditions, args := buildSearchConditions(query, placeholder, args)
+	where := match + " AND " + conditions
+
+	var total int
+	if err := rawDB.QueryRowContext(ctx, `SELECT COUNT(*) FROM message_search WHERE `+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := rawDB.QueryContext(ctx,
+		`SELECT msg_id, content, from_user_id, to_user_id, group_id, is_group, create_time
+		FROM message_search WHERE `+where+` ORDER BY create_time DESC LIMIT ? OFFSET ?`,
+		append(args, query.PageSize, (query.Page-1)*query.PageSize)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	docs, err := scanSearchDocuments(rows)
+	return docs, total, err
+}
+
+// scanSearchDocuments 读取搜索结果，各实现的结果列顺序一致
+func scanSearchDocuments(rows *sql.Rows) ([]*searchDocument, error) {
+	docs := make([]*searchDocument, 0)
+	for rows.Next() {
+		var doc searchDocument
+		var isGroup int
+		var createTime int64
+		if err := rows.Scan(&doc.MsgId, &doc.Content, &doc.FromUserId, &doc.ToUserId, &doc.GroupId, &isGroup, &createTime); err != nil {
+			return nil, err
+		}
+		doc.IsGroup = isGroup != 0
+		doc.CreateTime = time.UnixMilli(createTime)
+		docs = append(docs, &doc)
+	}
+	return docs, rows.Err()
+}
+
+// boolToInt 将布尔值转换为整数，索引表统一使用 0/1 存储布尔值
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,291 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gochat_server/ent"
+	"gochat_server/ent/friendrelationship"
+	"gochat_server/ent/friendrequest"
+	"gochat_server/ent/user"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	friendSuggestionMaxCount    = 200 // 每个用户最多计算的推荐人数
+	friendSuggestionRegionLimit = 100 // 同地区用户最多取的人数
+	friendSuggestionReasonNames = 3   // 推荐理由中最多列出的共同好友和群聊名称
+	friendSuggestionCacheTTL    = 30 * time.Minute
+
+	// 推荐分数权重
+	mutualFriendScore = 3
+	sharedGroupScore  = 2
+	sameRegionScore   = 1
+)
+
+// FriendSuggestion 可能认识的人
+type FriendSuggestion struct {
+	UserId            int      `json:"userId"`
+	Username          string   `json:"username"`
+	Nickname          string   `json:"nickname"`
+	Avatar            string   `json:"avatar"`
+	Region            string   `json:"region"`
+	MutualFriendCount int      `json:"mutualFriendCount"`
+	MutualFriends     []string `json:"mutualFriends"` // 部分共同好友的昵称
+	SharedGroupCount  int      `json:"sharedGroupCount"`
+	SharedGroups      []string `json:"sharedGroups"` // 部分共同群聊的名称
+	SameRegion        bool     `json:"sameRegion"`
+	Score             int      `json:"score"`
+	Reasons           []string `json:"reasons"` // 推荐理由
+}
+
+// GetFriendSuggestions 分页获取可能认识的人
+// 推荐列表整体计算后缓存，每次请求时重新排除已是好友、拉黑和有请求往来的用户
+func GetFriendSuggestions(userId int, sameRegion bool, page, pageSize int) ([]*FriendSuggestion, int, error) {
+	excluded, err := friendSuggestionExclusions(userId)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	suggestions, found := GetCachedFriendSuggestions(userId, sameRegion)
+	if !found {
+		suggestions, err = computeFriendSuggestions(userId, sameRegion, excluded)
+		if err != nil {
+			return nil, 0, err
+		}
+		_ = CacheFriendSuggestions(userId, sameRegion, suggestions)
+	}
+
+	visible := make([]*FriendSuggestion, 0, len(suggestions))
+	for _, s := range suggestions {
+		if !excluded[s.UserId] {
+			visible = append(visible, s)
+		}
+	}
+
+	total := len(visible)
+	start := (page - 1) * pageSize
+	if start >= total {
+		return []*FriendSuggestion{}, total, nil
+	}
+	end := start + pageSize
+	if end > total {
+		end = total
+	}
+	return visible[start:end], total, nil
+}
+
+// friendSuggestionExclusions 不推荐的用户：自己、好友、双向拉黑的用户，以及有待处理或被拒绝的好友请求的用户
+func friendSuggestionExclusions(userId int) (map[int]bool, error) {
+	ctx := context.TODO()
+	excluded := map[int]bool{userId: true}
+
+	friendIds, err := db.FriendRelationship.Query().
+		Where(friendrelationship.UserId(userId)).
+		Select(friendrelationship.FieldFriendId).
+		Ints(ctx)
+	if err != nil {
+		return nil, errors.New("查询好友关系失败")
+	}
+	for _, id := range friendIds {
+		excluded[id] = true
+	}
+
+	for id := range blockedUserIds(userId) {
+		excluded[id] = true
+	}
+	for id := range blockerIds(userId) {
+		excluded[id] = true
+	}
+
+	requests, err := db.FriendRequest.Query().
+		Where(
+			friendrequest.Or(
+				friendrequest.FromUserId(userId),
+				friendrequest.ToUserId(userId),
+			),
+			friendrequest.Or(
+				friendrequest.Status(FriendRequestRejected),
+				friendrequest.And(
+					friendrequest.Status(FriendRequestPending),
+					friendrequest.CreateTimeGT(friendRequestExpireBefore()),
+				),
+			),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, errors.New("查询好友请求失败")
+	}
+	for _, r := range requests {
+		excluded[r.FromUserId] = true
+		excluded[r.ToUserId] = true
+	}
+
+	return excluded, nil
+}
+
+// computeFriendSuggestions 按共同好友数、共同群聊数和地区计算推荐列表
+func computeFriendSuggestions(userId int, sameRegion bool, excluded map[int]bool) ([]*FriendSuggestion, error) {
+	ctx := context.TODO()
+	me, err := db.User.Get(ctx, userId)
+	if err != nil {
+		return nil, errors.New("用户不存在")
+	}
+
+	friends, err := GetFriendList(userId)
+	if err != nil {
+		return nil, err
+	}
+	friendIds := make([]int, 0, len(friends))
+	friendNames := make(map[int]string, len(friends))
+	for _, f := range friends {
+		friendIds = append(friendIds, f.ID)
+		friendNames[f.ID] = f.Nickname
+	}
+
+	// 好友的好友，一次查询所有好友的好友关系
+	mutualFriends := make(map[int][]int)
+	if len(friendIds) > 0 {
+		relationships, err := db.FriendRelationship.Query().
+			Where(friendrelationship.UserIdIn(friendIds...)).
+			All(ctx)
+		if err != nil {
+			return nil, errors.New("查询好友关系失败")
+		}
+		for _, rel := range relationships {
+			if !excluded[rel.FriendId] {
+				mutualFriends[rel.FriendId] = append(mutualFriends[rel.FriendId], rel.UserId)
+			}
+		}
+	}
+
+	// 同群成员
+	groups, err := GetUserGroups(userId)
+	if err != nil {
+		return nil, err
+	}
+	sharedGroups := make(map[int][]string)
+	for _, g := range groups {
+		for _, memberId := range g.Members {
+			if !excluded[memberId] {
+				sharedGroups[memberId] = append(sharedGroups[memberId], g.GroupName)
+			}
+		}
+	}
+
+	candidates := make(map[int]bool, len(mutualFriends)+len(sharedGroups))
+	for id := range mutualFriends {
+		candidates[id] = true
+	}
+	for id := range sharedGroups {
+		candidates[id] = true
+	}
+
+	// 同地区的用户，只取最近注册的一部分
+	region := ""
+	if sameRegion {
+		region = me.Region
+	}
+	if region != "" {
+		regionIds, err := db.User.Query().
+			Where(
+				user.Region(region),
+				user.IDNEQ(userId),
+			).
+			Order(ent.Desc(user.FieldID)).
+			Limit(friendSuggestionRegionLimit).
+			IDs(ctx)
+		if err != nil {
+			return nil, errors.New("查询用户信息失败")
+		}
+		for _, id := range regionIds {
+			if !excluded[id] {
+				candidates[id] = true
+			}
+		}
+	}
+
+	if len(candidates) == 0 {
+		return []*FriendSuggestion{}, nil
+	}
+
+	candidateIds := make([]int, 0, len(candidates))
+	for id := range candidates {
+		candidateIds = append(candidateIds, id)
+	}
+	users, err := db.User.Query().
+		Where(user.IDIn(candidateIds...)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.New("查询用户信息失败")
+	}
+
+	suggestions := make([]*FriendSuggestion, 0, len(users))
+	for _, u := range users {
+		s := &FriendSuggestion{
+			UserId:            u.ID,
+			Username:          u.Username,
+			Nickname:          u.Nickname,
+			Avatar:            u.Avatar,
+			Region:            u.Region,
+			MutualFriendCount: len(mutualFriends[u.ID]),
+			MutualFriends:     []string{},
+			SharedGroupCount:  len(sharedGroups[u.ID]),
+			SharedGroups:      []string{},
+			SameRegion:        region != "" && u.Region == region,
+			Reasons:           []string{},
+		}
+		for _, friendId := range mutualFriends[u.ID] {
+			if len(s.MutualFriends) == friendSuggestionReasonNames {
+				break
+			}
+			s.MutualFriends = append(s.MutualFriends, friendNames[friendId])
+		}
+		for _, groupName := range sharedGroups[u.ID] {
+			if len(s.SharedGroups) == friendSuggestionReasonNames {
+				break
+			}
+			s.SharedGroups = append(s.SharedGroups, groupName)
+		}
+
+		s.Score = s.MutualFriendCount*mutualFriendScore + s.SharedGroupCount*sharedGroupScore
+		if s.MutualFriendCount > 0 {
+			s.Reasons = append(s.Reasons, fmt.Sprintf("%d 位共同好友：%s", s.MutualFriendCount, joinReasonNames(s.MutualFriends, s.MutualFriendCount)))
+		}
+		if s.SharedGroupCount > 0 {
+			s.Reasons = append(s.Reasons, fmt.Sprintf("同在 %d 个群聊：%s", s.SharedGroupCount, joinReasonNames(s.SharedGroups, s.SharedGroupCount)))
+		}
+		if s.SameRegion {
+			s.Score += sameRegionScore
+			s.Reasons = append(s.Reasons, "同在地区："+region)
+		}
+		suggestions = append(suggestions, s)
+	}
+
+	// 分数高的在前，分数相同时共同好友多的在前，再按用户ID排序保证分页稳定
+	sort.Slice(suggestions, func(i, j int) bool {
+		a, b := suggestions[i], suggestions[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if a.MutualFriendCount != b.MutualFriendCount {
+			return a.MutualFriendCount > b.MutualFriendCount
+		}
+		return a.UserId < b.UserId
+	})
+	if len(suggestions) > friendSuggestionMaxCount {
+		suggestions = suggestions[:friendSuggestionMaxCount]
+	}
+	return suggestions, nil
+}
+
+// joinReasonNames 拼接推荐理由中的名称，没有全部列出时加“等”
+func joinReasonNames(names []string, total int) string {
+	joined := strings.Join(names, "、")
+	if total > len(names) {
+		joined += " 等"
+	}
+	return joined
+}
@@ -196,6 +196,11 @@ func SearchUsers(keyword string, excludeFriends bool, userId int, limit int) ([]
 		if user.ID == userId {
 			return []*ent.User{}, nil
 		}
+		// 排除拉黑了自己的用户
+		blocked, err := IsBlocked(user.ID, userId)
+		if err != nil || blocked {
+			return []*ent.User{}, nil
+		}
 		// 如果要求排除好友，需要检查
 		if excludeFriends {
 			isFriend, err := IsFriend(userId, user.ID)
@@ -228,10 +233,11 @@ func SearchUsers(keyword string, excludeFriends bool, userId int, limit int) ([]
 	}
 
 	// 转换为切片
+	blockers := blockerIds(userId)
 	users := make([]*ent.User, 0, len(userMap))
 	for _, u := range userMap {
-		// 排除自己
-		if u.ID == userId {
+		// 排除自己和拉黑了自己的用户
+		if u.ID == userId || blockers[u.ID] {
 			continue
 		}
 		users = append(users, u)
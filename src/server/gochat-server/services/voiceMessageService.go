@@ -0,0 +1,101 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"gochat_server/configs"
+	"gochat_server/dto"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+)
+
+// 音频对象元数据的键
+const (
+	audioMetaDuration = "Duration"
+	audioMetaWaveform = "Waveform"
+)
+
+// encodeAudioMetadata 将音频解析结果编码为对象元数据
+func encodeAudioMetadata(audio *AudioInfo) map[string]string {
+	values := make([]string, 0, len(audio.Waveform))
+	for _, v := range audio.Waveform {
+		values = append(values, strconv.Itoa(v))
+	}
+	return map[string]string{
+		audioMetaDuration: strconv.Itoa(audio.Duration),
+		audioMetaWaveform: strings.Join(values, ","),
+	}
+}
+
+// decodeAudioMetadata 从对象元数据中读取音频解析结果
+func decodeAudioMetadata(metadata map[string]string) (*AudioInfo, bool) {
+	duration, err := strconv.Atoi(metadata[audioMetaDuration])
+	if err != nil {
+		return nil, false
+	}
+
+	waveform := make([]int, 0, WaveformSamples)
+	if raw := metadata[audioMetaWaveform]; raw != "" {
+		for _, v := range strings.Split(raw, ",") {
+			value, err := strconv.Atoi(v)
+			if err != nil {
+				return nil, false
+			}
+			waveform = append(waveform, value)
+		}
+	}
+
+	return &AudioInfo{Duration: duration, Waveform: waveform}, true
+}
+
+// parseVoiceContent 解析语音消息内容
+// 音频必须是通过 audio 类别上传的，时长和波形取自上传时保存的对象元数据
+func parseVoiceContent(content string) (*dto.VoiceContent, error) {
+	var voice dto.VoiceContent
+	if err := json.Unmarshal([]byte(content), &voice); err != nil {
+		return nil, rejectMessage(errors.New("无效的语音消息内容"))
+	}
+	if !strings.HasPrefix(voice.StorageKey, "audio/") || strings.Contains(voice.StorageKey, "..") {
+		return nil, rejectMessage(errors.New("无效的语音存储键"))
+	}
+	if minioClient == nil {
+		return nil, errors.New("MinIO客户端未初始化")
+	}
+
+	ctx := context.Background()
+	info, err := minioClient.StatObject(ctx, configs.Cfg.MinIO.BucketName, voice.StorageKey, minio.StatObjectOptions{})
+	if isObjectNotFound(err) {
+		return nil, rejectMessage(errors.New("语音文件不存在"))
+	}
+	if err != nil {
+		return nil, errors.New("查询语音文件失败")
+	}
+
+	audio, ok := decodeAudioMetadata(info.UserMetadata)
+	if !ok {
+		// 元数据缺失时重新下载并解析
+		object, err := DownloadFile(voice.StorageKey)
+		if err != nil {
+			return nil, err
+		}
+		defer object.Close()
+		audio, err = AnalyzeAudio(object, strings.ToLower(filepath.Ext(voice.StorageKey)))
+		if err != nil {
+			return nil, rejectMessage(err)
+		}
+	}
+
+	voice.MimeType = info.ContentType
+	if voice.MimeType == "" {
+		voice.MimeType = "application/octet-stream"
+	}
+	voice.FileSize = info.Size
+	voice.Duration = audio.Duration
+	voice.Waveform = audio.Waveform
+	voice.Url = ""
+	return &voice, nil
+}
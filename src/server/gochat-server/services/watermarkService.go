@@ -0,0 +1,259 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"gochat_server/ent"
+	"gochat_server/ent/groupchatrecord"
+	"gochat_server/ent/groupwatermark"
+	"gochat_server/ent/predicate"
+	"strconv"
+	"time"
+)
+
+// groupMessageSeq 群消息的序号（群聊记录ID）
+type groupMessageSeq struct {
+	GroupId    int
+	Seq        int
+	FromUserId int
+}
+
+// getGroupMessageSeq 查询群消息的序号，不是群消息时返回错误
+func getGroupMessageSeq(msgId string) (*groupMessageSeq, error) {
+	record, err := db.GroupChatRecord.Query().
+		Where(groupchatrecord.MsgId(msgId)).
+		First(context.TODO())
+	if err != nil {
+		return nil, errors.New("消息不存在")
+	}
+
+	groupId, _ := strconv.Atoi(record.GroupId)
+	fromUserId, _ := strconv.Atoi(record.FromUserId)
+	return &groupMessageSeq{GroupId: groupId, Seq: record.ID, FromUserId: fromUserId}, nil
+}
+
+// getGroupMessageSeqs 批量查询群消息的序号
+func getGroupMessageSeqs(msgIds []string) map[string]*groupMessageSeq {
+	result := make(map[string]*groupMessageSeq)
+	if len(msgIds) == 0 {
+		return result
+	}
+
+	records, err := db.GroupChatRecord.Query().
+		Where(groupchatrecord.MsgIdIn(msgIds...)).
+		All(context.TODO())
+	if err != nil {
+		return result
+	}
+	for _, r := range records {
+		groupId, _ := strconv.Atoi(r.GroupId)
+		fromUserId, _ := strconv.Atoi(r.FromUserId)
+		result[r.MsgId] = &groupMessageSeq{GroupId: groupId, Seq: r.ID, FromUserId: fromUserId}
+	}
+	return result
+}
+
+// latestGroupSeq 群聊最新消息的序号，没有消息时为0
+func latestGroupSeq(ctx context.Context, client *ent.Client, groupId int) (int, error) {
+	record, err := client.GroupChatRecord.Query().
+		Where(groupchatrecord.GroupId(strconv.Itoa(groupId))).
+		Order(ent.Desc(groupchatrecord.FieldID)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, errors.New("查询群聊记录失败")
+	}
+	return record.ID, nil
+}
+
+// getGroupWatermark 查询用户在群聊中的水位，没有记录时返回 nil
+func getGroupWatermark(userId, groupId int) (*ent.GroupWatermark, error) {
+	watermark, err := db.GroupWatermark.Query().
+		Where(
+			groupwatermark.UserId(userId),
+			groupwatermark.GroupId(groupId),
+		).
+		First(context.TODO())
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.New("查询群聊水位失败")
+	}
+	return watermark, nil
+}
+
+// advanceGroupWatermark 推进用户在群聊中的水位，水位只升不降，seq 为0表示不修改
+// 已读的消息一定已送达，推进已读水位时同时推进送达水位
+func advanceGroupWatermark(userId, groupId, readSeq, deliveredSeq int) error {
+	ctx := context.TODO()
+	now := time.Now()
+	if readSeq > deliveredSeq {
+		deliveredSeq = readSeq
+	}
+
+	watermark, err := getGroupWatermark(userId, groupId)
+	if err != nil {
+		return err
+	}
+	if watermark == nil {
+		create := db.GroupWatermark.Create().
+			SetUserId(userId).
+			SetGroupId(groupId).
+			SetReadSeq(readSeq).
+			SetDeliveredSeq(deliveredSeq)
+		if readSeq > 0 {
+			create = create.SetReadTime(now)
+		}
+		if deliveredSeq > 0 {
+			create = create.SetDeliveredTime(now)
+		}
+		if _, err := create.Save(ctx); err == nil {
+			if readSeq > 0 {
+				queueReadReceiptUpdate(groupId, 0, readSeq)
+			}
+			return nil
+		}
+		// 并发创建时唯一索引冲突，改为更新
+		if watermark, err = getGroupWatermark(userId, groupId); err != nil || watermark == nil {
+			return errors.New("更新群聊水位失败")
+		}
+	}
+
+	// 条件更新，避免并发请求使水位回退
+	if readSeq > watermark.ReadSeq {
+		updated, err := db.GroupWatermark.Update().
+			Where(groupwatermark.ID(watermark.ID), groupwatermark.ReadSeqLT(readSeq)).
+			SetReadSeq(readSeq).
+			SetReadTime(now).
+			Save(ctx)
+		if err != nil {
+			return errors.New("更新群聊已读水位失败")
+		}
+		if updated > 0 {
+			queueReadReceiptUpdate(groupId, watermark.ReadSeq, readSeq)
+		}
+	}
+	if deliveredSeq > watermark.DeliveredSeq {
+		_, err := db.GroupWatermark.Update().
+			Where(groupwatermark.ID(watermark.ID), groupwatermark.DeliveredSeqLT(deliveredSeq)).
+			SetDeliveredSeq(deliveredSeq).
+			SetDeliveredTime(now).
+			Save(ctx)
+		if err != nil {
+			return errors.New("更新群聊送达水位失败")
+		}
+	}
+	return nil
+}
+
+// initGroupWatermarks 为新成员创建水位，加入前的消息视为已读
+// 已有水位的成员不受影响
+func initGroupWatermarks(ctx context.Context, client *ent.Client, groupId int, userIds []int) error {
+	if len(userIds) == 0 {
+		return nil
+	}
+
+	existing, err := client.GroupWatermark.Query().
+		Where(
+			groupwatermark.GroupId(groupId),
+			groupwatermark.UserIdIn(userIds...),
+		).
+		Select(groupwatermark.FieldUserId).
+		Ints(ctx)
+	if err != nil {
+		return errors.New("查询群聊水位失败")
+	}
+	hasWatermark := make(map[int]bool, len(existing))
+	for _, userId := range existing {
+		hasWatermark[userId] = true
+	}
+
+	seq, err := latestGroupSeq(ctx, client, groupId)
+	if err != nil {
+		return err
+	}
+
+	builders := make([]*ent.GroupWatermarkCreate, 0, len(userIds))
+	for _, userId := range userIds {
+		if hasWatermark[userId] {
+			continue
+		}
+		hasWatermark[userId] = true
+		builders = append(builders, client.GroupWatermark.Create().
+			SetUserId(userId).
+			SetGroupId(groupId).
+			SetReadSeq(seq).
+			SetDeliveredSeq(seq).
+			SetJoinSeq(seq))
+	}
+	if len(builders) == 0 {
+		return nil
+	}
+
+	if _, err := client.GroupWatermark.CreateBulk(builders...).Save(ctx); err != nil {
+		return errors.New("创建群聊水位失败")
+	}
+	return nil
+}
+
+// deleteGroupWatermarks 删除群聊水位，userIds 为空时删除整个群的水位
+func deleteGroupWatermarks(groupId int, userIds ...int) {
+	query := db.GroupWatermark.Delete().
+		Where(groupwatermark.GroupId(groupId))
+	if len(userIds) > 0 {
+		query = query.Where(groupwatermark.UserIdIn(userIds...))
+	}
+	_, _ = query.Exec(context.TODO())
+}
+
+// countGroupUnread 根据已读水位计算群聊未读消息数，不包括自己发送的消息和自己删除的消息
+// predicates 为额外的查询条件，如只统计@自己的消息
+func countGroupUnread(userId, groupId int, predicates ...predicate.GroupChatRecord) (int, error) {
+	readSeq := 0
+	watermark, err := getGroupWatermark(userId, groupId)
+	if err != nil {
+		return 0, err
+	}
+	if watermark != nil {
+		readSeq = watermark.ReadSeq
+	}
+
+	visibility, err := getConversationVisibility(userId, conversationKey{isGroup: true, targetId: groupId})
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := db.GroupChatRecord.Query().
+		Where(
+			groupchatrecord.GroupId(strconv.Itoa(groupId)),
+			groupchatrecord.IDGT(readSeq),
+			groupchatrecord.FromUserIdNEQ(strconv.Itoa(userId)),
+		).
+		Where(visibility.groupChatRecordPredicates()...).
+		Where(predicates...).
+		Count(context.TODO())
+	if err != nil {
+		return 0, errors.New("查询群聊未读消息失败")
+	}
+	return count, nil
+}
+
+// getGroupMessagesReadState 查询用户对一组群消息的已读状态
+func getGroupMessagesReadState(userId int, msgIds []string) map[string]bool {
+	result := make(map[string]bool)
+	seqs := getGroupMessageSeqs(msgIds)
+
+	watermarks := make(map[int]*ent.GroupWatermark)
+	for msgId, seq := range seqs {
+		watermark, ok := watermarks[seq.GroupId]
+		if !ok {
+			watermark, _ = getGroupWatermark(userId, seq.GroupId)
+			watermarks[seq.GroupId] = watermark
+		}
+		result[msgId] = seq.FromUserId == userId || (watermark != nil && watermark.ReadSeq >= seq.Seq)
+	}
+	return result
+}
@@ -0,0 +1,108 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 消息ID结构（64位）：41位毫秒时间戳 | 10位节点ID | 12位序号
+// 编码为13位 Crockford Base32 字符串，字符串顺序与生成时间顺序一致，可以直接排序和分页
+const (
+	messageIdNodeBits = 10
+	messageIdSeqBits  = 12
+	messageIdLength   = 13
+
+	// MaxMessageIdNode 节点ID的最大值
+	MaxMessageIdNode = 1<<messageIdNodeBits - 1
+	messageIdMaxSeq  = 1<<messageIdSeqBits - 1
+)
+
+// messageIdEpoch 时间戳起点（2024-01-01 UTC），41位毫秒时间戳可以使用约69年
+var messageIdEpoch = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).UnixMilli()
+
+const crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// messageIdGenerator 消息ID生成器，同一节点内生成的ID严格递增
+type messageIdGenerator struct {
+	mu     sync.Mutex
+	nodeId int64
+	lastMs int64
+	seq    int64
+}
+
+var messageIds = &messageIdGenerator{}
+
+// SetMessageIdNode 设置生成消息ID使用的节点ID，多个节点部署时每个节点必须不同
+func SetMessageIdNode(nodeId int) error {
+	if nodeId < 0 || nodeId > MaxMessageIdNode {
+		return fmt.Errorf("节点ID必须在 0-%d 之间", MaxMessageIdNode)
+	}
+
+	messageIds.mu.Lock()
+	defer messageIds.mu.Unlock()
+	messageIds.nodeId = int64(nodeId)
+	return nil
+}
+
+// NewMessageId 生成消息ID，返回ID和其中的时间戳
+func NewMessageId() (string, time.Time) {
+	value, ms := messageIds.next()
+	return encodeMessageId(value), time.UnixMilli(ms + messageIdEpoch)
+}
+
+// next 生成下一个ID
+// 时钟回拨时沿用上次的时间戳；同一毫秒内序号用完时借用下一毫秒，保证ID递增且不需要等待
+func (g *messageIdGenerator) next() (int64, int64) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	ms := time.Now().UnixMilli() - messageIdEpoch
+	if ms <= g.lastMs {
+		ms = g.lastMs
+		g.seq++
+		if g.seq > messageIdMaxSeq {
+			ms++
+			g.seq = 0
+		}
+	} else {
+		g.seq = 0
+	}
+	g.lastMs = ms
+
+	value := ms<<(messageIdNodeBits+messageIdSeqBits) | g.nodeId<<messageIdSeqBits | g.seq
+	return value, ms
+}
+
+// encodeMessageId 将ID编码为定长的 Crockford Base32 字符串
+func encodeMessageId(value int64) string {
+	buf := make([]byte, messageIdLength)
+	for i := messageIdLength - 1; i >= 0; i-- {
+		buf[i] = crockfordAlphabet[value&31]
+		value >>= 5
+	}
+	return string(buf)
+}
+
+// ParseMessageId 解析由 NewMessageId 生成的消息ID，返回生成时间和节点ID
+// 旧版本使用的UUID等其他格式返回 false
+func ParseMessageId(msgId string) (time.Time, int, bool) {
+	if len(msgId) != messageIdLength {
+		return time.Time{}, 0, false
+	}
+
+	var value int64
+	for i, c := range strings.ToUpper(msgId) {
+		digit := strings.IndexRune(crockfordAlphabet, c)
+		// 首位只使用低3位，超出时不是有效的64位ID
+		if digit < 0 || (i == 0 && digit > 7) {
+			return time.Time{}, 0, false
+		}
+		value = value<<5 | int64(digit)
+	}
+
+	ms := value >> (messageIdNodeBits + messageIdSeqBits)
+	nodeId := int(value >> messageIdSeqBits & MaxMessageIdNode)
+	return time.UnixMilli(ms + messageIdEpoch), nodeId, true
+}
@@ -0,0 +1,145 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// decodeMessageIdValue 拆分ID中的毫秒时间戳、节点ID和序号
+func decodeMessageIdValue(value int64) (ms, nodeId, seq int64) {
+	return value >> (messageIdNodeBits + messageIdSeqBits),
+		value >> messageIdSeqBits & MaxMessageIdNode,
+		value & messageIdMaxSeq
+}
+
+func TestMessageIdGeneratorNext(t *testing.T) {
+	// 比当前时间晚一小时的时间戳，next 取到的当前时间一定小于它，模拟时钟回拨
+	future := time.Now().UnixMilli() - messageIdEpoch + time.Hour.Milliseconds()
+
+	tests := []struct {
+		name    string
+		lastMs  int64
+		seq     int64
+		wantMs  int64 // 0 表示使用当前时间
+		wantSeq int64
+	}{
+		{"new millisecond resets sequence", 0, 100, 0, 0},
+		{"clock regression keeps last timestamp", future, 5, future, 6},
+		{"sequence overflow borrows next millisecond", future, messageIdMaxSeq, future + 1, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &messageIdGenerator{nodeId: 7, lastMs: tt.lastMs, seq: tt.seq}
+			before := time.Now().UnixMilli() - messageIdEpoch
+
+			value, ms := g.next()
+			gotMs, gotNode, gotSeq := decodeMessageIdValue(value)
+
+			if gotMs != ms {
+				t.Errorf("timestamp in id = %d, returned %d", gotMs, ms)
+			}
+			if tt.wantMs == 0 {
+				if ms < before {
+					t.Errorf("timestamp = %d, want at least %d", ms, before)
+				}
+			} else if ms != tt.wantMs {
+				t.Errorf("timestamp = %d, want %d", ms, tt.wantMs)
+			}
+			if gotNode != 7 {
+				t.Errorf("node = %d, want 7", gotNode)
+			}
+			if gotSeq != tt.wantSeq {
+				t.Errorf("seq = %d, want %d", gotSeq, tt.wantSeq)
+			}
+			if g.lastMs != ms {
+				t.Errorf("lastMs = %d, want %d", g.lastMs, ms)
+			}
+		})
+	}
+}
+
+func TestMessageIdMonotonic(t *testing.T) {
+	tests := []struct {
+		name   string
+		lastMs int64
+		seq    int64
+	}{
+		// 连续生成，多个ID落在同一毫秒内
+		{"same millisecond", 0, 0},
+		// 时钟回拨期间序号很快用完，需要连续借用之后的毫秒
+		{"clock regression with sequence overflow", time.Now().UnixMilli() - messageIdEpoch + time.Minute.Milliseconds(), messageIdMaxSeq - 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &messageIdGenerator{lastMs: tt.lastMs, seq: tt.seq}
+
+			prev := ""
+			sameMs := 0
+			var prevMs int64
+			for i := 0; i < 3*(messageIdMaxSeq+1); i++ {
+				value, ms := g.next()
+				id := encodeMessageId(value)
+				if len(id) != messageIdLength {
+					t.Fatalf("id %q has length %d, want %d", id, len(id), messageIdLength)
+				}
+				if id <= prev {
+					t.Fatalf("id %q generated after %q is not greater", id, prev)
+				}
+				if ms == prevMs {
+					sameMs++
+				}
+				prev, prevMs = id, ms
+			}
+			if sameMs == 0 {
+				t.Errorf("no ids were generated within the same millisecond")
+			}
+		})
+	}
+}
+
+func TestParseMessageId(t *testing.T) {
+	if err := SetMessageIdNode(42); err != nil {
+		t.Fatal(err)
+	}
+	defer SetMessageIdNode(0)
+
+	msgId, createTime := NewMessageId()
+
+	tests := []struct {
+		name     string
+		msgId    string
+		wantOk   bool
+		wantTime time.Time
+		wantNode int
+	}{
+		{"generated id", msgId, true, createTime, 42},
+		{"lower case", strings.ToLower(msgId), true, createTime, 42},
+		{"legacy uuid", "3f2b8c1e-9d4a-4b7e-8f21-6c5d0a9e7b13", false, time.Time{}, 0},
+		{"uuid without dashes", "3f2b8c1e9d4a4b7e8f216c5d0a9e7b13", false, time.Time{}, 0},
+		{"too short", msgId[:messageIdLength-1], false, time.Time{}, 0},
+		{"invalid character", "0000000000U00", false, time.Time{}, 0},
+		{"overflows 64 bits", "8000000000000", false, time.Time{}, 0},
+		{"empty", "", false, time.Time{}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotTime, gotNode, ok := ParseMessageId(tt.msgId)
+			if ok != tt.wantOk {
+				t.Fatalf("ParseMessageId(%q) ok = %v, want %v", tt.msgId, ok, tt.wantOk)
+			}
+			if !ok {
+				return
+			}
+			if !gotTime.Equal(tt.wantTime) {
+				t.Errorf("time = %v, want %v", gotTime, tt.wantTime)
+			}
+			if gotNode != tt.wantNode {
+				t.Errorf("node = %d, want %d", gotNode, tt.wantNode)
+			}
+		})
+	}
+}
@@ -356,6 +356,11 @@ func notifyMessageStatus(msgId string, userId int, status string) {
 		return
 	}
 
+	// 群消息的已读人数由已读回执任务合并后推送
+	if messageDetail.IsGroup {
+		return
+	}
+
 	// 构建状态更新消息
 	statusMsg := map[string]interface{}{
 		"type":   "message_status",